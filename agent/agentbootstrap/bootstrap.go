@@ -43,6 +43,7 @@ import (
 	"github.com/juju/juju/internal/cloudconfig/instancecfg"
 	"github.com/juju/juju/internal/database"
 	"github.com/juju/juju/internal/password"
+	"github.com/juju/juju/internal/secrets/encryptionkey"
 	"github.com/juju/juju/internal/uuid"
 )
 
@@ -275,6 +276,12 @@ func (b *AgentBootstrap) Initialize(ctx context.Context) (resultErr error) {
 		return errors.Trace(err)
 	}
 
+	// The secret key-encryption keys are derived from a root key which is
+	// kept with the controller agent, rather than in the database.
+	if err := encryptionkey.EnsureRootKey(agentConfig.DataDir()); err != nil {
+		return errors.Annotate(err, "creating secret encryption root key")
+	}
+
 	b.logger.Debugf(ctx, "initializing address %v", info.Addrs)
 	b.agentConfig.SetControllerAgentInfo(controllerAgentInfo)
 
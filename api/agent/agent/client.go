@@ -73,6 +73,8 @@ func (st *Client) StateServingInfo(ctx context.Context) (controller.ControllerAg
 		PrivateKey:     results.PrivateKey,
 		CAPrivateKey:   results.CAPrivateKey,
 		SystemIdentity: results.SystemIdentity,
+
+		SecretEncryptionKey: results.SecretEncryptionKey,
 	}, nil
 }
 
@@ -112,6 +112,14 @@ type DeployArgs struct {
 	// Force can be set to true to bypass any checks for charm-specific
 	// requirements ("assumes" sections in charm metadata)
 	Force bool
+
+	// CharmSignature is a detached signature over the charm archive's
+	// SHA256 hash, for controllers with a charm-signature-policy.
+	CharmSignature string
+
+	// ResourceSignatures maps OCI image resource names to detached
+	// signatures over the resource's SHA384 fingerprint.
+	ResourceSignatures map[string]string
 }
 
 // Leader returns the unit name for the leader of the provided application.
@@ -162,6 +170,9 @@ func (c *Client) Deploy(ctx context.Context, args DeployArgs) error {
 			EndpointBindings: args.EndpointBindings,
 			Resources:        args.Resources,
 			Force:            args.Force,
+
+			CharmSignature:     args.CharmSignature,
+			ResourceSignatures: args.ResourceSignatures,
 		}},
 	}
 	var results params.ErrorResults
@@ -296,6 +307,14 @@ type SetCharmConfig struct {
 	// EndpointBindings is a map of operator-defined endpoint names to
 	// space names to be merged with any existing endpoint bindings.
 	EndpointBindings map[string]string
+
+	// CharmSignature is a detached signature over the charm archive's
+	// SHA256 hash, for controllers with a charm-signature-policy.
+	CharmSignature string
+
+	// ResourceSignatures maps OCI image resource names to detached
+	// signatures over the resource's SHA384 fingerprint.
+	ResourceSignatures map[string]string
 }
 
 // SetCharm sets the charm for a given application.
@@ -336,6 +355,8 @@ func (c *Client) SetCharm(ctx context.Context, cfg SetCharmConfig) error {
 		ResourceIDs:        cfg.ResourceIDs,
 		StorageDirectives:  storageDirectives,
 		EndpointBindings:   cfg.EndpointBindings,
+		CharmSignature:     cfg.CharmSignature,
+		ResourceSignatures: cfg.ResourceSignatures,
 	}
 	return c.facade.FacadeCall(ctx, "SetCharm", args, nil)
 }
@@ -683,6 +704,134 @@ func (c *Client) SetConstraints(ctx context.Context, application string, constra
 	return c.facade.FacadeCall(ctx, "SetConstraints", args, nil)
 }
 
+// RefreshPolicy holds the charm refresh policy of an application.
+type RefreshPolicy struct {
+	// Policy is one of "none", "notify" or "auto".
+	Policy string
+	// Window is the maintenance window, as "HH:MM-HH:MM" in UTC, within
+	// which the application may be refreshed automatically.
+	Window string
+}
+
+// GetRefreshPolicy returns the charm refresh policy of the given application.
+func (c *Client) GetRefreshPolicy(ctx context.Context, application string) (RefreshPolicy, error) {
+	if c.BestAPIVersion() < 23 {
+		return RefreshPolicy{}, errors.NotSupportedf("charm refresh policies on this version of Juju")
+	}
+
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: names.NewApplicationTag(application).String()}},
+	}
+	var results params.ApplicationRefreshPolicyResults
+	if err := c.facade.FacadeCall(ctx, "GetRefreshPolicies", args, &results); err != nil {
+		return RefreshPolicy{}, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return RefreshPolicy{}, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return RefreshPolicy{}, errors.Trace(result.Error)
+	}
+	if result.Result == nil {
+		return RefreshPolicy{}, errors.Errorf("missing refresh policy for %q", application)
+	}
+	return RefreshPolicy{
+		Policy: result.Result.Policy,
+		Window: result.Result.Window,
+	}, nil
+}
+
+// SetRefreshPolicy sets the charm refresh policy of the given application.
+func (c *Client) SetRefreshPolicy(ctx context.Context, application string, policy RefreshPolicy) error {
+	if c.BestAPIVersion() < 23 {
+		return errors.NotSupportedf("charm refresh policies on this version of Juju")
+	}
+
+	args := params.ApplicationRefreshPolicies{
+		Policies: []params.ApplicationRefreshPolicy{{
+			ApplicationTag: names.NewApplicationTag(application).String(),
+			Policy:         policy.Policy,
+			Window:         policy.Window,
+		}},
+	}
+	var results params.ErrorResults
+	if err := c.facade.FacadeCall(ctx, "SetRefreshPolicies", args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
+
+// GetApplicationStorage returns the storage directives of the given
+// application, keyed on storage name. These are the directives used when
+// provisioning storage for new units of the application.
+func (c *Client) GetApplicationStorage(ctx context.Context, application string) (map[string]storage.Directive, error) {
+	if c.BestAPIVersion() < 22 {
+		return nil, errors.NotSupportedf("application storage directives on this version of Juju")
+	}
+
+	args := params.Entities{
+		Entities: []params.Entity{{Tag: names.NewApplicationTag(application).String()}},
+	}
+	var results params.ApplicationStorageGetResults
+	if err := c.facade.FacadeCall(ctx, "GetApplicationStorage", args, &results); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return nil, errors.Trace(result.Error)
+	}
+	directives := make(map[string]storage.Directive, len(result.StorageConstraints))
+	for name, sc := range result.StorageConstraints {
+		d := storage.Directive{Pool: sc.Pool}
+		if sc.SizeMiB != nil {
+			d.Size = *sc.SizeMiB
+		}
+		if sc.Count != nil {
+			d.Count = *sc.Count
+		}
+		directives[name] = d
+	}
+	return directives, nil
+}
+
+// UpdateApplicationStorage updates the storage directives of the given
+// application, keyed on storage name. Only the non-zero fields of each
+// directive are changed.
+func (c *Client) UpdateApplicationStorage(ctx context.Context, application string, directives map[string]storage.Directive) error {
+	if c.BestAPIVersion() < 22 {
+		return errors.NotSupportedf("application storage directives on this version of Juju")
+	}
+
+	sc := make(map[string]params.StorageDirectives, len(directives))
+	for name, d := range directives {
+		p := params.StorageDirectives{Pool: d.Pool}
+		if d.Size != 0 {
+			size := d.Size
+			p.SizeMiB = &size
+		}
+		if d.Count != 0 {
+			count := d.Count
+			p.Count = &count
+		}
+		sc[name] = p
+	}
+	args := params.ApplicationStorageUpdateRequest{
+		ApplicationStorageUpdates: []params.ApplicationStorageUpdate{{
+			ApplicationTag:     names.NewApplicationTag(application).String(),
+			StorageConstraints: sc,
+		}},
+	}
+	var results params.ErrorResults
+	if err := c.facade.FacadeCall(ctx, "UpdateApplicationStorage", args, &results); err != nil {
+		return errors.Trace(err)
+	}
+	return results.OneError()
+}
+
 // Expose changes the juju-managed firewall to expose any ports that
 // were also explicitly marked by units as open. The exposedEndpoints argument
 // can be used to restrict the set of ports that get exposed and at the same
@@ -1117,6 +1266,12 @@ type DeployFromRepositoryArg struct {
 	Storage map[string]storage.Directive
 	//  Trust allows charm to run hooks that require access credentials
 	Trust bool
+	// CharmSignature is a detached signature over the charm archive's
+	// SHA256 hash, for controllers with a charm-signature-policy.
+	CharmSignature string
+	// ResourceSignatures maps OCI image resource names to detached
+	// signatures over the resource's SHA384 fingerprint.
+	ResourceSignatures map[string]string
 }
 
 // DeployFromRepository deploys a charm from a repository based on the
@@ -1204,5 +1359,8 @@ func paramsFromDeployFromRepositoryArg(arg DeployFromRepositoryArg) params.Deplo
 		Resources:        arg.Resources,
 		Storage:          arg.Storage,
 		Trust:            arg.Trust,
+
+		CharmSignature:     arg.CharmSignature,
+		ResourceSignatures: arg.ResourceSignatures,
 	}
 }
@@ -293,6 +293,43 @@ func (s *applicationSuite) TestSetCharm(c *tc.C) {
 	c.Assert(err, tc.ErrorIsNil)
 }
 
+func (s *applicationSuite) TestSetCharmWithSignatures(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.ApplicationSetCharmV2{
+		ApplicationName: "application",
+		CharmURL:        "ch:application-1",
+		CharmOrigin: &params.CharmOrigin{
+			Source: "charm-hub",
+			Risk:   "edge",
+		},
+		Channel:            "edge",
+		ResourceIDs:        map[string]string{"image": "resource-uuid"},
+		CharmSignature:     "charm-sig",
+		ResourceSignatures: map[string]string{"image": "image-sig"},
+	}
+	cfg := application.SetCharmConfig{
+		ApplicationName: "application",
+		CharmID: application.CharmID{
+			URL: "ch:application-1",
+			Origin: apicharm.Origin{
+				Source: "charm-hub",
+				Risk:   "edge",
+			},
+		},
+		ResourceIDs:        map[string]string{"image": "resource-uuid"},
+		CharmSignature:     "charm-sig",
+		ResourceSignatures: map[string]string{"image": "image-sig"},
+	}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "SetCharm", args, nil).Return(nil)
+
+	client := application.NewClientFromCaller(mockFacadeCaller)
+	err := client.SetCharm(c.Context(), cfg)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
 func (s *applicationSuite) TestDestroyApplications(c *tc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
@@ -1594,3 +1631,136 @@ func (s *applicationSuite) TestDeployFromRepository(c *tc.C) {
 	})
 
 }
+
+func (s *applicationSuite) TestGetRefreshPolicy(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.Entities{Entities: []params.Entity{{Tag: "application-foo"}}}
+	result := new(params.ApplicationRefreshPolicyResults)
+	results := params.ApplicationRefreshPolicyResults{
+		Results: []params.ApplicationRefreshPolicyResult{{
+			Result: &params.ApplicationRefreshPolicy{
+				ApplicationTag: "application-foo",
+				Policy:         "auto",
+				Window:         "02:00-04:00",
+			},
+		}},
+	}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "GetRefreshPolicies", args, result).SetArg(3, results).Return(nil)
+
+	mockClientFacade := mocks.NewMockClientFacade(ctrl)
+	mockClientFacade.EXPECT().BestAPIVersion().Return(23).AnyTimes()
+
+	client := application.NewClientFromCaller(mockFacadeCaller)
+	client.ClientFacade = mockClientFacade
+	policy, err := client.GetRefreshPolicy(c.Context(), "foo")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(policy, tc.DeepEquals, application.RefreshPolicy{
+		Policy: "auto",
+		Window: "02:00-04:00",
+	})
+}
+
+func (s *applicationSuite) TestSetRefreshPolicy(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.ApplicationRefreshPolicies{
+		Policies: []params.ApplicationRefreshPolicy{{
+			ApplicationTag: "application-foo",
+			Policy:         "notify",
+		}},
+	}
+	result := new(params.ErrorResults)
+	results := params.ErrorResults{
+		Results: []params.ErrorResult{{Error: &params.Error{Message: "boom"}}},
+	}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "SetRefreshPolicies", args, result).SetArg(3, results).Return(nil)
+
+	mockClientFacade := mocks.NewMockClientFacade(ctrl)
+	mockClientFacade.EXPECT().BestAPIVersion().Return(23).AnyTimes()
+
+	client := application.NewClientFromCaller(mockFacadeCaller)
+	client.ClientFacade = mockClientFacade
+	err := client.SetRefreshPolicy(c.Context(), "foo", application.RefreshPolicy{Policy: "notify"})
+	c.Assert(err, tc.ErrorMatches, "boom")
+}
+
+func (s *applicationSuite) TestRefreshPolicyNotSupported(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockClientFacade := mocks.NewMockClientFacade(ctrl)
+	mockClientFacade.EXPECT().BestAPIVersion().Return(22).AnyTimes()
+
+	client := application.NewClientFromCaller(mocks.NewMockFacadeCaller(ctrl))
+	client.ClientFacade = mockClientFacade
+	_, err := client.GetRefreshPolicy(c.Context(), "foo")
+	c.Assert(err, tc.ErrorIs, errors.NotSupported)
+	err = client.SetRefreshPolicy(c.Context(), "foo", application.RefreshPolicy{Policy: "auto"})
+	c.Assert(err, tc.ErrorIs, errors.NotSupported)
+}
+
+func (s *applicationSuite) TestGetApplicationStorage(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	size := uint64(1024)
+	count := uint64(2)
+	args := params.Entities{Entities: []params.Entity{{Tag: "application-foo"}}}
+	result := new(params.ApplicationStorageGetResults)
+	results := params.ApplicationStorageGetResults{
+		Results: []params.ApplicationStorageGetResult{{
+			StorageConstraints: map[string]params.StorageDirectives{
+				"data": {Pool: "fast", SizeMiB: &size, Count: &count},
+			},
+		}},
+	}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "GetApplicationStorage", args, result).SetArg(3, results).Return(nil)
+
+	mockClientFacade := mocks.NewMockClientFacade(ctrl)
+	mockClientFacade.EXPECT().BestAPIVersion().Return(23).AnyTimes()
+
+	client := application.NewClientFromCaller(mockFacadeCaller)
+	client.ClientFacade = mockClientFacade
+	directives, err := client.GetApplicationStorage(c.Context(), "foo")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(directives, tc.DeepEquals, map[string]storage.Directive{
+		"data": {Pool: "fast", Size: 1024, Count: 2},
+	})
+}
+
+func (s *applicationSuite) TestUpdateApplicationStorage(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	size := uint64(2048)
+	args := params.ApplicationStorageUpdateRequest{
+		ApplicationStorageUpdates: []params.ApplicationStorageUpdate{{
+			ApplicationTag: "application-foo",
+			StorageConstraints: map[string]params.StorageDirectives{
+				"data": {Pool: "fast", SizeMiB: &size},
+			},
+		}},
+	}
+	result := new(params.ErrorResults)
+	results := params.ErrorResults{
+		Results: []params.ErrorResult{{Error: &params.Error{Message: "boom"}}},
+	}
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "UpdateApplicationStorage", args, result).SetArg(3, results).Return(nil)
+
+	mockClientFacade := mocks.NewMockClientFacade(ctrl)
+	mockClientFacade.EXPECT().BestAPIVersion().Return(23).AnyTimes()
+
+	client := application.NewClientFromCaller(mockFacadeCaller)
+	client.ClientFacade = mockClientFacade
+	err := client.UpdateApplicationStorage(c.Context(), "foo", map[string]storage.Directive{
+		"data": {Pool: "fast", Size: 2048},
+	})
+	c.Assert(err, tc.ErrorMatches, "boom")
+}
@@ -0,0 +1,110 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package resources_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/api/base/mocks"
+	"github.com/juju/juju/api/client/resources"
+	"github.com/juju/juju/rpc/params"
+)
+
+func TestStorageSuite(t *testing.T) {
+	tc.Run(t, &StorageSuite{})
+}
+
+type StorageSuite struct{}
+
+func (s *StorageSuite) TestStorageReport(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	result := params.ResourceStorageReport{
+		Blobs: []params.ResourceStorageBlob{{
+			Paths:   []string{"path-1"},
+			SHA384:  "hash-1",
+			Size:    42,
+			Created: created,
+			Usage:   "in-use",
+			References: []params.ResourceStorageBlobReference{{
+				Kind:            "resource",
+				Name:            "data",
+				ApplicationName: "mysql",
+				Revision:        2,
+				InUse:           true,
+			}},
+		}},
+	}
+
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(4)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "StorageReport", nil, gomock.Any()).SetArg(3, result).Return(nil)
+	client := resources.NewClientFromCaller(mockFacadeCaller)
+
+	blobs, err := client.StorageReport(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(blobs, tc.DeepEquals, []resources.StoredBlob{{
+		Paths:   []string{"path-1"},
+		SHA384:  "hash-1",
+		Size:    42,
+		Created: created,
+		Usage:   "in-use",
+		References: []resources.BlobReference{{
+			Kind:            "resource",
+			Name:            "data",
+			ApplicationName: "mysql",
+			Revision:        2,
+			InUse:           true,
+		}},
+	}})
+}
+
+func (s *StorageSuite) TestStorageReportNotSupported(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(3)
+	client := resources.NewClientFromCaller(mockFacadeCaller)
+
+	_, err := client.StorageReport(c.Context())
+	c.Assert(err, tc.ErrorIs, errors.NotSupported)
+}
+
+func (s *StorageSuite) TestPruneStorage(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	args := params.PruneResourceStorageArgs{
+		GracePeriod: time.Hour,
+		DryRun:      true,
+	}
+	result := params.ResourceStorageReport{
+		Blobs: []params.ResourceStorageBlob{{
+			Paths: []string{"path-1"},
+			Size:  42,
+			Usage: "unreferenced",
+		}},
+	}
+
+	mockFacadeCaller := mocks.NewMockFacadeCaller(ctrl)
+	mockFacadeCaller.EXPECT().BestAPIVersion().Return(4)
+	mockFacadeCaller.EXPECT().FacadeCall(gomock.Any(), "PruneStorage", args, gomock.Any()).SetArg(3, result).Return(nil)
+	client := resources.NewClientFromCaller(mockFacadeCaller)
+
+	blobs, err := client.PruneStorage(c.Context(), time.Hour, true)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(blobs, tc.DeepEquals, []resources.StoredBlob{{
+		Paths: []string{"path-1"},
+		Size:  42,
+		Usage: "unreferenced",
+	}})
+}
@@ -0,0 +1,100 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package resources
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/rpc/params"
+)
+
+// StoredBlob describes a blob held in a model's object store on behalf of
+// charms and resources.
+type StoredBlob struct {
+	// Paths are the object store paths of the blob.
+	Paths []string
+	// SHA384 is the hash of the blob.
+	SHA384 string
+	// Size is the size of the blob in bytes.
+	Size int64
+	// Created is when the blob was stored.
+	Created time.Time
+	// Usage is one of "in-use", "old-revision" or "unreferenced".
+	Usage string
+	// References are the charm and resource revisions held in the blob.
+	References []BlobReference
+}
+
+// BlobReference describes a charm or resource revision held in a blob.
+type BlobReference struct {
+	// Kind is either "charm" or "resource".
+	Kind string
+	// Name is the charm name, or the resource name.
+	Name string
+	// ApplicationName is the application a resource belongs to.
+	ApplicationName string
+	// Revision is the charm or resource revision.
+	Revision int
+	// InUse is true if the revision is in use by an application or unit.
+	InUse bool
+}
+
+// StorageReport returns the blobs held in the model's object store on behalf
+// of charms and resources.
+func (c Client) StorageReport(ctx context.Context) ([]StoredBlob, error) {
+	if c.facade.BestAPIVersion() < 4 {
+		return nil, errors.NotSupportedf("resource storage report on this version of Juju")
+	}
+	var result params.ResourceStorageReport
+	if err := c.facade.FacadeCall(ctx, "StorageReport", nil, &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return storedBlobs(result), nil
+}
+
+// PruneStorage removes the blobs from the model's object store which are not
+// referred to by any charm or resource, once they are older than the grace
+// period. The removed blobs are returned. If dryRun is true, nothing is
+// removed and the blobs which would be removed are returned.
+func (c Client) PruneStorage(ctx context.Context, gracePeriod time.Duration, dryRun bool) ([]StoredBlob, error) {
+	if c.facade.BestAPIVersion() < 4 {
+		return nil, errors.NotSupportedf("pruning resource storage on this version of Juju")
+	}
+	args := params.PruneResourceStorageArgs{
+		GracePeriod: gracePeriod,
+		DryRun:      dryRun,
+	}
+	var result params.ResourceStorageReport
+	if err := c.facade.FacadeCall(ctx, "PruneStorage", args, &result); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return storedBlobs(result), nil
+}
+
+func storedBlobs(report params.ResourceStorageReport) []StoredBlob {
+	result := make([]StoredBlob, len(report.Blobs))
+	for i, blob := range report.Blobs {
+		stored := StoredBlob{
+			Paths:   blob.Paths,
+			SHA384:  blob.SHA384,
+			Size:    blob.Size,
+			Created: blob.Created,
+			Usage:   blob.Usage,
+		}
+		for _, ref := range blob.References {
+			stored.References = append(stored.References, BlobReference{
+				Kind:            ref.Kind,
+				Name:            ref.Name,
+				ApplicationName: ref.ApplicationName,
+				Revision:        ref.Revision,
+				InUse:           ref.InUse,
+			})
+		}
+		result[i] = stored
+	}
+	return result
+}
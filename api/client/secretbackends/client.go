@@ -167,3 +167,12 @@ func (api *Client) RemoveSecretBackend(ctx context.Context, name string, force b
 	}
 	return params.TranslateWellKnownError(results.OneError())
 }
+
+// RotateSecretEncryptionKey replaces the controller key used to encrypt
+// secret content stored in the internal backend.
+func (api *Client) RotateSecretEncryptionKey(ctx context.Context) error {
+	if api.BestAPIVersion() < 2 {
+		return errors.NotSupportedf("rotating the secret encryption key on this juju version")
+	}
+	return errors.Trace(api.facade.FacadeCall(ctx, "RotateSecretEncryptionKey", nil, nil))
+}
@@ -7,6 +7,7 @@ import (
 	stdtesting "testing"
 	"time"
 
+	"github.com/juju/errors"
 	"github.com/juju/tc"
 
 	"github.com/juju/juju/api/base/testing"
@@ -179,3 +180,31 @@ func (s *SecretBackendsSuite) TestUpdateSecretsBackend(c *tc.C) {
 	err := client.UpdateSecretBackend(c.Context(), backend, true)
 	c.Assert(err, tc.ErrorMatches, "FAIL")
 }
+
+func (s *SecretBackendsSuite) TestRotateSecretEncryptionKey(c *tc.C) {
+	apiCaller := testing.BestVersionCaller{
+		APICallerFunc: testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+			c.Check(objType, tc.Equals, "SecretBackends")
+			c.Check(version, tc.Equals, 2)
+			c.Check(id, tc.Equals, "")
+			c.Check(request, tc.Equals, "RotateSecretEncryptionKey")
+			c.Check(arg, tc.IsNil)
+			return errors.New("FAIL")
+		}), BestVersion: 2,
+	}
+	client := secretbackends.NewClient(apiCaller)
+	err := client.RotateSecretEncryptionKey(c.Context())
+	c.Assert(err, tc.ErrorMatches, "FAIL")
+}
+
+func (s *SecretBackendsSuite) TestRotateSecretEncryptionKeyNotSupported(c *tc.C) {
+	apiCaller := testing.BestVersionCaller{
+		APICallerFunc: testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+			c.Fatalf("unexpected api call")
+			return nil
+		}), BestVersion: 1,
+	}
+	client := secretbackends.NewClient(apiCaller)
+	err := client.RotateSecretEncryptionKey(c.Context())
+	c.Assert(err, tc.ErrorIs, errors.NotSupported)
+}
@@ -5,6 +5,7 @@ package secrets
 
 import (
 	"context"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
@@ -139,6 +140,175 @@ func (api *Client) ListSecrets(ctx context.Context, reveal bool, filter secrets.
 	return result, err
 }
 
+// AccessLogEntry records a read of secret content.
+type AccessLogEntry struct {
+	Revision   int
+	Accessor   names.Tag
+	AccessTime time.Time
+}
+
+// SecretAccessLog returns the recorded reads of the content of the
+// specified secret, oldest first. If revision is not nil, only reads of
+// that revision are returned.
+func (c *Client) SecretAccessLog(ctx context.Context, uri *secrets.URI, revision *int) ([]AccessLogEntry, error) {
+	if c.BestAPIVersion() < 3 {
+		return nil, errors.NotSupportedf("secret access logs on this juju version")
+	}
+	arg := params.SecretAccessLogArg{
+		URI:      uri.String(),
+		Revision: revision,
+	}
+	var results params.SecretAccessLogResults
+	err := c.facade.FacadeCall(ctx, "ListSecretAccessLog", params.SecretAccessLogArgs{Args: []params.SecretAccessLogArg{arg}}, &results)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != 1 {
+		return nil, errors.Errorf("expected 1 result, got %d", len(results.Results))
+	}
+	result := results.Results[0]
+	if result.Error != nil {
+		return nil, params.TranslateWellKnownError(result.Error)
+	}
+	entries := make([]AccessLogEntry, len(result.Entries))
+	for i, e := range result.Entries {
+		tag, err := names.ParseTag(e.AccessorTag)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		entries[i] = AccessLogEntry{
+			Revision:   e.Revision,
+			Accessor:   tag,
+			AccessTime: e.AccessTime,
+		}
+	}
+	return entries, nil
+}
+
+// ExportedSecret holds a secret and the content of each of its revisions.
+type ExportedSecret struct {
+	Metadata  secrets.SecretMetadata
+	Revisions []ExportedSecretRevision
+}
+
+// ExportedSecretRevision holds the content of a secret revision.
+type ExportedSecretRevision struct {
+	Revision   int
+	CreateTime time.Time
+	ExpireTime *time.Time
+	Value      secrets.SecretValue
+}
+
+// ExportSecrets returns the secrets with the specified labels and owners,
+// with the content of each of their revisions. If no owners are specified,
+// user secrets are returned. If no labels are specified, secrets with any
+// label are returned.
+func (c *Client) ExportSecrets(ctx context.Context, labels []string, owners []secrets.Owner) ([]ExportedSecret, error) {
+	if c.BestAPIVersion() < 3 {
+		return nil, errors.NotSupportedf("exporting secrets on this juju version")
+	}
+	arg := params.ExportSecretsArgs{Labels: labels}
+	for _, owner := range owners {
+		ownerTag, err := common.OwnerTagFromSecretOwner(owner)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		arg.OwnerTags = append(arg.OwnerTags, ownerTag.String())
+	}
+	var result params.ExportSecretsResult
+	err := c.facade.FacadeCall(ctx, "ExportSecrets", arg, &result)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if result.Error != nil {
+		return nil, params.TranslateWellKnownError(result.Error)
+	}
+	exported := make([]ExportedSecret, len(result.Secrets))
+	for i, r := range result.Secrets {
+		uri, err := secrets.ParseURI(r.URI)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		owner, err := common.SecretOwnerFromTag(r.OwnerTag)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		details := ExportedSecret{
+			Metadata: secrets.SecretMetadata{
+				URI:                    uri,
+				Version:                r.Version,
+				Owner:                  owner,
+				Description:            r.Description,
+				Label:                  r.Label,
+				RotatePolicy:           secrets.RotatePolicy(r.RotatePolicy),
+				NextRotateTime:         r.NextRotateTime,
+				AutoPrune:              r.AutoPrune,
+				LatestRevisionChecksum: r.LatestRevisionChecksum,
+				LatestExpireTime:       r.LatestExpireTime,
+			},
+			Revisions: make([]ExportedSecretRevision, len(r.Revisions)),
+		}
+		for j, rev := range r.Revisions {
+			details.Revisions[j] = ExportedSecretRevision{
+				Revision:   rev.Revision,
+				CreateTime: rev.CreateTime,
+				ExpireTime: rev.ExpireTime,
+				Value:      secrets.NewSecretValue(rev.Data),
+			}
+		}
+		exported[i] = details
+	}
+	return exported, nil
+}
+
+// ImportSecrets saves secrets exported from another model to the current
+// model, returning an error for each secret which could not be imported.
+func (c *Client) ImportSecrets(ctx context.Context, toImport []ExportedSecret) ([]error, error) {
+	if c.BestAPIVersion() < 3 {
+		return nil, errors.NotSupportedf("importing secrets on this juju version")
+	}
+	arg := params.ImportSecretsArgs{
+		Secrets: make([]params.ExportedSecret, len(toImport)),
+	}
+	for i, s := range toImport {
+		ownerTag, err := common.OwnerTagFromSecretOwner(s.Metadata.Owner)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		exported := params.ExportedSecret{
+			URI:                    s.Metadata.URI.String(),
+			Version:                s.Metadata.Version,
+			OwnerTag:               ownerTag.String(),
+			Description:            s.Metadata.Description,
+			Label:                  s.Metadata.Label,
+			RotatePolicy:           string(s.Metadata.RotatePolicy),
+			NextRotateTime:         s.Metadata.NextRotateTime,
+			AutoPrune:              s.Metadata.AutoPrune,
+			LatestRevisionChecksum: s.Metadata.LatestRevisionChecksum,
+			LatestExpireTime:       s.Metadata.LatestExpireTime,
+			Revisions:              make([]params.ExportedSecretRevision, len(s.Revisions)),
+		}
+		for j, rev := range s.Revisions {
+			exported.Revisions[j] = params.ExportedSecretRevision{
+				Revision:   rev.Revision,
+				CreateTime: rev.CreateTime,
+				ExpireTime: rev.ExpireTime,
+				Data:       rev.Value.EncodedValues(),
+			}
+		}
+		arg.Secrets[i] = exported
+	}
+	var results params.ErrorResults
+	err := c.facade.FacadeCall(ctx, "ImportSecrets", arg, &results)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(results.Results) != len(toImport) {
+		return nil, errors.Errorf("expected %d results, got %d", len(toImport), len(results.Results))
+	}
+	return processErrors(results), nil
+}
+
 func (c *Client) CreateSecret(ctx context.Context, name, description string, data map[string]string) (string, error) {
 	if c.BestAPIVersion() < 2 {
 		return "", errors.NotSupportedf("user secrets")
@@ -7,6 +7,7 @@ import (
 	stdtesting "testing"
 	"time"
 
+	"github.com/juju/names/v6"
 	"github.com/juju/tc"
 
 	"github.com/juju/juju/api/base/testing"
@@ -154,6 +155,136 @@ func (s *SecretsSuite) TestListSecretsError(c *tc.C) {
 	c.Assert(result[0].Error, tc.Equals, "boom")
 }
 
+func (s *SecretsSuite) TestSecretAccessLog(c *tc.C) {
+	now := time.Now()
+	uri := secrets.NewURI()
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Check(objType, tc.Equals, "Secrets")
+		c.Check(request, tc.Equals, "ListSecretAccessLog")
+		c.Check(arg, tc.DeepEquals, params.SecretAccessLogArgs{
+			Args: []params.SecretAccessLogArg{{URI: uri.String(), Revision: ptr(2)}},
+		})
+		c.Assert(result, tc.FitsTypeOf, &params.SecretAccessLogResults{})
+		*(result.(*params.SecretAccessLogResults)) = params.SecretAccessLogResults{
+			Results: []params.SecretAccessLogResult{{
+				Entries: []params.SecretAccessLogEntry{{
+					Revision:    2,
+					AccessorTag: "unit-mariadb-0",
+					AccessTime:  now,
+				}},
+			}},
+		}
+		return nil
+	})
+	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 3}
+	client := apisecrets.NewClient(caller)
+	result, err := client.SecretAccessLog(c.Context(), uri, ptr(2))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.DeepEquals, []apisecrets.AccessLogEntry{{
+		Revision:   2,
+		Accessor:   names.NewUnitTag("mariadb/0"),
+		AccessTime: now,
+	}})
+}
+
+func (s *SecretsSuite) TestSecretAccessLogNotSupported(c *tc.C) {
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		return nil
+	})
+	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 2}
+	client := apisecrets.NewClient(caller)
+	_, err := client.SecretAccessLog(c.Context(), secrets.NewURI(), nil)
+	c.Assert(err, tc.ErrorMatches, "secret access logs on this juju version not supported")
+}
+
+func (s *SecretsSuite) TestExportSecrets(c *tc.C) {
+	uri := secrets.NewURI()
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Check(objType, tc.Equals, "Secrets")
+		c.Check(request, tc.Equals, "ExportSecrets")
+		c.Check(arg, tc.DeepEquals, params.ExportSecretsArgs{
+			Labels:    []string{"password"},
+			OwnerTags: []string{"application-mysql"},
+		})
+		c.Assert(result, tc.FitsTypeOf, &params.ExportSecretsResult{})
+		*(result.(*params.ExportSecretsResult)) = params.ExportSecretsResult{
+			Secrets: []params.ExportedSecret{{
+				URI:      uri.String(),
+				Version:  1,
+				OwnerTag: "application-mysql",
+				Label:    "password",
+				Revisions: []params.ExportedSecretRevision{{
+					Revision: 2,
+					Data:     map[string]string{"foo": "YmFy"},
+				}},
+			}},
+		}
+		return nil
+	})
+	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 3}
+	client := apisecrets.NewClient(caller)
+	result, err := client.ExportSecrets(c.Context(), []string{"password"}, []secrets.Owner{{
+		Kind: secrets.ApplicationOwner, ID: "mysql",
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.DeepEquals, []apisecrets.ExportedSecret{{
+		Metadata: secrets.SecretMetadata{
+			URI:     uri,
+			Version: 1,
+			Owner:   secrets.Owner{Kind: secrets.ApplicationOwner, ID: "mysql"},
+			Label:   "password",
+		},
+		Revisions: []apisecrets.ExportedSecretRevision{{
+			Revision: 2,
+			Value:    secrets.NewSecretValue(map[string]string{"foo": "YmFy"}),
+		}},
+	}})
+}
+
+func (s *SecretsSuite) TestImportSecrets(c *tc.C) {
+	uri := secrets.NewURI()
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Check(objType, tc.Equals, "Secrets")
+		c.Check(request, tc.Equals, "ImportSecrets")
+		c.Check(arg, tc.DeepEquals, params.ImportSecretsArgs{
+			Secrets: []params.ExportedSecret{{
+				URI:      uri.String(),
+				Version:  1,
+				OwnerTag: coretesting.ModelTag.String(),
+				Label:    "my-secret",
+				Revisions: []params.ExportedSecretRevision{{
+					Revision: 1,
+					Data:     map[string]string{"foo": "YmFy"},
+				}},
+			}},
+		})
+		c.Assert(result, tc.FitsTypeOf, &params.ErrorResults{})
+		*(result.(*params.ErrorResults)) = params.ErrorResults{
+			Results: []params.ErrorResult{{
+				Error: &params.Error{Message: "boom"},
+			}},
+		}
+		return nil
+	})
+	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 3}
+	client := apisecrets.NewClient(caller)
+	errs, err := client.ImportSecrets(c.Context(), []apisecrets.ExportedSecret{{
+		Metadata: secrets.SecretMetadata{
+			URI:     uri,
+			Version: 1,
+			Owner:   secrets.Owner{Kind: secrets.ModelOwner, ID: coretesting.ModelTag.Id()},
+			Label:   "my-secret",
+		},
+		Revisions: []apisecrets.ExportedSecretRevision{{
+			Revision: 1,
+			Value:    secrets.NewSecretValue(map[string]string{"foo": "YmFy"}),
+		}},
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(errs, tc.HasLen, 1)
+	c.Assert(errs[0], tc.ErrorMatches, "boom")
+}
+
 func (s *SecretsSuite) TestCreateSecretError(c *tc.C) {
 	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
 		return nil
@@ -264,6 +264,7 @@ func filesystemFromParams(in params.KubernetesFilesystemParams) (*storage.Kubern
 		Size:         in.Size,
 		Attributes:   in.Attributes,
 		ResourceTags: in.Tags,
+		Shared:       in.Shared,
 		Attachment:   attachment,
 	}, nil
 }
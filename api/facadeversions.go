@@ -24,8 +24,10 @@ var facadeVersions = facades.FacadeVersions{
 	"Action":                       {7},
 	"Agent":                        {3},
 	"AgentLifeFlag":                {1},
+	"AllModelWatcher":              {4},
+	"AllWatcher":                   {4},
 	"Annotations":                  {2},
-	"Application":                  {19, 20, 21, 22},
+	"Application":                  {19, 20, 21, 22, 23},
 	"ApplicationOffers":            {5, 6},
 	"Backups":                      {3},
 	"Block":                        {2},
@@ -82,15 +84,15 @@ var facadeVersions = facades.FacadeVersions{
 	"RelationUnitsWatcher":         {1},
 	"RemoteRelations":              {2},
 	"RemoteRelationWatcher":        {1},
-	"Resources":                    {3},
+	"Resources":                    {3, 4},
 	"ResourcesHookContext":         {1},
 	"RetryStrategy":                {1},
 	"SecretsTriggerWatcher":        {1},
-	"SecretBackends":               {1},
+	"SecretBackends":               {1, 2},
 	"SecretBackendsManager":        {1},
 	"SecretBackendsRotateWatcher":  {1},
 	"SecretsRevisionWatcher":       {1},
-	"Secrets":                      {1, 2},
+	"Secrets":                      {1, 2, 3},
 	"SecretsManager":               {3},
 	"SecretsDrain":                 {1},
 	"UserSecretsDrain":             {1},
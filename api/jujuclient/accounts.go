@@ -4,6 +4,7 @@
 package jujuclient
 
 import (
+	"encoding/json"
 	"os"
 	"strings"
 
@@ -81,3 +82,83 @@ func ParseAccounts(data []byte) (map[string]AccountDetails, error) {
 type accountsCollection struct {
 	ControllerAccounts map[string]AccountDetails `yaml:"controllers"`
 }
+
+// accountSecrets holds the secrets of an account which are kept by a
+// credential helper rather than in the accounts file.
+type accountSecrets struct {
+	Password     string `json:"password,omitempty"`
+	SessionToken string `json:"access-token,omitempty"`
+}
+
+// accountSecretsKey returns the credential helper key for the secrets of
+// the account on the named controller.
+func accountSecretsKey(controllerName string) string {
+	return "account:" + controllerName
+}
+
+func hasAccountSecrets(details AccountDetails) bool {
+	return details.Password != "" || details.SessionToken != ""
+}
+
+// storeAccountSecrets moves the secrets of the account into the credential
+// helper, clearing them from the details. If the account has no secrets,
+// any previously stored in the helper are erased.
+func storeAccountSecrets(helper CredentialHelper, controllerName string, details *AccountDetails) error {
+	key := accountSecretsKey(controllerName)
+	if !hasAccountSecrets(*details) {
+		return errors.Trace(helper.Erase(key))
+	}
+	data, err := json.Marshal(accountSecrets{
+		Password:     details.Password,
+		SessionToken: details.SessionToken,
+	})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := helper.Store(key, string(data)); err != nil {
+		return errors.Annotatef(err, "storing account secrets for controller %s", controllerName)
+	}
+	details.Password = ""
+	details.SessionToken = ""
+	return nil
+}
+
+// loadAccountSecrets fills in the secrets of the account from the
+// credential helper. Accounts whose secrets are still in the accounts file
+// are left untouched.
+func loadAccountSecrets(helper CredentialHelper, controllerName string, details *AccountDetails) error {
+	if hasAccountSecrets(*details) {
+		return nil
+	}
+	data, err := helper.Get(accountSecretsKey(controllerName))
+	if errors.Is(err, errors.NotFound) {
+		return nil
+	} else if err != nil {
+		return errors.Annotatef(err, "getting account secrets for controller %s", controllerName)
+	}
+	var secrets accountSecrets
+	if err := json.Unmarshal([]byte(data), &secrets); err != nil {
+		return errors.Annotatef(err, "parsing account secrets for controller %s", controllerName)
+	}
+	details.Password = secrets.Password
+	details.SessionToken = secrets.SessionToken
+	return nil
+}
+
+// migrateAccountSecrets moves the secrets of any accounts still written in
+// the clear into the credential helper, reporting whether any accounts
+// were changed and so need to be written back.
+func migrateAccountSecrets(helper CredentialHelper, accounts map[string]AccountDetails) (bool, error) {
+	changed := false
+	for controllerName, details := range accounts {
+		if !hasAccountSecrets(details) {
+			continue
+		}
+		if err := storeAccountSecrets(helper, controllerName, &details); err != nil {
+			return false, errors.Trace(err)
+		}
+		accounts[controllerName] = details
+		changed = true
+	}
+	return changed, nil
+}
@@ -0,0 +1,150 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jujuclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/juju/osenv"
+)
+
+const (
+	// FileCredentialHelper is the name of the built-in credential helper,
+	// which keeps secrets in a passphrase-encrypted file.
+	FileCredentialHelper = "file"
+
+	// credentialHelperPrefix is prepended to the name of an external
+	// credential helper to get the name of the executable to run.
+	credentialHelperPrefix = "juju-credential-"
+
+	// credentialsNotFoundMessage is written to stdout by an external
+	// credential helper when asked for a key it does not hold.
+	credentialsNotFoundMessage = "credentials not found"
+)
+
+// CredentialHelper holds secrets on behalf of the client store, so that
+// passwords and cloud credential attributes need not be written to the
+// YAML files in the clear.
+type CredentialHelper interface {
+	// Store saves the secret for the given key, replacing any existing one.
+	Store(key, secret string) error
+
+	// Get returns the secret for the given key, returning an error
+	// satisfying errors.NotFound if there is none.
+	Get(key string) (string, error)
+
+	// Erase removes the secret for the given key. It is not an error if
+	// there is no such secret.
+	Erase(key string) error
+}
+
+// CredentialHelperFromEnvironment returns the credential helper selected
+// by the JUJU_CREDENTIAL_HELPER environment variable, or nil if none is
+// selected. The value "file" selects the built-in encrypted file helper;
+// any other value names an external juju-credential-<name> executable.
+func CredentialHelperFromEnvironment() (CredentialHelper, error) {
+	name := strings.TrimSpace(os.Getenv(osenv.JujuCredentialHelperEnvKey))
+	switch name {
+	case "":
+		return nil, nil
+	case FileCredentialHelper:
+		passphrase := os.Getenv(osenv.JujuCredentialPassphraseEnvKey)
+		if passphrase == "" {
+			return nil, errors.NotValidf(
+				"%s credential helper without %s set", FileCredentialHelper, osenv.JujuCredentialPassphraseEnvKey,
+			)
+		}
+		return NewFileCredentialHelper(JujuCredentialHelperStorePath(), passphrase), nil
+	default:
+		return NewExecCredentialHelper(name), nil
+	}
+}
+
+// credentialHelperMessage is exchanged with an external credential helper
+// as JSON on stdin and stdout.
+type credentialHelperMessage struct {
+	Key    string `json:"Key"`
+	Secret string `json:"Secret"`
+}
+
+// execCredentialHelper runs an external juju-credential-<name> executable,
+// following the same protocol as docker credential helpers. The action is
+// passed as the only argument:
+//
+//   - store: a JSON object with Key and Secret is written to stdin.
+//   - get: the key is written to stdin, and a JSON object with Key and
+//     Secret is read from stdout. If the key is not held, the helper
+//     exits non-zero and writes "credentials not found" to stdout.
+//   - erase: the key is written to stdin.
+type execCredentialHelper struct {
+	program string
+}
+
+// NewExecCredentialHelper returns a credential helper which runs the
+// juju-credential-<name> executable found on the PATH.
+func NewExecCredentialHelper(name string) CredentialHelper {
+	return &execCredentialHelper{program: credentialHelperPrefix + name}
+}
+
+// Store implements CredentialHelper.
+func (h *execCredentialHelper) Store(key, secret string) error {
+	input, err := json.Marshal(credentialHelperMessage{Key: key, Secret: secret})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	_, err = h.run("store", input)
+	return errors.Annotatef(err, "storing %q", key)
+}
+
+// Get implements CredentialHelper.
+func (h *execCredentialHelper) Get(key string) (string, error) {
+	output, err := h.run("get", []byte(key))
+	if err != nil {
+		if strings.TrimSpace(string(output)) == credentialsNotFoundMessage {
+			return "", errors.NotFoundf("credentials for %q", key)
+		}
+		return "", errors.Annotatef(err, "getting %q", key)
+	}
+	var result credentialHelperMessage
+	if err := json.Unmarshal(output, &result); err != nil {
+		return "", errors.Annotatef(err, "parsing %s output", h.program)
+	}
+	return result.Secret, nil
+}
+
+// Erase implements CredentialHelper.
+func (h *execCredentialHelper) Erase(key string) error {
+	output, err := h.run("erase", []byte(key))
+	if err != nil && strings.TrimSpace(string(output)) != credentialsNotFoundMessage {
+		return errors.Annotatef(err, "erasing %q", key)
+	}
+	return nil
+}
+
+func (h *execCredentialHelper) run(action string, input []byte) ([]byte, error) {
+	path, err := exec.LookPath(h.program)
+	if err != nil {
+		return nil, errors.NotFoundf("credential helper %q", h.program)
+	}
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(path, action)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			err = errors.Errorf("%s %s: %s", h.program, action, msg)
+		} else {
+			err = errors.Annotatef(err, "%s %s", h.program, action)
+		}
+		return stdout.Bytes(), err
+	}
+	return stdout.Bytes(), nil
+}
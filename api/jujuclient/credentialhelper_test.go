@@ -0,0 +1,276 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jujuclient_test
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	stdtesting "testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/api/jujuclient"
+	"github.com/juju/juju/cloud"
+	"github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/juju/osenv"
+)
+
+type CredentialHelperSuite struct {
+	testing.FakeJujuXDGDataHomeSuite
+}
+
+func TestCredentialHelperSuite(t *stdtesting.T) {
+	tc.Run(t, &CredentialHelperSuite{})
+}
+
+func (s *CredentialHelperSuite) useFileHelper() {
+	s.PatchEnvironment(osenv.JujuCredentialHelperEnvKey, jujuclient.FileCredentialHelper)
+	s.PatchEnvironment(osenv.JujuCredentialPassphraseEnvKey, "sekrit")
+}
+
+// fakeCredentialHelper is a juju-credential-<name> executable which keeps
+// each secret in a file named after its key.
+const fakeCredentialHelper = `#!/bin/sh
+set -e
+dir=$(dirname "$0")/secrets
+mkdir -p "$dir"
+case "$1" in
+store)
+	input=$(cat)
+	key=$(echo "$input" | sed -e 's/^{"Key":"\([^"]*\)".*/\1/' | tr '/:' '__')
+	echo "$input" > "$dir/$key"
+	;;
+get)
+	key=$(cat | tr '/:' '__')
+	if [ ! -f "$dir/$key" ]; then
+		echo "credentials not found"
+		exit 1
+	fi
+	cat "$dir/$key"
+	;;
+erase)
+	key=$(cat | tr '/:' '__')
+	rm -f "$dir/$key"
+	;;
+*)
+	echo "unknown action $1" >&2
+	exit 1
+	;;
+esac
+`
+
+func (s *CredentialHelperSuite) TestFileHelper(c *tc.C) {
+	path := filepath.Join(c.MkDir(), "store.json")
+	helper := jujuclient.NewFileCredentialHelper(path, "sekrit")
+
+	_, err := helper.Get("foo")
+	c.Assert(err, tc.ErrorIs, errors.NotFound)
+
+	err = helper.Store("foo", "bar")
+	c.Assert(err, tc.ErrorIsNil)
+	err = helper.Store("baz", "qux")
+	c.Assert(err, tc.ErrorIsNil)
+
+	secret, err := jujuclient.NewFileCredentialHelper(path, "sekrit").Get("foo")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(secret, tc.Equals, "bar")
+
+	data, err := os.ReadFile(path)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(data), tc.Not(tc.Contains), "bar")
+
+	err = helper.Erase("foo")
+	c.Assert(err, tc.ErrorIsNil)
+	err = helper.Erase("foo")
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = helper.Get("foo")
+	c.Assert(err, tc.ErrorIs, errors.NotFound)
+	secret, err = helper.Get("baz")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(secret, tc.Equals, "qux")
+}
+
+func (s *CredentialHelperSuite) TestFileHelperWrongPassphrase(c *tc.C) {
+	path := filepath.Join(c.MkDir(), "store.json")
+	err := jujuclient.NewFileCredentialHelper(path, "sekrit").Store("foo", "bar")
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = jujuclient.NewFileCredentialHelper(path, "guess").Get("foo")
+	c.Assert(err, tc.ErrorMatches, `cannot decrypt .*: wrong passphrase or corrupt file`)
+}
+
+func (s *CredentialHelperSuite) TestExecHelper(c *tc.C) {
+	if runtime.GOOS == "windows" {
+		c.Skip("credential helper script requires a POSIX shell")
+	}
+	dir := c.MkDir()
+	err := os.WriteFile(filepath.Join(dir, "juju-credential-fake"), []byte(fakeCredentialHelper), 0755)
+	c.Assert(err, tc.ErrorIsNil)
+	s.PatchEnvPathPrepend(dir)
+
+	helper := jujuclient.NewExecCredentialHelper("fake")
+	_, err = helper.Get("account:ctrl")
+	c.Assert(err, tc.ErrorIs, errors.NotFound)
+
+	err = helper.Store("account:ctrl", "hunter2")
+	c.Assert(err, tc.ErrorIsNil)
+	secret, err := helper.Get("account:ctrl")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(secret, tc.Equals, "hunter2")
+
+	err = helper.Erase("account:ctrl")
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = helper.Get("account:ctrl")
+	c.Assert(err, tc.ErrorIs, errors.NotFound)
+}
+
+func (s *CredentialHelperSuite) TestExecHelperNotInstalled(c *tc.C) {
+	err := jujuclient.NewExecCredentialHelper("missing").Store("foo", "bar")
+	c.Assert(err, tc.ErrorMatches, `storing "foo": credential helper "juju-credential-missing" not found`)
+}
+
+func (s *CredentialHelperSuite) TestFromEnvironment(c *tc.C) {
+	helper, err := jujuclient.CredentialHelperFromEnvironment()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(helper, tc.IsNil)
+
+	s.PatchEnvironment(osenv.JujuCredentialHelperEnvKey, jujuclient.FileCredentialHelper)
+	_, err = jujuclient.CredentialHelperFromEnvironment()
+	c.Assert(err, tc.ErrorMatches, `file credential helper without JUJU_CREDENTIAL_PASSPHRASE set not valid`)
+
+	s.PatchEnvironment(osenv.JujuCredentialPassphraseEnvKey, "sekrit")
+	helper, err = jujuclient.CredentialHelperFromEnvironment()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(helper, tc.NotNil)
+}
+
+func (s *CredentialHelperSuite) TestAccountSecretsStoredInHelper(c *tc.C) {
+	s.useFileHelper()
+	store := jujuclient.NewFileClientStore()
+
+	details := jujuclient.AccountDetails{
+		User:         "admin",
+		Password:     "fnord",
+		SessionToken: "token",
+	}
+	err := store.UpdateAccount("ctrl", details)
+	c.Assert(err, tc.ErrorIsNil)
+
+	data, err := os.ReadFile(jujuclient.JujuAccountsPath())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(data), tc.Not(tc.Contains), "fnord")
+	c.Check(string(data), tc.Not(tc.Contains), "token")
+
+	found, err := store.AccountDetails("ctrl")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(*found, tc.DeepEquals, details)
+
+	err = store.RemoveAccount("ctrl")
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = jujuclient.NewFileCredentialHelper(jujuclient.JujuCredentialHelperStorePath(), "sekrit").Get("account:ctrl")
+	c.Assert(err, tc.ErrorIs, errors.NotFound)
+}
+
+func (s *CredentialHelperSuite) TestWithCredentialHelper(c *tc.C) {
+	// The helper given to the store is used, regardless of the environment.
+	s.PatchEnvironment(osenv.JujuCredentialHelperEnvKey, "")
+	path := filepath.Join(c.MkDir(), "store.json")
+	helper := jujuclient.NewFileCredentialHelper(path, "other")
+	store := jujuclient.NewFileClientStore(jujuclient.WithCredentialHelper(helper))
+
+	details := jujuclient.AccountDetails{
+		User:     "admin",
+		Password: "fnord",
+	}
+	err := store.UpdateAccount("ctrl", details)
+	c.Assert(err, tc.ErrorIsNil)
+
+	data, err := os.ReadFile(jujuclient.JujuAccountsPath())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(data), tc.Not(tc.Contains), "fnord")
+
+	_, err = jujuclient.NewFileCredentialHelper(path, "other").Get("account:ctrl")
+	c.Assert(err, tc.ErrorIsNil)
+
+	found, err := store.AccountDetails("ctrl")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(*found, tc.DeepEquals, details)
+}
+
+func (s *CredentialHelperSuite) TestAccountSecretsMigrated(c *tc.C) {
+	writeTestAccountsFile(c)
+	s.useFileHelper()
+
+	found, err := jujuclient.NewFileClientStore().AccountDetails("ctrl")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(*found, tc.DeepEquals, ctrlAdminAccountDetails)
+
+	data, err := os.ReadFile(jujuclient.JujuAccountsPath())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(data), tc.Not(tc.Contains), "hunter2")
+
+	found, err = jujuclient.NewFileClientStore().AccountDetails("ctrl")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(*found, tc.DeepEquals, ctrlAdminAccountDetails)
+}
+
+func (s *CredentialHelperSuite) TestCredentialSecretsStoredInHelper(c *tc.C) {
+	s.useFileHelper()
+	store := jujuclient.NewFileCredentialStore()
+
+	details := cloud.CloudCredential{
+		DefaultCredential: "peter",
+		AuthCredentials: map[string]cloud.Credential{
+			"peter": cloud.NewCredential(cloud.AccessKeyAuthType, map[string]string{
+				"access-key": "key",
+				"secret-key": "secret",
+			}),
+			"paul": cloud.NewCredential(cloud.AccessKeyAuthType, map[string]string{
+				"access-key": "paul-key",
+				"secret-key": "paul-secret",
+			}),
+		},
+	}
+	err := store.UpdateCredential("aws", details)
+	c.Assert(err, tc.ErrorIsNil)
+
+	data, err := os.ReadFile(jujuclient.JujuCredentialsPath())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(data), tc.Not(tc.Contains), "secret")
+	c.Check(string(data), tc.Contains, "auth-type: access-key")
+
+	found, err := store.CredentialForCloud("aws")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(*found, tc.DeepEquals, details)
+
+	// Removing a credential erases its secrets.
+	delete(details.AuthCredentials, "paul")
+	err = store.UpdateCredential("aws", details)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = jujuclient.NewFileCredentialHelper(jujuclient.JujuCredentialHelperStorePath(), "sekrit").Get("credential:aws/paul")
+	c.Assert(err, tc.ErrorIs, errors.NotFound)
+
+	all, err := store.AllCredentials()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(all, tc.DeepEquals, map[string]cloud.CloudCredential{"aws": details})
+}
+
+func (s *CredentialHelperSuite) TestCredentialSecretsMigrated(c *tc.C) {
+	expected := writeTestCredentialsFile(c)
+	s.useFileHelper()
+
+	all, err := jujuclient.NewFileCredentialStore().AllCredentials()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(all, tc.DeepEquals, expected)
+
+	data, err := os.ReadFile(jujuclient.JujuCredentialsPath())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(data), tc.Not(tc.Contains), "secret")
+
+	found, err := jujuclient.NewFileCredentialStore().CredentialForCloud("aws")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(*found, tc.DeepEquals, expected["aws"])
+}
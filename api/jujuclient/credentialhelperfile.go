@@ -0,0 +1,138 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package jujuclient
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+
+	"github.com/juju/errors"
+	"github.com/juju/utils/v4"
+
+	"github.com/juju/juju/internal/passphrase"
+	"github.com/juju/juju/juju/osenv"
+)
+
+// JujuCredentialHelperStorePath is the location of the file used by the
+// built-in encrypted file credential helper.
+func JujuCredentialHelperStorePath() string {
+	return osenv.JujuXDGDataHomePath("credential-store.json")
+}
+
+// fileCredentialHelper is a CredentialHelper which keeps secrets in a
+// single file, encrypted with a key derived from a passphrase.
+type fileCredentialHelper struct {
+	path       string
+	passphrase string
+
+	mu sync.Mutex
+	// keys caches keys derived from the passphrase by salt, as deriving
+	// them is deliberately slow.
+	keys map[string][]byte
+}
+
+// NewFileCredentialHelper returns a credential helper which keeps secrets
+// in the given file, encrypted with the given passphrase.
+func NewFileCredentialHelper(path, passphrase string) CredentialHelper {
+	return &fileCredentialHelper{
+		path:       path,
+		passphrase: passphrase,
+		keys:       make(map[string][]byte),
+	}
+}
+
+// Store implements CredentialHelper.
+func (h *fileCredentialHelper) Store(key, secret string) error {
+	secrets, salt, err := h.read()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	secrets[key] = secret
+	return errors.Trace(h.write(secrets, salt))
+}
+
+// Get implements CredentialHelper.
+func (h *fileCredentialHelper) Get(key string) (string, error) {
+	secrets, _, err := h.read()
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	secret, ok := secrets[key]
+	if !ok {
+		return "", errors.NotFoundf("credentials for %q", key)
+	}
+	return secret, nil
+}
+
+// Erase implements CredentialHelper.
+func (h *fileCredentialHelper) Erase(key string) error {
+	secrets, salt, err := h.read()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if _, ok := secrets[key]; !ok {
+		return nil
+	}
+	delete(secrets, key)
+	return errors.Trace(h.write(secrets, salt))
+}
+
+// read returns the decrypted secrets and the salt of the store. If the
+// file does not exist, there are no secrets and a new salt is returned.
+func (h *fileCredentialHelper) read() (map[string]string, []byte, error) {
+	data, err := os.ReadFile(h.path)
+	if os.IsNotExist(err) {
+		salt, err := passphrase.NewSalt()
+		if err != nil {
+			return nil, nil, errors.Trace(err)
+		}
+		return make(map[string]string), salt, nil
+	} else if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+
+	plaintext, salt, err := passphrase.Open(data, h.key)
+	if err != nil {
+		return nil, nil, errors.Annotatef(err, "cannot decrypt %s", h.path)
+	}
+	secrets := make(map[string]string)
+	if err := json.Unmarshal(plaintext, &secrets); err != nil {
+		return nil, nil, errors.Annotatef(err, "parsing %s", h.path)
+	}
+	return secrets, salt, nil
+}
+
+func (h *fileCredentialHelper) write(secrets map[string]string, salt []byte) error {
+	plaintext, err := json.Marshal(secrets)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	key, err := h.key(salt)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	data, err := passphrase.Seal(plaintext, key, salt)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return utils.AtomicWriteFile(h.path, data, os.FileMode(0600))
+}
+
+// key returns the key derived from the passphrase and salt.
+func (h *fileCredentialHelper) key(salt []byte) ([]byte, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	key, ok := h.keys[string(salt)]
+	if !ok {
+		var err error
+		key, err = passphrase.DeriveKey(h.passphrase, salt)
+		if err != nil {
+			return nil, errors.Annotate(err, "deriving credential store key")
+		}
+		h.keys[string(salt)] = key
+	}
+	return key, nil
+}
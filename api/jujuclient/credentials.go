@@ -4,6 +4,7 @@
 package jujuclient
 
 import (
+	"encoding/json"
 	"os"
 
 	"github.com/juju/errors"
@@ -46,3 +47,108 @@ func WriteCredentialsFile(credentials *cloud.CredentialCollection) error {
 	}
 	return utils.AtomicWriteFile(JujuCredentialsPath(), data, os.FileMode(0600))
 }
+
+// credentialSecretsKey returns the credential helper key for the attributes
+// of the named credential for a cloud.
+func credentialSecretsKey(cloudName, credentialName string) string {
+	return "credential:" + cloudName + "/" + credentialName
+}
+
+// withAttributes returns a copy of the credential with its attributes
+// replaced.
+func withAttributes(credential cloud.Credential, attributes map[string]string) cloud.Credential {
+	result := cloud.NewCredential(credential.AuthType(), attributes)
+	result.Revoked = credential.Revoked
+	result.Label = credential.Label
+	result.Invalid = credential.Invalid
+	result.InvalidReason = credential.InvalidReason
+	return result
+}
+
+func copyCredentials(in map[string]cloud.Credential) map[string]cloud.Credential {
+	out := make(map[string]cloud.Credential, len(in))
+	for name, credential := range in {
+		out[name] = credential
+	}
+	return out
+}
+
+// storeCredentialSecrets moves the attributes of the cloud's credentials
+// into the credential helper, clearing them from the details. If eraseEmpty
+// is true, any attributes previously stored for credentials which now have
+// none are erased.
+func storeCredentialSecrets(
+	helper CredentialHelper, cloudName string, details *cloud.CloudCredential, eraseEmpty bool,
+) (bool, error) {
+	details.AuthCredentials = copyCredentials(details.AuthCredentials)
+	changed := false
+	for name, credential := range details.AuthCredentials {
+		key := credentialSecretsKey(cloudName, name)
+		attributes := credential.Attributes()
+		if len(attributes) == 0 {
+			if !eraseEmpty {
+				continue
+			}
+			if err := helper.Erase(key); err != nil {
+				return false, errors.Trace(err)
+			}
+			continue
+		}
+		data, err := json.Marshal(attributes)
+		if err != nil {
+			return false, errors.Trace(err)
+		}
+		if err := helper.Store(key, string(data)); err != nil {
+			return false, errors.Annotatef(err, "storing credential %q for cloud %s", name, cloudName)
+		}
+		details.AuthCredentials[name] = withAttributes(credential, nil)
+		changed = true
+	}
+	return changed, nil
+}
+
+// loadCredentialSecrets fills in the attributes of the cloud's credentials
+// from the credential helper. Credentials whose attributes are still in the
+// credentials file are left untouched.
+func loadCredentialSecrets(helper CredentialHelper, cloudName string, details *cloud.CloudCredential) error {
+	details.AuthCredentials = copyCredentials(details.AuthCredentials)
+	for name, credential := range details.AuthCredentials {
+		if len(credential.Attributes()) > 0 {
+			continue
+		}
+		data, err := helper.Get(credentialSecretsKey(cloudName, name))
+		if errors.Is(err, errors.NotFound) {
+			continue
+		} else if err != nil {
+			return errors.Annotatef(err, "getting credential %q for cloud %s", name, cloudName)
+		}
+		var attributes map[string]string
+		if err := json.Unmarshal([]byte(data), &attributes); err != nil {
+			return errors.Annotatef(err, "parsing credential %q for cloud %s", name, cloudName)
+		}
+		details.AuthCredentials[name] = withAttributes(credential, attributes)
+	}
+	return nil
+}
+
+// migrateCredentialSecrets moves the attributes of any credentials still
+// written in the clear into the credential helper, reporting whether the
+// collection was changed and so needs to be written back.
+func migrateCredentialSecrets(helper CredentialHelper, credentials *cloud.CredentialCollection) (bool, error) {
+	changed := false
+	for _, cloudName := range credentials.CloudNames() {
+		details, err := credentials.CloudCredential(cloudName)
+		if err != nil {
+			return false, errors.Trace(err)
+		}
+		cloudChanged, err := storeCredentialSecrets(helper, cloudName, details, false)
+		if err != nil {
+			return false, errors.Trace(err)
+		}
+		if cloudChanged {
+			credentials.UpdateCloudCredential(cloudName, *details)
+			changed = true
+		}
+	}
+	return changed, nil
+}
@@ -13,6 +13,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/juju/clock"
@@ -35,24 +36,62 @@ var (
 	lockTimeout = 5 * time.Second
 )
 
+// StoreOption configures a filesystem-based store.
+type StoreOption func(*store)
+
+// WithCredentialHelper makes the store keep secrets in the given
+// credential helper, rather than the one selected by the
+// JUJU_CREDENTIAL_HELPER environment variable. A nil helper keeps
+// secrets in the YAML files.
+func WithCredentialHelper(helper CredentialHelper) StoreOption {
+	return func(s *store) {
+		s.helperOnce.Do(func() {
+			s.helper = helper
+		})
+	}
+}
+
 // NewFileClientStore returns a new filesystem-based client store
 // that manages files in $XDG_DATA_HOME/juju.
-func NewFileClientStore() ClientStore {
-	return &store{
-		lockName: generateStoreLockName(),
-	}
+func NewFileClientStore(opts ...StoreOption) ClientStore {
+	return newStore(opts...)
 }
 
 // NewFileCredentialStore returns a new filesystem-based credentials store
 // that manages credentials in $XDG_DATA_HOME/juju.
-func NewFileCredentialStore() CredentialStore {
-	return &store{
+func NewFileCredentialStore(opts ...StoreOption) CredentialStore {
+	return newStore(opts...)
+}
+
+func newStore(opts ...StoreOption) *store {
+	s := &store{
 		lockName: generateStoreLockName(),
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 type store struct {
 	lockName string
+
+	// helperOnce guards the credential helper, which is built at most
+	// once per store, as building the file helper derives a key from
+	// the passphrase.
+	helperOnce sync.Once
+	helper     CredentialHelper
+	helperErr  error
+}
+
+// credentialHelper returns the credential helper used by the store, or nil
+// if secrets are kept in the YAML files. Unless one was given when the
+// store was created, it is selected by the environment on first use.
+func (s *store) credentialHelper() (CredentialHelper, error) {
+	s.helperOnce.Do(func() {
+		s.helper, s.helperErr = CredentialHelperFromEnvironment()
+	})
+	return s.helper, s.helperErr
 }
 
 // generateStoreLockName uses part of the hash of the controller path as the
@@ -355,6 +394,15 @@ func (s *store) RemoveController(name string) error {
 			}
 		}
 	}
+	if helper, err := s.credentialHelper(); err != nil {
+		return errors.Trace(err)
+	} else if helper != nil {
+		for _, name := range names {
+			if err := helper.Erase(accountSecretsKey(name)); err != nil {
+				return errors.Trace(err)
+			}
+		}
+	}
 
 	// Remove bootstrap config for the controller.
 	bootstrapConfigurations, err := ReadBootstrapConfigFile(JujuBootstrapConfigPath())
@@ -751,6 +799,10 @@ func (s *store) UpdateAccount(controllerName string, details AccountDetails) err
 	}
 	defer releaser.Release()
 
+	helper, err := s.credentialHelper()
+	if err != nil {
+		return errors.Trace(err)
+	}
 	accounts, err := ReadAccountsFile(JujuAccountsPath())
 	if err != nil {
 		return errors.Trace(err)
@@ -758,15 +810,28 @@ func (s *store) UpdateAccount(controllerName string, details AccountDetails) err
 	if accounts == nil {
 		accounts = make(map[string]AccountDetails)
 	}
-	if oldDetails, ok := accounts[controllerName]; ok && reflect.DeepEqual(details, oldDetails) {
-		return nil
-	} else {
-		// Only update last known access if it has a value.
-		if details.LastKnownAccess == "" {
-			details.LastKnownAccess = oldDetails.LastKnownAccess
+	oldDetails, ok := accounts[controllerName]
+	if ok && helper != nil {
+		if err := loadAccountSecrets(helper, controllerName, &oldDetails); err != nil {
+			return errors.Trace(err)
 		}
 	}
+	if ok && reflect.DeepEqual(details, oldDetails) {
+		return nil
+	}
+	// Only update last known access if it has a value.
+	if details.LastKnownAccess == "" {
+		details.LastKnownAccess = oldDetails.LastKnownAccess
+	}
 
+	if helper != nil {
+		if err := storeAccountSecrets(helper, controllerName, &details); err != nil {
+			return errors.Trace(err)
+		}
+		if _, err := migrateAccountSecrets(helper, accounts); err != nil {
+			return errors.Trace(err)
+		}
+	}
 	accounts[controllerName] = details
 	return errors.Trace(WriteAccountsFile(accounts))
 }
@@ -785,6 +850,10 @@ func (s *store) AccountDetails(controllerName string) (*AccountDetails, error) {
 	}
 	defer releaser.Release()
 
+	helper, err := s.credentialHelper()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
 	accounts, err := ReadAccountsFile(JujuAccountsPath())
 	if err != nil {
 		return nil, errors.Trace(err)
@@ -793,6 +862,22 @@ func (s *store) AccountDetails(controllerName string) (*AccountDetails, error) {
 	if !ok {
 		return nil, errors.NotFoundf("account details for controller %s", controllerName)
 	}
+	if helper == nil {
+		return &details, nil
+	}
+
+	// Move any secrets still in the accounts file into the helper,
+	// before returning the account complete with its secrets.
+	if migrated, err := migrateAccountSecrets(helper, accounts); err != nil {
+		return nil, errors.Trace(err)
+	} else if migrated {
+		if err := WriteAccountsFile(accounts); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	if err := loadAccountSecrets(helper, controllerName, &details); err != nil {
+		return nil, errors.Trace(err)
+	}
 	return &details, nil
 }
 
@@ -819,7 +904,16 @@ func (s *store) RemoveAccount(controllerName string) error {
 	}
 
 	delete(accounts, controllerName)
-	return errors.Trace(WriteAccountsFile(accounts))
+	if err := WriteAccountsFile(accounts); err != nil {
+		return errors.Trace(err)
+	}
+	helper, err := s.credentialHelper()
+	if err != nil {
+		return errors.Trace(err)
+	} else if helper != nil {
+		return errors.Trace(helper.Erase(accountSecretsKey(controllerName)))
+	}
+	return nil
 }
 
 // UpdateCredential implements CredentialUpdater.
@@ -832,18 +926,49 @@ func (s *store) UpdateCredential(cloudName string, details cloud.CloudCredential
 	}
 	defer releaser.Release()
 
+	helper, err := s.credentialHelper()
+	if err != nil {
+		return errors.Trace(err)
+	}
 	credentials, err := ReadCredentialsFile(JujuCredentialsPath())
 	if err != nil {
 		return errors.Annotate(err, "cannot get credentials")
 	}
 
+	if helper != nil {
+		// Erase the secrets of credentials which are being removed.
+		if oldDetails, err := credentials.CloudCredential(cloudName); err == nil {
+			for name := range oldDetails.AuthCredentials {
+				if _, ok := details.AuthCredentials[name]; ok {
+					continue
+				}
+				if err := helper.Erase(credentialSecretsKey(cloudName, name)); err != nil {
+					return errors.Trace(err)
+				}
+			}
+		} else if !errors.Is(err, errors.NotFound) {
+			return errors.Trace(err)
+		}
+		if _, err := storeCredentialSecrets(helper, cloudName, &details, true); err != nil {
+			return errors.Trace(err)
+		}
+	}
 	credentials.UpdateCloudCredential(cloudName, details)
+	if helper != nil {
+		if _, err := migrateCredentialSecrets(helper, credentials); err != nil {
+			return errors.Trace(err)
+		}
+	}
 	return WriteCredentialsFile(credentials)
 }
 
 // CredentialForCloud implements CredentialGetter.
 func (s *store) CredentialForCloud(cloudName string) (*cloud.CloudCredential, error) {
-	credentialCollection, err := ReadCredentialsFile(JujuCredentialsPath())
+	helper, err := s.credentialHelper()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	credentialCollection, err := s.readCredentials(helper)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -851,12 +976,21 @@ func (s *store) CredentialForCloud(cloudName string) (*cloud.CloudCredential, er
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
+	if helper != nil {
+		if err := loadCredentialSecrets(helper, cloudName, credential); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
 	return credential, nil
 }
 
 // AllCredentials implements CredentialGetter.
 func (s *store) AllCredentials() (map[string]cloud.CloudCredential, error) {
-	credentialCollection, err := ReadCredentialsFile(JujuCredentialsPath())
+	helper, err := s.credentialHelper()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	credentialCollection, err := s.readCredentials(helper)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -867,11 +1001,44 @@ func (s *store) AllCredentials() (map[string]cloud.CloudCredential, error) {
 		if err != nil {
 			return nil, errors.Trace(err)
 		}
+		if helper != nil {
+			if err := loadCredentialSecrets(helper, cloudName, v); err != nil {
+				return nil, errors.Trace(err)
+			}
+		}
 		cloudCredentials[cloudName] = *v
 	}
 	return cloudCredentials, nil
 }
 
+// readCredentials reads the credentials file. If a credential helper is
+// in use, any credential attributes still in the file are first moved
+// into the helper.
+func (s *store) readCredentials(helper CredentialHelper) (*cloud.CredentialCollection, error) {
+	if helper == nil {
+		return ReadCredentialsFile(JujuCredentialsPath())
+	}
+
+	releaser, err := s.acquireLock()
+	if err != nil {
+		return nil, errors.Annotate(err, "cannot acquire lock file for reading credentials")
+	}
+	defer releaser.Release()
+
+	credentials, err := ReadCredentialsFile(JujuCredentialsPath())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if migrated, err := migrateCredentialSecrets(helper, credentials); err != nil {
+		return nil, errors.Trace(err)
+	} else if migrated {
+		if err := WriteCredentialsFile(credentials); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	return credentials, nil
+}
+
 // UpdateBootstrapConfig implements BootstrapConfigUpdater.
 func (s *store) UpdateBootstrapConfig(controllerName string, cfg BootstrapConfig) error {
 	if err := ValidateControllerName(controllerName); err != nil {
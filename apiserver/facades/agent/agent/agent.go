@@ -31,6 +31,7 @@ import (
 	machineerrors "github.com/juju/juju/domain/machine/errors"
 	"github.com/juju/juju/domain/model"
 	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/internal/secrets/encryptionkey"
 	"github.com/juju/juju/rpc/params"
 )
 
@@ -171,6 +172,7 @@ type AgentAPI struct {
 	machineService          MachineService
 	auth                    facade.Authorizer
 	watcherRegistry         facade.WatcherRegistry
+	dataDir                 string
 }
 
 // NewAgentAPI returns an agent API facade.
@@ -186,6 +188,7 @@ func NewAgentAPI(
 	machineService MachineService,
 	modelConfigService ModelConfigService,
 	applicationService ApplicationService,
+	dataDir string,
 ) *AgentAPI {
 	getCanChange := func(context.Context) (common.AuthFunc, error) {
 		return auth.AuthOwner, nil
@@ -207,6 +210,7 @@ func NewAgentAPI(
 		machineService:          machineService,
 		auth:                    auth,
 		watcherRegistry:         watcherRegistry,
+		dataDir:                 dataDir,
 	}
 }
 
@@ -321,12 +325,20 @@ func (api *AgentAPI) StateServingInfo(ctx context.Context) (result params.StateS
 		return params.StateServingInfo{}, errors.Trace(err)
 	}
 
+	// The secret encryption root key is not in the database, so it is read
+	// from this controller's key file for the controller being added.
+	secretEncryptionKey, err := encryptionkey.ReadRootKey(api.dataDir)
+	if err != nil {
+		return params.StateServingInfo{}, errors.Annotate(err, "reading secret encryption key")
+	}
+
 	result = params.StateServingInfo{
-		APIPort:        info.APIPort,
-		Cert:           info.Cert,
-		PrivateKey:     info.PrivateKey,
-		CAPrivateKey:   info.CAPrivateKey,
-		SystemIdentity: info.SystemIdentity,
+		APIPort:             info.APIPort,
+		Cert:                info.Cert,
+		PrivateKey:          info.PrivateKey,
+		CAPrivateKey:        info.CAPrivateKey,
+		SystemIdentity:      info.SystemIdentity,
+		SecretEncryptionKey: secretEncryptionKey,
 	}
 
 	return result, nil
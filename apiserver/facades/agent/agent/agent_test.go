@@ -14,10 +14,13 @@ import (
 
 	"github.com/juju/juju/apiserver/common"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/controller"
 	"github.com/juju/juju/core/machine"
 	"github.com/juju/juju/core/unit"
 	applicationerrors "github.com/juju/juju/domain/application/errors"
 	machineerrors "github.com/juju/juju/domain/machine/errors"
+	"github.com/juju/juju/internal/secrets/encryptionkey"
 	"github.com/juju/juju/internal/testhelpers"
 	"github.com/juju/juju/rpc/params"
 )
@@ -25,7 +28,8 @@ import (
 type agentSuite struct {
 	testhelpers.IsolationSuite
 
-	passwordService *MockAgentPasswordService
+	passwordService   *MockAgentPasswordService
+	controllerService *MockControllerService
 }
 
 func TestAgentSuite(t *testing.T) {
@@ -148,10 +152,59 @@ func (s *agentSuite) TestSetMachinePasswordMachineNotFound(c *tc.C) {
 	})
 }
 
+func (s *agentSuite) TestStateServingInfo(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	dataDir := c.MkDir()
+	err := encryptionkey.EnsureRootKey(dataDir)
+	c.Assert(err, tc.ErrorIsNil)
+	rootKey, err := encryptionkey.ReadRootKey(dataDir)
+	c.Assert(err, tc.ErrorIsNil)
+
+	s.controllerService.EXPECT().GetControllerAgentInfo(gomock.Any()).Return(controller.ControllerAgentInfo{
+		APIPort:        17070,
+		Cert:           "cert",
+		PrivateKey:     "private-key",
+		CAPrivateKey:   "ca-private-key",
+		SystemIdentity: "system-identity",
+	}, nil)
+
+	api := &AgentAPI{
+		auth:              apiservertesting.FakeAuthorizer{Controller: true},
+		controllerService: s.controllerService,
+		dataDir:           dataDir,
+	}
+	result, err := api.StateServingInfo(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, params.StateServingInfo{
+		APIPort:             17070,
+		Cert:                "cert",
+		PrivateKey:          "private-key",
+		CAPrivateKey:        "ca-private-key",
+		SystemIdentity:      "system-identity",
+		SecretEncryptionKey: rootKey,
+	})
+}
+
+func (s *agentSuite) TestStateServingInfoNoSecretEncryptionKey(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.controllerService.EXPECT().GetControllerAgentInfo(gomock.Any()).Return(controller.ControllerAgentInfo{}, nil)
+
+	api := &AgentAPI{
+		auth:              apiservertesting.FakeAuthorizer{Controller: true},
+		controllerService: s.controllerService,
+		dataDir:           c.MkDir(),
+	}
+	_, err := api.StateServingInfo(c.Context())
+	c.Assert(err, tc.ErrorMatches, `reading secret encryption key: .*`)
+}
+
 func (s *agentSuite) setupMocks(c *tc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 
 	s.passwordService = NewMockAgentPasswordService(ctrl)
+	s.controllerService = NewMockControllerService(ctrl)
 
 	return ctrl
 }
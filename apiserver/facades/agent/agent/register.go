@@ -39,5 +39,6 @@ func NewAgentAPIV3(ctx facade.ModelContext) (*AgentAPI, error) {
 		services.Machine(),
 		services.Config(),
 		services.Application(),
+		ctx.DataDir(),
 	), nil
 }
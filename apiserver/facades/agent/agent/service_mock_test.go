@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/facades/agent/agent (interfaces: CredentialService,AgentPasswordService)
+// Source: github.com/juju/juju/apiserver/facades/agent/agent (interfaces: CredentialService,AgentPasswordService,ControllerService)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package agent -destination service_mock_test.go github.com/juju/juju/apiserver/facades/agent/agent CredentialService,AgentPasswordService
+//	mockgen -typed -package agent -destination service_mock_test.go github.com/juju/juju/apiserver/facades/agent/agent CredentialService,AgentPasswordService,ControllerService
 //
 
 // Package agent is a generated GoMock package.
@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	cloud "github.com/juju/juju/cloud"
+	controller "github.com/juju/juju/controller"
 	credential "github.com/juju/juju/core/credential"
 	machine "github.com/juju/juju/core/machine"
 	unit "github.com/juju/juju/core/unit"
@@ -297,3 +298,65 @@ func (c *MockAgentPasswordServiceSetUnitPasswordCall) DoAndReturn(f func(context
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockControllerService is a mock of ControllerService interface.
+type MockControllerService struct {
+	ctrl     *gomock.Controller
+	recorder *MockControllerServiceMockRecorder
+}
+
+// MockControllerServiceMockRecorder is the mock recorder for MockControllerService.
+type MockControllerServiceMockRecorder struct {
+	mock *MockControllerService
+}
+
+// NewMockControllerService creates a new mock instance.
+func NewMockControllerService(ctrl *gomock.Controller) *MockControllerService {
+	mock := &MockControllerService{ctrl: ctrl}
+	mock.recorder = &MockControllerServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockControllerService) EXPECT() *MockControllerServiceMockRecorder {
+	return m.recorder
+}
+
+// GetControllerAgentInfo mocks base method.
+func (m *MockControllerService) GetControllerAgentInfo(arg0 context.Context) (controller.ControllerAgentInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetControllerAgentInfo", arg0)
+	ret0, _ := ret[0].(controller.ControllerAgentInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetControllerAgentInfo indicates an expected call of GetControllerAgentInfo.
+func (mr *MockControllerServiceMockRecorder) GetControllerAgentInfo(arg0 any) *MockControllerServiceGetControllerAgentInfoCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetControllerAgentInfo", reflect.TypeOf((*MockControllerService)(nil).GetControllerAgentInfo), arg0)
+	return &MockControllerServiceGetControllerAgentInfoCall{Call: call}
+}
+
+// MockControllerServiceGetControllerAgentInfoCall wrap *gomock.Call
+type MockControllerServiceGetControllerAgentInfoCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockControllerServiceGetControllerAgentInfoCall) Return(arg0 controller.ControllerAgentInfo, arg1 error) *MockControllerServiceGetControllerAgentInfoCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockControllerServiceGetControllerAgentInfoCall) Do(f func(context.Context) (controller.ControllerAgentInfo, error)) *MockControllerServiceGetControllerAgentInfoCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockControllerServiceGetControllerAgentInfoCall) DoAndReturn(f func(context.Context) (controller.ControllerAgentInfo, error)) *MockControllerServiceGetControllerAgentInfoCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
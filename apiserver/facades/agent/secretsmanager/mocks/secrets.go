@@ -856,6 +856,44 @@ func (c *MockSecretServiceProcessCharmSecretConsumerLabelCall) DoAndReturn(f fun
 	return c
 }
 
+// RecordSecretAccess mocks base method.
+func (m *MockSecretService) RecordSecretAccess(ctx context.Context, uri *secrets.URI, revision int, accessor service.SecretAccessor) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordSecretAccess", ctx, uri, revision, accessor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordSecretAccess indicates an expected call of RecordSecretAccess.
+func (mr *MockSecretServiceMockRecorder) RecordSecretAccess(ctx, uri, revision, accessor any) *MockSecretServiceRecordSecretAccessCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordSecretAccess", reflect.TypeOf((*MockSecretService)(nil).RecordSecretAccess), ctx, uri, revision, accessor)
+	return &MockSecretServiceRecordSecretAccessCall{Call: call}
+}
+
+// MockSecretServiceRecordSecretAccessCall wrap *gomock.Call
+type MockSecretServiceRecordSecretAccessCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretServiceRecordSecretAccessCall) Return(arg0 error) *MockSecretServiceRecordSecretAccessCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretServiceRecordSecretAccessCall) Do(f func(context.Context, *secrets.URI, int, service.SecretAccessor) error) *MockSecretServiceRecordSecretAccessCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretServiceRecordSecretAccessCall) DoAndReturn(f func(context.Context, *secrets.URI, int, service.SecretAccessor) error) *MockSecretServiceRecordSecretAccessCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockSecretBackendService is a mock of SecretBackendService interface.
 type MockSecretBackendService struct {
 	ctrl     *gomock.Controller
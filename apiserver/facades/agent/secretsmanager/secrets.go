@@ -425,6 +425,7 @@ func (s *SecretsManagerAPI) GetSecretRevisionContentInfo(ctx context.Context, ar
 			result.Results[i].Error = apiservererrors.ServerError(err)
 			continue
 		}
+		s.recordSecretAccess(ctx, uri, rev, accessor)
 		contentParams := params.SecretContentParams{}
 		if valueRef != nil {
 			contentParams.ValueRef = &params.SecretValueRef{
@@ -455,6 +456,16 @@ func (s *SecretsManagerAPI) GetSecretRevisionContentInfo(ctx context.Context, ar
 	return result, nil
 }
 
+// recordSecretAccess records a read of secret content in the access log.
+// Failing to record it doesn't fail the read.
+func (s *SecretsManagerAPI) recordSecretAccess(
+	ctx context.Context, uri *coresecrets.URI, revision int, accessor secretservice.SecretAccessor,
+) {
+	if err := s.secretService.RecordSecretAccess(ctx, uri, revision, accessor); err != nil {
+		s.logger.Warningf(ctx, "recording access to secret %s/%d: %v", uri.ID, revision, err)
+	}
+}
+
 func (s *SecretsManagerAPI) getSecretContent(ctx context.Context, arg params.GetSecretContentArg) (
 	*secrets.ContentParams, *secretsprovider.ModelBackendConfig, bool, error,
 ) {
@@ -503,6 +514,9 @@ func (s *SecretsManagerAPI) getSecretContent(ctx context.Context, arg params.Get
 		ID:   s.authTag.Id(),
 	}
 	val, valueRef, err := s.secretService.GetSecretValue(ctx, uri, consumedRevision, accessor)
+	if err == nil {
+		s.recordSecretAccess(ctx, uri, consumedRevision, accessor)
+	}
 	content := &secrets.ContentParams{SecretValue: val, ValueRef: valueRef}
 	if err != nil || content.ValueRef == nil {
 		return content, nil, false, errors.Trace(err)
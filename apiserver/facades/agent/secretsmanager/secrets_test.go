@@ -415,6 +415,44 @@ func (s *SecretsManagerSuite) TestGetSecretContentForOwnerSecretURIArg(c *tc.C)
 
 	s.secretService.EXPECT().ProcessCharmSecretConsumerLabel(gomock.Any(), unittesting.GenNewName(c, "mariadb/0"), uri, "").Return(uri, nil, nil)
 
+	s.secretsConsumer.EXPECT().GetConsumedRevision(gomock.Any(), uri, unittesting.GenNewName(c, "mariadb/0"), false, false, nil).
+		Return(668, nil)
+
+	s.secretService.EXPECT().RecordSecretAccess(gomock.Any(), uri, 668, secretservice.SecretAccessor{
+		Kind: secretservice.UnitAccessor,
+		ID:   "mariadb/0",
+	}).Return(nil)
+	s.secretService.EXPECT().GetSecretValue(gomock.Any(), uri, 668, secretservice.SecretAccessor{
+		Kind: secretservice.UnitAccessor,
+		ID:   "mariadb/0",
+	}).Return(
+		val, nil, nil,
+	)
+
+	results, err := s.facade.GetSecretContentInfo(c.Context(), params.GetSecretContentArgs{
+		Args: []params.GetSecretContentArg{
+			{URI: uri.String()},
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.DeepEquals, params.SecretContentResults{
+		Results: []params.SecretContentResult{{
+			Content: params.SecretContentParams{Data: data},
+		}},
+	})
+}
+
+// TestGetSecretContentRecordAccessError verifies that failing to record the
+// read in the access log doesn't fail the read.
+func (s *SecretsManagerSuite) TestGetSecretContentRecordAccessError(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	data := map[string]string{"foo": "bar"}
+	val := coresecrets.NewSecretValue(data)
+	uri := coresecrets.NewURI()
+
+	s.secretService.EXPECT().ProcessCharmSecretConsumerLabel(gomock.Any(), unittesting.GenNewName(c, "mariadb/0"), uri, "").Return(uri, nil, nil)
+
 	s.secretsConsumer.EXPECT().GetConsumedRevision(gomock.Any(), uri, unittesting.GenNewName(c, "mariadb/0"), false, false, nil).
 		Return(668, nil)
 
@@ -424,6 +462,10 @@ func (s *SecretsManagerSuite) TestGetSecretContentForOwnerSecretURIArg(c *tc.C)
 	}).Return(
 		val, nil, nil,
 	)
+	s.secretService.EXPECT().RecordSecretAccess(gomock.Any(), uri, 668, secretservice.SecretAccessor{
+		Kind: secretservice.UnitAccessor,
+		ID:   "mariadb/0",
+	}).Return(errors.New("boom"))
 
 	results, err := s.facade.GetSecretContentInfo(c.Context(), params.GetSecretContentArgs{
 		Args: []params.GetSecretContentArg{
@@ -450,6 +492,10 @@ func (s *SecretsManagerSuite) TestGetSecretContentForOwnerSecretLabelArg(c *tc.C
 	s.secretsConsumer.EXPECT().GetConsumedRevision(gomock.Any(), uri, unittesting.GenNewName(c, "mariadb/0"), false, false, nil).
 		Return(668, nil)
 
+	s.secretService.EXPECT().RecordSecretAccess(gomock.Any(), uri, 668, secretservice.SecretAccessor{
+		Kind: secretservice.UnitAccessor,
+		ID:   "mariadb/0",
+	}).Return(nil)
 	s.secretService.EXPECT().GetSecretValue(gomock.Any(), uri, 668, secretservice.SecretAccessor{
 		Kind: secretservice.UnitAccessor,
 		ID:   "mariadb/0",
@@ -481,6 +527,10 @@ func (s *SecretsManagerSuite) TestGetSecretContentForAppSecretUpdateLabel(c *tc.
 
 	s.secretsConsumer.EXPECT().GetConsumedRevision(gomock.Any(), uri, unittesting.GenNewName(c, "mariadb/0"), false, false, nil).
 		Return(668, nil)
+	s.secretService.EXPECT().RecordSecretAccess(gomock.Any(), uri, 668, secretservice.SecretAccessor{
+		Kind: secretservice.UnitAccessor,
+		ID:   "mariadb/0",
+	}).Return(nil)
 	s.secretService.EXPECT().GetSecretValue(gomock.Any(), uri, 668, secretservice.SecretAccessor{
 		Kind: secretservice.UnitAccessor,
 		ID:   "mariadb/0",
@@ -513,6 +563,10 @@ func (s *SecretsManagerSuite) TestGetSecretContentForUnitAccessApplicationOwnedS
 	s.secretsConsumer.EXPECT().GetConsumedRevision(gomock.Any(), uri, unittesting.GenNewName(c, "mariadb/0"), false, false, nil).
 		Return(668, nil)
 
+	s.secretService.EXPECT().RecordSecretAccess(gomock.Any(), uri, 668, secretservice.SecretAccessor{
+		Kind: secretservice.UnitAccessor,
+		ID:   "mariadb/0",
+	}).Return(nil)
 	s.secretService.EXPECT().GetSecretValue(gomock.Any(), uri, 668, secretservice.SecretAccessor{
 		Kind: secretservice.UnitAccessor,
 		ID:   "mariadb/0",
@@ -545,6 +599,10 @@ func (s *SecretsManagerSuite) TestGetSecretContentConsumerUnitAgent(c *tc.C) {
 	s.secretService.EXPECT().ProcessCharmSecretConsumerLabel(gomock.Any(), unittesting.GenNewName(c, "mariadb/0"), uri, "").Return(uri, nil, nil)
 	s.secretsConsumer.EXPECT().GetConsumedRevision(gomock.Any(), uri, unittesting.GenNewName(c, "mariadb/0"), false, false, nil).
 		Return(666, nil)
+	s.secretService.EXPECT().RecordSecretAccess(gomock.Any(), uri, 666, secretservice.SecretAccessor{
+		Kind: secretservice.UnitAccessor,
+		ID:   "mariadb/0",
+	}).Return(nil)
 	s.secretService.EXPECT().GetSecretValue(gomock.Any(), uri, 666, secretservice.SecretAccessor{
 		Kind: secretservice.UnitAccessor,
 		ID:   "mariadb/0",
@@ -575,6 +633,10 @@ func (s *SecretsManagerSuite) TestGetSecretContentConsumerLabelOnly(c *tc.C) {
 	s.secretService.EXPECT().ProcessCharmSecretConsumerLabel(gomock.Any(), unittesting.GenNewName(c, "mariadb/0"), nil, "label").Return(uri, nil, nil)
 	s.secretsConsumer.EXPECT().GetConsumedRevision(gomock.Any(), uri, unittesting.GenNewName(c, "mariadb/0"), false, false, nil).
 		Return(666, nil)
+	s.secretService.EXPECT().RecordSecretAccess(gomock.Any(), uri, 666, secretservice.SecretAccessor{
+		Kind: secretservice.UnitAccessor,
+		ID:   "mariadb/0",
+	}).Return(nil)
 	s.secretService.EXPECT().GetSecretValue(gomock.Any(), uri, 666, secretservice.SecretAccessor{
 		Kind: secretservice.UnitAccessor,
 		ID:   "mariadb/0",
@@ -606,6 +668,10 @@ func (s *SecretsManagerSuite) TestGetSecretContentConsumerUpdateArg(c *tc.C) {
 	s.secretsConsumer.EXPECT().GetConsumedRevision(gomock.Any(), uri, unittesting.GenNewName(c, "mariadb/0"), true, false, ptr("label")).
 		Return(668, nil)
 
+	s.secretService.EXPECT().RecordSecretAccess(gomock.Any(), uri, 668, secretservice.SecretAccessor{
+		Kind: secretservice.UnitAccessor,
+		ID:   "mariadb/0",
+	}).Return(nil)
 	s.secretService.EXPECT().GetSecretValue(gomock.Any(), uri, 668, secretservice.SecretAccessor{
 		Kind: secretservice.UnitAccessor,
 		ID:   "mariadb/0",
@@ -636,6 +702,10 @@ func (s *SecretsManagerSuite) TestGetSecretContentConsumerPeekArg(c *tc.C) {
 	s.secretService.EXPECT().ProcessCharmSecretConsumerLabel(gomock.Any(), unittesting.GenNewName(c, "mariadb/0"), uri, "").Return(uri, nil, nil)
 	s.secretsConsumer.EXPECT().GetConsumedRevision(gomock.Any(), uri, unittesting.GenNewName(c, "mariadb/0"), false, true, nil).
 		Return(668, nil)
+	s.secretService.EXPECT().RecordSecretAccess(gomock.Any(), uri, 668, secretservice.SecretAccessor{
+		Kind: secretservice.UnitAccessor,
+		ID:   "mariadb/0",
+	}).Return(nil)
 	s.secretService.EXPECT().GetSecretValue(gomock.Any(), uri, 668, secretservice.SecretAccessor{
 		Kind: secretservice.UnitAccessor,
 		ID:   "mariadb/0",
@@ -691,6 +761,10 @@ func (s *SecretsManagerSuite) TestGetSecretRevisionContentInfo(c *tc.C) {
 	defer s.setup(c).Finish()
 
 	uri := coresecrets.NewURI()
+	s.secretService.EXPECT().RecordSecretAccess(gomock.Any(), uri, 666, secretservice.SecretAccessor{
+		Kind: secretservice.UnitAccessor,
+		ID:   "mariadb/0",
+	}).Return(nil)
 	s.secretService.EXPECT().GetSecretValue(gomock.Any(), uri, 666, secretservice.SecretAccessor{
 		Kind: secretservice.UnitAccessor,
 		ID:   "mariadb/0",
@@ -40,6 +40,7 @@ type SecretsConsumer interface {
 type SecretService interface {
 	CreateSecretURIs(ctx context.Context, count int) ([]*secrets.URI, error)
 	GetSecretValue(context.Context, *secrets.URI, int, secretservice.SecretAccessor) (secrets.SecretValue, *secrets.ValueRef, error)
+	RecordSecretAccess(ctx context.Context, uri *secrets.URI, revision int, accessor secretservice.SecretAccessor) error
 	ListCharmSecrets(context.Context, ...secretservice.CharmSecretOwner) ([]*secrets.SecretMetadata, [][]*secrets.SecretRevisionMetadata, error)
 	ProcessCharmSecretConsumerLabel(
 		ctx context.Context, unitName unit.Name, uri *secrets.URI, label string,
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"maps"
+	"net"
 	"strconv"
 	"strings"
 	"time"
@@ -38,15 +39,22 @@ import (
 	"github.com/juju/juju/core/objectstore"
 	"github.com/juju/juju/core/os/ostype"
 	"github.com/juju/juju/core/permission"
+	corerelation "github.com/juju/juju/core/relation"
 	coreresource "github.com/juju/juju/core/resource"
 	"github.com/juju/juju/core/semversion"
+	corestorage "github.com/juju/juju/core/storage"
 	coreunit "github.com/juju/juju/core/unit"
 	jujuversion "github.com/juju/juju/core/version"
 	"github.com/juju/juju/domain/application"
+	applicationcharm "github.com/juju/juju/domain/application/charm"
 	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/domain/crossmodelrelation"
+	crossmodelrelationerrors "github.com/juju/juju/domain/crossmodelrelation/errors"
 	"github.com/juju/juju/domain/relation"
 	"github.com/juju/juju/domain/resolve"
 	resolveerrors "github.com/juju/juju/domain/resolve/errors"
+	domainstorage "github.com/juju/juju/domain/storage"
+	storageerrors "github.com/juju/juju/domain/storage/errors"
 	"github.com/juju/juju/environs/bootstrap"
 	"github.com/juju/juju/internal/charm"
 	"github.com/juju/juju/internal/charmhub"
@@ -57,9 +65,14 @@ import (
 	"github.com/juju/juju/rpc/params"
 )
 
+// APIv23 provides the Application API facade for version 23.
+type APIv23 struct {
+	*APIBase
+}
+
 // APIv22 provides the Application API facade for version 22.
 type APIv22 struct {
-	*APIBase
+	*APIv23
 }
 
 // APIv21 provides the Application API facade for version 21.
@@ -89,6 +102,7 @@ type APIBase struct {
 	controllerUUID            string
 	modelUUID                 model.UUID
 	modelType                 model.ModelType
+	controllerConfigService   ControllerConfigService
 	modelConfigService        ModelConfigService
 	machineService            MachineService
 	applicationService        ApplicationService
@@ -100,6 +114,7 @@ type APIBase struct {
 	resourceService           ResourceService
 	storageService            StorageService
 	externalControllerService ExternalControllerService
+	crossModelRelationService CrossModelRelationService
 
 	leadershipReader leadership.Reader
 
@@ -149,15 +164,16 @@ func newFacadeBase(stdCtx context.Context, ctx facade.ModelContext) (*APIBase, e
 	applicationService := domainServices.Application()
 
 	validatorCfg := validatorConfig{
-		charmhubHTTPClient: charmhubHTTPClient,
-		caasBroker:         nil,
-		modelInfo:          modelInfo,
-		modelConfigService: domainServices.Config(),
-		machineService:     domainServices.Machine(),
-		applicationService: applicationService,
-		registry:           registry,
-		storageService:     storageService,
-		logger:             repoLogger,
+		charmhubHTTPClient:      charmhubHTTPClient,
+		caasBroker:              nil,
+		modelInfo:               modelInfo,
+		controllerConfigService: domainServices.ControllerConfig(),
+		modelConfigService:      domainServices.Config(),
+		machineService:          domainServices.Machine(),
+		applicationService:      applicationService,
+		registry:                registry,
+		storageService:          storageService,
+		logger:                  repoLogger,
 	}
 
 	repoDeploy := NewDeployFromRepositoryAPI(
@@ -171,7 +187,9 @@ func newFacadeBase(stdCtx context.Context, ctx facade.ModelContext) (*APIBase, e
 
 	return NewAPIBase(
 		Services{
+			ControllerConfigService:   domainServices.ControllerConfig(),
 			ExternalControllerService: domainServices.ExternalController(),
+			CrossModelRelationService: domainServices.CrossModelRelation(),
 			NetworkService:            domainServices.Network(),
 			ModelConfigService:        domainServices.Config(),
 			MachineService:            domainServices.Machine(),
@@ -249,7 +267,9 @@ func NewAPIBase(
 		caasBroker:            caasBroker,
 		store:                 store,
 
+		controllerConfigService:   services.ControllerConfigService,
 		externalControllerService: services.ExternalControllerService,
+		crossModelRelationService: services.CrossModelRelationService,
 		applicationService:        services.ApplicationService,
 		resolveService:            services.ResolveService,
 		machineService:            services.MachineService,
@@ -510,6 +530,10 @@ func (api *APIBase) deployApplication(
 		return errors.Errorf("not all pending resources for charm provided")
 	}
 
+	if err := api.verifySignatures(ctx, locator, args.CharmSignature, args.Resources, args.ResourceSignatures); err != nil {
+		return errors.Trace(err)
+	}
+
 	if api.modelType == model.CAAS {
 		caas := caasDeployParams{
 			applicationName: args.ApplicationName,
@@ -700,6 +724,10 @@ func (api *APIBase) SetCharm(ctx context.Context, args params.ApplicationSetChar
 		return errors.Trace(err)
 	}
 
+	if err := api.verifySignatures(ctx, newCharmLocator, args.CharmSignature, args.ResourceIDs, args.ResourceSignatures); err != nil {
+		return errors.Trace(err)
+	}
+
 	err = api.applicationService.SetApplicationCharm(ctx, args.ApplicationName, newCharmLocator, application.SetCharmParams{})
 	if errors.Is(err, applicationerrors.ApplicationNotFound) {
 		return errors.NotFoundf("application %q", args.ApplicationName)
@@ -1140,15 +1168,49 @@ func (api *APIBase) DestroyConsumedApplications(ctx context.Context, args params
 		return params.ErrorResults{}, errors.Trace(err)
 	}
 	results := make([]params.ErrorResult, len(args.Applications))
-	for i := range args.Applications {
-		results[i].Error = apiservererrors.ServerError(errors.NotImplementedf("cross model relations are disabled until " +
-			"backend functionality is moved to domain"))
+	for i, arg := range args.Applications {
+		results[i].Error = apiservererrors.ServerError(api.destroyConsumedApplication(ctx, arg))
 	}
 	return params.ErrorResults{
 		Results: results,
 	}, nil
 }
 
+func (api *APIBase) destroyConsumedApplication(ctx context.Context, arg params.DestroyConsumedApplicationParams) error {
+	appTag, err := names.ParseApplicationTag(arg.ApplicationTag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	name := appTag.Id()
+
+	force := false
+	if arg.Force != nil {
+		force = *arg.Force
+	}
+	var maxWait time.Duration
+	if arg.MaxWait != nil {
+		maxWait = *arg.MaxWait
+	}
+
+	appID, err := api.applicationService.GetApplicationIDByName(ctx, name)
+	if errors.Is(err, applicationerrors.ApplicationNotFound) {
+		return errors.NotFoundf("saas application %q", name)
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+
+	removalUUID, err := api.removalService.RemoveRemoteApplicationOfferer(ctx, appID, force, maxWait)
+	if errors.Is(err, crossmodelrelationerrors.RemoteApplicationNotFound) ||
+		errors.Is(err, applicationerrors.ApplicationNotFound) {
+		return errors.NotFoundf("saas application %q", name)
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+
+	api.logger.Debugf(ctx, "removal uuid %q for saas application %q", removalUUID, name)
+	return nil
+}
+
 // ScaleApplications scales the specified application to the requested number of units.
 func (api *APIBase) ScaleApplications(ctx context.Context, args params.ScaleApplicationsParamsV2) (params.ScaleApplicationResults, error) {
 	if api.modelType != model.CAAS {
@@ -1181,14 +1243,32 @@ func (api *APIBase) ScaleApplications(ctx context.Context, args params.ScaleAppl
 			}
 			return nil, errors.Errorf("failed to scale a application: %s", strings.Join(errStrings, ", "))
 		}
-		// TODO(storage) - implement and test attach storage for k8s
-		//  update ChangeApplicationScale() below.
-		if len(storageTags) > 0 {
-			return nil, errors.NotImplementedf("attaching storage when scaling a k8s application")
-		}
 
 		var info params.ScaleApplicationInfo
-		if arg.ScaleChange != 0 {
+		if len(storageTags) > 0 {
+			// Attaching storage is only allowed when adding a single unit,
+			// which has been checked when parsing the storage tags.
+			storageIDs := transform.Slice(storageTags, func(tag names.StorageTag) corestorage.ID {
+				return corestorage.ID(tag.Id())
+			})
+			_, newScale, err := api.applicationService.AddCAASUnitAttachingStorage(ctx, name, storageIDs)
+			if errors.Is(err, applicationerrors.ApplicationNotFound) {
+				return nil, errors.NotFoundf("application %q", name)
+			} else if errors.Is(err, storageerrors.StorageNotFound) {
+				return nil, errors.NewNotFound(err, "attaching storage")
+			} else if errors.Is(err, applicationerrors.StorageNotAlive) ||
+				errors.Is(err, applicationerrors.StorageAlreadyAttached) ||
+				errors.Is(err, applicationerrors.StorageNotApplicationStorage) ||
+				errors.Is(err, applicationerrors.StoragePoolNotMatching) ||
+				errors.Is(err, applicationerrors.InvalidStorageCount) {
+				return nil, errors.NewNotValid(err, "attaching storage")
+			} else if errors.Is(err, applicationerrors.StorageNameNotSupported) {
+				return nil, errors.NewNotSupported(err, "attaching storage")
+			} else if err != nil {
+				return nil, errors.Trace(err)
+			}
+			info.Scale = newScale
+		} else if arg.ScaleChange != 0 {
 			newScale, err := api.applicationService.ChangeApplicationScale(ctx, name, arg.ScaleChange)
 			if err != nil {
 				return nil, errors.Trace(err)
@@ -1309,15 +1389,18 @@ func (api *APIBase) AddRelation(ctx context.Context, args params.AddRelation) (_
 		return params.AddRelationResults{}, internalerrors.Capture(err)
 	}
 
-	if len(args.ViaCIDRs) > 0 {
-		// Integration via subnets is only for cross model relations.
-		return params.AddRelationResults{}, internalerrors.Errorf("cross model relations are disabled until "+
-			"backend functionality is moved to domain: %w", errors.NotImplemented)
-	}
-
 	if len(args.Endpoints) != 2 {
 		return params.AddRelationResults{}, errors.BadRequestf("a relation should have exactly two endpoints")
 	}
+
+	// Integration via subnets is only for cross model relations, the CIDRs
+	// are validated up front so that no relation is added if any are bad.
+	for _, cidr := range args.ViaCIDRs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return params.AddRelationResults{}, errors.NotValidf("via CIDR %q", cidr)
+		}
+	}
+
 	ep1, ep2, err := api.relationService.AddRelation(
 		ctx, args.Endpoints[0], args.Endpoints[1],
 	)
@@ -1327,12 +1410,41 @@ func (api *APIBase) AddRelation(ctx context.Context, args params.AddRelation) (_
 			args.Endpoints[0], args.Endpoints[1], err,
 		)
 	}
+
+	if len(args.ViaCIDRs) > 0 {
+		if err := api.addRelationNetworkEgress(ctx, ep1, ep2, args.ViaCIDRs); err != nil {
+			return params.AddRelationResults{}, internalerrors.Capture(err)
+		}
+	}
+
 	return params.AddRelationResults{Endpoints: map[string]params.CharmRelation{
 		ep1.ApplicationName: encodeRelation(ep1.Relation),
 		ep2.ApplicationName: encodeRelation(ep2.Relation),
 	}}, nil
 }
 
+// addRelationNetworkEgress records the input CIDRs as the egress networks of
+// the relation between the input endpoints.
+func (api *APIBase) addRelationNetworkEgress(ctx context.Context, ep1, ep2 relation.Endpoint, cidrs []string) error {
+	key, err := corerelation.NewKey([]corerelation.EndpointIdentifier{
+		{ApplicationName: ep1.ApplicationName, EndpointName: ep1.Name, Role: ep1.Role},
+		{ApplicationName: ep2.ApplicationName, EndpointName: ep2.Name, Role: ep2.Role},
+	})
+	if err != nil {
+		return internalerrors.Errorf("generating relation key: %w", err)
+	}
+
+	relUUID, err := api.relationService.GetRelationUUIDByKey(ctx, key)
+	if err != nil {
+		return internalerrors.Errorf("getting relation %q: %w", key, err)
+	}
+
+	if err := api.crossModelRelationService.AddRelationNetworkEgress(ctx, relUUID, cidrs...); err != nil {
+		return internalerrors.Errorf("adding egress networks to relation %q: %w", key, err)
+	}
+	return nil
+}
+
 // encodeRelation encodes a relation for sending over the wire.
 func encodeRelation(rel charm.Relation) params.CharmRelation {
 	return params.CharmRelation{
@@ -1428,7 +1540,42 @@ func (api *APIBase) consumeOne(ctx context.Context, arg params.ConsumeApplicatio
 		}
 	}
 
-	_ = externalControllerTag
+	// Offers on this controller have no external controller saved.
+	offererControllerUUID := externalControllerTag.Id()
+	if offererControllerUUID == "" {
+		offererControllerUUID = api.controllerUUID
+	}
+
+	appName := arg.ApplicationAlias
+	if appName == "" {
+		appName = arg.OfferName
+	}
+
+	endpoints := make([]applicationcharm.Relation, len(arg.Endpoints))
+	for i, ep := range arg.Endpoints {
+		endpoints[i] = applicationcharm.Relation{
+			Name:      ep.Name,
+			Role:      applicationcharm.RelationRole(ep.Role),
+			Interface: ep.Interface,
+			Limit:     ep.Limit,
+			Scope:     applicationcharm.ScopeGlobal,
+		}
+	}
+
+	err = api.crossModelRelationService.AddRemoteApplicationOfferer(ctx, appName, crossmodelrelation.AddRemoteApplicationOffererArgs{
+		OfferUUID:             arg.OfferUUID,
+		OffererControllerUUID: offererControllerUUID,
+		OffererModelUUID:      sourceModelTag.Id(),
+		Endpoints:             endpoints,
+		Macaroon:              arg.Macaroon,
+	})
+	if errors.Is(err, applicationerrors.ApplicationAlreadyExists) {
+		return internalerrors.Errorf("saas application %q already exists", appName).Add(coreerrors.AlreadyExists)
+	} else if errors.Is(err, applicationerrors.ApplicationNameNotValid) {
+		return internalerrors.Errorf("saas application name %q not valid", appName).Add(coreerrors.NotValid)
+	} else if err != nil {
+		return internalerrors.Errorf("adding saas application %q: %w", appName, err)
+	}
 
 	return nil
 }
@@ -1532,10 +1679,6 @@ func (api *APIBase) SetConfigs(ctx context.Context, args params.ConfigSetArgs) (
 }
 
 func (api *APIBase) setConfig(ctx context.Context, arg params.ConfigSet) params.ErrorResult {
-	if arg.ConfigYAML != "" {
-		return params.ErrorResult{Error: apiservererrors.ServerError(errors.NotImplementedf("config yaml not supported"))}
-	}
-
 	appID, err := api.applicationService.GetApplicationIDByName(ctx, arg.ApplicationName)
 	if errors.Is(err, applicationerrors.ApplicationNotFound) {
 		return params.ErrorResult{Error: apiservererrors.ServerError(errors.NotFoundf("application %q", arg.ApplicationName))}
@@ -1545,7 +1688,13 @@ func (api *APIBase) setConfig(ctx context.Context, arg params.ConfigSet) params.
 		return params.ErrorResult{Error: apiservererrors.ServerError(err)}
 	}
 
-	err = api.applicationService.UpdateApplicationConfig(ctx, appID, arg.Config)
+	// The YAML config and the config map are applied together, so that either
+	// all of the settings are updated or none of them are.
+	if arg.ConfigYAML != "" {
+		err = api.applicationService.UpdateApplicationConfigWithYAML(ctx, appID, arg.ApplicationName, arg.ConfigYAML, arg.Config)
+	} else {
+		err = api.applicationService.UpdateApplicationConfig(ctx, appID, arg.Config)
+	}
 	if errors.Is(err, applicationerrors.ApplicationNotFound) {
 		return params.ErrorResult{Error: apiservererrors.ServerError(errors.NotFoundf("application %q", arg.ApplicationName))}
 	} else if errors.Is(err, applicationerrors.InvalidApplicationConfig) {
@@ -2132,9 +2281,37 @@ func (api *APIBase) DeployFromRepository(ctx context.Context, args params.Deploy
 	}, nil
 }
 
-func (api *APIBase) getOneApplicationStorage(entity params.Entity) (map[string]params.StorageDirectives, error) {
-	// TODO(storage): implement and add test.
-	return nil, errors.NotImplementedf("GetApplicationStorage")
+func (api *APIBase) getOneApplicationStorage(
+	ctx context.Context, entity params.Entity, poolNames map[domainstorage.StoragePoolUUID]string,
+) (map[string]params.StorageDirectives, error) {
+	appTag, err := names.ParseApplicationTag(entity.Tag)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	appID, err := api.applicationService.GetApplicationIDByName(ctx, appTag.Name)
+	if errors.Is(err, applicationerrors.ApplicationNotFound) {
+		return nil, errors.NotFoundf("application %q", appTag.Name)
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+	directives, err := api.applicationService.GetApplicationStorageDirectives(ctx, appID)
+	if errors.Is(err, applicationerrors.ApplicationNotFound) {
+		return nil, errors.NotFoundf("application %q", appTag.Name)
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	result := make(map[string]params.StorageDirectives, len(directives))
+	for _, d := range directives {
+		size := d.Size
+		count := uint64(d.Count)
+		result[d.Name.String()] = params.StorageDirectives{
+			Pool:    poolNames[d.PoolUUID],
+			SizeMiB: &size,
+			Count:   &count,
+		}
+	}
+	return result, nil
 }
 
 // GetApplicationStorage returns the current storage constraints for the specified applications in bulk.
@@ -2145,8 +2322,18 @@ func (api *APIBase) GetApplicationStorage(ctx context.Context, args params.Entit
 	if err := api.checkCanRead(ctx); err != nil {
 		return resp, errors.Trace(err)
 	}
+
+	pools, err := api.storageService.ListStoragePools(ctx)
+	if err != nil {
+		return resp, errors.Annotate(err, "listing storage pools")
+	}
+	poolNames := make(map[domainstorage.StoragePoolUUID]string, len(pools))
+	for _, pool := range pools {
+		poolNames[domainstorage.StoragePoolUUID(pool.UUID)] = pool.Name
+	}
+
 	for i, entity := range args.Entities {
-		sc, err := api.getOneApplicationStorage(entity)
+		sc, err := api.getOneApplicationStorage(ctx, entity, poolNames)
 		if err != nil {
 			resp.Results[i].Error = apiservererrors.ServerError(err)
 			continue
@@ -2156,9 +2343,37 @@ func (api *APIBase) GetApplicationStorage(ctx context.Context, args params.Entit
 	return resp, nil
 }
 
-func (api *APIBase) updateOneApplicationStorage(storageUpdate params.ApplicationStorageUpdate) error {
-	// TODO(storage): implement and add test.
-	return errors.NotImplementedf("UpdateApplicationStorage")
+func (api *APIBase) updateOneApplicationStorage(ctx context.Context, storageUpdate params.ApplicationStorageUpdate) error {
+	appTag, err := names.ParseApplicationTag(storageUpdate.ApplicationTag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	directives := make(map[string]storage.Directive, len(storageUpdate.StorageConstraints))
+	for name, sc := range storageUpdate.StorageConstraints {
+		d := storage.Directive{Pool: sc.Pool}
+		if sc.SizeMiB != nil {
+			d.Size = *sc.SizeMiB
+		}
+		if sc.Count != nil {
+			d.Count = *sc.Count
+		}
+		directives[name] = d
+	}
+	overrides, err := storageDirectives(ctx, api.storageService, directives)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	err = api.applicationService.UpdateApplicationStorageDirectives(ctx, appTag.Name, overrides)
+	if errors.Is(err, applicationerrors.ApplicationNotFound) {
+		return errors.NotFoundf("application %q", appTag.Name)
+	} else if errors.Is(err, applicationerrors.StorageNameNotSupported) {
+		return errors.NotSupportedf("storage for application %q: %v", appTag.Name, err)
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	return nil
 }
 
 // UpdateApplicationStorage updates the storage constraints for multiple existing applications in bulk.
@@ -2176,7 +2391,7 @@ func (api *APIBase) UpdateApplicationStorage(ctx context.Context, args params.Ap
 	resp.Results = res
 
 	for i, storageUpdate := range args.ApplicationStorageUpdates {
-		err := api.updateOneApplicationStorage(storageUpdate)
+		err := api.updateOneApplicationStorage(ctx, storageUpdate)
 		res[i].Error = apiservererrors.ServerError(err)
 	}
 
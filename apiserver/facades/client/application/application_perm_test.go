@@ -11,6 +11,7 @@ import (
 	gomock "go.uber.org/mock/gomock"
 
 	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/controller"
 	"github.com/juju/juju/core/errors"
 	applicationerrors "github.com/juju/juju/domain/application/errors"
 	"github.com/juju/juju/rpc/params"
@@ -130,6 +131,7 @@ func (s *permBaseSuite) TestSetCharmValidOrigin(c *tc.C) {
 	s.expectAnyChangeOrRemoval()
 	s.expectHasWritePermission()
 
+	s.expectControllerConfig(controller.Config{})
 	s.applicationService.EXPECT().SetApplicationCharm(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(applicationerrors.ApplicationNotFound)
 
@@ -14,8 +14,10 @@ import (
 	"github.com/juju/names/v6"
 	"github.com/juju/tc"
 	gomock "go.uber.org/mock/gomock"
+	"gopkg.in/macaroon.v2"
 
 	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/controller"
 	"github.com/juju/juju/core/application"
 	applicationtesting "github.com/juju/juju/core/application/testing"
 	corearch "github.com/juju/juju/core/arch"
@@ -29,17 +31,21 @@ import (
 	relationtesting "github.com/juju/juju/core/relation/testing"
 	"github.com/juju/juju/core/resource"
 	"github.com/juju/juju/core/resource/testing"
+	corestorage "github.com/juju/juju/core/storage"
 	coreunit "github.com/juju/juju/core/unit"
 	domainapplication "github.com/juju/juju/domain/application"
 	"github.com/juju/juju/domain/application/architecture"
 	applicationcharm "github.com/juju/juju/domain/application/charm"
 	applicationerrors "github.com/juju/juju/domain/application/errors"
 	applicationservice "github.com/juju/juju/domain/application/service"
+	"github.com/juju/juju/domain/crossmodelrelation"
+	crossmodelrelationerrors "github.com/juju/juju/domain/crossmodelrelation/errors"
 	"github.com/juju/juju/domain/relation"
 	relationerrors "github.com/juju/juju/domain/relation/errors"
 	"github.com/juju/juju/domain/removal"
 	"github.com/juju/juju/domain/resolve"
 	resolveerrors "github.com/juju/juju/domain/resolve/errors"
+	domainstorage "github.com/juju/juju/domain/storage"
 	"github.com/juju/juju/environs/bootstrap"
 	internalcharm "github.com/juju/juju/internal/charm"
 	charmresource "github.com/juju/juju/internal/charm/resource"
@@ -68,6 +74,7 @@ func (s *applicationSuite) TestDeploy(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
 	s.setupAPI(c)
+	s.expectControllerConfig(controller.Config{})
 	s.expectCharm(c, "foo", nil)
 	s.expectCreateApplicationForDeploy("foo", nil)
 
@@ -105,6 +112,7 @@ func (s *applicationSuite) TestDeployWithPendingResources(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
 	s.setupAPI(c)
+	s.expectControllerConfig(controller.Config{})
 	resourceUUID := testing.GenResourceUUID(c)
 	s.expectCharm(c, "foo", map[string]charmresource.Meta{
 		"bar": {
@@ -143,6 +151,7 @@ func (s *applicationSuite) TestDeployWithApplicationConfig(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
 	s.setupAPI(c)
+	s.expectControllerConfig(controller.Config{})
 	s.expectCharm(c, "foo", nil)
 	config := map[string]interface{}{"stringOption": "hey"}
 	s.expectCreateApplicationForDeployWithConfig(c, "foo", config, nil)
@@ -177,6 +186,7 @@ func (s *applicationSuite) TestDeployFailureDeletesPendingResources(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
 	s.setupAPI(c)
+	s.expectControllerConfig(controller.Config{})
 	s.expectCharm(c, "foo", map[string]charmresource.Meta{
 		"bar": {
 			Name: "bar",
@@ -624,6 +634,63 @@ func (s *applicationSuite) TestAddRelation(c *tc.C) {
 	})
 }
 
+func (s *applicationSuite) TestAddRelationViaCIDRs(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// Arrange:
+	s.setupAPI(c)
+	ep1 := relation.Endpoint{
+		ApplicationName: "mattermost",
+		Relation: internalcharm.Relation{
+			Name:      "db",
+			Role:      internalcharm.RoleRequirer,
+			Interface: "db",
+			Scope:     internalcharm.ScopeGlobal,
+		},
+	}
+	ep2 := relation.Endpoint{
+		ApplicationName: "postgresql",
+		Relation: internalcharm.Relation{
+			Name:      "db",
+			Role:      internalcharm.RoleProvider,
+			Interface: "db",
+			Scope:     internalcharm.ScopeGlobal,
+		},
+	}
+	s.relationService.EXPECT().AddRelation(gomock.Any(), "mattermost", "postgresql:db").Return(
+		ep1, ep2, nil,
+	)
+	relUUID := relationtesting.GenRelationUUID(c)
+	key := tc.Must1(c, corerelation.NewKeyFromString, "mattermost:db postgresql:db")
+	s.relationService.EXPECT().GetRelationUUIDByKey(gomock.Any(), key).Return(relUUID, nil)
+	s.crossModelRelationService.EXPECT().AddRelationNetworkEgress(gomock.Any(), relUUID, "10.0.0.0/24").Return(nil)
+
+	// Act:
+	_, err := s.api.AddRelation(c.Context(), params.AddRelation{
+		Endpoints: []string{"mattermost", "postgresql:db"},
+		ViaCIDRs:  []string{"10.0.0.0/24"},
+	})
+
+	// Assert:
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *applicationSuite) TestAddRelationViaCIDRsNotValid(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// Arrange:
+	s.setupAPI(c)
+
+	// Act:
+	_, err := s.api.AddRelation(c.Context(), params.AddRelation{
+		Endpoints: []string{"mattermost", "postgresql:db"},
+		ViaCIDRs:  []string{"10.0.0.0"},
+	})
+
+	// Assert:
+	c.Assert(err, tc.ErrorIs, errors.NotValid)
+}
+
 func (s *applicationSuite) TestAddRelationError(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -761,20 +828,49 @@ func (s *applicationSuite) TestCharmConfig(c *tc.C) {
 	})
 }
 
-func (s *applicationSuite) TestSetConfigsYAMLNotImplemented(c *tc.C) {
+func (s *applicationSuite) TestSetConfigsYAML(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
 	s.setupAPI(c)
+	appID := applicationtesting.GenApplicationUUID(c)
+
+	s.applicationService.EXPECT().GetApplicationIDByName(gomock.Any(), "foo").Return(appID, nil)
+	s.applicationService.EXPECT().UpdateApplicationConfigWithYAML(
+		gomock.Any(), appID, "foo", "foo:\n  bar: 42\n", map[string]string{"baz": "qux"},
+	).Return(nil)
 
 	res, err := s.api.SetConfigs(c.Context(), params.ConfigSetArgs{
 		Args: []params.ConfigSet{{
 			ApplicationName: "foo",
-			ConfigYAML:      "foo: bar",
+			ConfigYAML:      "foo:\n  bar: 42\n",
+			Config:          map[string]string{"baz": "qux"},
 		}},
 	})
 	c.Assert(err, tc.ErrorIsNil)
 	c.Assert(res.Results, tc.HasLen, 1)
-	c.Assert(res.Results[0].Error, tc.Satisfies, params.IsCodeNotImplemented)
+	c.Assert(res.Results[0].Error, tc.IsNil)
+}
+
+func (s *applicationSuite) TestSetConfigsYAMLInvalidConfig(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+	appID := applicationtesting.GenApplicationUUID(c)
+
+	s.applicationService.EXPECT().GetApplicationIDByName(gomock.Any(), "foo").Return(appID, nil)
+	s.applicationService.EXPECT().UpdateApplicationConfigWithYAML(
+		gomock.Any(), appID, "foo", "bar:\n  bar: 42\n", gomock.Any(),
+	).Return(applicationerrors.InvalidApplicationConfig)
+
+	res, err := s.api.SetConfigs(c.Context(), params.ConfigSetArgs{
+		Args: []params.ConfigSet{{
+			ApplicationName: "foo",
+			ConfigYAML:      "bar:\n  bar: 42\n",
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(res.Results, tc.HasLen, 1)
+	c.Assert(res.Results[0].Error, tc.Satisfies, params.IsCodeNotValid)
 }
 
 func (s *applicationSuite) TestSetConfigsApplicationNotFound(c *tc.C) {
@@ -1309,13 +1405,40 @@ func (s *applicationSuite) TestConsume(c *tc.C) {
 	}
 	s.externalControllerService.EXPECT().UpdateExternalController(gomock.Any(), controllerInfo).Return(nil)
 
+	offerUUID := tc.Must(c, uuid.NewUUID).String()
+	mac, err := macaroon.New(nil, []byte("id"), "", macaroon.LatestVersion)
+	c.Assert(err, tc.ErrorIsNil)
+	s.crossModelRelationService.EXPECT().AddRemoteApplicationOfferer(gomock.Any(), "mysql", crossmodelrelation.AddRemoteApplicationOffererArgs{
+		OfferUUID:             offerUUID,
+		OffererControllerUUID: controllerUUID,
+		OffererModelUUID:      modelUUID,
+		Endpoints: []applicationcharm.Relation{{
+			Name:      "db",
+			Role:      applicationcharm.RoleProvider,
+			Interface: "mysql",
+			Limit:     1,
+			Scope:     applicationcharm.ScopeGlobal,
+		}},
+		Macaroon: mac,
+	}).Return(nil)
+
 	s.setupAPI(c)
 
 	results, err := s.api.Consume(c.Context(), params.ConsumeApplicationArgsV5{
 		Args: []params.ConsumeApplicationArgV5{{
 			ApplicationOfferDetailsV5: params.ApplicationOfferDetailsV5{
 				SourceModelTag: names.NewModelTag(modelUUID).String(),
+				OfferUUID:      offerUUID,
+				OfferName:      "hosted-mysql",
+				Endpoints: []params.RemoteEndpoint{{
+					Name:      "db",
+					Role:      "provider",
+					Interface: "mysql",
+					Limit:     1,
+				}},
 			},
+			Macaroon:         mac,
+			ApplicationAlias: "mysql",
 			ControllerInfo: &params.ExternalControllerInfo{
 				ControllerTag: names.NewControllerTag(controllerUUID).String(),
 				Alias:         "alias",
@@ -1335,12 +1458,20 @@ func (s *applicationSuite) TestConsumeNoExternalController(c *tc.C) {
 
 	modelUUID := tc.Must(c, uuid.NewUUID).String()
 
+	s.crossModelRelationService.EXPECT().AddRemoteApplicationOfferer(gomock.Any(), "mysql", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, args crossmodelrelation.AddRemoteApplicationOffererArgs) error {
+			c.Check(args.OffererControllerUUID, tc.Equals, s.controllerUUID)
+			c.Check(args.OffererModelUUID, tc.Equals, modelUUID)
+			return nil
+		})
+
 	s.setupAPI(c)
 
 	results, err := s.api.Consume(c.Context(), params.ConsumeApplicationArgsV5{
 		Args: []params.ConsumeApplicationArgV5{{
 			ApplicationOfferDetailsV5: params.ApplicationOfferDetailsV5{
 				SourceModelTag: names.NewModelTag(modelUUID).String(),
+				OfferName:      "mysql",
 			},
 		}},
 	})
@@ -1358,12 +1489,19 @@ func (s *applicationSuite) TestConsumeSameController(c *tc.C) {
 
 	modelUUID := tc.Must(c, uuid.NewUUID).String()
 
+	s.crossModelRelationService.EXPECT().AddRemoteApplicationOfferer(gomock.Any(), "mysql", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, args crossmodelrelation.AddRemoteApplicationOffererArgs) error {
+			c.Check(args.OffererControllerUUID, tc.Equals, s.controllerUUID)
+			return nil
+		})
+
 	s.setupAPI(c)
 
 	results, err := s.api.Consume(c.Context(), params.ConsumeApplicationArgsV5{
 		Args: []params.ConsumeApplicationArgV5{{
 			ApplicationOfferDetailsV5: params.ApplicationOfferDetailsV5{
 				SourceModelTag: names.NewModelTag(modelUUID).String(),
+				OfferName:      "mysql",
 			},
 			ControllerInfo: &params.ExternalControllerInfo{
 				ControllerTag: names.NewControllerTag(s.controllerUUID).String(),
@@ -1379,6 +1517,72 @@ func (s *applicationSuite) TestConsumeSameController(c *tc.C) {
 	})
 }
 
+func (s *applicationSuite) TestConsumeAlreadyExists(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	modelUUID := tc.Must(c, uuid.NewUUID).String()
+
+	s.crossModelRelationService.EXPECT().AddRemoteApplicationOfferer(gomock.Any(), "mysql", gomock.Any()).
+		Return(applicationerrors.ApplicationAlreadyExists)
+
+	s.setupAPI(c)
+
+	results, err := s.api.Consume(c.Context(), params.ConsumeApplicationArgsV5{
+		Args: []params.ConsumeApplicationArgV5{{
+			ApplicationOfferDetailsV5: params.ApplicationOfferDetailsV5{
+				SourceModelTag: names.NewModelTag(modelUUID).String(),
+				OfferName:      "mysql",
+			},
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 1)
+	c.Check(results.Results[0].Error, tc.Satisfies, params.IsCodeAlreadyExists)
+}
+
+func (s *applicationSuite) TestDestroyConsumedApplications(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+
+	appID := applicationtesting.GenApplicationUUID(c)
+	s.applicationService.EXPECT().GetApplicationIDByName(gomock.Any(), "mysql").Return(appID, nil)
+	s.removalService.EXPECT().RemoveRemoteApplicationOfferer(gomock.Any(), appID, true, time.Minute).
+		Return(removal.UUID(tc.Must(c, uuid.NewUUID).String()), nil)
+
+	results, err := s.api.DestroyConsumedApplications(c.Context(), params.DestroyConsumedApplicationsParams{
+		Applications: []params.DestroyConsumedApplicationParams{{
+			ApplicationTag: names.NewApplicationTag("mysql").String(),
+			Force:          ptr(true),
+			MaxWait:        ptr(time.Minute),
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(results, tc.DeepEquals, params.ErrorResults{
+		Results: []params.ErrorResult{{}},
+	})
+}
+
+func (s *applicationSuite) TestDestroyConsumedApplicationsNotRemote(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+
+	appID := applicationtesting.GenApplicationUUID(c)
+	s.applicationService.EXPECT().GetApplicationIDByName(gomock.Any(), "mysql").Return(appID, nil)
+	s.removalService.EXPECT().RemoveRemoteApplicationOfferer(gomock.Any(), appID, false, time.Duration(0)).
+		Return("", crossmodelrelationerrors.RemoteApplicationNotFound)
+
+	results, err := s.api.DestroyConsumedApplications(c.Context(), params.DestroyConsumedApplicationsParams{
+		Applications: []params.DestroyConsumedApplicationParams{{
+			ApplicationTag: names.NewApplicationTag("mysql").String(),
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 1)
+	c.Check(results.Results[0].Error, tc.Satisfies, params.IsCodeNotFound)
+}
+
 func (s *applicationSuite) TestConsumeInvalidSourceModelTag(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -1404,6 +1608,103 @@ func (s *applicationSuite) TestConsumeInvalidSourceModelTag(c *tc.C) {
 	})
 }
 
+func (s *applicationSuite) TestGetApplicationStorage(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+
+	appID := applicationtesting.GenApplicationUUID(c)
+	poolUUID := domainstorage.StoragePoolUUID(tc.Must(c, uuid.NewUUID).String())
+	s.storageService.EXPECT().ListStoragePools(gomock.Any()).Return([]domainstorage.StoragePool{{
+		UUID: poolUUID.String(),
+		Name: "fast",
+	}}, nil)
+	s.applicationService.EXPECT().GetApplicationIDByName(gomock.Any(), "postgresql").Return(appID, nil)
+	s.applicationService.EXPECT().GetApplicationStorageDirectives(gomock.Any(), appID).Return([]domainapplication.StorageDirective{{
+		Name:     "pgdata",
+		PoolUUID: poolUUID,
+		Size:     1024,
+		Count:    2,
+	}}, nil)
+	s.applicationService.EXPECT().GetApplicationIDByName(gomock.Any(), "missing").Return("", applicationerrors.ApplicationNotFound)
+
+	result, err := s.api.GetApplicationStorage(c.Context(), params.Entities{
+		Entities: []params.Entity{
+			{Tag: "application-postgresql"},
+			{Tag: "application-missing"},
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Results, tc.HasLen, 2)
+	c.Check(result.Results[0], tc.DeepEquals, params.ApplicationStorageGetResult{
+		StorageConstraints: map[string]params.StorageDirectives{
+			"pgdata": {
+				Pool:    "fast",
+				SizeMiB: ptr[uint64](1024),
+				Count:   ptr[uint64](2),
+			},
+		},
+	})
+	c.Check(result.Results[1].Error, tc.Satisfies, params.IsCodeNotFound)
+}
+
+func (s *applicationSuite) TestUpdateApplicationStorage(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+
+	poolUUID := domainstorage.StoragePoolUUID(tc.Must(c, uuid.NewUUID).String())
+	s.storageService.EXPECT().GetStoragePoolUUID(gomock.Any(), "fast").Return(poolUUID, nil)
+	s.applicationService.EXPECT().UpdateApplicationStorageDirectives(gomock.Any(), "postgresql",
+		map[string]applicationservice.ApplicationStorageDirectiveOverride{
+			"pgdata": {
+				PoolUUID: &poolUUID,
+				Size:     ptr[uint64](2048),
+				Count:    ptr[uint32](3),
+			},
+		},
+	).Return(nil)
+
+	result, err := s.api.UpdateApplicationStorage(c.Context(), params.ApplicationStorageUpdateRequest{
+		ApplicationStorageUpdates: []params.ApplicationStorageUpdate{{
+			ApplicationTag: "application-postgresql",
+			StorageConstraints: map[string]params.StorageDirectives{
+				"pgdata": {
+					Pool:    "fast",
+					SizeMiB: ptr[uint64](2048),
+					Count:   ptr[uint64](3),
+				},
+			},
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.OneError(), tc.ErrorIsNil)
+}
+
+func (s *applicationSuite) TestUpdateApplicationStorageNotSupported(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+
+	s.applicationService.EXPECT().UpdateApplicationStorageDirectives(gomock.Any(), "postgresql",
+		map[string]applicationservice.ApplicationStorageDirectiveOverride{
+			"logs": {Size: ptr[uint64](10)},
+		},
+	).Return(applicationerrors.StorageNameNotSupported)
+
+	result, err := s.api.UpdateApplicationStorage(c.Context(), params.ApplicationStorageUpdateRequest{
+		ApplicationStorageUpdates: []params.ApplicationStorageUpdate{{
+			ApplicationTag: "application-postgresql",
+			StorageConstraints: map[string]params.StorageDirectives{
+				"logs": {SizeMiB: ptr[uint64](10)},
+			},
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Results, tc.HasLen, 1)
+	c.Check(result.Results[0].Error, tc.Satisfies, params.IsCodeNotSupported)
+}
+
 func (s *applicationSuite) setupAPI(c *tc.C) {
 	s.expectAuthClient()
 	s.expectAnyPermissions()
@@ -1483,3 +1784,66 @@ func (s *applicationSuite) expectRemoveRelation(c *tc.C, uuid corerelation.UUID,
 	rUUID, _ := removal.NewUUID()
 	s.removalService.EXPECT().RemoveRelation(gomock.Any(), uuid, force, maxWait).Return(rUUID, err)
 }
+
+func (s *applicationSuite) TestScaleApplicationsAttachStorage(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.setupCAASAPI(c)
+
+	s.applicationService.EXPECT().AddCAASUnitAttachingStorage(gomock.Any(), "postgresql", []corestorage.ID{"pgdata/0"}).
+		Return(coreunit.Name("postgresql/2"), 3, nil)
+
+	result, err := s.api.ScaleApplications(c.Context(), params.ScaleApplicationsParamsV2{
+		Applications: []params.ScaleApplicationParamsV2{{
+			ApplicationTag: names.NewApplicationTag("postgresql").String(),
+			ScaleChange:    1,
+			AttachStorage:  []string{"pgdata/0"},
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Results, tc.HasLen, 1)
+	c.Assert(result.Results[0].Error, tc.IsNil)
+	c.Check(result.Results[0].Info.Scale, tc.Equals, 3)
+}
+
+func (s *applicationSuite) TestScaleApplicationsAttachStorageNotValid(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.setupCAASAPI(c)
+
+	s.applicationService.EXPECT().AddCAASUnitAttachingStorage(gomock.Any(), "postgresql", []corestorage.ID{"pgdata/0"}).
+		Return("", -1, applicationerrors.StoragePoolNotMatching)
+
+	result, err := s.api.ScaleApplications(c.Context(), params.ScaleApplicationsParamsV2{
+		Applications: []params.ScaleApplicationParamsV2{{
+			ApplicationTag: names.NewApplicationTag("postgresql").String(),
+			ScaleChange:    1,
+			AttachStorage:  []string{"pgdata/0"},
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Results, tc.HasLen, 1)
+	c.Check(result.Results[0].Error, tc.Satisfies, params.IsCodeNotValid)
+}
+
+func (s *applicationSuite) TestScaleApplicationsAttachStorageMultipleUnits(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.setupCAASAPI(c)
+
+	result, err := s.api.ScaleApplications(c.Context(), params.ScaleApplicationsParamsV2{
+		Applications: []params.ScaleApplicationParamsV2{{
+			ApplicationTag: names.NewApplicationTag("postgresql").String(),
+			ScaleChange:    2,
+			AttachStorage:  []string{"pgdata/0"},
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Results, tc.HasLen, 1)
+	c.Check(result.Results[0].Error, tc.ErrorMatches, `.*AttachStorage is non-empty, but NumUnits is 2`)
+}
+
+func (s *applicationSuite) setupCAASAPI(c *tc.C) {
+	s.expectAuthClient()
+	s.expectAnyPermissions()
+	s.expectAnyChangeOrRemoval()
+
+	s.newCAASAPI(c)
+}
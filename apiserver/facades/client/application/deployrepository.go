@@ -165,13 +165,16 @@ func (api *DeployFromRepositoryAPI) DeployFromRepository(ctx context.Context, ar
 // resolveResources resolves and maps resources for deployment, handling input
 // resource revisions or files. It validates existence in the charm repository
 // and returns resolved resource details or an error.  Errors are not terminal,
-// and will be collected and returned altogether.
+// and will be collected and returned altogether. OCI image resources are
+// checked against the verifier, using the provided signatures.
 func (v *deployFromRepositoryValidator) resolveResources(
 	ctx context.Context,
 	curl *charm.URL,
 	origin corecharm.Origin,
 	deployResArg map[string]string,
 	resMeta map[string]resource.Meta,
+	verifier signatureVerifier,
+	resourceSignatures map[string]string,
 ) (applicationservice.ResolvedResources, []*params.PendingResourceUpload, error) {
 	var resourcesToUpload []*params.PendingResourceUpload
 	var resources []resource.Resource
@@ -190,6 +193,13 @@ func (v *deployFromRepositoryValidator) resolveResources(
 				// A file is coming from the client.
 				r.Origin = resource.OriginUpload
 
+				// The content isn't known until after the application is
+				// deployed, so it can't be verified.
+				if verifier.required && meta.Type == resource.TypeContainerImage {
+					return nil, nil, rejectedBySignaturePolicy(fmt.Sprintf("resource %q", name),
+						errors.New("uploaded OCI image resources can't be verified when deploying from a repository"))
+				}
+
 				// Record resources that the client needs to upload.
 				resourcesToUpload = append(resourcesToUpload, &params.PendingResourceUpload{
 					Name:     meta.Name,
@@ -217,6 +227,11 @@ func (v *deployFromRepositoryValidator) resolveResources(
 	// Convert it in resolved resources.
 	result := make(applicationservice.ResolvedResources, 0, len(resolvedResources))
 	for _, res := range resolvedResources {
+		if resMeta[res.Name].Type == resource.TypeContainerImage {
+			if err := verifier.verifyResource(res.Name, res.Fingerprint, resourceSignatures[res.Name]); err != nil {
+				return nil, nil, errors.Trace(err)
+			}
+		}
 		var revision *int
 		if res.Revision >= 0 {
 			revision = &res.Revision
@@ -252,25 +267,27 @@ type deployTemplate struct {
 }
 
 type validatorConfig struct {
-	charmhubHTTPClient facade.HTTPClient
-	caasBroker         CaasBrokerInterface
-	modelInfo          model.ModelInfo
-	modelConfigService ModelConfigService
-	applicationService ApplicationService
-	machineService     MachineService
-	registry           storage.ProviderRegistry
-	storageService     StorageService
-	logger             corelogger.Logger
+	charmhubHTTPClient      facade.HTTPClient
+	caasBroker              CaasBrokerInterface
+	modelInfo               model.ModelInfo
+	controllerConfigService ControllerConfigService
+	modelConfigService      ModelConfigService
+	applicationService      ApplicationService
+	machineService          MachineService
+	registry                storage.ProviderRegistry
+	storageService          StorageService
+	logger                  corelogger.Logger
 }
 
 func makeDeployFromRepositoryValidator(ctx context.Context, cfg validatorConfig) DeployFromRepositoryValidator {
 	v := &deployFromRepositoryValidator{
-		charmhubHTTPClient: cfg.charmhubHTTPClient,
-		modelInfo:          cfg.modelInfo,
-		modelConfigService: cfg.modelConfigService,
-		applicationService: cfg.applicationService,
-		machineService:     cfg.machineService,
-		storageService:     cfg.storageService,
+		charmhubHTTPClient:      cfg.charmhubHTTPClient,
+		modelInfo:               cfg.modelInfo,
+		controllerConfigService: cfg.controllerConfigService,
+		modelConfigService:      cfg.modelConfigService,
+		applicationService:      cfg.applicationService,
+		machineService:          cfg.machineService,
+		storageService:          cfg.storageService,
 		newCharmHubRepository: func(cfg repository.CharmHubRepositoryConfig) (corecharm.Repository, error) {
 			return repository.NewCharmHubRepository(cfg)
 		},
@@ -303,11 +320,12 @@ func makeDeployFromRepositoryValidator(ctx context.Context, cfg validatorConfig)
 }
 
 type deployFromRepositoryValidator struct {
-	modelInfo          model.ModelInfo
-	modelConfigService ModelConfigService
-	applicationService ApplicationService
-	machineService     MachineService
-	storageService     StorageService
+	modelInfo               model.ModelInfo
+	controllerConfigService ControllerConfigService
+	modelConfigService      ModelConfigService
+	applicationService      ApplicationService
+	machineService          MachineService
+	storageService          StorageService
 
 	// For testing using mocks.
 	newCharmHubRepository func(repository.CharmHubRepositoryConfig) (corecharm.Repository, error)
@@ -336,6 +354,8 @@ type deployFromRepositoryValidator struct {
 //   - NumUnits must be 1 if AttachedStorage used
 //   - CharmOrigin validation, see common.ValidateCharmOrigin
 //   - Manual deploy of juju-controller charm not allowed.
+//   - The charm and OCI image resources are signed by a trusted key, if
+//     required by the charm-signature-policy.
 //
 // IAAS specific (see iaasDeployFromRepositoryValidator)
 // CAAS specific (see caasDeployFromRepositoryValidator)
@@ -362,6 +382,14 @@ func (v *deployFromRepositoryValidator) validate(ctx context.Context, arg params
 		return deployTemplate{}, append(errs, err)
 	}
 
+	verifier, err := newSignatureVerifier(ctx, v.controllerConfigService)
+	if err != nil {
+		return deployTemplate{}, append(errs, err)
+	}
+	if err := verifier.verifyCharm(charmResult.Charm.Meta().Name, charmResult.Origin.Hash, arg.CharmSignature); err != nil {
+		errs = append(errs, err)
+	}
+
 	// Various checks of the resolved charm against the arg provided.
 	dt, rcErrs := v.resolvedCharmValidation(ctx, charmResult.Charm, arg)
 	if len(rcErrs) > 0 {
@@ -383,7 +411,7 @@ func (v *deployFromRepositoryValidator) validate(ctx context.Context, arg params
 	}
 
 	// Resolve resources and validate against the charm metadata.
-	resources, resourcesToUpload, resolveResErr := v.resolveResources(ctx, dt.charmURL, dt.origin, dt.resources, charmResult.Charm.Meta().Resources)
+	resources, resourcesToUpload, resolveResErr := v.resolveResources(ctx, dt.charmURL, dt.origin, dt.resources, charmResult.Charm.Meta().Resources, verifier, arg.ResourceSignatures)
 	if resolveResErr != nil {
 		errs = append(errs, resolveResErr)
 	}
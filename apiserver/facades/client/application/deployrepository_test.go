@@ -64,6 +64,8 @@ func (s *deployRepositorySuite) TestResolveResourcesNoResourcesOverride(c *tc.C)
 		origin,
 		map[string]string{},
 		resMeta,
+		signatureVerifier{},
+		nil,
 	)
 	c.Assert(err, tc.IsNil, tc.Commentf("(Act) unexpected error occurred"))
 
@@ -127,6 +129,8 @@ func (s *deployRepositorySuite) TestResolveResourcesWithResourcesWithOverride(c
 		origin,
 		deployResArg,
 		resMeta,
+		signatureVerifier{},
+		nil,
 	)
 	c.Assert(err, tc.IsNil, tc.Commentf("(Act) unexpected error occurred"))
 
@@ -152,7 +156,7 @@ func (s *deployRepositorySuite) TestResolveResourcesWithResourcesErrorWhileCharm
 	validator := s.expectValidator()
 
 	// Act
-	_, _, err := validator.resolveResources(c.Context(), charmURL, origin, map[string]string{}, resMeta)
+	_, _, err := validator.resolveResources(c.Context(), charmURL, origin, map[string]string{}, resMeta, signatureVerifier{}, nil)
 
 	// Assert
 	c.Check(err, tc.ErrorIs, mockRepoError,
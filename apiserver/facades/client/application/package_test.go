@@ -12,6 +12,7 @@ import (
 	gomock "go.uber.org/mock/gomock"
 
 	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/controller"
 	"github.com/juju/juju/core/model"
 	modeltesting "github.com/juju/juju/core/model/testing"
 	"github.com/juju/juju/core/permission"
@@ -20,7 +21,7 @@ import (
 	"github.com/juju/juju/internal/uuid"
 )
 
-//go:generate go run go.uber.org/mock/mockgen -typed -package application -destination services_mock_test.go github.com/juju/juju/apiserver/facades/client/application NetworkService,DeployFromRepository,BlockChecker,ModelConfigService,MachineService,ApplicationService,ResolveService,PortService,Leadership,StorageService,RelationService,ResourceService,RemovalService,ExternalControllerService
+//go:generate go run go.uber.org/mock/mockgen -typed -package application -destination services_mock_test.go github.com/juju/juju/apiserver/facades/client/application ControllerConfigService,NetworkService,DeployFromRepository,BlockChecker,ModelConfigService,MachineService,ApplicationService,ResolveService,PortService,Leadership,StorageService,RelationService,ResourceService,RemovalService,ExternalControllerService,CrossModelRelationService
 //go:generate go run go.uber.org/mock/mockgen -typed -package application -destination legacy_mock_test.go github.com/juju/juju/apiserver/facades/client/application CaasBrokerInterface
 //go:generate go run go.uber.org/mock/mockgen -typed -package application -destination objectstore_mock_test.go github.com/juju/juju/core/objectstore ObjectStore
 //go:generate go run go.uber.org/mock/mockgen -typed -package application -destination storage_mock_test.go github.com/juju/juju/internal/storage ProviderRegistry
@@ -33,7 +34,9 @@ type baseSuite struct {
 
 	api *APIBase
 
+	controllerConfigService   *MockControllerConfigService
 	externalControllerService *MockExternalControllerService
+	crossModelRelationService *MockCrossModelRelationService
 	applicationService        *MockApplicationService
 	resolveService            *MockResolveService
 	machineService            *MockMachineService
@@ -66,7 +69,9 @@ type baseSuite struct {
 func (s *baseSuite) setupMocks(c *tc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 
+	s.controllerConfigService = NewMockControllerConfigService(ctrl)
 	s.externalControllerService = NewMockExternalControllerService(ctrl)
+	s.crossModelRelationService = NewMockCrossModelRelationService(ctrl)
 	s.applicationService = NewMockApplicationService(ctrl)
 	s.resolveService = NewMockResolveService(ctrl)
 	s.machineService = NewMockMachineService(ctrl)
@@ -110,6 +115,10 @@ func (s *baseSuite) expectAnyPermissions() {
 	s.authorizer.EXPECT().HasPermission(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 }
 
+func (s *baseSuite) expectControllerConfig(cfg controller.Config) {
+	s.controllerConfigService.EXPECT().ControllerConfig(gomock.Any()).Return(cfg, nil)
+}
+
 func (s *baseSuite) expectDisallowBlockChange() {
 	s.blockChecker.EXPECT().ChangeAllowed(gomock.Any()).Return(fmt.Errorf("blocked"))
 }
@@ -137,7 +146,9 @@ func (s *baseSuite) newAPI(c *tc.C, modelType model.ModelType) {
 	var err error
 	s.api, err = NewAPIBase(
 		Services{
+			ControllerConfigService:   s.controllerConfigService,
 			ExternalControllerService: s.externalControllerService,
+			CrossModelRelationService: s.crossModelRelationService,
 			NetworkService:            s.networkService,
 			ModelConfigService:        s.modelConfigService,
 			MachineService:            s.machineService,
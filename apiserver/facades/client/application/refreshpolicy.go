@@ -0,0 +1,123 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/domain/application"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	internalerrors "github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+// GetRefreshPolicies returns the charm refresh policies of the specified
+// applications.
+func (api *APIBase) GetRefreshPolicies(ctx context.Context, args params.Entities) (params.ApplicationRefreshPolicyResults, error) {
+	results := params.ApplicationRefreshPolicyResults{
+		Results: make([]params.ApplicationRefreshPolicyResult, len(args.Entities)),
+	}
+	if err := api.checkCanRead(ctx); err != nil {
+		return results, errors.Trace(err)
+	}
+
+	for i, entity := range args.Entities {
+		policy, err := api.getOneRefreshPolicy(ctx, entity.Tag)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i].Result = policy
+	}
+	return results, nil
+}
+
+func (api *APIBase) getOneRefreshPolicy(ctx context.Context, tag string) (*params.ApplicationRefreshPolicy, error) {
+	appTag, err := names.ParseApplicationTag(tag)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	policy, err := api.applicationService.GetApplicationRefreshPolicy(ctx, appTag.Name)
+	if errors.Is(err, applicationerrors.ApplicationNotFound) {
+		return nil, errors.NotFoundf("application %q", appTag.Name)
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	result := &params.ApplicationRefreshPolicy{
+		ApplicationTag: appTag.String(),
+		Policy:         string(policy.Policy),
+	}
+	if policy.Window != nil {
+		result.Window = policy.Window.String()
+	}
+	return result, nil
+}
+
+// SetRefreshPolicies sets the charm refresh policies of the specified
+// applications. A maintenance window may only be given with the "auto"
+// policy.
+func (api *APIBase) SetRefreshPolicies(ctx context.Context, args params.ApplicationRefreshPolicies) (params.ErrorResults, error) {
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Policies)),
+	}
+	if err := api.checkCanWrite(ctx); err != nil {
+		return results, errors.Trace(err)
+	}
+	if err := api.check.ChangeAllowed(ctx); err != nil {
+		return results, errors.Trace(err)
+	}
+
+	for i, arg := range args.Policies {
+		if err := api.setOneRefreshPolicy(ctx, arg); err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+		}
+	}
+	return results, nil
+}
+
+func (api *APIBase) setOneRefreshPolicy(ctx context.Context, arg params.ApplicationRefreshPolicy) error {
+	appTag, err := names.ParseApplicationTag(arg.ApplicationTag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	policy, err := application.ParseRefreshPolicy(arg.Policy)
+	if err != nil {
+		return internalerrors.Errorf("%w%w", err, errors.Hide(errors.NotValid))
+	}
+	refreshPolicy := application.ApplicationRefreshPolicy{
+		Policy: policy,
+	}
+	if arg.Window != "" {
+		window, err := application.ParseMaintenanceWindow(arg.Window)
+		if err != nil {
+			return internalerrors.Errorf("%w%w", err, errors.Hide(errors.NotValid))
+		}
+		refreshPolicy.Window = &window
+	}
+
+	err = api.applicationService.SetApplicationRefreshPolicy(ctx, appTag.Name, refreshPolicy)
+	switch {
+	case errors.Is(err, applicationerrors.ApplicationNotFound):
+		return errors.NotFoundf("application %q", appTag.Name)
+	case errors.Is(err, applicationerrors.RefreshPolicyNotValid),
+		errors.Is(err, applicationerrors.MaintenanceWindowNotValid):
+		return internalerrors.Errorf("%w%w", err, errors.Hide(errors.NotValid))
+	case err != nil:
+		return errors.Trace(err)
+	}
+	return nil
+}
+
+// GetRefreshPolicies isn't on the v22 API.
+func (api *APIv22) GetRefreshPolicies(_ context.Context, _ struct{}) {}
+
+// SetRefreshPolicies isn't on the v22 API.
+func (api *APIv22) SetRefreshPolicies(_ context.Context, _ struct{}) {}
@@ -0,0 +1,101 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"time"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	domainapplication "github.com/juju/juju/domain/application"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/rpc/params"
+)
+
+func (s *applicationSuite) TestGetRefreshPolicies(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+
+	s.applicationService.EXPECT().GetApplicationRefreshPolicy(gomock.Any(), "foo").Return(domainapplication.ApplicationRefreshPolicy{
+		Policy: domainapplication.RefreshPolicyAuto,
+		Window: &domainapplication.MaintenanceWindow{Start: 2 * time.Hour, End: 4 * time.Hour},
+	}, nil)
+	s.applicationService.EXPECT().GetApplicationRefreshPolicy(gomock.Any(), "bar").Return(
+		domainapplication.ApplicationRefreshPolicy{}, applicationerrors.ApplicationNotFound)
+
+	res, err := s.api.GetRefreshPolicies(c.Context(), params.Entities{
+		Entities: []params.Entity{{Tag: "application-foo"}, {Tag: "application-bar"}, {Tag: "unit-foo-0"}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(res.Results, tc.HasLen, 3)
+	c.Check(res.Results[0], tc.DeepEquals, params.ApplicationRefreshPolicyResult{
+		Result: &params.ApplicationRefreshPolicy{
+			ApplicationTag: "application-foo",
+			Policy:         "auto",
+			Window:         "02:00-04:00",
+		},
+	})
+	c.Check(res.Results[1].Error, tc.Satisfies, params.IsCodeNotFound)
+	c.Check(res.Results[2].Error, tc.ErrorMatches, `"unit-foo-0" is not a valid application tag`)
+}
+
+func (s *applicationSuite) TestSetRefreshPolicies(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+
+	s.applicationService.EXPECT().SetApplicationRefreshPolicy(gomock.Any(), "foo", domainapplication.ApplicationRefreshPolicy{
+		Policy: domainapplication.RefreshPolicyAuto,
+		Window: &domainapplication.MaintenanceWindow{Start: 23 * time.Hour, End: time.Hour},
+	}).Return(nil)
+	s.applicationService.EXPECT().SetApplicationRefreshPolicy(gomock.Any(), "bar", domainapplication.ApplicationRefreshPolicy{
+		Policy: domainapplication.RefreshPolicyNotify,
+	}).Return(applicationerrors.ApplicationNotFound)
+
+	res, err := s.api.SetRefreshPolicies(c.Context(), params.ApplicationRefreshPolicies{
+		Policies: []params.ApplicationRefreshPolicy{{
+			ApplicationTag: "application-foo",
+			Policy:         "auto",
+			Window:         "23:00-01:00",
+		}, {
+			ApplicationTag: "application-bar",
+			Policy:         "notify",
+		}, {
+			ApplicationTag: "application-baz",
+			Policy:         "sometimes",
+		}, {
+			ApplicationTag: "application-baz",
+			Policy:         "auto",
+			Window:         "2am-4am",
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(res.Results, tc.HasLen, 4)
+	c.Check(res.Results[0].Error, tc.IsNil)
+	c.Check(res.Results[1].Error, tc.Satisfies, params.IsCodeNotFound)
+	c.Check(res.Results[2].Error, tc.Satisfies, params.IsCodeNotValid)
+	c.Check(res.Results[3].Error, tc.Satisfies, params.IsCodeNotValid)
+}
+
+func (s *applicationSuite) TestSetRefreshPoliciesWindowNotValid(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+
+	s.applicationService.EXPECT().SetApplicationRefreshPolicy(gomock.Any(), "foo", gomock.Any()).
+		Return(applicationerrors.MaintenanceWindowNotValid)
+
+	res, err := s.api.SetRefreshPolicies(c.Context(), params.ApplicationRefreshPolicies{
+		Policies: []params.ApplicationRefreshPolicy{{
+			ApplicationTag: "application-foo",
+			Policy:         "notify",
+			Window:         "02:00-04:00",
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(res.Results, tc.HasLen, 1)
+	c.Check(res.Results[0].Error, tc.Satisfies, params.IsCodeNotValid)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/juju/errors"
 
 	"github.com/juju/juju/cloud"
+	"github.com/juju/juju/controller"
 	coreapplication "github.com/juju/juju/core/application"
 	"github.com/juju/juju/core/assumes"
 	"github.com/juju/juju/core/base"
@@ -24,11 +25,13 @@ import (
 	"github.com/juju/juju/core/network"
 	corerelation "github.com/juju/juju/core/relation"
 	coreresource "github.com/juju/juju/core/resource"
+	corestorage "github.com/juju/juju/core/storage"
 	"github.com/juju/juju/core/unit"
 	"github.com/juju/juju/core/watcher"
 	"github.com/juju/juju/domain/application"
 	applicationcharm "github.com/juju/juju/domain/application/charm"
 	applicationservice "github.com/juju/juju/domain/application/service"
+	"github.com/juju/juju/domain/crossmodelrelation"
 	"github.com/juju/juju/domain/relation"
 	"github.com/juju/juju/domain/removal"
 	"github.com/juju/juju/domain/resolve"
@@ -39,7 +42,9 @@ import (
 
 // Services represents all the services that the application facade requires.
 type Services struct {
+	ControllerConfigService   ControllerConfigService
 	ExternalControllerService ExternalControllerService
+	CrossModelRelationService CrossModelRelationService
 	ApplicationService        ApplicationService
 	ResolveService            ResolveService
 	MachineService            MachineService
@@ -54,9 +59,15 @@ type Services struct {
 
 // Validate checks that all the services are set.
 func (s Services) Validate() error {
+	if s.ControllerConfigService == nil {
+		return errors.NotValidf("empty ControllerConfigService")
+	}
 	if s.ExternalControllerService == nil {
 		return errors.NotValidf("empty ExternalControllerService")
 	}
+	if s.CrossModelRelationService == nil {
+		return errors.NotValidf("empty CrossModelRelationService")
+	}
 	if s.NetworkService == nil {
 		return errors.NotValidf("empty NetworkService")
 	}
@@ -90,6 +101,12 @@ func (s Services) Validate() error {
 	return nil
 }
 
+// ControllerConfigService provides access to the controller configuration.
+type ControllerConfigService interface {
+	// ControllerConfig returns the current controller configuration.
+	ControllerConfig(context.Context) (controller.Config, error)
+}
+
 // ExternalControllerService provides a subset of the external controller domain
 // service methods.
 type ExternalControllerService interface {
@@ -98,6 +115,23 @@ type ExternalControllerService interface {
 	UpdateExternalController(ctx context.Context, ec crossmodel.ControllerInfo) error
 }
 
+// CrossModelRelationService provides a subset of the cross model relation
+// domain service methods.
+type CrossModelRelationService interface {
+	// AddRemoteApplicationOfferer adds a new synthetic application
+	// representing an offer from an external model, to be consumed by this
+	// model.
+	AddRemoteApplicationOfferer(
+		ctx context.Context,
+		applicationName string,
+		args crossmodelrelation.AddRemoteApplicationOffererArgs,
+	) error
+
+	// AddRelationNetworkEgress records the input CIDRs as the egress networks
+	// of the relation with the input UUID.
+	AddRelationNetworkEgress(ctx context.Context, relationUUID corerelation.UUID, cidrs ...string) error
+}
+
 // CredentialService provides access to credentials.
 type CredentialService interface {
 	// CloudCredential returns the cloud credential for the given tag.
@@ -163,6 +197,11 @@ type ApplicationService interface {
 	// amount, returning the new amount. This is used on CAAS models.
 	ChangeApplicationScale(ctx context.Context, name string, scaleChange int) (int, error)
 
+	// AddCAASUnitAttachingStorage adds a unit to the CAAS application with
+	// the specified existing storage attached, returning the name of the new
+	// unit and the new scale of the application.
+	AddCAASUnitAttachingStorage(ctx context.Context, name string, storageIDs []corestorage.ID) (unit.Name, int, error)
+
 	// GetApplicationLife looks up the life of the specified application.
 	GetApplicationLife(context.Context, coreapplication.ID) (life.Value, error)
 
@@ -216,6 +255,11 @@ type ApplicationService interface {
 	// source and revision.
 	GetCharmMetadata(ctx context.Context, locator applicationcharm.CharmLocator) (internalcharm.Meta, error)
 
+	// GetCharmArchiveSHA256 returns the SHA256 hash of the charm archive using
+	// the charm name, source and revision, whether or not the archive has been
+	// downloaded yet.
+	GetCharmArchiveSHA256(ctx context.Context, locator applicationcharm.CharmLocator) (string, error)
+
 	// GetCharmMetadataName returns the name for the charm using the
 	// charm name, source and revision.
 	GetCharmMetadataName(ctx context.Context, locator applicationcharm.CharmLocator) (string, error)
@@ -237,6 +281,23 @@ type ApplicationService interface {
 	// not found.
 	GetApplicationIDByName(ctx context.Context, name string) (coreapplication.ID, error)
 
+	// GetApplicationStorageDirectives returns the storage directives set for
+	// the specified application.
+	//
+	// Returns [applicationerrors.ApplicationNotFound] if the application is
+	// not found.
+	GetApplicationStorageDirectives(ctx context.Context, appID coreapplication.ID) ([]application.StorageDirective, error)
+
+	// UpdateApplicationStorageDirectives updates the storage directives of
+	// the named application, used for the storage of future units.
+	//
+	// Returns [applicationerrors.ApplicationNotFound] if the application is
+	// not found, and [applicationerrors.StorageNameNotSupported] if the charm
+	// doesn't define one of the named storage.
+	UpdateApplicationStorageDirectives(
+		ctx context.Context, appName string, overrides map[string]applicationservice.ApplicationStorageDirectiveOverride,
+	) error
+
 	// GetApplicationConstraints returns the application constraints for the
 	// specified application ID.
 	// Empty constraints are returned if no constraints exist for the given
@@ -245,6 +306,14 @@ type ApplicationService interface {
 	// [applicationerrors.ApplicationNotFound] is returned.
 	GetApplicationConstraints(ctx context.Context, appID coreapplication.ID) (constraints.Value, error)
 
+	// GetApplicationRefreshPolicy returns the charm refresh policy of the
+	// named application.
+	GetApplicationRefreshPolicy(ctx context.Context, appName string) (application.ApplicationRefreshPolicy, error)
+
+	// SetApplicationRefreshPolicy sets the charm refresh policy of the named
+	// application.
+	SetApplicationRefreshPolicy(ctx context.Context, appName string, policy application.ApplicationRefreshPolicy) error
+
 	// GetApplicationCharmOrigin returns the charm origin for the specified
 	// application name. If the application does not exist, an error satisfying
 	// [applicationerrors.ApplicationNotFound] is returned.
@@ -280,6 +349,19 @@ type ApplicationService interface {
 	// [applicationerrors.InvalidApplicationConfig] is returned.
 	UpdateApplicationConfig(context.Context, coreapplication.ID, map[string]string) error
 
+	// UpdateApplicationConfigWithYAML updates the application config with the
+	// settings found under the application name in the bundle style YAML,
+	// overridden by the specified string values. All settings are applied
+	// together.
+	// If no application is found, an error satisfying
+	// [applicationerrors.ApplicationNotFound] is returned.
+	// If the charm config is not valid, or the YAML has no settings for the
+	// application, an error satisfying
+	// [applicationerrors.InvalidApplicationConfig] is returned.
+	UpdateApplicationConfigWithYAML(
+		ctx context.Context, appID coreapplication.ID, appName string, configYAML string, newConfig map[string]string,
+	) error
+
 	// IsApplicationExposed returns whether the provided application is exposed or not.
 	//
 	// If no application is found, an error satisfying
@@ -378,12 +460,18 @@ type PortService interface {
 // service.
 type ResourceService interface {
 	DeleteResourcesAddedBeforeApplication(ctx context.Context, resources []coreresource.UUID) error
+
+	// GetResource returns the identified resource.
+	GetResource(ctx context.Context, resourceUUID coreresource.UUID) (coreresource.Resource, error)
 }
 
 // StorageService instances get a storage pool by name.
 type StorageService interface {
 	// GetStoragePoolUUID returns the UUID of the storage pool for the specified name.
 	GetStoragePoolUUID(context.Context, string) (domainstorage.StoragePoolUUID, error)
+
+	// ListStoragePools returns all the storage pools in the model.
+	ListStoragePools(context.Context) ([]domainstorage.StoragePool, error)
 }
 
 // BlockChecker defines the block-checking functionality required by
@@ -431,6 +519,13 @@ type RelationService interface {
 		ctx context.Context,
 		args relation.GetRelationUUIDForRemovalArgs,
 	) (corerelation.UUID, error)
+
+	// GetRelationUUIDByKey returns a relation UUID for the given Key.
+	//
+	// The following error types can be expected to be returned:
+	//   - [relationerrors.RelationNotFound] is returned if the relation key
+	//     is not found.
+	GetRelationUUIDByKey(ctx context.Context, relationKey corerelation.Key) (corerelation.UUID, error)
 }
 
 // RemovalService defines operations for removing juju entities.
@@ -456,6 +551,19 @@ type RemovalService interface {
 		wait time.Duration,
 	) (removal.UUID, error)
 
+	// RemoveRemoteApplicationOfferer checks if the application with the input
+	// UUID is a remote application offerer, representing an offer consumed
+	// from another model. If it is, it is removed in the same manner as any
+	// other application.
+	// [crossmodelrelationerrors.RemoteApplicationNotFound] is returned if no
+	// such remote application exists.
+	RemoveRemoteApplicationOfferer(
+		ctx context.Context,
+		appUUID coreapplication.ID,
+		force bool,
+		wait time.Duration,
+	) (removal.UUID, error)
+
 	// RemoveUnit checks if a unit with the input name exists.
 	// If it does, the unit is guaranteed after this call to be:
 	// - Not alive.
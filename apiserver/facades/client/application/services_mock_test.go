@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/facades/client/application (interfaces: NetworkService,DeployFromRepository,BlockChecker,ModelConfigService,MachineService,ApplicationService,ResolveService,PortService,Leadership,StorageService,RelationService,ResourceService,RemovalService,ExternalControllerService)
+// Source: github.com/juju/juju/apiserver/facades/client/application (interfaces: ControllerConfigService,NetworkService,DeployFromRepository,BlockChecker,ModelConfigService,MachineService,ApplicationService,ResolveService,PortService,Leadership,StorageService,RelationService,ResourceService,RemovalService,ExternalControllerService,CrossModelRelationService)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package application -destination services_mock_test.go github.com/juju/juju/apiserver/facades/client/application NetworkService,DeployFromRepository,BlockChecker,ModelConfigService,MachineService,ApplicationService,ResolveService,PortService,Leadership,StorageService,RelationService,ResourceService,RemovalService,ExternalControllerService
+//	mockgen -typed -package application -destination services_mock_test.go github.com/juju/juju/apiserver/facades/client/application ControllerConfigService,NetworkService,DeployFromRepository,BlockChecker,ModelConfigService,MachineService,ApplicationService,ResolveService,PortService,Leadership,StorageService,RelationService,ResourceService,RemovalService,ExternalControllerService,CrossModelRelationService
 //
 
 // Package application is a generated GoMock package.
@@ -15,6 +15,7 @@ import (
 	time "time"
 
 	set "github.com/juju/collections/set"
+	controller "github.com/juju/juju/controller"
 	application "github.com/juju/juju/core/application"
 	assumes "github.com/juju/juju/core/assumes"
 	base "github.com/juju/juju/core/base"
@@ -27,20 +28,84 @@ import (
 	network "github.com/juju/juju/core/network"
 	relation "github.com/juju/juju/core/relation"
 	resource "github.com/juju/juju/core/resource"
+	storage "github.com/juju/juju/core/storage"
 	unit "github.com/juju/juju/core/unit"
 	application0 "github.com/juju/juju/domain/application"
 	charm0 "github.com/juju/juju/domain/application/charm"
 	service "github.com/juju/juju/domain/application/service"
+	crossmodelrelation "github.com/juju/juju/domain/crossmodelrelation"
 	relation0 "github.com/juju/juju/domain/relation"
 	removal "github.com/juju/juju/domain/removal"
 	resolve "github.com/juju/juju/domain/resolve"
-	storage "github.com/juju/juju/domain/storage"
+	storage0 "github.com/juju/juju/domain/storage"
 	config "github.com/juju/juju/environs/config"
 	charm1 "github.com/juju/juju/internal/charm"
 	params "github.com/juju/juju/rpc/params"
 	gomock "go.uber.org/mock/gomock"
 )
 
+// MockControllerConfigService is a mock of ControllerConfigService interface.
+type MockControllerConfigService struct {
+	ctrl     *gomock.Controller
+	recorder *MockControllerConfigServiceMockRecorder
+}
+
+// MockControllerConfigServiceMockRecorder is the mock recorder for MockControllerConfigService.
+type MockControllerConfigServiceMockRecorder struct {
+	mock *MockControllerConfigService
+}
+
+// NewMockControllerConfigService creates a new mock instance.
+func NewMockControllerConfigService(ctrl *gomock.Controller) *MockControllerConfigService {
+	mock := &MockControllerConfigService{ctrl: ctrl}
+	mock.recorder = &MockControllerConfigServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockControllerConfigService) EXPECT() *MockControllerConfigServiceMockRecorder {
+	return m.recorder
+}
+
+// ControllerConfig mocks base method.
+func (m *MockControllerConfigService) ControllerConfig(arg0 context.Context) (controller.Config, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ControllerConfig", arg0)
+	ret0, _ := ret[0].(controller.Config)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ControllerConfig indicates an expected call of ControllerConfig.
+func (mr *MockControllerConfigServiceMockRecorder) ControllerConfig(arg0 any) *MockControllerConfigServiceControllerConfigCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ControllerConfig", reflect.TypeOf((*MockControllerConfigService)(nil).ControllerConfig), arg0)
+	return &MockControllerConfigServiceControllerConfigCall{Call: call}
+}
+
+// MockControllerConfigServiceControllerConfigCall wrap *gomock.Call
+type MockControllerConfigServiceControllerConfigCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockControllerConfigServiceControllerConfigCall) Return(arg0 controller.Config, arg1 error) *MockControllerConfigServiceControllerConfigCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockControllerConfigServiceControllerConfigCall) Do(f func(context.Context) (controller.Config, error)) *MockControllerConfigServiceControllerConfigCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockControllerConfigServiceControllerConfigCall) DoAndReturn(f func(context.Context) (controller.Config, error)) *MockControllerConfigServiceControllerConfigCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockNetworkService is a mock of NetworkService interface.
 type MockNetworkService struct {
 	ctrl     *gomock.Controller
@@ -529,6 +594,46 @@ func (m *MockApplicationService) EXPECT() *MockApplicationServiceMockRecorder {
 	return m.recorder
 }
 
+// AddCAASUnitAttachingStorage mocks base method.
+func (m *MockApplicationService) AddCAASUnitAttachingStorage(arg0 context.Context, arg1 string, arg2 []storage.ID) (unit.Name, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCAASUnitAttachingStorage", arg0, arg1, arg2)
+	ret0, _ := ret[0].(unit.Name)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AddCAASUnitAttachingStorage indicates an expected call of AddCAASUnitAttachingStorage.
+func (mr *MockApplicationServiceMockRecorder) AddCAASUnitAttachingStorage(arg0, arg1, arg2 any) *MockApplicationServiceAddCAASUnitAttachingStorageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCAASUnitAttachingStorage", reflect.TypeOf((*MockApplicationService)(nil).AddCAASUnitAttachingStorage), arg0, arg1, arg2)
+	return &MockApplicationServiceAddCAASUnitAttachingStorageCall{Call: call}
+}
+
+// MockApplicationServiceAddCAASUnitAttachingStorageCall wrap *gomock.Call
+type MockApplicationServiceAddCAASUnitAttachingStorageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceAddCAASUnitAttachingStorageCall) Return(arg0 unit.Name, arg1 int, arg2 error) *MockApplicationServiceAddCAASUnitAttachingStorageCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceAddCAASUnitAttachingStorageCall) Do(f func(context.Context, string, []storage.ID) (unit.Name, int, error)) *MockApplicationServiceAddCAASUnitAttachingStorageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceAddCAASUnitAttachingStorageCall) DoAndReturn(f func(context.Context, string, []storage.ID) (unit.Name, int, error)) *MockApplicationServiceAddCAASUnitAttachingStorageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // AddCAASUnits mocks base method.
 func (m *MockApplicationService) AddCAASUnits(arg0 context.Context, arg1 string, arg2 ...service.AddUnitArg) ([]unit.Name, error) {
 	m.ctrl.T.Helper()
@@ -1018,6 +1123,84 @@ func (c *MockApplicationServiceGetApplicationLifeCall) DoAndReturn(f func(contex
 	return c
 }
 
+// GetApplicationRefreshPolicy mocks base method.
+func (m *MockApplicationService) GetApplicationRefreshPolicy(arg0 context.Context, arg1 string) (application0.ApplicationRefreshPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationRefreshPolicy", arg0, arg1)
+	ret0, _ := ret[0].(application0.ApplicationRefreshPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationRefreshPolicy indicates an expected call of GetApplicationRefreshPolicy.
+func (mr *MockApplicationServiceMockRecorder) GetApplicationRefreshPolicy(arg0, arg1 any) *MockApplicationServiceGetApplicationRefreshPolicyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationRefreshPolicy", reflect.TypeOf((*MockApplicationService)(nil).GetApplicationRefreshPolicy), arg0, arg1)
+	return &MockApplicationServiceGetApplicationRefreshPolicyCall{Call: call}
+}
+
+// MockApplicationServiceGetApplicationRefreshPolicyCall wrap *gomock.Call
+type MockApplicationServiceGetApplicationRefreshPolicyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceGetApplicationRefreshPolicyCall) Return(arg0 application0.ApplicationRefreshPolicy, arg1 error) *MockApplicationServiceGetApplicationRefreshPolicyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceGetApplicationRefreshPolicyCall) Do(f func(context.Context, string) (application0.ApplicationRefreshPolicy, error)) *MockApplicationServiceGetApplicationRefreshPolicyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceGetApplicationRefreshPolicyCall) DoAndReturn(f func(context.Context, string) (application0.ApplicationRefreshPolicy, error)) *MockApplicationServiceGetApplicationRefreshPolicyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetApplicationStorageDirectives mocks base method.
+func (m *MockApplicationService) GetApplicationStorageDirectives(arg0 context.Context, arg1 application.ID) ([]application0.StorageDirective, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationStorageDirectives", arg0, arg1)
+	ret0, _ := ret[0].([]application0.StorageDirective)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationStorageDirectives indicates an expected call of GetApplicationStorageDirectives.
+func (mr *MockApplicationServiceMockRecorder) GetApplicationStorageDirectives(arg0, arg1 any) *MockApplicationServiceGetApplicationStorageDirectivesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationStorageDirectives", reflect.TypeOf((*MockApplicationService)(nil).GetApplicationStorageDirectives), arg0, arg1)
+	return &MockApplicationServiceGetApplicationStorageDirectivesCall{Call: call}
+}
+
+// MockApplicationServiceGetApplicationStorageDirectivesCall wrap *gomock.Call
+type MockApplicationServiceGetApplicationStorageDirectivesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceGetApplicationStorageDirectivesCall) Return(arg0 []application0.StorageDirective, arg1 error) *MockApplicationServiceGetApplicationStorageDirectivesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceGetApplicationStorageDirectivesCall) Do(f func(context.Context, application.ID) ([]application0.StorageDirective, error)) *MockApplicationServiceGetApplicationStorageDirectivesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceGetApplicationStorageDirectivesCall) DoAndReturn(f func(context.Context, application.ID) ([]application0.StorageDirective, error)) *MockApplicationServiceGetApplicationStorageDirectivesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetCharm mocks base method.
 func (m *MockApplicationService) GetCharm(arg0 context.Context, arg1 charm0.CharmLocator) (charm1.Charm, charm0.CharmLocator, bool, error) {
 	m.ctrl.T.Helper()
@@ -1059,6 +1242,45 @@ func (c *MockApplicationServiceGetCharmCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// GetCharmArchiveSHA256 mocks base method.
+func (m *MockApplicationService) GetCharmArchiveSHA256(arg0 context.Context, arg1 charm0.CharmLocator) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCharmArchiveSHA256", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCharmArchiveSHA256 indicates an expected call of GetCharmArchiveSHA256.
+func (mr *MockApplicationServiceMockRecorder) GetCharmArchiveSHA256(arg0, arg1 any) *MockApplicationServiceGetCharmArchiveSHA256Call {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCharmArchiveSHA256", reflect.TypeOf((*MockApplicationService)(nil).GetCharmArchiveSHA256), arg0, arg1)
+	return &MockApplicationServiceGetCharmArchiveSHA256Call{Call: call}
+}
+
+// MockApplicationServiceGetCharmArchiveSHA256Call wrap *gomock.Call
+type MockApplicationServiceGetCharmArchiveSHA256Call struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceGetCharmArchiveSHA256Call) Return(arg0 string, arg1 error) *MockApplicationServiceGetCharmArchiveSHA256Call {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceGetCharmArchiveSHA256Call) Do(f func(context.Context, charm0.CharmLocator) (string, error)) *MockApplicationServiceGetCharmArchiveSHA256Call {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceGetCharmArchiveSHA256Call) DoAndReturn(f func(context.Context, charm0.CharmLocator) (string, error)) *MockApplicationServiceGetCharmArchiveSHA256Call {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetCharmDownloadInfo mocks base method.
 func (m *MockApplicationService) GetCharmDownloadInfo(arg0 context.Context, arg1 charm0.CharmLocator) (*charm0.DownloadInfo, error) {
 	m.ctrl.T.Helper()
@@ -1874,6 +2096,44 @@ func (c *MockApplicationServiceSetApplicationConstraintsCall) DoAndReturn(f func
 	return c
 }
 
+// SetApplicationRefreshPolicy mocks base method.
+func (m *MockApplicationService) SetApplicationRefreshPolicy(arg0 context.Context, arg1 string, arg2 application0.ApplicationRefreshPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetApplicationRefreshPolicy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetApplicationRefreshPolicy indicates an expected call of SetApplicationRefreshPolicy.
+func (mr *MockApplicationServiceMockRecorder) SetApplicationRefreshPolicy(arg0, arg1, arg2 any) *MockApplicationServiceSetApplicationRefreshPolicyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetApplicationRefreshPolicy", reflect.TypeOf((*MockApplicationService)(nil).SetApplicationRefreshPolicy), arg0, arg1, arg2)
+	return &MockApplicationServiceSetApplicationRefreshPolicyCall{Call: call}
+}
+
+// MockApplicationServiceSetApplicationRefreshPolicyCall wrap *gomock.Call
+type MockApplicationServiceSetApplicationRefreshPolicyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceSetApplicationRefreshPolicyCall) Return(arg0 error) *MockApplicationServiceSetApplicationRefreshPolicyCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceSetApplicationRefreshPolicyCall) Do(f func(context.Context, string, application0.ApplicationRefreshPolicy) error) *MockApplicationServiceSetApplicationRefreshPolicyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceSetApplicationRefreshPolicyCall) DoAndReturn(f func(context.Context, string, application0.ApplicationRefreshPolicy) error) *MockApplicationServiceSetApplicationRefreshPolicyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SetApplicationScale mocks base method.
 func (m *MockApplicationService) SetApplicationScale(arg0 context.Context, arg1 string, arg2 int) error {
 	m.ctrl.T.Helper()
@@ -2026,6 +2286,82 @@ func (c *MockApplicationServiceUpdateApplicationConfigCall) DoAndReturn(f func(c
 	return c
 }
 
+// UpdateApplicationConfigWithYAML mocks base method.
+func (m *MockApplicationService) UpdateApplicationConfigWithYAML(arg0 context.Context, arg1 application.ID, arg2, arg3 string, arg4 map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateApplicationConfigWithYAML", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateApplicationConfigWithYAML indicates an expected call of UpdateApplicationConfigWithYAML.
+func (mr *MockApplicationServiceMockRecorder) UpdateApplicationConfigWithYAML(arg0, arg1, arg2, arg3, arg4 any) *MockApplicationServiceUpdateApplicationConfigWithYAMLCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateApplicationConfigWithYAML", reflect.TypeOf((*MockApplicationService)(nil).UpdateApplicationConfigWithYAML), arg0, arg1, arg2, arg3, arg4)
+	return &MockApplicationServiceUpdateApplicationConfigWithYAMLCall{Call: call}
+}
+
+// MockApplicationServiceUpdateApplicationConfigWithYAMLCall wrap *gomock.Call
+type MockApplicationServiceUpdateApplicationConfigWithYAMLCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceUpdateApplicationConfigWithYAMLCall) Return(arg0 error) *MockApplicationServiceUpdateApplicationConfigWithYAMLCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceUpdateApplicationConfigWithYAMLCall) Do(f func(context.Context, application.ID, string, string, map[string]string) error) *MockApplicationServiceUpdateApplicationConfigWithYAMLCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceUpdateApplicationConfigWithYAMLCall) DoAndReturn(f func(context.Context, application.ID, string, string, map[string]string) error) *MockApplicationServiceUpdateApplicationConfigWithYAMLCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateApplicationStorageDirectives mocks base method.
+func (m *MockApplicationService) UpdateApplicationStorageDirectives(arg0 context.Context, arg1 string, arg2 map[string]service.ApplicationStorageDirectiveOverride) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateApplicationStorageDirectives", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateApplicationStorageDirectives indicates an expected call of UpdateApplicationStorageDirectives.
+func (mr *MockApplicationServiceMockRecorder) UpdateApplicationStorageDirectives(arg0, arg1, arg2 any) *MockApplicationServiceUpdateApplicationStorageDirectivesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateApplicationStorageDirectives", reflect.TypeOf((*MockApplicationService)(nil).UpdateApplicationStorageDirectives), arg0, arg1, arg2)
+	return &MockApplicationServiceUpdateApplicationStorageDirectivesCall{Call: call}
+}
+
+// MockApplicationServiceUpdateApplicationStorageDirectivesCall wrap *gomock.Call
+type MockApplicationServiceUpdateApplicationStorageDirectivesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceUpdateApplicationStorageDirectivesCall) Return(arg0 error) *MockApplicationServiceUpdateApplicationStorageDirectivesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceUpdateApplicationStorageDirectivesCall) Do(f func(context.Context, string, map[string]service.ApplicationStorageDirectiveOverride) error) *MockApplicationServiceUpdateApplicationStorageDirectivesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceUpdateApplicationStorageDirectivesCall) DoAndReturn(f func(context.Context, string, map[string]service.ApplicationStorageDirectiveOverride) error) *MockApplicationServiceUpdateApplicationStorageDirectivesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockResolveService is a mock of ResolveService interface.
 type MockResolveService struct {
 	ctrl     *gomock.Controller
@@ -2273,10 +2609,10 @@ func (m *MockStorageService) EXPECT() *MockStorageServiceMockRecorder {
 }
 
 // GetStoragePoolUUID mocks base method.
-func (m *MockStorageService) GetStoragePoolUUID(arg0 context.Context, arg1 string) (storage.StoragePoolUUID, error) {
+func (m *MockStorageService) GetStoragePoolUUID(arg0 context.Context, arg1 string) (storage0.StoragePoolUUID, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetStoragePoolUUID", arg0, arg1)
-	ret0, _ := ret[0].(storage.StoragePoolUUID)
+	ret0, _ := ret[0].(storage0.StoragePoolUUID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -2294,19 +2630,58 @@ type MockStorageServiceGetStoragePoolUUIDCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStorageServiceGetStoragePoolUUIDCall) Return(arg0 storage.StoragePoolUUID, arg1 error) *MockStorageServiceGetStoragePoolUUIDCall {
+func (c *MockStorageServiceGetStoragePoolUUIDCall) Return(arg0 storage0.StoragePoolUUID, arg1 error) *MockStorageServiceGetStoragePoolUUIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageServiceGetStoragePoolUUIDCall) Do(f func(context.Context, string) (storage0.StoragePoolUUID, error)) *MockStorageServiceGetStoragePoolUUIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageServiceGetStoragePoolUUIDCall) DoAndReturn(f func(context.Context, string) (storage0.StoragePoolUUID, error)) *MockStorageServiceGetStoragePoolUUIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListStoragePools mocks base method.
+func (m *MockStorageService) ListStoragePools(arg0 context.Context) ([]storage0.StoragePool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStoragePools", arg0)
+	ret0, _ := ret[0].([]storage0.StoragePool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStoragePools indicates an expected call of ListStoragePools.
+func (mr *MockStorageServiceMockRecorder) ListStoragePools(arg0 any) *MockStorageServiceListStoragePoolsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStoragePools", reflect.TypeOf((*MockStorageService)(nil).ListStoragePools), arg0)
+	return &MockStorageServiceListStoragePoolsCall{Call: call}
+}
+
+// MockStorageServiceListStoragePoolsCall wrap *gomock.Call
+type MockStorageServiceListStoragePoolsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageServiceListStoragePoolsCall) Return(arg0 []storage0.StoragePool, arg1 error) *MockStorageServiceListStoragePoolsCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStorageServiceGetStoragePoolUUIDCall) Do(f func(context.Context, string) (storage.StoragePoolUUID, error)) *MockStorageServiceGetStoragePoolUUIDCall {
+func (c *MockStorageServiceListStoragePoolsCall) Do(f func(context.Context) ([]storage0.StoragePool, error)) *MockStorageServiceListStoragePoolsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStorageServiceGetStoragePoolUUIDCall) DoAndReturn(f func(context.Context, string) (storage.StoragePoolUUID, error)) *MockStorageServiceGetStoragePoolUUIDCall {
+func (c *MockStorageServiceListStoragePoolsCall) DoAndReturn(f func(context.Context) ([]storage0.StoragePool, error)) *MockStorageServiceListStoragePoolsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -2413,6 +2788,45 @@ func (c *MockRelationServiceApplicationRelationsInfoCall) DoAndReturn(f func(con
 	return c
 }
 
+// GetRelationUUIDByKey mocks base method.
+func (m *MockRelationService) GetRelationUUIDByKey(arg0 context.Context, arg1 relation.Key) (relation.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRelationUUIDByKey", arg0, arg1)
+	ret0, _ := ret[0].(relation.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRelationUUIDByKey indicates an expected call of GetRelationUUIDByKey.
+func (mr *MockRelationServiceMockRecorder) GetRelationUUIDByKey(arg0, arg1 any) *MockRelationServiceGetRelationUUIDByKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelationUUIDByKey", reflect.TypeOf((*MockRelationService)(nil).GetRelationUUIDByKey), arg0, arg1)
+	return &MockRelationServiceGetRelationUUIDByKeyCall{Call: call}
+}
+
+// MockRelationServiceGetRelationUUIDByKeyCall wrap *gomock.Call
+type MockRelationServiceGetRelationUUIDByKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRelationServiceGetRelationUUIDByKeyCall) Return(arg0 relation.UUID, arg1 error) *MockRelationServiceGetRelationUUIDByKeyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRelationServiceGetRelationUUIDByKeyCall) Do(f func(context.Context, relation.Key) (relation.UUID, error)) *MockRelationServiceGetRelationUUIDByKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRelationServiceGetRelationUUIDByKeyCall) DoAndReturn(f func(context.Context, relation.Key) (relation.UUID, error)) *MockRelationServiceGetRelationUUIDByKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetRelationUUIDForRemoval mocks base method.
 func (m *MockRelationService) GetRelationUUIDForRemoval(arg0 context.Context, arg1 relation0.GetRelationUUIDForRemovalArgs) (relation.UUID, error) {
 	m.ctrl.T.Helper()
@@ -2513,6 +2927,45 @@ func (c *MockResourceServiceDeleteResourcesAddedBeforeApplicationCall) DoAndRetu
 	return c
 }
 
+// GetResource mocks base method.
+func (m *MockResourceService) GetResource(arg0 context.Context, arg1 resource.UUID) (resource.Resource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResource", arg0, arg1)
+	ret0, _ := ret[0].(resource.Resource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResource indicates an expected call of GetResource.
+func (mr *MockResourceServiceMockRecorder) GetResource(arg0, arg1 any) *MockResourceServiceGetResourceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResource", reflect.TypeOf((*MockResourceService)(nil).GetResource), arg0, arg1)
+	return &MockResourceServiceGetResourceCall{Call: call}
+}
+
+// MockResourceServiceGetResourceCall wrap *gomock.Call
+type MockResourceServiceGetResourceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockResourceServiceGetResourceCall) Return(arg0 resource.Resource, arg1 error) *MockResourceServiceGetResourceCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockResourceServiceGetResourceCall) Do(f func(context.Context, resource.UUID) (resource.Resource, error)) *MockResourceServiceGetResourceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockResourceServiceGetResourceCall) DoAndReturn(f func(context.Context, resource.UUID) (resource.Resource, error)) *MockResourceServiceGetResourceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockRemovalService is a mock of RemovalService interface.
 type MockRemovalService struct {
 	ctrl     *gomock.Controller
@@ -2614,6 +3067,45 @@ func (c *MockRemovalServiceRemoveRelationCall) DoAndReturn(f func(context.Contex
 	return c
 }
 
+// RemoveRemoteApplicationOfferer mocks base method.
+func (m *MockRemovalService) RemoveRemoteApplicationOfferer(arg0 context.Context, arg1 application.ID, arg2 bool, arg3 time.Duration) (removal.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveRemoteApplicationOfferer", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(removal.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveRemoteApplicationOfferer indicates an expected call of RemoveRemoteApplicationOfferer.
+func (mr *MockRemovalServiceMockRecorder) RemoveRemoteApplicationOfferer(arg0, arg1, arg2, arg3 any) *MockRemovalServiceRemoveRemoteApplicationOffererCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRemoteApplicationOfferer", reflect.TypeOf((*MockRemovalService)(nil).RemoveRemoteApplicationOfferer), arg0, arg1, arg2, arg3)
+	return &MockRemovalServiceRemoveRemoteApplicationOffererCall{Call: call}
+}
+
+// MockRemovalServiceRemoveRemoteApplicationOffererCall wrap *gomock.Call
+type MockRemovalServiceRemoveRemoteApplicationOffererCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRemovalServiceRemoveRemoteApplicationOffererCall) Return(arg0 removal.UUID, arg1 error) *MockRemovalServiceRemoveRemoteApplicationOffererCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRemovalServiceRemoveRemoteApplicationOffererCall) Do(f func(context.Context, application.ID, bool, time.Duration) (removal.UUID, error)) *MockRemovalServiceRemoveRemoteApplicationOffererCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRemovalServiceRemoveRemoteApplicationOffererCall) DoAndReturn(f func(context.Context, application.ID, bool, time.Duration) (removal.UUID, error)) *MockRemovalServiceRemoveRemoteApplicationOffererCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // RemoveUnit mocks base method.
 func (m *MockRemovalService) RemoveUnit(arg0 context.Context, arg1 unit.UUID, arg2, arg3 bool, arg4 time.Duration) (removal.UUID, error) {
 	m.ctrl.T.Helper()
@@ -2713,3 +3205,107 @@ func (c *MockExternalControllerServiceUpdateExternalControllerCall) DoAndReturn(
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockCrossModelRelationService is a mock of CrossModelRelationService interface.
+type MockCrossModelRelationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCrossModelRelationServiceMockRecorder
+}
+
+// MockCrossModelRelationServiceMockRecorder is the mock recorder for MockCrossModelRelationService.
+type MockCrossModelRelationServiceMockRecorder struct {
+	mock *MockCrossModelRelationService
+}
+
+// NewMockCrossModelRelationService creates a new mock instance.
+func NewMockCrossModelRelationService(ctrl *gomock.Controller) *MockCrossModelRelationService {
+	mock := &MockCrossModelRelationService{ctrl: ctrl}
+	mock.recorder = &MockCrossModelRelationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCrossModelRelationService) EXPECT() *MockCrossModelRelationServiceMockRecorder {
+	return m.recorder
+}
+
+// AddRelationNetworkEgress mocks base method.
+func (m *MockCrossModelRelationService) AddRelationNetworkEgress(arg0 context.Context, arg1 relation.UUID, arg2 ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddRelationNetworkEgress", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddRelationNetworkEgress indicates an expected call of AddRelationNetworkEgress.
+func (mr *MockCrossModelRelationServiceMockRecorder) AddRelationNetworkEgress(arg0, arg1 any, arg2 ...any) *MockCrossModelRelationServiceAddRelationNetworkEgressCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRelationNetworkEgress", reflect.TypeOf((*MockCrossModelRelationService)(nil).AddRelationNetworkEgress), varargs...)
+	return &MockCrossModelRelationServiceAddRelationNetworkEgressCall{Call: call}
+}
+
+// MockCrossModelRelationServiceAddRelationNetworkEgressCall wrap *gomock.Call
+type MockCrossModelRelationServiceAddRelationNetworkEgressCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCrossModelRelationServiceAddRelationNetworkEgressCall) Return(arg0 error) *MockCrossModelRelationServiceAddRelationNetworkEgressCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCrossModelRelationServiceAddRelationNetworkEgressCall) Do(f func(context.Context, relation.UUID, ...string) error) *MockCrossModelRelationServiceAddRelationNetworkEgressCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCrossModelRelationServiceAddRelationNetworkEgressCall) DoAndReturn(f func(context.Context, relation.UUID, ...string) error) *MockCrossModelRelationServiceAddRelationNetworkEgressCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// AddRemoteApplicationOfferer mocks base method.
+func (m *MockCrossModelRelationService) AddRemoteApplicationOfferer(arg0 context.Context, arg1 string, arg2 crossmodelrelation.AddRemoteApplicationOffererArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRemoteApplicationOfferer", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddRemoteApplicationOfferer indicates an expected call of AddRemoteApplicationOfferer.
+func (mr *MockCrossModelRelationServiceMockRecorder) AddRemoteApplicationOfferer(arg0, arg1, arg2 any) *MockCrossModelRelationServiceAddRemoteApplicationOffererCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRemoteApplicationOfferer", reflect.TypeOf((*MockCrossModelRelationService)(nil).AddRemoteApplicationOfferer), arg0, arg1, arg2)
+	return &MockCrossModelRelationServiceAddRemoteApplicationOffererCall{Call: call}
+}
+
+// MockCrossModelRelationServiceAddRemoteApplicationOffererCall wrap *gomock.Call
+type MockCrossModelRelationServiceAddRemoteApplicationOffererCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCrossModelRelationServiceAddRemoteApplicationOffererCall) Return(arg0 error) *MockCrossModelRelationServiceAddRemoteApplicationOffererCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCrossModelRelationServiceAddRemoteApplicationOffererCall) Do(f func(context.Context, string, crossmodelrelation.AddRemoteApplicationOffererArgs) error) *MockCrossModelRelationServiceAddRemoteApplicationOffererCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCrossModelRelationServiceAddRemoteApplicationOffererCall) DoAndReturn(f func(context.Context, string, crossmodelrelation.AddRemoteApplicationOffererArgs) error) *MockCrossModelRelationServiceAddRemoteApplicationOffererCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,134 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/controller"
+	coreresource "github.com/juju/juju/core/resource"
+	applicationcharm "github.com/juju/juju/domain/application/charm"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/internal/charm/resource"
+	"github.com/juju/juju/internal/charm/signature"
+)
+
+// signatureVerifier enforces the charm-signature-policy controller config
+// on charms and OCI image resources. The zero value doesn't require any
+// signatures.
+type signatureVerifier struct {
+	required bool
+	keys     signature.Keys
+}
+
+// newSignatureVerifier returns a signatureVerifier for the current
+// controller config.
+func newSignatureVerifier(ctx context.Context, service ControllerConfigService) (signatureVerifier, error) {
+	cfg, err := service.ControllerConfig(ctx)
+	if err != nil {
+		return signatureVerifier{}, errors.Annotate(err, "getting controller config")
+	}
+	if cfg.CharmSignaturePolicy() != controller.CharmSignaturePolicyRequired {
+		return signatureVerifier{}, nil
+	}
+	keys, err := signature.ParseKeys(cfg.CharmSigningKeys())
+	if err != nil {
+		return signatureVerifier{}, errors.Annotatef(err, "parsing %s", controller.CharmSigningKeys)
+	}
+	return signatureVerifier{required: true, keys: keys}, nil
+}
+
+// verifyCharm checks the signature over the charm archive's SHA256 hash.
+func (v signatureVerifier) verifyCharm(name, sha256, sig string) error {
+	if !v.required {
+		return nil
+	}
+	if sha256 == "" {
+		return rejectedBySignaturePolicy(fmt.Sprintf("charm %q", name), errors.New("archive hash unknown"))
+	}
+	if err := v.keys.Verify(sha256, sig); err != nil {
+		return rejectedBySignaturePolicy(fmt.Sprintf("charm %q", name), err)
+	}
+	return nil
+}
+
+// verifyResource checks the signature over an OCI image resource's
+// fingerprint. Callers are expected to only pass OCI image resources, other
+// types of resource aren't covered by the policy.
+func (v signatureVerifier) verifyResource(name string, fingerprint resource.Fingerprint, sig string) error {
+	if !v.required {
+		return nil
+	}
+	if fingerprint.IsZero() {
+		return rejectedBySignaturePolicy(fmt.Sprintf("resource %q", name), errors.New("fingerprint unknown"))
+	}
+	if err := v.keys.Verify(fingerprint.Hex(), sig); err != nil {
+		return rejectedBySignaturePolicy(fmt.Sprintf("resource %q", name), err)
+	}
+	return nil
+}
+
+func rejectedBySignaturePolicy(what string, err error) error {
+	return errors.NewNotValid(nil, fmt.Sprintf("%s rejected by %s: %v", what, controller.CharmSignaturePolicy, err))
+}
+
+// verifySignatures checks the signatures of a charm held by the controller,
+// and the OCI image resources identified by resourceIDs, against the
+// charm-signature-policy.
+func (api *APIBase) verifySignatures(
+	ctx context.Context,
+	locator applicationcharm.CharmLocator,
+	charmSignature string,
+	resourceIDs map[string]string,
+	resourceSignatures map[string]string,
+) error {
+	verifier, err := newSignatureVerifier(ctx, api.controllerConfigService)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if !verifier.required {
+		return nil
+	}
+
+	meta, err := api.applicationService.GetCharmMetadata(ctx, locator)
+	if errors.Is(err, applicationerrors.CharmNotFound) {
+		return errors.NotFoundf("charm %q", locator.Name)
+	} else if err != nil {
+		return errors.Annotate(err, "getting charm metadata")
+	}
+	sha256, err := api.applicationService.GetCharmArchiveSHA256(ctx, locator)
+	if err != nil {
+		return errors.Annotate(err, "getting charm archive hash")
+	}
+	if err := verifier.verifyCharm(meta.Name, sha256, charmSignature); err != nil {
+		return errors.Trace(err)
+	}
+
+	for name, resMeta := range meta.Resources {
+		if resMeta.Type != resource.TypeContainerImage {
+			continue
+		}
+		// Deploy requires every resource to be provided, a refresh which
+		// doesn't provide a resource keeps the application's current one.
+		id, ok := resourceIDs[name]
+		if !ok {
+			continue
+		}
+		resUUID, err := coreresource.ParseUUID(id)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		res, err := api.resourceService.GetResource(ctx, resUUID)
+		if err != nil {
+			return errors.Annotatef(err, "getting resource %q", name)
+		}
+		if err := verifier.verifyResource(name, res.Fingerprint, resourceSignatures[name]); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,259 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/controller"
+	corecharm "github.com/juju/juju/core/charm"
+	coreresource "github.com/juju/juju/core/resource"
+	"github.com/juju/juju/core/resource/testing"
+	applicationcharm "github.com/juju/juju/domain/application/charm"
+	internalcharm "github.com/juju/juju/internal/charm"
+	charmresource "github.com/juju/juju/internal/charm/resource"
+	"github.com/juju/juju/rpc/params"
+)
+
+const (
+	// The signatures below were created with
+	//   ssh-keygen -Y sign -n juju-charm -f key
+	// over the hex digests, using the key below.
+	signingKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIIy7iZh6slcBa6/gSFuKRzkNFgjZh1uhPmYnnu1DaKcf publisher"
+
+	signedCharmSHA256 = "3c2b6fd8a3e4a1fdbd2f7bd7e9b3cf1c6d1b6b9c2f2a6e0f0b5a4c1a8e3f9d2b"
+	charmSignature    = `-----BEGIN SSH SIGNATURE-----
+U1NIU0lHAAAAAQAAADMAAAALc3NoLWVkMjU1MTkAAAAgjLuJmHqyVwFrr+BIW4pHOQ0WCN
+mHW6E+Ziee7UNopx8AAAAKanVqdS1jaGFybQAAAAAAAAAGc2hhNTEyAAAAUwAAAAtzc2gt
+ZWQyNTUxOQAAAEARKOLkCUr0T6C7hop+QNy1PCQyymj+iB6m71P6xK5Y1mw/tRwKO/6HiI
+vw2UR/6Nyy9YvzbIMJoqutZZYjrfwA
+-----END SSH SIGNATURE-----
+`
+
+	// signedResourceContent is the OCI image resource content whose
+	// fingerprint resourceSignature signs.
+	signedResourceContent = "registry.example.com/app@sha256:1"
+	resourceSignature     = `-----BEGIN SSH SIGNATURE-----
+U1NIU0lHAAAAAQAAADMAAAALc3NoLWVkMjU1MTkAAAAgjLuJmHqyVwFrr+BIW4pHOQ0WCN
+mHW6E+Ziee7UNopx8AAAAKanVqdS1jaGFybQAAAAAAAAAGc2hhNTEyAAAAUwAAAAtzc2gt
+ZWQyNTUxOQAAAEDIpKssVM/40so10H2VX5LXFMv9qvgg3U70KKK+wmoSx2MefFygQ1pxBG
+z7aHtNUpaX2qBJweHzk2MsmEu6gG8M
+-----END SSH SIGNATURE-----
+`
+)
+
+var requiredSignaturesConfig = controller.Config{
+	controller.CharmSignaturePolicy: controller.CharmSignaturePolicyRequired,
+	controller.CharmSigningKeys:     signingKey,
+}
+
+func (s *applicationSuite) TestDeploySignatureRequired(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+	resourceUUID := s.expectSignedCharm(c)
+	s.expectSignedResource(c, resourceUUID)
+	s.expectCreateApplicationForDeploy("foo", nil)
+
+	errorResults, err := s.api.Deploy(c.Context(), params.ApplicationsDeploy{
+		Applications: []params.ApplicationDeploy{
+			s.signedDeployArgs(resourceUUID, charmSignature, resourceSignature),
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(errorResults.Results, tc.HasLen, 1)
+	c.Assert(errorResults.Results[0].Error, tc.IsNil)
+}
+
+func (s *applicationSuite) TestDeploySignatureRequiredCharmNotSigned(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+	resourceUUID := s.expectSignedCharm(c)
+	s.expectDeletePendingResources([]coreresource.UUID{resourceUUID})
+
+	errorResults, err := s.api.Deploy(c.Context(), params.ApplicationsDeploy{
+		Applications: []params.ApplicationDeploy{
+			s.signedDeployArgs(resourceUUID, "", resourceSignature),
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(errorResults.Results, tc.HasLen, 1)
+	c.Check(errorResults.Results[0].Error, tc.ErrorMatches,
+		`cannot deploy "foo": charm "foo" rejected by charm-signature-policy: signature missing`)
+	c.Check(errorResults.Results[0].Error.Code, tc.Equals, params.CodeNotValid)
+}
+
+func (s *applicationSuite) TestDeploySignatureRequiredResourceNotValid(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+	resourceUUID := s.expectSignedCharm(c)
+	s.expectSignedResource(c, resourceUUID)
+	s.expectDeletePendingResources([]coreresource.UUID{resourceUUID})
+
+	// The charm signature doesn't match the resource's fingerprint.
+	errorResults, err := s.api.Deploy(c.Context(), params.ApplicationsDeploy{
+		Applications: []params.ApplicationDeploy{
+			s.signedDeployArgs(resourceUUID, charmSignature, charmSignature),
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(errorResults.Results, tc.HasLen, 1)
+	c.Check(errorResults.Results[0].Error, tc.ErrorMatches,
+		`cannot deploy "foo": resource "image" rejected by charm-signature-policy: .*signature not valid`)
+}
+
+func (s *applicationSuite) TestSetCharmSignatureRequired(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+	locator := applicationcharm.CharmLocator{
+		Name:     "foo",
+		Revision: 42,
+		Source:   applicationcharm.CharmHubSource,
+	}
+	s.expectControllerConfig(requiredSignaturesConfig)
+	s.applicationService.EXPECT().GetCharmMetadata(gomock.Any(), locator).Return(internalcharm.Meta{Name: "foo"}, nil)
+	s.applicationService.EXPECT().GetCharmArchiveSHA256(gomock.Any(), locator).Return(signedCharmSHA256, nil)
+	s.applicationService.EXPECT().SetApplicationCharm(gomock.Any(), "bar", locator, gomock.Any()).Return(nil)
+
+	err := s.api.SetCharm(c.Context(), params.ApplicationSetCharmV2{
+		ApplicationName: "bar",
+		CharmURL:        "ch:foo-42",
+		CharmOrigin:     &params.CharmOrigin{Source: "charm-hub", Base: params.Base{Name: "ubuntu", Channel: "24.04"}},
+		CharmSignature:  charmSignature,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *applicationSuite) TestSetCharmSignatureRequiredUntrusted(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.setupAPI(c)
+	locator := applicationcharm.CharmLocator{
+		Name:     "foo",
+		Revision: 42,
+		Source:   applicationcharm.CharmHubSource,
+	}
+	s.expectControllerConfig(controller.Config{
+		controller.CharmSignaturePolicy: controller.CharmSignaturePolicyRequired,
+		controller.CharmSigningKeys:     "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIJ1AOzEiFzJ8VGluTmH2JfGKhSRpi74m1AYKKf7qCOMb other",
+	})
+	s.applicationService.EXPECT().GetCharmMetadata(gomock.Any(), locator).Return(internalcharm.Meta{Name: "foo"}, nil)
+	s.applicationService.EXPECT().GetCharmArchiveSHA256(gomock.Any(), locator).Return(signedCharmSHA256, nil)
+
+	err := s.api.SetCharm(c.Context(), params.ApplicationSetCharmV2{
+		ApplicationName: "bar",
+		CharmURL:        "ch:foo-42",
+		CharmOrigin:     &params.CharmOrigin{Source: "charm-hub", Base: params.Base{Name: "ubuntu", Channel: "24.04"}},
+		CharmSignature:  charmSignature,
+	})
+	c.Assert(err, tc.ErrorIs, errors.NotValid)
+	c.Check(err, tc.ErrorMatches, `charm "foo" rejected by charm-signature-policy: ssh key SHA256:.*: signing key not trusted`)
+}
+
+func (s *deployRepositorySuite) TestResolveResourcesSignatureRequired(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	fp, err := charmresource.GenerateFingerprint(strings.NewReader(signedResourceContent))
+	c.Assert(err, tc.ErrorIsNil)
+	resMeta := map[string]charmresource.Meta{
+		"image": {Name: "image", Type: charmresource.TypeContainerImage},
+	}
+	s.charmRepository.EXPECT().ResolveResources(gomock.Any(), gomock.Any(), gomock.Any()).Return([]charmresource.Resource{{
+		Meta:        resMeta["image"],
+		Origin:      charmresource.OriginStore,
+		Revision:    3,
+		Fingerprint: fp,
+	}}, nil)
+	validator := s.expectValidator()
+	verifier, err := newSignatureVerifier(c.Context(), s.controllerConfigServiceReturning(requiredSignaturesConfig))
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, _, err = validator.resolveResources(c.Context(), internalcharm.MustParseURL("ch:foo-1"), corecharm.Origin{},
+		nil, resMeta, verifier, map[string]string{"image": resourceSignature})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *deployRepositorySuite) TestResolveResourcesSignatureRequiredUpload(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	resMeta := map[string]charmresource.Meta{
+		"image": {Name: "image", Type: charmresource.TypeContainerImage},
+	}
+	validator := deployFromRepositoryValidator{}
+	verifier, err := newSignatureVerifier(c.Context(), s.controllerConfigServiceReturning(requiredSignaturesConfig))
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, _, err = validator.resolveResources(c.Context(), internalcharm.MustParseURL("ch:foo-1"), corecharm.Origin{},
+		map[string]string{"image": "./image.yaml"}, resMeta, verifier, nil)
+	c.Assert(err, tc.ErrorIs, errors.NotValid)
+	c.Check(err, tc.ErrorMatches, `resource "image" rejected by charm-signature-policy: uploaded OCI image resources can't be verified .*`)
+}
+
+func (s *baseSuite) controllerConfigServiceReturning(cfg controller.Config) ControllerConfigService {
+	s.expectControllerConfig(cfg)
+	return s.controllerConfigService
+}
+
+// expectSignedCharm sets up a local charm with an OCI image resource, under
+// a policy requiring signatures. It returns a UUID for the pending resource.
+func (s *applicationSuite) expectSignedCharm(c *tc.C) coreresource.UUID {
+	imageMeta := charmresource.Meta{Name: "image", Type: charmresource.TypeContainerImage}
+	s.expectCharm(c, "foo", map[string]charmresource.Meta{"image": imageMeta})
+
+	locator := applicationcharm.CharmLocator{
+		Name:     "foo",
+		Revision: 42,
+		Source:   applicationcharm.LocalSource,
+	}
+	s.expectControllerConfig(requiredSignaturesConfig)
+	s.applicationService.EXPECT().GetCharmMetadata(gomock.Any(), locator).Return(internalcharm.Meta{
+		Name:      "foo",
+		Resources: map[string]charmresource.Meta{"image": imageMeta},
+	}, nil)
+	s.applicationService.EXPECT().GetCharmArchiveSHA256(gomock.Any(), locator).Return(signedCharmSHA256, nil)
+	return testing.GenResourceUUID(c)
+}
+
+// expectSignedResource sets up the pending OCI image resource for a charm
+// from expectSignedCharm.
+func (s *applicationSuite) expectSignedResource(c *tc.C, resourceUUID coreresource.UUID) {
+	imageMeta := charmresource.Meta{Name: "image", Type: charmresource.TypeContainerImage}
+	fp, err := charmresource.GenerateFingerprint(strings.NewReader(signedResourceContent))
+	c.Assert(err, tc.ErrorIsNil)
+	s.resourceService.EXPECT().GetResource(gomock.Any(), resourceUUID).Return(coreresource.Resource{
+		Resource: charmresource.Resource{
+			Meta:        imageMeta,
+			Origin:      charmresource.OriginUpload,
+			Fingerprint: fp,
+		},
+		UUID: resourceUUID,
+	}, nil)
+}
+
+func (s *applicationSuite) signedDeployArgs(resourceUUID coreresource.UUID, charmSig, resourceSig string) params.ApplicationDeploy {
+	return params.ApplicationDeploy{
+		ApplicationName: "foo",
+		CharmURL:        "local:foo-42",
+		CharmOrigin: &params.CharmOrigin{
+			Type:   "charm",
+			Source: "local",
+			Base: params.Base{
+				Name:    "ubuntu",
+				Channel: "24.04",
+			},
+			Architecture: "amd64",
+			Revision:     ptr(42),
+		},
+		Resources:          map[string]string{"image": resourceUUID.String()},
+		CharmSignature:     charmSig,
+		ResourceSignatures: map[string]string{"image": resourceSig},
+	}
+}
@@ -8,19 +8,29 @@ import (
 	"strings"
 
 	"github.com/juju/errors"
+	"github.com/juju/names/v6"
 
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/core/annotations"
+	coreapplication "github.com/juju/juju/core/application"
 	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/core/devices"
-	coreerrors "github.com/juju/juju/core/errors"
 	corelogger "github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/machine"
+	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/domain/application"
 	applicationcharm "github.com/juju/juju/domain/application/charm"
+	applicationservice "github.com/juju/juju/domain/application/service"
+	"github.com/juju/juju/domain/crossmodelrelation"
+	"github.com/juju/juju/domain/relation"
+	statusservice "github.com/juju/juju/domain/status/service"
+	domainstorage "github.com/juju/juju/domain/storage"
+	"github.com/juju/juju/environs/config"
 	bundlechanges "github.com/juju/juju/internal/bundle/changes"
 	"github.com/juju/juju/internal/charm"
-	internalerrors "github.com/juju/juju/internal/errors"
 	"github.com/juju/juju/internal/storage"
 	"github.com/juju/juju/rpc/params"
 )
@@ -41,6 +51,86 @@ type ApplicationService interface {
 	// implemented for the cases where all the charm data is needed; model
 	// migration, charm export, etc.
 	GetCharm(ctx context.Context, locator applicationcharm.CharmLocator) (charm.Charm, applicationcharm.CharmLocator, bool, error)
+
+	// GetApplicationIDByName returns an application ID by application name.
+	GetApplicationIDByName(ctx context.Context, name string) (coreapplication.ID, error)
+
+	// GetApplicationAndCharmConfig returns the application and charm config
+	// for the specified application ID.
+	GetApplicationAndCharmConfig(ctx context.Context, appID coreapplication.ID) (applicationservice.ApplicationConfig, error)
+
+	// GetApplicationConstraints returns the application constraints for the
+	// specified application ID.
+	GetApplicationConstraints(ctx context.Context, appID coreapplication.ID) (constraints.Value, error)
+
+	// GetApplicationStorageDirectives returns the storage directives set for
+	// the specified application.
+	GetApplicationStorageDirectives(ctx context.Context, appID coreapplication.ID) ([]application.StorageDirective, error)
+
+	// GetAllEndpointBindings returns the all endpoint bindings for the model,
+	// where endpoints are indexed by the application name for the application
+	// which they belong to.
+	GetAllEndpointBindings(ctx context.Context) (map[string]map[string]network.SpaceName, error)
+
+	// GetExposedEndpoints returns map where keys are endpoint names (or the ""
+	// value which represents all endpoints) and values are ExposedEndpoint
+	// instances that specify which sources (spaces or CIDRs) can access the
+	// opened ports for each endpoint once the application is exposed.
+	GetExposedEndpoints(ctx context.Context, appName string) (map[string]application.ExposedEndpoint, error)
+}
+
+// StatusService is the interface used to read the applications, units and
+// machines deployed in the model.
+type StatusService interface {
+	// GetApplicationAndUnitStatuses returns the application statuses of all
+	// the applications in the model, indexed by application name.
+	GetApplicationAndUnitStatuses(context.Context) (map[string]statusservice.Application, error)
+
+	// GetMachineFullStatuses returns all the machine statuses for the model,
+	// indexed by machine name.
+	GetMachineFullStatuses(context.Context) (map[machine.Name]statusservice.Machine, error)
+}
+
+// RelationService is the interface used to read the relations in the model.
+type RelationService interface {
+	// GetAllRelationDetails return all uuid of all relation for the current
+	// model.
+	GetAllRelationDetails(ctx context.Context) ([]relation.RelationDetailsResult, error)
+}
+
+// CrossModelRelationService is the interface used to read the offers made
+// from the model.
+type CrossModelRelationService interface {
+	// GetOffers returns offer details for all offers satisfying any of the
+	// provided filters.
+	GetOffers(context.Context, []crossmodelrelation.OfferFilter) ([]*crossmodelrelation.OfferDetail, error)
+}
+
+// AnnotationService is the interface used to read the annotations of
+// applications and machines.
+type AnnotationService interface {
+	// GetAnnotations returns the annotations for the given ID.
+	GetAnnotations(ctx context.Context, id annotations.ID) (map[string]string, error)
+}
+
+// StorageService is the interface used to resolve the storage pools used by
+// application storage directives.
+type StorageService interface {
+	// ListStoragePools returns all the storage pools in the model.
+	ListStoragePools(ctx context.Context) ([]domainstorage.StoragePool, error)
+}
+
+// ModelConfigService is the interface used to read the model config.
+type ModelConfigService interface {
+	// ModelConfig returns the current config for the model.
+	ModelConfig(ctx context.Context) (*config.Config, error)
+}
+
+// ModelInfoService is the interface used to read information about the
+// model.
+type ModelInfoService interface {
+	// GetModelInfo returns information about the current model.
+	GetModelInfo(ctx context.Context) (model.ModelInfo, error)
 }
 
 // APIv8 provides the Bundle API facade for version 8. It drops IncludeSeries
@@ -52,22 +142,54 @@ type APIv8 struct {
 // BundleAPI implements the Bundle interface and is the concrete implementation
 // of the API end point.
 type BundleAPI struct {
-	store              objectstore.ObjectStore
-	authorizer         facade.Authorizer
-	networkService     NetworkService
-	applicationService ApplicationService
-	logger             corelogger.Logger
+	store                     objectstore.ObjectStore
+	authorizer                facade.Authorizer
+	modelTag                  names.ModelTag
+	networkService            NetworkService
+	applicationService        ApplicationService
+	statusService             StatusService
+	relationService           RelationService
+	crossModelRelationService CrossModelRelationService
+	annotationService         AnnotationService
+	storageService            StorageService
+	modelConfigService        ModelConfigService
+	modelInfoService          ModelInfoService
+	logger                    corelogger.Logger
+}
+
+// Services holds the domain services used by the Bundle API facade.
+type Services struct {
+	NetworkService            NetworkService
+	ApplicationService        ApplicationService
+	StatusService             StatusService
+	RelationService           RelationService
+	CrossModelRelationService CrossModelRelationService
+	AnnotationService         AnnotationService
+	StorageService            StorageService
+	ModelConfigService        ModelConfigService
+	ModelInfoService          ModelInfoService
 }
 
 // NewFacade provides the required signature for facade registration.
 func newFacade(ctx facade.ModelContext) (*BundleAPI, error) {
 	authorizer := ctx.Auth()
+	domainServices := ctx.DomainServices()
 
 	return NewBundleAPI(
 		ctx.ObjectStore(),
 		authorizer,
-		ctx.DomainServices().Network(),
-		ctx.DomainServices().Application(),
+		names.NewModelTag(ctx.ModelUUID().String()),
+		Services{
+			NetworkService:            domainServices.Network(),
+			ApplicationService:        domainServices.Application(),
+			StatusService:             domainServices.Status(),
+			RelationService:           domainServices.Relation(),
+			CrossModelRelationService: domainServices.CrossModelRelation(),
+			AnnotationService:         domainServices.Annotation(),
+			StorageService:            domainServices.Storage(),
+			ModelConfigService:        domainServices.Config(),
+			ModelInfoService:          domainServices.ModelInfo(),
+		},
 		ctx.Logger().Child("bundlechanges"),
 	)
 }
@@ -76,8 +198,8 @@ func newFacade(ctx facade.ModelContext) (*BundleAPI, error) {
 func NewBundleAPI(
 	store objectstore.ObjectStore,
 	auth facade.Authorizer,
-	networkService NetworkService,
-	applicationService ApplicationService,
+	modelTag names.ModelTag,
+	services Services,
 	logger corelogger.Logger,
 ) (*BundleAPI, error) {
 	if !auth.AuthClient() {
@@ -85,14 +207,34 @@ func NewBundleAPI(
 	}
 
 	return &BundleAPI{
-		store:              store,
-		authorizer:         auth,
-		networkService:     networkService,
-		applicationService: applicationService,
-		logger:             logger,
+		store:                     store,
+		authorizer:                auth,
+		modelTag:                  modelTag,
+		networkService:            services.NetworkService,
+		applicationService:        services.ApplicationService,
+		statusService:             services.StatusService,
+		relationService:           services.RelationService,
+		crossModelRelationService: services.CrossModelRelationService,
+		annotationService:         services.AnnotationService,
+		storageService:            services.StorageService,
+		modelConfigService:        services.ModelConfigService,
+		modelInfoService:          services.ModelInfoService,
+		logger:                    logger,
 	}, nil
 }
 
+// noIncludeBundleDataSource is a bundle data source which refuses to
+// resolve includes, as a bundle sent by a client must not read files from
+// the controller.
+type noIncludeBundleDataSource struct {
+	charm.BundleDataSource
+}
+
+// ResolveInclude implements charm.BundleDataSource.
+func (noIncludeBundleDataSource) ResolveInclude(path string) ([]byte, error) {
+	return nil, errors.NotSupportedf("including %q in a bundle sent to the controller", path)
+}
+
 type validators struct {
 	verifyConstraints func(string) error
 	verifyStorage     func(string) error
@@ -105,7 +247,18 @@ func (b *BundleAPI) doGetBundleChanges(
 	vs validators,
 ) ([]bundlechanges.Change, []error, error) {
 	dataSource, _ := charm.StreamBundleDataSource(strings.NewReader(args.BundleDataYAML), args.BundleURL)
-	data, err := charm.ReadAndMergeBundleData(dataSource)
+	// Includes are resolved by the client, so are refused here rather than
+	// read from the controller's filesystem.
+	if dataSource != nil {
+		dataSource = noIncludeBundleDataSource{BundleDataSource: dataSource}
+	}
+	// Clients resolve the variables they are given; any left in the bundle
+	// take their declared defaults.
+	sources, err := charm.ResolveBundleVariables(nil, dataSource)
+	if err != nil {
+		return nil, nil, errors.Annotate(err, "cannot resolve bundle variables")
+	}
+	data, err := charm.ReadAndMergeBundleData(sources...)
 	if err != nil {
 		return nil, nil, errors.Annotate(err, "cannot read bundle YAML")
 	}
@@ -193,9 +346,3 @@ func (b *BundleAPI) doGetBundleChangesMapArgs(
 	err = postProcess(changes, &results)
 	return results, err
 }
-
-// ExportBundle exports the current model configuration as bundle.
-func (*BundleAPI) ExportBundle(context.Context, params.ExportBundleParams) (params.StringResult, error) {
-	return params.StringResult{}, apiservererrors.ServerError(internalerrors.Errorf(
-		"Juju 4.0 doesn't support exporting bundles").Add(coreerrors.NotImplemented))
-}
@@ -20,11 +20,18 @@ import (
 
 type bundleSuite struct {
 	coretesting.BaseSuite
-	auth               *apiservertesting.FakeAuthorizer
-	facade             *bundle.APIv8
-	store              *mockObjectStore
-	networkService     *MockNetworkService
-	applicationService *MockApplicationService
+	auth                      *apiservertesting.FakeAuthorizer
+	facade                    *bundle.APIv8
+	store                     *mockObjectStore
+	networkService            *MockNetworkService
+	applicationService        *MockApplicationService
+	statusService             *MockStatusService
+	relationService           *MockRelationService
+	crossModelRelationService *MockCrossModelRelationService
+	annotationService         *MockAnnotationService
+	storageService            *MockStorageService
+	modelConfigService        *MockModelConfigService
+	modelInfoService          *MockModelInfoService
 }
 
 func TestBundleSuite(t *testing.T) {
@@ -35,6 +42,13 @@ func (s *bundleSuite) setUpMocks(c *tc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 	s.networkService = NewMockNetworkService(ctrl)
 	s.applicationService = NewMockApplicationService(ctrl)
+	s.statusService = NewMockStatusService(ctrl)
+	s.relationService = NewMockRelationService(ctrl)
+	s.crossModelRelationService = NewMockCrossModelRelationService(ctrl)
+	s.annotationService = NewMockAnnotationService(ctrl)
+	s.storageService = NewMockStorageService(ctrl)
+	s.modelConfigService = NewMockModelConfigService(ctrl)
+	s.modelInfoService = NewMockModelInfoService(ctrl)
 	return ctrl
 }
 
@@ -51,8 +65,18 @@ func (s *bundleSuite) makeAPI(c *tc.C) *bundle.APIv8 {
 	api, err := bundle.NewBundleAPI(
 		s.store,
 		s.auth,
-		s.networkService,
-		s.applicationService,
+		coretesting.ModelTag,
+		bundle.Services{
+			NetworkService:            s.networkService,
+			ApplicationService:        s.applicationService,
+			StatusService:             s.statusService,
+			RelationService:           s.relationService,
+			CrossModelRelationService: s.crossModelRelationService,
+			AnnotationService:         s.annotationService,
+			StorageService:            s.storageService,
+			ModelConfigService:        s.modelConfigService,
+			ModelInfoService:          s.modelInfoService,
+		},
 		loggertesting.WrapCheckLog(c),
 	)
 	c.Assert(err, tc.ErrorIsNil)
@@ -71,6 +95,83 @@ func (s *bundleSuite) TestGetChangesMapArgsBundleContentError(c *tc.C) {
 	c.Assert(r, tc.DeepEquals, params.BundleChangesMapArgsResults{})
 }
 
+func (s *bundleSuite) TestGetChangesMapArgsBundleVariableDefaults(c *tc.C) {
+	defer s.setUpMocks(c).Finish()
+	s.facade = s.makeAPI(c)
+
+	args := params.BundleChangesParams{
+		BundleDataYAML: `
+            variables:
+                debug: true
+                proxy: false
+            applications:
+                django:
+                    charm: django
+                    options:
+                        debug: ${debug}
+                haproxy:
+                    $when: ${proxy}
+                    charm: ch:haproxy
+            relations:
+                - - django:web
+                  - haproxy:web
+        `,
+	}
+	r, err := s.facade.GetChangesMapArgs(c.Context(), args)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(r.Changes, tc.DeepEquals, []*params.BundleChangesMapArgs{{
+		Id:     "addCharm-0",
+		Method: "addCharm",
+		Args: map[string]interface{}{
+			"charm": "django",
+		},
+	}, {
+		Id:     "deploy-1",
+		Method: "deploy",
+		Args: map[string]interface{}{
+			"application": "django",
+			"charm":       "$addCharm-0",
+			"options": map[string]interface{}{
+				"debug": true,
+			},
+		},
+		Requires: []string{"addCharm-0"},
+	}})
+}
+
+func (s *bundleSuite) TestGetChangesMapArgsBundleMissingVariable(c *tc.C) {
+	defer s.setUpMocks(c).Finish()
+	s.facade = s.makeAPI(c)
+
+	args := params.BundleChangesParams{
+		BundleDataYAML: `
+            variables:
+                password:
+                    type: string
+            applications:
+                django:
+                    charm: django
+        `,
+	}
+	_, err := s.facade.GetChangesMapArgs(c.Context(), args)
+	c.Assert(err, tc.ErrorMatches, `cannot resolve bundle variables: missing values for variables password not valid`)
+}
+
+func (s *bundleSuite) TestGetChangesMapArgsBundleIncludeRefused(c *tc.C) {
+	defer s.setUpMocks(c).Finish()
+	s.facade = s.makeAPI(c)
+
+	args := params.BundleChangesParams{
+		BundleDataYAML: `
+            applications:
+                django:
+                    $include: /etc/passwd
+        `,
+	}
+	_, err := s.facade.GetChangesMapArgs(c.Context(), args)
+	c.Assert(err, tc.ErrorMatches, `cannot resolve bundle variables: .*including "/etc/passwd" in a bundle sent to the controller not supported`)
+}
+
 func (s *bundleSuite) TestGetChangesMapArgsBundleVerificationErrors(c *tc.C) {
 	defer s.setUpMocks(c).Finish()
 	s.facade = s.makeAPI(c)
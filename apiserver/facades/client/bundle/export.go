@@ -0,0 +1,562 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package bundle
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+	"gopkg.in/yaml.v2"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/internal/charms"
+	"github.com/juju/juju/core/annotations"
+	coreapplication "github.com/juju/juju/core/application"
+	corebase "github.com/juju/juju/core/base"
+	"github.com/juju/juju/core/machine"
+	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/core/network/firewall"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/unit"
+	"github.com/juju/juju/domain/application"
+	applicationcharm "github.com/juju/juju/domain/application/charm"
+	"github.com/juju/juju/domain/crossmodelrelation"
+	"github.com/juju/juju/domain/deployment"
+	statusservice "github.com/juju/juju/domain/status/service"
+	"github.com/juju/juju/internal/charm"
+	"github.com/juju/juju/internal/storage"
+	"github.com/juju/juju/rpc/params"
+)
+
+// bundleOutput is the exported form of a bundle. It holds the same fields as
+// charm.BundleData, in the order they are conventionally written.
+type bundleOutput struct {
+	Type         string                            `yaml:"bundle,omitempty"`
+	DefaultBase  string                            `yaml:"default-base,omitempty"`
+	Applications map[string]*charm.ApplicationSpec `yaml:"applications,omitempty"`
+	Machines     map[string]*charm.MachineSpec     `yaml:"machines,omitempty"`
+	Relations    [][]string                        `yaml:"relations,omitempty"`
+}
+
+// ExportBundle exports the current model configuration as bundle. Fields that
+// may only be set in an overlay, such as offers and per endpoint expose
+// settings, are written to an overlay document following the bundle.
+func (b *BundleAPI) ExportBundle(ctx context.Context, arg params.ExportBundleParams) (params.StringResult, error) {
+	if err := b.authorizer.HasPermission(ctx, permission.ReadAccess, b.modelTag); err != nil {
+		return params.StringResult{}, errors.Trace(err)
+	}
+
+	base, overlay, err := b.exportBundle(ctx, arg.IncludeCharmDefaults)
+	if err != nil {
+		return params.StringResult{Error: apiservererrors.ServerError(err)}, nil
+	}
+	out, err := yaml.Marshal(base)
+	if err != nil {
+		return params.StringResult{Error: apiservererrors.ServerError(err)}, nil
+	}
+	if len(overlay.Applications) > 0 {
+		overlayOut, err := yaml.Marshal(overlay)
+		if err != nil {
+			return params.StringResult{Error: apiservererrors.ServerError(err)}, nil
+		}
+		out = append(out, "--- # overlay.yaml\n"...)
+		out = append(out, overlayOut...)
+	}
+	return params.StringResult{Result: string(out)}, nil
+}
+
+func (b *BundleAPI) exportBundle(ctx context.Context, includeCharmDefaults bool) (bundleOutput, bundleOutput, error) {
+	modelInfo, err := b.modelInfoService.GetModelInfo(ctx)
+	if err != nil {
+		return bundleOutput{}, bundleOutput{}, errors.Annotate(err, "getting model info")
+	}
+	apps, err := b.statusService.GetApplicationAndUnitStatuses(ctx)
+	if err != nil {
+		return bundleOutput{}, bundleOutput{}, errors.Annotate(err, "getting applications")
+	}
+	if len(apps) == 0 {
+		return bundleOutput{}, bundleOutput{}, errors.NotFoundf("applications in model %q", modelInfo.Name)
+	}
+
+	defaultBase, err := b.exportDefaultBase(ctx, apps)
+	if err != nil {
+		return bundleOutput{}, bundleOutput{}, errors.Trace(err)
+	}
+	e := exporter{
+		BundleAPI:            b,
+		modelType:            modelInfo.Type,
+		defaultBase:          defaultBase,
+		includeCharmDefaults: includeCharmDefaults,
+		machines:             set.NewStrings(),
+	}
+	if err := e.prepare(ctx); err != nil {
+		return bundleOutput{}, bundleOutput{}, errors.Trace(err)
+	}
+
+	base := bundleOutput{
+		DefaultBase:  defaultBase,
+		Applications: make(map[string]*charm.ApplicationSpec),
+	}
+	if modelInfo.Type == model.CAAS {
+		base.Type = "kubernetes"
+	}
+	overlay := bundleOutput{
+		Applications: make(map[string]*charm.ApplicationSpec),
+	}
+	for name, app := range apps {
+		spec, overlaySpec, err := e.exportApplication(ctx, name, app)
+		if err != nil {
+			return bundleOutput{}, bundleOutput{}, errors.Annotatef(err, "exporting application %q", name)
+		}
+		base.Applications[name] = spec
+		if overlaySpec != nil {
+			overlay.Applications[name] = overlaySpec
+		}
+	}
+
+	if err := e.exportOffers(ctx, overlay.Applications, apps); err != nil {
+		return bundleOutput{}, bundleOutput{}, errors.Trace(err)
+	}
+	if base.Machines, err = e.exportMachines(ctx); err != nil {
+		return bundleOutput{}, bundleOutput{}, errors.Trace(err)
+	}
+	if base.Relations, err = e.exportRelations(ctx, apps); err != nil {
+		return bundleOutput{}, bundleOutput{}, errors.Trace(err)
+	}
+	return base, overlay, nil
+}
+
+// exportDefaultBase returns the default base for the bundle. This is the
+// model's default base if one is set, otherwise the base most used by the
+// applications in the model.
+func (b *BundleAPI) exportDefaultBase(ctx context.Context, apps map[string]statusservice.Application) (string, error) {
+	cfg, err := b.modelConfigService.ModelConfig(ctx)
+	if err != nil {
+		return "", errors.Annotate(err, "getting model config")
+	}
+	if s, ok := cfg.DefaultBase(); ok && s != "" {
+		base, err := corebase.ParseBaseFromString(s)
+		if err != nil {
+			return "", errors.Annotatef(err, "parsing model default base %q", s)
+		}
+		return base.DisplayString(), nil
+	}
+
+	counts := make(map[string]int)
+	for _, app := range apps {
+		base, err := encodePlatform(app.Platform)
+		if err != nil {
+			return "", errors.Trace(err)
+		}
+		counts[base]++
+	}
+	var defaultBase string
+	for base, count := range counts {
+		if count > counts[defaultBase] || (count == counts[defaultBase] && base < defaultBase) {
+			defaultBase = base
+		}
+	}
+	return defaultBase, nil
+}
+
+// exporter holds the model wide state needed while exporting the
+// applications and machines of a model.
+type exporter struct {
+	*BundleAPI
+
+	modelType            model.ModelType
+	defaultBase          string
+	includeCharmDefaults bool
+
+	bindings  map[string]map[string]network.SpaceName
+	spaces    network.SpaceInfos
+	poolNames map[string]string
+
+	// machines holds the top level machines referenced by unit placements.
+	machines set.Strings
+}
+
+func (e *exporter) prepare(ctx context.Context) error {
+	var err error
+	if e.bindings, err = e.applicationService.GetAllEndpointBindings(ctx); err != nil {
+		return errors.Annotate(err, "getting endpoint bindings")
+	}
+	if e.spaces, err = e.networkService.GetAllSpaces(ctx); err != nil {
+		return errors.Annotate(err, "getting spaces")
+	}
+	pools, err := e.storageService.ListStoragePools(ctx)
+	if err != nil {
+		return errors.Annotate(err, "getting storage pools")
+	}
+	e.poolNames = make(map[string]string, len(pools))
+	for _, pool := range pools {
+		e.poolNames[pool.UUID] = pool.Name
+	}
+	return nil
+}
+
+// exportApplication returns the bundle spec of the application, and the
+// overlay spec holding its overlay only fields, if it has any.
+func (e *exporter) exportApplication(
+	ctx context.Context, name string, app statusservice.Application,
+) (*charm.ApplicationSpec, *charm.ApplicationSpec, error) {
+	appID, err := e.applicationService.GetApplicationIDByName(ctx, name)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	appConfig, err := e.applicationService.GetApplicationAndCharmConfig(ctx, appID)
+	if err != nil {
+		return nil, nil, errors.Annotate(err, "getting config")
+	}
+
+	spec := &charm.ApplicationSpec{
+		Options:       exportOptions(appConfig.ApplicationConfig, appConfig.CharmConfig, e.includeCharmDefaults),
+		RequiresTrust: appConfig.Trust,
+	}
+
+	isCharmhub := app.CharmLocator.Source == applicationcharm.CharmHubSource
+	if isCharmhub {
+		spec.Charm = app.CharmLocator.Name
+	} else if spec.Charm, err = charms.CharmURLFromLocator(app.CharmLocator.Name, app.CharmLocator); err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	if ch := app.Channel; ch != nil {
+		spec.Channel = charm.Channel{
+			Track:  ch.Track,
+			Risk:   charm.Risk(ch.Risk),
+			Branch: ch.Branch,
+		}.Normalize().String()
+	}
+	// A charmhub revision must be accompanied by a channel to be deployable.
+	if revision := app.CharmLocator.Revision; revision >= 0 && (!isCharmhub || spec.Channel != "") {
+		spec.Revision = &revision
+	}
+
+	base, err := encodePlatform(app.Platform)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	if base != e.defaultBase {
+		spec.Base = base
+	}
+
+	if !app.Subordinate {
+		if e.modelType == model.CAAS {
+			spec.Scale_ = len(app.Units)
+			if app.Scale != nil {
+				spec.Scale_ = *app.Scale
+			}
+		} else {
+			spec.NumUnits = len(app.Units)
+			spec.To = e.exportPlacements(app.Units)
+		}
+	}
+
+	cons, err := e.applicationService.GetApplicationConstraints(ctx, appID)
+	if err != nil {
+		return nil, nil, errors.Annotate(err, "getting constraints")
+	}
+	spec.Constraints = cons.String()
+
+	if spec.Storage, err = e.exportStorage(ctx, appID); err != nil {
+		return nil, nil, errors.Annotate(err, "getting storage")
+	}
+	spec.EndpointBindings = exportBindings(e.bindings[name])
+
+	if spec.Annotations, err = e.annotationService.GetAnnotations(ctx, annotations.ID{
+		Kind: annotations.KindApplication,
+		Name: name,
+	}); err != nil {
+		return nil, nil, errors.Annotate(err, "getting annotations")
+	}
+
+	if !app.Exposed {
+		return spec, nil, nil
+	}
+	exposedEndpoints, err := e.applicationService.GetExposedEndpoints(ctx, name)
+	if err != nil {
+		return nil, nil, errors.Annotate(err, "getting exposed endpoints")
+	}
+	if isExposedToAll(exposedEndpoints) {
+		spec.Expose = true
+		return spec, nil, nil
+	}
+	endpoints, err := e.exportExposedEndpoints(exposedEndpoints)
+	if err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+	return spec, &charm.ApplicationSpec{ExposedEndpoints: endpoints}, nil
+}
+
+// exportPlacements returns the placement of the units, in unit order. Units
+// in containers are placed in a new container on the same host machine.
+func (e *exporter) exportPlacements(units map[unit.Name]statusservice.Unit) []string {
+	names := make([]unit.Name, 0, len(units))
+	for name, u := range units {
+		if u.MachineName != nil {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return names[i].Number() < names[j].Number()
+	})
+
+	placements := make([]string, len(names))
+	for i, name := range names {
+		machineName := *units[name].MachineName
+		parent := machineName.Parent().String()
+		e.machines.Add(parent)
+		if !machineName.IsContainer() {
+			placements[i] = parent
+			continue
+		}
+		containerType := strings.SplitN(machineName.String(), "/", 3)[1]
+		placements[i] = fmt.Sprintf("%s:%s", containerType, parent)
+	}
+	return placements
+}
+
+func (e *exporter) exportStorage(ctx context.Context, appID coreapplication.ID) (map[string]string, error) {
+	directives, err := e.applicationService.GetApplicationStorageDirectives(ctx, appID)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(directives) == 0 {
+		return nil, nil
+	}
+	result := make(map[string]string, len(directives))
+	for _, directive := range directives {
+		s, err := storage.ToString(storage.Directive{
+			Pool:  e.poolNames[directive.PoolUUID.String()],
+			Size:  directive.Size,
+			Count: uint64(directive.Count),
+		})
+		if err != nil {
+			return nil, errors.Annotatef(err, "storage %q", directive.Name)
+		}
+		result[directive.Name.String()] = s
+	}
+	return result, nil
+}
+
+func (e *exporter) exportExposedEndpoints(
+	exposedEndpoints map[string]application.ExposedEndpoint,
+) (map[string]charm.ExposedEndpointSpec, error) {
+	result := make(map[string]charm.ExposedEndpointSpec, len(exposedEndpoints))
+	for endpoint, exposed := range exposedEndpoints {
+		var spaces []string
+		for _, spaceID := range exposed.ExposeToSpaceIDs.SortedValues() {
+			space := e.spaces.GetByID(network.SpaceUUID(spaceID))
+			if space == nil {
+				return nil, errors.NotFoundf("space with ID %q", spaceID)
+			}
+			spaces = append(spaces, space.Name.String())
+		}
+		sort.Strings(spaces)
+		result[endpoint] = charm.ExposedEndpointSpec{
+			ExposeToSpaces: spaces,
+			ExposeToCIDRs:  exposed.ExposeToCIDRs.SortedValues(),
+		}
+	}
+	return result, nil
+}
+
+// exportOffers adds the offers made from the exported applications to the
+// overlay.
+func (e *exporter) exportOffers(
+	ctx context.Context,
+	overlay map[string]*charm.ApplicationSpec,
+	apps map[string]statusservice.Application,
+) error {
+	offers, err := e.crossModelRelationService.GetOffers(ctx, []crossmodelrelation.OfferFilter{{}})
+	if err != nil {
+		return errors.Annotate(err, "getting offers")
+	}
+	for _, offer := range offers {
+		if _, ok := apps[offer.ApplicationName]; !ok {
+			continue
+		}
+		spec, ok := overlay[offer.ApplicationName]
+		if !ok {
+			spec = &charm.ApplicationSpec{}
+			overlay[offer.ApplicationName] = spec
+		}
+		if spec.Offers == nil {
+			spec.Offers = make(map[string]*charm.OfferSpec)
+		}
+		offerSpec := &charm.OfferSpec{}
+		for _, endpoint := range offer.Endpoints {
+			offerSpec.Endpoints = append(offerSpec.Endpoints, endpoint.Name)
+		}
+		sort.Strings(offerSpec.Endpoints)
+		if len(offer.OfferUsers) > 0 {
+			offerSpec.ACL = make(map[string]string, len(offer.OfferUsers))
+			for _, user := range offer.OfferUsers {
+				offerSpec.ACL[user.Name] = string(user.Access)
+			}
+		}
+		spec.Offers[offer.OfferName] = offerSpec
+	}
+	return nil
+}
+
+// exportMachines returns the top level machines referenced by the unit
+// placements.
+func (e *exporter) exportMachines(ctx context.Context) (map[string]*charm.MachineSpec, error) {
+	if e.machines.IsEmpty() {
+		return nil, nil
+	}
+	statuses, err := e.statusService.GetMachineFullStatuses(ctx)
+	if err != nil {
+		return nil, errors.Annotate(err, "getting machines")
+	}
+	result := make(map[string]*charm.MachineSpec, e.machines.Size())
+	for _, name := range e.machines.SortedValues() {
+		spec := &charm.MachineSpec{}
+		if m, ok := statuses[machine.Name(name)]; ok {
+			base, err := encodePlatform(m.Platform)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			if base != e.defaultBase {
+				spec.Base = base
+			}
+			spec.Constraints = m.Constraints.String()
+		}
+		if spec.Annotations, err = e.annotationService.GetAnnotations(ctx, annotations.ID{
+			Kind: annotations.KindMachine,
+			Name: name,
+		}); err != nil {
+			return nil, errors.Annotatef(err, "getting annotations for machine %q", name)
+		}
+		result[name] = spec
+	}
+	return result, nil
+}
+
+// exportRelations returns the relations between the exported applications.
+// Peer relations and relations to remote applications are skipped.
+func (e *exporter) exportRelations(ctx context.Context, apps map[string]statusservice.Application) ([][]string, error) {
+	relations, err := e.relationService.GetAllRelationDetails(ctx)
+	if err != nil {
+		return nil, errors.Annotate(err, "getting relations")
+	}
+	var result [][]string
+	for _, rel := range relations {
+		if len(rel.Endpoints) != 2 {
+			continue
+		}
+		pair := make([]string, 2)
+		for i, ep := range rel.Endpoints {
+			if _, ok := apps[ep.ApplicationName]; !ok {
+				pair = nil
+				break
+			}
+			pair[i] = ep.String()
+		}
+		if pair == nil {
+			continue
+		}
+		sort.Strings(pair)
+		result = append(result, pair)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i][0] != result[j][0] {
+			return result[i][0] < result[j][0]
+		}
+		return result[i][1] < result[j][1]
+	})
+	return result, nil
+}
+
+// exportOptions returns the options set on the application which differ from
+// the charm defaults, along with the defaults of the unset options when they
+// are requested.
+func exportOptions(settings charm.Config, config charm.ConfigSpec, includeDefaults bool) map[string]interface{} {
+	result := make(map[string]interface{})
+	for name, option := range config.Options {
+		// Settings and defaults may have been stored with different types
+		// (e.g. an int read back as a float), so they are both coerced to
+		// the option's type before they are compared.
+		value := coerceOption(config, name, settings[name])
+		defaultValue := coerceOption(config, name, option.Default)
+		if value != nil && value != defaultValue {
+			result[name] = value
+		} else if includeDefaults && defaultValue != nil {
+			result[name] = defaultValue
+		}
+	}
+	if len(result) == 0 {
+		return nil
+	}
+	return result
+}
+
+// coerceOption returns the value converted to the type of the named option.
+// The value is returned unchanged if it can not be converted.
+func coerceOption(config charm.ConfigSpec, name string, value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	coerced, err := config.ValidateApplicationConfig(charm.Config{name: value})
+	if err != nil {
+		return value
+	}
+	return coerced[name]
+}
+
+// exportBindings returns the endpoint bindings of an application, unless all
+// of them are to the alpha space, which is where they are bound by default.
+func exportBindings(bindings map[string]network.SpaceName) map[string]string {
+	bound := false
+	for _, space := range bindings {
+		if space != network.AlphaSpaceName {
+			bound = true
+			break
+		}
+	}
+	if !bound {
+		return nil
+	}
+	result := make(map[string]string, len(bindings))
+	for endpoint, space := range bindings {
+		result[endpoint] = space.String()
+	}
+	return result
+}
+
+// isExposedToAll returns true if the exposed endpoints are the default of
+// all endpoints being open to all addresses, which is what "expose: true"
+// means in a bundle.
+func isExposedToAll(exposedEndpoints map[string]application.ExposedEndpoint) bool {
+	if len(exposedEndpoints) == 0 {
+		return true
+	}
+	if len(exposedEndpoints) > 1 {
+		return false
+	}
+	exposed, ok := exposedEndpoints[""]
+	if !ok || len(exposed.ExposeToSpaceIDs) != 0 {
+		return false
+	}
+	return exposed.ExposeToCIDRs.Size() == 2 &&
+		exposed.ExposeToCIDRs.Contains(firewall.AllNetworksIPV4CIDR) &&
+		exposed.ExposeToCIDRs.Contains(firewall.AllNetworksIPV6CIDR)
+}
+
+func encodePlatform(platform deployment.Platform) (string, error) {
+	base, err := corebase.ParseBase(platform.OSType.String(), platform.Channel)
+	if err != nil {
+		return "", errors.Annotatef(err, "parsing base %q", platform.OSType)
+	}
+	return base.DisplayString(), nil
+}
@@ -0,0 +1,467 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package bundle_test
+
+import (
+	"strings"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/core/annotations"
+	coreapplication "github.com/juju/juju/core/application"
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/core/machine"
+	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/core/unit"
+	"github.com/juju/juju/domain/application"
+	applicationcharm "github.com/juju/juju/domain/application/charm"
+	applicationservice "github.com/juju/juju/domain/application/service"
+	"github.com/juju/juju/domain/crossmodelrelation"
+	"github.com/juju/juju/domain/deployment"
+	"github.com/juju/juju/domain/relation"
+	statusservice "github.com/juju/juju/domain/status/service"
+	domainstorage "github.com/juju/juju/domain/storage"
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/internal/charm"
+	coretesting "github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+var (
+	jammy = deployment.Platform{OSType: deployment.Ubuntu, Channel: "22.04"}
+	focal = deployment.Platform{OSType: deployment.Ubuntu, Channel: "20.04"}
+)
+
+func (s *bundleSuite) expectModel(c *tc.C, modelType model.ModelType, attrs coretesting.Attrs) {
+	s.modelInfoService.EXPECT().GetModelInfo(gomock.Any()).Return(model.ModelInfo{
+		Name: "testmodel",
+		Type: modelType,
+	}, nil)
+	cfg, err := config.New(config.UseDefaults, coretesting.FakeConfig().Merge(attrs))
+	c.Assert(err, tc.ErrorIsNil)
+	s.modelConfigService.EXPECT().ModelConfig(gomock.Any()).Return(cfg, nil)
+}
+
+func (s *bundleSuite) expectModelWide(bindings map[string]map[string]network.SpaceName) {
+	s.applicationService.EXPECT().GetAllEndpointBindings(gomock.Any()).Return(bindings, nil)
+	s.networkService.EXPECT().GetAllSpaces(gomock.Any()).Return(network.SpaceInfos{
+		{ID: network.AlphaSpaceId, Name: network.AlphaSpaceName},
+		{ID: "space-1", Name: "internal"},
+		{ID: "space-2", Name: "dmz"},
+	}, nil)
+	s.storageService.EXPECT().ListStoragePools(gomock.Any()).Return([]domainstorage.StoragePool{{
+		UUID: "pool-uuid",
+		Name: "ebs",
+	}}, nil)
+}
+
+type exportedApplication struct {
+	name        string
+	config      applicationservice.ApplicationConfig
+	constraints constraints.Value
+	storage     []application.StorageDirective
+	annotations map[string]string
+	exposed     map[string]application.ExposedEndpoint
+}
+
+func (s *bundleSuite) expectApplication(app exportedApplication) {
+	appID := coreapplication.ID(app.name + "-uuid")
+	s.applicationService.EXPECT().GetApplicationIDByName(gomock.Any(), app.name).Return(appID, nil)
+	s.applicationService.EXPECT().GetApplicationAndCharmConfig(gomock.Any(), appID).Return(app.config, nil)
+	s.applicationService.EXPECT().GetApplicationConstraints(gomock.Any(), appID).Return(app.constraints, nil)
+	s.applicationService.EXPECT().GetApplicationStorageDirectives(gomock.Any(), appID).Return(app.storage, nil)
+	s.annotationService.EXPECT().GetAnnotations(gomock.Any(), annotations.ID{
+		Kind: annotations.KindApplication,
+		Name: app.name,
+	}).Return(app.annotations, nil)
+	if app.exposed != nil {
+		s.applicationService.EXPECT().GetExposedEndpoints(gomock.Any(), app.name).Return(app.exposed, nil)
+	}
+}
+
+func machineName(name string) *machine.Name {
+	n := machine.Name(name)
+	return &n
+}
+
+func (s *bundleSuite) TestExportBundle(c *tc.C) {
+	defer s.setUpMocks(c).Finish()
+	s.facade = s.makeAPI(c)
+
+	s.expectModel(c, model.IAAS, coretesting.Attrs{"default-base": "ubuntu@22.04"})
+	s.statusService.EXPECT().GetApplicationAndUnitStatuses(gomock.Any()).Return(map[string]statusservice.Application{
+		"mysql": {
+			CharmLocator: applicationcharm.CharmLocator{
+				Name:     "mysql",
+				Revision: 42,
+				Source:   applicationcharm.CharmHubSource,
+			},
+			Channel:  &deployment.Channel{Track: "8.0", Risk: deployment.RiskStable},
+			Platform: jammy,
+			Exposed:  true,
+			Units: map[unit.Name]statusservice.Unit{
+				"mysql/0": {MachineName: machineName("0")},
+				"mysql/1": {MachineName: machineName("1/lxd/0")},
+			},
+		},
+		"wordpress": {
+			CharmLocator: applicationcharm.CharmLocator{
+				Name:     "wordpress",
+				Revision: 7,
+				Source:   applicationcharm.CharmHubSource,
+			},
+			Channel:  &deployment.Channel{Risk: deployment.RiskEdge},
+			Platform: focal,
+			Exposed:  true,
+			Units: map[unit.Name]statusservice.Unit{
+				"wordpress/0": {MachineName: machineName("2")},
+			},
+		},
+		"telegraf": {
+			CharmLocator: applicationcharm.CharmLocator{
+				Name:     "telegraf",
+				Revision: 3,
+				Source:   applicationcharm.CharmHubSource,
+			},
+			Channel:     &deployment.Channel{Risk: deployment.RiskStable},
+			Platform:    jammy,
+			Subordinate: true,
+		},
+	}, nil)
+	s.expectModelWide(map[string]map[string]network.SpaceName{
+		"mysql": {"": network.AlphaSpaceName, "db": network.AlphaSpaceName},
+		"wordpress": {
+			"":        network.AlphaSpaceName,
+			"db":      "internal",
+			"website": "dmz",
+		},
+	})
+
+	mysqlConfig := charm.ConfigSpec{Options: map[string]charm.Option{
+		"flavour":         {Type: "string", Default: "mysql"},
+		"max-connections": {Type: "int", Default: 100},
+	}}
+	s.expectApplication(exportedApplication{
+		name: "mysql",
+		config: applicationservice.ApplicationConfig{
+			CharmConfig: mysqlConfig,
+			ApplicationConfig: charm.Config{
+				"flavour":         "mysql",
+				"max-connections": 200,
+			},
+		},
+		constraints: constraints.MustParse("mem=4G"),
+		storage: []application.StorageDirective{{
+			Name:     "database",
+			Count:    1,
+			PoolUUID: "pool-uuid",
+			Size:     10240,
+		}},
+		annotations: map[string]string{"owner": "dba"},
+		exposed: map[string]application.ExposedEndpoint{
+			"": {ExposeToCIDRs: set.NewStrings("0.0.0.0/0", "::/0")},
+		},
+	})
+	s.expectApplication(exportedApplication{
+		name: "wordpress",
+		config: applicationservice.ApplicationConfig{
+			Trust: true,
+		},
+		exposed: map[string]application.ExposedEndpoint{
+			"website": {
+				ExposeToSpaceIDs: set.NewStrings("space-2"),
+				ExposeToCIDRs:    set.NewStrings("10.0.0.0/8", "192.168.0.0/16"),
+			},
+		},
+	})
+	s.expectApplication(exportedApplication{name: "telegraf"})
+
+	s.crossModelRelationService.EXPECT().GetOffers(gomock.Any(), []crossmodelrelation.OfferFilter{{}}).Return([]*crossmodelrelation.OfferDetail{{
+		OfferName:       "mysql-db",
+		ApplicationName: "mysql",
+		Endpoints:       []crossmodelrelation.OfferEndpoint{{Name: "db"}},
+		OfferUsers: []crossmodelrelation.OfferUser{
+			{Name: "admin", Access: permission.AdminAccess},
+			{Name: "everyone@external", Access: permission.ReadAccess},
+		},
+	}}, nil)
+	s.statusService.EXPECT().GetMachineFullStatuses(gomock.Any()).Return(map[machine.Name]statusservice.Machine{
+		"0":       {Platform: jammy, Constraints: constraints.MustParse("cores=4")},
+		"1":       {Platform: jammy},
+		"1/lxd/0": {Platform: jammy},
+		"2":       {Platform: focal},
+		"3":       {Platform: jammy},
+	}, nil)
+	for _, name := range []string{"0", "1", "2"} {
+		s.annotationService.EXPECT().GetAnnotations(gomock.Any(), annotations.ID{
+			Kind: annotations.KindMachine,
+			Name: name,
+		}).Return(nil, nil)
+	}
+	s.relationService.EXPECT().GetAllRelationDetails(gomock.Any()).Return([]relation.RelationDetailsResult{{
+		Endpoints: []relation.Endpoint{
+			{ApplicationName: "wordpress", Relation: charm.Relation{Name: "db"}},
+			{ApplicationName: "mysql", Relation: charm.Relation{Name: "db"}},
+		},
+	}, {
+		Endpoints: []relation.Endpoint{
+			{ApplicationName: "telegraf", Relation: charm.Relation{Name: "juju-info"}},
+			{ApplicationName: "mysql", Relation: charm.Relation{Name: "juju-info"}},
+		},
+	}, {
+		Endpoints: []relation.Endpoint{
+			{ApplicationName: "mysql", Relation: charm.Relation{Name: "cluster"}},
+		},
+	}, {
+		Endpoints: []relation.Endpoint{
+			{ApplicationName: "remote-grafana", Relation: charm.Relation{Name: "dashboard"}},
+			{ApplicationName: "mysql", Relation: charm.Relation{Name: "dashboard"}},
+		},
+	}}, nil)
+
+	result, err := s.facade.ExportBundle(c.Context(), params.ExportBundleParams{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Error, tc.IsNil)
+	c.Check(result.Result, tc.Equals, `
+default-base: ubuntu@22.04
+applications:
+  mysql:
+    charm: mysql
+    channel: 8.0/stable
+    revision: 42
+    num_units: 2
+    to:
+    - "0"
+    - lxd:1
+    expose: true
+    options:
+      max-connections: 200
+    annotations:
+      owner: dba
+    constraints: mem=4096M
+    storage:
+      database: ebs,1,10240M
+  telegraf:
+    charm: telegraf
+    channel: stable
+    revision: 3
+  wordpress:
+    charm: wordpress
+    channel: edge
+    revision: 7
+    base: ubuntu@20.04
+    num_units: 1
+    to:
+    - "2"
+    bindings:
+      "": alpha
+      db: internal
+      website: dmz
+    trust: true
+machines:
+  "0":
+    constraints: cores=4
+  "1": {}
+  "2":
+    base: ubuntu@20.04
+relations:
+- - mysql:db
+  - wordpress:db
+- - mysql:juju-info
+  - telegraf:juju-info
+--- # overlay.yaml
+applications:
+  mysql:
+    offers:
+      mysql-db:
+        endpoints:
+        - db
+        acl:
+          admin: admin
+          everyone@external: read
+  wordpress:
+    exposed-endpoints:
+      website:
+        expose-to-spaces:
+        - dmz
+        expose-to-cidrs:
+        - 10.0.0.0/8
+        - 192.168.0.0/16
+`[1:])
+
+	// The exported bundle must be deployable as is.
+	ds, err := charm.StreamBundleDataSource(strings.NewReader(result.Result), "")
+	c.Assert(err, tc.ErrorIsNil)
+	data, err := charm.ReadAndMergeBundleData(ds)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(data.Verify(nil, nil, nil), tc.ErrorIsNil)
+}
+
+func (s *bundleSuite) TestExportBundleIncludeCharmDefaults(c *tc.C) {
+	defer s.setUpMocks(c).Finish()
+	s.facade = s.makeAPI(c)
+
+	s.expectModel(c, model.IAAS, nil)
+	s.statusService.EXPECT().GetApplicationAndUnitStatuses(gomock.Any()).Return(map[string]statusservice.Application{
+		"mysql": {
+			CharmLocator: applicationcharm.CharmLocator{
+				Name:     "mysql",
+				Revision: 1,
+				Source:   applicationcharm.LocalSource,
+			},
+			Platform: jammy,
+		},
+	}, nil)
+	s.expectModelWide(nil)
+	s.expectApplication(exportedApplication{
+		name: "mysql",
+		config: applicationservice.ApplicationConfig{
+			CharmConfig: charm.ConfigSpec{Options: map[string]charm.Option{
+				"flavour":         {Type: "string", Default: "mysql"},
+				"max-connections": {Type: "int", Default: 100},
+				"password":        {Type: "string"},
+			}},
+			ApplicationConfig: charm.Config{
+				"flavour": "percona",
+			},
+		},
+	})
+	s.crossModelRelationService.EXPECT().GetOffers(gomock.Any(), gomock.Any()).Return(nil, nil)
+	s.relationService.EXPECT().GetAllRelationDetails(gomock.Any()).Return(nil, nil)
+
+	result, err := s.facade.ExportBundle(c.Context(), params.ExportBundleParams{
+		IncludeCharmDefaults: true,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Error, tc.IsNil)
+	c.Check(result.Result, tc.Equals, `
+default-base: ubuntu@22.04
+applications:
+  mysql:
+    charm: local:amd64/mysql-1
+    revision: 1
+    options:
+      flavour: percona
+      max-connections: 100
+`[1:])
+}
+
+func (s *bundleSuite) TestExportBundleOptionsMatchingDefaultsOfAnotherType(c *tc.C) {
+	defer s.setUpMocks(c).Finish()
+	s.facade = s.makeAPI(c)
+
+	s.expectModel(c, model.IAAS, nil)
+	s.statusService.EXPECT().GetApplicationAndUnitStatuses(gomock.Any()).Return(map[string]statusservice.Application{
+		"mysql": {
+			CharmLocator: applicationcharm.CharmLocator{
+				Name:     "mysql",
+				Revision: 1,
+				Source:   applicationcharm.LocalSource,
+			},
+			Platform: jammy,
+		},
+	}, nil)
+	s.expectModelWide(nil)
+	s.expectApplication(exportedApplication{
+		name: "mysql",
+		config: applicationservice.ApplicationConfig{
+			CharmConfig: charm.ConfigSpec{Options: map[string]charm.Option{
+				"max-connections": {Type: "int", Default: 100},
+				"cache-ratio":     {Type: "float", Default: 0.5},
+				"debug":           {Type: "boolean", Default: false},
+			}},
+			ApplicationConfig: charm.Config{
+				"max-connections": int64(100),
+				"cache-ratio":     float64(0.75),
+				"debug":           false,
+			},
+		},
+	})
+	s.crossModelRelationService.EXPECT().GetOffers(gomock.Any(), gomock.Any()).Return(nil, nil)
+	s.relationService.EXPECT().GetAllRelationDetails(gomock.Any()).Return(nil, nil)
+
+	result, err := s.facade.ExportBundle(c.Context(), params.ExportBundleParams{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Error, tc.IsNil)
+	c.Check(result.Result, tc.Equals, `
+default-base: ubuntu@22.04
+applications:
+  mysql:
+    charm: local:amd64/mysql-1
+    revision: 1
+    options:
+      cache-ratio: 0.75
+`[1:])
+}
+
+func (s *bundleSuite) TestExportBundleKubernetes(c *tc.C) {
+	defer s.setUpMocks(c).Finish()
+	s.facade = s.makeAPI(c)
+
+	scale := 3
+	s.expectModel(c, model.CAAS, nil)
+	s.statusService.EXPECT().GetApplicationAndUnitStatuses(gomock.Any()).Return(map[string]statusservice.Application{
+		"gitlab": {
+			CharmLocator: applicationcharm.CharmLocator{
+				Name:     "gitlab-k8s",
+				Revision: 12,
+				Source:   applicationcharm.CharmHubSource,
+			},
+			Channel:  &deployment.Channel{Track: "latest", Risk: deployment.RiskStable},
+			Platform: jammy,
+			Scale:    &scale,
+			Units: map[unit.Name]statusservice.Unit{
+				"gitlab/0": {},
+				"gitlab/1": {},
+			},
+		},
+	}, nil)
+	s.expectModelWide(nil)
+	s.expectApplication(exportedApplication{name: "gitlab"})
+	s.crossModelRelationService.EXPECT().GetOffers(gomock.Any(), gomock.Any()).Return(nil, nil)
+	s.relationService.EXPECT().GetAllRelationDetails(gomock.Any()).Return(nil, nil)
+
+	result, err := s.facade.ExportBundle(c.Context(), params.ExportBundleParams{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Error, tc.IsNil)
+	c.Check(result.Result, tc.Equals, `
+bundle: kubernetes
+default-base: ubuntu@22.04
+applications:
+  gitlab:
+    charm: gitlab-k8s
+    channel: latest/stable
+    revision: 12
+    scale: 3
+`[1:])
+}
+
+func (s *bundleSuite) TestExportBundleNoApplications(c *tc.C) {
+	defer s.setUpMocks(c).Finish()
+	s.facade = s.makeAPI(c)
+
+	s.modelInfoService.EXPECT().GetModelInfo(gomock.Any()).Return(model.ModelInfo{
+		Name: "testmodel",
+		Type: model.IAAS,
+	}, nil)
+	s.statusService.EXPECT().GetApplicationAndUnitStatuses(gomock.Any()).Return(nil, nil)
+
+	result, err := s.facade.ExportBundle(c.Context(), params.ExportBundleParams{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result.Error, tc.ErrorMatches, `applications in model "testmodel" not found`)
+}
+
+func (s *bundleSuite) TestExportBundlePermissionDenied(c *tc.C) {
+	defer s.setUpMocks(c).Finish()
+	s.auth.Tag = names.NewUserTag("nobody")
+	s.facade = s.makeAPI(c)
+
+	_, err := s.facade.ExportBundle(c.Context(), params.ExportBundleParams{})
+	c.Check(err, tc.ErrorMatches, "permission denied")
+}
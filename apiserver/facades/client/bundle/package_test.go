@@ -3,5 +3,5 @@
 
 package bundle_test
 
-//go:generate go run go.uber.org/mock/mockgen -typed -package bundle_test -destination service_mock_test.go github.com/juju/juju/apiserver/facades/client/bundle NetworkService,ApplicationService
+//go:generate go run go.uber.org/mock/mockgen -typed -package bundle_test -destination service_mock_test.go github.com/juju/juju/apiserver/facades/client/bundle NetworkService,ApplicationService,StatusService,RelationService,CrossModelRelationService,AnnotationService,StorageService,ModelConfigService,ModelInfoService
 //go:generate go run go.uber.org/mock/mockgen -typed -package bundle_test -destination charm_mock_test.go github.com/juju/juju/internal/charm Charm
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/facades/client/bundle (interfaces: NetworkService,ApplicationService)
+// Source: github.com/juju/juju/apiserver/facades/client/bundle (interfaces: NetworkService,ApplicationService,StatusService,RelationService,CrossModelRelationService,AnnotationService,StorageService,ModelConfigService,ModelInfoService)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package bundle_test -destination service_mock_test.go github.com/juju/juju/apiserver/facades/client/bundle NetworkService,ApplicationService
+//	mockgen -typed -package bundle_test -destination service_mock_test.go github.com/juju/juju/apiserver/facades/client/bundle NetworkService,ApplicationService,StatusService,RelationService,CrossModelRelationService,AnnotationService,StorageService,ModelConfigService,ModelInfoService
 //
 
 // Package bundle_test is a generated GoMock package.
@@ -13,8 +13,20 @@ import (
 	context "context"
 	reflect "reflect"
 
+	annotations "github.com/juju/juju/core/annotations"
+	application "github.com/juju/juju/core/application"
+	constraints "github.com/juju/juju/core/constraints"
+	machine "github.com/juju/juju/core/machine"
+	model "github.com/juju/juju/core/model"
 	network "github.com/juju/juju/core/network"
+	application0 "github.com/juju/juju/domain/application"
 	charm "github.com/juju/juju/domain/application/charm"
+	service "github.com/juju/juju/domain/application/service"
+	crossmodelrelation "github.com/juju/juju/domain/crossmodelrelation"
+	relation "github.com/juju/juju/domain/relation"
+	service0 "github.com/juju/juju/domain/status/service"
+	storage "github.com/juju/juju/domain/storage"
+	config "github.com/juju/juju/environs/config"
 	charm0 "github.com/juju/juju/internal/charm"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -104,6 +116,201 @@ func (m *MockApplicationService) EXPECT() *MockApplicationServiceMockRecorder {
 	return m.recorder
 }
 
+// GetAllEndpointBindings mocks base method.
+func (m *MockApplicationService) GetAllEndpointBindings(arg0 context.Context) (map[string]map[string]network.SpaceName, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllEndpointBindings", arg0)
+	ret0, _ := ret[0].(map[string]map[string]network.SpaceName)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllEndpointBindings indicates an expected call of GetAllEndpointBindings.
+func (mr *MockApplicationServiceMockRecorder) GetAllEndpointBindings(arg0 any) *MockApplicationServiceGetAllEndpointBindingsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllEndpointBindings", reflect.TypeOf((*MockApplicationService)(nil).GetAllEndpointBindings), arg0)
+	return &MockApplicationServiceGetAllEndpointBindingsCall{Call: call}
+}
+
+// MockApplicationServiceGetAllEndpointBindingsCall wrap *gomock.Call
+type MockApplicationServiceGetAllEndpointBindingsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceGetAllEndpointBindingsCall) Return(arg0 map[string]map[string]network.SpaceName, arg1 error) *MockApplicationServiceGetAllEndpointBindingsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceGetAllEndpointBindingsCall) Do(f func(context.Context) (map[string]map[string]network.SpaceName, error)) *MockApplicationServiceGetAllEndpointBindingsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceGetAllEndpointBindingsCall) DoAndReturn(f func(context.Context) (map[string]map[string]network.SpaceName, error)) *MockApplicationServiceGetAllEndpointBindingsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetApplicationAndCharmConfig mocks base method.
+func (m *MockApplicationService) GetApplicationAndCharmConfig(arg0 context.Context, arg1 application.ID) (service.ApplicationConfig, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationAndCharmConfig", arg0, arg1)
+	ret0, _ := ret[0].(service.ApplicationConfig)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationAndCharmConfig indicates an expected call of GetApplicationAndCharmConfig.
+func (mr *MockApplicationServiceMockRecorder) GetApplicationAndCharmConfig(arg0, arg1 any) *MockApplicationServiceGetApplicationAndCharmConfigCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationAndCharmConfig", reflect.TypeOf((*MockApplicationService)(nil).GetApplicationAndCharmConfig), arg0, arg1)
+	return &MockApplicationServiceGetApplicationAndCharmConfigCall{Call: call}
+}
+
+// MockApplicationServiceGetApplicationAndCharmConfigCall wrap *gomock.Call
+type MockApplicationServiceGetApplicationAndCharmConfigCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceGetApplicationAndCharmConfigCall) Return(arg0 service.ApplicationConfig, arg1 error) *MockApplicationServiceGetApplicationAndCharmConfigCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceGetApplicationAndCharmConfigCall) Do(f func(context.Context, application.ID) (service.ApplicationConfig, error)) *MockApplicationServiceGetApplicationAndCharmConfigCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceGetApplicationAndCharmConfigCall) DoAndReturn(f func(context.Context, application.ID) (service.ApplicationConfig, error)) *MockApplicationServiceGetApplicationAndCharmConfigCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetApplicationConstraints mocks base method.
+func (m *MockApplicationService) GetApplicationConstraints(arg0 context.Context, arg1 application.ID) (constraints.Value, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationConstraints", arg0, arg1)
+	ret0, _ := ret[0].(constraints.Value)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationConstraints indicates an expected call of GetApplicationConstraints.
+func (mr *MockApplicationServiceMockRecorder) GetApplicationConstraints(arg0, arg1 any) *MockApplicationServiceGetApplicationConstraintsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationConstraints", reflect.TypeOf((*MockApplicationService)(nil).GetApplicationConstraints), arg0, arg1)
+	return &MockApplicationServiceGetApplicationConstraintsCall{Call: call}
+}
+
+// MockApplicationServiceGetApplicationConstraintsCall wrap *gomock.Call
+type MockApplicationServiceGetApplicationConstraintsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceGetApplicationConstraintsCall) Return(arg0 constraints.Value, arg1 error) *MockApplicationServiceGetApplicationConstraintsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceGetApplicationConstraintsCall) Do(f func(context.Context, application.ID) (constraints.Value, error)) *MockApplicationServiceGetApplicationConstraintsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceGetApplicationConstraintsCall) DoAndReturn(f func(context.Context, application.ID) (constraints.Value, error)) *MockApplicationServiceGetApplicationConstraintsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetApplicationIDByName mocks base method.
+func (m *MockApplicationService) GetApplicationIDByName(arg0 context.Context, arg1 string) (application.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationIDByName", arg0, arg1)
+	ret0, _ := ret[0].(application.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationIDByName indicates an expected call of GetApplicationIDByName.
+func (mr *MockApplicationServiceMockRecorder) GetApplicationIDByName(arg0, arg1 any) *MockApplicationServiceGetApplicationIDByNameCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationIDByName", reflect.TypeOf((*MockApplicationService)(nil).GetApplicationIDByName), arg0, arg1)
+	return &MockApplicationServiceGetApplicationIDByNameCall{Call: call}
+}
+
+// MockApplicationServiceGetApplicationIDByNameCall wrap *gomock.Call
+type MockApplicationServiceGetApplicationIDByNameCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceGetApplicationIDByNameCall) Return(arg0 application.ID, arg1 error) *MockApplicationServiceGetApplicationIDByNameCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceGetApplicationIDByNameCall) Do(f func(context.Context, string) (application.ID, error)) *MockApplicationServiceGetApplicationIDByNameCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceGetApplicationIDByNameCall) DoAndReturn(f func(context.Context, string) (application.ID, error)) *MockApplicationServiceGetApplicationIDByNameCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetApplicationStorageDirectives mocks base method.
+func (m *MockApplicationService) GetApplicationStorageDirectives(arg0 context.Context, arg1 application.ID) ([]application0.StorageDirective, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationStorageDirectives", arg0, arg1)
+	ret0, _ := ret[0].([]application0.StorageDirective)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationStorageDirectives indicates an expected call of GetApplicationStorageDirectives.
+func (mr *MockApplicationServiceMockRecorder) GetApplicationStorageDirectives(arg0, arg1 any) *MockApplicationServiceGetApplicationStorageDirectivesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationStorageDirectives", reflect.TypeOf((*MockApplicationService)(nil).GetApplicationStorageDirectives), arg0, arg1)
+	return &MockApplicationServiceGetApplicationStorageDirectivesCall{Call: call}
+}
+
+// MockApplicationServiceGetApplicationStorageDirectivesCall wrap *gomock.Call
+type MockApplicationServiceGetApplicationStorageDirectivesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceGetApplicationStorageDirectivesCall) Return(arg0 []application0.StorageDirective, arg1 error) *MockApplicationServiceGetApplicationStorageDirectivesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceGetApplicationStorageDirectivesCall) Do(f func(context.Context, application.ID) ([]application0.StorageDirective, error)) *MockApplicationServiceGetApplicationStorageDirectivesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceGetApplicationStorageDirectivesCall) DoAndReturn(f func(context.Context, application.ID) ([]application0.StorageDirective, error)) *MockApplicationServiceGetApplicationStorageDirectivesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetCharm mocks base method.
 func (m *MockApplicationService) GetCharm(arg0 context.Context, arg1 charm.CharmLocator) (charm0.Charm, charm.CharmLocator, bool, error) {
 	m.ctrl.T.Helper()
@@ -144,3 +351,515 @@ func (c *MockApplicationServiceGetCharmCall) DoAndReturn(f func(context.Context,
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// GetExposedEndpoints mocks base method.
+func (m *MockApplicationService) GetExposedEndpoints(arg0 context.Context, arg1 string) (map[string]application0.ExposedEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExposedEndpoints", arg0, arg1)
+	ret0, _ := ret[0].(map[string]application0.ExposedEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExposedEndpoints indicates an expected call of GetExposedEndpoints.
+func (mr *MockApplicationServiceMockRecorder) GetExposedEndpoints(arg0, arg1 any) *MockApplicationServiceGetExposedEndpointsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExposedEndpoints", reflect.TypeOf((*MockApplicationService)(nil).GetExposedEndpoints), arg0, arg1)
+	return &MockApplicationServiceGetExposedEndpointsCall{Call: call}
+}
+
+// MockApplicationServiceGetExposedEndpointsCall wrap *gomock.Call
+type MockApplicationServiceGetExposedEndpointsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceGetExposedEndpointsCall) Return(arg0 map[string]application0.ExposedEndpoint, arg1 error) *MockApplicationServiceGetExposedEndpointsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceGetExposedEndpointsCall) Do(f func(context.Context, string) (map[string]application0.ExposedEndpoint, error)) *MockApplicationServiceGetExposedEndpointsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceGetExposedEndpointsCall) DoAndReturn(f func(context.Context, string) (map[string]application0.ExposedEndpoint, error)) *MockApplicationServiceGetExposedEndpointsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockStatusService is a mock of StatusService interface.
+type MockStatusService struct {
+	ctrl     *gomock.Controller
+	recorder *MockStatusServiceMockRecorder
+}
+
+// MockStatusServiceMockRecorder is the mock recorder for MockStatusService.
+type MockStatusServiceMockRecorder struct {
+	mock *MockStatusService
+}
+
+// NewMockStatusService creates a new mock instance.
+func NewMockStatusService(ctrl *gomock.Controller) *MockStatusService {
+	mock := &MockStatusService{ctrl: ctrl}
+	mock.recorder = &MockStatusServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStatusService) EXPECT() *MockStatusServiceMockRecorder {
+	return m.recorder
+}
+
+// GetApplicationAndUnitStatuses mocks base method.
+func (m *MockStatusService) GetApplicationAndUnitStatuses(arg0 context.Context) (map[string]service0.Application, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationAndUnitStatuses", arg0)
+	ret0, _ := ret[0].(map[string]service0.Application)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationAndUnitStatuses indicates an expected call of GetApplicationAndUnitStatuses.
+func (mr *MockStatusServiceMockRecorder) GetApplicationAndUnitStatuses(arg0 any) *MockStatusServiceGetApplicationAndUnitStatusesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationAndUnitStatuses", reflect.TypeOf((*MockStatusService)(nil).GetApplicationAndUnitStatuses), arg0)
+	return &MockStatusServiceGetApplicationAndUnitStatusesCall{Call: call}
+}
+
+// MockStatusServiceGetApplicationAndUnitStatusesCall wrap *gomock.Call
+type MockStatusServiceGetApplicationAndUnitStatusesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatusServiceGetApplicationAndUnitStatusesCall) Return(arg0 map[string]service0.Application, arg1 error) *MockStatusServiceGetApplicationAndUnitStatusesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatusServiceGetApplicationAndUnitStatusesCall) Do(f func(context.Context) (map[string]service0.Application, error)) *MockStatusServiceGetApplicationAndUnitStatusesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatusServiceGetApplicationAndUnitStatusesCall) DoAndReturn(f func(context.Context) (map[string]service0.Application, error)) *MockStatusServiceGetApplicationAndUnitStatusesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetMachineFullStatuses mocks base method.
+func (m *MockStatusService) GetMachineFullStatuses(arg0 context.Context) (map[machine.Name]service0.Machine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMachineFullStatuses", arg0)
+	ret0, _ := ret[0].(map[machine.Name]service0.Machine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMachineFullStatuses indicates an expected call of GetMachineFullStatuses.
+func (mr *MockStatusServiceMockRecorder) GetMachineFullStatuses(arg0 any) *MockStatusServiceGetMachineFullStatusesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMachineFullStatuses", reflect.TypeOf((*MockStatusService)(nil).GetMachineFullStatuses), arg0)
+	return &MockStatusServiceGetMachineFullStatusesCall{Call: call}
+}
+
+// MockStatusServiceGetMachineFullStatusesCall wrap *gomock.Call
+type MockStatusServiceGetMachineFullStatusesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatusServiceGetMachineFullStatusesCall) Return(arg0 map[machine.Name]service0.Machine, arg1 error) *MockStatusServiceGetMachineFullStatusesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatusServiceGetMachineFullStatusesCall) Do(f func(context.Context) (map[machine.Name]service0.Machine, error)) *MockStatusServiceGetMachineFullStatusesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatusServiceGetMachineFullStatusesCall) DoAndReturn(f func(context.Context) (map[machine.Name]service0.Machine, error)) *MockStatusServiceGetMachineFullStatusesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockRelationService is a mock of RelationService interface.
+type MockRelationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRelationServiceMockRecorder
+}
+
+// MockRelationServiceMockRecorder is the mock recorder for MockRelationService.
+type MockRelationServiceMockRecorder struct {
+	mock *MockRelationService
+}
+
+// NewMockRelationService creates a new mock instance.
+func NewMockRelationService(ctrl *gomock.Controller) *MockRelationService {
+	mock := &MockRelationService{ctrl: ctrl}
+	mock.recorder = &MockRelationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRelationService) EXPECT() *MockRelationServiceMockRecorder {
+	return m.recorder
+}
+
+// GetAllRelationDetails mocks base method.
+func (m *MockRelationService) GetAllRelationDetails(arg0 context.Context) ([]relation.RelationDetailsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllRelationDetails", arg0)
+	ret0, _ := ret[0].([]relation.RelationDetailsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllRelationDetails indicates an expected call of GetAllRelationDetails.
+func (mr *MockRelationServiceMockRecorder) GetAllRelationDetails(arg0 any) *MockRelationServiceGetAllRelationDetailsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllRelationDetails", reflect.TypeOf((*MockRelationService)(nil).GetAllRelationDetails), arg0)
+	return &MockRelationServiceGetAllRelationDetailsCall{Call: call}
+}
+
+// MockRelationServiceGetAllRelationDetailsCall wrap *gomock.Call
+type MockRelationServiceGetAllRelationDetailsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRelationServiceGetAllRelationDetailsCall) Return(arg0 []relation.RelationDetailsResult, arg1 error) *MockRelationServiceGetAllRelationDetailsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRelationServiceGetAllRelationDetailsCall) Do(f func(context.Context) ([]relation.RelationDetailsResult, error)) *MockRelationServiceGetAllRelationDetailsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRelationServiceGetAllRelationDetailsCall) DoAndReturn(f func(context.Context) ([]relation.RelationDetailsResult, error)) *MockRelationServiceGetAllRelationDetailsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockCrossModelRelationService is a mock of CrossModelRelationService interface.
+type MockCrossModelRelationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCrossModelRelationServiceMockRecorder
+}
+
+// MockCrossModelRelationServiceMockRecorder is the mock recorder for MockCrossModelRelationService.
+type MockCrossModelRelationServiceMockRecorder struct {
+	mock *MockCrossModelRelationService
+}
+
+// NewMockCrossModelRelationService creates a new mock instance.
+func NewMockCrossModelRelationService(ctrl *gomock.Controller) *MockCrossModelRelationService {
+	mock := &MockCrossModelRelationService{ctrl: ctrl}
+	mock.recorder = &MockCrossModelRelationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCrossModelRelationService) EXPECT() *MockCrossModelRelationServiceMockRecorder {
+	return m.recorder
+}
+
+// GetOffers mocks base method.
+func (m *MockCrossModelRelationService) GetOffers(arg0 context.Context, arg1 []crossmodelrelation.OfferFilter) ([]*crossmodelrelation.OfferDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOffers", arg0, arg1)
+	ret0, _ := ret[0].([]*crossmodelrelation.OfferDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOffers indicates an expected call of GetOffers.
+func (mr *MockCrossModelRelationServiceMockRecorder) GetOffers(arg0, arg1 any) *MockCrossModelRelationServiceGetOffersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOffers", reflect.TypeOf((*MockCrossModelRelationService)(nil).GetOffers), arg0, arg1)
+	return &MockCrossModelRelationServiceGetOffersCall{Call: call}
+}
+
+// MockCrossModelRelationServiceGetOffersCall wrap *gomock.Call
+type MockCrossModelRelationServiceGetOffersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCrossModelRelationServiceGetOffersCall) Return(arg0 []*crossmodelrelation.OfferDetail, arg1 error) *MockCrossModelRelationServiceGetOffersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCrossModelRelationServiceGetOffersCall) Do(f func(context.Context, []crossmodelrelation.OfferFilter) ([]*crossmodelrelation.OfferDetail, error)) *MockCrossModelRelationServiceGetOffersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCrossModelRelationServiceGetOffersCall) DoAndReturn(f func(context.Context, []crossmodelrelation.OfferFilter) ([]*crossmodelrelation.OfferDetail, error)) *MockCrossModelRelationServiceGetOffersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockAnnotationService is a mock of AnnotationService interface.
+type MockAnnotationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAnnotationServiceMockRecorder
+}
+
+// MockAnnotationServiceMockRecorder is the mock recorder for MockAnnotationService.
+type MockAnnotationServiceMockRecorder struct {
+	mock *MockAnnotationService
+}
+
+// NewMockAnnotationService creates a new mock instance.
+func NewMockAnnotationService(ctrl *gomock.Controller) *MockAnnotationService {
+	mock := &MockAnnotationService{ctrl: ctrl}
+	mock.recorder = &MockAnnotationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAnnotationService) EXPECT() *MockAnnotationServiceMockRecorder {
+	return m.recorder
+}
+
+// GetAnnotations mocks base method.
+func (m *MockAnnotationService) GetAnnotations(arg0 context.Context, arg1 annotations.ID) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAnnotations", arg0, arg1)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAnnotations indicates an expected call of GetAnnotations.
+func (mr *MockAnnotationServiceMockRecorder) GetAnnotations(arg0, arg1 any) *MockAnnotationServiceGetAnnotationsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnnotations", reflect.TypeOf((*MockAnnotationService)(nil).GetAnnotations), arg0, arg1)
+	return &MockAnnotationServiceGetAnnotationsCall{Call: call}
+}
+
+// MockAnnotationServiceGetAnnotationsCall wrap *gomock.Call
+type MockAnnotationServiceGetAnnotationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAnnotationServiceGetAnnotationsCall) Return(arg0 map[string]string, arg1 error) *MockAnnotationServiceGetAnnotationsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAnnotationServiceGetAnnotationsCall) Do(f func(context.Context, annotations.ID) (map[string]string, error)) *MockAnnotationServiceGetAnnotationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAnnotationServiceGetAnnotationsCall) DoAndReturn(f func(context.Context, annotations.ID) (map[string]string, error)) *MockAnnotationServiceGetAnnotationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockStorageService is a mock of StorageService interface.
+type MockStorageService struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageServiceMockRecorder
+}
+
+// MockStorageServiceMockRecorder is the mock recorder for MockStorageService.
+type MockStorageServiceMockRecorder struct {
+	mock *MockStorageService
+}
+
+// NewMockStorageService creates a new mock instance.
+func NewMockStorageService(ctrl *gomock.Controller) *MockStorageService {
+	mock := &MockStorageService{ctrl: ctrl}
+	mock.recorder = &MockStorageServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorageService) EXPECT() *MockStorageServiceMockRecorder {
+	return m.recorder
+}
+
+// ListStoragePools mocks base method.
+func (m *MockStorageService) ListStoragePools(arg0 context.Context) ([]storage.StoragePool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStoragePools", arg0)
+	ret0, _ := ret[0].([]storage.StoragePool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStoragePools indicates an expected call of ListStoragePools.
+func (mr *MockStorageServiceMockRecorder) ListStoragePools(arg0 any) *MockStorageServiceListStoragePoolsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStoragePools", reflect.TypeOf((*MockStorageService)(nil).ListStoragePools), arg0)
+	return &MockStorageServiceListStoragePoolsCall{Call: call}
+}
+
+// MockStorageServiceListStoragePoolsCall wrap *gomock.Call
+type MockStorageServiceListStoragePoolsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageServiceListStoragePoolsCall) Return(arg0 []storage.StoragePool, arg1 error) *MockStorageServiceListStoragePoolsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageServiceListStoragePoolsCall) Do(f func(context.Context) ([]storage.StoragePool, error)) *MockStorageServiceListStoragePoolsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageServiceListStoragePoolsCall) DoAndReturn(f func(context.Context) ([]storage.StoragePool, error)) *MockStorageServiceListStoragePoolsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockModelConfigService is a mock of ModelConfigService interface.
+type MockModelConfigService struct {
+	ctrl     *gomock.Controller
+	recorder *MockModelConfigServiceMockRecorder
+}
+
+// MockModelConfigServiceMockRecorder is the mock recorder for MockModelConfigService.
+type MockModelConfigServiceMockRecorder struct {
+	mock *MockModelConfigService
+}
+
+// NewMockModelConfigService creates a new mock instance.
+func NewMockModelConfigService(ctrl *gomock.Controller) *MockModelConfigService {
+	mock := &MockModelConfigService{ctrl: ctrl}
+	mock.recorder = &MockModelConfigServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModelConfigService) EXPECT() *MockModelConfigServiceMockRecorder {
+	return m.recorder
+}
+
+// ModelConfig mocks base method.
+func (m *MockModelConfigService) ModelConfig(arg0 context.Context) (*config.Config, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModelConfig", arg0)
+	ret0, _ := ret[0].(*config.Config)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModelConfig indicates an expected call of ModelConfig.
+func (mr *MockModelConfigServiceMockRecorder) ModelConfig(arg0 any) *MockModelConfigServiceModelConfigCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModelConfig", reflect.TypeOf((*MockModelConfigService)(nil).ModelConfig), arg0)
+	return &MockModelConfigServiceModelConfigCall{Call: call}
+}
+
+// MockModelConfigServiceModelConfigCall wrap *gomock.Call
+type MockModelConfigServiceModelConfigCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelConfigServiceModelConfigCall) Return(arg0 *config.Config, arg1 error) *MockModelConfigServiceModelConfigCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelConfigServiceModelConfigCall) Do(f func(context.Context) (*config.Config, error)) *MockModelConfigServiceModelConfigCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelConfigServiceModelConfigCall) DoAndReturn(f func(context.Context) (*config.Config, error)) *MockModelConfigServiceModelConfigCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockModelInfoService is a mock of ModelInfoService interface.
+type MockModelInfoService struct {
+	ctrl     *gomock.Controller
+	recorder *MockModelInfoServiceMockRecorder
+}
+
+// MockModelInfoServiceMockRecorder is the mock recorder for MockModelInfoService.
+type MockModelInfoServiceMockRecorder struct {
+	mock *MockModelInfoService
+}
+
+// NewMockModelInfoService creates a new mock instance.
+func NewMockModelInfoService(ctrl *gomock.Controller) *MockModelInfoService {
+	mock := &MockModelInfoService{ctrl: ctrl}
+	mock.recorder = &MockModelInfoServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModelInfoService) EXPECT() *MockModelInfoServiceMockRecorder {
+	return m.recorder
+}
+
+// GetModelInfo mocks base method.
+func (m *MockModelInfoService) GetModelInfo(arg0 context.Context) (model.ModelInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModelInfo", arg0)
+	ret0, _ := ret[0].(model.ModelInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModelInfo indicates an expected call of GetModelInfo.
+func (mr *MockModelInfoServiceMockRecorder) GetModelInfo(arg0 any) *MockModelInfoServiceGetModelInfoCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModelInfo", reflect.TypeOf((*MockModelInfoService)(nil).GetModelInfo), arg0)
+	return &MockModelInfoServiceGetModelInfoCall{Call: call}
+}
+
+// MockModelInfoServiceGetModelInfoCall wrap *gomock.Call
+type MockModelInfoServiceGetModelInfoCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelInfoServiceGetModelInfoCall) Return(arg0 model.ModelInfo, arg1 error) *MockModelInfoServiceGetModelInfoCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelInfoServiceGetModelInfoCall) Do(f func(context.Context) (model.ModelInfo, error)) *MockModelInfoServiceGetModelInfoCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelInfoServiceGetModelInfoCall) DoAndReturn(f func(context.Context) (model.ModelInfo, error)) *MockModelInfoServiceGetModelInfoCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/juju/juju/apiserver/authentication"
 	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/apiserver/internal/allwatcher"
 	"github.com/juju/juju/core/leadership"
 	"github.com/juju/juju/core/permission"
 	internallogger "github.com/juju/juju/internal/logger"
@@ -27,6 +28,10 @@ type Client struct {
 
 	auth             facade.Authorizer
 	leadershipReader leadership.Reader
+	watcherRegistry  facade.WatcherRegistry
+
+	// newAllWatcher returns an all-watcher over the entities of the model.
+	newAllWatcher func() (allwatcher.AllWatcher, error)
 
 	logDir string
 	clock  clock.Clock
@@ -72,7 +77,22 @@ func (c *Client) checkIsAdmin(ctx context.Context) error {
 
 // WatchAll initiates a watcher for entities in the connected model.
 func (c *Client) WatchAll(ctx context.Context) (params.AllWatcherId, error) {
-	return params.AllWatcherId{}, errors.NotImplementedf("WatchAll")
+	if err := c.checkCanRead(ctx); err != nil {
+		return params.AllWatcherId{}, errors.Trace(err)
+	}
+
+	w, err := c.newAllWatcher()
+	if err != nil {
+		return params.AllWatcherId{}, errors.Trace(err)
+	}
+
+	// The watcher is registered without consuming its initial event, as the
+	// first call to Next must return the complete set of entities.
+	id, err := c.watcherRegistry.Register(ctx, w)
+	if err != nil {
+		return params.AllWatcherId{}, errors.Trace(err)
+	}
+	return params.AllWatcherId{AllWatcherId: id}, nil
 }
 
 // NOTE: this is necessary for the other packages that do upgrade tests.
@@ -2,7 +2,8 @@
 // Licensed under the AGPLv3, see LICENCE file for details.
 
 // Package client defines the Client facade, which is responsible for providing
-// status API methods to the Juju client. Older versions of the client facade
-// also provided the FindTools and WatchAll methods, but these are going away
-// in Juju 4.
+// status API methods to the Juju client, along with the WatchAll method which
+// starts an all-watcher over the entities of the model. Older versions of the
+// client facade also provided the FindTools method, but this is going away in
+// Juju 4.
 package client
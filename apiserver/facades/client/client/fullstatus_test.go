@@ -14,13 +14,17 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/juju/juju/apiserver/authentication"
+	coreerrors "github.com/juju/juju/core/errors"
+	coremachine "github.com/juju/juju/core/machine"
 	"github.com/juju/juju/core/model"
 	modeltesting "github.com/juju/juju/core/model/testing"
 	"github.com/juju/juju/core/permission"
 	"github.com/juju/juju/core/status"
+	coreunit "github.com/juju/juju/core/unit"
 	"github.com/juju/juju/domain/application/architecture"
 	"github.com/juju/juju/domain/application/charm"
 	"github.com/juju/juju/domain/crossmodelrelation"
+	statusservice "github.com/juju/juju/domain/status/service"
 	"github.com/juju/juju/internal/testhelpers"
 	internaluuid "github.com/juju/juju/internal/uuid"
 	"github.com/juju/juju/rpc/params"
@@ -149,6 +153,76 @@ func (s *fullStatusSuite) TestFullStatusOffersNotIncluded(c *tc.C) {
 	c.Assert(output.Offers, tc.DeepEquals, map[string]params.ApplicationOfferStatus{})
 }
 
+// TestFullStatusPatterns tests that patterns are resolved by the status
+// service and only the matching entities are fetched.
+func (s *fullStatusSuite) TestFullStatusPatterns(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// Arrange
+	client := s.client(false)
+	s.expectCheckCanRead(client, true)
+	s.expectCheckIsAdmin(client, false)
+
+	s.modelInfoService.EXPECT().GetModelInfo(c.Context()).Return(model.ModelInfo{
+		Cloud:     "aws",
+		CloudType: "ec2",
+		Type:      model.IAAS,
+	}, nil)
+	s.statusService.EXPECT().GetModelStatus(gomock.Any()).Return(status.StatusInfo{
+		Status: status.Available,
+	}, nil)
+
+	filter := statusservice.StatusFilter{
+		Applications: []string{},
+		Units:        []coreunit.Name{},
+		Machines:     []coremachine.Name{"0", "0/lxd/0"},
+	}
+	s.statusService.EXPECT().ResolveStatusPatterns(gomock.Any(), []string{"0"}).Return(filter, nil)
+	s.statusService.EXPECT().GetApplicationAndUnitStatusesForFilter(gomock.Any(), filter).Return(nil, nil)
+	s.statusService.EXPECT().GetMachineFullStatusesForFilter(gomock.Any(), filter).Return(
+		map[coremachine.Name]statusservice.Machine{
+			"0":       {Name: "0"},
+			"0/lxd/0": {Name: "0/lxd/0"},
+		}, nil)
+	s.applicationService.EXPECT().GetAllEndpointBindings(gomock.Any()).Return(nil, nil)
+	s.portService.EXPECT().GetAllOpenedPorts(gomock.Any()).Return(nil, nil)
+	s.networkService.EXPECT().GetAllSpaces(gomock.Any()).Return(nil, nil)
+	s.networkService.EXPECT().GetAllDevicesByMachineNames(gomock.Any()).Return(nil, nil)
+	s.relationService.EXPECT().GetAllRelationDetails(gomock.Any()).Return(nil, nil)
+
+	// Act
+	output, err := client.FullStatus(c.Context(), params.StatusParams{
+		Patterns: []string{"0"},
+	})
+
+	// Assert
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(output.Machines, tc.HasLen, 1)
+	c.Check(output.Machines["0"].Containers, tc.HasLen, 1)
+	c.Check(output.Applications, tc.HasLen, 0)
+}
+
+// TestFullStatusPatternsNotValid tests that a malformed pattern is reported
+// back to the caller.
+func (s *fullStatusSuite) TestFullStatusPatternsNotValid(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// Arrange
+	client := s.client(false)
+	s.expectCheckCanRead(client, true)
+
+	s.statusService.EXPECT().ResolveStatusPatterns(gomock.Any(), []string{"mysql/["}).Return(
+		statusservice.StatusFilter{}, coreerrors.NotValid)
+
+	// Act
+	_, err := client.FullStatus(c.Context(), params.StatusParams{
+		Patterns: []string{"mysql/["},
+	})
+
+	// Assert
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
 func (s *fullStatusSuite) client(isControllerModel bool) *Client {
 	return &Client{
 		controllerTag:     names.NewControllerTag(internaluuid.MustNewUUID().String()),
@@ -12,6 +12,7 @@ import (
 
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/apiserver/internal/allwatcher"
 )
 
 // Register is called to expose a package of facades onto a given registry.
@@ -34,14 +35,26 @@ func newFacadeV8(ctx facade.ModelContext) (*Client, error) {
 	}
 
 	domainServices := ctx.DomainServices()
+	modelUUID := ctx.ModelUUID()
 	client := &Client{
 		logDir: ctx.LogDir(),
 		clock:  ctx.Clock(),
 
 		controllerTag:    names.NewControllerTag(ctx.ControllerUUID()),
-		modelTag:         names.NewModelTag(ctx.ModelUUID().String()),
+		modelTag:         names.NewModelTag(modelUUID.String()),
 		auth:             authorizer,
 		leadershipReader: leadershipReader,
+		watcherRegistry:  ctx.WatcherRegistry(),
+
+		newAllWatcher: func() (allwatcher.AllWatcher, error) {
+			return allwatcher.NewModelWatcher(allwatcher.NewBackend(modelUUID, allwatcher.Services{
+				Status:             domainServices.Status(),
+				Relation:           domainServices.Relation(),
+				Annotation:         domainServices.Annotation(),
+				Port:               domainServices.Port(),
+				CrossModelRelation: domainServices.CrossModelRelation(),
+			}))
+		},
 
 		applicationService:        domainServices.Application(),
 		crossModelRelationService: domainServices.CrossModelRelation(),
@@ -60,6 +60,15 @@ type StatusService interface {
 	// applications in the model, indexed by application name.
 	GetApplicationAndUnitStatuses(context.Context) (map[string]statusservice.Application, error)
 
+	// GetApplicationAndUnitStatusesForFilter returns the application statuses
+	// of the applications and units included in the filter, indexed by
+	// application name.
+	GetApplicationAndUnitStatusesForFilter(context.Context, statusservice.StatusFilter) (map[string]statusservice.Application, error)
+
+	// ResolveStatusPatterns resolves the given status patterns into a filter
+	// of the applications, units and machines to report on.
+	ResolveStatusPatterns(ctx context.Context, patterns []string) (statusservice.StatusFilter, error)
+
 	// GetStatusHistory returns the status history based on the request.
 	GetStatusHistory(context.Context, statusservice.StatusHistoryRequest) ([]status.DetailedStatus, error)
 
@@ -70,6 +79,10 @@ type StatusService interface {
 	// by machine name.
 	GetMachineFullStatuses(ctx context.Context) (map[machine.Name]statusservice.Machine, error)
 
+	// GetMachineFullStatusesForFilter returns the machine statuses of the
+	// machines included in the filter, indexed by machine name.
+	GetMachineFullStatusesForFilter(ctx context.Context, filter statusservice.StatusFilter) (map[machine.Name]statusservice.Machine, error)
+
 	// GetStorageInstanceStatuses returns all the storage instance statuses for
 	// the model.
 	GetStorageInstanceStatuses(ctx context.Context) ([]statusservice.StorageInstance, error)
@@ -862,6 +862,45 @@ func (c *MockStatusServiceGetApplicationAndUnitStatusesCall) DoAndReturn(f func(
 	return c
 }
 
+// GetApplicationAndUnitStatusesForFilter mocks base method.
+func (m *MockStatusService) GetApplicationAndUnitStatusesForFilter(arg0 context.Context, arg1 service.StatusFilter) (map[string]service.Application, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationAndUnitStatusesForFilter", arg0, arg1)
+	ret0, _ := ret[0].(map[string]service.Application)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationAndUnitStatusesForFilter indicates an expected call of GetApplicationAndUnitStatusesForFilter.
+func (mr *MockStatusServiceMockRecorder) GetApplicationAndUnitStatusesForFilter(arg0, arg1 any) *MockStatusServiceGetApplicationAndUnitStatusesForFilterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationAndUnitStatusesForFilter", reflect.TypeOf((*MockStatusService)(nil).GetApplicationAndUnitStatusesForFilter), arg0, arg1)
+	return &MockStatusServiceGetApplicationAndUnitStatusesForFilterCall{Call: call}
+}
+
+// MockStatusServiceGetApplicationAndUnitStatusesForFilterCall wrap *gomock.Call
+type MockStatusServiceGetApplicationAndUnitStatusesForFilterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatusServiceGetApplicationAndUnitStatusesForFilterCall) Return(arg0 map[string]service.Application, arg1 error) *MockStatusServiceGetApplicationAndUnitStatusesForFilterCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatusServiceGetApplicationAndUnitStatusesForFilterCall) Do(f func(context.Context, service.StatusFilter) (map[string]service.Application, error)) *MockStatusServiceGetApplicationAndUnitStatusesForFilterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatusServiceGetApplicationAndUnitStatusesForFilterCall) DoAndReturn(f func(context.Context, service.StatusFilter) (map[string]service.Application, error)) *MockStatusServiceGetApplicationAndUnitStatusesForFilterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetFilesystemStatuses mocks base method.
 func (m *MockStatusService) GetFilesystemStatuses(arg0 context.Context) ([]service.Filesystem, error) {
 	m.ctrl.T.Helper()
@@ -940,6 +979,45 @@ func (c *MockStatusServiceGetMachineFullStatusesCall) DoAndReturn(f func(context
 	return c
 }
 
+// GetMachineFullStatusesForFilter mocks base method.
+func (m *MockStatusService) GetMachineFullStatusesForFilter(arg0 context.Context, arg1 service.StatusFilter) (map[machine.Name]service.Machine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMachineFullStatusesForFilter", arg0, arg1)
+	ret0, _ := ret[0].(map[machine.Name]service.Machine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMachineFullStatusesForFilter indicates an expected call of GetMachineFullStatusesForFilter.
+func (mr *MockStatusServiceMockRecorder) GetMachineFullStatusesForFilter(arg0, arg1 any) *MockStatusServiceGetMachineFullStatusesForFilterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMachineFullStatusesForFilter", reflect.TypeOf((*MockStatusService)(nil).GetMachineFullStatusesForFilter), arg0, arg1)
+	return &MockStatusServiceGetMachineFullStatusesForFilterCall{Call: call}
+}
+
+// MockStatusServiceGetMachineFullStatusesForFilterCall wrap *gomock.Call
+type MockStatusServiceGetMachineFullStatusesForFilterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatusServiceGetMachineFullStatusesForFilterCall) Return(arg0 map[machine.Name]service.Machine, arg1 error) *MockStatusServiceGetMachineFullStatusesForFilterCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatusServiceGetMachineFullStatusesForFilterCall) Do(f func(context.Context, service.StatusFilter) (map[machine.Name]service.Machine, error)) *MockStatusServiceGetMachineFullStatusesForFilterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatusServiceGetMachineFullStatusesForFilterCall) DoAndReturn(f func(context.Context, service.StatusFilter) (map[machine.Name]service.Machine, error)) *MockStatusServiceGetMachineFullStatusesForFilterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetModelStatus mocks base method.
 func (m *MockStatusService) GetModelStatus(arg0 context.Context) (status.StatusInfo, error) {
 	m.ctrl.T.Helper()
@@ -1095,3 +1173,42 @@ func (c *MockStatusServiceGetVolumeStatusesCall) DoAndReturn(f func(context.Cont
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// ResolveStatusPatterns mocks base method.
+func (m *MockStatusService) ResolveStatusPatterns(arg0 context.Context, arg1 []string) (service.StatusFilter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveStatusPatterns", arg0, arg1)
+	ret0, _ := ret[0].(service.StatusFilter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveStatusPatterns indicates an expected call of ResolveStatusPatterns.
+func (mr *MockStatusServiceMockRecorder) ResolveStatusPatterns(arg0, arg1 any) *MockStatusServiceResolveStatusPatternsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveStatusPatterns", reflect.TypeOf((*MockStatusService)(nil).ResolveStatusPatterns), arg0, arg1)
+	return &MockStatusServiceResolveStatusPatternsCall{Call: call}
+}
+
+// MockStatusServiceResolveStatusPatternsCall wrap *gomock.Call
+type MockStatusServiceResolveStatusPatternsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatusServiceResolveStatusPatternsCall) Return(arg0 service.StatusFilter, arg1 error) *MockStatusServiceResolveStatusPatternsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatusServiceResolveStatusPatternsCall) Do(f func(context.Context, []string) (service.StatusFilter, error)) *MockStatusServiceResolveStatusPatternsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatusServiceResolveStatusPatternsCall) DoAndReturn(f func(context.Context, []string) (service.StatusFilter, error)) *MockStatusServiceResolveStatusPatternsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
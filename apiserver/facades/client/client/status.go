@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"maps"
+	"slices"
 	"sort"
 
 	"github.com/juju/collections/set"
@@ -126,13 +127,15 @@ func (c *Client) FullStatus(ctx context.Context, args params.StatusParams) (para
 		return params.FullStatus{}, err
 	}
 
+	// Resolve any patterns into the set of entities to report on, so that
+	// only the matching entities are loaded from the status service.
+	var filter *statusservice.StatusFilter
 	if len(args.Patterns) > 0 {
-		// Patterns have been disabled until we tackle the status epic. This
-		// will require pushing the patterns down through the status service.
-		// For now, just black hole the request.
-		return params.FullStatus{}, internalerrors.Errorf("patterns are not implemented").Add(
-			errors.NotImplemented,
-		)
+		resolved, err := c.statusService.ResolveStatusPatterns(ctx, args.Patterns)
+		if err != nil {
+			return params.FullStatus{}, internalerrors.Errorf("resolving status patterns: %w", err)
+		}
+		filter = &resolved
 	}
 
 	machineJobFetcher := func(context.Context, coremachine.Name) []model.MachineJob {
@@ -158,6 +161,7 @@ func (c *Client) FullStatus(ctx context.Context, args params.StatusParams) (para
 		crossModelRelationService: c.crossModelRelationService,
 
 		machineJobFetcher: machineJobFetcher,
+		filter:            filter,
 	}
 
 	var err error
@@ -170,7 +174,7 @@ func (c *Client) FullStatus(ctx context.Context, args params.StatusParams) (para
 		return noStatus, internalerrors.Errorf("cannot obtain space information: %w", err)
 	}
 	if context.allAppsUnitsCharmBindings, context.units, err =
-		fetchAllApplicationsAndUnits(ctx, c.statusService, c.applicationService); err != nil {
+		fetchAllApplicationsAndUnits(ctx, c.statusService, c.applicationService, filter); err != nil {
 		return noStatus, internalerrors.Errorf("could not fetch applications and units: %w", err)
 	}
 	// Only admins can see offer details.
@@ -179,6 +183,7 @@ func (c *Client) FullStatus(ctx context.Context, args params.StatusParams) (para
 		if err != nil {
 			return noStatus, internalerrors.Errorf("could not fetch offers: %w", err)
 		}
+		context.filterOffers()
 	}
 	if err = context.fetchMachines(ctx); err != nil {
 		return noStatus, internalerrors.Errorf("could not fetch machines: %w", err)
@@ -194,6 +199,7 @@ func (c *Client) FullStatus(ctx context.Context, args params.StatusParams) (para
 	if context.relations, context.relationsByID, err = fetchRelations(ctx, c.relationService, c.statusService); err != nil {
 		return noStatus, internalerrors.Errorf("could not fetch relations: %w", err)
 	}
+	context.filterRelations()
 	if len(context.allAppsUnitsCharmBindings.applications) > 0 {
 		if context.leaders, err = c.leadershipReader.Leaders(); err != nil {
 			// Leader information is additive for status.
@@ -390,12 +396,16 @@ type statusContext struct {
 
 	// Information about all spaces.
 	spaceInfos network.SpaceInfos
+
+	// filter holds the entities matched by the status patterns. A nil filter
+	// reports on every entity in the model.
+	filter *statusservice.StatusFilter
 }
 
 // fetchMachines returns a map from top level machine id to machines, where machines[0] is the host
 // machine and machines[1..n] are any containers (including nested ones).
 //
-// If machineIds is non-nil, only machines whose IDs are in the set are returned.
+// If the context has a filter, only machines included in the filter are returned.
 func (c *statusContext) fetchMachines(ctx context.Context) error {
 	if c.model.Type == model.CAAS {
 		return nil
@@ -404,7 +414,15 @@ func (c *statusContext) fetchMachines(ctx context.Context) error {
 	c.machines = make(map[coremachine.Name][]statusservice.Machine)
 	c.allMachines = make(map[coremachine.Name]statusservice.Machine)
 
-	machines, err := c.statusService.GetMachineFullStatuses(ctx)
+	var (
+		machines map[coremachine.Name]statusservice.Machine
+		err      error
+	)
+	if c.filter != nil {
+		machines, err = c.statusService.GetMachineFullStatusesForFilter(ctx, *c.filter)
+	} else {
+		machines, err = c.statusService.GetMachineFullStatuses(ctx)
+	}
 	if err != nil {
 		return err
 	}
@@ -498,6 +516,7 @@ func fetchNetworkInterfaces(
 // a map from application name to unit name to unit, and a map from base charm URL to latest URL.
 func fetchAllApplicationsAndUnits(
 	ctx context.Context, statusService StatusService, applicationService ApplicationService,
+	filter *statusservice.StatusFilter,
 ) (applicationStatusInfo, map[coreunit.Name]statusservice.Unit, error) {
 	var (
 		apps         = make(map[string]statusservice.Application)
@@ -506,7 +525,15 @@ func fetchAllApplicationsAndUnits(
 		latestCharms = make(map[applicationcharm.CharmLocator]applicationcharm.CharmLocator)
 	)
 
-	applications, err := statusService.GetApplicationAndUnitStatuses(ctx)
+	var (
+		applications map[string]statusservice.Application
+		err          error
+	)
+	if filter != nil {
+		applications, err = statusService.GetApplicationAndUnitStatusesForFilter(ctx, *filter)
+	} else {
+		applications, err = statusService.GetApplicationAndUnitStatuses(ctx)
+	}
 	if err != nil {
 		return applicationStatusInfo{}, nil, err
 	}
@@ -793,6 +820,41 @@ func (c *statusContext) makeMachineStatus(
 	return
 }
 
+// filterOffers removes the offers of applications that are not included in
+// the context's filter.
+func (c *statusContext) filterOffers() {
+	if c.filter == nil {
+		return
+	}
+	for name, offer := range c.offers {
+		if _, ok := c.allAppsUnitsCharmBindings.applications[offer.ApplicationName]; !ok {
+			delete(c.offers, name)
+		}
+	}
+}
+
+// filterRelations removes the relations that don't have an endpoint on an
+// application included in the context's filter.
+func (c *statusContext) filterRelations() {
+	if c.filter == nil {
+		return
+	}
+	for id, rel := range c.relationsByID {
+		included := slices.ContainsFunc(rel.Endpoints, func(ep relation.Endpoint) bool {
+			_, ok := c.allAppsUnitsCharmBindings.applications[ep.ApplicationName]
+			return ok
+		})
+		if !included {
+			delete(c.relationsByID, id)
+		}
+	}
+	for appName := range c.relations {
+		if _, ok := c.allAppsUnitsCharmBindings.applications[appName]; !ok {
+			delete(c.relations, appName)
+		}
+	}
+}
+
 func (c *statusContext) processRelations(ctx context.Context) []params.RelationStatus {
 	var out []params.RelationStatus
 	for _, current := range c.relationsByID {
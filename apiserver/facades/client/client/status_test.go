@@ -19,6 +19,7 @@ import (
 	"github.com/juju/juju/domain/application/architecture"
 	"github.com/juju/juju/domain/application/charm"
 	"github.com/juju/juju/domain/crossmodelrelation"
+	"github.com/juju/juju/domain/relation"
 	statusservice "github.com/juju/juju/domain/status/service"
 	charm0 "github.com/juju/juju/internal/charm"
 	"github.com/juju/juju/internal/testhelpers"
@@ -273,6 +274,45 @@ func (s *statusSuite) TestFetchOffers(c *tc.C) {
 	})
 }
 
+func (s *statusSuite) TestFilterRelationsAndOffers(c *tc.C) {
+	// Arrange
+	mysqlWordpress := relationStatus{ID: 1, Endpoints: []relation.Endpoint{
+		{ApplicationName: "mysql"}, {ApplicationName: "wordpress"},
+	}}
+	grafanaPrometheus := relationStatus{ID: 2, Endpoints: []relation.Endpoint{
+		{ApplicationName: "grafana"}, {ApplicationName: "prometheus"},
+	}}
+	context := statusContext{
+		filter: &statusservice.StatusFilter{Applications: []string{"mysql"}},
+		allAppsUnitsCharmBindings: applicationStatusInfo{
+			applications: map[string]statusservice.Application{"mysql": {}},
+		},
+		relations: map[string][]relationStatus{
+			"mysql":      {mysqlWordpress},
+			"wordpress":  {mysqlWordpress},
+			"grafana":    {grafanaPrometheus},
+			"prometheus": {grafanaPrometheus},
+		},
+		relationsByID: map[int]relationStatus{1: mysqlWordpress, 2: grafanaPrometheus},
+		offers: map[string]offerStatus{
+			"db":      {ApplicationOffer: crossmodel.ApplicationOffer{ApplicationName: "mysql"}},
+			"metrics": {ApplicationOffer: crossmodel.ApplicationOffer{ApplicationName: "prometheus"}},
+		},
+	}
+
+	// Act
+	context.filterRelations()
+	context.filterOffers()
+
+	// Assert
+	c.Check(context.relations, tc.DeepEquals, map[string][]relationStatus{
+		"mysql": {mysqlWordpress},
+	})
+	c.Check(context.relationsByID, tc.DeepEquals, map[int]relationStatus{1: mysqlWordpress})
+	c.Check(context.offers, tc.HasLen, 1)
+	c.Check(context.offers["db"].ApplicationName, tc.Equals, "mysql")
+}
+
 func (s *statusSuite) setupMocks(c *tc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 
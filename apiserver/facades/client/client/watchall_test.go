@@ -0,0 +1,91 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package client
+
+import (
+	"testing"
+
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/apiserver/authentication"
+	"github.com/juju/juju/apiserver/facade/mocks"
+	"github.com/juju/juju/apiserver/internal/allwatcher"
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/rpc/params"
+)
+
+type watchAllSuite struct {
+	authorizer      *MockAuthorizer
+	watcherRegistry *mocks.MockWatcherRegistry
+}
+
+func TestWatchAllSuite(t *testing.T) {
+	tc.Run(t, &watchAllSuite{})
+}
+
+func (s *watchAllSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.authorizer = NewMockAuthorizer(ctrl)
+	s.watcherRegistry = mocks.NewMockWatcherRegistry(ctrl)
+
+	c.Cleanup(func() {
+		s.authorizer = nil
+		s.watcherRegistry = nil
+	})
+
+	return ctrl
+}
+
+func (s *watchAllSuite) client(w allwatcher.AllWatcher) *Client {
+	return &Client{
+		controllerTag:   names.NewControllerTag("deadbeef-0bad-400d-8000-4b1d0d06f00d"),
+		modelTag:        names.NewModelTag("deadbeef-0bad-400d-8000-4b1d0d06f00e"),
+		auth:            s.authorizer,
+		watcherRegistry: s.watcherRegistry,
+		newAllWatcher: func() (allwatcher.AllWatcher, error) {
+			return w, nil
+		},
+	}
+}
+
+func (s *watchAllSuite) TestWatchAll(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	w := &stubAllWatcher{}
+	client := s.client(w)
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, client.controllerTag).Return(authentication.ErrorEntityMissingPermission)
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.ReadAccess, client.modelTag).Return(nil)
+	s.watcherRegistry.EXPECT().Register(gomock.Any(), w).Return("42", nil)
+
+	result, err := client.WatchAll(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, params.AllWatcherId{AllWatcherId: "42"})
+}
+
+func (s *watchAllSuite) TestWatchAllNoReadAccess(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	client := s.client(nil)
+	client.newAllWatcher = func() (allwatcher.AllWatcher, error) {
+		c.Fatalf("unexpected all-watcher")
+		return nil, nil
+	}
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, client.controllerTag).Return(authentication.ErrorEntityMissingPermission)
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.ReadAccess, client.modelTag).Return(authentication.ErrorEntityMissingPermission)
+
+	_, err := client.WatchAll(c.Context())
+	c.Assert(err, tc.ErrorIs, authentication.ErrorEntityMissingPermission)
+}
+
+// stubAllWatcher is an all-watcher that never reports any deltas.
+type stubAllWatcher struct{}
+
+func (*stubAllWatcher) Changes() <-chan []params.Delta { return nil }
+func (*stubAllWatcher) Kill()                          {}
+func (*stubAllWatcher) Wait() error                    { return nil }
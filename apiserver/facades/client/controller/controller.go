@@ -25,6 +25,7 @@ import (
 	commonmodel "github.com/juju/juju/apiserver/common/model"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/apiserver/internal/allwatcher"
 	corecontroller "github.com/juju/juju/controller"
 	coreerrors "github.com/juju/juju/core/errors"
 	corelogger "github.com/juju/juju/core/logger"
@@ -85,6 +86,8 @@ type ControllerAPI struct {
 	proxyService                ProxyService
 	modelExporter               func(context.Context, coremodel.UUID) (ModelExporter, error)
 	store                       objectstore.ObjectStore
+	watcherRegistry             facade.WatcherRegistry
+	newAllModelWatcher          func() (allwatcher.AllWatcher, error)
 	logger                      corelogger.Logger
 	controllerModelUUID         coremodel.UUID
 	controllerUUID              string
@@ -122,6 +125,8 @@ func NewControllerAPI(
 	proxyService ProxyService,
 	modelExporter func(context.Context, coremodel.UUID) (ModelExporter, error),
 	store objectstore.ObjectStore,
+	watcherRegistry facade.WatcherRegistry,
+	newAllModelWatcher func() (allwatcher.AllWatcher, error),
 	controllerModelUUID coremodel.UUID,
 	controllerUUID string,
 ) (*ControllerAPI, error) {
@@ -176,6 +181,8 @@ func NewControllerAPI(
 		proxyService:                proxyService,
 		modelExporter:               modelExporter,
 		store:                       store,
+		watcherRegistry:             watcherRegistry,
+		newAllModelWatcher:          newAllModelWatcher,
 		controllerModelUUID:         controllerModelUUID,
 		controllerUUID:              controllerUUID,
 	}, nil
@@ -440,7 +447,19 @@ func (c *ControllerAPI) WatchAllModels(ctx context.Context) (params.AllWatcherId
 	if err := c.checkIsSuperUser(ctx); err != nil {
 		return params.AllWatcherId{}, errors.Trace(err)
 	}
-	return params.AllWatcherId{}, errors.NotImplementedf("WatchAllModels")
+
+	w, err := c.newAllModelWatcher()
+	if err != nil {
+		return params.AllWatcherId{}, errors.Trace(err)
+	}
+
+	// The watcher is registered without consuming its initial event, as the
+	// first call to Next must return the complete set of entities.
+	id, err := c.watcherRegistry.Register(ctx, w)
+	if err != nil {
+		return params.AllWatcherId{}, errors.Trace(err)
+	}
+	return params.AllWatcherId{AllWatcherId: id}, nil
 }
 
 // WatchAllModelSummaries starts watching the summary updates from the cache.
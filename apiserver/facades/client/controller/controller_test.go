@@ -252,6 +252,8 @@ func (s *controllerSuite) controllerAPI(c *tc.C) *controller.ControllerAPI {
 			return ctx.ModelExporter(c, modelUUID)
 		},
 		ctx.ObjectStore(),
+		ctx.WatcherRegistry(),
+		nil,
 		ctx.ControllerModelUUID(),
 		ctx.ControllerUUID(),
 	)
@@ -679,6 +681,25 @@ func (s *controllerSuite) TestWatchAllModelSummariesByNonAdmin(c *tc.C) {
 	c.Assert(err, tc.ErrorMatches, "permission denied")
 }
 
+func (s *controllerSuite) TestWatchAllModelsByNonAdmin(c *tc.C) {
+	anAuthoriser := apiservertesting.FakeAuthorizer{
+		Tag: names.NewLocalUserTag("bob"),
+	}
+	endPoint, err := controller.LatestAPI(
+		c.Context(),
+		facadetest.MultiModelContext{
+			ModelContext: facadetest.ModelContext{
+				Auth_:           anAuthoriser,
+				DomainServices_: s.ControllerDomainServices(c),
+				Logger_:         loggertesting.WrapCheckLog(c),
+			},
+		})
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = endPoint.WatchAllModels(c.Context())
+	c.Assert(err, tc.ErrorMatches, "permission denied")
+}
+
 type accessSuite struct {
 	authorizer apiservertesting.FakeAuthorizer
 
@@ -737,6 +758,8 @@ func (s *accessSuite) controllerAPI(c *tc.C) *controller.ControllerAPI {
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
 		s.controllerModelUUID,
 		s.controllerUUID,
 	)
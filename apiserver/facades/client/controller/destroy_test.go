@@ -199,6 +199,8 @@ func (s *destroyControllerSuite) controllerAPI(c *tc.C) *controller.ControllerAP
 			return ctx.ModelExporter(c, modelUUID)
 		},
 		ctx.ObjectStore(),
+		ctx.WatcherRegistry(),
+		nil,
 		ctx.ControllerModelUUID(),
 		ctx.ControllerUUID(),
 	)
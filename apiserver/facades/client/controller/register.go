@@ -11,6 +11,7 @@ import (
 	"github.com/juju/errors"
 
 	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/apiserver/internal/allwatcher"
 	"github.com/juju/juju/core/model"
 )
 
@@ -134,6 +135,24 @@ func makeControllerAPI(stdCtx context.Context, ctx facade.MultiModelContext) (*C
 		}
 		return svc.Removal(), nil
 	}
+	newAllModelWatcher := func() (allwatcher.AllWatcher, error) {
+		return allwatcher.NewAllModelWatcher(
+			domainServices.Model(),
+			func(c context.Context, modelUUID model.UUID) (allwatcher.Backend, error) {
+				svc, err := ctx.DomainServicesForModel(c, modelUUID)
+				if err != nil {
+					return nil, errors.Trace(err)
+				}
+				return allwatcher.NewBackend(modelUUID, allwatcher.Services{
+					Status:             svc.Status(),
+					Relation:           svc.Relation(),
+					Annotation:         svc.Annotation(),
+					Port:               svc.Port(),
+					CrossModelRelation: svc.CrossModelRelation(),
+				}), nil
+			},
+		)
+	}
 
 	return NewControllerAPI(
 		stdCtx,
@@ -163,6 +182,8 @@ func makeControllerAPI(stdCtx context.Context, ctx facade.MultiModelContext) (*C
 			return ctx.ModelExporter(c, modelUUID)
 		},
 		ctx.ObjectStore(),
+		ctx.WatcherRegistry(),
+		newAllModelWatcher,
 		ctx.ControllerModelUUID(),
 		ctx.ControllerUUID(),
 	)
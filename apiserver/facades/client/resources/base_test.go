@@ -7,17 +7,21 @@ import (
 	"context"
 	"time"
 
+	"github.com/juju/names/v6"
 	"github.com/juju/tc"
 	"go.uber.org/mock/gomock"
 
+	apiservertesting "github.com/juju/juju/apiserver/testing"
 	coreresource "github.com/juju/juju/core/resource"
 	resourcetesting "github.com/juju/juju/core/resource/testing"
 	"github.com/juju/juju/internal/charm"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
+	coretesting "github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/rpc/params"
 )
 
 type BaseSuite struct {
+	authorizer         apiservertesting.FakeAuthorizer
 	applicationService *MockApplicationService
 	resourceService    *MockResourceService
 	repository         *MockNewCharmRepository
@@ -27,6 +31,10 @@ type BaseSuite struct {
 func (s *BaseSuite) setupMocks(c *tc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 
+	s.authorizer = apiservertesting.FakeAuthorizer{
+		Tag:      names.NewUserTag("admin"),
+		AdminTag: names.NewUserTag("admin"),
+	}
 	s.applicationService = NewMockApplicationService(ctrl)
 	s.resourceService = NewMockResourceService(ctrl)
 	s.repository = NewMockNewCharmRepository(ctrl)
@@ -36,7 +44,7 @@ func (s *BaseSuite) setupMocks(c *tc.C) *gomock.Controller {
 }
 
 func (s *BaseSuite) newFacade(c *tc.C) *API {
-	facade, err := NewResourcesAPI(s.applicationService, s.resourceService, s.factory,
+	facade, err := NewResourcesAPI(s.authorizer, coretesting.ModelTag, s.applicationService, s.resourceService, s.factory,
 		loggertesting.WrapCheckLog(c))
 	c.Assert(err, tc.ErrorIsNil)
 	return facade
@@ -33,6 +33,9 @@ import (
 
 // API is the public API facade for resources.
 type API struct {
+	authorizer facade.Authorizer
+	modelTag   names.ModelTag
+
 	applicationService ApplicationService
 	resourceService    ResourceService
 
@@ -84,7 +87,14 @@ func NewFacade(ctx facade.ModelContext) (*API, error) {
 		}
 	}
 
-	f, err := NewResourcesAPI(ctx.DomainServices().Application(), ctx.DomainServices().Resource(), factory, logger)
+	f, err := NewResourcesAPI(
+		authorizer,
+		names.NewModelTag(ctx.ModelUUID().String()),
+		ctx.DomainServices().Application(),
+		ctx.DomainServices().Resource(),
+		factory,
+		logger,
+	)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -93,6 +103,8 @@ func NewFacade(ctx facade.ModelContext) (*API, error) {
 
 // NewResourcesAPI returns a new resources API facade.
 func NewResourcesAPI(
+	authorizer facade.Authorizer,
+	modelTag names.ModelTag,
 	applicationService ApplicationService,
 	resourceService ResourceService,
 	factory func(context.Context, *charm.URL) (NewCharmRepository, error),
@@ -113,6 +125,8 @@ func NewResourcesAPI(
 	}
 
 	f := &API{
+		authorizer:         authorizer,
+		modelTag:           modelTag,
 		applicationService: applicationService,
 		resourceService:    resourceService,
 		factory:            factory,
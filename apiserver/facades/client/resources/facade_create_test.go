@@ -9,6 +9,7 @@ import (
 	"github.com/juju/tc"
 
 	loggertesting "github.com/juju/juju/internal/logger/testing"
+	coretesting "github.com/juju/juju/internal/testing"
 )
 
 func TestFacadeSuite(t *testing.T) {
@@ -21,24 +22,24 @@ type FacadeSuite struct {
 
 func (s *FacadeSuite) TestNewFacadeOkay(c *tc.C) {
 	defer s.setupMocks(c).Finish()
-	_, err := NewResourcesAPI(s.applicationService, s.resourceService, s.factory, loggertesting.WrapCheckLog(c))
+	_, err := NewResourcesAPI(s.authorizer, coretesting.ModelTag, s.applicationService, s.resourceService, s.factory, loggertesting.WrapCheckLog(c))
 	c.Check(err, tc.ErrorIsNil)
 }
 
 func (s *FacadeSuite) TestNewFacadeMissingApplicationService(c *tc.C) {
 	defer s.setupMocks(c).Finish()
-	_, err := NewResourcesAPI(nil, s.resourceService, s.factory, loggertesting.WrapCheckLog(c))
+	_, err := NewResourcesAPI(s.authorizer, coretesting.ModelTag, nil, s.resourceService, s.factory, loggertesting.WrapCheckLog(c))
 	c.Check(err, tc.ErrorMatches, ".*missing application service.*")
 }
 
 func (s *FacadeSuite) TestNewFacadeMissingResourceService(c *tc.C) {
 	defer s.setupMocks(c).Finish()
-	_, err := NewResourcesAPI(s.applicationService, nil, s.factory, loggertesting.WrapCheckLog(c))
+	_, err := NewResourcesAPI(s.authorizer, coretesting.ModelTag, s.applicationService, nil, s.factory, loggertesting.WrapCheckLog(c))
 	c.Check(err, tc.ErrorMatches, ".*missing resource service.*")
 }
 
 func (s *FacadeSuite) TestNewFacadeMissingFactory(c *tc.C) {
 	defer s.setupMocks(c).Finish()
-	_, err := NewResourcesAPI(s.applicationService, s.resourceService, nil, loggertesting.WrapCheckLog(c))
+	_, err := NewResourcesAPI(s.authorizer, coretesting.ModelTag, s.applicationService, s.resourceService, nil, loggertesting.WrapCheckLog(c))
 	c.Check(err, tc.ErrorMatches, ".*missing factory for new repository.*")
 }
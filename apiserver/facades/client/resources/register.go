@@ -22,13 +22,35 @@ var _ = Register
 func Register(registry facade.FacadeRegistry) {
 	registry.MustRegister("Resources", 3, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
 		return newFacadeV3(ctx)
+	}, reflect.TypeOf((*APIV3)(nil)))
+	registry.MustRegister("Resources", 4, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newFacadeV4(ctx)
 	}, reflect.TypeOf((*API)(nil)))
 }
 
-func newFacadeV3(ctx facade.ModelContext) (*API, error) {
+// APIV3 provides the Resources API facade for version 3.
+type APIV3 struct {
+	*API
+}
+
+func newFacadeV3(ctx facade.ModelContext) (*APIV3, error) {
+	api, err := newFacadeV4(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &APIV3{API: api}, nil
+}
+
+func newFacadeV4(ctx facade.ModelContext) (*API, error) {
 	api, err := NewFacade(ctx)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
 	return api, nil
 }
+
+// StorageReport isn't on the v3 API.
+func (*APIV3) StorageReport(_ context.Context, _ struct{}) {}
+
+// PruneStorage isn't on the v3 API.
+func (*APIV3) PruneStorage(_ context.Context, _ struct{}) {}
@@ -38,6 +38,16 @@ type ResourceService interface {
 	// table with the desired parameters and sets it on the application. Any
 	// previous resource blob is removed. The new resource UUID is returned.
 	UpdateUploadResource(ctx context.Context, resourceToUpdate coreresource.UUID) (coreresource.UUID, error)
+
+	// GetStoredBlobs returns the blobs held in the model's object store on
+	// behalf of charms and resources, along with the charm and resource
+	// revisions referring to them.
+	GetStoredBlobs(ctx context.Context) ([]resource.StoredBlob, error)
+
+	// PruneStorage removes the blobs from the model's object store which are
+	// not referred to by any charm or resource, and which were stored longer
+	// ago than the grace period. The removed blobs are returned.
+	PruneStorage(ctx context.Context, args resource.PruneStorageArgs) ([]resource.StoredBlob, error)
 }
 
 // ApplicationService defines methods to manage application.
@@ -184,6 +184,45 @@ func (c *MockResourceServiceGetApplicationResourceIDCall) DoAndReturn(f func(con
 	return c
 }
 
+// GetStoredBlobs mocks base method.
+func (m *MockResourceService) GetStoredBlobs(arg0 context.Context) ([]resource0.StoredBlob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStoredBlobs", arg0)
+	ret0, _ := ret[0].([]resource0.StoredBlob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStoredBlobs indicates an expected call of GetStoredBlobs.
+func (mr *MockResourceServiceMockRecorder) GetStoredBlobs(arg0 any) *MockResourceServiceGetStoredBlobsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStoredBlobs", reflect.TypeOf((*MockResourceService)(nil).GetStoredBlobs), arg0)
+	return &MockResourceServiceGetStoredBlobsCall{Call: call}
+}
+
+// MockResourceServiceGetStoredBlobsCall wrap *gomock.Call
+type MockResourceServiceGetStoredBlobsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockResourceServiceGetStoredBlobsCall) Return(arg0 []resource0.StoredBlob, arg1 error) *MockResourceServiceGetStoredBlobsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockResourceServiceGetStoredBlobsCall) Do(f func(context.Context) ([]resource0.StoredBlob, error)) *MockResourceServiceGetStoredBlobsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockResourceServiceGetStoredBlobsCall) DoAndReturn(f func(context.Context) ([]resource0.StoredBlob, error)) *MockResourceServiceGetStoredBlobsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListResources mocks base method.
 func (m *MockResourceService) ListResources(arg0 context.Context, arg1 application.ID) (resource.ApplicationResources, error) {
 	m.ctrl.T.Helper()
@@ -223,6 +262,45 @@ func (c *MockResourceServiceListResourcesCall) DoAndReturn(f func(context.Contex
 	return c
 }
 
+// PruneStorage mocks base method.
+func (m *MockResourceService) PruneStorage(arg0 context.Context, arg1 resource0.PruneStorageArgs) ([]resource0.StoredBlob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PruneStorage", arg0, arg1)
+	ret0, _ := ret[0].([]resource0.StoredBlob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PruneStorage indicates an expected call of PruneStorage.
+func (mr *MockResourceServiceMockRecorder) PruneStorage(arg0, arg1 any) *MockResourceServicePruneStorageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneStorage", reflect.TypeOf((*MockResourceService)(nil).PruneStorage), arg0, arg1)
+	return &MockResourceServicePruneStorageCall{Call: call}
+}
+
+// MockResourceServicePruneStorageCall wrap *gomock.Call
+type MockResourceServicePruneStorageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockResourceServicePruneStorageCall) Return(arg0 []resource0.StoredBlob, arg1 error) *MockResourceServicePruneStorageCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockResourceServicePruneStorageCall) Do(f func(context.Context, resource0.PruneStorageArgs) ([]resource0.StoredBlob, error)) *MockResourceServicePruneStorageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockResourceServicePruneStorageCall) DoAndReturn(f func(context.Context, resource0.PruneStorageArgs) ([]resource0.StoredBlob, error)) *MockResourceServicePruneStorageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateResourceRevision mocks base method.
 func (m *MockResourceService) UpdateResourceRevision(arg0 context.Context, arg1 resource0.UpdateResourceRevisionArgs) (resource.UUID, error) {
 	m.ctrl.T.Helper()
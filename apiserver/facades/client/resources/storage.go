@@ -0,0 +1,71 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package resources
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/permission"
+	"github.com/juju/juju/domain/resource"
+	"github.com/juju/juju/rpc/params"
+)
+
+// StorageReport returns the blobs held in the model's object store on
+// behalf of charms and resources, and whether they are still in use.
+func (a *API) StorageReport(ctx context.Context) (params.ResourceStorageReport, error) {
+	if err := a.authorizer.HasPermission(ctx, permission.ReadAccess, a.modelTag); err != nil {
+		return params.ResourceStorageReport{}, errors.Trace(err)
+	}
+	blobs, err := a.resourceService.GetStoredBlobs(ctx)
+	if err != nil {
+		return params.ResourceStorageReport{}, errors.Trace(err)
+	}
+	return storageReport(blobs), nil
+}
+
+// PruneStorage removes the blobs from the model's object store which are not
+// referred to by any charm or resource, once they are older than the grace
+// period. The blobs which were removed, or would be removed in a dry run, are
+// returned.
+func (a *API) PruneStorage(ctx context.Context, args params.PruneResourceStorageArgs) (params.ResourceStorageReport, error) {
+	if err := a.authorizer.HasPermission(ctx, permission.AdminAccess, a.modelTag); err != nil {
+		return params.ResourceStorageReport{}, errors.Trace(err)
+	}
+	blobs, err := a.resourceService.PruneStorage(ctx, resource.PruneStorageArgs{
+		GracePeriod: args.GracePeriod,
+		DryRun:      args.DryRun,
+	})
+	if err != nil {
+		return params.ResourceStorageReport{}, errors.Trace(err)
+	}
+	return storageReport(blobs), nil
+}
+
+func storageReport(blobs []resource.StoredBlob) params.ResourceStorageReport {
+	result := params.ResourceStorageReport{
+		Blobs: make([]params.ResourceStorageBlob, len(blobs)),
+	}
+	for i, blob := range blobs {
+		apiBlob := params.ResourceStorageBlob{
+			Paths:   blob.Paths,
+			SHA384:  blob.SHA384,
+			Size:    blob.Size,
+			Created: blob.CreatedAt,
+			Usage:   string(blob.Usage()),
+		}
+		for _, ref := range blob.References {
+			apiBlob.References = append(apiBlob.References, params.ResourceStorageBlobReference{
+				Kind:            string(ref.Kind),
+				Name:            ref.Name,
+				ApplicationName: ref.ApplicationName,
+				Revision:        ref.Revision,
+				InUse:           ref.InUse,
+			})
+		}
+		result.Blobs[i] = apiBlob
+	}
+	return result
+}
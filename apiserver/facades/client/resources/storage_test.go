@@ -0,0 +1,124 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package resources
+
+import (
+	stdtesting "testing"
+	"time"
+
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	"github.com/juju/juju/domain/resource"
+	"github.com/juju/juju/rpc/params"
+)
+
+func TestStorageSuite(t *stdtesting.T) {
+	tc.Run(t, &storageSuite{})
+}
+
+type storageSuite struct {
+	BaseSuite
+}
+
+func (s *storageSuite) TestStorageReport(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.resourceService.EXPECT().GetStoredBlobs(gomock.Any()).Return([]resource.StoredBlob{{
+		UUID:      "blob-1",
+		Paths:     []string{"path-1"},
+		SHA384:    "hash-1",
+		Size:      42,
+		CreatedAt: created,
+		References: []resource.BlobReference{{
+			Kind:            resource.ResourceBlobReference,
+			Name:            "data",
+			ApplicationName: "mysql",
+			Revision:        2,
+		}},
+	}, {
+		UUID:      "blob-2",
+		Paths:     []string{"path-2"},
+		SHA384:    "hash-2",
+		Size:      7,
+		CreatedAt: created,
+	}}, nil)
+
+	result, err := s.newFacade(c).StorageReport(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, params.ResourceStorageReport{
+		Blobs: []params.ResourceStorageBlob{{
+			Paths:   []string{"path-1"},
+			SHA384:  "hash-1",
+			Size:    42,
+			Created: created,
+			Usage:   "old-revision",
+			References: []params.ResourceStorageBlobReference{{
+				Kind:            "resource",
+				Name:            "data",
+				ApplicationName: "mysql",
+				Revision:        2,
+			}},
+		}, {
+			Paths:   []string{"path-2"},
+			SHA384:  "hash-2",
+			Size:    7,
+			Created: created,
+			Usage:   "unreferenced",
+		}},
+	})
+}
+
+func (s *storageSuite) TestStorageReportPermission(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer = apiservertesting.FakeAuthorizer{
+		Tag: names.NewUserTag("bob"),
+	}
+	_, err := s.newFacade(c).StorageReport(c.Context())
+	c.Assert(err, tc.ErrorIs, apiservererrors.ErrPerm)
+}
+
+func (s *storageSuite) TestPruneStorage(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.resourceService.EXPECT().PruneStorage(gomock.Any(), resource.PruneStorageArgs{
+		GracePeriod: time.Hour,
+		DryRun:      true,
+	}).Return([]resource.StoredBlob{{
+		UUID:   "blob-1",
+		Paths:  []string{"path-1"},
+		SHA384: "hash-1",
+		Size:   42,
+	}}, nil)
+
+	result, err := s.newFacade(c).PruneStorage(c.Context(), params.PruneResourceStorageArgs{
+		GracePeriod: time.Hour,
+		DryRun:      true,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, params.ResourceStorageReport{
+		Blobs: []params.ResourceStorageBlob{{
+			Paths:  []string{"path-1"},
+			SHA384: "hash-1",
+			Size:   42,
+			Usage:  "unreferenced",
+		}},
+	})
+}
+
+func (s *storageSuite) TestPruneStoragePermission(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.authorizer = apiservertesting.FakeAuthorizer{
+		Tag:        names.NewUserTag("bob"),
+		HasReadTag: names.NewUserTag("bob"),
+	}
+	_, err := s.newFacade(c).PruneStorage(c.Context(), params.PruneResourceStorageArgs{})
+	c.Assert(err, tc.ErrorIs, apiservererrors.ErrPerm)
+}
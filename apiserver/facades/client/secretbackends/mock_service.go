@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/facades/client/secretbackends (interfaces: SecretBackendService)
+// Source: github.com/juju/juju/apiserver/facades/client/secretbackends (interfaces: SecretBackendService,ModelService,SecretService)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package secretbackends -destination mock_service.go github.com/juju/juju/apiserver/facades/client/secretbackends SecretBackendService
+//	mockgen -typed -package secretbackends -destination mock_service.go github.com/juju/juju/apiserver/facades/client/secretbackends SecretBackendService,ModelService,SecretService
 //
 
 // Package secretbackends is a generated GoMock package.
@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	model "github.com/juju/juju/core/model"
 	secrets "github.com/juju/juju/core/secrets"
 	service "github.com/juju/juju/domain/secretbackend/service"
 	gomock "go.uber.org/mock/gomock"
@@ -123,6 +124,44 @@ func (c *MockSecretBackendServiceCreateSecretBackendCall) DoAndReturn(f func(con
 	return c
 }
 
+// DeleteRetiredSecretEncryptionKeys mocks base method.
+func (m *MockSecretBackendService) DeleteRetiredSecretEncryptionKeys(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRetiredSecretEncryptionKeys", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRetiredSecretEncryptionKeys indicates an expected call of DeleteRetiredSecretEncryptionKeys.
+func (mr *MockSecretBackendServiceMockRecorder) DeleteRetiredSecretEncryptionKeys(arg0 any) *MockSecretBackendServiceDeleteRetiredSecretEncryptionKeysCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRetiredSecretEncryptionKeys", reflect.TypeOf((*MockSecretBackendService)(nil).DeleteRetiredSecretEncryptionKeys), arg0)
+	return &MockSecretBackendServiceDeleteRetiredSecretEncryptionKeysCall{Call: call}
+}
+
+// MockSecretBackendServiceDeleteRetiredSecretEncryptionKeysCall wrap *gomock.Call
+type MockSecretBackendServiceDeleteRetiredSecretEncryptionKeysCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretBackendServiceDeleteRetiredSecretEncryptionKeysCall) Return(arg0 error) *MockSecretBackendServiceDeleteRetiredSecretEncryptionKeysCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretBackendServiceDeleteRetiredSecretEncryptionKeysCall) Do(f func(context.Context) error) *MockSecretBackendServiceDeleteRetiredSecretEncryptionKeysCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretBackendServiceDeleteRetiredSecretEncryptionKeysCall) DoAndReturn(f func(context.Context) error) *MockSecretBackendServiceDeleteRetiredSecretEncryptionKeysCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // DeleteSecretBackend mocks base method.
 func (m *MockSecretBackendService) DeleteSecretBackend(arg0 context.Context, arg1 service.DeleteSecretBackendParams) error {
 	m.ctrl.T.Helper()
@@ -161,6 +200,44 @@ func (c *MockSecretBackendServiceDeleteSecretBackendCall) DoAndReturn(f func(con
 	return c
 }
 
+// RotateSecretEncryptionKey mocks base method.
+func (m *MockSecretBackendService) RotateSecretEncryptionKey(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateSecretEncryptionKey", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RotateSecretEncryptionKey indicates an expected call of RotateSecretEncryptionKey.
+func (mr *MockSecretBackendServiceMockRecorder) RotateSecretEncryptionKey(arg0 any) *MockSecretBackendServiceRotateSecretEncryptionKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateSecretEncryptionKey", reflect.TypeOf((*MockSecretBackendService)(nil).RotateSecretEncryptionKey), arg0)
+	return &MockSecretBackendServiceRotateSecretEncryptionKeyCall{Call: call}
+}
+
+// MockSecretBackendServiceRotateSecretEncryptionKeyCall wrap *gomock.Call
+type MockSecretBackendServiceRotateSecretEncryptionKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretBackendServiceRotateSecretEncryptionKeyCall) Return(arg0 error) *MockSecretBackendServiceRotateSecretEncryptionKeyCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretBackendServiceRotateSecretEncryptionKeyCall) Do(f func(context.Context) error) *MockSecretBackendServiceRotateSecretEncryptionKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretBackendServiceRotateSecretEncryptionKeyCall) DoAndReturn(f func(context.Context) error) *MockSecretBackendServiceRotateSecretEncryptionKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateSecretBackend mocks base method.
 func (m *MockSecretBackendService) UpdateSecretBackend(arg0 context.Context, arg1 service.UpdateSecretBackendParams) error {
 	m.ctrl.T.Helper()
@@ -198,3 +275,126 @@ func (c *MockSecretBackendServiceUpdateSecretBackendCall) DoAndReturn(f func(con
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockModelService is a mock of ModelService interface.
+type MockModelService struct {
+	ctrl     *gomock.Controller
+	recorder *MockModelServiceMockRecorder
+}
+
+// MockModelServiceMockRecorder is the mock recorder for MockModelService.
+type MockModelServiceMockRecorder struct {
+	mock *MockModelService
+}
+
+// NewMockModelService creates a new mock instance.
+func NewMockModelService(ctrl *gomock.Controller) *MockModelService {
+	mock := &MockModelService{ctrl: ctrl}
+	mock.recorder = &MockModelServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModelService) EXPECT() *MockModelServiceMockRecorder {
+	return m.recorder
+}
+
+// ListModelUUIDs mocks base method.
+func (m *MockModelService) ListModelUUIDs(arg0 context.Context) ([]model.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListModelUUIDs", arg0)
+	ret0, _ := ret[0].([]model.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListModelUUIDs indicates an expected call of ListModelUUIDs.
+func (mr *MockModelServiceMockRecorder) ListModelUUIDs(arg0 any) *MockModelServiceListModelUUIDsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListModelUUIDs", reflect.TypeOf((*MockModelService)(nil).ListModelUUIDs), arg0)
+	return &MockModelServiceListModelUUIDsCall{Call: call}
+}
+
+// MockModelServiceListModelUUIDsCall wrap *gomock.Call
+type MockModelServiceListModelUUIDsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelServiceListModelUUIDsCall) Return(arg0 []model.UUID, arg1 error) *MockModelServiceListModelUUIDsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelServiceListModelUUIDsCall) Do(f func(context.Context) ([]model.UUID, error)) *MockModelServiceListModelUUIDsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelServiceListModelUUIDsCall) DoAndReturn(f func(context.Context) ([]model.UUID, error)) *MockModelServiceListModelUUIDsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockSecretService is a mock of SecretService interface.
+type MockSecretService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecretServiceMockRecorder
+}
+
+// MockSecretServiceMockRecorder is the mock recorder for MockSecretService.
+type MockSecretServiceMockRecorder struct {
+	mock *MockSecretService
+}
+
+// NewMockSecretService creates a new mock instance.
+func NewMockSecretService(ctrl *gomock.Controller) *MockSecretService {
+	mock := &MockSecretService{ctrl: ctrl}
+	mock.recorder = &MockSecretServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSecretService) EXPECT() *MockSecretServiceMockRecorder {
+	return m.recorder
+}
+
+// RewrapSecretDataKeys mocks base method.
+func (m *MockSecretService) RewrapSecretDataKeys(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RewrapSecretDataKeys", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RewrapSecretDataKeys indicates an expected call of RewrapSecretDataKeys.
+func (mr *MockSecretServiceMockRecorder) RewrapSecretDataKeys(arg0 any) *MockSecretServiceRewrapSecretDataKeysCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RewrapSecretDataKeys", reflect.TypeOf((*MockSecretService)(nil).RewrapSecretDataKeys), arg0)
+	return &MockSecretServiceRewrapSecretDataKeysCall{Call: call}
+}
+
+// MockSecretServiceRewrapSecretDataKeysCall wrap *gomock.Call
+type MockSecretServiceRewrapSecretDataKeysCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretServiceRewrapSecretDataKeysCall) Return(arg0 error) *MockSecretServiceRewrapSecretDataKeysCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretServiceRewrapSecretDataKeysCall) Do(f func(context.Context) error) *MockSecretServiceRewrapSecretDataKeysCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretServiceRewrapSecretDataKeysCall) DoAndReturn(f func(context.Context) error) *MockSecretServiceRewrapSecretDataKeysCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -9,11 +9,13 @@ import (
 	coretesting "github.com/juju/juju/internal/testing"
 )
 
-//go:generate go run go.uber.org/mock/mockgen -typed -package secretbackends -destination mock_service.go github.com/juju/juju/apiserver/facades/client/secretbackends SecretBackendService
+//go:generate go run go.uber.org/mock/mockgen -typed -package secretbackends -destination mock_service.go github.com/juju/juju/apiserver/facades/client/secretbackends SecretBackendService,ModelService,SecretService
 
 func NewTestAPI(
 	authorizer facade.Authorizer,
 	backendService SecretBackendService,
+	modelService ModelService,
+	secretServiceGetter SecretServiceGetter,
 ) (*SecretBackendsAPI, error) {
 	if !authorizer.AuthClient() {
 		return nil, apiservererrors.ErrPerm
@@ -22,6 +24,8 @@ func NewTestAPI(
 	return &SecretBackendsAPI{
 		authorizer:     authorizer,
 		controllerUUID: coretesting.ControllerTag.Id(),
-		backendService: backendService,
+		backendService:      backendService,
+		modelService:        modelService,
+		secretServiceGetter: secretServiceGetter,
 	}, nil
 }
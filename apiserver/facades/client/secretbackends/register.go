@@ -7,27 +7,45 @@ import (
 	"context"
 	"reflect"
 
+	"github.com/juju/errors"
+
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
+	coremodel "github.com/juju/juju/core/model"
 )
 
 // Register is called to expose a package of facades onto a given registry.
 func Register(registry facade.FacadeRegistry) {
-	registry.MustRegister("SecretBackends", 1, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+	registry.MustRegisterForMultiModel("SecretBackends", 1, func(stdCtx context.Context, ctx facade.MultiModelContext) (facade.Facade, error) {
+		api, err := newSecretBackendsAPI(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &SecretBackendsAPIV1{SecretBackendsAPI: api}, nil
+	}, reflect.TypeOf((*SecretBackendsAPIV1)(nil)))
+	registry.MustRegisterForMultiModel("SecretBackends", 2, func(stdCtx context.Context, ctx facade.MultiModelContext) (facade.Facade, error) {
 		return newSecretBackendsAPI(ctx)
 	}, reflect.TypeOf((*SecretBackendsAPI)(nil)))
 }
 
 // newSecretBackendsAPI creates a SecretBackendsAPI.
-func newSecretBackendsAPI(context facade.ModelContext) (*SecretBackendsAPI, error) {
-	if !context.Auth().AuthClient() {
+func newSecretBackendsAPI(ctx facade.MultiModelContext) (*SecretBackendsAPI, error) {
+	if !ctx.Auth().AuthClient() {
 		return nil, apiservererrors.ErrPerm
 	}
-	domainServices := context.DomainServices()
+	domainServices := ctx.DomainServices()
 	secretBackendService := domainServices.SecretBackend()
 	return &SecretBackendsAPI{
-		authorizer:     context.Auth(),
-		controllerUUID: context.ControllerUUID(),
+		authorizer:     ctx.Auth(),
+		controllerUUID: ctx.ControllerUUID(),
 		backendService: secretBackendService,
+		modelService:   domainServices.Model(),
+		secretServiceGetter: func(stdCtx context.Context, modelUUID coremodel.UUID) (SecretService, error) {
+			svc, err := ctx.DomainServicesForModel(stdCtx, modelUUID)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			return svc.Secret(), nil
+		},
 	}, nil
 }
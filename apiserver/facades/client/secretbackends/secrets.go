@@ -6,6 +6,7 @@ package secretbackends
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/juju/collections/transform"
 	"github.com/juju/errors"
@@ -24,9 +25,17 @@ import (
 
 // SecretBackendsAPI is the server implementation for the SecretBackends facade.
 type SecretBackendsAPI struct {
-	authorizer     facade.Authorizer
-	controllerUUID string
-	backendService SecretBackendService
+	authorizer          facade.Authorizer
+	controllerUUID      string
+	backendService      SecretBackendService
+	modelService        ModelService
+	secretServiceGetter SecretServiceGetter
+}
+
+// SecretBackendsAPIV1 is the server implementation for the SecretBackends
+// facade, version 1.
+type SecretBackendsAPIV1 struct {
+	*SecretBackendsAPI
 }
 
 func (s *SecretBackendsAPI) checkCanAdmin(ctx context.Context) error {
@@ -143,3 +152,40 @@ func (s *SecretBackendsAPI) RemoveSecretBackends(ctx context.Context, args param
 	}
 	return result, nil
 }
+
+// RotateSecretEncryptionKey isn't implemented in the SecretBackendsAPIV1 facade.
+func (s *SecretBackendsAPIV1) RotateSecretEncryptionKey(_, _ struct{}) {}
+
+// RotateSecretEncryptionKey replaces the controller key used to encrypt
+// secret content stored in the internal backend. Each model's data keys
+// are re-wrapped with the new key, after which the old key is deleted if
+// no data key is still wrapped by it. Secrets remain readable throughout.
+// If any model fails to re-wrap its keys, the old key is kept and the
+// rotation can be run again.
+func (s *SecretBackendsAPI) RotateSecretEncryptionKey(ctx context.Context) error {
+	if err := s.checkCanAdmin(ctx); err != nil {
+		return errors.Trace(err)
+	}
+	if err := s.backendService.RotateSecretEncryptionKey(ctx); err != nil {
+		return errors.Annotate(err, "rotating secret encryption key")
+	}
+
+	modelUUIDs, err := s.modelService.ListModelUUIDs(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	var failed []string
+	for _, modelUUID := range modelUUIDs {
+		secretService, err := s.secretServiceGetter(ctx, modelUUID)
+		if err == nil {
+			err = secretService.RewrapSecretDataKeys(ctx)
+		}
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("model %q: %v", modelUUID, err))
+		}
+	}
+	if len(failed) > 0 {
+		return errors.Errorf("re-wrapping secret data keys:\n%s", strings.Join(failed, "\n"))
+	}
+	return errors.Trace(s.backendService.DeleteRetiredSecretEncryptionKeys(ctx))
+}
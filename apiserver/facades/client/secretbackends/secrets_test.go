@@ -4,6 +4,7 @@
 package secretbackends
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/juju/juju/apiserver/authentication"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	facademocks "github.com/juju/juju/apiserver/facade/mocks"
+	coremodel "github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/permission"
 	"github.com/juju/juju/core/secrets"
 	"github.com/juju/juju/domain/secretbackend"
@@ -33,6 +35,8 @@ type SecretsSuite struct {
 
 	authorizer         *facademocks.MockAuthorizer
 	mockBackendService *MockSecretBackendService
+	mockModelService   *MockModelService
+	mockSecretServices map[coremodel.UUID]*MockSecretService
 }
 
 func TestSecretsSuite(t *testing.T) {
@@ -45,7 +49,15 @@ func (s *SecretsSuite) setup(c *tc.C) (*SecretBackendsAPI, *gomock.Controller) {
 	s.authorizer = facademocks.NewMockAuthorizer(ctrl)
 	s.authorizer.EXPECT().AuthClient().Return(true)
 	s.mockBackendService = NewMockSecretBackendService(ctrl)
-	api, err := NewTestAPI(s.authorizer, s.mockBackendService)
+	s.mockModelService = NewMockModelService(ctrl)
+	s.mockSecretServices = map[coremodel.UUID]*MockSecretService{
+		"model-1": NewMockSecretService(ctrl),
+		"model-2": NewMockSecretService(ctrl),
+	}
+	api, err := NewTestAPI(s.authorizer, s.mockBackendService, s.mockModelService,
+		func(_ context.Context, modelUUID coremodel.UUID) (SecretService, error) {
+			return s.mockSecretServices[modelUUID], nil
+		})
 	c.Assert(err, tc.ErrorIsNil)
 	return api, ctrl
 }
@@ -313,3 +325,44 @@ func (s *SecretsSuite) TestRemoveSecretBackendsPermissionDenied(c *tc.C) {
 	_, err := facade.RemoveSecretBackends(c.Context(), params.RemoveSecretBackendArgs{})
 	c.Assert(err, tc.ErrorMatches, "permission denied")
 }
+
+func (s *SecretsSuite) TestRotateSecretEncryptionKey(c *tc.C) {
+	facade, ctrl := s.setup(c)
+	defer ctrl.Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(nil)
+	s.mockBackendService.EXPECT().RotateSecretEncryptionKey(gomock.Any()).Return(nil)
+	s.mockModelService.EXPECT().ListModelUUIDs(gomock.Any()).Return([]coremodel.UUID{"model-1", "model-2"}, nil)
+	s.mockSecretServices["model-1"].EXPECT().RewrapSecretDataKeys(gomock.Any()).Return(nil)
+	s.mockSecretServices["model-2"].EXPECT().RewrapSecretDataKeys(gomock.Any()).Return(nil)
+	s.mockBackendService.EXPECT().DeleteRetiredSecretEncryptionKeys(gomock.Any()).Return(nil)
+
+	err := facade.RotateSecretEncryptionKey(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *SecretsSuite) TestRotateSecretEncryptionKeyRewrapFails(c *tc.C) {
+	facade, ctrl := s.setup(c)
+	defer ctrl.Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(nil)
+	s.mockBackendService.EXPECT().RotateSecretEncryptionKey(gomock.Any()).Return(nil)
+	s.mockModelService.EXPECT().ListModelUUIDs(gomock.Any()).Return([]coremodel.UUID{"model-1", "model-2"}, nil)
+	s.mockSecretServices["model-1"].EXPECT().RewrapSecretDataKeys(gomock.Any()).Return(errors.New("boom"))
+	s.mockSecretServices["model-2"].EXPECT().RewrapSecretDataKeys(gomock.Any()).Return(nil)
+
+	// The retired key is kept, so that model-1 can still read its secrets.
+	err := facade.RotateSecretEncryptionKey(c.Context())
+	c.Assert(err, tc.ErrorMatches, `re-wrapping secret data keys:\nmodel "model-1": boom`)
+}
+
+func (s *SecretsSuite) TestRotateSecretEncryptionKeyPermissionDenied(c *tc.C) {
+	facade, ctrl := s.setup(c)
+	defer ctrl.Finish()
+
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(
+		errors.WithType(apiservererrors.ErrPerm, authentication.ErrorEntityMissingPermission))
+
+	err := facade.RotateSecretEncryptionKey(c.Context())
+	c.Assert(err, tc.ErrorMatches, "permission denied")
+}
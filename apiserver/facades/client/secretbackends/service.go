@@ -6,6 +6,7 @@ package secretbackends
 import (
 	"context"
 
+	coremodel "github.com/juju/juju/core/model"
 	coresecrets "github.com/juju/juju/core/secrets"
 	secretbackendservice "github.com/juju/juju/domain/secretbackend/service"
 )
@@ -16,4 +17,20 @@ type SecretBackendService interface {
 	UpdateSecretBackend(context.Context, secretbackendservice.UpdateSecretBackendParams) error
 	DeleteSecretBackend(context.Context, secretbackendservice.DeleteSecretBackendParams) error
 	BackendSummaryInfo(ctx context.Context, reveal bool, names ...string) ([]*secretbackendservice.SecretBackendInfo, error)
+	RotateSecretEncryptionKey(context.Context) error
+	DeleteRetiredSecretEncryptionKeys(context.Context) error
 }
+
+// ModelService provides access to the models in the controller.
+type ModelService interface {
+	ListModelUUIDs(context.Context) ([]coremodel.UUID, error)
+}
+
+// SecretService is an interface for interacting with the secrets
+// of a model.
+type SecretService interface {
+	RewrapSecretDataKeys(context.Context) error
+}
+
+// SecretServiceGetter returns the secret service for the given model.
+type SecretServiceGetter func(context.Context, coremodel.UUID) (SecretService, error)
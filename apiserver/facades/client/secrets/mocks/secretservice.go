@@ -120,6 +120,84 @@ func (c *MockSecretServiceDeleteSecretCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// ExportSecretArchive mocks base method.
+func (m *MockSecretService) ExportSecretArchive(arg0 context.Context, arg1 service.SecretArchiveFilter) (*service.SecretExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportSecretArchive", arg0, arg1)
+	ret0, _ := ret[0].(*service.SecretExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportSecretArchive indicates an expected call of ExportSecretArchive.
+func (mr *MockSecretServiceMockRecorder) ExportSecretArchive(arg0, arg1 any) *MockSecretServiceExportSecretArchiveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportSecretArchive", reflect.TypeOf((*MockSecretService)(nil).ExportSecretArchive), arg0, arg1)
+	return &MockSecretServiceExportSecretArchiveCall{Call: call}
+}
+
+// MockSecretServiceExportSecretArchiveCall wrap *gomock.Call
+type MockSecretServiceExportSecretArchiveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretServiceExportSecretArchiveCall) Return(arg0 *service.SecretExport, arg1 error) *MockSecretServiceExportSecretArchiveCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretServiceExportSecretArchiveCall) Do(f func(context.Context, service.SecretArchiveFilter) (*service.SecretExport, error)) *MockSecretServiceExportSecretArchiveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretServiceExportSecretArchiveCall) DoAndReturn(f func(context.Context, service.SecretArchiveFilter) (*service.SecretExport, error)) *MockSecretServiceExportSecretArchiveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetSecretAccessLog mocks base method.
+func (m *MockSecretService) GetSecretAccessLog(arg0 context.Context, arg1 *secrets.URI, arg2 *int) ([]secret.AccessLogEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretAccessLog", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]secret.AccessLogEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretAccessLog indicates an expected call of GetSecretAccessLog.
+func (mr *MockSecretServiceMockRecorder) GetSecretAccessLog(arg0, arg1, arg2 any) *MockSecretServiceGetSecretAccessLogCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretAccessLog", reflect.TypeOf((*MockSecretService)(nil).GetSecretAccessLog), arg0, arg1, arg2)
+	return &MockSecretServiceGetSecretAccessLogCall{Call: call}
+}
+
+// MockSecretServiceGetSecretAccessLogCall wrap *gomock.Call
+type MockSecretServiceGetSecretAccessLogCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretServiceGetSecretAccessLogCall) Return(arg0 []secret.AccessLogEntry, arg1 error) *MockSecretServiceGetSecretAccessLogCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretServiceGetSecretAccessLogCall) Do(f func(context.Context, *secrets.URI, *int) ([]secret.AccessLogEntry, error)) *MockSecretServiceGetSecretAccessLogCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretServiceGetSecretAccessLogCall) DoAndReturn(f func(context.Context, *secrets.URI, *int) ([]secret.AccessLogEntry, error)) *MockSecretServiceGetSecretAccessLogCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetSecretContentFromBackend mocks base method.
 func (m *MockSecretService) GetSecretContentFromBackend(arg0 context.Context, arg1 *secrets.URI, arg2 int) (secrets.SecretValue, error) {
 	m.ctrl.T.Helper()
@@ -275,6 +353,44 @@ func (c *MockSecretServiceGrantSecretAccessCall) DoAndReturn(f func(context.Cont
 	return c
 }
 
+// ImportSecretArchive mocks base method.
+func (m *MockSecretService) ImportSecretArchive(arg0 context.Context, arg1 *service.SecretExport) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportSecretArchive", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportSecretArchive indicates an expected call of ImportSecretArchive.
+func (mr *MockSecretServiceMockRecorder) ImportSecretArchive(arg0, arg1 any) *MockSecretServiceImportSecretArchiveCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportSecretArchive", reflect.TypeOf((*MockSecretService)(nil).ImportSecretArchive), arg0, arg1)
+	return &MockSecretServiceImportSecretArchiveCall{Call: call}
+}
+
+// MockSecretServiceImportSecretArchiveCall wrap *gomock.Call
+type MockSecretServiceImportSecretArchiveCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretServiceImportSecretArchiveCall) Return(arg0 error) *MockSecretServiceImportSecretArchiveCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretServiceImportSecretArchiveCall) Do(f func(context.Context, *service.SecretExport) error) *MockSecretServiceImportSecretArchiveCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretServiceImportSecretArchiveCall) DoAndReturn(f func(context.Context, *service.SecretExport) error) *MockSecretServiceImportSecretArchiveCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListCharmSecrets mocks base method.
 func (m *MockSecretService) ListCharmSecrets(arg0 context.Context, arg1 ...service.CharmSecretOwner) ([]*secrets.SecretMetadata, [][]*secrets.SecretRevisionMetadata, error) {
 	m.ctrl.T.Helper()
@@ -360,6 +476,44 @@ func (c *MockSecretServiceListSecretsCall) DoAndReturn(f func(context.Context, *
 	return c
 }
 
+// RecordSecretAccess mocks base method.
+func (m *MockSecretService) RecordSecretAccess(arg0 context.Context, arg1 *secrets.URI, arg2 int, arg3 service.SecretAccessor) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordSecretAccess", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordSecretAccess indicates an expected call of RecordSecretAccess.
+func (mr *MockSecretServiceMockRecorder) RecordSecretAccess(arg0, arg1, arg2, arg3 any) *MockSecretServiceRecordSecretAccessCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordSecretAccess", reflect.TypeOf((*MockSecretService)(nil).RecordSecretAccess), arg0, arg1, arg2, arg3)
+	return &MockSecretServiceRecordSecretAccessCall{Call: call}
+}
+
+// MockSecretServiceRecordSecretAccessCall wrap *gomock.Call
+type MockSecretServiceRecordSecretAccessCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretServiceRecordSecretAccessCall) Return(arg0 error) *MockSecretServiceRecordSecretAccessCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretServiceRecordSecretAccessCall) Do(f func(context.Context, *secrets.URI, int, service.SecretAccessor) error) *MockSecretServiceRecordSecretAccessCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretServiceRecordSecretAccessCall) DoAndReturn(f func(context.Context, *secrets.URI, int, service.SecretAccessor) error) *MockSecretServiceRecordSecretAccessCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // RevokeSecretAccess mocks base method.
 func (m *MockSecretService) RevokeSecretAccess(arg0 context.Context, arg1 *secrets.URI, arg2 service.SecretAccessParams) error {
 	m.ctrl.T.Helper()
@@ -8,6 +8,7 @@ import (
 
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/core/logger"
 	coretesting "github.com/juju/juju/internal/testing"
 )
 
@@ -18,6 +19,7 @@ func NewTestAPI(
 	authorizer facade.Authorizer,
 	secretService SecretService,
 	secretBackendService SecretBackendService,
+	logger logger.Logger,
 ) (*SecretsAPI, error) {
 	if !authorizer.AuthClient() {
 		return nil, apiservererrors.ErrPerm
@@ -30,5 +32,6 @@ func NewTestAPI(
 		modelUUID:            coretesting.ModelTag.Id(),
 		secretService:        secretService,
 		secretBackendService: secretBackendService,
+		logger:               logger,
 	}, nil
 }
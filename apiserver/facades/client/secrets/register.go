@@ -11,24 +11,36 @@ import (
 
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
+	corelogger "github.com/juju/juju/core/logger"
 )
 
 // Register is called to expose a package of facades onto a given registry.
 func Register(registry facade.FacadeRegistry) {
 	registry.MustRegister("Secrets", 1, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
 		return newSecretsAPIV1(stdCtx, ctx)
-	}, reflect.TypeOf((*SecretsAPI)(nil)))
+	}, reflect.TypeOf((*SecretsAPIV1)(nil)))
 	registry.MustRegister("Secrets", 2, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newSecretsAPIV2(stdCtx, ctx)
+	}, reflect.TypeOf((*SecretsAPIV2)(nil)))
+	registry.MustRegister("Secrets", 3, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
 		return newSecretsAPI(stdCtx, ctx)
 	}, reflect.TypeOf((*SecretsAPI)(nil)))
 }
 
 func newSecretsAPIV1(stdCtx context.Context, context facade.ModelContext) (*SecretsAPIV1, error) {
+	api, err := newSecretsAPIV2(stdCtx, context)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &SecretsAPIV1{SecretsAPIV2: api}, nil
+}
+
+func newSecretsAPIV2(stdCtx context.Context, context facade.ModelContext) (*SecretsAPIV2, error) {
 	api, err := newSecretsAPI(stdCtx, context)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	return &SecretsAPIV1{SecretsAPI: api}, nil
+	return &SecretsAPIV2{SecretsAPI: api}, nil
 }
 
 // newSecretsAPI creates a SecretsAPI.
@@ -56,5 +68,6 @@ func newSecretsAPI(stdCtx context.Context, ctx facade.ModelContext) (*SecretsAPI
 		modelName:            modelInfo.Name,
 		secretService:        secretService,
 		secretBackendService: backendService,
+		logger:               ctx.Logger().Child("secrets", corelogger.SECRETS),
 	}, nil
 }
@@ -13,6 +13,7 @@ import (
 	commonsecrets "github.com/juju/juju/apiserver/common/secrets"
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/core/logger"
 	"github.com/juju/juju/core/permission"
 	coresecrets "github.com/juju/juju/core/secrets"
 	domainsecret "github.com/juju/juju/domain/secret"
@@ -34,11 +35,28 @@ type SecretsAPI struct {
 
 	secretBackendService SecretBackendService
 	secretService        SecretService
+
+	logger logger.Logger
+}
+
+// SecretsAPIV2 is the backend for the Secrets facade v2.
+type SecretsAPIV2 struct {
+	*SecretsAPI
 }
 
 // SecretsAPIV1 is the backend for the Secrets facade v1.
 type SecretsAPIV1 struct {
-	*SecretsAPI
+	*SecretsAPIV2
+}
+
+// recordSecretAccess records a read of secret content in the access log.
+// Failing to record it doesn't fail the read.
+func (s *SecretsAPI) recordSecretAccess(
+	ctx context.Context, uri *coresecrets.URI, revision int, accessor secretservice.SecretAccessor,
+) {
+	if err := s.secretService.RecordSecretAccess(ctx, uri, revision, accessor); err != nil {
+		s.logger.Warningf(ctx, "recording access to secret %s/%d: %v", uri.ID, revision, err)
+	}
 }
 
 func (s *SecretsAPI) checkCanRead(ctx context.Context) error {
@@ -90,24 +108,11 @@ func (s *SecretsAPI) ListSecrets(ctx context.Context, arg params.ListSecretsArgs
 		labels = append(labels, *arg.Filter.Label)
 	}
 	if arg.Filter.OwnerTag != nil {
-		tag, err := names.ParseTag(*arg.Filter.OwnerTag)
+		charmOwner, err := charmOwnerFromTag(*arg.Filter.OwnerTag)
 		if err != nil {
 			return params.ListSecretResults{}, errors.Trace(err)
 		}
-		switch kind := tag.Kind(); kind {
-		case names.ApplicationTagKind:
-			owner = &secretservice.CharmSecretOwner{
-				Kind: secretservice.ApplicationOwner,
-				ID:   tag.Id(),
-			}
-		case names.UnitTagKind:
-			owner = &secretservice.CharmSecretOwner{
-				Kind: secretservice.UnitOwner,
-				ID:   tag.Id(),
-			}
-		default:
-			return result, errors.NotValidf("secret owner tag kind %q", kind)
-		}
+		owner = &charmOwner
 	}
 	var (
 		metadata         []*coresecrets.SecretMetadata
@@ -186,6 +191,12 @@ func (s *SecretsAPI) ListSecrets(ctx context.Context, arg params.ListSecretsArgs
 				rev = *arg.Filter.Revision
 			}
 			val, err := s.secretService.GetSecretContentFromBackend(ctx, m.URI, rev)
+			if err == nil {
+				s.recordSecretAccess(ctx, m.URI, rev, secretservice.SecretAccessor{
+					Kind: secretservice.UserAccessor,
+					ID:   s.authTag.Id(),
+				})
+			}
 			valueResult := &params.SecretValueResult{
 				Error: apiservererrors.ServerError(err),
 			}
@@ -199,6 +210,86 @@ func (s *SecretsAPI) ListSecrets(ctx context.Context, arg params.ListSecretsArgs
 	return result, nil
 }
 
+// ListSecretAccessLog isn't on the v1 or v2 API.
+func (s *SecretsAPIV2) ListSecretAccessLog(_ context.Context, _ struct{}) {}
+
+// ListSecretAccessLog returns the recorded reads of the content of the
+// specified secrets, oldest first.
+func (s *SecretsAPI) ListSecretAccessLog(ctx context.Context, args params.SecretAccessLogArgs) (params.SecretAccessLogResults, error) {
+	result := params.SecretAccessLogResults{
+		Results: make([]params.SecretAccessLogResult, len(args.Args)),
+	}
+	if err := s.checkCanAdmin(ctx); err != nil {
+		return result, errors.Trace(err)
+	}
+	for i, arg := range args.Args {
+		entries, err := s.secretAccessLog(ctx, arg)
+		result.Results[i].Entries = entries
+		result.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return result, nil
+}
+
+func (s *SecretsAPI) secretAccessLog(ctx context.Context, arg params.SecretAccessLogArg) ([]params.SecretAccessLogEntry, error) {
+	uri, err := coresecrets.ParseURI(arg.URI)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	entries, err := s.secretService.GetSecretAccessLog(ctx, uri, arg.Revision)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	result := make([]params.SecretAccessLogEntry, len(entries))
+	for i, e := range entries {
+		accessorTag, err := tagFromAccessLogEntry(e)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		result[i] = params.SecretAccessLogEntry{
+			Revision:    e.Revision,
+			AccessorTag: accessorTag.String(),
+			AccessTime:  e.AccessTime,
+		}
+	}
+	return result, nil
+}
+
+func tagFromAccessLogEntry(entry domainsecret.AccessLogEntry) (names.Tag, error) {
+	switch entry.AccessorType {
+	case domainsecret.AccessorUnit:
+		return names.NewUnitTag(entry.AccessorID), nil
+	case domainsecret.AccessorApplication, domainsecret.AccessorRemoteApplication:
+		return names.NewApplicationTag(entry.AccessorID), nil
+	case domainsecret.AccessorModel:
+		return names.NewModelTag(entry.AccessorID), nil
+	case domainsecret.AccessorUser:
+		return names.NewUserTag(entry.AccessorID), nil
+	default:
+		return nil, errors.NotValidf("secret accessor type %q", entry.AccessorType)
+	}
+}
+
+func charmOwnerFromTag(ownerTag string) (secretservice.CharmSecretOwner, error) {
+	tag, err := names.ParseTag(ownerTag)
+	if err != nil {
+		return secretservice.CharmSecretOwner{}, errors.Trace(err)
+	}
+	switch kind := tag.Kind(); kind {
+	case names.ApplicationTagKind:
+		return secretservice.CharmSecretOwner{
+			Kind: secretservice.ApplicationOwner,
+			ID:   tag.Id(),
+		}, nil
+	case names.UnitTagKind:
+		return secretservice.CharmSecretOwner{
+			Kind: secretservice.UnitOwner,
+			ID:   tag.Id(),
+		}, nil
+	default:
+		return secretservice.CharmSecretOwner{}, errors.NotValidf("secret owner tag kind %q", kind)
+	}
+}
+
 func tagFromSubject(access secretservice.SecretAccessor) (names.Tag, error) {
 	switch kind := access.Kind; kind {
 	case secretservice.UnitAccessor:
@@ -227,6 +318,158 @@ func tagFromAccessScope(access secretservice.SecretAccessScope) (names.Tag, erro
 	}
 }
 
+// ExportSecrets isn't on the v1 or v2 API.
+func (s *SecretsAPIV2) ExportSecrets(_ context.Context, _ struct{}) {}
+
+// ExportSecrets returns the selected secrets with the content of each of
+// their revisions, to be imported into another model with ImportSecrets.
+// If no owners are specified, user secrets are exported.
+func (s *SecretsAPI) ExportSecrets(ctx context.Context, arg params.ExportSecretsArgs) (params.ExportSecretsResult, error) {
+	if err := s.checkCanAdmin(ctx); err != nil {
+		return params.ExportSecretsResult{}, errors.Trace(err)
+	}
+	secrets, err := s.exportSecrets(ctx, arg)
+	if err != nil {
+		return params.ExportSecretsResult{Error: apiservererrors.ServerError(err)}, nil
+	}
+	return params.ExportSecretsResult{Secrets: secrets}, nil
+}
+
+func (s *SecretsAPI) exportSecrets(ctx context.Context, arg params.ExportSecretsArgs) ([]params.ExportedSecret, error) {
+	filter := secretservice.SecretArchiveFilter{
+		Labels: arg.Labels,
+	}
+	for _, ownerTag := range arg.OwnerTags {
+		owner, err := charmOwnerFromTag(ownerTag)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		filter.Owners = append(filter.Owners, owner)
+	}
+	archive, err := s.secretService.ExportSecretArchive(ctx, filter)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	accessor := secretservice.SecretAccessor{
+		Kind: secretservice.UserAccessor,
+		ID:   s.authTag.Id(),
+	}
+	result := make([]params.ExportedSecret, len(archive.Secrets))
+	for i, md := range archive.Secrets {
+		ownerTag, err := commonsecrets.OwnerTagFromOwner(md.Owner)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		exported := params.ExportedSecret{
+			URI:                    md.URI.String(),
+			Version:                md.Version,
+			OwnerTag:               ownerTag.String(),
+			Description:            md.Description,
+			Label:                  md.Label,
+			RotatePolicy:           string(md.RotatePolicy),
+			NextRotateTime:         md.NextRotateTime,
+			AutoPrune:              md.AutoPrune,
+			LatestRevisionChecksum: md.LatestRevisionChecksum,
+			LatestExpireTime:       md.LatestExpireTime,
+		}
+		for _, rev := range archive.Revisions[md.URI.ID] {
+			s.recordSecretAccess(ctx, md.URI, rev.Revision, accessor)
+			exported.Revisions = append(exported.Revisions, params.ExportedSecretRevision{
+				Revision:   rev.Revision,
+				CreateTime: rev.CreateTime,
+				ExpireTime: rev.ExpireTime,
+				Data:       archive.Content[md.URI.ID][rev.Revision],
+			})
+		}
+		result[i] = exported
+	}
+	return result, nil
+}
+
+// ImportSecrets isn't on the v1 or v2 API.
+func (s *SecretsAPIV2) ImportSecrets(_ context.Context, _ struct{}) {}
+
+// ImportSecrets saves secrets exported from another model with
+// ExportSecrets to this model. User secrets become owned by this model.
+func (s *SecretsAPI) ImportSecrets(ctx context.Context, args params.ImportSecretsArgs) (params.ErrorResults, error) {
+	result := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.Secrets)),
+	}
+	if err := s.checkCanAdmin(ctx); err != nil {
+		return result, errors.Trace(err)
+	}
+	for i, arg := range args.Secrets {
+		err := s.importSecret(ctx, arg)
+		if errors.Is(err, secreterrors.SecretAlreadyExists) {
+			err = errors.AlreadyExistsf("secret %q", arg.URI)
+		} else if errors.Is(err, secreterrors.SecretLabelAlreadyExists) {
+			err = errors.AlreadyExistsf("secret with name %q", arg.Label)
+		}
+		result.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return result, nil
+}
+
+func (s *SecretsAPI) importSecret(ctx context.Context, arg params.ExportedSecret) error {
+	uri, err := coresecrets.ParseURI(arg.URI)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(arg.Revisions) == 0 {
+		return errors.NotValidf("secret %q with no revisions", uri.ID)
+	}
+	owner, err := ownerFromTag(arg.OwnerTag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if owner.Kind == coresecrets.ModelOwner {
+		owner.ID = s.modelUUID
+	}
+	md := &coresecrets.SecretMetadata{
+		URI:                    uri,
+		Version:                arg.Version,
+		Owner:                  owner,
+		Description:            arg.Description,
+		Label:                  arg.Label,
+		RotatePolicy:           coresecrets.RotatePolicy(arg.RotatePolicy),
+		NextRotateTime:         arg.NextRotateTime,
+		AutoPrune:              arg.AutoPrune,
+		LatestRevisionChecksum: arg.LatestRevisionChecksum,
+		LatestExpireTime:       arg.LatestExpireTime,
+	}
+	archive := &secretservice.SecretExport{
+		Secrets:   []*coresecrets.SecretMetadata{md},
+		Revisions: make(map[string][]*coresecrets.SecretRevisionMetadata),
+		Content:   map[string]map[int]coresecrets.SecretData{uri.ID: {}},
+	}
+	for _, rev := range arg.Revisions {
+		archive.Revisions[uri.ID] = append(archive.Revisions[uri.ID], &coresecrets.SecretRevisionMetadata{
+			Revision:   rev.Revision,
+			CreateTime: rev.CreateTime,
+			ExpireTime: rev.ExpireTime,
+		})
+		archive.Content[uri.ID][rev.Revision] = rev.Data
+	}
+	return errors.Trace(s.secretService.ImportSecretArchive(ctx, archive))
+}
+
+func ownerFromTag(ownerTag string) (coresecrets.Owner, error) {
+	tag, err := names.ParseTag(ownerTag)
+	if err != nil {
+		return coresecrets.Owner{}, errors.Trace(err)
+	}
+	switch kind := tag.Kind(); kind {
+	case names.ApplicationTagKind:
+		return coresecrets.Owner{Kind: coresecrets.ApplicationOwner, ID: tag.Id()}, nil
+	case names.UnitTagKind:
+		return coresecrets.Owner{Kind: coresecrets.UnitOwner, ID: tag.Id()}, nil
+	case names.ModelTagKind:
+		return coresecrets.Owner{Kind: coresecrets.ModelOwner, ID: tag.Id()}, nil
+	default:
+		return coresecrets.Owner{}, errors.NotValidf("secret owner tag kind %q", kind)
+	}
+}
+
 // CreateSecrets isn't on the v1 API.
 func (s *SecretsAPIV1) CreateSecrets(_ context.Context, _ struct{}) {}
 
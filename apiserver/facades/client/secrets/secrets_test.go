@@ -5,6 +5,7 @@ package secrets_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -23,6 +24,7 @@ import (
 	"github.com/juju/juju/domain/secret"
 	secreterrors "github.com/juju/juju/domain/secret/errors"
 	secretservice "github.com/juju/juju/domain/secret/service"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
 	"github.com/juju/juju/internal/testhelpers"
 	coretesting "github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/rpc/params"
@@ -82,7 +84,7 @@ func (s *SecretsSuite) assertListSecrets(c *tc.C, reveal bool) {
 		s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.ReadAccess, coretesting.ModelTag).Return(nil)
 	}
 
-	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService)
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService, loggertesting.WrapCheckLog(c))
 	c.Assert(err, tc.ErrorIsNil)
 
 	now := time.Now()
@@ -141,6 +143,10 @@ func (s *SecretsSuite) assertListSecrets(c *tc.C, reveal bool) {
 		s.secretService.EXPECT().GetSecretContentFromBackend(gomock.Any(), uri, 2).Return(
 			coresecrets.NewSecretValue(valueResult.Data), nil,
 		)
+		s.secretService.EXPECT().RecordSecretAccess(gomock.Any(), uri, 2, secretservice.SecretAccessor{
+			Kind: secretservice.UserAccessor,
+			ID:   "foo",
+		}).Return(nil)
 	}
 
 	results, err := facade.ListSecrets(c.Context(), params.ListSecretsArgs{ShowSecrets: reveal})
@@ -187,7 +193,7 @@ func (s *SecretsSuite) TestListSecretsPermissionDenied(c *tc.C) {
 	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.ReadAccess, coretesting.ModelTag).Return(
 		errors.WithType(apiservererrors.ErrPerm, authentication.ErrorEntityMissingPermission))
 
-	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService)
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService, loggertesting.WrapCheckLog(c))
 	c.Assert(err, tc.ErrorIsNil)
 
 	_, err = facade.ListSecrets(c.Context(), params.ListSecretsArgs{})
@@ -203,13 +209,183 @@ func (s *SecretsSuite) TestListSecretsPermissionDeniedShow(c *tc.C) {
 	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.AdminAccess, coretesting.ModelTag).Return(
 		errors.WithType(apiservererrors.ErrPerm, authentication.ErrorEntityMissingPermission))
 
-	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService)
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService, loggertesting.WrapCheckLog(c))
 	c.Assert(err, tc.ErrorIsNil)
 
 	_, err = facade.ListSecrets(c.Context(), params.ListSecretsArgs{ShowSecrets: true})
 	c.Assert(err, tc.ErrorMatches, "permission denied")
 }
 
+func (s *SecretsSuite) TestListSecretAccessLog(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.expectAuthClient()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(nil)
+
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService, loggertesting.WrapCheckLog(c))
+	c.Assert(err, tc.ErrorIsNil)
+
+	now := time.Now()
+	uri := coresecrets.NewURI()
+	s.secretService.EXPECT().GetSecretAccessLog(gomock.Any(), uri, ptr(2)).Return([]secret.AccessLogEntry{{
+		Revision:     2,
+		AccessorType: secret.AccessorUnit,
+		AccessorID:   "mariadb/0",
+		AccessTime:   now,
+	}, {
+		Revision:     2,
+		AccessorType: secret.AccessorUser,
+		AccessorID:   "bob",
+		AccessTime:   now.Add(time.Second),
+	}}, nil)
+
+	results, err := facade.ListSecretAccessLog(c.Context(), params.SecretAccessLogArgs{
+		Args: []params.SecretAccessLogArg{{URI: uri.String(), Revision: ptr(2)}, {URI: "bad"}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 2)
+	c.Check(results.Results[0], tc.DeepEquals, params.SecretAccessLogResult{
+		Entries: []params.SecretAccessLogEntry{
+			{Revision: 2, AccessorTag: "unit-mariadb-0", AccessTime: now},
+			{Revision: 2, AccessorTag: "user-bob", AccessTime: now.Add(time.Second)},
+		},
+	})
+	c.Check(results.Results[1].Error, tc.ErrorMatches, `secret URI "bad" not valid`)
+}
+
+func (s *SecretsSuite) TestListSecretAccessLogPermissionDenied(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.expectAuthClient()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(
+		errors.WithType(apiservererrors.ErrPerm, authentication.ErrorEntityMissingPermission))
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.AdminAccess, coretesting.ModelTag).Return(
+		errors.WithType(apiservererrors.ErrPerm, authentication.ErrorEntityMissingPermission))
+
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService, loggertesting.WrapCheckLog(c))
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = facade.ListSecretAccessLog(c.Context(), params.SecretAccessLogArgs{
+		Args: []params.SecretAccessLogArg{{URI: coresecrets.NewURI().String()}},
+	})
+	c.Assert(err, tc.ErrorMatches, "permission denied")
+}
+
+func (s *SecretsSuite) TestExportSecrets(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.expectAuthClient()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(nil)
+
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService, loggertesting.WrapCheckLog(c))
+	c.Assert(err, tc.ErrorIsNil)
+
+	now := time.Now()
+	uri := coresecrets.NewURI()
+	s.secretService.EXPECT().ExportSecretArchive(gomock.Any(), secretservice.SecretArchiveFilter{
+		Labels: []string{"password"},
+		Owners: []secretservice.CharmSecretOwner{{Kind: secretservice.ApplicationOwner, ID: "mysql"}},
+	}).Return(&secretservice.SecretExport{
+		Secrets: []*coresecrets.SecretMetadata{{
+			URI:                    uri,
+			Version:                1,
+			Owner:                  coresecrets.Owner{Kind: coresecrets.ApplicationOwner, ID: "mysql"},
+			Label:                  "password",
+			RotatePolicy:           coresecrets.RotateHourly,
+			LatestRevisionChecksum: "checksum",
+		}},
+		Revisions: map[string][]*coresecrets.SecretRevisionMetadata{
+			uri.ID: {{Revision: 2, CreateTime: now}},
+		},
+		Content: map[string]map[int]coresecrets.SecretData{
+			uri.ID: {2: {"foo": "YmFy"}},
+		},
+	}, nil)
+	s.secretService.EXPECT().RecordSecretAccess(gomock.Any(), uri, 2, secretservice.SecretAccessor{
+		Kind: secretservice.UserAccessor,
+		ID:   "foo",
+	}).Return(nil)
+
+	result, err := facade.ExportSecrets(c.Context(), params.ExportSecretsArgs{
+		Labels:    []string{"password"},
+		OwnerTags: []string{"application-mysql"},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result, tc.DeepEquals, params.ExportSecretsResult{
+		Secrets: []params.ExportedSecret{{
+			URI:                    uri.String(),
+			Version:                1,
+			OwnerTag:               "application-mysql",
+			Label:                  "password",
+			RotatePolicy:           "hourly",
+			LatestRevisionChecksum: "checksum",
+			Revisions: []params.ExportedSecretRevision{{
+				Revision:   2,
+				CreateTime: now,
+				Data:       map[string]string{"foo": "YmFy"},
+			}},
+		}},
+	})
+}
+
+func (s *SecretsSuite) TestImportSecrets(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.expectAuthClient()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.SuperuserAccess, coretesting.ControllerTag).Return(nil)
+
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService, loggertesting.WrapCheckLog(c))
+	c.Assert(err, tc.ErrorIsNil)
+
+	uri := coresecrets.NewURI()
+	existing := coresecrets.NewURI()
+	s.secretService.EXPECT().ImportSecretArchive(gomock.Any(), &secretservice.SecretExport{
+		Secrets: []*coresecrets.SecretMetadata{{
+			URI:                    uri,
+			Version:                1,
+			Owner:                  coresecrets.Owner{Kind: coresecrets.ModelOwner, ID: coretesting.ModelTag.Id()},
+			Label:                  "my-secret",
+			LatestRevisionChecksum: "checksum",
+		}},
+		Revisions: map[string][]*coresecrets.SecretRevisionMetadata{
+			uri.ID: {{Revision: 1}},
+		},
+		Content: map[string]map[int]coresecrets.SecretData{
+			uri.ID: {1: {"foo": "YmFy"}},
+		},
+	}).Return(nil)
+	s.secretService.EXPECT().ImportSecretArchive(gomock.Any(), gomock.Any()).Return(secreterrors.SecretAlreadyExists)
+
+	result, err := facade.ImportSecrets(c.Context(), params.ImportSecretsArgs{
+		Secrets: []params.ExportedSecret{{
+			URI:                    uri.String(),
+			Version:                1,
+			OwnerTag:               "model-deadbeef-1bad-400d-8000-4b1d0d06f00d",
+			Label:                  "my-secret",
+			LatestRevisionChecksum: "checksum",
+			Revisions: []params.ExportedSecretRevision{{
+				Revision: 1,
+				Data:     map[string]string{"foo": "YmFy"},
+			}},
+		}, {
+			URI:      existing.String(),
+			OwnerTag: "model-deadbeef-0bad-400d-8000-4b1d0d06f00d",
+			Revisions: []params.ExportedSecretRevision{{
+				Revision: 1,
+				Data:     map[string]string{"foo": "YmFy"},
+			}},
+		}, {
+			URI:      coresecrets.NewURI().String(),
+			OwnerTag: "application-mysql",
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(result.Results, tc.HasLen, 3)
+	c.Check(result.Results[0].Error, tc.IsNil)
+	c.Check(result.Results[1].Error, tc.ErrorMatches, fmt.Sprintf(`secret %q already exists`, existing.String()))
+	c.Check(result.Results[2].Error, tc.ErrorMatches, `secret ".*" with no revisions not valid`)
+}
+
 func (s *SecretsSuite) TestCreateSecretsPermissionDenied(c *tc.C) {
 	defer s.setup(c).Finish()
 
@@ -217,7 +393,7 @@ func (s *SecretsSuite) TestCreateSecretsPermissionDenied(c *tc.C) {
 	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.WriteAccess, coretesting.ModelTag).Return(
 		errors.WithType(apiservererrors.ErrPerm, authentication.ErrorEntityMissingPermission))
 
-	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService)
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService, loggertesting.WrapCheckLog(c))
 	c.Assert(err, tc.ErrorIsNil)
 
 	_, err = facade.CreateSecrets(c.Context(), params.CreateSecretArgs{})
@@ -233,7 +409,7 @@ func (s *SecretsSuite) TestCreateSecretsEmptyData(c *tc.C) {
 	uri := coresecrets.NewURI()
 	uriStrPtr := ptr(uri.String())
 
-	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService)
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService, loggertesting.WrapCheckLog(c))
 	c.Assert(err, tc.ErrorIsNil)
 
 	result, err := facade.CreateSecrets(c.Context(), params.CreateSecretArgs{
@@ -265,7 +441,7 @@ func (s *SecretsSuite) TestCreateSecrets(c *tc.C) {
 		c.Assert(params.UpdateUserSecretParams.Checksum, tc.Equals, "7a38bf81f383f69433ad6e900d35b3e2385593f76a7b7ab5d4355b8ba41ee24b")
 		return nil
 	})
-	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService)
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService, loggertesting.WrapCheckLog(c))
 	c.Assert(err, tc.ErrorIsNil)
 
 	result, err := facade.CreateSecrets(c.Context(), params.CreateSecretArgs{
@@ -312,7 +488,7 @@ func (s *SecretsSuite) assertUpdateSecrets(c *tc.C, uri *coresecrets.URI) {
 		c.Assert(params.Checksum, tc.Equals, "7a38bf81f383f69433ad6e900d35b3e2385593f76a7b7ab5d4355b8ba41ee24b")
 		return nil
 	})
-	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService)
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService, loggertesting.WrapCheckLog(c))
 	c.Assert(err, tc.ErrorIsNil)
 
 	result, err := facade.UpdateSecrets(c.Context(), params.UpdateUserSecretArgs{
@@ -355,7 +531,7 @@ func (s *SecretsSuite) TestRemoveSecrets(c *tc.C) {
 		Revisions: []int{666},
 	}).Return(nil)
 
-	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService)
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService, loggertesting.WrapCheckLog(c))
 	c.Assert(err, tc.ErrorIsNil)
 	results, err := facade.RemoveSecrets(c.Context(), params.DeleteSecretArgs{
 		Args: []params.DeleteSecretArg{{
@@ -377,7 +553,7 @@ func (s *SecretsSuite) TestRemoveSecretsFailedNotModelAdmin(c *tc.C) {
 	expectURI := *uri
 	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.WriteAccess, coretesting.ModelTag).Return(apiservererrors.ErrPerm)
 
-	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService)
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService, loggertesting.WrapCheckLog(c))
 	c.Assert(err, tc.ErrorIsNil)
 	_, err = facade.RemoveSecrets(c.Context(), params.DeleteSecretArgs{
 		Args: []params.DeleteSecretArg{{
@@ -400,7 +576,7 @@ func (s *SecretsSuite) TestRemoveSecretRevision(c *tc.C) {
 		Revisions: []int{666},
 	}).Return(nil)
 
-	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService)
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService, loggertesting.WrapCheckLog(c))
 	c.Assert(err, tc.ErrorIsNil)
 	results, err := facade.RemoveSecrets(c.Context(), params.DeleteSecretArgs{
 		Args: []params.DeleteSecretArg{{
@@ -426,7 +602,7 @@ func (s *SecretsSuite) TestRemoveSecretNotFound(c *tc.C) {
 		Revisions: []int{666},
 	}).Return(secreterrors.SecretNotFound)
 
-	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService)
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService, loggertesting.WrapCheckLog(c))
 	c.Assert(err, tc.ErrorIsNil)
 	results, err := facade.RemoveSecrets(c.Context(), params.DeleteSecretArgs{
 		Args: []params.DeleteSecretArg{{
@@ -468,7 +644,7 @@ func (s *SecretsSuite) TestGrantSecret(c *tc.C) {
 		},
 	)
 
-	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService)
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService, loggertesting.WrapCheckLog(c))
 	c.Assert(err, tc.ErrorIsNil)
 
 	result, err := facade.GrantSecret(c.Context(), params.GrantRevokeUserSecretArg{
@@ -512,7 +688,7 @@ func (s *SecretsSuite) TestGrantSecretByName(c *tc.C) {
 		},
 	)
 
-	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService)
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService, loggertesting.WrapCheckLog(c))
 	c.Assert(err, tc.ErrorIsNil)
 
 	result, err := facade.GrantSecret(c.Context(), params.GrantRevokeUserSecretArg{
@@ -533,7 +709,7 @@ func (s *SecretsSuite) TestGrantSecretPermissionDenied(c *tc.C) {
 		errors.WithType(apiservererrors.ErrPerm, authentication.ErrorEntityMissingPermission),
 	)
 
-	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService)
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService, loggertesting.WrapCheckLog(c))
 	c.Assert(err, tc.ErrorIsNil)
 
 	_, err = facade.GrantSecret(c.Context(), params.GrantRevokeUserSecretArg{Label: "my-secret"})
@@ -570,7 +746,7 @@ func (s *SecretsSuite) TestRevokeSecret(c *tc.C) {
 		},
 	)
 
-	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService)
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService, loggertesting.WrapCheckLog(c))
 	c.Assert(err, tc.ErrorIsNil)
 
 	result, err := facade.RevokeSecret(c.Context(), params.GrantRevokeUserSecretArg{
@@ -591,7 +767,7 @@ func (s *SecretsSuite) TestRevokeSecretPermissionDenied(c *tc.C) {
 		errors.WithType(apiservererrors.ErrPerm, authentication.ErrorEntityMissingPermission),
 	)
 
-	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService)
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService, loggertesting.WrapCheckLog(c))
 	c.Assert(err, tc.ErrorIsNil)
 
 	_, err = facade.RevokeSecret(c.Context(), params.GrantRevokeUserSecretArg{Label: "my-secret"})
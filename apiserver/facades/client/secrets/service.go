@@ -30,6 +30,16 @@ type SecretService interface {
 	) ([]*secrets.SecretMetadata, [][]*secrets.SecretRevisionMetadata, error)
 	ListCharmSecrets(ctx context.Context, owners ...secretservice.CharmSecretOwner) ([]*secrets.SecretMetadata, [][]*secrets.SecretRevisionMetadata, error)
 
+	// Audit secret content reads.
+
+	RecordSecretAccess(ctx context.Context, uri *secrets.URI, revision int, accessor secretservice.SecretAccessor) error
+	GetSecretAccessLog(ctx context.Context, uri *secrets.URI, revision *int) ([]domainsecret.AccessLogEntry, error)
+
+	// Move secrets between models.
+
+	ExportSecretArchive(ctx context.Context, filter secretservice.SecretArchiveFilter) (*secretservice.SecretExport, error)
+	ImportSecretArchive(ctx context.Context, archive *secretservice.SecretExport) error
+
 	// Delete secrets.
 
 	DeleteSecret(ctx context.Context, uri *secrets.URI, params secretservice.DeleteSecretParams) error
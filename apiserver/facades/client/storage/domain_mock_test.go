@@ -15,10 +15,11 @@ import (
 
 	blockdevice "github.com/juju/juju/core/blockdevice"
 	machine "github.com/juju/juju/core/machine"
+	storage "github.com/juju/juju/core/storage"
 	unit "github.com/juju/juju/core/unit"
-	storage "github.com/juju/juju/domain/storage"
+	storage0 "github.com/juju/juju/domain/storage"
 	service "github.com/juju/juju/domain/storage/service"
-	storage0 "github.com/juju/juju/internal/storage"
+	storage1 "github.com/juju/juju/internal/storage"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -46,7 +47,7 @@ func (m *MockStorageService) EXPECT() *MockStorageServiceMockRecorder {
 }
 
 // CreateStoragePool mocks base method.
-func (m *MockStorageService) CreateStoragePool(arg0 context.Context, arg1 string, arg2 storage0.ProviderType, arg3 service.PoolAttrs) error {
+func (m *MockStorageService) CreateStoragePool(arg0 context.Context, arg1 string, arg2 storage1.ProviderType, arg3 service.PoolAttrs) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateStoragePool", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(error)
@@ -72,13 +73,13 @@ func (c *MockStorageServiceCreateStoragePoolCall) Return(arg0 error) *MockStorag
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStorageServiceCreateStoragePoolCall) Do(f func(context.Context, string, storage0.ProviderType, service.PoolAttrs) error) *MockStorageServiceCreateStoragePoolCall {
+func (c *MockStorageServiceCreateStoragePoolCall) Do(f func(context.Context, string, storage1.ProviderType, service.PoolAttrs) error) *MockStorageServiceCreateStoragePoolCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStorageServiceCreateStoragePoolCall) DoAndReturn(f func(context.Context, string, storage0.ProviderType, service.PoolAttrs) error) *MockStorageServiceCreateStoragePoolCall {
+func (c *MockStorageServiceCreateStoragePoolCall) DoAndReturn(f func(context.Context, string, storage1.ProviderType, service.PoolAttrs) error) *MockStorageServiceCreateStoragePoolCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -122,10 +123,10 @@ func (c *MockStorageServiceDeleteStoragePoolCall) DoAndReturn(f func(context.Con
 }
 
 // GetStoragePoolByName mocks base method.
-func (m *MockStorageService) GetStoragePoolByName(arg0 context.Context, arg1 string) (storage.StoragePool, error) {
+func (m *MockStorageService) GetStoragePoolByName(arg0 context.Context, arg1 string) (storage0.StoragePool, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetStoragePoolByName", arg0, arg1)
-	ret0, _ := ret[0].(storage.StoragePool)
+	ret0, _ := ret[0].(storage0.StoragePool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -143,28 +144,67 @@ type MockStorageServiceGetStoragePoolByNameCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStorageServiceGetStoragePoolByNameCall) Return(arg0 storage.StoragePool, arg1 error) *MockStorageServiceGetStoragePoolByNameCall {
+func (c *MockStorageServiceGetStoragePoolByNameCall) Return(arg0 storage0.StoragePool, arg1 error) *MockStorageServiceGetStoragePoolByNameCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStorageServiceGetStoragePoolByNameCall) Do(f func(context.Context, string) (storage.StoragePool, error)) *MockStorageServiceGetStoragePoolByNameCall {
+func (c *MockStorageServiceGetStoragePoolByNameCall) Do(f func(context.Context, string) (storage0.StoragePool, error)) *MockStorageServiceGetStoragePoolByNameCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStorageServiceGetStoragePoolByNameCall) DoAndReturn(f func(context.Context, string) (storage.StoragePool, error)) *MockStorageServiceGetStoragePoolByNameCall {
+func (c *MockStorageServiceGetStoragePoolByNameCall) DoAndReturn(f func(context.Context, string) (storage0.StoragePool, error)) *MockStorageServiceGetStoragePoolByNameCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ImportFilesystem mocks base method.
+func (m *MockStorageService) ImportFilesystem(arg0 context.Context, arg1 service.ImportStorageParams) (storage.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportFilesystem", arg0, arg1)
+	ret0, _ := ret[0].(storage.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportFilesystem indicates an expected call of ImportFilesystem.
+func (mr *MockStorageServiceMockRecorder) ImportFilesystem(arg0, arg1 any) *MockStorageServiceImportFilesystemCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportFilesystem", reflect.TypeOf((*MockStorageService)(nil).ImportFilesystem), arg0, arg1)
+	return &MockStorageServiceImportFilesystemCall{Call: call}
+}
+
+// MockStorageServiceImportFilesystemCall wrap *gomock.Call
+type MockStorageServiceImportFilesystemCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageServiceImportFilesystemCall) Return(arg0 storage.ID, arg1 error) *MockStorageServiceImportFilesystemCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageServiceImportFilesystemCall) Do(f func(context.Context, service.ImportStorageParams) (storage.ID, error)) *MockStorageServiceImportFilesystemCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageServiceImportFilesystemCall) DoAndReturn(f func(context.Context, service.ImportStorageParams) (storage.ID, error)) *MockStorageServiceImportFilesystemCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // ListStoragePools mocks base method.
-func (m *MockStorageService) ListStoragePools(arg0 context.Context) ([]storage.StoragePool, error) {
+func (m *MockStorageService) ListStoragePools(arg0 context.Context) ([]storage0.StoragePool, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListStoragePools", arg0)
-	ret0, _ := ret[0].([]storage.StoragePool)
+	ret0, _ := ret[0].([]storage0.StoragePool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -182,28 +222,28 @@ type MockStorageServiceListStoragePoolsCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStorageServiceListStoragePoolsCall) Return(arg0 []storage.StoragePool, arg1 error) *MockStorageServiceListStoragePoolsCall {
+func (c *MockStorageServiceListStoragePoolsCall) Return(arg0 []storage0.StoragePool, arg1 error) *MockStorageServiceListStoragePoolsCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStorageServiceListStoragePoolsCall) Do(f func(context.Context) ([]storage.StoragePool, error)) *MockStorageServiceListStoragePoolsCall {
+func (c *MockStorageServiceListStoragePoolsCall) Do(f func(context.Context) ([]storage0.StoragePool, error)) *MockStorageServiceListStoragePoolsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStorageServiceListStoragePoolsCall) DoAndReturn(f func(context.Context) ([]storage.StoragePool, error)) *MockStorageServiceListStoragePoolsCall {
+func (c *MockStorageServiceListStoragePoolsCall) DoAndReturn(f func(context.Context) ([]storage0.StoragePool, error)) *MockStorageServiceListStoragePoolsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // ListStoragePoolsByNames mocks base method.
-func (m *MockStorageService) ListStoragePoolsByNames(arg0 context.Context, arg1 storage.Names) ([]storage.StoragePool, error) {
+func (m *MockStorageService) ListStoragePoolsByNames(arg0 context.Context, arg1 storage0.Names) ([]storage0.StoragePool, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListStoragePoolsByNames", arg0, arg1)
-	ret0, _ := ret[0].([]storage.StoragePool)
+	ret0, _ := ret[0].([]storage0.StoragePool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -221,28 +261,28 @@ type MockStorageServiceListStoragePoolsByNamesCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStorageServiceListStoragePoolsByNamesCall) Return(arg0 []storage.StoragePool, arg1 error) *MockStorageServiceListStoragePoolsByNamesCall {
+func (c *MockStorageServiceListStoragePoolsByNamesCall) Return(arg0 []storage0.StoragePool, arg1 error) *MockStorageServiceListStoragePoolsByNamesCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStorageServiceListStoragePoolsByNamesCall) Do(f func(context.Context, storage.Names) ([]storage.StoragePool, error)) *MockStorageServiceListStoragePoolsByNamesCall {
+func (c *MockStorageServiceListStoragePoolsByNamesCall) Do(f func(context.Context, storage0.Names) ([]storage0.StoragePool, error)) *MockStorageServiceListStoragePoolsByNamesCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStorageServiceListStoragePoolsByNamesCall) DoAndReturn(f func(context.Context, storage.Names) ([]storage.StoragePool, error)) *MockStorageServiceListStoragePoolsByNamesCall {
+func (c *MockStorageServiceListStoragePoolsByNamesCall) DoAndReturn(f func(context.Context, storage0.Names) ([]storage0.StoragePool, error)) *MockStorageServiceListStoragePoolsByNamesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // ListStoragePoolsByNamesAndProviders mocks base method.
-func (m *MockStorageService) ListStoragePoolsByNamesAndProviders(arg0 context.Context, arg1 storage.Names, arg2 storage.Providers) ([]storage.StoragePool, error) {
+func (m *MockStorageService) ListStoragePoolsByNamesAndProviders(arg0 context.Context, arg1 storage0.Names, arg2 storage0.Providers) ([]storage0.StoragePool, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListStoragePoolsByNamesAndProviders", arg0, arg1, arg2)
-	ret0, _ := ret[0].([]storage.StoragePool)
+	ret0, _ := ret[0].([]storage0.StoragePool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -260,28 +300,28 @@ type MockStorageServiceListStoragePoolsByNamesAndProvidersCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStorageServiceListStoragePoolsByNamesAndProvidersCall) Return(arg0 []storage.StoragePool, arg1 error) *MockStorageServiceListStoragePoolsByNamesAndProvidersCall {
+func (c *MockStorageServiceListStoragePoolsByNamesAndProvidersCall) Return(arg0 []storage0.StoragePool, arg1 error) *MockStorageServiceListStoragePoolsByNamesAndProvidersCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStorageServiceListStoragePoolsByNamesAndProvidersCall) Do(f func(context.Context, storage.Names, storage.Providers) ([]storage.StoragePool, error)) *MockStorageServiceListStoragePoolsByNamesAndProvidersCall {
+func (c *MockStorageServiceListStoragePoolsByNamesAndProvidersCall) Do(f func(context.Context, storage0.Names, storage0.Providers) ([]storage0.StoragePool, error)) *MockStorageServiceListStoragePoolsByNamesAndProvidersCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStorageServiceListStoragePoolsByNamesAndProvidersCall) DoAndReturn(f func(context.Context, storage.Names, storage.Providers) ([]storage.StoragePool, error)) *MockStorageServiceListStoragePoolsByNamesAndProvidersCall {
+func (c *MockStorageServiceListStoragePoolsByNamesAndProvidersCall) DoAndReturn(f func(context.Context, storage0.Names, storage0.Providers) ([]storage0.StoragePool, error)) *MockStorageServiceListStoragePoolsByNamesAndProvidersCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // ListStoragePoolsByProviders mocks base method.
-func (m *MockStorageService) ListStoragePoolsByProviders(arg0 context.Context, arg1 storage.Providers) ([]storage.StoragePool, error) {
+func (m *MockStorageService) ListStoragePoolsByProviders(arg0 context.Context, arg1 storage0.Providers) ([]storage0.StoragePool, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListStoragePoolsByProviders", arg0, arg1)
-	ret0, _ := ret[0].([]storage.StoragePool)
+	ret0, _ := ret[0].([]storage0.StoragePool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -299,25 +339,25 @@ type MockStorageServiceListStoragePoolsByProvidersCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStorageServiceListStoragePoolsByProvidersCall) Return(arg0 []storage.StoragePool, arg1 error) *MockStorageServiceListStoragePoolsByProvidersCall {
+func (c *MockStorageServiceListStoragePoolsByProvidersCall) Return(arg0 []storage0.StoragePool, arg1 error) *MockStorageServiceListStoragePoolsByProvidersCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStorageServiceListStoragePoolsByProvidersCall) Do(f func(context.Context, storage.Providers) ([]storage.StoragePool, error)) *MockStorageServiceListStoragePoolsByProvidersCall {
+func (c *MockStorageServiceListStoragePoolsByProvidersCall) Do(f func(context.Context, storage0.Providers) ([]storage0.StoragePool, error)) *MockStorageServiceListStoragePoolsByProvidersCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStorageServiceListStoragePoolsByProvidersCall) DoAndReturn(f func(context.Context, storage.Providers) ([]storage.StoragePool, error)) *MockStorageServiceListStoragePoolsByProvidersCall {
+func (c *MockStorageServiceListStoragePoolsByProvidersCall) DoAndReturn(f func(context.Context, storage0.Providers) ([]storage0.StoragePool, error)) *MockStorageServiceListStoragePoolsByProvidersCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // ReplaceStoragePool mocks base method.
-func (m *MockStorageService) ReplaceStoragePool(arg0 context.Context, arg1 string, arg2 storage0.ProviderType, arg3 service.PoolAttrs) error {
+func (m *MockStorageService) ReplaceStoragePool(arg0 context.Context, arg1 string, arg2 storage1.ProviderType, arg3 service.PoolAttrs) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ReplaceStoragePool", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(error)
@@ -343,13 +383,13 @@ func (c *MockStorageServiceReplaceStoragePoolCall) Return(arg0 error) *MockStora
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStorageServiceReplaceStoragePoolCall) Do(f func(context.Context, string, storage0.ProviderType, service.PoolAttrs) error) *MockStorageServiceReplaceStoragePoolCall {
+func (c *MockStorageServiceReplaceStoragePoolCall) Do(f func(context.Context, string, storage1.ProviderType, service.PoolAttrs) error) *MockStorageServiceReplaceStoragePoolCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStorageServiceReplaceStoragePoolCall) DoAndReturn(f func(context.Context, string, storage0.ProviderType, service.PoolAttrs) error) *MockStorageServiceReplaceStoragePoolCall {
+func (c *MockStorageServiceReplaceStoragePoolCall) DoAndReturn(f func(context.Context, string, storage1.ProviderType, service.PoolAttrs) error) *MockStorageServiceReplaceStoragePoolCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -17,6 +17,7 @@ import (
 	"github.com/juju/juju/core/machine"
 	coremodel "github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/permission"
+	corestorage "github.com/juju/juju/core/storage"
 	"github.com/juju/juju/core/unit"
 	domainstorage "github.com/juju/juju/domain/storage"
 	storageservice "github.com/juju/juju/domain/storage/service"
@@ -82,6 +83,16 @@ type StorageService interface {
 	// The following errors can be expected:
 	// - [storageerrors.PoolNotFoundError] if a pool with the specified name does not exist.
 	GetStoragePoolByName(ctx context.Context, name string) (domainstorage.StoragePool, error)
+
+	// ImportFilesystem associates a filesystem (either native or volume backed)
+	// hosted by a cloud provider with a new storage instance in the model.
+	// The following error types can be expected:
+	// - [coreerrors.NotSupported]: when the importing the kind of storage is not supported by the provider.
+	// - [storageerrors.InvalidPoolNameError]: when the supplied pool name is invalid.
+	// - [storageerrors.PoolNotFoundError]: when the supplied pool does not exist.
+	// - [corestorage.InvalidStorageName]: when the supplied storage name is invalid.
+	// - [storageerrors.StorageAlreadyImported]: when the storage is already in the model.
+	ImportFilesystem(ctx context.Context, arg storageservice.ImportStorageParams) (corestorage.ID, error)
 }
 
 // ApplicationService defines apis on the application service.
@@ -299,10 +310,53 @@ func (a *StorageAPI) Attach(ctx context.Context, args params.StorageAttachmentId
 // Import imports existing storage into the model.
 // A "CHANGE" block can block this operation.
 func (a *StorageAPI) Import(ctx context.Context, args params.BulkImportStorageParamsV2) (params.ImportStorageResults, error) {
+	if err := a.checkCanWrite(ctx); err != nil {
+		return params.ImportStorageResults{}, errors.Trace(err)
+	}
+
+	blockChecker := common.NewBlockChecker(a.blockCommandService)
+	if err := blockChecker.ChangeAllowed(ctx); err != nil {
+		return params.ImportStorageResults{}, errors.Trace(err)
+	}
+
 	results := make([]params.ImportStorageResult, len(args.Storage))
+	for i, arg := range args.Storage {
+		details, err := a.importStorage(ctx, arg)
+		if err != nil {
+			results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results[i].Result = details
+	}
 	return params.ImportStorageResults{Results: results}, nil
 }
 
+func (a *StorageAPI) importStorage(ctx context.Context, arg params.ImportStorageParamsV2) (*params.ImportStorageDetails, error) {
+	var kind storage.StorageKind
+	switch arg.Kind {
+	case params.StorageKindBlock:
+		kind = storage.StorageKindBlock
+	case params.StorageKindFilesystem:
+		kind = storage.StorageKindFilesystem
+	default:
+		return nil, errors.NotSupportedf("storage kind %q", arg.Kind.String())
+	}
+
+	storageID, err := a.storageService.ImportFilesystem(ctx, storageservice.ImportStorageParams{
+		Kind:        kind,
+		Pool:        arg.Pool,
+		ProviderId:  arg.ProviderId,
+		StorageName: corestorage.Name(arg.StorageName),
+		Force:       arg.Force,
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &params.ImportStorageDetails{
+		StorageTag: names.NewStorageTag(storageID.String()).String(),
+	}, nil
+}
+
 // Import imports existing storage into the model.
 // A "CHANGE" block can block this operation.
 func (a *StorageAPIv6) Import(ctx context.Context, args params.BulkImportStorageParams) (params.ImportStorageResults, error) {
@@ -37,13 +37,6 @@ func (s *storageSuite) TestStub(c *tc.C) {
 - TestDetachAttachmentNotFoundConcurrent
 - TestDetachNoAttachmentsStorageNotFound
 - TestAttach
-- TestImportFilesystem
-- TestImportFilesystemVolumeBacked
-- TestImportFilesystemError
-- TestImportFilesystemNotSupported
-- TestImportFilesystemK8sProvider
-- TestImportFilesystemVolumeBackedNotSupported
-- TestImportValidationErrors
 - TestListStorageAsAdminOnNotOwnedModel
 - TestListStorageAsNonAdminOnNotOwnedModel
 `)
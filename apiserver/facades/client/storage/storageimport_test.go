@@ -0,0 +1,125 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package storage_test
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	corestorage "github.com/juju/juju/core/storage"
+	"github.com/juju/juju/domain/blockcommand"
+	blockcommanderrors "github.com/juju/juju/domain/blockcommand/errors"
+	storageerrors "github.com/juju/juju/domain/storage/errors"
+	storageservice "github.com/juju/juju/domain/storage/service"
+	internalstorage "github.com/juju/juju/internal/storage"
+	"github.com/juju/juju/rpc/params"
+)
+
+type storageImportSuite struct {
+	baseStorageSuite
+}
+
+func TestStorageImportSuite(t *testing.T) {
+	tc.Run(t, &storageImportSuite{})
+}
+
+func (s *storageImportSuite) expectChangeAllowed() {
+	s.blockCommandService.EXPECT().GetBlockSwitchedOn(gomock.Any(), blockcommand.ChangeBlock).
+		Return("", blockcommanderrors.NotFound)
+}
+
+func (s *storageImportSuite) TestImportFilesystem(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectChangeAllowed()
+	s.storageService.EXPECT().ImportFilesystem(gomock.Any(), storageservice.ImportStorageParams{
+		Kind:        internalstorage.StorageKindFilesystem,
+		Pool:        "radiance",
+		ProviderId:  "foo",
+		StorageName: "pgdata",
+		Force:       true,
+	}).Return(corestorage.ID("pgdata/0"), nil)
+
+	results, err := s.api.Import(c.Context(), params.BulkImportStorageParamsV2{
+		Storage: []params.ImportStorageParamsV2{{
+			Kind:        params.StorageKindFilesystem,
+			Pool:        "radiance",
+			ProviderId:  "foo",
+			StorageName: "pgdata",
+			Force:       true,
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.DeepEquals, []params.ImportStorageResult{{
+		Result: &params.ImportStorageDetails{
+			StorageTag: "storage-pgdata-0",
+		},
+	}})
+}
+
+func (s *storageImportSuite) TestImportFilesystemError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectChangeAllowed()
+	s.storageService.EXPECT().ImportFilesystem(gomock.Any(), gomock.Any()).
+		Return(corestorage.ID(""), storageerrors.PoolNotFoundError)
+	s.storageService.EXPECT().ImportFilesystem(gomock.Any(), gomock.Any()).
+		Return(corestorage.ID(""), coreerrors.NotSupported)
+
+	results, err := s.api.Import(c.Context(), params.BulkImportStorageParamsV2{
+		Storage: []params.ImportStorageParamsV2{{
+			Kind:        params.StorageKindFilesystem,
+			Pool:        "radiance",
+			ProviderId:  "foo",
+			StorageName: "pgdata",
+		}, {
+			Kind:        params.StorageKindFilesystem,
+			Pool:        "radiance",
+			ProviderId:  "bar",
+			StorageName: "pgdata",
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 2)
+	c.Check(results.Results[0].Error, tc.ErrorMatches, "storage pool is not found")
+	c.Check(results.Results[1].Error, tc.Satisfies, params.IsCodeNotSupported)
+}
+
+func (s *storageImportSuite) TestImportUnknownKind(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectChangeAllowed()
+
+	results, err := s.api.Import(c.Context(), params.BulkImportStorageParamsV2{
+		Storage: []params.ImportStorageParamsV2{{
+			Kind:        params.StorageKindUnknown,
+			Pool:        "radiance",
+			ProviderId:  "foo",
+			StorageName: "pgdata",
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 1)
+	c.Check(results.Results[0].Error, tc.Satisfies, params.IsCodeNotSupported)
+}
+
+func (s *storageImportSuite) TestImportBlocked(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.blockCommandService.EXPECT().GetBlockSwitchedOn(gomock.Any(), blockcommand.ChangeBlock).
+		Return("TestImportBlocked", nil)
+
+	_, err := s.api.Import(c.Context(), params.BulkImportStorageParamsV2{
+		Storage: []params.ImportStorageParamsV2{{
+			Kind:        params.StorageKindFilesystem,
+			Pool:        "radiance",
+			ProviderId:  "foo",
+			StorageName: "pgdata",
+		}},
+	})
+	c.Assert(params.IsCodeOperationBlocked(err), tc.IsTrue)
+}
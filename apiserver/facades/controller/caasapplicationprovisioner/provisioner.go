@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/juju/clock"
+	"github.com/juju/collections/transform"
 	"github.com/juju/errors"
 	"github.com/juju/names/v6"
 	"gopkg.in/tomb.v2"
@@ -28,6 +29,7 @@ import (
 	"github.com/juju/juju/core/watcher/eventsource"
 	applicationerrors "github.com/juju/juju/domain/application/errors"
 	modelerrors "github.com/juju/juju/domain/model/errors"
+	"github.com/juju/juju/domain/storage"
 	"github.com/juju/juju/environs/tags"
 	"github.com/juju/juju/internal/cloudconfig/podcfg"
 	"github.com/juju/juju/internal/docker"
@@ -49,16 +51,17 @@ type API struct {
 	auth            facade.Authorizer
 	watcherRegistry facade.WatcherRegistry
 
-	applicationService      ApplicationService
-	controllerConfigService ControllerConfigService
-	controllerNodeService   ControllerNodeService
-	modelConfigService      ModelConfigService
-	modelInfoService        ModelInfoService
-	statusService           StatusService
-	removalService          RemovalService
-	getCanWatch             common.GetAuthFunc
-	clock                   clock.Clock
-	logger                  corelogger.Logger
+	applicationService         ApplicationService
+	controllerConfigService    ControllerConfigService
+	controllerNodeService      ControllerNodeService
+	modelConfigService         ModelConfigService
+	modelInfoService           ModelInfoService
+	statusService              StatusService
+	removalService             RemovalService
+	storageProvisioningService StorageProvisioningService
+	getCanWatch                common.GetAuthFunc
+	clock                      clock.Clock
+	logger                     corelogger.Logger
 }
 
 // NewStateCAASApplicationProvisionerAPI provides the signature required for facade registration.
@@ -84,13 +87,14 @@ func NewStateCAASApplicationProvisionerAPI(stdCtx context.Context, ctx facade.Mo
 	}
 
 	services := Services{
-		ApplicationService:      applicationService,
-		ControllerConfigService: controllerConfigService,
-		ControllerNodeService:   domainServices.ControllerNode(),
-		ModelConfigService:      modelConfigService,
-		ModelInfoService:        domainServices.ModelInfo(),
-		StatusService:           domainServices.Status(),
-		RemovalService:          domainServices.Removal(),
+		ApplicationService:         applicationService,
+		ControllerConfigService:    controllerConfigService,
+		ControllerNodeService:      domainServices.ControllerNode(),
+		ModelConfigService:         modelConfigService,
+		ModelInfoService:           domainServices.ModelInfo(),
+		StatusService:              domainServices.Status(),
+		RemovalService:             domainServices.Removal(),
+		StorageProvisioningService: domainServices.StorageProvisioning(),
 	}
 
 	api, err := NewCAASApplicationProvisionerAPI(
@@ -144,18 +148,19 @@ func NewCAASApplicationProvisionerAPI(
 	getCanWatch := common.AuthFuncForTagKind(names.ApplicationTagKind)
 
 	return &API{
-		auth:                    authorizer,
-		watcherRegistry:         watcherRegistry,
-		controllerConfigService: services.ControllerConfigService,
-		controllerNodeService:   services.ControllerNodeService,
-		modelConfigService:      services.ModelConfigService,
-		modelInfoService:        services.ModelInfoService,
-		applicationService:      services.ApplicationService,
-		statusService:           services.StatusService,
-		removalService:          services.RemovalService,
-		getCanWatch:             getCanWatch,
-		clock:                   clock,
-		logger:                  logger,
+		auth:                       authorizer,
+		watcherRegistry:            watcherRegistry,
+		controllerConfigService:    services.ControllerConfigService,
+		controllerNodeService:      services.ControllerNodeService,
+		modelConfigService:         services.ModelConfigService,
+		modelInfoService:           services.ModelInfoService,
+		applicationService:         services.ApplicationService,
+		statusService:              services.StatusService,
+		removalService:             services.RemovalService,
+		storageProvisioningService: services.StorageProvisioningService,
+		getCanWatch:                getCanWatch,
+		clock:                      clock,
+		logger:                     logger,
 	}, nil
 }
 
@@ -523,9 +528,77 @@ func (a *API) provisioningInfo(ctx context.Context, appTag names.ApplicationTag)
 }
 
 // FilesystemProvisioningInfo returns the filesystem info needed to provision a caas application.
+// Existing filesystems to be attached to units that the provider has yet to
+// start are returned as unit attachments keyed by storage name.
 func (a *API) FilesystemProvisioningInfo(ctx context.Context, args params.Entity) (params.CAASApplicationFilesystemProvisioningInfo, error) {
-	// TODO(storage) - implement and test
-	return params.CAASApplicationFilesystemProvisioningInfo{}, nil
+	appTag, err := names.ParseApplicationTag(args.Tag)
+	if err != nil {
+		return params.CAASApplicationFilesystemProvisioningInfo{}, errors.Trace(err)
+	}
+	appName := appTag.Id()
+
+	appID, err := a.applicationService.GetApplicationIDByName(ctx, appName)
+	if errors.Is(err, applicationerrors.ApplicationNotFound) {
+		return params.CAASApplicationFilesystemProvisioningInfo{}, errors.NotFoundf("application %s", appName)
+	} else if err != nil {
+		return params.CAASApplicationFilesystemProvisioningInfo{}, errors.Trace(err)
+	}
+
+	templates, err := a.storageProvisioningService.GetFilesystemTemplatesForApplication(ctx, appID)
+	if err != nil {
+		return params.CAASApplicationFilesystemProvisioningInfo{}, errors.Trace(err)
+	}
+	resourceTags, err := a.storageProvisioningService.GetStorageResourceTagsForApplication(ctx, appID)
+	if err != nil {
+		return params.CAASApplicationFilesystemProvisioningInfo{}, errors.Trace(err)
+	}
+
+	var result params.CAASApplicationFilesystemProvisioningInfo
+	for _, fst := range templates {
+		for i := range fst.Count {
+			mountPoint, err := storage.FilesystemMountPointK8s(
+				fst.Location, fst.MaxCount, i, fst.StorageName,
+			)
+			if err != nil {
+				return params.CAASApplicationFilesystemProvisioningInfo{}, errors.Trace(err)
+			}
+			result.Filesystems = append(result.Filesystems, params.KubernetesFilesystemParams{
+				StorageName: fst.StorageName,
+				Size:        fst.SizeMiB,
+				Provider:    fst.ProviderType,
+				Attributes: transform.Map(fst.Attributes, func(k, v string) (string, any) {
+					return k, v
+				}),
+				Tags:   resourceTags,
+				Shared: fst.Shared,
+				Attachment: &params.KubernetesFilesystemAttachmentParams{
+					MountPoint: mountPoint,
+					ReadOnly:   fst.ReadOnly,
+				},
+			})
+		}
+	}
+
+	pending, err := a.applicationService.GetPendingCAASUnitFilesystems(ctx, appID)
+	if errors.Is(err, applicationerrors.ApplicationNotFound) {
+		return params.CAASApplicationFilesystemProvisioningInfo{}, errors.NotFoundf("application %s", appName)
+	} else if err != nil {
+		return params.CAASApplicationFilesystemProvisioningInfo{}, errors.Trace(err)
+	}
+	for _, fs := range pending {
+		if result.FilesystemUnitAttachments == nil {
+			result.FilesystemUnitAttachments = make(map[string][]params.KubernetesFilesystemUnitAttachmentParams)
+		}
+		storageName := fs.StorageName.String()
+		result.FilesystemUnitAttachments[storageName] = append(
+			result.FilesystemUnitAttachments[storageName],
+			params.KubernetesFilesystemUnitAttachmentParams{
+				UnitTag:  names.NewUnitTag(fs.UnitName.String()).String(),
+				VolumeId: fs.ProviderID,
+			},
+		)
+	}
+	return result, nil
 }
 
 func (a *API) devicesParams(ctx context.Context, appName string) ([]params.KubernetesDeviceParams, error) {
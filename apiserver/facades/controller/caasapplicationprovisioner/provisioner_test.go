@@ -27,9 +27,11 @@ import (
 	unittesting "github.com/juju/juju/core/unit/testing"
 	jujuversion "github.com/juju/juju/core/version"
 	"github.com/juju/juju/core/watcher/watchertest"
+	"github.com/juju/juju/domain/application"
 	applicationcharm "github.com/juju/juju/domain/application/charm"
 	applicationerrors "github.com/juju/juju/domain/application/errors"
 	"github.com/juju/juju/domain/removal"
+	"github.com/juju/juju/domain/storageprovisioning"
 	envconfig "github.com/juju/juju/environs/config"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 	coretesting "github.com/juju/juju/internal/testing"
@@ -44,16 +46,17 @@ type CAASApplicationProvisionerSuite struct {
 	coretesting.BaseSuite
 	clock clock.Clock
 
-	watcherRegistry         *facademocks.MockWatcherRegistry
-	authorizer              *apiservertesting.FakeAuthorizer
-	api                     *caasapplicationprovisioner.API
-	applicationService      *MockApplicationService
-	controllerConfigService *MockControllerConfigService
-	controllerNodeService   *MockControllerNodeService
-	modelConfigService      *MockModelConfigService
-	modelInfoService        *MockModelInfoService
-	statusService           *MockStatusService
-	removalService          *MockRemovalService
+	watcherRegistry            *facademocks.MockWatcherRegistry
+	authorizer                 *apiservertesting.FakeAuthorizer
+	api                        *caasapplicationprovisioner.API
+	applicationService         *MockApplicationService
+	controllerConfigService    *MockControllerConfigService
+	controllerNodeService      *MockControllerNodeService
+	modelConfigService         *MockModelConfigService
+	modelInfoService           *MockModelInfoService
+	statusService              *MockStatusService
+	removalService             *MockRemovalService
+	storageProvisioningService *MockStorageProvisioningService
 }
 
 func (s *CAASApplicationProvisionerSuite) SetUpTest(c *tc.C) {
@@ -78,17 +81,19 @@ func (s *CAASApplicationProvisionerSuite) setupAPI(c *tc.C) *gomock.Controller {
 	s.modelInfoService = NewMockModelInfoService(ctrl)
 	s.removalService = NewMockRemovalService(ctrl)
 	s.statusService = NewMockStatusService(ctrl)
+	s.storageProvisioningService = NewMockStorageProvisioningService(ctrl)
 	s.watcherRegistry = facademocks.NewMockWatcherRegistry(ctrl)
 	api, err := caasapplicationprovisioner.NewCAASApplicationProvisionerAPI(
 		s.authorizer,
 		caasapplicationprovisioner.Services{
-			ApplicationService:      s.applicationService,
-			ControllerConfigService: s.controllerConfigService,
-			ControllerNodeService:   s.controllerNodeService,
-			ModelConfigService:      s.modelConfigService,
-			ModelInfoService:        s.modelInfoService,
-			StatusService:           s.statusService,
-			RemovalService:          s.removalService,
+			ApplicationService:         s.applicationService,
+			ControllerConfigService:    s.controllerConfigService,
+			ControllerNodeService:      s.controllerNodeService,
+			ModelConfigService:         s.modelConfigService,
+			ModelInfoService:           s.modelInfoService,
+			StatusService:              s.statusService,
+			RemovalService:             s.removalService,
+			StorageProvisioningService: s.storageProvisioningService,
 		},
 		s.clock,
 		loggertesting.WrapCheckLog(c),
@@ -327,3 +332,57 @@ func (s *CAASApplicationProvisionerSuite) TestDestroyUnitsNotFound(c *tc.C) {
 	c.Check(res.Results, tc.HasLen, 1)
 	c.Check(res.Results[0].Error, tc.IsNil)
 }
+
+func (s *CAASApplicationProvisionerSuite) TestFilesystemProvisioningInfo(c *tc.C) {
+	ctrl := s.setupAPI(c)
+	defer ctrl.Finish()
+
+	appID := tc.Must(c, coreapplication.NewID)
+	s.applicationService.EXPECT().GetApplicationIDByName(gomock.Any(), "gitlab").Return(appID, nil)
+	s.storageProvisioningService.EXPECT().GetFilesystemTemplatesForApplication(gomock.Any(), appID).Return([]storageprovisioning.FilesystemTemplate{{
+		StorageName:  "data",
+		Count:        1,
+		MaxCount:     1,
+		SizeMiB:      1024,
+		ProviderType: "kubernetes",
+		Location:     "/data",
+		Attributes:   map[string]string{"storage-class": "fast"},
+	}}, nil)
+	s.storageProvisioningService.EXPECT().GetStorageResourceTagsForApplication(gomock.Any(), appID).Return(map[string]string{"foo": "bar"}, nil)
+	s.applicationService.EXPECT().GetPendingCAASUnitFilesystems(gomock.Any(), appID).Return([]application.PendingCAASUnitFilesystem{{
+		UnitName:    "gitlab/2",
+		StorageName: "data",
+		ProviderID:  "pvc-uid",
+	}}, nil)
+
+	result, err := s.api.FilesystemProvisioningInfo(c.Context(), params.Entity{Tag: "application-gitlab"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, params.CAASApplicationFilesystemProvisioningInfo{
+		Filesystems: []params.KubernetesFilesystemParams{{
+			StorageName: "data",
+			Size:        1024,
+			Provider:    "kubernetes",
+			Attributes:  map[string]any{"storage-class": "fast"},
+			Tags:        map[string]string{"foo": "bar"},
+			Attachment: &params.KubernetesFilesystemAttachmentParams{
+				MountPoint: "/data",
+			},
+		}},
+		FilesystemUnitAttachments: map[string][]params.KubernetesFilesystemUnitAttachmentParams{
+			"data": {{
+				UnitTag:  "unit-gitlab-2",
+				VolumeId: "pvc-uid",
+			}},
+		},
+	})
+}
+
+func (s *CAASApplicationProvisionerSuite) TestFilesystemProvisioningInfoApplicationNotFound(c *tc.C) {
+	ctrl := s.setupAPI(c)
+	defer ctrl.Finish()
+
+	s.applicationService.EXPECT().GetApplicationIDByName(gomock.Any(), "gitlab").Return("", applicationerrors.ApplicationNotFound)
+
+	_, err := s.api.FilesystemProvisioningInfo(c.Context(), params.Entity{Tag: "application-gitlab"})
+	c.Assert(err, tc.ErrorMatches, "application gitlab not found")
+}
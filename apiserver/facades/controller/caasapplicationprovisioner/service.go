@@ -17,22 +17,25 @@ import (
 	"github.com/juju/juju/core/status"
 	"github.com/juju/juju/core/unit"
 	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/domain/application"
 	applicationcharm "github.com/juju/juju/domain/application/charm"
 	"github.com/juju/juju/domain/application/service"
 	"github.com/juju/juju/domain/removal"
+	"github.com/juju/juju/domain/storageprovisioning"
 	"github.com/juju/juju/environs/config"
 	internalcharm "github.com/juju/juju/internal/charm"
 	"github.com/juju/juju/internal/charm/resource"
 )
 
 type Services struct {
-	ApplicationService      ApplicationService
-	ControllerConfigService ControllerConfigService
-	ControllerNodeService   ControllerNodeService
-	ModelConfigService      ModelConfigService
-	ModelInfoService        ModelInfoService
-	StatusService           StatusService
-	RemovalService          RemovalService
+	ApplicationService         ApplicationService
+	ControllerConfigService    ControllerConfigService
+	ControllerNodeService      ControllerNodeService
+	ModelConfigService         ModelConfigService
+	ModelInfoService           ModelInfoService
+	StatusService              StatusService
+	RemovalService             RemovalService
+	StorageProvisioningService StorageProvisioningService
 }
 
 // ControllerConfigService provides the controller configuration.
@@ -140,6 +143,22 @@ type ApplicationService interface {
 
 	// GetApplicationLifeByName looks up the life of the specified application.
 	GetApplicationLifeByName(ctx context.Context, appName string) (life.Value, error)
+
+	// GetPendingCAASUnitFilesystems returns the provisioned filesystems owned
+	// by the units of the application that the provider has yet to start.
+	GetPendingCAASUnitFilesystems(ctx context.Context, appID coreapplication.ID) ([]application.PendingCAASUnitFilesystem, error)
+}
+
+// StorageProvisioningService provides the filesystem templates of an
+// application.
+type StorageProvisioningService interface {
+	// GetFilesystemTemplatesForApplication returns all the filesystem
+	// templates for a given application.
+	GetFilesystemTemplatesForApplication(ctx context.Context, appID coreapplication.ID) ([]storageprovisioning.FilesystemTemplate, error)
+
+	// GetStorageResourceTagsForApplication returns the storage resource tags
+	// for the given application.
+	GetStorageResourceTagsForApplication(ctx context.Context, appID coreapplication.ID) (map[string]string, error)
 }
 
 // RemovalService defines operations for removing juju entities.
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/facades/controller/caasapplicationprovisioner (interfaces: ControllerConfigService,ModelConfigService,ModelInfoService,ApplicationService,StatusService,ControllerNodeService,RemovalService)
+// Source: github.com/juju/juju/apiserver/facades/controller/caasapplicationprovisioner (interfaces: ControllerConfigService,ModelConfigService,ModelInfoService,ApplicationService,StatusService,ControllerNodeService,RemovalService,StorageProvisioningService)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package caasapplicationprovisioner_test -destination service_mock_test.go github.com/juju/juju/apiserver/facades/controller/caasapplicationprovisioner ControllerConfigService,ModelConfigService,ModelInfoService,ApplicationService,StatusService,ControllerNodeService,RemovalService
+//	mockgen -typed -package caasapplicationprovisioner_test -destination service_mock_test.go github.com/juju/juju/apiserver/facades/controller/caasapplicationprovisioner ControllerConfigService,ModelConfigService,ModelInfoService,ApplicationService,StatusService,ControllerNodeService,RemovalService,StorageProvisioningService
 //
 
 // Package caasapplicationprovisioner_test is a generated GoMock package.
@@ -24,9 +24,11 @@ import (
 	status "github.com/juju/juju/core/status"
 	unit "github.com/juju/juju/core/unit"
 	watcher "github.com/juju/juju/core/watcher"
+	application0 "github.com/juju/juju/domain/application"
 	charm0 "github.com/juju/juju/domain/application/charm"
 	service "github.com/juju/juju/domain/application/service"
 	removal "github.com/juju/juju/domain/removal"
+	storageprovisioning "github.com/juju/juju/domain/storageprovisioning"
 	config "github.com/juju/juju/environs/config"
 	charm1 "github.com/juju/juju/internal/charm"
 	resource "github.com/juju/juju/internal/charm/resource"
@@ -788,6 +790,45 @@ func (c *MockApplicationServiceGetDeviceConstraintsCall) DoAndReturn(f func(cont
 	return c
 }
 
+// GetPendingCAASUnitFilesystems mocks base method.
+func (m *MockApplicationService) GetPendingCAASUnitFilesystems(arg0 context.Context, arg1 application.ID) ([]application0.PendingCAASUnitFilesystem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingCAASUnitFilesystems", arg0, arg1)
+	ret0, _ := ret[0].([]application0.PendingCAASUnitFilesystem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingCAASUnitFilesystems indicates an expected call of GetPendingCAASUnitFilesystems.
+func (mr *MockApplicationServiceMockRecorder) GetPendingCAASUnitFilesystems(arg0, arg1 any) *MockApplicationServiceGetPendingCAASUnitFilesystemsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingCAASUnitFilesystems", reflect.TypeOf((*MockApplicationService)(nil).GetPendingCAASUnitFilesystems), arg0, arg1)
+	return &MockApplicationServiceGetPendingCAASUnitFilesystemsCall{Call: call}
+}
+
+// MockApplicationServiceGetPendingCAASUnitFilesystemsCall wrap *gomock.Call
+type MockApplicationServiceGetPendingCAASUnitFilesystemsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceGetPendingCAASUnitFilesystemsCall) Return(arg0 []application0.PendingCAASUnitFilesystem, arg1 error) *MockApplicationServiceGetPendingCAASUnitFilesystemsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceGetPendingCAASUnitFilesystemsCall) Do(f func(context.Context, application.ID) ([]application0.PendingCAASUnitFilesystem, error)) *MockApplicationServiceGetPendingCAASUnitFilesystemsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceGetPendingCAASUnitFilesystemsCall) DoAndReturn(f func(context.Context, application.ID) ([]application0.PendingCAASUnitFilesystem, error)) *MockApplicationServiceGetPendingCAASUnitFilesystemsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetUnitNamesForApplication mocks base method.
 func (m *MockApplicationService) GetUnitNamesForApplication(arg0 context.Context, arg1 string) ([]unit.Name, error) {
 	m.ctrl.T.Helper()
@@ -1282,3 +1323,104 @@ func (c *MockRemovalServiceRemoveUnitCall) DoAndReturn(f func(context.Context, u
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockStorageProvisioningService is a mock of StorageProvisioningService interface.
+type MockStorageProvisioningService struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageProvisioningServiceMockRecorder
+}
+
+// MockStorageProvisioningServiceMockRecorder is the mock recorder for MockStorageProvisioningService.
+type MockStorageProvisioningServiceMockRecorder struct {
+	mock *MockStorageProvisioningService
+}
+
+// NewMockStorageProvisioningService creates a new mock instance.
+func NewMockStorageProvisioningService(ctrl *gomock.Controller) *MockStorageProvisioningService {
+	mock := &MockStorageProvisioningService{ctrl: ctrl}
+	mock.recorder = &MockStorageProvisioningServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorageProvisioningService) EXPECT() *MockStorageProvisioningServiceMockRecorder {
+	return m.recorder
+}
+
+// GetFilesystemTemplatesForApplication mocks base method.
+func (m *MockStorageProvisioningService) GetFilesystemTemplatesForApplication(arg0 context.Context, arg1 application.ID) ([]storageprovisioning.FilesystemTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilesystemTemplatesForApplication", arg0, arg1)
+	ret0, _ := ret[0].([]storageprovisioning.FilesystemTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilesystemTemplatesForApplication indicates an expected call of GetFilesystemTemplatesForApplication.
+func (mr *MockStorageProvisioningServiceMockRecorder) GetFilesystemTemplatesForApplication(arg0, arg1 any) *MockStorageProvisioningServiceGetFilesystemTemplatesForApplicationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilesystemTemplatesForApplication", reflect.TypeOf((*MockStorageProvisioningService)(nil).GetFilesystemTemplatesForApplication), arg0, arg1)
+	return &MockStorageProvisioningServiceGetFilesystemTemplatesForApplicationCall{Call: call}
+}
+
+// MockStorageProvisioningServiceGetFilesystemTemplatesForApplicationCall wrap *gomock.Call
+type MockStorageProvisioningServiceGetFilesystemTemplatesForApplicationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageProvisioningServiceGetFilesystemTemplatesForApplicationCall) Return(arg0 []storageprovisioning.FilesystemTemplate, arg1 error) *MockStorageProvisioningServiceGetFilesystemTemplatesForApplicationCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageProvisioningServiceGetFilesystemTemplatesForApplicationCall) Do(f func(context.Context, application.ID) ([]storageprovisioning.FilesystemTemplate, error)) *MockStorageProvisioningServiceGetFilesystemTemplatesForApplicationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageProvisioningServiceGetFilesystemTemplatesForApplicationCall) DoAndReturn(f func(context.Context, application.ID) ([]storageprovisioning.FilesystemTemplate, error)) *MockStorageProvisioningServiceGetFilesystemTemplatesForApplicationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetStorageResourceTagsForApplication mocks base method.
+func (m *MockStorageProvisioningService) GetStorageResourceTagsForApplication(arg0 context.Context, arg1 application.ID) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStorageResourceTagsForApplication", arg0, arg1)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStorageResourceTagsForApplication indicates an expected call of GetStorageResourceTagsForApplication.
+func (mr *MockStorageProvisioningServiceMockRecorder) GetStorageResourceTagsForApplication(arg0, arg1 any) *MockStorageProvisioningServiceGetStorageResourceTagsForApplicationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageResourceTagsForApplication", reflect.TypeOf((*MockStorageProvisioningService)(nil).GetStorageResourceTagsForApplication), arg0, arg1)
+	return &MockStorageProvisioningServiceGetStorageResourceTagsForApplicationCall{Call: call}
+}
+
+// MockStorageProvisioningServiceGetStorageResourceTagsForApplicationCall wrap *gomock.Call
+type MockStorageProvisioningServiceGetStorageResourceTagsForApplicationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStorageProvisioningServiceGetStorageResourceTagsForApplicationCall) Return(arg0 map[string]string, arg1 error) *MockStorageProvisioningServiceGetStorageResourceTagsForApplicationCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStorageProvisioningServiceGetStorageResourceTagsForApplicationCall) Do(f func(context.Context, application.ID) (map[string]string, error)) *MockStorageProvisioningServiceGetStorageResourceTagsForApplicationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStorageProvisioningServiceGetStorageResourceTagsForApplicationCall) DoAndReturn(f func(context.Context, application.ID) (map[string]string, error)) *MockStorageProvisioningServiceGetStorageResourceTagsForApplicationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
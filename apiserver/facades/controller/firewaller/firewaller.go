@@ -6,6 +6,7 @@ package firewaller
 import (
 	"context"
 	"strconv"
+	"time"
 
 	"github.com/juju/collections/set"
 	jujuerrors "github.com/juju/errors"
@@ -22,10 +23,14 @@ import (
 	corelogger "github.com/juju/juju/core/logger"
 	"github.com/juju/juju/core/machine"
 	"github.com/juju/juju/core/network"
+	corerelation "github.com/juju/juju/core/relation"
+	"github.com/juju/juju/core/status"
 	coreunit "github.com/juju/juju/core/unit"
 	"github.com/juju/juju/core/watcher"
 	applicationerrors "github.com/juju/juju/domain/application/errors"
+	crossmodelrelationerrors "github.com/juju/juju/domain/crossmodelrelation/errors"
 	machineerrors "github.com/juju/juju/domain/machine/errors"
+	relationerrors "github.com/juju/juju/domain/relation/errors"
 	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/internal/errors"
 	"github.com/juju/juju/rpc/params"
@@ -63,9 +68,12 @@ type FirewallerAPI struct {
 	accessUnitApplicationOrMachineOrRelation common.GetAuthFunc
 	logger                                   corelogger.Logger
 
-	controllerConfigService ControllerConfigService
-	modelConfigService      ModelConfigService
-	modelInfoService        ModelInfoService
+	controllerConfigService   ControllerConfigService
+	modelConfigService        ModelConfigService
+	modelInfoService          ModelInfoService
+	crossModelRelationService CrossModelRelationService
+	relationService           RelationService
+	statusService             StatusService
 }
 
 // NewStateFirewallerAPI creates a new server-side FirewallerAPIV7 facade.
@@ -79,6 +87,9 @@ func NewStateFirewallerAPI(
 	applicationService ApplicationService,
 	machineService MachineService,
 	modelInfoService ModelInfoService,
+	crossModelRelationService CrossModelRelationService,
+	relationService RelationService,
+	statusService StatusService,
 	logger corelogger.Logger,
 ) (*FirewallerAPI, error) {
 	if !authorizer.AuthController() {
@@ -133,6 +144,9 @@ func NewStateFirewallerAPI(
 		applicationService:                       applicationService,
 		machineService:                           machineService,
 		modelInfoService:                         modelInfoService,
+		crossModelRelationService:                crossModelRelationService,
+		relationService:                          relationService,
+		statusService:                            statusService,
 		logger:                                   logger,
 	}, nil
 }
@@ -237,14 +251,69 @@ func (f *FirewallerAPI) WatchModelFirewallRules(ctx context.Context) (params.Not
 // WatchEgressAddressesForRelations creates a watcher that notifies when addresses, from which
 // connections will originate for the relation, change.
 // Each event contains the entire set of addresses which are required for ingress for the relation.
+// A NotSupported error is returned for relations that have no egress networks
+// recorded, indicating that no egress is required.
 func (f *FirewallerAPI) WatchEgressAddressesForRelations(ctx context.Context, relations params.Entities) (params.StringsWatchResults, error) {
-	return params.StringsWatchResults{}, nil
+	results := params.StringsWatchResults{
+		Results: make([]params.StringsWatchResult, len(relations.Entities)),
+	}
+	for i, entity := range relations.Entities {
+		watcherID, changes, err := f.watchOneEgressAddressesForRelation(ctx, entity.Tag)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i].StringsWatcherId = watcherID
+		results.Results[i].Changes = changes
+	}
+	return results, nil
+}
+
+func (f *FirewallerAPI) watchOneEgressAddressesForRelation(ctx context.Context, tag string) (string, []string, error) {
+	relUUID, err := f.getRelationUUID(ctx, tag)
+	if err != nil {
+		return "", nil, errors.Capture(err)
+	}
+
+	// The egress networks fall back to the model's egress-subnets and then to
+	// the addresses of the units in the relation when none were specified
+	// for the relation itself, so there is always something to watch.
+	w, err := f.crossModelRelationService.WatchRelationNetworkEgress(ctx, relUUID)
+	if err != nil {
+		return "", nil, errors.Capture(err)
+	}
+	return internal.EnsureRegisterWatcher[[]string](ctx, f.watcherRegistry, w)
 }
 
 // WatchIngressAddressesForRelations creates a watcher that returns the ingress networks
 // that have been recorded against the specified relations.
 func (f *FirewallerAPI) WatchIngressAddressesForRelations(ctx context.Context, relations params.Entities) (params.StringsWatchResults, error) {
-	return params.StringsWatchResults{}, nil
+	results := params.StringsWatchResults{
+		Results: make([]params.StringsWatchResult, len(relations.Entities)),
+	}
+	for i, entity := range relations.Entities {
+		watcherID, changes, err := f.watchOneIngressAddressesForRelation(ctx, entity.Tag)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		results.Results[i].StringsWatcherId = watcherID
+		results.Results[i].Changes = changes
+	}
+	return results, nil
+}
+
+func (f *FirewallerAPI) watchOneIngressAddressesForRelation(ctx context.Context, tag string) (string, []string, error) {
+	relUUID, err := f.getRelationUUID(ctx, tag)
+	if err != nil {
+		return "", nil, errors.Capture(err)
+	}
+
+	w, err := f.crossModelRelationService.WatchRelationNetworkIngress(ctx, relUUID)
+	if err != nil {
+		return "", nil, errors.Capture(err)
+	}
+	return internal.EnsureRegisterWatcher[[]string](ctx, f.watcherRegistry, w)
 }
 
 // MacaroonForRelations returns the macaroon for the specified relations.
@@ -257,7 +326,7 @@ func (f *FirewallerAPI) MacaroonForRelations(ctx context.Context, args params.En
 			result.Results[i].Error = apiservererrors.ServerError(err)
 			continue
 		}
-		mac, err := f.getMacaroon(relationTag)
+		mac, err := f.getMacaroon(ctx, relationTag)
 		if err != nil {
 			result.Results[i].Error = apiservererrors.ServerError(err)
 			continue
@@ -438,12 +507,49 @@ func setEquals(a, b set.Strings) bool {
 	return a.Intersection(b).Size() == a.Size()
 }
 
-func (f *FirewallerAPI) getMacaroon(tag names.RelationTag) (*macaroon.Macaroon, error) {
-	return nil, jujuerrors.NotImplementedf("cross model relations are disabled until " +
-		"backend functionality is moved to domain")
+// getRelationUUID returns the UUID of the relation identified by the input
+// relation tag string.
+func (f *FirewallerAPI) getRelationUUID(ctx context.Context, tag string) (corerelation.UUID, error) {
+	relationTag, err := names.ParseRelationTag(tag)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	key, err := corerelation.NewKeyFromString(relationTag.Id())
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	relUUID, err := f.relationService.GetRelationUUIDByKey(ctx, key)
+	if errors.Is(err, relationerrors.RelationNotFound) {
+		return "", jujuerrors.NotFoundf("relation %q", relationTag.Id())
+	} else if err != nil {
+		return "", errors.Capture(err)
+	}
+	return relUUID, nil
+}
+
+func (f *FirewallerAPI) getMacaroon(ctx context.Context, tag names.RelationTag) (*macaroon.Macaroon, error) {
+	relUUID, err := f.getRelationUUID(ctx, tag.String())
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	mac, err := f.crossModelRelationService.GetMacaroonForRelation(ctx, relUUID)
+	if errors.Is(err, crossmodelrelationerrors.RemoteApplicationNotFound) {
+		return nil, jujuerrors.NotFoundf("macaroon for relation %q", tag.Id())
+	} else if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return mac, nil
 }
 
 func (f *FirewallerAPI) oneSetRelationStatus(ctx context.Context, tag names.RelationTag, s string, info string) error {
-	return jujuerrors.NotImplementedf("cross model relations are disabled until " +
-		"backend functionality is moved to domain")
+	relUUID, err := f.getRelationUUID(ctx, tag.String())
+	if err != nil {
+		return errors.Capture(err)
+	}
+	now := time.Now()
+	return f.statusService.SetRemoteRelationStatus(ctx, relUUID, status.StatusInfo{
+		Status:  status.Status(s),
+		Message: info,
+		Since:   &now,
+	})
 }
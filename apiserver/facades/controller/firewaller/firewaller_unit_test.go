@@ -4,43 +4,30 @@
 package firewaller_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/juju/collections/set"
 	"github.com/juju/names/v6"
 	"github.com/juju/tc"
 	"go.uber.org/mock/gomock"
+	"gopkg.in/macaroon.v2"
 
 	facademocks "github.com/juju/juju/apiserver/facade/mocks"
 	"github.com/juju/juju/apiserver/facades/controller/firewaller"
 	apiservertesting "github.com/juju/juju/apiserver/testing"
 	"github.com/juju/juju/controller"
 	"github.com/juju/juju/core/network"
+	corerelation "github.com/juju/juju/core/relation"
+	"github.com/juju/juju/core/status"
 	"github.com/juju/juju/core/watcher/watchertest"
+	relationerrors "github.com/juju/juju/domain/relation/errors"
 	"github.com/juju/juju/environs/config"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 	coretesting "github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/rpc/params"
 )
 
-func TestRemoteFirewallerSuite(t *testing.T) {
-	tc.Run(t, &RemoteFirewallerSuite{})
-}
-
-type RemoteFirewallerSuite struct {
-}
-
-func (s *RemoteFirewallerSuite) TestStub(c *tc.C) {
-	c.Skip(`
-This suite is missing tests for the following scenarios:
-- Watch for changes to ingress addresses of remote peer in a cross-model relation.
-- Set the status of a cross-model relation, for instance to suspend a relation 
-  with an informational message.
-- Generate an authentication macaroon for a cross-model relation to secure 
-  communication between models.
-`)
-}
-
 func TestFirewallerSuite(t *testing.T) {
 	tc.Run(t, &FirewallerSuite{})
 }
@@ -60,6 +47,10 @@ type FirewallerSuite struct {
 	applicationService      *MockApplicationService
 	machineService          *MockMachineService
 	modelInfoService        *MockModelInfoService
+
+	crossModelRelationService *MockCrossModelRelationService
+	relationService           *MockRelationService
+	statusService             *MockStatusService
 }
 
 func (s *FirewallerSuite) SetUpTest(c *tc.C) {
@@ -83,6 +74,9 @@ func (s *FirewallerSuite) setupMocks(c *tc.C) *gomock.Controller {
 	s.applicationService = NewMockApplicationService(ctrl)
 	s.machineService = NewMockMachineService(ctrl)
 	s.modelInfoService = NewMockModelInfoService(ctrl)
+	s.crossModelRelationService = NewMockCrossModelRelationService(ctrl)
+	s.relationService = NewMockRelationService(ctrl)
+	s.statusService = NewMockStatusService(ctrl)
 
 	return ctrl
 }
@@ -99,6 +93,9 @@ func (s *FirewallerSuite) setupAPI(c *tc.C) {
 		s.applicationService,
 		s.machineService,
 		s.modelInfoService,
+		s.crossModelRelationService,
+		s.relationService,
+		s.statusService,
 		loggertesting.WrapCheckLog(c),
 	)
 	c.Assert(err, tc.ErrorIsNil)
@@ -251,3 +248,147 @@ func (s *FirewallerSuite) TestWatchSubnets(c *tc.C) {
 	c.Assert(got.StringsWatcherId, tc.Equals, want.StringsWatcherId)
 	c.Assert(got.Changes, tc.SameContents, want.Changes)
 }
+
+func (s *FirewallerSuite) TestWatchEgressAddressesForRelations(c *tc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+	s.setupAPI(c)
+
+	relTag, relUUID := s.expectRelationUUID(c)
+
+	ch := make(chan []string, 1)
+	ch <- []string{"10.0.0.0/24"}
+	w := watchertest.NewMockStringsWatcher(ch)
+	s.crossModelRelationService.EXPECT().WatchRelationNetworkEgress(gomock.Any(), relUUID).Return(w, nil)
+	s.watcherRegistry.EXPECT().Register(gomock.Any(), gomock.Any()).Return("1", nil)
+
+	results, err := s.api.WatchEgressAddressesForRelations(c.Context(), params.Entities{
+		Entities: []params.Entity{{Tag: relTag.String()}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 1)
+	c.Assert(results.Results[0].Error, tc.IsNil)
+	c.Check(results.Results[0].StringsWatcherId, tc.Equals, "1")
+	c.Check(results.Results[0].Changes, tc.DeepEquals, []string{"10.0.0.0/24"})
+}
+
+func (s *FirewallerSuite) TestWatchEgressAddressesForRelationsNoEgress(c *tc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+	s.setupAPI(c)
+
+	relTag, relUUID := s.expectRelationUUID(c)
+
+	// A watcher is returned even when there is no egress yet, so that
+	// egress determined later is picked up.
+	ch := make(chan []string, 1)
+	ch <- []string{}
+	w := watchertest.NewMockStringsWatcher(ch)
+	s.crossModelRelationService.EXPECT().WatchRelationNetworkEgress(gomock.Any(), relUUID).Return(w, nil)
+	s.watcherRegistry.EXPECT().Register(gomock.Any(), gomock.Any()).Return("1", nil)
+
+	results, err := s.api.WatchEgressAddressesForRelations(c.Context(), params.Entities{
+		Entities: []params.Entity{{Tag: relTag.String()}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 1)
+	c.Assert(results.Results[0].Error, tc.IsNil)
+	c.Check(results.Results[0].StringsWatcherId, tc.Equals, "1")
+	c.Check(results.Results[0].Changes, tc.HasLen, 0)
+}
+
+func (s *FirewallerSuite) TestWatchIngressAddressesForRelations(c *tc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+	s.setupAPI(c)
+
+	relTag, relUUID := s.expectRelationUUID(c)
+
+	ch := make(chan []string, 1)
+	ch <- []string{"192.168.1.0/24"}
+	w := watchertest.NewMockStringsWatcher(ch)
+	s.crossModelRelationService.EXPECT().WatchRelationNetworkIngress(gomock.Any(), relUUID).Return(w, nil)
+	s.watcherRegistry.EXPECT().Register(gomock.Any(), gomock.Any()).Return("1", nil)
+
+	results, err := s.api.WatchIngressAddressesForRelations(c.Context(), params.Entities{
+		Entities: []params.Entity{{Tag: relTag.String()}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 1)
+	c.Assert(results.Results[0].Error, tc.IsNil)
+	c.Check(results.Results[0].StringsWatcherId, tc.Equals, "1")
+	c.Check(results.Results[0].Changes, tc.DeepEquals, []string{"192.168.1.0/24"})
+}
+
+func (s *FirewallerSuite) TestWatchIngressAddressesForRelationsNotFound(c *tc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+	s.setupAPI(c)
+
+	relTag := names.NewRelationTag("remote-app:db mysql:server")
+	s.relationService.EXPECT().GetRelationUUIDByKey(gomock.Any(), gomock.Any()).Return("", relationerrors.RelationNotFound)
+
+	results, err := s.api.WatchIngressAddressesForRelations(c.Context(), params.Entities{
+		Entities: []params.Entity{{Tag: relTag.String()}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 1)
+	c.Check(results.Results[0].Error, tc.Satisfies, params.IsCodeNotFound)
+}
+
+func (s *FirewallerSuite) TestMacaroonForRelations(c *tc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+	s.setupAPI(c)
+
+	relTag, relUUID := s.expectRelationUUID(c)
+
+	mac, err := macaroon.New(nil, []byte("id"), "", macaroon.LatestVersion)
+	c.Assert(err, tc.ErrorIsNil)
+	s.crossModelRelationService.EXPECT().GetMacaroonForRelation(gomock.Any(), relUUID).Return(mac, nil)
+
+	results, err := s.api.MacaroonForRelations(c.Context(), params.Entities{
+		Entities: []params.Entity{{Tag: relTag.String()}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 1)
+	c.Assert(results.Results[0].Error, tc.IsNil)
+	c.Check(results.Results[0].Result, tc.Equals, mac)
+}
+
+func (s *FirewallerSuite) TestSetRelationsStatus(c *tc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+	s.setupAPI(c)
+
+	relTag, relUUID := s.expectRelationUUID(c)
+
+	s.statusService.EXPECT().SetRemoteRelationStatus(gomock.Any(), relUUID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ corerelation.UUID, info status.StatusInfo) error {
+			c.Check(info.Status, tc.Equals, status.Suspended)
+			c.Check(info.Message, tc.Equals, "a message")
+			c.Check(info.Since, tc.NotNil)
+			return nil
+		})
+
+	results, err := s.api.SetRelationsStatus(c.Context(), params.SetStatus{
+		Entities: []params.EntityStatusArgs{{
+			Tag:    relTag.String(),
+			Status: status.Suspended.String(),
+			Info:   "a message",
+		}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results.Results, tc.HasLen, 1)
+	c.Check(results.Results[0].Error, tc.IsNil)
+}
+
+func (s *FirewallerSuite) expectRelationUUID(c *tc.C) (names.RelationTag, corerelation.UUID) {
+	relTag := names.NewRelationTag("remote-app:db mysql:server")
+	key, err := corerelation.NewKeyFromString(relTag.Id())
+	c.Assert(err, tc.ErrorIsNil)
+
+	relUUID := tc.Must(c, corerelation.NewUUID)
+	s.relationService.EXPECT().GetRelationUUIDByKey(gomock.Any(), key).Return(relUUID, nil)
+	return relTag, relUUID
+}
@@ -7,11 +7,14 @@ import (
 	"context"
 
 	"github.com/juju/collections/set"
+	"gopkg.in/macaroon.v2"
 
 	"github.com/juju/juju/core/instance"
 	"github.com/juju/juju/core/life"
 	"github.com/juju/juju/core/machine"
 	"github.com/juju/juju/core/network"
+	corerelation "github.com/juju/juju/core/relation"
+	"github.com/juju/juju/core/status"
 	"github.com/juju/juju/core/unit"
 	"github.com/juju/juju/core/watcher"
 	"github.com/juju/juju/domain/application"
@@ -114,3 +117,37 @@ type ModelInfoService interface {
 	// - [modelerrors.NotFound] when the model does not exist.
 	IsControllerModel(ctx context.Context) (bool, error)
 }
+
+// CrossModelRelationService provides access to cross model relations.
+type CrossModelRelationService interface {
+	// GetMacaroonForRelation returns the macaroon used to authenticate with
+	// the offering model, for the relation with the input UUID.
+	GetMacaroonForRelation(ctx context.Context, relationUUID corerelation.UUID) (*macaroon.Macaroon, error)
+
+	// WatchRelationNetworkEgress returns a watcher that emits the full set of
+	// egress networks of the relation with the input UUID, whenever they
+	// change. These are the networks recorded against the relation,
+	// otherwise the model's egress-subnets, otherwise the addresses of the
+	// units in the relation.
+	WatchRelationNetworkEgress(ctx context.Context, relationUUID corerelation.UUID) (watcher.StringsWatcher, error)
+
+	// WatchRelationNetworkIngress returns a watcher that emits the full set of
+	// ingress networks of the relation with the input UUID, whenever they
+	// change.
+	WatchRelationNetworkIngress(ctx context.Context, relationUUID corerelation.UUID) (watcher.StringsWatcher, error)
+}
+
+// RelationService provides access to relations.
+type RelationService interface {
+	// GetRelationUUIDByKey returns a relation UUID for the given Key.
+	// The following errors may be returned:
+	// - [relationerrors.RelationNotFound] if the relation does not exist.
+	GetRelationUUIDByKey(ctx context.Context, relationKey corerelation.Key) (corerelation.UUID, error)
+}
+
+// StatusService provides access to the status service.
+type StatusService interface {
+	// SetRemoteRelationStatus sets the status of the cross model relation
+	// with the input UUID.
+	SetRemoteRelationStatus(ctx context.Context, relationUUID corerelation.UUID, info status.StatusInfo) error
+}
@@ -38,6 +38,9 @@ func newFirewallerAPIV7(ctx facade.ModelContext) (*FirewallerAPI, error) {
 		domainServices.Application(),
 		domainServices.Machine(),
 		domainServices.ModelInfo(),
+		domainServices.CrossModelRelation(),
+		domainServices.Relation(),
+		domainServices.Status(),
 		ctx.Logger().Child("firewaller"),
 	)
 }
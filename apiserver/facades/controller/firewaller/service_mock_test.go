@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/facades/controller/firewaller (interfaces: ControllerConfigService,ModelConfigService,NetworkService,ApplicationService,MachineService,ModelInfoService)
+// Source: github.com/juju/juju/apiserver/facades/controller/firewaller (interfaces: ControllerConfigService,ModelConfigService,NetworkService,ApplicationService,MachineService,ModelInfoService,CrossModelRelationService,RelationService,StatusService)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package firewaller_test -destination service_mock_test.go github.com/juju/juju/apiserver/facades/controller/firewaller ControllerConfigService,ModelConfigService,NetworkService,ApplicationService,MachineService,ModelInfoService
+//	mockgen -typed -package firewaller_test -destination service_mock_test.go github.com/juju/juju/apiserver/facades/controller/firewaller ControllerConfigService,ModelConfigService,NetworkService,ApplicationService,MachineService,ModelInfoService,CrossModelRelationService,RelationService,StatusService
 //
 
 // Package firewaller_test is a generated GoMock package.
@@ -19,11 +19,14 @@ import (
 	life "github.com/juju/juju/core/life"
 	machine "github.com/juju/juju/core/machine"
 	network "github.com/juju/juju/core/network"
+	relation "github.com/juju/juju/core/relation"
+	status "github.com/juju/juju/core/status"
 	unit "github.com/juju/juju/core/unit"
 	watcher "github.com/juju/juju/core/watcher"
 	application "github.com/juju/juju/domain/application"
 	config "github.com/juju/juju/environs/config"
 	gomock "go.uber.org/mock/gomock"
+	macaroon "gopkg.in/macaroon.v2"
 )
 
 // MockControllerConfigService is a mock of ControllerConfigService interface.
@@ -944,3 +947,266 @@ func (c *MockModelInfoServiceIsControllerModelCall) DoAndReturn(f func(context.C
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockCrossModelRelationService is a mock of CrossModelRelationService interface.
+type MockCrossModelRelationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCrossModelRelationServiceMockRecorder
+}
+
+// MockCrossModelRelationServiceMockRecorder is the mock recorder for MockCrossModelRelationService.
+type MockCrossModelRelationServiceMockRecorder struct {
+	mock *MockCrossModelRelationService
+}
+
+// NewMockCrossModelRelationService creates a new mock instance.
+func NewMockCrossModelRelationService(ctrl *gomock.Controller) *MockCrossModelRelationService {
+	mock := &MockCrossModelRelationService{ctrl: ctrl}
+	mock.recorder = &MockCrossModelRelationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCrossModelRelationService) EXPECT() *MockCrossModelRelationServiceMockRecorder {
+	return m.recorder
+}
+
+// GetMacaroonForRelation mocks base method.
+func (m *MockCrossModelRelationService) GetMacaroonForRelation(arg0 context.Context, arg1 relation.UUID) (*macaroon.Macaroon, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMacaroonForRelation", arg0, arg1)
+	ret0, _ := ret[0].(*macaroon.Macaroon)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMacaroonForRelation indicates an expected call of GetMacaroonForRelation.
+func (mr *MockCrossModelRelationServiceMockRecorder) GetMacaroonForRelation(arg0, arg1 any) *MockCrossModelRelationServiceGetMacaroonForRelationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacaroonForRelation", reflect.TypeOf((*MockCrossModelRelationService)(nil).GetMacaroonForRelation), arg0, arg1)
+	return &MockCrossModelRelationServiceGetMacaroonForRelationCall{Call: call}
+}
+
+// MockCrossModelRelationServiceGetMacaroonForRelationCall wrap *gomock.Call
+type MockCrossModelRelationServiceGetMacaroonForRelationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCrossModelRelationServiceGetMacaroonForRelationCall) Return(arg0 *macaroon.Macaroon, arg1 error) *MockCrossModelRelationServiceGetMacaroonForRelationCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCrossModelRelationServiceGetMacaroonForRelationCall) Do(f func(context.Context, relation.UUID) (*macaroon.Macaroon, error)) *MockCrossModelRelationServiceGetMacaroonForRelationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCrossModelRelationServiceGetMacaroonForRelationCall) DoAndReturn(f func(context.Context, relation.UUID) (*macaroon.Macaroon, error)) *MockCrossModelRelationServiceGetMacaroonForRelationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// WatchRelationNetworkEgress mocks base method.
+func (m *MockCrossModelRelationService) WatchRelationNetworkEgress(arg0 context.Context, arg1 relation.UUID) (watcher.Watcher[[]string], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchRelationNetworkEgress", arg0, arg1)
+	ret0, _ := ret[0].(watcher.Watcher[[]string])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchRelationNetworkEgress indicates an expected call of WatchRelationNetworkEgress.
+func (mr *MockCrossModelRelationServiceMockRecorder) WatchRelationNetworkEgress(arg0, arg1 any) *MockCrossModelRelationServiceWatchRelationNetworkEgressCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchRelationNetworkEgress", reflect.TypeOf((*MockCrossModelRelationService)(nil).WatchRelationNetworkEgress), arg0, arg1)
+	return &MockCrossModelRelationServiceWatchRelationNetworkEgressCall{Call: call}
+}
+
+// MockCrossModelRelationServiceWatchRelationNetworkEgressCall wrap *gomock.Call
+type MockCrossModelRelationServiceWatchRelationNetworkEgressCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCrossModelRelationServiceWatchRelationNetworkEgressCall) Return(arg0 watcher.Watcher[[]string], arg1 error) *MockCrossModelRelationServiceWatchRelationNetworkEgressCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCrossModelRelationServiceWatchRelationNetworkEgressCall) Do(f func(context.Context, relation.UUID) (watcher.Watcher[[]string], error)) *MockCrossModelRelationServiceWatchRelationNetworkEgressCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCrossModelRelationServiceWatchRelationNetworkEgressCall) DoAndReturn(f func(context.Context, relation.UUID) (watcher.Watcher[[]string], error)) *MockCrossModelRelationServiceWatchRelationNetworkEgressCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// WatchRelationNetworkIngress mocks base method.
+func (m *MockCrossModelRelationService) WatchRelationNetworkIngress(arg0 context.Context, arg1 relation.UUID) (watcher.Watcher[[]string], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchRelationNetworkIngress", arg0, arg1)
+	ret0, _ := ret[0].(watcher.Watcher[[]string])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchRelationNetworkIngress indicates an expected call of WatchRelationNetworkIngress.
+func (mr *MockCrossModelRelationServiceMockRecorder) WatchRelationNetworkIngress(arg0, arg1 any) *MockCrossModelRelationServiceWatchRelationNetworkIngressCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchRelationNetworkIngress", reflect.TypeOf((*MockCrossModelRelationService)(nil).WatchRelationNetworkIngress), arg0, arg1)
+	return &MockCrossModelRelationServiceWatchRelationNetworkIngressCall{Call: call}
+}
+
+// MockCrossModelRelationServiceWatchRelationNetworkIngressCall wrap *gomock.Call
+type MockCrossModelRelationServiceWatchRelationNetworkIngressCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCrossModelRelationServiceWatchRelationNetworkIngressCall) Return(arg0 watcher.Watcher[[]string], arg1 error) *MockCrossModelRelationServiceWatchRelationNetworkIngressCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCrossModelRelationServiceWatchRelationNetworkIngressCall) Do(f func(context.Context, relation.UUID) (watcher.Watcher[[]string], error)) *MockCrossModelRelationServiceWatchRelationNetworkIngressCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCrossModelRelationServiceWatchRelationNetworkIngressCall) DoAndReturn(f func(context.Context, relation.UUID) (watcher.Watcher[[]string], error)) *MockCrossModelRelationServiceWatchRelationNetworkIngressCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockRelationService is a mock of RelationService interface.
+type MockRelationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRelationServiceMockRecorder
+}
+
+// MockRelationServiceMockRecorder is the mock recorder for MockRelationService.
+type MockRelationServiceMockRecorder struct {
+	mock *MockRelationService
+}
+
+// NewMockRelationService creates a new mock instance.
+func NewMockRelationService(ctrl *gomock.Controller) *MockRelationService {
+	mock := &MockRelationService{ctrl: ctrl}
+	mock.recorder = &MockRelationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRelationService) EXPECT() *MockRelationServiceMockRecorder {
+	return m.recorder
+}
+
+// GetRelationUUIDByKey mocks base method.
+func (m *MockRelationService) GetRelationUUIDByKey(arg0 context.Context, arg1 relation.Key) (relation.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRelationUUIDByKey", arg0, arg1)
+	ret0, _ := ret[0].(relation.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRelationUUIDByKey indicates an expected call of GetRelationUUIDByKey.
+func (mr *MockRelationServiceMockRecorder) GetRelationUUIDByKey(arg0, arg1 any) *MockRelationServiceGetRelationUUIDByKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelationUUIDByKey", reflect.TypeOf((*MockRelationService)(nil).GetRelationUUIDByKey), arg0, arg1)
+	return &MockRelationServiceGetRelationUUIDByKeyCall{Call: call}
+}
+
+// MockRelationServiceGetRelationUUIDByKeyCall wrap *gomock.Call
+type MockRelationServiceGetRelationUUIDByKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRelationServiceGetRelationUUIDByKeyCall) Return(arg0 relation.UUID, arg1 error) *MockRelationServiceGetRelationUUIDByKeyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRelationServiceGetRelationUUIDByKeyCall) Do(f func(context.Context, relation.Key) (relation.UUID, error)) *MockRelationServiceGetRelationUUIDByKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRelationServiceGetRelationUUIDByKeyCall) DoAndReturn(f func(context.Context, relation.Key) (relation.UUID, error)) *MockRelationServiceGetRelationUUIDByKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockStatusService is a mock of StatusService interface.
+type MockStatusService struct {
+	ctrl     *gomock.Controller
+	recorder *MockStatusServiceMockRecorder
+}
+
+// MockStatusServiceMockRecorder is the mock recorder for MockStatusService.
+type MockStatusServiceMockRecorder struct {
+	mock *MockStatusService
+}
+
+// NewMockStatusService creates a new mock instance.
+func NewMockStatusService(ctrl *gomock.Controller) *MockStatusService {
+	mock := &MockStatusService{ctrl: ctrl}
+	mock.recorder = &MockStatusServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStatusService) EXPECT() *MockStatusServiceMockRecorder {
+	return m.recorder
+}
+
+// SetRemoteRelationStatus mocks base method.
+func (m *MockStatusService) SetRemoteRelationStatus(arg0 context.Context, arg1 relation.UUID, arg2 status.StatusInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRemoteRelationStatus", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRemoteRelationStatus indicates an expected call of SetRemoteRelationStatus.
+func (mr *MockStatusServiceMockRecorder) SetRemoteRelationStatus(arg0, arg1, arg2 any) *MockStatusServiceSetRemoteRelationStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRemoteRelationStatus", reflect.TypeOf((*MockStatusService)(nil).SetRemoteRelationStatus), arg0, arg1, arg2)
+	return &MockStatusServiceSetRemoteRelationStatusCall{Call: call}
+}
+
+// MockStatusServiceSetRemoteRelationStatusCall wrap *gomock.Call
+type MockStatusServiceSetRemoteRelationStatusCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatusServiceSetRemoteRelationStatusCall) Return(arg0 error) *MockStatusServiceSetRemoteRelationStatusCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatusServiceSetRemoteRelationStatusCall) Do(f func(context.Context, relation.UUID, status.StatusInfo) error) *MockStatusServiceSetRemoteRelationStatusCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatusServiceSetRemoteRelationStatusCall) DoAndReturn(f func(context.Context, relation.UUID, status.StatusInfo) error) *MockStatusServiceSetRemoteRelationStatusCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
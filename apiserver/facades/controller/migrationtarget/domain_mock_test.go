@@ -272,6 +272,44 @@ func (m *MockModelImporter) EXPECT() *MockModelImporterMockRecorder {
 	return m.recorder
 }
 
+// AbortImport mocks base method.
+func (m *MockModelImporter) AbortImport(arg0 context.Context, arg1 model.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AbortImport", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AbortImport indicates an expected call of AbortImport.
+func (mr *MockModelImporterMockRecorder) AbortImport(arg0, arg1 any) *MockModelImporterAbortImportCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AbortImport", reflect.TypeOf((*MockModelImporter)(nil).AbortImport), arg0, arg1)
+	return &MockModelImporterAbortImportCall{Call: call}
+}
+
+// MockModelImporterAbortImportCall wrap *gomock.Call
+type MockModelImporterAbortImportCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelImporterAbortImportCall) Return(arg0 error) *MockModelImporterAbortImportCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelImporterAbortImportCall) Do(f func(context.Context, model.UUID) error) *MockModelImporterAbortImportCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelImporterAbortImportCall) DoAndReturn(f func(context.Context, model.UUID) error) *MockModelImporterAbortImportCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ImportModel mocks base method.
 func (m *MockModelImporter) ImportModel(arg0 context.Context, arg1 []byte) error {
 	m.ctrl.T.Helper()
@@ -333,44 +371,6 @@ func (m *MockModelMigrationService) EXPECT() *MockModelMigrationServiceMockRecor
 	return m.recorder
 }
 
-// AbortImport mocks base method.
-func (m *MockModelMigrationService) AbortImport(arg0 context.Context) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AbortImport", arg0)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// AbortImport indicates an expected call of AbortImport.
-func (mr *MockModelMigrationServiceMockRecorder) AbortImport(arg0 any) *MockModelMigrationServiceAbortImportCall {
-	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AbortImport", reflect.TypeOf((*MockModelMigrationService)(nil).AbortImport), arg0)
-	return &MockModelMigrationServiceAbortImportCall{Call: call}
-}
-
-// MockModelMigrationServiceAbortImportCall wrap *gomock.Call
-type MockModelMigrationServiceAbortImportCall struct {
-	*gomock.Call
-}
-
-// Return rewrite *gomock.Call.Return
-func (c *MockModelMigrationServiceAbortImportCall) Return(arg0 error) *MockModelMigrationServiceAbortImportCall {
-	c.Call = c.Call.Return(arg0)
-	return c
-}
-
-// Do rewrite *gomock.Call.Do
-func (c *MockModelMigrationServiceAbortImportCall) Do(f func(context.Context) error) *MockModelMigrationServiceAbortImportCall {
-	c.Call = c.Call.Do(f)
-	return c
-}
-
-// DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockModelMigrationServiceAbortImportCall) DoAndReturn(f func(context.Context) error) *MockModelMigrationServiceAbortImportCall {
-	c.Call = c.Call.DoAndReturn(f)
-	return c
-}
-
 // ActivateImport mocks base method.
 func (m *MockModelMigrationService) ActivateImport(arg0 context.Context) error {
 	m.ctrl.T.Helper()
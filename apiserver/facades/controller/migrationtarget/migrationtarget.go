@@ -40,6 +40,10 @@ type ModelImporter interface {
 	// ImportModel takes a serialized description model (yaml bytes) and returns
 	// a state model and state state.
 	ImportModel(ctx context.Context, bytes []byte) error
+
+	// AbortImport removes the model being imported from the controller,
+	// along with its database.
+	AbortImport(ctx context.Context, modelUUID coremodel.UUID) error
 }
 
 // ExternalControllerService provides a subset of the external controller
@@ -80,9 +84,6 @@ type ModelMigrationService interface {
 	// any discrepancies.
 	CheckMachines(context.Context) ([]modelmigration.MigrationMachineDiscrepancy, error)
 
-	// AbortImport stops the import of the model.
-	AbortImport(ctx context.Context) error
-
 	// ActivateImport finalises the import of the model.
 	ActivateImport(ctx context.Context) error
 
@@ -337,13 +338,7 @@ func (api *API) Abort(ctx context.Context, args params.ModelArgs) error {
 		return errors.Capture(err)
 	}
 
-	modelUUID := coremodel.UUID(modelTag.Id())
-	modelMigrationService, err := api.modelMigrationServiceGetter(ctx, modelUUID)
-	if err != nil {
-		return errors.Capture(err)
-	}
-
-	err = modelMigrationService.AbortImport(ctx)
+	err = api.modelImporter.AbortImport(ctx, coremodel.UUID(modelTag.Id()))
 	if err != nil {
 		return errors.Capture(err)
 	}
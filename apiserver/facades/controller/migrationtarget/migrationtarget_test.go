@@ -26,6 +26,7 @@ import (
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 	"github.com/juju/juju/internal/migration"
 	_ "github.com/juju/juju/internal/provider/manual"
+	"github.com/juju/juju/internal/secrets/encryptionkey"
 	"github.com/juju/juju/internal/storage"
 	jujutesting "github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/internal/uuid"
@@ -534,6 +535,7 @@ func (s *Suite) expectImportModel(c *tc.C) {
 				return nil
 			}),
 			s.objectStoreGetter,
+			encryptionkey.NewSource(c.MkDir()),
 			loggertesting.WrapCheckLog(c),
 			clock.WallClock,
 		).ImportModel(ctx, bytes)
@@ -0,0 +1,275 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package allwatcher
+
+import (
+	"context"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+	"github.com/juju/worker/v4/catacomb"
+
+	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/rpc/params"
+)
+
+// ModelLister provides the models of the controller to the all-model
+// watcher.
+type ModelLister interface {
+	// WatchModels returns a watcher that notifies when models are added to,
+	// or removed from, the controller.
+	WatchModels(ctx context.Context) (watcher.NotifyWatcher, error)
+
+	// ListModelUUIDs returns the UUIDs of all the models in the controller.
+	ListModelUUIDs(ctx context.Context) ([]model.UUID, error)
+}
+
+// BackendGetter returns the backend for the model with the given UUID.
+type BackendGetter func(context.Context, model.UUID) (Backend, error)
+
+// modelDeltas holds deltas reported by the watcher of a single model.
+type modelDeltas struct {
+	child  *modelChild
+	deltas []params.Delta
+}
+
+// modelChild tracks the watcher of a single model, along with the entities
+// reported for that model so far.
+type modelChild struct {
+	uuid    model.UUID
+	watcher *modelWatcher
+	known   map[params.EntityId]params.EntityInfo
+}
+
+// removals returns a removal delta for each of the entities reported for the
+// model.
+func (c *modelChild) removals() []params.Delta {
+	ids := make([]params.EntityId, 0, len(c.known))
+	for id := range c.known {
+		ids = append(ids, id)
+	}
+	sortEntityIds(ids)
+
+	deltas := make([]params.Delta, len(ids))
+	for i, id := range ids {
+		deltas[i] = params.Delta{Removed: true, Entity: c.known[id]}
+	}
+	return deltas
+}
+
+// allModelWatcher is an all-watcher over the entities of every model in the
+// controller. A model watcher is started for each model, and its deltas are
+// merged into a single stream. When a model is removed, a removal delta is
+// sent for each of its entities.
+type allModelWatcher struct {
+	catacomb   catacomb.Catacomb
+	models     ModelLister
+	getBackend BackendGetter
+
+	in  chan modelDeltas
+	out chan []params.Delta
+
+	children map[model.UUID]*modelChild
+}
+
+// NewAllModelWatcher returns an all-watcher over the entities of every model
+// in the controller.
+func NewAllModelWatcher(models ModelLister, getBackend BackendGetter) (AllWatcher, error) {
+	w := &allModelWatcher{
+		models:     models,
+		getBackend: getBackend,
+		in:         make(chan modelDeltas),
+		out:        make(chan []params.Delta),
+		children:   make(map[model.UUID]*modelChild),
+	}
+
+	err := catacomb.Invoke(catacomb.Plan{
+		Name: "all-model-watcher",
+		Site: &w.catacomb,
+		Work: w.loop,
+	})
+	return w, errors.Trace(err)
+}
+
+func (w *allModelWatcher) loop() error {
+	defer close(w.out)
+
+	ctx, cancel := w.scopedContext()
+	defer cancel()
+
+	modelsWatcher, err := w.models.WatchModels(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := w.catacomb.Add(modelsWatcher); err != nil {
+		return errors.Trace(err)
+	}
+
+	var (
+		out     chan []params.Delta
+		pending deltaBuffer
+
+		// waiting holds the models whose initial event is still required
+		// before the initial event of the all-model watcher can be sent. It
+		// is nil until the first set of models is known.
+		waiting     set.Strings
+		initialSent bool
+	)
+	for {
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+
+		case out <- pending.deltas():
+			out = nil
+			pending = deltaBuffer{}
+			initialSent = true
+
+		case _, ok := <-modelsWatcher.Changes():
+			if !ok {
+				return w.catacomb.ErrDying()
+			}
+			added, removed, err := w.syncModels(ctx)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			if waiting == nil {
+				waiting = set.NewStrings()
+				for _, uuid := range added {
+					waiting.Add(uuid.String())
+				}
+			}
+			for _, child := range removed {
+				waiting.Remove(child.uuid.String())
+				for _, delta := range child.removals() {
+					pending.add(delta)
+				}
+			}
+
+		case change := <-w.in:
+			if w.children[change.child.uuid] != change.child {
+				// The model has since been removed.
+				continue
+			}
+			for _, delta := range change.deltas {
+				id := delta.Entity.EntityId()
+				if delta.Removed {
+					delete(change.child.known, id)
+				} else {
+					change.child.known[id] = delta.Entity
+				}
+				pending.add(delta)
+			}
+			waiting.Remove(change.child.uuid.String())
+		}
+
+		// The initial event is only sent once every model known at the
+		// start has reported its entities, and it is sent even if there
+		// are none.
+		ready := waiting != nil && waiting.IsEmpty()
+		if ready && (!initialSent || !pending.empty()) {
+			out = w.out
+		}
+	}
+}
+
+// syncModels starts a watcher for every model that is not already being
+// watched, and stops the watchers of models that have been removed. The
+// UUIDs of the new models are returned, along with the models that have gone.
+func (w *allModelWatcher) syncModels(ctx context.Context) ([]model.UUID, []*modelChild, error) {
+	uuids, err := w.models.ListModelUUIDs(ctx)
+	if err != nil {
+		return nil, nil, errors.Annotate(err, "listing models")
+	}
+
+	current := make(map[model.UUID]bool, len(uuids))
+	var added []model.UUID
+	for _, uuid := range uuids {
+		current[uuid] = true
+		if _, ok := w.children[uuid]; ok {
+			continue
+		}
+		if err := w.startModel(ctx, uuid); err != nil {
+			return nil, nil, errors.Annotatef(err, "watching model %q", uuid)
+		}
+		added = append(added, uuid)
+	}
+
+	var removed []*modelChild
+	for uuid, child := range w.children {
+		if current[uuid] {
+			continue
+		}
+		child.watcher.Kill()
+		delete(w.children, uuid)
+		removed = append(removed, child)
+	}
+	return added, removed, nil
+}
+
+func (w *allModelWatcher) startModel(ctx context.Context, uuid model.UUID) error {
+	backend, err := w.getBackend(ctx, uuid)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	mw, err := newModelWatcher(backend)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := w.catacomb.Add(mw); err != nil {
+		return errors.Trace(err)
+	}
+
+	child := &modelChild{
+		uuid:    uuid,
+		watcher: mw,
+		known:   make(map[params.EntityId]params.EntityInfo),
+	}
+	w.children[uuid] = child
+	go w.forward(child)
+	return nil
+}
+
+// forward passes the deltas of a single model on to the main loop, until
+// either the model watcher or the all-model watcher stops.
+func (w *allModelWatcher) forward(child *modelChild) {
+	for {
+		select {
+		case <-w.catacomb.Dying():
+			return
+		case deltas, ok := <-child.watcher.Changes():
+			if !ok {
+				return
+			}
+			select {
+			case w.in <- modelDeltas{child: child, deltas: deltas}:
+			case <-w.catacomb.Dying():
+				return
+			}
+		}
+	}
+}
+
+// Changes returns the channel on which the deltas are delivered.
+func (w *allModelWatcher) Changes() <-chan []params.Delta {
+	return w.out
+}
+
+// Kill is part of the worker.Worker interface.
+func (w *allModelWatcher) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (w *allModelWatcher) Wait() error {
+	return w.catacomb.Wait()
+}
+
+// scopedContext returns a context that is in the scope of the watcher
+// lifetime.
+func (w *allModelWatcher) scopedContext() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	return w.catacomb.Context(ctx), cancel
+}
@@ -0,0 +1,113 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package allwatcher
+
+import (
+	"context"
+	"testing"
+
+	"github.com/juju/tc"
+	"github.com/juju/worker/v4/workertest"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/watcher/watchertest"
+	"github.com/juju/juju/rpc/params"
+)
+
+type allModelWatcherSuite struct {
+	models *MockModelLister
+
+	backends map[model.UUID]*MockBackend
+}
+
+func TestAllModelWatcherSuite(t *testing.T) {
+	tc.Run(t, &allModelWatcherSuite{})
+}
+
+func (s *allModelWatcherSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.models = NewMockModelLister(ctrl)
+	s.backends = map[model.UUID]*MockBackend{
+		"model-a": NewMockBackend(ctrl),
+		"model-b": NewMockBackend(ctrl),
+	}
+
+	c.Cleanup(func() {
+		s.models = nil
+		s.backends = nil
+	})
+
+	return ctrl
+}
+
+func (s *allModelWatcherSuite) getBackend(_ context.Context, uuid model.UUID) (Backend, error) {
+	return s.backends[uuid], nil
+}
+
+func (s *allModelWatcherSuite) expectModel(uuid model.UUID, entities ...params.EntityInfo) {
+	ch := make(chan []string, 1)
+	ch <- []string{"application"}
+	backend := s.backends[uuid]
+	backend.EXPECT().WatchChanges(gomock.Any()).Return(watchertest.NewMockStringsWatcher(ch), nil)
+	backend.EXPECT().Entities(gomock.Any(), nil).Return(entities, allKinds, nil)
+}
+
+func (s *allModelWatcherSuite) TestInitialEventNoModels(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	ch := make(chan struct{}, 1)
+	ch <- struct{}{}
+	s.models.EXPECT().WatchModels(gomock.Any()).Return(watchertest.NewMockNotifyWatcher(ch), nil)
+	s.models.EXPECT().ListModelUUIDs(gomock.Any()).Return(nil, nil)
+
+	w, err := NewAllModelWatcher(s.models, s.getBackend)
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	c.Check(nextDeltas(c, w), tc.DeepEquals, []params.Delta{})
+}
+
+func (s *allModelWatcherSuite) TestModelsAddedAndRemoved(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appA := &params.ApplicationInfo{ModelUUID: "model-a", Name: "mysql"}
+	unitA := &params.UnitInfo{ModelUUID: "model-a", Name: "mysql/0", Application: "mysql"}
+	appB := &params.ApplicationInfo{ModelUUID: "model-b", Name: "wordpress"}
+
+	s.expectModel("model-a", appA, unitA)
+	s.expectModel("model-b", appB)
+
+	ch := make(chan struct{}, 1)
+	ch <- struct{}{}
+	s.models.EXPECT().WatchModels(gomock.Any()).Return(watchertest.NewMockNotifyWatcher(ch), nil)
+	gomock.InOrder(
+		s.models.EXPECT().ListModelUUIDs(gomock.Any()).Return([]model.UUID{"model-a"}, nil),
+		s.models.EXPECT().ListModelUUIDs(gomock.Any()).Return([]model.UUID{"model-a", "model-b"}, nil),
+		s.models.EXPECT().ListModelUUIDs(gomock.Any()).Return([]model.UUID{"model-b"}, nil),
+	)
+
+	w, err := NewAllModelWatcher(s.models, s.getBackend)
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	c.Check(nextDeltas(c, w), tc.DeepEquals, []params.Delta{
+		{Entity: appA},
+		{Entity: unitA},
+	})
+
+	// A new model reports its entities.
+	ch <- struct{}{}
+	c.Check(nextDeltas(c, w), tc.DeepEquals, []params.Delta{
+		{Entity: appB},
+	})
+
+	// A removed model reports the removal of its entities.
+	ch <- struct{}{}
+	c.Check(nextDeltas(c, w), tc.DeepEquals, []params.Delta{
+		{Removed: true, Entity: appA},
+		{Removed: true, Entity: unitA},
+	})
+}
@@ -0,0 +1,223 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package allwatcher
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"sort"
+
+	"github.com/juju/errors"
+	"github.com/juju/worker/v4/catacomb"
+
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/rpc/params"
+)
+
+// AllWatcher reports the changes to the entities of one or more models, as a
+// list of deltas. The first event holds the complete set of entities, as a
+// change delta for each one.
+type AllWatcher = watcher.Watcher[[]params.Delta]
+
+// Backend provides the entities of a single model to an all-watcher.
+type Backend interface {
+	// WatchChanges returns a watcher that emits the names of the namespaces
+	// in which entities of the model may have changed.
+	WatchChanges(ctx context.Context) (watcher.StringsWatcher, error)
+
+	// Entities returns the current state of the entities of the model that
+	// are held in the given namespaces, along with the kinds of entity that
+	// were read. Every entity of those kinds is returned, so any that are
+	// missing have been removed. All entities are read if no namespaces are
+	// given.
+	Entities(ctx context.Context, namespaces []string) ([]params.EntityInfo, []string, error)
+}
+
+// modelWatcher is an all-watcher over the entities of a single model. Every
+// time the backend reports a change, the entities held in the changed
+// namespaces are read again and compared against those last reported, so
+// that only the entities that actually changed are sent.
+type modelWatcher struct {
+	catacomb catacomb.Catacomb
+	backend  Backend
+
+	out chan []params.Delta
+
+	// known holds the entities as they were last reported.
+	known map[params.EntityId]params.EntityInfo
+}
+
+// NewModelWatcher returns an all-watcher over the entities of the model
+// provided by the given backend.
+func NewModelWatcher(backend Backend) (AllWatcher, error) {
+	return newModelWatcher(backend)
+}
+
+func newModelWatcher(backend Backend) (*modelWatcher, error) {
+	w := &modelWatcher{
+		backend: backend,
+		out:     make(chan []params.Delta),
+		known:   make(map[params.EntityId]params.EntityInfo),
+	}
+
+	err := catacomb.Invoke(catacomb.Plan{
+		Name: "model-all-watcher",
+		Site: &w.catacomb,
+		Work: w.loop,
+	})
+	return w, errors.Trace(err)
+}
+
+func (w *modelWatcher) loop() error {
+	defer close(w.out)
+
+	ctx, cancel := w.scopedContext()
+	defer cancel()
+
+	changes, err := w.backend.WatchChanges(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := w.catacomb.Add(changes); err != nil {
+		return errors.Trace(err)
+	}
+
+	var (
+		out     chan []params.Delta
+		pending deltaBuffer
+		initial = true
+	)
+	for {
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+		case out <- pending.deltas():
+			out = nil
+			pending = deltaBuffer{}
+		case namespaces, ok := <-changes.Changes():
+			if !ok {
+				return w.catacomb.ErrDying()
+			}
+			// The first event must hold every entity, whatever namespaces
+			// it names.
+			if initial {
+				namespaces = nil
+			}
+			entities, kinds, err := w.backend.Entities(ctx, namespaces)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			for _, delta := range diff(w.known, kinds, entities) {
+				pending.add(delta)
+			}
+
+			// The first event is always sent, even if the model is empty,
+			// so the consumer knows it has the complete set of entities.
+			if initial || !pending.empty() {
+				out = w.out
+			}
+			initial = false
+		}
+	}
+}
+
+// Changes returns the channel on which the deltas are delivered.
+func (w *modelWatcher) Changes() <-chan []params.Delta {
+	return w.out
+}
+
+// Kill is part of the worker.Worker interface.
+func (w *modelWatcher) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (w *modelWatcher) Wait() error {
+	return w.catacomb.Wait()
+}
+
+// scopedContext returns a context that is in the scope of the watcher
+// lifetime.
+func (w *modelWatcher) scopedContext() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	return w.catacomb.Context(ctx), cancel
+}
+
+// diff returns the deltas required to move a consumer from the known
+// entities to the current ones, updating known as it goes. Entities that
+// have not changed are not reported. Only known entities of the given kinds
+// are considered for removal, as those are the kinds current was read for.
+func diff(known map[params.EntityId]params.EntityInfo, kinds []string, current []params.EntityInfo) []params.Delta {
+	var deltas []params.Delta
+
+	seen := make(map[params.EntityId]bool, len(current))
+	for _, info := range current {
+		id := info.EntityId()
+		seen[id] = true
+		if prev, ok := known[id]; ok && reflect.DeepEqual(prev, info) {
+			continue
+		}
+		known[id] = info
+		deltas = append(deltas, params.Delta{Entity: info})
+	}
+
+	var removed []params.EntityId
+	for id := range known {
+		if !seen[id] && slices.Contains(kinds, id.Kind) {
+			removed = append(removed, id)
+		}
+	}
+	sortEntityIds(removed)
+	for _, id := range removed {
+		deltas = append(deltas, params.Delta{Removed: true, Entity: known[id]})
+		delete(known, id)
+	}
+	return deltas
+}
+
+func sortEntityIds(ids []params.EntityId) {
+	sort.Slice(ids, func(i, j int) bool {
+		if ids[i].ModelUUID != ids[j].ModelUUID {
+			return ids[i].ModelUUID < ids[j].ModelUUID
+		}
+		if ids[i].Kind != ids[j].Kind {
+			return ids[i].Kind < ids[j].Kind
+		}
+		return ids[i].Id < ids[j].Id
+	})
+}
+
+// deltaBuffer holds the deltas that have not been sent yet. Only the latest
+// delta for each entity is kept, in the order the entities were first seen.
+type deltaBuffer struct {
+	buffer []params.Delta
+	index  map[params.EntityId]int
+}
+
+func (b *deltaBuffer) add(delta params.Delta) {
+	if b.index == nil {
+		b.index = make(map[params.EntityId]int)
+	}
+	id := delta.Entity.EntityId()
+	if i, ok := b.index[id]; ok {
+		b.buffer[i] = delta
+		return
+	}
+	b.index[id] = len(b.buffer)
+	b.buffer = append(b.buffer, delta)
+}
+
+func (b *deltaBuffer) empty() bool {
+	return len(b.buffer) == 0
+}
+
+// deltas returns the buffered deltas, never nil so that an empty initial
+// event is still a valid result.
+func (b *deltaBuffer) deltas() []params.Delta {
+	if b.buffer == nil {
+		return []params.Delta{}
+	}
+	return b.buffer
+}
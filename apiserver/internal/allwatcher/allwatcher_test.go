@@ -0,0 +1,130 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package allwatcher
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+	"github.com/juju/worker/v4/workertest"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/core/life"
+	"github.com/juju/juju/core/watcher/watchertest"
+	coretesting "github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc/params"
+)
+
+type allWatcherSuite struct {
+	backend *MockBackend
+}
+
+func TestAllWatcherSuite(t *testing.T) {
+	tc.Run(t, &allWatcherSuite{})
+}
+
+func (s *allWatcherSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.backend = NewMockBackend(ctrl)
+
+	c.Cleanup(func() {
+		s.backend = nil
+	})
+
+	return ctrl
+}
+
+func (s *allWatcherSuite) TestInitialEventEmptyModel(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	ch := make(chan []string, 1)
+	ch <- []string{"application", "machine"}
+	s.backend.EXPECT().WatchChanges(gomock.Any()).Return(watchertest.NewMockStringsWatcher(ch), nil)
+	s.backend.EXPECT().Entities(gomock.Any(), nil).Return(nil, allKinds, nil)
+
+	w, err := NewModelWatcher(s.backend)
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	c.Check(nextDeltas(c, w), tc.DeepEquals, []params.Delta{})
+}
+
+func (s *allWatcherSuite) TestDeltas(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	mysql := &params.ApplicationInfo{ModelUUID: "uuid", Name: "mysql", Life: life.Alive}
+	mysql0 := &params.UnitInfo{ModelUUID: "uuid", Name: "mysql/0", Application: "mysql", Life: life.Alive}
+	mysql0Dying := &params.UnitInfo{ModelUUID: "uuid", Name: "mysql/0", Application: "mysql", Life: life.Dying}
+	machine0 := &params.MachineInfo{ModelUUID: "uuid", Id: "0", Life: life.Alive}
+
+	appKinds := []string{params.ApplicationEntityKind, params.UnitEntityKind}
+
+	ch := make(chan []string, 1)
+	ch <- []string{"application", "machine", "unit"}
+	s.backend.EXPECT().WatchChanges(gomock.Any()).Return(watchertest.NewMockStringsWatcher(ch), nil)
+	gomock.InOrder(
+		s.backend.EXPECT().Entities(gomock.Any(), nil).Return([]params.EntityInfo{machine0, mysql, mysql0}, allKinds, nil),
+		s.backend.EXPECT().Entities(gomock.Any(), []string{"unit"}).Return([]params.EntityInfo{mysql, mysql0Dying}, appKinds, nil),
+		s.backend.EXPECT().Entities(gomock.Any(), []string{"unit"}).Return([]params.EntityInfo{mysql}, appKinds, nil),
+		s.backend.EXPECT().Entities(gomock.Any(), []string{"application_status"}).Return([]params.EntityInfo{mysql}, appKinds, nil),
+	)
+
+	w, err := NewModelWatcher(s.backend)
+	c.Assert(err, tc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	c.Check(nextDeltas(c, w), tc.DeepEquals, []params.Delta{
+		{Entity: machine0},
+		{Entity: mysql},
+		{Entity: mysql0},
+	})
+
+	// Only the unit changed. The machine was not read again, so it is not
+	// reported as removed.
+	ch <- []string{"unit"}
+	c.Check(nextDeltas(c, w), tc.DeepEquals, []params.Delta{
+		{Entity: mysql0Dying},
+	})
+
+	// The unit is removed.
+	ch <- []string{"unit"}
+	c.Check(nextDeltas(c, w), tc.DeepEquals, []params.Delta{
+		{Removed: true, Entity: mysql0Dying},
+	})
+
+	// Nothing changed, so nothing is sent.
+	ch <- []string{"application_status"}
+	assertNoDeltas(c, w)
+}
+
+// allKinds holds every kind of entity reported by an all-watcher.
+var allKinds = []string{
+	params.MachineEntityKind,
+	params.ApplicationEntityKind,
+	params.UnitEntityKind,
+	params.RemoteApplicationEntityKind,
+	params.RelationEntityKind,
+	params.AnnotationEntityKind,
+}
+
+func nextDeltas(c *tc.C, w AllWatcher) []params.Delta {
+	select {
+	case deltas, ok := <-w.Changes():
+		c.Assert(ok, tc.IsTrue)
+		return deltas
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for deltas")
+	}
+	return nil
+}
+
+func assertNoDeltas(c *tc.C, w AllWatcher) {
+	select {
+	case deltas := <-w.Changes():
+		c.Fatalf("unexpected deltas %v", deltas)
+	case <-time.After(coretesting.ShortWait):
+	}
+}
@@ -0,0 +1,494 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package allwatcher
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/apiserver/internal/charms"
+	"github.com/juju/juju/core/annotations"
+	corebase "github.com/juju/juju/core/base"
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/core/machine"
+	"github.com/juju/juju/core/model"
+	corerelation "github.com/juju/juju/core/relation"
+	corestatus "github.com/juju/juju/core/status"
+	"github.com/juju/juju/core/unit"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/domain/crossmodelrelation"
+	"github.com/juju/juju/domain/deployment"
+	"github.com/juju/juju/domain/port"
+	domainrelation "github.com/juju/juju/domain/relation"
+	statusservice "github.com/juju/juju/domain/status/service"
+	"github.com/juju/juju/rpc/params"
+)
+
+// StatusService provides the statuses of the entities of a model, along with
+// a watcher over them.
+type StatusService interface {
+	// WatchModelEntityChanges returns a watcher that emits the names of the
+	// namespaces in which an entity of the model, or its status, changed.
+	WatchModelEntityChanges(ctx context.Context) (watcher.StringsWatcher, error)
+
+	// GetApplicationAndUnitStatuses returns the applications of the model,
+	// along with their units, keyed by application name.
+	GetApplicationAndUnitStatuses(ctx context.Context) (map[string]statusservice.Application, error)
+
+	// GetMachineFullStatuses returns the machines of the model, keyed by
+	// machine name.
+	GetMachineFullStatuses(ctx context.Context) (map[machine.Name]statusservice.Machine, error)
+
+	// GetAllRelationStatuses returns the statuses of all the relations of
+	// the model.
+	GetAllRelationStatuses(ctx context.Context) (map[corerelation.UUID]corestatus.StatusInfo, error)
+}
+
+// RelationService provides the relations of a model.
+type RelationService interface {
+	// GetAllRelationDetails returns the details of all the relations of the
+	// model.
+	GetAllRelationDetails(ctx context.Context) ([]domainrelation.RelationDetailsResult, error)
+}
+
+// AnnotationService provides the annotations of a model.
+type AnnotationService interface {
+	// GetAnnotations returns the annotations of the given entity.
+	GetAnnotations(ctx context.Context, id annotations.ID) (map[string]string, error)
+
+	// GetAnnotationsForKind returns the annotations of every entity of the
+	// given kind, keyed by entity name.
+	GetAnnotationsForKind(ctx context.Context, kind annotations.Kind) (map[string]map[string]string, error)
+}
+
+// PortService provides the opened ports of a model.
+type PortService interface {
+	// GetAllOpenedPorts returns the opened ports of the model, grouped by
+	// unit name.
+	GetAllOpenedPorts(ctx context.Context) (port.UnitGroupedPortRanges, error)
+}
+
+// CrossModelRelationService provides the remote applications of a model.
+type CrossModelRelationService interface {
+	// GetRemoteApplicationConsumers returns the remote applications that
+	// represent offers consumed by the model.
+	GetRemoteApplicationConsumers(ctx context.Context) ([]crossmodelrelation.RemoteApplicationConsumer, error)
+}
+
+// Services holds the domain services of a model used to build the entities
+// reported by an all-watcher.
+type Services struct {
+	Status             StatusService
+	Relation           RelationService
+	Annotation         AnnotationService
+	Port               PortService
+	CrossModelRelation CrossModelRelationService
+}
+
+// servicesBackend is a Backend that reads the entities of a model from its
+// domain services.
+type servicesBackend struct {
+	modelUUID model.UUID
+	services  Services
+}
+
+// NewBackend returns a Backend for the given model that reads the entities
+// from the model domain services.
+func NewBackend(modelUUID model.UUID, services Services) Backend {
+	return &servicesBackend{
+		modelUUID: modelUUID,
+		services:  services,
+	}
+}
+
+// entityGroup is a set of entities that are read together, along with the
+// namespaces in which a change may alter them.
+type entityGroup struct {
+	name       string
+	kinds      []string
+	namespaces []string
+	read       func(*servicesBackend, context.Context) ([]params.EntityInfo, error)
+}
+
+// entityGroups holds the groups of entities reported by the all-watcher, in
+// the order they are read.
+var entityGroups = []entityGroup{{
+	name:  "machines",
+	kinds: []string{params.MachineEntityKind},
+	namespaces: []string{
+		"machine",
+		"machine_cloud_instance",
+		"machine_status",
+		"machine_cloud_instance_status",
+	},
+	read: (*servicesBackend).machines,
+}, {
+	name:  "applications",
+	kinds: []string{params.ApplicationEntityKind, params.UnitEntityKind},
+	namespaces: []string{
+		"application",
+		"application_scale",
+		"application_status",
+		"application_exposed_endpoint_space",
+		"application_exposed_endpoint_cidr",
+		"unit",
+		"unit_principal",
+		"unit_agent_status",
+		"unit_workload_status",
+		"k8s_pod_status",
+		"port_range",
+	},
+	read: (*servicesBackend).applicationsAndUnits,
+}, {
+	name:  "remote applications",
+	kinds: []string{params.RemoteApplicationEntityKind},
+	namespaces: []string{
+		"application",
+		"application_remote_offerer",
+	},
+	read: (*servicesBackend).remoteApplications,
+}, {
+	name:  "relations",
+	kinds: []string{params.RelationEntityKind},
+	namespaces: []string{
+		"relation",
+		"relation_status",
+	},
+	read: (*servicesBackend).relations,
+}, {
+	name:  "annotations",
+	kinds: []string{params.AnnotationEntityKind},
+	namespaces: []string{
+		"annotation_model",
+		"annotation_application",
+		"annotation_machine",
+		"annotation_unit",
+	},
+	read: (*servicesBackend).annotations,
+}}
+
+// entityGroupsFor returns the groups of entities that may be altered by
+// changes in the given namespaces. Every group is returned if no namespaces
+// are given, or if any of them is not known to hold the entities of a group.
+func entityGroupsFor(namespaces []string) []entityGroup {
+	if len(namespaces) == 0 {
+		return entityGroups
+	}
+	changed := make([]bool, len(entityGroups))
+	for _, ns := range namespaces {
+		known := false
+		for i, group := range entityGroups {
+			if slices.Contains(group.namespaces, ns) {
+				changed[i] = true
+				known = true
+			}
+		}
+		if !known {
+			return entityGroups
+		}
+	}
+	var groups []entityGroup
+	for i, group := range entityGroups {
+		if changed[i] {
+			groups = append(groups, group)
+		}
+	}
+	return groups
+}
+
+// WatchChanges is part of the Backend interface.
+func (b *servicesBackend) WatchChanges(ctx context.Context) (watcher.StringsWatcher, error) {
+	return b.services.Status.WatchModelEntityChanges(ctx)
+}
+
+// Entities is part of the Backend interface.
+func (b *servicesBackend) Entities(ctx context.Context, namespaces []string) ([]params.EntityInfo, []string, error) {
+	var (
+		entities []params.EntityInfo
+		kinds    []string
+	)
+	for _, group := range entityGroupsFor(namespaces) {
+		read, err := group.read(b, ctx)
+		if err != nil {
+			return nil, nil, errors.Annotatef(err, "reading %s", group.name)
+		}
+		entities = append(entities, read...)
+		kinds = append(kinds, group.kinds...)
+	}
+	return entities, kinds, nil
+}
+
+func (b *servicesBackend) machines(ctx context.Context) ([]params.EntityInfo, error) {
+	machines, err := b.services.Status.GetMachineFullStatuses(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	names := make([]machine.Name, 0, len(machines))
+	for name := range machines {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+
+	entities := make([]params.EntityInfo, 0, len(names))
+	for _, name := range names {
+		m := machines[name]
+		info := &params.MachineInfo{
+			ModelUUID:      b.modelUUID.String(),
+			Id:             name.String(),
+			InstanceId:     m.InstanceID.String(),
+			AgentStatus:    statusInfo(m.MachineStatus),
+			InstanceStatus: statusInfo(m.InstanceStatus),
+			Life:           m.Life,
+			Base:           platformBase(m.Platform),
+			ContainerType:  containerType(name),
+			Hostname:       m.Hostname,
+		}
+		if m.HardwareCharacteristics != (instance.HardwareCharacteristics{}) {
+			hc := m.HardwareCharacteristics
+			info.HardwareCharacteristics = &hc
+		}
+		for _, addr := range m.IPAddresses {
+			info.Addresses = append(info.Addresses, params.Address{Value: addr})
+		}
+		entities = append(entities, info)
+	}
+	return entities, nil
+}
+
+func (b *servicesBackend) applicationsAndUnits(ctx context.Context) ([]params.EntityInfo, error) {
+	applications, err := b.services.Status.GetApplicationAndUnitStatuses(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	ports, err := b.services.Port.GetAllOpenedPorts(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	appNames := make([]string, 0, len(applications))
+	for name := range applications {
+		appNames = append(appNames, name)
+	}
+	sort.Strings(appNames)
+
+	var entities []params.EntityInfo
+	for _, appName := range appNames {
+		app := applications[appName]
+		charmURL, err := charms.CharmURLFromLocator(app.CharmLocator.Name, app.CharmLocator)
+		if err != nil {
+			return nil, errors.Annotatef(err, "charm URL for application %q", appName)
+		}
+		entities = append(entities, &params.ApplicationInfo{
+			ModelUUID:       b.modelUUID.String(),
+			Name:            appName,
+			Exposed:         app.Exposed,
+			CharmURL:        charmURL,
+			Life:            app.Life,
+			Subordinate:     app.Subordinate,
+			Status:          statusInfo(app.Status),
+			WorkloadVersion: deref(app.WorkloadVersion),
+		})
+
+		unitNames := make([]unit.Name, 0, len(app.Units))
+		for name := range app.Units {
+			unitNames = append(unitNames, name)
+		}
+		sort.Slice(unitNames, func(i, j int) bool { return unitNames[i] < unitNames[j] })
+
+		base := platformBase(app.Platform)
+		for _, unitName := range unitNames {
+			u := app.Units[unitName]
+			unitCharmURL, err := charms.CharmURLFromLocator(u.CharmLocator.Name, u.CharmLocator)
+			if err != nil {
+				return nil, errors.Annotatef(err, "charm URL for unit %q", unitName)
+			}
+			info := &params.UnitInfo{
+				ModelUUID:      b.modelUUID.String(),
+				Name:           unitName.String(),
+				Application:    appName,
+				Base:           base,
+				CharmURL:       unitCharmURL,
+				Life:           u.Life,
+				Subordinate:    u.Subordinate,
+				WorkloadStatus: statusInfo(u.WorkloadStatus),
+				AgentStatus:    statusInfo(u.AgentStatus),
+			}
+			if u.MachineName != nil {
+				info.MachineId = u.MachineName.String()
+			}
+			if u.PrincipalName != nil {
+				info.Principal = u.PrincipalName.String()
+			}
+			for _, pr := range ports[unitName] {
+				info.PortRanges = append(info.PortRanges, params.FromNetworkPortRange(pr))
+			}
+			entities = append(entities, info)
+		}
+	}
+	return entities, nil
+}
+
+func (b *servicesBackend) remoteApplications(ctx context.Context) ([]params.EntityInfo, error) {
+	consumers, err := b.services.CrossModelRelation.GetRemoteApplicationConsumers(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	sort.Slice(consumers, func(i, j int) bool {
+		return consumers[i].ApplicationName < consumers[j].ApplicationName
+	})
+	entities := make([]params.EntityInfo, len(consumers))
+	for i, consumer := range consumers {
+		l, err := consumer.Life.Value()
+		if err != nil {
+			return nil, errors.Annotatef(err, "remote application %q", consumer.ApplicationName)
+		}
+		entities[i] = &params.RemoteApplicationUpdate{
+			ModelUUID: b.modelUUID.String(),
+			Name:      consumer.ApplicationName,
+			OfferUUID: consumer.OfferUUID,
+			Life:      l,
+		}
+	}
+	return entities, nil
+}
+
+func (b *servicesBackend) relations(ctx context.Context) ([]params.EntityInfo, error) {
+	details, err := b.services.Relation.GetAllRelationDetails(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	statuses, err := b.services.Status.GetAllRelationStatuses(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	sort.Slice(details, func(i, j int) bool { return details[i].ID < details[j].ID })
+	entities := make([]params.EntityInfo, 0, len(details))
+	for _, rel := range details {
+		eids := make([]corerelation.EndpointIdentifier, len(rel.Endpoints))
+		endpoints := make([]params.RelationEndpoint, len(rel.Endpoints))
+		for i, ep := range rel.Endpoints {
+			eids[i] = ep.EndpointIdentifier()
+			endpoints[i] = params.RelationEndpoint{
+				ApplicationName: ep.ApplicationName,
+				Relation: params.CharmRelation{
+					Name:      ep.Name,
+					Role:      string(ep.Role),
+					Interface: ep.Interface,
+					Optional:  ep.Optional,
+					Limit:     ep.Limit,
+					Scope:     string(ep.Scope),
+				},
+			}
+		}
+		key, err := corerelation.NewKey(eids)
+		if err != nil {
+			return nil, errors.Annotatef(err, "relation %d", rel.ID)
+		}
+		entities = append(entities, &params.RelationInfo{
+			ModelUUID: b.modelUUID.String(),
+			Key:       key.String(),
+			Id:        rel.ID,
+			Life:      rel.Life,
+			Status:    statusInfo(statuses[rel.UUID]),
+			Endpoints: endpoints,
+		})
+	}
+	return entities, nil
+}
+
+// annotationKinds maps the annotation kinds reported by the all-watcher to
+// the function building the tag of the annotated entity.
+var annotationKinds = []struct {
+	kind annotations.Kind
+	tag  func(string) names.Tag
+}{
+	{kind: annotations.KindMachine, tag: func(n string) names.Tag { return names.NewMachineTag(n) }},
+	{kind: annotations.KindApplication, tag: func(n string) names.Tag { return names.NewApplicationTag(n) }},
+	{kind: annotations.KindUnit, tag: func(n string) names.Tag { return names.NewUnitTag(n) }},
+}
+
+func (b *servicesBackend) annotations(ctx context.Context) ([]params.EntityInfo, error) {
+	var entities []params.EntityInfo
+
+	modelAnnotations, err := b.services.Annotation.GetAnnotations(ctx, annotations.ID{
+		Kind: annotations.KindModel,
+		Name: b.modelUUID.String(),
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(modelAnnotations) > 0 {
+		entities = append(entities, &params.AnnotationInfo{
+			ModelUUID:   b.modelUUID.String(),
+			Tag:         names.NewModelTag(b.modelUUID.String()).String(),
+			Annotations: modelAnnotations,
+		})
+	}
+
+	for _, k := range annotationKinds {
+		byName, err := b.services.Annotation.GetAnnotationsForKind(ctx, k.kind)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		entityNames := make([]string, 0, len(byName))
+		for name := range byName {
+			entityNames = append(entityNames, name)
+		}
+		sort.Strings(entityNames)
+		for _, name := range entityNames {
+			entities = append(entities, &params.AnnotationInfo{
+				ModelUUID:   b.modelUUID.String(),
+				Tag:         k.tag(name).String(),
+				Annotations: byName[name],
+			})
+		}
+	}
+	return entities, nil
+}
+
+func statusInfo(s corestatus.StatusInfo) params.StatusInfo {
+	return params.StatusInfo{
+		Current: s.Status,
+		Message: s.Message,
+		Since:   s.Since,
+		Data:    s.Data,
+	}
+}
+
+func platformBase(platform deployment.Platform) string {
+	if platform.OSType != deployment.Ubuntu {
+		return ""
+	}
+	base, err := corebase.ParseBase(corebase.UbuntuOS, platform.Channel)
+	if err != nil {
+		return ""
+	}
+	return base.DisplayString()
+}
+
+// containerType returns the type of container for a machine name such as
+// 0/lxd/1, or an empty string if the machine is not a container.
+func containerType(name machine.Name) string {
+	if !name.IsContainer() {
+		return ""
+	}
+	parts := strings.Split(name.String(), "/")
+	return parts[len(parts)-2]
+}
+
+func deref[T any](v *T) T {
+	var zero T
+	if v == nil {
+		return zero
+	}
+	return *v
+}
@@ -0,0 +1,253 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package allwatcher
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/core/annotations"
+	"github.com/juju/juju/core/life"
+	"github.com/juju/juju/core/machine"
+	"github.com/juju/juju/core/network"
+	corerelation "github.com/juju/juju/core/relation"
+	corestatus "github.com/juju/juju/core/status"
+	"github.com/juju/juju/core/unit"
+	"github.com/juju/juju/domain/application/architecture"
+	"github.com/juju/juju/domain/application/charm"
+	"github.com/juju/juju/domain/deployment"
+	"github.com/juju/juju/domain/port"
+	domainrelation "github.com/juju/juju/domain/relation"
+	statusservice "github.com/juju/juju/domain/status/service"
+	internalcharm "github.com/juju/juju/internal/charm"
+	"github.com/juju/juju/rpc/params"
+)
+
+type backendSuite struct {
+	status             *MockStatusService
+	relation           *MockRelationService
+	annotation         *MockAnnotationService
+	port               *MockPortService
+	crossModelRelation *MockCrossModelRelationService
+}
+
+func TestBackendSuite(t *testing.T) {
+	tc.Run(t, &backendSuite{})
+}
+
+func (s *backendSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.status = NewMockStatusService(ctrl)
+	s.relation = NewMockRelationService(ctrl)
+	s.annotation = NewMockAnnotationService(ctrl)
+	s.port = NewMockPortService(ctrl)
+	s.crossModelRelation = NewMockCrossModelRelationService(ctrl)
+
+	c.Cleanup(func() {
+		s.status = nil
+		s.relation = nil
+		s.annotation = nil
+		s.port = nil
+		s.crossModelRelation = nil
+	})
+
+	return ctrl
+}
+
+func (s *backendSuite) newBackend() Backend {
+	return NewBackend("model-uuid", Services{
+		Status:             s.status,
+		Relation:           s.relation,
+		Annotation:         s.annotation,
+		Port:               s.port,
+		CrossModelRelation: s.crossModelRelation,
+	})
+}
+
+func (s *backendSuite) TestEntities(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	machineName := machine.Name("0")
+	locator := charm.CharmLocator{
+		Name:         "mysql",
+		Revision:     42,
+		Source:       charm.CharmHubSource,
+		Architecture: architecture.AMD64,
+	}
+	platform := deployment.Platform{
+		OSType:       deployment.Ubuntu,
+		Channel:      "24.04",
+		Architecture: architecture.AMD64,
+	}
+
+	s.status.EXPECT().GetMachineFullStatuses(gomock.Any()).Return(map[machine.Name]statusservice.Machine{
+		machineName: {
+			Life:           life.Alive,
+			InstanceID:     "inst-0",
+			MachineStatus:  corestatus.StatusInfo{Status: corestatus.Started},
+			InstanceStatus: corestatus.StatusInfo{Status: corestatus.Running},
+			Platform:       platform,
+			Hostname:       "host-0",
+			IPAddresses:    []string{"10.0.0.1"},
+		},
+	}, nil)
+	s.status.EXPECT().GetApplicationAndUnitStatuses(gomock.Any()).Return(map[string]statusservice.Application{
+		"mysql": {
+			Life:         life.Alive,
+			Status:       corestatus.StatusInfo{Status: corestatus.Active},
+			CharmLocator: locator,
+			Platform:     platform,
+			Exposed:      true,
+			Units: map[unit.Name]statusservice.Unit{
+				"mysql/0": {
+					Life:           life.Alive,
+					MachineName:    &machineName,
+					AgentStatus:    corestatus.StatusInfo{Status: corestatus.Idle},
+					WorkloadStatus: corestatus.StatusInfo{Status: corestatus.Active},
+					CharmLocator:   locator,
+				},
+			},
+		},
+	}, nil)
+	s.port.EXPECT().GetAllOpenedPorts(gomock.Any()).Return(port.UnitGroupedPortRanges{
+		"mysql/0": {network.MustParsePortRange("3306/tcp")},
+	}, nil)
+	s.crossModelRelation.EXPECT().GetRemoteApplicationConsumers(gomock.Any()).Return(nil, nil)
+	s.relation.EXPECT().GetAllRelationDetails(gomock.Any()).Return([]domainrelation.RelationDetailsResult{{
+		Life: life.Alive,
+		UUID: "rel-uuid",
+		ID:   7,
+		Endpoints: []domainrelation.Endpoint{{
+			ApplicationName: "mysql",
+			Relation: internalcharm.Relation{
+				Name:      "cluster",
+				Role:      internalcharm.RolePeer,
+				Interface: "mysql-ha",
+				Scope:     internalcharm.ScopeGlobal,
+			},
+		}},
+	}}, nil)
+	s.status.EXPECT().GetAllRelationStatuses(gomock.Any()).Return(map[corerelation.UUID]corestatus.StatusInfo{
+		"rel-uuid": {Status: corestatus.Joined},
+	}, nil)
+	s.annotation.EXPECT().GetAnnotations(gomock.Any(), annotations.ID{
+		Kind: annotations.KindModel,
+		Name: "model-uuid",
+	}).Return(map[string]string{"owner": "admin"}, nil)
+	s.annotation.EXPECT().GetAnnotationsForKind(gomock.Any(), annotations.KindMachine).Return(nil, nil)
+	s.annotation.EXPECT().GetAnnotationsForKind(gomock.Any(), annotations.KindApplication).Return(map[string]map[string]string{
+		"mysql": {"tier": "db"},
+	}, nil)
+	s.annotation.EXPECT().GetAnnotationsForKind(gomock.Any(), annotations.KindUnit).Return(nil, nil)
+
+	entities, kinds, err := s.newBackend().Entities(c.Context(), nil)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(kinds, tc.DeepEquals, allKinds)
+	c.Check(entities, tc.DeepEquals, []params.EntityInfo{
+		&params.MachineInfo{
+			ModelUUID:      "model-uuid",
+			Id:             "0",
+			InstanceId:     "inst-0",
+			AgentStatus:    params.StatusInfo{Current: corestatus.Started},
+			InstanceStatus: params.StatusInfo{Current: corestatus.Running},
+			Life:           life.Alive,
+			Base:           "ubuntu@24.04",
+			Addresses:      []params.Address{{Value: "10.0.0.1"}},
+			Hostname:       "host-0",
+		},
+		&params.ApplicationInfo{
+			ModelUUID: "model-uuid",
+			Name:      "mysql",
+			Exposed:   true,
+			CharmURL:  "ch:amd64/mysql-42",
+			Life:      life.Alive,
+			Status:    params.StatusInfo{Current: corestatus.Active},
+		},
+		&params.UnitInfo{
+			ModelUUID:      "model-uuid",
+			Name:           "mysql/0",
+			Application:    "mysql",
+			Base:           "ubuntu@24.04",
+			CharmURL:       "ch:amd64/mysql-42",
+			Life:           life.Alive,
+			MachineId:      "0",
+			PortRanges:     []params.PortRange{{FromPort: 3306, ToPort: 3306, Protocol: "tcp"}},
+			WorkloadStatus: params.StatusInfo{Current: corestatus.Active},
+			AgentStatus:    params.StatusInfo{Current: corestatus.Idle},
+		},
+		&params.RelationInfo{
+			ModelUUID: "model-uuid",
+			Key:       "mysql:cluster",
+			Id:        7,
+			Life:      life.Alive,
+			Status:    params.StatusInfo{Current: corestatus.Joined},
+			Endpoints: []params.RelationEndpoint{{
+				ApplicationName: "mysql",
+				Relation: params.CharmRelation{
+					Name:      "cluster",
+					Role:      "peer",
+					Interface: "mysql-ha",
+					Scope:     "global",
+				},
+			}},
+		},
+		&params.AnnotationInfo{
+			ModelUUID:   "model-uuid",
+			Tag:         "model-model-uuid",
+			Annotations: map[string]string{"owner": "admin"},
+		},
+		&params.AnnotationInfo{
+			ModelUUID:   "model-uuid",
+			Tag:         "application-mysql",
+			Annotations: map[string]string{"tier": "db"},
+		},
+	})
+}
+
+func (s *backendSuite) TestEntitiesForNamespaces(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.status.EXPECT().GetMachineFullStatuses(gomock.Any()).Return(map[machine.Name]statusservice.Machine{
+		"0": {Life: life.Alive},
+	}, nil)
+	s.annotation.EXPECT().GetAnnotations(gomock.Any(), annotations.ID{
+		Kind: annotations.KindModel,
+		Name: "model-uuid",
+	}).Return(nil, nil)
+	s.annotation.EXPECT().GetAnnotationsForKind(gomock.Any(), gomock.Any()).Return(nil, nil).Times(3)
+
+	// Only the machines and annotations are read.
+	entities, kinds, err := s.newBackend().Entities(c.Context(), []string{"machine_status", "annotation_unit"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(kinds, tc.DeepEquals, []string{params.MachineEntityKind, params.AnnotationEntityKind})
+	c.Check(entities, tc.DeepEquals, []params.EntityInfo{
+		&params.MachineInfo{
+			ModelUUID: "model-uuid",
+			Id:        "0",
+			Life:      life.Alive,
+		},
+	})
+}
+
+func (s *backendSuite) TestEntitiesForUnknownNamespace(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.status.EXPECT().GetMachineFullStatuses(gomock.Any()).Return(nil, nil)
+	s.status.EXPECT().GetApplicationAndUnitStatuses(gomock.Any()).Return(nil, nil)
+	s.status.EXPECT().GetAllRelationStatuses(gomock.Any()).Return(nil, nil)
+	s.port.EXPECT().GetAllOpenedPorts(gomock.Any()).Return(nil, nil)
+	s.crossModelRelation.EXPECT().GetRemoteApplicationConsumers(gomock.Any()).Return(nil, nil)
+	s.relation.EXPECT().GetAllRelationDetails(gomock.Any()).Return(nil, nil)
+	s.annotation.EXPECT().GetAnnotations(gomock.Any(), gomock.Any()).Return(nil, nil)
+	s.annotation.EXPECT().GetAnnotationsForKind(gomock.Any(), gomock.Any()).Return(nil, nil).Times(3)
+
+	// A namespace which holds no known entities causes everything to be
+	// read.
+	_, kinds, err := s.newBackend().Entities(c.Context(), []string{"machine", "charm"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(kinds, tc.DeepEquals, allKinds)
+}
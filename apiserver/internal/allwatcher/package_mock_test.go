@@ -0,0 +1,697 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/apiserver/internal/allwatcher (interfaces: Backend,ModelLister,StatusService,RelationService,AnnotationService,PortService,CrossModelRelationService)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package allwatcher -destination package_mock_test.go github.com/juju/juju/apiserver/internal/allwatcher Backend,ModelLister,StatusService,RelationService,AnnotationService,PortService,CrossModelRelationService
+//
+
+// Package allwatcher is a generated GoMock package.
+package allwatcher
+
+import (
+	context "context"
+	reflect "reflect"
+
+	annotations "github.com/juju/juju/core/annotations"
+	machine "github.com/juju/juju/core/machine"
+	model "github.com/juju/juju/core/model"
+	relation "github.com/juju/juju/core/relation"
+	status "github.com/juju/juju/core/status"
+	watcher "github.com/juju/juju/core/watcher"
+	crossmodelrelation "github.com/juju/juju/domain/crossmodelrelation"
+	port "github.com/juju/juju/domain/port"
+	relation0 "github.com/juju/juju/domain/relation"
+	service "github.com/juju/juju/domain/status/service"
+	params "github.com/juju/juju/rpc/params"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBackend is a mock of Backend interface.
+type MockBackend struct {
+	ctrl     *gomock.Controller
+	recorder *MockBackendMockRecorder
+}
+
+// MockBackendMockRecorder is the mock recorder for MockBackend.
+type MockBackendMockRecorder struct {
+	mock *MockBackend
+}
+
+// NewMockBackend creates a new mock instance.
+func NewMockBackend(ctrl *gomock.Controller) *MockBackend {
+	mock := &MockBackend{ctrl: ctrl}
+	mock.recorder = &MockBackendMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBackend) EXPECT() *MockBackendMockRecorder {
+	return m.recorder
+}
+
+// Entities mocks base method.
+func (m *MockBackend) Entities(arg0 context.Context, arg1 []string) ([]params.EntityInfo, []string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Entities", arg0, arg1)
+	ret0, _ := ret[0].([]params.EntityInfo)
+	ret1, _ := ret[1].([]string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Entities indicates an expected call of Entities.
+func (mr *MockBackendMockRecorder) Entities(arg0, arg1 any) *MockBackendEntitiesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Entities", reflect.TypeOf((*MockBackend)(nil).Entities), arg0, arg1)
+	return &MockBackendEntitiesCall{Call: call}
+}
+
+// MockBackendEntitiesCall wrap *gomock.Call
+type MockBackendEntitiesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBackendEntitiesCall) Return(arg0 []params.EntityInfo, arg1 []string, arg2 error) *MockBackendEntitiesCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBackendEntitiesCall) Do(f func(context.Context, []string) ([]params.EntityInfo, []string, error)) *MockBackendEntitiesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBackendEntitiesCall) DoAndReturn(f func(context.Context, []string) ([]params.EntityInfo, []string, error)) *MockBackendEntitiesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// WatchChanges mocks base method.
+func (m *MockBackend) WatchChanges(arg0 context.Context) (watcher.Watcher[[]string], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchChanges", arg0)
+	ret0, _ := ret[0].(watcher.Watcher[[]string])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchChanges indicates an expected call of WatchChanges.
+func (mr *MockBackendMockRecorder) WatchChanges(arg0 any) *MockBackendWatchChangesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchChanges", reflect.TypeOf((*MockBackend)(nil).WatchChanges), arg0)
+	return &MockBackendWatchChangesCall{Call: call}
+}
+
+// MockBackendWatchChangesCall wrap *gomock.Call
+type MockBackendWatchChangesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockBackendWatchChangesCall) Return(arg0 watcher.Watcher[[]string], arg1 error) *MockBackendWatchChangesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockBackendWatchChangesCall) Do(f func(context.Context) (watcher.Watcher[[]string], error)) *MockBackendWatchChangesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockBackendWatchChangesCall) DoAndReturn(f func(context.Context) (watcher.Watcher[[]string], error)) *MockBackendWatchChangesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockModelLister is a mock of ModelLister interface.
+type MockModelLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockModelListerMockRecorder
+}
+
+// MockModelListerMockRecorder is the mock recorder for MockModelLister.
+type MockModelListerMockRecorder struct {
+	mock *MockModelLister
+}
+
+// NewMockModelLister creates a new mock instance.
+func NewMockModelLister(ctrl *gomock.Controller) *MockModelLister {
+	mock := &MockModelLister{ctrl: ctrl}
+	mock.recorder = &MockModelListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModelLister) EXPECT() *MockModelListerMockRecorder {
+	return m.recorder
+}
+
+// ListModelUUIDs mocks base method.
+func (m *MockModelLister) ListModelUUIDs(arg0 context.Context) ([]model.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListModelUUIDs", arg0)
+	ret0, _ := ret[0].([]model.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListModelUUIDs indicates an expected call of ListModelUUIDs.
+func (mr *MockModelListerMockRecorder) ListModelUUIDs(arg0 any) *MockModelListerListModelUUIDsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListModelUUIDs", reflect.TypeOf((*MockModelLister)(nil).ListModelUUIDs), arg0)
+	return &MockModelListerListModelUUIDsCall{Call: call}
+}
+
+// MockModelListerListModelUUIDsCall wrap *gomock.Call
+type MockModelListerListModelUUIDsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelListerListModelUUIDsCall) Return(arg0 []model.UUID, arg1 error) *MockModelListerListModelUUIDsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelListerListModelUUIDsCall) Do(f func(context.Context) ([]model.UUID, error)) *MockModelListerListModelUUIDsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelListerListModelUUIDsCall) DoAndReturn(f func(context.Context) ([]model.UUID, error)) *MockModelListerListModelUUIDsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// WatchModels mocks base method.
+func (m *MockModelLister) WatchModels(arg0 context.Context) (watcher.Watcher[struct{}], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchModels", arg0)
+	ret0, _ := ret[0].(watcher.Watcher[struct{}])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchModels indicates an expected call of WatchModels.
+func (mr *MockModelListerMockRecorder) WatchModels(arg0 any) *MockModelListerWatchModelsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchModels", reflect.TypeOf((*MockModelLister)(nil).WatchModels), arg0)
+	return &MockModelListerWatchModelsCall{Call: call}
+}
+
+// MockModelListerWatchModelsCall wrap *gomock.Call
+type MockModelListerWatchModelsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelListerWatchModelsCall) Return(arg0 watcher.Watcher[struct{}], arg1 error) *MockModelListerWatchModelsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelListerWatchModelsCall) Do(f func(context.Context) (watcher.Watcher[struct{}], error)) *MockModelListerWatchModelsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelListerWatchModelsCall) DoAndReturn(f func(context.Context) (watcher.Watcher[struct{}], error)) *MockModelListerWatchModelsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockStatusService is a mock of StatusService interface.
+type MockStatusService struct {
+	ctrl     *gomock.Controller
+	recorder *MockStatusServiceMockRecorder
+}
+
+// MockStatusServiceMockRecorder is the mock recorder for MockStatusService.
+type MockStatusServiceMockRecorder struct {
+	mock *MockStatusService
+}
+
+// NewMockStatusService creates a new mock instance.
+func NewMockStatusService(ctrl *gomock.Controller) *MockStatusService {
+	mock := &MockStatusService{ctrl: ctrl}
+	mock.recorder = &MockStatusServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStatusService) EXPECT() *MockStatusServiceMockRecorder {
+	return m.recorder
+}
+
+// GetAllRelationStatuses mocks base method.
+func (m *MockStatusService) GetAllRelationStatuses(arg0 context.Context) (map[relation.UUID]status.StatusInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllRelationStatuses", arg0)
+	ret0, _ := ret[0].(map[relation.UUID]status.StatusInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllRelationStatuses indicates an expected call of GetAllRelationStatuses.
+func (mr *MockStatusServiceMockRecorder) GetAllRelationStatuses(arg0 any) *MockStatusServiceGetAllRelationStatusesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllRelationStatuses", reflect.TypeOf((*MockStatusService)(nil).GetAllRelationStatuses), arg0)
+	return &MockStatusServiceGetAllRelationStatusesCall{Call: call}
+}
+
+// MockStatusServiceGetAllRelationStatusesCall wrap *gomock.Call
+type MockStatusServiceGetAllRelationStatusesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatusServiceGetAllRelationStatusesCall) Return(arg0 map[relation.UUID]status.StatusInfo, arg1 error) *MockStatusServiceGetAllRelationStatusesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatusServiceGetAllRelationStatusesCall) Do(f func(context.Context) (map[relation.UUID]status.StatusInfo, error)) *MockStatusServiceGetAllRelationStatusesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatusServiceGetAllRelationStatusesCall) DoAndReturn(f func(context.Context) (map[relation.UUID]status.StatusInfo, error)) *MockStatusServiceGetAllRelationStatusesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetApplicationAndUnitStatuses mocks base method.
+func (m *MockStatusService) GetApplicationAndUnitStatuses(arg0 context.Context) (map[string]service.Application, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationAndUnitStatuses", arg0)
+	ret0, _ := ret[0].(map[string]service.Application)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationAndUnitStatuses indicates an expected call of GetApplicationAndUnitStatuses.
+func (mr *MockStatusServiceMockRecorder) GetApplicationAndUnitStatuses(arg0 any) *MockStatusServiceGetApplicationAndUnitStatusesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationAndUnitStatuses", reflect.TypeOf((*MockStatusService)(nil).GetApplicationAndUnitStatuses), arg0)
+	return &MockStatusServiceGetApplicationAndUnitStatusesCall{Call: call}
+}
+
+// MockStatusServiceGetApplicationAndUnitStatusesCall wrap *gomock.Call
+type MockStatusServiceGetApplicationAndUnitStatusesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatusServiceGetApplicationAndUnitStatusesCall) Return(arg0 map[string]service.Application, arg1 error) *MockStatusServiceGetApplicationAndUnitStatusesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatusServiceGetApplicationAndUnitStatusesCall) Do(f func(context.Context) (map[string]service.Application, error)) *MockStatusServiceGetApplicationAndUnitStatusesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatusServiceGetApplicationAndUnitStatusesCall) DoAndReturn(f func(context.Context) (map[string]service.Application, error)) *MockStatusServiceGetApplicationAndUnitStatusesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetMachineFullStatuses mocks base method.
+func (m *MockStatusService) GetMachineFullStatuses(arg0 context.Context) (map[machine.Name]service.Machine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMachineFullStatuses", arg0)
+	ret0, _ := ret[0].(map[machine.Name]service.Machine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMachineFullStatuses indicates an expected call of GetMachineFullStatuses.
+func (mr *MockStatusServiceMockRecorder) GetMachineFullStatuses(arg0 any) *MockStatusServiceGetMachineFullStatusesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMachineFullStatuses", reflect.TypeOf((*MockStatusService)(nil).GetMachineFullStatuses), arg0)
+	return &MockStatusServiceGetMachineFullStatusesCall{Call: call}
+}
+
+// MockStatusServiceGetMachineFullStatusesCall wrap *gomock.Call
+type MockStatusServiceGetMachineFullStatusesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatusServiceGetMachineFullStatusesCall) Return(arg0 map[machine.Name]service.Machine, arg1 error) *MockStatusServiceGetMachineFullStatusesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatusServiceGetMachineFullStatusesCall) Do(f func(context.Context) (map[machine.Name]service.Machine, error)) *MockStatusServiceGetMachineFullStatusesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatusServiceGetMachineFullStatusesCall) DoAndReturn(f func(context.Context) (map[machine.Name]service.Machine, error)) *MockStatusServiceGetMachineFullStatusesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// WatchModelEntityChanges mocks base method.
+func (m *MockStatusService) WatchModelEntityChanges(arg0 context.Context) (watcher.Watcher[[]string], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchModelEntityChanges", arg0)
+	ret0, _ := ret[0].(watcher.Watcher[[]string])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchModelEntityChanges indicates an expected call of WatchModelEntityChanges.
+func (mr *MockStatusServiceMockRecorder) WatchModelEntityChanges(arg0 any) *MockStatusServiceWatchModelEntityChangesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchModelEntityChanges", reflect.TypeOf((*MockStatusService)(nil).WatchModelEntityChanges), arg0)
+	return &MockStatusServiceWatchModelEntityChangesCall{Call: call}
+}
+
+// MockStatusServiceWatchModelEntityChangesCall wrap *gomock.Call
+type MockStatusServiceWatchModelEntityChangesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatusServiceWatchModelEntityChangesCall) Return(arg0 watcher.Watcher[[]string], arg1 error) *MockStatusServiceWatchModelEntityChangesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatusServiceWatchModelEntityChangesCall) Do(f func(context.Context) (watcher.Watcher[[]string], error)) *MockStatusServiceWatchModelEntityChangesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatusServiceWatchModelEntityChangesCall) DoAndReturn(f func(context.Context) (watcher.Watcher[[]string], error)) *MockStatusServiceWatchModelEntityChangesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockRelationService is a mock of RelationService interface.
+type MockRelationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRelationServiceMockRecorder
+}
+
+// MockRelationServiceMockRecorder is the mock recorder for MockRelationService.
+type MockRelationServiceMockRecorder struct {
+	mock *MockRelationService
+}
+
+// NewMockRelationService creates a new mock instance.
+func NewMockRelationService(ctrl *gomock.Controller) *MockRelationService {
+	mock := &MockRelationService{ctrl: ctrl}
+	mock.recorder = &MockRelationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRelationService) EXPECT() *MockRelationServiceMockRecorder {
+	return m.recorder
+}
+
+// GetAllRelationDetails mocks base method.
+func (m *MockRelationService) GetAllRelationDetails(arg0 context.Context) ([]relation0.RelationDetailsResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllRelationDetails", arg0)
+	ret0, _ := ret[0].([]relation0.RelationDetailsResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllRelationDetails indicates an expected call of GetAllRelationDetails.
+func (mr *MockRelationServiceMockRecorder) GetAllRelationDetails(arg0 any) *MockRelationServiceGetAllRelationDetailsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllRelationDetails", reflect.TypeOf((*MockRelationService)(nil).GetAllRelationDetails), arg0)
+	return &MockRelationServiceGetAllRelationDetailsCall{Call: call}
+}
+
+// MockRelationServiceGetAllRelationDetailsCall wrap *gomock.Call
+type MockRelationServiceGetAllRelationDetailsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRelationServiceGetAllRelationDetailsCall) Return(arg0 []relation0.RelationDetailsResult, arg1 error) *MockRelationServiceGetAllRelationDetailsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRelationServiceGetAllRelationDetailsCall) Do(f func(context.Context) ([]relation0.RelationDetailsResult, error)) *MockRelationServiceGetAllRelationDetailsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRelationServiceGetAllRelationDetailsCall) DoAndReturn(f func(context.Context) ([]relation0.RelationDetailsResult, error)) *MockRelationServiceGetAllRelationDetailsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockAnnotationService is a mock of AnnotationService interface.
+type MockAnnotationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAnnotationServiceMockRecorder
+}
+
+// MockAnnotationServiceMockRecorder is the mock recorder for MockAnnotationService.
+type MockAnnotationServiceMockRecorder struct {
+	mock *MockAnnotationService
+}
+
+// NewMockAnnotationService creates a new mock instance.
+func NewMockAnnotationService(ctrl *gomock.Controller) *MockAnnotationService {
+	mock := &MockAnnotationService{ctrl: ctrl}
+	mock.recorder = &MockAnnotationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAnnotationService) EXPECT() *MockAnnotationServiceMockRecorder {
+	return m.recorder
+}
+
+// GetAnnotations mocks base method.
+func (m *MockAnnotationService) GetAnnotations(arg0 context.Context, arg1 annotations.ID) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAnnotations", arg0, arg1)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAnnotations indicates an expected call of GetAnnotations.
+func (mr *MockAnnotationServiceMockRecorder) GetAnnotations(arg0, arg1 any) *MockAnnotationServiceGetAnnotationsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnnotations", reflect.TypeOf((*MockAnnotationService)(nil).GetAnnotations), arg0, arg1)
+	return &MockAnnotationServiceGetAnnotationsCall{Call: call}
+}
+
+// MockAnnotationServiceGetAnnotationsCall wrap *gomock.Call
+type MockAnnotationServiceGetAnnotationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAnnotationServiceGetAnnotationsCall) Return(arg0 map[string]string, arg1 error) *MockAnnotationServiceGetAnnotationsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAnnotationServiceGetAnnotationsCall) Do(f func(context.Context, annotations.ID) (map[string]string, error)) *MockAnnotationServiceGetAnnotationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAnnotationServiceGetAnnotationsCall) DoAndReturn(f func(context.Context, annotations.ID) (map[string]string, error)) *MockAnnotationServiceGetAnnotationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetAnnotationsForKind mocks base method.
+func (m *MockAnnotationService) GetAnnotationsForKind(arg0 context.Context, arg1 annotations.Kind) (map[string]map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAnnotationsForKind", arg0, arg1)
+	ret0, _ := ret[0].(map[string]map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAnnotationsForKind indicates an expected call of GetAnnotationsForKind.
+func (mr *MockAnnotationServiceMockRecorder) GetAnnotationsForKind(arg0, arg1 any) *MockAnnotationServiceGetAnnotationsForKindCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnnotationsForKind", reflect.TypeOf((*MockAnnotationService)(nil).GetAnnotationsForKind), arg0, arg1)
+	return &MockAnnotationServiceGetAnnotationsForKindCall{Call: call}
+}
+
+// MockAnnotationServiceGetAnnotationsForKindCall wrap *gomock.Call
+type MockAnnotationServiceGetAnnotationsForKindCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAnnotationServiceGetAnnotationsForKindCall) Return(arg0 map[string]map[string]string, arg1 error) *MockAnnotationServiceGetAnnotationsForKindCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAnnotationServiceGetAnnotationsForKindCall) Do(f func(context.Context, annotations.Kind) (map[string]map[string]string, error)) *MockAnnotationServiceGetAnnotationsForKindCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAnnotationServiceGetAnnotationsForKindCall) DoAndReturn(f func(context.Context, annotations.Kind) (map[string]map[string]string, error)) *MockAnnotationServiceGetAnnotationsForKindCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockPortService is a mock of PortService interface.
+type MockPortService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPortServiceMockRecorder
+}
+
+// MockPortServiceMockRecorder is the mock recorder for MockPortService.
+type MockPortServiceMockRecorder struct {
+	mock *MockPortService
+}
+
+// NewMockPortService creates a new mock instance.
+func NewMockPortService(ctrl *gomock.Controller) *MockPortService {
+	mock := &MockPortService{ctrl: ctrl}
+	mock.recorder = &MockPortServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPortService) EXPECT() *MockPortServiceMockRecorder {
+	return m.recorder
+}
+
+// GetAllOpenedPorts mocks base method.
+func (m *MockPortService) GetAllOpenedPorts(arg0 context.Context) (port.UnitGroupedPortRanges, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllOpenedPorts", arg0)
+	ret0, _ := ret[0].(port.UnitGroupedPortRanges)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllOpenedPorts indicates an expected call of GetAllOpenedPorts.
+func (mr *MockPortServiceMockRecorder) GetAllOpenedPorts(arg0 any) *MockPortServiceGetAllOpenedPortsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllOpenedPorts", reflect.TypeOf((*MockPortService)(nil).GetAllOpenedPorts), arg0)
+	return &MockPortServiceGetAllOpenedPortsCall{Call: call}
+}
+
+// MockPortServiceGetAllOpenedPortsCall wrap *gomock.Call
+type MockPortServiceGetAllOpenedPortsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockPortServiceGetAllOpenedPortsCall) Return(arg0 port.UnitGroupedPortRanges, arg1 error) *MockPortServiceGetAllOpenedPortsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockPortServiceGetAllOpenedPortsCall) Do(f func(context.Context) (port.UnitGroupedPortRanges, error)) *MockPortServiceGetAllOpenedPortsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockPortServiceGetAllOpenedPortsCall) DoAndReturn(f func(context.Context) (port.UnitGroupedPortRanges, error)) *MockPortServiceGetAllOpenedPortsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockCrossModelRelationService is a mock of CrossModelRelationService interface.
+type MockCrossModelRelationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCrossModelRelationServiceMockRecorder
+}
+
+// MockCrossModelRelationServiceMockRecorder is the mock recorder for MockCrossModelRelationService.
+type MockCrossModelRelationServiceMockRecorder struct {
+	mock *MockCrossModelRelationService
+}
+
+// NewMockCrossModelRelationService creates a new mock instance.
+func NewMockCrossModelRelationService(ctrl *gomock.Controller) *MockCrossModelRelationService {
+	mock := &MockCrossModelRelationService{ctrl: ctrl}
+	mock.recorder = &MockCrossModelRelationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCrossModelRelationService) EXPECT() *MockCrossModelRelationServiceMockRecorder {
+	return m.recorder
+}
+
+// GetRemoteApplicationConsumers mocks base method.
+func (m *MockCrossModelRelationService) GetRemoteApplicationConsumers(arg0 context.Context) ([]crossmodelrelation.RemoteApplicationConsumer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRemoteApplicationConsumers", arg0)
+	ret0, _ := ret[0].([]crossmodelrelation.RemoteApplicationConsumer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRemoteApplicationConsumers indicates an expected call of GetRemoteApplicationConsumers.
+func (mr *MockCrossModelRelationServiceMockRecorder) GetRemoteApplicationConsumers(arg0 any) *MockCrossModelRelationServiceGetRemoteApplicationConsumersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRemoteApplicationConsumers", reflect.TypeOf((*MockCrossModelRelationService)(nil).GetRemoteApplicationConsumers), arg0)
+	return &MockCrossModelRelationServiceGetRemoteApplicationConsumersCall{Call: call}
+}
+
+// MockCrossModelRelationServiceGetRemoteApplicationConsumersCall wrap *gomock.Call
+type MockCrossModelRelationServiceGetRemoteApplicationConsumersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCrossModelRelationServiceGetRemoteApplicationConsumersCall) Return(arg0 []crossmodelrelation.RemoteApplicationConsumer, arg1 error) *MockCrossModelRelationServiceGetRemoteApplicationConsumersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCrossModelRelationServiceGetRemoteApplicationConsumersCall) Do(f func(context.Context) ([]crossmodelrelation.RemoteApplicationConsumer, error)) *MockCrossModelRelationServiceGetRemoteApplicationConsumersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCrossModelRelationServiceGetRemoteApplicationConsumersCall) DoAndReturn(f func(context.Context) ([]crossmodelrelation.RemoteApplicationConsumer, error)) *MockCrossModelRelationServiceGetRemoteApplicationConsumersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
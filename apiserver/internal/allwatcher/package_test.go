@@ -0,0 +1,6 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package allwatcher
+
+//go:generate go run go.uber.org/mock/mockgen -typed -package allwatcher -destination package_mock_test.go github.com/juju/juju/apiserver/internal/allwatcher Backend,ModelLister,StatusService,RelationService,AnnotationService,PortService,CrossModelRelationService
@@ -32,6 +32,7 @@ import (
 	"github.com/juju/juju/domain/modelmigration"
 	"github.com/juju/juju/internal/migration"
 	"github.com/juju/juju/internal/rpcreflect"
+	"github.com/juju/juju/internal/secrets/encryptionkey"
 	"github.com/juju/juju/internal/services"
 	"github.com/juju/juju/internal/storage"
 	"github.com/juju/juju/internal/worker/watcherregistry"
@@ -866,6 +867,7 @@ func (ctx *facadeContext) ModelExporter(c context.Context, modelUUID model.UUID)
 			return storageService.GetStorageRegistry(ctx)
 		}),
 		objectStoreGetter,
+		encryptionkey.NewSource(ctx.r.shared.dataDir),
 		clock,
 		logger,
 	)
@@ -897,6 +899,7 @@ func (ctx *facadeContext) ModelImporter() facade.ModelImporter {
 		modelObjectStore(func(stdCtx context.Context) (objectstore.ObjectStore, error) {
 			return ctx.r.objectStoreGetter.GetObjectStore(stdCtx, ctx.ModelUUID().String())
 		}),
+		encryptionkey.NewSource(ctx.r.shared.dataDir),
 		ctx.Logger(),
 		ctx.r.clock,
 	)
@@ -12,6 +12,7 @@ import (
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
 	"github.com/juju/juju/apiserver/internal"
+	"github.com/juju/juju/apiserver/internal/allwatcher"
 	coresecrets "github.com/juju/juju/core/secrets"
 	corewatcher "github.com/juju/juju/core/watcher"
 	secreterrors "github.com/juju/juju/domain/secret/errors"
@@ -274,6 +275,53 @@ func (w *srvEntitiesWatcher) Next(ctx context.Context) (params.EntitiesWatchResu
 	}, nil
 }
 
+// newAllWatcher returns a new API server endpoint for interacting with a
+// watcher created by the WatchAll and WatchAllModels API calls.
+func newAllWatcher(_ context.Context, context facade.ModelContext) (facade.Facade, error) {
+	var (
+		id              = context.ID()
+		auth            = context.Auth()
+		watcherRegistry = context.WatcherRegistry()
+	)
+	if !auth.AuthClient() {
+		// As with the model summary watcher, the permission check is made
+		// when the watcher is created, by either WatchAll (model read
+		// access) or WatchAllModels (controller superuser access).
+		return nil, apiservererrors.ErrPerm
+	}
+	w, err := watcherRegistry.Get(id)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	watcher, ok := w.(allwatcher.AllWatcher)
+	if !ok {
+		return nil, errors.Annotatef(apiservererrors.ErrUnknownWatcher, "watcher id: %s", id)
+	}
+	return &srvAllWatcher{
+		watcherCommon: newWatcherCommon(context),
+		watcher:       watcher,
+	}, nil
+}
+
+// srvAllWatcher defines the API methods on an all-watcher, which reports
+// the changes to the entities of one or more models.
+type srvAllWatcher struct {
+	watcherCommon
+	watcher allwatcher.AllWatcher
+}
+
+// Next will return the current state of everything on the first call
+// and subsequent calls will return just those entities that have changed.
+func (w *srvAllWatcher) Next(ctx context.Context) (params.AllWatcherNextResults, error) {
+	deltas, err := internal.FirstResult[[]params.Delta](ctx, w.watcher)
+	if err != nil {
+		return params.AllWatcherNextResults{}, errors.Trace(err)
+	}
+	return params.AllWatcherNextResults{
+		Deltas: deltas,
+	}, nil
+}
+
 // newModelSummaryWatcher exists solely to be registered with regRaw.
 // Standard registration doesn't handle watcher types (it checks for
 // and empty ID in the context).
@@ -0,0 +1,185 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/api/client/application"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/internal/storage"
+)
+
+// NewApplicationStorageCommand returns a command which shows or updates the
+// storage directives of an application.
+func NewApplicationStorageCommand() modelcmd.ModelCommand {
+	cmd := &applicationStorageCommand{}
+	cmd.newAPIFunc = func(ctx context.Context) (applicationStorageAPI, error) {
+		root, err := cmd.NewAPIRoot(ctx)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return application.NewClient(root), nil
+	}
+	return modelcmd.Wrap(cmd)
+}
+
+type applicationStorageAPI interface {
+	Close() error
+	GetApplicationStorage(context.Context, string) (map[string]storage.Directive, error)
+	UpdateApplicationStorage(context.Context, string, map[string]storage.Directive) error
+}
+
+// applicationStorageCommand shows or updates the storage directives of an
+// application.
+type applicationStorageCommand struct {
+	modelcmd.ModelCommandBase
+
+	newAPIFunc func(ctx context.Context) (applicationStorageAPI, error)
+	out        cmd.Output
+
+	applicationName string
+	directives      map[string]storage.Directive
+}
+
+const applicationStorageDoc = `
+Shows or updates the storage directives of an application.
+
+Storage directives determine the pool, size and count of the storage
+provisioned for units added to the application in the future. Storage of
+existing units is not changed.
+
+A directive is given as <storage-name>=<directive>, where <directive> is a
+comma separated sequence of any of a pool name, a count and a size. Only the
+values given are changed; the rest of the directive is left as it is. The
+directive is validated against the storage defined by the application's charm.
+
+Without directives, the current storage directives of the application are
+shown.
+`
+
+const applicationStorageExamples = `
+    juju application-storage postgresql
+    juju application-storage postgresql pgdata=fast
+    juju application-storage postgresql pgdata=ebs,100G,2
+`
+
+// Info implements cmd.Command.
+func (c *applicationStorageCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "application-storage",
+		Args:     "<application> [<storage-name>=<pool>,<size>,<count> ...]",
+		Purpose:  "Show or update the storage directives of an application.",
+		Doc:      applicationStorageDoc,
+		Examples: applicationStorageExamples,
+		SeeAlso: []string{
+			"add-storage",
+			"deploy",
+			"storage",
+			"storage-pools",
+		},
+	})
+}
+
+// SetFlags implements cmd.Command.
+func (c *applicationStorageCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	c.out.AddFlags(f, "yaml", map[string]cmd.Formatter{
+		"yaml": cmd.FormatYaml,
+		"json": cmd.FormatJson,
+	})
+}
+
+// Init implements cmd.Command.
+func (c *applicationStorageCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.Errorf("no application specified")
+	}
+	c.applicationName = args[0]
+	if !names.IsValidApplication(c.applicationName) {
+		return errors.Errorf("invalid application name %q", c.applicationName)
+	}
+	if len(args) == 1 {
+		return nil
+	}
+
+	directives, err := storage.ParseDirectivesMap(args[1:], true)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	// A parsed directive defaults the count to 1 when it isn't given, which
+	// would reset the count of the existing directive. Only send the count
+	// when it has been asked for.
+	for _, arg := range args[1:] {
+		name, value, _ := strings.Cut(arg, "=")
+		if !hasStorageCount(value) {
+			d := directives[name]
+			d.Count = 0
+			directives[name] = d
+		}
+	}
+	c.directives = directives
+	return nil
+}
+
+// hasStorageCount reports whether the storage directive string includes a
+// count.
+func hasStorageCount(directive string) bool {
+	for _, field := range strings.Split(directive, ",") {
+		if _, err := strconv.ParseUint(field, 10, 64); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// formattedStorageDirective is the output format of a storage directive of
+// an application.
+type formattedStorageDirective struct {
+	Pool  string `yaml:"pool" json:"pool"`
+	Size  string `yaml:"size" json:"size"`
+	Count uint64 `yaml:"count" json:"count"`
+}
+
+// Run implements cmd.Command.
+func (c *applicationStorageCommand) Run(ctx *cmd.Context) error {
+	client, err := c.newAPIFunc(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if len(c.directives) == 0 {
+		directives, err := client.GetApplicationStorage(ctx, c.applicationName)
+		if err != nil {
+			return errors.Annotatef(err, "cannot get storage directives of %q", c.applicationName)
+		}
+		out := make(map[string]formattedStorageDirective, len(directives))
+		for name, d := range directives {
+			out[name] = formattedStorageDirective{
+				Pool:  d.Pool,
+				Size:  fmt.Sprintf("%dM", d.Size),
+				Count: d.Count,
+			}
+		}
+		return c.out.Write(ctx, out)
+	}
+
+	err = client.UpdateApplicationStorage(ctx, c.applicationName, c.directives)
+	if err != nil {
+		return block.ProcessBlockedError(
+			errors.Annotatef(err, "cannot update storage directives of %q", c.applicationName), block.BlockChange)
+	}
+	return nil
+}
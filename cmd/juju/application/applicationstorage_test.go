@@ -0,0 +1,143 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/api/jujuclient/jujuclienttesting"
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/internal/storage"
+	"github.com/juju/juju/internal/testhelpers"
+	"github.com/juju/juju/rpc/params"
+)
+
+type ApplicationStorageSuite struct {
+	testhelpers.IsolationSuite
+
+	mockAPI *mockApplicationStorageAPI
+}
+
+func TestApplicationStorageSuite(t *testing.T) {
+	tc.Run(t, &ApplicationStorageSuite{})
+}
+
+type mockApplicationStorageAPI struct {
+	*testhelpers.Stub
+	directives map[string]storage.Directive
+}
+
+func (s *mockApplicationStorageAPI) Close() error {
+	s.MethodCall(s, "Close")
+	return s.NextErr()
+}
+
+func (s *mockApplicationStorageAPI) GetApplicationStorage(ctx context.Context, appName string) (map[string]storage.Directive, error) {
+	s.MethodCall(s, "GetApplicationStorage", appName)
+	return s.directives, s.NextErr()
+}
+
+func (s *mockApplicationStorageAPI) UpdateApplicationStorage(ctx context.Context, appName string, directives map[string]storage.Directive) error {
+	s.MethodCall(s, "UpdateApplicationStorage", appName, directives)
+	return s.NextErr()
+}
+
+func (s *ApplicationStorageSuite) SetUpTest(c *tc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.mockAPI = &mockApplicationStorageAPI{Stub: &testhelpers.Stub{}}
+}
+
+func (s *ApplicationStorageSuite) runApplicationStorage(c *tc.C, args ...string) (*cmd.Context, error) {
+	return cmdtesting.RunCommand(c, NewApplicationStorageCommandForTest(s.mockAPI, jujuclienttesting.MinimalStore()), args...)
+}
+
+func (s *ApplicationStorageSuite) TestInit(c *tc.C) {
+	for _, test := range []struct {
+		args []string
+		err  string
+	}{{
+		args: []string{},
+		err:  `no application specified`,
+	}, {
+		args: []string{"foo/0"},
+		err:  `invalid application name "foo/0"`,
+	}, {
+		args: []string{"foo", "data"},
+		err:  `expected "name=directive" where "directive" must be specified, got "data"`,
+	}, {
+		args: []string{"foo", "data=fast", "data=10G"},
+		err:  `storage "data" specified more than once`,
+	}, {
+		args: []string{"foo", "data=-1"},
+		err:  `cannot parse directive for storage "data": .*`,
+	}, {
+		args: []string{"foo"},
+	}, {
+		args: []string{"foo", "data=fast,10G,2", "logs=1G"},
+	}} {
+		cmd := NewApplicationStorageCommandForTest(s.mockAPI, jujuclienttesting.MinimalStore())
+		err := cmdtesting.InitCommand(cmd, test.args)
+		if test.err == "" {
+			c.Check(err, tc.ErrorIsNil, tc.Commentf("args %v", test.args))
+		} else {
+			c.Check(err, tc.ErrorMatches, test.err, tc.Commentf("args %v", test.args))
+		}
+	}
+}
+
+func (s *ApplicationStorageSuite) TestShow(c *tc.C) {
+	s.mockAPI.directives = map[string]storage.Directive{
+		"data": {Pool: "fast", Size: 10240, Count: 2},
+	}
+
+	ctx, err := s.runApplicationStorage(c, "foo")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `
+data:
+  pool: fast
+  size: 10240M
+  count: 2
+`[1:])
+	s.mockAPI.CheckCall(c, 0, "GetApplicationStorage", "foo")
+}
+
+func (s *ApplicationStorageSuite) TestShowJSON(c *tc.C) {
+	s.mockAPI.directives = map[string]storage.Directive{
+		"data": {Pool: "rootfs", Size: 1024, Count: 1},
+	}
+
+	ctx, err := s.runApplicationStorage(c, "foo", "--format", "json")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `{"data":{"pool":"rootfs","size":"1024M","count":1}}`+"\n")
+}
+
+func (s *ApplicationStorageSuite) TestUpdate(c *tc.C) {
+	_, err := s.runApplicationStorage(c, "foo", "data=fast,10G,2", "logs=1G")
+	c.Assert(err, tc.ErrorIsNil)
+	s.mockAPI.CheckCallNames(c, "UpdateApplicationStorage", "Close")
+	s.mockAPI.CheckCall(c, 0, "UpdateApplicationStorage", "foo", map[string]storage.Directive{
+		"data": {Pool: "fast", Size: 10240, Count: 2},
+		// The count isn't given, so it is left as it is.
+		"logs": {Size: 1024},
+	})
+}
+
+func (s *ApplicationStorageSuite) TestUpdateError(c *tc.C) {
+	s.mockAPI.SetErrors(&params.Error{Code: params.CodeNotSupported, Message: `storage "logs" not supported`})
+
+	_, err := s.runApplicationStorage(c, "foo", "logs=1G")
+	c.Assert(err, tc.ErrorMatches, `cannot update storage directives of "foo": storage "logs" not supported`)
+}
+
+func (s *ApplicationStorageSuite) TestUpdateBlocked(c *tc.C) {
+	s.mockAPI.SetErrors(&params.Error{Code: params.CodeOperationBlocked, Message: "nope"})
+
+	_, err := s.runApplicationStorage(c, "foo", "data=fast")
+	c.Assert(err.Error(), tc.Contains, `cannot update storage directives of "foo": nope`)
+	c.Assert(err.Error(), tc.Contains, `All operations that change model have been disabled for the current model.`)
+}
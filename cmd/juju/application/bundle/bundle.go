@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"os"
 	"reflect"
 	"sort"
 	"strings"
@@ -250,32 +251,71 @@ func applicationConfigValue(key string, valueMap interface{}) (interface{}, erro
 	return value, nil
 }
 
-// ComposeAndVerifyBundle merges base and overlays then verifies the
-// combined bundle data. Returns a slice of errors encountered while
-// processing the bundle. They are for informational purposes and do
-// not require failing the bundle deployment.
-func ComposeAndVerifyBundle(ctx *cmd.Context, base BundleDataSource, pathToOverlays []string) (*charm.BundleData, []error, error) {
+// ReadVariables returns the values of bundle variables read from the YAML
+// files, in order, overridden by the values supplied on the command line.
+func ReadVariables(ctx *cmd.Context, files []string, values map[string]string) (map[string]string, error) {
+	if len(files) == 0 {
+		return values, nil
+	}
+	result := make(map[string]string)
+	for _, file := range files {
+		data, err := os.ReadFile(ctx.AbsPath(file))
+		if err != nil {
+			return nil, errors.Annotatef(err, "reading variables file %q", file)
+		}
+		var fileValues map[string]interface{}
+		if err := yaml.Unmarshal(data, &fileValues); err != nil {
+			return nil, errors.Annotatef(err, "parsing variables file %q", file)
+		}
+		for name, value := range fileValues {
+			switch value.(type) {
+			case map[string]interface{}, []interface{}:
+				return nil, errors.NotValidf("value of variable %q in %q", name, file)
+			case nil:
+				result[name] = ""
+			default:
+				result[name] = fmt.Sprint(value)
+			}
+		}
+	}
+	for name, value := range values {
+		result[name] = value
+	}
+	return result, nil
+}
+
+// ComposeAndVerifyBundle merges base and overlays, after resolving the
+// variables they use, then verifies the combined bundle data. Returns a
+// slice of errors encountered while processing the bundle. They are for
+// informational purposes and do not require failing the bundle deployment.
+func ComposeAndVerifyBundle(
+	ctx *cmd.Context, base BundleDataSource, pathToOverlays []string, variables map[string]string,
+) (*charm.BundleData, []error, error) {
 	verifyConstraints := func(s string) error {
 		_, err := constraints.Parse(s)
 		return err
 	}
-	// verify that the base bundle does not contain image-id constraint
-	err := verifyBaseBundle(base)
-	if err != nil {
-		return nil, nil, errors.Trace(err)
-	}
-
-	var dsList []charm.BundleDataSource
-	unMarshallErrors := make([]error, 0)
-	unMarshallErrors = append(unMarshallErrors, gatherErrors(base)...)
 
-	dsList = append(dsList, base)
+	dsList := []charm.BundleDataSource{base}
 	for _, pathToOverlay := range pathToOverlays {
 		ds, err := charm.LocalBundleDataSource(pathToOverlay)
 		if err != nil {
 			return nil, nil, errors.Annotatef(err, "unable to process overlays")
 		}
 		dsList = append(dsList, ds)
+	}
+	dsList, err := charm.ResolveBundleVariables(variables, dsList...)
+	if err != nil {
+		return nil, nil, errors.Annotatef(err, "resolving bundle variables")
+	}
+
+	// verify that the base bundle does not contain image-id constraint
+	if err := verifyBaseBundle(dsList[0]); err != nil {
+		return nil, nil, errors.Trace(err)
+	}
+
+	unMarshallErrors := make([]error, 0)
+	for _, ds := range dsList {
 		unMarshallErrors = append(unMarshallErrors, gatherErrors(ds)...)
 	}
 
@@ -220,7 +220,7 @@ func (s *composeAndVerifyRepSuite) TestComposeAndVerifyBundleEmpty(c *tc.C) {
 	ctx, err := cmd.DefaultContext()
 	c.Assert(err, tc.ErrorIsNil)
 
-	obtained, _, err := ComposeAndVerifyBundle(ctx, s.bundleDataSource, nil)
+	obtained, _, err := ComposeAndVerifyBundle(ctx, s.bundleDataSource, nil, nil)
 	c.Assert(err, tc.ErrorMatches, ".*bundle is empty not valid")
 	c.Assert(obtained, tc.IsNil)
 }
@@ -235,7 +235,7 @@ func (s *composeAndVerifyRepSuite) TestComposeAndVerifyBundleUnsupportedConstrai
 	ctx, err := cmd.DefaultContext()
 	c.Assert(err, tc.ErrorIsNil)
 
-	obtained, _, err := ComposeAndVerifyBundle(ctx, s.bundleDataSource, nil)
+	obtained, _, err := ComposeAndVerifyBundle(ctx, s.bundleDataSource, nil, nil)
 	c.Assert(err, tc.ErrorMatches, "*'image-id' constraint in a base bundle not supported")
 	c.Assert(obtained, tc.IsNil)
 }
@@ -250,7 +250,7 @@ func (s *composeAndVerifyRepSuite) TestComposeAndVerifyBundleNoOverlay(c *tc.C)
 	ctx, err := cmd.DefaultContext()
 	c.Assert(err, tc.ErrorIsNil)
 
-	obtained, _, err := ComposeAndVerifyBundle(ctx, s.bundleDataSource, nil)
+	obtained, _, err := ComposeAndVerifyBundle(ctx, s.bundleDataSource, nil, nil)
 	c.Assert(err, tc.ErrorIsNil)
 	c.Assert(obtained, tc.DeepEquals, bundleData)
 }
@@ -271,7 +271,7 @@ func (s *composeAndVerifyRepSuite) TestComposeAndVerifyBundleOverlay(c *tc.C) {
 		"blog-title": "magic bundle config",
 	}
 
-	obtained, _, err := ComposeAndVerifyBundle(ctx, s.bundleDataSource, []string{s.overlayFile})
+	obtained, _, err := ComposeAndVerifyBundle(ctx, s.bundleDataSource, []string{s.overlayFile}, nil)
 	c.Assert(err, tc.ErrorIsNil)
 	c.Assert(obtained, tc.DeepEquals, &expected)
 }
@@ -292,7 +292,7 @@ func (s *composeAndVerifyRepSuite) TestComposeAndVerifyBundleOverlayUnsupportedC
 		"blog-title": "magic bundle config",
 	}
 
-	obtained, _, err := ComposeAndVerifyBundle(ctx, s.bundleDataSource, []string{s.overlayFile})
+	obtained, _, err := ComposeAndVerifyBundle(ctx, s.bundleDataSource, []string{s.overlayFile}, nil)
 	c.Assert(err, tc.ErrorMatches, "*'image-id' constraint in a base bundle not supported")
 	c.Assert(obtained, tc.IsNil)
 }
@@ -317,7 +317,7 @@ func (s *composeAndVerifyRepSuite) TestComposeAndVerifyBundleOverlayUnmarshallEr
 		"blog-title": "magic bundle config",
 	}
 
-	obtained, unmarshallErrors, err := ComposeAndVerifyBundle(ctx, s.bundleDataSource, []string{s.overlayFile})
+	obtained, unmarshallErrors, err := ComposeAndVerifyBundle(ctx, s.bundleDataSource, []string{s.overlayFile}, nil)
 	c.Assert(err, tc.ErrorIsNil)
 	c.Assert(obtained, tc.DeepEquals, &expected)
 	c.Assert(unmarshallErrors, tc.HasLen, 1)
@@ -334,10 +334,57 @@ func (s *composeAndVerifyRepSuite) TestComposeAndVerifyBundleWithSeriesStillPass
 	ctx, err := cmd.DefaultContext()
 	c.Assert(err, tc.ErrorIsNil)
 
-	_, _, err = ComposeAndVerifyBundle(ctx, s.bundleDataSource, nil)
+	_, _, err = ComposeAndVerifyBundle(ctx, s.bundleDataSource, nil, nil)
 	c.Assert(err, tc.ErrorIsNil)
 }
 
+func (s *composeAndVerifyRepSuite) TestComposeAndVerifyBundleVariables(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.expectBundleBytes([]byte(variablesBundle))
+	s.expectBasePath()
+	ctx, err := cmd.DefaultContext()
+	c.Assert(err, tc.ErrorIsNil)
+
+	obtained, unmarshallErrors, err := ComposeAndVerifyBundle(ctx, s.bundleDataSource, nil, map[string]string{
+		"units": "3",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(unmarshallErrors, tc.HasLen, 0)
+	c.Check(obtained.Applications["wordpress"].Channel, tc.Equals, "edge")
+	c.Check(obtained.Applications["wordpress"].NumUnits, tc.Equals, 3)
+}
+
+func (s *composeAndVerifyRepSuite) TestComposeAndVerifyBundleUndeclaredVariable(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.expectBundleBytes([]byte(variablesBundle))
+	s.expectBasePath()
+	ctx, err := cmd.DefaultContext()
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, _, err = ComposeAndVerifyBundle(ctx, s.bundleDataSource, nil, map[string]string{
+		"unit": "3",
+	})
+	c.Assert(err, tc.ErrorMatches, `resolving bundle variables: variables unit not declared by the bundle not valid`)
+}
+
+func (s *composeAndVerifyRepSuite) TestReadVariables(c *tc.C) {
+	dir := c.MkDir()
+	first := filepath.Join(dir, "first.yaml")
+	c.Assert(os.WriteFile(first, []byte("units: 2\nchannel: edge\n"), 0644), tc.ErrorIsNil)
+	second := filepath.Join(dir, "second.yaml")
+	c.Assert(os.WriteFile(second, []byte("units: 4\ntrust: true\n"), 0644), tc.ErrorIsNil)
+	ctx, err := cmd.DefaultContext()
+	c.Assert(err, tc.ErrorIsNil)
+
+	values, err := ReadVariables(ctx, []string{first, second}, map[string]string{"channel": "beta"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(values, tc.DeepEquals, map[string]string{
+		"units":   "4",
+		"channel": "beta",
+		"trust":   "true",
+	})
+}
+
 func (s *composeAndVerifyRepSuite) setupOverlayFile(c *tc.C) {
 	s.overlayDir = c.MkDir()
 	s.overlayFile = filepath.Join(s.overlayDir, "config.yaml")
@@ -459,6 +506,20 @@ func (m stringSliceMatcher) String() string {
 	return "match a slice of strings, no matter the order"
 }
 
+const variablesBundle = `
+variables:
+  channel: edge
+  units:
+    type: int
+    default: 1
+default-base: ubuntu@22.04
+applications:
+  wordpress:
+    charm: wordpress
+    channel: ${channel}
+    num_units: ${units}
+`
+
 const unsupportedConstraintBundle = `
 default-base: ubuntu@22.04
 applications:
@@ -25,6 +25,7 @@ import (
 	"github.com/juju/juju/api/client/spaces"
 	commoncharm "github.com/juju/juju/api/common/charm"
 	jujucmd "github.com/juju/juju/cmd"
+	appbundle "github.com/juju/juju/cmd/juju/application/bundle"
 	"github.com/juju/juju/cmd/juju/application/deployer"
 	"github.com/juju/juju/cmd/juju/application/store"
 	"github.com/juju/juju/cmd/juju/block"
@@ -245,6 +246,14 @@ type DeployCommand struct {
 	// configuration to be merged with the main bundle.
 	BundleOverlayFile []string
 
+	// BundleVariables maps the names of variables declared by a bundle to
+	// the values supplied with --var.
+	BundleVariables map[string]string
+
+	// BundleVariableFiles are paths to YAML files of bundle variable
+	// values, which are overridden by values supplied with --var.
+	BundleVariableFiles []string
+
 	// Channel holds the channel to use when obtaining
 	// the charm to be deployed.
 	Channel charm.Channel
@@ -294,6 +303,14 @@ type DeployCommand struct {
 	// Resources is a map of resource name to filename to be uploaded on deploy.
 	Resources map[string]string
 
+	// SignatureFile is the path to a signature over the charm archive's
+	// SHA256 hash.
+	SignatureFile string
+
+	// ResourceSignatureFiles maps OCI image resource names to the path of a
+	// signature over the image's digest.
+	ResourceSignatureFiles map[string]string
+
 	Bindings map[string]string
 
 	// UseExisting machines when deploying the bundle.
@@ -504,6 +521,15 @@ as follows:
 
 Note: If multiple resources are needed, repeat the option.
 
+If the controller's ` + "`charm-signature-policy`" + ` is ` + "`required`" + `, the charm must be
+signed by one of the keys in ` + "`charm-signing-keys`" + `. Use the ` + "`--signature`" + `
+option to provide a file containing an SSH (namespace ` + "`juju-charm`" + `) or
+minisign signature over the hex encoded SHA256 hash of the charm archive, and the
+` + "`--resource-signature`" + ` option to provide a signature over the digest of each
+OCI image resource:
+
+    --resource-signature <resource name>=<signature file>
+
 
 Use the ` + "`--to`" + ` option to deploy to an existing machine or container by
 specifying a "placement directive". The ` + "`status`" + ` command should be used for
@@ -539,6 +565,13 @@ bundle.
 Only top level machines can be mapped in this way, just as only top level
 machines can be defined in the machines section of the bundle.
 
+Bundles and overlays can declare variables in a top level ` + "`variables`" + `
+section, and refer to them as ` + "`${name}`" + `. Use the ` + "`--var`" + ` option to supply
+the value of a variable, or ` + "`--var-file`" + ` to supply the values in a YAML file.
+Variables which are not supplied take the default declared by the bundle.
+
+    juju deploy ./bundle.yaml --var-file staging.yaml --var units=3
+
 When charms that include LXD profiles are deployed the profiles are validated
 for security purposes by allowing only certain configurations and devices. Use
 the ` + "`--force`" + ` option to bypass this check. Doing so is not recommended as it
@@ -605,6 +638,12 @@ attribute of 'gpu=nvidia-tesla-p100':
 Deploy with specific resources:
 
     juju deploy foo --resource bar=/some/file.tgz --resource baz=./docs/cfg.xml
+
+Deploy a signed charm with a signed OCI image resource:
+
+    juju deploy ./foo.charm --signature ./foo.charm.sig \
+       --resource image=registry.example.com/foo@sha256:<digest> \
+       --resource-signature image=./foo-image.sig
 `
 
 func (c *DeployCommand) Info() *cmd.Info {
@@ -640,6 +679,8 @@ func (c *DeployCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.BoolVar(&c.Trust, "trust", false, "Allows charm to run hooks that require access credentials")
 
 	f.Var(cmd.NewAppendStringsValue(&c.BundleOverlayFile), "overlay", "Bundles to overlay on the primary bundle, applied in order")
+	f.Var(stringMap{&c.BundleVariables}, "var", "Value of a bundle variable, as name=value")
+	f.Var(cmd.NewAppendStringsValue(&c.BundleVariableFiles), "var-file", "YAML file of bundle variable values")
 	f.Var(&c.ConstraintsStr, "constraints", "Set application constraints")
 	f.StringVar(&c.Base, "base", "", "The base on which to deploy")
 	f.IntVar(&c.Revision, "revision", -1, "The revision to deploy")
@@ -648,6 +689,8 @@ func (c *DeployCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.Var(storageFlag{&c.Storage, &c.BundleStorage}, "storage", "Charm storage directives")
 	f.Var(devicesFlag{&c.Devices, &c.BundleDevices}, "device", "Charm device constraints")
 	f.Var(stringMap{&c.Resources}, "resource", "Resource to be uploaded to the controller")
+	f.StringVar(&c.SignatureFile, "signature", "", "Path to a signature over the charm archive hash")
+	f.Var(stringMap{&c.ResourceSignatureFiles}, "resource-signature", "Path to a signature over an OCI image resource digest")
 	f.StringVar(&c.BindToSpaces, "bind", "", "Configure application endpoint bindings to spaces")
 	f.StringVar(&c.machineMap, "map-machines", "", "Specify the existing machines to use for bundle deployments")
 
@@ -772,6 +815,9 @@ func (c *DeployCommand) Run(ctx *cmd.Context) error {
 	if c.Constraints, err = common.ParseConstraints(ctx, strings.Join(c.ConstraintsStr, " ")); err != nil {
 		return errors.Trace(err)
 	}
+	if c.BundleVariables, err = appbundle.ReadVariables(ctx, c.BundleVariableFiles, c.BundleVariables); err != nil {
+		return errors.Trace(err)
+	}
 
 	deployAPI, err := c.NewDeployAPI(ctx)
 	if err != nil {
@@ -850,6 +896,7 @@ func (c *DeployCommand) getDeployerFactory(base corebase.Base, defaultCharmSchem
 		BundleDevices:      c.BundleDevices,
 		BundleMachines:     c.BundleMachines,
 		BundleOverlayFile:  c.BundleOverlayFile,
+		BundleVariables:    c.BundleVariables,
 		BundleStorage:      c.BundleStorage,
 		Channel:            c.Channel,
 		CharmOrBundle:      c.CharmOrBundle,
@@ -865,7 +912,9 @@ func (c *DeployCommand) getDeployerFactory(base corebase.Base, defaultCharmSchem
 		PlacementSpec:      c.PlacementSpec,
 		Placement:          c.Placement,
 		Resources:          c.Resources,
+		ResourceSignatures: c.ResourceSignatureFiles,
 		Revision:           c.Revision,
+		Signature:          c.SignatureFile,
 		Base:               base,
 		Storage:            c.Storage,
 		Trust:              c.Trust,
@@ -32,6 +32,7 @@ type deployBundle struct {
 	bundleDir         string
 	bundleURL         *charm.URL
 	bundleOverlayFile []string
+	bundleVariables   map[string]string
 	origin            commoncharm.Origin
 	modelConstraints  constraints.Value
 
@@ -86,7 +87,7 @@ func (d *deployBundle) deploy(
 	d.accountUser = accountDetails.User
 
 	// Compose bundle to be deployed and check its validity.
-	bundleData, unmarshalErrors, err := bundle.ComposeAndVerifyBundle(ctx, d.bundleDataSource, d.bundleOverlayFile)
+	bundleData, unmarshalErrors, err := bundle.ComposeAndVerifyBundle(ctx, d.bundleDataSource, d.bundleOverlayFile, d.bundleVariables)
 	if err != nil {
 		return errors.Annotatef(err, "cannot deploy bundle")
 	}
@@ -30,26 +30,28 @@ import (
 )
 
 type deployCharm struct {
-	applicationName  string
-	attachStorage    []string
-	bindings         map[string]string
-	configOptions    DeployConfigFlag
-	constraints      constraints.Value
-	dryRun           bool
-	modelConstraints constraints.Value
-	devices          map[string]devices.Constraints
-	deployResources  DeployResourcesFunc
-	force            bool
-	id               application.CharmID
-	flagSet          *gnuflag.FlagSet
-	model            ModelCommand
-	numUnits         int
-	placement        []*instance.Placement
-	placementSpec    string
-	resources        map[string]string
-	baseFlag         corebase.Base
-	storage          map[string]storage.Directive
-	trust            bool
+	applicationName    string
+	attachStorage      []string
+	bindings           map[string]string
+	configOptions      DeployConfigFlag
+	constraints        constraints.Value
+	dryRun             bool
+	modelConstraints   constraints.Value
+	devices            map[string]devices.Constraints
+	deployResources    DeployResourcesFunc
+	force              bool
+	id                 application.CharmID
+	flagSet            *gnuflag.FlagSet
+	model              ModelCommand
+	numUnits           int
+	placement          []*instance.Placement
+	placementSpec      string
+	resources          map[string]string
+	resourceSignatures map[string]string
+	signature          string
+	baseFlag           corebase.Base
+	storage            map[string]storage.Directive
+	trust              bool
 }
 
 func checkCharmFormat(ctx context.Context, m ModelCommand, charmInfo *apicharms.CharmInfo) error {
@@ -147,22 +149,29 @@ func (d *deployCharm) deploy(
 		appConfig = nil
 	}
 
+	charmSignature, resourceSignatures, err := utils.ReadSignatures(ctx, d.model.Filesystem(), d.signature, d.resourceSignatures)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
 	ctx.Infof("%s", d.formatDeployingText(applicationName, charmName))
 	args := application.DeployArgs{
-		CharmID:          id,
-		CharmOrigin:      id.Origin,
-		Cons:             d.constraints,
-		ApplicationName:  applicationName,
-		NumUnits:         numUnits,
-		ConfigYAML:       configYAML,
-		Config:           appConfig,
-		Placement:        d.placement,
-		Storage:          d.storage,
-		Devices:          d.devices,
-		AttachStorage:    d.attachStorage,
-		Resources:        ids,
-		EndpointBindings: d.bindings,
-		Force:            d.force,
+		CharmID:            id,
+		CharmOrigin:        id.Origin,
+		Cons:               d.constraints,
+		ApplicationName:    applicationName,
+		NumUnits:           numUnits,
+		ConfigYAML:         configYAML,
+		Config:             appConfig,
+		Placement:          d.placement,
+		Storage:            d.storage,
+		Devices:            d.devices,
+		AttachStorage:      d.attachStorage,
+		Resources:          ids,
+		EndpointBindings:   d.bindings,
+		Force:              d.force,
+		CharmSignature:     charmSignature,
+		ResourceSignatures: resourceSignatures,
 	}
 
 	err = deployAPI.Deploy(ctx, args)
@@ -184,7 +193,7 @@ or use remove-application to remove the existing one and try again.`,
 var (
 	// BundleOnlyFlags represents what flags are used for bundles only.
 	BundleOnlyFlags = []string{
-		"overlay", "map-machines",
+		"overlay", "map-machines", "var", "var-file",
 	}
 )
 
@@ -357,25 +366,32 @@ func (c *repositoryCharm) PrepareAndDeploy(ctx *cmd.Context, deployAPI DeployerA
 		return errors.Trace(err)
 	}
 
+	charmSignature, resourceSignatures, err := utils.ReadSignatures(ctx, c.model.Filesystem(), c.signature, c.resourceSignatures)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
 	charmName := c.userRequestedURL.Name
 	info, localPendingResources, errs := deployAPI.DeployFromRepository(ctx, application.DeployFromRepositoryArg{
-		CharmName:        charmName,
-		ApplicationName:  c.applicationName,
-		AttachStorage:    c.attachStorage,
-		Base:             base,
-		Channel:          channel,
-		ConfigYAML:       configYAML,
-		Cons:             c.constraints,
-		Devices:          c.devices,
-		DryRun:           c.dryRun,
-		EndpointBindings: c.bindings,
-		Force:            c.force,
-		NumUnits:         &c.numUnits,
-		Placement:        c.placement,
-		Revision:         c.id.Origin.Revision,
-		Resources:        c.resources,
-		Storage:          c.storage,
-		Trust:            c.trust,
+		CharmName:          charmName,
+		ApplicationName:    c.applicationName,
+		AttachStorage:      c.attachStorage,
+		Base:               base,
+		Channel:            channel,
+		ConfigYAML:         configYAML,
+		Cons:               c.constraints,
+		Devices:            c.devices,
+		DryRun:             c.dryRun,
+		EndpointBindings:   c.bindings,
+		Force:              c.force,
+		NumUnits:           &c.numUnits,
+		Placement:          c.placement,
+		Revision:           c.id.Origin.Revision,
+		Resources:          c.resources,
+		Storage:            c.storage,
+		Trust:              c.trust,
+		CharmSignature:     charmSignature,
+		ResourceSignatures: resourceSignatures,
 	})
 
 	for _, err := range errs {
@@ -6,6 +6,8 @@ package deployer
 import (
 	"bytes"
 	"context"
+	"io"
+	"strings"
 	"testing"
 
 	"github.com/juju/clock"
@@ -71,6 +73,28 @@ func (s *charmSuite) TestSimpleCharmDeploy(c *tc.C) {
 	c.Assert(err, tc.ErrorIsNil)
 }
 
+func (s *charmSuite) TestSimpleCharmDeployWithSignatures(c *tc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+	s.filesystem = mocks.NewMockFilesystem(ctrl)
+	s.modelCommand.EXPECT().Filesystem().Return(s.filesystem).AnyTimes()
+	s.configFlag.EXPECT().AbsoluteFileNames(gomock.Any()).Return(nil, nil)
+	s.configFlag.EXPECT().ReadConfigPairs(gomock.Any()).Return(nil, nil)
+	s.filesystem.EXPECT().Open("/sigs/charm.sig").Return(nopSeekCloser{strings.NewReader("charm-signature")}, nil)
+	s.filesystem.EXPECT().Open("/sigs/image.sig").Return(nopSeekCloser{strings.NewReader("image-signature")}, nil)
+	s.deployerAPI.EXPECT().Deploy(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, args application.DeployArgs) error {
+		c.Check(args.CharmSignature, tc.Equals, "charm-signature")
+		c.Check(args.ResourceSignatures, tc.DeepEquals, map[string]string{"image": "image-signature"})
+		return nil
+	})
+
+	dCharm := s.newDeployCharm()
+	dCharm.signature = "/sigs/charm.sig"
+	dCharm.resourceSignatures = map[string]string{"image": "/sigs/image.sig"}
+	err := dCharm.deploy(s.ctx, s.deployerAPI)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
 func (s *charmSuite) TestRepositoryCharmDeployDryRunDefaultSeriesForce(c *tc.C) {
 	ctrl := s.setupMocks(c)
 	defer ctrl.Finish()
@@ -311,3 +335,11 @@ func minimalModelConfig() map[string]interface{} {
 func strptr(s string) *string {
 	return &s
 }
+
+type nopSeekCloser struct {
+	io.ReadSeeker
+}
+
+func (nopSeekCloser) Close() error {
+	return nil
+}
@@ -361,6 +361,7 @@ func (d *factory) setConfig(cfg DeployerConfig) {
 	d.charmOrBundle = cfg.CharmOrBundle
 	d.defaultCharmSchema = cfg.DefaultCharmSchema
 	d.bundleOverlayFile = cfg.BundleOverlayFile
+	d.bundleVariables = cfg.BundleVariables
 	d.channel = cfg.Channel
 	d.base = cfg.Base
 	d.force = cfg.Force
@@ -374,6 +375,8 @@ func (d *factory) setConfig(cfg DeployerConfig) {
 	d.devices = cfg.Devices
 	d.bundleDevices = cfg.BundleDevices
 	d.resources = cfg.Resources
+	d.signature = cfg.Signature
+	d.resourceSignatures = cfg.ResourceSignatures
 	d.revision = cfg.Revision
 	d.bindings = cfg.Bindings
 	d.useExisting = cfg.UseExisting
@@ -405,6 +408,7 @@ type DeployerConfig struct {
 	BundleDevices        map[string]map[string]devices.Constraints
 	BundleMachines       map[string]string
 	BundleOverlayFile    []string
+	BundleVariables      map[string]string
 	BundleStorage        map[string]map[string]storage.Directive
 	Channel              charm.Channel
 	CharmOrBundle        string
@@ -423,7 +427,9 @@ type DeployerConfig struct {
 	PlacementSpec        string
 	Placement            []*instance.Placement
 	Resources            map[string]string
+	ResourceSignatures   map[string]string
 	Revision             int
+	Signature            string
 	Base                 corebase.Base
 	Storage              map[string]storage.Directive
 	Trust                bool
@@ -446,6 +452,7 @@ type factory struct {
 	attachStorage      []string
 	charmOrBundle      string
 	bundleOverlayFile  []string
+	bundleVariables    map[string]string
 	channel            charm.Channel
 	revision           int
 	base               corebase.Base
@@ -460,6 +467,8 @@ type factory struct {
 	devices            map[string]devices.Constraints
 	bundleDevices      map[string]map[string]devices.Constraints
 	resources          map[string]string
+	resourceSignatures map[string]string
+	signature          string
 	bindings           map[string]string
 	useExisting        bool
 	bundleMachines     map[string]string
@@ -475,25 +484,27 @@ type factory struct {
 // be deployed
 func (d *factory) newDeployCharm() deployCharm {
 	return deployCharm{
-		applicationName:  d.applicationName,
-		attachStorage:    d.attachStorage,
-		bindings:         d.bindings,
-		configOptions:    &d.configOptions,
-		constraints:      d.constraints,
-		dryRun:           d.dryRun,
-		modelConstraints: d.modelConstraints,
-		devices:          d.devices,
-		deployResources:  d.deployResources,
-		flagSet:          d.flagSet,
-		force:            d.force,
-		model:            d.model,
-		numUnits:         d.numUnits,
-		placement:        d.placement,
-		placementSpec:    d.placementSpec,
-		resources:        d.resources,
-		baseFlag:         d.base,
-		storage:          d.storage,
-		trust:            d.trust,
+		applicationName:    d.applicationName,
+		attachStorage:      d.attachStorage,
+		bindings:           d.bindings,
+		configOptions:      &d.configOptions,
+		constraints:        d.constraints,
+		dryRun:             d.dryRun,
+		modelConstraints:   d.modelConstraints,
+		devices:            d.devices,
+		deployResources:    d.deployResources,
+		flagSet:            d.flagSet,
+		force:              d.force,
+		model:              d.model,
+		numUnits:           d.numUnits,
+		placement:          d.placement,
+		placementSpec:      d.placementSpec,
+		resources:          d.resources,
+		resourceSignatures: d.resourceSignatures,
+		signature:          d.signature,
+		baseFlag:           d.base,
+		storage:            d.storage,
+		trust:              d.trust,
 	}
 }
 
@@ -537,6 +548,7 @@ func (d *factory) newDeployBundle(_ charm.Schema, ds charm.BundleDataSource) dep
 		bundleStorage:        d.bundleStorage,
 		bundleDevices:        d.bundleDevices,
 		bundleOverlayFile:    d.bundleOverlayFile,
+		bundleVariables:      d.bundleVariables,
 		bundleDir:            d.charmOrBundle,
 		modelConstraints:     d.modelConstraints,
 		charmReader:          d.charmReader,
@@ -602,6 +614,7 @@ func (dk *repositoryBundleDeployerKind) CreateDeployer(ctx context.Context, d fa
 	db := d.newDeployBundle(d.defaultCharmSchema, store.NewResolvedBundle(bundle))
 	db.bundleURL = dk.bundleURL
 	db.bundleOverlayFile = d.bundleOverlayFile
+	db.bundleVariables = d.bundleVariables
 	db.origin = dk.bundleOrigin
 	return &repositoryBundle{deployBundle: db}, nil
 }
@@ -700,6 +713,7 @@ func CharmOnlyFlags() []string {
 	charmOnlyFlags := []string{
 		"bind", "config", "constraints", "n", "num-units",
 		"base", "to", "resource", "attach-storage",
+		"signature", "resource-signature",
 	}
 
 	return charmOnlyFlags
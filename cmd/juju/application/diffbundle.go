@@ -70,6 +70,9 @@ Bundle can be a local bundle file or the name of a bundle in
 Charmhub. The bundle can also be combined with overlays (in the
 same way as the deploy command) before comparing with the model.
 
+Values of the variables declared by the bundle and overlays are supplied
+with the ` + "`--var`" + ` and ` + "`--var-file`" + ` options, as for the ` + "`deploy`" + ` command.
+
 The ` + "`map-machines`" + ` option works similarly as for the ` + "`deploy`" + ` command, but
 existing is always assumed, so it doesn't need to be specified.
 
@@ -86,6 +89,7 @@ const bundleDiffExamples = `
 	juju diff-bundle charmed-kubernetes --base ubuntu@22.04
     juju diff-bundle -m othermodel hadoop-spark
     juju diff-bundle localbundle.yaml --map-machines 3=4
+    juju diff-bundle localbundle.yaml --var-file production.yaml --var units=3
 `
 
 // NewDiffBundleCommand returns a command to compare a bundle against
@@ -117,6 +121,8 @@ type diffBundleCommand struct {
 	modelcmd.ModelCommandBase
 	bundle         string
 	bundleOverlays []string
+	bundleVars     map[string]string
+	bundleVarFiles []string
 	channelStr     string
 	channel        charm.Channel
 	arch           string
@@ -161,6 +167,8 @@ func (c *diffBundleCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.StringVar(&c.base, "base", "", "Specify a base")
 	f.StringVar(&c.channelStr, "channel", "", "Channel to use when getting the bundle from Charmhub")
 	f.Var(cmd.NewAppendStringsValue(&c.bundleOverlays), "overlay", "Bundles to overlay on the primary bundle, applied in order")
+	f.Var(stringMap{&c.bundleVars}, "var", "Value of a bundle variable, as name=value")
+	f.Var(cmd.NewAppendStringsValue(&c.bundleVarFiles), "var-file", "YAML file of bundle variable values")
 	f.StringVar(&c.machineMap, "map-machines", "", "Indicates how existing machines correspond to bundle machines")
 	f.BoolVar(&c.annotations, "annotations", false, "Include differences in annotations")
 }
@@ -214,7 +222,11 @@ func (c *diffBundleCommand) Run(ctx *cmd.Context) error {
 		return errors.Trace(err)
 	}
 
-	bundle, _, err := appbundle.ComposeAndVerifyBundle(ctx, baseSrc, c.bundleOverlays)
+	bundleVars, err := appbundle.ReadVariables(ctx, c.bundleVarFiles, c.bundleVars)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	bundle, _, err := appbundle.ComposeAndVerifyBundle(ctx, baseSrc, c.bundleOverlays, bundleVars)
 	if err != nil {
 		return errors.Trace(err)
 	}
@@ -206,3 +206,23 @@ func NewConfigCommandForTest(api ApplicationAPI, store jujuclient.ClientStore) m
 	c.SetClientStore(store)
 	return c
 }
+
+// NewRefreshPolicyCommandForTest returns a RefreshPolicyCommand with the api
+// provided as specified.
+func NewRefreshPolicyCommandForTest(api refreshPolicyAPI, store jujuclient.ClientStore) modelcmd.ModelCommand {
+	cmd := &refreshPolicyCommand{newAPIFunc: func(ctx context.Context) (refreshPolicyAPI, error) {
+		return api, nil
+	}}
+	cmd.SetClientStore(store)
+	return modelcmd.Wrap(cmd)
+}
+
+// NewApplicationStorageCommandForTest returns an ApplicationStorageCommand
+// with the api provided as specified.
+func NewApplicationStorageCommandForTest(api applicationStorageAPI, store jujuclient.ClientStore) modelcmd.ModelCommand {
+	cmd := &applicationStorageCommand{newAPIFunc: func(ctx context.Context) (applicationStorageAPI, error) {
+		return api, nil
+	}}
+	cmd.SetClientStore(store)
+	return modelcmd.Wrap(cmd)
+}
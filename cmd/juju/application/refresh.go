@@ -155,6 +155,14 @@ type refreshCommand struct {
 	// Resources is a map of resource name to filename to be uploaded on upgrade.
 	Resources map[string]string
 
+	// SignatureFile is the path to a signature over the charm archive's
+	// SHA256 hash.
+	SignatureFile string
+
+	// ResourceSignatureFiles maps OCI image resource names to the path of a
+	// signature over the image's digest.
+	ResourceSignatureFiles map[string]string
+
 	// Channel holds the charmhub channel to use when obtaining
 	// the charm to be refreshed to.
 	Channel    charm.Channel
@@ -198,6 +206,12 @@ repeated more than once to upload more than one resource.
 
     juju refresh foo --resource bar=/some/file.tgz --resource baz=./docs/cfg.xml
 
+If the controller's ` + "`charm-signature-policy`" + ` is ` + "`required`" + `, the new charm
+must be signed by one of the keys in ` + "`charm-signing-keys`" + `. The ` + "`--signature`" + `
+and ` + "`--resource-signature`" + ` options provide the signatures, in the same way as
+for ` + "`juju deploy`" + `. OCI image resources which aren't changed by the refresh
+don't need a new signature.
+
 Where bar and baz are resources named in the metadata for the foo charm.
 
 Storage directives may be added or updated at upgrade time by specifying
@@ -299,6 +313,8 @@ func (c *refreshCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.StringVar(&c.Base, "base", "", "Select a different base than what is currently running.")
 	f.IntVar(&c.Revision, "revision", -1, "Explicit revision of current charm")
 	f.Var(stringMap{mapping: &c.Resources}, "resource", "Resource to be uploaded to the controller")
+	f.StringVar(&c.SignatureFile, "signature", "", "Path to a signature over the charm archive hash")
+	f.Var(stringMap{mapping: &c.ResourceSignatureFiles}, "resource-signature", "Path to a signature over an OCI image resource digest")
 	f.Var(storageFlag{stores: &c.Storage, bundleStores: nil}, "storage", "Charm storage directives")
 	f.Var(&c.ConfigOptions, "config", "Either a path to yaml-formatted application config file or a key=value pair ")
 	f.StringVar(&c.BindToSpaces, "bind", "", "Configure application endpoint bindings to spaces")
@@ -552,6 +568,10 @@ func (c *refreshCommand) Run(ctx *cmd.Context) error {
 	if err != nil {
 		return errors.Trace(err)
 	}
+	charmSignature, resourceSignatures, err := utils.ReadSignatures(ctx, c.Filesystem(), c.SignatureFile, c.ResourceSignatureFiles)
+	if err != nil {
+		return errors.Trace(err)
+	}
 	charmCfg := application.SetCharmConfig{
 		ApplicationName:    c.ApplicationName,
 		CharmID:            chID,
@@ -563,6 +583,8 @@ func (c *refreshCommand) Run(ctx *cmd.Context) error {
 		ResourceIDs:        resourceIDs,
 		StorageDirectives:  c.Storage,
 		EndpointBindings:   c.Bindings,
+		CharmSignature:     charmSignature,
+		ResourceSignatures: resourceSignatures,
 	}
 
 	err = charmRefreshClient.SetCharm(ctx, charmCfg)
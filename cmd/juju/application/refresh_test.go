@@ -302,6 +302,43 @@ func (s *RefreshSuite) TestConfigSettings(c *tc.C) {
 	})
 }
 
+func (s *RefreshSuite) TestSignatures(c *tc.C) {
+	tempdir := c.MkDir()
+	charmSig := filepath.Join(tempdir, "charm.sig")
+	err := os.WriteFile(charmSig, []byte("charm-signature"), 0644)
+	c.Assert(err, tc.ErrorIsNil)
+	imageSig := filepath.Join(tempdir, "image.sig")
+	err = os.WriteFile(imageSig, []byte("image-signature"), 0644)
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = s.runRefresh(c, "foo", "--signature", charmSig, "--resource-signature", "image="+imageSig)
+	c.Assert(err, tc.ErrorIsNil)
+	s.charmAPIClient.CheckCallNames(c, "GetCharmURLOrigin", "Get", "SetCharm")
+
+	s.charmAPIClient.CheckCall(c, 2, "SetCharm", application.SetCharmConfig{
+		ApplicationName: "foo",
+		CharmID: application.CharmID{
+			URL: s.resolvedCharmURL.String(),
+			Origin: commoncharm.Origin{
+				ID:           "testing",
+				Source:       "charm-hub",
+				Risk:         "stable",
+				Architecture: arch.DefaultArchitecture,
+				Base:         s.testBase,
+			},
+		},
+		ConfigSettings:     map[string]string{},
+		EndpointBindings:   map[string]string{},
+		CharmSignature:     "charm-signature",
+		ResourceSignatures: map[string]string{"image": "image-signature"},
+	})
+}
+
+func (s *RefreshSuite) TestSignatureFileNotFound(c *tc.C) {
+	_, err := s.runRefresh(c, "foo", "--signature", filepath.Join(c.MkDir(), "missing.sig"))
+	c.Assert(err, tc.ErrorMatches, `cannot read signature from file ".*missing.sig": .*`)
+}
+
 func (s *RefreshSuite) TestConfigSettingsWithTrust(c *tc.C) {
 	_, err := s.runRefresh(c, "foo", "--trust", "--config", "foo=bar")
 	c.Assert(err, tc.ErrorIsNil)
@@ -0,0 +1,164 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/api/client/application"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/internal/cmd"
+)
+
+// NewRefreshPolicyCommand returns a command which shows or sets the charm
+// refresh policy of an application.
+func NewRefreshPolicyCommand() modelcmd.ModelCommand {
+	cmd := &refreshPolicyCommand{}
+	cmd.newAPIFunc = func(ctx context.Context) (refreshPolicyAPI, error) {
+		root, err := cmd.NewAPIRoot(ctx)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return application.NewClient(root), nil
+	}
+	return modelcmd.Wrap(cmd)
+}
+
+type refreshPolicyAPI interface {
+	Close() error
+	GetRefreshPolicy(context.Context, string) (application.RefreshPolicy, error)
+	SetRefreshPolicy(context.Context, string, application.RefreshPolicy) error
+}
+
+// refreshPolicyCommand shows or sets the charm refresh policy of an
+// application.
+type refreshPolicyCommand struct {
+	modelcmd.ModelCommandBase
+
+	newAPIFunc func(ctx context.Context) (refreshPolicyAPI, error)
+	out        cmd.Output
+
+	applicationName string
+	policy          string
+	window          string
+}
+
+const refreshPolicyDoc = `
+Shows or sets the policy used when a newer revision of an application's charm
+is published in the channel the application is tracking.
+
+The policy is one of:
+
+  none    Do nothing. This is the default.
+  notify  Record the available revision in the application's status history.
+  auto    Refresh the application to the available revision, and record the
+          outcome in the application's status history.
+
+With the ` + "`auto`" + ` policy, ` + "`--window`" + ` restricts automatic refreshes to a daily
+maintenance window, given as ` + "`HH:MM-HH:MM`" + ` in UTC. A window may span midnight.
+
+Without a policy, the current policy of the application is shown.
+`
+
+const refreshPolicyExamples = `
+    juju refresh-policy mysql
+    juju refresh-policy mysql notify
+    juju refresh-policy mysql auto --window 02:00-04:00
+    juju refresh-policy mysql none
+`
+
+// Info implements cmd.Command.
+func (c *refreshPolicyCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "refresh-policy",
+		Args:     "<application> [none|notify|auto]",
+		Purpose:  "Show or set the charm refresh policy of an application.",
+		Doc:      refreshPolicyDoc,
+		Examples: refreshPolicyExamples,
+		SeeAlso: []string{
+			"refresh",
+			"show-status-log",
+		},
+	})
+}
+
+// SetFlags implements cmd.Command.
+func (c *refreshPolicyCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	f.StringVar(&c.window, "window", "", "Maintenance window for the auto policy, as HH:MM-HH:MM in UTC")
+	c.out.AddFlags(f, "yaml", map[string]cmd.Formatter{
+		"yaml": cmd.FormatYaml,
+		"json": cmd.FormatJson,
+	})
+}
+
+// Init implements cmd.Command.
+func (c *refreshPolicyCommand) Init(args []string) error {
+	if len(args) == 0 {
+		return errors.Errorf("no application specified")
+	}
+	c.applicationName = args[0]
+	if !names.IsValidApplication(c.applicationName) {
+		return errors.Errorf("invalid application name %q", c.applicationName)
+	}
+	if len(args) == 1 {
+		if c.window != "" {
+			return errors.New("--window requires the auto policy")
+		}
+		return nil
+	}
+	c.policy = args[1]
+	switch c.policy {
+	case "none", "notify", "auto":
+	default:
+		return errors.Errorf("invalid policy %q, expected one of none, notify or auto", c.policy)
+	}
+	if c.window != "" && c.policy != "auto" {
+		return errors.New("--window requires the auto policy")
+	}
+	return cmd.CheckEmpty(args[2:])
+}
+
+// formattedRefreshPolicy is the output format of the refresh policy of an
+// application.
+type formattedRefreshPolicy struct {
+	Policy string `yaml:"policy" json:"policy"`
+	Window string `yaml:"window,omitempty" json:"window,omitempty"`
+}
+
+// Run implements cmd.Command.
+func (c *refreshPolicyCommand) Run(ctx *cmd.Context) error {
+	client, err := c.newAPIFunc(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if c.policy == "" {
+		policy, err := client.GetRefreshPolicy(ctx, c.applicationName)
+		if err != nil {
+			return errors.Annotatef(err, "cannot get refresh policy of %q", c.applicationName)
+		}
+		return c.out.Write(ctx, formattedRefreshPolicy{
+			Policy: policy.Policy,
+			Window: policy.Window,
+		})
+	}
+
+	err = client.SetRefreshPolicy(ctx, c.applicationName, application.RefreshPolicy{
+		Policy: c.policy,
+		Window: c.window,
+	})
+	if err != nil {
+		return block.ProcessBlockedError(
+			errors.Annotatef(err, "cannot set refresh policy of %q", c.applicationName), block.BlockChange)
+	}
+	return nil
+}
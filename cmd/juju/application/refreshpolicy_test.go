@@ -0,0 +1,136 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/api/client/application"
+	"github.com/juju/juju/api/jujuclient/jujuclienttesting"
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/internal/testhelpers"
+	"github.com/juju/juju/rpc/params"
+)
+
+type RefreshPolicySuite struct {
+	testhelpers.IsolationSuite
+
+	mockAPI *mockRefreshPolicyAPI
+}
+
+func TestRefreshPolicySuite(t *testing.T) {
+	tc.Run(t, &RefreshPolicySuite{})
+}
+
+type mockRefreshPolicyAPI struct {
+	*testhelpers.Stub
+	policy application.RefreshPolicy
+}
+
+func (s *mockRefreshPolicyAPI) Close() error {
+	s.MethodCall(s, "Close")
+	return s.NextErr()
+}
+
+func (s *mockRefreshPolicyAPI) GetRefreshPolicy(ctx context.Context, appName string) (application.RefreshPolicy, error) {
+	s.MethodCall(s, "GetRefreshPolicy", appName)
+	return s.policy, s.NextErr()
+}
+
+func (s *mockRefreshPolicyAPI) SetRefreshPolicy(ctx context.Context, appName string, policy application.RefreshPolicy) error {
+	s.MethodCall(s, "SetRefreshPolicy", appName, policy)
+	return s.NextErr()
+}
+
+func (s *RefreshPolicySuite) SetUpTest(c *tc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.mockAPI = &mockRefreshPolicyAPI{Stub: &testhelpers.Stub{}}
+}
+
+func (s *RefreshPolicySuite) runRefreshPolicy(c *tc.C, args ...string) (*cmd.Context, error) {
+	return cmdtesting.RunCommand(c, NewRefreshPolicyCommandForTest(s.mockAPI, jujuclienttesting.MinimalStore()), args...)
+}
+
+func (s *RefreshPolicySuite) TestInit(c *tc.C) {
+	for _, test := range []struct {
+		args []string
+		err  string
+	}{{
+		args: []string{},
+		err:  `no application specified`,
+	}, {
+		args: []string{"foo/0"},
+		err:  `invalid application name "foo/0"`,
+	}, {
+		args: []string{"foo", "sometimes"},
+		err:  `invalid policy "sometimes", expected one of none, notify or auto`,
+	}, {
+		args: []string{"foo", "notify", "--window", "02:00-04:00"},
+		err:  `--window requires the auto policy`,
+	}, {
+		args: []string{"foo", "--window", "02:00-04:00"},
+		err:  `--window requires the auto policy`,
+	}, {
+		args: []string{"foo", "auto", "extra"},
+		err:  `unrecognized args: \["extra"\]`,
+	}, {
+		args: []string{"foo"},
+	}, {
+		args: []string{"foo", "auto", "--window", "02:00-04:00"},
+	}} {
+		cmd := NewRefreshPolicyCommandForTest(s.mockAPI, jujuclienttesting.MinimalStore())
+		err := cmdtesting.InitCommand(cmd, test.args)
+		if test.err == "" {
+			c.Check(err, tc.ErrorIsNil, tc.Commentf("args %v", test.args))
+		} else {
+			c.Check(err, tc.ErrorMatches, test.err, tc.Commentf("args %v", test.args))
+		}
+	}
+}
+
+func (s *RefreshPolicySuite) TestShow(c *tc.C) {
+	s.mockAPI.policy = application.RefreshPolicy{Policy: "auto", Window: "02:00-04:00"}
+
+	ctx, err := s.runRefreshPolicy(c, "foo")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, "policy: auto\nwindow: 02:00-04:00\n")
+	s.mockAPI.CheckCall(c, 0, "GetRefreshPolicy", "foo")
+}
+
+func (s *RefreshPolicySuite) TestShowJSON(c *tc.C) {
+	s.mockAPI.policy = application.RefreshPolicy{Policy: "none"}
+
+	ctx, err := s.runRefreshPolicy(c, "foo", "--format", "json")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), tc.Equals, `{"policy":"none"}`+"\n")
+}
+
+func (s *RefreshPolicySuite) TestSet(c *tc.C) {
+	_, err := s.runRefreshPolicy(c, "foo", "auto", "--window", "23:00-01:00")
+	c.Assert(err, tc.ErrorIsNil)
+	s.mockAPI.CheckCallNames(c, "SetRefreshPolicy", "Close")
+	s.mockAPI.CheckCall(c, 0, "SetRefreshPolicy", "foo", application.RefreshPolicy{
+		Policy: "auto",
+		Window: "23:00-01:00",
+	})
+}
+
+func (s *RefreshPolicySuite) TestSetError(c *tc.C) {
+	s.mockAPI.SetErrors(&params.Error{Code: params.CodeNotValid, Message: "maintenance window not valid"})
+
+	_, err := s.runRefreshPolicy(c, "foo", "auto", "--window", "2am-4am")
+	c.Assert(err, tc.ErrorMatches, `cannot set refresh policy of "foo": maintenance window not valid`)
+}
+
+func (s *RefreshPolicySuite) TestSetBlocked(c *tc.C) {
+	s.mockAPI.SetErrors(&params.Error{Code: params.CodeOperationBlocked, Message: "nope"})
+
+	_, err := s.runRefreshPolicy(c, "foo", "notify")
+	c.Assert(err.Error(), tc.Contains, `cannot set refresh policy of "foo": nope`)
+	c.Assert(err.Error(), tc.Contains, `All operations that change model have been disabled for the current model.`)
+}
@@ -266,6 +266,54 @@ func ReadValue(ctx *cmd.Context, filesystem modelcmd.Filesystem, filename string
 	return string(content), nil
 }
 
+// maxSignatureSize is the maximum size of a detached signature file.
+const maxSignatureSize = 64 * 1024
+
+// ReadSignatures reads the detached charm signature, and any OCI image
+// resource signatures keyed on resource name, out of the named files.
+// Empty file names are ignored.
+func ReadSignatures(
+	ctx *cmd.Context, filesystem modelcmd.Filesystem,
+	charmSignatureFile string, resourceSignatureFiles map[string]string,
+) (string, map[string]string, error) {
+	var charmSignature string
+	if charmSignatureFile != "" {
+		var err error
+		if charmSignature, err = readSignature(ctx, filesystem, charmSignatureFile); err != nil {
+			return "", nil, errors.Trace(err)
+		}
+	}
+	var resourceSignatures map[string]string
+	for name, filename := range resourceSignatureFiles {
+		sig, err := readSignature(ctx, filesystem, filename)
+		if err != nil {
+			return "", nil, errors.Annotatef(err, "resource %q", name)
+		}
+		if resourceSignatures == nil {
+			resourceSignatures = make(map[string]string)
+		}
+		resourceSignatures[name] = sig
+	}
+	return charmSignature, resourceSignatures, nil
+}
+
+func readSignature(ctx *cmd.Context, filesystem modelcmd.Filesystem, filename string) (string, error) {
+	f, err := filesystem.Open(ctx.AbsPath(filename))
+	if err != nil {
+		return "", errors.Errorf("cannot read signature from file %q: %v", filename, err)
+	}
+	defer func() { _ = f.Close() }()
+
+	content, err := io.ReadAll(io.LimitReader(f, maxSignatureSize+1))
+	if err != nil {
+		return "", errors.Errorf("cannot read signature from file %q: %v", filename, err)
+	}
+	if len(content) > maxSignatureSize {
+		return "", errors.Errorf("signature file %q is larger than 64K", filename)
+	}
+	return string(content), nil
+}
+
 // IsTerminal checks if the file descriptor is a terminal.
 func IsTerminal(w io.Writer) bool {
 	f, ok := w.(*os.File)
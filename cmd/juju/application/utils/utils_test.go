@@ -4,6 +4,8 @@
 package utils_test
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/juju/gnuflag"
@@ -15,10 +17,12 @@ import (
 	apicommoncharms "github.com/juju/juju/api/common/charms"
 	"github.com/juju/juju/cmd/juju/application/utils"
 	"github.com/juju/juju/cmd/juju/application/utils/mocks"
+	"github.com/juju/juju/cmd/modelcmd"
 	"github.com/juju/juju/core/instance"
 	"github.com/juju/juju/core/resource"
 	"github.com/juju/juju/internal/charm"
 	charmresource "github.com/juju/juju/internal/charm/resource"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
 )
 
 type utilsSuite struct{}
@@ -34,6 +38,35 @@ func (s *utilsSuite) TestParsePlacement(c *tc.C) {
 
 }
 
+func (s *utilsSuite) TestReadSignatures(c *tc.C) {
+	dir := c.MkDir()
+	c.Assert(os.WriteFile(filepath.Join(dir, "charm.sig"), []byte("charm-sig"), 0644), tc.ErrorIsNil)
+	c.Assert(os.WriteFile(filepath.Join(dir, "image.sig"), []byte("image-sig"), 0644), tc.ErrorIsNil)
+	ctx := cmdtesting.Context(c)
+	ctx.Dir = dir
+
+	charmSig, resourceSigs, err := utils.ReadSignatures(ctx, (&modelcmd.FilesystemCommand{}).Filesystem(),
+		"charm.sig", map[string]string{"image": "image.sig"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(charmSig, tc.Equals, "charm-sig")
+	c.Check(resourceSigs, tc.DeepEquals, map[string]string{"image": "image-sig"})
+}
+
+func (s *utilsSuite) TestReadSignaturesNone(c *tc.C) {
+	charmSig, resourceSigs, err := utils.ReadSignatures(cmdtesting.Context(c), (&modelcmd.FilesystemCommand{}).Filesystem(), "", nil)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(charmSig, tc.Equals, "")
+	c.Check(resourceSigs, tc.IsNil)
+}
+
+func (s *utilsSuite) TestReadSignaturesMissingFile(c *tc.C) {
+	ctx := cmdtesting.Context(c)
+	ctx.Dir = c.MkDir()
+
+	_, _, err := utils.ReadSignatures(ctx, (&modelcmd.FilesystemCommand{}).Filesystem(), "", map[string]string{"image": "missing.sig"})
+	c.Assert(err, tc.ErrorMatches, `resource "image": cannot read signature from file "missing.sig": .*`)
+}
+
 func (s *utilsSuite) TestGetFlags(c *tc.C) {
 	flagSet := gnuflag.NewFlagSet("testing", gnuflag.ContinueOnError)
 	flagSet.Bool("debug", true, "debug")
@@ -381,6 +381,8 @@ func registerCommands(r commandRegistry) {
 	r.Register(newUpgradeModelCommand())
 	r.Register(newUpgradeControllerCommand())
 	r.Register(application.NewRefreshCommand())
+	r.Register(application.NewRefreshPolicyCommand())
+	r.Register(application.NewApplicationStorageCommand())
 	r.Register(application.NewBindCommand())
 
 	// Charm tool commands.
@@ -530,6 +532,7 @@ func registerCommands(r commandRegistry) {
 	// Resource commands.
 	r.Register(resource.NewUploadCommand())
 	r.Register(resource.NewListCommand())
+	r.Register(resource.NewPruneCommand())
 	r.Register(resource.NewCharmResourcesCommand())
 
 	// CharmHub related commands
@@ -545,6 +548,8 @@ func registerCommands(r commandRegistry) {
 	r.Register(secrets.NewRemoveSecretCommand())
 	r.Register(secrets.NewGrantSecretCommand())
 	r.Register(secrets.NewRevokeSecretCommand())
+	r.Register(secrets.NewExportSecretsCommand())
+	r.Register(secrets.NewImportSecretsCommand())
 
 	// Secret backends.
 	r.Register(secretbackends.NewListSecretBackendsCommand())
@@ -553,6 +558,7 @@ func registerCommands(r commandRegistry) {
 	r.Register(secretbackends.NewRemoveSecretBackendCommand())
 	r.Register(secretbackends.NewShowSecretBackendCommand())
 	r.Register(secretbackends.NewModelSecretBackendCommand())
+	r.Register(secretbackends.NewRotateSecretEncryptionKeyCommand())
 }
 
 type cloudToCommandAdaptor struct{}
@@ -212,6 +212,7 @@ var commandNames = []string{
 	"add-storage",
 	"add-unit",
 	"add-user",
+	"application-storage",
 	"attach-resource",
 	"attach-storage",
 	"autoload-credentials",
@@ -252,6 +253,7 @@ var commandNames = []string{
 	"enable-user",
 	"exec",
 	"export-bundle",
+	"export-secrets",
 	"expose",
 	"find-offers",
 	"find",
@@ -263,6 +265,7 @@ var commandNames = []string{
 	"help-action-commands",
 	"help-hook-commands",
 	"import-filesystem",
+	"import-secrets",
 	"import-ssh-key",
 	"info",
 	"integrate",
@@ -302,7 +305,9 @@ var commandNames = []string{
 	"offer",
 	"offers",
 	"operations",
+	"prune-resources",
 	"refresh",
+	"refresh-policy",
 	"regions",
 	"register",
 	"relate", // alias for integrate
@@ -332,6 +337,7 @@ var commandNames = []string{
 	"revoke-cloud",
 	"revoke-secret",
 	"revoke",
+	"rotate-secret-encryption-key",
 	"run",
 	"scale-application",
 	"scp",
@@ -14,6 +14,7 @@ import (
 	"github.com/juju/juju/api/client/bundle"
 	jujucmd "github.com/juju/juju/cmd"
 	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/internal/charm"
 	"github.com/juju/juju/internal/cmd"
 )
 
@@ -31,6 +32,7 @@ type exportBundleCommand struct {
 	newAPIFunc           func(ctx context.Context) (ExportBundleAPI, error)
 	Filename             string
 	includeCharmDefaults bool
+	template             bool
 }
 
 const exportBundleHelpDoc = `
@@ -38,12 +40,18 @@ Exports the current model configuration as a reusable bundle.
 
 If ` + "`--filename`" + ` is not used, the configuration is printed to ` + "`stdout`" + `.
 ` + "` --filename`" + ` specifies an output file.
+
+If ` + "`--template`" + ` is used, the channel and unit count of each application are
+replaced by bundle variables, declared with the current values as defaults.
+Different values can be supplied when the bundle is deployed with the
+` + "`--var`" + ` and ` + "`--var-file`" + ` options of ` + "`juju deploy`" + `.
 `
 
 const exportBundleHelpExamples = `
     juju export-bundle
     juju export-bundle --filename mymodel.yaml
     juju export-bundle --include-charm-defaults
+    juju export-bundle --template --filename mymodel.yaml
 `
 
 // Info implements Command.
@@ -61,6 +69,7 @@ func (c *exportBundleCommand) SetFlags(f *gnuflag.FlagSet) {
 	c.ModelCommandBase.SetFlags(f)
 	f.StringVar(&c.Filename, "filename", "", "Bundle file")
 	f.BoolVar(&c.includeCharmDefaults, "include-charm-defaults", false, "Whether to include charm config default values in the exported bundle")
+	f.BoolVar(&c.template, "template", false, "Export a bundle parameterised with variables")
 }
 
 // Init implements Command.
@@ -95,6 +104,13 @@ func (c *exportBundleCommand) Run(ctx *cmd.Context) error {
 	if err != nil {
 		return err
 	}
+	if c.template {
+		template, err := charm.ParameteriseBundle([]byte(result))
+		if err != nil {
+			return errors.Annotate(err, "parameterising bundle")
+		}
+		result = string(template)
+	}
 
 	if c.Filename == "" {
 		_, err := fmt.Fprintf(ctx.Stdout, "%v", result)
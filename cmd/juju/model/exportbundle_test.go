@@ -115,6 +115,41 @@ func (s *ExportBundleCommandSuite) TestExportBundleSuccessNoFilename(c *tc.C) {
 		"  - mysql:mysql\n")
 }
 
+func (s *ExportBundleCommandSuite) TestExportBundleTemplate(c *tc.C) {
+	s.fakeBundle.result = "applications:\n" +
+		"  mysql:\n" +
+		"    charm: mysql\n" +
+		"    channel: 8.0/stable\n" +
+		"    num_units: 1\n" +
+		"relations:\n" +
+		"- - wordpress:db\n" +
+		"  - mysql:mysql\n"
+
+	ctx, err := cmdtesting.RunCommand(c, model.NewExportBundleCommandForTest(s.fakeBundle, s.store), "--template")
+	c.Assert(err, tc.ErrorIsNil)
+	s.fakeBundle.CheckCalls(c, []testhelpers.StubCall{
+		{"ExportBundle", []interface{}{false}},
+	})
+
+	out := cmdtesting.Stdout(ctx)
+	c.Assert(out, tc.Equals, ""+
+		"variables:\n"+
+		"  mysql-channel:\n"+
+		"    type: string\n"+
+		"    default: 8.0/stable\n"+
+		"  mysql-num-units:\n"+
+		"    type: int\n"+
+		"    default: 1\n"+
+		"applications:\n"+
+		"  mysql:\n"+
+		"    charm: mysql\n"+
+		"    channel: ${mysql-channel}\n"+
+		"    num_units: ${mysql-num-units}\n"+
+		"relations:\n"+
+		"- - wordpress:db\n"+
+		"  - mysql:mysql\n")
+}
+
 func (s *ExportBundleCommandSuite) TestExportBundleSuccessFilename(c *tc.C) {
 	s.fakeBundle.filename = filepath.Join(c.MkDir(), "mymodel")
 	s.fakeBundle.result = "applications:\n" +
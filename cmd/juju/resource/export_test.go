@@ -54,3 +54,9 @@ func NewListCommandForTest(newClient func(ctx context.Context) (ListClient, erro
 	cmd.SetClientStore(jujuclienttesting.MinimalStore())
 	return cmd
 }
+
+func NewPruneCommandForTest(newClient func(ctx context.Context) (PruneClient, error)) *PruneCommand {
+	cmd := &PruneCommand{newClient: newClient}
+	cmd.SetClientStore(jujuclienttesting.MinimalStore())
+	return cmd
+}
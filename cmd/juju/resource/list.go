@@ -22,6 +22,9 @@ import (
 type ListClient interface {
 	// ListResources returns info about resources for applications in the model.
 	ListResources(ctx context.Context, applications []string) ([]coreresources.ApplicationResources, error)
+	// StorageReport returns the blobs held in the model's object store on
+	// behalf of charms and resources.
+	StorageReport(ctx context.Context) ([]resources.StoredBlob, error)
 	// Close closes the connection.
 	Close() error
 }
@@ -32,9 +35,10 @@ type ListCommand struct {
 
 	newClient func(ctx context.Context) (ListClient, error)
 
-	details bool
-	out     cmd.Output
-	target  string
+	details       bool
+	storageReport bool
+	out           cmd.Output
+	target        string
 }
 
 // NewListCommand returns a new command that lists resources defined
@@ -63,6 +67,10 @@ To list resources for a unit:
 To show detailed information about resources used by a unit:
 
 	juju resources mysql/0 --details
+
+To show the space used by charm and resource blobs in the model:
+
+	juju resources --storage-report
 `
 
 // Info implements cmd.Command.Info.
@@ -75,11 +83,18 @@ func (c *ListCommand) Info() *cmd.Info {
 		SeeAlso: []string{
 			"attach-resource",
 			"charm-resources",
+			"prune-resources",
 		},
 		Doc: `
 This command shows the resources required by and those in use by an existing
 application or unit in your model.  When run for an application, it will also show any
 updates available for resources from a store.
+
+With --storage-report, no application or unit is given. Instead the charm and
+resource blobs held in the model's object store are shown, along with the
+revisions referring to them. Blobs are reported as in use, as only holding
+old revisions, or as unreferenced. Unreferenced blobs can be removed with
+prune-resources.
 `,
 		Examples: listResourcesExamples,
 	})
@@ -96,11 +111,21 @@ func (c *ListCommand) SetFlags(f *gnuflag.FlagSet) {
 	})
 
 	f.BoolVar(&c.details, "details", false, "Show detailed information about the resources used by each unit.")
+	f.BoolVar(&c.storageReport, "storage-report", false, "Show the charm and resource blobs held in the model's object store.")
 }
 
 // Init implements cmd.Command.Init. It will return an error satisfying
 // errors.BadRequest if you give it an incorrect number of arguments.
 func (c *ListCommand) Init(args []string) error {
+	if c.storageReport {
+		if c.details {
+			return errors.NewBadRequest(nil, "--details cannot be used with --storage-report")
+		}
+		if err := cmd.CheckEmpty(args); err != nil {
+			return errors.NewBadRequest(err, "")
+		}
+		return nil
+	}
 	if len(args) == 0 {
 		return errors.NewBadRequest(nil, "missing application or unit name")
 	}
@@ -119,6 +144,10 @@ func (c *ListCommand) Run(ctx *cmd.Context) error {
 	}
 	defer apiclient.Close()
 
+	if c.storageReport {
+		return c.formatStorageReport(ctx, apiclient)
+	}
+
 	var unit string
 	var application string
 	if names.IsValidApplication(c.target) {
@@ -11,10 +11,12 @@ import (
 	"github.com/juju/errors"
 	"github.com/juju/tc"
 
+	"github.com/juju/juju/api/client/resources"
 	resourcecmd "github.com/juju/juju/cmd/juju/resource"
 	"github.com/juju/juju/core/resource"
 	coreunit "github.com/juju/juju/core/unit"
 	charmresource "github.com/juju/juju/internal/charm/resource"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
 	"github.com/juju/juju/internal/testhelpers"
 )
 
@@ -620,6 +622,76 @@ charlie   2011-11-11T11:11  2012-12-12T12:12
 	s.stubDeps.stub.CheckCall(c, 1, "ListResources", []string{"svc"})
 }
 
+func (*ShowApplicationSuite) TestInitStorageReport(c *tc.C) {
+	s := resourcecmd.NewListCommandForTest(nil)
+	err := cmdtesting.InitCommand(s, []string{"--storage-report"})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (*ShowApplicationSuite) TestInitStorageReportWithTarget(c *tc.C) {
+	s := resourcecmd.NewListCommandForTest(nil)
+	err := cmdtesting.InitCommand(s, []string{"--storage-report", "foo"})
+	c.Assert(err, tc.ErrorIs, errors.BadRequest)
+}
+
+func (*ShowApplicationSuite) TestInitStorageReportWithDetails(c *tc.C) {
+	s := resourcecmd.NewListCommandForTest(nil)
+	err := cmdtesting.InitCommand(s, []string{"--storage-report", "--details"})
+	c.Assert(err, tc.ErrorMatches, "--details cannot be used with --storage-report")
+}
+
+func (s *ShowApplicationSuite) TestRunStorageReport(c *tc.C) {
+	s.stubDeps.client.ReturnStoredBlobs = []resources.StoredBlob{{
+		SHA384:  "0123456789abcdef",
+		Size:    2048,
+		Created: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		Usage:   "in-use",
+		References: []resources.BlobReference{{
+			Kind:     "charm",
+			Name:     "mysql",
+			Revision: 3,
+			InUse:    true,
+		}, {
+			Kind:            "resource",
+			Name:            "data",
+			ApplicationName: "mysql",
+			Revision:        -1,
+			InUse:           true,
+		}},
+	}, {
+		SHA384:  "fedcba9876543210",
+		Size:    1024,
+		Created: time.Date(2025, 1, 3, 3, 4, 5, 0, time.UTC),
+		Usage:   "unreferenced",
+	}}
+
+	cmd := resourcecmd.NewListCommandForTest(s.stubDeps.NewClient)
+
+	code, stdout, stderr := runCmd(c, cmd, "--storage-report")
+	c.Assert(code, tc.Equals, 0)
+	c.Assert(stderr, tc.Equals, "")
+	c.Check(stdout, tc.Equals, `
+Usage         Blobs  Size
+in-use        1      2.0 KiB
+unreferenced  1      1.0 KiB
+
+Blob          Size     Created              Usage         Held by
+0123456789ab  2.0 KiB  2025-01-02 03:04:05  in-use        charm mysql (rev 3), resource mysql/data
+fedcba987654  1.0 KiB  2025-01-03 03:04:05  unreferenced  -
+`[1:])
+
+	s.stubDeps.stub.CheckCallNames(c, "NewClient", "StorageReport", "Close")
+}
+
+func (s *ShowApplicationSuite) TestRunStorageReportEmpty(c *tc.C) {
+	cmd := resourcecmd.NewListCommandForTest(s.stubDeps.NewClient)
+
+	code, stdout, stderr := runCmd(c, cmd, "--storage-report")
+	c.Assert(code, tc.Equals, 0)
+	c.Check(stderr, tc.Equals, "No charm or resource blobs are stored in the model.\n")
+	c.Check(stdout, tc.Equals, "")
+}
+
 type stubShowApplicationDeps struct {
 	stub   *testhelpers.Stub
 	client *stubResourceClient
@@ -635,8 +707,9 @@ func (s *stubShowApplicationDeps) NewClient(ctx context.Context) (resourcecmd.Li
 }
 
 type stubResourceClient struct {
-	stub            *testhelpers.Stub
-	ReturnResources []resource.ApplicationResources
+	stub              *testhelpers.Stub
+	ReturnResources   []resource.ApplicationResources
+	ReturnStoredBlobs []resources.StoredBlob
 }
 
 func (s *stubResourceClient) ListResources(ctx context.Context, applications []string) ([]resource.ApplicationResources, error) {
@@ -647,6 +720,14 @@ func (s *stubResourceClient) ListResources(ctx context.Context, applications []s
 	return s.ReturnResources, nil
 }
 
+func (s *stubResourceClient) StorageReport(ctx context.Context) ([]resources.StoredBlob, error) {
+	s.stub.AddCall("StorageReport")
+	if err := s.stub.NextErr(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return s.ReturnStoredBlobs, nil
+}
+
 func (s *stubResourceClient) Close() error {
 	s.stub.AddCall("Close")
 	if err := s.stub.NextErr(); err != nil {
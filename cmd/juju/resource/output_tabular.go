@@ -77,6 +77,9 @@ func FormatAppTabular(writer io.Writer, value interface{}) error {
 	case FormattedUnitDetails:
 		formatUnitDetailTabular(writer, resources)
 		return nil
+	case FormattedStorageReport:
+		formatStorageReportTabular(writer, resources)
+		return nil
 	default:
 		return errors.Errorf("unexpected type for data: %T", resources)
 	}
@@ -0,0 +1,148 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	"github.com/juju/juju/api/client/resources"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/juju/block"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/internal/cmd"
+)
+
+// defaultPruneGracePeriod is how long an unreferenced blob is kept after it
+// was stored, unless told otherwise.
+const defaultPruneGracePeriod = 24 * time.Hour
+
+// PruneClient has the API client methods needed by PruneCommand.
+type PruneClient interface {
+	// PruneStorage removes the unreferenced charm and resource blobs from
+	// the model's object store.
+	PruneStorage(ctx context.Context, gracePeriod time.Duration, dryRun bool) ([]resources.StoredBlob, error)
+	// Close closes the connection.
+	Close() error
+}
+
+// PruneCommand removes unreferenced charm and resource blobs.
+type PruneCommand struct {
+	modelcmd.ModelCommandBase
+
+	newClient func(ctx context.Context) (PruneClient, error)
+
+	gracePeriod time.Duration
+	dryRun      bool
+	out         cmd.Output
+}
+
+// NewPruneCommand returns a new command that removes unreferenced charm and
+// resource blobs from the model's object store.
+func NewPruneCommand() modelcmd.ModelCommand {
+	c := &PruneCommand{}
+	c.newClient = func(ctx context.Context) (PruneClient, error) {
+		apiRoot, err := c.NewAPIRoot(ctx)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return resources.NewClient(apiRoot)
+	}
+	return modelcmd.Wrap(c)
+}
+
+const pruneResourcesExamples = `
+To see which blobs would be removed:
+
+	juju prune-resources --dry-run
+
+To remove unreferenced blobs stored more than a week ago:
+
+	juju prune-resources --grace-period 168h
+`
+
+// Info implements cmd.Command.Info.
+func (c *PruneCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:    "prune-resources",
+		Purpose: "Remove unreferenced charm and resource blobs from the model.",
+		SeeAlso: []string{
+			"resources",
+		},
+		Doc: `
+Charms and file resources uploaded to a model are kept as blobs in the
+model's object store. This command removes the blobs which are no longer
+referred to by any charm or resource revision, freeing the space they use.
+
+Blobs are only removed once they were stored longer ago than the grace
+period, so that blobs which are still being uploaded are left alone. Blobs
+holding old charm or resource revisions are not removed; they are reported
+as old revisions by "juju resources --storage-report".
+
+With --dry-run, the blobs which would be removed are shown and nothing is
+removed.
+`,
+		Examples: pruneResourcesExamples,
+	})
+}
+
+// SetFlags implements cmd.Command.SetFlags.
+func (c *PruneCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.ModelCommandBase.SetFlags(f)
+	const defaultFormat = "tabular"
+	c.out.AddFlags(f, defaultFormat, map[string]cmd.Formatter{
+		defaultFormat: FormatAppTabular,
+		"yaml":        cmd.FormatYaml,
+		"json":        cmd.FormatJson,
+	})
+
+	f.DurationVar(&c.gracePeriod, "grace-period", defaultPruneGracePeriod, "Only remove blobs stored longer ago than this")
+	f.BoolVar(&c.dryRun, "dry-run", false, "Show the blobs which would be removed without removing them")
+}
+
+// Init implements cmd.Command.Init.
+func (c *PruneCommand) Init(args []string) error {
+	if c.gracePeriod < 0 {
+		return errors.NotValidf("negative grace period %v", c.gracePeriod)
+	}
+	return cmd.CheckEmpty(args)
+}
+
+// Run implements cmd.Command.Run.
+func (c *PruneCommand) Run(ctx *cmd.Context) error {
+	client, err := c.newClient(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer client.Close()
+
+	blobs, err := client.PruneStorage(ctx, c.gracePeriod, c.dryRun)
+	if err != nil {
+		return block.ProcessBlockedError(errors.Annotate(err, "cannot prune resources"), block.BlockChange)
+	}
+	if len(blobs) == 0 {
+		ctx.Infof("No unreferenced blobs to remove.")
+		return nil
+	}
+
+	var size int64
+	for _, blob := range blobs {
+		size += blob.Size
+	}
+	verb := "Removed"
+	if c.dryRun {
+		verb = "Would remove"
+	}
+	report := formatStorageReport(blobs)
+	if err := c.out.Write(ctx, report); err != nil {
+		return errors.Trace(err)
+	}
+	fmt.Fprintf(ctx.Stderr, "%s %d blob(s), %s.\n", verb, len(blobs), humanize.IBytes(uint64(size)))
+	return nil
+}
@@ -0,0 +1,129 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package resource_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/api/client/resources"
+	resourcecmd "github.com/juju/juju/cmd/juju/resource"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+func TestPruneSuite(t *testing.T) {
+	tc.Run(t, &PruneSuite{})
+}
+
+type PruneSuite struct {
+	testhelpers.IsolationSuite
+
+	stub   *testhelpers.Stub
+	client *stubPruneClient
+}
+
+func (s *PruneSuite) SetUpTest(c *tc.C) {
+	s.IsolationSuite.SetUpTest(c)
+
+	s.stub = &testhelpers.Stub{}
+	s.client = &stubPruneClient{stub: s.stub}
+}
+
+func (s *PruneSuite) newClient(ctx context.Context) (resourcecmd.PruneClient, error) {
+	s.stub.AddCall("NewClient")
+	if err := s.stub.NextErr(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return s.client, nil
+}
+
+func (s *PruneSuite) TestInitTooManyArgs(c *tc.C) {
+	cmd := resourcecmd.NewPruneCommandForTest(nil)
+	err := cmdtesting.InitCommand(cmd, []string{"foo"})
+	c.Assert(err, tc.ErrorMatches, `unrecognized args: \["foo"\]`)
+}
+
+func (s *PruneSuite) TestInitNegativeGracePeriod(c *tc.C) {
+	cmd := resourcecmd.NewPruneCommandForTest(nil)
+	err := cmdtesting.InitCommand(cmd, []string{"--grace-period", "-1h"})
+	c.Assert(err, tc.ErrorIs, errors.NotValid)
+}
+
+func (s *PruneSuite) TestRun(c *tc.C) {
+	s.client.ReturnStoredBlobs = []resources.StoredBlob{{
+		SHA384:  "0123456789abcdef",
+		Size:    1024,
+		Created: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		Usage:   "unreferenced",
+	}}
+
+	cmd := resourcecmd.NewPruneCommandForTest(s.newClient)
+	code, stdout, stderr := runCmd(c, cmd)
+	c.Assert(code, tc.Equals, 0)
+	c.Check(stdout, tc.Equals, `
+Usage         Blobs  Size
+unreferenced  1      1.0 KiB
+
+Blob          Size     Created              Usage         Held by
+0123456789ab  1.0 KiB  2025-01-02 03:04:05  unreferenced  -
+`[1:])
+	c.Check(stderr, tc.Equals, "Removed 1 blob(s), 1.0 KiB.\n")
+
+	s.stub.CheckCall(c, 1, "PruneStorage", 24*time.Hour, false)
+}
+
+func (s *PruneSuite) TestRunDryRun(c *tc.C) {
+	s.client.ReturnStoredBlobs = []resources.StoredBlob{{
+		SHA384: "0123456789abcdef",
+		Size:   2048,
+		Usage:  "unreferenced",
+	}}
+
+	cmd := resourcecmd.NewPruneCommandForTest(s.newClient)
+	code, _, stderr := runCmd(c, cmd, "--dry-run", "--grace-period", "1h", "--format", "yaml")
+	c.Assert(code, tc.Equals, 0)
+	c.Check(stderr, tc.Equals, "Would remove 1 blob(s), 2.0 KiB.\n")
+
+	s.stub.CheckCall(c, 1, "PruneStorage", time.Hour, true)
+}
+
+func (s *PruneSuite) TestRunNothingToPrune(c *tc.C) {
+	cmd := resourcecmd.NewPruneCommandForTest(s.newClient)
+	code, stdout, stderr := runCmd(c, cmd)
+	c.Assert(code, tc.Equals, 0)
+	c.Check(stdout, tc.Equals, "")
+	c.Check(stderr, tc.Equals, "No unreferenced blobs to remove.\n")
+}
+
+func (s *PruneSuite) TestRunError(c *tc.C) {
+	s.stub.SetErrors(nil, errors.New("boom"))
+
+	cmd := resourcecmd.NewPruneCommandForTest(s.newClient)
+	code, _, stderr := runCmd(c, cmd)
+	c.Assert(code, tc.Equals, 1)
+	c.Check(stderr, tc.Matches, "ERROR cannot prune resources: boom\n")
+}
+
+type stubPruneClient struct {
+	stub              *testhelpers.Stub
+	ReturnStoredBlobs []resources.StoredBlob
+}
+
+func (s *stubPruneClient) PruneStorage(ctx context.Context, gracePeriod time.Duration, dryRun bool) ([]resources.StoredBlob, error) {
+	s.stub.AddCall("PruneStorage", gracePeriod, dryRun)
+	if err := s.stub.NextErr(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return s.ReturnStoredBlobs, nil
+}
+
+func (s *stubPruneClient) Close() error {
+	s.stub.AddCall("Close")
+	return nil
+}
@@ -0,0 +1,152 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package resource
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/api/client/resources"
+	"github.com/juju/juju/core/output"
+	"github.com/juju/juju/internal/cmd"
+)
+
+// storageUsages are the blob usages reported by the server, in the order
+// they are summarised.
+var storageUsages = []string{"in-use", "old-revision", "unreferenced"}
+
+// FormattedStorageReport holds the formatted representation of the blobs
+// held in the model's object store on behalf of charms and resources.
+type FormattedStorageReport struct {
+	Summary map[string]FormattedStorageUsage `json:"summary" yaml:"summary"`
+	Blobs   []FormattedStoredBlob            `json:"blobs,omitempty" yaml:"blobs,omitempty"`
+}
+
+// FormattedStorageUsage holds the number and total size of the blobs with a
+// given usage.
+type FormattedStorageUsage struct {
+	Count int   `json:"count" yaml:"count"`
+	Size  int64 `json:"size" yaml:"size"`
+}
+
+// FormattedStoredBlob holds the formatted representation of a stored blob.
+type FormattedStoredBlob struct {
+	SHA384     string                   `json:"sha384" yaml:"sha384"`
+	Size       int64                    `json:"size" yaml:"size"`
+	Created    time.Time                `json:"created" yaml:"created"`
+	Usage      string                   `json:"usage" yaml:"usage"`
+	Paths      []string                 `json:"paths,omitempty" yaml:"paths,omitempty"`
+	References []FormattedBlobReference `json:"references,omitempty" yaml:"references,omitempty"`
+}
+
+// FormattedBlobReference holds the formatted representation of a charm or
+// resource revision held in a blob.
+type FormattedBlobReference struct {
+	Kind        string `json:"kind" yaml:"kind"`
+	Name        string `json:"name" yaml:"name"`
+	Application string `json:"application,omitempty" yaml:"application,omitempty"`
+	Revision    int    `json:"revision" yaml:"revision"`
+	InUse       bool   `json:"in-use" yaml:"in-use"`
+}
+
+func formatStorageReport(blobs []resources.StoredBlob) FormattedStorageReport {
+	report := FormattedStorageReport{
+		Summary: make(map[string]FormattedStorageUsage),
+		Blobs:   make([]FormattedStoredBlob, len(blobs)),
+	}
+	for i, blob := range blobs {
+		usage := report.Summary[blob.Usage]
+		usage.Count++
+		usage.Size += blob.Size
+		report.Summary[blob.Usage] = usage
+
+		formatted := FormattedStoredBlob{
+			SHA384:  blob.SHA384,
+			Size:    blob.Size,
+			Created: blob.Created,
+			Usage:   blob.Usage,
+			Paths:   blob.Paths,
+		}
+		for _, ref := range blob.References {
+			formatted.References = append(formatted.References, FormattedBlobReference{
+				Kind:        ref.Kind,
+				Name:        ref.Name,
+				Application: ref.ApplicationName,
+				Revision:    ref.Revision,
+				InUse:       ref.InUse,
+			})
+		}
+		report.Blobs[i] = formatted
+	}
+	return report
+}
+
+func (c *ListCommand) formatStorageReport(ctx *cmd.Context, client ListClient) error {
+	blobs, err := client.StorageReport(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(blobs) == 0 {
+		ctx.Infof("No charm or resource blobs are stored in the model.")
+		return nil
+	}
+	return c.out.Write(ctx, formatStorageReport(blobs))
+}
+
+func formatStorageReportTabular(writer io.Writer, report FormattedStorageReport) {
+	tw := output.TabWriter(writer)
+
+	fmt.Fprintln(tw, "Usage\tBlobs\tSize")
+	for _, usage := range storageUsages {
+		summary, ok := report.Summary[usage]
+		if !ok {
+			continue
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%s\n", usage, summary.Count, humanize.IBytes(uint64(summary.Size)))
+	}
+
+	fmt.Fprintln(tw)
+	fmt.Fprintln(tw, "Blob\tSize\tCreated\tUsage\tHeld by")
+	for _, blob := range report.Blobs {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n",
+			shortHash(blob.SHA384),
+			humanize.IBytes(uint64(blob.Size)),
+			blob.Created.Format(time.DateTime),
+			blob.Usage,
+			formatBlobReferences(blob.References),
+		)
+	}
+	tw.Flush()
+}
+
+func formatBlobReferences(refs []FormattedBlobReference) string {
+	if len(refs) == 0 {
+		return "-"
+	}
+	held := make([]string, len(refs))
+	for i, ref := range refs {
+		name := ref.Name
+		if ref.Application != "" {
+			name = ref.Application + "/" + name
+		}
+		held[i] = fmt.Sprintf("%s %s", ref.Kind, name)
+		if ref.Revision >= 0 {
+			held[i] += fmt.Sprintf(" (rev %d)", ref.Revision)
+		}
+	}
+	return strings.Join(held, ", ")
+}
+
+func shortHash(hash string) string {
+	const length = 12
+	if len(hash) <= length {
+		return hash
+	}
+	return hash[:length]
+}
@@ -0,0 +1,88 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secretbackends
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/api/client/secretbackends"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/internal/cmd"
+)
+
+type rotateSecretEncryptionKeyCommand struct {
+	modelcmd.ControllerCommandBase
+
+	RotateSecretEncryptionKeyAPIFunc func(ctx context.Context) (RotateSecretEncryptionKeyAPI, error)
+}
+
+var rotateSecretEncryptionKeyDoc = `
+Secret content stored in the internal secret backend is encrypted with
+keys held in each model, which are themselves encrypted with a controller
+key. This command replaces the controller key, re-encrypting the model
+keys with the new one. Secrets remain available while the key is rotated.
+
+If the model keys cannot be re-encrypted for every model, the old
+controller key is kept so that no secret content becomes unreadable, and
+the command should be run again.
+`
+
+const rotateSecretEncryptionKeyExamples = `
+    juju rotate-secret-encryption-key
+`
+
+// RotateSecretEncryptionKeyAPI is the secret backends client API.
+type RotateSecretEncryptionKeyAPI interface {
+	RotateSecretEncryptionKey(context.Context) error
+	Close() error
+}
+
+// NewRotateSecretEncryptionKeyCommand returns a command to rotate the
+// controller secret encryption key.
+func NewRotateSecretEncryptionKeyCommand() cmd.Command {
+	c := &rotateSecretEncryptionKeyCommand{}
+	c.RotateSecretEncryptionKeyAPIFunc = c.secretBackendsAPI
+
+	return modelcmd.WrapController(c)
+}
+
+func (c *rotateSecretEncryptionKeyCommand) secretBackendsAPI(ctx context.Context) (RotateSecretEncryptionKeyAPI, error) {
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return secretbackends.NewClient(root), nil
+}
+
+// Info implements cmd.Info.
+func (c *rotateSecretEncryptionKeyCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "rotate-secret-encryption-key",
+		Purpose:  "Rotates the key used to encrypt secrets stored in the controller.",
+		Doc:      rotateSecretEncryptionKeyDoc,
+		Examples: rotateSecretEncryptionKeyExamples,
+		SeeAlso: []string{
+			"secret-backends",
+		},
+	})
+}
+
+// Init implements cmd.Init.
+func (c *rotateSecretEncryptionKeyCommand) Init(args []string) error {
+	return cmd.CheckEmpty(args)
+}
+
+// Run implements cmd.Run.
+func (c *rotateSecretEncryptionKeyCommand) Run(ctxt *cmd.Context) error {
+	api, err := c.RotateSecretEncryptionKeyAPIFunc(ctxt)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer api.Close()
+
+	return errors.Trace(api.RotateSecretEncryptionKey(ctxt))
+}
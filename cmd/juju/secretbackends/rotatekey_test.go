@@ -0,0 +1,68 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secretbackends_test
+
+import (
+	"testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/api/jujuclient"
+	"github.com/juju/juju/cmd/juju/secretbackends"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type RotateKeySuite struct {
+	testhelpers.IsolationSuite
+	store *jujuclient.MemStore
+	api   *secretbackends.MockRotateSecretEncryptionKeyAPI
+}
+
+func TestRotateKeySuite(t *testing.T) {
+	tc.Run(t, &RotateKeySuite{})
+}
+
+func (s *RotateKeySuite) SetUpTest(c *tc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	store := jujuclient.NewMemStore()
+	store.Controllers["mycontroller"] = jujuclient.ControllerDetails{}
+	store.CurrentControllerName = "mycontroller"
+	s.store = store
+}
+
+func (s *RotateKeySuite) setup(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.api = secretbackends.NewMockRotateSecretEncryptionKeyAPI(ctrl)
+
+	return ctrl
+}
+
+func (s *RotateKeySuite) TestRotateInitError(c *tc.C) {
+	_, err := cmdtesting.RunCommand(c, secretbackends.NewRotateSecretEncryptionKeyCommandForTest(s.store, s.api), "extra")
+	c.Assert(err, tc.ErrorMatches, `unrecognized args: \["extra"\]`)
+}
+
+func (s *RotateKeySuite) TestRotate(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.api.EXPECT().RotateSecretEncryptionKey(gomock.Any()).Return(nil)
+	s.api.EXPECT().Close().Return(nil)
+
+	_, err := cmdtesting.RunCommand(c, secretbackends.NewRotateSecretEncryptionKeyCommandForTest(s.store, s.api))
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *RotateKeySuite) TestRotateError(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	s.api.EXPECT().RotateSecretEncryptionKey(gomock.Any()).Return(errors.New("boom"))
+	s.api.EXPECT().Close().Return(nil)
+
+	_, err := cmdtesting.RunCommand(c, secretbackends.NewRotateSecretEncryptionKeyCommandForTest(s.store, s.api))
+	c.Assert(err, tc.ErrorMatches, "boom")
+}
@@ -0,0 +1,239 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secrets
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+	"golang.org/x/crypto/ssh/terminal"
+
+	apisecrets "github.com/juju/juju/api/client/secrets"
+	"github.com/juju/juju/api/common"
+	"github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/internal/passphrase"
+)
+
+// secretArchive holds secrets exported from a model.
+type secretArchive struct {
+	Secrets []archivedSecret `json:"secrets"`
+}
+
+type archivedSecret struct {
+	URI            string             `json:"uri"`
+	Version        int                `json:"version"`
+	Owner          string             `json:"owner"`
+	Description    string             `json:"description,omitempty"`
+	Label          string             `json:"label,omitempty"`
+	RotatePolicy   string             `json:"rotate-policy,omitempty"`
+	NextRotateTime *time.Time         `json:"next-rotate-time,omitempty"`
+	AutoPrune      bool               `json:"auto-prune,omitempty"`
+	Checksum       string             `json:"checksum,omitempty"`
+	ExpireTime     *time.Time         `json:"expire-time,omitempty"`
+	Revisions      []archivedRevision `json:"revisions"`
+}
+
+type archivedRevision struct {
+	Revision   int               `json:"revision"`
+	CreateTime time.Time         `json:"create-time"`
+	ExpireTime *time.Time        `json:"expire-time,omitempty"`
+	Content    map[string]string `json:"content"`
+}
+
+func newSecretArchive(exported []apisecrets.ExportedSecret) (secretArchive, error) {
+	archive := secretArchive{
+		Secrets: make([]archivedSecret, len(exported)),
+	}
+	for i, s := range exported {
+		ownerTag, err := common.OwnerTagFromSecretOwner(s.Metadata.Owner)
+		if err != nil {
+			return secretArchive{}, errors.Trace(err)
+		}
+		archived := archivedSecret{
+			URI:            s.Metadata.URI.String(),
+			Version:        s.Metadata.Version,
+			Owner:          ownerTag.String(),
+			Description:    s.Metadata.Description,
+			Label:          s.Metadata.Label,
+			RotatePolicy:   string(s.Metadata.RotatePolicy),
+			NextRotateTime: s.Metadata.NextRotateTime,
+			AutoPrune:      s.Metadata.AutoPrune,
+			Checksum:       s.Metadata.LatestRevisionChecksum,
+			ExpireTime:     s.Metadata.LatestExpireTime,
+			Revisions:      make([]archivedRevision, len(s.Revisions)),
+		}
+		for j, rev := range s.Revisions {
+			archived.Revisions[j] = archivedRevision{
+				Revision:   rev.Revision,
+				CreateTime: rev.CreateTime,
+				ExpireTime: rev.ExpireTime,
+				Content:    rev.Value.EncodedValues(),
+			}
+		}
+		archive.Secrets[i] = archived
+	}
+	return archive, nil
+}
+
+func (a secretArchive) exportedSecrets() ([]apisecrets.ExportedSecret, error) {
+	result := make([]apisecrets.ExportedSecret, len(a.Secrets))
+	for i, s := range a.Secrets {
+		uri, err := secrets.ParseURI(s.URI)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		owner, err := common.SecretOwnerFromTag(s.Owner)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		exported := apisecrets.ExportedSecret{
+			Metadata: secrets.SecretMetadata{
+				URI:                    uri,
+				Version:                s.Version,
+				Owner:                  owner,
+				Description:            s.Description,
+				Label:                  s.Label,
+				RotatePolicy:           secrets.RotatePolicy(s.RotatePolicy),
+				NextRotateTime:         s.NextRotateTime,
+				AutoPrune:              s.AutoPrune,
+				LatestRevisionChecksum: s.Checksum,
+				LatestExpireTime:       s.ExpireTime,
+			},
+			Revisions: make([]apisecrets.ExportedSecretRevision, len(s.Revisions)),
+		}
+		for j, rev := range s.Revisions {
+			exported.Revisions[j] = apisecrets.ExportedSecretRevision{
+				Revision:   rev.Revision,
+				CreateTime: rev.CreateTime,
+				ExpireTime: rev.ExpireTime,
+				Value:      secrets.NewSecretValue(rev.Content),
+			}
+		}
+		result[i] = exported
+	}
+	return result, nil
+}
+
+// writeSecretArchive encrypts the archive with the passphrase and writes
+// it to a new file at path.
+func writeSecretArchive(path, pass string, archive secretArchive) error {
+	plaintext, err := json.Marshal(archive)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	salt, err := passphrase.NewSalt()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	key, err := passphrase.DeriveKey(pass, salt)
+	if err != nil {
+		return errors.Annotate(err, "deriving secret archive key")
+	}
+	data, err := passphrase.Seal(plaintext, key, salt)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if os.IsExist(err) {
+		return errors.AlreadyExistsf("secret archive %q", path)
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return errors.Trace(err)
+	}
+	return errors.Trace(f.Close())
+}
+
+// readSecretArchive reads the archive at path and decrypts it with
+// the passphrase.
+func readSecretArchive(path, pass string) (secretArchive, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return secretArchive{}, errors.Trace(err)
+	}
+	plaintext, _, err := passphrase.Open(data, func(salt []byte) ([]byte, error) {
+		return passphrase.DeriveKey(pass, salt)
+	})
+	if err != nil {
+		return secretArchive{}, errors.Annotatef(err, "cannot decrypt %s", path)
+	}
+	var archive secretArchive
+	if err := json.Unmarshal(plaintext, &archive); err != nil {
+		return secretArchive{}, errors.Annotatef(err, "parsing %s", path)
+	}
+	return archive, nil
+}
+
+// archivePassphrase returns the passphrase for a secret archive, read from
+// passphraseFile if set, else prompted for. If confirm is true, a prompted
+// passphrase must be entered twice.
+func archivePassphrase(ctx *cmd.Context, passphraseFile string, confirm bool) (string, error) {
+	if passphraseFile != "" {
+		data, err := os.ReadFile(ctx.AbsPath(passphraseFile))
+		if err != nil {
+			return "", errors.Trace(err)
+		}
+		passphrase := strings.TrimRight(string(data), "\r\n")
+		if passphrase == "" {
+			return "", errors.Errorf("passphrase file %q is empty", passphraseFile)
+		}
+		return passphrase, nil
+	}
+
+	fmt.Fprint(ctx.Stderr, "passphrase: ")
+	passphrase, err := readPassphrase(ctx.Stdin)
+	fmt.Fprint(ctx.Stderr, "\n")
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	if passphrase == "" {
+		return "", errors.Errorf("you must enter a passphrase")
+	}
+	if !confirm {
+		return passphrase, nil
+	}
+	fmt.Fprint(ctx.Stderr, "type passphrase again: ")
+	verify, err := readPassphrase(ctx.Stdin)
+	fmt.Fprint(ctx.Stderr, "\n")
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	if passphrase != verify {
+		return "", errors.New("passphrases do not match")
+	}
+	return passphrase, nil
+}
+
+func readPassphrase(stdin io.Reader) (string, error) {
+	if f, ok := stdin.(*os.File); ok && terminal.IsTerminal(int(f.Fd())) {
+		passphrase, err := terminal.ReadPassword(int(f.Fd()))
+		if err != nil {
+			return "", errors.Trace(err)
+		}
+		return string(passphrase), nil
+	}
+	// Read one byte at a time to avoid reading beyond the delimiter.
+	line, err := bufio.NewReader(byteAtATimeReader{stdin}).ReadString('\n')
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+type byteAtATimeReader struct {
+	io.Reader
+}
+
+func (r byteAtATimeReader) Read(out []byte) (int, error) {
+	return r.Reader.Read(out[:1])
+}
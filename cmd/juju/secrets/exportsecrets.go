@@ -0,0 +1,157 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secrets
+
+import (
+	"context"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+	"github.com/juju/names/v6"
+
+	apisecrets "github.com/juju/juju/api/client/secrets"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/internal/cmd"
+)
+
+type exportSecretsCommand struct {
+	modelcmd.ModelCommandBase
+
+	secretsAPIFunc func(ctx context.Context) (ExportSecretsAPI, error)
+
+	archivePath    string
+	names          []string
+	owners         []secrets.Owner
+	ownersArg      string
+	passphraseFile string
+}
+
+// ExportSecretsAPI is the secrets client API.
+type ExportSecretsAPI interface {
+	ExportSecrets(ctx context.Context, labels []string, owners []secrets.Owner) ([]apisecrets.ExportedSecret, error)
+	Close() error
+}
+
+// NewExportSecretsCommand returns a command to export secrets to an archive.
+func NewExportSecretsCommand() cmd.Command {
+	c := &exportSecretsCommand{}
+	c.secretsAPIFunc = c.secretsAPI
+	return modelcmd.Wrap(c)
+}
+
+func (c *exportSecretsCommand) secretsAPI(ctx context.Context) (ExportSecretsAPI, error) {
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return apisecrets.NewClient(root), nil
+}
+
+const (
+	exportSecretsDoc = `
+Export secrets, with the content of each of their revisions, to an
+encrypted archive which can be imported into another model with
+` + "`juju import-secrets`" + `.
+
+By default all user secrets in the model are exported. Specify secret
+names to only export those secrets. Use ` + "`--owner`" + ` to export the
+secrets owned by the given applications or units instead.
+
+The archive is encrypted with a passphrase, which is prompted for unless
+` + "`--passphrase-file`" + ` is given. Secret content held in external
+secret backends is included in the archive.
+
+Only model admins can export secrets.
+`
+	exportSecretsExamples = `
+    juju export-secrets secrets.archive
+    juju export-secrets secrets.archive my-secret another-secret
+    juju export-secrets secrets.archive --owner application-mysql
+    juju export-secrets secrets.archive --passphrase-file ~/passphrase
+`
+)
+
+// Info implements cmd.Command.
+func (c *exportSecretsCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "export-secrets",
+		Args:     "<archive> [<name>...]",
+		Purpose:  "Export secrets to an encrypted archive.",
+		Doc:      exportSecretsDoc,
+		Examples: exportSecretsExamples,
+		SeeAlso: []string{
+			"import-secrets",
+			"secrets",
+		},
+	})
+}
+
+// SetFlags implements cmd.Command.
+func (c *exportSecretsCommand) SetFlags(f *gnuflag.FlagSet) {
+	f.StringVar(&c.ownersArg, "owner", "", "Export the secrets owned by these comma separated applications or units")
+	f.StringVar(&c.passphraseFile, "passphrase-file", "", "A file containing the archive passphrase")
+}
+
+// Init implements cmd.Command.
+func (c *exportSecretsCommand) Init(args []string) error {
+	if len(args) < 1 {
+		return errors.New("missing archive path")
+	}
+	c.archivePath = args[0]
+	if len(args) > 1 {
+		c.names = args[1:]
+	}
+	if c.ownersArg == "" {
+		return nil
+	}
+	for _, owner := range strings.Split(c.ownersArg, ",") {
+		ownerTag, err := names.ParseTag(owner)
+		if err != nil {
+			return errors.Maskf(err, "invalid owner %q", owner)
+		}
+		switch ownerTag.Kind() {
+		case names.ApplicationTagKind:
+			c.owners = append(c.owners, secrets.Owner{Kind: secrets.ApplicationOwner, ID: ownerTag.Id()})
+		case names.UnitTagKind:
+			c.owners = append(c.owners, secrets.Owner{Kind: secrets.UnitOwner, ID: ownerTag.Id()})
+		default:
+			return errors.Errorf("invalid owner %q", owner)
+		}
+	}
+	return nil
+}
+
+// Run implements cmd.Command.
+func (c *exportSecretsCommand) Run(ctx *cmd.Context) error {
+	passphrase, err := archivePassphrase(ctx, c.passphraseFile, true)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	secretsAPI, err := c.secretsAPIFunc(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer secretsAPI.Close()
+
+	exported, err := secretsAPI.ExportSecrets(ctx, c.names, c.owners)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(exported) == 0 {
+		return errors.New("no secrets to export")
+	}
+	archive, err := newSecretArchive(exported)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := writeSecretArchive(ctx.AbsPath(c.archivePath), passphrase, archive); err != nil {
+		return errors.Trace(err)
+	}
+	ctx.Infof("exported %d secrets to %s", len(exported), c.archivePath)
+	return nil
+}
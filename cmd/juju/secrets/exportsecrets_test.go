@@ -0,0 +1,182 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secrets_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	stdtesting "testing"
+	"time"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	apisecrets "github.com/juju/juju/api/client/secrets"
+	"github.com/juju/juju/api/jujuclient"
+	"github.com/juju/juju/cmd/juju/secrets"
+	"github.com/juju/juju/cmd/juju/secrets/mocks"
+	coresecrets "github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type ExportSecretsSuite struct {
+	testhelpers.IsolationSuite
+	store      *jujuclient.MemStore
+	secretsAPI *mocks.MockExportSecretsAPI
+}
+
+func TestExportSecretsSuite(t *stdtesting.T) {
+	tc.Run(t, &ExportSecretsSuite{})
+}
+
+func (s *ExportSecretsSuite) SetUpTest(c *tc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	store := jujuclient.NewMemStore()
+	store.Controllers["mycontroller"] = jujuclient.ControllerDetails{}
+	store.CurrentControllerName = "mycontroller"
+	s.store = store
+}
+
+func (s *ExportSecretsSuite) setup(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.secretsAPI = mocks.NewMockExportSecretsAPI(ctrl)
+
+	return ctrl
+}
+
+func writePassphraseFile(c *tc.C, dir, passphrase string) string {
+	path := filepath.Join(dir, "passphrase")
+	err := os.WriteFile(path, []byte(passphrase+"\n"), 0600)
+	c.Assert(err, tc.ErrorIsNil)
+	return path
+}
+
+func exportedSecretsForTest() []apisecrets.ExportedSecret {
+	created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	return []apisecrets.ExportedSecret{{
+		Metadata: coresecrets.SecretMetadata{
+			URI:         coresecrets.NewURI(),
+			Version:     1,
+			Owner:       coresecrets.Owner{Kind: coresecrets.ModelOwner, ID: "deadbeef-0bad-400d-8000-4b1d0d06f00d"},
+			Description: "my secret",
+			Label:       "my-secret",
+		},
+		Revisions: []apisecrets.ExportedSecretRevision{{
+			Revision:   1,
+			CreateTime: created,
+			Value:      coresecrets.NewSecretValue(map[string]string{"foo": "YmFy"}),
+		}},
+	}, {
+		Metadata: coresecrets.SecretMetadata{
+			URI:          coresecrets.NewURI(),
+			Version:      1,
+			Owner:        coresecrets.Owner{Kind: coresecrets.ApplicationOwner, ID: "mysql"},
+			RotatePolicy: coresecrets.RotateDaily,
+		},
+		Revisions: []apisecrets.ExportedSecretRevision{{
+			Revision:   2,
+			CreateTime: created,
+			Value:      coresecrets.NewSecretValue(map[string]string{"password": "c2VjcmV0"}),
+		}},
+	}}
+}
+
+func (s *ExportSecretsSuite) TestInit(c *tc.C) {
+	_, err := cmdtesting.RunCommand(c, secrets.NewExportSecretsCommandForTest(s.store, s.secretsAPI))
+	c.Assert(err, tc.ErrorMatches, "missing archive path")
+	_, err = cmdtesting.RunCommand(c, secrets.NewExportSecretsCommandForTest(s.store, s.secretsAPI), "archive", "--owner", "model-uuid")
+	c.Assert(err, tc.ErrorMatches, `invalid owner "model-uuid": .*`)
+	_, err = cmdtesting.RunCommand(c, secrets.NewExportSecretsCommandForTest(s.store, s.secretsAPI), "archive", "--owner", "machine-0")
+	c.Assert(err, tc.ErrorMatches, `invalid owner "machine-0"`)
+}
+
+func (s *ExportSecretsSuite) TestExport(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	dir := c.MkDir()
+	archivePath := filepath.Join(dir, "secrets.archive")
+	exported := exportedSecretsForTest()
+	s.secretsAPI.EXPECT().ExportSecrets(gomock.Any(), []string{"my-secret"}, []coresecrets.Owner{
+		{Kind: coresecrets.ApplicationOwner, ID: "mysql"},
+		{Kind: coresecrets.UnitOwner, ID: "mysql/0"},
+	}).Return(exported, nil)
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	ctx, err := cmdtesting.RunCommand(c, secrets.NewExportSecretsCommandForTest(s.store, s.secretsAPI),
+		archivePath, "my-secret", "--owner", "application-mysql,unit-mysql-0",
+		"--passphrase-file", writePassphraseFile(c, dir, "passw0rd"),
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(cmdtesting.Stderr(ctx), tc.Contains, "exported 2 secrets to "+archivePath)
+
+	info, err := os.Stat(archivePath)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(info.Mode().Perm(), tc.Equals, os.FileMode(0600))
+	data, err := os.ReadFile(archivePath)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(string(data), tc.Not(tc.Contains), "my-secret")
+}
+
+func (s *ExportSecretsSuite) TestExportPrompt(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	archivePath := filepath.Join(c.MkDir(), "secrets.archive")
+	s.secretsAPI.EXPECT().ExportSecrets(gomock.Any(), nil, nil).Return(exportedSecretsForTest(), nil)
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	command := secrets.NewExportSecretsCommandForTest(s.store, s.secretsAPI)
+	err := cmdtesting.InitCommand(command, []string{archivePath})
+	c.Assert(err, tc.ErrorIsNil)
+	ctx := cmdtesting.Context(c)
+	ctx.Stdin = strings.NewReader("passw0rd\npassw0rd\n")
+	err = command.Run(ctx)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(cmdtesting.Stderr(ctx), tc.Contains, "passphrase: \ntype passphrase again: \n")
+	_, err = os.Stat(archivePath)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *ExportSecretsSuite) TestExportPromptMismatch(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	command := secrets.NewExportSecretsCommandForTest(s.store, s.secretsAPI)
+	err := cmdtesting.InitCommand(command, []string{filepath.Join(c.MkDir(), "secrets.archive")})
+	c.Assert(err, tc.ErrorIsNil)
+	ctx := cmdtesting.Context(c)
+	ctx.Stdin = strings.NewReader("passw0rd\nother\n")
+	err = command.Run(ctx)
+	c.Assert(err, tc.ErrorMatches, "passphrases do not match")
+}
+
+func (s *ExportSecretsSuite) TestExportNothing(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	dir := c.MkDir()
+	s.secretsAPI.EXPECT().ExportSecrets(gomock.Any(), nil, nil).Return(nil, nil)
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	_, err := cmdtesting.RunCommand(c, secrets.NewExportSecretsCommandForTest(s.store, s.secretsAPI),
+		filepath.Join(dir, "secrets.archive"), "--passphrase-file", writePassphraseFile(c, dir, "passw0rd"),
+	)
+	c.Assert(err, tc.ErrorMatches, "no secrets to export")
+}
+
+func (s *ExportSecretsSuite) TestExportArchiveExists(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	dir := c.MkDir()
+	archivePath := filepath.Join(dir, "secrets.archive")
+	err := os.WriteFile(archivePath, nil, 0600)
+	c.Assert(err, tc.ErrorIsNil)
+	s.secretsAPI.EXPECT().ExportSecrets(gomock.Any(), nil, nil).Return(exportedSecretsForTest(), nil)
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	_, err = cmdtesting.RunCommand(c, secrets.NewExportSecretsCommandForTest(s.store, s.secretsAPI),
+		archivePath, "--passphrase-file", writePassphraseFile(c, dir, "passw0rd"),
+	)
+	c.Assert(err, tc.ErrorMatches, `secret archive ".*secrets.archive" already exists`)
+}
@@ -0,0 +1,140 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secrets
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	apisecrets "github.com/juju/juju/api/client/secrets"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/internal/cmd"
+)
+
+type importSecretsCommand struct {
+	modelcmd.ModelCommandBase
+
+	secretsAPIFunc func(ctx context.Context) (ImportSecretsAPI, error)
+
+	archivePath    string
+	passphraseFile string
+}
+
+// ImportSecretsAPI is the secrets client API.
+type ImportSecretsAPI interface {
+	ImportSecrets(ctx context.Context, toImport []apisecrets.ExportedSecret) ([]error, error)
+	Close() error
+}
+
+// NewImportSecretsCommand returns a command to import secrets from an archive.
+func NewImportSecretsCommand() cmd.Command {
+	c := &importSecretsCommand{}
+	c.secretsAPIFunc = c.secretsAPI
+	return modelcmd.Wrap(c)
+}
+
+func (c *importSecretsCommand) secretsAPI(ctx context.Context) (ImportSecretsAPI, error) {
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return apisecrets.NewClient(root), nil
+}
+
+const (
+	importSecretsDoc = `
+Import secrets from an archive created with ` + "`juju export-secrets`" + `.
+
+Secrets keep their IDs, so references to them remain valid. User secrets
+become owned by the current model. Secrets owned by applications or units
+can only be imported if those applications or units exist in the model.
+Grants of access to the secrets are not imported.
+
+Revisions are renumbered from 1, and their content is stored in the model's
+internal secret store. A secret which already exists in the model, or which
+has a name already used in the model, is not imported.
+
+The archive passphrase is prompted for unless ` + "`--passphrase-file`" + `
+is given.
+
+Only model admins can import secrets.
+`
+	importSecretsExamples = `
+    juju import-secrets secrets.archive
+    juju import-secrets secrets.archive --passphrase-file ~/passphrase
+`
+)
+
+// Info implements cmd.Command.
+func (c *importSecretsCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "import-secrets",
+		Args:     "<archive>",
+		Purpose:  "Import secrets from an encrypted archive.",
+		Doc:      importSecretsDoc,
+		Examples: importSecretsExamples,
+		SeeAlso: []string{
+			"export-secrets",
+			"secrets",
+		},
+	})
+}
+
+// SetFlags implements cmd.Command.
+func (c *importSecretsCommand) SetFlags(f *gnuflag.FlagSet) {
+	f.StringVar(&c.passphraseFile, "passphrase-file", "", "A file containing the archive passphrase")
+}
+
+// Init implements cmd.Command.
+func (c *importSecretsCommand) Init(args []string) error {
+	if len(args) < 1 {
+		return errors.New("missing archive path")
+	}
+	c.archivePath = args[0]
+	return cmd.CheckEmpty(args[1:])
+}
+
+// Run implements cmd.Command.
+func (c *importSecretsCommand) Run(ctx *cmd.Context) error {
+	passphrase, err := archivePassphrase(ctx, c.passphraseFile, false)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	archive, err := readSecretArchive(ctx.AbsPath(c.archivePath), passphrase)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	toImport, err := archive.exportedSecrets()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	secretsAPI, err := c.secretsAPIFunc(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer secretsAPI.Close()
+
+	errs, err := secretsAPI.ImportSecrets(ctx, toImport)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	failed := 0
+	for i, err := range errs {
+		if err == nil {
+			continue
+		}
+		failed++
+		fmt.Fprintf(ctx.Stderr, "cannot import secret %s: %v\n", toImport[i].Metadata.URI.ID, err)
+	}
+	ctx.Infof("imported %d of %d secrets", len(toImport)-failed, len(toImport))
+	if failed > 0 {
+		return cmd.ErrSilent
+	}
+	return nil
+}
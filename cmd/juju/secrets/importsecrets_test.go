@@ -0,0 +1,116 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secrets_test
+
+import (
+	"path/filepath"
+	stdtesting "testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	apisecrets "github.com/juju/juju/api/client/secrets"
+	"github.com/juju/juju/api/jujuclient"
+	"github.com/juju/juju/cmd/juju/secrets"
+	"github.com/juju/juju/cmd/juju/secrets/mocks"
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type ImportSecretsSuite struct {
+	testhelpers.IsolationSuite
+	store      *jujuclient.MemStore
+	exportAPI  *mocks.MockExportSecretsAPI
+	secretsAPI *mocks.MockImportSecretsAPI
+}
+
+func TestImportSecretsSuite(t *stdtesting.T) {
+	tc.Run(t, &ImportSecretsSuite{})
+}
+
+func (s *ImportSecretsSuite) SetUpTest(c *tc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	store := jujuclient.NewMemStore()
+	store.Controllers["mycontroller"] = jujuclient.ControllerDetails{}
+	store.CurrentControllerName = "mycontroller"
+	s.store = store
+}
+
+func (s *ImportSecretsSuite) setup(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.exportAPI = mocks.NewMockExportSecretsAPI(ctrl)
+	s.secretsAPI = mocks.NewMockImportSecretsAPI(ctrl)
+
+	return ctrl
+}
+
+// exportArchive writes an archive of the exported secrets, returning
+// its path and the path of its passphrase file.
+func (s *ImportSecretsSuite) exportArchive(c *tc.C, exported []apisecrets.ExportedSecret) (string, string) {
+	dir := c.MkDir()
+	archivePath := filepath.Join(dir, "secrets.archive")
+	passphraseFile := writePassphraseFile(c, dir, "passw0rd")
+	s.exportAPI.EXPECT().ExportSecrets(gomock.Any(), nil, nil).Return(exported, nil)
+	s.exportAPI.EXPECT().Close().Return(nil)
+	_, err := cmdtesting.RunCommand(c, secrets.NewExportSecretsCommandForTest(s.store, s.exportAPI),
+		archivePath, "--passphrase-file", passphraseFile,
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	return archivePath, passphraseFile
+}
+
+func (s *ImportSecretsSuite) TestInit(c *tc.C) {
+	_, err := cmdtesting.RunCommand(c, secrets.NewImportSecretsCommandForTest(s.store, s.secretsAPI))
+	c.Assert(err, tc.ErrorMatches, "missing archive path")
+	_, err = cmdtesting.RunCommand(c, secrets.NewImportSecretsCommandForTest(s.store, s.secretsAPI), "archive", "extra")
+	c.Assert(err, tc.ErrorMatches, `unrecognized args: \["extra"\]`)
+}
+
+func (s *ImportSecretsSuite) TestImport(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	exported := exportedSecretsForTest()
+	archivePath, passphraseFile := s.exportArchive(c, exported)
+	s.secretsAPI.EXPECT().ImportSecrets(gomock.Any(), exported).Return([]error{nil, nil}, nil)
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	ctx, err := cmdtesting.RunCommand(c, secrets.NewImportSecretsCommandForTest(s.store, s.secretsAPI),
+		archivePath, "--passphrase-file", passphraseFile,
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(cmdtesting.Stderr(ctx), tc.Contains, "imported 2 of 2 secrets")
+}
+
+func (s *ImportSecretsSuite) TestImportPartialFailure(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	exported := exportedSecretsForTest()
+	archivePath, passphraseFile := s.exportArchive(c, exported)
+	s.secretsAPI.EXPECT().ImportSecrets(gomock.Any(), exported).Return([]error{
+		errors.AlreadyExistsf("secret %q", exported[0].Metadata.URI.ID), nil,
+	}, nil)
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	ctx, err := cmdtesting.RunCommand(c, secrets.NewImportSecretsCommandForTest(s.store, s.secretsAPI),
+		archivePath, "--passphrase-file", passphraseFile,
+	)
+	c.Assert(err, tc.Equals, cmd.ErrSilent)
+	stderr := cmdtesting.Stderr(ctx)
+	c.Assert(stderr, tc.Contains, "cannot import secret "+exported[0].Metadata.URI.ID)
+	c.Assert(stderr, tc.Contains, "imported 1 of 2 secrets")
+}
+
+func (s *ImportSecretsSuite) TestImportWrongPassphrase(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	archivePath, _ := s.exportArchive(c, exportedSecretsForTest())
+
+	_, err := cmdtesting.RunCommand(c, secrets.NewImportSecretsCommandForTest(s.store, s.secretsAPI),
+		archivePath, "--passphrase-file", writePassphraseFile(c, c.MkDir(), "wrong"),
+	)
+	c.Assert(err, tc.ErrorMatches, "cannot decrypt .*: wrong passphrase or corrupt file")
+}
@@ -108,22 +108,29 @@ type secretRevisionDetails struct {
 type secretDetailsByID map[string]secretDisplayDetails
 
 type secretDisplayDetails struct {
-	URI                    *secrets.URI            `json:"-" yaml:"-"`
-	LatestRevision         int                     `json:"revision" yaml:"revision"`
-	LatestRevisionChecksum string                  `json:"checksum,omitempty" yaml:"checksum,omitempty"`
-	LatestExpireTime       *time.Time              `json:"expires,omitempty" yaml:"expires,omitempty"`
-	RotatePolicy           secrets.RotatePolicy    `json:"rotation,omitempty" yaml:"rotation,omitempty"`
-	NextRotateTime         *time.Time              `json:"rotates,omitempty" yaml:"rotates,omitempty"`
-	Owner                  string                  `json:"owner,omitempty" yaml:"owner,omitempty"`
-	Description            string                  `json:"description,omitempty" yaml:"description,omitempty"`
-	Name                   string                  `json:"name,omitempty" yaml:"name,omitempty"`
-	Label                  string                  `json:"label,omitempty" yaml:"label,omitempty"`
-	CreateTime             time.Time               `json:"created" yaml:"created"`
-	UpdateTime             time.Time               `json:"updated" yaml:"updated"`
-	Error                  string                  `json:"error,omitempty" yaml:"error,omitempty"`
-	Value                  *secretValueDetails     `json:"content,omitempty" yaml:"content,omitempty"`
-	Revisions              []secretRevisionDetails `json:"revisions,omitempty" yaml:"revisions,omitempty"`
-	Access                 []AccessInfo            `yaml:"access,omitempty" json:"access,omitempty"`
+	URI                    *secrets.URI             `json:"-" yaml:"-"`
+	LatestRevision         int                      `json:"revision" yaml:"revision"`
+	LatestRevisionChecksum string                   `json:"checksum,omitempty" yaml:"checksum,omitempty"`
+	LatestExpireTime       *time.Time               `json:"expires,omitempty" yaml:"expires,omitempty"`
+	RotatePolicy           secrets.RotatePolicy     `json:"rotation,omitempty" yaml:"rotation,omitempty"`
+	NextRotateTime         *time.Time               `json:"rotates,omitempty" yaml:"rotates,omitempty"`
+	Owner                  string                   `json:"owner,omitempty" yaml:"owner,omitempty"`
+	Description            string                   `json:"description,omitempty" yaml:"description,omitempty"`
+	Name                   string                   `json:"name,omitempty" yaml:"name,omitempty"`
+	Label                  string                   `json:"label,omitempty" yaml:"label,omitempty"`
+	CreateTime             time.Time                `json:"created" yaml:"created"`
+	UpdateTime             time.Time                `json:"updated" yaml:"updated"`
+	Error                  string                   `json:"error,omitempty" yaml:"error,omitempty"`
+	Value                  *secretValueDetails      `json:"content,omitempty" yaml:"content,omitempty"`
+	Revisions              []secretRevisionDetails  `json:"revisions,omitempty" yaml:"revisions,omitempty"`
+	Access                 []AccessInfo             `yaml:"access,omitempty" json:"access,omitempty"`
+	AccessLog              []secretAccessLogDetails `yaml:"access-log,omitempty" json:"access-log,omitempty"`
+}
+
+type secretAccessLogDetails struct {
+	Revision   int       `json:"revision" yaml:"revision"`
+	Accessor   string    `json:"accessor" yaml:"accessor"`
+	AccessTime time.Time `json:"time" yaml:"time"`
 }
 
 // AccessInfo holds info about a secret access information.
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/cmd/juju/secrets (interfaces: ListSecretsAPI,AddSecretsAPI,GrantRevokeSecretsAPI,UpdateSecretsAPI,RemoveSecretsAPI)
+// Source: github.com/juju/juju/cmd/juju/secrets (interfaces: ListSecretsAPI,ShowSecretsAPI,AddSecretsAPI,GrantRevokeSecretsAPI,UpdateSecretsAPI,RemoveSecretsAPI,ExportSecretsAPI,ImportSecretsAPI)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package mocks -destination mocks/secretsapi.go github.com/juju/juju/cmd/juju/secrets ListSecretsAPI,AddSecretsAPI,GrantRevokeSecretsAPI,UpdateSecretsAPI,RemoveSecretsAPI
+//	mockgen -typed -package mocks -destination mocks/secretsapi.go github.com/juju/juju/cmd/juju/secrets ListSecretsAPI,ShowSecretsAPI,AddSecretsAPI,GrantRevokeSecretsAPI,UpdateSecretsAPI,RemoveSecretsAPI,ExportSecretsAPI,ImportSecretsAPI
 //
 
 // Package mocks is a generated GoMock package.
@@ -118,6 +118,145 @@ func (c *MockListSecretsAPIListSecretsCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// MockShowSecretsAPI is a mock of ShowSecretsAPI interface.
+type MockShowSecretsAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockShowSecretsAPIMockRecorder
+}
+
+// MockShowSecretsAPIMockRecorder is the mock recorder for MockShowSecretsAPI.
+type MockShowSecretsAPIMockRecorder struct {
+	mock *MockShowSecretsAPI
+}
+
+// NewMockShowSecretsAPI creates a new mock instance.
+func NewMockShowSecretsAPI(ctrl *gomock.Controller) *MockShowSecretsAPI {
+	mock := &MockShowSecretsAPI{ctrl: ctrl}
+	mock.recorder = &MockShowSecretsAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShowSecretsAPI) EXPECT() *MockShowSecretsAPIMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockShowSecretsAPI) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockShowSecretsAPIMockRecorder) Close() *MockShowSecretsAPICloseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockShowSecretsAPI)(nil).Close))
+	return &MockShowSecretsAPICloseCall{Call: call}
+}
+
+// MockShowSecretsAPICloseCall wrap *gomock.Call
+type MockShowSecretsAPICloseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockShowSecretsAPICloseCall) Return(arg0 error) *MockShowSecretsAPICloseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockShowSecretsAPICloseCall) Do(f func() error) *MockShowSecretsAPICloseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockShowSecretsAPICloseCall) DoAndReturn(f func() error) *MockShowSecretsAPICloseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListSecrets mocks base method.
+func (m *MockShowSecretsAPI) ListSecrets(arg0 context.Context, arg1 bool, arg2 secrets0.Filter) ([]secrets.SecretDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecrets", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]secrets.SecretDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecrets indicates an expected call of ListSecrets.
+func (mr *MockShowSecretsAPIMockRecorder) ListSecrets(arg0, arg1, arg2 any) *MockShowSecretsAPIListSecretsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecrets", reflect.TypeOf((*MockShowSecretsAPI)(nil).ListSecrets), arg0, arg1, arg2)
+	return &MockShowSecretsAPIListSecretsCall{Call: call}
+}
+
+// MockShowSecretsAPIListSecretsCall wrap *gomock.Call
+type MockShowSecretsAPIListSecretsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockShowSecretsAPIListSecretsCall) Return(arg0 []secrets.SecretDetails, arg1 error) *MockShowSecretsAPIListSecretsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockShowSecretsAPIListSecretsCall) Do(f func(context.Context, bool, secrets0.Filter) ([]secrets.SecretDetails, error)) *MockShowSecretsAPIListSecretsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockShowSecretsAPIListSecretsCall) DoAndReturn(f func(context.Context, bool, secrets0.Filter) ([]secrets.SecretDetails, error)) *MockShowSecretsAPIListSecretsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SecretAccessLog mocks base method.
+func (m *MockShowSecretsAPI) SecretAccessLog(arg0 context.Context, arg1 *secrets0.URI, arg2 *int) ([]secrets.AccessLogEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SecretAccessLog", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]secrets.AccessLogEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SecretAccessLog indicates an expected call of SecretAccessLog.
+func (mr *MockShowSecretsAPIMockRecorder) SecretAccessLog(arg0, arg1, arg2 any) *MockShowSecretsAPISecretAccessLogCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecretAccessLog", reflect.TypeOf((*MockShowSecretsAPI)(nil).SecretAccessLog), arg0, arg1, arg2)
+	return &MockShowSecretsAPISecretAccessLogCall{Call: call}
+}
+
+// MockShowSecretsAPISecretAccessLogCall wrap *gomock.Call
+type MockShowSecretsAPISecretAccessLogCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockShowSecretsAPISecretAccessLogCall) Return(arg0 []secrets.AccessLogEntry, arg1 error) *MockShowSecretsAPISecretAccessLogCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockShowSecretsAPISecretAccessLogCall) Do(f func(context.Context, *secrets0.URI, *int) ([]secrets.AccessLogEntry, error)) *MockShowSecretsAPISecretAccessLogCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockShowSecretsAPISecretAccessLogCall) DoAndReturn(f func(context.Context, *secrets0.URI, *int) ([]secrets.AccessLogEntry, error)) *MockShowSecretsAPISecretAccessLogCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockAddSecretsAPI is a mock of AddSecretsAPI interface.
 type MockAddSecretsAPI struct {
 	ctrl     *gomock.Controller
@@ -554,3 +693,203 @@ func (c *MockRemoveSecretsAPIRemoveSecretCall) DoAndReturn(f func(context.Contex
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockExportSecretsAPI is a mock of ExportSecretsAPI interface.
+type MockExportSecretsAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockExportSecretsAPIMockRecorder
+}
+
+// MockExportSecretsAPIMockRecorder is the mock recorder for MockExportSecretsAPI.
+type MockExportSecretsAPIMockRecorder struct {
+	mock *MockExportSecretsAPI
+}
+
+// NewMockExportSecretsAPI creates a new mock instance.
+func NewMockExportSecretsAPI(ctrl *gomock.Controller) *MockExportSecretsAPI {
+	mock := &MockExportSecretsAPI{ctrl: ctrl}
+	mock.recorder = &MockExportSecretsAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExportSecretsAPI) EXPECT() *MockExportSecretsAPIMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockExportSecretsAPI) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockExportSecretsAPIMockRecorder) Close() *MockExportSecretsAPICloseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockExportSecretsAPI)(nil).Close))
+	return &MockExportSecretsAPICloseCall{Call: call}
+}
+
+// MockExportSecretsAPICloseCall wrap *gomock.Call
+type MockExportSecretsAPICloseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockExportSecretsAPICloseCall) Return(arg0 error) *MockExportSecretsAPICloseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockExportSecretsAPICloseCall) Do(f func() error) *MockExportSecretsAPICloseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockExportSecretsAPICloseCall) DoAndReturn(f func() error) *MockExportSecretsAPICloseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ExportSecrets mocks base method.
+func (m *MockExportSecretsAPI) ExportSecrets(arg0 context.Context, arg1 []string, arg2 []secrets0.Owner) ([]secrets.ExportedSecret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportSecrets", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]secrets.ExportedSecret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportSecrets indicates an expected call of ExportSecrets.
+func (mr *MockExportSecretsAPIMockRecorder) ExportSecrets(arg0, arg1, arg2 any) *MockExportSecretsAPIExportSecretsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportSecrets", reflect.TypeOf((*MockExportSecretsAPI)(nil).ExportSecrets), arg0, arg1, arg2)
+	return &MockExportSecretsAPIExportSecretsCall{Call: call}
+}
+
+// MockExportSecretsAPIExportSecretsCall wrap *gomock.Call
+type MockExportSecretsAPIExportSecretsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockExportSecretsAPIExportSecretsCall) Return(arg0 []secrets.ExportedSecret, arg1 error) *MockExportSecretsAPIExportSecretsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockExportSecretsAPIExportSecretsCall) Do(f func(context.Context, []string, []secrets0.Owner) ([]secrets.ExportedSecret, error)) *MockExportSecretsAPIExportSecretsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockExportSecretsAPIExportSecretsCall) DoAndReturn(f func(context.Context, []string, []secrets0.Owner) ([]secrets.ExportedSecret, error)) *MockExportSecretsAPIExportSecretsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockImportSecretsAPI is a mock of ImportSecretsAPI interface.
+type MockImportSecretsAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockImportSecretsAPIMockRecorder
+}
+
+// MockImportSecretsAPIMockRecorder is the mock recorder for MockImportSecretsAPI.
+type MockImportSecretsAPIMockRecorder struct {
+	mock *MockImportSecretsAPI
+}
+
+// NewMockImportSecretsAPI creates a new mock instance.
+func NewMockImportSecretsAPI(ctrl *gomock.Controller) *MockImportSecretsAPI {
+	mock := &MockImportSecretsAPI{ctrl: ctrl}
+	mock.recorder = &MockImportSecretsAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImportSecretsAPI) EXPECT() *MockImportSecretsAPIMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockImportSecretsAPI) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockImportSecretsAPIMockRecorder) Close() *MockImportSecretsAPICloseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockImportSecretsAPI)(nil).Close))
+	return &MockImportSecretsAPICloseCall{Call: call}
+}
+
+// MockImportSecretsAPICloseCall wrap *gomock.Call
+type MockImportSecretsAPICloseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockImportSecretsAPICloseCall) Return(arg0 error) *MockImportSecretsAPICloseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockImportSecretsAPICloseCall) Do(f func() error) *MockImportSecretsAPICloseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockImportSecretsAPICloseCall) DoAndReturn(f func() error) *MockImportSecretsAPICloseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ImportSecrets mocks base method.
+func (m *MockImportSecretsAPI) ImportSecrets(arg0 context.Context, arg1 []secrets.ExportedSecret) ([]error, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportSecrets", arg0, arg1)
+	ret0, _ := ret[0].([]error)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportSecrets indicates an expected call of ImportSecrets.
+func (mr *MockImportSecretsAPIMockRecorder) ImportSecrets(arg0, arg1 any) *MockImportSecretsAPIImportSecretsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportSecrets", reflect.TypeOf((*MockImportSecretsAPI)(nil).ImportSecrets), arg0, arg1)
+	return &MockImportSecretsAPIImportSecretsCall{Call: call}
+}
+
+// MockImportSecretsAPIImportSecretsCall wrap *gomock.Call
+type MockImportSecretsAPIImportSecretsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockImportSecretsAPIImportSecretsCall) Return(arg0 []error, arg1 error) *MockImportSecretsAPIImportSecretsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockImportSecretsAPIImportSecretsCall) Do(f func(context.Context, []secrets.ExportedSecret) ([]error, error)) *MockImportSecretsAPIImportSecretsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockImportSecretsAPIImportSecretsCall) DoAndReturn(f func(context.Context, []secrets.ExportedSecret) ([]error, error)) *MockImportSecretsAPIImportSecretsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -20,12 +20,19 @@ type showSecretsCommand struct {
 	modelcmd.ModelCommandBase
 	out cmd.Output
 
-	listSecretsAPIFunc func(ctx context.Context) (ListSecretsAPI, error)
+	listSecretsAPIFunc func(ctx context.Context) (ShowSecretsAPI, error)
 	uri                *coresecrets.URI
 	name               string
 	revealSecrets      bool
 	revisions          bool
 	revision           int
+	accessLog          bool
+}
+
+// ShowSecretsAPI is the secrets client API used by show-secret.
+type ShowSecretsAPI interface {
+	ListSecretsAPI
+	SecretAccessLog(ctx context.Context, uri *coresecrets.URI, revision *int) ([]apisecrets.AccessLogEntry, error)
 }
 
 var showSecretsDoc = `
@@ -36,6 +43,10 @@ with the ` + "`--reveal`" + ` option in the ` + "`json`" + ` or ` + "`yaml`" + `
 
 Use ` + "`--revision`" + ` to inspect a particular revision, else latest is used.
 Use ` + "`--revisions`" + ` to see the metadata for each revision.
+
+Use ` + "`--access-log`" + ` to see which units, applications and users have
+read the secret content, and when. Combine with ` + "`--revision`" + ` to only
+see reads of that revision. Reads are kept for 90 days.
 `
 
 const showSecretsExamples = `
@@ -45,6 +56,8 @@ const showSecretsExamples = `
     juju show-secret 9m4e2mr0ui3e8a215n4g --revision 2 --reveal
     juju show-secret 9m4e2mr0ui3e8a215n4g --revisions
     juju show-secret 9m4e2mr0ui3e8a215n4g --reveal
+    juju show-secret 9m4e2mr0ui3e8a215n4g --access-log
+    juju show-secret 9m4e2mr0ui3e8a215n4g --revision 2 --access-log
 `
 
 // NewShowSecretsCommand returns a command to list secrets metadata.
@@ -55,7 +68,7 @@ func NewShowSecretsCommand() cmd.Command {
 	return modelcmd.Wrap(c)
 }
 
-func (c *showSecretsCommand) secretsAPI(ctx context.Context) (ListSecretsAPI, error) {
+func (c *showSecretsCommand) secretsAPI(ctx context.Context) (ShowSecretsAPI, error) {
 	root, err := c.NewAPIRoot(ctx)
 	if err != nil {
 		return nil, errors.Trace(err)
@@ -86,6 +99,7 @@ func (c *showSecretsCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.BoolVar(&c.revisions, "revisions", false, "Show the secret revisions metadata")
 	f.IntVar(&c.revision, "revision", 0, "Show a specific revision (defaults to latest)")
 	f.IntVar(&c.revision, "r", 0, "")
+	f.BoolVar(&c.accessLog, "access-log", false, "Show who has read the secret content and when")
 	c.out.AddFlags(f, "yaml", map[string]cmd.Formatter{
 		"yaml": cmd.FormatYaml,
 		"json": cmd.FormatJson,
@@ -149,6 +163,28 @@ func (c *showSecretsCommand) Run(ctxt *cmd.Context) error {
 		}
 		return errors.NotFoundf("secret %q", c.name)
 	}
+	if c.accessLog {
+		for id, info := range details {
+			entries, err := api.SecretAccessLog(ctxt, info.URI, filter.Revision)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			info.AccessLog = toAccessLogDetails(entries)
+			details[id] = info
+		}
+	}
 
 	return c.out.Write(ctxt, details)
 }
+
+func toAccessLogDetails(entries []apisecrets.AccessLogEntry) []secretAccessLogDetails {
+	result := make([]secretAccessLogDetails, len(entries))
+	for i, e := range entries {
+		result[i] = secretAccessLogDetails{
+			Revision:   e.Revision,
+			Accessor:   e.Accessor.String(),
+			AccessTime: e.AccessTime,
+		}
+	}
+	return result
+}
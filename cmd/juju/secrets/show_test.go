@@ -6,7 +6,9 @@ package secrets_test
 import (
 	"fmt"
 	stdtesting "testing"
+	"time"
 
+	"github.com/juju/names/v6"
 	"github.com/juju/tc"
 	"go.uber.org/mock/gomock"
 
@@ -23,7 +25,7 @@ import (
 type ShowSuite struct {
 	testhelpers.IsolationSuite
 	store      *jujuclient.MemStore
-	secretsAPI *mocks.MockListSecretsAPI
+	secretsAPI *mocks.MockShowSecretsAPI
 }
 
 func TestShowSuite(t *stdtesting.T) {
@@ -41,7 +43,7 @@ func (s *ShowSuite) SetUpTest(c *tc.C) {
 func (s *ShowSuite) setup(c *tc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 
-	s.secretsAPI = mocks.NewMockListSecretsAPI(ctrl)
+	s.secretsAPI = mocks.NewMockShowSecretsAPI(ctrl)
 
 	return ctrl
 }
@@ -228,3 +230,49 @@ func (s *ShowSuite) TestShowRevisions(c *tc.C) {
     updated: 0001-01-01T00:00:00Z
 `[1:], uri.ID))
 }
+
+func (s *ShowSuite) TestShowAccessLog(c *tc.C) {
+	defer s.setup(c).Finish()
+
+	uri := coresecrets.NewURI()
+	accessTime := time.Date(2025, 4, 1, 12, 0, 0, 0, time.UTC)
+	s.secretsAPI.EXPECT().ListSecrets(gomock.Any(), false, coresecrets.Filter{
+		URI:      uri,
+		Revision: ptr(2),
+	}).Return(
+		[]apisecrets.SecretDetails{{
+			Metadata: coresecrets.SecretMetadata{
+				URI: uri, Version: 1, LatestRevision: 2,
+				Owner: coresecrets.Owner{Kind: coresecrets.ApplicationOwner, ID: "mysql"},
+			},
+		}}, nil)
+	s.secretsAPI.EXPECT().SecretAccessLog(gomock.Any(), uri, ptr(2)).Return(
+		[]apisecrets.AccessLogEntry{{
+			Revision:   2,
+			Accessor:   names.NewUnitTag("mysql/0"),
+			AccessTime: accessTime,
+		}, {
+			Revision:   2,
+			Accessor:   names.NewUserTag("admin"),
+			AccessTime: accessTime.Add(time.Minute),
+		}}, nil)
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	ctx, err := cmdtesting.RunCommand(c, secrets.NewShowCommandForTest(s.store, s.secretsAPI), uri.ID, "--revision", "2", "--access-log")
+	c.Assert(err, tc.ErrorIsNil)
+	out := cmdtesting.Stdout(ctx)
+	c.Assert(out, tc.Equals, fmt.Sprintf(`
+%s:
+  revision: 2
+  owner: mysql
+  created: 0001-01-01T00:00:00Z
+  updated: 0001-01-01T00:00:00Z
+  access-log:
+  - revision: 2
+    accessor: unit-mysql-0
+    time: 2025-04-01T12:00:00Z
+  - revision: 2
+    accessor: user-admin
+    time: 2025-04-01T12:01:00Z
+`[1:], uri.ID))
+}
@@ -707,10 +707,12 @@ func (a *MachineAgent) startModelWorkers(cfg modelworkermanager.NewModelConfig)
 		LoggingContext:                cfg.LoggerContext,
 		RunFlagDuration:               time.Minute,
 		CharmRevisionUpdateInterval:   24 * time.Hour,
+		CharmRefreshInterval:          10 * time.Minute,
 		NewEnvironFunc:                newEnvirons,
 		NewContainerBrokerFunc:        newCAASBroker,
 		NewMigrationMaster:            migrationmaster.NewWorker,
 		OperationPrunerInterval:       24 * time.Hour,
+		SecretAccessLogPruneInterval:  24 * time.Hour,
 		DomainServices:                cfg.DomainServices,
 		ProviderServicesGetter:        cfg.ProviderServicesGetter,
 		LeaseManager:                  cfg.LeaseManager,
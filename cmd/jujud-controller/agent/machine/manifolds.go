@@ -594,6 +594,7 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 			Logger:                      internallogger.GetLogger("juju.worker.services"),
 			Clock:                       config.Clock,
 			LogDir:                      agentConfig.LogDir(),
+			DataDir:                     agentConfig.DataDir(),
 			NewWorker:                   workerdomainservices.NewWorker,
 			NewDomainServicesGetter:     workerdomainservices.NewDomainServicesGetter,
 			NewControllerDomainServices: workerdomainservices.NewControllerDomainServices,
@@ -35,6 +35,7 @@ import (
 	"github.com/juju/juju/internal/worker/caasmodelconfigmanager"
 	"github.com/juju/juju/internal/worker/caasmodeloperator"
 	"github.com/juju/juju/internal/worker/changestreampruner"
+	"github.com/juju/juju/internal/worker/charmrefresher"
 	"github.com/juju/juju/internal/worker/charmrevisioner"
 	provisioner "github.com/juju/juju/internal/worker/computeprovisioner"
 	"github.com/juju/juju/internal/worker/credentialvalidator"
@@ -51,6 +52,7 @@ import (
 	"github.com/juju/juju/internal/worker/remoterelationconsumer"
 	"github.com/juju/juju/internal/worker/remoterelationofferer"
 	"github.com/juju/juju/internal/worker/removal"
+	"github.com/juju/juju/internal/worker/secretaccesslogpruner"
 	"github.com/juju/juju/internal/worker/secretsdrainworker"
 	"github.com/juju/juju/internal/worker/secretspruner"
 	"github.com/juju/juju/internal/worker/singular"
@@ -95,6 +97,11 @@ type ManifoldsConfig struct {
 	// revision worker will check for new revisions of known charms.
 	CharmRevisionUpdateInterval time.Duration
 
+	// CharmRefreshInterval determines how often the charm-refresher
+	// worker will act on the revisions found by the charm-revision
+	// worker, according to each application's refresh policy.
+	CharmRefreshInterval time.Duration
+
 	// NewEnvironFunc is a function opens a provider "environment"
 	// (typically environs.New).
 	NewEnvironFunc environs.NewEnvironFunc
@@ -109,6 +116,10 @@ type ManifoldsConfig struct {
 	// OperationPrunerInterval determines how often the operations are pruned
 	OperationPrunerInterval time.Duration
 
+	// SecretAccessLogPruneInterval determines how often the secret access
+	// log is pruned of entries older than its retention period.
+	SecretAccessLogPruneInterval time.Duration
+
 	// ProviderServicesGetter is used to access the provider service.
 	ProviderServicesGetter modelworkermanager.ProviderServicesGetter
 
@@ -307,6 +318,19 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 			Logger:             config.LoggingContext.GetLogger("juju.worker.charmrevisioner"),
 		})),
 
+		// The charm refresher notifies of, or refreshes applications to,
+		// the revisions found by the charm revisioner, according to each
+		// application's refresh policy.
+		charmRefresherName: ifResponsible(ifNotMigrating(charmrefresher.Manifold(charmrefresher.ManifoldConfig{
+			DomainServicesName: domainServicesName,
+			HTTPClientName:     httpClientName,
+			NewHTTPClient:      charmrefresher.NewHTTPClient,
+			NewDownloader:      charmrefresher.NewDownloader,
+			Interval:           config.CharmRefreshInterval,
+			Logger:             config.LoggingContext.GetLogger("juju.worker.charmrefresher"),
+			Clock:              config.Clock,
+		}))),
+
 		remoteRelationConsumerName: ifNotMigrating(remoterelationconsumer.Manifold(remoterelationconsumer.ManifoldConfig{
 			ModelUUID:                     modelUUID,
 			APICallerName:                 apiCallerName,
@@ -382,6 +406,15 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 			NewBackendsClient:     secretsdrainworker.NewUserSecretBackendsClient,
 		})),
 
+		// The secret access log pruner removes the old entries of the
+		// secret access log periodically, away from the read path.
+		secretAccessLogPrunerName: ifResponsible(ifNotMigrating(secretaccesslogpruner.Manifold(secretaccesslogpruner.ManifoldConfig{
+			DomainServicesName: domainServicesName,
+			Interval:           config.SecretAccessLogPruneInterval,
+			Logger:             config.LoggingContext.GetLogger("juju.worker.secretaccesslogpruner"),
+			Clock:              config.Clock,
+		}))),
+
 		// the operationPruner is the worker that prune operation based on their
 		// age or result/log size periodically
 		operationPrunerName: ifResponsible(ifNotMigrating(operationpruner.Manifold(operationpruner.ManifoldConfig{
@@ -618,6 +651,7 @@ const (
 	applicationScalerName        = "application-scaler"
 	asyncCharmDownloader         = "async-charm-downloader"
 	changeStreamPrunerName       = "change-stream-pruner"
+	charmRefresherName           = "charm-refresher"
 	charmRevisionerName          = "charm-revisioner"
 	computeProvisionerName       = "compute-provisioner"
 	domainServicesName           = "domain-services"
@@ -642,8 +676,9 @@ const (
 	caasmodelconfigmanagerName     = "caas-model-config-manager"
 	caasApplicationProvisionerName = "caas-application-provisioner"
 
-	secretsPrunerName      = "secrets-pruner"
-	userSecretsDrainWorker = "user-secrets-drain-worker"
+	secretAccessLogPrunerName = "secret-access-log-pruner"
+	secretsPrunerName         = "secrets-pruner"
+	userSecretsDrainWorker    = "user-secrets-drain-worker"
 
 	validCredentialFlagName = "valid-credential-flag"
 )
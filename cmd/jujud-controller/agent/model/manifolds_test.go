@@ -43,6 +43,7 @@ func (s *ManifoldsSuite) TestIAASNames(c *tc.C) {
 		"api-remote-relation-caller",
 		"async-charm-downloader",
 		"change-stream-pruner",
+		"charm-refresher",
 		"charm-revisioner",
 		"clock",
 		"compute-provisioner",
@@ -64,6 +65,7 @@ func (s *ManifoldsSuite) TestIAASNames(c *tc.C) {
 		"remote-relation-consumer",
 		"remote-relation-offerer",
 		"removal",
+		"secret-access-log-pruner",
 		"secrets-pruner",
 		"storage-provisioner",
 		"user-secrets-drain-worker",
@@ -93,6 +95,7 @@ func (s *ManifoldsSuite) TestCAASNames(c *tc.C) {
 		"caas-model-config-manager",
 		"caas-model-operator",
 		"change-stream-pruner",
+		"charm-refresher",
 		"charm-revisioner",
 		"clock",
 		"domain-services",
@@ -111,6 +114,7 @@ func (s *ManifoldsSuite) TestCAASNames(c *tc.C) {
 		"remote-relation-consumer",
 		"remote-relation-offerer",
 		"removal",
+		"secret-access-log-pruner",
 		"secrets-pruner",
 		"user-secrets-drain-worker",
 		"valid-credential-flag",
@@ -195,6 +199,17 @@ func (s *ManifoldsSuite) TestCAASManifold(c *tc.C) {
 
 var expectedCAASModelManifoldsWithDependencies = map[string][]string{
 
+	"secret-access-log-pruner": {
+		"agent",
+		"api-caller",
+		"domain-services",
+		"is-responsible-flag",
+		"lease-manager",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"not-dead-flag",
+	},
+
 	"secrets-pruner": {
 		"agent",
 		"api-caller",
@@ -296,6 +311,18 @@ var expectedCAASModelManifoldsWithDependencies = map[string][]string{
 		"lease-manager",
 	},
 
+	"charm-refresher": {
+		"agent",
+		"api-caller",
+		"domain-services",
+		"http-client",
+		"is-responsible-flag",
+		"lease-manager",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"not-dead-flag",
+	},
+
 	"charm-revisioner": {
 		"agent",
 		"domain-services",
@@ -421,6 +448,17 @@ var expectedCAASModelManifoldsWithDependencies = map[string][]string{
 
 var expectedIAASModelManifoldsWithDependencies = map[string][]string{
 
+	"secret-access-log-pruner": {
+		"agent",
+		"api-caller",
+		"domain-services",
+		"is-responsible-flag",
+		"lease-manager",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"not-dead-flag",
+	},
+
 	"secrets-pruner": {
 		"agent",
 		"api-caller",
@@ -459,6 +497,18 @@ var expectedIAASModelManifoldsWithDependencies = map[string][]string{
 		"is-responsible-flag",
 	},
 
+	"charm-refresher": {
+		"agent",
+		"api-caller",
+		"domain-services",
+		"http-client",
+		"is-responsible-flag",
+		"lease-manager",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"not-dead-flag",
+	},
+
 	"charm-revisioner": {
 		"agent",
 		"lease-manager",
@@ -10,4 +10,9 @@ type ControllerAgentInfo struct {
 	PrivateKey     string
 	CAPrivateKey   string
 	SystemIdentity string
+
+	// SecretEncryptionKey is the root key from which the secret
+	// key-encryption keys are derived. It is only handed to controllers
+	// joining HA and is never written to the agent config.
+	SecretEncryptionKey string
 }
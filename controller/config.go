@@ -20,7 +20,9 @@ import (
 
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/objectstore"
+	"github.com/juju/juju/internal/charm/signature"
 	"github.com/juju/juju/internal/configschema"
+	"github.com/juju/juju/internal/docker"
 	"github.com/juju/juju/internal/pki"
 )
 
@@ -217,6 +219,12 @@ const (
 	// for the jujud operator and mongo images.
 	CAASImageRepo = "caas-image-repo"
 
+	// CAASImageMirror sets the docker repo that OCI image resources of
+	// Kubernetes charms are copied into, so they can be pulled by clusters
+	// without access to the upstream registries. It takes the same
+	// repository path or JSON credentials as caas-image-repo.
+	CAASImageMirror = "caas-image-mirror"
+
 	// Features allows a list of runtime changeable features to be updated.
 	Features = "features"
 
@@ -289,6 +297,26 @@ const (
 	// SSHMaxConcurrentConnections is the maximum number of concurrent SSH
 	// connections to the controller.
 	SSHMaxConcurrentConnections = "ssh-max-concurrent-connections"
+
+	// CharmSignaturePolicy determines whether charms and their OCI image
+	// resources must be signed by a trusted key before they can be deployed
+	// or refreshed. Can be set to "none" or "required".
+	CharmSignaturePolicy = "charm-signature-policy"
+
+	// CharmSigningKeys holds the public keys trusted to sign charms and
+	// their resources, one per line. Keys may be SSH public keys in
+	// authorized_keys format, or minisign public keys.
+	CharmSigningKeys = "charm-signing-keys"
+)
+
+// Charm signature policies.
+const (
+	// CharmSignaturePolicyNone doesn't verify charm signatures.
+	CharmSignaturePolicyNone = "none"
+
+	// CharmSignaturePolicyRequired rejects charms and OCI image resources
+	// without a valid signature from a trusted key.
+	CharmSignaturePolicyRequired = "required"
 )
 
 // Attribute Defaults
@@ -445,6 +473,10 @@ const (
 	// DefaultObjectStoreType is the default type of object store to use for
 	// storing blobs.
 	DefaultObjectStoreType = objectstore.FileBackend
+
+	// DefaultCharmSignaturePolicy is the default charm signature policy,
+	// which doesn't verify signatures.
+	DefaultCharmSignaturePolicy = CharmSignaturePolicyNone
 )
 
 var (
@@ -483,6 +515,7 @@ var (
 		AuditLogExcludeMethods,
 		CAASOperatorImagePath,
 		CAASImageRepo,
+		CAASImageMirror,
 		Features,
 		MaxCharmStateSize,
 		MaxAgentStateSize,
@@ -506,6 +539,8 @@ var (
 		JujudControllerSnapSource,
 		SSHMaxConcurrentConnections,
 		SSHServerPort,
+		CharmSignaturePolicy,
+		CharmSigningKeys,
 	}
 
 	// For backwards compatibility, we must include "anything", "juju-apiserver"
@@ -534,7 +569,10 @@ var (
 		AuditLogExcludeMethods,
 		AuditLogMaxBackups,
 		AuditLogMaxSize,
+		CAASImageMirror,
 		CAASImageRepo,
+		CharmSignaturePolicy,
+		CharmSigningKeys,
 		ControllerResourceDownloadLimit,
 		Features,
 		JujuManagementSpace,
@@ -969,6 +1007,12 @@ func (c Config) CAASImageRepo() string {
 	return c.asString(CAASImageRepo)
 }
 
+// CAASImageMirror returns the docker repo that OCI image resources are
+// mirrored into, or an empty string if they aren't mirrored.
+func (c Config) CAASImageMirror() string {
+	return c.asString(CAASImageMirror)
+}
+
 // MaxCharmStateSize returns the max size (in bytes) of charm-specific state
 // that each unit can store to the controller. A value of zero indicates no
 // limit.
@@ -1077,6 +1121,21 @@ func (c Config) SSHMaxConcurrentConnections() int {
 	return c.intOrDefault(SSHMaxConcurrentConnections, DefaultSSHMaxConcurrentConnections)
 }
 
+// CharmSignaturePolicy returns the policy for verifying charm and resource
+// signatures.
+func (c Config) CharmSignaturePolicy() string {
+	if v := c.asString(CharmSignaturePolicy); v != "" {
+		return v
+	}
+	return DefaultCharmSignaturePolicy
+}
+
+// CharmSigningKeys returns the public keys trusted to sign charms and
+// their resources.
+func (c Config) CharmSigningKeys() string {
+	return c.asString(CharmSigningKeys)
+}
+
 // Validate ensures that config is a valid configuration.
 func Validate(c Config) error {
 	if v, ok := c[IdentityPublicKey].(string); ok {
@@ -1339,6 +1398,33 @@ func Validate(c Config) error {
 		}
 	}
 
+	if v, ok := c[CAASImageMirror].(string); ok && v != "" {
+		if _, err := docker.NewImageRepoDetails(v); err != nil {
+			return errors.Annotatef(err, "invalid %s in configuration", CAASImageMirror)
+		}
+	}
+
+	var signingKeys signature.Keys
+	if v, ok := c[CharmSigningKeys].(string); ok {
+		var err error
+		if signingKeys, err = signature.ParseKeys(v); err != nil {
+			return errors.NotValidf("%s: %v", CharmSigningKeys, err)
+		}
+	}
+
+	if v, ok := c[CharmSignaturePolicy].(string); ok {
+		switch v {
+		case CharmSignaturePolicyNone:
+		case CharmSignaturePolicyRequired:
+			if signingKeys.Len() == 0 {
+				return errors.NotValidf("%s %q without %s", CharmSignaturePolicy, v, CharmSigningKeys)
+			}
+		default:
+			return errors.NotValidf("%s value %q, expected %q or %q",
+				CharmSignaturePolicy, v, CharmSignaturePolicyNone, CharmSignaturePolicyRequired)
+		}
+	}
+
 	return nil
 }
 
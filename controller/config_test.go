@@ -405,6 +405,30 @@ var newConfigTests = []struct {
 		controller.SSHServerPort: 17070,
 	},
 	expectError: `ssh-server-port matching api-port not valid`,
+}, {
+	about: "required charm signature policy with keys",
+	config: controller.Config{
+		controller.CharmSignaturePolicy: "required",
+		controller.CharmSigningKeys:     "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIIy7iZh6slcBa6/gSFuKRzkNFgjZh1uhPmYnnu1DaKcf publisher",
+	},
+}, {
+	about: "required charm signature policy without keys",
+	config: controller.Config{
+		controller.CharmSignaturePolicy: "required",
+	},
+	expectError: `charm-signature-policy "required" without charm-signing-keys not valid`,
+}, {
+	about: "invalid charm signature policy",
+	config: controller.Config{
+		controller.CharmSignaturePolicy: "sometimes",
+	},
+	expectError: `charm-signature-policy value "sometimes", expected "none" or "required" not valid`,
+}, {
+	about: "invalid charm signing keys",
+	config: controller.Config{
+		controller.CharmSigningKeys: "ssh-ed25519 nope",
+	},
+	expectError: `charm-signing-keys: line 1: public key not valid.*`,
 }}
 
 func (s *ConfigSuite) TestNewConfig(c *tc.C) {
@@ -649,6 +673,35 @@ func (s *ConfigSuite) TestCAASImageRepo(c *tc.C) {
 	}
 }
 
+func (s *ConfigSuite) TestCAASImageMirror(c *tc.C) {
+	cfg, err := controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert,
+		map[string]interface{}{},
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cfg.CAASImageMirror(), tc.Equals, "")
+
+	cfg, err = controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert,
+		map[string]interface{}{
+			controller.CAASImageMirror: "registry.internal:5000/juju",
+		},
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cfg.CAASImageMirror(), tc.Equals, "registry.internal:5000/juju")
+
+	_, err = controller.NewConfig(
+		testing.ControllerTag.Id(),
+		testing.CACert,
+		map[string]interface{}{
+			controller.CAASImageMirror: `{"serveraddress": "registry.internal:5000"}`,
+		},
+	)
+	c.Assert(err, tc.ErrorMatches, `invalid caas-image-mirror in configuration: empty repository not valid`)
+}
+
 func (s *ConfigSuite) TestControllerNameDefault(c *tc.C) {
 	cfg := controller.Config{}
 	c.Check(cfg.ControllerName(), tc.Equals, "")
@@ -721,6 +774,8 @@ func (s *ConfigSuite) TestDefaults(c *tc.C) {
 	c.Assert(cfg.QueryTracingThreshold(), tc.Equals, controller.DefaultQueryTracingThreshold)
 	c.Assert(cfg.SSHServerPort(), tc.Equals, controller.DefaultSSHServerPort)
 	c.Assert(cfg.SSHMaxConcurrentConnections(), tc.Equals, controller.DefaultSSHMaxConcurrentConnections)
+	c.Assert(cfg.CharmSignaturePolicy(), tc.Equals, controller.DefaultCharmSignaturePolicy)
+	c.Assert(cfg.CharmSigningKeys(), tc.Equals, "")
 }
 
 func (s *ConfigSuite) TestAgentLogfile(c *tc.C) {
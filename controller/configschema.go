@@ -42,6 +42,7 @@ var configChecker = schema.FieldMap(schema.Fields{
 	JujuManagementSpace:                schema.String(),
 	CAASOperatorImagePath:              schema.String(),
 	CAASImageRepo:                      schema.String(),
+	CAASImageMirror:                    schema.String(),
 	Features:                           schema.String(),
 	MaxCharmStateSize:                  schema.ForceInt(),
 	MaxAgentStateSize:                  schema.ForceInt(),
@@ -65,6 +66,8 @@ var configChecker = schema.FieldMap(schema.Fields{
 	JujudControllerSnapSource:          schema.String(),
 	SSHServerPort:                      schema.ForceInt(),
 	SSHMaxConcurrentConnections:        schema.ForceInt(),
+	CharmSignaturePolicy:               schema.String(),
+	CharmSigningKeys:                   schema.String(),
 }, schema.Defaults{
 	AgentRateLimitMax:                  schema.Omit,
 	AgentRateLimitRate:                 schema.Omit,
@@ -96,6 +99,7 @@ var configChecker = schema.FieldMap(schema.Fields{
 	JujuManagementSpace:                schema.Omit,
 	CAASOperatorImagePath:              schema.Omit,
 	CAASImageRepo:                      schema.Omit,
+	CAASImageMirror:                    schema.Omit,
 	Features:                           schema.Omit,
 	MaxCharmStateSize:                  DefaultMaxCharmStateSize,
 	MaxAgentStateSize:                  DefaultMaxAgentStateSize,
@@ -119,6 +123,8 @@ var configChecker = schema.FieldMap(schema.Fields{
 	JujudControllerSnapSource:          DefaultJujudControllerSnapSource,
 	SSHServerPort:                      DefaultSSHServerPort,
 	SSHMaxConcurrentConnections:        DefaultSSHMaxConcurrentConnections,
+	CharmSignaturePolicy:               DefaultCharmSignaturePolicy,
+	CharmSigningKeys:                   schema.Omit,
 })
 
 // ConfigSchema holds information on all the fields defined by
@@ -255,6 +261,10 @@ Use "caas-image-repo" instead.`,
 		Type:        configschema.Tstring,
 		Description: `The docker repo to use for the jujud operator and mongo images`,
 	},
+	CAASImageMirror: {
+		Type:        configschema.Tstring,
+		Description: `The docker repo that OCI image resources of Kubernetes charms are mirrored into`,
+	},
 	Features: {
 		Type:        configschema.Tstring,
 		Description: `A comma-delimited list of runtime changeable features to be updated`,
@@ -341,4 +351,12 @@ will be output if tracing is enabled.`,
 		Type:        configschema.Tint,
 		Description: `The maximum number of concurrent ssh connections to the controller`,
 	},
+	CharmSignaturePolicy: {
+		Type:        configschema.Tstring,
+		Description: `Whether charms and OCI image resources must be signed by a trusted key (none or required)`,
+	},
+	CharmSigningKeys: {
+		Type:        configschema.Tstring,
+		Description: `The public keys trusted to sign charms and resources, one per line (ssh or minisign)`,
+	},
 }
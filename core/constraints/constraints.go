@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -37,6 +38,9 @@ const (
 	Zones            = "zones"
 	AllocatePublicIP = "allocate-public-ip"
 	ImageID          = "image-id"
+	AffinityGroup    = "affinity-group"
+	AntiAffinity     = "anti-affinity"
+	Accelerators     = "accelerators"
 
 	// excludedPrefix is the prefix Juju expects to be in front of a value when
 	// it is to be considered excluded as part of constraints.
@@ -120,6 +124,24 @@ type Value struct {
 	// image. This is provider specific, and for the moment is only
 	// implemented on MAAS clouds.
 	ImageID *string `json:"image-id,omitempty" yaml:"image-id,omitempty"`
+
+	// AffinityGroup, if not nil or empty, names a group of machines whose
+	// placement on the underlying hosts should be coordinated. How the
+	// group is realised is provider specific (e.g. EC2 placement groups,
+	// OpenStack server groups or LXD cluster members).
+	AffinityGroup *string `json:"affinity-group,omitempty" yaml:"affinity-group,omitempty"`
+
+	// AntiAffinity, if true, indicates that machines in the same affinity
+	// group must be spread across separate physical hosts. If nil or false,
+	// machines in the group are placed as close together as the provider
+	// allows. It is only meaningful when AffinityGroup is also specified.
+	AntiAffinity *bool `json:"anti-affinity,omitempty" yaml:"anti-affinity,omitempty"`
+
+	// Accelerators, if not nil or empty, indicates that a machine must have
+	// at least the specified number of accelerators (such as GPUs), in the
+	// form <count>[:<type>]. The type, if specified, is matched against the
+	// provider's name for the accelerator (e.g. nvidia-tesla-t4).
+	Accelerators *string `json:"accelerators,omitempty" yaml:"accelerators,omitempty"`
 }
 
 var rawAliases = map[string]string{
@@ -264,6 +286,35 @@ func (v *Value) HasImageID() bool {
 	return v.ImageID != nil && *v.ImageID != ""
 }
 
+// HasAffinityGroup returns true if the constraints.Value specifies an
+// affinity group.
+func (v *Value) HasAffinityGroup() bool {
+	return v.AffinityGroup != nil && *v.AffinityGroup != ""
+}
+
+// HasAntiAffinity returns true if the constraints.Value requires machines in
+// its affinity group to be spread across separate hosts.
+func (v *Value) HasAntiAffinity() bool {
+	return v.AntiAffinity != nil && *v.AntiAffinity
+}
+
+// HasAccelerators returns true if the constraints.Value requires at least
+// one accelerator.
+func (v *Value) HasAccelerators() bool {
+	count, _, err := v.AcceleratorSpec()
+	return err == nil && count > 0
+}
+
+// AcceleratorSpec returns the number and type of accelerators required by
+// the constraints.Value. The type is empty if any type is acceptable. An
+// error is returned if the accelerators value can not be parsed.
+func (v *Value) AcceleratorSpec() (uint64, string, error) {
+	if v.Accelerators == nil || *v.Accelerators == "" {
+		return 0, "", nil
+	}
+	return ParseAccelerators(*v.Accelerators)
+}
+
 // String expresses a constraints.Value in the language in which it was specified.
 func (v Value) String() string {
 	var strs []string
@@ -324,6 +375,15 @@ func (v Value) String() string {
 	if v.ImageID != nil {
 		strs = append(strs, "image-id="+(*v.ImageID))
 	}
+	if v.AffinityGroup != nil {
+		strs = append(strs, "affinity-group="+(*v.AffinityGroup))
+	}
+	if v.AntiAffinity != nil {
+		strs = append(strs, "anti-affinity="+boolStr(*v.AntiAffinity))
+	}
+	if v.Accelerators != nil {
+		strs = append(strs, "accelerators="+(*v.Accelerators))
+	}
 
 	// Ensure constraint values with spaces are properly escaped
 	for i := 0; i < len(strs); i++ {
@@ -385,6 +445,15 @@ func (v Value) GoString() string {
 	if v.ImageID != nil {
 		values = append(values, fmt.Sprintf("ImageID: %q", *v.ImageID))
 	}
+	if v.AffinityGroup != nil {
+		values = append(values, fmt.Sprintf("AffinityGroup: %q", *v.AffinityGroup))
+	}
+	if v.AntiAffinity != nil {
+		values = append(values, fmt.Sprintf("AntiAffinity: %v", *v.AntiAffinity))
+	}
+	if v.Accelerators != nil {
+		values = append(values, fmt.Sprintf("Accelerators: %q", *v.Accelerators))
+	}
 	return fmt.Sprintf("{%s}", strings.Join(values, ", "))
 }
 
@@ -560,6 +629,12 @@ func (v *Value) setRaw(name, str string) error {
 		err = v.setAllocatePublicIP(str)
 	case ImageID:
 		err = v.setImageID(str)
+	case AffinityGroup:
+		err = v.setAffinityGroup(str)
+	case AntiAffinity:
+		err = v.setAntiAffinity(str)
+	case Accelerators:
+		err = v.setAccelerators(str)
 	default:
 		return errors.Errorf("unknown constraint %q", name)
 	}
@@ -633,6 +708,20 @@ func (v *Value) UnmarshalYAML(unmarshal func(interface{}) error) error {
 			v.AllocatePublicIP, err = parseBool(vstr)
 		case ImageID:
 			v.ImageID = &vstr
+		case AffinityGroup:
+			err = validateAffinityGroup(vstr)
+			if err == nil {
+				v.AffinityGroup = &vstr
+			}
+		case AntiAffinity:
+			v.AntiAffinity, err = parseBool(vstr)
+		case Accelerators:
+			if vstr != "" {
+				_, _, err = ParseAccelerators(vstr)
+			}
+			if err == nil {
+				v.Accelerators = &vstr
+			}
 		default:
 			return errors.Errorf("unknown constraint value: %v", k)
 		}
@@ -800,6 +889,64 @@ func (v *Value) setImageID(str string) (err error) {
 	return
 }
 
+func (v *Value) setAffinityGroup(str string) error {
+	if v.AffinityGroup != nil {
+		return errors.Errorf("already set")
+	}
+	if err := validateAffinityGroup(str); err != nil {
+		return err
+	}
+	v.AffinityGroup = &str
+	return nil
+}
+
+// validateAffinityGroup ensures that the affinity group name can be used
+// verbatim when naming provider resources such as placement groups.
+func validateAffinityGroup(str string) error {
+	if str != "" && !validAffinityGroup.MatchString(str) {
+		return errors.Errorf("%q is not a valid affinity group name", str)
+	}
+	return nil
+}
+
+func (v *Value) setAntiAffinity(str string) (err error) {
+	if str == "" {
+		return nil
+	}
+	if v.AntiAffinity != nil {
+		return errors.Errorf("already set")
+	}
+	v.AntiAffinity, err = parseBool(str)
+	return
+}
+
+func (v *Value) setAccelerators(str string) error {
+	if v.Accelerators != nil {
+		return errors.Errorf("already set")
+	}
+	if str != "" {
+		if _, _, err := ParseAccelerators(str); err != nil {
+			return err
+		}
+	}
+	v.Accelerators = &str
+	return nil
+}
+
+// ParseAccelerators parses an accelerators constraint value of the form
+// <count>[:<type>], returning the count and the (possibly empty) type.
+func ParseAccelerators(str string) (uint64, string, error) {
+	countStr, kind, hasKind := strings.Cut(str, ":")
+	count, err := strconv.ParseUint(countStr, 10, 64)
+	if err != nil {
+		return 0, "", errors.Errorf("%q is not a valid accelerator count, expected <count>[:<type>]", str)
+	}
+	if hasKind && !validAcceleratorType.MatchString(kind) {
+		return 0, "", errors.Errorf("%q is not a valid accelerator type", kind)
+	}
+	return count, kind, nil
+}
+
 func parseBool(str string) (*bool, error) {
 	var value bool
 	if str != "" {
@@ -868,6 +1015,14 @@ func parseYamlStrings(entityName string, val interface{}) (*[]string, error) {
 	return &items, nil
 }
 
+// validAffinityGroup matches lower case alphanumeric names, optionally
+// separated by hyphens, which are accepted by all supporting providers.
+var validAffinityGroup = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// validAcceleratorType matches accelerator type names such as
+// nvidia-tesla-t4 or A10G.
+var validAcceleratorType = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
 var mbSuffixes = map[string]float64{
 	"M": 1,
 	"G": 1024,
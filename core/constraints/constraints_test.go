@@ -392,6 +392,61 @@ var parseConstraintsTests = []struct {
 		err:     `bad "image-id" constraint: already set`,
 	},
 
+	// AffinityGroup
+	{
+		summary: "set affinity-group",
+		args:    []string{"affinity-group=db-cluster"},
+	}, {
+		summary: "set empty affinity-group",
+		args:    []string{"affinity-group="},
+	}, {
+		summary: "set invalid affinity-group",
+		args:    []string{"affinity-group=DB_cluster"},
+		err:     `bad "affinity-group" constraint: "DB_cluster" is not a valid affinity group name`,
+	}, {
+		summary: "try to set affinity-group twice",
+		args:    []string{"affinity-group=db affinity-group=web"},
+		err:     `bad "affinity-group" constraint: already set`,
+	},
+
+	// AntiAffinity
+	{
+		summary: "set anti-affinity",
+		args:    []string{"affinity-group=db anti-affinity=true"},
+	}, {
+		summary: "set nonsense anti-affinity",
+		args:    []string{"anti-affinity=fred"},
+		err:     `bad "anti-affinity" constraint: must be 'true' or 'false'`,
+	}, {
+		summary: "try to set anti-affinity twice",
+		args:    []string{"anti-affinity=true anti-affinity=false"},
+		err:     `bad "anti-affinity" constraint: already set`,
+	},
+
+	// Accelerators
+	{
+		summary: "set accelerators count",
+		args:    []string{"accelerators=2"},
+	}, {
+		summary: "set accelerators count and type",
+		args:    []string{"accelerators=1:nvidia-tesla-t4"},
+	}, {
+		summary: "set empty accelerators",
+		args:    []string{"accelerators="},
+	}, {
+		summary: "set nonsense accelerators count",
+		args:    []string{"accelerators=two"},
+		err:     `bad "accelerators" constraint: "two" is not a valid accelerator count, expected <count>\[:<type>\]`,
+	}, {
+		summary: "set missing accelerators type",
+		args:    []string{"accelerators=1:"},
+		err:     `bad "accelerators" constraint: "" is not a valid accelerator type`,
+	}, {
+		summary: "try to set accelerators twice",
+		args:    []string{"accelerators=1 accelerators=2"},
+		err:     `bad "accelerators" constraint: already set`,
+	},
+
 	// Everything at once.
 	{
 		summary: "kitchen sink together",
@@ -638,6 +693,58 @@ func (s *ConstraintsSuite) TestHasImageID(c *tc.C) {
 	c.Check(con.HasImageID(), tc.IsFalse)
 }
 
+func (s *ConstraintsSuite) TestHasAffinityGroup(c *tc.C) {
+	con := constraints.MustParse("affinity-group=db")
+	c.Check(con.HasAffinityGroup(), tc.IsTrue)
+	con = constraints.MustParse("affinity-group=")
+	c.Check(con.HasAffinityGroup(), tc.IsFalse)
+	con = constraints.MustParse("anti-affinity=true")
+	c.Check(con.HasAffinityGroup(), tc.IsFalse)
+}
+
+func (s *ConstraintsSuite) TestHasAntiAffinity(c *tc.C) {
+	con := constraints.MustParse("affinity-group=db anti-affinity=true")
+	c.Check(con.HasAntiAffinity(), tc.IsTrue)
+	con = constraints.MustParse("affinity-group=db anti-affinity=false")
+	c.Check(con.HasAntiAffinity(), tc.IsFalse)
+	con = constraints.MustParse("affinity-group=db")
+	c.Check(con.HasAntiAffinity(), tc.IsFalse)
+}
+
+func (s *ConstraintsSuite) TestAcceleratorSpec(c *tc.C) {
+	con := constraints.MustParse("accelerators=2:nvidia-tesla-t4")
+	c.Check(con.HasAccelerators(), tc.IsTrue)
+	count, kind, err := con.AcceleratorSpec()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(count, tc.Equals, uint64(2))
+	c.Check(kind, tc.Equals, "nvidia-tesla-t4")
+
+	con = constraints.MustParse("accelerators=1")
+	c.Check(con.HasAccelerators(), tc.IsTrue)
+	count, kind, err = con.AcceleratorSpec()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(count, tc.Equals, uint64(1))
+	c.Check(kind, tc.Equals, "")
+
+	con = constraints.MustParse("accelerators=")
+	c.Check(con.HasAccelerators(), tc.IsFalse)
+	con = constraints.MustParse("accelerators=0")
+	c.Check(con.HasAccelerators(), tc.IsFalse)
+	con = constraints.MustParse("mem=4G")
+	c.Check(con.HasAccelerators(), tc.IsFalse)
+
+	// Values which bypass parsing are reported when they are read.
+	bad := "lots:nvidia"
+	con = constraints.Value{Accelerators: &bad}
+	c.Check(con.HasAccelerators(), tc.IsFalse)
+	_, _, err = con.AcceleratorSpec()
+	c.Check(err, tc.ErrorMatches, `"lots:nvidia" is not a valid accelerator count, expected <count>\[:<type>\]`)
+
+	bad = "1:nvidia tesla"
+	_, _, err = con.AcceleratorSpec()
+	c.Check(err, tc.ErrorMatches, `"nvidia tesla" is not a valid accelerator type`)
+}
+
 func (s *ConstraintsSuite) TestIsEmpty(c *tc.C) {
 	con := constraints.Value{}
 	c.Check(&con, tc.Satisfies, constraints.IsEmpty)
@@ -734,6 +841,14 @@ var constraintsRoundtripTests = []roundTrip{
 	{"ImageID1", constraints.Value{ImageID: nil}},
 	{"ImageID1", constraints.Value{ImageID: strp("")}},
 	{"ImageID1", constraints.Value{ImageID: strp("ubuntu-bf2")}},
+	{"AffinityGroup1", constraints.Value{AffinityGroup: nil}},
+	{"AffinityGroup2", constraints.Value{AffinityGroup: strp("")}},
+	{"AffinityGroup3", constraints.Value{AffinityGroup: strp("db")}},
+	{"AntiAffinity1", constraints.Value{AntiAffinity: nil}},
+	{"AntiAffinity2", constraints.Value{AntiAffinity: boolp(true)}},
+	{"Accelerators1", constraints.Value{Accelerators: nil}},
+	{"Accelerators2", constraints.Value{Accelerators: strp("")}},
+	{"Accelerators3", constraints.Value{Accelerators: strp("2:nvidia-tesla-t4")}},
 	{"All", constraints.Value{
 		Arch:             strp("arm64"),
 		Container:        ctypep("lxd"),
@@ -748,6 +863,9 @@ var constraintsRoundtripTests = []roundTrip{
 		Zones:            &[]string{"az1", "az2"},
 		AllocatePublicIP: boolp(true),
 		ImageID:          strp("ubuntu-bf2"),
+		AffinityGroup:    strp("db"),
+		AntiAffinity:     boolp(true),
+		Accelerators:     strp("1:a10g"),
 	}},
 }
 
@@ -20,6 +20,10 @@ type State interface {
 	// If no annotations are found, an empty map is returned.
 	GetAnnotations(ctx context.Context, ID annotations.ID) (map[string]string, error)
 
+	// GetAnnotationsForKind retrieves the annotations of every entity of the
+	// given kind, keyed by the entity name.
+	GetAnnotationsForKind(ctx context.Context, kind annotations.Kind) (map[string]map[string]string, error)
+
 	// GetCharmAnnotations retrieves all the annotations associated with a given
 	// ID. If no annotations are found, an empty map is returned.
 	GetCharmAnnotations(ctx context.Context, ID annotation.GetCharmArgs) (map[string]string, error)
@@ -62,6 +66,23 @@ func (s *Service) GetAnnotations(ctx context.Context, id annotations.ID) (map[st
 	return annotations, errors.Capture(err)
 }
 
+// GetAnnotationsForKind retrieves the annotations of every entity of the given
+// kind, keyed by the entity name. Entities without annotations are not included
+// in the result. Model annotations can only be retrieved with GetAnnotations,
+// an error satisfying [annotationerrors.UnknownKind] is returned for them.
+func (s *Service) GetAnnotationsForKind(ctx context.Context, kind annotations.Kind) (map[string]map[string]string, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	switch kind {
+	case annotations.KindApplication, annotations.KindMachine, annotations.KindUnit, annotations.KindStorage:
+	default:
+		return nil, errors.Errorf("%d: %w", kind, annotationerrors.UnknownKind)
+	}
+	annotations, err := s.st.GetAnnotationsForKind(ctx, kind)
+	return annotations, errors.Capture(err)
+}
+
 // GetCharmAnnotations retrieves all the annotations associated with a given
 // charm argument. If no annotations are found, an empty map is returned.
 func (s *Service) GetCharmAnnotations(ctx context.Context, id annotation.GetCharmArgs) (map[string]string, error) {
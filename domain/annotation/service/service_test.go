@@ -104,6 +104,27 @@ func (s *serviceSuite) TestGetCharmAnnotations(c *tc.C) {
 	c.Assert(annotations["annotationKey2"], tc.Equals, "annotationValue2")
 }
 
+func (s *serviceSuite) TestGetAnnotationsForKind(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetAnnotationsForKind(gomock.Any(), annotations.KindUnit).Return(map[string]map[string]string{
+		"foo/0": {"annotationKey1": "annotationValue1"},
+	}, nil)
+
+	result, err := s.service().GetAnnotationsForKind(c.Context(), annotations.KindUnit)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, map[string]map[string]string{
+		"foo/0": {"annotationKey1": "annotationValue1"},
+	})
+}
+
+func (s *serviceSuite) TestGetAnnotationsForKindModel(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.service().GetAnnotationsForKind(c.Context(), annotations.KindModel)
+	c.Assert(err, tc.ErrorIs, annotationerrors.UnknownKind)
+}
+
 func (s *serviceSuite) TestSetAnnotations(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 	id1 := annotations.ID{Kind: annotations.KindUnit, Name: "unit1"}
@@ -80,6 +80,45 @@ func (c *MockStateGetAnnotationsCall) DoAndReturn(f func(context.Context, annota
 	return c
 }
 
+// GetAnnotationsForKind mocks base method.
+func (m *MockState) GetAnnotationsForKind(arg0 context.Context, arg1 annotations.Kind) (map[string]map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAnnotationsForKind", arg0, arg1)
+	ret0, _ := ret[0].(map[string]map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAnnotationsForKind indicates an expected call of GetAnnotationsForKind.
+func (mr *MockStateMockRecorder) GetAnnotationsForKind(arg0, arg1 any) *MockStateGetAnnotationsForKindCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnnotationsForKind", reflect.TypeOf((*MockState)(nil).GetAnnotationsForKind), arg0, arg1)
+	return &MockStateGetAnnotationsForKindCall{Call: call}
+}
+
+// MockStateGetAnnotationsForKindCall wrap *gomock.Call
+type MockStateGetAnnotationsForKindCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetAnnotationsForKindCall) Return(arg0 map[string]map[string]string, arg1 error) *MockStateGetAnnotationsForKindCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetAnnotationsForKindCall) Do(f func(context.Context, annotations.Kind) (map[string]map[string]string, error)) *MockStateGetAnnotationsForKindCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetAnnotationsForKindCall) DoAndReturn(f func(context.Context, annotations.Kind) (map[string]map[string]string, error)) *MockStateGetAnnotationsForKindCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetCharmAnnotations mocks base method.
 func (m *MockState) GetCharmAnnotations(arg0 context.Context, arg1 annotation.GetCharmArgs) (map[string]string, error) {
 	m.ctrl.T.Helper()
@@ -84,6 +84,58 @@ WHERE c.name = $charmArgs.name AND c.revision = $charmArgs.revision;
 	return annotations, nil
 }
 
+// GetAnnotationsForKind retrieves the annotations of every entity of the given
+// kind, keyed by the entity name. Entities without annotations are not
+// included in the result. Model annotations are not supported, as there is
+// only ever the one model; use GetAnnotations instead.
+func (st *State) GetAnnotationsForKind(ctx context.Context, kind annotations.Kind) (map[string]map[string]string, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	entityTable, nameColumn, err := entityTableForKind(kind)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	tableName, err := annotationTableNameFromID(annotations.ID{Kind: kind})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	query := fmt.Sprintf(`
+SELECT e.%s AS &entityAnnotation.name,
+       a.key AS &entityAnnotation.key,
+       a.value AS &entityAnnotation.value
+FROM   %s AS a
+JOIN   %s AS e ON a.uuid = e.uuid`, nameColumn, tableName, entityTable)
+
+	stmt, err := st.Prepare(query, entityAnnotation{})
+	if err != nil {
+		return nil, errors.Errorf("preparing get annotations query for kind %d: %w", kind, err)
+	}
+
+	var results []entityAnnotation
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt).GetAll(&results)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return nil, errors.Errorf("loading annotations for kind %d: %w", kind, err)
+	}
+
+	annotations := make(map[string]map[string]string)
+	for _, r := range results {
+		if _, ok := annotations[r.Name]; !ok {
+			annotations[r.Name] = make(map[string]string)
+		}
+		annotations[r.Name][r.Key] = r.Value
+	}
+	return annotations, nil
+}
+
 // getAnnotationsForModel retrieves all annotations associated with the given
 // model ID from the database.
 // If no annotations are found, an empty map is returned.
@@ -416,6 +468,23 @@ func uuidQueryForID(id annotations.ID) (string, sqlair.M, error) {
 	}
 }
 
+// entityTableForKind returns the table holding the entities of the given kind,
+// along with the column that holds their names.
+func entityTableForKind(kind annotations.Kind) (string, string, error) {
+	switch kind {
+	case annotations.KindMachine:
+		return "machine", "name", nil
+	case annotations.KindUnit:
+		return "unit", "name", nil
+	case annotations.KindApplication:
+		return "application", "name", nil
+	case annotations.KindStorage:
+		return "storage_instance", "storage_id", nil
+	default:
+		return "", "", errors.Errorf("%d: %w", kind, annotationerrors.UnknownKind)
+	}
+}
+
 // annotationTableNameFromID keeps the table names for the different annotation
 // tables.
 func annotationTableNameFromID(id annotations.ID) (string, error) {
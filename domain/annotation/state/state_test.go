@@ -74,6 +74,42 @@ func (s *stateSuite) TestGetAnnotationsModel(c *tc.C) {
 	c.Check(annotations, tc.HasLen, 2)
 }
 
+func (s *stateSuite) TestGetAnnotationsForKind(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	s.ensureApplication(c, "foo", "123")
+	s.ensureApplication(c, "bar", "234")
+	s.ensureApplication(c, "baz", "345")
+	s.ensureMachine(c, "0", "456")
+
+	s.ensureAnnotation(c, "application", "123", "foo", "5")
+	s.ensureAnnotation(c, "application", "123", "bar", "6")
+	s.ensureAnnotation(c, "application", "234", "foo", "7")
+	s.ensureAnnotation(c, "machine", "456", "foo", "8")
+
+	result, err := st.GetAnnotationsForKind(c.Context(), annotations.KindApplication)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, map[string]map[string]string{
+		"foo": {"foo": "5", "bar": "6"},
+		"bar": {"foo": "7"},
+	})
+}
+
+func (s *stateSuite) TestGetAnnotationsForKindEmpty(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	result, err := st.GetAnnotationsForKind(c.Context(), annotations.KindUnit)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.HasLen, 0)
+}
+
+func (s *stateSuite) TestGetAnnotationsForKindModel(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	_, err := st.GetAnnotationsForKind(c.Context(), annotations.KindModel)
+	c.Assert(err, tc.ErrorIs, annotationerrors.UnknownKind)
+}
+
 func (s *stateSuite) TestSetAnnotations(c *tc.C) {
 	st := NewState(s.TxnRunnerFactory())
 
@@ -16,6 +16,14 @@ type annotationUUID struct {
 	UUID string `db:"uuid"`
 }
 
+// entityAnnotation represents an annotation along with the name of the entity
+// it is associated with.
+type entityAnnotation struct {
+	Name  string `db:"name"`
+	Key   string `db:"key"`
+	Value string `db:"value"`
+}
+
 type charmArgs struct {
 	Name     string `db:"name"`
 	Revision int    `db:"revision"`
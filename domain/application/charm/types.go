@@ -40,6 +40,9 @@ const (
 	LocalSource CharmSource = "local"
 	// CharmHubSource represents a charmhub charm source.
 	CharmHubSource CharmSource = "charmhub"
+	// CMRSource represents a synthetic charm, created to back a remote
+	// application consumed from an offer in another model.
+	CMRSource CharmSource = "cmr"
 )
 
 // ParseCharmSchema creates a CharmSource from a  string.
@@ -122,6 +125,8 @@ type ReserveCharmRevisionArgs struct {
 	Architecture arch.Arch
 	// DownloadInfo holds the information needed to download a charmhub charm.
 	DownloadInfo *DownloadInfo
+	// Channel is the channel the revision was found in, if any.
+	Channel *Channel
 }
 
 // ResolveUploadCharm holds the arguments for the ResolveUploadCharm method.
@@ -298,4 +298,32 @@ const (
 	// InvalidStorageMountPoint describes an error that occurs when
 	// a storage attachment's location cannot be mounted on the node.
 	InvalidStorageMountPoint = errors.ConstError("invalid storage mount point")
+
+	// StorageNotApplicationStorage describes an error that occurs when
+	// existing storage being attached to a new unit was created for a
+	// different application.
+	StorageNotApplicationStorage = errors.ConstError("storage does not belong to application")
+
+	// StoragePoolNotMatching describes an error that occurs when existing
+	// storage being attached to a new unit is not from the pool of the
+	// application's storage directive.
+	StoragePoolNotMatching = errors.ConstError("storage pool does not match storage directive")
+
+	// SharedStorageNotSupported describes an error that occurs when charm
+	// storage that is shared between units cannot be provisioned by the
+	// storage pool selected for it.
+	SharedStorageNotSupported = errors.ConstError("shared storage not supported")
+
+	// RefreshPolicyNotValid describes an error that occurs when an
+	// application charm refresh policy is not known.
+	RefreshPolicyNotValid = errors.ConstError("refresh policy not valid")
+
+	// MaintenanceWindowNotValid describes an error that occurs when a charm
+	// refresh maintenance window is not valid.
+	MaintenanceWindowNotValid = errors.ConstError("maintenance window not valid")
+
+	// CharmRefreshNotCompatible describes an error that occurs when the
+	// charm an application is being refreshed to is not compatible with the
+	// application's current charm.
+	CharmRefreshNotCompatible = errors.ConstError("charm refresh not compatible")
 )
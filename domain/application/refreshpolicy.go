@@ -0,0 +1,151 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	domaincharm "github.com/juju/juju/domain/application/charm"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// RefreshPolicy describes what happens when a new revision of an
+// application's charm is found in the application's channel.
+type RefreshPolicy string
+
+const (
+	// RefreshPolicyNone indicates that nothing happens, beyond the new
+	// revision being shown in status.
+	RefreshPolicyNone RefreshPolicy = "none"
+	// RefreshPolicyNotify indicates that the new revision is recorded in the
+	// application's status history.
+	RefreshPolicyNotify RefreshPolicy = "notify"
+	// RefreshPolicyAuto indicates that the application is refreshed to the
+	// new revision, within the maintenance window if there is one.
+	RefreshPolicyAuto RefreshPolicy = "auto"
+)
+
+// ParseRefreshPolicy returns the refresh policy with the given name.
+// [applicationerrors.RefreshPolicyNotValid] is returned if the name is not
+// a known policy.
+func ParseRefreshPolicy(name string) (RefreshPolicy, error) {
+	switch p := RefreshPolicy(name); p {
+	case RefreshPolicyNone, RefreshPolicyNotify, RefreshPolicyAuto:
+		return p, nil
+	default:
+		return "", errors.Errorf("%q: %w", name, applicationerrors.RefreshPolicyNotValid)
+	}
+}
+
+// MaintenanceWindow is a daily window of time, in UTC, during which automatic
+// charm refreshes may happen.
+type MaintenanceWindow struct {
+	// Start is the time after midnight UTC at which the window opens.
+	Start time.Duration
+	// End is the time after midnight UTC at which the window closes. If it
+	// is before Start, the window spans midnight.
+	End time.Duration
+}
+
+// ParseMaintenanceWindow parses a maintenance window of the form
+// "HH:MM-HH:MM", where both times are in UTC.
+func ParseMaintenanceWindow(s string) (MaintenanceWindow, error) {
+	start, end, ok := strings.Cut(s, "-")
+	if !ok {
+		return MaintenanceWindow{}, errors.Errorf("window %q not of the form HH:MM-HH:MM: %w", s, applicationerrors.MaintenanceWindowNotValid)
+	}
+	startOffset, err := parseTimeOfDay(start)
+	if err != nil {
+		return MaintenanceWindow{}, errors.Errorf("window %q start: %w", s, err)
+	}
+	endOffset, err := parseTimeOfDay(end)
+	if err != nil {
+		return MaintenanceWindow{}, errors.Errorf("window %q end: %w", s, err)
+	}
+	w := MaintenanceWindow{Start: startOffset, End: endOffset}
+	return w, w.Validate()
+}
+
+func parseTimeOfDay(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return 0, errors.Errorf("%q is not a time of day: %w", s, applicationerrors.MaintenanceWindowNotValid)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// Validate returns [applicationerrors.MaintenanceWindowNotValid] if the
+// window is not a whole number of minutes within a day, or is empty.
+func (w MaintenanceWindow) Validate() error {
+	for _, offset := range []time.Duration{w.Start, w.End} {
+		if offset < 0 || offset >= 24*time.Hour || offset%time.Minute != 0 {
+			return errors.Errorf("offset %v: %w", offset, applicationerrors.MaintenanceWindowNotValid)
+		}
+	}
+	if w.Start == w.End {
+		return errors.Errorf("window is empty: %w", applicationerrors.MaintenanceWindowNotValid)
+	}
+	return nil
+}
+
+// Contains returns true if the given time falls within the window.
+func (w MaintenanceWindow) Contains(t time.Time) bool {
+	t = t.UTC()
+	offset := t.Sub(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC))
+	if w.Start < w.End {
+		return offset >= w.Start && offset < w.End
+	}
+	return offset >= w.Start || offset < w.End
+}
+
+// String returns the window in the form "HH:MM-HH:MM".
+func (w MaintenanceWindow) String() string {
+	return formatTimeOfDay(w.Start) + "-" + formatTimeOfDay(w.End)
+}
+
+func formatTimeOfDay(offset time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(offset.Hours()), int(offset.Minutes())%60)
+}
+
+// ApplicationRefreshPolicy is the charm refresh policy of an application.
+type ApplicationRefreshPolicy struct {
+	// Policy is what happens when a new charm revision is found.
+	Policy RefreshPolicy
+	// Window is the maintenance window within which automatic refreshes may
+	// happen. If nil, they may happen at any time.
+	Window *MaintenanceWindow
+}
+
+// Validate returns an error if the policy is not valid.
+func (p ApplicationRefreshPolicy) Validate() error {
+	if _, err := ParseRefreshPolicy(string(p.Policy)); err != nil {
+		return errors.Capture(err)
+	}
+	if p.Window != nil {
+		return p.Window.Validate()
+	}
+	return nil
+}
+
+// RefreshCandidate is an application with a refresh policy other than none,
+// for which a newer revision of its charm has been found in its channel.
+type RefreshCandidate struct {
+	// ApplicationName is the name of the application.
+	ApplicationName string
+	// RefreshPolicy is the refresh policy of the application.
+	RefreshPolicy ApplicationRefreshPolicy
+	// Current is the charm the application is using.
+	Current domaincharm.CharmLocator
+	// Latest is the newest charm revision found for the application.
+	Latest domaincharm.CharmLocator
+	// Notified is true if the application has already been notified of the
+	// latest revision.
+	Notified bool
+	// RefreshFailed is true if refreshing the application to the latest
+	// revision has already failed.
+	RefreshFailed bool
+}
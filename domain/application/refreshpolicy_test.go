@@ -0,0 +1,84 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package application
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type refreshPolicySuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestRefreshPolicySuite(t *testing.T) {
+	tc.Run(t, &refreshPolicySuite{})
+}
+
+func (s *refreshPolicySuite) TestParseRefreshPolicy(c *tc.C) {
+	for _, name := range []string{"none", "notify", "auto"} {
+		policy, err := ParseRefreshPolicy(name)
+		c.Check(err, tc.ErrorIsNil)
+		c.Check(policy, tc.Equals, RefreshPolicy(name))
+	}
+
+	_, err := ParseRefreshPolicy("sometimes")
+	c.Check(err, tc.ErrorIs, applicationerrors.RefreshPolicyNotValid)
+}
+
+func (s *refreshPolicySuite) TestParseMaintenanceWindow(c *tc.C) {
+	w, err := ParseMaintenanceWindow("02:30-04:00")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(w, tc.DeepEquals, MaintenanceWindow{
+		Start: 2*time.Hour + 30*time.Minute,
+		End:   4 * time.Hour,
+	})
+	c.Check(w.String(), tc.Equals, "02:30-04:00")
+}
+
+func (s *refreshPolicySuite) TestParseMaintenanceWindowNotValid(c *tc.C) {
+	for _, window := range []string{
+		"",
+		"02:00",
+		"02:00-25:00",
+		"2am-4am",
+		"03:00-03:00",
+	} {
+		_, err := ParseMaintenanceWindow(window)
+		c.Check(err, tc.ErrorIs, applicationerrors.MaintenanceWindowNotValid, tc.Commentf("window %q", window))
+	}
+}
+
+func (s *refreshPolicySuite) TestMaintenanceWindowContains(c *tc.C) {
+	w := MaintenanceWindow{Start: 2 * time.Hour, End: 4 * time.Hour}
+	c.Check(w.Contains(time.Date(2025, 1, 1, 2, 0, 0, 0, time.UTC)), tc.IsTrue)
+	c.Check(w.Contains(time.Date(2025, 1, 1, 3, 59, 0, 0, time.UTC)), tc.IsTrue)
+	c.Check(w.Contains(time.Date(2025, 1, 1, 4, 0, 0, 0, time.UTC)), tc.IsFalse)
+	c.Check(w.Contains(time.Date(2025, 1, 1, 1, 0, 0, 0, time.UTC)), tc.IsFalse)
+
+	// Times are compared in UTC.
+	loc := time.FixedZone("UTC+2", 2*60*60)
+	c.Check(w.Contains(time.Date(2025, 1, 1, 5, 0, 0, 0, loc)), tc.IsTrue)
+}
+
+func (s *refreshPolicySuite) TestMaintenanceWindowContainsSpanningMidnight(c *tc.C) {
+	w := MaintenanceWindow{Start: 23 * time.Hour, End: time.Hour}
+	c.Check(w.Contains(time.Date(2025, 1, 1, 23, 30, 0, 0, time.UTC)), tc.IsTrue)
+	c.Check(w.Contains(time.Date(2025, 1, 1, 0, 30, 0, 0, time.UTC)), tc.IsTrue)
+	c.Check(w.Contains(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)), tc.IsFalse)
+}
+
+func (s *refreshPolicySuite) TestApplicationRefreshPolicyValidate(c *tc.C) {
+	c.Check(ApplicationRefreshPolicy{Policy: RefreshPolicyAuto}.Validate(), tc.ErrorIsNil)
+	c.Check(ApplicationRefreshPolicy{Policy: "sometimes"}.Validate(), tc.ErrorIs, applicationerrors.RefreshPolicyNotValid)
+	c.Check(ApplicationRefreshPolicy{
+		Policy: RefreshPolicyAuto,
+		Window: &MaintenanceWindow{Start: time.Hour, End: time.Hour},
+	}.Validate(), tc.ErrorIs, applicationerrors.MaintenanceWindowNotValid)
+}
@@ -5,11 +5,13 @@ package service
 
 import (
 	"context"
+	"maps"
 	"math"
 	"strconv"
 
 	"github.com/juju/collections/set"
 	"github.com/juju/collections/transform"
+	"gopkg.in/yaml.v2"
 
 	coreapplication "github.com/juju/juju/core/application"
 	"github.com/juju/juju/core/arch"
@@ -183,6 +185,35 @@ type ApplicationState interface {
 	// This will return an empty slice if there are no applications.
 	GetApplicationsForRevisionUpdater(ctx context.Context) ([]application.RevisionUpdaterApplication, error)
 
+	// SetApplicationRefreshPolicy sets the charm refresh policy of the
+	// application.
+	//
+	// The following errors may be returned:
+	// - [applicationerrors.ApplicationNotFound] if the application doesn't
+	// exist.
+	// - [applicationerrors.ApplicationIsDead] if the application is dead.
+	SetApplicationRefreshPolicy(ctx context.Context, appID coreapplication.ID, policy application.ApplicationRefreshPolicy) error
+
+	// GetApplicationRefreshPolicy returns the charm refresh policy of the
+	// application.
+	//
+	// If the application doesn't exist,
+	// [applicationerrors.ApplicationNotFound] is returned.
+	GetApplicationRefreshPolicy(ctx context.Context, appID coreapplication.ID) (application.ApplicationRefreshPolicy, error)
+
+	// GetApplicationRefreshCandidates returns the alive applications with a
+	// refresh policy other than none, for which a newer revision of their
+	// charm is pending.
+	GetApplicationRefreshCandidates(ctx context.Context) ([]application.RefreshCandidate, error)
+
+	// SetApplicationRefreshNotified records that the application has been
+	// notified of the charm revision.
+	SetApplicationRefreshNotified(ctx context.Context, appID coreapplication.ID, revision int) error
+
+	// SetApplicationRefreshFailed records that refreshing the application to
+	// the charm revision failed.
+	SetApplicationRefreshFailed(ctx context.Context, appID coreapplication.ID, revision int) error
+
 	// GetCharmConfigByApplicationID returns the charm config for the specified
 	// application ID.
 	// If no application is found, an error satisfying
@@ -587,9 +618,8 @@ func validateCharmAndApplicationParams(
 // current model.
 //
 // The checks performed are:
-// - Checks to see if the charm requires shared storage and if so is the min
-// count greater than 0. While we might not support shared storage we can
-// support it if we don't have to provision it.
+// - Checks to see if the charm requires shared storage and if so that the
+// storage is a filesystem. Only filesystems can be shared between units.
 // - Checks to see that the minimum count for a storage definition is not less
 // than zero. Negative storage counts are impossible to achieve.
 // - Checks to see that the maximum count for a storage definition is not less
@@ -597,10 +627,10 @@ func validateCharmAndApplicationParams(
 // - Checks to see that the min is not greater than the max.
 func validateCharmStorage(charmStorage map[string]internalcharm.Storage) error {
 	for name, storage := range charmStorage {
-		if storage.Shared && storage.CountMin > 0 {
+		if storage.Shared && storage.Type != internalcharm.StorageFilesystem {
 			return errors.Errorf(
-				"charm storage %q requires shared storage which is not implemented",
-				name,
+				"charm storage %q requires shared %s storage, only filesystem storage can be shared",
+				name, storage.Type,
 			)
 		}
 
@@ -882,12 +912,6 @@ func makeApplicationStorageDirectiveArgs(
 
 	rval := make([]application.CreateApplicationStorageDirectiveArg, 0, len(charmMetaStorage))
 	for charmStorageName, charmStorageDef := range charmMetaStorage {
-		// We don't support shared storage. If the charm has a shared storage
-		// definition we ignore it.
-		if charmStorageDef.Shared {
-			continue
-		}
-
 		arg := makeApplicationStorageDirectiveArg(
 			domainstorage.Name(charmStorageName),
 			directiveOverrides[charmStorageName],
@@ -1370,7 +1394,12 @@ func (s *Service) ResolveCharmDownload(ctx context.Context, appID coreapplicatio
 	} else if err != nil {
 		return errors.Capture(err)
 	}
+	return s.resolveCharmDownload(ctx, info, resolve)
+}
 
+// resolveCharmDownload verifies the downloaded charm archive against the
+// reserved download info, stores it and sets the charm as available.
+func (s *Service) resolveCharmDownload(ctx context.Context, info application.CharmDownloadInfo, resolve application.ResolveCharmDownload) error {
 	// If the charm UUID doesn't match, what was downloaded then we need to
 	// return an error.
 	if info.CharmUUID != resolve.CharmUUID {
@@ -1633,6 +1662,65 @@ func (s *Service) UpdateApplicationConfig(ctx context.Context, appID coreapplica
 		return errors.Errorf("application ID: %w", err)
 	}
 
+	return s.updateApplicationConfig(ctx, appID, nil, newConfig)
+}
+
+// UpdateApplicationConfigWithYAML updates the application config with the
+// settings found under the application name in the supplied YAML, which uses
+// the bundle style format of:
+//
+//	<application name>:
+//	  <key>: <value>
+//
+// Values in the YAML are coerced to the type of the charm config option they
+// set. The specified string values are applied on top of the YAML settings,
+// taking precedence over them. All settings are applied together, so either
+// all of them are set or none are.
+// If no application is found, an error satisfying
+// [applicationerrors.ApplicationNotFound] is returned.
+// If the application config is not valid, or the YAML has no settings for
+// the application, an error satisfying
+// [applicationerrors.InvalidApplicationConfig] is returned.
+func (s *Service) UpdateApplicationConfigWithYAML(
+	ctx context.Context,
+	appID coreapplication.ID,
+	appName string,
+	configYAML string,
+	newConfig map[string]string,
+) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := appID.Validate(); err != nil {
+		return errors.Errorf("application ID: %w", err)
+	}
+
+	yamlConfig, err := splitTrustFromConfigYAML(configYAML, appName)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return s.updateApplicationConfig(ctx, appID, yamlConfig, newConfig)
+}
+
+// applicationConfigYAML holds the bundle style config YAML for an
+// application, with the trust setting split out of the application's
+// settings.
+type applicationConfigYAML struct {
+	appName string
+	data    []byte
+	trust   *bool
+}
+
+// updateApplicationConfig coerces the YAML config and the string config
+// against the charm config, and sets the result as the application config in
+// a single operation. The string config takes precedence over the YAML config.
+func (s *Service) updateApplicationConfig(
+	ctx context.Context,
+	appID coreapplication.ID,
+	yamlConfig *applicationConfigYAML,
+	newConfig map[string]string,
+) error {
 	// Get the charm config. This should be safe to do outside of a singular
 	// transaction, as the charm config is immutable. So it will either be there
 	// or not, and if it's not there we can return an error stating that.
@@ -1658,15 +1746,30 @@ func (s *Service) UpdateApplicationConfig(ctx context.Context, appID coreapplica
 	if err != nil {
 		return errors.Capture(err)
 	}
+	if trust == nil && yamlConfig != nil {
+		trust = yamlConfig.trust
+	}
 
-	// Everything else from the newConfig is just application config. Treat it
-	// as such.
-	coercedConfig, err := charmConfig.ParseSettingsStrings(newConfig)
+	// Everything else from the YAML config is just application config.
+	coercedConfig := make(internalcharm.Config)
+	if yamlConfig != nil {
+		coercedConfig, err = charmConfig.ParseSettingsYAML(yamlConfig.data, yamlConfig.appName)
+		if errors.Is(err, internalcharm.ErrUnknownOption) {
+			return errors.Errorf("%w: %w", applicationerrors.InvalidApplicationConfig, err)
+		} else if err != nil {
+			return errors.Capture(err)
+		}
+	}
+
+	// Everything else from the newConfig is just application config too,
+	// taking precedence over the YAML config.
+	stringConfig, err := charmConfig.ParseSettingsStrings(newConfig)
 	if errors.Is(err, internalcharm.ErrUnknownOption) {
 		return errors.Errorf("%w: %w", applicationerrors.InvalidApplicationConfig, err)
 	} else if err != nil {
 		return errors.Capture(err)
 	}
+	maps.Copy(coercedConfig, stringConfig)
 
 	// Validate the secret config.
 	if err := validateSecretConfig(charmConfig, coercedConfig); err != nil {
@@ -1858,6 +1961,62 @@ func getTrustSettingFromConfig(cfg map[string]string) (*bool, error) {
 	return &b, nil
 }
 
+// getTrustSettingFromYAMLConfig returns the trust setting from the YAML config,
+// removing it from the config. The trust setting may be either a boolean or a
+// string that parses as a boolean.
+func getTrustSettingFromYAMLConfig(cfg map[string]any) (*bool, error) {
+	trust, ok := cfg[coreapplication.TrustConfigOptionName]
+	if !ok {
+		// trust is not included, so we should not update it.
+		return nil, nil
+	}
+	delete(cfg, coreapplication.TrustConfigOptionName)
+
+	switch t := trust.(type) {
+	case bool:
+		return &t, nil
+	case string:
+		b, err := strconv.ParseBool(t)
+		if err != nil {
+			return nil, errors.Errorf("parsing trust setting: %w", err)
+		}
+		return &b, nil
+	default:
+		return nil, errors.Errorf("parsing trust setting: unexpected type %T", trust)
+	}
+}
+
+// splitTrustFromConfigYAML returns the named application's settings from the
+// bundle style config YAML, with the trust setting removed from them so that
+// the rest can be parsed against the charm config.
+func splitTrustFromConfigYAML(configYAML, appName string) (*applicationConfigYAML, error) {
+	var allSettings map[string]internalcharm.Config
+	if err := yaml.Unmarshal([]byte(configYAML), &allSettings); err != nil {
+		return nil, errors.Errorf("%w: parsing settings data: %w",
+			applicationerrors.InvalidApplicationConfig, err)
+	}
+	settings, ok := allSettings[appName]
+	if !ok {
+		return nil, errors.Errorf("%w: no settings found for %q",
+			applicationerrors.InvalidApplicationConfig, appName)
+	}
+
+	trust, err := getTrustSettingFromYAMLConfig(settings)
+	if err != nil {
+		return nil, errors.Errorf("%w: %w", applicationerrors.InvalidApplicationConfig, err)
+	}
+
+	data, err := yaml.Marshal(map[string]internalcharm.Config{appName: settings})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return &applicationConfigYAML{
+		appName: appName,
+		data:    data,
+		trust:   trust,
+	}, nil
+}
+
 func encodeApplicationConfig(cfg internalcharm.Config, charmConfig charm.Config) (map[string]application.ApplicationConfig, error) {
 	// If there is no config, then we can just return nil.
 	if len(cfg) == 0 {
@@ -36,10 +36,12 @@ import (
 	applicationerrors "github.com/juju/juju/domain/application/errors"
 	"github.com/juju/juju/domain/deployment"
 	domainstorage "github.com/juju/juju/domain/storage"
+	domainstorageprov "github.com/juju/juju/domain/storageprovisioning"
 	domaintesting "github.com/juju/juju/domain/testing"
 	internalcharm "github.com/juju/juju/internal/charm"
 	"github.com/juju/juju/internal/errors"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
+	internalstorage "github.com/juju/juju/internal/storage"
 	"github.com/juju/juju/internal/testhelpers"
 	"github.com/juju/juju/testcharms"
 )
@@ -797,6 +799,140 @@ func (s *applicationServiceSuite) TestUpdateApplicationConfigInvalidApplicationI
 	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
 }
 
+func (s *applicationServiceSuite) TestUpdateApplicationConfigWithYAML(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appUUID := applicationtesting.GenApplicationUUID(c)
+
+	s.state.EXPECT().GetCharmConfigByApplicationID(gomock.Any(), appUUID).Return("", applicationcharm.Config{
+		Options: map[string]applicationcharm.Option{
+			"foo": {
+				Type: applicationcharm.OptionString,
+			},
+			"bar": {
+				Type: applicationcharm.OptionInt,
+			},
+			"baz": {
+				Type: applicationcharm.OptionBool,
+			},
+		},
+	}, nil)
+	s.state.EXPECT().UpdateApplicationConfigAndSettings(gomock.Any(), appUUID, map[string]application.ApplicationConfig{
+		"foo": {
+			Type:  applicationcharm.OptionString,
+			Value: "override",
+		},
+		"bar": {
+			Type:  applicationcharm.OptionInt,
+			Value: int64(42),
+		},
+		"baz": {
+			Type:  applicationcharm.OptionBool,
+			Value: true,
+		},
+	}, application.UpdateApplicationSettingsArg{
+		Trust: ptr(true),
+	}).Return(nil)
+
+	err := s.service.UpdateApplicationConfigWithYAML(c.Context(), appUUID, "app", `
+app:
+  trust: true
+  foo: yaml
+  bar: 42
+  baz: "true"
+other:
+  foo: ignored
+`, map[string]string{
+		"foo": "override",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *applicationServiceSuite) TestUpdateApplicationConfigWithYAMLTrustOverride(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appUUID := applicationtesting.GenApplicationUUID(c)
+
+	s.state.EXPECT().GetCharmConfigByApplicationID(gomock.Any(), appUUID).Return("", applicationcharm.Config{}, nil)
+	s.state.EXPECT().UpdateApplicationConfigAndSettings(
+		gomock.Any(), appUUID,
+		map[string]application.ApplicationConfig{},
+		application.UpdateApplicationSettingsArg{
+			Trust: ptr(false),
+		},
+	).Return(nil)
+
+	err := s.service.UpdateApplicationConfigWithYAML(c.Context(), appUUID, "app", `
+app:
+  trust: true
+`, map[string]string{
+		"trust": "false",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *applicationServiceSuite) TestUpdateApplicationConfigWithYAMLNoSettings(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appUUID := applicationtesting.GenApplicationUUID(c)
+
+	err := s.service.UpdateApplicationConfigWithYAML(c.Context(), appUUID, "app", `
+other:
+  foo: bar
+`, nil)
+	c.Assert(err, tc.ErrorIs, applicationerrors.InvalidApplicationConfig)
+	c.Assert(err, tc.ErrorMatches, `.*no settings found for "app"`)
+}
+
+func (s *applicationServiceSuite) TestUpdateApplicationConfigWithYAMLInvalidYAML(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appUUID := applicationtesting.GenApplicationUUID(c)
+
+	err := s.service.UpdateApplicationConfigWithYAML(c.Context(), appUUID, "app", `{{{`, nil)
+	c.Assert(err, tc.ErrorIs, applicationerrors.InvalidApplicationConfig)
+}
+
+func (s *applicationServiceSuite) TestUpdateApplicationConfigWithYAMLUnknownOption(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appUUID := applicationtesting.GenApplicationUUID(c)
+
+	s.state.EXPECT().GetCharmConfigByApplicationID(gomock.Any(), appUUID).Return("", applicationcharm.Config{
+		Options: map[string]applicationcharm.Option{
+			"foo": {
+				Type: applicationcharm.OptionString,
+			},
+		},
+	}, nil)
+
+	err := s.service.UpdateApplicationConfigWithYAML(c.Context(), appUUID, "app", `
+app:
+  bar: 42
+`, nil)
+	c.Assert(err, tc.ErrorIs, applicationerrors.InvalidApplicationConfig)
+}
+
+func (s *applicationServiceSuite) TestUpdateApplicationConfigWithYAMLInvalidValue(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appUUID := applicationtesting.GenApplicationUUID(c)
+
+	s.state.EXPECT().GetCharmConfigByApplicationID(gomock.Any(), appUUID).Return("", applicationcharm.Config{
+		Options: map[string]applicationcharm.Option{
+			"bar": {
+				Type: applicationcharm.OptionInt,
+			},
+		},
+	}, nil)
+
+	err := s.service.UpdateApplicationConfigWithYAML(c.Context(), appUUID, "app", `
+app:
+  bar: [1, 2]
+`, nil)
+	c.Assert(err, tc.ErrorMatches, `.*option "bar" expected int.*`)
+}
+
 func (s *applicationServiceSuite) TestGetApplicationAndCharmConfig(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -1653,3 +1789,73 @@ func (s *applicationStorageSuite) TestMakeApplicationStorageDirectiveArgs(c *tc.
 		})
 	}
 }
+
+// TestMakeApplicationSharedStorageArgs tests that a shared filesystem backed
+// by a model scoped provider results in a storage instance for the
+// application.
+func (s *applicationStorageSuite) TestMakeApplicationSharedStorageArgs(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	poolUUID, err := domainstorage.NewStoragePoolUUID()
+	c.Assert(err, tc.ErrorIsNil)
+
+	provider := NewMockStorageProvider(ctrl)
+	provider.EXPECT().Scope().Return(internalstorage.ScopeEnviron).AnyTimes()
+	provider.EXPECT().Supports(internalstorage.StorageKindFilesystem).Return(true).AnyTimes()
+	poolProvider := NewMockStoragePoolProvider(ctrl)
+	poolProvider.EXPECT().GetProviderForPool(gomock.Any(), poolUUID).Return(provider, nil)
+
+	args, err := makeApplicationSharedStorageArgs(
+		c.Context(), poolProvider, []application.StorageDirective{
+			{
+				Count:    1,
+				Name:     "data",
+				PoolUUID: poolUUID,
+				Shared:   true,
+				Type:     applicationcharm.StorageFilesystem,
+			},
+			{
+				Count:    1,
+				Name:     "logs",
+				PoolUUID: poolUUID,
+				Type:     applicationcharm.StorageFilesystem,
+			},
+		},
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(args, tc.HasLen, 1)
+	c.Check(args[0].Name.String(), tc.Equals, "data")
+	c.Assert(args[0].Filesystem, tc.NotNil)
+	c.Check(args[0].Filesystem.ProvisionScope, tc.Equals, domainstorageprov.ProvisionScopeModel)
+	c.Check(args[0].Volume, tc.IsNil)
+}
+
+// TestMakeApplicationSharedStorageArgsMachineScoped tests that a shared
+// filesystem cannot be provisioned by a machine scoped provider.
+func (s *applicationStorageSuite) TestMakeApplicationSharedStorageArgsMachineScoped(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	poolUUID, err := domainstorage.NewStoragePoolUUID()
+	c.Assert(err, tc.ErrorIsNil)
+
+	provider := NewMockStorageProvider(ctrl)
+	provider.EXPECT().Scope().Return(internalstorage.ScopeMachine).AnyTimes()
+	provider.EXPECT().Supports(internalstorage.StorageKindFilesystem).Return(true).AnyTimes()
+	poolProvider := NewMockStoragePoolProvider(ctrl)
+	poolProvider.EXPECT().GetProviderForPool(gomock.Any(), poolUUID).Return(provider, nil)
+
+	_, err = makeApplicationSharedStorageArgs(
+		c.Context(), poolProvider, []application.StorageDirective{
+			{
+				Count:    1,
+				Name:     "data",
+				PoolUUID: poolUUID,
+				Shared:   true,
+				Type:     applicationcharm.StorageFilesystem,
+			},
+		},
+	)
+	c.Check(err, tc.ErrorIs, applicationerrors.SharedStorageNotSupported)
+}
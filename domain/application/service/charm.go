@@ -137,6 +137,12 @@ type CharmState interface {
 	// found.
 	GetCharmDownloadInfo(ctx context.Context, id corecharm.ID) (*charm.DownloadInfo, error)
 
+	// AddCharmChannel records that the revision updater found the charm in
+	// the channel, so that the revision is offered as a refresh candidate to
+	// the applications tracking it. It is a no-op if it is already recorded.
+	// Returns [applicationerrors.CharmNotFound] if the charm is not found.
+	AddCharmChannel(ctx context.Context, id corecharm.ID, channel charm.Channel) error
+
 	// GetAvailableCharmArchiveSHA256 returns the SHA256 hash of the charm
 	// archive for the given charm id. If the charm is not available,
 	// [applicationerrors.CharmNotResolved] is returned. Returns
@@ -734,6 +740,84 @@ func (s *Service) GetCharmDownloadInfo(ctx context.Context, locator charm.CharmL
 	return s.st.GetCharmDownloadInfo(ctx, id)
 }
 
+// GetCharmRevisionDownloadInfo returns the information needed to download
+// the archive of a charmhub charm which isn't referenced by any application
+// yet, such as a revision reserved by the revision updater.
+//
+// The following errors may be returned:
+// - [applicationerrors.CharmNotFound] if the charm doesn't exist.
+// - [applicationerrors.CharmAlreadyAvailable] if the archive has already
+// been downloaded.
+// - [applicationerrors.CharmDownloadInfoNotFound] if the charm has no
+// download info.
+func (s *Service) GetCharmRevisionDownloadInfo(ctx context.Context, locator charm.CharmLocator) (application.CharmDownloadInfo, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if locator.Source != charm.CharmHubSource {
+		return application.CharmDownloadInfo{}, applicationerrors.CharmProvenanceNotValid
+	}
+	id, err := s.getCharmID(ctx, argsFromLocator(locator))
+	if err != nil {
+		return application.CharmDownloadInfo{}, errors.Capture(err)
+	}
+	return s.getCharmRevisionDownloadInfo(ctx, id, locator.Name)
+}
+
+// ResolveCharmRevisionDownload resolves the download of a charmhub charm
+// which isn't referenced by any application yet. The archive is verified
+// against the reserved hash before being stored, after which the charm is
+// available. It is a no-op if the charm is already available.
+//
+// The following errors may be returned:
+// - [applicationerrors.CharmNotFound] if the charm doesn't exist.
+// - [applicationerrors.CharmNotResolved] if the charm UUID doesn't match.
+// - [applicationerrors.CharmHashMismatch] if the archive hash doesn't match.
+func (s *Service) ResolveCharmRevisionDownload(ctx context.Context, locator charm.CharmLocator, resolve application.ResolveCharmDownload) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	id, err := s.getCharmID(ctx, argsFromLocator(locator))
+	if err != nil {
+		return errors.Capture(err)
+	}
+	info, err := s.getCharmRevisionDownloadInfo(ctx, id, locator.Name)
+	if errors.Is(err, applicationerrors.CharmAlreadyAvailable) {
+		return nil
+	} else if err != nil {
+		return errors.Capture(err)
+	}
+	return s.resolveCharmDownload(ctx, info, resolve)
+}
+
+func (s *Service) getCharmRevisionDownloadInfo(ctx context.Context, id corecharm.ID, name string) (application.CharmDownloadInfo, error) {
+	available, err := s.st.IsCharmAvailable(ctx, id)
+	if err != nil {
+		return application.CharmDownloadInfo{}, errors.Capture(err)
+	} else if available {
+		return application.CharmDownloadInfo{}, applicationerrors.CharmAlreadyAvailable
+	}
+
+	downloadInfo, err := s.st.GetCharmDownloadInfo(ctx, id)
+	if err != nil {
+		return application.CharmDownloadInfo{}, errors.Capture(err)
+	} else if downloadInfo == nil {
+		return application.CharmDownloadInfo{}, applicationerrors.CharmDownloadInfoNotFound
+	}
+
+	_, hash, err := s.st.GetCharmArchiveMetadata(ctx, id)
+	if err != nil {
+		return application.CharmDownloadInfo{}, errors.Errorf("getting charm archive metadata: %w", err)
+	}
+
+	return application.CharmDownloadInfo{
+		CharmUUID:    id,
+		Name:         name,
+		SHA256:       hash,
+		DownloadInfo: *downloadInfo,
+	}, nil
+}
+
 // GetAvailableCharmArchiveSHA256 returns the SHA256 hash of the charm archive
 // for the given charm name, source and revision. If the charm is not available,
 // [applicationerrors.CharmNotResolved] is returned.
@@ -748,6 +832,30 @@ func (s *Service) GetAvailableCharmArchiveSHA256(ctx context.Context, locator ch
 	return s.st.GetAvailableCharmArchiveSHA256(ctx, id)
 }
 
+// GetCharmArchiveSHA256 returns the SHA256 hash of the charm archive for the
+// given charm name, source and revision. Unlike
+// [Service.GetAvailableCharmArchiveSHA256], the hash is returned even if the
+// charm archive hasn't been downloaded yet, in which case it is the hash the
+// archive is expected to have.
+//
+// If the charm does not exist, a [applicationerrors.CharmNotFound] error is
+// returned.
+func (s *Service) GetCharmArchiveSHA256(ctx context.Context, locator charm.CharmLocator) (string, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	args := argsFromLocator(locator)
+	id, err := s.getCharmID(ctx, args)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	_, hash, err := s.st.GetCharmArchiveMetadata(ctx, id)
+	if err != nil {
+		return "", errors.Errorf("getting charm archive metadata: %w", err)
+	}
+	return hash, nil
+}
+
 // ResolveUploadCharm resolves the upload of a charm archive. If the charm is
 // being imported from a migration then it can returns
 // [applicationerrors.CharmNotFound]. Returns
@@ -1020,7 +1128,18 @@ func (s *Service) ReserveCharmRevision(ctx context.Context, args charm.ReserveCh
 		// retrieve the charm ID
 		result.ID, err = s.st.GetCharmID(ctx, args.ReferenceName, args.Revision, charmSource)
 	}
-	return result.ID, warnings, errors.Capture(err)
+	if err != nil {
+		return "", warnings, errors.Capture(err)
+	}
+
+	// The same revision may be found in several channels, by applications
+	// tracking each of them.
+	if args.Channel != nil {
+		if err := s.st.AddCharmChannel(ctx, result.ID, *args.Channel); err != nil {
+			return "", warnings, errors.Errorf("recording charm channel: %w", err)
+		}
+	}
+	return result.ID, warnings, nil
 }
 
 // GetLatestPendingCharmhubCharm returns the latest charm that is pending from
@@ -1398,6 +1398,38 @@ func (s *charmServiceSuite) TestGetAvailableCharmArchiveSHA256(c *tc.C) {
 	c.Check(result, tc.DeepEquals, "hash")
 }
 
+func (s *charmServiceSuite) TestGetCharmArchiveSHA256(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	id := charmtesting.GenCharmID(c)
+
+	locator := charm.CharmLocator{
+		Name:     "foo",
+		Revision: 42,
+		Source:   charm.CharmHubSource,
+	}
+	s.state.EXPECT().GetCharmID(gomock.Any(), locator.Name, locator.Revision, locator.Source).Return(id, nil)
+	s.state.EXPECT().GetCharmArchiveMetadata(gomock.Any(), id).Return("archive-path", "hash", nil)
+
+	result, err := s.service.GetCharmArchiveSHA256(c.Context(), locator)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.Equals, "hash")
+}
+
+func (s *charmServiceSuite) TestGetCharmArchiveSHA256NotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	locator := charm.CharmLocator{
+		Name:     "foo",
+		Revision: 42,
+		Source:   charm.CharmHubSource,
+	}
+	s.state.EXPECT().GetCharmID(gomock.Any(), locator.Name, locator.Revision, locator.Source).Return("", applicationerrors.CharmNotFound)
+
+	_, err := s.service.GetCharmArchiveSHA256(c.Context(), locator)
+	c.Assert(err, tc.ErrorIs, applicationerrors.CharmNotFound)
+}
+
 func (s *charmServiceSuite) TestResolveUploadCharmInvalid(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -1823,6 +1855,43 @@ func (s *charmServiceSuite) TestReserveCharmRevisionAlreadyExists(c *tc.C) {
 	c.Assert(err, tc.ErrorIsNil)
 }
 
+func (s *charmServiceSuite) TestReserveCharmRevisionAlreadyExistsWithChannel(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	metadata := &internalcharm.Meta{
+		Name: "foo",
+	}
+	manifest := &internalcharm.Manifest{
+		Bases: []internalcharm.Base{{
+			Name:          "ubuntu",
+			Channel:       internalcharm.Channel{Risk: internalcharm.Beta},
+			Architectures: []string{"arm64"},
+		}},
+	}
+	charmBase := internalcharm.NewCharmBase(metadata, manifest, &internalcharm.ConfigSpec{}, nil, nil)
+	channel := charm.Channel{Track: "latest", Risk: charm.RiskEdge}
+
+	// The revision was already found in another channel, so only the new
+	// channel is recorded.
+	s.state.EXPECT().AddCharm(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return("id", charm.CharmLocator{},
+		applicationerrors.CharmAlreadyExists)
+	s.state.EXPECT().GetCharmID(gomock.Any(), "foo", 42, charm.CharmHubSource).Return("id", nil)
+	s.state.EXPECT().AddCharmChannel(gomock.Any(), corecharm.ID("id"), channel).Return(nil)
+
+	id, _, err := s.service.ReserveCharmRevision(c.Context(), charm.ReserveCharmRevisionArgs{
+		Charm:         charmBase,
+		Source:        corecharm.CharmHub,
+		ReferenceName: "foo",
+		Revision:      42,
+		DownloadInfo: &charm.DownloadInfo{
+			Provenance:  charm.ProvenanceDownload,
+			DownloadURL: "http://example.com/foo",
+		},
+		Channel: &channel,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(id, tc.Equals, corecharm.ID("id"))
+}
+
 func (s *charmServiceSuite) TestReserveCharmRevisionAlreadyExistsGetCharmIdError(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 	metadata := &internalcharm.Meta{
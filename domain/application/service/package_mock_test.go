@@ -509,6 +509,46 @@ func (m *MockState) EXPECT() *MockStateMockRecorder {
 	return m.recorder
 }
 
+// AddCAASUnitWithScale mocks base method.
+func (m *MockState) AddCAASUnitWithScale(arg0 context.Context, arg1 application.ID, arg2 application0.AddCAASUnitArg) (unit.Name, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCAASUnitWithScale", arg0, arg1, arg2)
+	ret0, _ := ret[0].(unit.Name)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AddCAASUnitWithScale indicates an expected call of AddCAASUnitWithScale.
+func (mr *MockStateMockRecorder) AddCAASUnitWithScale(arg0, arg1, arg2 any) *MockStateAddCAASUnitWithScaleCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCAASUnitWithScale", reflect.TypeOf((*MockState)(nil).AddCAASUnitWithScale), arg0, arg1, arg2)
+	return &MockStateAddCAASUnitWithScaleCall{Call: call}
+}
+
+// MockStateAddCAASUnitWithScaleCall wrap *gomock.Call
+type MockStateAddCAASUnitWithScaleCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateAddCAASUnitWithScaleCall) Return(arg0 unit.Name, arg1 int, arg2 error) *MockStateAddCAASUnitWithScaleCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateAddCAASUnitWithScaleCall) Do(f func(context.Context, application.ID, application0.AddCAASUnitArg) (unit.Name, int, error)) *MockStateAddCAASUnitWithScaleCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateAddCAASUnitWithScaleCall) DoAndReturn(f func(context.Context, application.ID, application0.AddCAASUnitArg) (unit.Name, int, error)) *MockStateAddCAASUnitWithScaleCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // AddCAASUnits mocks base method.
 func (m *MockState) AddCAASUnits(arg0 context.Context, arg1 application.ID, arg2 ...application0.AddCAASUnitArg) ([]unit.Name, error) {
 	m.ctrl.T.Helper()
@@ -593,6 +633,44 @@ func (c *MockStateAddCharmCall) DoAndReturn(f func(context.Context, charm0.Charm
 	return c
 }
 
+// AddCharmChannel mocks base method.
+func (m *MockState) AddCharmChannel(arg0 context.Context, arg1 charm.ID, arg2 charm0.Channel) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCharmChannel", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddCharmChannel indicates an expected call of AddCharmChannel.
+func (mr *MockStateMockRecorder) AddCharmChannel(arg0, arg1, arg2 any) *MockStateAddCharmChannelCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCharmChannel", reflect.TypeOf((*MockState)(nil).AddCharmChannel), arg0, arg1, arg2)
+	return &MockStateAddCharmChannelCall{Call: call}
+}
+
+// MockStateAddCharmChannelCall wrap *gomock.Call
+type MockStateAddCharmChannelCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateAddCharmChannelCall) Return(arg0 error) *MockStateAddCharmChannelCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateAddCharmChannelCall) Do(f func(context.Context, charm.ID, charm0.Channel) error) *MockStateAddCharmChannelCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateAddCharmChannelCall) DoAndReturn(f func(context.Context, charm.ID, charm0.Channel) error) *MockStateAddCharmChannelCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // AddIAASSubordinateUnit mocks base method.
 func (m *MockState) AddIAASSubordinateUnit(arg0 context.Context, arg1 application0.SubordinateUnitArg) (unit.Name, []machine.Name, error) {
 	m.ctrl.T.Helper()
@@ -1691,6 +1769,84 @@ func (c *MockStateGetApplicationNameCall) DoAndReturn(f func(context.Context, ap
 	return c
 }
 
+// GetApplicationRefreshCandidates mocks base method.
+func (m *MockState) GetApplicationRefreshCandidates(arg0 context.Context) ([]application0.RefreshCandidate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationRefreshCandidates", arg0)
+	ret0, _ := ret[0].([]application0.RefreshCandidate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationRefreshCandidates indicates an expected call of GetApplicationRefreshCandidates.
+func (mr *MockStateMockRecorder) GetApplicationRefreshCandidates(arg0 any) *MockStateGetApplicationRefreshCandidatesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationRefreshCandidates", reflect.TypeOf((*MockState)(nil).GetApplicationRefreshCandidates), arg0)
+	return &MockStateGetApplicationRefreshCandidatesCall{Call: call}
+}
+
+// MockStateGetApplicationRefreshCandidatesCall wrap *gomock.Call
+type MockStateGetApplicationRefreshCandidatesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetApplicationRefreshCandidatesCall) Return(arg0 []application0.RefreshCandidate, arg1 error) *MockStateGetApplicationRefreshCandidatesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetApplicationRefreshCandidatesCall) Do(f func(context.Context) ([]application0.RefreshCandidate, error)) *MockStateGetApplicationRefreshCandidatesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetApplicationRefreshCandidatesCall) DoAndReturn(f func(context.Context) ([]application0.RefreshCandidate, error)) *MockStateGetApplicationRefreshCandidatesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetApplicationRefreshPolicy mocks base method.
+func (m *MockState) GetApplicationRefreshPolicy(arg0 context.Context, arg1 application.ID) (application0.ApplicationRefreshPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationRefreshPolicy", arg0, arg1)
+	ret0, _ := ret[0].(application0.ApplicationRefreshPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationRefreshPolicy indicates an expected call of GetApplicationRefreshPolicy.
+func (mr *MockStateMockRecorder) GetApplicationRefreshPolicy(arg0, arg1 any) *MockStateGetApplicationRefreshPolicyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationRefreshPolicy", reflect.TypeOf((*MockState)(nil).GetApplicationRefreshPolicy), arg0, arg1)
+	return &MockStateGetApplicationRefreshPolicyCall{Call: call}
+}
+
+// MockStateGetApplicationRefreshPolicyCall wrap *gomock.Call
+type MockStateGetApplicationRefreshPolicyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetApplicationRefreshPolicyCall) Return(arg0 application0.ApplicationRefreshPolicy, arg1 error) *MockStateGetApplicationRefreshPolicyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetApplicationRefreshPolicyCall) Do(f func(context.Context, application.ID) (application0.ApplicationRefreshPolicy, error)) *MockStateGetApplicationRefreshPolicyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetApplicationRefreshPolicyCall) DoAndReturn(f func(context.Context, application.ID) (application0.ApplicationRefreshPolicy, error)) *MockStateGetApplicationRefreshPolicyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetApplicationScaleState mocks base method.
 func (m *MockState) GetApplicationScaleState(arg0 context.Context, arg1 application.ID) (application0.ScaleState, error) {
 	m.ctrl.T.Helper()
@@ -2086,6 +2242,45 @@ func (c *MockStateGetAsyncCharmDownloadInfoCall) DoAndReturn(f func(context.Cont
 	return c
 }
 
+// GetAttachableStorageInstances mocks base method.
+func (m *MockState) GetAttachableStorageInstances(arg0 context.Context, arg1 []storage.ID) ([]application0.AttachableStorageInstance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachableStorageInstances", arg0, arg1)
+	ret0, _ := ret[0].([]application0.AttachableStorageInstance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachableStorageInstances indicates an expected call of GetAttachableStorageInstances.
+func (mr *MockStateMockRecorder) GetAttachableStorageInstances(arg0, arg1 any) *MockStateGetAttachableStorageInstancesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachableStorageInstances", reflect.TypeOf((*MockState)(nil).GetAttachableStorageInstances), arg0, arg1)
+	return &MockStateGetAttachableStorageInstancesCall{Call: call}
+}
+
+// MockStateGetAttachableStorageInstancesCall wrap *gomock.Call
+type MockStateGetAttachableStorageInstancesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetAttachableStorageInstancesCall) Return(arg0 []application0.AttachableStorageInstance, arg1 error) *MockStateGetAttachableStorageInstancesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetAttachableStorageInstancesCall) Do(f func(context.Context, []storage.ID) ([]application0.AttachableStorageInstance, error)) *MockStateGetAttachableStorageInstancesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetAttachableStorageInstancesCall) DoAndReturn(f func(context.Context, []storage.ID) ([]application0.AttachableStorageInstance, error)) *MockStateGetAttachableStorageInstancesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetAvailableCharmArchiveSHA256 mocks base method.
 func (m *MockState) GetAvailableCharmArchiveSHA256(arg0 context.Context, arg1 charm.ID) (string, error) {
 	m.ctrl.T.Helper()
@@ -3222,6 +3417,45 @@ func (c *MockStateGetNetNodeUUIDByUnitNameCall) DoAndReturn(f func(context.Conte
 	return c
 }
 
+// GetPendingCAASUnitFilesystems mocks base method.
+func (m *MockState) GetPendingCAASUnitFilesystems(arg0 context.Context, arg1 application.ID) ([]application0.PendingCAASUnitFilesystem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingCAASUnitFilesystems", arg0, arg1)
+	ret0, _ := ret[0].([]application0.PendingCAASUnitFilesystem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingCAASUnitFilesystems indicates an expected call of GetPendingCAASUnitFilesystems.
+func (mr *MockStateMockRecorder) GetPendingCAASUnitFilesystems(arg0, arg1 any) *MockStateGetPendingCAASUnitFilesystemsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingCAASUnitFilesystems", reflect.TypeOf((*MockState)(nil).GetPendingCAASUnitFilesystems), arg0, arg1)
+	return &MockStateGetPendingCAASUnitFilesystemsCall{Call: call}
+}
+
+// MockStateGetPendingCAASUnitFilesystemsCall wrap *gomock.Call
+type MockStateGetPendingCAASUnitFilesystemsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetPendingCAASUnitFilesystemsCall) Return(arg0 []application0.PendingCAASUnitFilesystem, arg1 error) *MockStateGetPendingCAASUnitFilesystemsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetPendingCAASUnitFilesystemsCall) Do(f func(context.Context, application.ID) ([]application0.PendingCAASUnitFilesystem, error)) *MockStateGetPendingCAASUnitFilesystemsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetPendingCAASUnitFilesystemsCall) DoAndReturn(f func(context.Context, application.ID) ([]application0.PendingCAASUnitFilesystem, error)) *MockStateGetPendingCAASUnitFilesystemsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetSpaceUUIDByName mocks base method.
 func (m *MockState) GetSpaceUUIDByName(arg0 context.Context, arg1 string) (network.SpaceUUID, error) {
 	m.ctrl.T.Helper()
@@ -5250,6 +5484,120 @@ func (c *MockStateSetApplicationConstraintsCall) DoAndReturn(f func(context.Cont
 	return c
 }
 
+// SetApplicationRefreshFailed mocks base method.
+func (m *MockState) SetApplicationRefreshFailed(arg0 context.Context, arg1 application.ID, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetApplicationRefreshFailed", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetApplicationRefreshFailed indicates an expected call of SetApplicationRefreshFailed.
+func (mr *MockStateMockRecorder) SetApplicationRefreshFailed(arg0, arg1, arg2 any) *MockStateSetApplicationRefreshFailedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetApplicationRefreshFailed", reflect.TypeOf((*MockState)(nil).SetApplicationRefreshFailed), arg0, arg1, arg2)
+	return &MockStateSetApplicationRefreshFailedCall{Call: call}
+}
+
+// MockStateSetApplicationRefreshFailedCall wrap *gomock.Call
+type MockStateSetApplicationRefreshFailedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateSetApplicationRefreshFailedCall) Return(arg0 error) *MockStateSetApplicationRefreshFailedCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateSetApplicationRefreshFailedCall) Do(f func(context.Context, application.ID, int) error) *MockStateSetApplicationRefreshFailedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateSetApplicationRefreshFailedCall) DoAndReturn(f func(context.Context, application.ID, int) error) *MockStateSetApplicationRefreshFailedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetApplicationRefreshNotified mocks base method.
+func (m *MockState) SetApplicationRefreshNotified(arg0 context.Context, arg1 application.ID, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetApplicationRefreshNotified", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetApplicationRefreshNotified indicates an expected call of SetApplicationRefreshNotified.
+func (mr *MockStateMockRecorder) SetApplicationRefreshNotified(arg0, arg1, arg2 any) *MockStateSetApplicationRefreshNotifiedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetApplicationRefreshNotified", reflect.TypeOf((*MockState)(nil).SetApplicationRefreshNotified), arg0, arg1, arg2)
+	return &MockStateSetApplicationRefreshNotifiedCall{Call: call}
+}
+
+// MockStateSetApplicationRefreshNotifiedCall wrap *gomock.Call
+type MockStateSetApplicationRefreshNotifiedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateSetApplicationRefreshNotifiedCall) Return(arg0 error) *MockStateSetApplicationRefreshNotifiedCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateSetApplicationRefreshNotifiedCall) Do(f func(context.Context, application.ID, int) error) *MockStateSetApplicationRefreshNotifiedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateSetApplicationRefreshNotifiedCall) DoAndReturn(f func(context.Context, application.ID, int) error) *MockStateSetApplicationRefreshNotifiedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetApplicationRefreshPolicy mocks base method.
+func (m *MockState) SetApplicationRefreshPolicy(arg0 context.Context, arg1 application.ID, arg2 application0.ApplicationRefreshPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetApplicationRefreshPolicy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetApplicationRefreshPolicy indicates an expected call of SetApplicationRefreshPolicy.
+func (mr *MockStateMockRecorder) SetApplicationRefreshPolicy(arg0, arg1, arg2 any) *MockStateSetApplicationRefreshPolicyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetApplicationRefreshPolicy", reflect.TypeOf((*MockState)(nil).SetApplicationRefreshPolicy), arg0, arg1, arg2)
+	return &MockStateSetApplicationRefreshPolicyCall{Call: call}
+}
+
+// MockStateSetApplicationRefreshPolicyCall wrap *gomock.Call
+type MockStateSetApplicationRefreshPolicyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateSetApplicationRefreshPolicyCall) Return(arg0 error) *MockStateSetApplicationRefreshPolicyCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateSetApplicationRefreshPolicyCall) Do(f func(context.Context, application.ID, application0.ApplicationRefreshPolicy) error) *MockStateSetApplicationRefreshPolicyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateSetApplicationRefreshPolicyCall) DoAndReturn(f func(context.Context, application.ID, application0.ApplicationRefreshPolicy) error) *MockStateSetApplicationRefreshPolicyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SetApplicationScalingState mocks base method.
 func (m *MockState) SetApplicationScalingState(arg0 context.Context, arg1 string, arg2 int, arg3 bool) error {
 	m.ctrl.T.Helper()
@@ -5709,6 +6057,44 @@ func (c *MockStateUpdateApplicationScaleCall) DoAndReturn(f func(context.Context
 	return c
 }
 
+// UpdateApplicationStorageDirectives mocks base method.
+func (m *MockState) UpdateApplicationStorageDirectives(arg0 context.Context, arg1 application.ID, arg2 []application0.UpdateApplicationStorageDirectiveArg) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateApplicationStorageDirectives", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateApplicationStorageDirectives indicates an expected call of UpdateApplicationStorageDirectives.
+func (mr *MockStateMockRecorder) UpdateApplicationStorageDirectives(arg0, arg1, arg2 any) *MockStateUpdateApplicationStorageDirectivesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateApplicationStorageDirectives", reflect.TypeOf((*MockState)(nil).UpdateApplicationStorageDirectives), arg0, arg1, arg2)
+	return &MockStateUpdateApplicationStorageDirectivesCall{Call: call}
+}
+
+// MockStateUpdateApplicationStorageDirectivesCall wrap *gomock.Call
+type MockStateUpdateApplicationStorageDirectivesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateUpdateApplicationStorageDirectivesCall) Return(arg0 error) *MockStateUpdateApplicationStorageDirectivesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateUpdateApplicationStorageDirectivesCall) Do(f func(context.Context, application.ID, []application0.UpdateApplicationStorageDirectiveArg) error) *MockStateUpdateApplicationStorageDirectivesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateUpdateApplicationStorageDirectivesCall) DoAndReturn(f func(context.Context, application.ID, []application0.UpdateApplicationStorageDirectiveArg) error) *MockStateUpdateApplicationStorageDirectivesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateCAASUnit mocks base method.
 func (m *MockState) UpdateCAASUnit(arg0 context.Context, arg1 unit.Name, arg2 application0.UpdateCAASUnitParams) error {
 	m.ctrl.T.Helper()
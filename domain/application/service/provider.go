@@ -24,6 +24,7 @@ import (
 	"github.com/juju/juju/core/logger"
 	coremachine "github.com/juju/juju/core/machine"
 	"github.com/juju/juju/core/providertracker"
+	corestorage "github.com/juju/juju/core/storage"
 	"github.com/juju/juju/core/trace"
 	coreunit "github.com/juju/juju/core/unit"
 	"github.com/juju/juju/domain/application"
@@ -390,6 +391,174 @@ func (s *ProviderService) AddCAASUnits(
 	return unitNames, nil
 }
 
+// AddCAASUnitAttachingStorage adds a unit to the CAAS application, increasing
+// the application's scale by one, with the existing storage instances
+// identified by storageIDs attached to the new unit. This allows storage that
+// was retained when the application was scaled down to be re-adopted by a new
+// unit. The name of the new unit and the new scale of the application are
+// returned.
+//
+// Storage can only be attached if it is alive, detached, was created for the
+// application and comes from the pool of the application's storage directive.
+//
+// The following errors may be returned:
+// - [applicationerrors.ApplicationNameNotValid] if the application name is
+// not valid.
+// - [applicationerrors.ApplicationNotFound] if the application doesn't exist.
+// - [storageerrors.StorageNotFound] if any of the storage doesn't exist.
+// - [applicationerrors.StorageNotAlive] if any of the storage is not alive.
+// - [applicationerrors.StorageAlreadyAttached] if any of the storage is
+// attached to a unit.
+// - [applicationerrors.StorageNotApplicationStorage] if any of the storage
+// belongs to a different application.
+// - [applicationerrors.StorageNameNotSupported] if the application has no
+// storage directive for any of the storage.
+// - [applicationerrors.StoragePoolNotMatching] if any of the storage is not
+// from the pool of the application's storage directive.
+// - [applicationerrors.InvalidStorageCount] if more storage is given than the
+// application's storage directive allows for a unit.
+func (s *ProviderService) AddCAASUnitAttachingStorage(
+	ctx context.Context, appName string, storageIDs []corestorage.ID,
+) (coreunit.Name, int, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if !isValidApplicationName(appName) {
+		return "", -1, applicationerrors.ApplicationNameNotValid
+	}
+
+	appUUID, err := s.st.GetApplicationIDByName(ctx, appName)
+	if err != nil {
+		return "", -1, errors.Errorf("getting application %q id: %w", appName, err)
+	}
+	charmID, err := s.st.GetCharmIDByApplicationName(ctx, appName)
+	if err != nil {
+		return "", -1, errors.Errorf("getting application %q charm: %w", appName, err)
+	}
+	charmName, err := s.st.GetCharmMetadataName(ctx, charmID)
+	if err != nil {
+		return "", -1, errors.Errorf("getting application %q charm name: %w", appName, err)
+	}
+
+	storageDirectives, err := s.st.GetApplicationStorageDirectives(ctx, appUUID)
+	if err != nil {
+		return "", -1, errors.Errorf(
+			"getting application %q storage directives: %w", appName, err,
+		)
+	}
+
+	instances, err := s.st.GetAttachableStorageInstances(ctx, storageIDs)
+	if err != nil {
+		return "", -1, errors.Errorf("getting storage to attach: %w", err)
+	}
+	existingStorage, err := validateStorageToAttach(
+		appUUID, appName, charmName, storageDirectives, instances,
+	)
+	if err != nil {
+		return "", -1, errors.Capture(err)
+	}
+
+	cons, err := s.makeApplicationConstraints(ctx, appUUID)
+	if err != nil {
+		return "", -1, errors.Errorf("making application %q constraints: %w", appName, err)
+	}
+
+	netNodeUUID, err := domainnetwork.NewNetNodeUUID()
+	if err != nil {
+		return "", -1, errors.Errorf(
+			"making new net node uuid for caas unit: %w", err,
+		)
+	}
+
+	unitStorageArgs, err := makeUnitStorageArgs(
+		ctx, s.storagePoolProvider, storageDirectives, existingStorage,
+	)
+	if err != nil {
+		return "", -1, errors.Errorf("making storage for CAAS unit: %w", err)
+	}
+
+	arg := application.AddCAASUnitArg{
+		AddUnitArg: application.AddUnitArg{
+			CreateUnitStorageArg: unitStorageArgs,
+			Constraints:          constraints.DecodeConstraints(cons),
+			NetNodeUUID:          netNodeUUID,
+			UnitStatusArg:        s.makeCAASUnitStatusArgs(),
+		},
+	}
+
+	unitName, newScale, err := s.st.AddCAASUnitWithScale(ctx, appUUID, arg)
+	if err != nil {
+		return "", -1, errors.Errorf("adding CAAS unit to application %q: %w", appName, err)
+	}
+
+	if err := s.recordUnitStatusHistory(ctx, unitName, arg.UnitStatusArg); err != nil {
+		return "", -1, errors.Errorf("recording status history: %w", err)
+	}
+
+	return unitName, newScale, nil
+}
+
+// validateStorageToAttach checks that the existing storage instances can be
+// attached to a new unit of the application, returning the instances grouped
+// by storage name.
+func validateStorageToAttach(
+	appUUID coreapplication.ID,
+	appName string,
+	charmName string,
+	storageDirectives []application.StorageDirective,
+	instances []application.AttachableStorageInstance,
+) (map[domainstorage.Name][]domainstorage.StorageInstanceUUID, error) {
+	directives := make(map[domainstorage.Name]application.StorageDirective, len(storageDirectives))
+	for _, sd := range storageDirectives {
+		directives[sd.Name] = sd
+	}
+
+	existingStorage := make(map[domainstorage.Name][]domainstorage.StorageInstanceUUID)
+	for _, inst := range instances {
+		if inst.Life != life.Alive {
+			return nil, errors.Errorf(
+				"storage %q is not alive", inst.StorageID,
+			).Add(applicationerrors.StorageNotAlive)
+		}
+		if inst.Attached {
+			return nil, errors.Errorf(
+				"storage %q is attached to a unit", inst.StorageID,
+			).Add(applicationerrors.StorageAlreadyAttached)
+		}
+		if (inst.OwnerApplicationUUID != "" && inst.OwnerApplicationUUID != appUUID) ||
+			(inst.CharmName != "" && inst.CharmName != charmName) {
+			return nil, errors.Errorf(
+				"storage %q does not belong to application %q", inst.StorageID, appName,
+			).Add(applicationerrors.StorageNotApplicationStorage)
+		}
+
+		sd, ok := directives[inst.StorageName]
+		if !ok {
+			return nil, errors.Errorf(
+				"application %q has no storage %q for storage %q",
+				appName, inst.StorageName, inst.StorageID,
+			).Add(applicationerrors.StorageNameNotSupported)
+		}
+		if inst.PoolUUID != sd.PoolUUID {
+			return nil, errors.Errorf(
+				"storage %q is not from the pool of application %q storage %q",
+				inst.StorageID, appName, inst.StorageName,
+			).Add(applicationerrors.StoragePoolNotMatching)
+		}
+
+		existingStorage[inst.StorageName] = append(
+			existingStorage[inst.StorageName], inst.UUID,
+		)
+		if uint32(len(existingStorage[inst.StorageName])) > sd.Count {
+			return nil, errors.Errorf(
+				"application %q storage %q supports %d instances per unit",
+				appName, inst.StorageName, sd.Count,
+			).Add(applicationerrors.InvalidStorageCount)
+		}
+	}
+	return existingStorage, nil
+}
+
 // CAASUnitTerminating should be called by the CAASUnitTerminationWorker when
 // the agent receives a signal to exit. UnitTerminating will return how the
 // agent should shutdown.
@@ -782,6 +951,12 @@ func (s *ProviderService) makeIAASApplicationArg(ctx context.Context,
 		charm.Meta().Storage,
 		arg.StorageDirectives,
 	)
+	arg.SharedStorage, err = makeApplicationSharedStorageArgs(
+		ctx, s.storagePoolProvider, storageDirectives,
+	)
+	if err != nil {
+		return "", application.AddIAASApplicationArg{}, nil, errors.Errorf("making IAAS application shared storage args: %w", err)
+	}
 	unitArgs, err := s.makeIAASUnitArgs(
 		ctx, units, storageDirectives, arg.Platform, constraints.DecodeConstraints(cons),
 	)
@@ -838,6 +1013,12 @@ func (s *ProviderService) makeCAASApplicationArg(
 		charm.Meta().Storage,
 		arg.StorageDirectives,
 	)
+	arg.SharedStorage, err = makeApplicationSharedStorageArgs(
+		ctx, s.storagePoolProvider, storageDirectives,
+	)
+	if err != nil {
+		return "", application.AddCAASApplicationArg{}, nil, errors.Errorf("making CAAS application shared storage args: %w", err)
+	}
 	unitArgs, err := s.makeCAASUnitArgs(
 		ctx, units, storageDirectives, constraints.DecodeConstraints(cons),
 	)
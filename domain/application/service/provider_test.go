@@ -16,6 +16,7 @@ import (
 	"github.com/juju/juju/core/assumes"
 	corebase "github.com/juju/juju/core/base"
 	corecharm "github.com/juju/juju/core/charm"
+	charmtesting "github.com/juju/juju/core/charm/testing"
 	coreconstraints "github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/core/devices"
 	coreerrors "github.com/juju/juju/core/errors"
@@ -28,6 +29,7 @@ import (
 	resourcetesting "github.com/juju/juju/core/resource/testing"
 	"github.com/juju/juju/core/semversion"
 	corestatus "github.com/juju/juju/core/status"
+	corestorage "github.com/juju/juju/core/storage"
 	coreunit "github.com/juju/juju/core/unit"
 	unittesting "github.com/juju/juju/core/unit/testing"
 	"github.com/juju/juju/domain/application"
@@ -36,9 +38,12 @@ import (
 	applicationerrors "github.com/juju/juju/domain/application/errors"
 	"github.com/juju/juju/domain/constraints"
 	"github.com/juju/juju/domain/deployment"
+	"github.com/juju/juju/domain/life"
 	modelerrors "github.com/juju/juju/domain/model/errors"
 	domainnetwork "github.com/juju/juju/domain/network"
 	"github.com/juju/juju/domain/status"
+	domainstorage "github.com/juju/juju/domain/storage"
+	storagetesting "github.com/juju/juju/domain/storage/testing"
 	"github.com/juju/juju/environs"
 	"github.com/juju/juju/internal/charm"
 	charmresource "github.com/juju/juju/internal/charm/resource"
@@ -2910,3 +2915,237 @@ func (s *providerServiceSuite) expectFullConstraints(c *tc.C, unitUUID coreunit.
 	s.state.EXPECT().GetApplicationConstraints(gomock.Any(), appUUID).Return(appConstraints, nil)
 	s.state.EXPECT().GetModelConstraints(gomock.Any()).Return(modelConstraints, nil)
 }
+
+func (s *providerServiceSuite) TestUpdateApplicationStorageDirectives(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appUUID := applicationtesting.GenApplicationUUID(c)
+	charmUUID := charmtesting.GenCharmID(c)
+	poolUUID := storagetesting.GenStoragePoolUUID(c)
+	newPoolUUID := storagetesting.GenStoragePoolUUID(c)
+
+	s.state.EXPECT().GetApplicationIDByName(gomock.Any(), "postgresql").Return(appUUID, nil)
+	s.state.EXPECT().GetCharmIDByApplicationName(gomock.Any(), "postgresql").Return(charmUUID, nil)
+	s.state.EXPECT().GetCharmMetadataStorage(gomock.Any(), charmUUID).Return(map[string]applicationcharm.Storage{
+		"pgdata": {
+			Name:     "pgdata",
+			Type:     applicationcharm.StorageFilesystem,
+			CountMin: 1,
+			CountMax: 3,
+		},
+		"logs": {
+			Name:     "logs",
+			Type:     applicationcharm.StorageFilesystem,
+			CountMin: 1,
+			CountMax: 1,
+		},
+	}, nil)
+	s.storageValidator.EXPECT().CheckPoolSupportsCharmStorage(
+		gomock.Any(), newPoolUUID, charm.StorageFilesystem,
+	).Return(true, nil)
+	s.state.EXPECT().GetApplicationStorageDirectives(gomock.Any(), appUUID).Return([]application.StorageDirective{{
+		Name:     "pgdata",
+		Type:     applicationcharm.StorageFilesystem,
+		Count:    1,
+		PoolUUID: poolUUID,
+		Size:     1024,
+	}, {
+		Name:     "logs",
+		Type:     applicationcharm.StorageFilesystem,
+		Count:    1,
+		PoolUUID: poolUUID,
+		Size:     512,
+	}}, nil)
+	s.state.EXPECT().UpdateApplicationStorageDirectives(gomock.Any(), appUUID, []application.UpdateApplicationStorageDirectiveArg{{
+		Name:     "pgdata",
+		Count:    2,
+		PoolUUID: newPoolUUID,
+		Size:     1024,
+	}}).Return(nil)
+
+	err := s.service.UpdateApplicationStorageDirectives(c.Context(), "postgresql", map[string]ApplicationStorageDirectiveOverride{
+		"pgdata": {
+			Count:    ptr(uint32(2)),
+			PoolUUID: &newPoolUUID,
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *providerServiceSuite) TestUpdateApplicationStorageDirectivesUnknownStorage(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appUUID := applicationtesting.GenApplicationUUID(c)
+	charmUUID := charmtesting.GenCharmID(c)
+
+	s.state.EXPECT().GetApplicationIDByName(gomock.Any(), "postgresql").Return(appUUID, nil)
+	s.state.EXPECT().GetCharmIDByApplicationName(gomock.Any(), "postgresql").Return(charmUUID, nil)
+	s.state.EXPECT().GetCharmMetadataStorage(gomock.Any(), charmUUID).Return(map[string]applicationcharm.Storage{
+		"pgdata": {
+			Name: "pgdata",
+			Type: applicationcharm.StorageFilesystem,
+		},
+	}, nil)
+
+	err := s.service.UpdateApplicationStorageDirectives(c.Context(), "postgresql", map[string]ApplicationStorageDirectiveOverride{
+		"cache": {
+			Count: ptr(uint32(2)),
+		},
+	})
+	c.Assert(err, tc.ErrorIs, applicationerrors.StorageNameNotSupported)
+}
+
+func (s *providerServiceSuite) TestUpdateApplicationStorageDirectivesCountTooLarge(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appUUID := applicationtesting.GenApplicationUUID(c)
+	charmUUID := charmtesting.GenCharmID(c)
+
+	s.state.EXPECT().GetApplicationIDByName(gomock.Any(), "postgresql").Return(appUUID, nil)
+	s.state.EXPECT().GetCharmIDByApplicationName(gomock.Any(), "postgresql").Return(charmUUID, nil)
+	s.state.EXPECT().GetCharmMetadataStorage(gomock.Any(), charmUUID).Return(map[string]applicationcharm.Storage{
+		"pgdata": {
+			Name:     "pgdata",
+			Type:     applicationcharm.StorageFilesystem,
+			CountMin: 1,
+			CountMax: 1,
+		},
+	}, nil)
+
+	err := s.service.UpdateApplicationStorageDirectives(c.Context(), "postgresql", map[string]ApplicationStorageDirectiveOverride{
+		"pgdata": {
+			Count: ptr(uint32(2)),
+		},
+	})
+	c.Assert(err, tc.ErrorMatches, `invalid storage directive overrides: storage directive count 2 is greater than the charm maximum of 1`)
+}
+
+func (s *providerServiceSuite) TestUpdateApplicationStorageDirectivesInvalidName(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service.UpdateApplicationStorageDirectives(c.Context(), "!!!", map[string]ApplicationStorageDirectiveOverride{})
+	c.Assert(err, tc.ErrorIs, applicationerrors.ApplicationNameNotValid)
+}
+
+func (s *providerServiceSuite) TestAddCAASUnitAttachingStorage(c *tc.C) {
+	ctrl := s.setupMocksWithProvider(c, noProviderError, noProviderError)
+	defer ctrl.Finish()
+
+	appUUID := applicationtesting.GenApplicationUUID(c)
+	charmUUID := charmtesting.GenCharmID(c)
+	poolUUID := storagetesting.GenStoragePoolUUID(c)
+	storageUUID := storagetesting.GenStorageInstanceUUID(c)
+
+	s.state.EXPECT().GetApplicationIDByName(gomock.Any(), "postgresql").Return(appUUID, nil)
+	s.state.EXPECT().GetCharmIDByApplicationName(gomock.Any(), "postgresql").Return(charmUUID, nil)
+	s.state.EXPECT().GetCharmMetadataName(gomock.Any(), charmUUID).Return("postgresql-k8s", nil)
+	s.state.EXPECT().GetApplicationStorageDirectives(gomock.Any(), appUUID).Return([]application.StorageDirective{{
+		Name:     "pgdata",
+		Type:     applicationcharm.StorageFilesystem,
+		Count:    1,
+		PoolUUID: poolUUID,
+		Size:     1024,
+	}}, nil)
+	s.state.EXPECT().GetAttachableStorageInstances(gomock.Any(), []corestorage.ID{"pgdata/0"}).Return([]application.AttachableStorageInstance{{
+		UUID:                 storageUUID,
+		StorageID:            "pgdata/0",
+		StorageName:          "pgdata",
+		PoolUUID:             poolUUID,
+		CharmName:            "postgresql-k8s",
+		Life:                 life.Alive,
+		OwnerApplicationUUID: appUUID,
+	}}, nil)
+	s.expectEmptyUnitConstraints(c, appUUID)
+
+	var received application.AddCAASUnitArg
+	s.state.EXPECT().AddCAASUnitWithScale(gomock.Any(), appUUID, gomock.Any()).DoAndReturn(func(_ context.Context, _ coreapplication.ID, arg application.AddCAASUnitArg) (coreunit.Name, int, error) {
+		received = arg
+		return "postgresql/2", 3, nil
+	})
+
+	unitName, scale, err := s.service.AddCAASUnitAttachingStorage(c.Context(), "postgresql", []corestorage.ID{"pgdata/0"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(unitName, tc.Equals, coreunit.Name("postgresql/2"))
+	c.Check(scale, tc.Equals, 3)
+
+	// The existing storage is owned and attached, no new storage is created.
+	c.Check(received.StorageInstances, tc.HasLen, 0)
+	c.Check(received.StorageToOwn, tc.DeepEquals, []domainstorage.StorageInstanceUUID{storageUUID})
+	c.Assert(received.StorageToAttach, tc.HasLen, 1)
+	c.Check(received.StorageToAttach[0].StorageInstanceUUID, tc.Equals, storageUUID)
+}
+
+func (s *providerServiceSuite) TestAddCAASUnitAttachingStorageValidation(c *tc.C) {
+	appUUID := applicationtesting.GenApplicationUUID(c)
+	poolUUID := storagetesting.GenStoragePoolUUID(c)
+
+	valid := application.AttachableStorageInstance{
+		UUID:        storagetesting.GenStorageInstanceUUID(c),
+		StorageID:   "pgdata/0",
+		StorageName: "pgdata",
+		PoolUUID:    poolUUID,
+		CharmName:   "postgresql-k8s",
+		Life:        life.Alive,
+	}
+
+	tests := []struct {
+		summary  string
+		mutate   func(*application.AttachableStorageInstance)
+		expected error
+	}{{
+		summary:  "storage dying",
+		mutate:   func(i *application.AttachableStorageInstance) { i.Life = life.Dying },
+		expected: applicationerrors.StorageNotAlive,
+	}, {
+		summary:  "storage attached",
+		mutate:   func(i *application.AttachableStorageInstance) { i.Attached = true },
+		expected: applicationerrors.StorageAlreadyAttached,
+	}, {
+		summary: "storage owned by another application",
+		mutate: func(i *application.AttachableStorageInstance) {
+			i.OwnerApplicationUUID = applicationtesting.GenApplicationUUID(c)
+		},
+		expected: applicationerrors.StorageNotApplicationStorage,
+	}, {
+		summary:  "storage of another charm",
+		mutate:   func(i *application.AttachableStorageInstance) { i.CharmName = "mysql-k8s" },
+		expected: applicationerrors.StorageNotApplicationStorage,
+	}, {
+		summary:  "storage name not in directives",
+		mutate:   func(i *application.AttachableStorageInstance) { i.StorageName = "logs" },
+		expected: applicationerrors.StorageNameNotSupported,
+	}, {
+		summary: "storage from another pool",
+		mutate: func(i *application.AttachableStorageInstance) {
+			i.PoolUUID = storagetesting.GenStoragePoolUUID(c)
+		},
+		expected: applicationerrors.StoragePoolNotMatching,
+	}}
+
+	directives := []application.StorageDirective{{
+		Name:     "pgdata",
+		Type:     applicationcharm.StorageFilesystem,
+		Count:    1,
+		PoolUUID: poolUUID,
+	}}
+	for _, test := range tests {
+		c.Logf("test: %s", test.summary)
+
+		inst := valid
+		test.mutate(&inst)
+		_, err := validateStorageToAttach(
+			appUUID, "postgresql", "postgresql-k8s", directives,
+			[]application.AttachableStorageInstance{inst},
+		)
+		c.Check(err, tc.ErrorIs, test.expected)
+	}
+
+	other := valid
+	other.UUID = storagetesting.GenStorageInstanceUUID(c)
+	other.StorageID = "pgdata/1"
+	_, err := validateStorageToAttach(
+		appUUID, "postgresql", "postgresql-k8s", directives,
+		[]application.AttachableStorageInstance{valid, other},
+	)
+	c.Check(err, tc.ErrorIs, applicationerrors.InvalidStorageCount)
+}
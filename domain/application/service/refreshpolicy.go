@@ -0,0 +1,280 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"fmt"
+
+	corestatus "github.com/juju/juju/core/status"
+	"github.com/juju/juju/core/trace"
+	"github.com/juju/juju/domain/application"
+	"github.com/juju/juju/domain/application/charm"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/domain/status"
+	internalcharm "github.com/juju/juju/internal/charm"
+	"github.com/juju/juju/internal/errors"
+)
+
+// SetApplicationRefreshPolicy sets the charm refresh policy of the named
+// application.
+//
+// The following errors may be returned:
+// - [applicationerrors.ApplicationNameNotValid] if the name is not valid.
+// - [applicationerrors.ApplicationNotFound] if the application doesn't exist.
+// - [applicationerrors.ApplicationIsDead] if the application is dead.
+// - [applicationerrors.RefreshPolicyNotValid] if the policy is not known.
+// - [applicationerrors.MaintenanceWindowNotValid] if the window is not valid.
+func (s *Service) SetApplicationRefreshPolicy(ctx context.Context, appName string, policy application.ApplicationRefreshPolicy) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if !isValidApplicationName(appName) {
+		return applicationerrors.ApplicationNameNotValid
+	}
+	if err := policy.Validate(); err != nil {
+		return errors.Capture(err)
+	}
+	if policy.Policy != application.RefreshPolicyAuto && policy.Window != nil {
+		return errors.Errorf("a maintenance window requires the %q policy: %w",
+			application.RefreshPolicyAuto, applicationerrors.MaintenanceWindowNotValid)
+	}
+
+	appID, err := s.st.GetApplicationIDByName(ctx, appName)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if err := s.st.SetApplicationRefreshPolicy(ctx, appID, policy); err != nil {
+		return errors.Errorf("setting refresh policy of %q: %w", appName, err)
+	}
+	return nil
+}
+
+// GetApplicationRefreshPolicy returns the charm refresh policy of the named
+// application.
+//
+// The following errors may be returned:
+// - [applicationerrors.ApplicationNameNotValid] if the name is not valid.
+// - [applicationerrors.ApplicationNotFound] if the application doesn't exist.
+func (s *Service) GetApplicationRefreshPolicy(ctx context.Context, appName string) (application.ApplicationRefreshPolicy, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if !isValidApplicationName(appName) {
+		return application.ApplicationRefreshPolicy{}, applicationerrors.ApplicationNameNotValid
+	}
+
+	appID, err := s.st.GetApplicationIDByName(ctx, appName)
+	if err != nil {
+		return application.ApplicationRefreshPolicy{}, errors.Capture(err)
+	}
+	policy, err := s.st.GetApplicationRefreshPolicy(ctx, appID)
+	if err != nil {
+		return application.ApplicationRefreshPolicy{}, errors.Errorf("getting refresh policy of %q: %w", appName, err)
+	}
+	return policy, nil
+}
+
+// GetApplicationRefreshCandidates returns the alive applications with a
+// refresh policy other than none, for which the revision updater has found a
+// newer revision of their charm in their channel.
+func (s *Service) GetApplicationRefreshCandidates(ctx context.Context) ([]application.RefreshCandidate, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	candidates, err := s.st.GetApplicationRefreshCandidates(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return candidates, nil
+}
+
+// NotifyCharmRevisionAvailable records in the status history of the
+// candidate's application that a newer revision of its charm is available.
+// The application is then no longer a candidate to be notified of that
+// revision, see [application.RefreshCandidate.Notified].
+//
+// The following errors may be returned:
+// - [applicationerrors.ApplicationNotFound] if the application doesn't exist.
+func (s *Service) NotifyCharmRevisionAvailable(ctx context.Context, candidate application.RefreshCandidate) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	appID, err := s.st.GetApplicationIDByName(ctx, candidate.ApplicationName)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if err := s.recordRefreshStatus(ctx, candidate, corestatus.Maintenance,
+		fmt.Sprintf("charm revision %d is available", candidate.Latest.Revision)); err != nil {
+		return errors.Capture(err)
+	}
+	if err := s.st.SetApplicationRefreshNotified(ctx, appID, candidate.Latest.Revision); err != nil {
+		return errors.Errorf("recording notification of %q: %w", candidate.ApplicationName, err)
+	}
+	return nil
+}
+
+// RefreshApplicationCharm refreshes the candidate's application to the newest
+// revision of its charm, and records the outcome in the application's status
+// history. If the refresh fails, the revision is recorded as such, see
+// [application.RefreshCandidate.RefreshFailed].
+//
+// The revision must have been downloaded first, see
+// [Service.ResolveCharmRevisionDownload], so that the application is only
+// switched to a charm which is available to its units. The revision must
+// also be compatible with the application's current charm: it can't change
+// whether the charm is a subordinate, the type of an existing config option,
+// or remove or change an existing store.
+//
+// The following errors may be returned:
+// - [applicationerrors.ApplicationNotFound] if the application doesn't exist.
+// - [applicationerrors.CharmNotFound] if the charm revision doesn't exist.
+// - [applicationerrors.CharmNotResolved] if the charm revision hasn't been
+// downloaded yet.
+// - [applicationerrors.CharmRefreshNotCompatible] if the charm revision is
+// not compatible with the current charm.
+func (s *Service) RefreshApplicationCharm(ctx context.Context, candidate application.RefreshCandidate) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	// Not being downloaded yet isn't a failure of the refresh, the caller
+	// is expected to download the revision and try again.
+	available, err := s.IsCharmAvailable(ctx, candidate.Latest)
+	if err != nil {
+		return errors.Capture(err)
+	} else if !available {
+		return errors.Errorf("charm revision %d: %w", candidate.Latest.Revision, applicationerrors.CharmNotResolved)
+	}
+
+	appName := candidate.ApplicationName
+	err = s.checkCharmRefreshCompatible(ctx, candidate.Current, candidate.Latest)
+	if err == nil {
+		err = s.SetApplicationCharm(ctx, appName, candidate.Latest, application.SetCharmParams{})
+	}
+	if err != nil {
+		_ = s.recordRefreshStatus(ctx, candidate, corestatus.Error,
+			fmt.Sprintf("automatic refresh to charm revision %d failed: %v", candidate.Latest.Revision, err))
+		s.recordRefreshFailed(ctx, candidate)
+		return errors.Capture(err)
+	}
+
+	s.logger.Infof(ctx, "refreshed application %q from charm revision %d to %d",
+		appName, candidate.Current.Revision, candidate.Latest.Revision)
+	_ = s.recordRefreshStatus(ctx, candidate, corestatus.Maintenance,
+		fmt.Sprintf("automatically refreshed to charm revision %d", candidate.Latest.Revision))
+	return nil
+}
+
+// checkCharmRefreshCompatible checks that an application using the current
+// charm can be refreshed to the latest one without operator input. Endpoint
+// bindings are merged, and established relations checked, when the charm is
+// set on the application.
+func (s *Service) checkCharmRefreshCompatible(ctx context.Context, current, latest charm.CharmLocator) error {
+	currentMeta, err := s.GetCharmMetadata(ctx, current)
+	if err != nil {
+		return errors.Errorf("getting current charm metadata: %w", err)
+	}
+	latestMeta, err := s.GetCharmMetadata(ctx, latest)
+	if err != nil {
+		return errors.Errorf("getting latest charm metadata: %w", err)
+	}
+	if currentMeta.Subordinate != latestMeta.Subordinate {
+		return errors.Errorf("subordinate changed to %t: %w",
+			latestMeta.Subordinate, applicationerrors.CharmRefreshNotCompatible)
+	}
+	if err := checkStorageRefreshCompatible(currentMeta.Storage, latestMeta.Storage); err != nil {
+		return errors.Capture(err)
+	}
+
+	currentConfig, err := s.GetCharmConfig(ctx, current)
+	if err != nil {
+		return errors.Errorf("getting current charm config: %w", err)
+	}
+	latestConfig, err := s.GetCharmConfig(ctx, latest)
+	if err != nil {
+		return errors.Errorf("getting latest charm config: %w", err)
+	}
+	for name, option := range currentConfig.Options {
+		latestOption, ok := latestConfig.Options[name]
+		if ok && latestOption.Type != option.Type {
+			return errors.Errorf("config option %q type changed from %q to %q: %w",
+				name, option.Type, latestOption.Type, applicationerrors.CharmRefreshNotCompatible)
+		}
+	}
+	return nil
+}
+
+// checkStorageRefreshCompatible checks that every existing store is kept by
+// the latest charm, and that the storage already provisioned for it remains
+// valid.
+func checkStorageRefreshCompatible(current, latest map[string]internalcharm.Storage) error {
+	for name, store := range current {
+		latestStore, ok := latest[name]
+		switch {
+		case !ok:
+			return errors.Errorf("storage %q removed: %w", name, applicationerrors.CharmRefreshNotCompatible)
+		case latestStore.Type != store.Type:
+			return errors.Errorf("storage %q type changed from %q to %q: %w",
+				name, store.Type, latestStore.Type, applicationerrors.CharmRefreshNotCompatible)
+		case latestStore.Shared != store.Shared:
+			return errors.Errorf("storage %q shared changed to %t: %w",
+				name, latestStore.Shared, applicationerrors.CharmRefreshNotCompatible)
+		case latestStore.ReadOnly != store.ReadOnly:
+			return errors.Errorf("storage %q read-only changed to %t: %w",
+				name, latestStore.ReadOnly, applicationerrors.CharmRefreshNotCompatible)
+		case latestStore.Location != store.Location:
+			return errors.Errorf("storage %q location changed from %q to %q: %w",
+				name, store.Location, latestStore.Location, applicationerrors.CharmRefreshNotCompatible)
+		case latestStore.CountMin > store.CountMin:
+			return errors.Errorf("storage %q minimum count increased from %d to %d: %w",
+				name, store.CountMin, latestStore.CountMin, applicationerrors.CharmRefreshNotCompatible)
+		case store.CountMax < 0 && latestStore.CountMax >= 0,
+			store.CountMax >= 0 && latestStore.CountMax >= 0 && latestStore.CountMax < store.CountMax:
+			return errors.Errorf("storage %q maximum count decreased: %w",
+				name, applicationerrors.CharmRefreshNotCompatible)
+		}
+	}
+	return nil
+}
+
+// recordRefreshFailed records that refreshing the candidate's application to
+// the latest revision failed. Failures to record are only logged, as the
+// refresh error is the one of interest to the caller.
+func (s *Service) recordRefreshFailed(ctx context.Context, candidate application.RefreshCandidate) {
+	appID, err := s.st.GetApplicationIDByName(ctx, candidate.ApplicationName)
+	if err == nil {
+		err = s.st.SetApplicationRefreshFailed(ctx, appID, candidate.Latest.Revision)
+	}
+	if err != nil {
+		s.logger.Warningf(ctx, "recording failed charm refresh of %q: %v", candidate.ApplicationName, err)
+	}
+}
+
+// recordRefreshStatus records a charm refresh event in the status history
+// of the candidate's application. Failures to record are logged as well as
+// returned, so callers may choose to ignore them.
+func (s *Service) recordRefreshStatus(
+	ctx context.Context,
+	candidate application.RefreshCandidate,
+	value corestatus.Status,
+	message string,
+) error {
+	now := s.clock.Now()
+	err := s.statusHistory.RecordStatus(ctx, status.ApplicationNamespace.WithID(candidate.ApplicationName), corestatus.StatusInfo{
+		Status:  value,
+		Message: message,
+		Data: map[string]any{
+			"charm":            candidate.Latest.Name,
+			"current-revision": candidate.Current.Revision,
+			"latest-revision":  candidate.Latest.Revision,
+			"refresh-policy":   string(candidate.RefreshPolicy.Policy),
+		},
+		Since: &now,
+	})
+	if err != nil {
+		s.logger.Warningf(ctx, "recording charm refresh status history for %q: %v", candidate.ApplicationName, err)
+		return errors.Capture(err)
+	}
+	return nil
+}
@@ -0,0 +1,277 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	applicationtesting "github.com/juju/juju/core/application/testing"
+	corecharm "github.com/juju/juju/core/charm"
+	charmtesting "github.com/juju/juju/core/charm/testing"
+	corestatus "github.com/juju/juju/core/status"
+	"github.com/juju/juju/domain/application"
+	"github.com/juju/juju/domain/application/charm"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/domain/status"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/statushistory"
+)
+
+type refreshPolicySuite struct {
+	baseSuite
+
+	statusHistory *MockStatusHistory
+}
+
+func TestRefreshPolicySuite(t *testing.T) {
+	tc.Run(t, &refreshPolicySuite{})
+}
+
+func (s *refreshPolicySuite) setupMocks(c *tc.C) *gomock.Controller {
+	return s.setupMocksWithStatusHistory(c, func(ctrl *gomock.Controller) StatusHistory {
+		s.statusHistory = NewMockStatusHistory(ctrl)
+		return s.statusHistory
+	})
+}
+
+func (s *refreshPolicySuite) TestSetApplicationRefreshPolicy(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appID := applicationtesting.GenApplicationUUID(c)
+	policy := application.ApplicationRefreshPolicy{
+		Policy: application.RefreshPolicyAuto,
+		Window: &application.MaintenanceWindow{Start: time.Hour, End: 2 * time.Hour},
+	}
+	s.state.EXPECT().GetApplicationIDByName(gomock.Any(), "foo").Return(appID, nil)
+	s.state.EXPECT().SetApplicationRefreshPolicy(gomock.Any(), appID, policy).Return(nil)
+
+	err := s.service.SetApplicationRefreshPolicy(c.Context(), "foo", policy)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *refreshPolicySuite) TestSetApplicationRefreshPolicyNotValid(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service.SetApplicationRefreshPolicy(c.Context(), "foo", application.ApplicationRefreshPolicy{
+		Policy: "sometimes",
+	})
+	c.Assert(err, tc.ErrorIs, applicationerrors.RefreshPolicyNotValid)
+}
+
+func (s *refreshPolicySuite) TestSetApplicationRefreshPolicyWindowWithoutAuto(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service.SetApplicationRefreshPolicy(c.Context(), "foo", application.ApplicationRefreshPolicy{
+		Policy: application.RefreshPolicyNotify,
+		Window: &application.MaintenanceWindow{Start: time.Hour, End: 2 * time.Hour},
+	})
+	c.Assert(err, tc.ErrorIs, applicationerrors.MaintenanceWindowNotValid)
+}
+
+func (s *refreshPolicySuite) TestSetApplicationRefreshPolicyInvalidName(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service.SetApplicationRefreshPolicy(c.Context(), "!!!", application.ApplicationRefreshPolicy{
+		Policy: application.RefreshPolicyNone,
+	})
+	c.Assert(err, tc.ErrorIs, applicationerrors.ApplicationNameNotValid)
+}
+
+func (s *refreshPolicySuite) TestGetApplicationRefreshPolicy(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appID := applicationtesting.GenApplicationUUID(c)
+	policy := application.ApplicationRefreshPolicy{Policy: application.RefreshPolicyNotify}
+	s.state.EXPECT().GetApplicationIDByName(gomock.Any(), "foo").Return(appID, nil)
+	s.state.EXPECT().GetApplicationRefreshPolicy(gomock.Any(), appID).Return(policy, nil)
+
+	got, err := s.service.GetApplicationRefreshPolicy(c.Context(), "foo")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(got, tc.DeepEquals, policy)
+}
+
+func (s *refreshPolicySuite) TestGetApplicationRefreshPolicyNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetApplicationIDByName(gomock.Any(), "foo").Return("", applicationerrors.ApplicationNotFound)
+
+	_, err := s.service.GetApplicationRefreshPolicy(c.Context(), "foo")
+	c.Assert(err, tc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
+
+func (s *refreshPolicySuite) TestNotifyCharmRevisionAvailable(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	candidate := s.candidate(application.RefreshPolicyNotify)
+	appID := applicationtesting.GenApplicationUUID(c)
+	s.state.EXPECT().GetApplicationIDByName(gomock.Any(), "foo").Return(appID, nil)
+	s.state.EXPECT().SetApplicationRefreshNotified(gomock.Any(), appID, 43).Return(nil)
+	s.statusHistory.EXPECT().RecordStatus(gomock.Any(), status.ApplicationNamespace.WithID("foo"), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ statushistory.Namespace, info corestatus.StatusInfo) error {
+			c.Check(info.Status, tc.Equals, corestatus.Maintenance)
+			c.Check(info.Message, tc.Equals, "charm revision 43 is available")
+			c.Check(info.Data["refresh-policy"], tc.Equals, "notify")
+			return nil
+		})
+
+	err := s.service.NotifyCharmRevisionAvailable(c.Context(), candidate)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *refreshPolicySuite) TestRefreshApplicationCharm(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	candidate := s.candidate(application.RefreshPolicyAuto)
+	appID := applicationtesting.GenApplicationUUID(c)
+	latestID := s.expectCompatibleCharms(c, charm.Metadata{}, charm.Metadata{})
+	s.state.EXPECT().GetApplicationIDByName(gomock.Any(), "foo").Return(appID, nil)
+	s.state.EXPECT().SetApplicationCharm(gomock.Any(), appID, latestID, application.SetCharmParams{}).Return(nil)
+	s.statusHistory.EXPECT().RecordStatus(gomock.Any(), status.ApplicationNamespace.WithID("foo"), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ statushistory.Namespace, info corestatus.StatusInfo) error {
+			c.Check(info.Status, tc.Equals, corestatus.Maintenance)
+			c.Check(info.Message, tc.Equals, "automatically refreshed to charm revision 43")
+			return nil
+		})
+
+	err := s.service.RefreshApplicationCharm(c.Context(), candidate)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+// TestRefreshApplicationCharmNotAvailable verifies that the application isn't
+// switched to a revision which hasn't been downloaded, and that it isn't
+// recorded as a failure.
+func (s *refreshPolicySuite) TestRefreshApplicationCharmNotAvailable(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	candidate := s.candidate(application.RefreshPolicyAuto)
+	latestID := charmtesting.GenCharmID(c)
+	s.state.EXPECT().GetCharmID(gomock.Any(), "foo", 43, charm.CharmHubSource).Return(latestID, nil)
+	s.state.EXPECT().IsCharmAvailable(gomock.Any(), latestID).Return(false, nil)
+
+	err := s.service.RefreshApplicationCharm(c.Context(), candidate)
+	c.Assert(err, tc.ErrorIs, applicationerrors.CharmNotResolved)
+}
+
+// TestRefreshApplicationCharmNotCompatible verifies that the application isn't
+// switched to a revision which removes an existing store.
+func (s *refreshPolicySuite) TestRefreshApplicationCharmNotCompatible(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	candidate := s.candidate(application.RefreshPolicyAuto)
+	s.expectCompatibleCharms(c, charm.Metadata{
+		Storage: map[string]charm.Storage{
+			"data": {Name: "data", Type: charm.StorageFilesystem, CountMin: 1, CountMax: 1},
+		},
+	}, charm.Metadata{})
+	appID := applicationtesting.GenApplicationUUID(c)
+	s.state.EXPECT().GetApplicationIDByName(gomock.Any(), "foo").Return(appID, nil)
+	s.state.EXPECT().SetApplicationRefreshFailed(gomock.Any(), appID, 43).Return(nil)
+	s.statusHistory.EXPECT().RecordStatus(gomock.Any(), status.ApplicationNamespace.WithID("foo"), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ statushistory.Namespace, info corestatus.StatusInfo) error {
+			c.Check(info.Status, tc.Equals, corestatus.Error)
+			c.Check(info.Message, tc.Matches, `automatic refresh to charm revision 43 failed: storage "data" removed.*`)
+			return nil
+		})
+
+	err := s.service.RefreshApplicationCharm(c.Context(), candidate)
+	c.Assert(err, tc.ErrorIs, applicationerrors.CharmRefreshNotCompatible)
+}
+
+func (s *refreshPolicySuite) TestRefreshApplicationCharmError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	candidate := s.candidate(application.RefreshPolicyAuto)
+	appID := applicationtesting.GenApplicationUUID(c)
+	latestID := s.expectCompatibleCharms(c, charm.Metadata{}, charm.Metadata{})
+	s.state.EXPECT().GetApplicationIDByName(gomock.Any(), "foo").Return(appID, nil).Times(2)
+	s.state.EXPECT().SetApplicationCharm(gomock.Any(), appID, latestID, application.SetCharmParams{}).Return(errors.New("boom"))
+	s.state.EXPECT().SetApplicationRefreshFailed(gomock.Any(), appID, 43).Return(nil)
+	s.statusHistory.EXPECT().RecordStatus(gomock.Any(), status.ApplicationNamespace.WithID("foo"), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ statushistory.Namespace, info corestatus.StatusInfo) error {
+			c.Check(info.Status, tc.Equals, corestatus.Error)
+			c.Check(info.Message, tc.Matches, "automatic refresh to charm revision 43 failed: .*boom")
+			return nil
+		})
+
+	err := s.service.RefreshApplicationCharm(c.Context(), candidate)
+	c.Assert(err, tc.ErrorMatches, ".*boom")
+}
+
+func (s *refreshPolicySuite) TestGetCharmRevisionDownloadInfo(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	candidate := s.candidate(application.RefreshPolicyAuto)
+	latestID := charmtesting.GenCharmID(c)
+	s.state.EXPECT().GetCharmID(gomock.Any(), "foo", 43, charm.CharmHubSource).Return(latestID, nil)
+	s.state.EXPECT().IsCharmAvailable(gomock.Any(), latestID).Return(false, nil)
+	s.state.EXPECT().GetCharmDownloadInfo(gomock.Any(), latestID).Return(&charm.DownloadInfo{
+		Provenance:  charm.ProvenanceDownload,
+		DownloadURL: "https://example.com/foo",
+	}, nil)
+	s.state.EXPECT().GetCharmArchiveMetadata(gomock.Any(), latestID).Return("", "sha256", nil)
+
+	info, err := s.service.GetCharmRevisionDownloadInfo(c.Context(), candidate.Latest)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(info, tc.DeepEquals, application.CharmDownloadInfo{
+		CharmUUID: latestID,
+		Name:      "foo",
+		SHA256:    "sha256",
+		DownloadInfo: charm.DownloadInfo{
+			Provenance:  charm.ProvenanceDownload,
+			DownloadURL: "https://example.com/foo",
+		},
+	})
+}
+
+func (s *refreshPolicySuite) TestGetCharmRevisionDownloadInfoAlreadyAvailable(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	candidate := s.candidate(application.RefreshPolicyAuto)
+	latestID := charmtesting.GenCharmID(c)
+	s.state.EXPECT().GetCharmID(gomock.Any(), "foo", 43, charm.CharmHubSource).Return(latestID, nil)
+	s.state.EXPECT().IsCharmAvailable(gomock.Any(), latestID).Return(true, nil)
+
+	_, err := s.service.GetCharmRevisionDownloadInfo(c.Context(), candidate.Latest)
+	c.Assert(err, tc.ErrorIs, applicationerrors.CharmAlreadyAvailable)
+}
+
+// expectCompatibleCharms expects the latest charm to be available, and the
+// metadata and config of both charms to be read for the compatibility check.
+// It returns the ID of the latest charm.
+func (s *refreshPolicySuite) expectCompatibleCharms(c *tc.C, current, latest charm.Metadata) corecharm.ID {
+	current.RunAs = charm.RunAsDefault
+	latest.RunAs = charm.RunAsDefault
+	currentID := charmtesting.GenCharmID(c)
+	latestID := charmtesting.GenCharmID(c)
+	s.state.EXPECT().GetCharmID(gomock.Any(), "foo", 42, charm.CharmHubSource).Return(currentID, nil).AnyTimes()
+	s.state.EXPECT().GetCharmID(gomock.Any(), "foo", 43, charm.CharmHubSource).Return(latestID, nil).AnyTimes()
+	s.state.EXPECT().IsCharmAvailable(gomock.Any(), latestID).Return(true, nil)
+	s.state.EXPECT().GetCharmMetadata(gomock.Any(), currentID).Return(current, nil)
+	s.state.EXPECT().GetCharmMetadata(gomock.Any(), latestID).Return(latest, nil)
+	s.state.EXPECT().GetCharmConfig(gomock.Any(), currentID).Return(charm.Config{}, nil).AnyTimes()
+	s.state.EXPECT().GetCharmConfig(gomock.Any(), latestID).Return(charm.Config{}, nil).AnyTimes()
+	return latestID
+}
+
+func (s *refreshPolicySuite) candidate(policy application.RefreshPolicy) application.RefreshCandidate {
+	return application.RefreshCandidate{
+		ApplicationName: "foo",
+		RefreshPolicy:   application.ApplicationRefreshPolicy{Policy: policy},
+		Current: charm.CharmLocator{
+			Name:     "foo",
+			Revision: 42,
+			Source:   charm.CharmHubSource,
+		},
+		Latest: charm.CharmLocator{
+			Name:     "foo",
+			Revision: 43,
+			Source:   charm.CharmHubSource,
+		},
+	}
+}
@@ -155,6 +155,18 @@ type StorageState interface {
 		context.Context, coreapplication.ID,
 	) ([]application.StorageDirective, error)
 
+	// UpdateApplicationStorageDirectives updates the existing storage
+	// directives of an application with the supplied values.
+	//
+	// The following error types can be expected:
+	// - [github.com/juju/juju/domain/application/errors.ApplicationNotFound]
+	// when the application no longer exists.
+	// - [github.com/juju/juju/domain/application/errors.StorageNameNotSupported]
+	// when the application has no storage directive for one of the names.
+	UpdateApplicationStorageDirectives(
+		context.Context, coreapplication.ID, []application.UpdateApplicationStorageDirectiveArg,
+	) error
+
 	// GetDefaultStorageProvisioners returns the default storage provisioners
 	// that have been set for the model.
 	GetDefaultStorageProvisioners(
@@ -176,6 +188,27 @@ type StorageState interface {
 		ids []string,
 	) (map[string]domainstorage.StorageInstanceUUID, error)
 
+	// GetAttachableStorageInstances returns the details of the storage
+	// instances with the given ids, for them to be attached to a new unit.
+	//
+	// The following errors can be expected:
+	// - [github.com/juju/juju/domain/storage/errors.StorageNotFound] if one of
+	// the storage instances doesn't exist.
+	GetAttachableStorageInstances(
+		ctx context.Context, storageIDs []corestorage.ID,
+	) ([]application.AttachableStorageInstance, error)
+
+	// GetPendingCAASUnitFilesystems returns the provisioned filesystems owned
+	// by the alive units of the application that the provider has yet to
+	// start.
+	//
+	// The following errors can be expected:
+	// - [github.com/juju/juju/domain/application/errors.ApplicationNotFound]
+	// when the application no longer exists.
+	GetPendingCAASUnitFilesystems(
+		ctx context.Context, appUUID coreapplication.ID,
+	) ([]application.PendingCAASUnitFilesystem, error)
+
 	// GetStorageUUIDByID returns the UUID for the storage specified by id.
 	//
 	// The following errors can be expected:
@@ -222,6 +255,150 @@ func NewStoragePoolProvider(
 	}
 }
 
+// GetApplicationStorageDirectives returns the storage directives set for the
+// specified application. If the application has no storage directives an
+// empty result is returned.
+//
+// The following error types can be expected:
+// - [applicationerrors.ApplicationNotFound] when the application doesn't
+// exist.
+func (s *Service) GetApplicationStorageDirectives(
+	ctx context.Context, appID coreapplication.ID,
+) ([]application.StorageDirective, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := appID.Validate(); err != nil {
+		return nil, errors.Errorf("application ID: %w", err)
+	}
+
+	directives, err := s.st.GetApplicationStorageDirectives(ctx, appID)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return directives, nil
+}
+
+// GetPendingCAASUnitFilesystems returns the provisioned filesystems owned by
+// the alive units of the CAAS application that the provider has yet to start.
+// These are the filesystems that the provider must bind to the new units.
+//
+// The following error types can be expected:
+// - [applicationerrors.ApplicationNotFound] when the application doesn't
+// exist.
+func (s *Service) GetPendingCAASUnitFilesystems(
+	ctx context.Context, appID coreapplication.ID,
+) ([]application.PendingCAASUnitFilesystem, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := appID.Validate(); err != nil {
+		return nil, errors.Errorf("application ID: %w", err)
+	}
+
+	filesystems, err := s.st.GetPendingCAASUnitFilesystems(ctx, appID)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return filesystems, nil
+}
+
+// UpdateApplicationStorageDirectives updates the storage directives of the
+// named application, which are used for the storage of units added to the
+// application in the future. Only the values set in each override are
+// changed, the rest of the directive is left as it is. Overrides are validated
+// against the storage definitions of the application's charm.
+//
+// The following error types can be expected:
+// - [applicationerrors.ApplicationNameNotValid] when the application name is
+// not valid.
+// - [applicationerrors.ApplicationNotFound] when the application doesn't
+// exist.
+// - [applicationerrors.StorageNameNotSupported] when an override is supplied
+// for storage that the charm doesn't define.
+func (s *ProviderService) UpdateApplicationStorageDirectives(
+	ctx context.Context,
+	appName string,
+	overrides map[string]ApplicationStorageDirectiveOverride,
+) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if !isValidApplicationName(appName) {
+		return applicationerrors.ApplicationNameNotValid
+	}
+	if len(overrides) == 0 {
+		return nil
+	}
+
+	appID, err := s.st.GetApplicationIDByName(ctx, appName)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	charmID, err := s.st.GetCharmIDByApplicationName(ctx, appName)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	charmStorage, err := s.st.GetCharmMetadataStorage(ctx, charmID)
+	if err != nil {
+		return errors.Errorf("getting charm storage for application %q: %w", appName, err)
+	}
+	charmStorageDefs, err := decodeMetadataStorage(charmStorage)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	for name := range overrides {
+		if _, ok := charmStorageDefs[name]; !ok {
+			return errors.Errorf(
+				"charm for application %q has no storage %q", appName, name,
+			).Add(applicationerrors.StorageNameNotSupported)
+		}
+	}
+	err = validateApplicationStorageDirectiveParams(
+		ctx, charmStorageDefs, overrides, s.storagePoolProvider,
+	)
+	if err != nil {
+		return errors.Errorf("invalid storage directive overrides: %w", err)
+	}
+
+	existing, err := s.st.GetApplicationStorageDirectives(ctx, appID)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	args := make([]application.UpdateApplicationStorageDirectiveArg, 0, len(overrides))
+	for _, directive := range existing {
+		override, ok := overrides[directive.Name.String()]
+		if !ok {
+			continue
+		}
+		arg := application.UpdateApplicationStorageDirectiveArg{
+			Name:     directive.Name,
+			Count:    directive.Count,
+			PoolUUID: directive.PoolUUID,
+			Size:     directive.Size,
+		}
+		if override.Count != nil {
+			arg.Count = *override.Count
+		}
+		if override.PoolUUID != nil {
+			arg.PoolUUID = *override.PoolUUID
+		}
+		if override.Size != nil {
+			arg.Size = *override.Size
+		}
+		args = append(args, arg)
+	}
+	if len(args) != len(overrides) {
+		return errors.Errorf(
+			"application %q is missing storage directives for its charm", appName,
+		)
+	}
+
+	return s.st.UpdateApplicationStorageDirectives(ctx, appID, args)
+}
+
 // AttachStorage attached the specified storage to the specified unit.
 // If the attachment already exists, the result is a no op.
 // The following error types can be expected:
@@ -509,6 +686,12 @@ func makeUnitStorageArgs(
 			Size:     sd.Size,
 		})
 
+		// Shared storage is owned by the application and is attached to the
+		// unit when the unit is created.
+		if sd.Shared {
+			continue
+		}
+
 		existingStorageUUIDs := existingStorage[sd.Name]
 		if len(existingStorageUUIDs) > math.MaxUint32 {
 			return application.CreateUnitStorageArg{}, errors.Errorf(
@@ -706,8 +889,47 @@ func makeStorageDirectiveFromApplicationArg(
 			Type:     charm.StorageType(charmStorage[arg.Name.String()].Type),
 			PoolUUID: arg.PoolUUID,
 			Size:     arg.Size,
+			Shared:   charmStorage[arg.Name.String()].Shared,
 		})
 	}
 
 	return rval
 }
+
+// makeApplicationSharedStorageArgs creates the storage instance args for all of
+// the shared storage directives supplied. Shared storage is created once for
+// the application and attached to every unit, so it must be a filesystem that
+// is provisioned by the model and not by the machine of any one unit.
+func makeApplicationSharedStorageArgs(
+	ctx context.Context,
+	storagePoolProvider StoragePoolProvider,
+	storageDirectives []application.StorageDirective,
+) ([]application.CreateApplicationSharedStorageArg, error) {
+	var rval []application.CreateApplicationSharedStorageArg
+	for _, sd := range storageDirectives {
+		if !sd.Shared {
+			continue
+		}
+
+		instArgs, err := makeUnitStorageInstancesFromDirective(
+			ctx, storagePoolProvider, sd,
+		)
+		if err != nil {
+			return nil, errors.Errorf(
+				"making shared storage instance args for %q: %w", sd.Name, err,
+			)
+		}
+
+		for _, inst := range instArgs {
+			if inst.Filesystem == nil || inst.Volume != nil ||
+				inst.Filesystem.ProvisionScope != domainstorageprov.ProvisionScopeModel {
+				return nil, errors.Errorf(
+					"storage pool %q cannot provision shared storage %q",
+					sd.PoolUUID, sd.Name,
+				).Add(applicationerrors.SharedStorageNotSupported)
+			}
+		}
+		rval = append(rval, instArgs...)
+	}
+	return rval, nil
+}
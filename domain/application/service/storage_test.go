@@ -11,10 +11,13 @@ import (
 	"github.com/juju/tc"
 	"go.uber.org/mock/gomock"
 
+	applicationtesting "github.com/juju/juju/core/application/testing"
 	corestorage "github.com/juju/juju/core/storage"
 	"github.com/juju/juju/core/unit"
 	unittesting "github.com/juju/juju/core/unit/testing"
 	"github.com/juju/juju/domain"
+	"github.com/juju/juju/domain/application"
+	applicationcharm "github.com/juju/juju/domain/application/charm"
 	"github.com/juju/juju/domain/application/errors"
 	domainstorage "github.com/juju/juju/domain/storage"
 	storageerrors "github.com/juju/juju/domain/storage/errors"
@@ -99,6 +102,35 @@ func (s *storageSuite) TestAttachStorage(c *tc.C) {
 	c.Assert(err, tc.ErrorIsNil)
 }
 
+func (s *storageSuite) TestGetApplicationStorageDirectives(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appUUID := applicationtesting.GenApplicationUUID(c)
+	poolUUID := storagetesting.GenStoragePoolUUID(c)
+	directives := []application.StorageDirective{{
+		Count:    2,
+		Name:     "pgdata",
+		Type:     applicationcharm.StorageFilesystem,
+		PoolUUID: poolUUID,
+		Size:     1024,
+	}}
+	s.mockState.EXPECT().GetApplicationStorageDirectives(gomock.Any(), appUUID).Return(directives, nil)
+
+	result, err := s.service.GetApplicationStorageDirectives(c.Context(), appUUID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, directives)
+}
+
+func (s *storageSuite) TestGetApplicationStorageDirectivesNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appUUID := applicationtesting.GenApplicationUUID(c)
+	s.mockState.EXPECT().GetApplicationStorageDirectives(gomock.Any(), appUUID).Return(nil, errors.ApplicationNotFound)
+
+	_, err := s.service.GetApplicationStorageDirectives(c.Context(), appUUID)
+	c.Assert(err, tc.ErrorIs, errors.ApplicationNotFound)
+}
+
 func (s *storageSuite) TestAttachStorageAlreadyAttached(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -35,6 +35,17 @@ type UnitState interface {
 	// names.
 	AddCAASUnits(context.Context, coreapplication.ID, ...application.AddCAASUnitArg) ([]coreunit.Name, error)
 
+	// AddCAASUnitWithScale adds a single unit to the application, named after
+	// and increasing the application's scale. The unit name and new scale are
+	// returned.
+	//   - If the unit already exists [applicationerrors.UnitAlreadyExists] is
+	//     returned.
+	//   - If storage to be owned is attached to another unit,
+	//     [applicationerrors.StorageAlreadyAttached] is returned.
+	//   - If the application is not alive,
+	//     [applicationerrors.ApplicationNotAlive] is returned.
+	AddCAASUnitWithScale(context.Context, coreapplication.ID, application.AddCAASUnitArg) (coreunit.Name, int, error)
+
 	// InsertMigratingIAASUnits inserts the fully formed units for the specified
 	// IAAS application. This is only used when inserting units during model
 	// migration. If the application is not found, an error satisfying
@@ -317,6 +317,11 @@ func (st *State) insertApplication(
 	); err != nil {
 		return errors.Errorf("inserting storage directives for application %q: %w", name, err)
 	}
+	if err := st.insertApplicationSharedStorage(
+		ctx, tx, appDetails.UUID, args.Charm.Metadata.Name, args.StorageDirectives, args.SharedStorage,
+	); err != nil {
+		return errors.Errorf("inserting shared storage for application %q: %w", name, err)
+	}
 	if err := st.insertApplicationConfig(ctx, tx, appDetails.UUID, args.Config); err != nil {
 		return errors.Errorf("inserting config for application %q: %w", name, err)
 	}
@@ -2650,7 +2655,10 @@ ON CONFLICT (uuid) DO UPDATE SET
     container_type_id = excluded.container_type_id,
     virt_type = excluded.virt_type,
     allocate_public_ip = excluded.allocate_public_ip,
-    image_id = excluded.image_id
+    image_id = excluded.image_id,
+    affinity_group = excluded.affinity_group,
+    anti_affinity = excluded.anti_affinity,
+    accelerators = excluded.accelerators
 `
 	insertConstraintsStmt, err := st.Prepare(insertConstraintsQuery, setConstraint{})
 	if err != nil {
@@ -3005,6 +3013,15 @@ func decodeConstraints(cons applicationConstraints) constraints.Constraints {
 		if row.ImageID.Valid {
 			res.ImageID = &row.ImageID.String
 		}
+		if row.AffinityGroup.Valid {
+			res.AffinityGroup = &row.AffinityGroup.String
+		}
+		if row.AntiAffinity.Valid {
+			res.AntiAffinity = &row.AntiAffinity.Bool
+		}
+		if row.Accelerators.Valid {
+			res.Accelerators = &row.Accelerators.String
+		}
 		if row.SpaceName.Valid {
 			var exclude bool
 			if row.SpaceExclude.Valid {
@@ -3055,6 +3072,9 @@ func encodeConstraints(constraintUUID string, cons constraints.Constraints, cont
 		VirtType:         cons.VirtType,
 		ImageID:          cons.ImageID,
 		AllocatePublicIP: cons.AllocatePublicIP,
+		AffinityGroup:    cons.AffinityGroup,
+		AntiAffinity:     cons.AntiAffinity,
+		Accelerators:     cons.Accelerators,
 	}
 	if cons.Container != nil {
 		res.ContainerTypeID = &containerTypeID
@@ -0,0 +1,334 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/canonical/sqlair"
+
+	coreapplication "github.com/juju/juju/core/application"
+	corecharm "github.com/juju/juju/core/charm"
+	"github.com/juju/juju/domain/application"
+	"github.com/juju/juju/domain/application/charm"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// SetApplicationRefreshPolicy sets the charm refresh policy of the
+// application.
+//
+// The following errors may be returned:
+// - [applicationerrors.ApplicationNotFound] if the application doesn't exist.
+// - [applicationerrors.ApplicationIsDead] if the application is dead.
+func (st *State) SetApplicationRefreshPolicy(ctx context.Context, appID coreapplication.ID, policy application.ApplicationRefreshPolicy) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	policyID, err := encodeRefreshPolicy(policy.Policy)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	arg := applicationRefreshPolicy{
+		ApplicationUUID: appID,
+		PolicyID:        policyID,
+	}
+	if w := policy.Window; w != nil {
+		arg.WindowStartMinute = sql.Null[int64]{V: int64(w.Start / time.Minute), Valid: true}
+		arg.WindowEndMinute = sql.Null[int64]{V: int64(w.End / time.Minute), Valid: true}
+	}
+
+	stmt, err := st.Prepare(`
+INSERT INTO application_refresh_policy (*) VALUES ($applicationRefreshPolicy.*)
+ON CONFLICT (application_uuid) DO UPDATE SET
+    policy_id = excluded.policy_id,
+    window_start_minute = excluded.window_start_minute,
+    window_end_minute = excluded.window_end_minute
+`, arg)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := st.checkApplicationNotDead(ctx, tx, appID); err != nil {
+			return errors.Capture(err)
+		}
+		if err := tx.Query(ctx, stmt, arg).Run(); err != nil {
+			return errors.Errorf("setting refresh policy: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetApplicationRefreshPolicy returns the charm refresh policy of the
+// application. Applications which have never had a policy set have the
+// [application.RefreshPolicyNone] policy.
+//
+// If the application doesn't exist, [applicationerrors.ApplicationNotFound] is
+// returned.
+func (st *State) GetApplicationRefreshPolicy(ctx context.Context, appID coreapplication.ID) (application.ApplicationRefreshPolicy, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return application.ApplicationRefreshPolicy{}, errors.Capture(err)
+	}
+
+	result := applicationRefreshPolicy{ApplicationUUID: appID}
+	stmt, err := st.Prepare(`
+SELECT &applicationRefreshPolicy.*
+FROM   application_refresh_policy
+WHERE  application_uuid = $applicationRefreshPolicy.application_uuid
+`, result)
+	if err != nil {
+		return application.ApplicationRefreshPolicy{}, errors.Capture(err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		exists, err := st.checkApplicationExists(ctx, tx, appID)
+		if err != nil {
+			return errors.Capture(err)
+		} else if !exists {
+			return applicationerrors.ApplicationNotFound
+		}
+
+		err = tx.Query(ctx, stmt, result).Get(&result)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return application.ApplicationRefreshPolicy{}, errors.Errorf("getting refresh policy: %w", err)
+	}
+
+	return decodeApplicationRefreshPolicy(result.PolicyID, result.WindowStartMinute, result.WindowEndMinute)
+}
+
+// GetApplicationRefreshCandidates returns the alive applications with a refresh
+// policy other than none, for which a newer revision of their charmhub charm
+// has been reserved by the revision updater. Only the newest pending revision
+// is returned for each application.
+func (st *State) GetApplicationRefreshCandidates(ctx context.Context) ([]application.RefreshCandidate, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	// Pending charms are reserved by the revision updater, which polls the
+	// channel each application is tracking and records the channels each
+	// revision was found in. Only the revisions found in the channel an
+	// application is tracking are candidates for it. Another application
+	// may have already been refreshed to one, which doesn't stop it being a
+	// candidate for the rest.
+	stmt, err := st.Prepare(`
+SELECT
+    a.name AS &refreshCandidate.application_name,
+    arp.policy_id AS &refreshCandidate.policy_id,
+    arp.window_start_minute AS &refreshCandidate.window_start_minute,
+    arp.window_end_minute AS &refreshCandidate.window_end_minute,
+    c.reference_name AS &refreshCandidate.reference_name,
+    c.architecture_id AS &refreshCandidate.architecture_id,
+    c.revision AS &refreshCandidate.current_revision,
+    MAX(p.revision) AS &refreshCandidate.latest_revision,
+    arp.notified_revision AS &refreshCandidate.notified_revision,
+    arp.failed_revision AS &refreshCandidate.failed_revision
+FROM application AS a
+JOIN application_refresh_policy AS arp ON a.uuid = arp.application_uuid
+JOIN charm AS c ON a.charm_uuid = c.uuid
+JOIN application_channel AS ac ON a.uuid = ac.application_uuid
+JOIN charm AS p
+    ON c.reference_name = p.reference_name
+    AND c.architecture_id = p.architecture_id
+    AND p.source_id = 1
+    AND p.available = FALSE
+    AND p.revision > c.revision
+    AND p.uuid != a.charm_uuid
+JOIN charm_channel AS pc
+    ON p.uuid = pc.charm_uuid
+    AND ac.track IS pc.track
+    AND ac.risk = pc.risk
+    AND ac.branch IS pc.branch
+WHERE
+    a.life_id = 0
+    AND c.source_id = 1
+    AND arp.policy_id != 0
+GROUP BY a.uuid
+ORDER BY a.name
+`, refreshCandidate{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var results []refreshCandidate
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt).GetAll(&results)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return nil, errors.Errorf("getting refresh candidates: %w", err)
+	}
+
+	candidates := make([]application.RefreshCandidate, len(results))
+	for i, r := range results {
+		policy, err := decodeApplicationRefreshPolicy(r.PolicyID, r.WindowStartMinute, r.WindowEndMinute)
+		if err != nil {
+			return nil, errors.Errorf("decoding refresh policy of %q: %w", r.ApplicationName, err)
+		}
+		arch, err := decodeArchitecture(r.ArchitectureID)
+		if err != nil {
+			return nil, errors.Errorf("decoding architecture of %q: %w", r.ApplicationName, err)
+		}
+		candidates[i] = application.RefreshCandidate{
+			ApplicationName: r.ApplicationName,
+			RefreshPolicy:   policy,
+			Current: charm.CharmLocator{
+				Name:         r.ReferenceName,
+				Revision:     r.CurrentRevision,
+				Source:       charm.CharmHubSource,
+				Architecture: arch,
+			},
+			Latest: charm.CharmLocator{
+				Name:         r.ReferenceName,
+				Revision:     r.LatestRevision,
+				Source:       charm.CharmHubSource,
+				Architecture: arch,
+			},
+			Notified:      r.NotifiedRevision.Valid && int(r.NotifiedRevision.V) == r.LatestRevision,
+			RefreshFailed: r.FailedRevision.Valid && int(r.FailedRevision.V) == r.LatestRevision,
+		}
+	}
+	return candidates, nil
+}
+
+// SetApplicationRefreshNotified records that the application has been
+// notified of the charm revision.
+//
+// If the application doesn't exist, [applicationerrors.ApplicationNotFound] is
+// returned.
+func (st *State) SetApplicationRefreshNotified(ctx context.Context, appID coreapplication.ID, revision int) error {
+	return st.setApplicationRefreshRevision(ctx, `
+UPDATE application_refresh_policy
+SET    notified_revision = $applicationRefreshRevision.revision
+WHERE  application_uuid = $applicationRefreshRevision.application_uuid
+`, appID, revision)
+}
+
+// SetApplicationRefreshFailed records that refreshing the application to the
+// charm revision failed.
+//
+// If the application doesn't exist, [applicationerrors.ApplicationNotFound] is
+// returned.
+func (st *State) SetApplicationRefreshFailed(ctx context.Context, appID coreapplication.ID, revision int) error {
+	return st.setApplicationRefreshRevision(ctx, `
+UPDATE application_refresh_policy
+SET    failed_revision = $applicationRefreshRevision.revision
+WHERE  application_uuid = $applicationRefreshRevision.application_uuid
+`, appID, revision)
+}
+
+func (st *State) setApplicationRefreshRevision(ctx context.Context, query string, appID coreapplication.ID, revision int) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	arg := applicationRefreshRevision{
+		ApplicationUUID: appID,
+		Revision:        revision,
+	}
+	stmt, err := st.Prepare(query, arg)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		exists, err := st.checkApplicationExists(ctx, tx, appID)
+		if err != nil {
+			return errors.Capture(err)
+		} else if !exists {
+			return applicationerrors.ApplicationNotFound
+		}
+		if err := tx.Query(ctx, stmt, arg).Run(); err != nil {
+			return errors.Errorf("recording refresh revision: %w", err)
+		}
+		return nil
+	})
+}
+
+// AddCharmChannel records that the revision updater found the charm in the
+// channel, so that the revision is offered as a refresh candidate to the
+// applications tracking it. It is a no-op if it is already recorded.
+//
+// If the charm doesn't exist, [applicationerrors.CharmNotFound] is returned.
+func (st *State) AddCharmChannel(ctx context.Context, id corecharm.ID, channel charm.Channel) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	ident := charmID{UUID: id}
+	arg := charmChannel{
+		CharmUUID: id,
+		Track:     channel.Track,
+		Risk:      string(channel.Risk),
+		Branch:    channel.Branch,
+	}
+	stmt, err := st.Prepare(`
+INSERT INTO charm_channel (*) VALUES ($charmChannel.*)
+ON CONFLICT DO NOTHING
+`, arg)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := st.checkCharmExists(ctx, tx, ident); err != nil {
+			return errors.Capture(err)
+		}
+		if err := tx.Query(ctx, stmt, arg).Run(); err != nil {
+			return errors.Errorf("adding charm channel: %w", err)
+		}
+		return nil
+	})
+}
+
+func encodeRefreshPolicy(policy application.RefreshPolicy) (int, error) {
+	switch policy {
+	case application.RefreshPolicyNone:
+		return 0, nil
+	case application.RefreshPolicyNotify:
+		return 1, nil
+	case application.RefreshPolicyAuto:
+		return 2, nil
+	default:
+		return -1, errors.Errorf("%q: %w", policy, applicationerrors.RefreshPolicyNotValid)
+	}
+}
+
+func decodeApplicationRefreshPolicy(policyID int, start, end sql.Null[int64]) (application.ApplicationRefreshPolicy, error) {
+	var result application.ApplicationRefreshPolicy
+	switch policyID {
+	case 0:
+		result.Policy = application.RefreshPolicyNone
+	case 1:
+		result.Policy = application.RefreshPolicyNotify
+	case 2:
+		result.Policy = application.RefreshPolicyAuto
+	default:
+		return result, errors.Errorf("policy id %d: %w", policyID, applicationerrors.RefreshPolicyNotValid)
+	}
+	if start.Valid && end.Valid {
+		result.Window = &application.MaintenanceWindow{
+			Start: time.Duration(start.V) * time.Minute,
+			End:   time.Duration(end.V) * time.Minute,
+		}
+	}
+	return result, nil
+}
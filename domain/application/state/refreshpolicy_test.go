@@ -0,0 +1,295 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/tc"
+
+	applicationtesting "github.com/juju/juju/core/application/testing"
+	corecharm "github.com/juju/juju/core/charm"
+	"github.com/juju/juju/domain/application"
+	"github.com/juju/juju/domain/application/architecture"
+	"github.com/juju/juju/domain/application/charm"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/domain/life"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/uuid"
+)
+
+type refreshPolicySuite struct {
+	baseSuite
+
+	state *State
+}
+
+func TestRefreshPolicySuite(t *testing.T) {
+	tc.Run(t, &refreshPolicySuite{})
+}
+
+func (s *refreshPolicySuite) SetUpTest(c *tc.C) {
+	s.baseSuite.SetUpTest(c)
+
+	s.state = NewState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
+}
+
+func (s *refreshPolicySuite) TestGetApplicationRefreshPolicyDefault(c *tc.C) {
+	appID := s.createIAASApplication(c, "foo", life.Alive)
+
+	policy, err := s.state.GetApplicationRefreshPolicy(c.Context(), appID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(policy, tc.DeepEquals, application.ApplicationRefreshPolicy{
+		Policy: application.RefreshPolicyNone,
+	})
+}
+
+func (s *refreshPolicySuite) TestSetApplicationRefreshPolicy(c *tc.C) {
+	appID := s.createIAASApplication(c, "foo", life.Alive)
+
+	policy := application.ApplicationRefreshPolicy{
+		Policy: application.RefreshPolicyAuto,
+		Window: &application.MaintenanceWindow{
+			Start: 23 * time.Hour,
+			End:   90 * time.Minute,
+		},
+	}
+	err := s.state.SetApplicationRefreshPolicy(c.Context(), appID, policy)
+	c.Assert(err, tc.ErrorIsNil)
+
+	got, err := s.state.GetApplicationRefreshPolicy(c.Context(), appID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(got, tc.DeepEquals, policy)
+
+	// Setting the policy again replaces it.
+	policy = application.ApplicationRefreshPolicy{
+		Policy: application.RefreshPolicyNotify,
+	}
+	err = s.state.SetApplicationRefreshPolicy(c.Context(), appID, policy)
+	c.Assert(err, tc.ErrorIsNil)
+
+	got, err = s.state.GetApplicationRefreshPolicy(c.Context(), appID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(got, tc.DeepEquals, policy)
+}
+
+func (s *refreshPolicySuite) TestSetApplicationRefreshPolicyNotFound(c *tc.C) {
+	err := s.state.SetApplicationRefreshPolicy(c.Context(), applicationtesting.GenApplicationUUID(c), application.ApplicationRefreshPolicy{
+		Policy: application.RefreshPolicyAuto,
+	})
+	c.Assert(err, tc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
+
+func (s *refreshPolicySuite) TestSetApplicationRefreshPolicyDead(c *tc.C) {
+	appID := s.createIAASApplication(c, "foo", life.Dead)
+
+	err := s.state.SetApplicationRefreshPolicy(c.Context(), appID, application.ApplicationRefreshPolicy{
+		Policy: application.RefreshPolicyAuto,
+	})
+	c.Assert(err, tc.ErrorIs, applicationerrors.ApplicationIsDead)
+}
+
+func (s *refreshPolicySuite) TestGetApplicationRefreshPolicyNotFound(c *tc.C) {
+	_, err := s.state.GetApplicationRefreshPolicy(c.Context(), applicationtesting.GenApplicationUUID(c))
+	c.Assert(err, tc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
+
+func (s *refreshPolicySuite) TestGetApplicationRefreshCandidates(c *tc.C) {
+	fooID := s.createIAASApplication(c, "foo", life.Alive)
+	barID := s.createIAASApplication(c, "bar", life.Alive)
+	s.createIAASApplication(c, "baz", life.Alive)
+
+	policy := application.ApplicationRefreshPolicy{
+		Policy: application.RefreshPolicyAuto,
+		Window: &application.MaintenanceWindow{Start: time.Hour, End: 2 * time.Hour},
+	}
+	err := s.state.SetApplicationRefreshPolicy(c.Context(), fooID, policy)
+	c.Assert(err, tc.ErrorIsNil)
+	err = s.state.SetApplicationRefreshPolicy(c.Context(), barID, application.ApplicationRefreshPolicy{
+		Policy: application.RefreshPolicyNone,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	// The applications are using revision 42; only the newest pending
+	// revision is a candidate. baz has no policy, and bar's policy is none.
+	s.addPendingCharm(c, "foo", 43)
+	s.addPendingCharm(c, "foo", 44)
+	s.addPendingCharm(c, "foo", 41)
+	s.addPendingCharm(c, "bar", 43)
+	s.addPendingCharm(c, "baz", 43)
+
+	candidates, err := s.state.GetApplicationRefreshCandidates(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(candidates, tc.DeepEquals, []application.RefreshCandidate{{
+		ApplicationName: "foo",
+		RefreshPolicy:   policy,
+		Current: charm.CharmLocator{
+			Name:         "foo",
+			Revision:     42,
+			Source:       charm.CharmHubSource,
+			Architecture: architecture.AMD64,
+		},
+		Latest: charm.CharmLocator{
+			Name:         "foo",
+			Revision:     44,
+			Source:       charm.CharmHubSource,
+			Architecture: architecture.AMD64,
+		},
+	}})
+}
+
+func (s *refreshPolicySuite) TestGetApplicationRefreshCandidatesNone(c *tc.C) {
+	fooID := s.createIAASApplication(c, "foo", life.Alive)
+	err := s.state.SetApplicationRefreshPolicy(c.Context(), fooID, application.ApplicationRefreshPolicy{
+		Policy: application.RefreshPolicyNotify,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Only older revisions are pending.
+	s.addPendingCharm(c, "foo", 40)
+
+	candidates, err := s.state.GetApplicationRefreshCandidates(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(candidates, tc.HasLen, 0)
+}
+
+func (s *refreshPolicySuite) TestGetApplicationRefreshCandidatesUsedByAnotherApplication(c *tc.C) {
+	fooID := s.createIAASApplication(c, "foo", life.Alive)
+	policy := application.ApplicationRefreshPolicy{
+		Policy: application.RefreshPolicyAuto,
+	}
+	err := s.state.SetApplicationRefreshPolicy(c.Context(), fooID, policy)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Another application deployed from the same charm has already been
+	// refreshed to the pending revision; foo should still be offered it.
+	charmUUID := s.addPendingCharm(c, "foo", 43)
+	otherID := s.createIAASApplication(c, "other", life.Alive)
+	err = s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `UPDATE application SET charm_uuid = ? WHERE uuid = ?`, charmUUID, otherID)
+		return err
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	candidates, err := s.state.GetApplicationRefreshCandidates(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(candidates, tc.HasLen, 1)
+	c.Check(candidates[0].ApplicationName, tc.Equals, "foo")
+	c.Check(candidates[0].Latest.Revision, tc.Equals, 43)
+}
+
+func (s *refreshPolicySuite) TestGetApplicationRefreshCandidatesOtherChannel(c *tc.C) {
+	fooID := s.createIAASApplication(c, "foo", life.Alive)
+	err := s.state.SetApplicationRefreshPolicy(c.Context(), fooID, application.ApplicationRefreshPolicy{
+		Policy: application.RefreshPolicyAuto,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	// The newer revisions were found in channels other than the one foo is
+	// tracking, track/stable/branch.
+	s.addPendingCharmInChannel(c, "foo", 43, charm.Channel{Track: "track", Risk: charm.RiskEdge, Branch: "branch"})
+	s.addPendingCharmInChannel(c, "foo", 44, charm.Channel{Track: "other", Risk: charm.RiskStable, Branch: "branch"})
+	s.addPendingCharmInChannel(c, "foo", 45, charm.Channel{Track: "track", Risk: charm.RiskStable})
+
+	candidates, err := s.state.GetApplicationRefreshCandidates(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(candidates, tc.HasLen, 0)
+}
+
+func (s *refreshPolicySuite) TestGetApplicationRefreshCandidatesNotifiedAndFailed(c *tc.C) {
+	fooID := s.createIAASApplication(c, "foo", life.Alive)
+	err := s.state.SetApplicationRefreshPolicy(c.Context(), fooID, application.ApplicationRefreshPolicy{
+		Policy: application.RefreshPolicyAuto,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	s.addPendingCharm(c, "foo", 43)
+
+	err = s.state.SetApplicationRefreshNotified(c.Context(), fooID, 43)
+	c.Assert(err, tc.ErrorIsNil)
+	err = s.state.SetApplicationRefreshFailed(c.Context(), fooID, 43)
+	c.Assert(err, tc.ErrorIsNil)
+
+	candidates, err := s.state.GetApplicationRefreshCandidates(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(candidates, tc.HasLen, 1)
+	c.Check(candidates[0].Notified, tc.IsTrue)
+	c.Check(candidates[0].RefreshFailed, tc.IsTrue)
+
+	// A newer revision is neither notified nor failed, and setting the
+	// policy again doesn't reset what was recorded.
+	s.addPendingCharm(c, "foo", 44)
+	err = s.state.SetApplicationRefreshPolicy(c.Context(), fooID, application.ApplicationRefreshPolicy{
+		Policy: application.RefreshPolicyAuto,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	candidates, err = s.state.GetApplicationRefreshCandidates(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(candidates, tc.HasLen, 1)
+	c.Check(candidates[0].Latest.Revision, tc.Equals, 44)
+	c.Check(candidates[0].Notified, tc.IsFalse)
+	c.Check(candidates[0].RefreshFailed, tc.IsFalse)
+}
+
+func (s *refreshPolicySuite) TestSetApplicationRefreshNotifiedNotFound(c *tc.C) {
+	err := s.state.SetApplicationRefreshNotified(c.Context(), applicationtesting.GenApplicationUUID(c), 43)
+	c.Assert(err, tc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
+
+func (s *refreshPolicySuite) TestAddCharmChannelIdempotent(c *tc.C) {
+	charmUUID := s.addPendingCharm(c, "foo", 43)
+
+	err := s.state.AddCharmChannel(c.Context(), corecharm.ID(charmUUID), charm.Channel{
+		Track:  "track",
+		Risk:   charm.RiskStable,
+		Branch: "branch",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *refreshPolicySuite) TestAddCharmChannelCharmNotFound(c *tc.C) {
+	err := s.state.AddCharmChannel(c.Context(), corecharm.ID(uuid.MustNewUUID().String()), charm.Channel{
+		Risk: charm.RiskStable,
+	})
+	c.Assert(err, tc.ErrorIs, applicationerrors.CharmNotFound)
+}
+
+// addPendingCharm adds a charmhub charm which has been reserved, but not yet
+// downloaded, as the revision updater does, returning its UUID. The revision
+// is found in the channel the test applications are tracking.
+func (s *refreshPolicySuite) addPendingCharm(c *tc.C, name string, revision int) string {
+	return s.addPendingCharmInChannel(c, name, revision, charm.Channel{
+		Track:  "track",
+		Risk:   charm.RiskStable,
+		Branch: "branch",
+	})
+}
+
+// addPendingCharmInChannel adds a charmhub charm which has been reserved in
+// the given channel, returning its UUID.
+func (s *refreshPolicySuite) addPendingCharmInChannel(c *tc.C, name string, revision int, channel charm.Channel) string {
+	charmUUID := uuid.MustNewUUID().String()
+	err := s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+INSERT INTO charm (uuid, available, reference_name, revision, source_id, architecture_id)
+VALUES (?, false, ?, ?, 1, 0)
+`, charmUUID, name, revision)
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, `
+INSERT INTO charm_metadata (charm_uuid, name, subordinate, run_as_id)
+VALUES (?, ?, false, 0)`, charmUUID, name)
+		return err
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = s.state.AddCharmChannel(c.Context(), corecharm.ID(charmUUID), channel)
+	c.Assert(err, tc.ErrorIsNil)
+	return charmUUID
+}
@@ -1484,6 +1484,8 @@ func decodeCharmSource(source int) (charm.CharmSource, error) {
 		return charm.CharmHubSource, nil
 	case 0:
 		return charm.LocalSource, nil
+	case 2:
+		return charm.CMRSource, nil
 	default:
 		return "", errors.Errorf("unsupported charm source: %d", source)
 	}
@@ -1516,6 +1518,8 @@ func encodeCharmSource(source charm.CharmSource) (int, error) {
 		return 0, nil
 	case charm.CharmHubSource:
 		return 1, nil
+	case charm.CMRSource:
+		return 2, nil
 	default:
 		return 0, errors.Errorf("unsupported source type: %s", source)
 	}
@@ -102,11 +102,92 @@ WHERE  application_uuid = $entityUUID.uuid
 			Type:     charm.StorageType(val.CharmStorageKind),
 			PoolUUID: domainstorage.StoragePoolUUID(val.StoragePoolUUID),
 			Size:     val.SizeMiB,
+			Shared:   val.Shared,
 		})
 	}
 	return rval, nil
 }
 
+// UpdateApplicationStorageDirectives updates the existing storage directives
+// of an application with the supplied values. Only the directives included in
+// the arguments are changed.
+//
+// The following error types can be expected:
+// - [github.com/juju/juju/domain/application/errors.ApplicationNotFound]
+// when the application no longer exists.
+// - [github.com/juju/juju/domain/application/errors.StorageNameNotSupported]
+// when the application has no storage directive for one of the names.
+func (st *State) UpdateApplicationStorageDirectives(
+	ctx context.Context,
+	appUUID coreapplication.ID,
+	args []application.UpdateApplicationStorageDirectiveArg,
+) error {
+	if len(args) == 0 {
+		return nil
+	}
+
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	updateStmt, err := st.Prepare(`
+UPDATE application_storage_directive
+SET    storage_pool_uuid = $updateApplicationStorageDirective.storage_pool_uuid,
+       size_mib = $updateApplicationStorageDirective.size_mib,
+       count = $updateApplicationStorageDirective.count
+WHERE  application_uuid = $updateApplicationStorageDirective.application_uuid
+AND    storage_name = $updateApplicationStorageDirective.storage_name
+`,
+		updateApplicationStorageDirective{},
+	)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		exists, err := st.checkApplicationExists(ctx, tx, appUUID)
+		if err != nil {
+			return errors.Errorf(
+				"checking application %q exists: %w", appUUID, err,
+			)
+		}
+		if !exists {
+			return errors.Errorf(
+				"application %q does not exist", appUUID,
+			).Add(applicationerrors.ApplicationNotFound)
+		}
+
+		for _, arg := range args {
+			input := updateApplicationStorageDirective{
+				ApplicationUUID: appUUID.String(),
+				StorageName:     arg.Name.String(),
+				StoragePoolUUID: arg.PoolUUID.String(),
+				SizeMiB:         arg.Size,
+				Count:           arg.Count,
+			}
+
+			var outcome sqlair.Outcome
+			err := tx.Query(ctx, updateStmt, input).Get(&outcome)
+			if err != nil {
+				return errors.Errorf(
+					"updating storage directive %q: %w", arg.Name, err,
+				)
+			}
+			num, err := outcome.Result().RowsAffected()
+			if err != nil {
+				return errors.Capture(err)
+			}
+			if num == 0 {
+				return errors.Errorf(
+					"application %q has no storage directive %q", appUUID, arg.Name,
+				).Add(applicationerrors.StorageNameNotSupported)
+			}
+		}
+		return nil
+	})
+}
+
 func (st *State) GetStorageInstancesForProviderIDs(
 	ctx context.Context,
 	appUUID coreapplication.ID,
@@ -277,6 +358,106 @@ VALUES ($insertApplicationStorageDirective.*)
 	return nil
 }
 
+// insertApplicationSharedStorage is responsible for creating the shared storage
+// instances of a new application and making the application their owner. The
+// shared storage instances are attached to units as they are added.
+func (st *State) insertApplicationSharedStorage(
+	ctx context.Context,
+	tx *sqlair.TX,
+	appUUID coreapplication.ID,
+	charmName string,
+	directives []application.CreateApplicationStorageDirectiveArg,
+	args []application.CreateApplicationSharedStorageArg,
+) error {
+	if len(args) == 0 {
+		return nil
+	}
+
+	stDirectives := make([]unitStorageDirective, 0, len(directives))
+	for _, d := range directives {
+		stDirectives = append(stDirectives, unitStorageDirective{
+			CharmName:       charmName,
+			Count:           d.Count,
+			SizeMiB:         d.Size,
+			StorageName:     d.Name.String(),
+			StoragePoolUUID: d.PoolUUID.String(),
+		})
+	}
+
+	if err := st.insertUnitStorageInstances(ctx, tx, stDirectives, args); err != nil {
+		return errors.Errorf("creating shared storage instances: %w", err)
+	}
+
+	insertOwnerStmt, err := st.Prepare(`
+INSERT INTO storage_application_owner (*) VALUES ($insertStorageApplicationOwner.*)
+`,
+		insertStorageApplicationOwner{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	ownerArgs := make([]insertStorageApplicationOwner, 0, len(args))
+	for _, arg := range args {
+		ownerArgs = append(ownerArgs, insertStorageApplicationOwner{
+			ApplicationUUID:     appUUID.String(),
+			StorageInstanceUUID: arg.UUID.String(),
+		})
+	}
+
+	err = tx.Query(ctx, insertOwnerStmt, ownerArgs).Run()
+	if err != nil {
+		return errors.Errorf(
+			"setting shared storage instance application owner: %w", err,
+		)
+	}
+	return nil
+}
+
+// attachApplicationSharedStorage attaches all of the alive shared storage
+// instances owned by the application to the new unit. Filesystem attachments
+// are made for the unit's net node.
+func (st *State) attachApplicationSharedStorage(
+	ctx context.Context,
+	tx *sqlair.TX,
+	appUUID coreapplication.ID,
+	unitUUID coreunit.UUID,
+	netNodeUUID domainnetwork.NetNodeUUID,
+) error {
+	appUUIDInput := entityUUID{UUID: appUUID.String()}
+	sharedStorageStmt, err := st.Prepare(`
+SELECT sao.storage_instance_uuid AS &entityUUID.uuid
+FROM   storage_application_owner sao
+JOIN   storage_instance si ON si.uuid = sao.storage_instance_uuid
+WHERE  sao.application_uuid = $entityUUID.uuid
+AND    si.life_id = 0
+`,
+		appUUIDInput)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	var dbVals []entityUUID
+	err = tx.Query(ctx, sharedStorageStmt, appUUIDInput).GetAll(&dbVals)
+	if errors.Is(err, sqlair.ErrNoRows) {
+		return nil
+	} else if err != nil {
+		return errors.Errorf("getting application shared storage: %w", err)
+	}
+
+	attachArgs := make([]application.CreateStorageAttachmentArg, 0, len(dbVals))
+	for _, v := range dbVals {
+		saUUID, err := storageprovisioning.NewStorageAttachmentUUID()
+		if err != nil {
+			return errors.Errorf("generating new storage attachment uuid: %w", err)
+		}
+		attachArgs = append(attachArgs, application.CreateStorageAttachmentArg{
+			UUID:                saUUID,
+			StorageInstanceUUID: domainstorage.StorageInstanceUUID(v.UUID),
+		})
+	}
+	return st.insertUnitStorageAttachments(ctx, tx, unitUUID, netNodeUUID, attachArgs)
+}
+
 // insertUnitStorageAttachments is responsible for creating all of the unit
 // storage attachments for the supplied storage instance uuids. This func will
 // also create storage attachments for each filesystem and volume
@@ -1103,6 +1284,145 @@ WHERE  storage_id = $storageInstance.storage_id
 	return inst.StorageUUID, nil
 }
 
+// GetAttachableStorageInstances returns the details of the storage instances
+// with the given ids, for them to be attached to a new unit.
+//
+// The following error types can be expected:
+// - [storageerrors.StorageNotFound] when one of the storage instances doesn't
+// exist.
+func (st *State) GetAttachableStorageInstances(
+	ctx context.Context, storageIDs []corestorage.ID,
+) ([]application.AttachableStorageInstance, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	query, err := st.Prepare(`
+SELECT si.uuid AS &attachableStorageInstance.uuid,
+       si.storage_id AS &attachableStorageInstance.storage_id,
+       si.storage_name AS &attachableStorageInstance.storage_name,
+       si.storage_pool_uuid AS &attachableStorageInstance.storage_pool_uuid,
+       si.charm_name AS &attachableStorageInstance.charm_name,
+       si.life_id AS &attachableStorageInstance.life_id,
+       u.application_uuid AS &attachableStorageInstance.owner_application_uuid,
+       COUNT(sa.uuid) AS &attachableStorageInstance.attachment_count
+FROM      storage_instance si
+LEFT JOIN storage_unit_owner suo ON suo.storage_instance_uuid = si.uuid
+LEFT JOIN unit u ON u.uuid = suo.unit_uuid
+LEFT JOIN storage_attachment sa ON sa.storage_instance_uuid = si.uuid
+WHERE     si.storage_id = $storageInstance.storage_id
+GROUP BY  si.uuid
+`, attachableStorageInstance{}, storageInstance{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	dbVals := make([]attachableStorageInstance, 0, len(storageIDs))
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		for _, id := range storageIDs {
+			var dbVal attachableStorageInstance
+			err := tx.Query(ctx, query, storageInstance{StorageID: id}).Get(&dbVal)
+			if errors.Is(err, sqlair.ErrNoRows) {
+				return errors.Errorf("storage %q not found", id).Add(storageerrors.StorageNotFound)
+			} else if err != nil {
+				return errors.Errorf("querying storage %q: %w", id, err)
+			}
+			dbVals = append(dbVals, dbVal)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	rval := make([]application.AttachableStorageInstance, 0, len(dbVals))
+	for _, v := range dbVals {
+		rval = append(rval, application.AttachableStorageInstance{
+			UUID:                 domainstorage.StorageInstanceUUID(v.UUID),
+			StorageID:            v.StorageID,
+			StorageName:          domainstorage.Name(v.StorageName),
+			PoolUUID:             domainstorage.StoragePoolUUID(v.StoragePoolUUID),
+			CharmName:            v.CharmName.String,
+			Life:                 v.LifeID,
+			OwnerApplicationUUID: coreapplication.ID(v.OwnerApplicationUUID.String),
+			Attached:             v.AttachmentCount > 0,
+		})
+	}
+	return rval, nil
+}
+
+// GetPendingCAASUnitFilesystems returns the provisioned filesystems owned by
+// the alive units of the application that the provider has yet to start.
+//
+// The following error types can be expected:
+// - [applicationerrors.ApplicationNotFound] when the application doesn't
+// exist.
+func (st *State) GetPendingCAASUnitFilesystems(
+	ctx context.Context, appUUID coreapplication.ID,
+) ([]application.PendingCAASUnitFilesystem, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	appInput := entityUUID{UUID: appUUID.String()}
+	query, err := st.Prepare(`
+SELECT u.name AS &pendingCAASUnitFilesystem.unit_name,
+       si.storage_name AS &pendingCAASUnitFilesystem.storage_name,
+       sf.provider_id AS &pendingCAASUnitFilesystem.provider_id
+FROM      unit u
+JOIN      storage_unit_owner suo ON suo.unit_uuid = u.uuid
+JOIN      storage_instance si ON si.uuid = suo.storage_instance_uuid
+JOIN      storage_instance_filesystem sif ON sif.storage_instance_uuid = si.uuid
+JOIN      storage_filesystem sf ON sf.uuid = sif.storage_filesystem_uuid
+LEFT JOIN k8s_pod kp ON kp.unit_uuid = u.uuid
+WHERE     u.application_uuid = $entityUUID.uuid
+AND       u.life_id = 0
+AND       kp.unit_uuid IS NULL
+AND       sf.provider_id IS NOT NULL
+AND       sf.provider_id != ''
+ORDER BY  u.name, si.storage_name
+`, appInput, pendingCAASUnitFilesystem{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var dbVals []pendingCAASUnitFilesystem
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		exists, err := st.checkApplicationExists(ctx, tx, appUUID)
+		if err != nil {
+			return errors.Errorf(
+				"checking application %q exists: %w", appUUID, err,
+			)
+		}
+		if !exists {
+			return errors.Errorf(
+				"application %q does not exist", appUUID,
+			).Add(applicationerrors.ApplicationNotFound)
+		}
+
+		err = tx.Query(ctx, query, appInput).GetAll(&dbVals)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	rval := make([]application.PendingCAASUnitFilesystem, 0, len(dbVals))
+	for _, v := range dbVals {
+		rval = append(rval, application.PendingCAASUnitFilesystem{
+			UnitName:    coreunit.Name(v.UnitName),
+			StorageName: domainstorage.Name(v.StorageName),
+			ProviderID:  v.ProviderID,
+		})
+	}
+	return rval, nil
+}
+
 // AttachStorage attaches the specified storage to the specified unit.
 // The following error types can be expected:
 // - [storageerrors.StorageNotFound] when the storage doesn't exist.
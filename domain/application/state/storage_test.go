@@ -6,18 +6,27 @@ package state
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	stdtesting "testing"
 
 	"github.com/juju/clock"
 	"github.com/juju/tc"
 
+	applicationtesting "github.com/juju/juju/core/application/testing"
+	coremachinetesting "github.com/juju/juju/core/machine/testing"
 	modeltesting "github.com/juju/juju/core/model/testing"
+	corestorage "github.com/juju/juju/core/storage"
+	coreunit "github.com/juju/juju/core/unit"
 	"github.com/juju/juju/domain/application"
 	"github.com/juju/juju/domain/application/charm"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/domain/life"
+	domainnetwork "github.com/juju/juju/domain/network"
 	schematesting "github.com/juju/juju/domain/schema/testing"
 	domainstorage "github.com/juju/juju/domain/storage"
 	storageerrors "github.com/juju/juju/domain/storage/errors"
 	storagetesting "github.com/juju/juju/domain/storage/testing"
+	domainstorageprov "github.com/juju/juju/domain/storageprovisioning"
 	"github.com/juju/juju/internal/errors"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 	coretesting "github.com/juju/juju/internal/testing"
@@ -226,6 +235,169 @@ WHERE application_uuid = ? AND charm_uuid = ?`, appUUID, charmUUID)
 	c.Check(foundAppStorage, tc.SameContents, directives)
 }
 
+// TestCreateApplicationWithSharedStorage tests that shared storage created
+// with an application is owned by the application and attached to each of
+// the application's units.
+func (s *applicationStateSuite) TestCreateApplicationWithSharedStorage(c *tc.C) {
+	ctx := c.Context()
+	poolUUID := s.createStoragePool(c, "nfs", "nfs")
+	chStorage := []charm.Storage{{
+		Name:   "data",
+		Type:   "filesystem",
+		Shared: true,
+	}}
+	directives := []application.CreateApplicationStorageDirectiveArg{{
+		Name:     "data",
+		PoolUUID: poolUUID,
+		Size:     10,
+		Count:    1,
+	}}
+	args := s.addIAASApplicationArgForStorage(c, "foo", chStorage, directives)
+	storageUUID := storagetesting.GenStorageInstanceUUID(c)
+	args.SharedStorage = []application.CreateApplicationSharedStorageArg{{
+		Filesystem: &application.CreateUnitStorageFilesystemArg{
+			UUID:           tc.Must(c, domainstorageprov.NewFilesystemUUID),
+			ProvisionScope: domainstorageprov.ProvisionScopeModel,
+		},
+		Kind: domainstorageprov.KindFilesystem,
+		Name: "data",
+		UUID: storageUUID,
+	}}
+
+	units := make([]application.AddIAASUnitArg, 2)
+	for i := range units {
+		netNodeUUID := tc.Must(c, domainnetwork.NewNetNodeUUID)
+		units[i].MachineUUID = coremachinetesting.GenUUID(c)
+		units[i].MachineNetNodeUUID = netNodeUUID
+		units[i].NetNodeUUID = netNodeUUID
+	}
+
+	appUUID, _, err := s.state.CreateIAASApplication(ctx, "foo", args, units)
+	c.Assert(err, tc.ErrorIsNil)
+
+	var ownerUUID string
+	err = s.DB().QueryRow(`
+SELECT application_uuid FROM storage_application_owner WHERE storage_instance_uuid = ?`,
+		storageUUID.String()).Scan(&ownerUUID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(ownerUUID, tc.Equals, appUUID.String())
+
+	var unitAttachments, fsAttachments int
+	err = s.DB().QueryRow(`
+SELECT COUNT(*) FROM storage_attachment WHERE storage_instance_uuid = ?`,
+		storageUUID.String()).Scan(&unitAttachments)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(unitAttachments, tc.Equals, 2)
+	err = s.DB().QueryRow(`
+SELECT COUNT(*)
+FROM   storage_filesystem_attachment sfa
+JOIN   storage_instance_filesystem sif ON sif.storage_filesystem_uuid = sfa.storage_filesystem_uuid
+WHERE  sif.storage_instance_uuid = ?`,
+		storageUUID.String()).Scan(&fsAttachments)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(fsAttachments, tc.Equals, 2)
+}
+
+// TestUpdateApplicationStorageDirectives tests that the storage directives
+// of an application can be updated, leaving the other directives untouched.
+func (s *applicationStateSuite) TestUpdateApplicationStorageDirectives(c *tc.C) {
+	ctx := c.Context()
+	ebsPoolUUID := s.createStoragePool(c, "ebs", "ebs")
+	fastPoolUUID := s.createStoragePool(c, "fast", "ebs")
+	chStorage := []charm.Storage{{
+		Name: "database",
+		Type: "block",
+	}, {
+		Name: "cache",
+		Type: "block",
+	}}
+	directives := []application.CreateApplicationStorageDirectiveArg{
+		{
+			Name:     "database",
+			PoolUUID: ebsPoolUUID,
+			Size:     10,
+			Count:    1,
+		},
+		{
+			Name:     "cache",
+			PoolUUID: ebsPoolUUID,
+			Size:     30,
+			Count:    1,
+		},
+	}
+	appUUID, _, err := s.state.CreateIAASApplication(ctx, "666", s.addIAASApplicationArgForStorage(c, "666",
+		chStorage, directives), nil)
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = s.state.UpdateApplicationStorageDirectives(ctx, appUUID, []application.UpdateApplicationStorageDirectiveArg{{
+		Name:     "database",
+		PoolUUID: fastPoolUUID,
+		Size:     100,
+		Count:    2,
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+
+	result, err := s.state.GetApplicationStorageDirectives(ctx, appUUID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.SameContents, []application.StorageDirective{
+		{
+			Name:     "database",
+			Type:     charm.StorageBlock,
+			PoolUUID: fastPoolUUID,
+			Size:     100,
+			Count:    2,
+		},
+		{
+			Name:     "cache",
+			Type:     charm.StorageBlock,
+			PoolUUID: ebsPoolUUID,
+			Size:     30,
+			Count:    1,
+		},
+	})
+}
+
+// TestUpdateApplicationStorageDirectivesUnknownName tests that updating a
+// storage directive the application does not have returns an error satisfying
+// [applicationerrors.StorageNameNotSupported].
+func (s *applicationStateSuite) TestUpdateApplicationStorageDirectivesUnknownName(c *tc.C) {
+	ctx := c.Context()
+	ebsPoolUUID := s.createStoragePool(c, "ebs", "ebs")
+	chStorage := []charm.Storage{{
+		Name: "database",
+		Type: "block",
+	}}
+	directives := []application.CreateApplicationStorageDirectiveArg{{
+		Name:     "database",
+		PoolUUID: ebsPoolUUID,
+		Size:     10,
+		Count:    1,
+	}}
+	appUUID, _, err := s.state.CreateIAASApplication(ctx, "666", s.addIAASApplicationArgForStorage(c, "666",
+		chStorage, directives), nil)
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = s.state.UpdateApplicationStorageDirectives(ctx, appUUID, []application.UpdateApplicationStorageDirectiveArg{{
+		Name:     "logs",
+		PoolUUID: ebsPoolUUID,
+		Size:     10,
+		Count:    1,
+	}})
+	c.Assert(err, tc.ErrorIs, applicationerrors.StorageNameNotSupported)
+}
+
+// TestUpdateApplicationStorageDirectivesApplicationNotFound tests that
+// updating the storage directives of an application that doesn't exist
+// returns an error satisfying [applicationerrors.ApplicationNotFound].
+func (s *applicationStateSuite) TestUpdateApplicationStorageDirectivesApplicationNotFound(c *tc.C) {
+	appUUID := applicationtesting.GenApplicationUUID(c)
+	err := s.state.UpdateApplicationStorageDirectives(c.Context(), appUUID, []application.UpdateApplicationStorageDirectiveArg{{
+		Name:  "database",
+		Count: 1,
+	}})
+	c.Assert(err, tc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
+
 func (s *caasStorageSuite) SetUpTest(c *tc.C) {
 	s.baseStorageSuite.SetUpTest(c)
 
@@ -318,3 +490,191 @@ func (s *storageSuite) TestGetDefaultStorageProvisioners(c *tc.C) {
 		FilesystemPoolUUID: &poolUUID,
 	})
 }
+
+// insertStorageInstance inserts a storage instance into the model for the
+// tests, returning its uuid.
+func (s *baseStorageSuite) insertStorageInstance(
+	c *tc.C, poolUUID domainstorage.StoragePoolUUID, charmName, storageID string,
+) domainstorage.StorageInstanceUUID {
+	uuid := storagetesting.GenStorageInstanceUUID(c)
+	_, err := s.DB().Exec(`
+INSERT INTO storage_instance(uuid, charm_name, storage_name, storage_id,
+                             storage_kind_id, life_id, storage_pool_uuid,
+                             requested_size_mib)
+VALUES (?, ?, ?, ?, 1, 0, ?, 1024)`,
+		uuid.String(), charmName, "pgdata", storageID, poolUUID,
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	return uuid
+}
+
+func (s *baseStorageSuite) insertStoragePool(c *tc.C) domainstorage.StoragePoolUUID {
+	poolUUID := storagetesting.GenStoragePoolUUID(c)
+	_, err := s.DB().Exec(`
+INSERT INTO storage_pool (uuid, name, type) VALUES (?, ?, ?)`,
+		poolUUID, "fast", "kubernetes")
+	c.Assert(err, tc.ErrorIsNil)
+	return poolUUID
+}
+
+func (s *caasStorageSuite) TestGetAttachableStorageInstances(c *tc.C) {
+	appUUID, unitUUIDs := s.createCAASApplicationWithNUnits(c, "foo", life.Alive, 1)
+	poolUUID := s.insertStoragePool(c)
+
+	ownedUUID := s.insertStorageInstance(c, poolUUID, "foo", "pgdata/0")
+	attachedUUID := s.insertStorageInstance(c, poolUUID, "foo", "pgdata/1")
+	_, err := s.DB().Exec(`
+INSERT INTO storage_unit_owner (storage_instance_uuid, unit_uuid) VALUES (?, ?)`,
+		ownedUUID, unitUUIDs[0])
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = s.DB().Exec(`
+INSERT INTO storage_attachment (uuid, storage_instance_uuid, unit_uuid, life_id)
+VALUES (?, ?, ?, 0)`,
+		storagetesting.GenStorageInstanceUUID(c).String(), attachedUUID, unitUUIDs[0])
+	c.Assert(err, tc.ErrorIsNil)
+
+	result, err := s.state.GetAttachableStorageInstances(c.Context(), []corestorage.ID{"pgdata/0", "pgdata/1"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, []application.AttachableStorageInstance{{
+		UUID:                 ownedUUID,
+		StorageID:            "pgdata/0",
+		StorageName:          "pgdata",
+		PoolUUID:             poolUUID,
+		CharmName:            "foo",
+		Life:                 life.Alive,
+		OwnerApplicationUUID: appUUID,
+	}, {
+		UUID:        attachedUUID,
+		StorageID:   "pgdata/1",
+		StorageName: "pgdata",
+		PoolUUID:    poolUUID,
+		CharmName:   "foo",
+		Life:        life.Alive,
+		Attached:    true,
+	}})
+}
+
+func (s *caasStorageSuite) TestGetAttachableStorageInstancesNotFound(c *tc.C) {
+	_, err := s.state.GetAttachableStorageInstances(c.Context(), []corestorage.ID{"pgdata/0"})
+	c.Assert(err, tc.ErrorIs, storageerrors.StorageNotFound)
+}
+
+func (s *caasStorageSuite) TestGetPendingCAASUnitFilesystems(c *tc.C) {
+	appUUID, unitUUIDs := s.createCAASApplicationWithNUnits(c, "foo", life.Alive, 2)
+	poolUUID := s.insertStoragePool(c)
+
+	for i, unitUUID := range unitUUIDs {
+		storageUUID := s.insertStorageInstance(c, poolUUID, "foo", fmt.Sprintf("pgdata/%d", i))
+		fsUUID := storagetesting.GenStorageInstanceUUID(c).String()
+		_, err := s.DB().Exec(`
+INSERT INTO storage_unit_owner (storage_instance_uuid, unit_uuid) VALUES (?, ?)`,
+			storageUUID, unitUUID)
+		c.Assert(err, tc.ErrorIsNil)
+		_, err = s.DB().Exec(`
+INSERT INTO storage_filesystem (uuid, filesystem_id, life_id, provider_id)
+VALUES (?, ?, 0, ?)`,
+			fsUUID, fmt.Sprintf("%d", i), fmt.Sprintf("pvc-uid-%d", i))
+		c.Assert(err, tc.ErrorIsNil)
+		_, err = s.DB().Exec(`
+INSERT INTO storage_instance_filesystem (storage_instance_uuid, storage_filesystem_uuid)
+VALUES (?, ?)`,
+			storageUUID, fsUUID)
+		c.Assert(err, tc.ErrorIsNil)
+	}
+
+	// The second unit has been started by the provider.
+	_, err := s.DB().Exec(`
+INSERT INTO k8s_pod (unit_uuid, provider_id) VALUES (?, ?)`,
+		unitUUIDs[1], "foo-1")
+	c.Assert(err, tc.ErrorIsNil)
+
+	unitName, err := s.state.GetUnitNameForUUID(c.Context(), unitUUIDs[0])
+	c.Assert(err, tc.ErrorIsNil)
+
+	result, err := s.state.GetPendingCAASUnitFilesystems(c.Context(), appUUID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, []application.PendingCAASUnitFilesystem{{
+		UnitName:    unitName,
+		StorageName: "pgdata",
+		ProviderID:  "pvc-uid-0",
+	}})
+}
+
+func (s *caasStorageSuite) TestGetPendingCAASUnitFilesystemsApplicationNotFound(c *tc.C) {
+	_, err := s.state.GetPendingCAASUnitFilesystems(c.Context(), applicationtesting.GenApplicationUUID(c))
+	c.Assert(err, tc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
+
+func (s *caasStorageSuite) TestAddCAASUnitWithScale(c *tc.C) {
+	appUUID, unitUUIDs := s.createCAASApplicationWithNUnits(c, "foo", life.Alive, 1)
+	poolUUID := s.insertStoragePool(c)
+
+	// The storage is left behind by a previous unit.
+	storageUUID := s.insertStorageInstance(c, poolUUID, "foo", "pgdata/0")
+	_, err := s.DB().Exec(`
+INSERT INTO storage_unit_owner (storage_instance_uuid, unit_uuid) VALUES (?, ?)`,
+		storageUUID, unitUUIDs[0])
+	c.Assert(err, tc.ErrorIsNil)
+
+	unitName, scale, err := s.state.AddCAASUnitWithScale(c.Context(), appUUID, application.AddCAASUnitArg{
+		AddUnitArg: application.AddUnitArg{
+			CreateUnitStorageArg: application.CreateUnitStorageArg{
+				StorageToAttach: []application.CreateStorageAttachmentArg{{
+					UUID:                tc.Must(c, domainstorageprov.NewStorageAttachmentUUID),
+					StorageInstanceUUID: storageUUID,
+				}},
+				StorageToOwn: []domainstorage.StorageInstanceUUID{storageUUID},
+			},
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(unitName, tc.Equals, coreunit.Name("foo/1"))
+	c.Check(scale, tc.Equals, 2)
+
+	unitUUID, err := s.state.GetUnitUUIDByName(c.Context(), unitName)
+	c.Assert(err, tc.ErrorIsNil)
+
+	var ownerUUID, attachedUUID string
+	err = s.DB().QueryRow(`
+SELECT unit_uuid FROM storage_unit_owner WHERE storage_instance_uuid = ?`,
+		storageUUID).Scan(&ownerUUID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(ownerUUID, tc.Equals, unitUUID.String())
+	err = s.DB().QueryRow(`
+SELECT unit_uuid FROM storage_attachment WHERE storage_instance_uuid = ?`,
+		storageUUID).Scan(&attachedUUID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(attachedUUID, tc.Equals, unitUUID.String())
+
+	scaleState, err := s.state.GetApplicationScaleState(c.Context(), appUUID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(scaleState.Scale, tc.Equals, 2)
+}
+
+func (s *caasStorageSuite) TestAddCAASUnitWithScaleStorageAttached(c *tc.C) {
+	appUUID, unitUUIDs := s.createCAASApplicationWithNUnits(c, "foo", life.Alive, 1)
+	poolUUID := s.insertStoragePool(c)
+
+	storageUUID := s.insertStorageInstance(c, poolUUID, "foo", "pgdata/0")
+	_, err := s.DB().Exec(`
+INSERT INTO storage_attachment (uuid, storage_instance_uuid, unit_uuid, life_id)
+VALUES (?, ?, ?, 0)`,
+		storagetesting.GenStorageInstanceUUID(c).String(), storageUUID, unitUUIDs[0])
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, _, err = s.state.AddCAASUnitWithScale(c.Context(), appUUID, application.AddCAASUnitArg{
+		AddUnitArg: application.AddUnitArg{
+			CreateUnitStorageArg: application.CreateUnitStorageArg{
+				StorageToOwn: []domainstorage.StorageInstanceUUID{storageUUID},
+			},
+		},
+	})
+	c.Assert(err, tc.ErrorIs, applicationerrors.StorageAlreadyAttached)
+}
+
+func (s *caasStorageSuite) TestAddCAASUnitWithScaleApplicationNotAlive(c *tc.C) {
+	appUUID, _ := s.createCAASApplicationWithNUnits(c, "foo", life.Dying, 1)
+
+	_, _, err := s.state.AddCAASUnitWithScale(c.Context(), appUUID, application.AddCAASUnitArg{})
+	c.Assert(err, tc.ErrorIs, applicationerrors.ApplicationNotAlive)
+}
@@ -4,7 +4,10 @@
 package state
 
 import (
+	"database/sql"
 	"time"
+
+	"github.com/juju/juju/domain/life"
 )
 
 // applicationStorageDirective is used to represent the values held in the
@@ -16,6 +19,17 @@ type applicationStorageDirective struct {
 	SizeMiB          uint64 `db:"size_mib"`
 	StorageName      string `db:"storage_name"`
 	StoragePoolUUID  string `db:"storage_pool_uuid"`
+	Shared           bool   `db:"shared"`
+}
+
+// updateApplicationStorageDirective represents the set of values required for
+// updating an existing storage directive of an application.
+type updateApplicationStorageDirective struct {
+	ApplicationUUID string `db:"application_uuid"`
+	Count           uint32 `db:"count"`
+	SizeMiB         uint64 `db:"size_mib"`
+	StorageName     string `db:"storage_name"`
+	StoragePoolUUID string `db:"storage_pool_uuid"`
 }
 
 // insertStorageFilesystem represents the set of values required for inserting a
@@ -174,3 +188,24 @@ type storageProvisioners struct {
 	StorageType     string `db:"type"`
 	StoragePoolUUID string `db:"uuid"`
 }
+
+// attachableStorageInstance is used to fetch the details of an existing
+// storage instance being attached to a new unit.
+type attachableStorageInstance struct {
+	UUID                 string         `db:"uuid"`
+	StorageID            string         `db:"storage_id"`
+	StorageName          string         `db:"storage_name"`
+	StoragePoolUUID      string         `db:"storage_pool_uuid"`
+	CharmName            sql.NullString `db:"charm_name"`
+	LifeID               life.Life      `db:"life_id"`
+	OwnerApplicationUUID sql.NullString `db:"owner_application_uuid"`
+	AttachmentCount      int            `db:"attachment_count"`
+}
+
+// pendingCAASUnitFilesystem is used to fetch the provisioned filesystems
+// owned by CAAS units that have not yet been started by the provider.
+type pendingCAASUnitFilesystem struct {
+	UnitName    string `db:"unit_name"`
+	StorageName string `db:"storage_name"`
+	ProviderID  string `db:"provider_id"`
+}
@@ -806,6 +806,9 @@ type applicationConstraint struct {
 	VirtType         sql.NullString  `db:"virt_type"`
 	AllocatePublicIP sql.NullBool    `db:"allocate_public_ip"`
 	ImageID          sql.NullString  `db:"image_id"`
+	AffinityGroup    sql.NullString  `db:"affinity_group"`
+	AntiAffinity     sql.NullBool    `db:"anti_affinity"`
+	Accelerators     sql.NullString  `db:"accelerators"`
 	SpaceName        sql.NullString  `db:"space_name"`
 	SpaceExclude     sql.NullBool    `db:"space_exclude"`
 	Tag              sql.NullString  `db:"tag"`
@@ -846,6 +849,9 @@ type setConstraint struct {
 	VirtType         *string `db:"virt_type"`
 	AllocatePublicIP *bool   `db:"allocate_public_ip"`
 	ImageID          *string `db:"image_id"`
+	AffinityGroup    *string `db:"affinity_group"`
+	AntiAffinity     *bool   `db:"anti_affinity"`
+	Accelerators     *string `db:"accelerators"`
 }
 
 type containerTypeID struct {
@@ -953,6 +959,9 @@ type dbConstraint struct {
 	VirtType         sql.NullString  `db:"virt_type"`
 	AllocatePublicIP sql.NullBool    `db:"allocate_public_ip"`
 	ImageID          sql.NullString  `db:"image_id"`
+	AffinityGroup    sql.NullString  `db:"affinity_group"`
+	AntiAffinity     sql.NullBool    `db:"anti_affinity"`
+	Accelerators     sql.NullString  `db:"accelerators"`
 }
 
 func (c dbConstraint) toValue(
@@ -997,6 +1006,15 @@ func (c dbConstraint) toValue(
 	if c.ImageID.Valid {
 		rval.ImageID = &c.ImageID.String
 	}
+	if c.AffinityGroup.Valid {
+		rval.AffinityGroup = &c.AffinityGroup.String
+	}
+	if c.AntiAffinity.Valid {
+		rval.AntiAffinity = &c.AntiAffinity.Bool
+	}
+	if c.Accelerators.Valid {
+		rval.Accelerators = &c.Accelerators.String
+	}
 	if c.ContainerType.Valid {
 		containerType := instance.ContainerType(c.ContainerType.String)
 		rval.Container = &containerType
@@ -1260,6 +1278,13 @@ type insertStorageUnitOwner struct {
 	UnitUUID            string `db:"unit_uuid"`
 }
 
+// insertStorageApplicationOwner represents the set of values required for
+// creating a new storage_application_owner record.
+type insertStorageApplicationOwner struct {
+	StorageInstanceUUID string `db:"storage_instance_uuid"`
+	ApplicationUUID     string `db:"application_uuid"`
+}
+
 // insertUnitStorageDirective represents the set of values required for
 // inserting a new unit storage directive.
 type insertUnitStorageDirective struct {
@@ -1277,3 +1302,41 @@ type bindingToTable struct {
 	UUID        string       `db:"uuid"`
 	BindingType bindingTable `db:"binding_type"`
 }
+
+// applicationRefreshPolicy is used to get and set the charm refresh policy of
+// an application.
+type applicationRefreshPolicy struct {
+	ApplicationUUID   coreapplication.ID `db:"application_uuid"`
+	PolicyID          int                `db:"policy_id"`
+	WindowStartMinute sql.Null[int64]    `db:"window_start_minute"`
+	WindowEndMinute   sql.Null[int64]    `db:"window_end_minute"`
+}
+
+// applicationRefreshRevision is used to record the charm revision an
+// application was last notified of, or failed to be refreshed to.
+type applicationRefreshRevision struct {
+	ApplicationUUID coreapplication.ID `db:"application_uuid"`
+	Revision        int                `db:"revision"`
+}
+
+// refreshCandidate is used to get the applications for which a newer charm
+// revision is pending.
+type charmChannel struct {
+	CharmUUID corecharm.ID `db:"charm_uuid"`
+	Track     string       `db:"track"`
+	Risk      string       `db:"risk"`
+	Branch    string       `db:"branch"`
+}
+
+type refreshCandidate struct {
+	ApplicationName   string          `db:"application_name"`
+	PolicyID          int             `db:"policy_id"`
+	WindowStartMinute sql.Null[int64] `db:"window_start_minute"`
+	WindowEndMinute   sql.Null[int64] `db:"window_end_minute"`
+	ReferenceName     string          `db:"reference_name"`
+	ArchitectureID    sql.Null[int64] `db:"architecture_id"`
+	CurrentRevision   int             `db:"current_revision"`
+	LatestRevision    int             `db:"latest_revision"`
+	NotifiedRevision  sql.Null[int64] `db:"notified_revision"`
+	FailedRevision    sql.Null[int64] `db:"failed_revision"`
+}
@@ -649,6 +649,133 @@ func (st *State) AddCAASUnits(
 	return unitNames, errors.Capture(err)
 }
 
+// AddCAASUnitWithScale adds a single unit to the application, increasing the
+// scale of the application by one. The unit is named after the current scale
+// so that it matches the next ordinal of the application's stateful set.
+// Existing storage that the unit is to own is detached from any previous
+// owner.
+//   - If the unit already exists [applicationerrors.UnitAlreadyExists] is returned.
+//   - If storage to be owned is attached to another unit,
+//     [applicationerrors.StorageAlreadyAttached] is returned.
+//   - If the application is not alive, [applicationerrors.ApplicationNotAlive] is returned.
+//   - If the application is not found, [applicationerrors.ApplicationNotFound] is returned.
+func (st *State) AddCAASUnitWithScale(
+	ctx context.Context,
+	appUUID coreapplication.ID,
+	arg application.AddCAASUnitArg,
+) (coreunit.Name, int, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return "", -1, errors.Capture(err)
+	}
+
+	attachedStmt, err := st.Prepare(`
+SELECT &storageInstance.storage_id
+FROM   storage_instance si
+JOIN   storage_attachment sa ON sa.storage_instance_uuid = si.uuid
+WHERE  si.uuid = $storageInstance.uuid
+`, storageInstance{})
+	if err != nil {
+		return "", -1, errors.Capture(err)
+	}
+
+	deleteOwnerStmt, err := st.Prepare(`
+DELETE FROM storage_unit_owner
+WHERE  storage_instance_uuid = $storageInstance.uuid
+`, storageInstance{})
+	if err != nil {
+		return "", -1, errors.Capture(err)
+	}
+
+	updateScaleStmt, err := st.Prepare(`
+UPDATE application_scale SET scale = $applicationScale.scale
+WHERE application_uuid = $applicationScale.application_uuid
+`, applicationScale{})
+	if err != nil {
+		return "", -1, errors.Capture(err)
+	}
+
+	var (
+		unitName coreunit.Name
+		newScale int
+	)
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := st.checkApplicationAlive(ctx, tx, appUUID); err != nil {
+			return errors.Capture(err)
+		}
+
+		appName, err := st.getApplicationName(ctx, tx, appUUID)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		scaleState, err := st.getApplicationScaleState(ctx, tx, appUUID)
+		if err != nil {
+			return errors.Errorf("getting application %q scale: %w", appName, err)
+		}
+		unitName, err = coreunit.NewNameFromParts(appName, scaleState.Scale)
+		if err != nil {
+			return errors.Capture(err)
+		}
+
+		_, err = st.getLifeForUnitName(ctx, tx, unitName)
+		if err == nil {
+			return errors.Errorf(
+				"unit %q already exists", unitName,
+			).Add(applicationerrors.UnitAlreadyExists)
+		} else if !errors.Is(err, applicationerrors.UnitNotFound) {
+			return errors.Errorf("checking unit %q exists: %w", unitName, err)
+		}
+
+		for _, storageUUID := range arg.StorageToOwn {
+			input := storageInstance{StorageUUID: storageUUID}
+			var attached storageInstance
+			err := tx.Query(ctx, attachedStmt, input).Get(&attached)
+			if err == nil {
+				return errors.Errorf(
+					"storage %q is attached to another unit", attached.StorageID,
+				).Add(applicationerrors.StorageAlreadyAttached)
+			} else if !errors.Is(err, sqlair.ErrNoRows) {
+				return errors.Errorf(
+					"checking storage instance %q attachments: %w", storageUUID, err,
+				)
+			}
+
+			// Storage left behind by a unit that has been removed may still
+			// record its previous owner.
+			if err := tx.Query(ctx, deleteOwnerStmt, input).Run(); err != nil {
+				return errors.Errorf(
+					"removing previous owner of storage instance %q: %w",
+					storageUUID, err,
+				)
+			}
+		}
+
+		charmUUID, err := st.getCharmIDByApplicationID(ctx, tx, appUUID)
+		if err != nil {
+			return errors.Errorf("getting application %q charm uuid: %w", appUUID, err)
+		}
+		if _, err := st.insertCAASUnitWithName(
+			ctx, tx, appUUID, charmUUID, unitName, arg,
+		); err != nil {
+			return errors.Errorf("inserting unit %q: %w", unitName, err)
+		}
+
+		newScale = scaleState.Scale + 1
+		err = tx.Query(ctx, updateScaleStmt, applicationScale{
+			ApplicationID: appUUID,
+			Scale:         newScale,
+		}).Run()
+		if err != nil {
+			return errors.Errorf("updating application %q scale: %w", appName, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", -1, errors.Capture(err)
+	}
+	return unitName, newScale, nil
+}
+
 // AddIAASSubordinateUnit adds a unit to the specified subordinate application
 // to the IAAS application on the same machine as the given principal unit and
 // records the principal-subordinate relationship.
@@ -1392,6 +1519,15 @@ func (st *State) insertCAASUnitWithName(
 		)
 	}
 
+	err = st.attachApplicationSharedStorage(
+		ctx, tx, appUUID, unitUUID, domainnetwork.NetNodeUUID(netNodeUUID),
+	)
+	if err != nil {
+		return "", errors.Errorf(
+			"attaching shared storage to unit %q: %w", unitName, err,
+		)
+	}
+
 	return unitUUID, nil
 }
 
@@ -1504,6 +1640,15 @@ func (st *State) insertIAASUnit(
 		)
 	}
 
+	err = st.attachApplicationSharedStorage(
+		ctx, tx, appUUID, unitUUID, args.NetNodeUUID,
+	)
+	if err != nil {
+		return "", "", nil, errors.Errorf(
+			"attaching shared storage to unit %q: %w", unitName, err,
+		)
+	}
+
 	return unitName, unitUUID, machineNames, nil
 }
 
@@ -2413,7 +2558,10 @@ ON CONFLICT (uuid) DO UPDATE SET
     container_type_id = excluded.container_type_id,
     virt_type = excluded.virt_type,
     allocate_public_ip = excluded.allocate_public_ip,
-    image_id = excluded.image_id
+    image_id = excluded.image_id,
+    affinity_group = excluded.affinity_group,
+    anti_affinity = excluded.anti_affinity,
+    accelerators = excluded.accelerators
 `
 	insertConstraintsStmt, err := st.Prepare(insertConstraintsQuery, setConstraint{})
 	if err != nil {
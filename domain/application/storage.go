@@ -4,7 +4,10 @@
 package application
 
 import (
+	coreapplication "github.com/juju/juju/core/application"
+	coreunit "github.com/juju/juju/core/unit"
 	"github.com/juju/juju/domain/application/charm"
+	"github.com/juju/juju/domain/life"
 	domainstorage "github.com/juju/juju/domain/storage"
 	domainstorageprov "github.com/juju/juju/domain/storageprovisioning"
 )
@@ -53,6 +56,11 @@ type CreateUnitStorageInstanceArg struct {
 	UUID domainstorage.StorageInstanceUUID
 }
 
+// CreateApplicationSharedStorageArg describes a set of arguments that create a
+// new shared storage instance on behalf of an application. Shared storage is
+// owned by the application and attached to every unit of the application.
+type CreateApplicationSharedStorageArg = CreateUnitStorageInstanceArg
+
 // CreateUnitStorageVolumeArg describes a set of arguments for a volume
 // that should be created as part of a unit's storage.
 type CreateUnitStorageVolumeArg struct {
@@ -147,6 +155,29 @@ type StorageDirective struct {
 
 	// Size defines the size of the storage directive in MiB.
 	Size uint64
+
+	// Shared is true when the charm storage is shared between all units of
+	// the application. Shared storage instances are owned by the application
+	// and are not created for each unit.
+	Shared bool
+}
+
+// UpdateApplicationStorageDirectiveArg defines the new values for an existing
+// storage directive of an application. The directive applies to the storage of
+// units that are added to the application in the future.
+type UpdateApplicationStorageDirectiveArg struct {
+	// Count represents the number of storage instances that should be made for
+	// this directive.
+	Count uint32
+
+	// Name relates to the charm storage name definition and must match up.
+	Name domainstorage.Name
+
+	// PoolUUID defines the storage pool uuid to use for the directive.
+	PoolUUID domainstorage.StoragePoolUUID
+
+	// Size defines the size of the storage directive in MiB.
+	Size uint64
 }
 
 // CreateStorageDirectiveArg defines the arguments required to add a storage
@@ -167,3 +198,49 @@ type CreateStorageDirectiveArg struct {
 	// Size defines the size of the storage directive in MiB.
 	Size uint64
 }
+
+// AttachableStorageInstance describes an existing storage instance in the
+// model that has been asked to be attached to a new unit.
+type AttachableStorageInstance struct {
+	// UUID is the unique identifier of the storage instance.
+	UUID domainstorage.StorageInstanceUUID
+
+	// StorageID is the user facing id of the storage instance.
+	StorageID string
+
+	// StorageName is the charm storage name the instance was created for.
+	StorageName domainstorage.Name
+
+	// PoolUUID is the uuid of the storage pool the instance was provisioned
+	// from.
+	PoolUUID domainstorage.StoragePoolUUID
+
+	// CharmName is the name of the charm the storage instance has been used
+	// with. It is empty when the storage instance has never been attached.
+	CharmName string
+
+	// Life is the life of the storage instance.
+	Life life.Life
+
+	// OwnerApplicationUUID is the uuid of the application of the unit that
+	// owns the storage instance. It is empty when the storage instance is
+	// not owned by a unit.
+	OwnerApplicationUUID coreapplication.ID
+
+	// Attached is true when the storage instance is attached to a unit.
+	Attached bool
+}
+
+// PendingCAASUnitFilesystem describes a filesystem that exists in the provider
+// and is owned by a CAAS unit which the provider has yet to start. The
+// provider must bind the filesystem to the unit when it is started.
+type PendingCAASUnitFilesystem struct {
+	// UnitName is the name of the unit owning the filesystem.
+	UnitName coreunit.Name
+
+	// StorageName is the charm storage name of the filesystem.
+	StorageName domainstorage.Name
+
+	// ProviderID is the provider's identifier of the filesystem.
+	ProviderID string
+}
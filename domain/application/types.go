@@ -51,6 +51,9 @@ type BaseAddApplicationArg struct {
 	// StorageDirectives defines the list of storage directives to add to an
 	// application. The Name values must match the storage defined in the Charm.
 	StorageDirectives []CreateApplicationStorageDirectiveArg
+	// SharedStorage defines the shared storage instances to create for the
+	// application. Each instance is attached to every unit of the application.
+	SharedStorage []CreateApplicationSharedStorageArg
 	// Config contains the configuration for the application, overlaid on top
 	// of the charm's default configuration.
 	Config map[string]ApplicationConfig
@@ -44,6 +44,12 @@ const (
 	// CloudTypeVSphere represents the vSphere cloud provider.
 	CloudTypeVSphere
 
+	// CloudTypeLibvirt represents the libvirt/QEMU cloud provider.
+	CloudTypeLibvirt
+
+	// CloudTypeProxmox represents the Proxmox VE cloud provider.
+	CloudTypeProxmox
+
 	// cloudTypeInvalidHigh is a sentinel value used to indicate the upper
 	// invalid bounds for [CloudType] values.
 	//
@@ -88,6 +94,10 @@ func (ct CloudType) String() string {
 		return "openstack"
 	case CloudTypeVSphere:
 		return "vsphere"
+	case CloudTypeLibvirt:
+		return "libvirt"
+	case CloudTypeProxmox:
+		return "proxmox"
 	}
 	return ""
 }
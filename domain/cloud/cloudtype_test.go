@@ -66,6 +66,8 @@ func (s *cloudTypeSuite) TestCloudTypeDBValues(c *tc.C) {
 		CloudTypeOCI:        CloudTypeOCI.String(),
 		CloudTypeOpenStack:  CloudTypeOpenStack.String(),
 		CloudTypeVSphere:    CloudTypeVSphere.String(),
+		CloudTypeLibvirt:    CloudTypeLibvirt.String(),
+		CloudTypeProxmox:    CloudTypeProxmox.String(),
 	})
 }
 
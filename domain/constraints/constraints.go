@@ -82,6 +82,19 @@ type Constraints struct {
 	// image. This is provider specific, and for the moment is only
 	// implemented on MAAS clouds.
 	ImageID *string
+
+	// AffinityGroup, if not nil or empty, names a group of machines whose
+	// placement on the underlying hosts should be coordinated.
+	AffinityGroup *string
+
+	// AntiAffinity, if true, indicates that machines in the same affinity
+	// group must be spread across separate physical hosts.
+	AntiAffinity *bool
+
+	// Accelerators, if not nil or empty, indicates that a machine must have
+	// at least the specified number of accelerators, in the form
+	// <count>[:<type>].
+	Accelerators *string
 }
 
 // SpaceConstraint represents a single space constraint for an application.
@@ -112,6 +125,9 @@ func DecodeConstraints(coreCons constraints.Value) Constraints {
 		Zones:            coreCons.Zones,
 		AllocatePublicIP: coreCons.AllocatePublicIP,
 		ImageID:          coreCons.ImageID,
+		AffinityGroup:    coreCons.AffinityGroup,
+		AntiAffinity:     coreCons.AntiAffinity,
+		Accelerators:     coreCons.Accelerators,
 	}
 
 	if coreCons.Spaces == nil {
@@ -157,6 +173,9 @@ func EncodeConstraints(cons Constraints) constraints.Value {
 		Zones:            cons.Zones,
 		AllocatePublicIP: cons.AllocatePublicIP,
 		ImageID:          cons.ImageID,
+		AffinityGroup:    cons.AffinityGroup,
+		AntiAffinity:     cons.AntiAffinity,
+		Accelerators:     cons.Accelerators,
 	}
 
 	if cons.Spaces == nil {
@@ -13,4 +13,12 @@ const (
 	// OfferNotFound describes an error that occurs when the offer
 	// being operated on does not exist.
 	OfferNotFound = errors.ConstError("offer not found")
+
+	// OfferHasConnections describes an error that occurs when an offer
+	// cannot be removed because it still has connections.
+	OfferHasConnections = errors.ConstError("offer has connections")
+
+	// RemoteApplicationNotFound describes an error that occurs when the
+	// remote application being operated on does not exist.
+	RemoteApplicationNotFound = errors.ConstError("remote application not found")
 )
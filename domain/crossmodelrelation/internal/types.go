@@ -87,3 +87,20 @@ func (f OfferFilter) EmptyModuloEndpoints() bool {
 		f.ApplicationName == "" &&
 		f.ApplicationDescription == ""
 }
+
+// AddRemoteApplicationOffererArgs contains parameters used to add a remote
+// application offerer, along with the UUIDs of the synthetic application and
+// charm that represent it in the consuming model.
+type AddRemoteApplicationOffererArgs struct {
+	crossmodelrelation.AddRemoteApplicationOffererArgs
+
+	// RemoteApplicationUUID is the unique identifier of the new remote
+	// application offerer.
+	RemoteApplicationUUID string
+
+	// ApplicationUUID is the unique identifier of the synthetic application.
+	ApplicationUUID string
+
+	// CharmUUID is the unique identifier of the synthetic charm.
+	CharmUUID string
+}
@@ -13,11 +13,13 @@ import (
 	context "context"
 	reflect "reflect"
 
+	network "github.com/juju/juju/core/network"
 	user "github.com/juju/juju/core/user"
 	crossmodelrelation "github.com/juju/juju/domain/crossmodelrelation"
 	internal "github.com/juju/juju/domain/crossmodelrelation/internal"
 	uuid "github.com/juju/juju/internal/uuid"
 	gomock "go.uber.org/mock/gomock"
+	macaroon "gopkg.in/macaroon.v2"
 )
 
 // MockControllerDBState is a mock of ControllerDBState interface.
@@ -221,6 +223,87 @@ func (m *MockModelDBState) EXPECT() *MockModelDBStateMockRecorder {
 	return m.recorder
 }
 
+// AddRelationNetworkEgress mocks base method.
+func (m *MockModelDBState) AddRelationNetworkEgress(arg0 context.Context, arg1 string, arg2 ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddRelationNetworkEgress", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddRelationNetworkEgress indicates an expected call of AddRelationNetworkEgress.
+func (mr *MockModelDBStateMockRecorder) AddRelationNetworkEgress(arg0, arg1 any, arg2 ...any) *MockModelDBStateAddRelationNetworkEgressCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0, arg1}, arg2...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRelationNetworkEgress", reflect.TypeOf((*MockModelDBState)(nil).AddRelationNetworkEgress), varargs...)
+	return &MockModelDBStateAddRelationNetworkEgressCall{Call: call}
+}
+
+// MockModelDBStateAddRelationNetworkEgressCall wrap *gomock.Call
+type MockModelDBStateAddRelationNetworkEgressCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelDBStateAddRelationNetworkEgressCall) Return(arg0 error) *MockModelDBStateAddRelationNetworkEgressCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelDBStateAddRelationNetworkEgressCall) Do(f func(context.Context, string, ...string) error) *MockModelDBStateAddRelationNetworkEgressCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelDBStateAddRelationNetworkEgressCall) DoAndReturn(f func(context.Context, string, ...string) error) *MockModelDBStateAddRelationNetworkEgressCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// AddRemoteApplicationOfferer mocks base method.
+func (m *MockModelDBState) AddRemoteApplicationOfferer(arg0 context.Context, arg1 string, arg2 internal.AddRemoteApplicationOffererArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRemoteApplicationOfferer", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddRemoteApplicationOfferer indicates an expected call of AddRemoteApplicationOfferer.
+func (mr *MockModelDBStateMockRecorder) AddRemoteApplicationOfferer(arg0, arg1, arg2 any) *MockModelDBStateAddRemoteApplicationOffererCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRemoteApplicationOfferer", reflect.TypeOf((*MockModelDBState)(nil).AddRemoteApplicationOfferer), arg0, arg1, arg2)
+	return &MockModelDBStateAddRemoteApplicationOffererCall{Call: call}
+}
+
+// MockModelDBStateAddRemoteApplicationOffererCall wrap *gomock.Call
+type MockModelDBStateAddRemoteApplicationOffererCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelDBStateAddRemoteApplicationOffererCall) Return(arg0 error) *MockModelDBStateAddRemoteApplicationOffererCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelDBStateAddRemoteApplicationOffererCall) Do(f func(context.Context, string, internal.AddRemoteApplicationOffererArgs) error) *MockModelDBStateAddRemoteApplicationOffererCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelDBStateAddRemoteApplicationOffererCall) DoAndReturn(f func(context.Context, string, internal.AddRemoteApplicationOffererArgs) error) *MockModelDBStateAddRemoteApplicationOffererCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreateOffer mocks base method.
 func (m *MockModelDBState) CreateOffer(arg0 context.Context, arg1 internal.CreateOfferArgs) error {
 	m.ctrl.T.Helper()
@@ -297,6 +380,84 @@ func (c *MockModelDBStateDeleteFailedOfferCall) DoAndReturn(f func(context.Conte
 	return c
 }
 
+// GetMacaroonForRelation mocks base method.
+func (m *MockModelDBState) GetMacaroonForRelation(arg0 context.Context, arg1 string) (*macaroon.Macaroon, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMacaroonForRelation", arg0, arg1)
+	ret0, _ := ret[0].(*macaroon.Macaroon)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMacaroonForRelation indicates an expected call of GetMacaroonForRelation.
+func (mr *MockModelDBStateMockRecorder) GetMacaroonForRelation(arg0, arg1 any) *MockModelDBStateGetMacaroonForRelationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMacaroonForRelation", reflect.TypeOf((*MockModelDBState)(nil).GetMacaroonForRelation), arg0, arg1)
+	return &MockModelDBStateGetMacaroonForRelationCall{Call: call}
+}
+
+// MockModelDBStateGetMacaroonForRelationCall wrap *gomock.Call
+type MockModelDBStateGetMacaroonForRelationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelDBStateGetMacaroonForRelationCall) Return(arg0 *macaroon.Macaroon, arg1 error) *MockModelDBStateGetMacaroonForRelationCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelDBStateGetMacaroonForRelationCall) Do(f func(context.Context, string) (*macaroon.Macaroon, error)) *MockModelDBStateGetMacaroonForRelationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelDBStateGetMacaroonForRelationCall) DoAndReturn(f func(context.Context, string) (*macaroon.Macaroon, error)) *MockModelDBStateGetMacaroonForRelationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetModelEgressSubnets mocks base method.
+func (m *MockModelDBState) GetModelEgressSubnets(arg0 context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModelEgressSubnets", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModelEgressSubnets indicates an expected call of GetModelEgressSubnets.
+func (mr *MockModelDBStateMockRecorder) GetModelEgressSubnets(arg0 any) *MockModelDBStateGetModelEgressSubnetsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModelEgressSubnets", reflect.TypeOf((*MockModelDBState)(nil).GetModelEgressSubnets), arg0)
+	return &MockModelDBStateGetModelEgressSubnetsCall{Call: call}
+}
+
+// MockModelDBStateGetModelEgressSubnetsCall wrap *gomock.Call
+type MockModelDBStateGetModelEgressSubnetsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelDBStateGetModelEgressSubnetsCall) Return(arg0 []string, arg1 error) *MockModelDBStateGetModelEgressSubnetsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelDBStateGetModelEgressSubnetsCall) Do(f func(context.Context) ([]string, error)) *MockModelDBStateGetModelEgressSubnetsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelDBStateGetModelEgressSubnetsCall) DoAndReturn(f func(context.Context) ([]string, error)) *MockModelDBStateGetModelEgressSubnetsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetOfferDetails mocks base method.
 func (m *MockModelDBState) GetOfferDetails(arg0 context.Context, arg1 internal.OfferFilter) ([]*crossmodelrelation.OfferDetail, error) {
 	m.ctrl.T.Helper()
@@ -375,6 +536,393 @@ func (c *MockModelDBStateGetOfferUUIDCall) DoAndReturn(f func(context.Context, s
 	return c
 }
 
+// GetRelationNetworkEgress mocks base method.
+func (m *MockModelDBState) GetRelationNetworkEgress(arg0 context.Context, arg1 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRelationNetworkEgress", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRelationNetworkEgress indicates an expected call of GetRelationNetworkEgress.
+func (mr *MockModelDBStateMockRecorder) GetRelationNetworkEgress(arg0, arg1 any) *MockModelDBStateGetRelationNetworkEgressCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelationNetworkEgress", reflect.TypeOf((*MockModelDBState)(nil).GetRelationNetworkEgress), arg0, arg1)
+	return &MockModelDBStateGetRelationNetworkEgressCall{Call: call}
+}
+
+// MockModelDBStateGetRelationNetworkEgressCall wrap *gomock.Call
+type MockModelDBStateGetRelationNetworkEgressCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelDBStateGetRelationNetworkEgressCall) Return(arg0 []string, arg1 error) *MockModelDBStateGetRelationNetworkEgressCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelDBStateGetRelationNetworkEgressCall) Do(f func(context.Context, string) ([]string, error)) *MockModelDBStateGetRelationNetworkEgressCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelDBStateGetRelationNetworkEgressCall) DoAndReturn(f func(context.Context, string) ([]string, error)) *MockModelDBStateGetRelationNetworkEgressCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetRelationNetworkIngress mocks base method.
+func (m *MockModelDBState) GetRelationNetworkIngress(arg0 context.Context, arg1 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRelationNetworkIngress", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRelationNetworkIngress indicates an expected call of GetRelationNetworkIngress.
+func (mr *MockModelDBStateMockRecorder) GetRelationNetworkIngress(arg0, arg1 any) *MockModelDBStateGetRelationNetworkIngressCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelationNetworkIngress", reflect.TypeOf((*MockModelDBState)(nil).GetRelationNetworkIngress), arg0, arg1)
+	return &MockModelDBStateGetRelationNetworkIngressCall{Call: call}
+}
+
+// MockModelDBStateGetRelationNetworkIngressCall wrap *gomock.Call
+type MockModelDBStateGetRelationNetworkIngressCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelDBStateGetRelationNetworkIngressCall) Return(arg0 []string, arg1 error) *MockModelDBStateGetRelationNetworkIngressCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelDBStateGetRelationNetworkIngressCall) Do(f func(context.Context, string) ([]string, error)) *MockModelDBStateGetRelationNetworkIngressCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelDBStateGetRelationNetworkIngressCall) DoAndReturn(f func(context.Context, string) ([]string, error)) *MockModelDBStateGetRelationNetworkIngressCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetRelationUnitAddresses mocks base method.
+func (m *MockModelDBState) GetRelationUnitAddresses(arg0 context.Context, arg1 string) (map[string]network.SpaceAddresses, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRelationUnitAddresses", arg0, arg1)
+	ret0, _ := ret[0].(map[string]network.SpaceAddresses)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRelationUnitAddresses indicates an expected call of GetRelationUnitAddresses.
+func (mr *MockModelDBStateMockRecorder) GetRelationUnitAddresses(arg0, arg1 any) *MockModelDBStateGetRelationUnitAddressesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelationUnitAddresses", reflect.TypeOf((*MockModelDBState)(nil).GetRelationUnitAddresses), arg0, arg1)
+	return &MockModelDBStateGetRelationUnitAddressesCall{Call: call}
+}
+
+// MockModelDBStateGetRelationUnitAddressesCall wrap *gomock.Call
+type MockModelDBStateGetRelationUnitAddressesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelDBStateGetRelationUnitAddressesCall) Return(arg0 map[string]network.SpaceAddresses, arg1 error) *MockModelDBStateGetRelationUnitAddressesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelDBStateGetRelationUnitAddressesCall) Do(f func(context.Context, string) (map[string]network.SpaceAddresses, error)) *MockModelDBStateGetRelationUnitAddressesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelDBStateGetRelationUnitAddressesCall) DoAndReturn(f func(context.Context, string) (map[string]network.SpaceAddresses, error)) *MockModelDBStateGetRelationUnitAddressesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetRemoteApplicationConsumers mocks base method.
+func (m *MockModelDBState) GetRemoteApplicationConsumers(arg0 context.Context) ([]crossmodelrelation.RemoteApplicationConsumer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRemoteApplicationConsumers", arg0)
+	ret0, _ := ret[0].([]crossmodelrelation.RemoteApplicationConsumer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRemoteApplicationConsumers indicates an expected call of GetRemoteApplicationConsumers.
+func (mr *MockModelDBStateMockRecorder) GetRemoteApplicationConsumers(arg0 any) *MockModelDBStateGetRemoteApplicationConsumersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRemoteApplicationConsumers", reflect.TypeOf((*MockModelDBState)(nil).GetRemoteApplicationConsumers), arg0)
+	return &MockModelDBStateGetRemoteApplicationConsumersCall{Call: call}
+}
+
+// MockModelDBStateGetRemoteApplicationConsumersCall wrap *gomock.Call
+type MockModelDBStateGetRemoteApplicationConsumersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelDBStateGetRemoteApplicationConsumersCall) Return(arg0 []crossmodelrelation.RemoteApplicationConsumer, arg1 error) *MockModelDBStateGetRemoteApplicationConsumersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelDBStateGetRemoteApplicationConsumersCall) Do(f func(context.Context) ([]crossmodelrelation.RemoteApplicationConsumer, error)) *MockModelDBStateGetRemoteApplicationConsumersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelDBStateGetRemoteApplicationConsumersCall) DoAndReturn(f func(context.Context) ([]crossmodelrelation.RemoteApplicationConsumer, error)) *MockModelDBStateGetRemoteApplicationConsumersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetRemoteApplicationOfferers mocks base method.
+func (m *MockModelDBState) GetRemoteApplicationOfferers(arg0 context.Context) ([]crossmodelrelation.RemoteApplicationOfferer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRemoteApplicationOfferers", arg0)
+	ret0, _ := ret[0].([]crossmodelrelation.RemoteApplicationOfferer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRemoteApplicationOfferers indicates an expected call of GetRemoteApplicationOfferers.
+func (mr *MockModelDBStateMockRecorder) GetRemoteApplicationOfferers(arg0 any) *MockModelDBStateGetRemoteApplicationOfferersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRemoteApplicationOfferers", reflect.TypeOf((*MockModelDBState)(nil).GetRemoteApplicationOfferers), arg0)
+	return &MockModelDBStateGetRemoteApplicationOfferersCall{Call: call}
+}
+
+// MockModelDBStateGetRemoteApplicationOfferersCall wrap *gomock.Call
+type MockModelDBStateGetRemoteApplicationOfferersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelDBStateGetRemoteApplicationOfferersCall) Return(arg0 []crossmodelrelation.RemoteApplicationOfferer, arg1 error) *MockModelDBStateGetRemoteApplicationOfferersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelDBStateGetRemoteApplicationOfferersCall) Do(f func(context.Context) ([]crossmodelrelation.RemoteApplicationOfferer, error)) *MockModelDBStateGetRemoteApplicationOfferersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelDBStateGetRemoteApplicationOfferersCall) DoAndReturn(f func(context.Context) ([]crossmodelrelation.RemoteApplicationOfferer, error)) *MockModelDBStateGetRemoteApplicationOfferersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// NamespaceForWatchRelationNetworkEgress mocks base method.
+func (m *MockModelDBState) NamespaceForWatchRelationNetworkEgress() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NamespaceForWatchRelationNetworkEgress")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// NamespaceForWatchRelationNetworkEgress indicates an expected call of NamespaceForWatchRelationNetworkEgress.
+func (mr *MockModelDBStateMockRecorder) NamespaceForWatchRelationNetworkEgress() *MockModelDBStateNamespaceForWatchRelationNetworkEgressCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NamespaceForWatchRelationNetworkEgress", reflect.TypeOf((*MockModelDBState)(nil).NamespaceForWatchRelationNetworkEgress))
+	return &MockModelDBStateNamespaceForWatchRelationNetworkEgressCall{Call: call}
+}
+
+// MockModelDBStateNamespaceForWatchRelationNetworkEgressCall wrap *gomock.Call
+type MockModelDBStateNamespaceForWatchRelationNetworkEgressCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelDBStateNamespaceForWatchRelationNetworkEgressCall) Return(arg0 string) *MockModelDBStateNamespaceForWatchRelationNetworkEgressCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelDBStateNamespaceForWatchRelationNetworkEgressCall) Do(f func() string) *MockModelDBStateNamespaceForWatchRelationNetworkEgressCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelDBStateNamespaceForWatchRelationNetworkEgressCall) DoAndReturn(f func() string) *MockModelDBStateNamespaceForWatchRelationNetworkEgressCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// NamespaceForWatchRelationNetworkIngress mocks base method.
+func (m *MockModelDBState) NamespaceForWatchRelationNetworkIngress() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NamespaceForWatchRelationNetworkIngress")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// NamespaceForWatchRelationNetworkIngress indicates an expected call of NamespaceForWatchRelationNetworkIngress.
+func (mr *MockModelDBStateMockRecorder) NamespaceForWatchRelationNetworkIngress() *MockModelDBStateNamespaceForWatchRelationNetworkIngressCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NamespaceForWatchRelationNetworkIngress", reflect.TypeOf((*MockModelDBState)(nil).NamespaceForWatchRelationNetworkIngress))
+	return &MockModelDBStateNamespaceForWatchRelationNetworkIngressCall{Call: call}
+}
+
+// MockModelDBStateNamespaceForWatchRelationNetworkIngressCall wrap *gomock.Call
+type MockModelDBStateNamespaceForWatchRelationNetworkIngressCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelDBStateNamespaceForWatchRelationNetworkIngressCall) Return(arg0 string) *MockModelDBStateNamespaceForWatchRelationNetworkIngressCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelDBStateNamespaceForWatchRelationNetworkIngressCall) Do(f func() string) *MockModelDBStateNamespaceForWatchRelationNetworkIngressCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelDBStateNamespaceForWatchRelationNetworkIngressCall) DoAndReturn(f func() string) *MockModelDBStateNamespaceForWatchRelationNetworkIngressCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// NamespaceForWatchRemoteApplicationConsumers mocks base method.
+func (m *MockModelDBState) NamespaceForWatchRemoteApplicationConsumers() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NamespaceForWatchRemoteApplicationConsumers")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// NamespaceForWatchRemoteApplicationConsumers indicates an expected call of NamespaceForWatchRemoteApplicationConsumers.
+func (mr *MockModelDBStateMockRecorder) NamespaceForWatchRemoteApplicationConsumers() *MockModelDBStateNamespaceForWatchRemoteApplicationConsumersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NamespaceForWatchRemoteApplicationConsumers", reflect.TypeOf((*MockModelDBState)(nil).NamespaceForWatchRemoteApplicationConsumers))
+	return &MockModelDBStateNamespaceForWatchRemoteApplicationConsumersCall{Call: call}
+}
+
+// MockModelDBStateNamespaceForWatchRemoteApplicationConsumersCall wrap *gomock.Call
+type MockModelDBStateNamespaceForWatchRemoteApplicationConsumersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelDBStateNamespaceForWatchRemoteApplicationConsumersCall) Return(arg0 string) *MockModelDBStateNamespaceForWatchRemoteApplicationConsumersCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelDBStateNamespaceForWatchRemoteApplicationConsumersCall) Do(f func() string) *MockModelDBStateNamespaceForWatchRemoteApplicationConsumersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelDBStateNamespaceForWatchRemoteApplicationConsumersCall) DoAndReturn(f func() string) *MockModelDBStateNamespaceForWatchRemoteApplicationConsumersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// NamespaceForWatchRemoteApplicationOfferers mocks base method.
+func (m *MockModelDBState) NamespaceForWatchRemoteApplicationOfferers() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NamespaceForWatchRemoteApplicationOfferers")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// NamespaceForWatchRemoteApplicationOfferers indicates an expected call of NamespaceForWatchRemoteApplicationOfferers.
+func (mr *MockModelDBStateMockRecorder) NamespaceForWatchRemoteApplicationOfferers() *MockModelDBStateNamespaceForWatchRemoteApplicationOfferersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NamespaceForWatchRemoteApplicationOfferers", reflect.TypeOf((*MockModelDBState)(nil).NamespaceForWatchRemoteApplicationOfferers))
+	return &MockModelDBStateNamespaceForWatchRemoteApplicationOfferersCall{Call: call}
+}
+
+// MockModelDBStateNamespaceForWatchRemoteApplicationOfferersCall wrap *gomock.Call
+type MockModelDBStateNamespaceForWatchRemoteApplicationOfferersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelDBStateNamespaceForWatchRemoteApplicationOfferersCall) Return(arg0 string) *MockModelDBStateNamespaceForWatchRemoteApplicationOfferersCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelDBStateNamespaceForWatchRemoteApplicationOfferersCall) Do(f func() string) *MockModelDBStateNamespaceForWatchRemoteApplicationOfferersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelDBStateNamespaceForWatchRemoteApplicationOfferersCall) DoAndReturn(f func() string) *MockModelDBStateNamespaceForWatchRemoteApplicationOfferersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// NamespacesForWatchRelationEgressFallback mocks base method.
+func (m *MockModelDBState) NamespacesForWatchRelationEgressFallback() (string, string, string) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NamespacesForWatchRelationEgressFallback")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(string)
+	return ret0, ret1, ret2
+}
+
+// NamespacesForWatchRelationEgressFallback indicates an expected call of NamespacesForWatchRelationEgressFallback.
+func (mr *MockModelDBStateMockRecorder) NamespacesForWatchRelationEgressFallback() *MockModelDBStateNamespacesForWatchRelationEgressFallbackCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NamespacesForWatchRelationEgressFallback", reflect.TypeOf((*MockModelDBState)(nil).NamespacesForWatchRelationEgressFallback))
+	return &MockModelDBStateNamespacesForWatchRelationEgressFallbackCall{Call: call}
+}
+
+// MockModelDBStateNamespacesForWatchRelationEgressFallbackCall wrap *gomock.Call
+type MockModelDBStateNamespacesForWatchRelationEgressFallbackCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelDBStateNamespacesForWatchRelationEgressFallbackCall) Return(arg0, arg1, arg2 string) *MockModelDBStateNamespacesForWatchRelationEgressFallbackCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelDBStateNamespacesForWatchRelationEgressFallbackCall) Do(f func() (string, string, string)) *MockModelDBStateNamespacesForWatchRelationEgressFallbackCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelDBStateNamespacesForWatchRelationEgressFallbackCall) DoAndReturn(f func() (string, string, string)) *MockModelDBStateNamespacesForWatchRelationEgressFallbackCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateOffer mocks base method.
 func (m *MockModelDBState) UpdateOffer(arg0 context.Context, arg1 string, arg2 []string) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,111 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"net"
+	"slices"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	corenetwork "github.com/juju/juju/core/network"
+	corerelation "github.com/juju/juju/core/relation"
+	"github.com/juju/juju/core/trace"
+	"github.com/juju/juju/internal/errors"
+)
+
+// AddRelationNetworkEgress records the input CIDRs as the egress networks of
+// the relation with the input UUID. These are the networks from which
+// connections to the offering model will originate.
+// It returns an error satisfying:
+//   - [coreerrors.NotValid] if any of the CIDRs are not valid.
+//   - [relationerrors.RelationNotFound] if the relation does not exist.
+func (s *Service) AddRelationNetworkEgress(
+	ctx context.Context,
+	relationUUID corerelation.UUID,
+	cidrs ...string,
+) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := relationUUID.Validate(); err != nil {
+		return errors.Errorf("relation uuid: %w", err).Add(coreerrors.NotValid)
+	}
+	for _, cidr := range cidrs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return errors.Errorf("egress network %q: %w", cidr, err).Add(coreerrors.NotValid)
+		}
+	}
+	return s.modelState.AddRelationNetworkEgress(ctx, relationUUID.String(), cidrs...)
+}
+
+// GetRelationNetworkEgress returns the egress networks of the relation with
+// the input UUID. These are the networks recorded against the relation if
+// there are any, otherwise the egress-subnets of the model if set, otherwise
+// the addresses of the local units in scope of the relation.
+func (s *Service) GetRelationNetworkEgress(
+	ctx context.Context,
+	relationUUID corerelation.UUID,
+) ([]string, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := relationUUID.Validate(); err != nil {
+		return nil, errors.Errorf("relation uuid: %w", err).Add(coreerrors.NotValid)
+	}
+	return getRelationNetworkEgress(ctx, s.modelState, relationUUID.String())
+}
+
+func getRelationNetworkEgress(ctx context.Context, st ModelDBState, relationUUID string) ([]string, error) {
+	cidrs, err := st.GetRelationNetworkEgress(ctx, relationUUID)
+	if err != nil {
+		return nil, errors.Capture(err)
+	} else if len(cidrs) > 0 {
+		return sorted(cidrs), nil
+	}
+
+	cidrs, err = st.GetModelEgressSubnets(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	} else if len(cidrs) > 0 {
+		return sorted(cidrs), nil
+	}
+
+	unitAddresses, err := st.GetRelationUnitAddresses(ctx, relationUUID)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	for _, addresses := range unitAddresses {
+		addr, ok := addresses.OneMatchingScope(corenetwork.ScopeMatchPublic)
+		if !ok {
+			continue
+		}
+		cidr, err := hostCIDR(addr.Value)
+		if err != nil {
+			return nil, errors.Capture(err)
+		}
+		if !slices.Contains(cidrs, cidr) {
+			cidrs = append(cidrs, cidr)
+		}
+	}
+	return sorted(cidrs), nil
+}
+
+// hostCIDR returns the single host CIDR of the input IP address.
+func hostCIDR(address string) (string, error) {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return "", errors.Errorf("invalid unit address %q", address).Add(coreerrors.NotValid)
+	}
+	bits := 128
+	if ip.To4() != nil {
+		bits = 32
+	}
+	return (&net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}).String(), nil
+}
+
+func sorted(cidrs []string) []string {
+	slices.Sort(cidrs)
+	return cidrs
+}
@@ -6,18 +6,98 @@ package service
 import (
 	"context"
 
-	"github.com/juju/juju/core/errors"
+	"github.com/juju/names/v6"
+	"gopkg.in/macaroon.v2"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	corerelation "github.com/juju/juju/core/relation"
+	"github.com/juju/juju/core/trace"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
 	"github.com/juju/juju/domain/crossmodelrelation"
+	"github.com/juju/juju/domain/crossmodelrelation/internal"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/uuid"
 )
 
+// AddRemoteApplicationOfferer adds a new synthetic application representing
+// an offer from an external model, to be consumed by this model. The
+// application has no units, and its charm only describes the endpoints of
+// the offer.
+// It returns an error satisfying:
+//   - [applicationerrors.ApplicationNameNotValid] if the name is not valid.
+//   - [applicationerrors.ApplicationAlreadyExists] if an application with the
+//     same name already exists.
+//   - [coreerrors.NotValid] if the arguments are not valid.
+func (s *Service) AddRemoteApplicationOfferer(
+	ctx context.Context,
+	applicationName string,
+	args crossmodelrelation.AddRemoteApplicationOffererArgs,
+) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if !names.IsValidApplication(applicationName) {
+		return errors.Errorf("application name %q", applicationName).Add(applicationerrors.ApplicationNameNotValid)
+	}
+	if err := args.Validate(); err != nil {
+		return errors.Capture(err)
+	}
+
+	remoteApplicationUUID, err := uuid.NewUUID()
+	if err != nil {
+		return errors.Capture(err)
+	}
+	applicationUUID, err := uuid.NewUUID()
+	if err != nil {
+		return errors.Capture(err)
+	}
+	charmUUID, err := uuid.NewUUID()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	if err := s.modelState.AddRemoteApplicationOfferer(ctx, applicationName, internal.AddRemoteApplicationOffererArgs{
+		AddRemoteApplicationOffererArgs: args,
+		RemoteApplicationUUID:           remoteApplicationUUID.String(),
+		ApplicationUUID:                 applicationUUID.String(),
+		CharmUUID:                       charmUUID.String(),
+	}); err != nil {
+		return errors.Errorf("adding remote application offerer %q: %w", applicationName, err)
+	}
+	return nil
+}
+
 // GetRemoteApplicationConsumers returns the current state of all remote
-// application consumers in the local model.
-func (s *Service) GetRemoteApplicationConsumers(context.Context) ([]crossmodelrelation.RemoteApplicationConsumer, error) {
-	return nil, errors.NotImplemented
+// application consumers in the local model. These are the synthetic
+// applications representing offers consumed from other models.
+func (s *Service) GetRemoteApplicationConsumers(ctx context.Context) ([]crossmodelrelation.RemoteApplicationConsumer, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	return s.modelState.GetRemoteApplicationConsumers(ctx)
 }
 
-// GetRemoteApplicationOfferers returns all application proxies for offers
-// consumed in this model.
-func (s *Service) GetRemoteApplicationOfferers(context.Context) ([]crossmodelrelation.RemoteApplicationOfferer, error) {
-	return nil, errors.NotImplemented
+// GetRemoteApplicationOfferers returns the current state of all remote
+// application offerers in the local model. These are the applications in
+// other models consuming offers from this model.
+func (s *Service) GetRemoteApplicationOfferers(ctx context.Context) ([]crossmodelrelation.RemoteApplicationOfferer, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	return s.modelState.GetRemoteApplicationOfferers(ctx)
+}
+
+// GetMacaroonForRelation returns the macaroon used to authenticate with the
+// offering model, for the relation with the input UUID.
+// It returns an error satisfying
+// [crossmodelrelationerrors.RemoteApplicationNotFound] if the relation does
+// not involve a remote application offerer.
+func (s *Service) GetMacaroonForRelation(ctx context.Context, relationUUID corerelation.UUID) (*macaroon.Macaroon, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := relationUUID.Validate(); err != nil {
+		return nil, errors.Errorf("relation uuid: %w", err).Add(coreerrors.NotValid)
+	}
+	return s.modelState.GetMacaroonForRelation(ctx, relationUUID.String())
 }
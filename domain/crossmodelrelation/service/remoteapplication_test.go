@@ -0,0 +1,161 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+	"gopkg.in/macaroon.v2"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/network"
+	corerelation "github.com/juju/juju/core/relation"
+	"github.com/juju/juju/domain/application/charm"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/domain/crossmodelrelation"
+	"github.com/juju/juju/domain/crossmodelrelation/internal"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/uuid"
+)
+
+type remoteApplicationServiceSuite struct {
+	baseSuite
+}
+
+func TestRemoteApplicationServiceSuite(t *testing.T) {
+	tc.Run(t, &remoteApplicationServiceSuite{})
+}
+
+func (s *remoteApplicationServiceSuite) TestAddRemoteApplicationOfferer(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	args := s.addRemoteApplicationOffererArgs(c)
+
+	var received internal.AddRemoteApplicationOffererArgs
+	s.modelDBState.EXPECT().AddRemoteApplicationOfferer(gomock.Any(), "foo", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, a internal.AddRemoteApplicationOffererArgs) error {
+			received = a
+			return nil
+		})
+
+	err := s.service(c).AddRemoteApplicationOfferer(c.Context(), "foo", args)
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Check(received.AddRemoteApplicationOffererArgs, tc.DeepEquals, args)
+	c.Check(uuid.IsValidUUIDString(received.RemoteApplicationUUID), tc.IsTrue)
+	c.Check(uuid.IsValidUUIDString(received.ApplicationUUID), tc.IsTrue)
+	c.Check(uuid.IsValidUUIDString(received.CharmUUID), tc.IsTrue)
+}
+
+func (s *remoteApplicationServiceSuite) TestAddRemoteApplicationOffererInvalidName(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service(c).AddRemoteApplicationOfferer(c.Context(), "!foo", s.addRemoteApplicationOffererArgs(c))
+	c.Assert(err, tc.ErrorIs, applicationerrors.ApplicationNameNotValid)
+}
+
+func (s *remoteApplicationServiceSuite) TestAddRemoteApplicationOffererNoEndpoints(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	args := s.addRemoteApplicationOffererArgs(c)
+	args.Endpoints = nil
+
+	err := s.service(c).AddRemoteApplicationOfferer(c.Context(), "foo", args)
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *remoteApplicationServiceSuite) TestAddRelationNetworkEgress(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	relUUID := tc.Must(c, corerelation.NewUUID)
+	s.modelDBState.EXPECT().AddRelationNetworkEgress(gomock.Any(), relUUID.String(), "10.0.0.0/24").Return(nil)
+
+	err := s.service(c).AddRelationNetworkEgress(c.Context(), relUUID, "10.0.0.0/24")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *remoteApplicationServiceSuite) TestAddRelationNetworkEgressInvalidCIDR(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	relUUID := tc.Must(c, corerelation.NewUUID)
+
+	err := s.service(c).AddRelationNetworkEgress(c.Context(), relUUID, "10.0.0.0")
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *remoteApplicationServiceSuite) TestGetRelationNetworkEgressRecorded(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	relUUID := tc.Must(c, corerelation.NewUUID)
+	s.modelDBState.EXPECT().GetRelationNetworkEgress(gomock.Any(), relUUID.String()).Return([]string{"10.0.0.0/24"}, nil)
+
+	cidrs, err := s.service(c).GetRelationNetworkEgress(c.Context(), relUUID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cidrs, tc.DeepEquals, []string{"10.0.0.0/24"})
+}
+
+func (s *remoteApplicationServiceSuite) TestGetRelationNetworkEgressModelConfig(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	relUUID := tc.Must(c, corerelation.NewUUID)
+	s.modelDBState.EXPECT().GetRelationNetworkEgress(gomock.Any(), relUUID.String()).Return(nil, nil)
+	s.modelDBState.EXPECT().GetModelEgressSubnets(gomock.Any()).Return([]string{"192.168.0.0/16", "10.0.0.0/8"}, nil)
+
+	cidrs, err := s.service(c).GetRelationNetworkEgress(c.Context(), relUUID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cidrs, tc.DeepEquals, []string{"10.0.0.0/8", "192.168.0.0/16"})
+}
+
+func (s *remoteApplicationServiceSuite) TestGetRelationNetworkEgressUnitAddresses(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	relUUID := tc.Must(c, corerelation.NewUUID)
+	s.modelDBState.EXPECT().GetRelationNetworkEgress(gomock.Any(), relUUID.String()).Return(nil, nil)
+	s.modelDBState.EXPECT().GetModelEgressSubnets(gomock.Any()).Return(nil, nil)
+	s.modelDBState.EXPECT().GetRelationUnitAddresses(gomock.Any(), relUUID.String()).Return(map[string]network.SpaceAddresses{
+		"unit-1": {
+			network.NewSpaceAddress("10.0.0.1", network.WithScope(network.ScopeCloudLocal)),
+			network.NewSpaceAddress("54.0.0.1", network.WithScope(network.ScopePublic)),
+		},
+		"unit-2": {
+			network.NewSpaceAddress("10.0.0.2", network.WithScope(network.ScopeCloudLocal)),
+		},
+		"unit-3": {
+			network.NewSpaceAddress("2001:db8::1", network.WithScope(network.ScopePublic)),
+		},
+	}, nil)
+
+	cidrs, err := s.service(c).GetRelationNetworkEgress(c.Context(), relUUID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cidrs, tc.DeepEquals, []string{"10.0.0.2/32", "2001:db8::1/128", "54.0.0.1/32"})
+}
+
+func (s *remoteApplicationServiceSuite) addRemoteApplicationOffererArgs(c *tc.C) crossmodelrelation.AddRemoteApplicationOffererArgs {
+	mac, err := macaroon.New(nil, []byte("id"), "", macaroon.LatestVersion)
+	c.Assert(err, tc.ErrorIsNil)
+
+	return crossmodelrelation.AddRemoteApplicationOffererArgs{
+		OfferUUID:             uuid.MustNewUUID().String(),
+		OffererControllerUUID: uuid.MustNewUUID().String(),
+		OffererModelUUID:      uuid.MustNewUUID().String(),
+		Endpoints: []charm.Relation{{
+			Name:      "db",
+			Role:      charm.RoleProvider,
+			Interface: "mysql",
+			Scope:     charm.ScopeGlobal,
+		}},
+		Macaroon: mac,
+	}
+}
+
+func (s *remoteApplicationServiceSuite) service(c *tc.C) *Service {
+	return &Service{
+		controllerState: s.controllerDBState,
+		modelState:      s.modelDBState,
+		logger:          loggertesting.WrapCheckLog(c),
+	}
+}
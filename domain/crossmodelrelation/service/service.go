@@ -5,14 +5,23 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"slices"
 
-	"github.com/juju/juju/core/errors"
+	"gopkg.in/macaroon.v2"
+
+	"github.com/juju/juju/core/changestream"
+	"github.com/juju/juju/core/database"
 	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/network"
+	corerelation "github.com/juju/juju/core/relation"
+	"github.com/juju/juju/core/trace"
 	"github.com/juju/juju/core/user"
 	"github.com/juju/juju/core/watcher"
 	"github.com/juju/juju/core/watcher/eventsource"
 	"github.com/juju/juju/domain/crossmodelrelation"
 	"github.com/juju/juju/domain/crossmodelrelation/internal"
+	"github.com/juju/juju/internal/errors"
 	"github.com/juju/juju/internal/uuid"
 )
 
@@ -47,6 +56,69 @@ type ModelDBState interface {
 		offerName string,
 		offerEndpoints []string,
 	) error
+
+	// AddRemoteApplicationOfferer adds a new synthetic application
+	// representing an offer from an external model, to be consumed by this
+	// model.
+	AddRemoteApplicationOfferer(
+		ctx context.Context,
+		applicationName string,
+		args internal.AddRemoteApplicationOffererArgs,
+	) error
+
+	// GetRemoteApplicationConsumers returns the current state of all remote
+	// application consumers in the local model.
+	GetRemoteApplicationConsumers(context.Context) ([]crossmodelrelation.RemoteApplicationConsumer, error)
+
+	// GetRemoteApplicationOfferers returns the current state of all remote
+	// application offerers in the local model.
+	GetRemoteApplicationOfferers(context.Context) ([]crossmodelrelation.RemoteApplicationOfferer, error)
+
+	// GetMacaroonForRelation returns the macaroon of the remote application
+	// offerer that participates in the relation with the input UUID.
+	GetMacaroonForRelation(ctx context.Context, relationUUID string) (*macaroon.Macaroon, error)
+
+	// AddRelationNetworkEgress adds the input egress CIDRs to the relation
+	// with the input UUID.
+	AddRelationNetworkEgress(ctx context.Context, relationUUID string, cidrs ...string) error
+
+	// GetRelationNetworkEgress returns the egress CIDRs recorded against the
+	// relation with the input UUID.
+	GetRelationNetworkEgress(ctx context.Context, relationUUID string) ([]string, error)
+
+	// GetRelationNetworkIngress returns the ingress CIDRs recorded against
+	// the relation with the input UUID.
+	GetRelationNetworkIngress(ctx context.Context, relationUUID string) ([]string, error)
+
+	// GetModelEgressSubnets returns the CIDRs of the egress-subnets model
+	// config value.
+	GetModelEgressSubnets(ctx context.Context) ([]string, error)
+
+	// GetRelationUnitAddresses returns the addresses of the local units in
+	// scope of the relation with the input UUID, keyed by unit UUID.
+	GetRelationUnitAddresses(ctx context.Context, relationUUID string) (map[string]network.SpaceAddresses, error)
+
+	// NamespaceForWatchRemoteApplicationConsumers returns the namespace
+	// identifier for the remote application consumers watcher.
+	NamespaceForWatchRemoteApplicationConsumers() string
+
+	// NamespaceForWatchRemoteApplicationOfferers returns the namespace
+	// identifier for the remote application offerers watcher.
+	NamespaceForWatchRemoteApplicationOfferers() string
+
+	// NamespaceForWatchRelationNetworkEgress returns the namespace
+	// identifier for the relation egress networks watcher.
+	NamespaceForWatchRelationNetworkEgress() string
+
+	// NamespacesForWatchRelationEgressFallback returns the namespace
+	// identifiers of the model config, relation unit and IP address tables,
+	// from which the egress networks of a relation are derived when none
+	// are recorded against it.
+	NamespacesForWatchRelationEgressFallback() (string, string, string)
+
+	// NamespaceForWatchRelationNetworkIngress returns the namespace
+	// identifier for the relation ingress networks watcher.
+	NamespaceForWatchRelationNetworkIngress() string
 }
 
 // ControllerDBState describes retrieval and persistence methods for cross
@@ -87,6 +159,20 @@ type WatcherFactory interface {
 		filterOption eventsource.FilterOption,
 		filterOptions ...eventsource.FilterOption,
 	) (watcher.NotifyWatcher, error)
+
+	// NewNamespaceMapperWatcher returns a new watcher that receives changes
+	// from the input base watcher's db/queue. Change-log events will be
+	// emitted only if the filter accepts them, and dispatching the
+	// notifications via the Changes channel, once the mapper has processed
+	// them.
+	NewNamespaceMapperWatcher(
+		ctx context.Context,
+		initialQuery eventsource.NamespaceQuery,
+		summary string,
+		mapper eventsource.Mapper,
+		filterOption eventsource.FilterOption,
+		filterOptions ...eventsource.FilterOption,
+	) (watcher.StringsWatcher, error)
 }
 
 // Service provides the API for working with cross model relations.
@@ -135,11 +221,126 @@ func NewWatchableService(
 // WatchRemoteApplicationConsumers watches the changes to remote
 // application consumers and notifies the worker of any changes.
 func (w *WatchableService) WatchRemoteApplicationConsumers(ctx context.Context) (watcher.NotifyWatcher, error) {
-	return nil, errors.NotImplemented
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	return w.watcherFactory.NewNotifyWatcher(
+		ctx,
+		"remote application consumers watcher",
+		eventsource.NamespaceFilter(
+			w.modelState.NamespaceForWatchRemoteApplicationConsumers(),
+			changestream.All,
+		),
+	)
 }
 
 // WatchRemoteApplicationOfferers watches the changes to remote
 // application offerers and notifies the worker of any changes.
 func (w *WatchableService) WatchRemoteApplicationOfferers(ctx context.Context) (watcher.NotifyWatcher, error) {
-	return nil, errors.NotImplemented
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	return w.watcherFactory.NewNotifyWatcher(
+		ctx,
+		"remote application offerers watcher",
+		eventsource.NamespaceFilter(
+			w.modelState.NamespaceForWatchRemoteApplicationOfferers(),
+			changestream.All,
+		),
+	)
+}
+
+// WatchRelationNetworkEgress returns a watcher that emits the complete set of
+// egress CIDRs of the relation with the input UUID, whenever they change.
+// See [Service.GetRelationNetworkEgress] for how the CIDRs are determined.
+func (w *WatchableService) WatchRelationNetworkEgress(
+	ctx context.Context,
+	relationUUID corerelation.UUID,
+) (watcher.StringsWatcher, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := relationUUID.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+	relUUID := relationUUID.String()
+
+	// The fallback tables aren't specific to the relation, so track what was
+	// last emitted to only notify of actual changes to the egress.
+	var last []string
+	getEgress := func(ctx context.Context) ([]string, error) {
+		cidrs, err := getRelationNetworkEgress(ctx, w.modelState, relUUID)
+		if err != nil {
+			return nil, errors.Capture(err)
+		}
+		if slices.Equal(cidrs, last) {
+			return nil, nil
+		}
+		last = cidrs
+		return cidrs, nil
+	}
+	initialQuery := func(ctx context.Context, _ database.TxnRunner) ([]string, error) {
+		return getEgress(ctx)
+	}
+	mapper := func(ctx context.Context, _ []changestream.ChangeEvent) ([]string, error) {
+		return getEgress(ctx)
+	}
+
+	configNamespace, unitNamespace, addressNamespace := w.modelState.NamespacesForWatchRelationEgressFallback()
+	return w.watcherFactory.NewNamespaceMapperWatcher(
+		ctx,
+		initialQuery,
+		fmt.Sprintf("relation egress networks watcher for %q", relUUID),
+		mapper,
+		eventsource.PredicateFilter(
+			w.modelState.NamespaceForWatchRelationNetworkEgress(),
+			changestream.All,
+			eventsource.EqualsPredicate(relUUID),
+		),
+		eventsource.NamespaceFilter(configNamespace, changestream.All),
+		eventsource.NamespaceFilter(unitNamespace, changestream.All),
+		eventsource.NamespaceFilter(addressNamespace, changestream.All),
+	)
+}
+
+// WatchRelationNetworkIngress returns a watcher that emits the complete set of
+// ingress CIDRs recorded against the relation with the input UUID, whenever
+// they change.
+func (w *WatchableService) WatchRelationNetworkIngress(
+	ctx context.Context,
+	relationUUID corerelation.UUID,
+) (watcher.StringsWatcher, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := relationUUID.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+	return w.watchRelationNetworks(
+		ctx, "ingress", relationUUID.String(),
+		w.modelState.NamespaceForWatchRelationNetworkIngress(),
+		w.modelState.GetRelationNetworkIngress,
+	)
+}
+
+func (w *WatchableService) watchRelationNetworks(
+	ctx context.Context,
+	direction, relationUUID, namespace string,
+	getNetworks func(context.Context, string) ([]string, error),
+) (watcher.StringsWatcher, error) {
+	initialQuery := func(ctx context.Context, _ database.TxnRunner) ([]string, error) {
+		return getNetworks(ctx, relationUUID)
+	}
+	// Each change event is mapped to the complete set of CIDRs for the
+	// relation, as that is what consumers of the watcher expect.
+	mapper := func(ctx context.Context, _ []changestream.ChangeEvent) ([]string, error) {
+		return getNetworks(ctx, relationUUID)
+	}
+	return w.watcherFactory.NewNamespaceMapperWatcher(
+		ctx,
+		initialQuery,
+		fmt.Sprintf("relation %s networks watcher for %q", direction, relationUUID),
+		mapper,
+		eventsource.PredicateFilter(namespace, changestream.All, eventsource.EqualsPredicate(relationUUID)),
+	)
 }
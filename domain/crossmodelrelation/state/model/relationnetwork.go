@@ -0,0 +1,218 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/canonical/sqlair"
+	"github.com/juju/collections/transform"
+
+	corenetwork "github.com/juju/juju/core/network"
+	relationerrors "github.com/juju/juju/domain/relation/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// AddRelationNetworkEgress adds the input egress CIDRs to the relation with
+// the input UUID. CIDRs already recorded against the relation are ignored.
+// Returns [relationerrors.RelationNotFound] if the relation does not exist.
+func (st *State) AddRelationNetworkEgress(ctx context.Context, relUUID string, cidrs ...string) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	rel := uuid{UUID: relUUID}
+	existsStmt, err := st.Prepare(`
+SELECT &uuid.*
+FROM   relation
+WHERE  uuid = $uuid.uuid`, rel)
+	if err != nil {
+		return errors.Errorf("preparing relation exists query: %w", err)
+	}
+
+	insertStmt, err := st.Prepare(`
+INSERT INTO relation_network_egress (*) VALUES ($relationNetwork.*)
+ON CONFLICT DO NOTHING`, relationNetwork{})
+	if err != nil {
+		return errors.Errorf("preparing insert relation egress query: %w", err)
+	}
+
+	networks := transform.Slice(cidrs, func(cidr string) relationNetwork {
+		return relationNetwork{RelationUUID: relUUID, CIDR: cidr}
+	})
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, existsStmt, rel).Get(&rel)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("relation %q", relUUID).Add(relationerrors.RelationNotFound)
+		} else if err != nil {
+			return errors.Errorf("checking if relation %q exists: %w", relUUID, err)
+		}
+
+		if len(networks) == 0 {
+			return nil
+		}
+		if err := tx.Query(ctx, insertStmt, networks).Run(); err != nil {
+			return errors.Errorf("inserting egress networks for relation %q: %w", relUUID, err)
+		}
+		return nil
+	})
+}
+
+// GetRelationNetworkEgress returns the egress CIDRs recorded against the
+// relation with the input UUID.
+func (st *State) GetRelationNetworkEgress(ctx context.Context, relUUID string) ([]string, error) {
+	return st.getRelationNetworks(ctx, "relation_network_egress", relUUID)
+}
+
+// GetRelationNetworkIngress returns the ingress CIDRs recorded against the
+// relation with the input UUID.
+func (st *State) GetRelationNetworkIngress(ctx context.Context, relUUID string) ([]string, error) {
+	return st.getRelationNetworks(ctx, "relation_network_ingress", relUUID)
+}
+
+// GetModelEgressSubnets returns the CIDRs of the egress-subnets model config
+// value. An empty slice is returned if the value is not set.
+func (st *State) GetModelEgressSubnets(ctx context.Context) ([]string, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	key := modelConfigKey{Key: egressSubnetsKey}
+	stmt, err := st.Prepare(`
+SELECT &modelConfigKey.*
+FROM   model_config
+WHERE  key = $modelConfigKey.key`, key)
+	if err != nil {
+		return nil, errors.Errorf("preparing egress subnets query: %w", err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, key).Get(&key)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return nil, errors.Errorf("querying model egress subnets: %w", err)
+	}
+
+	var cidrs []string
+	for _, cidr := range strings.Split(key.Value, ",") {
+		if cidr = strings.TrimSpace(cidr); cidr != "" {
+			cidrs = append(cidrs, cidr)
+		}
+	}
+	return cidrs, nil
+}
+
+// GetRelationUnitAddresses returns the addresses of the local units in scope
+// of the relation with the input UUID, keyed by unit UUID. Units of synthetic
+// applications representing the other side of a cross model relation are not
+// included.
+func (st *State) GetRelationUnitAddresses(ctx context.Context, relUUID string) (map[string]corenetwork.SpaceAddresses, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	rel := relationUUID{UUID: relUUID}
+	stmt, err := st.Prepare(`
+SELECT u.uuid AS &relationUnitAddress.unit_uuid,
+       ipa.address_value AS &relationUnitAddress.address_value,
+       ipa.scope_name AS &relationUnitAddress.scope_name
+FROM   relation_endpoint AS re
+JOIN   relation_unit AS ru ON re.uuid = ru.relation_endpoint_uuid
+JOIN   unit AS u ON ru.unit_uuid = u.uuid
+JOIN   application AS a ON u.application_uuid = a.uuid
+JOIN   charm AS c ON a.charm_uuid = c.uuid
+JOIN   v_ip_address_with_names AS ipa ON u.net_node_uuid = ipa.net_node_uuid
+WHERE  re.relation_uuid = $relationUUID.relation_uuid
+AND    c.source_id != $cmrSource.source_id`, relationUnitAddress{}, rel, cmrSource{})
+	if err != nil {
+		return nil, errors.Errorf("preparing relation unit addresses query: %w", err)
+	}
+
+	var addresses []relationUnitAddress
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, rel, cmrSource{SourceID: cmrCharmSourceID}).GetAll(&addresses)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return nil, errors.Errorf("querying unit addresses for relation %q: %w", relUUID, err)
+	}
+
+	result := make(map[string]corenetwork.SpaceAddresses)
+	for _, addr := range addresses {
+		// The saved address value is in the form 192.0.2.1/24, but may not
+		// have a suffix for addresses from Kubernetes.
+		value := addr.Value
+		if ip, _, err := net.ParseCIDR(value); err == nil {
+			value = ip.String()
+		}
+		result[addr.UnitUUID] = append(result[addr.UnitUUID], corenetwork.NewSpaceAddress(
+			value, corenetwork.WithScope(corenetwork.Scope(addr.Scope)),
+		))
+	}
+	return result, nil
+}
+
+// NamespaceForWatchRelationNetworkEgress returns the namespace identifier
+// for the relation egress networks watcher.
+func (*State) NamespaceForWatchRelationNetworkEgress() string {
+	return "relation_network_egress"
+}
+
+// NamespacesForWatchRelationEgressFallback returns the namespace identifiers
+// of the model config, relation unit and IP address tables, from which the
+// egress networks of a relation without any recorded are derived.
+func (*State) NamespacesForWatchRelationEgressFallback() (string, string, string) {
+	return "model_config", "relation_unit", "ip_address"
+}
+
+// NamespaceForWatchRelationNetworkIngress returns the namespace identifier
+// for the relation ingress networks watcher.
+func (*State) NamespaceForWatchRelationNetworkIngress() string {
+	return "relation_network_ingress"
+}
+
+func (st *State) getRelationNetworks(ctx context.Context, table, relUUID string) ([]string, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	rel := relationUUID{UUID: relUUID}
+	stmt, err := st.Prepare(`
+SELECT &relationNetwork.*
+FROM   `+table+`
+WHERE  relation_uuid = $relationUUID.relation_uuid`, relationNetwork{}, rel)
+	if err != nil {
+		return nil, errors.Errorf("preparing %s query: %w", table, err)
+	}
+
+	var networks []relationNetwork
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, rel).GetAll(&networks)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return nil, errors.Errorf("querying networks for relation %q: %w", relUUID, err)
+	}
+
+	return transform.Slice(networks, func(n relationNetwork) string {
+		return n.CIDR
+	}), nil
+}
@@ -0,0 +1,140 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/network"
+	relationerrors "github.com/juju/juju/domain/relation/errors"
+	"github.com/juju/juju/internal/charm"
+	internaluuid "github.com/juju/juju/internal/uuid"
+)
+
+type relationNetworkSuite struct {
+	baseSuite
+}
+
+func TestRelationNetworkSuite(t *testing.T) {
+	tc.Run(t, &relationNetworkSuite{})
+}
+
+func (s *relationNetworkSuite) TestAddRelationNetworkEgress(c *tc.C) {
+	relUUID := s.addRelation(c)
+
+	err := s.state.AddRelationNetworkEgress(c.Context(), relUUID, "10.0.0.0/24", "192.168.0.0/16")
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Adding an already recorded CIDR is a no-op.
+	err = s.state.AddRelationNetworkEgress(c.Context(), relUUID, "10.0.0.0/24")
+	c.Assert(err, tc.ErrorIsNil)
+
+	cidrs, err := s.state.GetRelationNetworkEgress(c.Context(), relUUID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cidrs, tc.SameContents, []string{"10.0.0.0/24", "192.168.0.0/16"})
+
+	cidrs, err = s.state.GetRelationNetworkIngress(c.Context(), relUUID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cidrs, tc.HasLen, 0)
+}
+
+func (s *relationNetworkSuite) TestAddRelationNetworkEgressRelationNotFound(c *tc.C) {
+	err := s.state.AddRelationNetworkEgress(c.Context(), internaluuid.MustNewUUID().String(), "10.0.0.0/24")
+	c.Assert(err, tc.ErrorIs, relationerrors.RelationNotFound)
+}
+
+func (s *relationNetworkSuite) TestGetRelationNetworkIngress(c *tc.C) {
+	relUUID := s.addRelation(c)
+	s.query(c, `
+INSERT INTO relation_network_ingress (relation_uuid, cidr) VALUES (?, ?)`, relUUID, "10.0.0.0/24")
+
+	cidrs, err := s.state.GetRelationNetworkIngress(c.Context(), relUUID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cidrs, tc.DeepEquals, []string{"10.0.0.0/24"})
+}
+
+func (s *relationNetworkSuite) TestGetModelEgressSubnets(c *tc.C) {
+	cidrs, err := s.state.GetModelEgressSubnets(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cidrs, tc.HasLen, 0)
+
+	s.query(c, `
+INSERT INTO model_config (key, value) VALUES ('egress-subnets', '10.0.0.0/8, 192.168.0.0/16')`)
+
+	cidrs, err = s.state.GetModelEgressSubnets(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cidrs, tc.DeepEquals, []string{"10.0.0.0/8", "192.168.0.0/16"})
+}
+
+func (s *relationNetworkSuite) TestGetRelationUnitAddresses(c *tc.C) {
+	relUUID := s.addRelation(c)
+
+	localUnit := s.addRelationUnit(c, relUUID, "foo", 0)
+	s.addUnitAddress(c, localUnit, "54.0.0.1/24", 1 /* public */)
+	s.addUnitAddress(c, localUnit, "10.0.0.1/24", 2 /* local-cloud */)
+
+	// Units of synthetic applications are ignored.
+	remoteUnit := s.addRelationUnit(c, relUUID, "bar", cmrCharmSourceID)
+	s.addUnitAddress(c, remoteUnit, "54.0.0.2/24", 1 /* public */)
+
+	addresses, err := s.state.GetRelationUnitAddresses(c.Context(), relUUID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(addresses, tc.HasLen, 1)
+	c.Check(addresses[localUnit], tc.SameContents, network.SpaceAddresses{
+		network.NewSpaceAddress("54.0.0.1", network.WithScope(network.ScopePublic)),
+		network.NewSpaceAddress("10.0.0.1", network.WithScope(network.ScopeCloudLocal)),
+	})
+}
+
+func (s *relationNetworkSuite) addRelation(c *tc.C) string {
+	relUUID := internaluuid.MustNewUUID().String()
+	s.query(c, `
+INSERT INTO relation (uuid, life_id, relation_id, scope_id) VALUES (?, 0, 1, 0)`, relUUID)
+	return relUUID
+}
+
+// addRelationUnit adds an application with the input name and charm source,
+// with a unit in scope of the relation. It returns the unit UUID.
+func (s *relationNetworkSuite) addRelationUnit(c *tc.C, relUUID, appName string, sourceID int) string {
+	charmUUID := s.addCharm(c)
+	s.query(c, `UPDATE charm SET source_id = ? WHERE uuid = ?`, sourceID, charmUUID)
+	charmRelationUUID := s.addCharmRelation(c, charmUUID, charm.Relation{
+		Name:  "db",
+		Role:  charm.RoleProvider,
+		Scope: charm.ScopeGlobal,
+	})
+	appUUID := s.addApplication(c, charmUUID, appName)
+	endpointUUID := s.addApplicationEndpoint(c, appUUID, charmRelationUUID)
+
+	relEndpointUUID := internaluuid.MustNewUUID().String()
+	s.query(c, `
+INSERT INTO relation_endpoint (uuid, relation_uuid, endpoint_uuid) VALUES (?, ?, ?)`,
+		relEndpointUUID, relUUID, endpointUUID)
+
+	netNodeUUID := internaluuid.MustNewUUID().String()
+	s.query(c, `INSERT INTO net_node (uuid) VALUES (?)`, netNodeUUID)
+	unitUUID := internaluuid.MustNewUUID().String()
+	s.query(c, `
+INSERT INTO unit (uuid, name, life_id, application_uuid, charm_uuid, net_node_uuid)
+VALUES (?, ?, 0, ?, ?, ?)`, unitUUID, appName+"/0", appUUID, charmUUID, netNodeUUID)
+	s.query(c, `
+INSERT INTO relation_unit (uuid, relation_endpoint_uuid, unit_uuid) VALUES (?, ?, ?)`,
+		internaluuid.MustNewUUID().String(), relEndpointUUID, unitUUID)
+	return unitUUID
+}
+
+// addUnitAddress adds an address with the input scope to a device on the net
+// node of the unit with the input UUID.
+func (s *relationNetworkSuite) addUnitAddress(c *tc.C, unitUUID, address string, scopeID int) {
+	deviceUUID := internaluuid.MustNewUUID().String()
+	s.query(c, `
+INSERT INTO link_layer_device (uuid, net_node_uuid, name, device_type_id, virtual_port_type_id)
+SELECT ?, net_node_uuid, ?, 0, 0 FROM unit WHERE uuid = ?`, deviceUUID, "eth-"+deviceUUID, unitUUID)
+	s.query(c, `
+INSERT INTO ip_address (uuid, net_node_uuid, device_uuid, address_value, type_id, scope_id, origin_id, config_type_id)
+SELECT ?, net_node_uuid, ?, ?, 0, ?, 0, 1 FROM unit WHERE uuid = ?`,
+		internaluuid.MustNewUUID().String(), deviceUUID, address, scopeID, unitUUID)
+}
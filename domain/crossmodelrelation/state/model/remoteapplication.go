@@ -0,0 +1,376 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/canonical/sqlair"
+	"gopkg.in/macaroon.v2"
+
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/domain/application/charm"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/domain/crossmodelrelation"
+	crossmodelrelationerrors "github.com/juju/juju/domain/crossmodelrelation/errors"
+	"github.com/juju/juju/domain/crossmodelrelation/internal"
+	"github.com/juju/juju/domain/life"
+	"github.com/juju/juju/internal/errors"
+	internaluuid "github.com/juju/juju/internal/uuid"
+)
+
+// cmrCharmSourceID is the charm_source id of synthetic charms, created to
+// back remote application offerers.
+const cmrCharmSourceID = 2
+
+// AddRemoteApplicationOfferer adds a new synthetic application representing
+// an offer from an external model, to be consumed by this model. The
+// synthetic application is backed by a charm with the offered endpoints as
+// its relations.
+// Returns [applicationerrors.ApplicationAlreadyExists] if an application with
+// the same name already exists.
+func (st *State) AddRemoteApplicationOfferer(
+	ctx context.Context,
+	applicationName string,
+	args internal.AddRemoteApplicationOffererArgs,
+) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	mac, err := json.Marshal(args.Macaroon)
+	if err != nil {
+		return errors.Errorf("encoding macaroon: %w", err)
+	}
+
+	relations := make([]charmRelation, len(args.Endpoints))
+	endpoints := make([]applicationEndpoint, len(args.Endpoints))
+	for i, ep := range args.Endpoints {
+		relation, err := encodeCharmRelation(args.CharmUUID, ep)
+		if err != nil {
+			return errors.Errorf("encoding endpoint %q: %w", ep.Name, err)
+		}
+		relations[i] = relation
+
+		endpointUUID, err := internaluuid.NewUUID()
+		if err != nil {
+			return errors.Capture(err)
+		}
+		endpoints[i] = applicationEndpoint{
+			UUID:              endpointUUID.String(),
+			ApplicationUUID:   args.ApplicationUUID,
+			CharmRelationUUID: relation.UUID,
+		}
+	}
+
+	appName := name{Name: applicationName}
+	existsStmt, err := st.Prepare(`
+SELECT &name.*
+FROM   application
+WHERE  name = $name.name`, appName)
+	if err != nil {
+		return errors.Errorf("preparing application exists query: %w", err)
+	}
+
+	synthCharm := charmRow{
+		UUID:          args.CharmUUID,
+		ReferenceName: applicationName,
+		SourceID:      cmrCharmSourceID,
+		Available:     true,
+	}
+	insertCharmStmt, err := st.Prepare(`
+INSERT INTO charm (uuid, reference_name, source_id, available)
+VALUES ($charmRow.*)`, synthCharm)
+	if err != nil {
+		return errors.Errorf("preparing insert charm query: %w", err)
+	}
+
+	metadata := charmMetadata{
+		CharmUUID: args.CharmUUID,
+		Name:      applicationName,
+	}
+	insertMetadataStmt, err := st.Prepare(`
+INSERT INTO charm_metadata (charm_uuid, name, subordinate)
+VALUES ($charmMetadata.*)`, metadata)
+	if err != nil {
+		return errors.Errorf("preparing insert charm metadata query: %w", err)
+	}
+
+	insertRelationStmt, err := st.Prepare(`
+INSERT INTO charm_relation (*) VALUES ($charmRelation.*)`, charmRelation{})
+	if err != nil {
+		return errors.Errorf("preparing insert charm relation query: %w", err)
+	}
+
+	app := applicationRow{
+		UUID:      args.ApplicationUUID,
+		Name:      applicationName,
+		LifeID:    life.Alive,
+		CharmUUID: args.CharmUUID,
+		SpaceUUID: network.AlphaSpaceId.String(),
+	}
+	insertApplicationStmt, err := st.Prepare(`
+INSERT INTO application (uuid, name, life_id, charm_uuid, space_uuid)
+VALUES ($applicationRow.*)`, app)
+	if err != nil {
+		return errors.Errorf("preparing insert application query: %w", err)
+	}
+
+	insertEndpointStmt, err := st.Prepare(`
+INSERT INTO application_endpoint (uuid, application_uuid, charm_relation_uuid)
+VALUES ($applicationEndpoint.*)`, applicationEndpoint{})
+	if err != nil {
+		return errors.Errorf("preparing insert application endpoint query: %w", err)
+	}
+
+	remoteApp := applicationRemoteOfferer{
+		UUID:                  args.RemoteApplicationUUID,
+		LifeID:                life.Alive,
+		ApplicationUUID:       args.ApplicationUUID,
+		OfferUUID:             args.OfferUUID,
+		OffererControllerUUID: args.OffererControllerUUID,
+		OffererModelUUID:      args.OffererModelUUID,
+		Macaroon:              string(mac),
+	}
+	insertRemoteAppStmt, err := st.Prepare(`
+INSERT INTO application_remote_offerer (*) VALUES ($applicationRemoteOfferer.*)`, remoteApp)
+	if err != nil {
+		return errors.Errorf("preparing insert remote application offerer query: %w", err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, existsStmt, appName).Get(&appName)
+		if err == nil {
+			return errors.Errorf("application %q already exists", applicationName).
+				Add(applicationerrors.ApplicationAlreadyExists)
+		} else if !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("checking if application %q exists: %w", applicationName, err)
+		}
+
+		if err := tx.Query(ctx, insertCharmStmt, synthCharm).Run(); err != nil {
+			return errors.Errorf("inserting charm for %q: %w", applicationName, err)
+		}
+		if err := tx.Query(ctx, insertMetadataStmt, metadata).Run(); err != nil {
+			return errors.Errorf("inserting charm metadata for %q: %w", applicationName, err)
+		}
+		if err := tx.Query(ctx, insertRelationStmt, relations).Run(); err != nil {
+			return errors.Errorf("inserting charm relations for %q: %w", applicationName, err)
+		}
+		if err := tx.Query(ctx, insertApplicationStmt, app).Run(); err != nil {
+			return errors.Errorf("inserting application %q: %w", applicationName, err)
+		}
+		if err := tx.Query(ctx, insertEndpointStmt, endpoints).Run(); err != nil {
+			return errors.Errorf("inserting application endpoints for %q: %w", applicationName, err)
+		}
+		if err := tx.Query(ctx, insertRemoteAppStmt, remoteApp).Run(); err != nil {
+			return errors.Errorf("inserting remote application offerer for %q: %w", applicationName, err)
+		}
+		return nil
+	})
+}
+
+// GetRemoteApplicationConsumers returns the current state of all remote
+// application consumers in the local model.
+func (st *State) GetRemoteApplicationConsumers(ctx context.Context) ([]crossmodelrelation.RemoteApplicationConsumer, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	stmt, err := st.Prepare(`
+SELECT a.name AS &remoteApplicationConsumer.application_name,
+       aro.life_id AS &remoteApplicationConsumer.life_id,
+       aro.offer_uuid AS &remoteApplicationConsumer.offer_uuid,
+       aro.version AS &remoteApplicationConsumer.version,
+       aro.offerer_model_uuid AS &remoteApplicationConsumer.offerer_model_uuid,
+       aro.macaroon AS &remoteApplicationConsumer.macaroon
+FROM   application_remote_offerer AS aro
+JOIN   application AS a ON a.uuid = aro.application_uuid
+`, remoteApplicationConsumer{})
+	if err != nil {
+		return nil, errors.Errorf("preparing remote application consumers query: %w", err)
+	}
+
+	var rows []remoteApplicationConsumer
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt).GetAll(&rows)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return nil, errors.Errorf("querying remote application consumers: %w", err)
+	}
+
+	result := make([]crossmodelrelation.RemoteApplicationConsumer, len(rows))
+	for i, row := range rows {
+		mac, err := decodeMacaroon(row.Macaroon)
+		if err != nil {
+			return nil, errors.Errorf("decoding macaroon for %q: %w", row.ApplicationName, err)
+		}
+		result[i] = crossmodelrelation.RemoteApplicationConsumer{
+			ApplicationName:  row.ApplicationName,
+			Life:             row.LifeID,
+			OfferUUID:        row.OfferUUID,
+			ConsumeVersion:   row.Version,
+			OffererModelUUID: row.OffererModelUUID,
+			Macaroon:         mac,
+		}
+	}
+	return result, nil
+}
+
+// GetRemoteApplicationOfferers returns the current state of all remote
+// application offerers in the local model. The application name is that of
+// the application in the offer connection's relation, which is not the
+// offered application.
+func (st *State) GetRemoteApplicationOfferers(ctx context.Context) ([]crossmodelrelation.RemoteApplicationOfferer, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	stmt, err := st.Prepare(`
+SELECT a.name AS &remoteApplicationOfferer.application_name,
+       arc.life_id AS &remoteApplicationOfferer.life_id,
+       oc.offer_uuid AS &remoteApplicationOfferer.offer_uuid,
+       arc.version AS &remoteApplicationOfferer.version
+FROM   application_remote_consumer AS arc
+JOIN   offer_connection AS oc ON oc.uuid = arc.offer_connection_uuid
+JOIN   relation_endpoint AS re ON re.relation_uuid = oc.remote_relation_uuid
+JOIN   application_endpoint AS ae ON ae.uuid = re.endpoint_uuid
+JOIN   application AS a ON a.uuid = ae.application_uuid
+WHERE  ae.uuid NOT IN (
+    SELECT endpoint_uuid
+    FROM   offer_endpoint
+    WHERE  offer_uuid = oc.offer_uuid
+)
+`, remoteApplicationOfferer{})
+	if err != nil {
+		return nil, errors.Errorf("preparing remote application offerers query: %w", err)
+	}
+
+	var rows []remoteApplicationOfferer
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt).GetAll(&rows)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return nil, errors.Errorf("querying remote application offerers: %w", err)
+	}
+
+	result := make([]crossmodelrelation.RemoteApplicationOfferer, len(rows))
+	for i, row := range rows {
+		result[i] = crossmodelrelation.RemoteApplicationOfferer{
+			ApplicationName: row.ApplicationName,
+			Life:            row.LifeID,
+			OfferUUID:       row.OfferUUID,
+			ConsumeVersion:  row.Version,
+		}
+	}
+	return result, nil
+}
+
+// GetMacaroonForRelation returns the macaroon of the remote application
+// offerer that participates in the relation with the input UUID.
+// Returns [crossmodelrelationerrors.RemoteApplicationNotFound] if no remote
+// application offerer is part of the relation.
+func (st *State) GetMacaroonForRelation(ctx context.Context, relUUID string) (*macaroon.Macaroon, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	rel := relationUUID{UUID: relUUID}
+	stmt, err := st.Prepare(`
+SELECT aro.macaroon AS &macaroonValue.macaroon
+FROM   application_remote_offerer AS aro
+JOIN   application_endpoint AS ae ON ae.application_uuid = aro.application_uuid
+JOIN   relation_endpoint AS re ON re.endpoint_uuid = ae.uuid
+WHERE  re.relation_uuid = $relationUUID.relation_uuid
+`, macaroonValue{}, rel)
+	if err != nil {
+		return nil, errors.Errorf("preparing relation macaroon query: %w", err)
+	}
+
+	var result macaroonValue
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, rel).Get(&result)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("remote application for relation %q", relUUID).
+				Add(crossmodelrelationerrors.RemoteApplicationNotFound)
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return decodeMacaroon(result.Macaroon)
+}
+
+// NamespaceForWatchRemoteApplicationConsumers returns the namespace
+// identifier for the remote application consumers watcher.
+func (*State) NamespaceForWatchRemoteApplicationConsumers() string {
+	return "application_remote_offerer"
+}
+
+// NamespaceForWatchRemoteApplicationOfferers returns the namespace
+// identifier for the remote application offerers watcher.
+func (*State) NamespaceForWatchRemoteApplicationOfferers() string {
+	return "application_remote_consumer"
+}
+
+func encodeCharmRelation(charmUUID string, rel charm.Relation) (charmRelation, error) {
+	relUUID, err := internaluuid.NewUUID()
+	if err != nil {
+		return charmRelation{}, errors.Capture(err)
+	}
+
+	// These values are hardcoded to match the charm_relation_role and
+	// charm_relation_scope values in the database.
+	var roleID int
+	switch rel.Role {
+	case charm.RoleProvider:
+		roleID = 0
+	case charm.RoleRequirer:
+		roleID = 1
+	default:
+		return charmRelation{}, errors.Errorf("relation role %q not valid for a remote application", rel.Role)
+	}
+
+	var scopeID int
+	switch rel.Scope {
+	case charm.ScopeGlobal, "":
+		scopeID = 0
+	case charm.ScopeContainer:
+		scopeID = 1
+	default:
+		return charmRelation{}, errors.Errorf("unknown relation scope %q", rel.Scope)
+	}
+
+	return charmRelation{
+		UUID:      relUUID.String(),
+		CharmUUID: charmUUID,
+		Name:      rel.Name,
+		RoleID:    roleID,
+		ScopeID:   scopeID,
+		Interface: rel.Interface,
+		Optional:  rel.Optional,
+		Capacity:  rel.Limit,
+	}, nil
+}
+
+func decodeMacaroon(data string) (*macaroon.Macaroon, error) {
+	var mac macaroon.Macaroon
+	if err := json.Unmarshal([]byte(data), &mac); err != nil {
+		return nil, errors.Capture(err)
+	}
+	return &mac, nil
+}
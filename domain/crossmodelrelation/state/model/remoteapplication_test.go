@@ -0,0 +1,158 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+	"gopkg.in/macaroon.v2"
+
+	"github.com/juju/juju/domain/application/charm"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/domain/crossmodelrelation"
+	crossmodelrelationerrors "github.com/juju/juju/domain/crossmodelrelation/errors"
+	"github.com/juju/juju/domain/crossmodelrelation/internal"
+	"github.com/juju/juju/domain/life"
+	internaluuid "github.com/juju/juju/internal/uuid"
+)
+
+type remoteApplicationSuite struct {
+	baseSuite
+}
+
+func TestRemoteApplicationSuite(t *testing.T) {
+	tc.Run(t, &remoteApplicationSuite{})
+}
+
+func (s *remoteApplicationSuite) TestAddRemoteApplicationOfferer(c *tc.C) {
+	args := s.addRemoteApplicationOffererArgs(c)
+
+	err := s.state.AddRemoteApplicationOfferer(c.Context(), "foo", args)
+	c.Assert(err, tc.ErrorIsNil)
+
+	var (
+		sourceID  int
+		charmName string
+	)
+	row := s.DB().QueryRowContext(c.Context(), `
+SELECT c.source_id, cm.name
+FROM   charm AS c
+JOIN   charm_metadata AS cm ON cm.charm_uuid = c.uuid
+WHERE  c.uuid = ?`, args.CharmUUID)
+	c.Assert(row.Scan(&sourceID, &charmName), tc.ErrorIsNil)
+	c.Check(sourceID, tc.Equals, cmrCharmSourceID)
+	c.Check(charmName, tc.Equals, "foo")
+
+	var endpoints []string
+	rows, err := s.DB().QueryContext(c.Context(), `
+SELECT cr.name
+FROM   application_endpoint AS ae
+JOIN   charm_relation AS cr ON cr.uuid = ae.charm_relation_uuid
+WHERE  ae.application_uuid = ?
+ORDER BY cr.name`, args.ApplicationUUID)
+	c.Assert(err, tc.ErrorIsNil)
+	defer func() { _ = rows.Close() }()
+	for rows.Next() {
+		var name string
+		c.Assert(rows.Scan(&name), tc.ErrorIsNil)
+		endpoints = append(endpoints, name)
+	}
+	c.Check(endpoints, tc.DeepEquals, []string{"db", "logging"})
+
+	consumers, err := s.state.GetRemoteApplicationConsumers(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(consumers, tc.HasLen, 1)
+	c.Check(consumers[0].ApplicationName, tc.Equals, "foo")
+	c.Check(consumers[0].Life, tc.Equals, life.Alive)
+	c.Check(consumers[0].OfferUUID, tc.Equals, args.OfferUUID)
+	c.Check(consumers[0].OffererModelUUID, tc.Equals, args.OffererModelUUID)
+	c.Check(consumers[0].Macaroon.Id(), tc.DeepEquals, args.Macaroon.Id())
+}
+
+func (s *remoteApplicationSuite) TestAddRemoteApplicationOffererAlreadyExists(c *tc.C) {
+	err := s.state.AddRemoteApplicationOfferer(c.Context(), "foo", s.addRemoteApplicationOffererArgs(c))
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = s.state.AddRemoteApplicationOfferer(c.Context(), "foo", s.addRemoteApplicationOffererArgs(c))
+	c.Assert(err, tc.ErrorIs, applicationerrors.ApplicationAlreadyExists)
+}
+
+func (s *remoteApplicationSuite) TestAddRemoteApplicationOffererPeerEndpoint(c *tc.C) {
+	args := s.addRemoteApplicationOffererArgs(c)
+	args.Endpoints = append(args.Endpoints, charm.Relation{
+		Name:      "cluster",
+		Role:      charm.RolePeer,
+		Interface: "cluster",
+	})
+
+	err := s.state.AddRemoteApplicationOfferer(c.Context(), "foo", args)
+	c.Assert(err, tc.ErrorMatches, `.*relation role "peer" not valid for a remote application`)
+}
+
+func (s *remoteApplicationSuite) TestGetRemoteApplicationConsumersEmpty(c *tc.C) {
+	consumers, err := s.state.GetRemoteApplicationConsumers(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(consumers, tc.HasLen, 0)
+}
+
+func (s *remoteApplicationSuite) TestGetMacaroonForRelation(c *tc.C) {
+	args := s.addRemoteApplicationOffererArgs(c)
+	err := s.state.AddRemoteApplicationOfferer(c.Context(), "foo", args)
+	c.Assert(err, tc.ErrorIsNil)
+
+	relUUID := s.addRelationForApplication(c, args.ApplicationUUID)
+
+	mac, err := s.state.GetMacaroonForRelation(c.Context(), relUUID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(mac.Id(), tc.DeepEquals, args.Macaroon.Id())
+}
+
+func (s *remoteApplicationSuite) TestGetMacaroonForRelationNotFound(c *tc.C) {
+	_, err := s.state.GetMacaroonForRelation(c.Context(), internaluuid.MustNewUUID().String())
+	c.Assert(err, tc.ErrorIs, crossmodelrelationerrors.RemoteApplicationNotFound)
+}
+
+func (s *remoteApplicationSuite) addRemoteApplicationOffererArgs(c *tc.C) internal.AddRemoteApplicationOffererArgs {
+	mac, err := macaroon.New(nil, []byte("id"), "", macaroon.LatestVersion)
+	c.Assert(err, tc.ErrorIsNil)
+
+	return internal.AddRemoteApplicationOffererArgs{
+		AddRemoteApplicationOffererArgs: crossmodelrelation.AddRemoteApplicationOffererArgs{
+			OfferUUID:             internaluuid.MustNewUUID().String(),
+			OffererControllerUUID: internaluuid.MustNewUUID().String(),
+			OffererModelUUID:      internaluuid.MustNewUUID().String(),
+			Endpoints: []charm.Relation{{
+				Name:      "db",
+				Role:      charm.RoleProvider,
+				Interface: "mysql",
+				Scope:     charm.ScopeGlobal,
+			}, {
+				Name:      "logging",
+				Role:      charm.RoleRequirer,
+				Interface: "logging",
+				Scope:     charm.ScopeGlobal,
+			}},
+			Macaroon: mac,
+		},
+		RemoteApplicationUUID: internaluuid.MustNewUUID().String(),
+		ApplicationUUID:       internaluuid.MustNewUUID().String(),
+		CharmUUID:             internaluuid.MustNewUUID().String(),
+	}
+}
+
+// addRelationForApplication adds a relation with a single endpoint of the
+// application with the input UUID. Returns the relation UUID.
+func (s *baseSuite) addRelationForApplication(c *tc.C, appUUID string) string {
+	relUUID := internaluuid.MustNewUUID().String()
+	s.query(c, `
+INSERT INTO relation (uuid, life_id, relation_id, scope_id) VALUES (?, 0, 1, 0)`, relUUID)
+	s.query(c, `
+INSERT INTO relation_endpoint (uuid, relation_uuid, endpoint_uuid)
+SELECT ?, ?, uuid
+FROM   application_endpoint
+WHERE  application_uuid = ?
+LIMIT  1`, internaluuid.MustNewUUID().String(), relUUID, appUUID)
+	return relUUID
+}
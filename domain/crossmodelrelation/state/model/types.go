@@ -10,6 +10,7 @@ import (
 	"github.com/juju/juju/domain/application/architecture"
 	"github.com/juju/juju/domain/application/charm"
 	"github.com/juju/juju/domain/crossmodelrelation"
+	"github.com/juju/juju/domain/life"
 )
 
 // nameAndUUID is an agnostic container for the pair of
@@ -111,3 +112,119 @@ func (o offerDetails) TransformToOfferDetails() []*crossmodelrelation.OfferDetai
 
 	return slices.Collect(maps.Values(converted))
 }
+
+// charmRow represents the synthetic charm for a remote application offerer.
+type charmRow struct {
+	UUID          string `db:"uuid"`
+	ReferenceName string `db:"reference_name"`
+	SourceID      int    `db:"source_id"`
+	Available     bool   `db:"available"`
+}
+
+// charmMetadata represents the metadata of the synthetic charm for a remote
+// application offerer.
+type charmMetadata struct {
+	CharmUUID   string `db:"charm_uuid"`
+	Name        string `db:"name"`
+	Subordinate bool   `db:"subordinate"`
+}
+
+// charmRelation represents a row in the charm_relation table.
+type charmRelation struct {
+	UUID      string `db:"uuid"`
+	CharmUUID string `db:"charm_uuid"`
+	Name      string `db:"name"`
+	RoleID    int    `db:"role_id"`
+	ScopeID   int    `db:"scope_id"`
+	Interface string `db:"interface"`
+	Optional  bool   `db:"optional"`
+	Capacity  int    `db:"capacity"`
+}
+
+// applicationRow represents the synthetic application for a remote
+// application offerer.
+type applicationRow struct {
+	UUID      string    `db:"uuid"`
+	Name      string    `db:"name"`
+	LifeID    life.Life `db:"life_id"`
+	CharmUUID string    `db:"charm_uuid"`
+	SpaceUUID string    `db:"space_uuid"`
+}
+
+// applicationEndpoint represents a row in the application_endpoint table.
+type applicationEndpoint struct {
+	UUID              string `db:"uuid"`
+	ApplicationUUID   string `db:"application_uuid"`
+	CharmRelationUUID string `db:"charm_relation_uuid"`
+}
+
+// applicationRemoteOfferer represents a row in the application_remote_offerer
+// table.
+type applicationRemoteOfferer struct {
+	UUID                  string    `db:"uuid"`
+	LifeID                life.Life `db:"life_id"`
+	ApplicationUUID       string    `db:"application_uuid"`
+	OfferUUID             string    `db:"offer_uuid"`
+	Version               int       `db:"version"`
+	OffererControllerUUID string    `db:"offerer_controller_uuid"`
+	OffererModelUUID      string    `db:"offerer_model_uuid"`
+	Macaroon              string    `db:"macaroon"`
+}
+
+// remoteApplicationConsumer contains the data necessary to create a
+// RemoteApplicationConsumer.
+type remoteApplicationConsumer struct {
+	ApplicationName  string    `db:"application_name"`
+	LifeID           life.Life `db:"life_id"`
+	OfferUUID        string    `db:"offer_uuid"`
+	Version          int       `db:"version"`
+	OffererModelUUID string    `db:"offerer_model_uuid"`
+	Macaroon         string    `db:"macaroon"`
+}
+
+// remoteApplicationOfferer contains the data necessary to create a
+// RemoteApplicationOfferer.
+type remoteApplicationOfferer struct {
+	ApplicationName string    `db:"application_name"`
+	LifeID          life.Life `db:"life_id"`
+	OfferUUID       string    `db:"offer_uuid"`
+	Version         int       `db:"version"`
+}
+
+// relationUUID is a container for a `relation_uuid` column.
+type relationUUID struct {
+	UUID string `db:"relation_uuid"`
+}
+
+// egressSubnetsKey is the model config key holding the model's egress subnets.
+const egressSubnetsKey = "egress-subnets"
+
+// modelConfigKey represents a row in the model_config table.
+type modelConfigKey struct {
+	Key   string `db:"key"`
+	Value string `db:"value"`
+}
+
+// cmrSource is a container for the charm_source id of synthetic charms.
+type cmrSource struct {
+	SourceID int `db:"source_id"`
+}
+
+// relationUnitAddress is an address of a unit in scope of a relation.
+type relationUnitAddress struct {
+	UnitUUID string `db:"unit_uuid"`
+	Value    string `db:"address_value"`
+	Scope    string `db:"scope_name"`
+}
+
+// relationNetwork represents a row in either of the relation_network_ingress
+// or relation_network_egress tables.
+type relationNetwork struct {
+	RelationUUID string `db:"relation_uuid"`
+	CIDR         string `db:"cidr"`
+}
+
+// macaroonValue is a container for a `macaroon` column.
+type macaroonValue struct {
+	Macaroon string `db:"macaroon"`
+}
@@ -134,8 +134,50 @@ type OfferImport struct {
 	Endpoints       []string
 }
 
-// RemoteApplicationConsumer represents a remote application
-// that is consuming an offer from this model.
+// AddRemoteApplicationOffererArgs contains the parameters required to add a
+// remote application offerer to the consuming model. The remote application
+// offerer is a synthetic application that stands in for the offered
+// application, located in another model.
+type AddRemoteApplicationOffererArgs struct {
+	// OfferUUID is the UUID of the offer being consumed.
+	OfferUUID string
+
+	// OffererControllerUUID is the UUID of the controller hosting the
+	// offering model.
+	OffererControllerUUID string
+
+	// OffererModelUUID is the UUID of the model hosting the offer.
+	OffererModelUUID string
+
+	// Endpoints is the collection of endpoints exposed by the offer.
+	Endpoints []charm.Relation
+
+	// Macaroon is the macaroon used to authenticate with the offering model.
+	Macaroon *macaroon.Macaroon
+}
+
+// Validate returns an error if the arguments are not valid.
+func (a AddRemoteApplicationOffererArgs) Validate() error {
+	if a.OfferUUID == "" {
+		return errors.Errorf("offer uuid cannot be empty").Add(coreerrors.NotValid)
+	}
+	if a.OffererControllerUUID == "" {
+		return errors.Errorf("offerer controller uuid cannot be empty").Add(coreerrors.NotValid)
+	}
+	if a.OffererModelUUID == "" {
+		return errors.Errorf("offerer model uuid cannot be empty").Add(coreerrors.NotValid)
+	}
+	if len(a.Endpoints) == 0 {
+		return errors.Errorf("endpoints cannot be empty").Add(coreerrors.NotValid)
+	}
+	if a.Macaroon == nil {
+		return errors.Errorf("macaroon cannot be nil").Add(coreerrors.NotValid)
+	}
+	return nil
+}
+
+// RemoteApplicationConsumer represents a remote application, located in
+// this model, that is consuming an offer from another model.
 type RemoteApplicationConsumer struct {
 	// ApplicationName is the name of the remote application.
 	ApplicationName string
@@ -160,7 +202,8 @@ type RemoteApplicationConsumer struct {
 	Macaroon *macaroon.Macaroon
 }
 
-// RemoteApplicationOfferer represents a remote application
+// RemoteApplicationOfferer represents a remote application, located in
+// another model, that is consuming an offer from this model.
 type RemoteApplicationOfferer struct {
 	// ApplicationName is the name of the remote application.
 	ApplicationName string
@@ -790,6 +790,9 @@ func encodeConstraints(constraintUUID string, cons constraints.Constraints, cont
 		VirtType:         cons.VirtType,
 		ImageID:          cons.ImageID,
 		AllocatePublicIP: cons.AllocatePublicIP,
+		AffinityGroup:    cons.AffinityGroup,
+		AntiAffinity:     cons.AntiAffinity,
+		Accelerators:     cons.Accelerators,
 	}
 	if cons.Container != nil {
 		res.ContainerTypeID = &containerTypeID
@@ -1619,6 +1619,15 @@ func decodeConstraints(cons machineConstraints) constraints.Constraints {
 		if row.ImageID.Valid {
 			res.ImageID = &row.ImageID.String
 		}
+		if row.AffinityGroup.Valid {
+			res.AffinityGroup = &row.AffinityGroup.String
+		}
+		if row.AntiAffinity.Valid {
+			res.AntiAffinity = &row.AntiAffinity.Bool
+		}
+		if row.Accelerators.Valid {
+			res.Accelerators = &row.Accelerators.String
+		}
 		if row.SpaceName.Valid {
 			var exclude bool
 			if row.SpaceExclude.Valid {
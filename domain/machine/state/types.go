@@ -269,6 +269,9 @@ type machineConstraint struct {
 	VirtType         sql.NullString  `db:"virt_type"`
 	AllocatePublicIP sql.NullBool    `db:"allocate_public_ip"`
 	ImageID          sql.NullString  `db:"image_id"`
+	AffinityGroup    sql.NullString  `db:"affinity_group"`
+	AntiAffinity     sql.NullBool    `db:"anti_affinity"`
+	Accelerators     sql.NullString  `db:"accelerators"`
 	SpaceName        sql.NullString  `db:"space_name"`
 	SpaceExclude     sql.NullBool    `db:"space_exclude"`
 	Tag              sql.NullString  `db:"tag"`
@@ -302,6 +305,9 @@ type setConstraint struct {
 	VirtType         *string `db:"virt_type"`
 	AllocatePublicIP *bool   `db:"allocate_public_ip"`
 	ImageID          *string `db:"image_id"`
+	AffinityGroup    *string `db:"affinity_group"`
+	AntiAffinity     *bool   `db:"anti_affinity"`
+	Accelerators     *string `db:"accelerators"`
 }
 
 type setConstraintTag struct {
@@ -342,6 +348,9 @@ type dbConstraint struct {
 	VirtType         sql.NullString  `db:"virt_type"`
 	AllocatePublicIP sql.NullBool    `db:"allocate_public_ip"`
 	ImageID          sql.NullString  `db:"image_id"`
+	AffinityGroup    sql.NullString  `db:"affinity_group"`
+	AntiAffinity     sql.NullBool    `db:"anti_affinity"`
+	Accelerators     sql.NullString  `db:"accelerators"`
 }
 
 func (c dbConstraint) toValue(
@@ -386,6 +395,15 @@ func (c dbConstraint) toValue(
 	if c.ImageID.Valid {
 		rval.ImageID = &c.ImageID.String
 	}
+	if c.AffinityGroup.Valid {
+		rval.AffinityGroup = &c.AffinityGroup.String
+	}
+	if c.AntiAffinity.Valid {
+		rval.AntiAffinity = &c.AntiAffinity.Bool
+	}
+	if c.Accelerators.Valid {
+		rval.Accelerators = &c.Accelerators.String
+	}
 	if c.ContainerType.Valid {
 		containerType := instance.ContainerType(c.ContainerType.String)
 		rval.Container = &containerType
@@ -364,30 +364,53 @@ func (i *importModelOperation) Rollback(ctx context.Context, model description.M
 		return errors.Errorf("rollback of model during migration %w", coreerrors.NotValid)
 	}
 
+	if err := i.deleteModel(ctx, modelID); err != nil {
+		return errors.Errorf(
+			"rollback of model %q with uuid %q during migration: %w",
+			modelName, modelID, err,
+		)
+	}
+	return nil
+}
+
+// deleteModel removes the model from the model database and the controller,
+// along with the model database itself.
+func (i *importModelOperation) deleteModel(ctx context.Context, modelID coremodel.UUID) error {
 	// If the model is not found, or the underlying db is not found, we can
 	// ignore the error.
 	if err := i.modelDetailServiceFunc(modelID).DeleteModel(ctx); err != nil &&
 		!errors.Is(err, modelerrors.NotFound) &&
 		!errors.Is(err, coredatabase.ErrDBNotFound) {
-		return errors.Errorf(
-			"rollback of read only model %q with uuid %q during migration: %w",
-			modelName, modelID, err,
-		)
+		return errors.Errorf("deleting read only model: %w", err)
 	}
 
 	// If the model isn't found, we can simply ignore the error.
 	if err := i.modelImportService.DeleteModel(ctx, modelID, domainmodel.WithDeleteDB()); err != nil &&
 		!errors.Is(err, modelerrors.NotFound) &&
 		!errors.Is(err, coredatabase.ErrDBNotFound) {
-		return errors.Errorf(
-			"rollback of model %q with uuid %q during migration: %w",
-			modelName, modelID, err,
-		)
+		return errors.Errorf("deleting model: %w", err)
 	}
 
 	return nil
 }
 
+// DeleteImportedModel removes a model imported into this controller, along
+// with its database, in the same way that a failed import is rolled back. It
+// is used when the import of the model is aborted after the import itself
+// succeeded. No cloud resources of the model are touched.
+func DeleteImportedModel(
+	ctx context.Context,
+	scope modelmigration.Scope,
+	modelID coremodel.UUID,
+	logger logger.Logger,
+) error {
+	op := &importModelOperation{logger: logger}
+	if err := op.Setup(scope); err != nil {
+		return errors.Capture(err)
+	}
+	return op.deleteModel(ctx, modelID)
+}
+
 func (i *importModelOperation) getModelNameAndID(model description.Model) (string, coremodel.UUID, error) {
 	modelConfig := model.Config()
 	if modelConfig == nil {
@@ -71,7 +71,19 @@ func (s *MigrationService) ImportModel(
 		)
 	}
 
-	return createModel(ctx, s.st, args.UUID, args.GlobalModelCreationArgs)
+	activator, err := createModel(ctx, s.st, args.UUID, args.GlobalModelCreationArgs)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	// The model remains in the importing migration mode until the migration
+	// activates or aborts the import.
+	if err := s.st.SetModelImporting(ctx, args.UUID); err != nil {
+		return nil, errors.Errorf(
+			"setting model %q as importing: %w", args.UUID, err,
+		)
+	}
+	return activator, nil
 }
 
 // DeleteModel is responsible for removing a model from Juju and all of it's
@@ -96,6 +96,9 @@ func (s *migrationServiceSuite) TestImportModel(c *tc.C) {
 
 	_, exists := s.state.models[modelID]
 	c.Assert(exists, tc.IsTrue)
+
+	_, importing := s.state.importingModels[modelID]
+	c.Check(importing, tc.IsTrue)
 }
 
 func (s *migrationServiceSuite) TestDeleteModel(c *tc.C) {
@@ -1999,6 +1999,44 @@ func (c *MockStateListModelsForUserCall) DoAndReturn(f func(context.Context, use
 	return c
 }
 
+// SetModelImporting mocks base method.
+func (m *MockState) SetModelImporting(arg0 context.Context, arg1 model.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetModelImporting", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetModelImporting indicates an expected call of SetModelImporting.
+func (mr *MockStateMockRecorder) SetModelImporting(arg0, arg1 any) *MockStateSetModelImportingCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetModelImporting", reflect.TypeOf((*MockState)(nil).SetModelImporting), arg0, arg1)
+	return &MockStateSetModelImportingCall{Call: call}
+}
+
+// MockStateSetModelImportingCall wrap *gomock.Call
+type MockStateSetModelImportingCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateSetModelImportingCall) Return(arg0 error) *MockStateSetModelImportingCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateSetModelImportingCall) Do(f func(context.Context, model.UUID) error) *MockStateSetModelImportingCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateSetModelImportingCall) DoAndReturn(f func(context.Context, model.UUID) error) *MockStateSetModelImportingCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateCredential mocks base method.
 func (m *MockState) UpdateCredential(arg0 context.Context, arg1 model.UUID, arg2 credential.Key) error {
 	m.ctrl.T.Helper()
@@ -119,6 +119,13 @@ type State interface {
 	// Delete removes a model and all of it's associated data from Juju.
 	Delete(context.Context, coremodel.UUID) error
 
+	// SetModelImporting records that the model is being imported into the
+	// controller by a model migration. The model stays in the importing
+	// migration mode until the import is either activated or aborted.
+	// If no model exists for the provided id then a [modelerrors.NotFound]
+	// will be returned.
+	SetModelImporting(context.Context, coremodel.UUID) error
+
 	// ListAllModels returns all models registered in the controller. If no
 	// models exist a zero value slice will be returned.
 	ListAllModels(context.Context) ([]coremodel.Model, error)
@@ -38,6 +38,7 @@ type dummyState struct {
 	users               map[user.UUID]user.Name
 	secretBackends      []string
 	controllerModelUUID coremodel.UUID
+	importingModels     map[coremodel.UUID]struct{}
 }
 
 func (d *dummyState) CheckModelExists(ctx context.Context, uuid coremodel.UUID) (bool, error) {
@@ -221,6 +222,22 @@ func (d *dummyState) Delete(
 	return nil
 }
 
+func (d *dummyState) SetModelImporting(
+	_ context.Context,
+	uuid coremodel.UUID,
+) error {
+	_, activated := d.models[uuid]
+	_, nonActivated := d.nonActivatedModels[uuid]
+	if !activated && !nonActivated {
+		return errors.Errorf("%w %q", modelerrors.NotFound, uuid)
+	}
+	if d.importingModels == nil {
+		d.importingModels = map[coremodel.UUID]struct{}{}
+	}
+	d.importingModels[uuid] = struct{}{}
+	return nil
+}
+
 func (d *dummyState) ListAllModels(
 	_ context.Context,
 ) ([]coremodel.Model, error) {
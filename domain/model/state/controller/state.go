@@ -774,9 +774,11 @@ func (s *State) Delete(
 	queries := []string{
 		`DELETE FROM model_secret_backend WHERE model_uuid = $dbUUID.uuid`,
 		`DELETE FROM secret_backend_reference WHERE model_uuid = $dbUUID.uuid`,
+		`DELETE FROM secret_encryption_key_reference WHERE model_uuid = $dbUUID.uuid`,
 		`DELETE FROM model_authorized_keys WHERE model_uuid = $dbUUID.uuid`,
 		`DELETE FROM permission WHERE grant_on = $dbUUID.uuid`,
 		`DELETE FROM model_last_login WHERE model_uuid = $dbUUID.uuid`,
+		`DELETE FROM model_migration_import WHERE model_uuid = $dbUUID.uuid`,
 	}
 
 	var stmts []*sqlair.Statement
@@ -845,6 +847,49 @@ func (s *State) Activate(ctx context.Context, uuid coremodel.UUID) error {
 	})
 }
 
+// SetModelImporting records that the model is being imported into the
+// controller by a model migration. If no model exists for the provided id
+// then a [modelerrors.NotFound] will be returned.
+func (s *State) SetModelImporting(ctx context.Context, uuid coremodel.UUID) error {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	mUUID := dbUUID{UUID: uuid.String()}
+
+	existsStmt, err := s.Prepare(`
+SELECT &dbUUID.uuid
+FROM   model
+WHERE  uuid = $dbUUID.uuid
+`, mUUID)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	stmt, err := s.Prepare(`
+INSERT INTO model_migration_import (model_uuid)
+VALUES ($dbUUID.uuid)
+ON CONFLICT (model_uuid) DO NOTHING
+`, mUUID)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := tx.Query(ctx, existsStmt, mUUID).Get(&mUUID); errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("%w for id %q", modelerrors.NotFound, uuid)
+		} else if err != nil {
+			return errors.Errorf("checking model with id %q exists: %w", uuid, err)
+		}
+
+		if err := tx.Query(ctx, stmt, mUUID).Run(); err != nil {
+			return errors.Errorf("setting model with id %q as importing: %w", uuid, err)
+		}
+		return nil
+	})
+}
+
 // ActivatorFunc is responsible for setting a model as fully constructed and
 // indicates the final system state for the model is ready for use. This is used
 // because the model creation process involves several transactions with which
@@ -892,6 +892,36 @@ WHERE model_uuid = ?
 	c.Assert(row.Scan(nil), tc.ErrorIs, sql.ErrNoRows)
 }
 
+// TestSetModelImporting asserts that a model can be recorded as importing and
+// that the record is removed along with the model.
+func (m *stateSuite) TestSetModelImporting(c *tc.C) {
+	modelSt := NewState(m.TxnRunnerFactory())
+	err := modelSt.SetModelImporting(c.Context(), m.uuid)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Setting the model as importing is idempotent.
+	err = modelSt.SetModelImporting(c.Context(), m.uuid)
+	c.Assert(err, tc.ErrorIsNil)
+
+	var count int
+	row := m.DB().QueryRow("SELECT COUNT(*) FROM model_migration_import WHERE model_uuid = ?", m.uuid)
+	c.Assert(row.Scan(&count), tc.ErrorIsNil)
+	c.Check(count, tc.Equals, 1)
+
+	err = modelSt.Delete(c.Context(), m.uuid)
+	c.Assert(err, tc.ErrorIsNil)
+
+	row = m.DB().QueryRow("SELECT COUNT(*) FROM model_migration_import WHERE model_uuid = ?", m.uuid)
+	c.Assert(row.Scan(&count), tc.ErrorIsNil)
+	c.Check(count, tc.Equals, 0)
+}
+
+func (m *stateSuite) TestSetModelImportingNotFound(c *tc.C) {
+	modelSt := NewState(m.TxnRunnerFactory())
+	err := modelSt.SetModelImporting(c.Context(), modeltesting.GenModelUUID(c))
+	c.Assert(err, tc.ErrorIs, modelerrors.NotFound)
+}
+
 func (m *stateSuite) TestDeleteModelNotFound(c *tc.C) {
 	uuid := modeltesting.GenModelUUID(c)
 	modelSt := NewState(m.TxnRunnerFactory())
@@ -125,6 +125,9 @@ type dbConstraint struct {
 	VirtType         sql.NullString `db:"virt_type"`
 	AllocatePublicIP sql.NullBool   `db:"allocate_public_ip"`
 	ImageID          sql.NullString `db:"image_id"`
+	AffinityGroup    sql.NullString `db:"affinity_group"`
+	AntiAffinity     sql.NullBool   `db:"anti_affinity"`
+	Accelerators     sql.NullString `db:"accelerators"`
 }
 
 // dbConstraintInsert is used to supply insert values into the constraint table.
@@ -142,6 +145,9 @@ type dbConstraintInsert struct {
 	VirtType         sql.NullString `db:"virt_type"`
 	AllocatePublicIP sql.NullBool   `db:"allocate_public_ip"`
 	ImageID          sql.NullString `db:"image_id"`
+	AffinityGroup    sql.NullString `db:"affinity_group"`
+	AntiAffinity     sql.NullBool   `db:"anti_affinity"`
+	Accelerators     sql.NullString `db:"accelerators"`
 }
 
 // constraintsToDBInsert is responsible for taking a constraints value and
@@ -196,6 +202,18 @@ func constraintsToDBInsert(
 			String: deref(constraints.ImageID),
 			Valid:  constraints.ImageID != nil,
 		},
+		AffinityGroup: sql.NullString{
+			String: deref(constraints.AffinityGroup),
+			Valid:  constraints.AffinityGroup != nil,
+		},
+		AntiAffinity: sql.NullBool{
+			Bool:  deref(constraints.AntiAffinity),
+			Valid: constraints.AntiAffinity != nil,
+		},
+		Accelerators: sql.NullString{
+			String: deref(constraints.Accelerators),
+			Valid:  constraints.Accelerators != nil,
+		},
 	}
 }
 
@@ -255,6 +273,15 @@ func (c dbConstraint) toValue(
 	if c.ImageID.Valid {
 		rval.ImageID = &c.ImageID.String
 	}
+	if c.AffinityGroup.Valid {
+		rval.AffinityGroup = &c.AffinityGroup.String
+	}
+	if c.AntiAffinity.Valid {
+		rval.AntiAffinity = &c.AntiAffinity.Bool
+	}
+	if c.Accelerators.Valid {
+		rval.Accelerators = &c.Accelerators.String
+	}
 	if c.ContainerType.Valid {
 		containerType := instance.ContainerType(c.ContainerType.String)
 		rval.Container = &containerType
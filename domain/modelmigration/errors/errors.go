@@ -0,0 +1,24 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package errors
+
+import "github.com/juju/juju/internal/errors"
+
+const (
+	// MigrationNotFound describes an error that occurs when there is no
+	// migration, or no active migration, for a model.
+	MigrationNotFound = errors.ConstError("migration not found")
+
+	// MigrationInProgress describes an error that occurs when a migration is
+	// initiated for a model that already has an active migration.
+	MigrationInProgress = errors.ConstError("migration in progress")
+
+	// PhaseTransitionNotValid describes an error that occurs when the phase
+	// of a migration cannot be advanced to the requested phase.
+	PhaseTransitionNotValid = errors.ConstError("migration phase transition not valid")
+
+	// ModelNotImporting describes an error that occurs when an import
+	// operation is performed against a model that is not being imported.
+	ModelNotImporting = errors.ConstError("model not importing")
+)
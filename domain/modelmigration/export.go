@@ -27,6 +27,7 @@ import (
 	relation "github.com/juju/juju/domain/relation/modelmigration"
 	resource "github.com/juju/juju/domain/resource/modelmigration"
 	secret "github.com/juju/juju/domain/secret/modelmigration"
+	secretservice "github.com/juju/juju/domain/secret/service"
 	sequence "github.com/juju/juju/domain/sequence/modelmigration"
 	status "github.com/juju/juju/domain/status/modelmigration"
 	storage "github.com/juju/juju/domain/storage/modelmigration"
@@ -40,6 +41,7 @@ type Exporter struct {
 	coordinator           Coordinator
 	storageRegistryGetter corestorage.ModelStorageRegistryGetter
 	objectStoreGetter     objectstore.ModelObjectStoreGetter
+	encryptionKeySource   secretservice.EncryptionKeySource
 	clock                 clock.Clock
 	logger                logger.Logger
 }
@@ -51,14 +53,16 @@ func NewExporter(
 	coordinator Coordinator,
 	storageRegistryGetter corestorage.ModelStorageRegistryGetter,
 	objectStoreGetter objectstore.ModelObjectStoreGetter,
+	encryptionKeySource secretservice.EncryptionKeySource,
 	clock clock.Clock,
 	logger logger.Logger,
 ) *Exporter {
 	return &Exporter{
-		coordinator:       coordinator,
-		objectStoreGetter: objectStoreGetter,
-		clock:             clock,
-		logger:            logger,
+		coordinator:         coordinator,
+		objectStoreGetter:   objectStoreGetter,
+		encryptionKeySource: encryptionKeySource,
+		clock:               clock,
+		logger:              logger,
 	}
 }
 
@@ -78,7 +82,7 @@ func (e *Exporter) ExportOperations(registry corestorage.ModelStorageRegistryGet
 	machine.RegisterExport(e.coordinator, e.clock, e.logger.Child("machine"))
 	blockdevice.RegisterExport(e.coordinator, e.logger.Child("blockdevice"))
 	storage.RegisterExport(e.coordinator, registry, e.logger.Child("storage"))
-	secret.RegisterExport(e.coordinator, e.logger.Child("secret"))
+	secret.RegisterExport(e.coordinator, e.encryptionKeySource, e.logger.Child("secret"))
 	application.RegisterExport(e.coordinator, e.storageRegistryGetter, e.clock, e.logger.Child("application"))
 	relation.RegisterExport(e.coordinator, e.clock, e.logger.Child("relation"))
 	lease.RegisterExport(e.coordinator, e.logger.Child("lease"))
@@ -31,6 +31,7 @@ import (
 	relation "github.com/juju/juju/domain/relation/modelmigration"
 	resource "github.com/juju/juju/domain/resource/modelmigration"
 	secret "github.com/juju/juju/domain/secret/modelmigration"
+	secretservice "github.com/juju/juju/domain/secret/service"
 	sequence "github.com/juju/juju/domain/sequence/modelmigration"
 	status "github.com/juju/juju/domain/status/modelmigration"
 	storage "github.com/juju/juju/domain/storage/modelmigration"
@@ -51,6 +52,7 @@ func ImportOperations(
 	modelDefaultsProvider modelconfigservice.ModelDefaultsProvider,
 	storageRegistryGetter corestorage.ModelStorageRegistryGetter,
 	objectStoreGetter objectstore.ModelObjectStoreGetter,
+	encryptionKeySource secretservice.EncryptionKeySource,
 	clock clock.Clock,
 	logger logger.Logger,
 ) {
@@ -88,7 +90,7 @@ func ImportOperations(
 	blockdevice.RegisterImport(coordinator, logger.Child("blockdevice"))
 	// TODO(storage) - we need to break out storage pools and import BEFORE applications.
 	storage.RegisterImport(coordinator, storageRegistryGetter, logger.Child("storage"))
-	secret.RegisterImport(coordinator, logger.Child("secret"))
+	secret.RegisterImport(coordinator, encryptionKeySource, logger.Child("secret"))
 	cloudimagemetadata.RegisterImport(coordinator, logger.Child("cloudimagemetadata"), clock)
 	unitstate.RegisterImport(coordinator)
 
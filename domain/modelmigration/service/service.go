@@ -5,20 +5,29 @@ package service
 
 import (
 	"context"
+	"time"
 
+	"github.com/juju/clock"
 	"github.com/juju/collections/set"
+	"github.com/juju/names/v6"
 
+	"github.com/juju/juju/core/changestream"
 	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/logger"
 	"github.com/juju/juju/core/machine"
 	"github.com/juju/juju/core/migration"
+	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/providertracker"
 	"github.com/juju/juju/core/semversion"
 	"github.com/juju/juju/core/trace"
 	"github.com/juju/juju/core/unit"
 	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/core/watcher/eventsource"
 	"github.com/juju/juju/domain/modelmigration"
+	modelmigrationerrors "github.com/juju/juju/domain/modelmigration/errors"
 	"github.com/juju/juju/environs/instances"
 	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/uuid"
 )
 
 // InstanceProvider describes the interface that is needed from the cloud provider to
@@ -48,6 +57,11 @@ type ResourceProvider interface {
 // controllers and answering questions about the underlying model(s) that are
 // being migrated.
 type Service struct {
+	modelUUID model.UUID
+
+	controllerState ControllerState
+	modelState      ModelState
+
 	// instanceProviderGetter is a getter for getting access to the model's
 	// [InstanceProvider].
 	instanceProviderGetter func(context.Context) (InstanceProvider, error)
@@ -56,29 +70,111 @@ type Service struct {
 	// [ResourceProvider]
 	resourceProviderGettter func(context.Context) (ResourceProvider, error)
 
-	st State
+	watcherFactory WatcherFactory
+	clock          clock.Clock
+	logger         logger.Logger
+}
+
+// ControllerState defines the interface required for accessing the migrations
+// of the model recorded in the controller database.
+type ControllerState interface {
+	// InitiateMigration records a new migration of the model to the target
+	// controller, starting in the QUIESCE phase.
+	InitiateMigration(
+		ctx context.Context,
+		migrationUUID string,
+		modelUUID string,
+		target migration.TargetInfo,
+		initiatedBy string,
+		startTime time.Time,
+	) error
+
+	// GetLatestMigration returns the most recent migration attempt for the
+	// model, returning [modelmigrationerrors.MigrationNotFound] if the model
+	// has never been migrated from this controller.
+	GetLatestMigration(ctx context.Context, modelUUID string) (modelmigration.Migration, error)
+
+	// SetMigrationPhase moves the migration from one phase to another,
+	// returning [modelmigrationerrors.PhaseTransitionNotValid] if the
+	// migration is no longer in the from phase.
+	SetMigrationPhase(ctx context.Context, migrationUUID string, from, to migration.Phase, changedTime time.Time) error
+
+	// SetMigrationStatusMessage sets the human readable status message of the
+	// migration.
+	SetMigrationStatusMessage(ctx context.Context, migrationUUID, message string) error
+
+	// RecordMinionReport records the report of an agent for a phase of the
+	// migration.
+	RecordMinionReport(
+		ctx context.Context,
+		migrationUUID string,
+		phase migration.Phase,
+		entityKey string,
+		success bool,
+		reportTime time.Time,
+	) error
+
+	// GetMinionReports returns the reports made by agents for the phase of
+	// the migration, keyed on the entity key of the reporting agent.
+	GetMinionReports(ctx context.Context, migrationUUID string, phase migration.Phase) (map[string]bool, error)
+
+	// IsModelImporting returns true if the model is being imported into this
+	// controller.
+	IsModelImporting(ctx context.Context, modelUUID string) (bool, error)
+
+	// ActivateImport marks the import of the model as complete.
+	ActivateImport(ctx context.Context, modelUUID string) error
 }
 
-// State defines the interface required for accessing the underlying state of
-// the model during migration.
-type State interface {
+// ModelState defines the interface required for accessing the underlying
+// state of the model during migration.
+type ModelState interface {
 	GetControllerUUID(context.Context) (string, error)
 	// GetAllInstanceIDs returns all instance IDs from the current model as
 	// juju/collections set.
 	GetAllInstanceIDs(ctx context.Context) (set.Strings, error)
+
+	// GetAllMachineNames returns the names of all the machines in the model
+	// that are not dead.
+	GetAllMachineNames(ctx context.Context) ([]string, error)
+
+	// GetAllUnitNames returns the names of all the units in the model that
+	// are not dead.
+	GetAllUnitNames(ctx context.Context) ([]string, error)
+}
+
+// WatcherFactory describes the methods required for creating new watchers
+// for model migrations.
+type WatcherFactory interface {
+	// NewNotifyWatcher returns a new watcher that filters changes from the
+	// input base watcher's db/queue. Change-log events will be emitted only if
+	// the filter accepts them, and dispatching the notifications via the
+	// Changes channel. A filter option is required, though additional filter
+	// options can be provided.
+	NewNotifyWatcher(context.Context, string, eventsource.FilterOption, ...eventsource.FilterOption) (watcher.NotifyWatcher, error)
 }
 
 // NewService is responsible for constructing a new [Service] to handle model migration
 // tasks.
 func NewService(
+	modelUUID model.UUID,
+	controllerState ControllerState,
+	modelState ModelState,
 	instanceProviderGetter providertracker.ProviderGetter[InstanceProvider],
 	resourceProviderGetter providertracker.ProviderGetter[ResourceProvider],
-	st State,
+	watcherFactory WatcherFactory,
+	clock clock.Clock,
+	logger logger.Logger,
 ) *Service {
 	return &Service{
+		modelUUID:               modelUUID,
+		controllerState:         controllerState,
+		modelState:              modelState,
 		instanceProviderGetter:  instanceProviderGetter,
 		resourceProviderGettter: resourceProviderGetter,
-		st:                      st,
+		watcherFactory:          watcherFactory,
+		clock:                   clock,
+		logger:                  logger,
 	}
 }
 
@@ -103,7 +199,7 @@ func (s *Service) AdoptResources(
 		)
 	}
 
-	controllerUUID, err := s.st.GetControllerUUID(ctx)
+	controllerUUID, err := s.modelState.GetControllerUUID(ctx)
 	if err != nil {
 		return errors.Errorf(
 			"cannot get controller uuid while adopting model cloud resources: %w",
@@ -164,7 +260,7 @@ func (s *Service) CheckMachines(
 		providerInstanceIDsSet.Add(instance.Id().String())
 	}
 
-	instanceIDsSet, err := s.st.GetAllInstanceIDs(ctx)
+	instanceIDsSet, err := s.modelState.GetAllInstanceIDs(ctx)
 	if err != nil {
 		return nil, errors.Errorf("cannot get all instance IDs for model when checking machines: %w", err)
 	}
@@ -183,112 +279,304 @@ func (s *Service) CheckMachines(
 
 // ModelMigrationMode returns the current migration mode for the model.
 func (s *Service) ModelMigrationMode(ctx context.Context) (modelmigration.MigrationMode, error) {
-	_, span := trace.Start(ctx, trace.NameFromFunc())
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
 	defer span.End()
-	// TODO(modelmigration): implement migration mode reporting.
-	return modelmigration.MigrationModeNone, nil
+
+	importing, err := s.controllerState.IsModelImporting(ctx, s.modelUUID.String())
+	if err != nil {
+		return modelmigration.MigrationModeNone, errors.Errorf("checking if model %q is importing: %w", s.modelUUID, err)
+	} else if importing {
+		return modelmigration.MigrationModeImporting, nil
+	}
+
+	mig, err := s.controllerState.GetLatestMigration(ctx, s.modelUUID.String())
+	if errors.Is(err, modelmigrationerrors.MigrationNotFound) {
+		return modelmigration.MigrationModeNone, nil
+	} else if err != nil {
+		return modelmigration.MigrationModeNone, errors.Errorf("getting latest migration for model %q: %w", s.modelUUID, err)
+	}
+
+	// A model that has been aborted is returned to normal use. Every other
+	// phase, including the terminal phases of a successful migration, leaves
+	// the model exporting.
+	if mig.Phase == migration.ABORTDONE {
+		return modelmigration.MigrationModeNone, nil
+	}
+	return modelmigration.MigrationModeExporting, nil
 }
 
-// Migration returns status about migration of this model.
+// Migration returns status about migration of this model. If the model has
+// never been migrated from this controller, a migration in the NONE phase is
+// returned.
 func (s *Service) Migration(ctx context.Context) (modelmigration.Migration, error) {
-	_, span := trace.Start(ctx, trace.NameFromFunc())
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
 	defer span.End()
-	// TODO(modelmigration): implement migration info reporting.
-	return modelmigration.Migration{
-		Phase: migration.NONE,
-	}, nil
+
+	mig, err := s.controllerState.GetLatestMigration(ctx, s.modelUUID.String())
+	if errors.Is(err, modelmigrationerrors.MigrationNotFound) {
+		return modelmigration.Migration{
+			Phase: migration.NONE,
+		}, nil
+	} else if err != nil {
+		return modelmigration.Migration{}, errors.Errorf("getting latest migration for model %q: %w", s.modelUUID, err)
+	}
+	return mig, nil
 }
 
-// InitiateMigration kicks off migrating this model to the target controller.
+// InitiateMigration kicks off migrating this model to the target controller,
+// returning the UUID of the new migration.
+// The following errors can be expected:
+// - [coreerrors.NotValid] when the target information is not valid.
+// - [modelmigrationerrors.MigrationInProgress] when the model already has an
+// active migration.
 func (s *Service) InitiateMigration(ctx context.Context, targetInfo migration.TargetInfo, userName string) (string, error) {
-	_, span := trace.Start(ctx, trace.NameFromFunc())
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
 	defer span.End()
-	// TODO(modelmigration): implement migration info reporting.
-	return "", errors.ConstError("migration is not implemented")
+
+	if err := targetInfo.Validate(); err != nil {
+		return "", errors.Errorf("validating migration target: %w", err)
+	}
+
+	migrationUUID, err := uuid.NewUUID()
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	err = s.controllerState.InitiateMigration(
+		ctx,
+		migrationUUID.String(),
+		s.modelUUID.String(),
+		targetInfo,
+		userName,
+		s.clock.Now().UTC(),
+	)
+	if err != nil {
+		return "", errors.Errorf("initiating migration of model %q: %w", s.modelUUID, err)
+	}
+
+	s.logger.Infof(ctx, "initiated migration %q of model %q to controller %q",
+		migrationUUID, s.modelUUID, targetInfo.ControllerUUID)
+	return migrationUUID.String(), nil
 }
 
 // WatchForMigration returns a notification watcher that fires when this model
 // undergoes migration.
 func (s *Service) WatchForMigration(ctx context.Context) (watcher.NotifyWatcher, error) {
-	_, span := trace.Start(ctx, trace.NameFromFunc())
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
 	defer span.End()
-	// TODO(modelmigration): implement migration watcher.
-	return watcher.TODO[struct{}](), nil
+
+	return s.watcherFactory.NewNotifyWatcher(
+		ctx,
+		"migration watcher",
+		eventsource.PredicateFilter(
+			"model_migration_status",
+			changestream.All,
+			eventsource.EqualsPredicate(s.modelUUID.String()),
+		),
+	)
 }
 
 // WatchMigrationPhase returns a notification watcher that fires when this
 // model's migration phase changes.
 func (s *Service) WatchMigrationPhase(ctx context.Context) (watcher.NotifyWatcher, error) {
-	_, span := trace.Start(ctx, trace.NameFromFunc())
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
 	defer span.End()
-	// TODO(modelmigration): implement migration watcher.
-	return watcher.TODO[struct{}](), nil
+
+	return s.watcherFactory.NewNotifyWatcher(
+		ctx,
+		"migration phase watcher",
+		eventsource.PredicateFilter(
+			"model_migration_status",
+			changestream.All,
+			eventsource.EqualsPredicate(s.modelUUID.String()),
+		),
+	)
 }
 
 // ReportFromUnit accepts a phase report from a migration minion for a unit
 // agent.
-func (s *Service) ReportFromUnit(ctx context.Context, unitName unit.Name, phase migration.Phase) error {
-	_, span := trace.Start(ctx, trace.NameFromFunc())
+// The following errors can be expected:
+// - [modelmigrationerrors.MigrationNotFound] when the model is not being
+// migrated.
+func (s *Service) ReportFromUnit(ctx context.Context, unitName unit.Name, phase migration.Phase, success bool) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
 	defer span.End()
-	// TODO(modelmigration): implement reporting phase from a unit.
-	return errors.ConstError("migration report from a unit is not implemented")
+
+	return s.recordMinionReport(ctx, names.NewUnitTag(unitName.String()), phase, success)
 }
 
 // ReportFromMachine accepts a phase report from a migration minion for a
 // machine agent.
-func (s *Service) ReportFromMachine(ctx context.Context, machineName machine.Name, phase migration.Phase) error {
-	_, span := trace.Start(ctx, trace.NameFromFunc())
+// The following errors can be expected:
+// - [modelmigrationerrors.MigrationNotFound] when the model is not being
+// migrated.
+func (s *Service) ReportFromMachine(ctx context.Context, machineName machine.Name, phase migration.Phase, success bool) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
 	defer span.End()
-	// TODO(modelmigration): implement reporting phase from a machine.
-	return errors.ConstError("migration report from a machine is not implemented")
+
+	return s.recordMinionReport(ctx, names.NewMachineTag(machineName.String()), phase, success)
+}
+
+func (s *Service) recordMinionReport(ctx context.Context, tag names.Tag, phase migration.Phase, success bool) error {
+	mig, err := s.controllerState.GetLatestMigration(ctx, s.modelUUID.String())
+	if err != nil {
+		return errors.Errorf("getting latest migration for model %q: %w", s.modelUUID, err)
+	}
+
+	err = s.controllerState.RecordMinionReport(ctx, mig.UUID, phase, tag.String(), success, s.clock.Now().UTC())
+	if err != nil {
+		return errors.Errorf("recording migration report from %q: %w", tag, err)
+	}
+	return nil
 }
 
 // SetMigrationPhase is called by the migration master to progress migration.
+// Setting the phase the migration is already in is a no-op.
+// The following errors can be expected:
+// - [modelmigrationerrors.MigrationNotFound] when the model is not being
+// migrated.
+// - [modelmigrationerrors.PhaseTransitionNotValid] when the migration cannot
+// move from its current phase to the requested phase.
 func (s *Service) SetMigrationPhase(ctx context.Context, phase migration.Phase) error {
-	_, span := trace.Start(ctx, trace.NameFromFunc())
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
 	defer span.End()
-	// TODO(modelmigration): implement reporting phase from migration master.
-	return errors.ConstError("setting migration phase is not implemented")
+
+	mig, err := s.controllerState.GetLatestMigration(ctx, s.modelUUID.String())
+	if err != nil {
+		return errors.Errorf("getting latest migration for model %q: %w", s.modelUUID, err)
+	}
+
+	if mig.Phase == phase {
+		return nil
+	} else if mig.Phase.IsTerminal() {
+		return errors.Errorf(
+			"migration %q of model %q has already ended", mig.UUID, s.modelUUID,
+		).Add(modelmigrationerrors.MigrationNotFound)
+	} else if !mig.Phase.CanTransitionTo(phase) {
+		return errors.Errorf(
+			"illegal phase change: %s -> %s", mig.Phase, phase,
+		).Add(modelmigrationerrors.PhaseTransitionNotValid)
+	}
+
+	err = s.controllerState.SetMigrationPhase(ctx, mig.UUID, mig.Phase, phase, s.clock.Now().UTC())
+	if err != nil {
+		return errors.Errorf("setting phase of migration %q: %w", mig.UUID, err)
+	}
+
+	s.logger.Infof(ctx, "migration %q of model %q moved from %s to %s", mig.UUID, s.modelUUID, mig.Phase, phase)
+	return nil
 }
 
 // SetMigrationStatusMessage is called by the migration master to report on
 // migration status.
+// The following errors can be expected:
+// - [modelmigrationerrors.MigrationNotFound] when the model has never been
+// migrated.
 func (s *Service) SetMigrationStatusMessage(ctx context.Context, message string) error {
-	_, span := trace.Start(ctx, trace.NameFromFunc())
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
 	defer span.End()
-	// TODO(modelmigration): implement setting migration status message.
-	return errors.ConstError("setting migration status message is not implemented")
+
+	mig, err := s.controllerState.GetLatestMigration(ctx, s.modelUUID.String())
+	if err != nil {
+		return errors.Errorf("getting latest migration for model %q: %w", s.modelUUID, err)
+	}
+
+	if err := s.controllerState.SetMigrationStatusMessage(ctx, mig.UUID, message); err != nil {
+		return errors.Errorf("setting status message of migration %q: %w", mig.UUID, err)
+	}
+	return nil
 }
 
 // WatchMinionReports returns a notification watcher that fires when any minion
 // reports a update to their phase.
 func (s *Service) WatchMinionReports(ctx context.Context) (watcher.NotifyWatcher, error) {
-	_, span := trace.Start(ctx, trace.NameFromFunc())
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
 	defer span.End()
-	// TODO(modelmigration): implement watching minion reports.
-	return watcher.TODO[struct{}](), nil
+
+	return s.watcherFactory.NewNotifyWatcher(
+		ctx,
+		"migration minion reports watcher",
+		eventsource.PredicateFilter(
+			"model_migration_minion_sync",
+			changestream.All,
+			eventsource.EqualsPredicate(s.modelUUID.String()),
+		),
+	)
 }
 
-// MinionReports returns phase information about minions in this model.
+// MinionReports returns phase information about minions in this model for the
+// current phase of the migration.
+// The following errors can be expected:
+// - [modelmigrationerrors.MigrationNotFound] when the model has never been
+// migrated.
 func (s *Service) MinionReports(ctx context.Context) (migration.MinionReports, error) {
-	_, span := trace.Start(ctx, trace.NameFromFunc())
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
 	defer span.End()
-	// TODO(modelmigration): implement getting minion reports.
-	return migration.MinionReports{}, errors.ConstError("getting minion reports is not implemented")
-}
 
-// AbortImport stops the import of the model.
-func (s *Service) AbortImport(ctx context.Context) error {
-	_, span := trace.Start(ctx, trace.NameFromFunc())
-	defer span.End()
-	// TODO(modelmigration): implement aborting model import.
-	return errors.ConstError("aborting the import of a model is not implemented")
+	mig, err := s.controllerState.GetLatestMigration(ctx, s.modelUUID.String())
+	if err != nil {
+		return migration.MinionReports{}, errors.Errorf("getting latest migration for model %q: %w", s.modelUUID, err)
+	}
+
+	reported, err := s.controllerState.GetMinionReports(ctx, mig.UUID, mig.Phase)
+	if err != nil {
+		return migration.MinionReports{}, errors.Errorf("getting minion reports for migration %q: %w", mig.UUID, err)
+	}
+
+	machineNames, err := s.modelState.GetAllMachineNames(ctx)
+	if err != nil {
+		return migration.MinionReports{}, errors.Errorf("getting machines of model %q: %w", s.modelUUID, err)
+	}
+
+	unitNames, err := s.modelState.GetAllUnitNames(ctx)
+	if err != nil {
+		return migration.MinionReports{}, errors.Errorf("getting units of model %q: %w", s.modelUUID, err)
+	}
+
+	reports := migration.MinionReports{
+		MigrationId: mig.UUID,
+		Phase:       mig.Phase,
+	}
+	for _, name := range machineNames {
+		success, ok := reported[names.NewMachineTag(name).String()]
+		switch {
+		case !ok:
+			reports.UnknownCount++
+			reports.SomeUnknownMachines = append(reports.SomeUnknownMachines, name)
+		case success:
+			reports.SuccessCount++
+		default:
+			reports.FailedMachines = append(reports.FailedMachines, name)
+		}
+	}
+	for _, name := range unitNames {
+		success, ok := reported[names.NewUnitTag(name).String()]
+		switch {
+		case !ok:
+			reports.UnknownCount++
+			reports.SomeUnknownUnits = append(reports.SomeUnknownUnits, name)
+		case success:
+			reports.SuccessCount++
+		default:
+			reports.FailedUnits = append(reports.FailedUnits, name)
+		}
+	}
+	return reports, nil
 }
 
-// ActivateImport finalises the import of the model.
+// ActivateImport finalises the import of the model, taking it out of the
+// importing migration mode.
+// The following errors can be expected:
+// - [modelmigrationerrors.ModelNotImporting] when the model is not being
+// imported.
 func (s *Service) ActivateImport(ctx context.Context) error {
-	_, span := trace.Start(ctx, trace.NameFromFunc())
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
 	defer span.End()
-	// TODO(modelmigration): implement activate imported model.
-	return errors.ConstError("activating an imported model is not implemented")
+
+	if err := s.controllerState.ActivateImport(ctx, s.modelUUID.String()); err != nil {
+		return errors.Errorf("activating import of model %q: %w", s.modelUUID, err)
+	}
+
+	s.logger.Infof(ctx, "activated import of model %q", s.modelUUID)
+	return nil
 }
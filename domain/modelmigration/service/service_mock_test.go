@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/domain/modelmigration/service (interfaces: InstanceProvider,ResourceProvider,State)
+// Source: github.com/juju/juju/domain/modelmigration/service (interfaces: InstanceProvider,ResourceProvider,ControllerState,ModelState,WatcherFactory)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package service -destination service_mock_test.go github.com/juju/juju/domain/modelmigration/service InstanceProvider,ResourceProvider,State
+//	mockgen -typed -package service -destination service_mock_test.go github.com/juju/juju/domain/modelmigration/service InstanceProvider,ResourceProvider,ControllerState,ModelState,WatcherFactory
 //
 
 // Package service is a generated GoMock package.
@@ -12,9 +12,14 @@ package service
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	set "github.com/juju/collections/set"
+	migration "github.com/juju/juju/core/migration"
 	semversion "github.com/juju/juju/core/semversion"
+	watcher "github.com/juju/juju/core/watcher"
+	eventsource "github.com/juju/juju/core/watcher/eventsource"
+	modelmigration "github.com/juju/juju/domain/modelmigration"
 	instances "github.com/juju/juju/environs/instances"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -142,31 +147,361 @@ func (c *MockResourceProviderAdoptResourcesCall) DoAndReturn(f func(context.Cont
 	return c
 }
 
-// MockState is a mock of State interface.
-type MockState struct {
+// MockControllerState is a mock of ControllerState interface.
+type MockControllerState struct {
 	ctrl     *gomock.Controller
-	recorder *MockStateMockRecorder
+	recorder *MockControllerStateMockRecorder
 }
 
-// MockStateMockRecorder is the mock recorder for MockState.
-type MockStateMockRecorder struct {
-	mock *MockState
+// MockControllerStateMockRecorder is the mock recorder for MockControllerState.
+type MockControllerStateMockRecorder struct {
+	mock *MockControllerState
 }
 
-// NewMockState creates a new mock instance.
-func NewMockState(ctrl *gomock.Controller) *MockState {
-	mock := &MockState{ctrl: ctrl}
-	mock.recorder = &MockStateMockRecorder{mock}
+// NewMockControllerState creates a new mock instance.
+func NewMockControllerState(ctrl *gomock.Controller) *MockControllerState {
+	mock := &MockControllerState{ctrl: ctrl}
+	mock.recorder = &MockControllerStateMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockState) EXPECT() *MockStateMockRecorder {
+func (m *MockControllerState) EXPECT() *MockControllerStateMockRecorder {
+	return m.recorder
+}
+
+// ActivateImport mocks base method.
+func (m *MockControllerState) ActivateImport(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActivateImport", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ActivateImport indicates an expected call of ActivateImport.
+func (mr *MockControllerStateMockRecorder) ActivateImport(arg0, arg1 any) *MockControllerStateActivateImportCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateImport", reflect.TypeOf((*MockControllerState)(nil).ActivateImport), arg0, arg1)
+	return &MockControllerStateActivateImportCall{Call: call}
+}
+
+// MockControllerStateActivateImportCall wrap *gomock.Call
+type MockControllerStateActivateImportCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockControllerStateActivateImportCall) Return(arg0 error) *MockControllerStateActivateImportCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockControllerStateActivateImportCall) Do(f func(context.Context, string) error) *MockControllerStateActivateImportCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockControllerStateActivateImportCall) DoAndReturn(f func(context.Context, string) error) *MockControllerStateActivateImportCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetLatestMigration mocks base method.
+func (m *MockControllerState) GetLatestMigration(arg0 context.Context, arg1 string) (modelmigration.Migration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestMigration", arg0, arg1)
+	ret0, _ := ret[0].(modelmigration.Migration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestMigration indicates an expected call of GetLatestMigration.
+func (mr *MockControllerStateMockRecorder) GetLatestMigration(arg0, arg1 any) *MockControllerStateGetLatestMigrationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestMigration", reflect.TypeOf((*MockControllerState)(nil).GetLatestMigration), arg0, arg1)
+	return &MockControllerStateGetLatestMigrationCall{Call: call}
+}
+
+// MockControllerStateGetLatestMigrationCall wrap *gomock.Call
+type MockControllerStateGetLatestMigrationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockControllerStateGetLatestMigrationCall) Return(arg0 modelmigration.Migration, arg1 error) *MockControllerStateGetLatestMigrationCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockControllerStateGetLatestMigrationCall) Do(f func(context.Context, string) (modelmigration.Migration, error)) *MockControllerStateGetLatestMigrationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockControllerStateGetLatestMigrationCall) DoAndReturn(f func(context.Context, string) (modelmigration.Migration, error)) *MockControllerStateGetLatestMigrationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetMinionReports mocks base method.
+func (m *MockControllerState) GetMinionReports(arg0 context.Context, arg1 string, arg2 migration.Phase) (map[string]bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMinionReports", arg0, arg1, arg2)
+	ret0, _ := ret[0].(map[string]bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMinionReports indicates an expected call of GetMinionReports.
+func (mr *MockControllerStateMockRecorder) GetMinionReports(arg0, arg1, arg2 any) *MockControllerStateGetMinionReportsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMinionReports", reflect.TypeOf((*MockControllerState)(nil).GetMinionReports), arg0, arg1, arg2)
+	return &MockControllerStateGetMinionReportsCall{Call: call}
+}
+
+// MockControllerStateGetMinionReportsCall wrap *gomock.Call
+type MockControllerStateGetMinionReportsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockControllerStateGetMinionReportsCall) Return(arg0 map[string]bool, arg1 error) *MockControllerStateGetMinionReportsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockControllerStateGetMinionReportsCall) Do(f func(context.Context, string, migration.Phase) (map[string]bool, error)) *MockControllerStateGetMinionReportsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockControllerStateGetMinionReportsCall) DoAndReturn(f func(context.Context, string, migration.Phase) (map[string]bool, error)) *MockControllerStateGetMinionReportsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// InitiateMigration mocks base method.
+func (m *MockControllerState) InitiateMigration(arg0 context.Context, arg1, arg2 string, arg3 migration.TargetInfo, arg4 string, arg5 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InitiateMigration", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InitiateMigration indicates an expected call of InitiateMigration.
+func (mr *MockControllerStateMockRecorder) InitiateMigration(arg0, arg1, arg2, arg3, arg4, arg5 any) *MockControllerStateInitiateMigrationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitiateMigration", reflect.TypeOf((*MockControllerState)(nil).InitiateMigration), arg0, arg1, arg2, arg3, arg4, arg5)
+	return &MockControllerStateInitiateMigrationCall{Call: call}
+}
+
+// MockControllerStateInitiateMigrationCall wrap *gomock.Call
+type MockControllerStateInitiateMigrationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockControllerStateInitiateMigrationCall) Return(arg0 error) *MockControllerStateInitiateMigrationCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockControllerStateInitiateMigrationCall) Do(f func(context.Context, string, string, migration.TargetInfo, string, time.Time) error) *MockControllerStateInitiateMigrationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockControllerStateInitiateMigrationCall) DoAndReturn(f func(context.Context, string, string, migration.TargetInfo, string, time.Time) error) *MockControllerStateInitiateMigrationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsModelImporting mocks base method.
+func (m *MockControllerState) IsModelImporting(arg0 context.Context, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsModelImporting", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsModelImporting indicates an expected call of IsModelImporting.
+func (mr *MockControllerStateMockRecorder) IsModelImporting(arg0, arg1 any) *MockControllerStateIsModelImportingCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsModelImporting", reflect.TypeOf((*MockControllerState)(nil).IsModelImporting), arg0, arg1)
+	return &MockControllerStateIsModelImportingCall{Call: call}
+}
+
+// MockControllerStateIsModelImportingCall wrap *gomock.Call
+type MockControllerStateIsModelImportingCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockControllerStateIsModelImportingCall) Return(arg0 bool, arg1 error) *MockControllerStateIsModelImportingCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockControllerStateIsModelImportingCall) Do(f func(context.Context, string) (bool, error)) *MockControllerStateIsModelImportingCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockControllerStateIsModelImportingCall) DoAndReturn(f func(context.Context, string) (bool, error)) *MockControllerStateIsModelImportingCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RecordMinionReport mocks base method.
+func (m *MockControllerState) RecordMinionReport(arg0 context.Context, arg1 string, arg2 migration.Phase, arg3 string, arg4 bool, arg5 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordMinionReport", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordMinionReport indicates an expected call of RecordMinionReport.
+func (mr *MockControllerStateMockRecorder) RecordMinionReport(arg0, arg1, arg2, arg3, arg4, arg5 any) *MockControllerStateRecordMinionReportCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordMinionReport", reflect.TypeOf((*MockControllerState)(nil).RecordMinionReport), arg0, arg1, arg2, arg3, arg4, arg5)
+	return &MockControllerStateRecordMinionReportCall{Call: call}
+}
+
+// MockControllerStateRecordMinionReportCall wrap *gomock.Call
+type MockControllerStateRecordMinionReportCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockControllerStateRecordMinionReportCall) Return(arg0 error) *MockControllerStateRecordMinionReportCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockControllerStateRecordMinionReportCall) Do(f func(context.Context, string, migration.Phase, string, bool, time.Time) error) *MockControllerStateRecordMinionReportCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockControllerStateRecordMinionReportCall) DoAndReturn(f func(context.Context, string, migration.Phase, string, bool, time.Time) error) *MockControllerStateRecordMinionReportCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetMigrationPhase mocks base method.
+func (m *MockControllerState) SetMigrationPhase(arg0 context.Context, arg1 string, arg2, arg3 migration.Phase, arg4 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMigrationPhase", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMigrationPhase indicates an expected call of SetMigrationPhase.
+func (mr *MockControllerStateMockRecorder) SetMigrationPhase(arg0, arg1, arg2, arg3, arg4 any) *MockControllerStateSetMigrationPhaseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMigrationPhase", reflect.TypeOf((*MockControllerState)(nil).SetMigrationPhase), arg0, arg1, arg2, arg3, arg4)
+	return &MockControllerStateSetMigrationPhaseCall{Call: call}
+}
+
+// MockControllerStateSetMigrationPhaseCall wrap *gomock.Call
+type MockControllerStateSetMigrationPhaseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockControllerStateSetMigrationPhaseCall) Return(arg0 error) *MockControllerStateSetMigrationPhaseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockControllerStateSetMigrationPhaseCall) Do(f func(context.Context, string, migration.Phase, migration.Phase, time.Time) error) *MockControllerStateSetMigrationPhaseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockControllerStateSetMigrationPhaseCall) DoAndReturn(f func(context.Context, string, migration.Phase, migration.Phase, time.Time) error) *MockControllerStateSetMigrationPhaseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetMigrationStatusMessage mocks base method.
+func (m *MockControllerState) SetMigrationStatusMessage(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMigrationStatusMessage", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMigrationStatusMessage indicates an expected call of SetMigrationStatusMessage.
+func (mr *MockControllerStateMockRecorder) SetMigrationStatusMessage(arg0, arg1, arg2 any) *MockControllerStateSetMigrationStatusMessageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMigrationStatusMessage", reflect.TypeOf((*MockControllerState)(nil).SetMigrationStatusMessage), arg0, arg1, arg2)
+	return &MockControllerStateSetMigrationStatusMessageCall{Call: call}
+}
+
+// MockControllerStateSetMigrationStatusMessageCall wrap *gomock.Call
+type MockControllerStateSetMigrationStatusMessageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockControllerStateSetMigrationStatusMessageCall) Return(arg0 error) *MockControllerStateSetMigrationStatusMessageCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockControllerStateSetMigrationStatusMessageCall) Do(f func(context.Context, string, string) error) *MockControllerStateSetMigrationStatusMessageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockControllerStateSetMigrationStatusMessageCall) DoAndReturn(f func(context.Context, string, string) error) *MockControllerStateSetMigrationStatusMessageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockModelState is a mock of ModelState interface.
+type MockModelState struct {
+	ctrl     *gomock.Controller
+	recorder *MockModelStateMockRecorder
+}
+
+// MockModelStateMockRecorder is the mock recorder for MockModelState.
+type MockModelStateMockRecorder struct {
+	mock *MockModelState
+}
+
+// NewMockModelState creates a new mock instance.
+func NewMockModelState(ctrl *gomock.Controller) *MockModelState {
+	mock := &MockModelState{ctrl: ctrl}
+	mock.recorder = &MockModelStateMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModelState) EXPECT() *MockModelStateMockRecorder {
 	return m.recorder
 }
 
 // GetAllInstanceIDs mocks base method.
-func (m *MockState) GetAllInstanceIDs(arg0 context.Context) (set.Strings, error) {
+func (m *MockModelState) GetAllInstanceIDs(arg0 context.Context) (set.Strings, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetAllInstanceIDs", arg0)
 	ret0, _ := ret[0].(set.Strings)
@@ -175,37 +510,115 @@ func (m *MockState) GetAllInstanceIDs(arg0 context.Context) (set.Strings, error)
 }
 
 // GetAllInstanceIDs indicates an expected call of GetAllInstanceIDs.
-func (mr *MockStateMockRecorder) GetAllInstanceIDs(arg0 any) *MockStateGetAllInstanceIDsCall {
+func (mr *MockModelStateMockRecorder) GetAllInstanceIDs(arg0 any) *MockModelStateGetAllInstanceIDsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllInstanceIDs", reflect.TypeOf((*MockState)(nil).GetAllInstanceIDs), arg0)
-	return &MockStateGetAllInstanceIDsCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllInstanceIDs", reflect.TypeOf((*MockModelState)(nil).GetAllInstanceIDs), arg0)
+	return &MockModelStateGetAllInstanceIDsCall{Call: call}
 }
 
-// MockStateGetAllInstanceIDsCall wrap *gomock.Call
-type MockStateGetAllInstanceIDsCall struct {
+// MockModelStateGetAllInstanceIDsCall wrap *gomock.Call
+type MockModelStateGetAllInstanceIDsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStateGetAllInstanceIDsCall) Return(arg0 set.Strings, arg1 error) *MockStateGetAllInstanceIDsCall {
+func (c *MockModelStateGetAllInstanceIDsCall) Return(arg0 set.Strings, arg1 error) *MockModelStateGetAllInstanceIDsCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStateGetAllInstanceIDsCall) Do(f func(context.Context) (set.Strings, error)) *MockStateGetAllInstanceIDsCall {
+func (c *MockModelStateGetAllInstanceIDsCall) Do(f func(context.Context) (set.Strings, error)) *MockModelStateGetAllInstanceIDsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStateGetAllInstanceIDsCall) DoAndReturn(f func(context.Context) (set.Strings, error)) *MockStateGetAllInstanceIDsCall {
+func (c *MockModelStateGetAllInstanceIDsCall) DoAndReturn(f func(context.Context) (set.Strings, error)) *MockModelStateGetAllInstanceIDsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetAllMachineNames mocks base method.
+func (m *MockModelState) GetAllMachineNames(arg0 context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllMachineNames", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllMachineNames indicates an expected call of GetAllMachineNames.
+func (mr *MockModelStateMockRecorder) GetAllMachineNames(arg0 any) *MockModelStateGetAllMachineNamesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllMachineNames", reflect.TypeOf((*MockModelState)(nil).GetAllMachineNames), arg0)
+	return &MockModelStateGetAllMachineNamesCall{Call: call}
+}
+
+// MockModelStateGetAllMachineNamesCall wrap *gomock.Call
+type MockModelStateGetAllMachineNamesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelStateGetAllMachineNamesCall) Return(arg0 []string, arg1 error) *MockModelStateGetAllMachineNamesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelStateGetAllMachineNamesCall) Do(f func(context.Context) ([]string, error)) *MockModelStateGetAllMachineNamesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelStateGetAllMachineNamesCall) DoAndReturn(f func(context.Context) ([]string, error)) *MockModelStateGetAllMachineNamesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetAllUnitNames mocks base method.
+func (m *MockModelState) GetAllUnitNames(arg0 context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllUnitNames", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllUnitNames indicates an expected call of GetAllUnitNames.
+func (mr *MockModelStateMockRecorder) GetAllUnitNames(arg0 any) *MockModelStateGetAllUnitNamesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllUnitNames", reflect.TypeOf((*MockModelState)(nil).GetAllUnitNames), arg0)
+	return &MockModelStateGetAllUnitNamesCall{Call: call}
+}
+
+// MockModelStateGetAllUnitNamesCall wrap *gomock.Call
+type MockModelStateGetAllUnitNamesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelStateGetAllUnitNamesCall) Return(arg0 []string, arg1 error) *MockModelStateGetAllUnitNamesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelStateGetAllUnitNamesCall) Do(f func(context.Context) ([]string, error)) *MockModelStateGetAllUnitNamesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelStateGetAllUnitNamesCall) DoAndReturn(f func(context.Context) ([]string, error)) *MockModelStateGetAllUnitNamesCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // GetControllerUUID mocks base method.
-func (m *MockState) GetControllerUUID(arg0 context.Context) (string, error) {
+func (m *MockModelState) GetControllerUUID(arg0 context.Context) (string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetControllerUUID", arg0)
 	ret0, _ := ret[0].(string)
@@ -214,31 +627,98 @@ func (m *MockState) GetControllerUUID(arg0 context.Context) (string, error) {
 }
 
 // GetControllerUUID indicates an expected call of GetControllerUUID.
-func (mr *MockStateMockRecorder) GetControllerUUID(arg0 any) *MockStateGetControllerUUIDCall {
+func (mr *MockModelStateMockRecorder) GetControllerUUID(arg0 any) *MockModelStateGetControllerUUIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetControllerUUID", reflect.TypeOf((*MockModelState)(nil).GetControllerUUID), arg0)
+	return &MockModelStateGetControllerUUIDCall{Call: call}
+}
+
+// MockModelStateGetControllerUUIDCall wrap *gomock.Call
+type MockModelStateGetControllerUUIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelStateGetControllerUUIDCall) Return(arg0 string, arg1 error) *MockModelStateGetControllerUUIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelStateGetControllerUUIDCall) Do(f func(context.Context) (string, error)) *MockModelStateGetControllerUUIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelStateGetControllerUUIDCall) DoAndReturn(f func(context.Context) (string, error)) *MockModelStateGetControllerUUIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockWatcherFactory is a mock of WatcherFactory interface.
+type MockWatcherFactory struct {
+	ctrl     *gomock.Controller
+	recorder *MockWatcherFactoryMockRecorder
+}
+
+// MockWatcherFactoryMockRecorder is the mock recorder for MockWatcherFactory.
+type MockWatcherFactoryMockRecorder struct {
+	mock *MockWatcherFactory
+}
+
+// NewMockWatcherFactory creates a new mock instance.
+func NewMockWatcherFactory(ctrl *gomock.Controller) *MockWatcherFactory {
+	mock := &MockWatcherFactory{ctrl: ctrl}
+	mock.recorder = &MockWatcherFactoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWatcherFactory) EXPECT() *MockWatcherFactoryMockRecorder {
+	return m.recorder
+}
+
+// NewNotifyWatcher mocks base method.
+func (m *MockWatcherFactory) NewNotifyWatcher(arg0 context.Context, arg1 string, arg2 eventsource.FilterOption, arg3 ...eventsource.FilterOption) (watcher.Watcher[struct{}], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0, arg1, arg2}
+	for _, a := range arg3 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "NewNotifyWatcher", varargs...)
+	ret0, _ := ret[0].(watcher.Watcher[struct{}])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewNotifyWatcher indicates an expected call of NewNotifyWatcher.
+func (mr *MockWatcherFactoryMockRecorder) NewNotifyWatcher(arg0, arg1, arg2 any, arg3 ...any) *MockWatcherFactoryNewNotifyWatcherCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetControllerUUID", reflect.TypeOf((*MockState)(nil).GetControllerUUID), arg0)
-	return &MockStateGetControllerUUIDCall{Call: call}
+	varargs := append([]any{arg0, arg1, arg2}, arg3...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewNotifyWatcher", reflect.TypeOf((*MockWatcherFactory)(nil).NewNotifyWatcher), varargs...)
+	return &MockWatcherFactoryNewNotifyWatcherCall{Call: call}
 }
 
-// MockStateGetControllerUUIDCall wrap *gomock.Call
-type MockStateGetControllerUUIDCall struct {
+// MockWatcherFactoryNewNotifyWatcherCall wrap *gomock.Call
+type MockWatcherFactoryNewNotifyWatcherCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockStateGetControllerUUIDCall) Return(arg0 string, arg1 error) *MockStateGetControllerUUIDCall {
+func (c *MockWatcherFactoryNewNotifyWatcherCall) Return(arg0 watcher.Watcher[struct{}], arg1 error) *MockWatcherFactoryNewNotifyWatcherCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStateGetControllerUUIDCall) Do(f func(context.Context) (string, error)) *MockStateGetControllerUUIDCall {
+func (c *MockWatcherFactoryNewNotifyWatcherCall) Do(f func(context.Context, string, eventsource.FilterOption, ...eventsource.FilterOption) (watcher.Watcher[struct{}], error)) *MockWatcherFactoryNewNotifyWatcherCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStateGetControllerUUIDCall) DoAndReturn(f func(context.Context) (string, error)) *MockStateGetControllerUUIDCall {
+func (c *MockWatcherFactoryNewNotifyWatcherCall) DoAndReturn(f func(context.Context, string, eventsource.FilterOption, ...eventsource.FilterOption) (watcher.Watcher[struct{}], error)) *MockWatcherFactoryNewNotifyWatcherCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
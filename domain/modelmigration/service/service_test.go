@@ -6,24 +6,38 @@ package service
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/juju/clock"
 	"github.com/juju/collections/set"
 	"github.com/juju/tc"
 	"go.uber.org/mock/gomock"
 
 	coreerrors "github.com/juju/juju/core/errors"
 	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/core/machine"
+	"github.com/juju/juju/core/migration"
+	"github.com/juju/juju/core/model"
+	modeltesting "github.com/juju/juju/core/model/testing"
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/providertracker"
 	"github.com/juju/juju/core/semversion"
 	"github.com/juju/juju/core/status"
+	"github.com/juju/juju/core/unit"
+	"github.com/juju/juju/domain/modelmigration"
+	modelmigrationerrors "github.com/juju/juju/domain/modelmigration/errors"
 	"github.com/juju/juju/environs/instances"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
 )
 
 type serviceSuite struct {
 	instanceProvider *MockInstanceProvider
 	resourceProvider *MockResourceProvider
-	state            *MockState
+	controllerState  *MockControllerState
+	modelState       *MockModelState
+	watcherFactory   *MockWatcherFactory
+
+	modelUUID model.UUID
 }
 
 func TestServiceSuite(t *testing.T) {
@@ -34,10 +48,33 @@ func (s *serviceSuite) setupMocks(c *tc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 	s.instanceProvider = NewMockInstanceProvider(ctrl)
 	s.resourceProvider = NewMockResourceProvider(ctrl)
-	s.state = NewMockState(ctrl)
+	s.controllerState = NewMockControllerState(ctrl)
+	s.modelState = NewMockModelState(ctrl)
+	s.watcherFactory = NewMockWatcherFactory(ctrl)
+	s.modelUUID = modeltesting.GenModelUUID(c)
 	return ctrl
 }
 
+func (s *serviceSuite) newService(c *tc.C) *Service {
+	return s.newServiceWithResourceGetter(c, s.resourceProviderGetter(c))
+}
+
+func (s *serviceSuite) newServiceWithResourceGetter(
+	c *tc.C,
+	resourceGetter providertracker.ProviderGetter[ResourceProvider],
+) *Service {
+	return NewService(
+		s.modelUUID,
+		s.controllerState,
+		s.modelState,
+		s.instanceProviderGetter(c),
+		resourceGetter,
+		s.watcherFactory,
+		clock.WallClock,
+		loggertesting.WrapCheckLog(c),
+	)
+}
+
 func (s *serviceSuite) instanceProviderGetter(_ *tc.C) providertracker.ProviderGetter[InstanceProvider] {
 	return func(_ context.Context) (InstanceProvider, error) {
 		return s.instanceProvider, nil
@@ -58,7 +95,7 @@ func (s *serviceSuite) TestAdoptResources(c *tc.C) {
 	sourceControllerVersion, err := semversion.Parse("4.1.1")
 	c.Assert(err, tc.ErrorIsNil)
 
-	s.state.EXPECT().GetControllerUUID(gomock.Any()).Return(
+	s.modelState.EXPECT().GetControllerUUID(gomock.Any()).Return(
 		"deadbeef-1bad-500d-9000-4b1d0d06f00d",
 		nil,
 	)
@@ -68,11 +105,7 @@ func (s *serviceSuite) TestAdoptResources(c *tc.C) {
 		sourceControllerVersion,
 	).Return(nil)
 
-	err = NewService(
-		s.instanceProviderGetter(c),
-		s.resourceProviderGetter(c),
-		s.state,
-	).AdoptResources(c.Context(), sourceControllerVersion)
+	err = s.newService(c).AdoptResources(c.Context(), sourceControllerVersion)
 	c.Check(err, tc.ErrorIsNil)
 }
 
@@ -89,16 +122,12 @@ func (s *serviceSuite) TestAdoptResourcesProviderNotSupported(c *tc.C) {
 	sourceControllerVersion, err := semversion.Parse("4.1.1")
 	c.Assert(err, tc.ErrorIsNil)
 
-	s.state.EXPECT().GetControllerUUID(gomock.Any()).Return(
+	s.modelState.EXPECT().GetControllerUUID(gomock.Any()).Return(
 		"deadbeef-1bad-500d-9000-4b1d0d06f00d",
 		nil,
 	).AnyTimes()
 
-	err = NewService(
-		s.instanceProviderGetter(c),
-		resourceGetter,
-		s.state,
-	).AdoptResources(c.Context(), sourceControllerVersion)
+	err = s.newServiceWithResourceGetter(c, resourceGetter).AdoptResources(c.Context(), sourceControllerVersion)
 	c.Check(err, tc.ErrorIsNil)
 }
 
@@ -111,7 +140,7 @@ func (s *serviceSuite) TestAdoptResourcesProviderNotImplemented(c *tc.C) {
 	sourceControllerVersion, err := semversion.Parse("4.1.1")
 	c.Assert(err, tc.ErrorIsNil)
 
-	s.state.EXPECT().GetControllerUUID(gomock.Any()).Return(
+	s.modelState.EXPECT().GetControllerUUID(gomock.Any()).Return(
 		"deadbeef-1bad-500d-9000-4b1d0d06f00d",
 		nil,
 	)
@@ -121,11 +150,7 @@ func (s *serviceSuite) TestAdoptResourcesProviderNotImplemented(c *tc.C) {
 		sourceControllerVersion,
 	).Return(coreerrors.NotImplemented)
 
-	err = NewService(
-		s.instanceProviderGetter(c),
-		s.resourceProviderGetter(c),
-		s.state,
-	).AdoptResources(c.Context(), sourceControllerVersion)
+	err = s.newService(c).AdoptResources(c.Context(), sourceControllerVersion)
 	c.Check(err, tc.ErrorIsNil)
 }
 
@@ -144,14 +169,10 @@ func (s *serviceSuite) TestMachinesFromProviderNotInModel(c *tc.C) {
 			},
 		},
 			nil)
-	s.state.EXPECT().GetAllInstanceIDs(gomock.Any()).
+	s.modelState.EXPECT().GetAllInstanceIDs(gomock.Any()).
 		Return(set.NewStrings("instance0"), nil)
 
-	_, err := NewService(
-		s.instanceProviderGetter(c),
-		s.resourceProviderGetter(c),
-		s.state,
-	).CheckMachines(c.Context())
+	_, err := s.newService(c).CheckMachines(c.Context())
 	c.Check(err, tc.ErrorMatches, "provider instance IDs.*instance1.*")
 }
 
@@ -168,17 +189,243 @@ func (s *serviceSuite) TestMachineInstanceIDsNotInProvider(c *tc.C) {
 			},
 		},
 			nil)
-	s.state.EXPECT().GetAllInstanceIDs(gomock.Any()).
+	s.modelState.EXPECT().GetAllInstanceIDs(gomock.Any()).
 		Return(set.NewStrings("instance0", "instance1"), nil)
 
-	_, err := NewService(
-		s.instanceProviderGetter(c),
-		s.resourceProviderGetter(c),
-		s.state,
-	).CheckMachines(c.Context())
+	_, err := s.newService(c).CheckMachines(c.Context())
 	c.Check(err, tc.ErrorMatches, "instance IDs.*instance1.*")
 }
 
+// TestModelMigrationModeImporting is asserting that a model being imported
+// into the controller reports the importing migration mode.
+func (s *serviceSuite) TestModelMigrationModeImporting(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.controllerState.EXPECT().IsModelImporting(gomock.Any(), s.modelUUID.String()).Return(true, nil)
+
+	mode, err := s.newService(c).ModelMigrationMode(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(mode, tc.Equals, modelmigration.MigrationModeImporting)
+}
+
+// TestModelMigrationModeNoMigration is asserting that a model that has never
+// been migrated reports no migration mode.
+func (s *serviceSuite) TestModelMigrationModeNoMigration(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.controllerState.EXPECT().IsModelImporting(gomock.Any(), s.modelUUID.String()).Return(false, nil)
+	s.controllerState.EXPECT().GetLatestMigration(gomock.Any(), s.modelUUID.String()).
+		Return(modelmigration.Migration{}, modelmigrationerrors.MigrationNotFound)
+
+	mode, err := s.newService(c).ModelMigrationMode(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(mode, tc.Equals, modelmigration.MigrationModeNone)
+}
+
+// TestModelMigrationModeExporting is asserting that a model with a migration
+// that has not been aborted reports the exporting migration mode.
+func (s *serviceSuite) TestModelMigrationModeExporting(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.controllerState.EXPECT().IsModelImporting(gomock.Any(), s.modelUUID.String()).Return(false, nil)
+	s.controllerState.EXPECT().GetLatestMigration(gomock.Any(), s.modelUUID.String()).
+		Return(modelmigration.Migration{UUID: "mig-uuid", Phase: migration.IMPORT}, nil)
+
+	mode, err := s.newService(c).ModelMigrationMode(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(mode, tc.Equals, modelmigration.MigrationModeExporting)
+}
+
+// TestModelMigrationModeAborted is asserting that a model whose latest
+// migration has been aborted reports no migration mode.
+func (s *serviceSuite) TestModelMigrationModeAborted(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.controllerState.EXPECT().IsModelImporting(gomock.Any(), s.modelUUID.String()).Return(false, nil)
+	s.controllerState.EXPECT().GetLatestMigration(gomock.Any(), s.modelUUID.String()).
+		Return(modelmigration.Migration{UUID: "mig-uuid", Phase: migration.ABORTDONE}, nil)
+
+	mode, err := s.newService(c).ModelMigrationMode(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(mode, tc.Equals, modelmigration.MigrationModeNone)
+}
+
+// TestMigrationNotFound is asserting that a model that has never been
+// migrated reports a migration in the NONE phase.
+func (s *serviceSuite) TestMigrationNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.controllerState.EXPECT().GetLatestMigration(gomock.Any(), s.modelUUID.String()).
+		Return(modelmigration.Migration{}, modelmigrationerrors.MigrationNotFound)
+
+	mig, err := s.newService(c).Migration(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(mig, tc.DeepEquals, modelmigration.Migration{Phase: migration.NONE})
+}
+
+// TestInitiateMigration is asserting the happy path of initiating a
+// migration.
+func (s *serviceSuite) TestInitiateMigration(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	target := s.targetInfo()
+
+	var migrationUUID string
+	s.controllerState.EXPECT().InitiateMigration(
+		gomock.Any(), gomock.Any(), s.modelUUID.String(), target, "admin", gomock.Any(),
+	).DoAndReturn(func(_ context.Context, uuid, _ string, _ migration.TargetInfo, _ string, _ time.Time) error {
+		migrationUUID = uuid
+		return nil
+	})
+
+	id, err := s.newService(c).InitiateMigration(c.Context(), target, "admin")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(id, tc.Equals, migrationUUID)
+}
+
+// TestInitiateMigrationTargetNotValid is asserting that a migration is not
+// initiated when the target information is not valid.
+func (s *serviceSuite) TestInitiateMigrationTargetNotValid(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	target := s.targetInfo()
+	target.Addrs = nil
+
+	_, err := s.newService(c).InitiateMigration(c.Context(), target, "admin")
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+// TestSetMigrationPhase is asserting the happy path of moving a migration to
+// its next phase.
+func (s *serviceSuite) TestSetMigrationPhase(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.controllerState.EXPECT().GetLatestMigration(gomock.Any(), s.modelUUID.String()).
+		Return(modelmigration.Migration{UUID: "mig-uuid", Phase: migration.QUIESCE}, nil)
+	s.controllerState.EXPECT().SetMigrationPhase(
+		gomock.Any(), "mig-uuid", migration.QUIESCE, migration.IMPORT, gomock.Any(),
+	).Return(nil)
+
+	err := s.newService(c).SetMigrationPhase(c.Context(), migration.IMPORT)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+// TestSetMigrationPhaseUnchanged is asserting that setting the phase a
+// migration is already in is a no-op, even if that phase is terminal.
+func (s *serviceSuite) TestSetMigrationPhaseUnchanged(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.controllerState.EXPECT().GetLatestMigration(gomock.Any(), s.modelUUID.String()).
+		Return(modelmigration.Migration{UUID: "mig-uuid", Phase: migration.DONE}, nil)
+
+	err := s.newService(c).SetMigrationPhase(c.Context(), migration.DONE)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+// TestSetMigrationPhaseTransitionNotValid is asserting that a migration can
+// not skip phases.
+func (s *serviceSuite) TestSetMigrationPhaseTransitionNotValid(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.controllerState.EXPECT().GetLatestMigration(gomock.Any(), s.modelUUID.String()).
+		Return(modelmigration.Migration{UUID: "mig-uuid", Phase: migration.QUIESCE}, nil)
+
+	err := s.newService(c).SetMigrationPhase(c.Context(), migration.SUCCESS)
+	c.Check(err, tc.ErrorIs, modelmigrationerrors.PhaseTransitionNotValid)
+}
+
+// TestSetMigrationPhaseEnded is asserting that the phase of a migration that
+// has ended can not be changed.
+func (s *serviceSuite) TestSetMigrationPhaseEnded(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.controllerState.EXPECT().GetLatestMigration(gomock.Any(), s.modelUUID.String()).
+		Return(modelmigration.Migration{UUID: "mig-uuid", Phase: migration.ABORTDONE}, nil)
+
+	err := s.newService(c).SetMigrationPhase(c.Context(), migration.QUIESCE)
+	c.Check(err, tc.ErrorIs, modelmigrationerrors.MigrationNotFound)
+}
+
+// TestReportFromUnit is asserting that a unit report is recorded against the
+// latest migration keyed on the unit tag.
+func (s *serviceSuite) TestReportFromUnit(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.controllerState.EXPECT().GetLatestMigration(gomock.Any(), s.modelUUID.String()).
+		Return(modelmigration.Migration{UUID: "mig-uuid", Phase: migration.QUIESCE}, nil)
+	s.controllerState.EXPECT().RecordMinionReport(
+		gomock.Any(), "mig-uuid", migration.QUIESCE, "unit-foo-0", true, gomock.Any(),
+	).Return(nil)
+
+	err := s.newService(c).ReportFromUnit(c.Context(), unit.Name("foo/0"), migration.QUIESCE, true)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+// TestReportFromMachine is asserting that a machine report is recorded
+// against the latest migration keyed on the machine tag.
+func (s *serviceSuite) TestReportFromMachine(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.controllerState.EXPECT().GetLatestMigration(gomock.Any(), s.modelUUID.String()).
+		Return(modelmigration.Migration{UUID: "mig-uuid", Phase: migration.QUIESCE}, nil)
+	s.controllerState.EXPECT().RecordMinionReport(
+		gomock.Any(), "mig-uuid", migration.QUIESCE, "machine-0", false, gomock.Any(),
+	).Return(nil)
+
+	err := s.newService(c).ReportFromMachine(c.Context(), machine.Name("0"), migration.QUIESCE, false)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+// TestMinionReports is asserting that the reports for the current phase are
+// tallied against the machines and units of the model.
+func (s *serviceSuite) TestMinionReports(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.controllerState.EXPECT().GetLatestMigration(gomock.Any(), s.modelUUID.String()).
+		Return(modelmigration.Migration{UUID: "mig-uuid", Phase: migration.QUIESCE}, nil)
+	s.controllerState.EXPECT().GetMinionReports(gomock.Any(), "mig-uuid", migration.QUIESCE).
+		Return(map[string]bool{
+			"machine-0":  true,
+			"machine-1":  false,
+			"unit-foo-0": true,
+		}, nil)
+	s.modelState.EXPECT().GetAllMachineNames(gomock.Any()).Return([]string{"0", "1", "2"}, nil)
+	s.modelState.EXPECT().GetAllUnitNames(gomock.Any()).Return([]string{"foo/0", "foo/1"}, nil)
+
+	reports, err := s.newService(c).MinionReports(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(reports, tc.DeepEquals, migration.MinionReports{
+		MigrationId:         "mig-uuid",
+		Phase:               migration.QUIESCE,
+		SuccessCount:        2,
+		UnknownCount:        2,
+		SomeUnknownMachines: []string{"2"},
+		SomeUnknownUnits:    []string{"foo/1"},
+		FailedMachines:      []string{"1"},
+	})
+}
+
+// TestActivateImport is asserting that activating an import is passed
+// through to the controller state.
+func (s *serviceSuite) TestActivateImport(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.controllerState.EXPECT().ActivateImport(gomock.Any(), s.modelUUID.String()).Return(nil)
+
+	err := s.newService(c).ActivateImport(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) targetInfo() migration.TargetInfo {
+	return migration.TargetInfo{
+		ControllerUUID: "deadbeef-1bad-500d-9000-4b1d0d06f00d",
+		Addrs:          []string{"10.0.0.1:17070"},
+		CACert:         "cert",
+		User:           "admin",
+		Password:       "secret",
+	}
+}
+
 type instanceStub struct {
 	instances.Instance
 	id string
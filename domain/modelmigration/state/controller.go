@@ -0,0 +1,531 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/canonical/sqlair"
+	"gopkg.in/macaroon.v2"
+
+	"github.com/juju/juju/core/database"
+	coremigration "github.com/juju/juju/core/migration"
+	"github.com/juju/juju/domain"
+	"github.com/juju/juju/domain/modelmigration"
+	modelmigrationerrors "github.com/juju/juju/domain/modelmigration/errors"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/uuid"
+)
+
+// ControllerState represents the access method for interacting with the
+// migrations of models recorded in the controller database.
+type ControllerState struct {
+	*domain.StateBase
+}
+
+// NewControllerState creates a new [ControllerState].
+func NewControllerState(factory database.TxnRunnerFactory) *ControllerState {
+	return &ControllerState{
+		StateBase: domain.NewStateBase(factory),
+	}
+}
+
+// InitiateMigration records a new migration of the model to the target
+// controller. The migration starts in the QUIESCE phase, and is given the
+// next attempt number for the model.
+// The following errors can be expected:
+// - [modelmigrationerrors.MigrationInProgress] when the model already has an
+// active migration.
+func (s *ControllerState) InitiateMigration(
+	ctx context.Context,
+	migrationUUID string,
+	mUUID string,
+	target coremigration.TargetInfo,
+	initiatedBy string,
+	startTime time.Time,
+) error {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	macaroons, err := json.Marshal(target.Macaroons)
+	if err != nil {
+		return errors.Errorf("encoding target macaroons: %w", err)
+	}
+
+	model := modelUUID{UUID: mUUID}
+	activeStmt, err := s.Prepare(`
+SELECT &activeMigration.uuid
+FROM   model_migration
+WHERE  model_uuid = $modelUUID.model_uuid
+AND    active = TRUE`, model, activeMigration{})
+	if err != nil {
+		return errors.Errorf("preparing active migration statement: %w", err)
+	}
+
+	attemptStmt, err := s.Prepare(`
+SELECT COALESCE(MAX(attempt), -1) AS &migrationAttempt.attempt
+FROM   model_migration
+WHERE  model_uuid = $modelUUID.model_uuid`, model, migrationAttempt{})
+	if err != nil {
+		return errors.Errorf("preparing migration attempt statement: %w", err)
+	}
+
+	insertMigrationStmt, err := s.Prepare(`
+INSERT INTO model_migration (*) VALUES ($migration.*)`, migration{})
+	if err != nil {
+		return errors.Errorf("preparing insert migration statement: %w", err)
+	}
+
+	insertStatusStmt, err := s.Prepare(`
+INSERT INTO model_migration_status (*) VALUES ($migrationStatus.*)`, migrationStatus{})
+	if err != nil {
+		return errors.Errorf("preparing insert migration status statement: %w", err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var active activeMigration
+		err := tx.Query(ctx, activeStmt, model).Get(&active)
+		if err == nil {
+			return errors.Errorf(
+				"model %q has active migration %q", mUUID, active.UUID,
+			).Add(modelmigrationerrors.MigrationInProgress)
+		} else if !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("checking for active migration: %w", err)
+		}
+
+		var attempt migrationAttempt
+		if err := tx.Query(ctx, attemptStmt, model).Get(&attempt); err != nil {
+			return errors.Errorf("getting migration attempt: %w", err)
+		}
+
+		row := migration{
+			UUID:                  migrationUUID,
+			ModelUUID:             mUUID,
+			Attempt:               attempt.Attempt + 1,
+			InitiatedBy:           initiatedBy,
+			TargetControllerUUID:  target.ControllerUUID,
+			TargetControllerAlias: target.ControllerAlias,
+			TargetAddresses:       strings.Join(target.Addrs, ","),
+			TargetCACert:          target.CACert,
+			TargetEntity:          target.User,
+			TargetPassword:        target.Password,
+			TargetMacaroons:       string(macaroons),
+			TargetToken:           target.Token,
+			TargetSkipUserChecks:  target.SkipUserChecks,
+			StartTime:             startTime,
+		}
+		if err := tx.Query(ctx, insertMigrationStmt, row).Run(); err != nil {
+			return errors.Errorf("inserting migration: %w", err)
+		}
+
+		status := migrationStatus{
+			MigrationUUID:    migrationUUID,
+			ModelUUID:        mUUID,
+			Phase:            coremigration.QUIESCE.String(),
+			PhaseChangedTime: startTime,
+		}
+		if err := tx.Query(ctx, insertStatusStmt, status).Run(); err != nil {
+			return errors.Errorf("inserting migration status: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetLatestMigration returns the most recent migration attempt for the model,
+// regardless of whether it is still active.
+// The following errors can be expected:
+// - [modelmigrationerrors.MigrationNotFound] when the model has never been
+// migrated from this controller.
+func (s *ControllerState) GetLatestMigration(ctx context.Context, mUUID string) (modelmigration.Migration, error) {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return modelmigration.Migration{}, errors.Capture(err)
+	}
+
+	model := modelUUID{UUID: mUUID}
+	stmt, err := s.Prepare(`
+SELECT   &migrationInfo.*
+FROM     model_migration AS m
+JOIN     model_migration_status AS s ON m.uuid = s.migration_uuid
+WHERE    m.model_uuid = $modelUUID.model_uuid
+ORDER BY m.attempt DESC
+LIMIT    1`, model, migrationInfo{})
+	if err != nil {
+		return modelmigration.Migration{}, errors.Errorf("preparing latest migration statement: %w", err)
+	}
+
+	var info migrationInfo
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, model).Get(&info)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("model %q", mUUID).Add(modelmigrationerrors.MigrationNotFound)
+		} else if err != nil {
+			return errors.Errorf("getting latest migration: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return modelmigration.Migration{}, errors.Capture(err)
+	}
+
+	phase, ok := coremigration.ParsePhase(info.Phase)
+	if !ok {
+		return modelmigration.Migration{}, errors.Errorf("migration %q has unknown phase %q", info.UUID, info.Phase)
+	}
+
+	var macaroons []macaroon.Slice
+	if info.TargetMacaroons != "" {
+		if err := json.Unmarshal([]byte(info.TargetMacaroons), &macaroons); err != nil {
+			return modelmigration.Migration{}, errors.Errorf("decoding target macaroons: %w", err)
+		}
+	}
+
+	var addrs []string
+	if info.TargetAddresses != "" {
+		addrs = strings.Split(info.TargetAddresses, ",")
+	}
+
+	return modelmigration.Migration{
+		UUID:             info.UUID,
+		Attempt:          info.Attempt,
+		Phase:            phase,
+		PhaseChangedTime: info.PhaseChangedTime,
+		Target: coremigration.TargetInfo{
+			ControllerUUID:  info.TargetControllerUUID,
+			ControllerAlias: info.TargetControllerAlias,
+			Addrs:           addrs,
+			CACert:          info.TargetCACert,
+			User:            info.TargetEntity,
+			Password:        info.TargetPassword,
+			Macaroons:       macaroons,
+			Token:           info.TargetToken,
+			SkipUserChecks:  info.TargetSkipUserChecks,
+		},
+	}, nil
+}
+
+// SetMigrationPhase moves the migration from one phase to another. The phase
+// is only changed if the migration is still in the from phase, guarding
+// against concurrent updates. When the migration reaches the SUCCESS phase its
+// success time is recorded, and when it reaches a terminal phase it is marked
+// as no longer active.
+// The following errors can be expected:
+// - [modelmigrationerrors.PhaseTransitionNotValid] when the migration is no
+// longer in the from phase.
+func (s *ControllerState) SetMigrationPhase(
+	ctx context.Context,
+	mUUID string,
+	from, to coremigration.Phase,
+	changedTime time.Time,
+) error {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	change := phaseChange{
+		MigrationUUID: mUUID,
+		From:          from.String(),
+		To:            to.String(),
+		Time:          changedTime,
+	}
+	phaseStmt, err := s.Prepare(`
+UPDATE model_migration_status
+SET    phase = $phaseChange.to_phase,
+       phase_changed_time = $phaseChange.time
+WHERE  migration_uuid = $phaseChange.migration_uuid
+AND    phase = $phaseChange.from_phase`, change)
+	if err != nil {
+		return errors.Errorf("preparing migration phase statement: %w", err)
+	}
+
+	end := migrationEnd{UUID: mUUID, Time: changedTime}
+	successStmt, err := s.Prepare(`
+UPDATE model_migration
+SET    success_time = $migrationEnd.time
+WHERE  uuid = $migrationEnd.uuid`, end)
+	if err != nil {
+		return errors.Errorf("preparing migration success statement: %w", err)
+	}
+
+	endStmt, err := s.Prepare(`
+UPDATE model_migration
+SET    end_time = $migrationEnd.time,
+       active = FALSE
+WHERE  uuid = $migrationEnd.uuid`, end)
+	if err != nil {
+		return errors.Errorf("preparing migration end statement: %w", err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var outcome sqlair.Outcome
+		if err := tx.Query(ctx, phaseStmt, change).Get(&outcome); err != nil {
+			return errors.Errorf("setting migration phase: %w", err)
+		}
+		if affected, err := outcome.Result().RowsAffected(); err != nil {
+			return errors.Errorf("setting migration phase: %w", err)
+		} else if affected == 0 {
+			return errors.Errorf(
+				"migration %q is no longer in phase %s", mUUID, from,
+			).Add(modelmigrationerrors.PhaseTransitionNotValid)
+		}
+
+		if to == coremigration.SUCCESS {
+			if err := tx.Query(ctx, successStmt, end).Run(); err != nil {
+				return errors.Errorf("setting migration success time: %w", err)
+			}
+		}
+
+		if to.IsTerminal() {
+			if err := tx.Query(ctx, endStmt, end).Run(); err != nil {
+				return errors.Errorf("setting migration end time: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// SetMigrationStatusMessage sets the human readable status message of the
+// migration.
+// The following errors can be expected:
+// - [modelmigrationerrors.MigrationNotFound] when the migration does not
+// exist.
+func (s *ControllerState) SetMigrationStatusMessage(ctx context.Context, mUUID, message string) error {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	status := migrationStatus{
+		MigrationUUID: mUUID,
+		StatusMessage: message,
+	}
+	stmt, err := s.Prepare(`
+UPDATE model_migration_status
+SET    status_message = $migrationStatus.status_message
+WHERE  migration_uuid = $migrationStatus.migration_uuid`, status)
+	if err != nil {
+		return errors.Errorf("preparing migration status message statement: %w", err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var outcome sqlair.Outcome
+		if err := tx.Query(ctx, stmt, status).Get(&outcome); err != nil {
+			return errors.Errorf("setting migration status message: %w", err)
+		}
+		if affected, err := outcome.Result().RowsAffected(); err != nil {
+			return errors.Errorf("setting migration status message: %w", err)
+		} else if affected == 0 {
+			return errors.Errorf("migration %q", mUUID).Add(modelmigrationerrors.MigrationNotFound)
+		}
+		return nil
+	})
+}
+
+// RecordMinionReport records the report of an agent for a phase of the
+// migration. A subsequent report by the same agent for the same phase replaces
+// the previous one.
+// The following errors can be expected:
+// - [modelmigrationerrors.MigrationNotFound] when the migration does not
+// exist.
+func (s *ControllerState) RecordMinionReport(
+	ctx context.Context,
+	mUUID string,
+	phase coremigration.Phase,
+	entityKey string,
+	success bool,
+	reportTime time.Time,
+) error {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	reportUUID, err := uuid.NewUUID()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	report := minionReport{
+		UUID:          reportUUID.String(),
+		MigrationUUID: mUUID,
+		Phase:         phase.String(),
+		EntityKey:     entityKey,
+		Time:          reportTime,
+		Success:       success,
+	}
+	stmt, err := s.Prepare(`
+INSERT INTO model_migration_minion_sync (uuid, migration_uuid, model_uuid, phase, entity_key, time, success)
+SELECT $minionReport.uuid,
+       m.uuid,
+       m.model_uuid,
+       $minionReport.phase,
+       $minionReport.entity_key,
+       $minionReport.time,
+       $minionReport.success
+FROM   model_migration AS m
+WHERE  m.uuid = $minionReport.migration_uuid
+ON CONFLICT (migration_uuid, phase, entity_key) DO UPDATE SET
+       time = excluded.time,
+       success = excluded.success`, report)
+	if err != nil {
+		return errors.Errorf("preparing minion report statement: %w", err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var outcome sqlair.Outcome
+		if err := tx.Query(ctx, stmt, report).Get(&outcome); err != nil {
+			return errors.Errorf("recording minion report: %w", err)
+		}
+		if affected, err := outcome.Result().RowsAffected(); err != nil {
+			return errors.Errorf("recording minion report: %w", err)
+		} else if affected == 0 {
+			return errors.Errorf("migration %q", mUUID).Add(modelmigrationerrors.MigrationNotFound)
+		}
+		return nil
+	})
+}
+
+// GetMinionReports returns the reports made by agents for the phase of the
+// migration, keyed on the entity key of the reporting agent. The value
+// indicates whether the agent succeeded in completing the phase.
+func (s *ControllerState) GetMinionReports(
+	ctx context.Context,
+	mUUID string,
+	phase coremigration.Phase,
+) (map[string]bool, error) {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	arg := minionReport{
+		MigrationUUID: mUUID,
+		Phase:         phase.String(),
+	}
+	stmt, err := s.Prepare(`
+SELECT (entity_key, success) AS (&minionReport.*)
+FROM   model_migration_minion_sync
+WHERE  migration_uuid = $minionReport.migration_uuid
+AND    phase = $minionReport.phase`, arg)
+	if err != nil {
+		return nil, errors.Errorf("preparing minion reports statement: %w", err)
+	}
+
+	var reports []minionReport
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, arg).GetAll(&reports)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("getting minion reports: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	result := make(map[string]bool, len(reports))
+	for _, report := range reports {
+		result[report.EntityKey] = report.Success
+	}
+	return result, nil
+}
+
+// IsModelImporting returns true if the model is being imported into this
+// controller by a migration that has not yet been activated or aborted.
+func (s *ControllerState) IsModelImporting(ctx context.Context, mUUID string) (bool, error) {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return false, errors.Capture(err)
+	}
+
+	var importing bool
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		importing, err = s.isModelImporting(ctx, tx, modelUUID{UUID: mUUID})
+		return err
+	})
+	return importing, errors.Capture(err)
+}
+
+// ActivateImport marks the import of the model as complete, taking the model
+// out of the importing migration mode. Any migrations of the model recorded
+// from a previous time the model was hosted by this controller are removed, as
+// they no longer describe the model.
+// The following errors can be expected:
+// - [modelmigrationerrors.ModelNotImporting] when the model is not being
+// imported.
+func (s *ControllerState) ActivateImport(ctx context.Context, mUUID string) error {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	model := modelUUID{UUID: mUUID}
+	queries := []string{
+		`DELETE FROM model_migration_import WHERE model_uuid = $modelUUID.model_uuid`,
+		`DELETE FROM model_migration_minion_sync WHERE model_uuid = $modelUUID.model_uuid`,
+		`DELETE FROM model_migration_status WHERE model_uuid = $modelUUID.model_uuid`,
+		`
+DELETE FROM model_migration_user
+WHERE  migration_uuid IN (
+    SELECT uuid FROM model_migration WHERE model_uuid = $modelUUID.model_uuid
+)`,
+		`DELETE FROM model_migration WHERE model_uuid = $modelUUID.model_uuid`,
+	}
+	stmts, err := s.prepareModelStatements(queries, model)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if importing, err := s.isModelImporting(ctx, tx, model); err != nil {
+			return errors.Capture(err)
+		} else if !importing {
+			return errors.Errorf("model %q", mUUID).Add(modelmigrationerrors.ModelNotImporting)
+		}
+
+		for _, stmt := range stmts {
+			if err := tx.Query(ctx, stmt, model).Run(); err != nil {
+				return errors.Errorf("activating import of model %q: %w", mUUID, err)
+			}
+		}
+		return nil
+	})
+}
+
+func (s *ControllerState) isModelImporting(ctx context.Context, tx *sqlair.TX, model modelUUID) (bool, error) {
+	stmt, err := s.Prepare(`
+SELECT &modelUUID.model_uuid
+FROM   model_migration_import
+WHERE  model_uuid = $modelUUID.model_uuid`, model)
+	if err != nil {
+		return false, errors.Errorf("preparing model importing statement: %w", err)
+	}
+
+	var result modelUUID
+	err = tx.Query(ctx, stmt, model).Get(&result)
+	if errors.Is(err, sqlair.ErrNoRows) {
+		return false, nil
+	} else if err != nil {
+		return false, errors.Errorf("checking if model %q is importing: %w", model.UUID, err)
+	}
+	return true, nil
+}
+
+func (s *ControllerState) prepareModelStatements(queries []string, model modelUUID) ([]*sqlair.Statement, error) {
+	stmts := make([]*sqlair.Statement, 0, len(queries))
+	for _, query := range queries {
+		stmt, err := s.Prepare(query, model)
+		if err != nil {
+			return nil, errors.Errorf("preparing statement %q: %w", query, err)
+		}
+		stmts = append(stmts, stmt)
+	}
+	return stmts, nil
+}
@@ -0,0 +1,247 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	coremigration "github.com/juju/juju/core/migration"
+	coremodel "github.com/juju/juju/core/model"
+	modelstatetesting "github.com/juju/juju/domain/model/state/testing"
+	modelmigrationerrors "github.com/juju/juju/domain/modelmigration/errors"
+	schematesting "github.com/juju/juju/domain/schema/testing"
+)
+
+type controllerStateSuite struct {
+	schematesting.ControllerSuite
+
+	modelUUID coremodel.UUID
+}
+
+func TestControllerStateSuite(t *testing.T) {
+	tc.Run(t, &controllerStateSuite{})
+}
+
+func (s *controllerStateSuite) SetUpTest(c *tc.C) {
+	s.ControllerSuite.SetUpTest(c)
+	s.modelUUID = modelstatetesting.CreateTestModel(c, s.TxnRunnerFactory(), "migrating")
+}
+
+func (s *controllerStateSuite) targetInfo() coremigration.TargetInfo {
+	return coremigration.TargetInfo{
+		ControllerUUID:  "deadbeef-1bad-500d-9000-4b1d0d06f00d",
+		ControllerAlias: "target",
+		Addrs:           []string{"10.0.0.1:17070", "10.0.0.2:17070"},
+		CACert:          "cert",
+		User:            "admin",
+		Password:        "secret",
+	}
+}
+
+// TestGetLatestMigrationNotFound is asserting that a model that has never been
+// migrated has no latest migration.
+func (s *controllerStateSuite) TestGetLatestMigrationNotFound(c *tc.C) {
+	st := NewControllerState(s.TxnRunnerFactory())
+
+	_, err := st.GetLatestMigration(c.Context(), s.modelUUID.String())
+	c.Check(err, tc.ErrorIs, modelmigrationerrors.MigrationNotFound)
+}
+
+// TestInitiateMigration is asserting that an initiated migration is returned
+// as the latest migration in the QUIESCE phase.
+func (s *controllerStateSuite) TestInitiateMigration(c *tc.C) {
+	st := NewControllerState(s.TxnRunnerFactory())
+
+	now := time.Now().UTC()
+	err := st.InitiateMigration(c.Context(), "mig-0", s.modelUUID.String(), s.targetInfo(), "admin", now)
+	c.Assert(err, tc.ErrorIsNil)
+
+	mig, err := st.GetLatestMigration(c.Context(), s.modelUUID.String())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(mig.UUID, tc.Equals, "mig-0")
+	c.Check(mig.Attempt, tc.Equals, 0)
+	c.Check(mig.Phase, tc.Equals, coremigration.QUIESCE)
+	c.Check(mig.PhaseChangedTime.Equal(now), tc.IsTrue)
+	c.Check(mig.Target, tc.DeepEquals, s.targetInfo())
+}
+
+// TestInitiateMigrationInProgress is asserting that a second migration can
+// not be initiated while the first is active.
+func (s *controllerStateSuite) TestInitiateMigrationInProgress(c *tc.C) {
+	st := NewControllerState(s.TxnRunnerFactory())
+
+	err := st.InitiateMigration(c.Context(), "mig-0", s.modelUUID.String(), s.targetInfo(), "admin", time.Now())
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = st.InitiateMigration(c.Context(), "mig-1", s.modelUUID.String(), s.targetInfo(), "admin", time.Now())
+	c.Check(err, tc.ErrorIs, modelmigrationerrors.MigrationInProgress)
+}
+
+// TestInitiateMigrationAfterAbort is asserting that a new migration can be
+// initiated once the previous one has ended, and that it is given the next
+// attempt number.
+func (s *controllerStateSuite) TestInitiateMigrationAfterAbort(c *tc.C) {
+	st := NewControllerState(s.TxnRunnerFactory())
+
+	err := st.InitiateMigration(c.Context(), "mig-0", s.modelUUID.String(), s.targetInfo(), "admin", time.Now())
+	c.Assert(err, tc.ErrorIsNil)
+	err = st.SetMigrationPhase(c.Context(), "mig-0", coremigration.QUIESCE, coremigration.ABORT, time.Now())
+	c.Assert(err, tc.ErrorIsNil)
+	err = st.SetMigrationPhase(c.Context(), "mig-0", coremigration.ABORT, coremigration.ABORTDONE, time.Now())
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = st.InitiateMigration(c.Context(), "mig-1", s.modelUUID.String(), s.targetInfo(), "admin", time.Now())
+	c.Assert(err, tc.ErrorIsNil)
+
+	mig, err := st.GetLatestMigration(c.Context(), s.modelUUID.String())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(mig.UUID, tc.Equals, "mig-1")
+	c.Check(mig.Attempt, tc.Equals, 1)
+	c.Check(mig.Phase, tc.Equals, coremigration.QUIESCE)
+}
+
+// TestSetMigrationPhase is asserting that the phase of a migration is
+// persisted, and that the migration ends when a terminal phase is reached.
+func (s *controllerStateSuite) TestSetMigrationPhase(c *tc.C) {
+	st := NewControllerState(s.TxnRunnerFactory())
+
+	err := st.InitiateMigration(c.Context(), "mig-0", s.modelUUID.String(), s.targetInfo(), "admin", time.Now())
+	c.Assert(err, tc.ErrorIsNil)
+
+	phases := append([]coremigration.Phase{coremigration.QUIESCE}, coremigration.SuccessfulMigrationPhases()...)
+	for i := 1; i < len(phases); i++ {
+		err = st.SetMigrationPhase(c.Context(), "mig-0", phases[i-1], phases[i], time.Now())
+		c.Assert(err, tc.ErrorIsNil)
+
+		mig, err := st.GetLatestMigration(c.Context(), s.modelUUID.String())
+		c.Assert(err, tc.ErrorIsNil)
+		c.Check(mig.Phase, tc.Equals, phases[i])
+	}
+
+	var (
+		active                     bool
+		hasSuccessTime, hasEndTime bool
+	)
+	row := s.DB().QueryRow(`
+SELECT active, success_time IS NOT NULL, end_time IS NOT NULL
+FROM   model_migration
+WHERE  uuid = 'mig-0'`)
+	c.Assert(row.Scan(&active, &hasSuccessTime, &hasEndTime), tc.ErrorIsNil)
+	c.Check(active, tc.IsFalse)
+	c.Check(hasSuccessTime, tc.IsTrue)
+	c.Check(hasEndTime, tc.IsTrue)
+}
+
+// TestSetMigrationPhaseChanged is asserting that the phase of a migration is
+// not changed if it is no longer in the expected phase.
+func (s *controllerStateSuite) TestSetMigrationPhaseChanged(c *tc.C) {
+	st := NewControllerState(s.TxnRunnerFactory())
+
+	err := st.InitiateMigration(c.Context(), "mig-0", s.modelUUID.String(), s.targetInfo(), "admin", time.Now())
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = st.SetMigrationPhase(c.Context(), "mig-0", coremigration.IMPORT, coremigration.PROCESSRELATIONS, time.Now())
+	c.Check(err, tc.ErrorIs, modelmigrationerrors.PhaseTransitionNotValid)
+}
+
+// TestSetMigrationStatusMessage is asserting that the status message of a
+// migration is persisted.
+func (s *controllerStateSuite) TestSetMigrationStatusMessage(c *tc.C) {
+	st := NewControllerState(s.TxnRunnerFactory())
+
+	err := st.InitiateMigration(c.Context(), "mig-0", s.modelUUID.String(), s.targetInfo(), "admin", time.Now())
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = st.SetMigrationStatusMessage(c.Context(), "mig-0", "exporting model")
+	c.Assert(err, tc.ErrorIsNil)
+
+	var message string
+	row := s.DB().QueryRow("SELECT status_message FROM model_migration_status WHERE migration_uuid = 'mig-0'")
+	c.Assert(row.Scan(&message), tc.ErrorIsNil)
+	c.Check(message, tc.Equals, "exporting model")
+
+	err = st.SetMigrationStatusMessage(c.Context(), "mig-1", "exporting model")
+	c.Check(err, tc.ErrorIs, modelmigrationerrors.MigrationNotFound)
+}
+
+// TestMinionReports is asserting that minion reports are recorded per phase,
+// with later reports replacing earlier ones.
+func (s *controllerStateSuite) TestMinionReports(c *tc.C) {
+	st := NewControllerState(s.TxnRunnerFactory())
+
+	err := st.InitiateMigration(c.Context(), "mig-0", s.modelUUID.String(), s.targetInfo(), "admin", time.Now())
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = st.RecordMinionReport(c.Context(), "mig-0", coremigration.QUIESCE, "machine-0", false, time.Now())
+	c.Assert(err, tc.ErrorIsNil)
+	err = st.RecordMinionReport(c.Context(), "mig-0", coremigration.QUIESCE, "machine-0", true, time.Now())
+	c.Assert(err, tc.ErrorIsNil)
+	err = st.RecordMinionReport(c.Context(), "mig-0", coremigration.QUIESCE, "unit-foo-0", false, time.Now())
+	c.Assert(err, tc.ErrorIsNil)
+	err = st.RecordMinionReport(c.Context(), "mig-0", coremigration.VALIDATION, "machine-0", true, time.Now())
+	c.Assert(err, tc.ErrorIsNil)
+
+	reports, err := st.GetMinionReports(c.Context(), "mig-0", coremigration.QUIESCE)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(reports, tc.DeepEquals, map[string]bool{
+		"machine-0":  true,
+		"unit-foo-0": false,
+	})
+
+	reports, err = st.GetMinionReports(c.Context(), "mig-0", coremigration.SUCCESS)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(reports, tc.HasLen, 0)
+}
+
+// TestRecordMinionReportMigrationNotFound is asserting that a report can not
+// be recorded against a migration that does not exist.
+func (s *controllerStateSuite) TestRecordMinionReportMigrationNotFound(c *tc.C) {
+	st := NewControllerState(s.TxnRunnerFactory())
+
+	err := st.RecordMinionReport(c.Context(), "mig-0", coremigration.QUIESCE, "machine-0", true, time.Now())
+	c.Check(err, tc.ErrorIs, modelmigrationerrors.MigrationNotFound)
+}
+
+// TestActivateImport is asserting that activating an import takes the model
+// out of the importing mode and clears any previous migrations of the model.
+func (s *controllerStateSuite) TestActivateImport(c *tc.C) {
+	st := NewControllerState(s.TxnRunnerFactory())
+
+	err := st.InitiateMigration(c.Context(), "mig-0", s.modelUUID.String(), s.targetInfo(), "admin", time.Now())
+	c.Assert(err, tc.ErrorIsNil)
+	err = st.RecordMinionReport(c.Context(), "mig-0", coremigration.QUIESCE, "machine-0", true, time.Now())
+	c.Assert(err, tc.ErrorIsNil)
+	s.setModelImporting(c)
+
+	importing, err := st.IsModelImporting(c.Context(), s.modelUUID.String())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(importing, tc.IsTrue)
+
+	err = st.ActivateImport(c.Context(), s.modelUUID.String())
+	c.Assert(err, tc.ErrorIsNil)
+
+	importing, err = st.IsModelImporting(c.Context(), s.modelUUID.String())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(importing, tc.IsFalse)
+
+	_, err = st.GetLatestMigration(c.Context(), s.modelUUID.String())
+	c.Check(err, tc.ErrorIs, modelmigrationerrors.MigrationNotFound)
+}
+
+// TestActivateImportNotImporting is asserting that a model that is not being
+// imported can not be activated.
+func (s *controllerStateSuite) TestActivateImportNotImporting(c *tc.C) {
+	st := NewControllerState(s.TxnRunnerFactory())
+
+	err := st.ActivateImport(c.Context(), s.modelUUID.String())
+	c.Check(err, tc.ErrorIs, modelmigrationerrors.ModelNotImporting)
+}
+
+func (s *controllerStateSuite) setModelImporting(c *tc.C) {
+	_, err := s.DB().Exec("INSERT INTO model_migration_import (model_uuid) VALUES (?)", s.modelUUID.String())
+	c.Assert(err, tc.ErrorIsNil)
+}
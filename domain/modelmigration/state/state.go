@@ -102,3 +102,60 @@ FROM   machine_cloud_instance`
 	}
 	return instanceIDs, nil
 }
+
+// GetAllMachineNames returns the names of all the machines in the model that
+// are not dead.
+func (s *State) GetAllMachineNames(ctx context.Context) ([]string, error) {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return nil, errors.Errorf("cannot get database to retrieve machine names: %w", err)
+	}
+
+	stmt, err := s.Prepare(`
+SELECT &entityName.name
+FROM   machine
+WHERE  life_id != 2`, entityName{})
+	if err != nil {
+		return nil, errors.Errorf("preparing retrieve all machine names statement: %w", err)
+	}
+
+	return s.getAllNames(ctx, db, stmt)
+}
+
+// GetAllUnitNames returns the names of all the units in the model that are
+// not dead.
+func (s *State) GetAllUnitNames(ctx context.Context) ([]string, error) {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return nil, errors.Errorf("cannot get database to retrieve unit names: %w", err)
+	}
+
+	stmt, err := s.Prepare(`
+SELECT &entityName.name
+FROM   unit
+WHERE  life_id != 2`, entityName{})
+	if err != nil {
+		return nil, errors.Errorf("preparing retrieve all unit names statement: %w", err)
+	}
+
+	return s.getAllNames(ctx, db, stmt)
+}
+
+func (s *State) getAllNames(ctx context.Context, db domain.TxnRunner, stmt *sqlair.Statement) ([]string, error) {
+	var result []entityName
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt).GetAll(&result)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("retrieving names: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	names := make([]string, len(result))
+	for i, r := range result {
+		names[i] = r.Name
+	}
+	return names, nil
+}
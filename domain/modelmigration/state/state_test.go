@@ -139,3 +139,36 @@ func (s *migrationSuite) TestEmptyInstanceIDs(c *tc.C) {
 	c.Assert(err, tc.ErrorIsNil)
 	c.Check(instanceIDs, tc.HasLen, 0)
 }
+
+// TestGetAllMachineNames is asserting that the names of all machines that are
+// not dead are returned.
+func (s *migrationSuite) TestGetAllMachineNames(c *tc.C) {
+	machineState := machinestate.NewState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
+
+	var names []string
+	for range 3 {
+		_, machineNames, err := machineState.AddMachine(c.Context(), domainmachine.AddMachineArgs{
+			Platform: deployment.Platform{
+				Channel: "24.04",
+				OSType:  deployment.Ubuntu,
+			},
+		})
+		c.Assert(err, tc.ErrorIsNil)
+		names = append(names, machineNames[0].String())
+	}
+
+	_, err := s.DB().ExecContext(c.Context(), "UPDATE machine SET life_id = 2 WHERE name = ?", names[2])
+	c.Assert(err, tc.ErrorIsNil)
+
+	machineNames, err := New(s.TxnRunnerFactory()).GetAllMachineNames(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(machineNames, tc.SameContents, names[:2])
+}
+
+// TestEmptyUnitNames tests that no error is returned when there are no units
+// in the model.
+func (s *migrationSuite) TestEmptyUnitNames(c *tc.C) {
+	unitNames, err := New(s.TxnRunnerFactory()).GetAllUnitNames(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(unitNames, tc.HasLen, 0)
+}
@@ -3,6 +3,8 @@
 
 package state
 
+import "time"
+
 // ModelInfo represents the model's read only information from the model table
 // in the model database.
 type ModelInfo struct {
@@ -15,3 +17,95 @@ type ModelInfo struct {
 type instanceID struct {
 	ID string `db:"instance_id"`
 }
+
+// entityName represents the name column of an entity, such as a machine or a
+// unit, in the model database.
+type entityName struct {
+	Name string `db:"name"`
+}
+
+// modelUUID represents the model_uuid column of the migration tables in the
+// controller database.
+type modelUUID struct {
+	UUID string `db:"model_uuid"`
+}
+
+// activeMigration represents the uuid column of an active migration in the
+// model_migration table.
+type activeMigration struct {
+	UUID string `db:"uuid"`
+}
+
+// migrationAttempt represents the attempt column of the model_migration
+// table.
+type migrationAttempt struct {
+	Attempt int `db:"attempt"`
+}
+
+// migration represents a row in the model_migration table.
+type migration struct {
+	UUID                  string    `db:"uuid"`
+	ModelUUID             string    `db:"model_uuid"`
+	Attempt               int       `db:"attempt"`
+	InitiatedBy           string    `db:"initiated_by"`
+	TargetControllerUUID  string    `db:"target_controller_uuid"`
+	TargetControllerAlias string    `db:"target_controller_alias"`
+	TargetAddresses       string    `db:"target_addresses"`
+	TargetCACert          string    `db:"target_ca_cert"`
+	TargetEntity          string    `db:"target_entity"`
+	TargetPassword        string    `db:"target_password"`
+	TargetMacaroons       string    `db:"target_macaroons"`
+	TargetToken           string    `db:"target_token"`
+	TargetSkipUserChecks  bool      `db:"target_skip_user_checks"`
+	StartTime             time.Time `db:"start_time"`
+}
+
+// migrationInfo represents a migration joined with its current status.
+type migrationInfo struct {
+	UUID                  string    `db:"uuid"`
+	Attempt               int       `db:"attempt"`
+	TargetControllerUUID  string    `db:"target_controller_uuid"`
+	TargetControllerAlias string    `db:"target_controller_alias"`
+	TargetAddresses       string    `db:"target_addresses"`
+	TargetCACert          string    `db:"target_ca_cert"`
+	TargetEntity          string    `db:"target_entity"`
+	TargetPassword        string    `db:"target_password"`
+	TargetMacaroons       string    `db:"target_macaroons"`
+	TargetToken           string    `db:"target_token"`
+	TargetSkipUserChecks  bool      `db:"target_skip_user_checks"`
+	Phase                 string    `db:"phase"`
+	PhaseChangedTime      time.Time `db:"phase_changed_time"`
+}
+
+// migrationStatus represents a row in the model_migration_status table.
+type migrationStatus struct {
+	MigrationUUID    string    `db:"migration_uuid"`
+	ModelUUID        string    `db:"model_uuid"`
+	Phase            string    `db:"phase"`
+	PhaseChangedTime time.Time `db:"phase_changed_time"`
+	StatusMessage    string    `db:"status_message"`
+}
+
+// phaseChange represents an update to the phase of a migration.
+type phaseChange struct {
+	MigrationUUID string    `db:"migration_uuid"`
+	From          string    `db:"from_phase"`
+	To            string    `db:"to_phase"`
+	Time          time.Time `db:"time"`
+}
+
+// migrationEnd represents the completion times of a migration.
+type migrationEnd struct {
+	UUID string    `db:"uuid"`
+	Time time.Time `db:"time"`
+}
+
+// minionReport represents a row in the model_migration_minion_sync table.
+type minionReport struct {
+	UUID          string    `db:"uuid"`
+	MigrationUUID string    `db:"migration_uuid"`
+	Phase         string    `db:"phase"`
+	EntityKey     string    `db:"entity_key"`
+	Time          time.Time `db:"time"`
+	Success       bool      `db:"success"`
+}
@@ -10,6 +10,7 @@ import (
 	coreapplication "github.com/juju/juju/core/application"
 	"github.com/juju/juju/core/trace"
 	applicationerrors "github.com/juju/juju/domain/application/errors"
+	crossmodelrelationerrors "github.com/juju/juju/domain/crossmodelrelation/errors"
 	"github.com/juju/juju/domain/life"
 	"github.com/juju/juju/domain/removal"
 	removalerrors "github.com/juju/juju/domain/removal/errors"
@@ -23,6 +24,11 @@ type ApplicationState interface {
 	// application UUID.
 	ApplicationExists(ctx context.Context, appUUID string) (bool, error)
 
+	// IsRemoteApplicationOfferer returns true if the application with the
+	// input UUID is a synthetic application, representing an offer consumed
+	// from another model.
+	IsRemoteApplicationOfferer(ctx context.Context, appUUID string) (bool, error)
+
 	// EnsureApplicationNotAliveCascade ensures that there is no application
 	// identified by the input application UUID, that is still alive. If the
 	// application has units, they are also guaranteed to be no longer alive,
@@ -129,6 +135,33 @@ func (s *Service) RemoveApplication(
 	return appJobUUID, nil
 }
 
+// RemoveRemoteApplicationOfferer checks if the application with the input
+// UUID is a remote application offerer, representing an offer consumed from
+// another model. If it is, it is removed in the same manner as any other
+// application, see [Service.RemoveApplication].
+// [crossmodelrelationerrors.RemoteApplicationNotFound] is returned if no such
+// remote application exists.
+func (s *Service) RemoveRemoteApplicationOfferer(
+	ctx context.Context,
+	appUUID coreapplication.ID,
+	force bool,
+	wait time.Duration,
+) (removal.UUID, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	isRemote, err := s.modelState.IsRemoteApplicationOfferer(ctx, appUUID.String())
+	if err != nil {
+		return "", errors.Errorf("checking if application %q is a remote offerer: %w", appUUID, err)
+	} else if !isRemote {
+		return "", errors.Errorf("remote application %q does not exist", appUUID).
+			Add(crossmodelrelationerrors.RemoteApplicationNotFound)
+	}
+
+	// Remote applications have no units, so they have no storage to destroy.
+	return s.RemoveApplication(ctx, appUUID, false, force, wait)
+}
+
 func (s *Service) applicationScheduleRemoval(
 	ctx context.Context, appUUID coreapplication.ID, force bool, wait time.Duration,
 ) (removal.UUID, error) {
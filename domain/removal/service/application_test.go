@@ -12,6 +12,7 @@ import (
 
 	applicationtesting "github.com/juju/juju/core/application/testing"
 	applicationerrors "github.com/juju/juju/domain/application/errors"
+	crossmodelrelationerrors "github.com/juju/juju/domain/crossmodelrelation/errors"
 	"github.com/juju/juju/domain/life"
 	removal "github.com/juju/juju/domain/removal"
 	removalerrors "github.com/juju/juju/domain/removal/errors"
@@ -174,6 +175,36 @@ func (s *applicationSuite) TestRemoveApplicationNoForceSuccessWithRelations(c *t
 	c.Assert(jobUUID.Validate(), tc.ErrorIsNil)
 }
 
+func (s *applicationSuite) TestRemoveRemoteApplicationOfferer(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appUUID := applicationtesting.GenApplicationUUID(c)
+
+	when := time.Now()
+	s.clock.EXPECT().Now().Return(when)
+
+	exp := s.modelState.EXPECT()
+	exp.IsRemoteApplicationOfferer(gomock.Any(), appUUID.String()).Return(true, nil)
+	exp.ApplicationExists(gomock.Any(), appUUID.String()).Return(true, nil)
+	exp.EnsureApplicationNotAliveCascade(gomock.Any(), appUUID.String()).Return(removal.ApplicationArtifacts{}, nil)
+	exp.ApplicationScheduleRemoval(gomock.Any(), gomock.Any(), appUUID.String(), false, when.UTC()).Return(nil)
+
+	jobUUID, err := s.newService(c).RemoveRemoteApplicationOfferer(c.Context(), appUUID, false, 0)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(jobUUID.Validate(), tc.ErrorIsNil)
+}
+
+func (s *applicationSuite) TestRemoveRemoteApplicationOffererNotRemote(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appUUID := applicationtesting.GenApplicationUUID(c)
+
+	s.modelState.EXPECT().IsRemoteApplicationOfferer(gomock.Any(), appUUID.String()).Return(false, nil)
+
+	_, err := s.newService(c).RemoveRemoteApplicationOfferer(c.Context(), appUUID, false, 0)
+	c.Assert(err, tc.ErrorIs, crossmodelrelationerrors.RemoteApplicationNotFound)
+}
+
 func (s *applicationSuite) TestProcessRemovalJobInvalidJobType(c *tc.C) {
 	var invalidJobType removal.JobType = 500
 
@@ -35,6 +35,10 @@ type ControllerDBState interface {
 
 	// DeleteModel removes the model with the input UUID from the database.
 	DeleteModel(ctx context.Context, modelUUID string) error
+
+	// DeleteOfferAccess removes all permissions granted on the offer with
+	// the input UUID.
+	DeleteOfferAccess(ctx context.Context, offerUUID string) error
 }
 
 // RemoveController checks if a model is the controller model, and will set the
@@ -5,15 +5,133 @@ package service
 
 import (
 	"context"
+	"time"
 
-	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/trace"
+	crossmodelrelationerrors "github.com/juju/juju/domain/crossmodelrelation/errors"
+	"github.com/juju/juju/domain/removal"
+	removalerrors "github.com/juju/juju/domain/removal/errors"
+	"github.com/juju/juju/internal/errors"
 	"github.com/juju/juju/internal/uuid"
 )
 
-// RemoveOffer removes the offer from the model.
+// OfferState describes retrieval and persistence
+// methods specific to offer removal.
+type OfferState interface {
+	// OfferExists returns true if an offer exists with the input UUID.
+	OfferExists(ctx context.Context, offerUUID string) (bool, error)
+
+	// GetOfferConnectionRelationUUIDs returns the UUIDs of the relations
+	// established through connections to the offer with the input UUID.
+	GetOfferConnectionRelationUUIDs(ctx context.Context, offerUUID string) ([]string, error)
+
+	// OfferScheduleRemoval schedules a removal job for the offer with the
+	// input UUID, qualified with the input force boolean.
+	OfferScheduleRemoval(ctx context.Context, removalUUID, offerUUID string, force bool, when time.Time) error
+
+	// DeleteOffer removes an offer from the database completely, along with
+	// any connections made to it.
+	DeleteOffer(ctx context.Context, offerUUID string) error
+}
+
+// RemoveOffer removes the offer with the input UUID from the model, along
+// with all permissions granted on it. If the offer has connections, it is
+// only removed if force is true, in which case the relations of the
+// connections are scheduled for forced removal, and the offer is removed by
+// a removal job once they are gone.
+// It returns an error satisfying:
+//   - [crossmodelrelationerrors.OfferNotFound] if the offer does not exist.
+//   - [crossmodelrelationerrors.OfferHasConnections] if the offer has
+//     connections and force is false.
 func (s *Service) RemoveOffer(ctx context.Context, offerUUID uuid.UUID, force bool) error {
-	// TODO:
-	// Remove offer with cascade
-	// Remove permissions on offer
-	return coreerrors.NotImplemented
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	exists, err := s.modelState.OfferExists(ctx, offerUUID.String())
+	if err != nil {
+		return errors.Errorf("checking if offer %q exists: %w", offerUUID, err)
+	} else if !exists {
+		return errors.Errorf("offer %q does not exist", offerUUID).Add(crossmodelrelationerrors.OfferNotFound)
+	}
+
+	relationUUIDs, err := s.modelState.GetOfferConnectionRelationUUIDs(ctx, offerUUID.String())
+	if err != nil {
+		return errors.Errorf("getting connections for offer %q: %w", offerUUID, err)
+	}
+
+	if len(relationUUIDs) == 0 {
+		return s.deleteOffer(ctx, offerUUID.String())
+	}
+
+	if !force {
+		return errors.Errorf("offer %q has %d connection(s)", offerUUID, len(relationUUIDs)).
+			Add(crossmodelrelationerrors.OfferHasConnections)
+	}
+
+	s.logger.Infof(ctx, "offer has relations %v, scheduling removal", relationUUIDs)
+	s.removeRelations(ctx, relationUUIDs, true, 0)
+
+	// The connections are deleted along with their relations, so the offer
+	// itself is only deleted by its removal job once they are all gone.
+	if _, err := s.offerScheduleRemoval(ctx, offerUUID.String(), force); err != nil {
+		return errors.Capture(err)
+	}
+	return nil
+}
+
+func (s *Service) offerScheduleRemoval(ctx context.Context, offerUUID string, force bool) (removal.UUID, error) {
+	jobUUID, err := removal.NewUUID()
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	if err := s.modelState.OfferScheduleRemoval(
+		ctx, jobUUID.String(), offerUUID, force, s.clock.Now().UTC(),
+	); err != nil {
+		return "", errors.Errorf("offer %q: %w", offerUUID, err)
+	}
+
+	s.logger.Infof(ctx, "scheduled removal job %q for offer %q", jobUUID, offerUUID)
+	return jobUUID, nil
+}
+
+// processOfferRemovalJob deletes an offer and the permissions granted on it,
+// once the relations established through connections to it are gone.
+func (s *Service) processOfferRemovalJob(ctx context.Context, job removal.Job) error {
+	if job.RemovalType != removal.OfferJob {
+		return errors.Errorf("job type: %q not valid for offer removal", job.RemovalType).Add(
+			removalerrors.RemovalJobTypeNotValid)
+	}
+
+	exists, err := s.modelState.OfferExists(ctx, job.EntityUUID)
+	if err != nil {
+		return errors.Errorf("checking if offer %q exists: %w", job.EntityUUID, err)
+	} else if !exists {
+		// The offer has already been removed.
+		// Indicate success so that this job will be deleted.
+		return nil
+	}
+
+	relationUUIDs, err := s.modelState.GetOfferConnectionRelationUUIDs(ctx, job.EntityUUID)
+	if err != nil {
+		return errors.Errorf("getting connections for offer %q: %w", job.EntityUUID, err)
+	}
+	if len(relationUUIDs) > 0 {
+		s.logger.Infof(ctx, "removal job %q for offer %q is waiting for relations to be removed: %v",
+			job.UUID, job.EntityUUID, relationUUIDs)
+		return removalerrors.RemovalJobIncomplete
+	}
+
+	return s.deleteOffer(ctx, job.EntityUUID)
+}
+
+func (s *Service) deleteOffer(ctx context.Context, offerUUID string) error {
+	if err := s.modelState.DeleteOffer(ctx, offerUUID); err != nil {
+		return errors.Errorf("deleting offer %q: %w", offerUUID, err)
+	}
+
+	if err := s.controllerState.DeleteOfferAccess(ctx, offerUUID); err != nil {
+		return errors.Errorf("deleting permissions for offer %q: %w", offerUUID, err)
+	}
+	return nil
 }
@@ -0,0 +1,141 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	crossmodelrelationerrors "github.com/juju/juju/domain/crossmodelrelation/errors"
+	"github.com/juju/juju/domain/removal"
+	"github.com/juju/juju/internal/uuid"
+)
+
+type offerSuite struct {
+	baseSuite
+}
+
+func TestOfferSuite(t *testing.T) {
+	tc.Run(t, &offerSuite{})
+}
+
+func (s *offerSuite) TestRemoveOfferNoConnections(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	offerUUID := tc.Must(c, uuid.NewUUID)
+
+	exp := s.modelState.EXPECT()
+	exp.OfferExists(gomock.Any(), offerUUID.String()).Return(true, nil)
+	exp.GetOfferConnectionRelationUUIDs(gomock.Any(), offerUUID.String()).Return(nil, nil)
+	exp.DeleteOffer(gomock.Any(), offerUUID.String()).Return(nil)
+	s.controllerState.EXPECT().DeleteOfferAccess(gomock.Any(), offerUUID.String()).Return(nil)
+
+	err := s.newService(c).RemoveOffer(c.Context(), offerUUID, false)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *offerSuite) TestRemoveOfferNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	offerUUID := tc.Must(c, uuid.NewUUID)
+
+	s.modelState.EXPECT().OfferExists(gomock.Any(), offerUUID.String()).Return(false, nil)
+
+	err := s.newService(c).RemoveOffer(c.Context(), offerUUID, false)
+	c.Assert(err, tc.ErrorIs, crossmodelrelationerrors.OfferNotFound)
+}
+
+func (s *offerSuite) TestRemoveOfferWithConnectionsNoForce(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	offerUUID := tc.Must(c, uuid.NewUUID)
+
+	exp := s.modelState.EXPECT()
+	exp.OfferExists(gomock.Any(), offerUUID.String()).Return(true, nil)
+	exp.GetOfferConnectionRelationUUIDs(gomock.Any(), offerUUID.String()).Return([]string{"relation-1"}, nil)
+
+	err := s.newService(c).RemoveOffer(c.Context(), offerUUID, false)
+	c.Assert(err, tc.ErrorIs, crossmodelrelationerrors.OfferHasConnections)
+}
+
+func (s *offerSuite) TestRemoveOfferWithConnectionsForce(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	offerUUID := tc.Must(c, uuid.NewUUID)
+	when := time.Now()
+	s.clock.EXPECT().Now().Return(when).AnyTimes()
+
+	exp := s.modelState.EXPECT()
+	exp.OfferExists(gomock.Any(), offerUUID.String()).Return(true, nil)
+	exp.GetOfferConnectionRelationUUIDs(gomock.Any(), offerUUID.String()).Return([]string{"relation-1"}, nil)
+
+	// We don't want to create all the relation expectations here, so we'll
+	// assume that the relation no longer exists, to prevent this test from
+	// depending on the relation removal logic.
+	exp.RelationExists(gomock.Any(), "relation-1").Return(false, nil)
+
+	// The offer is not deleted straight away, but by its removal job.
+	exp.OfferScheduleRemoval(gomock.Any(), gomock.Any(), offerUUID.String(), true, when.UTC()).Return(nil)
+
+	err := s.newService(c).RemoveOffer(c.Context(), offerUUID, true)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *offerSuite) TestExecuteJobForOfferNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	j := newOfferJob(c)
+
+	exp := s.modelState.EXPECT()
+	exp.OfferExists(gomock.Any(), j.EntityUUID).Return(false, nil)
+	exp.DeleteJob(gomock.Any(), j.UUID.String()).Return(nil)
+
+	err := s.newService(c).ExecuteJob(c.Context(), j)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *offerSuite) TestExecuteJobForOfferWithConnections(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	j := newOfferJob(c)
+
+	exp := s.modelState.EXPECT()
+	exp.OfferExists(gomock.Any(), j.EntityUUID).Return(true, nil)
+	exp.GetOfferConnectionRelationUUIDs(gomock.Any(), j.EntityUUID).Return([]string{"relation-1"}, nil)
+
+	// The job is left in place to be run again once the relations are gone.
+	err := s.newService(c).ExecuteJob(c.Context(), j)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *offerSuite) TestExecuteJobForOfferNoConnections(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	j := newOfferJob(c)
+
+	exp := s.modelState.EXPECT()
+	exp.OfferExists(gomock.Any(), j.EntityUUID).Return(true, nil)
+	exp.GetOfferConnectionRelationUUIDs(gomock.Any(), j.EntityUUID).Return(nil, nil)
+	exp.DeleteOffer(gomock.Any(), j.EntityUUID).Return(nil)
+	s.controllerState.EXPECT().DeleteOfferAccess(gomock.Any(), j.EntityUUID).Return(nil)
+	exp.DeleteJob(gomock.Any(), j.UUID.String()).Return(nil)
+
+	err := s.newService(c).ExecuteJob(c.Context(), j)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func newOfferJob(c *tc.C) removal.Job {
+	jUUID, err := removal.NewUUID()
+	c.Assert(err, tc.ErrorIsNil)
+
+	return removal.Job{
+		UUID:        jUUID,
+		RemovalType: removal.OfferJob,
+		EntityUUID:  tc.Must(c, uuid.NewUUID).String(),
+		Force:       true,
+	}
+}
@@ -82,6 +82,44 @@ func (c *MockControllerDBStateDeleteModelCall) DoAndReturn(f func(context.Contex
 	return c
 }
 
+// DeleteOfferAccess mocks base method.
+func (m *MockControllerDBState) DeleteOfferAccess(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOfferAccess", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOfferAccess indicates an expected call of DeleteOfferAccess.
+func (mr *MockControllerDBStateMockRecorder) DeleteOfferAccess(arg0, arg1 any) *MockControllerDBStateDeleteOfferAccessCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOfferAccess", reflect.TypeOf((*MockControllerDBState)(nil).DeleteOfferAccess), arg0, arg1)
+	return &MockControllerDBStateDeleteOfferAccessCall{Call: call}
+}
+
+// MockControllerDBStateDeleteOfferAccessCall wrap *gomock.Call
+type MockControllerDBStateDeleteOfferAccessCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockControllerDBStateDeleteOfferAccessCall) Return(arg0 error) *MockControllerDBStateDeleteOfferAccessCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockControllerDBStateDeleteOfferAccessCall) Do(f func(context.Context, string) error) *MockControllerDBStateDeleteOfferAccessCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockControllerDBStateDeleteOfferAccessCall) DoAndReturn(f func(context.Context, string) error) *MockControllerDBStateDeleteOfferAccessCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // EnsureModelNotAliveCascade mocks base method.
 func (m *MockControllerDBState) EnsureModelNotAliveCascade(arg0 context.Context, arg1 string, arg2 bool) error {
 	m.ctrl.T.Helper()
@@ -527,6 +565,44 @@ func (c *MockModelDBStateDeleteModelArtifactsCall) DoAndReturn(f func(context.Co
 	return c
 }
 
+// DeleteOffer mocks base method.
+func (m *MockModelDBState) DeleteOffer(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOffer", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOffer indicates an expected call of DeleteOffer.
+func (mr *MockModelDBStateMockRecorder) DeleteOffer(arg0, arg1 any) *MockModelDBStateDeleteOfferCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOffer", reflect.TypeOf((*MockModelDBState)(nil).DeleteOffer), arg0, arg1)
+	return &MockModelDBStateDeleteOfferCall{Call: call}
+}
+
+// MockModelDBStateDeleteOfferCall wrap *gomock.Call
+type MockModelDBStateDeleteOfferCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelDBStateDeleteOfferCall) Return(arg0 error) *MockModelDBStateDeleteOfferCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelDBStateDeleteOfferCall) Do(f func(context.Context, string) error) *MockModelDBStateDeleteOfferCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelDBStateDeleteOfferCall) DoAndReturn(f func(context.Context, string) error) *MockModelDBStateDeleteOfferCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // DeleteRelation mocks base method.
 func (m *MockModelDBState) DeleteRelation(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -1148,6 +1224,45 @@ func (c *MockModelDBStateGetModelLifeCall) DoAndReturn(f func(context.Context, s
 	return c
 }
 
+// GetOfferConnectionRelationUUIDs mocks base method.
+func (m *MockModelDBState) GetOfferConnectionRelationUUIDs(arg0 context.Context, arg1 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOfferConnectionRelationUUIDs", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOfferConnectionRelationUUIDs indicates an expected call of GetOfferConnectionRelationUUIDs.
+func (mr *MockModelDBStateMockRecorder) GetOfferConnectionRelationUUIDs(arg0, arg1 any) *MockModelDBStateGetOfferConnectionRelationUUIDsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOfferConnectionRelationUUIDs", reflect.TypeOf((*MockModelDBState)(nil).GetOfferConnectionRelationUUIDs), arg0, arg1)
+	return &MockModelDBStateGetOfferConnectionRelationUUIDsCall{Call: call}
+}
+
+// MockModelDBStateGetOfferConnectionRelationUUIDsCall wrap *gomock.Call
+type MockModelDBStateGetOfferConnectionRelationUUIDsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelDBStateGetOfferConnectionRelationUUIDsCall) Return(arg0 []string, arg1 error) *MockModelDBStateGetOfferConnectionRelationUUIDsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelDBStateGetOfferConnectionRelationUUIDsCall) Do(f func(context.Context, string) ([]string, error)) *MockModelDBStateGetOfferConnectionRelationUUIDsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelDBStateGetOfferConnectionRelationUUIDsCall) DoAndReturn(f func(context.Context, string) ([]string, error)) *MockModelDBStateGetOfferConnectionRelationUUIDsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetRelationLife mocks base method.
 func (m *MockModelDBState) GetRelationLife(arg0 context.Context, arg1 string) (life.Life, error) {
 	m.ctrl.T.Helper()
@@ -1265,6 +1380,45 @@ func (c *MockModelDBStateIsControllerModelCall) DoAndReturn(f func(context.Conte
 	return c
 }
 
+// IsRemoteApplicationOfferer mocks base method.
+func (m *MockModelDBState) IsRemoteApplicationOfferer(arg0 context.Context, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsRemoteApplicationOfferer", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsRemoteApplicationOfferer indicates an expected call of IsRemoteApplicationOfferer.
+func (mr *MockModelDBStateMockRecorder) IsRemoteApplicationOfferer(arg0, arg1 any) *MockModelDBStateIsRemoteApplicationOffererCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsRemoteApplicationOfferer", reflect.TypeOf((*MockModelDBState)(nil).IsRemoteApplicationOfferer), arg0, arg1)
+	return &MockModelDBStateIsRemoteApplicationOffererCall{Call: call}
+}
+
+// MockModelDBStateIsRemoteApplicationOffererCall wrap *gomock.Call
+type MockModelDBStateIsRemoteApplicationOffererCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelDBStateIsRemoteApplicationOffererCall) Return(arg0 bool, arg1 error) *MockModelDBStateIsRemoteApplicationOffererCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelDBStateIsRemoteApplicationOffererCall) Do(f func(context.Context, string) (bool, error)) *MockModelDBStateIsRemoteApplicationOffererCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelDBStateIsRemoteApplicationOffererCall) DoAndReturn(f func(context.Context, string) (bool, error)) *MockModelDBStateIsRemoteApplicationOffererCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MachineExists mocks base method.
 func (m *MockModelDBState) MachineExists(arg0 context.Context, arg1 string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -1648,6 +1802,83 @@ func (c *MockModelDBStateNamespaceForWatchRemovalsCall) DoAndReturn(f func() str
 	return c
 }
 
+// OfferExists mocks base method.
+func (m *MockModelDBState) OfferExists(arg0 context.Context, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OfferExists", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OfferExists indicates an expected call of OfferExists.
+func (mr *MockModelDBStateMockRecorder) OfferExists(arg0, arg1 any) *MockModelDBStateOfferExistsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OfferExists", reflect.TypeOf((*MockModelDBState)(nil).OfferExists), arg0, arg1)
+	return &MockModelDBStateOfferExistsCall{Call: call}
+}
+
+// MockModelDBStateOfferExistsCall wrap *gomock.Call
+type MockModelDBStateOfferExistsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelDBStateOfferExistsCall) Return(arg0 bool, arg1 error) *MockModelDBStateOfferExistsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelDBStateOfferExistsCall) Do(f func(context.Context, string) (bool, error)) *MockModelDBStateOfferExistsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelDBStateOfferExistsCall) DoAndReturn(f func(context.Context, string) (bool, error)) *MockModelDBStateOfferExistsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// OfferScheduleRemoval mocks base method.
+func (m *MockModelDBState) OfferScheduleRemoval(arg0 context.Context, arg1, arg2 string, arg3 bool, arg4 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OfferScheduleRemoval", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OfferScheduleRemoval indicates an expected call of OfferScheduleRemoval.
+func (mr *MockModelDBStateMockRecorder) OfferScheduleRemoval(arg0, arg1, arg2, arg3, arg4 any) *MockModelDBStateOfferScheduleRemovalCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OfferScheduleRemoval", reflect.TypeOf((*MockModelDBState)(nil).OfferScheduleRemoval), arg0, arg1, arg2, arg3, arg4)
+	return &MockModelDBStateOfferScheduleRemovalCall{Call: call}
+}
+
+// MockModelDBStateOfferScheduleRemovalCall wrap *gomock.Call
+type MockModelDBStateOfferScheduleRemovalCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelDBStateOfferScheduleRemovalCall) Return(arg0 error) *MockModelDBStateOfferScheduleRemovalCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelDBStateOfferScheduleRemovalCall) Do(f func(context.Context, string, string, bool, time.Time) error) *MockModelDBStateOfferScheduleRemovalCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelDBStateOfferScheduleRemovalCall) DoAndReturn(f func(context.Context, string, string, bool, time.Time) error) *MockModelDBStateOfferScheduleRemovalCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // RelationExists mocks base method.
 func (m *MockModelDBState) RelationExists(arg0 context.Context, arg1 string) (bool, error) {
 	m.ctrl.T.Helper()
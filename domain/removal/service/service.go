@@ -51,6 +51,7 @@ type ModelDBState interface {
 	MachineState
 	ModelState
 	StorageState
+	OfferState
 
 	// GetAllJobs returns all removal jobs.
 	GetAllJobs(ctx context.Context) ([]removal.Job, error)
@@ -140,6 +141,9 @@ func (s *Service) ExecuteJob(ctx context.Context, job removal.Job) error {
 	case removal.ModelJob:
 		err = s.processModelJob(ctx, job)
 
+	case removal.OfferJob:
+		err = s.processOfferRemovalJob(ctx, job)
+
 	default:
 		err = errors.Errorf("removal job type %q not supported", job.RemovalType).Add(
 			removalerrors.RemovalJobTypeNotSupported)
@@ -268,8 +268,10 @@ func (st *State) removeBasicModelData(ctx context.Context, tx *sqlair.TX, mUUID
 		"DELETE FROM model_namespace WHERE model_uuid = $entityUUID.uuid",
 		"DELETE FROM model_secret_backend WHERE model_uuid = $entityUUID.uuid",
 		"DELETE FROM secret_backend_reference WHERE model_uuid = $entityUUID.uuid",
+		"DELETE FROM secret_encryption_key_reference WHERE model_uuid = $entityUUID.uuid",
 		"DELETE FROM model_authorized_keys WHERE model_uuid = $entityUUID.uuid",
 		"DELETE FROM model_last_login WHERE model_uuid = $entityUUID.uuid",
+		"DELETE FROM model_migration_import WHERE model_uuid = $entityUUID.uuid",
 	}
 
 	for _, table := range tables {
@@ -0,0 +1,36 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package controller
+
+import (
+	"context"
+
+	"github.com/canonical/sqlair"
+
+	"github.com/juju/juju/internal/errors"
+)
+
+// DeleteOfferAccess removes all permissions granted on the offer with the
+// input UUID.
+func (st *State) DeleteOfferAccess(ctx context.Context, offerUUID string) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	offer := entityUUID{UUID: offerUUID}
+	stmt, err := st.Prepare(`
+DELETE FROM permission
+WHERE grant_on = $entityUUID.uuid`, offer)
+	if err != nil {
+		return errors.Errorf("preparing offer permissions deletion: %w", err)
+	}
+
+	return errors.Capture(db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := tx.Query(ctx, stmt, offer).Run(); err != nil {
+			return errors.Errorf("deleting offer permissions: %w", err)
+		}
+		return nil
+	}))
+}
@@ -49,6 +49,40 @@ WHERE  uuid = $entityUUID.uuid`, applicationUUID)
 	return applicationExists, errors.Capture(err)
 }
 
+// IsRemoteApplicationOfferer returns true if the application with the input
+// UUID is a synthetic application, representing an offer consumed from
+// another model.
+func (st *State) IsRemoteApplicationOfferer(ctx context.Context, aUUID string) (bool, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return false, errors.Capture(err)
+	}
+
+	applicationUUID := entityUUID{UUID: aUUID}
+	existsStmt, err := st.Prepare(`
+SELECT application_uuid AS &entityUUID.uuid
+FROM   application_remote_offerer
+WHERE  application_uuid = $entityUUID.uuid`, applicationUUID)
+	if err != nil {
+		return false, errors.Errorf("preparing remote application offerer query: %w", err)
+	}
+
+	var isRemote bool
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err = tx.Query(ctx, existsStmt, applicationUUID).Get(&applicationUUID)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		} else if err != nil {
+			return errors.Errorf("running remote application offerer query: %w", err)
+		}
+
+		isRemote = true
+		return nil
+	})
+
+	return isRemote, errors.Capture(err)
+}
+
 // EnsureApplicationNotAliveCascade ensures that there is no application
 // identified by the input application UUID, that is still alive. If the
 // application has units, they are also guaranteed to be no longer alive,
@@ -73,6 +107,17 @@ AND    life_id = 0`, applicationUUID)
 		return res, errors.Errorf("preparing application life update: %w", err)
 	}
 
+	// If the application represents an offer consumed from another model,
+	// the remote application record follows the life of the application.
+	updateRemoteApplicationStmt, err := st.Prepare(`
+UPDATE application_remote_offerer
+SET    life_id = 1
+WHERE  application_uuid = $entityUUID.uuid
+AND    life_id = 0`, applicationUUID)
+	if err != nil {
+		return res, errors.Errorf("preparing remote application life update: %w", err)
+	}
+
 	// Also ensure that any other entities that are associated with the
 	// application are also set to dying. This has to be done in a single
 	// transaction because we want to ensure that the application is not
@@ -116,6 +161,21 @@ AND    life_id = 0`, applicationUUID)
 		return res, errors.Errorf("preparing unit life update: %w", err)
 	}
 
+	// Shared storage is owned by the application, so it goes away with the
+	// application and not with any one of its units.
+	updateSharedStorageStmt, err := st.Prepare(`
+UPDATE storage_instance
+SET    life_id = 1
+WHERE  life_id = 0
+AND    uuid IN (
+    SELECT storage_instance_uuid
+    FROM   storage_application_owner
+    WHERE  application_uuid = $entityUUID.uuid
+)`, applicationUUID)
+	if err != nil {
+		return res, errors.Errorf("preparing shared storage life update: %w", err)
+	}
+
 	var (
 		relationUUIDsRec []string
 		unitUUIDsRec     []entityUUID
@@ -126,6 +186,10 @@ AND    life_id = 0`, applicationUUID)
 			return errors.Errorf("advancing application life: %w", err)
 		}
 
+		if err := tx.Query(ctx, updateRemoteApplicationStmt, applicationUUID).Run(); err != nil {
+			return errors.Errorf("advancing remote application life: %w", err)
+		}
+
 		var relationUUIDs []entityUUID
 		if err := tx.Query(ctx, selectRelationUUIDsStmt, applicationUUID).GetAll(&relationUUIDs); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
 			return errors.Errorf("selecting relation UUIDs: %w", err)
@@ -138,6 +202,10 @@ AND    life_id = 0`, applicationUUID)
 			}
 		}
 
+		if err := tx.Query(ctx, updateSharedStorageStmt, applicationUUID).Run(); err != nil {
+			return errors.Errorf("advancing shared storage life: %w", err)
+		}
+
 		if err := tx.Query(ctx, selectUnitUUIDsStmt, applicationUUID).GetAll(&unitUUIDsRec); errors.Is(err, sqlair.ErrNoRows) {
 			// If there are no units associated with the application,
 			// we can just return nil, as there is nothing to update.
@@ -373,6 +441,7 @@ func (st *State) deleteSimpleApplicationReferences(ctx context.Context, tx *sqla
 	for _, table := range []string{
 		"DELETE FROM application_channel WHERE application_uuid = $entityUUID.uuid",
 		"DELETE FROM application_platform WHERE application_uuid = $entityUUID.uuid",
+		"DELETE FROM application_refresh_policy WHERE application_uuid = $entityUUID.uuid",
 		"DELETE FROM application_scale WHERE application_uuid = $entityUUID.uuid",
 		"DELETE FROM application_config WHERE application_uuid = $entityUUID.uuid",
 		"DELETE FROM application_config_hash WHERE application_uuid = $entityUUID.uuid",
@@ -383,9 +452,11 @@ func (st *State) deleteSimpleApplicationReferences(ctx context.Context, tx *sqla
 		"DELETE FROM application_endpoint WHERE application_uuid = $entityUUID.uuid",
 		"DELETE FROM application_extra_endpoint WHERE application_uuid = $entityUUID.uuid",
 		"DELETE FROM application_storage_directive WHERE application_uuid = $entityUUID.uuid",
+		"DELETE FROM storage_application_owner WHERE application_uuid = $entityUUID.uuid",
 		"DELETE FROM application_status WHERE application_uuid = $entityUUID.uuid",
 		"DELETE FROM application_workload_version WHERE application_uuid = $entityUUID.uuid",
 		"DELETE FROM device_constraint WHERE application_uuid = $entityUUID.uuid",
+		"DELETE FROM application_remote_offerer WHERE application_uuid = $entityUUID.uuid",
 	} {
 		deleteApplicationReferenceStmt, err := st.Prepare(table, app)
 		if err != nil {
@@ -585,6 +656,7 @@ func (st *State) deleteCharm(ctx context.Context, tx *sqlair.TX, cUUID string) e
 		"DELETE FROM charm_hash WHERE charm_uuid = $entityUUID.uuid",
 		"DELETE FROM charm_metadata WHERE charm_uuid = $entityUUID.uuid",
 		"DELETE FROM charm_download_info WHERE charm_uuid = $entityUUID.uuid",
+		"DELETE FROM charm_channel WHERE charm_uuid = $entityUUID.uuid",
 	} {
 		deleteApplicationReferenceStmt, err := st.Prepare(table, charmUUID)
 		if err != nil {
@@ -396,6 +396,42 @@ func (s *applicationSuite) TestDeleteIAASApplication(c *tc.C) {
 	c.Check(exists, tc.Equals, false)
 }
 
+func (s *applicationSuite) TestEnsureApplicationNotAliveCascadeSharedStorage(c *tc.C) {
+	svc := s.setupApplicationService(c)
+	appUUID := s.createIAASApplication(c, svc, "some-app")
+	storageUUID := s.addApplicationSharedStorage(c, appUUID)
+
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	_, err := st.EnsureApplicationNotAliveCascade(c.Context(), appUUID.String())
+	c.Assert(err, tc.ErrorIsNil)
+
+	row := s.DB().QueryRow("SELECT life_id FROM storage_instance WHERE uuid = ?", storageUUID)
+	var lifeID int
+	err = row.Scan(&lifeID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(lifeID, tc.Equals, 1)
+}
+
+func (s *applicationSuite) TestDeleteIAASApplicationWithSharedStorage(c *tc.C) {
+	svc := s.setupApplicationService(c)
+	appUUID := s.createIAASApplication(c, svc, "some-app")
+	s.addApplicationSharedStorage(c, appUUID)
+
+	s.advanceApplicationLife(c, appUUID, life.Dead)
+
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	err := st.DeleteApplication(c.Context(), appUUID.String())
+	c.Assert(err, tc.ErrorIsNil)
+
+	row := s.DB().QueryRow("SELECT COUNT(*) FROM storage_application_owner WHERE application_uuid = ?", appUUID.String())
+	var count int
+	err = row.Scan(&count)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(count, tc.Equals, 0)
+}
+
 func (s *applicationSuite) TestDeleteIAASApplicationWithUnits(c *tc.C) {
 	svc := s.setupApplicationService(c)
 	appUUID := s.createIAASApplication(c, svc, "some-app",
@@ -627,6 +663,24 @@ func (s *applicationSuite) TestDeleteApplicationWithSharedObjectstoreResource(c
 	c.Check(exists, tc.Equals, false)
 }
 
+// addApplicationSharedStorage adds a shared storage instance owned by the
+// application, returning the uuid of the storage instance.
+func (s *applicationSuite) addApplicationSharedStorage(c *tc.C, appUUID coreapplication.ID) string {
+	poolUUID := "pool-" + appUUID.String()
+	storageUUID := "storage-" + appUUID.String()
+	_, err := s.DB().Exec("INSERT INTO storage_pool (uuid, name, type) VALUES (?, ?, ?)",
+		poolUUID, "shared-"+appUUID.String(), "nfs")
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = s.DB().Exec(`
+INSERT INTO storage_instance (uuid, storage_name, storage_kind_id, storage_id, life_id, storage_pool_uuid, requested_size_mib)
+VALUES (?, ?, ?, ?, ?, ?, ?)`, storageUUID, "data", 1, "data/0", 0, poolUUID, 1024)
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = s.DB().Exec("INSERT INTO storage_application_owner (storage_instance_uuid, application_uuid) VALUES (?, ?)",
+		storageUUID, appUUID.String())
+	c.Assert(err, tc.ErrorIsNil)
+	return storageUUID
+}
+
 func (s *applicationSuite) getAppResourceUUID(c *tc.C, appUUID coreapplication.ID) string {
 	row := s.DB().QueryRow(`
 SELECT uuid
@@ -0,0 +1,148 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/canonical/sqlair"
+	"github.com/juju/collections/transform"
+
+	"github.com/juju/juju/internal/errors"
+)
+
+// OfferExists returns true if an offer exists with the input UUID.
+func (st *State) OfferExists(ctx context.Context, oUUID string) (bool, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return false, errors.Capture(err)
+	}
+
+	offerUUID := entityUUID{UUID: oUUID}
+	existsStmt, err := st.Prepare(`
+SELECT &entityUUID.uuid
+FROM   offer
+WHERE  uuid = $entityUUID.uuid`, offerUUID)
+	if err != nil {
+		return false, errors.Errorf("preparing offer exists query: %w", err)
+	}
+
+	var offerExists bool
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err = tx.Query(ctx, existsStmt, offerUUID).Get(&offerUUID)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		} else if err != nil {
+			return errors.Errorf("running offer exists query: %w", err)
+		}
+
+		offerExists = true
+		return nil
+	})
+
+	return offerExists, errors.Capture(err)
+}
+
+// GetOfferConnectionRelationUUIDs returns the UUIDs of the relations
+// established through connections to the offer with the input UUID.
+func (st *State) GetOfferConnectionRelationUUIDs(ctx context.Context, oUUID string) ([]string, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	offerUUID := entityUUID{UUID: oUUID}
+	stmt, err := st.Prepare(`
+SELECT remote_relation_uuid AS &entityUUID.uuid
+FROM   offer_connection
+WHERE  offer_uuid = $entityUUID.uuid`, offerUUID)
+	if err != nil {
+		return nil, errors.Errorf("preparing offer connections query: %w", err)
+	}
+
+	var relationUUIDs []entityUUID
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, offerUUID).GetAll(&relationUUIDs)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return nil, errors.Errorf("running offer connections query: %w", err)
+	}
+
+	return transform.Slice(relationUUIDs, func(e entityUUID) string { return e.UUID }), nil
+}
+
+// OfferScheduleRemoval schedules a removal job for the offer with the input
+// UUID, qualified with the input force boolean.
+func (st *State) OfferScheduleRemoval(
+	ctx context.Context, removalUUID, offerUUID string, force bool, when time.Time,
+) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	removalRec := removalJob{
+		UUID:          removalUUID,
+		RemovalTypeID: 12,
+		EntityUUID:    offerUUID,
+		Force:         force,
+		ScheduledFor:  when,
+	}
+
+	stmt, err := st.Prepare("INSERT INTO removal (*) VALUES ($removalJob.*)", removalRec)
+	if err != nil {
+		return errors.Errorf("preparing offer removal: %w", err)
+	}
+
+	return errors.Capture(db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err = tx.Query(ctx, stmt, removalRec).Run()
+		if err != nil {
+			return errors.Errorf("scheduling offer removal: %w", err)
+		}
+		return nil
+	}))
+}
+
+// DeleteOffer removes an offer from the database completely, along with any
+// connections made to it and the remote consumers of those connections.
+// The relations of the connections are not removed, they are expected to go
+// through their own removal.
+func (st *State) DeleteOffer(ctx context.Context, oUUID string) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	offerUUID := entityUUID{UUID: oUUID}
+
+	var stmts []*sqlair.Statement
+	for _, q := range []string{
+		`DELETE FROM application_remote_consumer WHERE offer_connection_uuid IN (
+    SELECT uuid FROM offer_connection WHERE offer_uuid = $entityUUID.uuid
+)`,
+		"DELETE FROM offer_connection WHERE offer_uuid = $entityUUID.uuid",
+		"DELETE FROM offer_endpoint WHERE offer_uuid = $entityUUID.uuid",
+		"DELETE FROM offer WHERE uuid = $entityUUID.uuid",
+	} {
+		stmt, err := st.Prepare(q, offerUUID)
+		if err != nil {
+			return errors.Errorf("preparing offer deletion: %w", err)
+		}
+		stmts = append(stmts, stmt)
+	}
+
+	return errors.Capture(db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		for _, stmt := range stmts {
+			if err := tx.Query(ctx, stmt, offerUUID).Run(); err != nil {
+				return errors.Errorf("running offer deletion: %w", err)
+			}
+		}
+		return nil
+	}))
+}
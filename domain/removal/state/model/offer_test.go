@@ -0,0 +1,144 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	schematesting "github.com/juju/juju/domain/schema/testing"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+type offerSuite struct {
+	schematesting.ModelSuite
+}
+
+func TestOfferSuite(t *testing.T) {
+	tc.Run(t, &offerSuite{})
+}
+
+func (s *offerSuite) TestOfferExists(c *tc.C) {
+	_, err := s.DB().Exec("INSERT INTO offer (uuid, name) VALUES (?, ?)", "some-offer-uuid", "some-offer")
+	c.Assert(err, tc.ErrorIsNil)
+
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	exists, err := st.OfferExists(c.Context(), "some-offer-uuid")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(exists, tc.Equals, true)
+
+	exists, err = st.OfferExists(c.Context(), "not-today-henry")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(exists, tc.Equals, false)
+}
+
+func (s *offerSuite) TestGetOfferConnectionRelationUUIDs(c *tc.C) {
+	s.addOfferWithConnection(c)
+
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	relUUIDs, err := st.GetOfferConnectionRelationUUIDs(c.Context(), "some-offer-uuid")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(relUUIDs, tc.DeepEquals, []string{"some-relation-uuid"})
+
+	relUUIDs, err = st.GetOfferConnectionRelationUUIDs(c.Context(), "not-today-henry")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(relUUIDs, tc.HasLen, 0)
+}
+
+func (s *offerSuite) TestOfferScheduleRemoval(c *tc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	when := time.Now().UTC()
+	err := st.OfferScheduleRemoval(c.Context(), "removal-uuid", "some-offer-uuid", true, when)
+	c.Assert(err, tc.ErrorIsNil)
+
+	row := s.DB().QueryRow(`
+SELECT t.name, r.entity_uuid, r.force, r.scheduled_for
+FROM   removal r JOIN removal_type t ON r.removal_type_id = t.id
+where  r.uuid = ?`, "removal-uuid",
+	)
+	var (
+		removalType  string
+		oUUID        string
+		force        bool
+		scheduledFor time.Time
+	)
+	err = row.Scan(&removalType, &oUUID, &force, &scheduledFor)
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Check(removalType, tc.Equals, "offer")
+	c.Check(oUUID, tc.Equals, "some-offer-uuid")
+	c.Check(force, tc.Equals, true)
+	c.Check(scheduledFor, tc.Equals, when)
+}
+
+func (s *offerSuite) TestDeleteOffer(c *tc.C) {
+	s.addOfferWithConnection(c)
+
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	err := st.DeleteOffer(c.Context(), "some-offer-uuid")
+	c.Assert(err, tc.ErrorIsNil)
+
+	for _, table := range []string{"offer", "offer_connection", "application_remote_consumer"} {
+		var count int
+		row := s.DB().QueryRow("SELECT COUNT(*) FROM " + table)
+		c.Assert(row.Scan(&count), tc.ErrorIsNil)
+		c.Check(count, tc.Equals, 0, tc.Commentf("table %s", table))
+	}
+
+	// The relation is not removed with the offer.
+	var count int
+	row := s.DB().QueryRow("SELECT COUNT(*) FROM relation")
+	c.Assert(row.Scan(&count), tc.ErrorIsNil)
+	c.Check(count, tc.Equals, 1)
+}
+
+func (s *offerSuite) TestDeleteRelationRemovesOfferConnection(c *tc.C) {
+	s.addOfferWithConnection(c)
+
+	_, err := s.DB().Exec("INSERT INTO relation_network_ingress (relation_uuid, cidr) VALUES (?, ?)",
+		"some-relation-uuid", "10.0.0.0/24")
+	c.Assert(err, tc.ErrorIsNil)
+
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	err = st.DeleteRelation(c.Context(), "some-relation-uuid")
+	c.Assert(err, tc.ErrorIsNil)
+
+	for _, table := range []string{"relation", "offer_connection", "application_remote_consumer", "relation_network_ingress"} {
+		var count int
+		row := s.DB().QueryRow("SELECT COUNT(*) FROM " + table)
+		c.Assert(row.Scan(&count), tc.ErrorIsNil)
+		c.Check(count, tc.Equals, 0, tc.Commentf("table %s", table))
+	}
+
+	// The offer itself is not removed with the relation.
+	exists, err := st.OfferExists(c.Context(), "some-offer-uuid")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(exists, tc.Equals, true)
+}
+
+func (s *offerSuite) addOfferWithConnection(c *tc.C) {
+	_, err := s.DB().Exec("INSERT INTO offer (uuid, name) VALUES (?, ?)", "some-offer-uuid", "some-offer")
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = s.DB().Exec("INSERT INTO relation (uuid, life_id, relation_id, scope_id) VALUES (?, ?, ?, ?)",
+		"some-relation-uuid", 0, 1, 0)
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = s.DB().Exec(`
+INSERT INTO offer_connection (uuid, offer_uuid, remote_relation_uuid, username)
+VALUES (?, ?, ?, ?)`, "some-connection-uuid", "some-offer-uuid", "some-relation-uuid", "fred")
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = s.DB().Exec(`
+INSERT INTO application_remote_consumer (uuid, life_id, offer_connection_uuid, version)
+VALUES (?, ?, ?, ?)`, "some-consumer-uuid", 0, "some-connection-uuid", 0)
+	c.Assert(err, tc.ErrorIsNil)
+}
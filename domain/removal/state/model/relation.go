@@ -282,6 +282,25 @@ WHERE  relation_endpoint_uuid IN (
 		return errors.Errorf("preparing relation app settings deletion: %w", err)
 	}
 
+	// Cross model relations have references to the relation that are not
+	// removed through any other means.
+	var cmrStmts []*sqlair.Statement
+	for _, q := range []string{
+		`DELETE FROM application_remote_consumer WHERE offer_connection_uuid IN (
+    SELECT uuid FROM offer_connection WHERE remote_relation_uuid = $entityUUID.uuid
+)`,
+		"DELETE FROM offer_connection WHERE remote_relation_uuid = $entityUUID.uuid",
+		"DELETE FROM application_remote_relation WHERE relation_uuid = $entityUUID.uuid",
+		"DELETE FROM relation_network_ingress WHERE relation_uuid = $entityUUID.uuid",
+		"DELETE FROM relation_network_egress WHERE relation_uuid = $entityUUID.uuid",
+	} {
+		stmt, err := st.Prepare(q, relationUUID)
+		if err != nil {
+			return errors.Errorf("preparing cross model relation deletion: %w", err)
+		}
+		cmrStmts = append(cmrStmts, stmt)
+	}
+
 	endpointStmt, err := st.Prepare("DELETE FROM relation_endpoint WHERE relation_uuid = $entityUUID.uuid", relationUUID)
 	if err != nil {
 		return errors.Errorf("preparing relation endpoint deletion: %w", err)
@@ -308,6 +327,12 @@ WHERE  relation_endpoint_uuid IN (
 			return errors.Errorf("running relation app settings hash deletion: %w", err)
 		}
 
+		for _, stmt := range cmrStmts {
+			if err := tx.Query(ctx, stmt, relationUUID).Run(); err != nil {
+				return errors.Errorf("running cross model relation deletion: %w", err)
+			}
+		}
+
 		err = tx.Query(ctx, endpointStmt, relationUUID).Run()
 		if err != nil {
 			if database.IsErrConstraintForeignKey(err) {
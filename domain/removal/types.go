@@ -27,6 +27,10 @@ const (
 	// StorageAttachmentJob indicates a job to remove a unit's
 	// attachment to a storage instance.
 	StorageAttachmentJob
+
+	// OfferJob indicates a job to remove an offer. The preceding
+	// identifiers are reserved for the removal of storage entities.
+	OfferJob JobType = 12
 )
 
 // String is used in logging output make job type identifiers readable.
@@ -47,6 +51,8 @@ func (t JobType) String() string {
 		return "storage instance"
 	case StorageAttachmentJob:
 		return "storage attachment"
+	case OfferJob:
+		return "offer"
 	default:
 		return strconv.FormatInt(int64(t), 10)
 	}
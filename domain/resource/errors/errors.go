@@ -82,4 +82,9 @@ const (
 
 	// OriginNotValid describes an error where the resource origin is invalid
 	OriginNotValid = errors.ConstError("origin not valid")
+
+	// StoredBlobNotPrunable describes an error where a blob held in the
+	// object store can no longer be pruned, because it has been referred to,
+	// stored again or removed since it was found to be prunable.
+	StoredBlobNotPrunable = errors.ConstError("stored blob not prunable")
 )
@@ -12,6 +12,7 @@ package service
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	application "github.com/juju/juju/core/application"
 	resource "github.com/juju/juju/core/resource"
@@ -160,6 +161,44 @@ func (c *MockStateDeleteImportedResourcesCall) DoAndReturn(f func(context.Contex
 	return c
 }
 
+// DeletePrunableBlob mocks base method.
+func (m *MockState) DeletePrunableBlob(arg0 context.Context, arg1 resource0.StoredBlob, arg2 time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePrunableBlob", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePrunableBlob indicates an expected call of DeletePrunableBlob.
+func (mr *MockStateMockRecorder) DeletePrunableBlob(arg0, arg1, arg2 any) *MockStateDeletePrunableBlobCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePrunableBlob", reflect.TypeOf((*MockState)(nil).DeletePrunableBlob), arg0, arg1, arg2)
+	return &MockStateDeletePrunableBlobCall{Call: call}
+}
+
+// MockStateDeletePrunableBlobCall wrap *gomock.Call
+type MockStateDeletePrunableBlobCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateDeletePrunableBlobCall) Return(arg0 error) *MockStateDeletePrunableBlobCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateDeletePrunableBlobCall) Do(f func(context.Context, resource0.StoredBlob, time.Duration) error) *MockStateDeletePrunableBlobCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateDeletePrunableBlobCall) DoAndReturn(f func(context.Context, resource0.StoredBlob, time.Duration) error) *MockStateDeletePrunableBlobCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // DeleteResourcesAddedBeforeApplication mocks base method.
 func (m *MockState) DeleteResourcesAddedBeforeApplication(arg0 context.Context, arg1 []resource.UUID) error {
 	m.ctrl.T.Helper()
@@ -314,6 +353,45 @@ func (c *MockStateGetApplicationResourceIDCall) DoAndReturn(f func(context.Conte
 	return c
 }
 
+// GetPrunableBlobs mocks base method.
+func (m *MockState) GetPrunableBlobs(arg0 context.Context, arg1 time.Duration) ([]resource0.StoredBlob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPrunableBlobs", arg0, arg1)
+	ret0, _ := ret[0].([]resource0.StoredBlob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPrunableBlobs indicates an expected call of GetPrunableBlobs.
+func (mr *MockStateMockRecorder) GetPrunableBlobs(arg0, arg1 any) *MockStateGetPrunableBlobsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrunableBlobs", reflect.TypeOf((*MockState)(nil).GetPrunableBlobs), arg0, arg1)
+	return &MockStateGetPrunableBlobsCall{Call: call}
+}
+
+// MockStateGetPrunableBlobsCall wrap *gomock.Call
+type MockStateGetPrunableBlobsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetPrunableBlobsCall) Return(arg0 []resource0.StoredBlob, arg1 error) *MockStateGetPrunableBlobsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetPrunableBlobsCall) Do(f func(context.Context, time.Duration) ([]resource0.StoredBlob, error)) *MockStateGetPrunableBlobsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetPrunableBlobsCall) DoAndReturn(f func(context.Context, time.Duration) ([]resource0.StoredBlob, error)) *MockStateGetPrunableBlobsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetResource mocks base method.
 func (m *MockState) GetResource(arg0 context.Context, arg1 resource.UUID) (resource.Resource, error) {
 	m.ctrl.T.Helper()
@@ -470,6 +548,45 @@ func (c *MockStateGetResourcesByApplicationIDCall) DoAndReturn(f func(context.Co
 	return c
 }
 
+// GetStoredBlobs mocks base method.
+func (m *MockState) GetStoredBlobs(arg0 context.Context) ([]resource0.StoredBlob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStoredBlobs", arg0)
+	ret0, _ := ret[0].([]resource0.StoredBlob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStoredBlobs indicates an expected call of GetStoredBlobs.
+func (mr *MockStateMockRecorder) GetStoredBlobs(arg0 any) *MockStateGetStoredBlobsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStoredBlobs", reflect.TypeOf((*MockState)(nil).GetStoredBlobs), arg0)
+	return &MockStateGetStoredBlobsCall{Call: call}
+}
+
+// MockStateGetStoredBlobsCall wrap *gomock.Call
+type MockStateGetStoredBlobsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetStoredBlobsCall) Return(arg0 []resource0.StoredBlob, arg1 error) *MockStateGetStoredBlobsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetStoredBlobsCall) Do(f func(context.Context) ([]resource0.StoredBlob, error)) *MockStateGetStoredBlobsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetStoredBlobsCall) DoAndReturn(f func(context.Context) ([]resource0.StoredBlob, error)) *MockStateGetStoredBlobsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ImportResources mocks base method.
 func (m *MockState) ImportResources(arg0 context.Context, arg1 resource0.ImportResourcesArgs) error {
 	m.ctrl.T.Helper()
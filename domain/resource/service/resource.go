@@ -7,6 +7,7 @@ import (
 	"context"
 	"io"
 	"regexp"
+	"time"
 
 	coreapplication "github.com/juju/juju/core/application"
 	"github.com/juju/juju/core/logger"
@@ -156,6 +157,25 @@ type State interface {
 	//   - [resourceerrors.ApplicationNotFound] is returned if the application is
 	//     not found.
 	DeleteImportedResources(ctx context.Context, appNames []string) error
+
+	// GetStoredBlobs returns the blobs held in the model's object store on
+	// behalf of charms and resources, along with the charm and resource
+	// revisions referring to them.
+	GetStoredBlobs(ctx context.Context) ([]resource.StoredBlob, error)
+
+	// GetPrunableBlobs returns the blobs held in the model's object store
+	// which are not referred to by any charm or resource, and which were
+	// stored longer ago than the grace period.
+	GetPrunableBlobs(ctx context.Context, gracePeriod time.Duration) ([]resource.StoredBlob, error)
+
+	// DeletePrunableBlob removes the object store metadata of the given blob,
+	// if it's still not referred to, was stored longer ago than the grace
+	// period, and is held at the same paths.
+	//
+	// The following error types can be expected to be returned:
+	//   - [resourceerrors.StoredBlobNotPrunable] if the blob can no longer be
+	//     pruned.
+	DeletePrunableBlob(ctx context.Context, blob resource.StoredBlob, gracePeriod time.Duration) error
 }
 
 type ResourceStoreGetter interface {
@@ -0,0 +1,60 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	"github.com/juju/juju/domain/resource"
+	resourceerrors "github.com/juju/juju/domain/resource/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// GetStoredBlobs returns the blobs held in the model's object store on behalf
+// of charms and resources, along with the charm and resource revisions
+// referring to them, ordered by when they were stored.
+func (s *Service) GetStoredBlobs(ctx context.Context) ([]resource.StoredBlob, error) {
+	blobs, err := s.st.GetStoredBlobs(ctx)
+	if err != nil {
+		return nil, errors.Errorf("getting stored blobs: %w", err)
+	}
+	return blobs, nil
+}
+
+// PruneStorage removes the blobs from the model's object store which are not
+// referred to by any charm or resource, and which were stored longer ago than
+// the grace period. The removed blobs are returned. If DryRun is set, the
+// blobs which would be removed are returned and nothing is removed.
+//
+// Each blob's metadata is removed only once it has been checked again to be
+// prunable, and the object store then removes the objects no longer described
+// by any metadata. Blobs which have been referred to or stored again since
+// they were listed are kept, and not returned.
+func (s *Service) PruneStorage(ctx context.Context, args resource.PruneStorageArgs) ([]resource.StoredBlob, error) {
+	if args.GracePeriod < 0 {
+		return nil, errors.Errorf("grace period %v cannot be negative", args.GracePeriod)
+	}
+
+	blobs, err := s.st.GetPrunableBlobs(ctx, args.GracePeriod)
+	if err != nil {
+		return nil, errors.Errorf("getting prunable blobs: %w", err)
+	}
+	if args.DryRun {
+		return blobs, nil
+	}
+
+	var pruned []resource.StoredBlob
+	for _, blob := range blobs {
+		err := s.st.DeletePrunableBlob(ctx, blob, args.GracePeriod)
+		if errors.Is(err, resourceerrors.StoredBlobNotPrunable) {
+			s.logger.Debugf(ctx, "not pruning blob %s: %v", blob.SHA384, err)
+			continue
+		} else if err != nil {
+			return pruned, errors.Errorf("removing blob %s: %w", blob.SHA384, err)
+		}
+		s.logger.Infof(ctx, "pruned unreferenced blob %s (%d bytes)", blob.SHA384, blob.Size)
+		pruned = append(pruned, blob)
+	}
+	return pruned, nil
+}
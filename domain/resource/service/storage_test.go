@@ -0,0 +1,128 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"time"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/domain/resource"
+	resourceerrors "github.com/juju/juju/domain/resource/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+func (s *resourceServiceSuite) TestGetStoredBlobs(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	blobs := []resource.StoredBlob{{
+		UUID:  "blob-uuid",
+		Paths: []string{"path"},
+		Size:  42,
+		References: []resource.BlobReference{{
+			Kind:  resource.CharmBlobReference,
+			Name:  "mysql",
+			InUse: true,
+		}},
+	}}
+	s.state.EXPECT().GetStoredBlobs(gomock.Any()).Return(blobs, nil)
+
+	obtained, err := s.service.GetStoredBlobs(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(obtained, tc.DeepEquals, blobs)
+}
+
+func (s *resourceServiceSuite) TestPruneStorage(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	blobs := []resource.StoredBlob{{
+		UUID:  "blob-1",
+		Paths: []string{"path-1", "path-2"},
+	}, {
+		UUID:  "blob-2",
+		Paths: []string{"path-3"},
+	}}
+	s.state.EXPECT().GetPrunableBlobs(gomock.Any(), time.Hour).Return(blobs, nil)
+	s.state.EXPECT().DeletePrunableBlob(gomock.Any(), blobs[0], time.Hour).Return(nil)
+	s.state.EXPECT().DeletePrunableBlob(gomock.Any(), blobs[1], time.Hour).Return(nil)
+
+	pruned, err := s.service.PruneStorage(c.Context(), resource.PruneStorageArgs{
+		GracePeriod: time.Hour,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(pruned, tc.DeepEquals, blobs)
+}
+
+func (s *resourceServiceSuite) TestPruneStorageDryRun(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	blobs := []resource.StoredBlob{{
+		UUID:  "blob-1",
+		Paths: []string{"path-1"},
+	}}
+	s.state.EXPECT().GetPrunableBlobs(gomock.Any(), time.Hour).Return(blobs, nil)
+
+	pruned, err := s.service.PruneStorage(c.Context(), resource.PruneStorageArgs{
+		GracePeriod: time.Hour,
+		DryRun:      true,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(pruned, tc.DeepEquals, blobs)
+}
+
+// TestPruneStorageNotPrunable verifies that blobs which have been referred
+// to or stored again since they were listed are kept.
+func (s *resourceServiceSuite) TestPruneStorageNotPrunable(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	blobs := []resource.StoredBlob{{
+		UUID:  "blob-1",
+		Paths: []string{"path-1"},
+	}, {
+		UUID:  "blob-2",
+		Paths: []string{"path-2"},
+	}}
+	s.state.EXPECT().GetPrunableBlobs(gomock.Any(), time.Hour).Return(blobs, nil)
+	s.state.EXPECT().DeletePrunableBlob(gomock.Any(), blobs[0], time.Hour).Return(resourceerrors.StoredBlobNotPrunable)
+	s.state.EXPECT().DeletePrunableBlob(gomock.Any(), blobs[1], time.Hour).Return(nil)
+
+	pruned, err := s.service.PruneStorage(c.Context(), resource.PruneStorageArgs{
+		GracePeriod: time.Hour,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(pruned, tc.DeepEquals, blobs[1:])
+}
+
+func (s *resourceServiceSuite) TestPruneStorageDeleteError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	blobs := []resource.StoredBlob{{
+		UUID:   "blob-1",
+		SHA384: "hash-1",
+		Paths:  []string{"path-1"},
+	}, {
+		UUID:   "blob-2",
+		SHA384: "hash-2",
+		Paths:  []string{"path-2"},
+	}}
+	s.state.EXPECT().GetPrunableBlobs(gomock.Any(), time.Hour).Return(blobs, nil)
+	s.state.EXPECT().DeletePrunableBlob(gomock.Any(), blobs[0], time.Hour).Return(nil)
+	s.state.EXPECT().DeletePrunableBlob(gomock.Any(), blobs[1], time.Hour).Return(errors.New("boom"))
+
+	pruned, err := s.service.PruneStorage(c.Context(), resource.PruneStorageArgs{
+		GracePeriod: time.Hour,
+	})
+	c.Assert(err, tc.ErrorMatches, `removing blob hash-2: boom`)
+	c.Check(pruned, tc.DeepEquals, blobs[:1])
+}
+
+func (s *resourceServiceSuite) TestPruneStorageNegativeGracePeriod(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.service.PruneStorage(c.Context(), resource.PruneStorageArgs{
+		GracePeriod: -time.Hour,
+	})
+	c.Assert(err, tc.ErrorMatches, `grace period -1h0m0s cannot be negative`)
+}
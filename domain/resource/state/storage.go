@@ -0,0 +1,285 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/canonical/sqlair"
+
+	"github.com/juju/juju/domain/resource"
+	resourceerrors "github.com/juju/juju/domain/resource/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// GetStoredBlobs returns the blobs held in the model's object store on behalf
+// of charms and resources, along with the charm and resource revisions
+// referring to them. Blobs with no references are included, while blobs
+// belonging to agent binaries and operation task outputs are not.
+func (st *State) GetStoredBlobs(ctx context.Context) ([]resource.StoredBlob, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	blobStmt, err := st.Prepare(`
+SELECT &storedBlob.*
+FROM   object_store_metadata AS osm
+WHERE  NOT EXISTS (
+    SELECT 1 FROM agent_binary_store WHERE object_store_uuid = osm.uuid
+)
+AND    NOT EXISTS (
+    SELECT 1 FROM operation_task_output WHERE store_uuid = osm.uuid
+)`, storedBlob{})
+	if err != nil {
+		return nil, errors.Errorf("preparing select blobs statement: %w", err)
+	}
+
+	pathStmt, err := st.Prepare(`
+SELECT &storedBlobPath.*
+FROM   object_store_metadata_path`, storedBlobPath{})
+	if err != nil {
+		return nil, errors.Errorf("preparing select blob paths statement: %w", err)
+	}
+
+	charmStmt, err := st.Prepare(`
+SELECT c.object_store_uuid AS &blobReference.store_uuid,
+       c.reference_name AS &blobReference.name,
+       c.revision AS &blobReference.revision,
+       CASE
+           WHEN EXISTS (SELECT 1 FROM application WHERE charm_uuid = c.uuid) THEN TRUE
+           WHEN EXISTS (SELECT 1 FROM unit WHERE charm_uuid = c.uuid) THEN TRUE
+           ELSE FALSE
+       END AS &blobReference.in_use
+FROM   charm AS c
+WHERE  c.object_store_uuid IS NOT NULL`, blobReference{})
+	if err != nil {
+		return nil, errors.Errorf("preparing select charm blobs statement: %w", err)
+	}
+
+	resourceStmt, err := st.Prepare(`
+SELECT rfs.store_uuid AS &blobReference.store_uuid,
+       r.charm_resource_name AS &blobReference.name,
+       COALESCE(r.revision, -1) AS &blobReference.revision,
+       COALESCE(
+           a.name,
+           par.application_name,
+           (
+               SELECT ua.name
+               FROM   unit_resource AS ur
+               JOIN   unit AS u ON ur.unit_uuid = u.uuid
+               JOIN   application AS ua ON u.application_uuid = ua.uuid
+               WHERE  ur.resource_uuid = r.uuid
+               LIMIT 1
+           ),
+           ''
+       ) AS &blobReference.application_name,
+       CASE
+           WHEN ar.resource_uuid IS NOT NULL THEN TRUE
+           WHEN par.resource_uuid IS NOT NULL THEN TRUE
+           WHEN EXISTS (SELECT 1 FROM unit_resource WHERE resource_uuid = r.uuid) THEN TRUE
+           ELSE FALSE
+       END AS &blobReference.in_use
+FROM      resource_file_store AS rfs
+JOIN      resource AS r ON rfs.resource_uuid = r.uuid
+LEFT JOIN application_resource AS ar ON r.uuid = ar.resource_uuid
+LEFT JOIN application AS a ON ar.application_uuid = a.uuid
+LEFT JOIN pending_application_resource AS par ON r.uuid = par.resource_uuid`, blobReference{})
+	if err != nil {
+		return nil, errors.Errorf("preparing select resource blobs statement: %w", err)
+	}
+
+	var (
+		blobs              []storedBlob
+		paths              []storedBlobPath
+		charmReferences    []blobReference
+		resourceReferences []blobReference
+	)
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := tx.Query(ctx, blobStmt).GetAll(&blobs); errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		} else if err != nil {
+			return errors.Errorf("getting blobs: %w", err)
+		}
+		if err := tx.Query(ctx, pathStmt).GetAll(&paths); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("getting blob paths: %w", err)
+		}
+		if err := tx.Query(ctx, charmStmt).GetAll(&charmReferences); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("getting charm blobs: %w", err)
+		}
+		if err := tx.Query(ctx, resourceStmt).GetAll(&resourceReferences); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("getting resource blobs: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	result := make([]resource.StoredBlob, len(blobs))
+	byUUID := make(map[string]*resource.StoredBlob, len(blobs))
+	for i, b := range blobs {
+		result[i] = resource.StoredBlob{
+			UUID:      b.UUID,
+			SHA384:    b.SHA384,
+			Size:      b.Size,
+			CreatedAt: b.CreatedAt,
+		}
+		byUUID[b.UUID] = &result[i]
+	}
+	for _, p := range paths {
+		if b, ok := byUUID[p.MetadataUUID]; ok {
+			b.Paths = append(b.Paths, p.Path)
+		}
+	}
+	addReferences := func(kind resource.BlobReferenceKind, refs []blobReference) {
+		for _, ref := range refs {
+			b, ok := byUUID[ref.StoreUUID]
+			if !ok {
+				continue
+			}
+			b.References = append(b.References, resource.BlobReference{
+				Kind:            kind,
+				Name:            ref.Name,
+				ApplicationName: ref.ApplicationName,
+				Revision:        ref.Revision,
+				InUse:           ref.InUse,
+			})
+		}
+	}
+	addReferences(resource.CharmBlobReference, charmReferences)
+	addReferences(resource.ResourceBlobReference, resourceReferences)
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].CreatedAt.Before(result[j].CreatedAt)
+	})
+	return result, nil
+}
+
+// GetPrunableBlobs returns the blobs held in the model's object store which
+// are not referred to by any charm or resource, and which were stored longer
+// ago than the grace period.
+func (st *State) GetPrunableBlobs(ctx context.Context, gracePeriod time.Duration) ([]resource.StoredBlob, error) {
+	blobs, err := st.GetStoredBlobs(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	cutoff := st.clock.Now().Add(-gracePeriod)
+	var result []resource.StoredBlob
+	for _, b := range blobs {
+		if b.Usage() == resource.BlobUnreferenced && b.CreatedAt.Before(cutoff) {
+			result = append(result, b)
+		}
+	}
+	return result, nil
+}
+
+// DeletePrunableBlob removes the object store metadata of the given blob, if
+// it's still not referred to by any charm, resource, agent binary or
+// operation task output, was stored longer ago than the grace period, and is
+// held at the same paths. Checking and removing happen in the same
+// transaction, so that a blob referred to or stored again since it was found
+// to be prunable is kept. The object itself is removed by the object store
+// once no metadata describes it.
+//
+// The following error types can be expected to be returned:
+//   - [resourceerrors.StoredBlobNotPrunable] if the blob can no longer be
+//     pruned.
+func (st *State) DeletePrunableBlob(ctx context.Context, blob resource.StoredBlob, gracePeriod time.Duration) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	ident := storedBlob{UUID: blob.UUID}
+	blobStmt, err := st.Prepare(`
+SELECT &storedBlob.*
+FROM   object_store_metadata AS osm
+WHERE  uuid = $storedBlob.uuid
+AND    NOT EXISTS (
+    SELECT 1 FROM agent_binary_store WHERE object_store_uuid = osm.uuid
+)
+AND    NOT EXISTS (
+    SELECT 1 FROM operation_task_output WHERE store_uuid = osm.uuid
+)
+AND    NOT EXISTS (
+    SELECT 1 FROM charm WHERE object_store_uuid = osm.uuid
+)
+AND    NOT EXISTS (
+    SELECT 1 FROM resource_file_store WHERE store_uuid = osm.uuid
+)`, ident)
+	if err != nil {
+		return errors.Errorf("preparing select blob statement: %w", err)
+	}
+
+	pathStmt, err := st.Prepare(`
+SELECT &storedBlobPath.*
+FROM   object_store_metadata_path
+WHERE  metadata_uuid = $storedBlob.uuid`, storedBlobPath{}, ident)
+	if err != nil {
+		return errors.Errorf("preparing select blob paths statement: %w", err)
+	}
+
+	deletePathsStmt, err := st.Prepare(`
+DELETE FROM object_store_metadata_path
+WHERE  metadata_uuid = $storedBlob.uuid`, ident)
+	if err != nil {
+		return errors.Errorf("preparing delete blob paths statement: %w", err)
+	}
+
+	deleteBlobStmt, err := st.Prepare(`
+DELETE FROM object_store_metadata
+WHERE  uuid = $storedBlob.uuid`, ident)
+	if err != nil {
+		return errors.Errorf("preparing delete blob statement: %w", err)
+	}
+
+	cutoff := st.clock.Now().Add(-gracePeriod)
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var current storedBlob
+		if err := tx.Query(ctx, blobStmt, ident).Get(&current); errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("blob %q is referred to or removed", blob.UUID).Add(resourceerrors.StoredBlobNotPrunable)
+		} else if err != nil {
+			return errors.Errorf("getting blob: %w", err)
+		}
+		if !current.CreatedAt.Before(cutoff) {
+			return errors.Errorf("blob %q is within the grace period", blob.UUID).Add(resourceerrors.StoredBlobNotPrunable)
+		}
+
+		var paths []storedBlobPath
+		if err := tx.Query(ctx, pathStmt, ident).GetAll(&paths); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("getting blob paths: %w", err)
+		}
+		if !samePaths(paths, blob.Paths) {
+			return errors.Errorf("blob %q has been stored again", blob.UUID).Add(resourceerrors.StoredBlobNotPrunable)
+		}
+
+		if err := tx.Query(ctx, deletePathsStmt, ident).Run(); err != nil {
+			return errors.Errorf("deleting blob paths: %w", err)
+		}
+		if err := tx.Query(ctx, deleteBlobStmt, ident).Run(); err != nil {
+			return errors.Errorf("deleting blob: %w", err)
+		}
+		return nil
+	})
+}
+
+// samePaths returns true if the stored paths are exactly the expected ones.
+func samePaths(stored []storedBlobPath, expected []string) bool {
+	if len(stored) != len(expected) {
+		return false
+	}
+	want := make(map[string]bool, len(expected))
+	for _, path := range expected {
+		want[path] = true
+	}
+	for _, p := range stored {
+		if !want[p.Path] {
+			return false
+		}
+	}
+	return true
+}
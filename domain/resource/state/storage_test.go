@@ -0,0 +1,230 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/domain/resource"
+	resourceerrors "github.com/juju/juju/domain/resource/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// addStoredBlob adds a blob to the object store metadata. If createdAt is
+// zero the blob is given the current time by the database.
+func (s *resourceSuite) addStoredBlob(c *tc.C, uuid, path string, createdAt time.Time) {
+	err := s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		// Use the uuid as the hash to avoid uniqueness issues while testing.
+		var err error
+		if createdAt.IsZero() {
+			_, err = tx.ExecContext(ctx, `
+INSERT INTO object_store_metadata (uuid, sha_256, sha_384, size)
+VALUES (?, ?, ?, 42)`, uuid, uuid, uuid)
+		} else {
+			_, err = tx.ExecContext(ctx, `
+INSERT INTO object_store_metadata (uuid, sha_256, sha_384, size, created_at)
+VALUES (?, ?, ?, 42, ?)`, uuid, uuid, uuid, createdAt)
+		}
+		if err != nil {
+			return errors.Capture(err)
+		}
+		_, err = tx.ExecContext(ctx, `
+INSERT INTO object_store_metadata_path (path, metadata_uuid)
+VALUES (?, ?)`, path, uuid)
+		return errors.Capture(err)
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *resourceSuite) setUpStoredBlobs(c *tc.C) time.Time {
+	old := time.Now().UTC().Add(-48 * time.Hour).Truncate(time.Second)
+	s.addStoredBlob(c, "charm-blob", "charm-path", old)
+	s.addStoredBlob(c, "resource-blob", "resource-path", old)
+	s.addStoredBlob(c, "old-resource-blob", "old-resource-path", old)
+	s.addStoredBlob(c, "orphan-blob", "orphan-path", old)
+	s.addStoredBlob(c, "new-orphan-blob", "new-orphan-path", time.Time{})
+	s.addStoredBlob(c, "agent-blob", "agent-path", old)
+
+	current := resourceData{
+		UUID:            "current-res-uuid",
+		ApplicationUUID: s.constants.fakeApplicationUUID1,
+		Name:            "res1",
+		Revision:        2,
+	}
+	previous := resourceData{
+		UUID:     "previous-res-uuid",
+		Name:     "res1",
+		Revision: 1,
+	}
+	err := s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		for _, res := range []resourceData{current, previous} {
+			if err := res.insert(ctx, tx); err != nil {
+				return errors.Capture(err)
+			}
+		}
+		for _, stmt := range []struct {
+			query string
+			args  []any
+		}{{
+			query: `UPDATE charm SET object_store_uuid = 'charm-blob', revision = 3 WHERE uuid = ?`,
+			args:  []any{fakeCharmUUID},
+		}, {
+			query: `INSERT INTO resource_file_store (resource_uuid, store_uuid) VALUES (?, 'resource-blob')`,
+			args:  []any{current.UUID},
+		}, {
+			query: `INSERT INTO resource_file_store (resource_uuid, store_uuid) VALUES (?, 'old-resource-blob')`,
+			args:  []any{previous.UUID},
+		}, {
+			query: `INSERT INTO agent_binary_store (version, architecture_id, object_store_uuid) VALUES ('4.0.0', 0, 'agent-blob')`,
+		}} {
+			if _, err := tx.ExecContext(ctx, stmt.query, stmt.args...); err != nil {
+				return errors.Capture(err)
+			}
+		}
+		return nil
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	return old
+}
+
+func (s *resourceSuite) TestGetStoredBlobs(c *tc.C) {
+	old := s.setUpStoredBlobs(c)
+
+	blobs, err := s.state.GetStoredBlobs(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(blobs, tc.HasLen, 5)
+
+	byUUID := make(map[string]resource.StoredBlob)
+	for _, b := range blobs {
+		byUUID[b.UUID] = b
+	}
+	c.Check(byUUID["charm-blob"], tc.DeepEquals, resource.StoredBlob{
+		UUID:      "charm-blob",
+		Paths:     []string{"charm-path"},
+		SHA384:    "charm-blob",
+		Size:      42,
+		CreatedAt: old,
+		References: []resource.BlobReference{{
+			Kind:     resource.CharmBlobReference,
+			Name:     "app",
+			Revision: 3,
+			InUse:    true,
+		}},
+	})
+	c.Check(byUUID["resource-blob"].References, tc.DeepEquals, []resource.BlobReference{{
+		Kind:            resource.ResourceBlobReference,
+		Name:            "res1",
+		ApplicationName: s.constants.fakeApplicationName1,
+		Revision:        2,
+		InUse:           true,
+	}})
+	c.Check(byUUID["resource-blob"].Usage(), tc.Equals, resource.BlobInUse)
+	c.Check(byUUID["old-resource-blob"].References, tc.DeepEquals, []resource.BlobReference{{
+		Kind:     resource.ResourceBlobReference,
+		Name:     "res1",
+		Revision: 1,
+	}})
+	c.Check(byUUID["old-resource-blob"].Usage(), tc.Equals, resource.BlobOldRevision)
+	c.Check(byUUID["orphan-blob"].Usage(), tc.Equals, resource.BlobUnreferenced)
+	c.Check(byUUID["new-orphan-blob"].Usage(), tc.Equals, resource.BlobUnreferenced)
+	c.Check(byUUID["new-orphan-blob"].CreatedAt.After(old), tc.IsTrue)
+	_, ok := byUUID["agent-blob"]
+	c.Check(ok, tc.IsFalse)
+
+	// The blobs are ordered by when they were stored.
+	c.Check(blobs[4].UUID, tc.Equals, "new-orphan-blob")
+}
+
+func (s *resourceSuite) TestGetStoredBlobsEmpty(c *tc.C) {
+	blobs, err := s.state.GetStoredBlobs(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(blobs, tc.HasLen, 0)
+}
+
+func (s *resourceSuite) TestGetPrunableBlobs(c *tc.C) {
+	s.setUpStoredBlobs(c)
+
+	blobs, err := s.state.GetPrunableBlobs(c.Context(), time.Hour)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(blobs, tc.HasLen, 1)
+	c.Check(blobs[0].UUID, tc.Equals, "orphan-blob")
+	c.Check(blobs[0].Paths, tc.DeepEquals, []string{"orphan-path"})
+
+	blobs, err = s.state.GetPrunableBlobs(c.Context(), 72*time.Hour)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(blobs, tc.HasLen, 0)
+}
+
+func (s *resourceSuite) TestDeletePrunableBlob(c *tc.C) {
+	s.setUpStoredBlobs(c)
+
+	blobs, err := s.state.GetPrunableBlobs(c.Context(), time.Hour)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(blobs, tc.HasLen, 1)
+
+	err = s.state.DeletePrunableBlob(c.Context(), blobs[0], time.Hour)
+	c.Assert(err, tc.ErrorIsNil)
+
+	blobs, err = s.state.GetStoredBlobs(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	for _, b := range blobs {
+		c.Check(b.UUID, tc.Not(tc.Equals), "orphan-blob")
+	}
+
+	// Deleting it again fails, as it no longer exists.
+	err = s.state.DeletePrunableBlob(c.Context(), resource.StoredBlob{
+		UUID:  "orphan-blob",
+		Paths: []string{"orphan-path"},
+	}, time.Hour)
+	c.Check(err, tc.ErrorIs, resourceerrors.StoredBlobNotPrunable)
+}
+
+// TestDeletePrunableBlobReferenced verifies that a blob referred to since it
+// was found to be prunable is kept.
+func (s *resourceSuite) TestDeletePrunableBlobReferenced(c *tc.C) {
+	s.setUpStoredBlobs(c)
+
+	blobs, err := s.state.GetPrunableBlobs(c.Context(), time.Hour)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(blobs, tc.HasLen, 1)
+
+	_, err = s.DB().ExecContext(c.Context(),
+		`UPDATE charm SET object_store_uuid = 'orphan-blob' WHERE uuid = ?`, fakeCharmUUID)
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = s.state.DeletePrunableBlob(c.Context(), blobs[0], time.Hour)
+	c.Check(err, tc.ErrorIs, resourceerrors.StoredBlobNotPrunable)
+}
+
+// TestDeletePrunableBlobStoredAgain verifies that a blob stored again at
+// another path since it was found to be prunable is kept.
+func (s *resourceSuite) TestDeletePrunableBlobStoredAgain(c *tc.C) {
+	s.setUpStoredBlobs(c)
+
+	blobs, err := s.state.GetPrunableBlobs(c.Context(), time.Hour)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(blobs, tc.HasLen, 1)
+
+	_, err = s.DB().ExecContext(c.Context(), `
+INSERT INTO object_store_metadata_path (path, metadata_uuid)
+VALUES ('another-path', 'orphan-blob')`)
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = s.state.DeletePrunableBlob(c.Context(), blobs[0], time.Hour)
+	c.Check(err, tc.ErrorIs, resourceerrors.StoredBlobNotPrunable)
+}
+
+func (s *resourceSuite) TestDeletePrunableBlobWithinGracePeriod(c *tc.C) {
+	s.setUpStoredBlobs(c)
+
+	err := s.state.DeletePrunableBlob(c.Context(), resource.StoredBlob{
+		UUID:  "orphan-blob",
+		Paths: []string{"orphan-path"},
+	}, 72*time.Hour)
+	c.Check(err, tc.ErrorIs, resourceerrors.StoredBlobNotPrunable)
+}
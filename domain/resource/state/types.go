@@ -240,3 +240,27 @@ type uuidOriginAndRevision struct {
 	Origin   charmresource.Origin
 	Revision int
 }
+
+// storedBlob represents a blob held in the object store which is not owned by
+// agent binaries or operation task outputs.
+type storedBlob struct {
+	UUID      string    `db:"uuid"`
+	SHA384    string    `db:"sha_384"`
+	Size      int64     `db:"size"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// storedBlobPath represents a path of a blob held in the object store.
+type storedBlobPath struct {
+	MetadataUUID string `db:"metadata_uuid"`
+	Path         string `db:"path"`
+}
+
+// blobReference represents a charm or resource revision held in a blob.
+type blobReference struct {
+	StoreUUID       string `db:"store_uuid"`
+	Name            string `db:"name"`
+	ApplicationName string `db:"application_name"`
+	Revision        int    `db:"revision"`
+	InUse           bool   `db:"in_use"`
+}
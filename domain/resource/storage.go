@@ -0,0 +1,92 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package resource
+
+import (
+	"time"
+)
+
+// BlobUsage describes how a blob held in the model's object store is used.
+type BlobUsage string
+
+const (
+	// BlobInUse indicates that the blob holds a charm or resource revision
+	// which is in use by an application or unit.
+	BlobInUse BlobUsage = "in-use"
+	// BlobOldRevision indicates that the blob only holds charm or resource
+	// revisions which are no longer used by any application or unit.
+	BlobOldRevision BlobUsage = "old-revision"
+	// BlobUnreferenced indicates that nothing refers to the blob. It can be
+	// pruned once it is older than the prune grace period.
+	BlobUnreferenced BlobUsage = "unreferenced"
+)
+
+// BlobReferenceKind is the kind of entity referring to a blob.
+type BlobReferenceKind string
+
+const (
+	// CharmBlobReference is a reference to a blob from a charm.
+	CharmBlobReference BlobReferenceKind = "charm"
+	// ResourceBlobReference is a reference to a blob from a file resource.
+	ResourceBlobReference BlobReferenceKind = "resource"
+)
+
+// BlobReference describes a charm or resource revision held in a blob.
+type BlobReference struct {
+	// Kind is the kind of entity referring to the blob.
+	Kind BlobReferenceKind
+	// Name is the charm name, or the resource name.
+	Name string
+	// ApplicationName is the name of the application the resource belongs
+	// to. It is empty for charms, and for resources no longer associated with
+	// an application.
+	ApplicationName string
+	// Revision is the charm or resource revision, or -1 for resources
+	// without a revision.
+	Revision int
+	// InUse is true if the revision is in use by an application or unit.
+	InUse bool
+}
+
+// StoredBlob describes a blob held in the model's object store on behalf of
+// charms or resources.
+type StoredBlob struct {
+	// UUID is the uuid of the object store metadata for the blob.
+	UUID string
+	// Paths are the object store paths of the blob.
+	Paths []string
+	// SHA384 is the hash of the blob.
+	SHA384 string
+	// Size is the size of the blob in bytes.
+	Size int64
+	// CreatedAt is when the blob was stored.
+	CreatedAt time.Time
+	// References are the charm and resource revisions held in the blob.
+	References []BlobReference
+}
+
+// Usage returns how the blob is used.
+func (b StoredBlob) Usage() BlobUsage {
+	if len(b.References) == 0 {
+		return BlobUnreferenced
+	}
+	for _, ref := range b.References {
+		if ref.InUse {
+			return BlobInUse
+		}
+	}
+	return BlobOldRevision
+}
+
+// PruneStorageArgs holds the arguments for pruning unreferenced blobs from the
+// model's object store.
+type PruneStorageArgs struct {
+	// GracePeriod is how long an unreferenced blob is kept after it has been
+	// stored, so that blobs whose charm or resource is still being recorded
+	// are not removed.
+	GracePeriod time.Duration
+	// DryRun is true if the blobs which would be removed should be returned
+	// without removing them.
+	DryRun bool
+}
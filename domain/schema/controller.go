@@ -88,8 +88,8 @@ func ControllerDDL() *schema.Schema {
 		triggers.ChangeLogTriggersForControllerConfig("key", tableControllerConfig),
 		triggers.ChangeLogTriggersForControllerNode("controller_id", tableControllerNode),
 		triggers.ChangeLogTriggersForControllerApiAddress("controller_id", tableControllerAPIAddress),
-		triggers.ChangeLogTriggersForModelMigrationStatus("uuid", tableModelMigrationStatus),
-		triggers.ChangeLogTriggersForModelMigrationMinionSync("uuid", tableModelMigrationMinionSync),
+		triggers.ChangeLogTriggersForModelMigrationStatus("model_uuid", tableModelMigrationStatus),
+		triggers.ChangeLogTriggersForModelMigrationMinionSync("model_uuid", tableModelMigrationMinionSync),
 		triggers.ChangeLogTriggersForUpgradeInfo("uuid", tableUpgradeInfo),
 		triggers.ChangeLogTriggersForUpgradeInfoControllerNode("upgrade_info_uuid", tableUpgradeInfoControllerNode),
 		triggers.ChangeLogTriggersForObjectStoreMetadataPath("path", tableObjectStoreMetadata),
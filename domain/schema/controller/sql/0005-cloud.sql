@@ -23,7 +23,9 @@ INSERT INTO cloud_type VALUES
 (6, 'gce'),
 (7, 'oci'),
 (8, 'openstack'),
-(9, 'vsphere');
+(9, 'vsphere'),
+(10, 'libvirt'),
+(11, 'proxmox');
 
 CREATE TABLE auth_type (
     id INT PRIMARY KEY,
@@ -1,32 +1,57 @@
+-- model_migration records each attempt at migrating a model from this
+-- controller to another. The model_uuid is intentionally not a foreign key
+-- to the model table, as the migration history of a model is retained after
+-- the model has been removed from this controller.
 CREATE TABLE model_migration (
     uuid TEXT NOT NULL PRIMARY KEY,
-    attempt INT,
+    model_uuid TEXT NOT NULL,
+    attempt INT NOT NULL,
+    initiated_by TEXT NOT NULL,
     target_controller_uuid TEXT NOT NULL,
+    target_controller_alias TEXT,
+    -- target_addresses is a comma separated list of the host:port
+    -- addresses of the target controller's API servers.
+    target_addresses TEXT NOT NULL,
+    target_ca_cert TEXT,
     target_entity TEXT,
     target_password TEXT,
+    -- target_macaroons holds the JSON serialised macaroons used to
+    -- authenticate with the target controller.
     target_macaroons TEXT,
-    active BOOLEAN,
-    start_time TIMESTAMP,
+    target_token TEXT,
+    target_skip_user_checks BOOLEAN DEFAULT FALSE NOT NULL,
+    -- active is true until the migration has reached a terminal phase.
+    active BOOLEAN DEFAULT TRUE NOT NULL,
+    start_time TIMESTAMP NOT NULL,
     success_time TIMESTAMP,
-    end_time TIMESTAMP,
-    phase TEXT,
-    phase_changed_time TIMESTAMP,
-    status_message TEXT,
-    CONSTRAINT fk_model_migration_target_controller
-    FOREIGN KEY (target_controller_uuid)
-    REFERENCES external_controller (uuid)
+    end_time TIMESTAMP
 );
 
+CREATE UNIQUE INDEX idx_model_migration_model_attempt
+ON model_migration (model_uuid, attempt);
+
+-- idx_model_migration_model_active ensures that there can only ever be one
+-- active migration for a model.
+CREATE UNIQUE INDEX idx_model_migration_model_active
+ON model_migration (model_uuid) WHERE active = TRUE;
+
+-- model_migration_status holds the progress of a model migration. The
+-- model_uuid is denormalised from model_migration so that changes can be
+-- watched per model.
 CREATE TABLE model_migration_status (
-    uuid TEXT NOT NULL PRIMARY KEY,
-    start_time TIMESTAMP,
-    success_time TIMESTAMP,
-    end_time TIMESTAMP,
-    phase TEXT,
-    phase_changed_time TIMESTAMP,
-    status TEXT
+    migration_uuid TEXT NOT NULL PRIMARY KEY,
+    model_uuid TEXT NOT NULL,
+    phase TEXT NOT NULL,
+    phase_changed_time TIMESTAMP NOT NULL,
+    status_message TEXT,
+    CONSTRAINT fk_model_migration_status_model_migration
+    FOREIGN KEY (migration_uuid)
+    REFERENCES model_migration (uuid)
 );
 
+CREATE INDEX idx_model_migration_status_model
+ON model_migration_status (model_uuid);
+
 CREATE TABLE model_migration_user (
     uuid TEXT NOT NULL PRIMARY KEY,
     --     user_uuid       TEXT NOT NULL,
@@ -40,14 +65,31 @@ CREATE TABLE model_migration_user (
     REFERENCES model_migration (uuid)
 );
 
+-- model_migration_minion_sync holds the reports made by the agents of a
+-- model (minions) for each phase of a migration. The entity_key is the tag
+-- of the reporting agent.
 CREATE TABLE model_migration_minion_sync (
     uuid TEXT NOT NULL PRIMARY KEY,
     migration_uuid TEXT NOT NULL,
-    phase TEXT,
-    entity_key TEXT,
-    time TIMESTAMP,
-    success BOOLEAN,
+    model_uuid TEXT NOT NULL,
+    phase TEXT NOT NULL,
+    entity_key TEXT NOT NULL,
+    time TIMESTAMP NOT NULL,
+    success BOOLEAN NOT NULL,
     CONSTRAINT fk_model_migration_minion_sync_model_migration
     FOREIGN KEY (migration_uuid)
     REFERENCES model_migration (uuid)
 );
+
+CREATE UNIQUE INDEX idx_model_migration_minion_sync_entity
+ON model_migration_minion_sync (migration_uuid, phase, entity_key);
+
+-- model_migration_import records the models that have been imported into
+-- this controller by a migration that has not yet been activated or
+-- aborted. A model in this table is in the importing migration mode.
+CREATE TABLE model_migration_import (
+    model_uuid TEXT NOT NULL PRIMARY KEY,
+    CONSTRAINT fk_model_migration_import_model
+    FOREIGN KEY (model_uuid)
+    REFERENCES model (uuid)
+);
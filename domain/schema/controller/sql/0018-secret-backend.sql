@@ -14,7 +14,8 @@ ON secret_backend_type (type);
 INSERT INTO secret_backend_type VALUES
 (0, 'controller', 'the juju controller secret backend'),
 (1, 'kubernetes', 'the kubernetes secret backend'),
-(2, 'vault', 'the vault secret backend');
+(2, 'vault', 'the vault secret backend'),
+(3, 'aws-secrets-manager', 'the aws secrets manager secret backend');
 
 CREATE TABLE secret_backend (
     uuid TEXT NOT NULL PRIMARY KEY,
@@ -91,3 +92,40 @@ FROM model_secret_backend AS msb
 JOIN secret_backend AS sb ON msb.secret_backend_uuid = sb.uuid
 JOIN model AS m ON msb.model_uuid = m.uuid
 JOIN model_type AS mt ON m.model_type_id = mt.id;
+
+-- secret_encryption_key records the controller's key-encryption keys. These
+-- wrap the per-model data keys that encrypt secret content stored in the
+-- internal secret backend. Only the key UUIDs are stored; the key material
+-- is derived from the root key held by the controller agents, outside of
+-- the database. A single key is active, wrapping new data keys; retired
+-- keys are kept until no data key is wrapped by them.
+CREATE TABLE secret_encryption_key (
+    uuid TEXT NOT NULL PRIMARY KEY,
+    active BOOLEAN NOT NULL DEFAULT (FALSE),
+    create_time DATETIME NOT NULL DEFAULT (STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW', 'utc'))
+);
+
+CREATE UNIQUE INDEX idx_secret_encryption_key_active
+ON secret_encryption_key (active)
+WHERE active = TRUE;
+
+-- secret_encryption_key_reference records which key-encryption keys wrap
+-- each model data key. A reference is added before a data key is stored
+-- wrapped by a key, so a retired key is only deleted once no data key in
+-- any model can still depend on it.
+CREATE TABLE secret_encryption_key_reference (
+    encryption_key_uuid TEXT NOT NULL,
+    model_uuid TEXT NOT NULL,
+    data_key_uuid TEXT NOT NULL,
+    CONSTRAINT pk_secret_encryption_key_reference
+    PRIMARY KEY (encryption_key_uuid, model_uuid, data_key_uuid),
+    CONSTRAINT fk_secret_encryption_key_reference_encryption_key_uuid
+    FOREIGN KEY (encryption_key_uuid)
+    REFERENCES secret_encryption_key (uuid),
+    CONSTRAINT fk_secret_encryption_key_reference_model_uuid
+    FOREIGN KEY (model_uuid)
+    REFERENCES model (uuid)
+);
+
+CREATE INDEX idx_secret_encryption_key_reference_data_key
+ON secret_encryption_key_reference (model_uuid, data_key_uuid);
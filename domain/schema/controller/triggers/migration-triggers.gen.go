@@ -31,10 +31,11 @@ AFTER UPDATE ON model_migration_minion_sync FOR EACH ROW
 WHEN 
 	NEW.uuid != OLD.uuid OR
 	NEW.migration_uuid != OLD.migration_uuid OR
-	(NEW.phase != OLD.phase OR (NEW.phase IS NOT NULL AND OLD.phase IS NULL) OR (NEW.phase IS NULL AND OLD.phase IS NOT NULL)) OR
-	(NEW.entity_key != OLD.entity_key OR (NEW.entity_key IS NOT NULL AND OLD.entity_key IS NULL) OR (NEW.entity_key IS NULL AND OLD.entity_key IS NOT NULL)) OR
-	(NEW.time != OLD.time OR (NEW.time IS NOT NULL AND OLD.time IS NULL) OR (NEW.time IS NULL AND OLD.time IS NOT NULL)) OR
-	(NEW.success != OLD.success OR (NEW.success IS NOT NULL AND OLD.success IS NULL) OR (NEW.success IS NULL AND OLD.success IS NOT NULL)) 
+	NEW.model_uuid != OLD.model_uuid OR
+	NEW.phase != OLD.phase OR
+	NEW.entity_key != OLD.entity_key OR
+	NEW.time != OLD.time OR
+	NEW.success != OLD.success 
 BEGIN
     INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
     VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
@@ -69,13 +70,11 @@ END;
 CREATE TRIGGER trg_log_model_migration_status_update
 AFTER UPDATE ON model_migration_status FOR EACH ROW
 WHEN 
-	NEW.uuid != OLD.uuid OR
-	(NEW.start_time != OLD.start_time OR (NEW.start_time IS NOT NULL AND OLD.start_time IS NULL) OR (NEW.start_time IS NULL AND OLD.start_time IS NOT NULL)) OR
-	(NEW.success_time != OLD.success_time OR (NEW.success_time IS NOT NULL AND OLD.success_time IS NULL) OR (NEW.success_time IS NULL AND OLD.success_time IS NOT NULL)) OR
-	(NEW.end_time != OLD.end_time OR (NEW.end_time IS NOT NULL AND OLD.end_time IS NULL) OR (NEW.end_time IS NULL AND OLD.end_time IS NOT NULL)) OR
-	(NEW.phase != OLD.phase OR (NEW.phase IS NOT NULL AND OLD.phase IS NULL) OR (NEW.phase IS NULL AND OLD.phase IS NOT NULL)) OR
-	(NEW.phase_changed_time != OLD.phase_changed_time OR (NEW.phase_changed_time IS NOT NULL AND OLD.phase_changed_time IS NULL) OR (NEW.phase_changed_time IS NULL AND OLD.phase_changed_time IS NOT NULL)) OR
-	(NEW.status != OLD.status OR (NEW.status IS NOT NULL AND OLD.status IS NULL) OR (NEW.status IS NULL AND OLD.status IS NOT NULL)) 
+	NEW.migration_uuid != OLD.migration_uuid OR
+	NEW.model_uuid != OLD.model_uuid OR
+	NEW.phase != OLD.phase OR
+	NEW.phase_changed_time != OLD.phase_changed_time OR
+	(NEW.status_message != OLD.status_message OR (NEW.status_message IS NOT NULL AND OLD.status_message IS NULL) OR (NEW.status_message IS NULL AND OLD.status_message IS NOT NULL)) 
 BEGIN
     INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
     VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
@@ -90,6 +90,7 @@ func (s *controllerSchemaSuite) TestControllerTables(c *tc.C) {
 		"model_migration_status",
 		"model_migration_user",
 		"model_migration_minion_sync",
+		"model_migration_import",
 		"model_authorized_keys",
 
 		// Upgrade info
@@ -130,6 +131,8 @@ func (s *controllerSchemaSuite) TestControllerTables(c *tc.C) {
 		"secret_backend_type",
 		"secret_backend_reference",
 		"model_secret_backend",
+		"secret_encryption_key",
+		"secret_encryption_key_reference",
 
 		// macaroon bakery
 		"bakery_config",
@@ -25,6 +25,9 @@ import (
 //go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/relation-triggers.gen.go -package=triggers -tables=relation_application_settings_hash,relation_unit_settings_hash,relation_unit,relation,relation_status,application_endpoint
 //go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/cleanup-triggers.gen.go -package=triggers -tables=removal
 //go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/operation-triggers.gen.go -package=triggers -tables=operation_task_log
+//go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/status-triggers.gen.go -package=triggers -tables=application_status,unit_agent_status,unit_workload_status,k8s_pod_status,machine_status,machine_cloud_instance_status
+//go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/annotation-triggers.gen.go -package=triggers -tables=annotation_model,annotation_application,annotation_machine,annotation_unit
+//go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/crossmodelrelation-triggers.gen.go -package=triggers -tables=application_remote_offerer,application_remote_consumer,relation_network_ingress,relation_network_egress
 
 //go:embed model/sql/*.sql
 var modelSchemaDir embed.FS
@@ -92,6 +95,20 @@ const (
 	tableApplicationEndpoint
 	tableOperationTaskLog
 	tableOperationTaskStatus
+	tableApplicationStatus
+	tableUnitAgentStatus
+	tableUnitWorkloadStatus
+	tableK8sPodStatus
+	tableMachineStatus
+	tableMachineCloudInstanceStatus
+	tableAnnotationModel
+	tableAnnotationApplication
+	tableAnnotationMachine
+	tableAnnotationUnit
+	tableApplicationRemoteOfferer
+	tableApplicationRemoteConsumer
+	tableRelationNetworkIngress
+	tableRelationNetworkEgress
 )
 
 // ModelDDL is used to create model databases.
@@ -168,6 +185,20 @@ func ModelDDL() *schema.Schema {
 		triggers.ChangeLogTriggersForIpAddress("net_node_uuid", tableIpAddress),
 		triggers.ChangeLogTriggersForApplicationEndpoint("application_uuid", tableApplicationEndpoint),
 		triggers.ChangeLogTriggersForOperationTaskLog("task_uuid", tableOperationTaskLog),
+		triggers.ChangeLogTriggersForApplicationStatus("application_uuid", tableApplicationStatus),
+		triggers.ChangeLogTriggersForUnitAgentStatus("unit_uuid", tableUnitAgentStatus),
+		triggers.ChangeLogTriggersForUnitWorkloadStatus("unit_uuid", tableUnitWorkloadStatus),
+		triggers.ChangeLogTriggersForK8sPodStatus("unit_uuid", tableK8sPodStatus),
+		triggers.ChangeLogTriggersForMachineStatus("machine_uuid", tableMachineStatus),
+		triggers.ChangeLogTriggersForMachineCloudInstanceStatus("machine_uuid", tableMachineCloudInstanceStatus),
+		triggers.ChangeLogTriggersForAnnotationModel("key", tableAnnotationModel),
+		triggers.ChangeLogTriggersForAnnotationApplication("uuid", tableAnnotationApplication),
+		triggers.ChangeLogTriggersForAnnotationMachine("uuid", tableAnnotationMachine),
+		triggers.ChangeLogTriggersForAnnotationUnit("uuid", tableAnnotationUnit),
+		triggers.ChangeLogTriggersForApplicationRemoteOfferer("application_uuid", tableApplicationRemoteOfferer),
+		triggers.ChangeLogTriggersForApplicationRemoteConsumer("uuid", tableApplicationRemoteConsumer),
+		triggers.ChangeLogTriggersForRelationNetworkIngress("relation_uuid", tableRelationNetworkIngress),
+		triggers.ChangeLogTriggersForRelationNetworkEgress("relation_uuid", tableRelationNetworkEgress),
 	)
 
 	// Generic triggers.
@@ -2,7 +2,10 @@ CREATE TABLE object_store_metadata (
     uuid TEXT NOT NULL PRIMARY KEY,
     sha_256 TEXT NOT NULL,
     sha_384 TEXT NOT NULL,
-    size INT NOT NULL
+    size INT NOT NULL,
+    -- created_at is used to give a newly stored object a grace period before
+    -- it can be pruned, while the entity that references it is recorded.
+    created_at DATETIME NOT NULL DEFAULT (STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW', 'utc'))
 );
 
 -- Add a unique index for each hash and a composite unique index for both hashes
@@ -168,6 +168,24 @@ CREATE TABLE storage_unit_owner (
     REFERENCES unit (uuid)
 );
 
+-- storage_application_owner is used to indicate when an application is the
+-- owner of a storage instance. This is the case for shared storage, where a
+-- single storage instance is attached to every unit of the application.
+CREATE TABLE storage_application_owner (
+    storage_instance_uuid TEXT NOT NULL PRIMARY KEY,
+    application_uuid TEXT NOT NULL,
+    CONSTRAINT fk_storage_application_owner_storage_instance
+    FOREIGN KEY (storage_instance_uuid)
+    REFERENCES storage_instance (uuid),
+    CONSTRAINT fk_storage_application_owner_application
+    FOREIGN KEY (application_uuid)
+    REFERENCES application (uuid)
+);
+
+-- Note that this is not unique; it speeds access by application.
+CREATE INDEX idx_storage_application_owner_application
+ON storage_application_owner (application_uuid);
+
 CREATE TABLE storage_attachment (
     uuid TEXT NOT NULL PRIMARY KEY,
     storage_instance_uuid TEXT NOT NULL,
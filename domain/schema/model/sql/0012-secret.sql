@@ -98,6 +98,26 @@ CREATE TABLE secret_content (
 CREATE INDEX idx_secret_content_revision_uuid
 ON secret_content (revision_uuid);
 
+-- secret_data_key holds the model's data keys, which encrypt the content
+-- of secrets stored in the internal secret backend. Each is wrapped by a
+-- key-encryption key held in the controller database. A single key is
+-- active, encrypting new content.
+CREATE TABLE secret_data_key (
+    uuid TEXT NOT NULL PRIMARY KEY,
+    -- encryption_key_uuid is the UUID of the wrapping key in the
+    -- controller database.
+    encryption_key_uuid TEXT NOT NULL,
+    -- wrapped_key is the base64 encoded, encrypted data key.
+    wrapped_key TEXT NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT (FALSE),
+    CONSTRAINT chk_empty_wrapped_key
+    CHECK (wrapped_key != '')
+);
+
+CREATE UNIQUE INDEX idx_secret_data_key_active
+ON secret_data_key (active)
+WHERE active = TRUE;
+
 CREATE TABLE secret_revision (
     uuid TEXT NOT NULL PRIMARY KEY,
     secret_id TEXT NOT NULL,
@@ -318,3 +338,44 @@ LEFT JOIN application AS sua ON sp.subject_uuid = sua.uuid
 LEFT JOIN unit AS scu ON sp.scope_uuid = scu.uuid
 LEFT JOIN application AS sca ON sp.scope_uuid = sca.uuid
 JOIN model AS m;
+
+CREATE TABLE secret_accessor_type (
+    id INT PRIMARY KEY,
+    type TEXT NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_secret_accessor_type
+ON secret_accessor_type (type);
+
+INSERT INTO secret_accessor_type VALUES
+(0, 'unit'),
+(1, 'application'),
+(2, 'model'),
+(3, 'remote-application'),
+(4, 'user');
+
+-- secret_access_log records each read of secret content, so that it can
+-- later be determined which entities read a secret revision and when.
+-- Accessors are recorded by name rather than uuid, and there is no foreign
+-- key to the secret, so that entries outlive the entities they refer to.
+-- Entries older than the retention period are pruned as new ones are
+-- added.
+CREATE TABLE secret_access_log (
+    uuid TEXT NOT NULL PRIMARY KEY,
+    secret_id TEXT NOT NULL,
+    revision INT NOT NULL,
+    accessor_type_id INT NOT NULL,
+    accessor_id TEXT NOT NULL,
+    access_time DATETIME NOT NULL,
+    CONSTRAINT chk_empty_accessor_id
+    CHECK (accessor_id != ''),
+    CONSTRAINT fk_secret_access_log_secret_accessor_type_id
+    FOREIGN KEY (accessor_type_id)
+    REFERENCES secret_accessor_type (id)
+);
+
+CREATE INDEX idx_secret_access_log_secret_id_access_time
+ON secret_access_log (secret_id, access_time);
+
+CREATE INDEX idx_secret_access_log_access_time
+ON secret_access_log (access_time);
@@ -18,6 +18,11 @@ CREATE TABLE "constraint" (
     -- limitations with NULL bools.
     allocate_public_ip INT,
     image_id TEXT,
+    affinity_group TEXT,
+    -- anti_affinity is a bool value. We only use int to get around DQlite
+    -- limitations with NULL bools.
+    anti_affinity INT,
+    accelerators TEXT,
     CONSTRAINT fk_constraint_container_type
     FOREIGN KEY (container_type_id)
     REFERENCES container_type (id)
@@ -39,7 +44,10 @@ SELECT
     ct.value AS container_type,
     c.virt_type,
     c.allocate_public_ip,
-    c.image_id
+    c.image_id,
+    c.affinity_group,
+    c.anti_affinity,
+    c.accelerators
 FROM "constraint" AS c
 LEFT JOIN container_type AS ct ON c.container_type_id = ct.id;
 
@@ -241,6 +241,9 @@ SELECT
     c.virt_type,
     c.allocate_public_ip,
     c.image_id,
+    c.affinity_group,
+    c.anti_affinity,
+    c.accelerators,
     ctag.tag,
     cspace.space AS space_name,
     cspace."exclude" AS space_exclude,
@@ -26,3 +26,64 @@ SELECT
 FROM application AS a
 LEFT JOIN unit AS u ON a.uuid = u.application_uuid
 GROUP BY u.uuid;
+
+-- charm_refresh_policy describes what happens when a new revision of an
+-- application's charm is found in its channel.
+CREATE TABLE charm_refresh_policy (
+    id INT PRIMARY KEY,
+    name TEXT NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_charm_refresh_policy_name
+ON charm_refresh_policy (name);
+
+INSERT INTO charm_refresh_policy VALUES
+(0, 'none'),
+(1, 'notify'),
+(2, 'auto');
+
+-- application_refresh_policy holds the charm refresh policy of an
+-- application. Applications without a row use the 'none' policy.
+-- The maintenance window is a daily window, in minutes after midnight UTC,
+-- during which automatic refreshes may happen. If window_end_minute is before
+-- window_start_minute the window spans midnight. A NULL window allows
+-- refreshes at any time.
+-- notified_revision is the last charm revision the application was notified
+-- of, and failed_revision the last charm revision it failed to be refreshed
+-- to, so neither is repeated.
+CREATE TABLE application_refresh_policy (
+    application_uuid TEXT NOT NULL PRIMARY KEY,
+    policy_id INT NOT NULL,
+    window_start_minute INT,
+    window_end_minute INT,
+    notified_revision INT,
+    failed_revision INT,
+    CONSTRAINT fk_application_refresh_policy_application
+    FOREIGN KEY (application_uuid)
+    REFERENCES application (uuid),
+    CONSTRAINT fk_application_refresh_policy_policy
+    FOREIGN KEY (policy_id)
+    REFERENCES charm_refresh_policy (id),
+    CONSTRAINT chk_application_refresh_policy_window
+    CHECK (
+        (window_start_minute IS NULL AND window_end_minute IS NULL)
+        OR (
+            window_start_minute BETWEEN 0 AND 1439
+            AND window_end_minute BETWEEN 0 AND 1439
+        )
+    )
+);
+
+-- charm_channel holds the channels in which the revision updater found a
+-- charmhub charm revision. A pending revision is only a refresh candidate
+-- for the applications tracking one of its channels.
+CREATE TABLE charm_channel (
+    charm_uuid TEXT NOT NULL,
+    track TEXT,
+    risk TEXT NOT NULL,
+    branch TEXT,
+    CONSTRAINT fk_charm_channel_charm
+    FOREIGN KEY (charm_uuid)
+    REFERENCES charm (uuid),
+    PRIMARY KEY (charm_uuid, track, risk, branch)
+);
@@ -6,6 +6,9 @@ CREATE TABLE application_remote_offerer (
     -- application_uuid is the synthetic application in the consumer model.
     -- Locating charm is done through the application.
     application_uuid TEXT NOT NULL,
+    -- offer_uuid is the offer being consumed. There is no FK constraint on
+    -- it, because the offer is located in the offering model.
+    offer_uuid TEXT NOT NULL,
     -- version is the unique version number that is incremented when the 
     -- consumer model changes the offerer application.
     version INT NOT NULL,
@@ -27,6 +30,9 @@ CREATE TABLE application_remote_offerer (
     REFERENCES life (id)
 );
 
+CREATE UNIQUE INDEX idx_application_remote_offerer_application_uuid
+ON application_remote_offerer (application_uuid);
+
 -- application_remote_consumer represents a remote consumer application
 -- inside of the offering model.
 CREATE TABLE application_remote_consumer (
@@ -81,3 +87,29 @@ CREATE TABLE offer_connection (
     FOREIGN KEY (remote_relation_uuid)
     REFERENCES relation (uuid)
 );
+
+-- relation_network_ingress holds the ingress CIDRs for a cross model
+-- relation. On the offering side, these are the networks published by the
+-- consuming model, from which connections to the offered application
+-- originate.
+CREATE TABLE relation_network_ingress (
+    relation_uuid TEXT NOT NULL,
+    cidr TEXT NOT NULL,
+    CONSTRAINT fk_relation_uuid
+    FOREIGN KEY (relation_uuid)
+    REFERENCES relation (uuid),
+    PRIMARY KEY (relation_uuid, cidr)
+);
+
+-- relation_network_egress holds the egress CIDRs for a cross model
+-- relation. On the consuming side, these are the networks from which
+-- connections to the offering model will originate, as supplied with
+-- integrate --via.
+CREATE TABLE relation_network_egress (
+    relation_uuid TEXT NOT NULL,
+    cidr TEXT NOT NULL,
+    CONSTRAINT fk_relation_uuid
+    FOREIGN KEY (relation_uuid)
+    REFERENCES relation (uuid),
+    PRIMARY KEY (relation_uuid, cidr)
+);
@@ -0,0 +1,158 @@
+// Code generated by triggergen. DO NOT EDIT.
+
+package triggers
+
+import (
+	"fmt"
+
+	"github.com/juju/juju/core/database/schema"
+)
+
+
+// ChangeLogTriggersForAnnotationApplication generates the triggers for the
+// annotation_application table.
+func ChangeLogTriggersForAnnotationApplication(columnName string, namespaceID int) func() schema.Patch {
+	return func() schema.Patch {
+		return schema.MakePatch(fmt.Sprintf(`
+-- insert namespace for AnnotationApplication
+INSERT INTO change_log_namespace VALUES (%[2]d, 'annotation_application', 'AnnotationApplication changes based on %[1]s');
+
+-- insert trigger for AnnotationApplication
+CREATE TRIGGER trg_log_annotation_application_insert
+AFTER INSERT ON annotation_application FOR EACH ROW
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (1, %[2]d, NEW.%[1]s, DATETIME('now'));
+END;
+
+-- update trigger for AnnotationApplication
+CREATE TRIGGER trg_log_annotation_application_update
+AFTER UPDATE ON annotation_application FOR EACH ROW
+WHEN 
+	NEW.uuid != OLD.uuid OR
+	NEW.key != OLD.key OR
+	NEW.value != OLD.value 
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+END;
+-- delete trigger for AnnotationApplication
+CREATE TRIGGER trg_log_annotation_application_delete
+AFTER DELETE ON annotation_application FOR EACH ROW
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (4, %[2]d, OLD.%[1]s, DATETIME('now'));
+END;`, columnName, namespaceID))
+	}
+}
+
+// ChangeLogTriggersForAnnotationMachine generates the triggers for the
+// annotation_machine table.
+func ChangeLogTriggersForAnnotationMachine(columnName string, namespaceID int) func() schema.Patch {
+	return func() schema.Patch {
+		return schema.MakePatch(fmt.Sprintf(`
+-- insert namespace for AnnotationMachine
+INSERT INTO change_log_namespace VALUES (%[2]d, 'annotation_machine', 'AnnotationMachine changes based on %[1]s');
+
+-- insert trigger for AnnotationMachine
+CREATE TRIGGER trg_log_annotation_machine_insert
+AFTER INSERT ON annotation_machine FOR EACH ROW
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (1, %[2]d, NEW.%[1]s, DATETIME('now'));
+END;
+
+-- update trigger for AnnotationMachine
+CREATE TRIGGER trg_log_annotation_machine_update
+AFTER UPDATE ON annotation_machine FOR EACH ROW
+WHEN 
+	NEW.uuid != OLD.uuid OR
+	NEW.key != OLD.key OR
+	NEW.value != OLD.value 
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+END;
+-- delete trigger for AnnotationMachine
+CREATE TRIGGER trg_log_annotation_machine_delete
+AFTER DELETE ON annotation_machine FOR EACH ROW
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (4, %[2]d, OLD.%[1]s, DATETIME('now'));
+END;`, columnName, namespaceID))
+	}
+}
+
+// ChangeLogTriggersForAnnotationModel generates the triggers for the
+// annotation_model table.
+func ChangeLogTriggersForAnnotationModel(columnName string, namespaceID int) func() schema.Patch {
+	return func() schema.Patch {
+		return schema.MakePatch(fmt.Sprintf(`
+-- insert namespace for AnnotationModel
+INSERT INTO change_log_namespace VALUES (%[2]d, 'annotation_model', 'AnnotationModel changes based on %[1]s');
+
+-- insert trigger for AnnotationModel
+CREATE TRIGGER trg_log_annotation_model_insert
+AFTER INSERT ON annotation_model FOR EACH ROW
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (1, %[2]d, NEW.%[1]s, DATETIME('now'));
+END;
+
+-- update trigger for AnnotationModel
+CREATE TRIGGER trg_log_annotation_model_update
+AFTER UPDATE ON annotation_model FOR EACH ROW
+WHEN 
+	NEW.key != OLD.key OR
+	NEW.value != OLD.value 
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+END;
+-- delete trigger for AnnotationModel
+CREATE TRIGGER trg_log_annotation_model_delete
+AFTER DELETE ON annotation_model FOR EACH ROW
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (4, %[2]d, OLD.%[1]s, DATETIME('now'));
+END;`, columnName, namespaceID))
+	}
+}
+
+// ChangeLogTriggersForAnnotationUnit generates the triggers for the
+// annotation_unit table.
+func ChangeLogTriggersForAnnotationUnit(columnName string, namespaceID int) func() schema.Patch {
+	return func() schema.Patch {
+		return schema.MakePatch(fmt.Sprintf(`
+-- insert namespace for AnnotationUnit
+INSERT INTO change_log_namespace VALUES (%[2]d, 'annotation_unit', 'AnnotationUnit changes based on %[1]s');
+
+-- insert trigger for AnnotationUnit
+CREATE TRIGGER trg_log_annotation_unit_insert
+AFTER INSERT ON annotation_unit FOR EACH ROW
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (1, %[2]d, NEW.%[1]s, DATETIME('now'));
+END;
+
+-- update trigger for AnnotationUnit
+CREATE TRIGGER trg_log_annotation_unit_update
+AFTER UPDATE ON annotation_unit FOR EACH ROW
+WHEN 
+	NEW.uuid != OLD.uuid OR
+	NEW.key != OLD.key OR
+	NEW.value != OLD.value 
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+END;
+-- delete trigger for AnnotationUnit
+CREATE TRIGGER trg_log_annotation_unit_delete
+AFTER DELETE ON annotation_unit FOR EACH ROW
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (4, %[2]d, OLD.%[1]s, DATETIME('now'));
+END;`, columnName, namespaceID))
+	}
+}
+
@@ -38,6 +38,7 @@ func (s *modelSchemaSuite) TestModelTables(c *tc.C) {
 		"application_exposed_endpoint_cidr",
 		"application_exposed_endpoint_space",
 		"application_platform",
+		"application_refresh_policy",
 		"application_scale",
 		"application_setting",
 		"application_status",
@@ -171,6 +172,7 @@ func (s *modelSchemaSuite) TestModelTables(c *tc.C) {
 		"architecture",
 		"charm_action",
 		"charm_category",
+		"charm_channel",
 		"charm_config_type",
 		"charm_config",
 		"charm_container_mount",
@@ -182,6 +184,7 @@ func (s *modelSchemaSuite) TestModelTables(c *tc.C) {
 		"charm_manifest_base",
 		"charm_metadata",
 		"charm_provenance",
+		"charm_refresh_policy",
 		"charm_relation_role",
 		"charm_relation_scope",
 		"charm_relation",
@@ -243,6 +246,7 @@ func (s *modelSchemaSuite) TestModelTables(c *tc.C) {
 		"storage_pool_origin",
 		"storage_provision_scope",
 		"storage_unit_owner",
+		"storage_application_owner",
 		"storage_volume_attachment_plan_attr",
 		"storage_volume_attachment_plan",
 		"storage_volume_attachment",
@@ -261,6 +265,7 @@ func (s *modelSchemaSuite) TestModelTables(c *tc.C) {
 		"secret_value_ref",
 		"secret_deleted_value_ref",
 		"secret_content",
+		"secret_data_key",
 		"secret_revision",
 		"secret_revision_obsolete",
 		"secret_revision_expire",
@@ -273,6 +278,8 @@ func (s *modelSchemaSuite) TestModelTables(c *tc.C) {
 		"secret_role",
 		"secret_grant_subject_type",
 		"secret_grant_scope_type",
+		"secret_accessor_type",
+		"secret_access_log",
 
 		// Opened Ports
 		"protocol",
@@ -310,6 +317,8 @@ func (s *modelSchemaSuite) TestModelTables(c *tc.C) {
 		"application_remote_offerer",
 		"application_remote_relation",
 		"offer_connection",
+		"relation_network_egress",
+		"relation_network_ingress",
 
 		// Operations
 		"operation_action",
@@ -644,6 +653,62 @@ func (s *modelSchemaSuite) TestModelTriggers(c *tc.C) {
 		"trg_log_machine_insert_life_start_time",
 		"trg_log_machine_update_life_start_time",
 		"trg_log_machine_delete_life_start_time",
+
+		"trg_log_application_status_delete",
+		"trg_log_application_status_insert",
+		"trg_log_application_status_update",
+
+		"trg_log_unit_agent_status_delete",
+		"trg_log_unit_agent_status_insert",
+		"trg_log_unit_agent_status_update",
+
+		"trg_log_unit_workload_status_delete",
+		"trg_log_unit_workload_status_insert",
+		"trg_log_unit_workload_status_update",
+
+		"trg_log_k8s_pod_status_delete",
+		"trg_log_k8s_pod_status_insert",
+		"trg_log_k8s_pod_status_update",
+
+		"trg_log_machine_status_delete",
+		"trg_log_machine_status_insert",
+		"trg_log_machine_status_update",
+
+		"trg_log_machine_cloud_instance_status_delete",
+		"trg_log_machine_cloud_instance_status_insert",
+		"trg_log_machine_cloud_instance_status_update",
+
+		"trg_log_annotation_model_delete",
+		"trg_log_annotation_model_insert",
+		"trg_log_annotation_model_update",
+
+		"trg_log_annotation_application_delete",
+		"trg_log_annotation_application_insert",
+		"trg_log_annotation_application_update",
+
+		"trg_log_annotation_machine_delete",
+		"trg_log_annotation_machine_insert",
+		"trg_log_annotation_machine_update",
+
+		"trg_log_annotation_unit_delete",
+		"trg_log_annotation_unit_insert",
+		"trg_log_annotation_unit_update",
+
+		"trg_log_application_remote_offerer_delete",
+		"trg_log_application_remote_offerer_insert",
+		"trg_log_application_remote_offerer_update",
+
+		"trg_log_application_remote_consumer_delete",
+		"trg_log_application_remote_consumer_insert",
+		"trg_log_application_remote_consumer_update",
+
+		"trg_log_relation_network_ingress_delete",
+		"trg_log_relation_network_ingress_insert",
+		"trg_log_relation_network_ingress_update",
+
+		"trg_log_relation_network_egress_delete",
+		"trg_log_relation_network_egress_insert",
+		"trg_log_relation_network_egress_update",
 	)
 
 	// These are additional triggers that are not change log triggers, but
@@ -0,0 +1,48 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secret
+
+import "time"
+
+// AccessorType represents the type of an entity which read secret content,
+// as recorded in the secret_accessor_type lookup table.
+type AccessorType int
+
+const (
+	AccessorUnit AccessorType = iota
+	AccessorApplication
+	AccessorModel
+	AccessorRemoteApplication
+	AccessorUser
+)
+
+// String implements fmt.Stringer.
+func (t AccessorType) String() string {
+	switch t {
+	case AccessorUnit:
+		return "unit"
+	case AccessorApplication:
+		return "application"
+	case AccessorModel:
+		return "model"
+	case AccessorRemoteApplication:
+		return "remote-application"
+	case AccessorUser:
+		return "user"
+	}
+	return ""
+}
+
+// AccessLogEntry records a read of the content of a secret revision.
+type AccessLogEntry struct {
+	// Revision is the secret revision which was read.
+	Revision int
+	// AccessorType is the type of the entity which read the content.
+	AccessorType AccessorType
+	// AccessorID is the name of the entity which read the content,
+	// or the uuid for a model.
+	AccessorID string
+	// AccessTime is when the content was read.
+	AccessTime time.Time
+}
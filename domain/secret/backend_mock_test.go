@@ -82,6 +82,83 @@ func (c *MockSecretBackendStateAddSecretBackendReferenceCall) DoAndReturn(f func
 	return c
 }
 
+// AddSecretEncryptionKeyReference mocks base method.
+func (m *MockSecretBackendState) AddSecretEncryptionKeyReference(arg0 context.Context, arg1 string, arg2 model.UUID, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSecretEncryptionKeyReference", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddSecretEncryptionKeyReference indicates an expected call of AddSecretEncryptionKeyReference.
+func (mr *MockSecretBackendStateMockRecorder) AddSecretEncryptionKeyReference(arg0, arg1, arg2, arg3 any) *MockSecretBackendStateAddSecretEncryptionKeyReferenceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSecretEncryptionKeyReference", reflect.TypeOf((*MockSecretBackendState)(nil).AddSecretEncryptionKeyReference), arg0, arg1, arg2, arg3)
+	return &MockSecretBackendStateAddSecretEncryptionKeyReferenceCall{Call: call}
+}
+
+// MockSecretBackendStateAddSecretEncryptionKeyReferenceCall wrap *gomock.Call
+type MockSecretBackendStateAddSecretEncryptionKeyReferenceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretBackendStateAddSecretEncryptionKeyReferenceCall) Return(arg0 error) *MockSecretBackendStateAddSecretEncryptionKeyReferenceCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretBackendStateAddSecretEncryptionKeyReferenceCall) Do(f func(context.Context, string, model.UUID, string) error) *MockSecretBackendStateAddSecretEncryptionKeyReferenceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretBackendStateAddSecretEncryptionKeyReferenceCall) DoAndReturn(f func(context.Context, string, model.UUID, string) error) *MockSecretBackendStateAddSecretEncryptionKeyReferenceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// EnsureActiveSecretEncryptionKey mocks base method.
+func (m *MockSecretBackendState) EnsureActiveSecretEncryptionKey(arg0 context.Context, arg1 secretbackend.EncryptionKey) (secretbackend.EncryptionKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureActiveSecretEncryptionKey", arg0, arg1)
+	ret0, _ := ret[0].(secretbackend.EncryptionKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureActiveSecretEncryptionKey indicates an expected call of EnsureActiveSecretEncryptionKey.
+func (mr *MockSecretBackendStateMockRecorder) EnsureActiveSecretEncryptionKey(arg0, arg1 any) *MockSecretBackendStateEnsureActiveSecretEncryptionKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureActiveSecretEncryptionKey", reflect.TypeOf((*MockSecretBackendState)(nil).EnsureActiveSecretEncryptionKey), arg0, arg1)
+	return &MockSecretBackendStateEnsureActiveSecretEncryptionKeyCall{Call: call}
+}
+
+// MockSecretBackendStateEnsureActiveSecretEncryptionKeyCall wrap *gomock.Call
+type MockSecretBackendStateEnsureActiveSecretEncryptionKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretBackendStateEnsureActiveSecretEncryptionKeyCall) Return(arg0 secretbackend.EncryptionKey, arg1 error) *MockSecretBackendStateEnsureActiveSecretEncryptionKeyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretBackendStateEnsureActiveSecretEncryptionKeyCall) Do(f func(context.Context, secretbackend.EncryptionKey) (secretbackend.EncryptionKey, error)) *MockSecretBackendStateEnsureActiveSecretEncryptionKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretBackendStateEnsureActiveSecretEncryptionKeyCall) DoAndReturn(f func(context.Context, secretbackend.EncryptionKey) (secretbackend.EncryptionKey, error)) *MockSecretBackendStateEnsureActiveSecretEncryptionKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetActiveModelSecretBackend mocks base method.
 func (m *MockSecretBackendState) GetActiveModelSecretBackend(arg0 context.Context, arg1 model.UUID) (string, *provider.ModelBackendConfig, error) {
 	m.ctrl.T.Helper()
@@ -161,6 +238,45 @@ func (c *MockSecretBackendStateGetModelSecretBackendDetailsCall) DoAndReturn(f f
 	return c
 }
 
+// GetSecretEncryptionKey mocks base method.
+func (m *MockSecretBackendState) GetSecretEncryptionKey(arg0 context.Context, arg1 string) (secretbackend.EncryptionKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretEncryptionKey", arg0, arg1)
+	ret0, _ := ret[0].(secretbackend.EncryptionKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretEncryptionKey indicates an expected call of GetSecretEncryptionKey.
+func (mr *MockSecretBackendStateMockRecorder) GetSecretEncryptionKey(arg0, arg1 any) *MockSecretBackendStateGetSecretEncryptionKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretEncryptionKey", reflect.TypeOf((*MockSecretBackendState)(nil).GetSecretEncryptionKey), arg0, arg1)
+	return &MockSecretBackendStateGetSecretEncryptionKeyCall{Call: call}
+}
+
+// MockSecretBackendStateGetSecretEncryptionKeyCall wrap *gomock.Call
+type MockSecretBackendStateGetSecretEncryptionKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretBackendStateGetSecretEncryptionKeyCall) Return(arg0 secretbackend.EncryptionKey, arg1 error) *MockSecretBackendStateGetSecretEncryptionKeyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretBackendStateGetSecretEncryptionKeyCall) Do(f func(context.Context, string) (secretbackend.EncryptionKey, error)) *MockSecretBackendStateGetSecretEncryptionKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretBackendStateGetSecretEncryptionKeyCall) DoAndReturn(f func(context.Context, string) (secretbackend.EncryptionKey, error)) *MockSecretBackendStateGetSecretEncryptionKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListSecretBackendsForModel mocks base method.
 func (m *MockSecretBackendState) ListSecretBackendsForModel(arg0 context.Context, arg1 model.UUID, arg2 bool) ([]*secretbackend.SecretBackend, error) {
 	m.ctrl.T.Helper()
@@ -200,6 +316,44 @@ func (c *MockSecretBackendStateListSecretBackendsForModelCall) DoAndReturn(f fun
 	return c
 }
 
+// PruneSecretEncryptionKeyReferences mocks base method.
+func (m *MockSecretBackendState) PruneSecretEncryptionKeyReferences(arg0 context.Context, arg1 model.UUID, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PruneSecretEncryptionKeyReferences", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PruneSecretEncryptionKeyReferences indicates an expected call of PruneSecretEncryptionKeyReferences.
+func (mr *MockSecretBackendStateMockRecorder) PruneSecretEncryptionKeyReferences(arg0, arg1, arg2, arg3 any) *MockSecretBackendStatePruneSecretEncryptionKeyReferencesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneSecretEncryptionKeyReferences", reflect.TypeOf((*MockSecretBackendState)(nil).PruneSecretEncryptionKeyReferences), arg0, arg1, arg2, arg3)
+	return &MockSecretBackendStatePruneSecretEncryptionKeyReferencesCall{Call: call}
+}
+
+// MockSecretBackendStatePruneSecretEncryptionKeyReferencesCall wrap *gomock.Call
+type MockSecretBackendStatePruneSecretEncryptionKeyReferencesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretBackendStatePruneSecretEncryptionKeyReferencesCall) Return(arg0 error) *MockSecretBackendStatePruneSecretEncryptionKeyReferencesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretBackendStatePruneSecretEncryptionKeyReferencesCall) Do(f func(context.Context, model.UUID, string, string) error) *MockSecretBackendStatePruneSecretEncryptionKeyReferencesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretBackendStatePruneSecretEncryptionKeyReferencesCall) DoAndReturn(f func(context.Context, model.UUID, string, string) error) *MockSecretBackendStatePruneSecretEncryptionKeyReferencesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // RemoveSecretBackendReference mocks base method.
 func (m *MockSecretBackendState) RemoveSecretBackendReference(arg0 context.Context, arg1 ...string) error {
 	m.ctrl.T.Helper()
@@ -14,6 +14,10 @@ const (
 	// does not exist.
 	SecretNotFound = errors.ConstError("secret not found")
 
+	// SecretAlreadyExists describes an error that occurs when a secret being
+	// imported already exists.
+	SecretAlreadyExists = errors.ConstError("secret already exists")
+
 	// SecretIsNotLocal describes an error that occurs when a secret is not from the current model.
 	SecretIsNotLocal = errors.ConstError("secret is from a different model")
 
@@ -41,4 +45,8 @@ const (
 
 	// MissingSecretBackendID describes an error that occurs when importing a secret and the backend doesn't exist.
 	MissingSecretBackendID = errors.ConstError("missing secret backend id")
+
+	// SecretDataKeyNotFound describes an error that occurs when the data key
+	// used to encrypt secret content does not exist.
+	SecretDataKeyNotFound = errors.ConstError("secret data key not found")
 )
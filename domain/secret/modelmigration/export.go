@@ -20,9 +20,10 @@ import (
 )
 
 // RegisterExport registers the export operations with the given coordinator.
-func RegisterExport(coordinator Coordinator, logger logger.Logger) {
+func RegisterExport(coordinator Coordinator, encryptionKeySource service.EncryptionKeySource, logger logger.Logger) {
 	coordinator.Add(&exportOperation{
-		logger: logger,
+		encryptionKeySource: encryptionKeySource,
+		logger:              logger,
 	})
 }
 
@@ -37,8 +38,9 @@ type ExportService interface {
 type exportOperation struct {
 	modelmigration.BaseOperation
 
-	service ExportService
-	logger  logger.Logger
+	service             ExportService
+	encryptionKeySource service.EncryptionKeySource
+	logger              logger.Logger
 }
 
 // Name returns the name of this operation.
@@ -50,6 +52,7 @@ func (e *exportOperation) Setup(scope modelmigration.Scope) error {
 	e.service = service.NewSecretService(
 		state.NewState(scope.ModelDB(), e.logger),
 		secretbackendstate.NewState(scope.ControllerDB(), e.logger),
+		e.encryptionKeySource,
 		nil,
 		e.logger,
 	)
@@ -16,6 +16,7 @@ import (
 	"github.com/juju/juju/core/secrets"
 	"github.com/juju/juju/domain/secret/service"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/secrets/encryptionkey"
 	"github.com/juju/juju/internal/testing"
 )
 
@@ -49,7 +50,7 @@ func (s *exportSuite) TestRegisterExport(c *tc.C) {
 
 	s.coordinator.EXPECT().Add(gomock.Any())
 
-	RegisterExport(s.coordinator, loggertesting.WrapCheckLog(c))
+	RegisterExport(s.coordinator, encryptionkey.NewSource(c.MkDir()), loggertesting.WrapCheckLog(c))
 }
 
 func ptr[T any](v T) *T {
@@ -32,9 +32,10 @@ type Coordinator interface {
 }
 
 // RegisterImport registers the import operations with the given coordinator.
-func RegisterImport(coordinator Coordinator, logger logger.Logger) {
+func RegisterImport(coordinator Coordinator, encryptionKeySource service.EncryptionKeySource, logger logger.Logger) {
 	coordinator.Add(&importOperation{
-		logger: logger,
+		encryptionKeySource: encryptionKeySource,
+		logger:              logger,
 	})
 }
 
@@ -53,9 +54,10 @@ type SecretBackendService interface {
 type importOperation struct {
 	modelmigration.BaseOperation
 
-	service        ImportService
-	backendService SecretBackendService
-	logger         logger.Logger
+	service             ImportService
+	backendService      SecretBackendService
+	encryptionKeySource service.EncryptionKeySource
+	logger              logger.Logger
 
 	knownSecretBackends set.Strings
 	seenBackendIds      set.Strings
@@ -72,7 +74,7 @@ func (i *importOperation) Setup(scope modelmigration.Scope) error {
 	backendstate := secretbackendstate.NewState(scope.ControllerDB(), i.logger)
 	i.service = service.NewSecretService(
 		state.NewState(scope.ModelDB(), i.logger),
-		backendstate, nil, i.logger,
+		backendstate, i.encryptionKeySource, nil, i.logger,
 	)
 	i.backendService = backendservice.NewService(
 		backendstate, i.logger,
@@ -15,6 +15,7 @@ import (
 	"github.com/juju/juju/core/secrets"
 	secreterrors "github.com/juju/juju/domain/secret/errors"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/secrets/encryptionkey"
 	"github.com/juju/juju/internal/testing"
 )
 
@@ -51,7 +52,7 @@ func (s *importSuite) TestRegisterImport(c *tc.C) {
 
 	s.coordinator.EXPECT().Add(gomock.Any())
 
-	RegisterImport(s.coordinator, loggertesting.WrapCheckLog(c))
+	RegisterImport(s.coordinator, encryptionkey.NewSource(c.MkDir()), loggertesting.WrapCheckLog(c))
 }
 
 // serialisedModel provides a model with secrets to import.
@@ -0,0 +1,80 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/core/trace"
+	domainsecret "github.com/juju/juju/domain/secret"
+	"github.com/juju/juju/internal/errors"
+)
+
+// AccessLogRetention is how long reads of secret content are kept in the
+// secret access log.
+const AccessLogRetention = 90 * 24 * time.Hour
+
+// RecordSecretAccess records that the accessor read the content of the
+// specified secret revision. Entries older than [AccessLogRetention] are
+// removed by [SecretService.PruneSecretAccessLog].
+func (s *SecretService) RecordSecretAccess(
+	ctx context.Context, uri *secrets.URI, revision int, accessor SecretAccessor,
+) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	accessorType, err := accessorTypeFromKind(accessor.Kind)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	err = s.secretState.RecordSecretAccess(ctx, uri, domainsecret.AccessLogEntry{
+		Revision:     revision,
+		AccessorType: accessorType,
+		AccessorID:   accessor.ID,
+		AccessTime:   s.clock.Now().UTC(),
+	})
+	return errors.Capture(err)
+}
+
+// PruneSecretAccessLog removes the entries of the secret access log, for any
+// secret, recorded longer ago than [AccessLogRetention].
+func (s *SecretService) PruneSecretAccessLog(ctx context.Context) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	before := s.clock.Now().UTC().Add(-AccessLogRetention)
+	return errors.Capture(s.secretState.PruneSecretAccessLog(ctx, before))
+}
+
+// GetSecretAccessLog returns the recorded reads of the content of the
+// specified secret, oldest first. If revision is not nil, only reads of
+// that revision are returned. Entries are kept after the secret is removed,
+// so it is not an error if the secret does not exist.
+func (s *SecretService) GetSecretAccessLog(
+	ctx context.Context, uri *secrets.URI, revision *int,
+) ([]domainsecret.AccessLogEntry, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	return s.secretState.ListSecretAccessLog(ctx, uri, revision)
+}
+
+func accessorTypeFromKind(kind SecretAccessorKind) (domainsecret.AccessorType, error) {
+	switch kind {
+	case UnitAccessor:
+		return domainsecret.AccessorUnit, nil
+	case ApplicationAccessor:
+		return domainsecret.AccessorApplication, nil
+	case ModelAccessor:
+		return domainsecret.AccessorModel, nil
+	case RemoteApplicationAccessor:
+		return domainsecret.AccessorRemoteApplication, nil
+	case UserAccessor:
+		return domainsecret.AccessorUser, nil
+	}
+	return 0, errors.Errorf("secret accessor kind %q %w", kind, coreerrors.NotValid)
+}
@@ -0,0 +1,93 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"time"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	coresecrets "github.com/juju/juju/core/secrets"
+	domainsecret "github.com/juju/juju/domain/secret"
+)
+
+func (s *serviceSuite) TestRecordSecretAccess(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	now := s.clock.Now().UTC()
+
+	s.state.EXPECT().RecordSecretAccess(gomock.Any(), uri, domainsecret.AccessLogEntry{
+		Revision:     2,
+		AccessorType: domainsecret.AccessorUnit,
+		AccessorID:   "mariadb/0",
+		AccessTime:   now,
+	}).Return(nil)
+
+	err := s.service.RecordSecretAccess(c.Context(), uri, 2, SecretAccessor{
+		Kind: UnitAccessor,
+		ID:   "mariadb/0",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestRecordSecretAccessUser(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	now := s.clock.Now().UTC()
+
+	s.state.EXPECT().RecordSecretAccess(gomock.Any(), uri, domainsecret.AccessLogEntry{
+		Revision:     1,
+		AccessorType: domainsecret.AccessorUser,
+		AccessorID:   "fred",
+		AccessTime:   now,
+	}).Return(nil)
+
+	err := s.service.RecordSecretAccess(c.Context(), uri, 1, SecretAccessor{
+		Kind: UserAccessor,
+		ID:   "fred",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestRecordSecretAccessInvalidKind(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service.RecordSecretAccess(c.Context(), coresecrets.NewURI(), 1, SecretAccessor{
+		Kind: "machine",
+		ID:   "0",
+	})
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *serviceSuite) TestPruneSecretAccessLog(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	now := s.clock.Now().UTC()
+	s.state.EXPECT().PruneSecretAccessLog(gomock.Any(), now.Add(-AccessLogRetention)).Return(nil)
+
+	err := s.service.PruneSecretAccessLog(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestGetSecretAccessLog(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	rev := 2
+	entries := []domainsecret.AccessLogEntry{{
+		Revision:     2,
+		AccessorType: domainsecret.AccessorUnit,
+		AccessorID:   "mariadb/0",
+		AccessTime:   time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	}}
+	s.state.EXPECT().ListSecretAccessLog(gomock.Any(), uri, &rev).Return(entries, nil)
+
+	result, err := s.service.GetSecretAccessLog(c.Context(), uri, &rev)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, entries)
+}
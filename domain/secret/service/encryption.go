@@ -0,0 +1,371 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"strings"
+	"sync"
+
+	"github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/core/trace"
+	domainsecret "github.com/juju/juju/domain/secret"
+	"github.com/juju/juju/domain/secretbackend"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/uuid"
+)
+
+const (
+	// encryptedValuePrefix marks a secret content value as having been
+	// encrypted with a model data key. It is followed by the data key UUID
+	// and the base64 encoded nonce and ciphertext, separated by ":".
+	// Values stored before encryption was introduced are base64 encoded,
+	// so can never contain a ":", and are read as is.
+	encryptedValuePrefix = "juju-enc:v1:"
+
+	// keySize is the size of data keys; the keys are used for AES-256-GCM.
+	keySize = 32
+)
+
+// contentCipher encrypts and decrypts secret content which is stored in
+// the internal secret backend.
+type contentCipher interface {
+	// Encrypt returns the data with each value encrypted.
+	Encrypt(ctx context.Context, data secrets.SecretData) (secrets.SecretData, error)
+	// Decrypt returns the data with each encrypted value decrypted.
+	Decrypt(ctx context.Context, data secrets.SecretData) (secrets.SecretData, error)
+	// RewrapDataKeys re-wraps all model data keys with the active
+	// controller key-encryption key.
+	RewrapDataKeys(ctx context.Context) error
+}
+
+// envelopeCipher implements envelope encryption of secret content. Content
+// is encrypted with a per-model data key, which is itself stored wrapped by
+// a controller key-encryption key. Rotating the key-encryption key only
+// requires the data keys to be re-wrapped; the content itself is untouched.
+// The database only records which key-encryption key wraps each data key;
+// the key-encryption keys themselves come from the key source.
+type envelopeCipher struct {
+	dataKeyState       DataKeyState
+	encryptionKeyState EncryptionKeyState
+	keySource          EncryptionKeySource
+
+	mu sync.Mutex
+	// activeDataKeyUUID is the UUID of the data key used for encryption.
+	activeDataKeyUUID string
+	// dataKeys caches unwrapped data keys by UUID. Re-wrapping a data key
+	// does not change the key itself, so entries never become stale.
+	dataKeys map[string][]byte
+}
+
+func newEnvelopeCipher(
+	dataKeyState DataKeyState, encryptionKeyState EncryptionKeyState, keySource EncryptionKeySource,
+) *envelopeCipher {
+	return &envelopeCipher{
+		dataKeyState:       dataKeyState,
+		encryptionKeyState: encryptionKeyState,
+		keySource:          keySource,
+		dataKeys:           make(map[string][]byte),
+	}
+}
+
+// Encrypt is part of the contentCipher interface.
+func (e *envelopeCipher) Encrypt(ctx context.Context, data secrets.SecretData) (secrets.SecretData, error) {
+	if len(data) == 0 {
+		return data, nil
+	}
+	keyUUID, key, err := e.activeDataKey(ctx)
+	if err != nil {
+		return nil, errors.Errorf("getting secret data key: %w", err)
+	}
+	result := make(secrets.SecretData, len(data))
+	for k, v := range data {
+		sealed, err := seal(key, []byte(v), []byte(k))
+		if err != nil {
+			return nil, errors.Errorf("encrypting secret content %q: %w", k, err)
+		}
+		result[k] = encryptedValuePrefix + keyUUID + ":" + base64.StdEncoding.EncodeToString(sealed)
+	}
+	return result, nil
+}
+
+// Decrypt is part of the contentCipher interface.
+func (e *envelopeCipher) Decrypt(ctx context.Context, data secrets.SecretData) (secrets.SecretData, error) {
+	if len(data) == 0 {
+		return data, nil
+	}
+	result := make(secrets.SecretData, len(data))
+	for k, v := range data {
+		if !strings.HasPrefix(v, encryptedValuePrefix) {
+			result[k] = v
+			continue
+		}
+		keyUUID, sealed, ok := strings.Cut(strings.TrimPrefix(v, encryptedValuePrefix), ":")
+		if !ok {
+			return nil, errors.Errorf("encrypted secret content %q not valid", k)
+		}
+		key, err := e.dataKey(ctx, keyUUID)
+		if err != nil {
+			return nil, errors.Errorf("getting secret data key: %w", err)
+		}
+		ciphertext, err := base64.StdEncoding.DecodeString(sealed)
+		if err != nil {
+			return nil, errors.Errorf("decoding secret content %q: %w", k, err)
+		}
+		plaintext, err := open(key, ciphertext, []byte(k))
+		if err != nil {
+			return nil, errors.Errorf("decrypting secret content %q: %w", k, err)
+		}
+		result[k] = string(plaintext)
+	}
+	return result, nil
+}
+
+// RewrapDataKeys is part of the contentCipher interface.
+func (e *envelopeCipher) RewrapDataKeys(ctx context.Context) error {
+	kekUUID, kek, err := e.activeEncryptionKey(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	modelID, err := e.dataKeyState.GetModelUUID(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	dataKeys, err := e.dataKeyState.ListSecretDataKeys(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	var rewrapped []domainsecret.DataKey
+	for _, dk := range dataKeys {
+		if dk.EncryptionKeyUUID == kekUUID {
+			continue
+		}
+		key, err := e.unwrap(ctx, dk)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		if err := e.encryptionKeyState.AddSecretEncryptionKeyReference(ctx, kekUUID, modelID, dk.UUID); err != nil {
+			return errors.Capture(err)
+		}
+		wrapped, err := seal(kek, key, []byte(dk.UUID))
+		if err != nil {
+			return errors.Errorf("wrapping secret data key %q: %w", dk.UUID, err)
+		}
+		rewrapped = append(rewrapped, domainsecret.DataKey{
+			UUID:              dk.UUID,
+			EncryptionKeyUUID: kekUUID,
+			WrappedKey:        wrapped,
+		})
+	}
+	if err := e.dataKeyState.UpdateSecretDataKeys(ctx, rewrapped); err != nil {
+		return errors.Capture(err)
+	}
+
+	// Only once the data keys are stored wrapped by the active key can
+	// their references to the retired keys be dropped.
+	for _, dk := range rewrapped {
+		if err := e.encryptionKeyState.PruneSecretEncryptionKeyReferences(ctx, modelID, dk.UUID, kekUUID); err != nil {
+			return errors.Capture(err)
+		}
+	}
+	return nil
+}
+
+// activeDataKey returns the model's active data key, creating it if the
+// model does not have one yet.
+func (e *envelopeCipher) activeDataKey(ctx context.Context) (string, []byte, error) {
+	e.mu.Lock()
+	keyUUID := e.activeDataKeyUUID
+	key := e.dataKeys[keyUUID]
+	e.mu.Unlock()
+	if keyUUID != "" {
+		return keyUUID, key, nil
+	}
+
+	modelID, err := e.dataKeyState.GetModelUUID(ctx)
+	if err != nil {
+		return "", nil, errors.Capture(err)
+	}
+	kekUUID, kek, err := e.activeEncryptionKey(ctx)
+	if err != nil {
+		return "", nil, errors.Capture(err)
+	}
+	candidateUUID, err := uuid.NewUUID()
+	if err != nil {
+		return "", nil, errors.Capture(err)
+	}
+	candidate, err := newKey()
+	if err != nil {
+		return "", nil, errors.Capture(err)
+	}
+	wrapped, err := seal(kek, candidate, []byte(candidateUUID.String()))
+	if err != nil {
+		return "", nil, errors.Errorf("wrapping secret data key: %w", err)
+	}
+
+	// The reference is recorded before the data key is stored, so that a
+	// rotation racing with this cannot delete the key-encryption key out
+	// from under it. If the key has already been deleted, this fails and
+	// a later attempt uses the new active key.
+	if err := e.encryptionKeyState.AddSecretEncryptionKeyReference(
+		ctx, kekUUID, modelID, candidateUUID.String(),
+	); err != nil {
+		return "", nil, errors.Capture(err)
+	}
+	dk, err := e.dataKeyState.EnsureActiveSecretDataKey(ctx, domainsecret.DataKey{
+		UUID:              candidateUUID.String(),
+		EncryptionKeyUUID: kekUUID,
+		WrappedKey:        wrapped,
+	})
+	if err != nil {
+		return "", nil, errors.Capture(err)
+	}
+
+	// Another writer may have won the race to create the data key, in
+	// which case it is wrapped by the key recorded against it and the
+	// candidate is discarded.
+	key = candidate
+	if dk.UUID != candidateUUID.String() {
+		if err := e.encryptionKeyState.PruneSecretEncryptionKeyReferences(
+			ctx, modelID, candidateUUID.String(), "",
+		); err != nil {
+			return "", nil, errors.Capture(err)
+		}
+		if key, err = e.unwrap(ctx, dk); err != nil {
+			return "", nil, errors.Capture(err)
+		}
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.activeDataKeyUUID = dk.UUID
+	e.dataKeys[dk.UUID] = key
+	return dk.UUID, key, nil
+}
+
+// dataKey returns the unwrapped data key with the given UUID.
+func (e *envelopeCipher) dataKey(ctx context.Context, keyUUID string) ([]byte, error) {
+	e.mu.Lock()
+	key, ok := e.dataKeys[keyUUID]
+	e.mu.Unlock()
+	if ok {
+		return key, nil
+	}
+
+	dk, err := e.dataKeyState.GetSecretDataKey(ctx, keyUUID)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	if key, err = e.unwrap(ctx, dk); err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.dataKeys[keyUUID] = key
+	return key, nil
+}
+
+// unwrap decrypts the given data key with the key-encryption key that
+// wraps it.
+func (e *envelopeCipher) unwrap(ctx context.Context, dk domainsecret.DataKey) ([]byte, error) {
+	// Only keys known to the controller are used, so that a data key
+	// cannot be unwrapped with a key which has been deleted.
+	kek, err := e.encryptionKeyState.GetSecretEncryptionKey(ctx, dk.EncryptionKeyUUID)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	key, err := e.keySource.EncryptionKey(ctx, kek.UUID)
+	if err != nil {
+		return nil, errors.Errorf("getting secret encryption key %q: %w", kek.UUID, err)
+	}
+	dataKey, err := open(key, dk.WrappedKey, []byte(dk.UUID))
+	if err != nil {
+		return nil, errors.Errorf("unwrapping secret data key %q: %w", dk.UUID, err)
+	}
+	return dataKey, nil
+}
+
+// activeEncryptionKey returns the UUID and material of the controller's
+// active key-encryption key, creating it if the controller does not have
+// one yet.
+func (e *envelopeCipher) activeEncryptionKey(ctx context.Context) (string, []byte, error) {
+	candidateUUID, err := uuid.NewUUID()
+	if err != nil {
+		return "", nil, errors.Capture(err)
+	}
+	kek, err := e.encryptionKeyState.EnsureActiveSecretEncryptionKey(ctx, secretbackend.EncryptionKey{
+		UUID: candidateUUID.String(),
+	})
+	if err != nil {
+		return "", nil, errors.Errorf("getting secret encryption key: %w", err)
+	}
+	key, err := e.keySource.EncryptionKey(ctx, kek.UUID)
+	if err != nil {
+		return "", nil, errors.Errorf("getting secret encryption key %q: %w", kek.UUID, err)
+	}
+	return kek.UUID, key, nil
+}
+
+func newKey() ([]byte, error) {
+	key := make([]byte, keySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, errors.Errorf("generating key: %w", err)
+	}
+	return key, nil
+}
+
+// seal encrypts the plaintext with AES-256-GCM, returning the nonce
+// followed by the ciphertext.
+func seal(key, plaintext, additionalData []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, errors.Errorf("generating nonce: %w", err)
+	}
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+// open decrypts the output of seal.
+func open(key, sealed, additionalData []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	if len(sealed) < aead.NonceSize() {
+		return nil, errors.New("ciphertext too short")
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, additionalData)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// RewrapSecretDataKeys re-wraps the model's secret data keys with the
+// controller's active secret encryption key. It is run for every model
+// when the encryption key is rotated, after which the retired key may
+// be deleted.
+func (s *SecretService) RewrapSecretDataKeys(ctx context.Context) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := s.contentCipher.RewrapDataKeys(ctx); err != nil {
+		return errors.Errorf("re-wrapping secret data keys: %w", err)
+	}
+	return nil
+}
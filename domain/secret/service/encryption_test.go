@@ -0,0 +1,303 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"strings"
+	stdtesting "testing"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	coremodel "github.com/juju/juju/core/model"
+	coresecrets "github.com/juju/juju/core/secrets"
+	domainsecret "github.com/juju/juju/domain/secret"
+	"github.com/juju/juju/domain/secretbackend"
+	backenderrors "github.com/juju/juju/domain/secretbackend/errors"
+	"github.com/juju/juju/internal/secrets/encryptionkey"
+	"github.com/juju/juju/internal/testhelpers"
+	"github.com/juju/juju/internal/uuid"
+)
+
+type encryptionSuite struct {
+	testhelpers.IsolationSuite
+
+	state              *MockState
+	secretBackendState *MockSecretBackendState
+	keySource          *encryptionkey.Source
+
+	// encryptionKeys, references and dataKeys stand in for the
+	// controller and model databases respectively.
+	activeEncryptionKey string
+	encryptionKeys      map[string]secretbackend.EncryptionKey
+	references          map[string]set.Strings
+	dataKeys            map[string]domainsecret.DataKey
+
+	// deleteEncryptionKeyAfterRead simulates a rotation deleting the
+	// active key-encryption key as soon as it has been read.
+	deleteEncryptionKeyAfterRead bool
+}
+
+const modelUUID = coremodel.UUID("6a4ea5b5-d6d3-4ba6-8d8f-0e7ddc0e8b5d")
+
+func TestEncryptionSuite(t *stdtesting.T) {
+	tc.Run(t, &encryptionSuite{})
+}
+
+func (s *encryptionSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.state = NewMockState(ctrl)
+	s.secretBackendState = NewMockSecretBackendState(ctrl)
+	s.keySource = newKeySource(c)
+
+	s.activeEncryptionKey = ""
+	s.deleteEncryptionKeyAfterRead = false
+	s.encryptionKeys = make(map[string]secretbackend.EncryptionKey)
+	s.references = make(map[string]set.Strings)
+	s.dataKeys = make(map[string]domainsecret.DataKey)
+
+	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(modelUUID, nil).AnyTimes()
+
+	s.secretBackendState.EXPECT().EnsureActiveSecretEncryptionKey(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, candidate secretbackend.EncryptionKey) (secretbackend.EncryptionKey, error) {
+			if s.activeEncryptionKey == "" {
+				s.activeEncryptionKey = candidate.UUID
+				s.encryptionKeys[candidate.UUID] = candidate
+			}
+			key := s.encryptionKeys[s.activeEncryptionKey]
+			if s.deleteEncryptionKeyAfterRead {
+				delete(s.encryptionKeys, key.UUID)
+				s.activeEncryptionKey = ""
+			}
+			return key, nil
+		}).AnyTimes()
+	s.secretBackendState.EXPECT().GetSecretEncryptionKey(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, uuid string) (secretbackend.EncryptionKey, error) {
+			key, ok := s.encryptionKeys[uuid]
+			if !ok {
+				return secretbackend.EncryptionKey{}, backenderrors.EncryptionKeyNotFound
+			}
+			return key, nil
+		}).AnyTimes()
+	s.secretBackendState.EXPECT().AddSecretEncryptionKeyReference(gomock.Any(), gomock.Any(), modelUUID, gomock.Any()).DoAndReturn(
+		func(_ context.Context, keyUUID string, _ coremodel.UUID, dataKeyUUID string) error {
+			if _, ok := s.encryptionKeys[keyUUID]; !ok {
+				return backenderrors.EncryptionKeyNotFound
+			}
+			if s.references[dataKeyUUID] == nil {
+				s.references[dataKeyUUID] = set.NewStrings()
+			}
+			s.references[dataKeyUUID].Add(keyUUID)
+			return nil
+		}).AnyTimes()
+	s.secretBackendState.EXPECT().PruneSecretEncryptionKeyReferences(gomock.Any(), modelUUID, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ coremodel.UUID, dataKeyUUID string, keyUUID string) error {
+			refs := set.NewStrings()
+			if s.references[dataKeyUUID].Contains(keyUUID) {
+				refs.Add(keyUUID)
+			}
+			s.references[dataKeyUUID] = refs
+			return nil
+		}).AnyTimes()
+	s.state.EXPECT().GetSecretDataKey(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, uuid string) (domainsecret.DataKey, error) {
+			return s.dataKeys[uuid], nil
+		}).AnyTimes()
+	s.state.EXPECT().ListSecretDataKeys(gomock.Any()).DoAndReturn(
+		func(context.Context) ([]domainsecret.DataKey, error) {
+			var result []domainsecret.DataKey
+			for _, dk := range s.dataKeys {
+				result = append(result, dk)
+			}
+			return result, nil
+		}).AnyTimes()
+	s.state.EXPECT().UpdateSecretDataKeys(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, keys []domainsecret.DataKey) error {
+			for _, dk := range keys {
+				s.dataKeys[dk.UUID] = dk
+			}
+			return nil
+		}).AnyTimes()
+
+	return ctrl
+}
+
+func (s *encryptionSuite) expectCreateDataKey() {
+	s.state.EXPECT().EnsureActiveSecretDataKey(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, candidate domainsecret.DataKey) (domainsecret.DataKey, error) {
+			s.dataKeys[candidate.UUID] = candidate
+			return candidate, nil
+		})
+}
+
+func (s *encryptionSuite) TestEncryptDecrypt(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.expectCreateDataKey()
+
+	cipher := newEnvelopeCipher(s.state, s.secretBackendState, s.keySource)
+	data := coresecrets.SecretData{"foo": "YmFy", "hello": "d29ybGQ="}
+
+	encrypted, err := cipher.Encrypt(c.Context(), data)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(encrypted, tc.HasLen, 2)
+	for k, v := range encrypted {
+		c.Check(strings.HasPrefix(v, encryptedValuePrefix), tc.IsTrue, tc.Commentf("key %q", k))
+	}
+
+	// The data key is only created once, and references the active
+	// key-encryption key.
+	_, err = cipher.Encrypt(c.Context(), data)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(s.dataKeys, tc.HasLen, 1)
+	for uuid := range s.dataKeys {
+		c.Check(s.references[uuid].Values(), tc.DeepEquals, []string{s.activeEncryptionKey})
+	}
+
+	// A new cipher loads the data key from state.
+	decrypted, err := newEnvelopeCipher(s.state, s.secretBackendState, s.keySource).Decrypt(c.Context(), encrypted)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(decrypted, tc.DeepEquals, data)
+}
+
+func (s *encryptionSuite) TestEncryptEmpty(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	encrypted, err := newEnvelopeCipher(s.state, s.secretBackendState, s.keySource).Encrypt(c.Context(), nil)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(encrypted, tc.HasLen, 0)
+}
+
+func (s *encryptionSuite) TestDecryptPlaintext(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// Content stored before encryption was enabled is read as is.
+	data := coresecrets.SecretData{"foo": "YmFy"}
+	decrypted, err := newEnvelopeCipher(s.state, s.secretBackendState, s.keySource).Decrypt(c.Context(), data)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(decrypted, tc.DeepEquals, data)
+}
+
+func (s *encryptionSuite) TestDecryptTampered(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.expectCreateDataKey()
+
+	cipher := newEnvelopeCipher(s.state, s.secretBackendState, s.keySource)
+	encrypted, err := cipher.Encrypt(c.Context(), coresecrets.SecretData{"foo": "YmFy"})
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Content encrypted for one key is not accepted for another.
+	_, err = cipher.Decrypt(c.Context(), coresecrets.SecretData{"bar": encrypted["foo"]})
+	c.Assert(err, tc.ErrorMatches, `decrypting secret content "bar": .*`)
+}
+
+func (s *encryptionSuite) TestRewrapDataKeys(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.expectCreateDataKey()
+
+	data := coresecrets.SecretData{"foo": "YmFy"}
+	encrypted, err := newEnvelopeCipher(s.state, s.secretBackendState, s.keySource).Encrypt(c.Context(), data)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Rotate the key-encryption key and re-wrap the data keys.
+	retired := s.activeEncryptionKey
+	next := secretbackend.EncryptionKey{UUID: uuid.MustNewUUID().String()}
+	s.encryptionKeys[next.UUID] = next
+	s.activeEncryptionKey = next.UUID
+
+	err = newEnvelopeCipher(s.state, s.secretBackendState, s.keySource).RewrapDataKeys(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	for _, dk := range s.dataKeys {
+		c.Check(dk.EncryptionKeyUUID, tc.Equals, next.UUID)
+		// Only the active key is still referenced.
+		c.Check(s.references[dk.UUID].Values(), tc.DeepEquals, []string{next.UUID})
+	}
+
+	// The content can still be decrypted once the retired key is gone.
+	delete(s.encryptionKeys, retired)
+	decrypted, err := newEnvelopeCipher(s.state, s.secretBackendState, s.keySource).Decrypt(c.Context(), encrypted)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(decrypted, tc.DeepEquals, data)
+}
+
+func (s *encryptionSuite) TestRewrapDataKeysMissingEncryptionKey(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.expectCreateDataKey()
+
+	_, err := newEnvelopeCipher(s.state, s.secretBackendState, s.keySource).Encrypt(c.Context(), coresecrets.SecretData{"foo": "YmFy"})
+	c.Assert(err, tc.ErrorIsNil)
+
+	delete(s.encryptionKeys, s.activeEncryptionKey)
+	s.activeEncryptionKey = ""
+
+	err = newEnvelopeCipher(s.state, s.secretBackendState, s.keySource).RewrapDataKeys(c.Context())
+	c.Assert(err, tc.ErrorIs, backenderrors.EncryptionKeyNotFound)
+}
+
+func (s *encryptionSuite) TestEncryptLostDataKeyRace(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// Another writer stores the active data key first.
+	kek := secretbackend.EncryptionKey{UUID: uuid.MustNewUUID().String()}
+	s.encryptionKeys[kek.UUID] = kek
+	s.activeEncryptionKey = kek.UUID
+	winner, err := newKey()
+	c.Assert(err, tc.ErrorIsNil)
+	key, err := s.keySource.EncryptionKey(c.Context(), kek.UUID)
+	c.Assert(err, tc.ErrorIsNil)
+	wrapped, err := seal(key, winner, []byte("winner"))
+	c.Assert(err, tc.ErrorIsNil)
+	s.dataKeys["winner"] = domainsecret.DataKey{
+		UUID:              "winner",
+		EncryptionKeyUUID: kek.UUID,
+		WrappedKey:        wrapped,
+	}
+	s.references["winner"] = set.NewStrings(kek.UUID)
+	s.state.EXPECT().EnsureActiveSecretDataKey(gomock.Any(), gomock.Any()).Return(s.dataKeys["winner"], nil)
+
+	encrypted, err := newEnvelopeCipher(s.state, s.secretBackendState, s.keySource).Encrypt(c.Context(), coresecrets.SecretData{"foo": "YmFy"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(strings.HasPrefix(encrypted["foo"], encryptedValuePrefix+"winner:"), tc.IsTrue)
+
+	// The discarded candidate leaves no reference behind.
+	for uuid, refs := range s.references {
+		if uuid != "winner" {
+			c.Check(refs.IsEmpty(), tc.IsTrue, tc.Commentf("data key %q", uuid))
+		}
+	}
+}
+
+func (s *encryptionSuite) TestEncryptDeletedEncryptionKey(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// The active key is retired and deleted after being read, so no data
+	// key may be stored wrapped by it.
+	s.deleteEncryptionKeyAfterRead = true
+
+	_, err := newEnvelopeCipher(s.state, s.secretBackendState, s.keySource).Encrypt(c.Context(), coresecrets.SecretData{"foo": "YmFy"})
+	c.Assert(err, tc.ErrorIs, backenderrors.EncryptionKeyNotFound)
+	c.Check(s.dataKeys, tc.HasLen, 0)
+}
+
+func (s *encryptionSuite) TestDecryptDifferentRootKey(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	s.expectCreateDataKey()
+
+	encrypted, err := newEnvelopeCipher(s.state, s.secretBackendState, s.keySource).Encrypt(
+		c.Context(), coresecrets.SecretData{"foo": "YmFy"})
+	c.Assert(err, tc.ErrorIsNil)
+
+	// The database alone is not enough to read the content; the data keys
+	// can only be unwrapped with the controller's root key.
+	_, err = newEnvelopeCipher(s.state, s.secretBackendState, newKeySource(c)).Decrypt(c.Context(), encrypted)
+	c.Assert(err, tc.ErrorMatches, `.*unwrapping secret data key .*`)
+}
+
+func newKeySource(c *tc.C) *encryptionkey.Source {
+	dataDir := c.MkDir()
+	err := encryptionkey.EnsureRootKey(dataDir)
+	c.Assert(err, tc.ErrorIsNil)
+	return encryptionkey.NewSource(dataDir)
+}
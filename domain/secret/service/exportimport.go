@@ -5,6 +5,7 @@ package service
 
 import (
 	"context"
+	"slices"
 
 	coremodel "github.com/juju/juju/core/model"
 	coresecrets "github.com/juju/juju/core/secrets"
@@ -12,6 +13,7 @@ import (
 	"github.com/juju/juju/core/unit"
 	"github.com/juju/juju/domain"
 	"github.com/juju/juju/domain/secret"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
 	"github.com/juju/juju/internal/errors"
 )
 
@@ -65,6 +67,11 @@ func (s *SecretService) GetSecretsForExport(ctx context.Context) (*SecretExport,
 			if err != nil {
 				return nil, errors.Errorf("loading secret content for %q: %w", md.URI.ID, err)
 			}
+			// Content is exported in the clear, to be encrypted with the
+			// keys of the controller it is imported into.
+			if data, err = s.contentCipher.Decrypt(ctx, data); err != nil {
+				return nil, errors.Errorf("decrypting secret content for %q: %w", md.URI.ID, err)
+			}
 			if len(data) == 0 {
 				// Should not happen.
 				return nil, errors.Errorf("unexpected empty secret content for %q", md.URI.ID)
@@ -215,105 +222,253 @@ func (s *SecretService) ImportSecrets(ctx context.Context, modelSecrets *SecretE
 	return nil
 }
 
+// ExportSecretArchive returns the secrets selected by the filter, with the
+// content of each of their revisions, so they can be imported into another
+// model with [SecretService.ImportSecretArchive]. Content held in external
+// backends is included, so the result does not depend on the backends of
+// this model. Grants and consumers are not included as they refer to
+// entities in this model.
+func (s *SecretService) ExportSecretArchive(ctx context.Context, filter SecretArchiveFilter) (*SecretExport, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	var (
+		mds  []*coresecrets.SecretMetadata
+		revs [][]*coresecrets.SecretRevisionMetadata
+		err  error
+	)
+	if len(filter.Owners) == 0 {
+		mds, revs, err = s.secretState.ListSecrets(ctx, nil, nil, filter.Labels)
+	} else {
+		appOwners, unitOwners := splitCharmSecretOwners(filter.Owners...)
+		mds, revs, err = s.secretState.ListCharmSecrets(ctx, appOwners, unitOwners)
+	}
+	if err != nil {
+		return nil, errors.Errorf("loading secrets for export: %w", err)
+	}
+
+	archive := &SecretExport{
+		Revisions: make(map[string][]*coresecrets.SecretRevisionMetadata),
+		Content:   make(map[string]map[int]coresecrets.SecretData),
+	}
+	for i, md := range mds {
+		if len(filter.Owners) == 0 && md.Owner.Kind != coresecrets.ModelOwner {
+			continue
+		}
+		if len(filter.Labels) > 0 && !slices.Contains(filter.Labels, md.Label) {
+			continue
+		}
+		archive.Secrets = append(archive.Secrets, md)
+		content := make(map[int]coresecrets.SecretData)
+		for _, rev := range revs[i] {
+			val, err := s.GetSecretContentFromBackend(ctx, md.URI, rev.Revision)
+			if err != nil {
+				return nil, errors.Errorf("loading secret content for %s/%d: %w", md.URI.ID, rev.Revision, err)
+			}
+			content[rev.Revision] = val.EncodedValues()
+			// The content is held in the archive, not in a backend.
+			archiveRev := *rev
+			archiveRev.ValueRef = nil
+			archiveRev.BackendName = nil
+			archive.Revisions[md.URI.ID] = append(archive.Revisions[md.URI.ID], &archiveRev)
+		}
+		archive.Content[md.URI.ID] = content
+	}
+	return archive, nil
+}
+
+// ImportSecretArchive saves the secrets in an archive created by
+// [SecretService.ExportSecretArchive] to the model. User secrets become owned
+// by this model. Charm secrets can only be imported if their owner exists in
+// this model. Revisions are renumbered from 1 and their content is stored in
+// the model's internal secret store.
+// The secrets are saved in a single transaction, so if any of them can't be
+// imported, none are. It returns [secreterrors.SecretAlreadyExists] if any of
+// the secrets already exist.
+func (s *SecretService) ImportSecretArchive(ctx context.Context, archive *SecretExport) (errOut error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	for _, md := range archive.Secrets {
+		_, err := s.secretState.GetSecret(ctx, md.URI)
+		if err == nil {
+			return errors.Errorf("secret %q %w", md.URI.ID, secreterrors.SecretAlreadyExists)
+		} else if !errors.Is(err, secreterrors.SecretNotFound) {
+			return errors.Capture(err)
+		}
+	}
+
+	modelID, err := s.secretState.GetModelUUID(ctx)
+	if err != nil {
+		return errors.Errorf("getting model uuid: %w", err)
+	}
+
+	var rollBacks []func() error
+	defer func() {
+		if errOut == nil {
+			return
+		}
+		for _, rollBack := range rollBacks {
+			if err := rollBack(); err != nil {
+				s.logger.Warningf(ctx, "failed to roll back secret reference count: %v", err)
+			}
+		}
+	}()
+	params := make([][]secret.UpsertSecretParams, len(archive.Secrets))
+	for i, md := range archive.Secrets {
+		params[i], err = s.importedRevisionParams(ctx, md, archive.Revisions[md.URI.ID], archive.Content[md.URI.ID])
+		if err != nil {
+			return errors.Errorf("saving secret %q: %w", md.URI.ID, err)
+		}
+		rollBack, err := s.addImportedSecretReferences(ctx, modelID, params[i])
+		if err != nil {
+			return errors.Errorf("saving secret %q: %w", md.URI.ID, err)
+		}
+		rollBacks = append(rollBacks, rollBack)
+	}
+
+	return s.secretState.RunAtomic(ctx, func(ctx domain.AtomicContext) error {
+		for i, md := range archive.Secrets {
+			revisions := archive.Revisions[md.URI.ID]
+			if err := s.saveImportedSecret(ctx, md, revisions, params[i]); err != nil {
+				return errors.Errorf("saving secret %q: %w", md.URI.ID, err)
+			}
+		}
+		return nil
+	})
+}
+
 func (s *SecretService) importSecretRevisions(
 	ctx context.Context, modelID coremodel.UUID, md *coresecrets.SecretMetadata,
 	revisions []*coresecrets.SecretRevisionMetadata,
 	content map[int]coresecrets.SecretData,
 ) error {
+	params, err := s.importedRevisionParams(ctx, md, revisions, content)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	rollBack, err := s.addImportedSecretReferences(ctx, modelID, params)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	err = s.secretState.RunAtomic(ctx, func(ctx domain.AtomicContext) error {
+		return s.saveImportedSecret(ctx, md, revisions, params)
+	})
+	if err != nil {
+		if err := rollBack(); err != nil {
+			s.logger.Warningf(ctx, "failed to roll back secret reference count: %v", err)
+		}
+		return errors.Capture(err)
+	}
+	return nil
+}
+
+// importedRevisionParams returns the parameters to save each of the revisions
+// of an imported secret. The first of them creates the secret.
+func (s *SecretService) importedRevisionParams(
+	ctx context.Context, md *coresecrets.SecretMetadata,
+	revisions []*coresecrets.SecretRevisionMetadata,
+	content map[int]coresecrets.SecretData,
+) ([]secret.UpsertSecretParams, error) {
+	result := make([]secret.UpsertSecretParams, len(revisions))
 	for i, rev := range revisions {
 		params := secret.UpsertSecretParams{
 			ValueRef: rev.ValueRef,
 		}
+		if i == 0 {
+			params.NextRotateTime = md.NextRotateTime
+			if md.RotatePolicy != "" && md.RotatePolicy != coresecrets.RotateNever {
+				policy := secret.MarshallRotatePolicy(&md.RotatePolicy)
+				params.RotatePolicy = &policy
+			}
+			if md.Description != "" {
+				params.Description = &md.Description
+			}
+			if md.Label != "" {
+				params.Label = &md.Label
+			}
+			if md.AutoPrune {
+				params.AutoPrune = &md.AutoPrune
+			}
+		}
 		if i == len(revisions)-1 {
 			params.Checksum = md.LatestRevisionChecksum
+			// The expiry time of the most recent revision
+			// is included in the export.
+			params.ExpireTime = md.LatestExpireTime
 		}
 		if rev.ValueRef == nil {
 			if data, ok := content[rev.Revision]; ok {
-				params.Data = data
+				var err error
+				if params.Data, err = s.contentCipher.Encrypt(ctx, data); err != nil {
+					return nil, errors.Errorf("encrypting secret content for %s/%d: %w", md.URI.ID, rev.Revision, err)
+				}
 			} else {
 				// Should never happen.
-				return errors.Errorf("missing content for secret %s/%d", md.URI.ID, rev.Revision)
-			}
-		}
-		// The expiry time of the most recent revision
-		// is included in the export.
-		if i == len(revisions)-1 {
-			params.ExpireTime = md.LatestExpireTime
-		}
-		if i == 0 {
-			if err := s.createImportedSecret(ctx, modelID, md, params); err != nil {
-				return errors.Errorf("cannot import secret %q: %w", md.URI.ID, err)
+				return nil, errors.Errorf("missing content for secret %s/%d", md.URI.ID, rev.Revision)
 			}
-			continue
 		}
 		revisionID, err := s.uuidGenerator()
 		if err != nil {
-			return errors.Capture(err)
+			return nil, errors.Capture(err)
 		}
 		params.RevisionID = ptr(revisionID.String())
+		result[i] = params
+	}
+	return result, nil
+}
 
-		rollBack := func() error { return nil }
-		if params.ValueRef != nil || len(params.Data) != 0 {
-			rollBack, err = s.secretBackendState.AddSecretBackendReference(ctx, params.ValueRef, modelID, revisionID.String())
-			if err != nil {
-				return errors.Capture(err)
-			}
-		}
-		err = s.secretState.RunAtomic(ctx, func(ctx domain.AtomicContext) error {
-			return s.updateSecret(ctx, md.URI, params)
-		})
-		if err != nil {
+// addImportedSecretReferences records the secret backend references of the
+// revisions of an imported secret. The returned func removes them again.
+func (s *SecretService) addImportedSecretReferences(
+	ctx context.Context, modelID coremodel.UUID, params []secret.UpsertSecretParams,
+) (_ func() error, errOut error) {
+	var rollBacks []func() error
+	rollBackAll := func() error {
+		for _, rollBack := range rollBacks {
 			if err := rollBack(); err != nil {
-				s.logger.Warningf(ctx, "failed to roll back secret reference count: %v", err)
+				return errors.Capture(err)
 			}
-			return errors.Errorf("cannot import secret %q revision %d: %w", md.URI.ID, rev.Revision, err)
 		}
-	}
-	return nil
-}
-
-func (s *SecretService) createImportedSecret(
-	ctx context.Context, modelID coremodel.UUID, md *coresecrets.SecretMetadata, params secret.UpsertSecretParams,
-) (errOut error) {
-	params.NextRotateTime = md.NextRotateTime
-	if md.RotatePolicy != "" && md.RotatePolicy != coresecrets.RotateNever {
-		policy := secret.MarshallRotatePolicy(&md.RotatePolicy)
-		params.RotatePolicy = &policy
-	}
-	if md.Description != "" {
-		params.Description = &md.Description
-	}
-	if md.Label != "" {
-		params.Label = &md.Label
-	}
-	if md.AutoPrune {
-		params.AutoPrune = &md.AutoPrune
-	}
-
-	revisionID, err := s.uuidGenerator()
-	if err != nil {
-		return errors.Capture(err)
-	}
-	params.RevisionID = ptr(revisionID.String())
-
-	rollBack, err := s.secretBackendState.AddSecretBackendReference(ctx, params.ValueRef, modelID, revisionID.String())
-	if err != nil {
-		return errors.Capture(err)
+		return nil
 	}
 	defer func() {
 		if errOut != nil {
-			if err := rollBack(); err != nil {
+			if err := rollBackAll(); err != nil {
 				s.logger.Warningf(ctx, "failed to roll back secret reference count: %v", err)
 			}
 		}
 	}()
+	for i, p := range params {
+		if i > 0 && p.ValueRef == nil && len(p.Data) == 0 {
+			continue
+		}
+		rollBack, err := s.secretBackendState.AddSecretBackendReference(ctx, p.ValueRef, modelID, *p.RevisionID)
+		if err != nil {
+			return nil, errors.Capture(err)
+		}
+		rollBacks = append(rollBacks, rollBack)
+	}
+	return rollBackAll, nil
+}
 
-	if err = s.secretState.RunAtomic(ctx, func(ctx domain.AtomicContext) error {
-		return s.createSecret(ctx, md.Version, md.URI, md.Owner, params)
-	}); err != nil {
-		return errors.Errorf("cannot import secret %q with owner kind %q", md.URI.ID, md.Owner.Kind)
+// saveImportedSecret creates an imported secret and saves its revisions.
+func (s *SecretService) saveImportedSecret(
+	ctx domain.AtomicContext, md *coresecrets.SecretMetadata,
+	revisions []*coresecrets.SecretRevisionMetadata, params []secret.UpsertSecretParams,
+) error {
+	for i, p := range params {
+		if i == 0 {
+			if err := s.createSecret(ctx, md.Version, md.URI, md.Owner, p); err != nil {
+				return errors.Errorf("cannot import secret %q with owner kind %q: %w", md.URI.ID, md.Owner.Kind, err)
+			}
+			continue
+		}
+		if err := s.updateSecret(ctx, md.URI, p); err != nil {
+			return errors.Errorf("cannot import secret %q revision %d: %w", md.URI.ID, revisions[i].Revision, err)
+		}
 	}
-	return err
+	return nil
 }
 
 func (s *SecretService) importRemoteSecrets(ctx context.Context, remoteSecrets []RemoteSecret) error {
@@ -12,8 +12,11 @@ import (
 	coreapplication "github.com/juju/juju/core/application"
 	coresecrets "github.com/juju/juju/core/secrets"
 	unittesting "github.com/juju/juju/core/unit/testing"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
 	domainsecret "github.com/juju/juju/domain/secret"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
 	domaintesting "github.com/juju/juju/domain/testing"
+	"github.com/juju/juju/internal/secrets/provider"
 	"github.com/juju/juju/internal/testing"
 )
 
@@ -334,3 +337,184 @@ func (s *serviceSuite) TestImportSecrets(c *tc.C) {
 	err = s.service.ImportSecrets(c.Context(), toImport)
 	c.Assert(err, tc.ErrorIsNil)
 }
+
+func (s *serviceSuite) TestExportSecretArchive(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.service.activeBackendID = "backend-id"
+	s.service.backends = map[string]provider.SecretsBackend{"backend-id": s.secretsBackend}
+
+	uri := coresecrets.NewURI()
+	charmURI := coresecrets.NewURI()
+	secrets := []*coresecrets.SecretMetadata{{
+		URI:   uri,
+		Owner: coresecrets.Owner{Kind: coresecrets.ModelOwner, ID: s.modelID.String()},
+		Label: "my-secret",
+	}, {
+		URI:   charmURI,
+		Owner: coresecrets.Owner{Kind: coresecrets.ApplicationOwner, ID: "mysql"},
+	}}
+	valueRef := &coresecrets.ValueRef{BackendID: "backend-id", RevisionID: "revision-id"}
+	revisions := [][]*coresecrets.SecretRevisionMetadata{{{
+		Revision: 1,
+	}, {
+		Revision:    2,
+		ValueRef:    valueRef,
+		BackendName: ptr("vault"),
+	}}, {{
+		Revision: 1,
+	}}}
+
+	s.state.EXPECT().ListSecrets(gomock.Any(), nil, nil, domainsecret.NilLabels).Return(secrets, revisions, nil)
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 1).Return(coresecrets.SecretData{"foo": "YmFy"}, nil, nil)
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 2).Return(nil, valueRef, nil)
+	s.secretsBackend.EXPECT().GetContent(gomock.Any(), "revision-id").Return(
+		coresecrets.NewSecretValue(map[string]string{"foo": "YmF6"}), nil,
+	)
+
+	got, err := s.service.ExportSecretArchive(c.Context(), SecretArchiveFilter{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(got, tc.DeepEquals, &SecretExport{
+		Secrets: secrets[:1],
+		Revisions: map[string][]*coresecrets.SecretRevisionMetadata{
+			uri.ID: {{Revision: 1}, {Revision: 2}},
+		},
+		Content: map[string]map[int]coresecrets.SecretData{
+			uri.ID: {
+				1: {"foo": "YmFy"},
+				2: {"foo": "YmF6"},
+			},
+		},
+	})
+}
+
+func (s *serviceSuite) TestExportSecretArchiveCharmOwnersAndLabels(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.service.activeBackendID = "backend-id"
+
+	uri := coresecrets.NewURI()
+	secrets := []*coresecrets.SecretMetadata{{
+		URI:   uri,
+		Owner: coresecrets.Owner{Kind: coresecrets.ApplicationOwner, ID: "mysql"},
+		Label: "password",
+	}, {
+		URI:   coresecrets.NewURI(),
+		Owner: coresecrets.Owner{Kind: coresecrets.ApplicationOwner, ID: "mysql"},
+		Label: "other",
+	}}
+	revisions := [][]*coresecrets.SecretRevisionMetadata{{{Revision: 1}}, {{Revision: 1}}}
+
+	s.state.EXPECT().ListCharmSecrets(gomock.Any(), domainsecret.ApplicationOwners{"mysql"}, domainsecret.NilUnitOwners).Return(
+		secrets, revisions, nil,
+	)
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 1).Return(coresecrets.SecretData{"foo": "YmFy"}, nil, nil)
+
+	got, err := s.service.ExportSecretArchive(c.Context(), SecretArchiveFilter{
+		Labels: domainsecret.Labels{"password"},
+		Owners: []CharmSecretOwner{{Kind: ApplicationOwner, ID: "mysql"}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(got.Secrets, tc.DeepEquals, secrets[:1])
+	c.Assert(got.Content, tc.DeepEquals, map[string]map[int]coresecrets.SecretData{
+		uri.ID: {1: {"foo": "YmFy"}},
+	})
+}
+
+func (s *serviceSuite) TestImportSecretArchive(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	secrets := []*coresecrets.SecretMetadata{{
+		URI:                    uri,
+		Owner:                  coresecrets.Owner{Kind: coresecrets.ModelOwner, ID: "another-model"},
+		Label:                  "my-secret",
+		LatestRevisionChecksum: "checksum-1234",
+	}}
+
+	s.state.EXPECT().GetSecret(gomock.Any(), uri).Return(nil, secreterrors.SecretNotFound)
+	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(s.modelID, nil)
+	s.state.EXPECT().CheckUserSecretLabelExists(domaintesting.IsAtomicContextChecker, "my-secret").Return(false, nil)
+	s.state.EXPECT().CreateUserSecret(domaintesting.IsAtomicContextChecker, 0, uri, domainsecret.UpsertSecretParams{
+		Label:      ptr("my-secret"),
+		Data:       map[string]string{"foo": "YmFy"},
+		RevisionID: ptr(s.fakeUUID.String()),
+	})
+	s.state.EXPECT().UpdateSecret(domaintesting.IsAtomicContextChecker, uri, domainsecret.UpsertSecretParams{
+		Data:       map[string]string{"foo": "YmF6"},
+		RevisionID: ptr(s.fakeUUID.String()),
+		Checksum:   "checksum-1234",
+	})
+	s.secretBackendState.EXPECT().AddSecretBackendReference(gomock.Any(), nil, s.modelID, s.fakeUUID.String()).Return(
+		func() error { return nil }, nil,
+	).Times(2)
+
+	err := s.service.ImportSecretArchive(c.Context(), &SecretExport{
+		Secrets: secrets,
+		Revisions: map[string][]*coresecrets.SecretRevisionMetadata{
+			uri.ID: {{Revision: 3}, {Revision: 4}},
+		},
+		Content: map[string]map[int]coresecrets.SecretData{
+			uri.ID: {
+				3: {"foo": "YmFy"},
+				4: {"foo": "YmF6"},
+			},
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+// TestImportSecretArchiveFailureImportsNothing verifies that if a secret
+// can't be imported, the references recorded for the others are removed.
+func (s *serviceSuite) TestImportSecretArchiveFailureImportsNothing(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	uri2 := coresecrets.NewURI()
+	secrets := []*coresecrets.SecretMetadata{{
+		URI:   uri,
+		Owner: coresecrets.Owner{Kind: coresecrets.ModelOwner, ID: "another-model"},
+	}, {
+		URI:   uri2,
+		Owner: coresecrets.Owner{Kind: coresecrets.ApplicationOwner, ID: "mysql"},
+	}}
+
+	s.state.EXPECT().GetSecret(gomock.Any(), uri).Return(nil, secreterrors.SecretNotFound)
+	s.state.EXPECT().GetSecret(gomock.Any(), uri2).Return(nil, secreterrors.SecretNotFound)
+	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(s.modelID, nil)
+	rolledBack := 0
+	s.secretBackendState.EXPECT().AddSecretBackendReference(gomock.Any(), nil, s.modelID, s.fakeUUID.String()).Return(
+		func() error { rolledBack++; return nil }, nil,
+	).Times(2)
+	s.state.EXPECT().CreateUserSecret(domaintesting.IsAtomicContextChecker, 0, uri, domainsecret.UpsertSecretParams{
+		Data:       map[string]string{"foo": "YmFy"},
+		RevisionID: ptr(s.fakeUUID.String()),
+	})
+	s.state.EXPECT().GetApplicationUUID(domaintesting.IsAtomicContextChecker, "mysql").Return("", applicationerrors.ApplicationNotFound)
+
+	err := s.service.ImportSecretArchive(c.Context(), &SecretExport{
+		Secrets: secrets,
+		Revisions: map[string][]*coresecrets.SecretRevisionMetadata{
+			uri.ID:  {{Revision: 1}},
+			uri2.ID: {{Revision: 1}},
+		},
+		Content: map[string]map[int]coresecrets.SecretData{
+			uri.ID:  {1: {"foo": "YmFy"}},
+			uri2.ID: {1: {"foo": "YmF6"}},
+		},
+	})
+	c.Assert(err, tc.ErrorIs, applicationerrors.ApplicationNotFound)
+	c.Check(rolledBack, tc.Equals, 2)
+}
+
+func (s *serviceSuite) TestImportSecretArchiveAlreadyExists(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.state.EXPECT().GetSecret(gomock.Any(), uri).Return(&coresecrets.SecretMetadata{URI: uri}, nil)
+
+	err := s.service.ImportSecretArchive(c.Context(), &SecretExport{
+		Secrets: []*coresecrets.SecretMetadata{{URI: uri}},
+	})
+	c.Assert(err, tc.ErrorIs, secreterrors.SecretAlreadyExists)
+}
@@ -48,8 +48,9 @@ type AtomicState interface {
 // the secrets domain service.
 type State interface {
 	AtomicState
+	DataKeyState
+	AccessLogState
 
-	GetModelUUID(ctx context.Context) (coremodel.UUID, error)
 	DeleteObsoleteUserSecretRevisions(ctx context.Context) ([]string, error)
 	GetSecret(ctx context.Context, uri *secrets.URI) (*secrets.SecretMetadata, error)
 	GetLatestRevision(ctx context.Context, uri *secrets.URI) (int, error)
@@ -170,10 +171,83 @@ type SecretBackendReferenceMutator interface {
 	) (func() error, error)
 }
 
+// DataKeyState describes persistence methods for the model data keys
+// used to encrypt secret content.
+type DataKeyState interface {
+	// GetModelUUID returns the UUID of the model holding the data keys.
+	GetModelUUID(ctx context.Context) (coremodel.UUID, error)
+
+	// EnsureActiveSecretDataKey returns the active data key, making the
+	// candidate key active if there is none.
+	EnsureActiveSecretDataKey(ctx context.Context, candidate domainsecret.DataKey) (domainsecret.DataKey, error)
+
+	// GetSecretDataKey returns the data key with the given UUID.
+	GetSecretDataKey(ctx context.Context, uuid string) (domainsecret.DataKey, error)
+
+	// ListSecretDataKeys returns all of the model's data keys.
+	ListSecretDataKeys(ctx context.Context) ([]domainsecret.DataKey, error)
+
+	// UpdateSecretDataKeys replaces the wrapped key material of the given
+	// data keys.
+	UpdateSecretDataKeys(ctx context.Context, keys []domainsecret.DataKey) error
+}
+
+// AccessLogState describes persistence methods for the secret access log.
+type AccessLogState interface {
+	// RecordSecretAccess records a read of the content of a secret
+	// revision.
+	RecordSecretAccess(ctx context.Context, uri *secrets.URI, entry domainsecret.AccessLogEntry) error
+
+	// PruneSecretAccessLog deletes the entries recorded before the given
+	// time, for any secret.
+	PruneSecretAccessLog(ctx context.Context, before time.Time) error
+
+	// ListSecretAccessLog returns the recorded reads of the content of
+	// the secret, optionally limited to a single revision.
+	ListSecretAccessLog(ctx context.Context, uri *secrets.URI, revision *int) ([]domainsecret.AccessLogEntry, error)
+}
+
+// EncryptionKeySource provides the material of the controller
+// key-encryption keys, which is kept outside of the database.
+type EncryptionKeySource interface {
+	// EncryptionKey returns the key-encryption key with the given UUID.
+	EncryptionKey(ctx context.Context, keyUUID string) ([]byte, error)
+}
+
+// EncryptionKeyState describes persistence methods for the controller
+// key-encryption keys used to wrap model data keys. Only the identity of
+// the keys is persisted.
+type EncryptionKeyState interface {
+	// EnsureActiveSecretEncryptionKey returns the active key-encryption
+	// key, making the candidate key active if there is none.
+	EnsureActiveSecretEncryptionKey(
+		ctx context.Context, candidate secretbackend.EncryptionKey,
+	) (secretbackend.EncryptionKey, error)
+
+	// GetSecretEncryptionKey returns the key-encryption key with the
+	// given UUID.
+	GetSecretEncryptionKey(ctx context.Context, uuid string) (secretbackend.EncryptionKey, error)
+
+	// AddSecretEncryptionKeyReference records that the model's data key
+	// is wrapped by the key-encryption key, keeping the key from being
+	// deleted.
+	AddSecretEncryptionKeyReference(
+		ctx context.Context, keyUUID string, modelID coremodel.UUID, dataKeyUUID string,
+	) error
+
+	// PruneSecretEncryptionKeyReferences removes the references of the
+	// model's data key to all key-encryption keys other than the given
+	// one.
+	PruneSecretEncryptionKeyReferences(
+		ctx context.Context, modelID coremodel.UUID, dataKeyUUID string, keyUUID string,
+	) error
+}
+
 // SecretBackendState describes persistence methods for working
 // with secret backends in the controller database.
 type SecretBackendState interface {
 	SecretBackendReferenceMutator
+	EncryptionKeyState
 
 	// GetModelSecretBackendDetails returns the details of the secret
 	// backend that the input model is configured to use.
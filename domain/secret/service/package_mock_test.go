@@ -553,6 +553,45 @@ func (c *MockStateDeleteSecretCall) DoAndReturn(f func(domain.AtomicContext, *se
 	return c
 }
 
+// EnsureActiveSecretDataKey mocks base method.
+func (m *MockState) EnsureActiveSecretDataKey(arg0 context.Context, arg1 secret.DataKey) (secret.DataKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureActiveSecretDataKey", arg0, arg1)
+	ret0, _ := ret[0].(secret.DataKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureActiveSecretDataKey indicates an expected call of EnsureActiveSecretDataKey.
+func (mr *MockStateMockRecorder) EnsureActiveSecretDataKey(arg0, arg1 any) *MockStateEnsureActiveSecretDataKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureActiveSecretDataKey", reflect.TypeOf((*MockState)(nil).EnsureActiveSecretDataKey), arg0, arg1)
+	return &MockStateEnsureActiveSecretDataKeyCall{Call: call}
+}
+
+// MockStateEnsureActiveSecretDataKeyCall wrap *gomock.Call
+type MockStateEnsureActiveSecretDataKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateEnsureActiveSecretDataKeyCall) Return(arg0 secret.DataKey, arg1 error) *MockStateEnsureActiveSecretDataKeyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateEnsureActiveSecretDataKeyCall) Do(f func(context.Context, secret.DataKey) (secret.DataKey, error)) *MockStateEnsureActiveSecretDataKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateEnsureActiveSecretDataKeyCall) DoAndReturn(f func(context.Context, secret.DataKey) (secret.DataKey, error)) *MockStateEnsureActiveSecretDataKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetApplicationUUID mocks base method.
 func (m *MockState) GetApplicationUUID(arg0 domain.AtomicContext, arg1 string) (application.ID, error) {
 	m.ctrl.T.Helper()
@@ -1159,6 +1198,45 @@ func (c *MockStateGetSecretConsumerCall) DoAndReturn(f func(context.Context, *se
 	return c
 }
 
+// GetSecretDataKey mocks base method.
+func (m *MockState) GetSecretDataKey(arg0 context.Context, arg1 string) (secret.DataKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretDataKey", arg0, arg1)
+	ret0, _ := ret[0].(secret.DataKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretDataKey indicates an expected call of GetSecretDataKey.
+func (mr *MockStateMockRecorder) GetSecretDataKey(arg0, arg1 any) *MockStateGetSecretDataKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretDataKey", reflect.TypeOf((*MockState)(nil).GetSecretDataKey), arg0, arg1)
+	return &MockStateGetSecretDataKeyCall{Call: call}
+}
+
+// MockStateGetSecretDataKeyCall wrap *gomock.Call
+type MockStateGetSecretDataKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetSecretDataKeyCall) Return(arg0 secret.DataKey, arg1 error) *MockStateGetSecretDataKeyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetSecretDataKeyCall) Do(f func(context.Context, string) (secret.DataKey, error)) *MockStateGetSecretDataKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetSecretDataKeyCall) DoAndReturn(f func(context.Context, string) (secret.DataKey, error)) *MockStateGetSecretDataKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetSecretGrants mocks base method.
 func (m *MockState) GetSecretGrants(arg0 context.Context, arg1 *secrets.URI, arg2 secrets.SecretRole) ([]secret.GrantParams, error) {
 	m.ctrl.T.Helper()
@@ -1990,6 +2068,84 @@ func (c *MockStateListGrantedSecretsForBackendCall) DoAndReturn(f func(context.C
 	return c
 }
 
+// ListSecretAccessLog mocks base method.
+func (m *MockState) ListSecretAccessLog(arg0 context.Context, arg1 *secrets.URI, arg2 *int) ([]secret.AccessLogEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecretAccessLog", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]secret.AccessLogEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecretAccessLog indicates an expected call of ListSecretAccessLog.
+func (mr *MockStateMockRecorder) ListSecretAccessLog(arg0, arg1, arg2 any) *MockStateListSecretAccessLogCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecretAccessLog", reflect.TypeOf((*MockState)(nil).ListSecretAccessLog), arg0, arg1, arg2)
+	return &MockStateListSecretAccessLogCall{Call: call}
+}
+
+// MockStateListSecretAccessLogCall wrap *gomock.Call
+type MockStateListSecretAccessLogCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateListSecretAccessLogCall) Return(arg0 []secret.AccessLogEntry, arg1 error) *MockStateListSecretAccessLogCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateListSecretAccessLogCall) Do(f func(context.Context, *secrets.URI, *int) ([]secret.AccessLogEntry, error)) *MockStateListSecretAccessLogCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateListSecretAccessLogCall) DoAndReturn(f func(context.Context, *secrets.URI, *int) ([]secret.AccessLogEntry, error)) *MockStateListSecretAccessLogCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListSecretDataKeys mocks base method.
+func (m *MockState) ListSecretDataKeys(arg0 context.Context) ([]secret.DataKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecretDataKeys", arg0)
+	ret0, _ := ret[0].([]secret.DataKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecretDataKeys indicates an expected call of ListSecretDataKeys.
+func (mr *MockStateMockRecorder) ListSecretDataKeys(arg0 any) *MockStateListSecretDataKeysCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecretDataKeys", reflect.TypeOf((*MockState)(nil).ListSecretDataKeys), arg0)
+	return &MockStateListSecretDataKeysCall{Call: call}
+}
+
+// MockStateListSecretDataKeysCall wrap *gomock.Call
+type MockStateListSecretDataKeysCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateListSecretDataKeysCall) Return(arg0 []secret.DataKey, arg1 error) *MockStateListSecretDataKeysCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateListSecretDataKeysCall) Do(f func(context.Context) ([]secret.DataKey, error)) *MockStateListSecretDataKeysCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateListSecretDataKeysCall) DoAndReturn(f func(context.Context) ([]secret.DataKey, error)) *MockStateListSecretDataKeysCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListSecrets mocks base method.
 func (m *MockState) ListSecrets(arg0 context.Context, arg1 *secrets.URI, arg2 *int, arg3 secret.Labels) ([]*secrets.SecretMetadata, [][]*secrets.SecretRevisionMetadata, error) {
 	m.ctrl.T.Helper()
@@ -2145,6 +2301,82 @@ func (c *MockStateNamespaceForWatchSecretRevisionObsoleteCall) DoAndReturn(f fun
 	return c
 }
 
+// PruneSecretAccessLog mocks base method.
+func (m *MockState) PruneSecretAccessLog(arg0 context.Context, arg1 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PruneSecretAccessLog", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PruneSecretAccessLog indicates an expected call of PruneSecretAccessLog.
+func (mr *MockStateMockRecorder) PruneSecretAccessLog(arg0, arg1 any) *MockStatePruneSecretAccessLogCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneSecretAccessLog", reflect.TypeOf((*MockState)(nil).PruneSecretAccessLog), arg0, arg1)
+	return &MockStatePruneSecretAccessLogCall{Call: call}
+}
+
+// MockStatePruneSecretAccessLogCall wrap *gomock.Call
+type MockStatePruneSecretAccessLogCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatePruneSecretAccessLogCall) Return(arg0 error) *MockStatePruneSecretAccessLogCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatePruneSecretAccessLogCall) Do(f func(context.Context, time.Time) error) *MockStatePruneSecretAccessLogCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatePruneSecretAccessLogCall) DoAndReturn(f func(context.Context, time.Time) error) *MockStatePruneSecretAccessLogCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RecordSecretAccess mocks base method.
+func (m *MockState) RecordSecretAccess(arg0 context.Context, arg1 *secrets.URI, arg2 secret.AccessLogEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordSecretAccess", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordSecretAccess indicates an expected call of RecordSecretAccess.
+func (mr *MockStateMockRecorder) RecordSecretAccess(arg0, arg1, arg2 any) *MockStateRecordSecretAccessCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordSecretAccess", reflect.TypeOf((*MockState)(nil).RecordSecretAccess), arg0, arg1, arg2)
+	return &MockStateRecordSecretAccessCall{Call: call}
+}
+
+// MockStateRecordSecretAccessCall wrap *gomock.Call
+type MockStateRecordSecretAccessCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateRecordSecretAccessCall) Return(arg0 error) *MockStateRecordSecretAccessCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateRecordSecretAccessCall) Do(f func(context.Context, *secrets.URI, secret.AccessLogEntry) error) *MockStateRecordSecretAccessCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateRecordSecretAccessCall) DoAndReturn(f func(context.Context, *secrets.URI, secret.AccessLogEntry) error) *MockStateRecordSecretAccessCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // RevokeAccess mocks base method.
 func (m *MockState) RevokeAccess(arg0 context.Context, arg1 *secrets.URI, arg2 secret.AccessParams) error {
 	m.ctrl.T.Helper()
@@ -2411,6 +2643,44 @@ func (c *MockStateUpdateSecretCall) DoAndReturn(f func(domain.AtomicContext, *se
 	return c
 }
 
+// UpdateSecretDataKeys mocks base method.
+func (m *MockState) UpdateSecretDataKeys(arg0 context.Context, arg1 []secret.DataKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSecretDataKeys", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSecretDataKeys indicates an expected call of UpdateSecretDataKeys.
+func (mr *MockStateMockRecorder) UpdateSecretDataKeys(arg0, arg1 any) *MockStateUpdateSecretDataKeysCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSecretDataKeys", reflect.TypeOf((*MockState)(nil).UpdateSecretDataKeys), arg0, arg1)
+	return &MockStateUpdateSecretDataKeysCall{Call: call}
+}
+
+// MockStateUpdateSecretDataKeysCall wrap *gomock.Call
+type MockStateUpdateSecretDataKeysCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateUpdateSecretDataKeysCall) Return(arg0 error) *MockStateUpdateSecretDataKeysCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateUpdateSecretDataKeysCall) Do(f func(context.Context, []secret.DataKey) error) *MockStateUpdateSecretDataKeysCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateUpdateSecretDataKeysCall) DoAndReturn(f func(context.Context, []secret.DataKey) error) *MockStateUpdateSecretDataKeysCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockSecretBackendState is a mock of SecretBackendState interface.
 type MockSecretBackendState struct {
 	ctrl     *gomock.Controller
@@ -2473,6 +2743,83 @@ func (c *MockSecretBackendStateAddSecretBackendReferenceCall) DoAndReturn(f func
 	return c
 }
 
+// AddSecretEncryptionKeyReference mocks base method.
+func (m *MockSecretBackendState) AddSecretEncryptionKeyReference(arg0 context.Context, arg1 string, arg2 model.UUID, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSecretEncryptionKeyReference", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddSecretEncryptionKeyReference indicates an expected call of AddSecretEncryptionKeyReference.
+func (mr *MockSecretBackendStateMockRecorder) AddSecretEncryptionKeyReference(arg0, arg1, arg2, arg3 any) *MockSecretBackendStateAddSecretEncryptionKeyReferenceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSecretEncryptionKeyReference", reflect.TypeOf((*MockSecretBackendState)(nil).AddSecretEncryptionKeyReference), arg0, arg1, arg2, arg3)
+	return &MockSecretBackendStateAddSecretEncryptionKeyReferenceCall{Call: call}
+}
+
+// MockSecretBackendStateAddSecretEncryptionKeyReferenceCall wrap *gomock.Call
+type MockSecretBackendStateAddSecretEncryptionKeyReferenceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretBackendStateAddSecretEncryptionKeyReferenceCall) Return(arg0 error) *MockSecretBackendStateAddSecretEncryptionKeyReferenceCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretBackendStateAddSecretEncryptionKeyReferenceCall) Do(f func(context.Context, string, model.UUID, string) error) *MockSecretBackendStateAddSecretEncryptionKeyReferenceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretBackendStateAddSecretEncryptionKeyReferenceCall) DoAndReturn(f func(context.Context, string, model.UUID, string) error) *MockSecretBackendStateAddSecretEncryptionKeyReferenceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// EnsureActiveSecretEncryptionKey mocks base method.
+func (m *MockSecretBackendState) EnsureActiveSecretEncryptionKey(arg0 context.Context, arg1 secretbackend.EncryptionKey) (secretbackend.EncryptionKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureActiveSecretEncryptionKey", arg0, arg1)
+	ret0, _ := ret[0].(secretbackend.EncryptionKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureActiveSecretEncryptionKey indicates an expected call of EnsureActiveSecretEncryptionKey.
+func (mr *MockSecretBackendStateMockRecorder) EnsureActiveSecretEncryptionKey(arg0, arg1 any) *MockSecretBackendStateEnsureActiveSecretEncryptionKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureActiveSecretEncryptionKey", reflect.TypeOf((*MockSecretBackendState)(nil).EnsureActiveSecretEncryptionKey), arg0, arg1)
+	return &MockSecretBackendStateEnsureActiveSecretEncryptionKeyCall{Call: call}
+}
+
+// MockSecretBackendStateEnsureActiveSecretEncryptionKeyCall wrap *gomock.Call
+type MockSecretBackendStateEnsureActiveSecretEncryptionKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretBackendStateEnsureActiveSecretEncryptionKeyCall) Return(arg0 secretbackend.EncryptionKey, arg1 error) *MockSecretBackendStateEnsureActiveSecretEncryptionKeyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretBackendStateEnsureActiveSecretEncryptionKeyCall) Do(f func(context.Context, secretbackend.EncryptionKey) (secretbackend.EncryptionKey, error)) *MockSecretBackendStateEnsureActiveSecretEncryptionKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretBackendStateEnsureActiveSecretEncryptionKeyCall) DoAndReturn(f func(context.Context, secretbackend.EncryptionKey) (secretbackend.EncryptionKey, error)) *MockSecretBackendStateEnsureActiveSecretEncryptionKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetActiveModelSecretBackend mocks base method.
 func (m *MockSecretBackendState) GetActiveModelSecretBackend(arg0 context.Context, arg1 model.UUID) (string, *provider.ModelBackendConfig, error) {
 	m.ctrl.T.Helper()
@@ -2552,6 +2899,45 @@ func (c *MockSecretBackendStateGetModelSecretBackendDetailsCall) DoAndReturn(f f
 	return c
 }
 
+// GetSecretEncryptionKey mocks base method.
+func (m *MockSecretBackendState) GetSecretEncryptionKey(arg0 context.Context, arg1 string) (secretbackend.EncryptionKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretEncryptionKey", arg0, arg1)
+	ret0, _ := ret[0].(secretbackend.EncryptionKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretEncryptionKey indicates an expected call of GetSecretEncryptionKey.
+func (mr *MockSecretBackendStateMockRecorder) GetSecretEncryptionKey(arg0, arg1 any) *MockSecretBackendStateGetSecretEncryptionKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretEncryptionKey", reflect.TypeOf((*MockSecretBackendState)(nil).GetSecretEncryptionKey), arg0, arg1)
+	return &MockSecretBackendStateGetSecretEncryptionKeyCall{Call: call}
+}
+
+// MockSecretBackendStateGetSecretEncryptionKeyCall wrap *gomock.Call
+type MockSecretBackendStateGetSecretEncryptionKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretBackendStateGetSecretEncryptionKeyCall) Return(arg0 secretbackend.EncryptionKey, arg1 error) *MockSecretBackendStateGetSecretEncryptionKeyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretBackendStateGetSecretEncryptionKeyCall) Do(f func(context.Context, string) (secretbackend.EncryptionKey, error)) *MockSecretBackendStateGetSecretEncryptionKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretBackendStateGetSecretEncryptionKeyCall) DoAndReturn(f func(context.Context, string) (secretbackend.EncryptionKey, error)) *MockSecretBackendStateGetSecretEncryptionKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListSecretBackendsForModel mocks base method.
 func (m *MockSecretBackendState) ListSecretBackendsForModel(arg0 context.Context, arg1 model.UUID, arg2 bool) ([]*secretbackend.SecretBackend, error) {
 	m.ctrl.T.Helper()
@@ -2591,6 +2977,44 @@ func (c *MockSecretBackendStateListSecretBackendsForModelCall) DoAndReturn(f fun
 	return c
 }
 
+// PruneSecretEncryptionKeyReferences mocks base method.
+func (m *MockSecretBackendState) PruneSecretEncryptionKeyReferences(arg0 context.Context, arg1 model.UUID, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PruneSecretEncryptionKeyReferences", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PruneSecretEncryptionKeyReferences indicates an expected call of PruneSecretEncryptionKeyReferences.
+func (mr *MockSecretBackendStateMockRecorder) PruneSecretEncryptionKeyReferences(arg0, arg1, arg2, arg3 any) *MockSecretBackendStatePruneSecretEncryptionKeyReferencesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneSecretEncryptionKeyReferences", reflect.TypeOf((*MockSecretBackendState)(nil).PruneSecretEncryptionKeyReferences), arg0, arg1, arg2, arg3)
+	return &MockSecretBackendStatePruneSecretEncryptionKeyReferencesCall{Call: call}
+}
+
+// MockSecretBackendStatePruneSecretEncryptionKeyReferencesCall wrap *gomock.Call
+type MockSecretBackendStatePruneSecretEncryptionKeyReferencesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretBackendStatePruneSecretEncryptionKeyReferencesCall) Return(arg0 error) *MockSecretBackendStatePruneSecretEncryptionKeyReferencesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretBackendStatePruneSecretEncryptionKeyReferencesCall) Do(f func(context.Context, model.UUID, string, string) error) *MockSecretBackendStatePruneSecretEncryptionKeyReferencesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretBackendStatePruneSecretEncryptionKeyReferencesCall) DoAndReturn(f func(context.Context, model.UUID, string, string) error) *MockSecretBackendStatePruneSecretEncryptionKeyReferencesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // RemoveSecretBackendReference mocks base method.
 func (m *MockSecretBackendState) RemoveSecretBackendReference(arg0 context.Context, arg1 ...string) error {
 	m.ctrl.T.Helper()
@@ -4,6 +4,9 @@
 package service
 
 import (
+	"context"
+
+	"github.com/juju/juju/core/secrets"
 	"github.com/juju/juju/internal/errors"
 )
 
@@ -23,3 +26,19 @@ type badToken struct{}
 func (t badToken) Check() error {
 	return errors.New("not leader")
 }
+
+// noopContentCipher stores secret content in the clear, so that tests
+// not concerned with encryption can assert on the content they save.
+type noopContentCipher struct{}
+
+func (noopContentCipher) Encrypt(_ context.Context, data secrets.SecretData) (secrets.SecretData, error) {
+	return data, nil
+}
+
+func (noopContentCipher) Decrypt(_ context.Context, data secrets.SecretData) (secrets.SecretData, error) {
+	return data, nil
+}
+
+func (noopContentCipher) RewrapDataKeys(context.Context) error {
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/juju/juju/core/secrets"
+	domainsecret "github.com/juju/juju/domain/secret"
 )
 
 // CreateCharmSecretParams are used to create charm a secret.
@@ -92,6 +93,10 @@ const (
 	RemoteApplicationAccessor SecretAccessorKind = "remote-application"
 	UnitAccessor              SecretAccessorKind = "unit"
 	ModelAccessor             SecretAccessorKind = "model"
+
+	// UserAccessor is a user reading secret content through the client
+	// API. It is only used to record reads in the secret access log.
+	UserAccessor SecretAccessorKind = "user"
 )
 
 // GrantedSecretsGetter returns the revisions on the given backend for which
@@ -169,6 +174,15 @@ type SecretExport struct {
 	RemoteSecrets []RemoteSecret
 }
 
+// SecretArchiveFilter selects the secrets to include in a secret archive.
+type SecretArchiveFilter struct {
+	// Labels, if not empty, selects only secrets with one of these labels.
+	Labels domainsecret.Labels
+	// Owners, if not empty, selects the charm secrets owned by these
+	// applications and units. Otherwise user secrets are selected.
+	Owners []CharmSecretOwner
+}
+
 // ConsumerInfo holds information about the consumer of a secret.
 type ConsumerInfo struct {
 	secrets.SecretConsumerMetadata
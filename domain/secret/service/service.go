@@ -30,12 +30,14 @@ import (
 func NewSecretService(
 	secretState State,
 	secretBackendState SecretBackendState,
+	encryptionKeySource EncryptionKeySource,
 	leaderEnsurer leadership.Ensurer,
 	logger logger.Logger,
 ) *SecretService {
 	return &SecretService{
 		secretState:        secretState,
 		secretBackendState: secretBackendState,
+		contentCipher:      newEnvelopeCipher(secretState, secretBackendState, encryptionKeySource),
 		providerGetter:     provider.Provider,
 		leaderEnsurer:      leaderEnsurer,
 		uuidGenerator:      uuid.NewUUID,
@@ -51,6 +53,7 @@ type ProviderGetter func(backendType string) (provider.SecretBackendProvider, er
 type SecretService struct {
 	secretState        State
 	secretBackendState SecretBackendState
+	contentCipher      contentCipher
 
 	providerGetter ProviderGetter
 
@@ -251,6 +254,9 @@ func (s *SecretService) CreateUserSecret(ctx context.Context, uri *secrets.URI,
 			RevisionID: revId,
 		}
 	}
+	if p.Data, err = s.contentCipher.Encrypt(ctx, p.Data); err != nil {
+		return errors.Errorf("encrypting secret content: %w", err)
+	}
 	revisionID, err := s.uuidGenerator()
 	if err != nil {
 		return errors.Capture(err)
@@ -306,9 +312,9 @@ func (s *SecretService) CreateCharmSecret(ctx context.Context, uri *secrets.URI,
 		Checksum:    params.Checksum,
 	}
 	if len(params.Data) > 0 {
-		p.Data = make(map[string]string)
-		for k, v := range params.Data {
-			p.Data[k] = v
+		var err error
+		if p.Data, err = s.contentCipher.Encrypt(ctx, params.Data); err != nil {
+			return errors.Errorf("encrypting secret content: %w", err)
 		}
 	}
 
@@ -429,6 +435,9 @@ func (s *SecretService) UpdateUserSecret(ctx context.Context, uri *secrets.URI,
 					RevisionID: revId,
 				}
 			}
+			if p.Data, err = s.contentCipher.Encrypt(innerCtx, p.Data); err != nil {
+				return errors.Errorf("encrypting secret content: %w", err)
+			}
 		}
 
 		if p.ValueRef != nil || len(p.Data) != 0 {
@@ -505,9 +514,8 @@ func (s *SecretService) UpdateCharmSecret(ctx context.Context, uri *secrets.URI,
 		}
 	}
 	if len(params.Data) > 0 {
-		p.Data = make(map[string]string)
-		for k, v := range params.Data {
-			p.Data[k] = v
+		if p.Data, err = s.contentCipher.Encrypt(ctx, params.Data); err != nil {
+			return errors.Errorf("encrypting secret content: %w", err)
 		}
 	}
 
@@ -727,7 +735,13 @@ func (s *SecretService) GetSecretValue(ctx context.Context, uri *secrets.URI, re
 		return nil, nil, errors.Capture(err)
 	}
 	data, ref, err := s.secretState.GetSecretValue(ctx, uri, rev)
-	return secrets.NewSecretValue(data), ref, errors.Capture(err)
+	if err != nil {
+		return nil, nil, errors.Capture(err)
+	}
+	if data, err = s.contentCipher.Decrypt(ctx, data); err != nil {
+		return nil, nil, errors.Errorf("decrypting secret content: %w", err)
+	}
+	return secrets.NewSecretValue(data), ref, nil
 }
 
 // GetSecretContentFromBackend retrieves the content for the specified secret revision.
@@ -746,7 +760,6 @@ func (s *SecretService) GetSecretContentFromBackend(ctx context.Context, uri *se
 	lastBackendID := ""
 	for {
 		data, ref, err := s.secretState.GetSecretValue(ctx, uri, rev)
-		val := secrets.NewSecretValue(data)
 		if err != nil {
 			notFound := errors.Is(err, secreterrors.SecretNotFound) || errors.Is(err, secreterrors.SecretRevisionNotFound)
 			if notFound {
@@ -755,7 +768,10 @@ func (s *SecretService) GetSecretContentFromBackend(ctx context.Context, uri *se
 			return nil, errors.Capture(err)
 		}
 		if ref == nil {
-			return val, nil
+			if data, err = s.contentCipher.Decrypt(ctx, data); err != nil {
+				return nil, errors.Errorf("decrypting secret content: %w", err)
+			}
+			return secrets.NewSecretValue(data), nil
 		}
 
 		backendID := ref.BackendID
@@ -763,7 +779,7 @@ func (s *SecretService) GetSecretContentFromBackend(ctx context.Context, uri *se
 		if !ok {
 			return nil, errors.Errorf("external secret backend %q not found, have %q", backendID, s.backends).Add(backenderrors.NotFound)
 		}
-		val, err = backend.GetContent(ctx, ref.RevisionID)
+		val, err := backend.GetContent(ctx, ref.RevisionID)
 		notFound := errors.Is(err, secreterrors.SecretNotFound) || errors.Is(err, secreterrors.SecretRevisionNotFound)
 		if err == nil || !notFound || lastBackendID == backendID {
 			if notFound {
@@ -962,7 +978,11 @@ func (s *SecretService) ChangeSecretBackend(
 			}
 		}()
 
-		err = s.secretState.ChangeSecretBackend(innerCtx, revisionID, params.ValueRef, params.Data)
+		data, err := s.contentCipher.Encrypt(innerCtx, params.Data)
+		if err != nil {
+			return errors.Errorf("encrypting secret content: %w", err)
+		}
+		err = s.secretState.ChangeSecretBackend(innerCtx, revisionID, params.ValueRef, data)
 		if err != nil {
 			return errors.Capture(err)
 		}
@@ -78,6 +78,7 @@ func (s *serviceSuite) setupMocks(c *tc.C) *gomock.Controller {
 	s.service = &SecretService{
 		secretState:        s.state,
 		secretBackendState: s.secretBackendState,
+		contentCipher:      noopContentCipher{},
 		providerGetter:     func(string) (provider.SecretBackendProvider, error) { return s.secretsBackendProvider, nil },
 		leaderEnsurer:      s.ensurer,
 		uuidGenerator:      func() (uuid.UUID, error) { return s.fakeUUID, nil },
@@ -2206,7 +2207,7 @@ func (s *serviceSuite) TestWatchObsolete(c *tc.C) {
 	).Return("secret_metadata", namespaceQuery)
 
 	svc := NewWatchableService(
-		s.state, s.secretBackendState, s.ensurer, mockWatcherFactory, loggertesting.WrapCheckLog(c))
+		s.state, s.secretBackendState, newKeySource(c), s.ensurer, mockWatcherFactory, loggertesting.WrapCheckLog(c))
 	w, err := svc.WatchObsolete(c.Context(),
 		CharmSecretOwner{
 			Kind: ApplicationOwner,
@@ -2267,7 +2268,7 @@ func (s *serviceSuite) TestWatchObsoleteUserSecretsToPrune(c *tc.C) {
 	)
 
 	svc := NewWatchableService(
-		s.state, s.secretBackendState, s.ensurer, mockWatcherFactory, loggertesting.WrapCheckLog(c))
+		s.state, s.secretBackendState, newKeySource(c), s.ensurer, mockWatcherFactory, loggertesting.WrapCheckLog(c))
 	w, err := svc.WatchObsoleteUserSecretsToPrune(c.Context())
 	c.Assert(err, tc.ErrorIsNil)
 	c.Assert(w, tc.NotNil)
@@ -2327,7 +2328,7 @@ func (s *serviceSuite) TestWatchConsumedSecretsChanges(c *tc.C) {
 	).Return([]string{uri2.String()}, nil)
 
 	svc := NewWatchableService(
-		s.state, s.secretBackendState, s.ensurer, mockWatcherFactory, loggertesting.WrapCheckLog(c))
+		s.state, s.secretBackendState, newKeySource(c), s.ensurer, mockWatcherFactory, loggertesting.WrapCheckLog(c))
 	w, err := svc.WatchConsumedSecretsChanges(c.Context(), "mysql/0")
 	c.Assert(err, tc.ErrorIsNil)
 	c.Assert(w, tc.NotNil)
@@ -2380,7 +2381,7 @@ func (s *serviceSuite) TestWatchRemoteConsumedSecretsChanges(c *tc.C) {
 	})
 
 	svc := NewWatchableService(
-		s.state, s.secretBackendState, s.ensurer, mockWatcherFactory, loggertesting.WrapCheckLog(c))
+		s.state, s.secretBackendState, newKeySource(c), s.ensurer, mockWatcherFactory, loggertesting.WrapCheckLog(c))
 	w, err := svc.WatchRemoteConsumedSecretsChanges(c.Context(), "mysql")
 	c.Assert(err, tc.ErrorIsNil)
 	c.Assert(w, tc.NotNil)
@@ -2445,7 +2446,7 @@ func (s *serviceSuite) TestWatchSecretsRotationChanges(c *tc.C) {
 	)
 
 	svc := NewWatchableService(
-		s.state, s.secretBackendState, s.ensurer, mockWatcherFactory, loggertesting.WrapCheckLog(c))
+		s.state, s.secretBackendState, newKeySource(c), s.ensurer, mockWatcherFactory, loggertesting.WrapCheckLog(c))
 	w, err := svc.WatchSecretsRotationChanges(c.Context(),
 		CharmSecretOwner{
 			Kind: ApplicationOwner,
@@ -2531,7 +2532,7 @@ func (s *serviceSuite) TestWatchSecretRevisionsExpiryChanges(c *tc.C) {
 	)
 
 	svc := NewWatchableService(
-		s.state, s.secretBackendState, s.ensurer, mockWatcherFactory, loggertesting.WrapCheckLog(c))
+		s.state, s.secretBackendState, newKeySource(c), s.ensurer, mockWatcherFactory, loggertesting.WrapCheckLog(c))
 	w, err := svc.WatchSecretRevisionsExpiryChanges(c.Context(),
 		CharmSecretOwner{
 			Kind: ApplicationOwner,
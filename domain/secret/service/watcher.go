@@ -80,11 +80,12 @@ type WatchableService struct {
 func NewWatchableService(
 	secretState State,
 	secretBackendState SecretBackendState,
+	encryptionKeySource EncryptionKeySource,
 	leaderEnsurer leadership.Ensurer,
 	watcherFactory WatcherFactory,
 	logger logger.Logger,
 ) *WatchableService {
-	svc := NewSecretService(secretState, secretBackendState, leaderEnsurer, logger)
+	svc := NewSecretService(secretState, secretBackendState, encryptionKeySource, leaderEnsurer, logger)
 	return &WatchableService{
 		SecretService:  *svc,
 		watcherFactory: watcherFactory,
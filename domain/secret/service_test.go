@@ -27,9 +27,11 @@ import (
 	secreterrors "github.com/juju/juju/domain/secret/errors"
 	"github.com/juju/juju/domain/secret/service"
 	"github.com/juju/juju/domain/secret/state"
+	"github.com/juju/juju/domain/secretbackend"
 	domaintesting "github.com/juju/juju/domain/testing"
 	"github.com/juju/juju/environs"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/secrets/encryptionkey"
 	coretesting "github.com/juju/juju/internal/testing"
 )
 
@@ -65,6 +67,7 @@ func (s *serviceSuite) TestDeleteSecretInternal(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
 	s.secretBackendState.EXPECT().AddSecretBackendReference(gomock.Any(), nil, s.modelUUID, gomock.Any())
+	s.expectEncryptionKey()
 	uri := s.createSecret(c, map[string]string{"foo": "bar"}, nil)
 
 	err := s.svc.DeleteSecret(c.Context(), uri, service.DeleteSecretParams{
@@ -103,6 +106,53 @@ func (s *serviceSuite) TestDeleteSecretExternal(c *tc.C) {
 	c.Assert(err, tc.ErrorIs, secreterrors.SecretNotFound)
 }
 
+func (s *serviceSuite) TestInternalSecretContentEncrypted(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.secretBackendState.EXPECT().AddSecretBackendReference(gomock.Any(), nil, s.modelUUID, gomock.Any())
+	s.expectEncryptionKey()
+	uri := s.createSecret(c, map[string]string{"foo": "YmFy"}, nil)
+
+	// The content is not stored in the clear.
+	var content string
+	err := s.ModelTxnRunner(c, s.modelUUID.String()).StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx, `SELECT content FROM secret_content WHERE name = 'foo'`).Scan(&content)
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(content, tc.Not(tc.Equals), "YmFy")
+
+	val, ref, err := s.svc.GetSecretValue(c.Context(), uri, 1, service.SecretAccessor{
+		Kind: service.UnitAccessor,
+		ID:   "mariadb/0",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(ref, tc.IsNil)
+	c.Check(val.EncodedValues(), tc.DeepEquals, map[string]string{"foo": "YmFy"})
+}
+
+func encryptionKeySource(c *tc.C) service.EncryptionKeySource {
+	dataDir := c.MkDir()
+	err := encryptionkey.EnsureRootKey(dataDir)
+	c.Assert(err, tc.ErrorIsNil)
+	return encryptionkey.NewSource(dataDir)
+}
+
+// expectEncryptionKey sets up the controller key-encryption key used to
+// wrap the model's secret data key.
+func (s *serviceSuite) expectEncryptionKey() {
+	var kek secretbackend.EncryptionKey
+	s.secretBackendState.EXPECT().EnsureActiveSecretEncryptionKey(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, candidate secretbackend.EncryptionKey) (secretbackend.EncryptionKey, error) {
+			kek = candidate
+			return candidate, nil
+		})
+	s.secretBackendState.EXPECT().GetSecretEncryptionKey(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(context.Context, string) (secretbackend.EncryptionKey, error) {
+			return kek, nil
+		}).AnyTimes()
+	s.secretBackendState.EXPECT().AddSecretEncryptionKeyReference(gomock.Any(), gomock.Any(), s.modelUUID, gomock.Any())
+}
+
 func (s *serviceSuite) setupMocks(c *tc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 	s.secretBackendState = secret.NewMockSecretBackendState(ctrl)
@@ -112,6 +162,7 @@ func (s *serviceSuite) setupMocks(c *tc.C) *gomock.Controller {
 			return s.ModelTxnRunner(c, s.modelUUID.String()), nil
 		}, loggertesting.WrapCheckLog(c)),
 		s.secretBackendState,
+		encryptionKeySource(c),
 		nil,
 		loggertesting.WrapCheckLog(c),
 	)
@@ -0,0 +1,130 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"time"
+
+	"github.com/canonical/sqlair"
+
+	coresecrets "github.com/juju/juju/core/secrets"
+	domainsecret "github.com/juju/juju/domain/secret"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/uuid"
+)
+
+// RecordSecretAccess records a read of the content of a secret revision.
+func (st State) RecordSecretAccess(
+	ctx context.Context, uri *coresecrets.URI, entry domainsecret.AccessLogEntry,
+) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	entryUUID, err := uuid.NewUUID()
+	if err != nil {
+		return errors.Capture(err)
+	}
+	row := secretAccessLog{
+		UUID:           entryUUID.String(),
+		SecretID:       uri.ID,
+		Revision:       entry.Revision,
+		AccessorTypeID: int(entry.AccessorType),
+		AccessorID:     entry.AccessorID,
+		AccessTime:     entry.AccessTime.UTC(),
+	}
+	insertStmt, err := st.Prepare(`
+INSERT INTO secret_access_log (*)
+VALUES ($secretAccessLog.*)`, row)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		return errors.Capture(tx.Query(ctx, insertStmt, row).Run())
+	})
+	if err != nil {
+		return errors.Errorf("recording access to secret %q: %w", uri.ID, err)
+	}
+	return nil
+}
+
+// PruneSecretAccessLog deletes the entries of the secret access log, for any
+// secret, recorded before the given time.
+func (st State) PruneSecretAccessLog(ctx context.Context, before time.Time) error {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	cutoff := accessLogCutoff{Cutoff: before.UTC()}
+	stmt, err := st.Prepare(`
+DELETE FROM secret_access_log
+WHERE  access_time < $accessLogCutoff.cutoff`, cutoff)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		return errors.Capture(tx.Query(ctx, stmt, cutoff).Run())
+	})
+	if err != nil {
+		return errors.Errorf("pruning secret access log: %w", err)
+	}
+	return nil
+}
+
+// ListSecretAccessLog returns the recorded reads of the content of the
+// secret, oldest first. If revision is not nil, only reads of that
+// revision are returned.
+func (st State) ListSecretAccessLog(
+	ctx context.Context, uri *coresecrets.URI, revision *int,
+) ([]domainsecret.AccessLogEntry, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	query := `
+SELECT &secretAccessLog.*
+FROM   secret_access_log
+WHERE  secret_id = $secretAccessLog.secret_id`
+	arg := secretAccessLog{SecretID: uri.ID}
+	if revision != nil {
+		query += `
+AND    revision = $secretAccessLog.revision`
+		arg.Revision = *revision
+	}
+	query += `
+ORDER BY access_time, revision`
+	stmt, err := st.Prepare(query, arg)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var rows []secretAccessLog
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, arg).GetAll(&rows)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return nil, errors.Errorf("listing access log for secret %q: %w", uri.ID, err)
+	}
+
+	result := make([]domainsecret.AccessLogEntry, len(rows))
+	for i, r := range rows {
+		result[i] = domainsecret.AccessLogEntry{
+			Revision:     r.Revision,
+			AccessorType: domainsecret.AccessorType(r.AccessorTypeID),
+			AccessorID:   r.AccessorID,
+			AccessTime:   r.AccessTime,
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,165 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/canonical/sqlair"
+
+	domainsecret "github.com/juju/juju/domain/secret"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// EnsureActiveSecretDataKey returns the active secret data key. If there is
+// no active key, the candidate key is made active and returned.
+func (st State) EnsureActiveSecretDataKey(
+	ctx context.Context, candidate domainsecret.DataKey,
+) (domainsecret.DataKey, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return domainsecret.DataKey{}, errors.Capture(err)
+	}
+
+	selectStmt, err := st.Prepare(`
+SELECT &secretDataKey.*
+FROM   secret_data_key
+WHERE  active = TRUE`, secretDataKey{})
+	if err != nil {
+		return domainsecret.DataKey{}, errors.Capture(err)
+	}
+	insertStmt, err := st.Prepare(`
+INSERT INTO secret_data_key (uuid, encryption_key_uuid, wrapped_key, active)
+VALUES ($secretDataKey.uuid, $secretDataKey.encryption_key_uuid, $secretDataKey.wrapped_key, TRUE)`, secretDataKey{})
+	if err != nil {
+		return domainsecret.DataKey{}, errors.Capture(err)
+	}
+
+	var result secretDataKey
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, selectStmt).Get(&result)
+		if err == nil {
+			return nil
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return errors.Errorf("querying active secret data key: %w", err)
+		}
+		result = fromDataKey(candidate)
+		if err := tx.Query(ctx, insertStmt, result).Run(); err != nil {
+			return errors.Errorf("inserting secret data key: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return domainsecret.DataKey{}, errors.Errorf("ensuring active secret data key: %w", err)
+	}
+	return result.toDataKey()
+}
+
+// GetSecretDataKey returns the secret data key with the given UUID,
+// returning an error satisfying [secreterrors.SecretDataKeyNotFound] if it
+// does not exist.
+func (st State) GetSecretDataKey(ctx context.Context, uuid string) (domainsecret.DataKey, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return domainsecret.DataKey{}, errors.Capture(err)
+	}
+
+	result := secretDataKey{UUID: uuid}
+	stmt, err := st.Prepare(`
+SELECT &secretDataKey.*
+FROM   secret_data_key
+WHERE  uuid = $secretDataKey.uuid`, result)
+	if err != nil {
+		return domainsecret.DataKey{}, errors.Capture(err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, result).Get(&result)
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.Errorf("%w: %q", secreterrors.SecretDataKeyNotFound, uuid)
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return domainsecret.DataKey{}, errors.Errorf("getting secret data key %q: %w", uuid, err)
+	}
+	return result.toDataKey()
+}
+
+// ListSecretDataKeys returns all of the secret data keys in the model.
+func (st State) ListSecretDataKeys(ctx context.Context) ([]domainsecret.DataKey, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	stmt, err := st.Prepare(`
+SELECT &secretDataKey.*
+FROM   secret_data_key`, secretDataKey{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var rows []secretDataKey
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt).GetAll(&rows)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return nil, errors.Errorf("listing secret data keys: %w", err)
+	}
+
+	result := make([]domainsecret.DataKey, len(rows))
+	for i, row := range rows {
+		if result[i], err = row.toDataKey(); err != nil {
+			return nil, errors.Capture(err)
+		}
+	}
+	return result, nil
+}
+
+// UpdateSecretDataKeys replaces the wrapped key material of the given secret
+// data keys, in a single transaction.
+func (st State) UpdateSecretDataKeys(ctx context.Context, keys []domainsecret.DataKey) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	db, err := st.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	stmt, err := st.Prepare(`
+UPDATE secret_data_key
+SET    encryption_key_uuid = $secretDataKey.encryption_key_uuid,
+       wrapped_key = $secretDataKey.wrapped_key
+WHERE  uuid = $secretDataKey.uuid`, secretDataKey{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		for _, key := range keys {
+			var outcome sqlair.Outcome
+			if err := tx.Query(ctx, stmt, fromDataKey(key)).Get(&outcome); err != nil {
+				return errors.Errorf("updating secret data key %q: %w", key.UUID, err)
+			}
+			if n, err := outcome.Result().RowsAffected(); err != nil {
+				return errors.Capture(err)
+			} else if n == 0 {
+				return errors.Errorf("%w: %q", secreterrors.SecretDataKeyNotFound, key.UUID)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Errorf("updating secret data keys: %w", err)
+	}
+	return nil
+}
@@ -4433,3 +4433,139 @@ func (s *stateSuite) TestInitialWatchStatementForSecretMatadataGetForUnitOwners(
 		uri2.ID,
 	})
 }
+
+func (s *stateSuite) TestEnsureActiveSecretDataKey(c *tc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+
+	first := domainsecret.DataKey{
+		UUID:              uuid.MustNewUUID().String(),
+		EncryptionKeyUUID: "kek-1",
+		WrappedKey:        []byte("wrapped-1"),
+	}
+	key, err := st.EnsureActiveSecretDataKey(c.Context(), first)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(key, tc.DeepEquals, first)
+
+	// The active key is returned in preference to the candidate.
+	second := domainsecret.DataKey{
+		UUID:              uuid.MustNewUUID().String(),
+		EncryptionKeyUUID: "kek-1",
+		WrappedKey:        []byte("wrapped-2"),
+	}
+	key, err = st.EnsureActiveSecretDataKey(c.Context(), second)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(key, tc.DeepEquals, first)
+
+	key, err = st.GetSecretDataKey(c.Context(), first.UUID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(key, tc.DeepEquals, first)
+}
+
+func (s *stateSuite) TestGetSecretDataKeyNotFound(c *tc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+
+	_, err := st.GetSecretDataKey(c.Context(), uuid.MustNewUUID().String())
+	c.Assert(err, tc.ErrorIs, secreterrors.SecretDataKeyNotFound)
+}
+
+func (s *stateSuite) TestUpdateSecretDataKeys(c *tc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+
+	key := domainsecret.DataKey{
+		UUID:              uuid.MustNewUUID().String(),
+		EncryptionKeyUUID: "kek-1",
+		WrappedKey:        []byte("wrapped-1"),
+	}
+	_, err := st.EnsureActiveSecretDataKey(c.Context(), key)
+	c.Assert(err, tc.ErrorIsNil)
+
+	key.EncryptionKeyUUID = "kek-2"
+	key.WrappedKey = []byte("wrapped-2")
+	err = st.UpdateSecretDataKeys(c.Context(), []domainsecret.DataKey{key})
+	c.Assert(err, tc.ErrorIsNil)
+
+	keys, err := st.ListSecretDataKeys(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(keys, tc.DeepEquals, []domainsecret.DataKey{key})
+
+	err = st.UpdateSecretDataKeys(c.Context(), []domainsecret.DataKey{{
+		UUID:              uuid.MustNewUUID().String(),
+		EncryptionKeyUUID: "kek-2",
+		WrappedKey:        []byte("wrapped-3"),
+	}})
+	c.Assert(err, tc.ErrorIs, secreterrors.SecretDataKeyNotFound)
+}
+
+func (s *stateSuite) TestSecretAccessLog(c *tc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+
+	uri := coresecrets.NewURI()
+	other := coresecrets.NewURI()
+	now := time.Now().UTC().Truncate(time.Second)
+	entries := []domainsecret.AccessLogEntry{{
+		Revision:     1,
+		AccessorType: domainsecret.AccessorUnit,
+		AccessorID:   "mysql/0",
+		AccessTime:   now.Add(-2 * time.Hour),
+	}, {
+		Revision:     2,
+		AccessorType: domainsecret.AccessorUser,
+		AccessorID:   "admin",
+		AccessTime:   now.Add(-time.Hour),
+	}, {
+		Revision:     1,
+		AccessorType: domainsecret.AccessorUnit,
+		AccessorID:   "mysql/1",
+		AccessTime:   now,
+	}}
+	for _, e := range entries {
+		err := st.RecordSecretAccess(c.Context(), uri, e)
+		c.Assert(err, tc.ErrorIsNil)
+	}
+	err := st.RecordSecretAccess(c.Context(), other, entries[0])
+	c.Assert(err, tc.ErrorIsNil)
+
+	result, err := st.ListSecretAccessLog(c.Context(), uri, nil)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, entries)
+
+	rev := 1
+	result, err = st.ListSecretAccessLog(c.Context(), uri, &rev)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, []domainsecret.AccessLogEntry{entries[0], entries[2]})
+
+	result, err = st.ListSecretAccessLog(c.Context(), coresecrets.NewURI(), nil)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.HasLen, 0)
+}
+
+func (s *stateSuite) TestSecretAccessLogPruned(c *tc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+
+	uri := coresecrets.NewURI()
+	now := time.Now().UTC().Truncate(time.Second)
+	old := domainsecret.AccessLogEntry{
+		Revision:     1,
+		AccessorType: domainsecret.AccessorUnit,
+		AccessorID:   "mysql/0",
+		AccessTime:   now.Add(-48 * time.Hour),
+	}
+	err := st.RecordSecretAccess(c.Context(), uri, old)
+	c.Assert(err, tc.ErrorIsNil)
+
+	recent := domainsecret.AccessLogEntry{
+		Revision:     1,
+		AccessorType: domainsecret.AccessorUnit,
+		AccessorID:   "mysql/1",
+		AccessTime:   now,
+	}
+	err = st.RecordSecretAccess(c.Context(), uri, recent)
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = st.PruneSecretAccessLog(c.Context(), now.Add(-24*time.Hour))
+	c.Assert(err, tc.ErrorIsNil)
+
+	result, err := st.ListSecretAccessLog(c.Context(), uri, nil)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, []domainsecret.AccessLogEntry{recent})
+}
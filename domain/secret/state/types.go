@@ -4,6 +4,7 @@
 package state
 
 import (
+	"encoding/base64"
 	"fmt"
 	"time"
 
@@ -502,3 +503,46 @@ type count struct {
 func getRevisionID(secretID string, revision int) string {
 	return fmt.Sprintf("%s/%d", secretID, revision)
 }
+
+// secretDataKey represents a single row from the secret_data_key table.
+type secretDataKey struct {
+	UUID              string `db:"uuid"`
+	EncryptionKeyUUID string `db:"encryption_key_uuid"`
+	WrappedKey        string `db:"wrapped_key"`
+	Active            bool   `db:"active"`
+}
+
+func (k secretDataKey) toDataKey() (domainsecret.DataKey, error) {
+	wrapped, err := base64.StdEncoding.DecodeString(k.WrappedKey)
+	if err != nil {
+		return domainsecret.DataKey{}, errors.Errorf("decoding secret data key %q: %w", k.UUID, err)
+	}
+	return domainsecret.DataKey{
+		UUID:              k.UUID,
+		EncryptionKeyUUID: k.EncryptionKeyUUID,
+		WrappedKey:        wrapped,
+	}, nil
+}
+
+func fromDataKey(k domainsecret.DataKey) secretDataKey {
+	return secretDataKey{
+		UUID:              k.UUID,
+		EncryptionKeyUUID: k.EncryptionKeyUUID,
+		WrappedKey:        base64.StdEncoding.EncodeToString(k.WrappedKey),
+	}
+}
+
+// secretAccessLog represents a single row from the secret_access_log table.
+type secretAccessLog struct {
+	UUID           string    `db:"uuid"`
+	SecretID       string    `db:"secret_id"`
+	Revision       int       `db:"revision"`
+	AccessorTypeID int       `db:"accessor_type_id"`
+	AccessorID     string    `db:"accessor_id"`
+	AccessTime     time.Time `db:"access_time"`
+}
+
+// accessLogCutoff is used to prune the secret access log.
+type accessLogCutoff struct {
+	Cutoff time.Time `db:"cutoff"`
+}
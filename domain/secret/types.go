@@ -134,3 +134,15 @@ type RemoteSecretInfo struct {
 	CurrentRevision int
 	LatestRevision  int
 }
+
+// DataKey is a model data key, used to encrypt secret content stored in
+// the internal backend.
+type DataKey struct {
+	// UUID is the unique identifier for the data key.
+	UUID string
+	// EncryptionKeyUUID identifies the controller key-encryption key
+	// that wraps the data key.
+	EncryptionKeyUUID string
+	// WrappedKey is the data key, encrypted by the key-encryption key.
+	WrappedKey []byte
+}
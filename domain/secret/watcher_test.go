@@ -1014,7 +1014,7 @@ func (s *watcherSuite) setupServiceAndState(c *tc.C) (*service.WatchableService,
 		changestream.NewWatchableDBFactoryForNamespace(s.GetWatchableDB, "secret_revision"),
 		logger,
 	)
-	return service.NewWatchableService(st, nil, nil, factory, logger), st
+	return service.NewWatchableService(st, nil, nil, nil, factory, logger), st
 }
 
 func revID(uri *coresecrets.URI, rev int) string {
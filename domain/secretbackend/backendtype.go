@@ -6,6 +6,7 @@ package secretbackend
 import (
 	coreerrors "github.com/juju/juju/core/errors"
 	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/secrets/provider/awssecretsmanager"
 	"github.com/juju/juju/internal/secrets/provider/juju"
 	"github.com/juju/juju/internal/secrets/provider/kubernetes"
 	"github.com/juju/juju/internal/secrets/provider/vault"
@@ -19,6 +20,7 @@ const (
 	BackendTypeController BackendType = iota
 	BackendTypeKubernetes
 	BackendTypeVault
+	BackendTypeAWSSecretsManager
 )
 
 // MarshallBackendType converts a secret backend type to a db backend type id.
@@ -30,6 +32,8 @@ func MarshallBackendType(backendType string) (BackendType, error) {
 		return BackendTypeKubernetes, nil
 	case vault.BackendType:
 		return BackendTypeVault, nil
+	case awssecretsmanager.BackendType:
+		return BackendTypeAWSSecretsManager, nil
 	}
 	return 0, errors.Errorf("secret backend type %q %w", backendType, coreerrors.NotValid)
 }
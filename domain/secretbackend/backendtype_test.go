@@ -9,6 +9,7 @@ import (
 	"github.com/juju/tc"
 
 	schematesting "github.com/juju/juju/domain/schema/testing"
+	"github.com/juju/juju/internal/secrets/provider/awssecretsmanager"
 	"github.com/juju/juju/internal/secrets/provider/juju"
 	"github.com/juju/juju/internal/secrets/provider/kubernetes"
 	"github.com/juju/juju/internal/secrets/provider/vault"
@@ -41,8 +42,9 @@ func (s *backendtypeSuite) TestBackendTypeDBValues(c *tc.C) {
 		dbValues[BackendType(id)] = value
 	}
 	c.Assert(dbValues, tc.DeepEquals, map[BackendType]string{
-		BackendTypeController: juju.BackendType,
-		BackendTypeKubernetes: kubernetes.BackendType,
-		BackendTypeVault:      vault.BackendType,
+		BackendTypeController:        juju.BackendType,
+		BackendTypeKubernetes:        kubernetes.BackendType,
+		BackendTypeVault:             vault.BackendType,
+		BackendTypeAWSSecretsManager: awssecretsmanager.BackendType,
 	})
 }
@@ -26,4 +26,8 @@ const (
 
 	// NotSupported describes an error that occurs when the secret backend is not supported.
 	NotSupported = errors.ConstError("secret backend not supported")
+
+	// EncryptionKeyNotFound describes an error that occurs when the secret
+	// encryption key being operated on does not exist.
+	EncryptionKeyNotFound = errors.ConstError("secret encryption key not found")
 )
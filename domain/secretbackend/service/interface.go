@@ -31,6 +31,9 @@ type State interface {
 
 	GetInternalAndActiveBackendUUIDs(ctx context.Context, modelUUID coremodel.UUID) (string, string, error)
 
+	RotateSecretEncryptionKey(ctx context.Context, key secretbackend.EncryptionKey) error
+	DeleteRetiredSecretEncryptionKeys(ctx context.Context) error
+
 	InitialWatchStatementForSecretBackendRotationChanges() (string, string)
 	GetSecretBackendRotateChanges(ctx context.Context, backendIDs ...string) ([]watcher.SecretBackendRotateChange, error)
 	NamespaceForWatchModelSecretBackend() string
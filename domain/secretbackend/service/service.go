@@ -31,6 +31,7 @@ import (
 	"github.com/juju/juju/internal/secrets/provider"
 	"github.com/juju/juju/internal/secrets/provider/juju"
 	"github.com/juju/juju/internal/secrets/provider/kubernetes"
+	"github.com/juju/juju/internal/uuid"
 )
 
 // SecretProviderRegistry is a function that returns a secret backend provider for the given backend type.
@@ -634,6 +635,37 @@ func (s *Service) RotateBackendToken(ctx context.Context, backendID string) erro
 	return errors.Capture(err)
 }
 
+// RotateSecretEncryptionKey replaces the controller key used to wrap the
+// model data keys which encrypt secret content in the internal backend.
+// The retired key is kept so that content remains readable; once no model
+// data key is wrapped by it, [Service.DeleteRetiredSecretEncryptionKeys]
+// removes it.
+func (s *Service) RotateSecretEncryptionKey(ctx context.Context) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	// The key material is derived from the controller's root key and the
+	// new key's UUID, so only the UUID is recorded.
+	keyUUID, err := uuid.NewUUID()
+	if err != nil {
+		return errors.Capture(err)
+	}
+	return s.st.RotateSecretEncryptionKey(ctx, secretbackend.EncryptionKey{
+		UUID: keyUUID.String(),
+	})
+}
+
+// DeleteRetiredSecretEncryptionKeys deletes the secret encryption keys
+// replaced by [Service.RotateSecretEncryptionKey] which no model data key
+// is wrapped by any more. Retired keys still wrapping a data key, in any
+// model, are kept until that data key has been re-wrapped.
+func (s *Service) DeleteRetiredSecretEncryptionKeys(ctx context.Context) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	return s.st.DeleteRetiredSecretEncryptionKeys(ctx)
+}
+
 // GetRevisionsToDrain looks at the supplied revisions and returns any which should be
 // drained to a different backend for the specified model.
 func (s *Service) GetRevisionsToDrain(ctx context.Context, modelUUID coremodel.UUID, revs []coresecrets.SecretExternalRevision) ([]RevisionInfo, error) {
@@ -1306,6 +1306,28 @@ func (s *serviceSuite) TestDeleteSecretBackend(c *tc.C) {
 	c.Assert(err, tc.ErrorIsNil)
 }
 
+func (s *serviceSuite) TestRotateSecretEncryptionKey(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	svc := newService(s.mockState, s.logger, s.clock, nil)
+
+	s.mockState.EXPECT().RotateSecretEncryptionKey(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, key secretbackend.EncryptionKey) error {
+			c.Check(key.UUID, tc.Not(tc.Equals), "")
+			return nil
+		})
+	err := svc.RotateSecretEncryptionKey(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestDeleteRetiredSecretEncryptionKeys(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+	svc := newService(s.mockState, s.logger, s.clock, nil)
+
+	s.mockState.EXPECT().DeleteRetiredSecretEncryptionKeys(gomock.Any()).Return(nil)
+	err := svc.DeleteRetiredSecretEncryptionKeys(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+}
+
 func (s *serviceSuite) TestRotateBackendToken(c *tc.C) {
 	ctrl := s.setupMocks(c)
 	defer ctrl.Finish()
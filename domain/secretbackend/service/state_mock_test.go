@@ -82,6 +82,44 @@ func (c *MockStateCreateSecretBackendCall) DoAndReturn(f func(context.Context, s
 	return c
 }
 
+// DeleteRetiredSecretEncryptionKeys mocks base method.
+func (m *MockState) DeleteRetiredSecretEncryptionKeys(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRetiredSecretEncryptionKeys", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRetiredSecretEncryptionKeys indicates an expected call of DeleteRetiredSecretEncryptionKeys.
+func (mr *MockStateMockRecorder) DeleteRetiredSecretEncryptionKeys(arg0 any) *MockStateDeleteRetiredSecretEncryptionKeysCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRetiredSecretEncryptionKeys", reflect.TypeOf((*MockState)(nil).DeleteRetiredSecretEncryptionKeys), arg0)
+	return &MockStateDeleteRetiredSecretEncryptionKeysCall{Call: call}
+}
+
+// MockStateDeleteRetiredSecretEncryptionKeysCall wrap *gomock.Call
+type MockStateDeleteRetiredSecretEncryptionKeysCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateDeleteRetiredSecretEncryptionKeysCall) Return(arg0 error) *MockStateDeleteRetiredSecretEncryptionKeysCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateDeleteRetiredSecretEncryptionKeysCall) Do(f func(context.Context) error) *MockStateDeleteRetiredSecretEncryptionKeysCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateDeleteRetiredSecretEncryptionKeysCall) DoAndReturn(f func(context.Context) error) *MockStateDeleteRetiredSecretEncryptionKeysCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // DeleteSecretBackend mocks base method.
 func (m *MockState) DeleteSecretBackend(arg0 context.Context, arg1 secretbackend.BackendIdentifier, arg2 bool) error {
 	m.ctrl.T.Helper()
@@ -515,6 +553,44 @@ func (c *MockStateNamespaceForWatchModelSecretBackendCall) DoAndReturn(f func()
 	return c
 }
 
+// RotateSecretEncryptionKey mocks base method.
+func (m *MockState) RotateSecretEncryptionKey(arg0 context.Context, arg1 secretbackend.EncryptionKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateSecretEncryptionKey", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RotateSecretEncryptionKey indicates an expected call of RotateSecretEncryptionKey.
+func (mr *MockStateMockRecorder) RotateSecretEncryptionKey(arg0, arg1 any) *MockStateRotateSecretEncryptionKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateSecretEncryptionKey", reflect.TypeOf((*MockState)(nil).RotateSecretEncryptionKey), arg0, arg1)
+	return &MockStateRotateSecretEncryptionKeyCall{Call: call}
+}
+
+// MockStateRotateSecretEncryptionKeyCall wrap *gomock.Call
+type MockStateRotateSecretEncryptionKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateRotateSecretEncryptionKeyCall) Return(arg0 error) *MockStateRotateSecretEncryptionKeyCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateRotateSecretEncryptionKeyCall) Do(f func(context.Context, secretbackend.EncryptionKey) error) *MockStateRotateSecretEncryptionKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateRotateSecretEncryptionKeyCall) DoAndReturn(f func(context.Context, secretbackend.EncryptionKey) error) *MockStateRotateSecretEncryptionKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SecretBackendRotated mocks base method.
 func (m *MockState) SecretBackendRotated(arg0 context.Context, arg1 string, arg2 time.Time) error {
 	m.ctrl.T.Helper()
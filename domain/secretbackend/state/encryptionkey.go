@@ -0,0 +1,253 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/canonical/sqlair"
+
+	coremodel "github.com/juju/juju/core/model"
+	modelerrors "github.com/juju/juju/domain/model/errors"
+	"github.com/juju/juju/domain/secretbackend"
+	secretbackenderrors "github.com/juju/juju/domain/secretbackend/errors"
+	"github.com/juju/juju/internal/database"
+	"github.com/juju/juju/internal/errors"
+)
+
+// EnsureActiveSecretEncryptionKey returns the active secret encryption key.
+// If there is no active key, the candidate key is made active and returned.
+func (s *State) EnsureActiveSecretEncryptionKey(
+	ctx context.Context, candidate secretbackend.EncryptionKey,
+) (secretbackend.EncryptionKey, error) {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return secretbackend.EncryptionKey{}, errors.Capture(err)
+	}
+
+	selectStmt, err := s.Prepare(`
+SELECT &encryptionKey.*
+FROM   secret_encryption_key
+WHERE  active = TRUE`, encryptionKey{})
+	if err != nil {
+		return secretbackend.EncryptionKey{}, errors.Capture(err)
+	}
+	insertStmt, err := s.Prepare(`
+INSERT INTO secret_encryption_key (uuid, active)
+VALUES ($encryptionKey.uuid, TRUE)`, encryptionKey{})
+	if err != nil {
+		return secretbackend.EncryptionKey{}, errors.Capture(err)
+	}
+
+	var result encryptionKey
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, selectStmt).Get(&result)
+		if err == nil {
+			return nil
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return errors.Errorf("querying active secret encryption key: %w", err)
+		}
+		result = encryptionKey{UUID: candidate.UUID}
+		if err := tx.Query(ctx, insertStmt, result).Run(); err != nil {
+			return errors.Errorf("inserting secret encryption key: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return secretbackend.EncryptionKey{}, errors.Errorf("ensuring active secret encryption key: %w", err)
+	}
+	return result.toEncryptionKey(), nil
+}
+
+// GetSecretEncryptionKey returns the secret encryption key with the given
+// UUID, returning an error satisfying
+// [secretbackenderrors.EncryptionKeyNotFound] if it does not exist.
+func (s *State) GetSecretEncryptionKey(ctx context.Context, uuid string) (secretbackend.EncryptionKey, error) {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return secretbackend.EncryptionKey{}, errors.Capture(err)
+	}
+
+	result := encryptionKey{UUID: uuid}
+	stmt, err := s.Prepare(`
+SELECT &encryptionKey.*
+FROM   secret_encryption_key
+WHERE  uuid = $encryptionKey.uuid`, result)
+	if err != nil {
+		return secretbackend.EncryptionKey{}, errors.Capture(err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, result).Get(&result)
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.Errorf("%w: %q", secretbackenderrors.EncryptionKeyNotFound, uuid)
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return secretbackend.EncryptionKey{}, errors.Errorf("getting secret encryption key %q: %w", uuid, err)
+	}
+	return result.toEncryptionKey(), nil
+}
+
+// RotateSecretEncryptionKey makes the given key the active secret encryption
+// key. The previously active key is retired but kept, so that data keys
+// wrapped by it can still be unwrapped until they are re-wrapped.
+func (s *State) RotateSecretEncryptionKey(ctx context.Context, key secretbackend.EncryptionKey) error {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	retireStmt, err := s.Prepare(`
+UPDATE secret_encryption_key
+SET    active = FALSE
+WHERE  active = TRUE`)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	insertStmt, err := s.Prepare(`
+INSERT INTO secret_encryption_key (uuid, active)
+VALUES ($encryptionKey.uuid, TRUE)`, encryptionKey{})
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	row := encryptionKey{UUID: key.UUID}
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := tx.Query(ctx, retireStmt).Run(); err != nil {
+			return errors.Errorf("retiring active secret encryption key: %w", err)
+		}
+		if err := tx.Query(ctx, insertStmt, row).Run(); err != nil {
+			return errors.Errorf("inserting secret encryption key: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Errorf("rotating secret encryption key: %w", err)
+	}
+	return nil
+}
+
+// AddSecretEncryptionKeyReference records that the given data key of the
+// model is, or is about to be, wrapped by the key-encryption key with the
+// given UUID. A key with a reference is never deleted. An error satisfying
+// [secretbackenderrors.EncryptionKeyNotFound] is returned if the key does
+// not exist.
+func (s *State) AddSecretEncryptionKeyReference(
+	ctx context.Context, keyUUID string, modelID coremodel.UUID, dataKeyUUID string,
+) error {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	ref := encryptionKeyReference{
+		EncryptionKeyUUID: keyUUID,
+		ModelUUID:         modelID.String(),
+		DataKeyUUID:       dataKeyUUID,
+	}
+	keyStmt, err := s.Prepare(`
+SELECT &encryptionKey.uuid
+FROM   secret_encryption_key
+WHERE  uuid = $encryptionKeyReference.encryption_key_uuid`, encryptionKey{}, ref)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	insertStmt, err := s.Prepare(`
+INSERT INTO secret_encryption_key_reference (*)
+VALUES ($encryptionKeyReference.*)
+ON CONFLICT DO NOTHING`, ref)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var key encryptionKey
+		err := tx.Query(ctx, keyStmt, ref).Get(&key)
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.Errorf("%w: %q", secretbackenderrors.EncryptionKeyNotFound, keyUUID)
+		} else if err != nil {
+			return errors.Capture(err)
+		}
+		err = tx.Query(ctx, insertStmt, ref).Run()
+		if database.IsErrConstraintForeignKey(err) {
+			return errors.Errorf("%w: model %q", modelerrors.NotFound, modelID)
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return errors.Errorf(
+			"adding secret encryption key reference for data key %q: %w", dataKeyUUID, err,
+		)
+	}
+	return nil
+}
+
+// PruneSecretEncryptionKeyReferences removes the references of the given
+// data key of the model to all key-encryption keys other than the one with
+// the given UUID. An empty key UUID removes all of the data key's
+// references.
+func (s *State) PruneSecretEncryptionKeyReferences(
+	ctx context.Context, modelID coremodel.UUID, dataKeyUUID string, keyUUID string,
+) error {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	ref := encryptionKeyReference{
+		EncryptionKeyUUID: keyUUID,
+		ModelUUID:         modelID.String(),
+		DataKeyUUID:       dataKeyUUID,
+	}
+	stmt, err := s.Prepare(`
+DELETE FROM secret_encryption_key_reference
+WHERE  model_uuid = $encryptionKeyReference.model_uuid
+AND    data_key_uuid = $encryptionKeyReference.data_key_uuid
+AND    encryption_key_uuid != $encryptionKeyReference.encryption_key_uuid`, ref)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		return errors.Capture(tx.Query(ctx, stmt, ref).Run())
+	})
+	if err != nil {
+		return errors.Errorf(
+			"pruning secret encryption key references for data key %q: %w", dataKeyUUID, err,
+		)
+	}
+	return nil
+}
+
+// DeleteRetiredSecretEncryptionKeys deletes the secret encryption keys
+// which are no longer active and which no data key in any model still
+// references. Retired keys that are still referenced are kept.
+func (s *State) DeleteRetiredSecretEncryptionKeys(ctx context.Context) error {
+	db, err := s.DB(ctx)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	stmt, err := s.Prepare(`
+DELETE FROM secret_encryption_key
+WHERE  active = FALSE
+AND    uuid NOT IN (
+    SELECT encryption_key_uuid
+    FROM   secret_encryption_key_reference
+)`)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		return errors.Capture(tx.Query(ctx, stmt).Run())
+	})
+	if err != nil {
+		return errors.Errorf("deleting retired secret encryption keys: %w", err)
+	}
+	return nil
+}
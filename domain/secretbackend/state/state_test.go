@@ -1843,3 +1843,112 @@ func (s *stateSuite) TestGetSecretBackendRotateChanges(c *tc.C) {
 	c.Assert(changes[1].Name, tc.Equals, "my-backend2")
 	c.Assert(changes[1].NextTriggerTime.Equal(nextRotateTime2), tc.IsTrue)
 }
+
+func (s *stateSuite) TestEnsureActiveSecretEncryptionKey(c *tc.C) {
+	first := secretbackend.EncryptionKey{UUID: uuid.MustNewUUID().String()}
+	key, err := s.state.EnsureActiveSecretEncryptionKey(c.Context(), first)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(key, tc.DeepEquals, first)
+
+	// The active key is returned in preference to the candidate.
+	second := secretbackend.EncryptionKey{UUID: uuid.MustNewUUID().String()}
+	key, err = s.state.EnsureActiveSecretEncryptionKey(c.Context(), second)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(key, tc.DeepEquals, first)
+}
+
+func (s *stateSuite) TestGetSecretEncryptionKeyNotFound(c *tc.C) {
+	_, err := s.state.GetSecretEncryptionKey(c.Context(), uuid.MustNewUUID().String())
+	c.Assert(err, tc.ErrorIs, backenderrors.EncryptionKeyNotFound)
+}
+
+func (s *stateSuite) TestRotateSecretEncryptionKey(c *tc.C) {
+	first := secretbackend.EncryptionKey{UUID: uuid.MustNewUUID().String()}
+	_, err := s.state.EnsureActiveSecretEncryptionKey(c.Context(), first)
+	c.Assert(err, tc.ErrorIsNil)
+
+	second := secretbackend.EncryptionKey{UUID: uuid.MustNewUUID().String()}
+	err = s.state.RotateSecretEncryptionKey(c.Context(), second)
+	c.Assert(err, tc.ErrorIsNil)
+
+	key, err := s.state.EnsureActiveSecretEncryptionKey(c.Context(), first)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(key, tc.DeepEquals, second)
+
+	// The retired key is kept until it is explicitly deleted.
+	key, err = s.state.GetSecretEncryptionKey(c.Context(), first.UUID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(key, tc.DeepEquals, first)
+
+	err = s.state.DeleteRetiredSecretEncryptionKeys(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = s.state.GetSecretEncryptionKey(c.Context(), first.UUID)
+	c.Check(err, tc.ErrorIs, backenderrors.EncryptionKeyNotFound)
+	key, err = s.state.GetSecretEncryptionKey(c.Context(), second.UUID)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(key, tc.DeepEquals, second)
+}
+
+func (s *stateSuite) TestDeleteRetiredSecretEncryptionKeysKeepsReferenced(c *tc.C) {
+	modelUUID := s.createModel(c, coremodel.IAAS)
+	first := secretbackend.EncryptionKey{UUID: uuid.MustNewUUID().String()}
+	_, err := s.state.EnsureActiveSecretEncryptionKey(c.Context(), first)
+	c.Assert(err, tc.ErrorIsNil)
+	dataKeyUUID := uuid.MustNewUUID().String()
+	err = s.state.AddSecretEncryptionKeyReference(c.Context(), first.UUID, modelUUID, dataKeyUUID)
+	c.Assert(err, tc.ErrorIsNil)
+
+	second := secretbackend.EncryptionKey{UUID: uuid.MustNewUUID().String()}
+	err = s.state.RotateSecretEncryptionKey(c.Context(), second)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// A data key is still wrapped by the retired key, so it is kept.
+	err = s.state.DeleteRetiredSecretEncryptionKeys(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = s.state.GetSecretEncryptionKey(c.Context(), first.UUID)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Once the data key is re-wrapped, the retired key can go.
+	err = s.state.AddSecretEncryptionKeyReference(c.Context(), second.UUID, modelUUID, dataKeyUUID)
+	c.Assert(err, tc.ErrorIsNil)
+	err = s.state.PruneSecretEncryptionKeyReferences(c.Context(), modelUUID, dataKeyUUID, second.UUID)
+	c.Assert(err, tc.ErrorIsNil)
+	err = s.state.DeleteRetiredSecretEncryptionKeys(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = s.state.GetSecretEncryptionKey(c.Context(), first.UUID)
+	c.Check(err, tc.ErrorIs, backenderrors.EncryptionKeyNotFound)
+	_, err = s.state.GetSecretEncryptionKey(c.Context(), second.UUID)
+	c.Check(err, tc.ErrorIsNil)
+}
+
+func (s *stateSuite) TestAddSecretEncryptionKeyReferenceIdempotent(c *tc.C) {
+	modelUUID := s.createModel(c, coremodel.IAAS)
+	key := secretbackend.EncryptionKey{UUID: uuid.MustNewUUID().String()}
+	_, err := s.state.EnsureActiveSecretEncryptionKey(c.Context(), key)
+	c.Assert(err, tc.ErrorIsNil)
+
+	dataKeyUUID := uuid.MustNewUUID().String()
+	err = s.state.AddSecretEncryptionKeyReference(c.Context(), key.UUID, modelUUID, dataKeyUUID)
+	c.Assert(err, tc.ErrorIsNil)
+	err = s.state.AddSecretEncryptionKeyReference(c.Context(), key.UUID, modelUUID, dataKeyUUID)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *stateSuite) TestAddSecretEncryptionKeyReferenceKeyNotFound(c *tc.C) {
+	modelUUID := s.createModel(c, coremodel.IAAS)
+	err := s.state.AddSecretEncryptionKeyReference(
+		c.Context(), uuid.MustNewUUID().String(), modelUUID, uuid.MustNewUUID().String(),
+	)
+	c.Assert(err, tc.ErrorIs, backenderrors.EncryptionKeyNotFound)
+}
+
+func (s *stateSuite) TestAddSecretEncryptionKeyReferenceModelNotFound(c *tc.C) {
+	key := secretbackend.EncryptionKey{UUID: uuid.MustNewUUID().String()}
+	_, err := s.state.EnsureActiveSecretEncryptionKey(c.Context(), key)
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = s.state.AddSecretEncryptionKeyReference(
+		c.Context(), key.UUID, coremodel.UUID(uuid.MustNewUUID().String()), uuid.MustNewUUID().String(),
+	)
+	c.Assert(err, tc.ErrorIs, modelerrors.NotFound)
+}
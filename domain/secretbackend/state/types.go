@@ -401,3 +401,26 @@ type Count struct {
 	// Num is the number of rows.
 	Num int `db:"num"`
 }
+
+// encryptionKey represents a single row from the secret_encryption_key table.
+type encryptionKey struct {
+	// UUID is the unique identifier for the key.
+	UUID string `db:"uuid"`
+	// Active is true if the key wraps new data keys.
+	Active bool `db:"active"`
+}
+
+func (k encryptionKey) toEncryptionKey() secretbackend.EncryptionKey {
+	return secretbackend.EncryptionKey{UUID: k.UUID}
+}
+
+// encryptionKeyReference represents a single row from the
+// secret_encryption_key_reference table.
+type encryptionKeyReference struct {
+	// EncryptionKeyUUID is the UUID of the referenced key-encryption key.
+	EncryptionKeyUUID string `db:"encryption_key_uuid"`
+	// ModelUUID is the UUID of the model holding the data key.
+	ModelUUID string `db:"model_uuid"`
+	// DataKeyUUID is the UUID of the data key wrapped by the key.
+	DataKeyUUID string `db:"data_key_uuid"`
+}
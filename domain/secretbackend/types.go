@@ -22,3 +22,12 @@ type ModelSecretBackend struct {
 	// SecretBackendName is the name of the secret backend configured for the model.
 	SecretBackendName string
 }
+
+// EncryptionKey is a controller key-encryption key, which wraps the model
+// data keys that encrypt secret content stored in the internal backend.
+// Only the key's identity is recorded; the key material is derived from
+// the controller's root key, which is kept outside of the database.
+type EncryptionKey struct {
+	// UUID is the unique identifier for the key.
+	UUID string
+}
@@ -110,6 +110,13 @@ type PublicKeyImporter interface {
 	FetchPublicKeysForSubject(context.Context, *url.URL) ([]string, error)
 }
 
+// EncryptionKeySource describes a source of the controller's secret
+// key-encryption keys, which are kept outside of the database.
+type EncryptionKeySource interface {
+	// EncryptionKey returns the key-encryption key with the given UUID.
+	EncryptionKey(ctx context.Context, keyUUID string) ([]byte, error)
+}
+
 // ModelServices provides access to the services required by the apiserver.
 type ModelServices struct {
 	modelServiceFactoryBase
@@ -119,6 +126,7 @@ type ModelServices struct {
 	modelObjectStoreGetter objectstore.ModelObjectStoreGetter
 	storageRegistry        corestorage.ModelStorageRegistryGetter
 	publicKeyImporter      PublicKeyImporter
+	encryptionKeySource    EncryptionKeySource
 	leaseManager           lease.ModelLeaseManagerGetter
 	logDir                 string
 	clock                  clock.Clock
@@ -134,6 +142,7 @@ func NewModelServices(
 	modelObjectStoreGetter objectstore.ModelObjectStoreGetter,
 	storageRegistry corestorage.ModelStorageRegistryGetter,
 	publicKeyImporter PublicKeyImporter,
+	encryptionKeySource EncryptionKeySource,
 	leaseManager lease.ModelLeaseManagerGetter,
 	logDir string,
 	clock clock.Clock,
@@ -152,6 +161,7 @@ func NewModelServices(
 		modelObjectStoreGetter: modelObjectStoreGetter,
 		storageRegistry:        storageRegistry,
 		publicKeyImporter:      publicKeyImporter,
+		encryptionKeySource:    encryptionKeySource,
 		leaseManager:           leaseManager,
 		logDir:                 logDir,
 		clock:                  clock,
@@ -257,9 +267,9 @@ func (s *ModelServices) Application() *applicationservice.WatchableService {
 }
 
 // Status returns the application status service.
-func (s *ModelServices) Status() *statusservice.LeadershipService {
+func (s *ModelServices) Status() *statusservice.WatchableService {
 	logger := s.logger.Child("status")
-	return statusservice.NewLeadershipService(
+	return statusservice.NewWatchableService(
 		statusstate.NewModelState(changestream.NewTxnRunnerFactory(s.modelDB), s.clock, logger),
 		statusstate.NewControllerState(changestream.NewTxnRunnerFactory(s.controllerDB), s.modelUUID),
 		domain.NewLeaseService(s.leaseManager),
@@ -269,6 +279,7 @@ func (s *ModelServices) Status() *statusservice.LeadershipService {
 			logsink := filepath.Join(s.logDir, "logsink.log")
 			return domain.NewStatusHistoryReader(logsink, s.modelUUID)
 		},
+		s.modelWatcherFactory("status"),
 		s.clock,
 		logger,
 	)
@@ -360,6 +371,7 @@ func (s *ModelServices) Secret() *secretservice.WatchableService {
 	return secretservice.NewWatchableService(
 		secretstate.NewState(changestream.NewTxnRunnerFactory(s.modelDB), log),
 		secretbackendstate.NewState(changestream.NewTxnRunnerFactory(s.controllerDB), log),
+		s.encryptionKeySource,
 		domain.NewLeaseService(s.leaseManager),
 		s.modelWatcherFactory("secret"),
 		log,
@@ -370,9 +382,14 @@ func (s *ModelServices) Secret() *secretservice.WatchableService {
 // operations.
 func (s *ModelServices) ModelMigration() *modelmigrationservice.Service {
 	return modelmigrationservice.NewService(
+		s.modelUUID,
+		modelmigrationstate.NewControllerState(changestream.NewTxnRunnerFactory(s.controllerDB)),
+		modelmigrationstate.New(changestream.NewTxnRunnerFactory(s.modelDB)),
 		providertracker.ProviderRunner[modelmigrationservice.InstanceProvider](s.providerFactory, s.modelUUID.String()),
 		providertracker.ProviderRunner[modelmigrationservice.ResourceProvider](s.providerFactory, s.modelUUID.String()),
-		modelmigrationstate.New(changestream.NewTxnRunnerFactory(s.modelDB)),
+		s.controllerWatcherFactory("modelmigration"),
+		s.clock,
+		s.logger.Child("modelmigration"),
 	)
 }
 
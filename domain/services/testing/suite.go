@@ -46,6 +46,7 @@ import (
 	"github.com/juju/juju/internal/errors"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 	_ "github.com/juju/juju/internal/provider/dummy"
+	"github.com/juju/juju/internal/secrets/encryptionkey"
 	"github.com/juju/juju/internal/services"
 	sshimporter "github.com/juju/juju/internal/ssh/importer"
 	"github.com/juju/juju/internal/storage"
@@ -266,6 +267,13 @@ type domainServices struct {
 // DomainServicesGetterWithStorageRegistry interface to use in tests with the
 // additional storage provider.
 func (s *DomainServicesSuite) DomainServicesGetterWithStorageRegistry(c *tc.C, objectStore objectstore.ObjectStore, leaseManager lease.LeaseManager, storageRegistry storage.ProviderRegistry) DomainServicesGetterFunc {
+	// All the services share the same secret encryption root key, as they
+	// would on a controller.
+	dataDir := c.MkDir()
+	err := encryptionkey.EnsureRootKey(dataDir)
+	c.Assert(err, tc.ErrorIsNil)
+	encryptionKeySource := encryptionkey.NewSource(dataDir)
+
 	return func(modelUUID model.UUID) services.DomainServices {
 		clock := clock.WallClock
 		logger := loggertesting.WrapCheckLog(c)
@@ -294,6 +302,7 @@ func (s *DomainServicesSuite) DomainServicesGetterWithStorageRegistry(c *tc.C, o
 				return storageRegistry, nil
 			}),
 			sshimporter.NewImporter(&http.Client{}),
+			encryptionKeySource,
 			modelApplicationLeaseManagerGetter(func() lease.LeaseManager {
 				return leaseManager
 			}),
@@ -0,0 +1,147 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"path"
+	"slices"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/machine"
+	"github.com/juju/juju/core/trace"
+	"github.com/juju/juju/core/unit"
+	"github.com/juju/juju/domain/status"
+	"github.com/juju/juju/internal/errors"
+)
+
+// ResolveStatusPatterns resolves the given status patterns into a filter of
+// the applications, units and machines that should be included in the status
+// output. Patterns use shell glob syntax and can name an application, a unit,
+// a machine or a container:
+//   - an application pattern includes all of the application's units;
+//   - a unit pattern includes the unit;
+//   - a machine pattern includes the machine, its containers and any units
+//     deployed to them.
+//
+// The principal and subordinate units of an included unit are also included,
+// along with the applications of every included unit and the machines (and
+// their hosts) that the included units are deployed to.
+//
+// If a pattern is malformed, an error satisfying [coreerrors.NotValid] is
+// returned.
+func (s *Service) ResolveStatusPatterns(ctx context.Context, patterns []string) (StatusFilter, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return StatusFilter{}, errors.Errorf("status pattern %q", pattern).Add(coreerrors.NotValid)
+		}
+	}
+
+	entities, err := s.modelState.GetStatusEntities(ctx)
+	if err != nil {
+		return StatusFilter{}, errors.Capture(err)
+	}
+	return resolveStatusFilter(entities, patterns), nil
+}
+
+func resolveStatusFilter(entities status.StatusEntities, patterns []string) StatusFilter {
+	matches := func(name string) bool {
+		for _, pattern := range patterns {
+			// The patterns have already been validated.
+			if ok, _ := path.Match(pattern, name); ok {
+				return true
+			}
+		}
+		return false
+	}
+
+	// Machines match either directly, or through their host so that a pattern
+	// for a machine includes its containers.
+	machines := make(map[machine.Name]struct{})
+	for _, name := range entities.Machines {
+		if matches(name.String()) || matches(name.Parent().String()) {
+			machines[name] = struct{}{}
+		}
+	}
+
+	apps := make(map[string]struct{})
+	for _, name := range entities.Applications {
+		if matches(name) {
+			apps[name] = struct{}{}
+		}
+	}
+
+	units := make(map[unit.Name]struct{})
+	for _, u := range entities.Units {
+		if _, ok := apps[u.ApplicationName]; ok {
+			units[u.Name] = struct{}{}
+			continue
+		}
+		if matches(u.Name.String()) {
+			units[u.Name] = struct{}{}
+			continue
+		}
+		if u.MachineName == nil {
+			continue
+		}
+		if _, ok := machines[*u.MachineName]; ok {
+			units[u.Name] = struct{}{}
+		}
+	}
+
+	// Include the principal of every included subordinate, then the
+	// subordinates of every included principal. Subordinates are deployed
+	// alongside their principal, so a single pass in each direction is
+	// enough.
+	for _, u := range entities.Units {
+		if _, ok := units[u.Name]; ok && u.PrincipalName != nil {
+			units[*u.PrincipalName] = struct{}{}
+		}
+	}
+	for _, u := range entities.Units {
+		if u.PrincipalName == nil {
+			continue
+		}
+		if _, ok := units[*u.PrincipalName]; ok {
+			units[u.Name] = struct{}{}
+		}
+	}
+
+	// Every included unit brings in its application and the machine it is
+	// deployed to, along with that machine's host.
+	for _, u := range entities.Units {
+		if _, ok := units[u.Name]; !ok {
+			continue
+		}
+		apps[u.ApplicationName] = struct{}{}
+		if u.MachineName != nil {
+			machines[*u.MachineName] = struct{}{}
+		}
+	}
+	for name := range machines {
+		machines[name.Parent()] = struct{}{}
+	}
+
+	filter := StatusFilter{
+		Applications: make([]string, 0, len(apps)),
+		Units:        make([]unit.Name, 0, len(units)),
+		Machines:     make([]machine.Name, 0, len(machines)),
+	}
+	for name := range apps {
+		filter.Applications = append(filter.Applications, name)
+	}
+	for name := range units {
+		filter.Units = append(filter.Units, name)
+	}
+	for name := range machines {
+		filter.Machines = append(filter.Machines, name)
+	}
+	slices.Sort(filter.Applications)
+	slices.Sort(filter.Units)
+	slices.Sort(filter.Machines)
+	return filter
+}
@@ -0,0 +1,205 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"testing"
+
+	"github.com/juju/clock"
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/machine"
+	coreunit "github.com/juju/juju/core/unit"
+	"github.com/juju/juju/domain/status"
+	"github.com/juju/juju/internal/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+type filterSuite struct {
+	modelState *MockModelState
+
+	modelService *Service
+}
+
+func TestFilterSuite(t *testing.T) {
+	tc.Run(t, &filterSuite{})
+}
+
+func (s *filterSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.modelState = NewMockModelState(ctrl)
+	s.modelService = NewService(
+		s.modelState,
+		NewMockControllerState(ctrl),
+		&statusHistoryRecorder{},
+		func() (StatusHistoryReader, error) {
+			return nil, errors.Errorf("status history reader not available")
+		},
+		clock.WallClock,
+		loggertesting.WrapCheckLog(c),
+	)
+
+	c.Cleanup(func() {
+		s.modelState = nil
+		s.modelService = nil
+	})
+
+	return ctrl
+}
+
+// entities returns a model with a principal application (mysql) deployed to
+// two machines, one of them in a container, with a subordinate (logging)
+// attached to each unit, and a second application (wordpress) on its own
+// machine.
+func (s *filterSuite) entities() status.StatusEntities {
+	return status.StatusEntities{
+		Applications: []string{"logging", "mysql", "wordpress"},
+		Units: []status.UnitEntity{{
+			Name:            "logging/0",
+			ApplicationName: "logging",
+			MachineName:     ptr(machine.Name("0")),
+			PrincipalName:   ptr(coreunit.Name("mysql/0")),
+		}, {
+			Name:            "logging/1",
+			ApplicationName: "logging",
+			MachineName:     ptr(machine.Name("1/lxd/0")),
+			PrincipalName:   ptr(coreunit.Name("mysql/1")),
+		}, {
+			Name:            "mysql/0",
+			ApplicationName: "mysql",
+			MachineName:     ptr(machine.Name("0")),
+		}, {
+			Name:            "mysql/1",
+			ApplicationName: "mysql",
+			MachineName:     ptr(machine.Name("1/lxd/0")),
+		}, {
+			Name:            "wordpress/0",
+			ApplicationName: "wordpress",
+			MachineName:     ptr(machine.Name("2")),
+		}},
+		Machines: []machine.Name{"0", "1", "1/lxd/0", "2"},
+	}
+}
+
+func (s *filterSuite) resolve(c *tc.C, patterns ...string) StatusFilter {
+	s.modelState.EXPECT().GetStatusEntities(gomock.Any()).Return(s.entities(), nil)
+
+	filter, err := s.modelService.ResolveStatusPatterns(c.Context(), patterns)
+	c.Assert(err, tc.ErrorIsNil)
+	return filter
+}
+
+func (s *filterSuite) TestResolveApplication(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	c.Check(s.resolve(c, "wordpress"), tc.DeepEquals, StatusFilter{
+		Applications: []string{"wordpress"},
+		Units:        []coreunit.Name{"wordpress/0"},
+		Machines:     []machine.Name{"2"},
+	})
+}
+
+func (s *filterSuite) TestResolveUnitIncludesSubordinates(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	c.Check(s.resolve(c, "mysql/1"), tc.DeepEquals, StatusFilter{
+		Applications: []string{"logging", "mysql"},
+		Units:        []coreunit.Name{"logging/1", "mysql/1"},
+		Machines:     []machine.Name{"1", "1/lxd/0"},
+	})
+}
+
+func (s *filterSuite) TestResolveSubordinateIncludesPrincipal(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	c.Check(s.resolve(c, "logging/0"), tc.DeepEquals, StatusFilter{
+		Applications: []string{"logging", "mysql"},
+		Units:        []coreunit.Name{"logging/0", "mysql/0"},
+		Machines:     []machine.Name{"0"},
+	})
+}
+
+func (s *filterSuite) TestResolveMachineIncludesContainers(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	c.Check(s.resolve(c, "1"), tc.DeepEquals, StatusFilter{
+		Applications: []string{"logging", "mysql"},
+		Units:        []coreunit.Name{"logging/1", "mysql/1"},
+		Machines:     []machine.Name{"1", "1/lxd/0"},
+	})
+}
+
+func (s *filterSuite) TestResolveContainer(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	c.Check(s.resolve(c, "1/lxd/*"), tc.DeepEquals, StatusFilter{
+		Applications: []string{"logging", "mysql"},
+		Units:        []coreunit.Name{"logging/1", "mysql/1"},
+		Machines:     []machine.Name{"1", "1/lxd/0"},
+	})
+}
+
+func (s *filterSuite) TestResolveWildcard(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	c.Check(s.resolve(c, "word*", "2"), tc.DeepEquals, StatusFilter{
+		Applications: []string{"wordpress"},
+		Units:        []coreunit.Name{"wordpress/0"},
+		Machines:     []machine.Name{"2"},
+	})
+	c.Check(s.resolve(c, "*"), tc.DeepEquals, StatusFilter{
+		Applications: []string{"logging", "mysql", "wordpress"},
+		Units:        []coreunit.Name{"logging/0", "logging/1", "mysql/0", "mysql/1", "wordpress/0"},
+		Machines:     []machine.Name{"0", "1", "1/lxd/0", "2"},
+	})
+}
+
+func (s *filterSuite) TestResolveNoMatch(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	c.Check(s.resolve(c, "postgresql"), tc.DeepEquals, StatusFilter{
+		Applications: []string{},
+		Units:        []coreunit.Name{},
+		Machines:     []machine.Name{},
+	})
+}
+
+func (s *filterSuite) TestResolveInvalidPattern(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.modelService.ResolveStatusPatterns(c.Context(), []string{"mysql/["})
+	c.Check(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *filterSuite) TestGetApplicationAndUnitStatusesForFilter(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.modelState.EXPECT().GetApplicationAndUnitStatusesForNames(
+		gomock.Any(), []string{"mysql"}, []string{"mysql/0"},
+	).Return(map[string]status.Application{}, nil)
+
+	statuses, err := s.modelService.GetApplicationAndUnitStatusesForFilter(c.Context(), StatusFilter{
+		Applications: []string{"mysql"},
+		Units:        []coreunit.Name{"mysql/0"},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(statuses, tc.HasLen, 0)
+}
+
+func (s *filterSuite) TestGetMachineFullStatusesForFilter(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.modelState.EXPECT().GetMachineFullStatusesForNames(
+		gomock.Any(), []string{"0", "0/lxd/0"},
+	).Return(map[machine.Name]status.Machine{}, nil)
+
+	statuses, err := s.modelService.GetMachineFullStatusesForFilter(c.Context(), StatusFilter{
+		Machines: []machine.Name{"0", "0/lxd/0"},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(statuses, tc.HasLen, 0)
+}
@@ -395,6 +395,45 @@ func (c *MockModelStateGetApplicationAndUnitStatusesCall) DoAndReturn(f func(con
 	return c
 }
 
+// GetApplicationAndUnitStatusesForNames mocks base method.
+func (m *MockModelState) GetApplicationAndUnitStatusesForNames(ctx context.Context, appNames, unitNames []string) (map[string]status.Application, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationAndUnitStatusesForNames", ctx, appNames, unitNames)
+	ret0, _ := ret[0].(map[string]status.Application)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationAndUnitStatusesForNames indicates an expected call of GetApplicationAndUnitStatusesForNames.
+func (mr *MockModelStateMockRecorder) GetApplicationAndUnitStatusesForNames(ctx, appNames, unitNames any) *MockModelStateGetApplicationAndUnitStatusesForNamesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationAndUnitStatusesForNames", reflect.TypeOf((*MockModelState)(nil).GetApplicationAndUnitStatusesForNames), ctx, appNames, unitNames)
+	return &MockModelStateGetApplicationAndUnitStatusesForNamesCall{Call: call}
+}
+
+// MockModelStateGetApplicationAndUnitStatusesForNamesCall wrap *gomock.Call
+type MockModelStateGetApplicationAndUnitStatusesForNamesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelStateGetApplicationAndUnitStatusesForNamesCall) Return(arg0 map[string]status.Application, arg1 error) *MockModelStateGetApplicationAndUnitStatusesForNamesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelStateGetApplicationAndUnitStatusesForNamesCall) Do(f func(context.Context, []string, []string) (map[string]status.Application, error)) *MockModelStateGetApplicationAndUnitStatusesForNamesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelStateGetApplicationAndUnitStatusesForNamesCall) DoAndReturn(f func(context.Context, []string, []string) (map[string]status.Application, error)) *MockModelStateGetApplicationAndUnitStatusesForNamesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetApplicationIDAndNameByUnitName mocks base method.
 func (m *MockModelState) GetApplicationIDAndNameByUnitName(ctx context.Context, name unit.Name) (application.ID, string, error) {
 	m.ctrl.T.Helper()
@@ -708,6 +747,45 @@ func (c *MockModelStateGetMachineFullStatusesCall) DoAndReturn(f func(context.Co
 	return c
 }
 
+// GetMachineFullStatusesForNames mocks base method.
+func (m *MockModelState) GetMachineFullStatusesForNames(ctx context.Context, names []string) (map[machine.Name]status.Machine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMachineFullStatusesForNames", ctx, names)
+	ret0, _ := ret[0].(map[machine.Name]status.Machine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMachineFullStatusesForNames indicates an expected call of GetMachineFullStatusesForNames.
+func (mr *MockModelStateMockRecorder) GetMachineFullStatusesForNames(ctx, names any) *MockModelStateGetMachineFullStatusesForNamesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMachineFullStatusesForNames", reflect.TypeOf((*MockModelState)(nil).GetMachineFullStatusesForNames), ctx, names)
+	return &MockModelStateGetMachineFullStatusesForNamesCall{Call: call}
+}
+
+// MockModelStateGetMachineFullStatusesForNamesCall wrap *gomock.Call
+type MockModelStateGetMachineFullStatusesForNamesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelStateGetMachineFullStatusesForNamesCall) Return(arg0 map[machine.Name]status.Machine, arg1 error) *MockModelStateGetMachineFullStatusesForNamesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelStateGetMachineFullStatusesForNamesCall) Do(f func(context.Context, []string) (map[machine.Name]status.Machine, error)) *MockModelStateGetMachineFullStatusesForNamesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelStateGetMachineFullStatusesForNamesCall) DoAndReturn(f func(context.Context, []string) (map[machine.Name]status.Machine, error)) *MockModelStateGetMachineFullStatusesForNamesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetMachineStatus mocks base method.
 func (m *MockModelState) GetMachineStatus(ctx context.Context, machineName string) (status.StatusInfo[status.MachineStatusType], error) {
 	m.ctrl.T.Helper()
@@ -825,6 +903,45 @@ func (c *MockModelStateGetRelationUUIDByIDCall) DoAndReturn(f func(context.Conte
 	return c
 }
 
+// GetStatusEntities mocks base method.
+func (m *MockModelState) GetStatusEntities(ctx context.Context) (status.StatusEntities, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatusEntities", ctx)
+	ret0, _ := ret[0].(status.StatusEntities)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatusEntities indicates an expected call of GetStatusEntities.
+func (mr *MockModelStateMockRecorder) GetStatusEntities(ctx any) *MockModelStateGetStatusEntitiesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatusEntities", reflect.TypeOf((*MockModelState)(nil).GetStatusEntities), ctx)
+	return &MockModelStateGetStatusEntitiesCall{Call: call}
+}
+
+// MockModelStateGetStatusEntitiesCall wrap *gomock.Call
+type MockModelStateGetStatusEntitiesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelStateGetStatusEntitiesCall) Return(arg0 status.StatusEntities, arg1 error) *MockModelStateGetStatusEntitiesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelStateGetStatusEntitiesCall) Do(f func(context.Context) (status.StatusEntities, error)) *MockModelStateGetStatusEntitiesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelStateGetStatusEntitiesCall) DoAndReturn(f func(context.Context) (status.StatusEntities, error)) *MockModelStateGetStatusEntitiesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetStorageInstanceAttachments mocks base method.
 func (m *MockModelState) GetStorageInstanceAttachments(ctx context.Context) ([]status.StorageAttachment, error) {
 	m.ctrl.T.Helper()
@@ -1292,6 +1409,44 @@ func (c *MockModelStateImportRelationStatusCall) DoAndReturn(f func(context.Cont
 	return c
 }
 
+// NamespacesForWatchModelEntities mocks base method.
+func (m *MockModelState) NamespacesForWatchModelEntities() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NamespacesForWatchModelEntities")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// NamespacesForWatchModelEntities indicates an expected call of NamespacesForWatchModelEntities.
+func (mr *MockModelStateMockRecorder) NamespacesForWatchModelEntities() *MockModelStateNamespacesForWatchModelEntitiesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NamespacesForWatchModelEntities", reflect.TypeOf((*MockModelState)(nil).NamespacesForWatchModelEntities))
+	return &MockModelStateNamespacesForWatchModelEntitiesCall{Call: call}
+}
+
+// MockModelStateNamespacesForWatchModelEntitiesCall wrap *gomock.Call
+type MockModelStateNamespacesForWatchModelEntitiesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelStateNamespacesForWatchModelEntitiesCall) Return(arg0 []string) *MockModelStateNamespacesForWatchModelEntitiesCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelStateNamespacesForWatchModelEntitiesCall) Do(f func() []string) *MockModelStateNamespacesForWatchModelEntitiesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelStateNamespacesForWatchModelEntitiesCall) DoAndReturn(f func() []string) *MockModelStateNamespacesForWatchModelEntitiesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SetApplicationStatus mocks base method.
 func (m *MockModelState) SetApplicationStatus(ctx context.Context, applicationID application.ID, status status.StatusInfo[status.WorkloadStatusType]) error {
 	m.ctrl.T.Helper()
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/juju/clock"
+	"github.com/juju/collections/transform"
 
 	coreapplication "github.com/juju/juju/core/application"
 	"github.com/juju/juju/core/logger"
@@ -173,6 +174,19 @@ type ModelState interface {
 	// applications in the model, indexed by application name.
 	GetApplicationAndUnitStatuses(ctx context.Context) (map[string]status.Application, error)
 
+	// GetApplicationAndUnitStatusesForNames returns the application and unit
+	// statuses of the named applications and units, indexed by application
+	// name.
+	GetApplicationAndUnitStatusesForNames(ctx context.Context, appNames, unitNames []string) (map[string]status.Application, error)
+
+	// GetStatusEntities returns the names of all the applications, units and
+	// machines in the model.
+	GetStatusEntities(ctx context.Context) (status.StatusEntities, error)
+
+	// NamespacesForWatchModelEntities returns the namespaces that hold the
+	// entities reported by the model status.
+	NamespacesForWatchModelEntities() []string
+
 	// GetApplicationAndUnitModelStatuses returns the application name and unit
 	// count for each model for the model status request.
 	GetApplicationAndUnitModelStatuses(ctx context.Context) (map[string]int, error)
@@ -196,6 +210,10 @@ type ModelState interface {
 	// indexed by machine name.
 	GetMachineFullStatuses(context.Context) (map[machine.Name]status.Machine, error)
 
+	// GetMachineFullStatusesForNames returns the machine statuses of the named
+	// machines, indexed by machine name.
+	GetMachineFullStatusesForNames(ctx context.Context, names []string) (map[machine.Name]status.Machine, error)
+
 	// GetInstanceStatus returns the cloud specific instance status for the
 	// given machine.
 	// This method may return the following errors:
@@ -586,7 +604,28 @@ func (s *Service) GetApplicationAndUnitStatuses(ctx context.Context) (map[string
 	if err != nil {
 		return nil, errors.Capture(err)
 	}
+	return s.decodeApplicationStatuses(ctx, statuses)
+}
 
+// GetApplicationAndUnitStatusesForFilter returns the application statuses of
+// the applications and units included in the filter, indexed by application
+// name. Only the units included in the filter are returned for each
+// application.
+func (s *Service) GetApplicationAndUnitStatusesForFilter(ctx context.Context, filter StatusFilter) (map[string]Application, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	unitNames := transform.Slice(filter.Units, func(n coreunit.Name) string {
+		return n.String()
+	})
+	statuses, err := s.modelState.GetApplicationAndUnitStatusesForNames(ctx, filter.Applications, unitNames)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return s.decodeApplicationStatuses(ctx, statuses)
+}
+
+func (s *Service) decodeApplicationStatuses(ctx context.Context, statuses map[string]status.Application) (map[string]Application, error) {
 	results := make(map[string]Application, len(statuses))
 	for appName, app := range statuses {
 		decoded, err := s.decodeApplicationStatusDetails(ctx, app)
@@ -633,6 +672,37 @@ func (s *Service) ImportRelationStatus(
 	return s.modelState.ImportRelationStatus(ctx, relationUUID, relationStatus)
 }
 
+// SetRemoteRelationStatus sets the status of the cross model relation with
+// the input UUID. Unlike [LeadershipService.SetRelationStatus], it is not
+// tied to the leader of either application, and is intended for use by
+// controller workers managing cross model relations, such as the firewaller.
+// It can return the following errors:
+//   - [statuserrors.RelationNotFound] if the relation doesn't exist.
+//   - [statuserrors.RelationStatusTransitionNotValid] if the current relation
+//     status cannot transition to the new relation status.
+func (s *Service) SetRemoteRelationStatus(
+	ctx context.Context,
+	relationUUID corerelation.UUID,
+	info corestatus.StatusInfo,
+) error {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	if err := relationUUID.Validate(); err != nil {
+		return errors.Errorf("validating relation UUID: %w", err)
+	}
+	if info.Since == nil || info.Since.IsZero() {
+		return errors.Errorf("invalid time: %v", info.Since)
+	}
+
+	relationStatus, err := encodeRelationStatus(info)
+	if err != nil {
+		return errors.Errorf("encoding relation status: %w", err)
+	}
+
+	return s.modelState.SetRelationStatus(ctx, relationUUID, relationStatus)
+}
+
 // GetInstanceStatus returns the cloud specific instance status for this
 // machine.
 // This method may return the following errors:
@@ -740,7 +810,26 @@ func (s *Service) GetMachineFullStatuses(ctx context.Context) (map[machine.Name]
 	if err != nil {
 		return nil, errors.Capture(err)
 	}
+	return s.decodeMachineStatuses(machineStatuses)
+}
+
+// GetMachineFullStatusesForFilter returns the machine statuses of the machines
+// included in the filter, indexed by machine name.
+func (s *Service) GetMachineFullStatusesForFilter(ctx context.Context, filter StatusFilter) (map[machine.Name]Machine, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	names := transform.Slice(filter.Machines, func(n machine.Name) string {
+		return n.String()
+	})
+	machineStatuses, err := s.modelState.GetMachineFullStatusesForNames(ctx, names)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return s.decodeMachineStatuses(machineStatuses)
+}
 
+func (s *Service) decodeMachineStatuses(machineStatuses map[machine.Name]status.Machine) (map[machine.Name]Machine, error) {
 	result := make(map[machine.Name]Machine, len(machineStatuses))
 	for name, m := range machineStatuses {
 		if err := name.Validate(); err != nil {
@@ -114,6 +114,46 @@ func (s *serviceSuite) TestImportRelationStatus(c *tc.C) {
 	c.Assert(err, tc.ErrorIsNil)
 }
 
+func (s *serviceSuite) TestSetRemoteRelationStatus(c *tc.C) {
+	// Arrange
+	defer s.setupMocks(c).Finish()
+
+	relationUUID := corerelationtesting.GenRelationUUID(c)
+	sts := corestatus.StatusInfo{
+		Status:  corestatus.Error,
+		Message: "message",
+		Since:   ptr(time.Now()),
+	}
+
+	expectedStatus := status.StatusInfo[status.RelationStatusType]{
+		Status:  status.RelationStatusTypeError,
+		Message: sts.Message,
+		Since:   sts.Since,
+	}
+	s.modelState.EXPECT().SetRelationStatus(gomock.Any(), relationUUID, expectedStatus).Return(nil)
+
+	// Act
+	err := s.modelService.SetRemoteRelationStatus(c.Context(), relationUUID, sts)
+
+	// Assert
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestSetRemoteRelationStatusNoTime(c *tc.C) {
+	// Arrange
+	defer s.setupMocks(c).Finish()
+
+	relationUUID := corerelationtesting.GenRelationUUID(c)
+
+	// Act
+	err := s.modelService.SetRemoteRelationStatus(c.Context(), relationUUID, corestatus.StatusInfo{
+		Status: corestatus.Error,
+	})
+
+	// Assert
+	c.Assert(err, tc.ErrorMatches, "invalid time: .*")
+}
+
 func (s *serviceSuite) TestImportRelationServiceError(c *tc.C) {
 	// Arrange
 	defer s.setupMocks(c).Finish()
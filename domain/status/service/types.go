@@ -20,6 +20,14 @@ import (
 	internalcharm "github.com/juju/juju/internal/charm"
 )
 
+// StatusFilter holds the names of the applications, units and machines whose
+// status should be returned.
+type StatusFilter struct {
+	Applications []string
+	Units        []unit.Name
+	Machines     []machine.Name
+}
+
 // Application represents the status of an application.
 type Application struct {
 	Life            life.Value
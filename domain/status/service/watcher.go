@@ -0,0 +1,127 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"sort"
+
+	"github.com/juju/clock"
+
+	"github.com/juju/juju/core/changestream"
+	"github.com/juju/juju/core/database"
+	"github.com/juju/juju/core/leadership"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/core/trace"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/core/watcher/eventsource"
+	"github.com/juju/juju/internal/errors"
+)
+
+// WatcherFactory describes methods for creating watchers that are used by the
+// WatchableService.
+type WatcherFactory interface {
+	// NewNotifyWatcher returns a new watcher that filters changes from the
+	// input base watcher's db/queue.
+	NewNotifyWatcher(
+		ctx context.Context,
+		summary string,
+		filter eventsource.FilterOption,
+		filterOpts ...eventsource.FilterOption,
+	) (watcher.NotifyWatcher, error)
+
+	// NewNamespaceMapperWatcher returns a new watcher that receives changes
+	// from the input base watcher's db/queue. Change-log events will be emitted
+	// only if the filter accepts them, and dispatching the notifications via
+	// the Changes channel, once the mapper has processed them. A filter option
+	// is required, though additional filter options can be provided.
+	NewNamespaceMapperWatcher(
+		ctx context.Context,
+		initialQuery eventsource.NamespaceQuery,
+		summary string,
+		mapper eventsource.Mapper,
+		filterOption eventsource.FilterOption, filterOptions ...eventsource.FilterOption,
+	) (watcher.StringsWatcher, error)
+}
+
+// WatchableService provides the API for working with the statuses of
+// applications and units, along with the ability to create watchers.
+type WatchableService struct {
+	*LeadershipService
+	watcherFactory WatcherFactory
+}
+
+// NewWatchableService returns a new watchable service reference wrapping the
+// input state.
+func NewWatchableService(
+	modelState ModelState,
+	controllerState ControllerState,
+	leaderEnsurer leadership.Ensurer,
+	modelUUID model.UUID,
+	statusHistory StatusHistory,
+	statusHistoryReaderFn StatusHistoryReaderFunc,
+	watcherFactory WatcherFactory,
+	clock clock.Clock,
+	logger logger.Logger,
+) *WatchableService {
+	return &WatchableService{
+		LeadershipService: NewLeadershipService(
+			modelState,
+			controllerState,
+			leaderEnsurer,
+			modelUUID,
+			statusHistory,
+			statusHistoryReaderFn,
+			clock,
+			logger,
+		),
+		watcherFactory: watcherFactory,
+	}
+}
+
+// WatchModelEntityChanges returns a watcher that emits the names of the
+// namespaces in which an application, unit, machine, relation, annotation or
+// remote application offerer of the model, or one of their statuses, has been
+// added, changed or removed. The initial event names every namespace, as the
+// consumer has yet to read any entity.
+func (s *WatchableService) WatchModelEntityChanges(ctx context.Context) (watcher.StringsWatcher, error) {
+	ctx, span := trace.Start(ctx, trace.NameFromFunc())
+	defer span.End()
+
+	namespaces := s.modelState.NamespacesForWatchModelEntities()
+	if len(namespaces) == 0 {
+		return nil, errors.Errorf("no namespaces to watch for model entities")
+	}
+
+	filters := make([]eventsource.FilterOption, len(namespaces))
+	for i, ns := range namespaces {
+		filters[i] = eventsource.NamespaceFilter(ns, changestream.All)
+	}
+	return s.watcherFactory.NewNamespaceMapperWatcher(
+		ctx,
+		func(context.Context, database.TxnRunner) ([]string, error) {
+			return namespaces, nil
+		},
+		"model entity changes watcher",
+		changedNamespaces,
+		filters[0],
+		filters[1:]...,
+	)
+}
+
+// changedNamespaces maps the change events to the sorted names of the
+// namespaces they occurred in.
+func changedNamespaces(_ context.Context, changes []changestream.ChangeEvent) ([]string, error) {
+	seen := make(map[string]bool)
+	var namespaces []string
+	for _, change := range changes {
+		if ns := change.Namespace(); !seen[ns] {
+			seen[ns] = true
+			namespaces = append(namespaces, ns)
+		}
+	}
+	sort.Strings(namespaces)
+	return namespaces, nil
+}
@@ -6,6 +6,7 @@ package state
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"net"
 	"sort"
 	"time"
@@ -1352,6 +1353,28 @@ ON CONFLICT(unit_uuid) DO UPDATE SET
 // GetApplicationAndUnitStatuses returns the application and unit statuses of
 // all the applications in the model, indexed by application name.
 func (st *ModelState) GetApplicationAndUnitStatuses(ctx context.Context) (map[string]status.Application, error) {
+	return st.getApplicationAndUnitStatuses(ctx, nil, nil)
+}
+
+// GetApplicationAndUnitStatusesForNames returns the application and unit
+// statuses of the named applications and units, indexed by application name.
+// Only the named units are included in each application, the remaining units
+// of an application are not loaded.
+func (st *ModelState) GetApplicationAndUnitStatusesForNames(
+	ctx context.Context, appNames, unitNames []string,
+) (map[string]status.Application, error) {
+	if len(appNames) == 0 {
+		return make(map[string]status.Application), nil
+	}
+	return st.getApplicationAndUnitStatuses(ctx, newEntityNames(appNames), newEntityNames(unitNames))
+}
+
+// getApplicationAndUnitStatuses returns the application and unit statuses
+// indexed by application name. A nil set of names matches all the
+// applications or units in the model.
+func (st *ModelState) getApplicationAndUnitStatuses(
+	ctx context.Context, appNames, unitNames entityNames,
+) (map[string]status.Application, error) {
 	db, err := st.DB(ctx)
 	if err != nil {
 		return nil, errors.Capture(err)
@@ -1360,11 +1383,11 @@ func (st *ModelState) GetApplicationAndUnitStatuses(ctx context.Context) (map[st
 	var result map[string]status.Application
 	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
 		var err error
-		if result, err = st.getApplicationsStatuses(ctx, tx); err != nil {
+		if result, err = st.getApplicationsStatuses(ctx, tx, appNames); err != nil {
 			return errors.Errorf("getting application statuses: %w", err)
 		}
 
-		unitStatues, err := st.getUnitsStatuses(ctx, tx)
+		unitStatues, err := st.getUnitsStatuses(ctx, tx, unitNames)
 		if err != nil {
 			return errors.Errorf("getting unit statuses: %w", err)
 		}
@@ -1391,9 +1414,20 @@ func (st *ModelState) GetApplicationAndUnitStatuses(ctx context.Context) (map[st
 	return result, nil
 }
 
-func (st *ModelState) getApplicationsStatuses(ctx context.Context, tx *sqlair.TX) (map[string]status.Application, error) {
-	// Get all the applications.
-	query, err := st.Prepare(`
+func (st *ModelState) getApplicationsStatuses(
+	ctx context.Context, tx *sqlair.TX, names entityNames,
+) (map[string]status.Application, error) {
+	// Get all the applications, or only the named ones if a filter is
+	// supplied.
+	var (
+		filter string
+		args   []any
+	)
+	if names != nil {
+		filter = "WHERE a.name IN ($entityNames[:])"
+		args = append(args, names)
+	}
+	query, err := st.Prepare(fmt.Sprintf(`
 SELECT
 	a.name AS &applicationStatusDetails.name,
 	a.uuid AS &applicationStatusDetails.uuid,
@@ -1433,14 +1467,15 @@ LEFT JOIN k8s_service AS k8s ON k8s.application_uuid = a.uuid
 LEFT JOIN application_scale AS aps ON aps.application_uuid = a.uuid
 LEFT JOIN v_relation_endpoint AS re ON re.application_uuid = a.uuid
 LEFT JOIN application_workload_version AS awv ON awv.application_uuid = a.uuid
+%s
 ORDER BY a.name, re.relation_uuid;
-`, applicationStatusDetails{})
+`, filter), append([]any{applicationStatusDetails{}}, args...)...)
 	if err != nil {
 		return nil, errors.Errorf("preparing application query: %w", err)
 	}
 
 	var appStatuses []applicationStatusDetails
-	if err := tx.Query(ctx, query).GetAll(&appStatuses); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+	if err := tx.Query(ctx, query, args...).GetAll(&appStatuses); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
 		return nil, errors.Capture(err)
 	}
 
@@ -1540,9 +1575,22 @@ ORDER BY a.name, re.relation_uuid;
 	return result, nil
 }
 
-func (st *ModelState) getUnitsStatuses(ctx context.Context, tx *sqlair.TX) (map[string]map[coreunit.Name]status.Unit, error) {
-	// Get all the units.
-	query, err := st.Prepare(`
+func (st *ModelState) getUnitsStatuses(
+	ctx context.Context, tx *sqlair.TX, names entityNames,
+) (map[string]map[coreunit.Name]status.Unit, error) {
+	// Get all the units, or only the named ones if a filter is supplied.
+	var (
+		filter string
+		args   []any
+	)
+	if names != nil {
+		if len(names) == 0 {
+			return make(map[string]map[coreunit.Name]status.Unit), nil
+		}
+		filter = "WHERE u.name IN ($entityNames[:])"
+		args = append(args, names)
+	}
+	query, err := st.Prepare(fmt.Sprintf(`
 WITH unit_subordinate AS (
 	SELECT u.name AS subordinate_name, principal_uuid
 	FROM unit_principal
@@ -1595,14 +1643,15 @@ LEFT JOIN unit AS upu ON up.principal_uuid = upu.uuid
 LEFT JOIN unit_subordinate AS us ON us.principal_uuid = u.uuid
 LEFT JOIN unit_agent_version AS uav ON uav.unit_uuid = u.uuid
 LEFT JOIN unit_workload_version AS awv ON awv.unit_uuid = u.uuid
+%s
 ORDER BY u.name;
-`, unitStatusDetails{})
+`, filter), append([]any{unitStatusDetails{}}, args...)...)
 	if err != nil {
 		return nil, errors.Errorf("preparing unit query: %w", err)
 	}
 
 	var unitStatuses []unitStatusDetails
-	if err := tx.Query(ctx, query).GetAll(&unitStatuses); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+	if err := tx.Query(ctx, query, args...).GetAll(&unitStatuses); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
 		return nil, errors.Capture(err)
 	}
 
@@ -1889,12 +1938,36 @@ JOIN machine AS m ON ms.machine_uuid = m.uuid
 // GetMachineFullStatuses returns all the machine statuses for the model, indexed
 // by machine name.
 func (st *ModelState) GetMachineFullStatuses(ctx context.Context) (map[coremachine.Name]status.Machine, error) {
+	return st.getMachineFullStatuses(ctx, nil)
+}
+
+// GetMachineFullStatusesForNames returns the machine statuses of the named
+// machines, indexed by machine name.
+func (st *ModelState) GetMachineFullStatusesForNames(ctx context.Context, names []string) (map[coremachine.Name]status.Machine, error) {
+	if len(names) == 0 {
+		return make(map[coremachine.Name]status.Machine), nil
+	}
+	return st.getMachineFullStatuses(ctx, newEntityNames(names))
+}
+
+// getMachineFullStatuses returns the machine statuses indexed by machine name.
+// A nil set of names matches all the machines in the model.
+func (st *ModelState) getMachineFullStatuses(ctx context.Context, names entityNames) (map[coremachine.Name]status.Machine, error) {
 	db, err := st.DB(ctx)
 	if err != nil {
 		return nil, errors.Capture(err)
 	}
 
-	stmt, err := st.Prepare(`
+	var (
+		filter string
+		args   []any
+	)
+	if names != nil {
+		filter = "WHERE m.name IN ($entityNames[:])"
+		args = append(args, names)
+	}
+
+	stmt, err := st.Prepare(fmt.Sprintf(`
 SELECT
   m.name AS &machineStatusDetails.name,
   m.uuid AS &machineStatusDetails.uuid,
@@ -1932,7 +2005,10 @@ SELECT
   ct.value AS &machineStatusDetails.constraint_container_type,
   c.virt_type AS &machineStatusDetails.constraint_virt_type,
   c.allocate_public_ip AS &machineStatusDetails.constraint_allocate_public_ip,
-  c.image_id AS &machineStatusDetails.constraint_image_id
+  c.image_id AS &machineStatusDetails.constraint_image_id,
+  c.affinity_group AS &machineStatusDetails.constraint_affinity_group,
+  c.anti_affinity AS &machineStatusDetails.constraint_anti_affinity,
+  c.accelerators AS &machineStatusDetails.constraint_accelerators
 FROM machine AS m
 LEFT JOIN machine_status AS ms ON ms.machine_uuid = m.uuid
 LEFT JOIN machine_platform AS p ON p.machine_uuid = m.uuid
@@ -1941,8 +2017,9 @@ LEFT JOIN machine_cloud_instance_status mcis ON mcis.machine_uuid = m.uuid
 LEFT JOIN availability_zone AS az ON az.uuid = mci.availability_zone_uuid
 LEFT JOIN machine_constraint AS mc ON mc.machine_uuid = m.uuid
 LEFT JOIN "constraint" AS c ON c.uuid = mc.constraint_uuid
-LEFT JOIN container_type AS ct ON c.container_type_id = ct.id;
-`, machineStatusDetails{})
+LEFT JOIN container_type AS ct ON c.container_type_id = ct.id
+%s;
+`, filter), append([]any{machineStatusDetails{}}, args...)...)
 	if err != nil {
 		return nil, errors.Capture(err)
 	}
@@ -1952,7 +2029,7 @@ LEFT JOIN container_type AS ct ON c.container_type_id = ct.id;
 		return nil, errors.Capture(err)
 	}
 
-	addressesStmt, err := st.Prepare(`
+	addressesStmt, err := st.Prepare(fmt.Sprintf(`
 SELECT 
 	m.uuid AS &machineSpaceAddress.machine_uuid,
 	ipa.address_value AS &machineSpaceAddress.address_value,
@@ -1966,7 +2043,8 @@ FROM machine AS m
 JOIN link_layer_device AS lld ON m.net_node_uuid = lld.net_node_uuid
 JOIN v_ip_address_with_names AS ipa ON lld.uuid = ipa.device_uuid
 LEFT JOIN subnet AS sn ON ipa.subnet_uuid = sn.uuid
-`, machineSpaceAddress{})
+%s
+`, filter), append([]any{machineSpaceAddress{}}, args...)...)
 	if err != nil {
 		return nil, errors.Capture(err)
 	}
@@ -1977,7 +2055,7 @@ LEFT JOIN subnet AS sn ON ipa.subnet_uuid = sn.uuid
 		resAddr []machineSpaceAddress
 	)
 	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
-		err := tx.Query(ctx, stmt).GetAll(&res)
+		err := tx.Query(ctx, stmt, args...).GetAll(&res)
 		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
 			return errors.Capture(err)
 		}
@@ -1985,7 +2063,7 @@ LEFT JOIN subnet AS sn ON ipa.subnet_uuid = sn.uuid
 		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
 			return errors.Capture(err)
 		}
-		err = tx.Query(ctx, addressesStmt).GetAll(&resAddr)
+		err = tx.Query(ctx, addressesStmt, args...).GetAll(&resAddr)
 		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
 			return errors.Capture(err)
 		}
@@ -2042,6 +2120,8 @@ LEFT JOIN subnet AS sn ON ipa.subnet_uuid = sn.uuid
 			s.ConstraintVirtType, s.ConstraintInstanceRole,
 			s.ConstraintInstanceType, s.ConstraintContainerType,
 			s.ConstraintAllocatePublicIP, s.ConstraintImageID,
+			s.ConstraintAffinityGroup, s.ConstraintAntiAffinity,
+			s.ConstraintAccelerators,
 		)
 
 		machineAddresses := addresses[s.UUID.String()]
@@ -2083,6 +2163,97 @@ LEFT JOIN subnet AS sn ON ipa.subnet_uuid = sn.uuid
 	return result, nil
 }
 
+// GetStatusEntities returns the names of all the applications, units and
+// machines in the model, along with the application, machine and principal of
+// each unit.
+func (st *ModelState) GetStatusEntities(ctx context.Context) (status.StatusEntities, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return status.StatusEntities{}, errors.Capture(err)
+	}
+
+	appStmt, err := st.Prepare(`
+SELECT name AS &entityName.name
+FROM application
+ORDER BY name;
+`, entityName{})
+	if err != nil {
+		return status.StatusEntities{}, errors.Capture(err)
+	}
+
+	unitStmt, err := st.Prepare(`
+SELECT
+	u.name AS &unitEntityName.name,
+	a.name AS &unitEntityName.application_name,
+	m.name AS &unitEntityName.machine_name,
+	upu.name AS &unitEntityName.principal_name
+FROM unit AS u
+JOIN application AS a ON a.uuid = u.application_uuid
+LEFT JOIN machine AS m ON m.net_node_uuid = u.net_node_uuid
+LEFT JOIN unit_principal AS up ON up.unit_uuid = u.uuid
+LEFT JOIN unit AS upu ON upu.uuid = up.principal_uuid
+ORDER BY u.name;
+`, unitEntityName{})
+	if err != nil {
+		return status.StatusEntities{}, errors.Capture(err)
+	}
+
+	machineStmt, err := st.Prepare(`
+SELECT name AS &entityName.name
+FROM machine
+ORDER BY name;
+`, entityName{})
+	if err != nil {
+		return status.StatusEntities{}, errors.Capture(err)
+	}
+
+	var (
+		apps     []entityName
+		units    []unitEntityName
+		machines []entityName
+	)
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, appStmt).GetAll(&apps)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("getting application names: %w", err)
+		}
+		err = tx.Query(ctx, unitStmt).GetAll(&units)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("getting unit names: %w", err)
+		}
+		err = tx.Query(ctx, machineStmt).GetAll(&machines)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("getting machine names: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return status.StatusEntities{}, errors.Capture(err)
+	}
+
+	result := status.StatusEntities{
+		Applications: transform.Slice(apps, func(a entityName) string {
+			return a.Name
+		}),
+		Units: transform.Slice(units, func(u unitEntityName) status.UnitEntity {
+			entity := status.UnitEntity{
+				Name:            u.Name,
+				ApplicationName: u.ApplicationName,
+			}
+			if u.MachineName.Valid {
+				entity.MachineName = &u.MachineName.V
+			}
+			if u.PrincipalName.Valid {
+				entity.PrincipalName = &u.PrincipalName.V
+			}
+			return entity
+		}),
+		Machines: transform.Slice(machines, func(m entityName) coremachine.Name {
+			return coremachine.Name(m.Name)
+		}),
+	}
+	return result, nil
+}
+
 // SetMachineStatus sets the status of the specified machine.
 // This method may return the following errors:
 // - [machineerrors.MachineNotFound] if the machine does not exist.
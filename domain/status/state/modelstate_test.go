@@ -1264,6 +1264,85 @@ func (s *modelStateSuite) TestGetApplicationAndUnitStatusesNoApplications(c *tc.
 	c.Check(statuses, tc.DeepEquals, map[string]status.Application{})
 }
 
+func (s *modelStateSuite) TestGetApplicationAndUnitStatusesForNames(c *tc.C) {
+	s.createIAASApplication(c, "foo", life.Alive, false, nil,
+		s.createIAASUnitArg(c),
+		s.createIAASUnitArg(c),
+	)
+	s.createIAASApplication(c, "bar", life.Alive, false, nil,
+		s.createIAASUnitArg(c),
+	)
+
+	statuses, err := s.state.GetApplicationAndUnitStatusesForNames(c.Context(), []string{"foo"}, []string{"foo/1"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(statuses, tc.HasLen, 1)
+	app, ok := statuses["foo"]
+	c.Assert(ok, tc.IsTrue)
+	c.Check(app.Units, tc.HasLen, 1)
+	c.Check(app.Units["foo/1"].MachineName, tc.DeepEquals, ptr(coremachine.Name("1")))
+}
+
+func (s *modelStateSuite) TestGetApplicationAndUnitStatusesForNamesNoUnits(c *tc.C) {
+	s.createIAASApplication(c, "foo", life.Alive, false, nil,
+		s.createIAASUnitArg(c),
+	)
+
+	statuses, err := s.state.GetApplicationAndUnitStatusesForNames(c.Context(), []string{"foo"}, nil)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(statuses, tc.HasLen, 1)
+	c.Check(statuses["foo"].Units, tc.HasLen, 0)
+}
+
+func (s *modelStateSuite) TestGetApplicationAndUnitStatusesForNamesNoApplications(c *tc.C) {
+	s.createIAASApplication(c, "foo", life.Alive, false, nil,
+		s.createIAASUnitArg(c),
+	)
+
+	statuses, err := s.state.GetApplicationAndUnitStatusesForNames(c.Context(), nil, []string{"foo/0"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(statuses, tc.HasLen, 0)
+}
+
+func (s *modelStateSuite) TestGetStatusEntities(c *tc.C) {
+	_, units0 := s.createIAASApplication(c, "foo", life.Alive, false, nil,
+		s.createIAASUnitArg(c),
+		s.createIAASUnitArg(c),
+	)
+	_, units1 := s.createIAASApplication(c, "sub", life.Alive, true, nil,
+		s.createIAASUnitArg(c),
+	)
+	s.setApplicationSubordinate(c, units0[0], units1[0])
+
+	entities, err := s.state.GetStatusEntities(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(entities, tc.DeepEquals, status.StatusEntities{
+		Applications: []string{"foo", "sub"},
+		Units: []status.UnitEntity{{
+			Name:            "foo/0",
+			ApplicationName: "foo",
+			MachineName:     ptr(coremachine.Name("0")),
+		}, {
+			Name:            "foo/1",
+			ApplicationName: "foo",
+			MachineName:     ptr(coremachine.Name("1")),
+		}, {
+			Name:            "sub/0",
+			ApplicationName: "sub",
+			MachineName:     ptr(coremachine.Name("2")),
+			PrincipalName:   ptr(coreunit.Name("foo/0")),
+		}},
+		Machines: []coremachine.Name{"0", "1", "2"},
+	})
+}
+
+func (s *modelStateSuite) TestGetStatusEntitiesEmptyModel(c *tc.C) {
+	entities, err := s.state.GetStatusEntities(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(entities.Applications, tc.HasLen, 0)
+	c.Check(entities.Units, tc.HasLen, 0)
+	c.Check(entities.Machines, tc.HasLen, 0)
+}
+
 func (s *modelStateSuite) TestGetApplicationAndUnitStatusesNoAppStatuses(c *tc.C) {
 	appUUID, _ := s.createIAASApplication(c, "foo", life.Alive, false, nil,
 		s.createIAASUnitArg(c),
@@ -2192,6 +2271,25 @@ func (s *modelStateSuite) TestGetMachineFullStatuses(c *tc.C) {
 	})
 }
 
+func (s *modelStateSuite) TestGetMachineFullStatusesForNames(c *tc.C) {
+	uuid0, mName0 := s.createMachine(c)
+	s.createMachine(c)
+
+	statuses, err := s.state.GetMachineFullStatusesForNames(c.Context(), []string{mName0.String()})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(statuses, tc.HasLen, 1)
+	c.Check(statuses[mName0].UUID, tc.Equals, uuid0)
+	c.Check(statuses[mName0].HardwareCharacteristics.Tags, tc.DeepEquals, ptr([]string{"tag1", "tag2"}))
+}
+
+func (s *modelStateSuite) TestGetMachineFullStatusesForNamesNoNames(c *tc.C) {
+	s.createMachine(c)
+
+	statuses, err := s.state.GetMachineFullStatusesForNames(c.Context(), nil)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(statuses, tc.HasLen, 0)
+}
+
 func (s *modelStateSuite) TestGetMachineFullStatusesEmptyModel(c *tc.C) {
 	statuses, err := s.state.GetMachineFullStatuses(c.Context())
 	c.Assert(err, tc.ErrorIsNil)
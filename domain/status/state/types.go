@@ -200,6 +200,30 @@ type unitStatusDetails struct {
 	K8sProviderID     sql.Null[string]           `db:"k8s_provider_id"`
 }
 
+// entityNames is a set of application, unit or machine names used to
+// restrict the status queries to the matching entities.
+type entityNames []string
+
+// newEntityNames returns a non-nil set of entity names, so that an empty set
+// of names matches no entities rather than all of them.
+func newEntityNames(names []string) entityNames {
+	return append(entityNames{}, names...)
+}
+
+// entityName is the name of an application or machine in the model.
+type entityName struct {
+	Name string `db:"name"`
+}
+
+// unitEntityName is the name of a unit along with the names of the entities it
+// is related to.
+type unitEntityName struct {
+	Name            coreunit.Name              `db:"name"`
+	ApplicationName string                     `db:"application_name"`
+	MachineName     sql.Null[coremachine.Name] `db:"machine_name"`
+	PrincipalName   sql.Null[coreunit.Name]    `db:"principal_name"`
+}
+
 // relationStatus represents the status of a relation and the relations ID.
 type relationStatusAndID struct {
 	RelationUUID corerelation.UUID `db:"relation_uuid"`
@@ -425,6 +449,9 @@ type machineStatusDetails struct {
 	ConstraintContainerType    sql.Null[string]          `db:"constraint_container_type"`
 	ConstraintAllocatePublicIP sql.Null[int]             `db:"constraint_allocate_public_ip"`
 	ConstraintImageID          sql.Null[string]          `db:"constraint_image_id"`
+	ConstraintAffinityGroup    sql.Null[string]          `db:"constraint_affinity_group"`
+	ConstraintAntiAffinity     sql.Null[int]             `db:"constraint_anti_affinity"`
+	ConstraintAccelerators     sql.Null[string]          `db:"constraint_accelerators"`
 }
 
 type instanceTag struct {
@@ -498,6 +525,9 @@ func decodeConstraints(
 	containerType sql.Null[string],
 	allocatePublicIP sql.Null[int],
 	imageID sql.Null[string],
+	affinityGroup sql.Null[string],
+	antiAffinity sql.Null[int],
+	accelerators sql.Null[string],
 ) constraints.Constraints {
 	var cons constraints.Constraints
 	if arch.Valid {
@@ -536,5 +566,14 @@ func decodeConstraints(
 	if imageID.Valid {
 		cons.ImageID = ptr(imageID.V)
 	}
+	if affinityGroup.Valid {
+		cons.AffinityGroup = ptr(affinityGroup.V)
+	}
+	if antiAffinity.Valid {
+		cons.AntiAffinity = ptr(antiAffinity.V == 1)
+	}
+	if accelerators.Valid {
+		cons.Accelerators = ptr(accelerators.V)
+	}
 	return cons
 }
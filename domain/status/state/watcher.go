@@ -0,0 +1,35 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+// NamespacesForWatchModelEntities returns the names of the tables that hold
+// the applications, units, machines, relations, annotations and remote
+// application offerers of the model, along with their statuses. A change to
+// any of these tables may alter the entities reported to an all-watcher.
+func (*ModelState) NamespacesForWatchModelEntities() []string {
+	return []string{
+		"application",
+		"application_scale",
+		"application_status",
+		"application_exposed_endpoint_space",
+		"application_exposed_endpoint_cidr",
+		"application_remote_offerer",
+		"unit",
+		"unit_principal",
+		"unit_agent_status",
+		"unit_workload_status",
+		"k8s_pod_status",
+		"machine",
+		"machine_cloud_instance",
+		"machine_status",
+		"machine_cloud_instance_status",
+		"port_range",
+		"relation",
+		"relation_status",
+		"annotation_model",
+		"annotation_application",
+		"annotation_machine",
+		"annotation_unit",
+	}
+}
@@ -54,6 +54,24 @@ type Unit struct {
 	K8sProviderID    *string
 }
 
+// StatusEntities holds the names of the applications, units and machines in
+// the model. It is used to resolve status patterns without loading the full
+// status of every entity.
+type StatusEntities struct {
+	Applications []string
+	Units        []UnitEntity
+	Machines     []machine.Name
+}
+
+// UnitEntity holds the name of a unit and the names of the application,
+// machine and principal unit it is related to.
+type UnitEntity struct {
+	Name            unit.Name
+	ApplicationName string
+	MachineName     *machine.Name
+	PrincipalName   *unit.Name
+}
+
 // Machine represents the status of a machine.
 type Machine struct {
 	UUID                    machine.UUID
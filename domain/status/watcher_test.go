@@ -0,0 +1,130 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package status_test
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"testing"
+
+	"github.com/juju/clock"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/changestream"
+	"github.com/juju/juju/core/database"
+	modeltesting "github.com/juju/juju/core/model/testing"
+	"github.com/juju/juju/core/watcher/watchertest"
+	"github.com/juju/juju/domain"
+	"github.com/juju/juju/domain/deployment"
+	domainmachine "github.com/juju/juju/domain/machine"
+	machinestate "github.com/juju/juju/domain/machine/state"
+	"github.com/juju/juju/domain/status/service"
+	"github.com/juju/juju/domain/status/state"
+	changestreamtesting "github.com/juju/juju/internal/changestream/testing"
+	"github.com/juju/juju/internal/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	coretesting "github.com/juju/juju/internal/testing"
+)
+
+type watcherSuite struct {
+	changestreamtesting.ModelSuite
+
+	svc        *service.WatchableService
+	namespaces []string
+}
+
+func TestWatcherSuite(t *testing.T) {
+	tc.Run(t, &watcherSuite{})
+}
+
+func (s *watcherSuite) SetUpTest(c *tc.C) {
+	s.ModelSuite.SetUpTest(c)
+
+	modelUUID := modeltesting.GenModelUUID(c)
+	err := s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO model (uuid, controller_uuid, name, qualifier, type, cloud, cloud_type)
+			VALUES (?, ?, "test", "prod", "iaas", "test-model", "ec2")
+		`, modelUUID.String(), coretesting.ControllerTag.Id())
+		return err
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	factory := changestream.NewWatchableDBFactoryForNamespace(s.GetWatchableDB, "status")
+	modelState := state.NewModelState(
+		func(ctx context.Context) (database.TxnRunner, error) { return factory(ctx) },
+		clock.WallClock,
+		loggertesting.WrapCheckLog(c),
+	)
+	s.namespaces = modelState.NamespacesForWatchModelEntities()
+	s.svc = service.NewWatchableService(
+		modelState,
+		nil,
+		nil,
+		modelUUID,
+		nil,
+		func() (service.StatusHistoryReader, error) {
+			return nil, errors.Errorf("status history reader not available")
+		},
+		domain.NewWatcherFactory(factory, loggertesting.WrapCheckLog(c)),
+		clock.WallClock,
+		loggertesting.WrapCheckLog(c),
+	)
+}
+
+func (s *watcherSuite) TestWatchModelEntityChanges(c *tc.C) {
+	watcher, err := s.svc.WatchModelEntityChanges(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+
+	harness := watchertest.NewHarness(s, watchertest.NewWatcherC(c, watcher))
+
+	// Adding a machine writes to several of the machine namespaces, which
+	// may be reported over more than one event.
+	machineNamespaces := func(c *tc.C, changes [][]string) bool {
+		var received []string
+		for _, change := range changes {
+			received = append(received, change...)
+		}
+		for _, ns := range received {
+			c.Assert(slices.Contains(s.namespaces, ns), tc.IsTrue, tc.Commentf("unexpected namespace %q", ns))
+		}
+		return slices.Contains(received, "machine")
+	}
+
+	machineSt := machinestate.NewState(s.TxnRunnerFactory(), clock.WallClock, loggertesting.WrapCheckLog(c))
+	harness.AddTest(c, func(c *tc.C) {
+		_, _, err := machineSt.AddMachine(c.Context(), domainmachine.AddMachineArgs{
+			Platform: deployment.Platform{
+				Channel: "24.04",
+				OSType:  deployment.Ubuntu,
+			},
+		})
+		c.Assert(err, tc.ErrorIsNil)
+	}, func(w watchertest.WatcherC[[]string]) {
+		w.Check(machineNamespaces)
+	})
+
+	harness.AddTest(c, func(c *tc.C) {
+		err := s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+			_, err := tx.ExecContext(ctx, `INSERT INTO annotation_model (key, value) VALUES ("foo", "bar")`)
+			return err
+		})
+		c.Assert(err, tc.ErrorIsNil)
+	}, func(w watchertest.WatcherC[[]string]) {
+		w.Check(watchertest.StringSliceAssert("annotation_model"))
+	})
+
+	harness.AddTest(c, func(c *tc.C) {
+		err := s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+			_, err := tx.ExecContext(ctx, `UPDATE machine_status SET message = "hello"`)
+			return err
+		})
+		c.Assert(err, tc.ErrorIsNil)
+	}, func(w watchertest.WatcherC[[]string]) {
+		w.Check(watchertest.StringSliceAssert("machine_status"))
+	})
+
+	harness.Run(c, s.namespaces)
+}
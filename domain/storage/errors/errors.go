@@ -43,4 +43,8 @@ const (
 	// VolumeNotFound describes an error that occurs when the volume being operated
 	// on does not exist.
 	VolumeNotFound = errors.ConstError("volume not found")
+
+	// StorageAlreadyImported describes an error that occurs when the provider
+	// storage being imported is already known to the model.
+	StorageAlreadyImported = errors.ConstError("storage already imported")
 )
@@ -23,4 +23,9 @@ type ImportStorageParams struct {
 
 	// StorageName is the name to assign to the imported storage.
 	StorageName storage.Name
+
+	// Force indicates whether the storage provider should take ownership of
+	// the storage even if it is in use outside of Juju, where the provider
+	// supports it.
+	Force bool
 }
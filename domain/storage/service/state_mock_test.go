@@ -118,18 +118,18 @@ func (c *MockStateDeleteStoragePoolCall) DoAndReturn(f func(context.Context, str
 }
 
 // GetModelDetails mocks base method.
-func (m *MockState) GetModelDetails() (storage0.ModelDetails, error) {
+func (m *MockState) GetModelDetails(arg0 context.Context) (storage0.ModelDetails, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetModelDetails")
+	ret := m.ctrl.Call(m, "GetModelDetails", arg0)
 	ret0, _ := ret[0].(storage0.ModelDetails)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetModelDetails indicates an expected call of GetModelDetails.
-func (mr *MockStateMockRecorder) GetModelDetails() *MockStateGetModelDetailsCall {
+func (mr *MockStateMockRecorder) GetModelDetails(arg0 any) *MockStateGetModelDetailsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModelDetails", reflect.TypeOf((*MockState)(nil).GetModelDetails))
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModelDetails", reflect.TypeOf((*MockState)(nil).GetModelDetails), arg0)
 	return &MockStateGetModelDetailsCall{Call: call}
 }
 
@@ -145,13 +145,13 @@ func (c *MockStateGetModelDetailsCall) Return(arg0 storage0.ModelDetails, arg1 e
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStateGetModelDetailsCall) Do(f func() (storage0.ModelDetails, error)) *MockStateGetModelDetailsCall {
+func (c *MockStateGetModelDetailsCall) Do(f func(context.Context) (storage0.ModelDetails, error)) *MockStateGetModelDetailsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStateGetModelDetailsCall) DoAndReturn(f func() (storage0.ModelDetails, error)) *MockStateGetModelDetailsCall {
+func (c *MockStateGetModelDetailsCall) DoAndReturn(f func(context.Context) (storage0.ModelDetails, error)) *MockStateGetModelDetailsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -79,9 +79,19 @@ func (s *storageSuite) service(c *tc.C) *Service {
 	}))
 }
 
-func (s *storageSuite) TestImportFilesystemValidate(c *tc.C) {
-	c.Skip("TODO: Implement ImportFilesystem in the service")
+func (s *storageSuite) expectGetStoragePool(c *tc.C, name, provider string) domainstorage.StoragePoolUUID {
+	poolUUID, err := domainstorage.NewStoragePoolUUID()
+	c.Assert(err, tc.ErrorIsNil)
+	s.state.EXPECT().GetStoragePoolUUID(gomock.Any(), name).Return(poolUUID, nil)
+	s.state.EXPECT().GetStoragePool(gomock.Any(), poolUUID).Return(domainstorage.StoragePool{
+		UUID:     poolUUID.String(),
+		Name:     name,
+		Provider: provider,
+	}, nil)
+	return poolUUID
+}
 
+func (s *storageSuite) TestImportFilesystemValidate(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
 	_, err := s.service(c).ImportFilesystem(c.Context(), ImportStorageParams{
@@ -110,8 +120,6 @@ func (s *storageSuite) TestImportFilesystemValidate(c *tc.C) {
 }
 
 func (s *storageSuite) TestImportFilesystem(c *tc.C) {
-	c.Skip("TODO: Implement ImportFilesystem in the service")
-
 	defer s.setupMocks(c).Finish()
 
 	s.provider.EXPECT().Supports(storage.StorageKindFilesystem).Return(true)
@@ -124,8 +132,8 @@ func (s *storageSuite) TestImportFilesystem(c *tc.C) {
 
 	controllerUUID := uuid.MustNewUUID().String()
 	modelUUID := modeltesting.GenModelUUID(c).String()
-	// s.state.EXPECT().GetStoragePoolByName(gomock.Any(), "elastic").Return(domainstorage.StoragePool{}, storageerrors.PoolNotFoundError)
-	s.state.EXPECT().GetModelDetails().Return(domainstorage.ModelDetails{
+	poolUUID := s.expectGetStoragePool(c, "elastic", "elastic")
+	s.state.EXPECT().GetModelDetails(gomock.Any()).Return(domainstorage.ModelDetails{
 		ModelUUID:      modelUUID,
 		ControllerUUID: controllerUUID,
 	}, nil)
@@ -142,7 +150,7 @@ func (s *storageSuite) TestImportFilesystem(c *tc.C) {
 			ProviderId: "filesystem-id",
 			Size:       123,
 		},
-		Pool: "elastic",
+		PoolUUID: poolUUID,
 	}).Return("pgdata/0", nil)
 
 	result, err := s.service(c).ImportFilesystem(c.Context(), ImportStorageParams{
@@ -156,8 +164,6 @@ func (s *storageSuite) TestImportFilesystem(c *tc.C) {
 }
 
 func (s *storageSuite) TestImportFilesystemUsingStoragePool(c *tc.C) {
-	c.Skip("TODO: Implement ImportFilesystem in the service")
-
 	defer s.setupMocks(c).Finish()
 
 	s.provider.EXPECT().Supports(storage.StorageKindFilesystem).Return(true)
@@ -168,13 +174,10 @@ func (s *storageSuite) TestImportFilesystemUsingStoragePool(c *tc.C) {
 		MockFilesystemImporter: s.filesystemImporter,
 	}, nil)
 
-	// s.state.EXPECT().GetStoragePoolByName(gomock.Any(), "fast-elastic").Return(domainstorage.StoragePool{
-	// 	Name:     "fast-elastic",
-	// 	Provider: "elastic",
-	// }, nil)
+	poolUUID := s.expectGetStoragePool(c, "fast-elastic", "elastic")
 	controllerUUID := uuid.MustNewUUID().String()
 	modelUUID := modeltesting.GenModelUUID(c).String()
-	s.state.EXPECT().GetModelDetails().Return(domainstorage.ModelDetails{
+	s.state.EXPECT().GetModelDetails(gomock.Any()).Return(domainstorage.ModelDetails{
 		ModelUUID:      modelUUID,
 		ControllerUUID: controllerUUID,
 	}, nil)
@@ -191,7 +194,7 @@ func (s *storageSuite) TestImportFilesystemUsingStoragePool(c *tc.C) {
 			ProviderId: "provider-id",
 			Size:       123,
 		},
-		Pool: "fast-elastic",
+		PoolUUID: poolUUID,
 	}).Return("pgdata/0", nil)
 
 	result, err := s.service(c).ImportFilesystem(c.Context(), ImportStorageParams{
@@ -205,8 +208,6 @@ func (s *storageSuite) TestImportFilesystemUsingStoragePool(c *tc.C) {
 }
 
 func (s *storageSuite) TestImportFilesystemNotSupported(c *tc.C) {
-	c.Skip("TODO: Implement ImportFilesystem in the service")
-
 	defer s.setupMocks(c).Finish()
 
 	s.provider.EXPECT().Supports(storage.StorageKindFilesystem).Return(true)
@@ -214,8 +215,8 @@ func (s *storageSuite) TestImportFilesystemNotSupported(c *tc.C) {
 	c.Assert(err, tc.ErrorIsNil)
 	s.provider.EXPECT().FilesystemSource(cfg).Return(s.filesystemSource, nil)
 
-	// s.state.EXPECT().GetStoragePoolByName(gomock.Any(), "elastic").Return(domainstorage.StoragePool{}, storageerrors.PoolNotFoundError)
-	s.state.EXPECT().GetModelDetails().Return(domainstorage.ModelDetails{
+	s.expectGetStoragePool(c, "elastic", "elastic")
+	s.state.EXPECT().GetModelDetails(gomock.Any()).Return(domainstorage.ModelDetails{
 		ModelUUID:      modeltesting.GenModelUUID(c).String(),
 		ControllerUUID: uuid.MustNewUUID().String(),
 	}, nil)
@@ -229,8 +230,6 @@ func (s *storageSuite) TestImportFilesystemNotSupported(c *tc.C) {
 }
 
 func (s *storageSuite) TestImportFilesystemVolumeBacked(c *tc.C) {
-	c.Skip("TODO: Implement ImportFilesystem in the service")
-
 	defer s.setupMocks(c).Finish()
 
 	s.provider.EXPECT().Supports(storage.StorageKindFilesystem).Return(false)
@@ -243,12 +242,12 @@ func (s *storageSuite) TestImportFilesystemVolumeBacked(c *tc.C) {
 
 	controllerUUID := uuid.MustNewUUID().String()
 	modelUUID := modeltesting.GenModelUUID(c).String()
-	// s.state.EXPECT().GetStoragePoolByName(gomock.Any(), "ebs").Return(domainstorage.StoragePool{}, storageerrors.PoolNotFoundError)
-	s.state.EXPECT().GetModelDetails().Return(domainstorage.ModelDetails{
+	poolUUID := s.expectGetStoragePool(c, "ebs", "ebs")
+	s.state.EXPECT().GetModelDetails(gomock.Any()).Return(domainstorage.ModelDetails{
 		ModelUUID:      modelUUID,
 		ControllerUUID: controllerUUID,
 	}, nil)
-	s.volumeImporter.EXPECT().ImportVolume(gomock.Any(), "provider-id", "", map[string]string{
+	s.volumeImporter.EXPECT().ImportVolume(gomock.Any(), "provider-id", "pgdata", map[string]string{
 		"juju-model-uuid":      modelUUID,
 		"juju-controller-uuid": controllerUUID,
 	}, false).Return(storage.VolumeInfo{
@@ -263,7 +262,7 @@ func (s *storageSuite) TestImportFilesystemVolumeBacked(c *tc.C) {
 		FilesystemInfo: storage.FilesystemInfo{
 			Size: 123,
 		},
-		Pool: "ebs",
+		PoolUUID: poolUUID,
 		BackingVolume: &storage.VolumeInfo{
 			VolumeId:   "provider-id",
 			HardwareId: "hw",
@@ -284,8 +283,6 @@ func (s *storageSuite) TestImportFilesystemVolumeBacked(c *tc.C) {
 }
 
 func (s *storageSuite) TestImportFilesystemVolumeBackedNotSupported(c *tc.C) {
-	c.Skip("TODO: Implement ImportFilesystem in the service")
-
 	defer s.setupMocks(c).Finish()
 
 	s.provider.EXPECT().Supports(storage.StorageKindFilesystem).Return(false)
@@ -293,8 +290,8 @@ func (s *storageSuite) TestImportFilesystemVolumeBackedNotSupported(c *tc.C) {
 	c.Assert(err, tc.ErrorIsNil)
 	s.provider.EXPECT().VolumeSource(cfg).Return(s.volumeSource, nil)
 
-	// s.state.EXPECT().GetStoragePoolByName(gomock.Any(), "ebs").Return(domainstorage.StoragePool{}, storageerrors.PoolNotFoundError)
-	s.state.EXPECT().GetModelDetails().Return(domainstorage.ModelDetails{
+	s.expectGetStoragePool(c, "ebs", "ebs")
+	s.state.EXPECT().GetModelDetails(gomock.Any()).Return(domainstorage.ModelDetails{
 		ModelUUID:      modeltesting.GenModelUUID(c).String(),
 		ControllerUUID: uuid.MustNewUUID().String(),
 	}, nil)
@@ -306,3 +303,17 @@ func (s *storageSuite) TestImportFilesystemVolumeBackedNotSupported(c *tc.C) {
 	})
 	c.Assert(err, tc.ErrorIs, errors.NotSupported)
 }
+
+func (s *storageSuite) TestImportFilesystemPoolNotFound(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetStoragePoolUUID(gomock.Any(), "elastic").Return("", storageerrors.PoolNotFoundError)
+
+	_, err := s.service(c).ImportFilesystem(c.Context(), ImportStorageParams{
+		Kind:        storage.StorageKindFilesystem,
+		Pool:        "elastic",
+		ProviderId:  "provider-id",
+		StorageName: "pgdata",
+	})
+	c.Assert(err, tc.ErrorIs, storageerrors.PoolNotFoundError)
+}
@@ -5,18 +5,369 @@ package state
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/canonical/sqlair"
 
 	"github.com/juju/juju/core/storage"
+	"github.com/juju/juju/domain/life"
+	domainsequence "github.com/juju/juju/domain/sequence"
+	sequencestate "github.com/juju/juju/domain/sequence/state"
+	"github.com/juju/juju/domain/status"
 	domainstorage "github.com/juju/juju/domain/storage"
+	storageerrors "github.com/juju/juju/domain/storage/errors"
+	domainstorageprov "github.com/juju/juju/domain/storageprovisioning"
 	"github.com/juju/juju/internal/errors"
+	internalstorage "github.com/juju/juju/internal/storage"
+)
+
+var (
+	filesystemNamespace = domainsequence.StaticNamespace("filesystem")
+	volumeNamespace     = domainsequence.StaticNamespace("volume")
+	storageNamespace    = domainsequence.StaticNamespace("storage")
 )
 
-func (s State) GetModelDetails() (domainstorage.ModelDetails, error) {
-	//TODO implement me
-	return domainstorage.ModelDetails{}, errors.New("not implemented")
+// GetModelDetails returns the model and controller UUID for the current model.
+func (st State) GetModelDetails(ctx context.Context) (domainstorage.ModelDetails, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return domainstorage.ModelDetails{}, errors.Capture(err)
+	}
+
+	var dbVal modelDetails
+	stmt, err := st.Prepare(`
+SELECT &modelDetails.*
+FROM   model
+`, dbVal)
+	if err != nil {
+		return domainstorage.ModelDetails{}, errors.Capture(err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt).Get(&dbVal)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			// This must never happen, but we return an error that at least
+			// signals the problem correctly in case it does.
+			return errors.New("model database has not had its information set")
+		}
+		return err
+	})
+	if err != nil {
+		return domainstorage.ModelDetails{}, errors.Capture(err)
+	}
+
+	return domainstorage.ModelDetails{
+		ModelUUID:      dbVal.ModelUUID,
+		ControllerUUID: dbVal.ControllerUUID,
+	}, nil
 }
 
-func (s State) ImportFilesystem(ctx context.Context, name storage.Name, filesystem domainstorage.FilesystemInfo) (storage.ID, error) {
-	//TODO implement me
-	return "", errors.New("not implemented")
+// ImportFilesystem associates a filesystem (either native or volume backed)
+// hosted by a cloud provider with a new storage instance in the model. The
+// new storage instance has no owner and is not attached to any unit.
+// The following errors can be expected:
+// - [storageerrors.PoolNotFoundError] if the storage pool does not exist.
+// - [storageerrors.StorageAlreadyImported] if the filesystem or its backing
+// volume is already known to the model.
+func (st State) ImportFilesystem(
+	ctx context.Context,
+	name storage.Name,
+	filesystem domainstorage.FilesystemInfo,
+) (storage.ID, error) {
+	db, err := st.DB(ctx)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+
+	storageUUID, err := domainstorage.NewStorageInstanceUUID()
+	if err != nil {
+		return "", errors.Errorf("generating storage instance uuid: %w", err)
+	}
+	filesystemUUID, err := domainstorageprov.NewFilesystemUUID()
+	if err != nil {
+		return "", errors.Errorf("generating filesystem uuid: %w", err)
+	}
+	var volumeUUID domainstorageprov.VolumeUUID
+	if filesystem.BackingVolume != nil {
+		volumeUUID, err = domainstorageprov.NewVolumeUUID()
+		if err != nil {
+			return "", errors.Errorf("generating volume uuid: %w", err)
+		}
+	}
+
+	var storageID storage.ID
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if _, err := GetStoragePool(ctx, tx, st, filesystem.PoolUUID); err != nil {
+			return errors.Capture(err)
+		}
+
+		if err := st.checkProviderStorageNotImported(ctx, tx, filesystem); err != nil {
+			return errors.Capture(err)
+		}
+
+		id, err := sequencestate.NextValue(ctx, st, tx, storageNamespace)
+		if err != nil {
+			return errors.Errorf("creating unique storage instance id: %w", err)
+		}
+		storageID = storage.MakeID(name, id)
+
+		if err := st.insertImportedStorageInstance(
+			ctx, tx, storageUUID, storageID, name, filesystem,
+		); err != nil {
+			return errors.Capture(err)
+		}
+		if err := st.insertImportedFilesystem(
+			ctx, tx, storageUUID, filesystemUUID, filesystem,
+		); err != nil {
+			return errors.Capture(err)
+		}
+		if filesystem.BackingVolume != nil {
+			if err := st.insertImportedVolume(
+				ctx, tx, storageUUID, volumeUUID, *filesystem.BackingVolume,
+			); err != nil {
+				return errors.Capture(err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return "", errors.Errorf("importing filesystem for storage %q: %w", name, err)
+	}
+	return storageID, nil
+}
+
+// checkProviderStorageNotImported checks that neither the filesystem nor its
+// backing volume have already been imported into the model.
+func (st State) checkProviderStorageNotImported(
+	ctx context.Context,
+	tx *sqlair.TX,
+	filesystem domainstorage.FilesystemInfo,
+) error {
+	if filesystem.ProviderId != "" {
+		input := providerID{ProviderID: filesystem.ProviderId}
+		stmt, err := st.Prepare(`
+SELECT &providerID.*
+FROM   storage_filesystem
+WHERE  provider_id = $providerID.provider_id
+`, input)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		err = tx.Query(ctx, stmt, input).Get(&input)
+		if err == nil {
+			return errors.Errorf(
+				"filesystem %q already imported", filesystem.ProviderId,
+			).Add(storageerrors.StorageAlreadyImported)
+		} else if !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Capture(err)
+		}
+	}
+
+	if filesystem.BackingVolume != nil {
+		input := providerID{ProviderID: filesystem.BackingVolume.VolumeId}
+		stmt, err := st.Prepare(`
+SELECT &providerID.*
+FROM   storage_volume
+WHERE  provider_id = $providerID.provider_id
+`, input)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		err = tx.Query(ctx, stmt, input).Get(&input)
+		if err == nil {
+			return errors.Errorf(
+				"volume %q already imported", filesystem.BackingVolume.VolumeId,
+			).Add(storageerrors.StorageAlreadyImported)
+		} else if !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Capture(err)
+		}
+	}
+	return nil
+}
+
+// insertImportedStorageInstance inserts the storage instance for an imported
+// filesystem.
+func (st State) insertImportedStorageInstance(
+	ctx context.Context,
+	tx *sqlair.TX,
+	storageUUID domainstorage.StorageInstanceUUID,
+	storageID storage.ID,
+	name storage.Name,
+	filesystem domainstorage.FilesystemInfo,
+) error {
+	input := importStorageInstance{
+		UUID:            storageUUID.String(),
+		StorageName:     name.String(),
+		StorageKindID:   int(domainstorageprov.KindFilesystem),
+		StorageID:       storageID.String(),
+		LifeID:          int(life.Alive),
+		StoragePoolUUID: filesystem.PoolUUID.String(),
+		RequestSizeMiB:  filesystem.Size,
+	}
+	stmt, err := st.Prepare(`
+INSERT INTO storage_instance (*) VALUES ($importStorageInstance.*)
+`, input)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if err := tx.Query(ctx, stmt, input).Run(); err != nil {
+		return errors.Errorf("inserting storage instance: %w", err)
+	}
+	return nil
+}
+
+// insertImportedFilesystem inserts the filesystem of an imported storage
+// instance. The filesystem is detached, as it is not yet attached to any unit.
+func (st State) insertImportedFilesystem(
+	ctx context.Context,
+	tx *sqlair.TX,
+	storageUUID domainstorage.StorageInstanceUUID,
+	filesystemUUID domainstorageprov.FilesystemUUID,
+	filesystem domainstorage.FilesystemInfo,
+) error {
+	id, err := sequencestate.NextValue(ctx, st, tx, filesystemNamespace)
+	if err != nil {
+		return errors.Errorf("creating unique filesystem id: %w", err)
+	}
+
+	// A filesystem backed by a volume has to be made on the machine the
+	// volume is attached to.
+	provisionScope := domainstorageprov.ProvisionScopeModel
+	if filesystem.BackingVolume != nil {
+		provisionScope = domainstorageprov.ProvisionScopeMachine
+	}
+
+	fsInput := importStorageFilesystem{
+		UUID:         filesystemUUID.String(),
+		FilesystemID: fmt.Sprintf("%d", id),
+		LifeID:       int(life.Alive),
+		ProviderID: sql.NullString{
+			String: filesystem.ProviderId,
+			Valid:  filesystem.ProviderId != "",
+		},
+		SizeMiB:          filesystem.Size,
+		ProvisionScopeID: int(provisionScope),
+	}
+	fsStmt, err := st.Prepare(`
+INSERT INTO storage_filesystem (*) VALUES ($importStorageFilesystem.*)
+`, fsInput)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	instanceInput := storageInstanceFilesystem{
+		StorageInstanceUUID:   storageUUID.String(),
+		StorageFilesystemUUID: filesystemUUID.String(),
+	}
+	instanceStmt, err := st.Prepare(`
+INSERT INTO storage_instance_filesystem (*) VALUES ($storageInstanceFilesystem.*)
+`, instanceInput)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	statusID, err := status.EncodeStorageFilesystemStatus(
+		status.StorageFilesystemStatusTypeDetached,
+	)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	statusInput := filesystemStatus{
+		FilesystemUUID: filesystemUUID.String(),
+		StatusID:       statusID,
+		UpdatedAt:      time.Now().UTC(),
+	}
+	statusStmt, err := st.Prepare(`
+INSERT INTO storage_filesystem_status (*) VALUES ($filesystemStatus.*)
+`, statusInput)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	if err := tx.Query(ctx, fsStmt, fsInput).Run(); err != nil {
+		return errors.Errorf("inserting filesystem: %w", err)
+	}
+	if err := tx.Query(ctx, instanceStmt, instanceInput).Run(); err != nil {
+		return errors.Errorf("linking filesystem to storage instance: %w", err)
+	}
+	if err := tx.Query(ctx, statusStmt, statusInput).Run(); err != nil {
+		return errors.Errorf("setting filesystem status: %w", err)
+	}
+	return nil
+}
+
+// insertImportedVolume inserts the volume backing the filesystem of an
+// imported storage instance. The volume is detached, as it is not yet
+// attached to any machine.
+func (st State) insertImportedVolume(
+	ctx context.Context,
+	tx *sqlair.TX,
+	storageUUID domainstorage.StorageInstanceUUID,
+	volumeUUID domainstorageprov.VolumeUUID,
+	volume internalstorage.VolumeInfo,
+) error {
+	id, err := sequencestate.NextValue(ctx, st, tx, volumeNamespace)
+	if err != nil {
+		return errors.Errorf("creating unique volume id: %w", err)
+	}
+
+	volInput := importStorageVolume{
+		UUID:             volumeUUID.String(),
+		VolumeID:         fmt.Sprintf("%d", id),
+		LifeID:           int(life.Alive),
+		ProviderID:       volume.VolumeId,
+		SizeMiB:          volume.Size,
+		HardwareID:       volume.HardwareId,
+		WWN:              volume.WWN,
+		Persistent:       volume.Persistent,
+		ProvisionScopeID: int(domainstorageprov.ProvisionScopeModel),
+	}
+	volStmt, err := st.Prepare(`
+INSERT INTO storage_volume (*) VALUES ($importStorageVolume.*)
+`, volInput)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	instanceInput := storageInstanceVolume{
+		StorageInstanceUUID: storageUUID.String(),
+		StorageVolumeUUID:   volumeUUID.String(),
+	}
+	instanceStmt, err := st.Prepare(`
+INSERT INTO storage_instance_volume (*) VALUES ($storageInstanceVolume.*)
+`, instanceInput)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	statusID, err := status.EncodeStorageVolumeStatus(
+		status.StorageVolumeStatusTypeDetached,
+	)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	statusInput := volumeStatus{
+		VolumeUUID: volumeUUID.String(),
+		StatusID:   statusID,
+		UpdatedAt:  time.Now().UTC(),
+	}
+	statusStmt, err := st.Prepare(`
+INSERT INTO storage_volume_status (*) VALUES ($volumeStatus.*)
+`, statusInput)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	if err := tx.Query(ctx, volStmt, volInput).Run(); err != nil {
+		return errors.Errorf("inserting volume: %w", err)
+	}
+	if err := tx.Query(ctx, instanceStmt, instanceInput).Run(); err != nil {
+		return errors.Errorf("linking volume to storage instance: %w", err)
+	}
+	if err := tx.Query(ctx, statusStmt, statusInput).Run(); err != nil {
+		return errors.Errorf("setting volume status: %w", err)
+	}
+	return nil
 }
@@ -0,0 +1,207 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+	stdtesting "testing"
+
+	"github.com/juju/tc"
+
+	corestorage "github.com/juju/juju/core/storage"
+	"github.com/juju/juju/domain/schema/testing"
+	domainstorage "github.com/juju/juju/domain/storage"
+	storageerrors "github.com/juju/juju/domain/storage/errors"
+	internalstorage "github.com/juju/juju/internal/storage"
+	"github.com/juju/juju/internal/uuid"
+)
+
+type storageSuite struct {
+	testing.ModelSuite
+}
+
+func TestStorageSuite(t *stdtesting.T) {
+	tc.Run(t, &storageSuite{})
+}
+
+func (s *storageSuite) createStoragePool(c *tc.C, name, provider string) domainstorage.StoragePoolUUID {
+	poolUUID, err := domainstorage.NewStoragePoolUUID()
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+INSERT INTO storage_pool (uuid, name, type, origin_id)
+VALUES (?, ?, ?, ?)`, poolUUID.String(), name, provider, int(domainstorage.StoragePoolOriginUser))
+		return err
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	return poolUUID
+}
+
+func (s *storageSuite) TestGetModelDetails(c *tc.C) {
+	modelUUID := uuid.MustNewUUID().String()
+	controllerUUID := uuid.MustNewUUID().String()
+	err := s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+INSERT INTO model (uuid, controller_uuid, name, qualifier, type, cloud, cloud_type)
+VALUES (?, ?, 'test', 'prod', 'iaas', 'fluffy', 'ec2')`, modelUUID, controllerUUID)
+		return err
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	st := NewState(s.TxnRunnerFactory())
+	details, err := st.GetModelDetails(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(details, tc.DeepEquals, domainstorage.ModelDetails{
+		ModelUUID:      modelUUID,
+		ControllerUUID: controllerUUID,
+	})
+}
+
+func (s *storageSuite) TestImportFilesystem(c *tc.C) {
+	poolUUID := s.createStoragePool(c, "elastic", "elastic")
+
+	st := NewState(s.TxnRunnerFactory())
+	id, err := st.ImportFilesystem(c.Context(), "pgdata", domainstorage.FilesystemInfo{
+		FilesystemInfo: internalstorage.FilesystemInfo{
+			ProviderId: "provider-id",
+			Size:       123,
+		},
+		PoolUUID: poolUUID,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(id, tc.Equals, corestorage.ID("pgdata/0"))
+
+	var (
+		storageName, storagePool string
+		fsProviderID             string
+		fsSize                   uint64
+		fsStatus                 string
+	)
+	err = s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx, `
+SELECT si.storage_name, si.storage_pool_uuid, sf.provider_id, sf.size_mib, fst.status
+FROM   storage_instance si
+JOIN   storage_instance_filesystem sif ON sif.storage_instance_uuid = si.uuid
+JOIN   storage_filesystem sf ON sf.uuid = sif.storage_filesystem_uuid
+JOIN   storage_filesystem_status sfs ON sfs.filesystem_uuid = sf.uuid
+JOIN   storage_filesystem_status_value fst ON fst.id = sfs.status_id
+WHERE  si.storage_id = ?`, id.String()).Scan(
+			&storageName, &storagePool, &fsProviderID, &fsSize, &fsStatus,
+		)
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(storageName, tc.Equals, "pgdata")
+	c.Check(storagePool, tc.Equals, poolUUID.String())
+	c.Check(fsProviderID, tc.Equals, "provider-id")
+	c.Check(fsSize, tc.Equals, uint64(123))
+	c.Check(fsStatus, tc.Equals, "detached")
+}
+
+func (s *storageSuite) TestImportFilesystemVolumeBacked(c *tc.C) {
+	poolUUID := s.createStoragePool(c, "ebs-fast", "ebs")
+
+	st := NewState(s.TxnRunnerFactory())
+	id, err := st.ImportFilesystem(c.Context(), "pgdata", domainstorage.FilesystemInfo{
+		FilesystemInfo: internalstorage.FilesystemInfo{
+			Size: 123,
+		},
+		PoolUUID: poolUUID,
+		BackingVolume: &internalstorage.VolumeInfo{
+			VolumeId:   "vol-123",
+			HardwareId: "hw",
+			WWN:        "wwn",
+			Size:       123,
+			Persistent: true,
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(id, tc.Equals, corestorage.ID("pgdata/0"))
+
+	var (
+		volProviderID, volHardwareID, volWWN string
+		volPersistent                        bool
+		volStatus                            string
+		fsProviderID                         sql.NullString
+	)
+	err = s.TxnRunner().StdTxn(c.Context(), func(ctx context.Context, tx *sql.Tx) error {
+		err := tx.QueryRowContext(ctx, `
+SELECT sv.provider_id, sv.hardware_id, sv.wwn, sv.persistent, vst.status
+FROM   storage_instance si
+JOIN   storage_instance_volume siv ON siv.storage_instance_uuid = si.uuid
+JOIN   storage_volume sv ON sv.uuid = siv.storage_volume_uuid
+JOIN   storage_volume_status svs ON svs.volume_uuid = sv.uuid
+JOIN   storage_volume_status_value vst ON vst.id = svs.status_id
+WHERE  si.storage_id = ?`, id.String()).Scan(
+			&volProviderID, &volHardwareID, &volWWN, &volPersistent, &volStatus,
+		)
+		if err != nil {
+			return err
+		}
+		return tx.QueryRowContext(ctx, `
+SELECT sf.provider_id
+FROM   storage_instance si
+JOIN   storage_instance_filesystem sif ON sif.storage_instance_uuid = si.uuid
+JOIN   storage_filesystem sf ON sf.uuid = sif.storage_filesystem_uuid
+WHERE  si.storage_id = ?`, id.String()).Scan(&fsProviderID)
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(volProviderID, tc.Equals, "vol-123")
+	c.Check(volHardwareID, tc.Equals, "hw")
+	c.Check(volWWN, tc.Equals, "wwn")
+	c.Check(volPersistent, tc.IsTrue)
+	c.Check(volStatus, tc.Equals, "detached")
+	c.Check(fsProviderID.Valid, tc.IsFalse)
+}
+
+func (s *storageSuite) TestImportFilesystemAlreadyImported(c *tc.C) {
+	poolUUID := s.createStoragePool(c, "elastic", "elastic")
+
+	st := NewState(s.TxnRunnerFactory())
+	fs := domainstorage.FilesystemInfo{
+		FilesystemInfo: internalstorage.FilesystemInfo{
+			ProviderId: "provider-id",
+			Size:       123,
+		},
+		PoolUUID: poolUUID,
+	}
+	_, err := st.ImportFilesystem(c.Context(), "pgdata", fs)
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = st.ImportFilesystem(c.Context(), "pgdata", fs)
+	c.Assert(err, tc.ErrorIs, storageerrors.StorageAlreadyImported)
+}
+
+func (s *storageSuite) TestImportFilesystemVolumeAlreadyImported(c *tc.C) {
+	poolUUID := s.createStoragePool(c, "ebs-fast", "ebs")
+
+	st := NewState(s.TxnRunnerFactory())
+	fs := domainstorage.FilesystemInfo{
+		PoolUUID: poolUUID,
+		BackingVolume: &internalstorage.VolumeInfo{
+			VolumeId: "vol-123",
+			Size:     123,
+		},
+	}
+	_, err := st.ImportFilesystem(c.Context(), "pgdata", fs)
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = st.ImportFilesystem(c.Context(), "pgdata", fs)
+	c.Assert(err, tc.ErrorIs, storageerrors.StorageAlreadyImported)
+}
+
+func (s *storageSuite) TestImportFilesystemPoolNotFound(c *tc.C) {
+	poolUUID, err := domainstorage.NewStoragePoolUUID()
+	c.Assert(err, tc.ErrorIsNil)
+
+	st := NewState(s.TxnRunnerFactory())
+	_, err = st.ImportFilesystem(c.Context(), "pgdata", domainstorage.FilesystemInfo{
+		FilesystemInfo: internalstorage.FilesystemInfo{
+			ProviderId: "provider-id",
+		},
+		PoolUUID: poolUUID,
+	})
+	c.Assert(err, tc.ErrorIs, storageerrors.PoolNotFoundError)
+}
@@ -0,0 +1,86 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"database/sql"
+	"time"
+)
+
+// modelDetails represents the model and controller uuid of the model.
+type modelDetails struct {
+	ModelUUID      string `db:"uuid"`
+	ControllerUUID string `db:"controller_uuid"`
+}
+
+// providerID represents the provider id of a filesystem or volume.
+type providerID struct {
+	ProviderID string `db:"provider_id"`
+}
+
+// importStorageInstance represents the values required for inserting an
+// imported storage instance into the model. Imported storage has no charm
+// until it is first attached to a unit.
+type importStorageInstance struct {
+	UUID            string `db:"uuid"`
+	StorageName     string `db:"storage_name"`
+	StorageKindID   int    `db:"storage_kind_id"`
+	StorageID       string `db:"storage_id"`
+	LifeID          int    `db:"life_id"`
+	StoragePoolUUID string `db:"storage_pool_uuid"`
+	RequestSizeMiB  uint64 `db:"requested_size_mib"`
+}
+
+// importStorageFilesystem represents the values required for inserting an
+// imported filesystem into the model.
+type importStorageFilesystem struct {
+	UUID             string         `db:"uuid"`
+	FilesystemID     string         `db:"filesystem_id"`
+	LifeID           int            `db:"life_id"`
+	ProviderID       sql.NullString `db:"provider_id"`
+	SizeMiB          uint64         `db:"size_mib"`
+	ProvisionScopeID int            `db:"provision_scope_id"`
+}
+
+// importStorageVolume represents the values required for inserting an
+// imported volume into the model.
+type importStorageVolume struct {
+	UUID             string `db:"uuid"`
+	VolumeID         string `db:"volume_id"`
+	LifeID           int    `db:"life_id"`
+	ProviderID       string `db:"provider_id"`
+	SizeMiB          uint64 `db:"size_mib"`
+	HardwareID       string `db:"hardware_id"`
+	WWN              string `db:"wwn"`
+	Persistent       bool   `db:"persistent"`
+	ProvisionScopeID int    `db:"provision_scope_id"`
+}
+
+// storageInstanceFilesystem represents the link between a storage instance
+// and its filesystem.
+type storageInstanceFilesystem struct {
+	StorageInstanceUUID   string `db:"storage_instance_uuid"`
+	StorageFilesystemUUID string `db:"storage_filesystem_uuid"`
+}
+
+// storageInstanceVolume represents the link between a storage instance and
+// its volume.
+type storageInstanceVolume struct {
+	StorageInstanceUUID string `db:"storage_instance_uuid"`
+	StorageVolumeUUID   string `db:"storage_volume_uuid"`
+}
+
+// filesystemStatus represents the status of a filesystem.
+type filesystemStatus struct {
+	FilesystemUUID string    `db:"filesystem_uuid"`
+	StatusID       int       `db:"status_id"`
+	UpdatedAt      time.Time `db:"updated_at"`
+}
+
+// volumeStatus represents the status of a volume.
+type volumeStatus struct {
+	VolumeUUID string    `db:"volume_uuid"`
+	StatusID   int       `db:"status_id"`
+	UpdatedAt  time.Time `db:"updated_at"`
+}
@@ -32,6 +32,7 @@ func getDefaultStoragePoolUUIDs() map[defaultStoragePoolLookup]StoragePoolUUID {
 		{N: "ebs-ssd", T: "ebs"}:           "40301372-0198-5081-9bd1-e85d086c1909",
 		{N: "gce", T: "gce"}:               "57c79a70-3651-5aa8-a36a-480343dff53f",
 		{N: "kubernetes", T: "kubernetes"}: "47052c4e-2955-5768-a053-bf91f52d54e0",
+		{N: "libvirt", T: "libvirt"}:       "b930789a-bffb-5fcf-8ac6-091df2f3fdbb",
 		{N: "loop", T: "loop"}:             "baa26e04-b1f0-50d9-9bf8-4d5a78ffe6ad",
 		{N: "lxd", T: "lxd"}:               "16d8c090-8ef4-59b4-8e88-0bc64a0598a3",
 		{N: "lxd-btrfs", T: "lxd"}:         "e1acb8b8-c978-5d53-bc22-2a0e7fd58734",
@@ -39,6 +40,7 @@ func getDefaultStoragePoolUUIDs() map[defaultStoragePoolLookup]StoragePoolUUID {
 		{N: "maas", T: "maas"}:             "6bbd79bb-ef10-5795-8c33-2a07acd0f6cc",
 		{N: "oci", T: "oci"}:               "db714d36-3c0e-56bd-ac82-4e929cc3d60c",
 		{N: "iscsi", T: "oci"}:             "d859abf6-4172-58b1-85a9-7aba58b6c364",
+		{N: "proxmox", T: "proxmox"}:       "6f146059-9e1e-5e30-9b50-a4721dea48e8",
 		{N: "rootfs", T: "rootfs"}:         "4d9a00e0-bf5f-5823-8ffa-db1a2ffb940c",
 		{N: "tmpfs", T: "tmpfs"}:           "6a16b09c-8ca9-5952-a50a-9082ae7c32c1",
 	}
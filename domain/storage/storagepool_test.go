@@ -25,6 +25,7 @@ var knownDefaultProviderPools = []struct {
 	{Name: "ebs-ssd", ProviderType: "ebs"},
 	{Name: "gce", ProviderType: "gce"},
 	{Name: "kubernetes", ProviderType: "kubernetes"},
+	{Name: "libvirt", ProviderType: "libvirt"},
 	{Name: "loop", ProviderType: "loop"},
 	{Name: "lxd", ProviderType: "lxd"},
 	{Name: "lxd-btrfs", ProviderType: "lxd"},
@@ -32,6 +33,7 @@ var knownDefaultProviderPools = []struct {
 	{Name: "maas", ProviderType: "maas"},
 	{Name: "oci", ProviderType: "oci"},
 	{Name: "iscsi", ProviderType: "oci"},
+	{Name: "proxmox", ProviderType: "proxmox"},
 	{Name: "rootfs", ProviderType: "rootfs"},
 	{Name: "tmpfs", ProviderType: "tmpfs"},
 }
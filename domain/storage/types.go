@@ -88,6 +88,11 @@ type ModelDetails struct {
 // FilesystemInfo describes information about a filesystem.
 type FilesystemInfo struct {
 	storage.FilesystemInfo
-	Pool          string
+
+	// PoolUUID is the uuid of the storage pool the filesystem belongs to.
+	PoolUUID StoragePoolUUID
+
+	// BackingVolume is the volume backing the filesystem. It is nil when the
+	// filesystem is provided natively by the storage provider.
 	BackingVolume *storage.VolumeInfo
 }
@@ -122,6 +122,10 @@ type FilesystemTemplate struct {
 	// mounted.
 	Location string
 
+	// Shared is true if this filesystem(s) is shared between all units of the
+	// application instead of being created for each unit.
+	Shared bool
+
 	// Attributes are a set of key value pairs that are supplied to the provider
 	// or provisioner to facilitate this filesystem(s).
 	Attributes map[string]string
@@ -51,7 +51,8 @@ SELECT (asd.storage_name,
        asd.count,
        cs.read_only,
        cs.location,
-       cs.count_max) AS (&filesystemTemplate.*),
+       cs.count_max,
+       cs.shared) AS (&filesystemTemplate.*),
        sp.type AS &filesystemTemplate.storage_type
 FROM   application_storage_directive AS asd
        JOIN charm_storage cs
@@ -124,6 +125,7 @@ ORDER BY asd.storage_name
 			ProviderType: v.ProviderType,
 			ReadOnly:     v.ReadOnly,
 			Location:     v.Location,
+			Shared:       v.Shared,
 			Attributes:   attrs[v.StorageName],
 		})
 	}
@@ -292,6 +292,7 @@ type filesystemTemplate struct {
 	ProviderType string `db:"storage_type"`
 	ReadOnly     bool   `db:"read_only"`
 	Location     string `db:"location"`
+	Shared       bool   `db:"shared"`
 }
 
 // volumeParams represents the attachment params for a volume from the model
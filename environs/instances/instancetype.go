@@ -33,6 +33,11 @@ type InstanceType struct {
 	// True value indicates it supports Secure Encrypted Virtualization.
 	// False on the contrary.
 	IsSev bool
+	// Accelerators is the number of accelerators (such as GPUs) attached
+	// to the instance type, and AcceleratorType is the provider's name for
+	// them (e.g. nvidia-tesla-t4).
+	Accelerators    uint64
+	AcceleratorType string
 }
 
 // InstanceTypeNetworking hold relevant information about an instances
@@ -94,9 +99,25 @@ func (itype InstanceType) match(cons constraints.Value) (InstanceType, bool) {
 	if cons.HasVirtType() && (itype.VirtType == nil || *itype.VirtType != *cons.VirtType) {
 		return nothing, false
 	}
+	count, kind, err := cons.AcceleratorSpec()
+	if err != nil {
+		return nothing, false
+	}
+	if count > 0 {
+		if itype.Accelerators < count || !acceleratorTypeMatch(kind, itype.AcceleratorType) {
+			return nothing, false
+		}
+	}
 	return itype, true
 }
 
+// acceleratorTypeMatch returns true if the wanted accelerator type is empty,
+// or is the accelerator type the instance type has. The comparison is case
+// insensitive.
+func acceleratorTypeMatch(wanted, have string) bool {
+	return wanted == "" || strings.EqualFold(wanted, have)
+}
+
 const (
 	// MinCpuCores is the assumed minimum CPU cores we prefer in order to run a server.
 	MinCpuCores uint64 = 1
@@ -130,6 +151,10 @@ func MatchingInstanceTypes(allInstanceTypes []InstanceType, region string, cons
 	//   try opinionated default with enough mem to run a server.
 	// - if no matches and no mem constraint specified, try again and
 	//   return any matching instance with the largest memory
+	if _, _, err := cons.AcceleratorSpec(); err != nil {
+		return nil, fmt.Errorf("invalid accelerators constraint: %w", err)
+	}
+
 	origCons := cons
 	if !cons.HasInstanceType() && !cons.HasCpuCores() {
 		minCpuCores := MinCpuCores
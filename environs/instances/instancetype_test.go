@@ -228,6 +228,32 @@ var getInstanceTypesTest = []struct {
 		cons:           "virt-type=hvm",
 		expectedItypes: []string{"cc1.4xlarge", "cc2.8xlarge"},
 		itypesToUse:    nil,
+	}, {
+		about: "accelerators filtered by count",
+		cons:  "accelerators=2",
+		itypesToUse: []InstanceType{
+			{Id: "3", Name: "it-3", Arch: "amd64", Mem: 4096, CpuCores: 4, Accelerators: 4, AcceleratorType: "nvidia-tesla-t4", Cost: 300},
+			{Id: "2", Name: "it-2", Arch: "amd64", Mem: 4096, CpuCores: 4, Accelerators: 1, AcceleratorType: "nvidia-tesla-t4", Cost: 100},
+			{Id: "1", Name: "it-1", Arch: "amd64", Mem: 4096, CpuCores: 4, Cost: 50},
+		},
+		expectedItypes: []string{"it-3"},
+	}, {
+		about: "accelerators filtered by count and type",
+		cons:  "accelerators=1:NVIDIA-Tesla-T4",
+		itypesToUse: []InstanceType{
+			{Id: "3", Name: "it-3", Arch: "amd64", Mem: 4096, CpuCores: 4, Accelerators: 1, AcceleratorType: "nvidia-a10g", Cost: 50},
+			{Id: "2", Name: "it-2", Arch: "amd64", Mem: 4096, CpuCores: 4, Accelerators: 2, AcceleratorType: "nvidia-tesla-t4", Cost: 200},
+			{Id: "1", Name: "it-1", Arch: "amd64", Mem: 4096, CpuCores: 4, Accelerators: 1, AcceleratorType: "nvidia-tesla-t4", Cost: 100},
+		},
+		expectedItypes: []string{"it-1", "it-2"},
+	}, {
+		about: "accelerator type must match exactly",
+		cons:  "accelerators=1:tesla-t4",
+		itypesToUse: []InstanceType{
+			{Id: "2", Name: "it-2", Arch: "amd64", Mem: 4096, CpuCores: 4, Accelerators: 1, AcceleratorType: "tesla-t4", Cost: 200},
+			{Id: "1", Name: "it-1", Arch: "amd64", Mem: 4096, CpuCores: 4, Accelerators: 1, AcceleratorType: "nvidia-tesla-t4", Cost: 100},
+		},
+		expectedItypes: []string{"it-2"},
 	},
 }
 
@@ -268,6 +294,10 @@ func (s *instanceTypeSuite) TestGetMatchingInstanceTypesErrors(c *tc.C) {
 
 	_, err = MatchingInstanceTypes(instanceTypes, "test", constraints.MustParse("instance-type=dep.medium mem=8G"))
 	c.Check(err, tc.ErrorMatches, `no instance types in test matching constraints "instance-type=dep.medium mem=8192M"`)
+
+	accelerators := "two:nvidia"
+	_, err = MatchingInstanceTypes(instanceTypes, "test", constraints.Value{Accelerators: &accelerators})
+	c.Check(err, tc.ErrorMatches, `invalid accelerators constraint: "two:nvidia" is not a valid accelerator count.*`)
 }
 
 var instanceTypeMatchTests = []struct {
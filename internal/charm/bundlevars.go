@@ -0,0 +1,831 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package charm
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/juju/errors"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// bundleVariablesKey is the top level key of a bundle document which
+	// declares the variables used by the bundle and its overlays.
+	bundleVariablesKey = "variables"
+
+	// bundleWhenKey marks a mapping as conditional. The mapping is
+	// removed from the bundle if the condition is false.
+	bundleWhenKey = "$when"
+
+	// bundleIncludeKey merges the mapping read from a file into the
+	// mapping that contains it.
+	bundleIncludeKey = "$include"
+
+	// maxBundleIncludeDepth limits how deeply $include directives can be
+	// nested, to catch files which include each other.
+	maxBundleIncludeDepth = 10
+)
+
+// The types which bundle variables can be declared with.
+const (
+	BundleVariableString = "string"
+	BundleVariableInt    = "int"
+	BundleVariableFloat  = "float"
+	BundleVariableBool   = "bool"
+)
+
+var (
+	validBundleVariableName = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_-]*$`)
+	bundleVariableRef       = regexp.MustCompile(`\$\$\{|\$\{([a-zA-Z][a-zA-Z0-9_-]*)\}`)
+)
+
+// BundleVariable is a variable declared in the variables section of a
+// bundle.
+type BundleVariable struct {
+	// Name is the name of the variable.
+	Name string
+
+	// Type is the type of the variable's value.
+	Type string
+
+	// Description describes what the variable is for.
+	Description string
+
+	// Default is the value of the variable when none is supplied.
+	Default interface{}
+
+	// HasDefault is true if the variable has a default value. A variable
+	// without a default must be supplied when the bundle is deployed.
+	HasDefault bool
+}
+
+// ResolveBundleVariables resolves the variables, conditional sections and
+// structured includes used by the base bundle and overlays in sources, and
+// returns sources which yield the resolved bundle data.
+//
+// Variables are declared by the variables section of any document, and are
+// visible to all documents, so overlays can use variables declared by the
+// base bundle:
+//
+//	variables:
+//	  env:
+//	    type: string
+//	    default: staging
+//	  units:
+//	    type: int
+//	    default: 1
+//	  password:
+//	    description: the admin password
+//
+// A variable with a scalar in place of a declaration takes the scalar as
+// its default, and the type of the scalar. A variable without a default
+// must be supplied in values. Values are converted to the declared type.
+//
+// References to declared variables in the form ${name} are replaced in
+// string values and keys. A value which consists only of a reference takes
+// the type of the variable. A literal "${" is written as "$${". A reference
+// to a name which is not declared is an error.
+//
+// A mapping containing a $when key is removed from the bundle if its
+// condition is false. A condition is a boolean, a negated boolean ("!cond")
+// or a comparison ("a == b", "a != b"), after variables are replaced.
+// Relations in the same document which refer to an application removed by a
+// condition are removed too.
+//
+// A mapping containing an $include key has the entries of the mapping read
+// from that file merged into it, with entries of the including mapping
+// taking precedence. The path is resolved by the data source of the
+// document.
+//
+// If the sources don't use any of these features, they are returned as is.
+func ResolveBundleVariables(values map[string]string, sources ...BundleDataSource) ([]BundleDataSource, error) {
+	docs := make([][]yaml.MapSlice, len(sources))
+	var templated bool
+	for i, src := range sources {
+		if src == nil {
+			continue
+		}
+		srcDocs, err := decodeBundleDocuments(src.BundleBytes())
+		if err != nil && len(values) == 0 {
+			// Leave the bundle to be rejected by the usual parsing.
+			return sources, nil
+		} else if err != nil {
+			return nil, errors.Trace(err)
+		}
+		for _, doc := range srcDocs {
+			templated = templated || usesBundleTemplating(doc, true)
+		}
+		docs[i] = srcDocs
+	}
+	if !templated && len(values) == 0 {
+		return sources, nil
+	}
+
+	var declared []BundleVariable
+	for i, srcDocs := range docs {
+		for _, doc := range srcDocs {
+			vars, err := readBundleVariables(doc, sources[i].ResolveInclude)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			declared, err = mergeBundleVariables(declared, vars)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+		}
+	}
+	resolved, err := bundleVariableValues(declared, values)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	result := make([]BundleDataSource, len(sources))
+	for i, srcDocs := range docs {
+		if sources[i] == nil {
+			continue
+		}
+		t := bundleTemplate{
+			values:         resolved,
+			resolveInclude: sources[i].ResolveInclude,
+		}
+		var buf bytes.Buffer
+		for docIdx, doc := range srcDocs {
+			doc, err := t.resolveDocument(doc)
+			if err != nil {
+				return nil, errors.Annotatef(err, "resolving document %d", docIdx)
+			}
+			out, err := yaml.Marshal(doc)
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			if docIdx > 0 {
+				buf.WriteString("---\n")
+			}
+			buf.Write(out)
+		}
+		parts, err := parseBundleParts(buf.Bytes())
+		if err != nil {
+			return nil, errors.NotValidf("cannot unmarshal resolved bundle contents: %v", err)
+		}
+		result[i] = &templatedBundleDataSource{
+			BundleDataSource: sources[i],
+			bundleBytes:      buf.Bytes(),
+			parts:            parts,
+		}
+	}
+	return result, nil
+}
+
+// ParameteriseBundle returns the bundle with the channel and unit count of
+// each application in the base bundle replaced by variable references. The
+// variables are declared with the current values as their defaults, so the
+// bundle deploys the same applications when no values are supplied.
+func ParameteriseBundle(b []byte) ([]byte, error) {
+	docs, err := decodeBundleDocuments(b)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if len(docs) == 0 {
+		return nil, errors.NotValidf("empty bundle")
+	}
+
+	var variables yaml.MapSlice
+	base := docs[0]
+	for _, item := range base {
+		if item.Key != "applications" {
+			continue
+		}
+		apps, _ := item.Value.(yaml.MapSlice)
+		for _, app := range apps {
+			name := fmt.Sprint(app.Key)
+			spec, _ := app.Value.(yaml.MapSlice)
+			for j, field := range spec {
+				var varName, varType string
+				switch field.Key {
+				case "channel":
+					varName, varType = name+"-channel", BundleVariableString
+				case "num_units":
+					varName, varType = name+"-num-units", BundleVariableInt
+				case "scale":
+					varName, varType = name+"-scale", BundleVariableInt
+				default:
+					continue
+				}
+				variables = append(variables, yaml.MapItem{
+					Key: varName,
+					Value: yaml.MapSlice{
+						{Key: "type", Value: varType},
+						{Key: "default", Value: field.Value},
+					},
+				})
+				spec[j].Value = "${" + varName + "}"
+			}
+		}
+	}
+	if len(variables) > 0 {
+		docs[0] = append(yaml.MapSlice{{Key: bundleVariablesKey, Value: variables}}, base...)
+	}
+
+	var buf bytes.Buffer
+	for docIdx, doc := range docs {
+		out, err := yaml.Marshal(doc)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if docIdx > 0 {
+			buf.WriteString("---\n")
+		}
+		buf.Write(out)
+	}
+	return buf.Bytes(), nil
+}
+
+// templatedBundleDataSource is a data source whose parts have been resolved
+// from a bundle template.
+type templatedBundleDataSource struct {
+	BundleDataSource
+	bundleBytes []byte
+	parts       []*BundleDataPart
+}
+
+// Parts is part of the BundleDataSource interface.
+func (s *templatedBundleDataSource) Parts() []*BundleDataPart {
+	return s.parts
+}
+
+// BundleBytes is part of the BundleDataSource interface.
+func (s *templatedBundleDataSource) BundleBytes() []byte {
+	return s.bundleBytes
+}
+
+func decodeBundleDocuments(b []byte) ([]yaml.MapSlice, error) {
+	var docs []yaml.MapSlice
+	dec := yaml.NewDecoder(bytes.NewReader(b))
+	for docIdx := 0; ; docIdx++ {
+		var doc yaml.MapSlice
+		err := dec.Decode(&doc)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, errors.NotValidf("cannot unmarshal document %d: %v", docIdx, err)
+		}
+		if doc == nil {
+			doc = yaml.MapSlice{}
+		}
+		docs = append(docs, doc)
+	}
+	return docs, nil
+}
+
+// usesBundleTemplating returns true if v declares variables or contains a
+// $when or $include directive.
+func usesBundleTemplating(v interface{}, topLevel bool) bool {
+	switch v := v.(type) {
+	case yaml.MapSlice:
+		for _, item := range v {
+			if item.Key == bundleWhenKey || item.Key == bundleIncludeKey ||
+				(topLevel && item.Key == bundleVariablesKey) {
+				return true
+			}
+			if usesBundleTemplating(item.Value, false) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, elem := range v {
+			if usesBundleTemplating(elem, false) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// readBundleVariables returns the variables declared by the document.
+func readBundleVariables(doc yaml.MapSlice, resolveInclude func(string) ([]byte, error)) ([]BundleVariable, error) {
+	var section yaml.MapSlice
+	for _, item := range doc {
+		if item.Key != bundleVariablesKey {
+			continue
+		}
+		if item.Value == nil {
+			return nil, nil
+		}
+		var ok bool
+		if section, ok = item.Value.(yaml.MapSlice); !ok {
+			return nil, errors.NotValidf("variables section of type %T", item.Value)
+		}
+	}
+	t := bundleTemplate{resolveInclude: resolveInclude}
+	section, _, err := t.expandIncludes(section, 0)
+	if err != nil {
+		return nil, errors.Annotate(err, "reading variables")
+	}
+
+	vars := make([]BundleVariable, 0, len(section))
+	for _, item := range section {
+		name, ok := item.Key.(string)
+		if !ok || !validBundleVariableName.MatchString(name) {
+			return nil, errors.NotValidf("variable name %v", item.Key)
+		}
+		v := BundleVariable{Name: name}
+		decl, isDecl := item.Value.(yaml.MapSlice)
+		switch {
+		case item.Value == nil:
+			v.Type = BundleVariableString
+		case !isDecl:
+			v.Default, v.HasDefault = item.Value, true
+			v.Type = bundleVariableTypeOf(item.Value)
+		default:
+			for _, field := range decl {
+				switch field.Key {
+				case "type":
+					v.Type = fmt.Sprint(field.Value)
+				case "default":
+					v.Default, v.HasDefault = field.Value, true
+				case "description":
+					v.Description = fmt.Sprint(field.Value)
+				default:
+					return nil, errors.NotValidf("field %v of variable %q", field.Key, name)
+				}
+			}
+			if v.Type == "" {
+				v.Type = BundleVariableString
+			}
+		}
+		switch v.Type {
+		case BundleVariableString, BundleVariableInt, BundleVariableFloat, BundleVariableBool:
+		default:
+			return nil, errors.NotSupportedf("type %q of variable %q", v.Type, name)
+		}
+		if v.HasDefault {
+			if v.Default, err = convertBundleVariable(v.Type, v.Default); err != nil {
+				return nil, errors.Annotatef(err, "default of variable %q", name)
+			}
+		}
+		vars = append(vars, v)
+	}
+	return vars, nil
+}
+
+// mergeBundleVariables adds the variables in vars to declared. A variable
+// which is declared again keeps its type, but takes the later default and
+// description.
+func mergeBundleVariables(declared, vars []BundleVariable) ([]BundleVariable, error) {
+	for _, v := range vars {
+		i := sort.Search(len(declared), func(i int) bool { return declared[i].Name >= v.Name })
+		if i < len(declared) && declared[i].Name == v.Name {
+			if declared[i].Type != v.Type {
+				return nil, errors.NotValidf("variable %q declared as both %s and %s", v.Name, declared[i].Type, v.Type)
+			}
+			if v.HasDefault {
+				declared[i].Default, declared[i].HasDefault = v.Default, true
+			}
+			if v.Description != "" {
+				declared[i].Description = v.Description
+			}
+			continue
+		}
+		declared = append(declared, BundleVariable{})
+		copy(declared[i+1:], declared[i:])
+		declared[i] = v
+	}
+	return declared, nil
+}
+
+// bundleVariableValues returns the value of each declared variable, taken
+// from values if it's supplied or from its default if it isn't.
+func bundleVariableValues(declared []BundleVariable, values map[string]string) (map[string]interface{}, error) {
+	result := make(map[string]interface{}, len(declared))
+	var missing []string
+	for _, v := range declared {
+		raw, ok := values[v.Name]
+		switch {
+		case ok:
+			value, err := convertBundleVariable(v.Type, raw)
+			if err != nil {
+				return nil, errors.Annotatef(err, "variable %q", v.Name)
+			}
+			result[v.Name] = value
+		case v.HasDefault:
+			result[v.Name] = v.Default
+		default:
+			missing = append(missing, v.Name)
+		}
+	}
+
+	var undeclared []string
+	for name := range values {
+		if _, ok := result[name]; !ok && !containsString(missing, name) {
+			undeclared = append(undeclared, name)
+		}
+	}
+	if len(undeclared) > 0 {
+		sort.Strings(undeclared)
+		return nil, errors.NotValidf("variables %s not declared by the bundle", strings.Join(undeclared, ", "))
+	}
+	if len(missing) > 0 {
+		return nil, errors.NotValidf("missing values for variables %s", strings.Join(missing, ", "))
+	}
+	return result, nil
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func bundleVariableTypeOf(v interface{}) string {
+	switch v.(type) {
+	case int, int64, uint64:
+		return BundleVariableInt
+	case float64:
+		return BundleVariableFloat
+	case bool:
+		return BundleVariableBool
+	default:
+		return BundleVariableString
+	}
+}
+
+// convertBundleVariable converts v, which is a string supplied by the user
+// or a value from the bundle YAML, to the variable type.
+func convertBundleVariable(varType string, v interface{}) (interface{}, error) {
+	s, isString := v.(string)
+	switch varType {
+	case BundleVariableString:
+		if isString {
+			return s, nil
+		}
+		if v == nil {
+			return "", nil
+		}
+		return fmt.Sprint(v), nil
+	case BundleVariableInt:
+		switch v := v.(type) {
+		case int:
+			return v, nil
+		case int64:
+			return int(v), nil
+		case uint64:
+			return int(v), nil
+		}
+		if isString {
+			i, err := strconv.Atoi(strings.TrimSpace(s))
+			if err == nil {
+				return i, nil
+			}
+		}
+	case BundleVariableFloat:
+		switch v := v.(type) {
+		case int:
+			return float64(v), nil
+		case float64:
+			return v, nil
+		}
+		if isString {
+			f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+			if err == nil {
+				return f, nil
+			}
+		}
+	case BundleVariableBool:
+		if b, ok := v.(bool); ok {
+			return b, nil
+		}
+		if isString {
+			b, err := strconv.ParseBool(strings.TrimSpace(s))
+			if err == nil {
+				return b, nil
+			}
+		}
+	}
+	return nil, errors.NotValidf("value %q for type %s", fmt.Sprint(v), varType)
+}
+
+// bundleTemplate resolves the variables and directives in a bundle
+// document.
+type bundleTemplate struct {
+	values         map[string]interface{}
+	resolveInclude func(string) ([]byte, error)
+}
+
+// resolveDocument returns the document without its variables section and
+// with all variables and directives resolved. A document whose condition is
+// false resolves to an empty document.
+func (t bundleTemplate) resolveDocument(doc yaml.MapSlice) (yaml.MapSlice, error) {
+	doc, depth, err := t.expandIncludes(doc, 0)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var (
+		result  yaml.MapSlice
+		removed = make(map[string]bool)
+	)
+	for _, item := range doc {
+		if item.Key == bundleVariablesKey {
+			continue
+		}
+		if item.Key == bundleWhenKey {
+			ok, err := t.evaluate(item.Value)
+			if err != nil {
+				return nil, errors.Trace(err)
+			} else if !ok {
+				return yaml.MapSlice{}, nil
+			}
+			continue
+		}
+		key, err := t.substitute(item.Key)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		value := item.Value
+		if key == "applications" {
+			if value, err = t.resolveApplications(value, depth, removed); err != nil {
+				return nil, errors.Annotate(err, "applications")
+			}
+		} else {
+			var keep bool
+			if value, keep, err = t.resolve(value, depth); err != nil {
+				return nil, errors.Annotatef(err, "%v", key)
+			} else if !keep {
+				continue
+			}
+		}
+		result = append(result, yaml.MapItem{Key: key, Value: value})
+	}
+
+	if len(removed) == 0 {
+		return result, nil
+	}
+	for i, item := range result {
+		if item.Key == "relations" {
+			result[i].Value = removeConditionalRelations(item.Value, removed)
+		}
+	}
+	return result, nil
+}
+
+// resolveApplications resolves the applications section of a document,
+// recording the names of the applications removed by a condition.
+func (t bundleTemplate) resolveApplications(v interface{}, depth int, removed map[string]bool) (interface{}, error) {
+	apps, ok := v.(yaml.MapSlice)
+	if !ok {
+		value, _, err := t.resolve(v, depth)
+		return value, errors.Trace(err)
+	}
+	apps, depth, err := t.expandIncludes(apps, depth)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	result := yaml.MapSlice{}
+	for _, app := range apps {
+		name, err := t.substitute(app.Key)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		value, keep, err := t.resolve(app.Value, depth)
+		if err != nil {
+			return nil, errors.Annotatef(err, "application %v", name)
+		}
+		if !keep {
+			removed[fmt.Sprint(name)] = true
+			continue
+		}
+		result = append(result, yaml.MapItem{Key: name, Value: value})
+	}
+	return result, nil
+}
+
+// resolve returns v with all variables and directives resolved, and false
+// if v is a mapping whose condition is false.
+func (t bundleTemplate) resolve(v interface{}, depth int) (interface{}, bool, error) {
+	switch v := v.(type) {
+	case yaml.MapSlice:
+		m, depth, err := t.expandIncludes(v, depth)
+		if err != nil {
+			return nil, false, errors.Trace(err)
+		}
+		result := yaml.MapSlice{}
+		for _, item := range m {
+			if item.Key == bundleWhenKey {
+				ok, err := t.evaluate(item.Value)
+				if err != nil {
+					return nil, false, errors.Trace(err)
+				}
+				if !ok {
+					return nil, false, nil
+				}
+				continue
+			}
+			key, err := t.substitute(item.Key)
+			if err != nil {
+				return nil, false, errors.Trace(err)
+			}
+			value, keep, err := t.resolve(item.Value, depth)
+			if err != nil {
+				return nil, false, errors.Annotatef(err, "%v", key)
+			}
+			if keep {
+				result = append(result, yaml.MapItem{Key: key, Value: value})
+			}
+		}
+		return result, true, nil
+	case []interface{}:
+		result := make([]interface{}, 0, len(v))
+		for i, elem := range v {
+			value, keep, err := t.resolve(elem, depth)
+			if err != nil {
+				return nil, false, errors.Annotatef(err, "item %d", i)
+			}
+			if keep {
+				result = append(result, value)
+			}
+		}
+		return result, true, nil
+	default:
+		value, err := t.substitute(v)
+		if err != nil {
+			return nil, false, errors.Trace(err)
+		}
+		return value, true, nil
+	}
+}
+
+// expandIncludes merges the mappings named by an $include directive into m.
+// It returns the include depth reached, which mappings nested in m must be
+// resolved with, so that an include nested in an included file counts
+// towards the limit.
+func (t bundleTemplate) expandIncludes(m yaml.MapSlice, depth int) (yaml.MapSlice, int, error) {
+	for {
+		var (
+			path     interface{}
+			included bool
+			local    yaml.MapSlice
+		)
+		for _, item := range m {
+			if item.Key == bundleIncludeKey {
+				path, included = item.Value, true
+				continue
+			}
+			local = append(local, item)
+		}
+		if !included {
+			return m, depth, nil
+		}
+		if depth >= maxBundleIncludeDepth {
+			return nil, 0, errors.NotValidf("includes nested more than %d deep", maxBundleIncludeDepth)
+		}
+		depth++
+
+		resolved, err := t.substitute(path)
+		if err != nil {
+			return nil, 0, errors.Trace(err)
+		}
+		pathStr, ok := resolved.(string)
+		if !ok || pathStr == "" {
+			return nil, 0, errors.NotValidf("include path %v", path)
+		}
+		data, err := t.resolveInclude(pathStr)
+		if err != nil {
+			return nil, 0, errors.Annotatef(err, "resolving include %q", pathStr)
+		}
+		var inc yaml.MapSlice
+		if err := yaml.Unmarshal(data, &inc); err != nil {
+			return nil, 0, errors.NotValidf("include %q, which is not a YAML mapping", pathStr)
+		}
+
+		merged := make(yaml.MapSlice, 0, len(inc)+len(local))
+		for _, item := range inc {
+			if !mapSliceHasKey(local, item.Key) {
+				merged = append(merged, item)
+			}
+		}
+		m = append(merged, local...)
+	}
+}
+
+func mapSliceHasKey(m yaml.MapSlice, key interface{}) bool {
+	for _, item := range m {
+		if item.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// substitute replaces the variable references in v, if it's a string.
+// A reference to a variable not declared by the bundle is an error.
+func (t bundleTemplate) substitute(v interface{}) (interface{}, error) {
+	s, ok := v.(string)
+	if !ok || !strings.Contains(s, "$") {
+		return v, nil
+	}
+	if m := bundleVariableRef.FindStringSubmatchIndex(s); m != nil && m[0] == 0 && m[1] == len(s) && m[2] != -1 {
+		name := s[m[2]:m[3]]
+		value, ok := t.values[name]
+		if !ok {
+			return nil, errors.NotValidf("reference to undeclared variable %q", name)
+		}
+		return value, nil
+	}
+	var undeclared string
+	result := bundleVariableRef.ReplaceAllStringFunc(s, func(ref string) string {
+		if ref == "$${" {
+			return "${"
+		}
+		name := ref[2 : len(ref)-1]
+		value, ok := t.values[name]
+		if !ok {
+			if undeclared == "" {
+				undeclared = name
+			}
+			return ref
+		}
+		return fmt.Sprint(value)
+	})
+	if undeclared != "" {
+		return nil, errors.NotValidf("reference to undeclared variable %q", undeclared)
+	}
+	return result, nil
+}
+
+// evaluate returns the result of a $when condition.
+func (t bundleTemplate) evaluate(cond interface{}) (bool, error) {
+	resolved, err := t.substitute(cond)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	switch v := resolved.(type) {
+	case bool:
+		return v, nil
+	case string:
+		return evaluateBundleCondition(v)
+	default:
+		return false, errors.NotValidf("condition %v", cond)
+	}
+}
+
+func evaluateBundleCondition(cond string) (bool, error) {
+	cond = strings.TrimSpace(cond)
+	for _, op := range []string{"==", "!="} {
+		lhs, rhs, ok := strings.Cut(cond, op)
+		if !ok {
+			continue
+		}
+		equal := unquoteBundleOperand(lhs) == unquoteBundleOperand(rhs)
+		return equal == (op == "=="), nil
+	}
+	if rest, ok := strings.CutPrefix(cond, "!"); ok {
+		result, err := evaluateBundleCondition(rest)
+		return !result, errors.Trace(err)
+	}
+	result, err := strconv.ParseBool(cond)
+	if err != nil {
+		return false, errors.NotValidf("condition %q", cond)
+	}
+	return result, nil
+}
+
+func unquoteBundleOperand(s string) string {
+	s = strings.TrimSpace(s)
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
+
+// removeConditionalRelations removes the relations which refer to the
+// removed applications.
+func removeConditionalRelations(v interface{}, removed map[string]bool) interface{} {
+	relations, ok := v.([]interface{})
+	if !ok {
+		return v
+	}
+	result := make([]interface{}, 0, len(relations))
+	for _, rel := range relations {
+		endpoints, _ := rel.([]interface{})
+		var drop bool
+		for _, ep := range endpoints {
+			app, _, _ := strings.Cut(fmt.Sprint(ep), ":")
+			drop = drop || removed[app]
+		}
+		if !drop {
+			result = append(result, rel)
+		}
+	}
+	return result
+}
@@ -0,0 +1,359 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENCE file for details.
+
+package charm_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/internal/charm"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type bundleVariablesSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestBundleVariablesSuite(t *testing.T) {
+	tc.Run(t, &bundleVariablesSuite{})
+}
+
+func (s *bundleVariablesSuite) resolve(c *tc.C, values map[string]string, data ...string) (*charm.BundleData, error) {
+	sources := make([]charm.BundleDataSource, len(data))
+	for i, d := range data {
+		sources[i] = mustCreateStringDataSource(c, d)
+	}
+	resolved, err := charm.ResolveBundleVariables(values, sources...)
+	if err != nil {
+		return nil, err
+	}
+	for _, src := range resolved {
+		for _, part := range src.Parts() {
+			c.Assert(part.UnmarshallError, tc.ErrorIsNil)
+		}
+	}
+	return charm.ReadAndMergeBundleData(resolved...)
+}
+
+func (s *bundleVariablesSuite) TestNoTemplatingReturnsSources(c *tc.C) {
+	src := mustCreateStringDataSource(c, `
+applications:
+  mysql:
+    charm: mysql
+    options:
+      script: echo ${HOME}
+`)
+	resolved, err := charm.ResolveBundleVariables(nil, src)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(resolved, tc.HasLen, 1)
+	c.Check(resolved[0], tc.Equals, src)
+}
+
+func (s *bundleVariablesSuite) TestDefaults(c *tc.C) {
+	bd, err := s.resolve(c, nil, `
+variables:
+  channel: edge
+  units:
+    type: int
+    default: 3
+  trust:
+    type: bool
+    default: true
+applications:
+  mysql:
+    charm: mysql
+    channel: ${channel}
+    num_units: ${units}
+    trust: ${trust}
+    options:
+      script: echo $${HOME} $${channel}
+      name: mysql-${channel}
+`)
+	c.Assert(err, tc.ErrorIsNil)
+	app := bd.Applications["mysql"]
+	c.Check(app.Channel, tc.Equals, "edge")
+	c.Check(app.NumUnits, tc.Equals, 3)
+	c.Check(app.RequiresTrust, tc.IsTrue)
+	c.Check(app.Options, tc.DeepEquals, map[string]interface{}{
+		"script": "echo ${HOME} ${channel}",
+		"name":   "mysql-edge",
+	})
+}
+
+func (s *bundleVariablesSuite) TestSuppliedValuesAcrossOverlays(c *tc.C) {
+	bd, err := s.resolve(c, map[string]string{"units": "5", "env": "prod"}, `
+variables:
+  env:
+    type: string
+  units:
+    type: int
+    default: 1
+applications:
+  mysql:
+    charm: mysql
+    num_units: ${units}
+`, `
+applications:
+  mysql:
+    annotations:
+      env: ${env}
+`)
+	c.Assert(err, tc.ErrorIsNil)
+	app := bd.Applications["mysql"]
+	c.Check(app.NumUnits, tc.Equals, 5)
+	c.Check(app.Annotations, tc.DeepEquals, map[string]string{"env": "prod"})
+}
+
+func (s *bundleVariablesSuite) TestMissingValue(c *tc.C) {
+	_, err := s.resolve(c, nil, `
+variables:
+  password:
+    description: the admin password
+applications:
+  mysql:
+    charm: mysql
+`)
+	c.Check(err, tc.ErrorIs, errors.NotValid)
+	c.Check(err, tc.ErrorMatches, `missing values for variables password not valid`)
+}
+
+func (s *bundleVariablesSuite) TestUndeclaredValue(c *tc.C) {
+	_, err := s.resolve(c, map[string]string{"unit": "1"}, `
+variables:
+  units: 1
+applications:
+  mysql:
+    charm: mysql
+`)
+	c.Check(err, tc.ErrorMatches, `variables unit not declared by the bundle not valid`)
+}
+
+func (s *bundleVariablesSuite) TestUndeclaredReference(c *tc.C) {
+	_, err := s.resolve(c, nil, `
+variables:
+  channel: edge
+applications:
+  mysql:
+    charm: mysql
+    channel: ${channel}
+    options:
+      name: mysql-${chanel}
+`)
+	c.Check(err, tc.ErrorIs, errors.NotValid)
+	c.Check(err, tc.ErrorMatches, `.*reference to undeclared variable "chanel" not valid`)
+}
+
+func (s *bundleVariablesSuite) TestInvalidValue(c *tc.C) {
+	_, err := s.resolve(c, map[string]string{"units": "many"}, `
+variables:
+  units: 1
+applications:
+  mysql:
+    charm: mysql
+`)
+	c.Check(err, tc.ErrorMatches, `variable "units": value "many" for type int not valid`)
+}
+
+func (s *bundleVariablesSuite) TestConflictingDeclarations(c *tc.C) {
+	_, err := s.resolve(c, nil, `
+variables:
+  units: 1
+applications:
+  mysql:
+    charm: mysql
+`, `
+variables:
+  units: one
+`)
+	c.Check(err, tc.ErrorMatches, `variable "units" declared as both int and string not valid`)
+}
+
+func (s *bundleVariablesSuite) TestConditionals(c *tc.C) {
+	data := `
+variables:
+  env: staging
+  ha: false
+applications:
+  mysql:
+    charm: mysql
+    num_units: 1
+  mysql-router:
+    $when: ${ha}
+    charm: mysql-router
+  grafana:
+    $when: ${env} != staging
+    charm: grafana
+    options:
+      admin:
+        $when: ${env} == "production"
+        user: admin
+relations:
+- [mysql:db, mysql-router:db]
+`
+	bd, err := s.resolve(c, nil, data)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(bd.Applications, tc.HasLen, 1)
+	c.Check(bd.Applications["mysql"], tc.NotNil)
+	c.Check(bd.Relations, tc.HasLen, 0)
+
+	bd, err = s.resolve(c, map[string]string{"ha": "true", "env": "production"}, data)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(bd.Applications, tc.HasLen, 3)
+	c.Check(bd.Applications["grafana"].Options, tc.HasLen, 1)
+	c.Check(bd.Relations, tc.DeepEquals, [][]string{{"mysql:db", "mysql-router:db"}})
+}
+
+func (s *bundleVariablesSuite) TestConditionalOverlay(c *tc.C) {
+	bd, err := s.resolve(c, nil, `
+variables:
+  env: staging
+applications:
+  mysql:
+    charm: mysql
+    num_units: 1
+`, `
+$when: ${env} == production
+applications:
+  mysql:
+    num_units: 3
+`)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(bd.Applications["mysql"].NumUnits, tc.Equals, 1)
+}
+
+func (s *bundleVariablesSuite) TestInvalidCondition(c *tc.C) {
+	_, err := s.resolve(c, nil, `
+variables:
+  env: staging
+applications:
+  mysql:
+    $when: ${env}
+    charm: mysql
+`)
+	c.Check(err, tc.ErrorMatches, `.*condition "staging" not valid`)
+}
+
+func (s *bundleVariablesSuite) TestIncludes(c *tc.C) {
+	dir := c.MkDir()
+	mustWriteFile(c, filepath.Join(dir, "vars.yaml"), `
+units:
+  type: int
+  default: 2
+`)
+	mustWriteFile(c, filepath.Join(dir, "mysql.yaml"), `
+charm: mysql
+num_units: ${units}
+options:
+  $include: mysql-options.yaml
+`)
+	mustWriteFile(c, filepath.Join(dir, "mysql-options.yaml"), `
+max-connections: 100
+flavour: percona
+`)
+	src := mustCreateStringDataSourceWithBasePath(c, `
+variables:
+  $include: vars.yaml
+applications:
+  mysql:
+    $include: mysql.yaml
+    options:
+      $include: mysql-options.yaml
+      flavour: mariadb
+`, dir)
+	resolved, err := charm.ResolveBundleVariables(nil, src)
+	c.Assert(err, tc.ErrorIsNil)
+	bd, err := charm.ReadAndMergeBundleData(resolved...)
+	c.Assert(err, tc.ErrorIsNil)
+	app := bd.Applications["mysql"]
+	c.Check(app.Charm, tc.Equals, "mysql")
+	c.Check(app.NumUnits, tc.Equals, 2)
+	c.Check(app.Options, tc.DeepEquals, map[string]interface{}{
+		"max-connections": 100,
+		"flavour":         "mariadb",
+	})
+}
+
+func (s *bundleVariablesSuite) TestIncludeLoop(c *tc.C) {
+	dir := c.MkDir()
+	mustWriteFile(c, filepath.Join(dir, "loop.yaml"), `
+$include: loop.yaml
+`)
+	src := mustCreateStringDataSourceWithBasePath(c, `
+applications:
+  mysql:
+    $include: loop.yaml
+`, dir)
+	_, err := charm.ResolveBundleVariables(nil, src)
+	c.Check(err, tc.ErrorMatches, `.*includes nested more than 10 deep not valid`)
+}
+
+func (s *bundleVariablesSuite) TestNestedIncludeLoop(c *tc.C) {
+	dir := c.MkDir()
+	mustWriteFile(c, filepath.Join(dir, "nested.yaml"), `
+options:
+  $include: nested.yaml
+`)
+	src := mustCreateStringDataSourceWithBasePath(c, `
+applications:
+  mysql:
+    $include: nested.yaml
+`, dir)
+	_, err := charm.ResolveBundleVariables(nil, src)
+	c.Check(err, tc.ErrorMatches, `.*includes nested more than 10 deep not valid`)
+}
+
+func (s *bundleVariablesSuite) TestParameteriseBundle(c *tc.C) {
+	out, err := charm.ParameteriseBundle([]byte(`
+default-base: ubuntu@22.04
+applications:
+  mysql:
+    charm: mysql
+    channel: 8.0/stable
+    num_units: 3
+relations:
+- - mysql:db
+  - wordpress:db
+--- # overlay.yaml
+applications:
+  mysql:
+    offers:
+      db:
+        endpoints:
+        - db
+`))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(out), tc.Equals, `
+variables:
+  mysql-channel:
+    type: string
+    default: 8.0/stable
+  mysql-num-units:
+    type: int
+    default: 3
+default-base: ubuntu@22.04
+applications:
+  mysql:
+    charm: mysql
+    channel: ${mysql-channel}
+    num_units: ${mysql-num-units}
+relations:
+- - mysql:db
+  - wordpress:db
+---
+applications:
+  mysql:
+    offers:
+      db:
+        endpoints:
+        - db
+`[1:])
+
+	bd, err := s.resolve(c, map[string]string{"mysql-num-units": "1"}, string(out))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(bd.Applications["mysql"].Channel, tc.Equals, "8.0/stable")
+	c.Check(bd.Applications["mysql"].NumUnits, tc.Equals, 1)
+}
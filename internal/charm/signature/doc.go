@@ -0,0 +1,16 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package signature verifies detached signatures over charm archives and
+// charm resources.
+//
+// The signed message is the lowercase hex digest which identifies the
+// content: the SHA256 hash of a charm archive, or the SHA384 fingerprint of a
+// resource. A trailing newline is allowed, so the output of sha256sum (cut to
+// the digest) can be signed directly.
+//
+// Two signature formats are supported:
+//   - SSH signatures, as created by "ssh-keygen -Y sign -n juju-charm", which
+//     are verified against public keys in authorized_keys format.
+//   - minisign signatures, which are verified against minisign public keys.
+package signature
@@ -0,0 +1,104 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package signature
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"strings"
+
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	minisignUntrustedComment = "untrusted comment:"
+	minisignTrustedComment   = "trusted comment:"
+
+	// minisignAlgorithm signs the message itself, while
+	// minisignHashedAlgorithm signs the BLAKE2b-512 hash of the message.
+	minisignAlgorithm       = "Ed"
+	minisignHashedAlgorithm = "ED"
+
+	minisignKeyIDSize = 8
+)
+
+// minisignKey is a minisign public key.
+type minisignKey struct {
+	id  [minisignKeyIDSize]byte
+	key ed25519.PublicKey
+}
+
+func parseMinisignKey(line string) (minisignKey, error) {
+	raw, err := base64.StdEncoding.DecodeString(line)
+	if err != nil {
+		return minisignKey{}, errors.Errorf("decoding minisign key: %w", KeyNotValid)
+	}
+	if len(raw) != 2+minisignKeyIDSize+ed25519.PublicKeySize || string(raw[:2]) != minisignAlgorithm {
+		return minisignKey{}, errors.Errorf("unrecognised key format: %w", KeyNotValid)
+	}
+	var key minisignKey
+	copy(key.id[:], raw[2:2+minisignKeyIDSize])
+	key.key = ed25519.PublicKey(raw[2+minisignKeyIDSize:])
+	return key, nil
+}
+
+func (k Keys) verifyMinisign(message []byte, text string) error {
+	lines := strings.Split(strings.TrimSpace(text), "\n")
+	if len(lines) != 4 {
+		return errors.Errorf("expected 4 lines in minisign signature, got %d: %w", len(lines), SignatureNotValid)
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(lines[1]))
+	if err != nil || len(sig) != 2+minisignKeyIDSize+ed25519.SignatureSize {
+		return errors.Errorf("decoding minisign signature: %w", SignatureNotValid)
+	}
+	algorithm := string(sig[:2])
+	switch algorithm {
+	case minisignAlgorithm:
+	case minisignHashedAlgorithm:
+		sum := blake2b.Sum512(message)
+		message = sum[:]
+	default:
+		return errors.Errorf("unsupported minisign algorithm %q: %w", algorithm, SignatureNotValid)
+	}
+	keyID := sig[2 : 2+minisignKeyIDSize]
+	sig = sig[2+minisignKeyIDSize:]
+
+	trustedComment, ok := strings.CutPrefix(strings.TrimSpace(lines[2]), minisignTrustedComment)
+	if !ok {
+		return errors.Errorf("missing minisign trusted comment: %w", SignatureNotValid)
+	}
+	trustedComment = strings.TrimPrefix(trustedComment, " ")
+	globalSig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(lines[3]))
+	if err != nil || len(globalSig) != ed25519.SignatureSize {
+		return errors.Errorf("decoding minisign global signature: %w", SignatureNotValid)
+	}
+
+	var key *minisignKey
+	for i := range k.minisign {
+		if bytes.Equal(k.minisign[i].id[:], keyID) {
+			key = &k.minisign[i]
+			break
+		}
+	}
+	// minisign displays key IDs as little-endian hex.
+	displayID := make([]byte, minisignKeyIDSize)
+	for i := range keyID {
+		displayID[i] = keyID[minisignKeyIDSize-1-i]
+	}
+	if key == nil {
+		return errors.Errorf("minisign key %X: %w", displayID, KeyNotTrusted)
+	}
+
+	if !ed25519.Verify(key.key, message, sig) {
+		return errors.Errorf("minisign key %X: %w", displayID, SignatureNotValid)
+	}
+	if !ed25519.Verify(key.key, append(append([]byte{}, sig...), trustedComment...), globalSig) {
+		return errors.Errorf("minisign trusted comment: %w", SignatureNotValid)
+	}
+	return nil
+}
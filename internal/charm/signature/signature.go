@@ -0,0 +1,126 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package signature
+
+import (
+	"bufio"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// SignatureMissing describes an error that occurs when content which
+	// must be signed has no signature.
+	SignatureMissing = errors.ConstError("signature missing")
+
+	// SignatureNotValid describes an error that occurs when a signature
+	// can't be parsed, or doesn't match the signed content.
+	SignatureNotValid = errors.ConstError("signature not valid")
+
+	// KeyNotTrusted describes an error that occurs when a signature was
+	// made with a key which isn't one of the trusted keys.
+	KeyNotTrusted = errors.ConstError("signing key not trusted")
+
+	// KeyNotValid describes an error that occurs when a public key can't be
+	// parsed.
+	KeyNotValid = errors.ConstError("public key not valid")
+)
+
+// SSHNamespace is the namespace SSH signatures must be made in, as given to
+// "ssh-keygen -Y sign -n".
+const SSHNamespace = "juju-charm"
+
+// Keys holds the public keys trusted to sign charms and resources.
+type Keys struct {
+	ssh      []ssh.PublicKey
+	minisign []minisignKey
+}
+
+// ParseKeys parses a set of public keys, one per line. Each line is either
+// an SSH public key in authorized_keys format, or a minisign public key.
+// Blank lines, comments starting with "#" and minisign "untrusted comment"
+// lines are ignored.
+func ParseKeys(text string) (Keys, error) {
+	var keys Keys
+	scanner := bufio.NewScanner(strings.NewReader(text))
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "", strings.HasPrefix(line, "#"),
+			strings.HasPrefix(line, minisignUntrustedComment):
+			continue
+		case isSSHKey(line):
+			key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(line))
+			if err != nil {
+				return Keys{}, errors.Errorf("line %d: %w: %v", lineNum, KeyNotValid, err)
+			}
+			keys.ssh = append(keys.ssh, key)
+		default:
+			key, err := parseMinisignKey(line)
+			if err != nil {
+				return Keys{}, errors.Errorf("line %d: %w", lineNum, err)
+			}
+			keys.minisign = append(keys.minisign, key)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return Keys{}, errors.Capture(err)
+	}
+	return keys, nil
+}
+
+// Len returns the number of trusted keys.
+func (k Keys) Len() int {
+	return len(k.ssh) + len(k.minisign)
+}
+
+// Verify checks that the signature is a valid signature of the hex digest,
+// made by one of the trusted keys.
+//
+// The following errors may be returned:
+//   - [SignatureMissing] if the signature is empty.
+//   - [SignatureNotValid] if the signature can't be parsed, or doesn't match
+//     the digest.
+//   - [KeyNotTrusted] if the signature was made by an untrusted key.
+func (k Keys) Verify(hexDigest, signature string) error {
+	signature = strings.TrimSpace(signature)
+	if signature == "" {
+		return SignatureMissing
+	}
+	if hexDigest == "" {
+		return errors.Errorf("empty digest: %w", SignatureNotValid)
+	}
+
+	var verify func(message []byte, signature string) error
+	switch {
+	case strings.HasPrefix(signature, sshSignatureHeader):
+		verify = k.verifySSH
+	case strings.HasPrefix(signature, minisignUntrustedComment):
+		verify = k.verifyMinisign
+	default:
+		return errors.Errorf("unknown signature format: %w", SignatureNotValid)
+	}
+
+	// The digest may have been signed with or without a trailing newline.
+	digest := strings.ToLower(hexDigest)
+	err := verify([]byte(digest), signature)
+	if errors.Is(err, SignatureNotValid) {
+		if nlErr := verify([]byte(digest+"\n"), signature); nlErr == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+func isSSHKey(line string) bool {
+	for _, prefix := range []string{"ssh-", "ecdsa-", "sk-"} {
+		if strings.HasPrefix(line, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,198 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package signature
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/juju/tc"
+	"golang.org/x/crypto/blake2b"
+)
+
+const (
+	// The following were created with:
+	//   ssh-keygen -t ed25519 -f key
+	//   printf '%s' <digest> > msg
+	//   ssh-keygen -Y sign -n juju-charm -f key msg
+	sshDigest    = "3c2b6fd8a3e4a1fdbd2f7bd7e9b3cf1c6d1b6b9c2f2a6e0f0b5a4c1a8e3f9d2b"
+	sshPublicKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIIy7iZh6slcBa6/gSFuKRzkNFgjZh1uhPmYnnu1DaKcf test"
+
+	sshSig = `-----BEGIN SSH SIGNATURE-----
+U1NIU0lHAAAAAQAAADMAAAALc3NoLWVkMjU1MTkAAAAgjLuJmHqyVwFrr+BIW4pHOQ0WCN
+mHW6E+Ziee7UNopx8AAAAKanVqdS1jaGFybQAAAAAAAAAGc2hhNTEyAAAAUwAAAAtzc2gt
+ZWQyNTUxOQAAAEARKOLkCUr0T6C7hop+QNy1PCQyymj+iB6m71P6xK5Y1mw/tRwKO/6HiI
+vw2UR/6Nyy9YvzbIMJoqutZZYjrfwA
+-----END SSH SIGNATURE-----
+`
+
+	// Signed with "-n other" rather than the juju-charm namespace.
+	sshSigOtherNamespace = `-----BEGIN SSH SIGNATURE-----
+U1NIU0lHAAAAAQAAADMAAAALc3NoLWVkMjU1MTkAAAAgjLuJmHqyVwFrr+BIW4pHOQ0WCN
+mHW6E+Ziee7UNopx8AAAAFb3RoZXIAAAAAAAAABnNoYTUxMgAAAFMAAAALc3NoLWVkMjU1
+MTkAAABAxYyebnythXEUcrcvwrqmv2fJLu0rO1KZ7gDBeaj61prXzzMZaFJ+NMhsmp4LYo
+NQtuGOIQ4LpRgxLInn50+oAA==
+-----END SSH SIGNATURE-----
+`
+
+	otherSSHPublicKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIJ1AOzEiFzJ8VGluTmH2JfGKhSRpi74m1AYKKf7qCOMb other"
+)
+
+type signatureSuite struct{}
+
+func TestSignatureSuite(t *testing.T) {
+	tc.Run(t, &signatureSuite{})
+}
+
+func (s *signatureSuite) TestParseKeys(c *tc.C) {
+	mk := newMinisignKey(c)
+	keys, err := ParseKeys(fmt.Sprintf(`
+# Charm publishers.
+%s
+
+untrusted comment: minisign public key
+%s
+`, sshPublicKey, mk.publicKey()))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(keys.Len(), tc.Equals, 2)
+}
+
+func (s *signatureSuite) TestParseKeysEmpty(c *tc.C) {
+	keys, err := ParseKeys("")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(keys.Len(), tc.Equals, 0)
+}
+
+func (s *signatureSuite) TestParseKeysNotValid(c *tc.C) {
+	_, err := ParseKeys("ssh-ed25519 not-base64")
+	c.Check(err, tc.ErrorIs, KeyNotValid)
+
+	_, err = ParseKeys("\nbm90IGEga2V5")
+	c.Check(err, tc.ErrorIs, KeyNotValid)
+	c.Check(err, tc.ErrorMatches, "line 2: .*")
+}
+
+func (s *signatureSuite) TestVerifySSH(c *tc.C) {
+	keys, err := ParseKeys(sshPublicKey)
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = keys.Verify(sshDigest, sshSig)
+	c.Check(err, tc.ErrorIsNil)
+}
+
+func (s *signatureSuite) TestVerifySSHWrongDigest(c *tc.C) {
+	keys, err := ParseKeys(sshPublicKey)
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = keys.Verify("deadbeef", sshSig)
+	c.Check(err, tc.ErrorIs, SignatureNotValid)
+}
+
+func (s *signatureSuite) TestVerifySSHUntrustedKey(c *tc.C) {
+	keys, err := ParseKeys(otherSSHPublicKey)
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = keys.Verify(sshDigest, sshSig)
+	c.Check(err, tc.ErrorIs, KeyNotTrusted)
+}
+
+func (s *signatureSuite) TestVerifySSHWrongNamespace(c *tc.C) {
+	keys, err := ParseKeys(sshPublicKey)
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = keys.Verify(sshDigest, sshSigOtherNamespace)
+	c.Check(err, tc.ErrorIs, SignatureNotValid)
+	c.Check(err, tc.ErrorMatches, `.*namespace "other".*`)
+}
+
+func (s *signatureSuite) TestVerifyMinisign(c *tc.C) {
+	mk := newMinisignKey(c)
+	keys, err := ParseKeys(mk.publicKey())
+	c.Assert(err, tc.ErrorIsNil)
+
+	for _, alg := range []string{minisignAlgorithm, minisignHashedAlgorithm} {
+		err = keys.Verify("ABCDEF", mk.sign(c, alg, "abcdef"))
+		c.Check(err, tc.ErrorIsNil, tc.Commentf("algorithm %q", alg))
+	}
+}
+
+func (s *signatureSuite) TestVerifyMinisignTrailingNewline(c *tc.C) {
+	mk := newMinisignKey(c)
+	keys, err := ParseKeys(mk.publicKey())
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = keys.Verify("abcdef", mk.sign(c, minisignHashedAlgorithm, "abcdef\n"))
+	c.Check(err, tc.ErrorIsNil)
+}
+
+func (s *signatureSuite) TestVerifyMinisignWrongDigest(c *tc.C) {
+	mk := newMinisignKey(c)
+	keys, err := ParseKeys(mk.publicKey())
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = keys.Verify("012345", mk.sign(c, minisignHashedAlgorithm, "abcdef"))
+	c.Check(err, tc.ErrorIs, SignatureNotValid)
+}
+
+func (s *signatureSuite) TestVerifyMinisignUntrustedKey(c *tc.C) {
+	keys, err := ParseKeys(newMinisignKey(c).publicKey())
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = keys.Verify("abcdef", newMinisignKey(c).sign(c, minisignHashedAlgorithm, "abcdef"))
+	c.Check(err, tc.ErrorIs, KeyNotTrusted)
+}
+
+func (s *signatureSuite) TestVerifyMissing(c *tc.C) {
+	keys, err := ParseKeys(sshPublicKey)
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = keys.Verify(sshDigest, " \n")
+	c.Check(err, tc.ErrorIs, SignatureMissing)
+}
+
+func (s *signatureSuite) TestVerifyUnknownFormat(c *tc.C) {
+	keys, err := ParseKeys(sshPublicKey)
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = keys.Verify(sshDigest, "-----BEGIN PGP SIGNATURE-----")
+	c.Check(err, tc.ErrorIs, SignatureNotValid)
+}
+
+type testMinisignKey struct {
+	id   [minisignKeyIDSize]byte
+	pub  ed25519.PublicKey
+	priv ed25519.PrivateKey
+}
+
+func newMinisignKey(c *tc.C) testMinisignKey {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	c.Assert(err, tc.ErrorIsNil)
+	k := testMinisignKey{pub: pub, priv: priv}
+	_, err = rand.Read(k.id[:])
+	c.Assert(err, tc.ErrorIsNil)
+	return k
+}
+
+func (k testMinisignKey) publicKey() string {
+	raw := append([]byte(minisignAlgorithm), k.id[:]...)
+	return base64.StdEncoding.EncodeToString(append(raw, k.pub...))
+}
+
+func (k testMinisignKey) sign(c *tc.C, alg, message string) string {
+	signed := []byte(message)
+	if alg == minisignHashedAlgorithm {
+		sum := blake2b.Sum512(signed)
+		signed = sum[:]
+	}
+	sig := ed25519.Sign(k.priv, signed)
+	raw := append(append([]byte(alg), k.id[:]...), sig...)
+
+	trustedComment := "timestamp:1700000000\tfile:charm.sha256"
+	globalSig := ed25519.Sign(k.priv, append(append([]byte{}, sig...), trustedComment...))
+	return fmt.Sprintf("untrusted comment: signature from minisign secret key\n%s\ntrusted comment: %s\n%s\n",
+		base64.StdEncoding.EncodeToString(raw), trustedComment, base64.StdEncoding.EncodeToString(globalSig))
+}
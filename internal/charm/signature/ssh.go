@@ -0,0 +1,125 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package signature
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	sshSignatureHeader = "-----BEGIN SSH SIGNATURE-----"
+	sshSignatureFooter = "-----END SSH SIGNATURE-----"
+	sshSignatureMagic  = "SSHSIG"
+)
+
+// sshSignature is the wire format of an SSH signature, following the magic
+// preamble. See PROTOCOL.sshsig in the OpenSSH sources.
+type sshSignature struct {
+	Version       uint32
+	PublicKey     []byte
+	Namespace     string
+	Reserved      string
+	HashAlgorithm string
+	Signature     []byte
+}
+
+// sshSignedData is the data an SSH signature is made over, following the
+// magic preamble.
+type sshSignedData struct {
+	Namespace     string
+	Reserved      string
+	HashAlgorithm string
+	Hash          []byte
+}
+
+func (k Keys) verifySSH(message []byte, armored string) error {
+	blob, err := decodeSSHSignature(armored)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	if blob.Version != 1 {
+		return errors.Errorf("unsupported ssh signature version %d: %w", blob.Version, SignatureNotValid)
+	}
+	if blob.Namespace != SSHNamespace {
+		return errors.Errorf("ssh signature namespace %q, expected %q: %w", blob.Namespace, SSHNamespace, SignatureNotValid)
+	}
+
+	var hash []byte
+	switch blob.HashAlgorithm {
+	case "sha256":
+		sum := sha256.Sum256(message)
+		hash = sum[:]
+	case "sha512":
+		sum := sha512.Sum512(message)
+		hash = sum[:]
+	default:
+		return errors.Errorf("unsupported ssh signature hash %q: %w", blob.HashAlgorithm, SignatureNotValid)
+	}
+
+	pub, err := ssh.ParsePublicKey(blob.PublicKey)
+	if err != nil {
+		return errors.Errorf("parsing ssh signature public key: %w", SignatureNotValid)
+	}
+	if !k.trustsSSHKey(pub) {
+		return errors.Errorf("ssh key %s: %w", ssh.FingerprintSHA256(pub), KeyNotTrusted)
+	}
+
+	var sig ssh.Signature
+	if err := ssh.Unmarshal(blob.Signature, &sig); err != nil {
+		return errors.Errorf("parsing ssh signature: %w", SignatureNotValid)
+	}
+	signed := append([]byte(sshSignatureMagic), ssh.Marshal(sshSignedData{
+		Namespace:     blob.Namespace,
+		HashAlgorithm: blob.HashAlgorithm,
+		Hash:          hash,
+	})...)
+	if err := pub.Verify(signed, &sig); err != nil {
+		return errors.Errorf("ssh key %s: %w", ssh.FingerprintSHA256(pub), SignatureNotValid)
+	}
+	return nil
+}
+
+func (k Keys) trustsSSHKey(pub ssh.PublicKey) bool {
+	marshalled := pub.Marshal()
+	for _, key := range k.ssh {
+		if bytes.Equal(key.Marshal(), marshalled) {
+			return true
+		}
+	}
+	return false
+}
+
+func decodeSSHSignature(armored string) (sshSignature, error) {
+	armored = strings.TrimSpace(armored)
+	body, ok := strings.CutPrefix(armored, sshSignatureHeader)
+	if !ok {
+		return sshSignature{}, errors.Errorf("missing ssh signature header: %w", SignatureNotValid)
+	}
+	body, ok = strings.CutSuffix(body, sshSignatureFooter)
+	if !ok {
+		return sshSignature{}, errors.Errorf("missing ssh signature footer: %w", SignatureNotValid)
+	}
+	raw, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(body), ""))
+	if err != nil {
+		return sshSignature{}, errors.Errorf("decoding ssh signature: %w", SignatureNotValid)
+	}
+	rest, ok := bytes.CutPrefix(raw, []byte(sshSignatureMagic))
+	if !ok {
+		return sshSignature{}, errors.Errorf("missing ssh signature preamble: %w", SignatureNotValid)
+	}
+
+	var blob sshSignature
+	if err := ssh.Unmarshal(rest, &blob); err != nil {
+		return sshSignature{}, errors.Errorf("parsing ssh signature: %w", SignatureNotValid)
+	}
+	return blob, nil
+}
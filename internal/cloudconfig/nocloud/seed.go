@@ -0,0 +1,176 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package nocloud writes seed images for the cloud-init NoCloud datasource,
+// for providers whose instances boot from a disk image rather than receive
+// user data from a metadata service.
+package nocloud
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sort"
+)
+
+// The seed image is an ISO 9660 image labelled "cidata", which cloud-init's
+// NoCloud datasource reads the instance's user and meta data from. It is
+// written here rather than with an external tool such as cloud-localds so
+// that it can be created on any controller, whatever is installed on it.
+const (
+	seedVolumeLabel = "CIDATA"
+	seedSectorSize  = 2048
+
+	// The layout of the image is fixed: the system area, a primary volume
+	// descriptor, a terminator, the two path tables and a single root
+	// directory, followed by the file contents.
+	seedPVDSector       = 16
+	seedTerminator      = 17
+	seedLPathSector     = 18
+	seedMPathSector     = 19
+	seedRootDirSector   = 20
+	seedFirstFileSector = 21
+
+	// seedMinSectors is the minimum size of a seed image. Some readers,
+	// libarchive among them, read ahead a fixed number of sectors before
+	// recognising an image, so small images are padded.
+	seedMinSectors = 32
+)
+
+// seedFile is a file in the root directory of a seed image.
+type seedFile struct {
+	name    string
+	content []byte
+	sector  uint32
+}
+
+// SeedImage returns a NoCloud seed image holding the input user data and
+// meta data.
+func SeedImage(userData, metaData []byte) ([]byte, error) {
+	files := []*seedFile{
+		{name: "USER-DATA;1", content: userData},
+		{name: "META-DATA;1", content: metaData},
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].name < files[j].name
+	})
+
+	sector := uint32(seedFirstFileSector)
+	for _, f := range files {
+		f.sector = sector
+		sector += sectorsFor(len(f.content))
+	}
+	totalSectors := max(sector, seedMinSectors)
+
+	rootDir, err := seedRootDirectory(files)
+	if err != nil {
+		return nil, err
+	}
+
+	image := make([]byte, int(totalSectors)*seedSectorSize)
+	copy(image[seedPVDSector*seedSectorSize:], seedPrimaryVolumeDescriptor(totalSectors))
+	copy(image[seedTerminator*seedSectorSize:], []byte{255, 'C', 'D', '0', '0', '1', 1})
+	copy(image[seedLPathSector*seedSectorSize:], seedPathTable(binary.LittleEndian))
+	copy(image[seedMPathSector*seedSectorSize:], seedPathTable(binary.BigEndian))
+	copy(image[seedRootDirSector*seedSectorSize:], rootDir)
+	for _, f := range files {
+		copy(image[int(f.sector)*seedSectorSize:], f.content)
+	}
+	return image, nil
+}
+
+func sectorsFor(size int) uint32 {
+	if size == 0 {
+		return 0
+	}
+	return uint32((size + seedSectorSize - 1) / seedSectorSize)
+}
+
+func seedPrimaryVolumeDescriptor(totalSectors uint32) []byte {
+	pvd := make([]byte, seedSectorSize)
+	pvd[0] = 1
+	copy(pvd[1:6], "CD001")
+	pvd[6] = 1
+	padded(pvd[8:40], "")
+	padded(pvd[40:72], seedVolumeLabel)
+	bothEndian32(pvd[80:88], totalSectors)
+	bothEndian16(pvd[120:124], 1)
+	bothEndian16(pvd[124:128], 1)
+	bothEndian16(pvd[128:132], seedSectorSize)
+	bothEndian32(pvd[132:140], seedPathTableSize)
+	binary.LittleEndian.PutUint32(pvd[140:144], seedLPathSector)
+	binary.BigEndian.PutUint32(pvd[148:152], seedMPathSector)
+	copy(pvd[156:190], seedDirectoryRecord([]byte{0}, seedRootDirSector, seedSectorSize, true))
+	for _, field := range [][2]int{{190, 318}, {318, 446}, {446, 574}, {574, 702}, {702, 739}, {739, 776}, {776, 813}} {
+		padded(pvd[field[0]:field[1]], "")
+	}
+	// The creation, modification, expiration and effective dates are
+	// all left unspecified.
+	for _, offset := range []int{813, 830, 847, 864} {
+		copy(pvd[offset:offset+16], "0000000000000000")
+	}
+	pvd[881] = 1
+	return pvd
+}
+
+// seedPathTableSize is the size of a path table holding only the root
+// directory.
+const seedPathTableSize = 10
+
+func seedPathTable(order binary.ByteOrder) []byte {
+	table := make([]byte, seedPathTableSize)
+	table[0] = 1
+	order.PutUint32(table[2:6], seedRootDirSector)
+	order.PutUint16(table[6:8], 1)
+	return table
+}
+
+func seedRootDirectory(files []*seedFile) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.Write(seedDirectoryRecord([]byte{0}, seedRootDirSector, seedSectorSize, true))
+	buf.Write(seedDirectoryRecord([]byte{1}, seedRootDirSector, seedSectorSize, true))
+	for _, f := range files {
+		buf.Write(seedDirectoryRecord([]byte(f.name), f.sector, uint32(len(f.content)), false))
+	}
+	if buf.Len() > seedSectorSize {
+		return nil, fmt.Errorf("seed image directory exceeds %d bytes", seedSectorSize)
+	}
+	return buf.Bytes(), nil
+}
+
+func seedDirectoryRecord(identifier []byte, sector, size uint32, dir bool) []byte {
+	length := 33 + len(identifier)
+	if len(identifier)%2 == 0 {
+		length++
+	}
+	record := make([]byte, length)
+	record[0] = byte(length)
+	bothEndian32(record[2:10], sector)
+	bothEndian32(record[10:18], size)
+	// Recorded 2000-01-01T00:00:00Z.
+	copy(record[18:25], []byte{100, 1, 1, 0, 0, 0, 0})
+	if dir {
+		record[25] = 2
+	}
+	bothEndian16(record[28:32], 1)
+	record[32] = byte(len(identifier))
+	copy(record[33:], identifier)
+	return record
+}
+
+func bothEndian16(b []byte, v uint16) {
+	binary.LittleEndian.PutUint16(b[0:2], v)
+	binary.BigEndian.PutUint16(b[2:4], v)
+}
+
+func bothEndian32(b []byte, v uint32) {
+	binary.LittleEndian.PutUint32(b[0:4], v)
+	binary.BigEndian.PutUint32(b[4:8], v)
+}
+
+func padded(b []byte, s string) {
+	for i := range b {
+		b[i] = ' '
+	}
+	copy(b, s)
+}
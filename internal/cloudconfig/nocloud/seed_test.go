@@ -0,0 +1,78 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package nocloud
+
+import (
+	"encoding/binary"
+	"strings"
+	stdtesting "testing"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type seedSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestSeedSuite(t *stdtesting.T) {
+	tc.Run(t, &seedSuite{})
+}
+
+// readSeedFiles returns the files in the root directory of an ISO 9660
+// image.
+func readSeedFiles(c *tc.C, image []byte) map[string]string {
+	pvd := image[seedPVDSector*seedSectorSize:]
+	c.Assert(pvd[0], tc.Equals, byte(1))
+	c.Assert(string(pvd[1:6]), tc.Equals, "CD001")
+
+	root := pvd[156:]
+	rootSector := binary.LittleEndian.Uint32(root[2:])
+	rootSize := binary.LittleEndian.Uint32(root[10:])
+	dir := image[int(rootSector)*seedSectorSize:][:rootSize]
+
+	files := make(map[string]string)
+	for len(dir) > 0 && dir[0] != 0 {
+		recordLen := int(dir[0])
+		record := dir[:recordLen]
+		dir = dir[recordLen:]
+		if record[25]&2 != 0 {
+			// Skip the "." and ".." directory entries.
+			continue
+		}
+		sector := binary.LittleEndian.Uint32(record[2:])
+		size := binary.LittleEndian.Uint32(record[10:])
+		name := string(record[33 : 33+int(record[32])])
+		files[name] = string(image[int(sector)*seedSectorSize:][:size])
+	}
+	return files
+}
+
+func (s *seedSuite) TestSeedImage(c *tc.C) {
+	userData := "#cloud-config\n" + strings.Repeat("x", 3*seedSectorSize)
+	metaData := "instance-id: juju-f75cba-0\n"
+
+	image, err := SeedImage([]byte(userData), []byte(metaData))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(len(image)%seedSectorSize, tc.Equals, 0)
+
+	pvd := image[seedPVDSector*seedSectorSize:]
+	c.Check(strings.TrimRight(string(pvd[40:72]), " "), tc.Equals, "CIDATA")
+	c.Check(int(binary.LittleEndian.Uint32(pvd[80:])), tc.Equals, len(image)/seedSectorSize)
+
+	c.Check(readSeedFiles(c, image), tc.DeepEquals, map[string]string{
+		"META-DATA;1": metaData,
+		"USER-DATA;1": userData,
+	})
+}
+
+func (s *seedSuite) TestSeedImageEmptyFile(c *tc.C) {
+	image, err := SeedImage([]byte("#cloud-config\n"), nil)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(readSeedFiles(c, image), tc.DeepEquals, map[string]string{
+		"META-DATA;1": "",
+		"USER-DATA;1": "#cloud-config\n",
+	})
+}
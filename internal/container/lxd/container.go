@@ -94,6 +94,54 @@ func (c *ContainerSpec) ApplyConstraints(serverVersion string, cons constraints.
 			c.VirtType = virtType
 		}
 	}
+
+	count, kind, err := cons.AcceleratorSpec()
+	if err != nil {
+		logger.Errorf(context.TODO(), "failed to parse accelerators constraint %q, ignoring err: %v", *cons.Accelerators, err)
+	} else if count > 0 {
+		c.applyAccelerators(count, kind)
+	}
+}
+
+// applyAccelerators adds GPU devices for the requested accelerators.
+// LXD applies every filter set on a GPU device, so a device selects GPUs
+// either by card ID or by vendor, never both. If the accelerator type names
+// a known vendor, a single device passes through that vendor's GPUs.
+// Otherwise a device is added for each of the first count cards.
+func (c *ContainerSpec) applyAccelerators(count uint64, kind string) {
+	if c.Devices == nil {
+		c.Devices = map[string]map[string]string{}
+	}
+	if vendorID, ok := gpuVendorID(kind); ok {
+		c.Devices["gpu0"] = map[string]string{
+			"type":     "gpu",
+			"gputype":  "physical",
+			"vendorid": vendorID,
+		}
+		return
+	}
+	for i := uint64(0); i < count; i++ {
+		c.Devices[fmt.Sprintf("gpu%d", i)] = map[string]string{
+			"type":    "gpu",
+			"gputype": "physical",
+			"id":      fmt.Sprintf("%d", i),
+		}
+	}
+}
+
+// gpuVendorIDs maps GPU vendor names to their PCI vendor IDs.
+var gpuVendorIDs = map[string]string{
+	"nvidia": "10de",
+	"amd":    "1002",
+	"intel":  "8086",
+}
+
+// gpuVendorID returns the PCI vendor ID for the vendor named by the
+// accelerator type, such as "nvidia" or "nvidia-tesla-t4".
+func gpuVendorID(kind string) (string, bool) {
+	vendor, _, _ := strings.Cut(strings.ToLower(kind), "-")
+	id, ok := gpuVendorIDs[vendor]
+	return id, ok
 }
 
 // Container extends the upstream LXD container type.
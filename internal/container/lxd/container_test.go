@@ -693,3 +693,22 @@ func (s *managerSuite) TestSpecApplyConstraints(c *tc.C) {
 	c.Check(spec.Config, tc.DeepEquals, exp)
 	c.Check(spec.InstanceType, tc.Equals, instType)
 }
+
+func (s *managerSuite) TestSpecApplyConstraintsAccelerators(c *tc.C) {
+	spec := lxd.ContainerSpec{
+		Config: map[string]string{},
+	}
+	spec.ApplyConstraints("3.10.0", constraints.MustParse("accelerators=2:nvidia-tesla-t4"))
+	c.Check(spec.Devices, tc.DeepEquals, map[string]map[string]string{
+		"gpu0": {"type": "gpu", "gputype": "physical", "vendorid": "10de"},
+	})
+
+	spec = lxd.ContainerSpec{
+		Config: map[string]string{},
+	}
+	spec.ApplyConstraints("3.10.0", constraints.MustParse("accelerators=2"))
+	c.Check(spec.Devices, tc.DeepEquals, map[string]map[string]string{
+		"gpu0": {"type": "gpu", "gputype": "physical", "id": "0"},
+		"gpu1": {"type": "gpu", "gputype": "physical", "id": "1"},
+	})
+}
@@ -0,0 +1,235 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package internal
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// Media types of the manifests which can be copied between registries.
+const (
+	MediaTypeOCIIndex           = "application/vnd.oci.image.index.v1+json"
+	MediaTypeOCIManifest        = "application/vnd.oci.image.manifest.v1+json"
+	MediaTypeDockerManifestList = "application/vnd.docker.distribution.manifest.list.v2+json"
+	MediaTypeDockerManifest     = "application/vnd.docker.distribution.manifest.v2+json"
+)
+
+const (
+	dockerContentDigestHeader = "Docker-Content-Digest"
+	maxManifestSize           = 4 * 1024 * 1024
+	sha256DigestPrefix        = "sha256:"
+)
+
+var manifestMediaTypes = []string{
+	MediaTypeOCIIndex,
+	MediaTypeOCIManifest,
+	MediaTypeDockerManifestList,
+	MediaTypeDockerManifest,
+}
+
+// Manifest is an image manifest, or an index of manifests, as stored in a
+// registry.
+type Manifest struct {
+	// MediaType is the media type of the manifest.
+	MediaType string
+
+	// Digest is the content digest of the manifest, in the form
+	// "sha256:<hex>".
+	Digest string
+
+	// Content is the raw manifest content. The digest is calculated over
+	// these bytes, so they must not be re-encoded.
+	Content []byte
+}
+
+// imagePath returns the path of the named image within the registry. Where
+// the repository has no namespace (the registry puts it in the host name),
+// the image name is used as is.
+func (c *baseClient) imagePath(imageName string) string {
+	repo := c.ImageRepoDetails().Repository
+	if i := strings.IndexRune(repo, '/'); i != -1 {
+		return path.Join(repo[i+1:], imageName)
+	}
+	return imageName
+}
+
+// transferClient returns a client for moving image content. Blobs can be
+// large, so unlike the API client it has no timeout and relies on the
+// context for cancellation.
+func (c *baseClient) transferClient() *http.Client {
+	return &http.Client{Transport: c.client.Transport}
+}
+
+// GetManifest returns the manifest of the image for the specified tag or
+// digest.
+func (c *baseClient) GetManifest(ctx context.Context, imageName, reference string) (Manifest, error) {
+	url := c.url("/%s/manifests/%s", c.imagePath(imageName), reference)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return Manifest{}, errors.Trace(err)
+	}
+	req.Header.Set("Accept", strings.Join(manifestMediaTypes, ", "))
+	resp, err := c.transferClient().Do(req)
+	if err != nil {
+		return Manifest{}, errors.Trace(unwrapNetError(err))
+	}
+	defer resp.Body.Close()
+
+	content, err := io.ReadAll(io.LimitReader(resp.Body, maxManifestSize+1))
+	if err != nil {
+		return Manifest{}, errors.Annotatef(err, "reading manifest %s:%s", imageName, reference)
+	}
+	if len(content) > maxManifestSize {
+		return Manifest{}, errors.NotValidf("manifest %s:%s larger than %d bytes", imageName, reference, maxManifestSize)
+	}
+	digest := sha256Digest(content)
+	if strings.HasPrefix(reference, sha256DigestPrefix) && reference != digest {
+		return Manifest{}, errors.NotValidf("manifest %s:%s with digest %q", imageName, reference, digest)
+	}
+	mediaType := resp.Header.Get("Content-Type")
+	if i := strings.IndexRune(mediaType, ';'); i != -1 {
+		mediaType = strings.TrimSpace(mediaType[:i])
+	}
+	return Manifest{
+		MediaType: mediaType,
+		Digest:    digest,
+		Content:   content,
+	}, nil
+}
+
+// PutManifest uploads the manifest for the image, referenced by the
+// specified tag or digest.
+func (c *baseClient) PutManifest(ctx context.Context, imageName, reference string, manifest Manifest) error {
+	url := c.url("/%s/manifests/%s", c.imagePath(imageName), reference)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, bytes.NewReader(manifest.Content))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	req.Header.Set("Content-Type", manifest.MediaType)
+	resp, err := c.transferClient().Do(req)
+	if err != nil {
+		return errors.Annotatef(unwrapNetError(err), "uploading manifest %s:%s", imageName, reference)
+	}
+	defer resp.Body.Close()
+	if digest := resp.Header.Get(dockerContentDigestHeader); digest != "" && digest != manifest.Digest {
+		return errors.Errorf("registry stored manifest %s:%s with digest %q, expected %q",
+			imageName, reference, digest, manifest.Digest)
+	}
+	return nil
+}
+
+// BlobExists returns true if the registry already holds the blob with the
+// specified digest for the image.
+func (c *baseClient) BlobExists(ctx context.Context, imageName, digest string) (bool, error) {
+	url := c.url("/%s/blobs/%s", c.imagePath(imageName), digest)
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, url, nil)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	resp, err := c.transferClient().Do(req)
+	if errors.Is(err, errors.NotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, errors.Trace(unwrapNetError(err))
+	}
+	_ = resp.Body.Close()
+	return true, nil
+}
+
+// GetBlob returns a reader for the blob with the specified digest, and the
+// size of the blob if it's known, or -1 if it isn't. The caller is
+// responsible for closing the reader.
+func (c *baseClient) GetBlob(ctx context.Context, imageName, digest string) (io.ReadCloser, int64, error) {
+	url := c.url("/%s/blobs/%s", c.imagePath(imageName), digest)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, -1, errors.Trace(err)
+	}
+	resp, err := c.transferClient().Do(req)
+	if err != nil {
+		return nil, -1, errors.Annotatef(unwrapNetError(err), "downloading blob %s@%s", imageName, digest)
+	}
+	return resp.Body, resp.ContentLength, nil
+}
+
+// PutBlob uploads a blob for the image with a monolithic upload. The
+// content is read from the start again if the registry asks for
+// authorisation part way through the upload.
+func (c *baseClient) PutBlob(ctx context.Context, imageName, digest string, size int64, content io.ReadSeeker) error {
+	// The trailing slash is required by the distribution spec, but is
+	// dropped when the URL is built.
+	url := c.url("/%s/blobs/uploads", c.imagePath(imageName)) + "/"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, nil)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	client := c.transferClient()
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Annotatef(unwrapNetError(err), "starting upload of blob %s@%s", imageName, digest)
+	}
+	_ = resp.Body.Close()
+	uploadURL, err := blobUploadURL(resp, digest)
+	if err != nil {
+		return errors.Annotatef(err, "starting upload of blob %s@%s", imageName, digest)
+	}
+
+	if _, err := content.Seek(0, io.SeekStart); err != nil {
+		return errors.Trace(err)
+	}
+	req, err = http.NewRequestWithContext(ctx, http.MethodPut, uploadURL, io.NopCloser(content))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	req.ContentLength = size
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.GetBody = func() (io.ReadCloser, error) {
+		if _, err := content.Seek(0, io.SeekStart); err != nil {
+			return nil, errors.Trace(err)
+		}
+		return io.NopCloser(content), nil
+	}
+	resp, err = client.Do(req)
+	if err != nil {
+		return errors.Annotatef(unwrapNetError(err), "uploading blob %s@%s", imageName, digest)
+	}
+	_ = resp.Body.Close()
+	return nil
+}
+
+// blobUploadURL returns the URL to complete a blob upload, from the
+// location returned when starting it.
+func blobUploadURL(resp *http.Response, digest string) (string, error) {
+	location := resp.Header.Get("Location")
+	if location == "" {
+		return "", errors.NotValidf("blob upload response without location")
+	}
+	u, err := url.Parse(location)
+	if err != nil {
+		return "", errors.Annotatef(err, "parsing blob upload location %q", location)
+	}
+	if resp.Request != nil {
+		u = resp.Request.URL.ResolveReference(u)
+	}
+	q := u.Query()
+	q.Set("digest", digest)
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+func sha256Digest(content []byte) string {
+	sum := sha256.Sum256(content)
+	return fmt.Sprintf("%s%s", sha256DigestPrefix, hex.EncodeToString(sum[:]))
+}
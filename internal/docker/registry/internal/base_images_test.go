@@ -0,0 +1,145 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package internal_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/internal/docker/registry/internal"
+)
+
+func (s *baseSuite) TestGetManifest(c *tc.C) {
+	reg, ctrl := s.getRegistry(c)
+	defer ctrl.Finish()
+
+	content := `{"schemaVersion": 2}`
+	sum := sha256.Sum256([]byte(content))
+	digest := "sha256:" + hex.EncodeToString(sum[:])
+
+	s.mockRoundTripper.EXPECT().RoundTrip(gomock.Any()).DoAndReturn(func(req *http.Request) (*http.Response, error) {
+		c.Assert(req.Method, tc.Equals, `GET`)
+		c.Assert(req.URL.String(), tc.Equals, `https://example.com/v2/jujuqa/nginx/manifests/1.25`)
+		c.Assert(req.Header.Get("Accept"), tc.Contains, internal.MediaTypeOCIIndex)
+		return &http.Response{
+			Request:    req,
+			StatusCode: http.StatusOK,
+			Header: http.Header{
+				"Content-Type": []string{internal.MediaTypeOCIManifest},
+			},
+			Body: io.NopCloser(strings.NewReader(content)),
+		}, nil
+	})
+	manifest, err := reg.GetManifest(c.Context(), "nginx", "1.25")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(manifest, tc.DeepEquals, internal.Manifest{
+		MediaType: internal.MediaTypeOCIManifest,
+		Digest:    digest,
+		Content:   []byte(content),
+	})
+}
+
+func (s *baseSuite) TestGetManifestDigestMismatch(c *tc.C) {
+	reg, ctrl := s.getRegistry(c)
+	defer ctrl.Finish()
+
+	s.mockRoundTripper.EXPECT().RoundTrip(gomock.Any()).DoAndReturn(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			Request:    req,
+			StatusCode: http.StatusOK,
+			Header: http.Header{
+				"Content-Type": []string{internal.MediaTypeOCIManifest},
+			},
+			Body: io.NopCloser(strings.NewReader(`{"schemaVersion": 2}`)),
+		}, nil
+	})
+	_, err := reg.GetManifest(c.Context(), "nginx", "sha256:"+strings.Repeat("0", 64))
+	c.Assert(err, tc.ErrorMatches, `manifest nginx:sha256:0+ with digest "sha256:.*" not valid`)
+}
+
+func (s *baseSuite) TestBlobExists(c *tc.C) {
+	reg, ctrl := s.getRegistry(c)
+	defer ctrl.Finish()
+
+	gomock.InOrder(
+		s.mockRoundTripper.EXPECT().RoundTrip(gomock.Any()).DoAndReturn(func(req *http.Request) (*http.Response, error) {
+			c.Assert(req.Method, tc.Equals, `HEAD`)
+			c.Assert(req.URL.String(), tc.Equals, `https://example.com/v2/jujuqa/nginx/blobs/sha256:aaa`)
+			return &http.Response{Request: req, StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(""))}, nil
+		}),
+		s.mockRoundTripper.EXPECT().RoundTrip(gomock.Any()).DoAndReturn(func(req *http.Request) (*http.Response, error) {
+			c.Assert(req.URL.String(), tc.Equals, `https://example.com/v2/jujuqa/nginx/blobs/sha256:bbb`)
+			return &http.Response{Request: req, StatusCode: http.StatusNotFound, Body: io.NopCloser(strings.NewReader(""))}, nil
+		}),
+	)
+	exists, err := reg.BlobExists(c.Context(), "nginx", "sha256:aaa")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(exists, tc.IsTrue)
+	exists, err = reg.BlobExists(c.Context(), "nginx", "sha256:bbb")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(exists, tc.IsFalse)
+}
+
+func (s *baseSuite) TestPutBlobRetriesWithContent(c *tc.C) {
+	reg, ctrl := s.getRegistry(c)
+	defer ctrl.Finish()
+
+	assertUpload := func(req *http.Request) {
+		c.Assert(req.Method, tc.Equals, `PUT`)
+		c.Assert(req.URL.String(), tc.Equals, `https://example.com/v2/jujuqa/nginx/blobs/uploads/upload-id?digest=sha256%3Aaaa&state=xyz`)
+		data, err := io.ReadAll(req.Body)
+		c.Assert(err, tc.ErrorIsNil)
+		c.Assert(string(data), tc.Equals, "layer content")
+	}
+	gomock.InOrder(
+		s.mockRoundTripper.EXPECT().RoundTrip(gomock.Any()).DoAndReturn(func(req *http.Request) (*http.Response, error) {
+			c.Assert(req.Method, tc.Equals, `POST`)
+			c.Assert(req.URL.String(), tc.Equals, `https://example.com/v2/jujuqa/nginx/blobs/uploads/`)
+			return &http.Response{
+				Request:    req,
+				StatusCode: http.StatusAccepted,
+				Header: http.Header{
+					"Location": []string{"/v2/jujuqa/nginx/blobs/uploads/upload-id?state=xyz"},
+				},
+				Body: io.NopCloser(strings.NewReader("")),
+			}, nil
+		}),
+		// The registry asks for authorisation after the content was sent.
+		s.mockRoundTripper.EXPECT().RoundTrip(gomock.Any()).DoAndReturn(func(req *http.Request) (*http.Response, error) {
+			assertUpload(req)
+			return &http.Response{
+				Request:    req,
+				StatusCode: http.StatusUnauthorized,
+				Body:       io.NopCloser(strings.NewReader("")),
+				Header: http.Header{
+					http.CanonicalHeaderKey("WWW-Authenticate"): []string{
+						`Bearer realm="https://auth.example.com/token",service="registry.example.com",scope="repository:jujuqa/nginx:push,pull"`,
+					},
+				},
+			}, nil
+		}),
+		s.mockRoundTripper.EXPECT().RoundTrip(gomock.Any()).DoAndReturn(func(req *http.Request) (*http.Response, error) {
+			c.Assert(req.URL.Host, tc.Equals, `auth.example.com`)
+			return &http.Response{
+				Request:    req,
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`{"token": "jwt-token", "access_token": "jwt-token","expires_in": 300}`)),
+			}, nil
+		}),
+		s.mockRoundTripper.EXPECT().RoundTrip(gomock.Any()).DoAndReturn(func(req *http.Request) (*http.Response, error) {
+			c.Assert(req.Header.Get("Authorization"), tc.Equals, "Bearer jwt-token")
+			assertUpload(req)
+			return &http.Response{Request: req, StatusCode: http.StatusCreated, Body: io.NopCloser(strings.NewReader(""))}, nil
+		}),
+	)
+	content := "layer content"
+	err := reg.PutBlob(c.Context(), "nginx", "sha256:aaa", int64(len(content)), strings.NewReader(content))
+	c.Assert(err, tc.ErrorIsNil)
+}
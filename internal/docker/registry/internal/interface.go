@@ -4,6 +4,8 @@
 package internal
 
 import (
+	"context"
+	"io"
 	"time"
 
 	"github.com/juju/juju/internal/docker"
@@ -22,6 +24,21 @@ type Registry interface {
 	ImageRepoDetails() docker.ImageRepoDetails
 	ShouldRefreshAuth() (bool, time.Duration)
 	RefreshAuth() error
+
+	// GetManifest returns the manifest of the image for the specified tag
+	// or digest.
+	GetManifest(ctx context.Context, imageName, reference string) (Manifest, error)
+	// PutManifest uploads the manifest for the image, referenced by the
+	// specified tag or digest.
+	PutManifest(ctx context.Context, imageName, reference string, manifest Manifest) error
+	// BlobExists returns true if the registry holds the blob with the
+	// specified digest for the image.
+	BlobExists(ctx context.Context, imageName, digest string) (bool, error)
+	// GetBlob returns a reader for the blob with the specified digest, and
+	// its size, or -1 if the size isn't known.
+	GetBlob(ctx context.Context, imageName, digest string) (io.ReadCloser, int64, error)
+	// PutBlob uploads the blob with the specified digest for the image.
+	PutBlob(ctx context.Context, imageName, digest string, size int64, content io.ReadSeeker) error
 }
 
 // RegistryInternal provides methods of registry clients for internal operations.
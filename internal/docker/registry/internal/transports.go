@@ -87,6 +87,9 @@ func (t *challengeTransport) RoundTrip(req *http.Request) (*http.Response, error
 			err = fmt.Errorf("unknown WWW-Authenticate challenge scheme: %s", c.Scheme)
 			continue
 		}
+		if err = rewindRequestBody(req); err != nil {
+			return nil, errors.Trace(err)
+		}
 		resp, err = transport.RoundTrip(req)
 		if err == nil && !isUnauthorizedResponse(resp) {
 			t.currentTransport = transport
@@ -300,6 +303,9 @@ func (t *tokenTransport) retry(req *http.Request, prevResp *http.Response) (*htt
 	if err := t.authorizeRequest(req); err != nil {
 		return nil, errors.Trace(err)
 	}
+	if err := rewindRequestBody(req); err != nil {
+		return nil, errors.Trace(err)
+	}
 	resp, err := t.transport.RoundTrip(req)
 	if isUnauthorizedResponse(resp) {
 		if t.password == "" && t.authToken == "" {
@@ -309,6 +315,20 @@ func (t *tokenTransport) retry(req *http.Request, prevResp *http.Response) (*htt
 	return resp, errors.Trace(err)
 }
 
+// rewindRequestBody resets the body of a request which is about to be
+// retried, so the content isn't lost after the first attempt read it.
+func rewindRequestBody(req *http.Request) error {
+	if req.Body == nil || req.GetBody == nil {
+		return nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	req.Body = body
+	return nil
+}
+
 func isUnauthorizedResponse(resp *http.Response) bool {
 	return resp != nil && resp.StatusCode == http.StatusUnauthorized
 }
@@ -0,0 +1,16 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package mirror
+
+import (
+	"github.com/juju/juju/internal/docker"
+	"github.com/juju/juju/internal/docker/registry"
+)
+
+func NewForTest(target docker.ImageRepoDetails, newRegistry func(docker.ImageRepoDetails) (registry.Registry, error)) *Mirror {
+	return &Mirror{
+		target:      target,
+		newRegistry: newRegistry,
+	}
+}
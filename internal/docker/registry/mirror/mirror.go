@@ -0,0 +1,225 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package mirror copies OCI images from their upstream registry into a
+// mirror registry, so they can be pulled by clusters which can't reach the
+// upstream registry.
+package mirror
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/distribution/reference"
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/internal/docker"
+	"github.com/juju/juju/internal/docker/registry"
+)
+
+// Mirror copies OCI images into a mirror registry.
+type Mirror struct {
+	target      docker.ImageRepoDetails
+	newRegistry func(docker.ImageRepoDetails) (registry.Registry, error)
+}
+
+// New returns a Mirror which copies images into the target repository.
+func New(target docker.ImageRepoDetails) *Mirror {
+	return &Mirror{
+		target:      target,
+		newRegistry: registry.New,
+	}
+}
+
+// MirrorImage copies the image into the mirror repository, returning the
+// details needed to pull the mirrored copy. The mirrored image is always
+// referenced by digest. Images which are already in the mirror repository
+// are returned unchanged.
+func (m *Mirror) MirrorImage(ctx context.Context, image docker.DockerImageDetails) (docker.DockerImageDetails, error) {
+	named, err := reference.ParseNormalizedNamed(image.RegistryPath)
+	if err != nil {
+		return docker.DockerImageDetails{}, errors.NewNotValid(err, fmt.Sprintf("image path %q", image.RegistryPath))
+	}
+	domain, imagePath := reference.Domain(named), reference.Path(named)
+	if m.isMirrored(domain, imagePath) {
+		return image, nil
+	}
+	ref := "latest"
+	if digested, ok := named.(reference.Digested); ok {
+		ref = digested.Digest().String()
+	} else if tagged, ok := named.(reference.Tagged); ok {
+		ref = tagged.Tag()
+	}
+
+	srcDetails := image.ImageRepoDetails
+	srcDetails.Repository = domain
+	if dir := path.Dir(imagePath); dir != "." {
+		srcDetails.Repository = domain + "/" + dir
+	}
+	if srcDetails.ServerAddress == "" {
+		srcDetails.ServerAddress = domain
+	}
+	src, err := m.newRegistry(srcDetails)
+	if err != nil {
+		return docker.DockerImageDetails{}, errors.Annotatef(err, "connecting to registry for %q", image.RegistryPath)
+	}
+	defer func() { _ = src.Close() }()
+
+	dst, err := m.newRegistry(m.target)
+	if err != nil {
+		return docker.DockerImageDetails{}, errors.Annotatef(err, "connecting to mirror registry %q", m.target.Repository)
+	}
+	defer func() { _ = dst.Close() }()
+
+	// Keep the upstream registry in the mirrored image name, so images
+	// with the same path in different registries don't collide.
+	dstName := path.Join(domain, imagePath)
+	digest, err := CopyImage(ctx, src, path.Base(imagePath), ref, dst, dstName)
+	if err != nil {
+		return docker.DockerImageDetails{}, errors.Annotatef(err, "mirroring image %q", image.RegistryPath)
+	}
+	return docker.DockerImageDetails{
+		RegistryPath:     fmt.Sprintf("%s/%s@%s", m.target.Repository, dstName, digest),
+		ImageRepoDetails: m.target,
+	}, nil
+}
+
+// isMirrored returns true if the image is already in the mirror repository.
+func (m *Mirror) isMirrored(domain, imagePath string) bool {
+	target, err := reference.ParseNormalizedNamed(m.target.Repository)
+	if err != nil {
+		return false
+	}
+	return domain == reference.Domain(target) &&
+		strings.HasPrefix(imagePath, reference.Path(target)+"/")
+}
+
+// descriptor references content held in a registry, from a manifest.
+type descriptor struct {
+	MediaType string   `json:"mediaType"`
+	Digest    string   `json:"digest"`
+	Size      int64    `json:"size"`
+	URLs      []string `json:"urls,omitempty"`
+}
+
+// CopyImage copies the image referenced by a tag or digest from the source
+// registry to the destination registry, including any platform specific
+// images of a multi-platform image. The digest of the copied manifest is
+// returned.
+func CopyImage(
+	ctx context.Context,
+	src registry.Registry, srcName, ref string,
+	dst registry.Registry, dstName string,
+) (string, error) {
+	manifest, err := src.GetManifest(ctx, srcName, ref)
+	if err != nil {
+		return "", errors.Annotatef(err, "getting manifest %s:%s", srcName, ref)
+	}
+	if err := copyManifestContent(ctx, src, srcName, dst, dstName, manifest); err != nil {
+		return "", errors.Trace(err)
+	}
+	if err := dst.PutManifest(ctx, dstName, ref, manifest); err != nil {
+		return "", errors.Trace(err)
+	}
+	return manifest.Digest, nil
+}
+
+// copyManifestContent copies everything the manifest references, so the
+// manifest itself can be uploaded.
+func copyManifestContent(
+	ctx context.Context,
+	src registry.Registry, srcName string,
+	dst registry.Registry, dstName string,
+	manifest registry.Manifest,
+) error {
+	switch manifest.MediaType {
+	case registry.MediaTypeOCIIndex, registry.MediaTypeDockerManifestList:
+		var index struct {
+			Manifests []descriptor `json:"manifests"`
+		}
+		if err := json.Unmarshal(manifest.Content, &index); err != nil {
+			return errors.Annotatef(err, "parsing manifest index %s@%s", srcName, manifest.Digest)
+		}
+		for _, desc := range index.Manifests {
+			if _, err := CopyImage(ctx, src, srcName, desc.Digest, dst, dstName); err != nil {
+				return errors.Trace(err)
+			}
+		}
+	case registry.MediaTypeOCIManifest, registry.MediaTypeDockerManifest:
+		var image struct {
+			Config descriptor   `json:"config"`
+			Layers []descriptor `json:"layers"`
+		}
+		if err := json.Unmarshal(manifest.Content, &image); err != nil {
+			return errors.Annotatef(err, "parsing manifest %s@%s", srcName, manifest.Digest)
+		}
+		for _, desc := range append([]descriptor{image.Config}, image.Layers...) {
+			// Foreign layers are pulled from their own URLs, not the
+			// registry.
+			if len(desc.URLs) > 0 {
+				continue
+			}
+			if err := copyBlob(ctx, src, srcName, dst, dstName, desc); err != nil {
+				return errors.Trace(err)
+			}
+		}
+	default:
+		return errors.NotSupportedf("manifest %s@%s with media type %q", srcName, manifest.Digest, manifest.MediaType)
+	}
+	return nil
+}
+
+// copyBlob copies a blob which the destination registry doesn't already
+// hold. The blob is staged in a temporary file, so its digest can be
+// checked before it's uploaded, and so the upload can be retried.
+func copyBlob(
+	ctx context.Context,
+	src registry.Registry, srcName string,
+	dst registry.Registry, dstName string,
+	desc descriptor,
+) error {
+	exists, err := dst.BlobExists(ctx, dstName, desc.Digest)
+	if err != nil {
+		return errors.Annotatef(err, "checking for blob %s@%s", dstName, desc.Digest)
+	} else if exists {
+		return nil
+	}
+
+	body, _, err := src.GetBlob(ctx, srcName, desc.Digest)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = body.Close() }()
+
+	f, err := os.CreateTemp("", "juju-image-blob-")
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+	}()
+
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(f, hash), body)
+	if err != nil {
+		return errors.Annotatef(err, "downloading blob %s@%s", srcName, desc.Digest)
+	}
+	if desc.Size > 0 && size != desc.Size {
+		return errors.NotValidf("blob %s@%s with size %d, expected %d", srcName, desc.Digest, size, desc.Size)
+	}
+	if digest := "sha256:" + hex.EncodeToString(hash.Sum(nil)); strings.HasPrefix(desc.Digest, "sha256:") && digest != desc.Digest {
+		return errors.NotValidf("blob %s@%s with digest %q", srcName, desc.Digest, digest)
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(dst.PutBlob(ctx, dstName, desc.Digest, size, f))
+}
@@ -0,0 +1,204 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package mirror_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/internal/docker"
+	"github.com/juju/juju/internal/docker/registry"
+	"github.com/juju/juju/internal/docker/registry/mirror"
+	"github.com/juju/juju/internal/docker/registry/mocks"
+)
+
+type mirrorSuite struct {
+	src *mocks.MockRegistry
+	dst *mocks.MockRegistry
+}
+
+func TestMirrorSuite(t *testing.T) {
+	tc.Run(t, &mirrorSuite{})
+}
+
+func (s *mirrorSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.src = mocks.NewMockRegistry(ctrl)
+	s.dst = mocks.NewMockRegistry(ctrl)
+	return ctrl
+}
+
+func digestOf(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+func imageManifest(config, layer string) registry.Manifest {
+	content := fmt.Sprintf(`{
+  "schemaVersion": 2,
+  "mediaType": %q,
+  "config": {"mediaType": "application/vnd.oci.image.config.v1+json", "digest": %q, "size": %d},
+  "layers": [{"mediaType": "application/vnd.oci.image.layer.v1.tar+gzip", "digest": %q, "size": %d}]
+}`, registry.MediaTypeOCIManifest, digestOf(config), len(config), digestOf(layer), len(layer))
+	return registry.Manifest{
+		MediaType: registry.MediaTypeOCIManifest,
+		Digest:    digestOf(content),
+		Content:   []byte(content),
+	}
+}
+
+func (s *mirrorSuite) expectBlobCopied(c *tc.C, content string) {
+	digest := digestOf(content)
+	s.dst.EXPECT().BlobExists(gomock.Any(), "docker.io/library/nginx", digest).Return(false, nil)
+	s.src.EXPECT().GetBlob(gomock.Any(), "nginx", digest).
+		Return(io.NopCloser(strings.NewReader(content)), int64(len(content)), nil)
+	s.dst.EXPECT().PutBlob(gomock.Any(), "docker.io/library/nginx", digest, int64(len(content)), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ string, _ int64, r io.ReadSeeker) error {
+			data, err := io.ReadAll(r)
+			c.Assert(err, tc.ErrorIsNil)
+			c.Check(string(data), tc.Equals, content)
+			return nil
+		})
+}
+
+func (s *mirrorSuite) TestCopyImage(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	manifest := imageManifest("config", "layer")
+	s.src.EXPECT().GetManifest(gomock.Any(), "nginx", "1.25").Return(manifest, nil)
+	// The config blob is already in the mirror.
+	s.dst.EXPECT().BlobExists(gomock.Any(), "docker.io/library/nginx", digestOf("config")).Return(true, nil)
+	s.expectBlobCopied(c, "layer")
+	s.dst.EXPECT().PutManifest(gomock.Any(), "docker.io/library/nginx", "1.25", manifest).Return(nil)
+
+	digest, err := mirror.CopyImage(c.Context(), s.src, "nginx", "1.25", s.dst, "docker.io/library/nginx")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(digest, tc.Equals, manifest.Digest)
+}
+
+func (s *mirrorSuite) TestCopyImageIndex(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	amd64 := imageManifest("amd64-config", "amd64-layer")
+	indexContent := fmt.Sprintf(`{"schemaVersion": 2, "manifests": [{"mediaType": %q, "digest": %q, "size": %d}]}`,
+		registry.MediaTypeOCIManifest, amd64.Digest, len(amd64.Content))
+	index := registry.Manifest{
+		MediaType: registry.MediaTypeOCIIndex,
+		Digest:    digestOf(indexContent),
+		Content:   []byte(indexContent),
+	}
+	s.src.EXPECT().GetManifest(gomock.Any(), "nginx", "latest").Return(index, nil)
+	s.src.EXPECT().GetManifest(gomock.Any(), "nginx", amd64.Digest).Return(amd64, nil)
+	s.expectBlobCopied(c, "amd64-config")
+	s.expectBlobCopied(c, "amd64-layer")
+	s.dst.EXPECT().PutManifest(gomock.Any(), "docker.io/library/nginx", amd64.Digest, amd64).Return(nil)
+	s.dst.EXPECT().PutManifest(gomock.Any(), "docker.io/library/nginx", "latest", index).Return(nil)
+
+	digest, err := mirror.CopyImage(c.Context(), s.src, "nginx", "latest", s.dst, "docker.io/library/nginx")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(digest, tc.Equals, index.Digest)
+}
+
+func (s *mirrorSuite) TestCopyImageBlobDigestMismatch(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	manifest := imageManifest("config", "layer")
+	s.src.EXPECT().GetManifest(gomock.Any(), "nginx", "1.25").Return(manifest, nil)
+	s.dst.EXPECT().BlobExists(gomock.Any(), "docker.io/library/nginx", digestOf("config")).Return(false, nil)
+	s.src.EXPECT().GetBlob(gomock.Any(), "nginx", digestOf("config")).
+		Return(io.NopCloser(strings.NewReader("CONFIG")), int64(6), nil)
+
+	_, err := mirror.CopyImage(c.Context(), s.src, "nginx", "1.25", s.dst, "docker.io/library/nginx")
+	c.Assert(err, tc.ErrorMatches, `blob nginx@sha256:.* with digest "sha256:.*" not valid`)
+}
+
+func (s *mirrorSuite) TestCopyImageUnsupportedMediaType(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.src.EXPECT().GetManifest(gomock.Any(), "nginx", "1.25").Return(registry.Manifest{
+		MediaType: "application/vnd.docker.distribution.manifest.v1+prettyjws",
+		Digest:    digestOf("{}"),
+		Content:   []byte("{}"),
+	}, nil)
+
+	_, err := mirror.CopyImage(c.Context(), s.src, "nginx", "1.25", s.dst, "docker.io/library/nginx")
+	c.Assert(err, tc.ErrorMatches, `manifest nginx@sha256:.* with media type .* not supported`)
+}
+
+func (s *mirrorSuite) TestMirrorImage(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	target := docker.ImageRepoDetails{
+		Repository: "registry.internal:5000/juju",
+		BasicAuthConfig: docker.BasicAuthConfig{
+			Username: "mirror",
+			Password: "secret",
+		},
+	}
+	var srcDetails docker.ImageRepoDetails
+	m := mirror.NewForTest(target, func(details docker.ImageRepoDetails) (registry.Registry, error) {
+		if details.Repository == target.Repository {
+			return s.dst, nil
+		}
+		srcDetails = details
+		return s.src, nil
+	})
+
+	manifest := imageManifest("config", "layer")
+	s.src.EXPECT().GetManifest(gomock.Any(), "nginx", "1.25").Return(manifest, nil)
+	s.expectBlobCopied(c, "config")
+	s.expectBlobCopied(c, "layer")
+	s.dst.EXPECT().PutManifest(gomock.Any(), "docker.io/library/nginx", "1.25", manifest).Return(nil)
+	s.src.EXPECT().Close().Return(nil)
+	s.dst.EXPECT().Close().Return(nil)
+
+	result, err := m.MirrorImage(c.Context(), docker.DockerImageDetails{
+		RegistryPath: "nginx:1.25",
+		ImageRepoDetails: docker.ImageRepoDetails{
+			BasicAuthConfig: docker.BasicAuthConfig{
+				Username: "upstream",
+				Password: "pwd",
+			},
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, docker.DockerImageDetails{
+		RegistryPath:     "registry.internal:5000/juju/docker.io/library/nginx@" + manifest.Digest,
+		ImageRepoDetails: target,
+	})
+	c.Check(srcDetails, tc.DeepEquals, docker.ImageRepoDetails{
+		BasicAuthConfig: docker.BasicAuthConfig{
+			Username: "upstream",
+			Password: "pwd",
+		},
+		Repository:    "docker.io/library",
+		ServerAddress: "docker.io",
+	})
+}
+
+func (s *mirrorSuite) TestMirrorImageAlreadyMirrored(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	m := mirror.NewForTest(docker.ImageRepoDetails{
+		Repository: "registry.internal:5000/juju",
+	}, func(docker.ImageRepoDetails) (registry.Registry, error) {
+		c.Fatalf("unexpected registry connection")
+		return nil, nil
+	})
+
+	image := docker.DockerImageDetails{
+		RegistryPath: "registry.internal:5000/juju/docker.io/library/nginx@sha256:" + strings.Repeat("a", 64),
+	}
+	result, err := m.MirrorImage(c.Context(), image)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, image)
+}
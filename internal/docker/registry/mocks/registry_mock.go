@@ -10,10 +10,13 @@
 package mocks
 
 import (
+	context "context"
+	io "io"
 	reflect "reflect"
 	time "time"
 
 	docker "github.com/juju/juju/internal/docker"
+	internal "github.com/juju/juju/internal/docker/registry/internal"
 	tools "github.com/juju/juju/internal/tools"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -41,6 +44,45 @@ func (m *MockRegistry) EXPECT() *MockRegistryMockRecorder {
 	return m.recorder
 }
 
+// BlobExists mocks base method.
+func (m *MockRegistry) BlobExists(arg0 context.Context, arg1, arg2 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlobExists", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlobExists indicates an expected call of BlobExists.
+func (mr *MockRegistryMockRecorder) BlobExists(arg0, arg1, arg2 any) *MockRegistryBlobExistsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlobExists", reflect.TypeOf((*MockRegistry)(nil).BlobExists), arg0, arg1, arg2)
+	return &MockRegistryBlobExistsCall{Call: call}
+}
+
+// MockRegistryBlobExistsCall wrap *gomock.Call
+type MockRegistryBlobExistsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRegistryBlobExistsCall) Return(arg0 bool, arg1 error) *MockRegistryBlobExistsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRegistryBlobExistsCall) Do(f func(context.Context, string, string) (bool, error)) *MockRegistryBlobExistsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRegistryBlobExistsCall) DoAndReturn(f func(context.Context, string, string) (bool, error)) *MockRegistryBlobExistsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Close mocks base method.
 func (m *MockRegistry) Close() error {
 	m.ctrl.T.Helper()
@@ -118,6 +160,85 @@ func (c *MockRegistryGetArchitecturesCall) DoAndReturn(f func(string, string) ([
 	return c
 }
 
+// GetBlob mocks base method.
+func (m *MockRegistry) GetBlob(arg0 context.Context, arg1, arg2 string) (io.ReadCloser, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlob", arg0, arg1, arg2)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBlob indicates an expected call of GetBlob.
+func (mr *MockRegistryMockRecorder) GetBlob(arg0, arg1, arg2 any) *MockRegistryGetBlobCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlob", reflect.TypeOf((*MockRegistry)(nil).GetBlob), arg0, arg1, arg2)
+	return &MockRegistryGetBlobCall{Call: call}
+}
+
+// MockRegistryGetBlobCall wrap *gomock.Call
+type MockRegistryGetBlobCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRegistryGetBlobCall) Return(arg0 io.ReadCloser, arg1 int64, arg2 error) *MockRegistryGetBlobCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRegistryGetBlobCall) Do(f func(context.Context, string, string) (io.ReadCloser, int64, error)) *MockRegistryGetBlobCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRegistryGetBlobCall) DoAndReturn(f func(context.Context, string, string) (io.ReadCloser, int64, error)) *MockRegistryGetBlobCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetManifest mocks base method.
+func (m *MockRegistry) GetManifest(arg0 context.Context, arg1, arg2 string) (internal.Manifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetManifest", arg0, arg1, arg2)
+	ret0, _ := ret[0].(internal.Manifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetManifest indicates an expected call of GetManifest.
+func (mr *MockRegistryMockRecorder) GetManifest(arg0, arg1, arg2 any) *MockRegistryGetManifestCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManifest", reflect.TypeOf((*MockRegistry)(nil).GetManifest), arg0, arg1, arg2)
+	return &MockRegistryGetManifestCall{Call: call}
+}
+
+// MockRegistryGetManifestCall wrap *gomock.Call
+type MockRegistryGetManifestCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRegistryGetManifestCall) Return(arg0 internal.Manifest, arg1 error) *MockRegistryGetManifestCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRegistryGetManifestCall) Do(f func(context.Context, string, string) (internal.Manifest, error)) *MockRegistryGetManifestCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRegistryGetManifestCall) DoAndReturn(f func(context.Context, string, string) (internal.Manifest, error)) *MockRegistryGetManifestCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ImageRepoDetails mocks base method.
 func (m *MockRegistry) ImageRepoDetails() docker.ImageRepoDetails {
 	m.ctrl.T.Helper()
@@ -194,6 +315,82 @@ func (c *MockRegistryPingCall) DoAndReturn(f func() error) *MockRegistryPingCall
 	return c
 }
 
+// PutBlob mocks base method.
+func (m *MockRegistry) PutBlob(arg0 context.Context, arg1, arg2 string, arg3 int64, arg4 io.ReadSeeker) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutBlob", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutBlob indicates an expected call of PutBlob.
+func (mr *MockRegistryMockRecorder) PutBlob(arg0, arg1, arg2, arg3, arg4 any) *MockRegistryPutBlobCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutBlob", reflect.TypeOf((*MockRegistry)(nil).PutBlob), arg0, arg1, arg2, arg3, arg4)
+	return &MockRegistryPutBlobCall{Call: call}
+}
+
+// MockRegistryPutBlobCall wrap *gomock.Call
+type MockRegistryPutBlobCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRegistryPutBlobCall) Return(arg0 error) *MockRegistryPutBlobCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRegistryPutBlobCall) Do(f func(context.Context, string, string, int64, io.ReadSeeker) error) *MockRegistryPutBlobCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRegistryPutBlobCall) DoAndReturn(f func(context.Context, string, string, int64, io.ReadSeeker) error) *MockRegistryPutBlobCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// PutManifest mocks base method.
+func (m *MockRegistry) PutManifest(arg0 context.Context, arg1, arg2 string, arg3 internal.Manifest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutManifest", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutManifest indicates an expected call of PutManifest.
+func (mr *MockRegistryMockRecorder) PutManifest(arg0, arg1, arg2, arg3 any) *MockRegistryPutManifestCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutManifest", reflect.TypeOf((*MockRegistry)(nil).PutManifest), arg0, arg1, arg2, arg3)
+	return &MockRegistryPutManifestCall{Call: call}
+}
+
+// MockRegistryPutManifestCall wrap *gomock.Call
+type MockRegistryPutManifestCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRegistryPutManifestCall) Return(arg0 error) *MockRegistryPutManifestCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRegistryPutManifestCall) Do(f func(context.Context, string, string, internal.Manifest) error) *MockRegistryPutManifestCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRegistryPutManifestCall) DoAndReturn(f func(context.Context, string, string, internal.Manifest) error) *MockRegistryPutManifestCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // RefreshAuth mocks base method.
 func (m *MockRegistry) RefreshAuth() error {
 	m.ctrl.T.Helper()
@@ -22,6 +22,18 @@ var logger = internallogger.GetLogger("juju.docker.registry")
 // Registry provides APIs to interact with the OCI provider client.
 type Registry = internal.Registry
 
+// Manifest is an image manifest, or an index of manifests, as stored in a
+// registry.
+type Manifest = internal.Manifest
+
+// Media types of the manifests which can be copied between registries.
+const (
+	MediaTypeOCIIndex           = internal.MediaTypeOCIIndex
+	MediaTypeOCIManifest        = internal.MediaTypeOCIManifest
+	MediaTypeDockerManifestList = internal.MediaTypeDockerManifestList
+	MediaTypeDockerManifest     = internal.MediaTypeDockerManifest
+)
+
 var (
 	// Override for testing.
 	DefaultTransport = http.DefaultTransport
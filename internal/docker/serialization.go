@@ -70,3 +70,33 @@ func convertToResourceToken(t *Token) *resource.Token {
 		ExpiresAt: t.ExpiresAt,
 	}
 }
+
+// ConvertFromResourceImageDetails converts the provided
+// resource.ImageRepoDetails to an ImageRepoDetails.
+func ConvertFromResourceImageDetails(imageRepo resource.ImageRepoDetails) ImageRepoDetails {
+	return ImageRepoDetails{
+		BasicAuthConfig: BasicAuthConfig{
+			Auth:     convertFromResourceToken(imageRepo.BasicAuthConfig.Auth),
+			Username: imageRepo.BasicAuthConfig.Username,
+			Password: imageRepo.BasicAuthConfig.Password,
+		},
+		TokenAuthConfig: TokenAuthConfig{
+			Email:         imageRepo.TokenAuthConfig.Email,
+			IdentityToken: convertFromResourceToken(imageRepo.TokenAuthConfig.IdentityToken),
+			RegistryToken: convertFromResourceToken(imageRepo.TokenAuthConfig.RegistryToken),
+		},
+		Repository:    imageRepo.Repository,
+		ServerAddress: imageRepo.ServerAddress,
+		Region:        imageRepo.Region,
+	}
+}
+
+func convertFromResourceToken(t *resource.Token) *Token {
+	if t == nil {
+		return nil
+	}
+	return &Token{
+		Value:     t.Value,
+		ExpiresAt: t.ExpiresAt,
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/juju/tc"
 
+	"github.com/juju/juju/core/resource"
 	"github.com/juju/juju/internal/docker"
 )
 
@@ -72,3 +73,24 @@ password: fragglerock
 		},
 	})
 }
+
+func (s *DockerResourceSuite) TestConvertResourceImageDetailsRoundTrip(c *tc.C) {
+	details := docker.ImageRepoDetails{
+		BasicAuthConfig: docker.BasicAuthConfig{
+			Auth:     docker.NewToken("dXNlcjpwYXNz"),
+			Username: "user",
+			Password: "pass",
+		},
+		TokenAuthConfig: docker.TokenAuthConfig{
+			IdentityToken: docker.NewToken("identity"),
+			RegistryToken: docker.NewToken("registry"),
+		},
+		Repository:    "registry.example.com/juju",
+		ServerAddress: "registry.example.com",
+		Region:        "us-east-1",
+	}
+	converted := docker.ConvertToResourceImageDetails(details)
+	c.Check(converted.Username, tc.Equals, "user")
+	c.Check(converted.RegistryToken, tc.DeepEquals, resource.NewToken("registry"))
+	c.Assert(docker.ConvertFromResourceImageDetails(converted), tc.DeepEquals, details)
+}
@@ -21,8 +21,11 @@ import (
 	"github.com/juju/juju/core/semversion"
 	corestorage "github.com/juju/juju/core/storage"
 	domaincharm "github.com/juju/juju/domain/application/charm"
+	modelimport "github.com/juju/juju/domain/model/modelmigration"
 	"github.com/juju/juju/domain/modeldefaults"
 	migrations "github.com/juju/juju/domain/modelmigration"
+	modelmigrationerrors "github.com/juju/juju/domain/modelmigration/errors"
+	secretservice "github.com/juju/juju/domain/secret/service"
 	"github.com/juju/juju/environs"
 	"github.com/juju/juju/environs/config"
 	"github.com/juju/juju/internal/charm"
@@ -121,6 +124,7 @@ type ModelImporter struct {
 	domainServices          services.DomainServicesGetter
 	storageRegistryGetter   corestorage.ModelStorageRegistryGetter
 	objectStoreGetter       objectstore.ModelObjectStoreGetter
+	encryptionKeySource     secretservice.EncryptionKeySource
 
 	scope  modelmigration.ScopeForModel
 	logger corelogger.Logger
@@ -136,6 +140,7 @@ func NewModelImporter(
 	domainServices services.DomainServicesGetter,
 	storageRegistryGetter corestorage.ModelStorageRegistryGetter,
 	objectStoreGetter objectstore.ModelObjectStoreGetter,
+	encryptionKeySource secretservice.EncryptionKeySource,
 	logger corelogger.Logger,
 	clock clock.Clock,
 ) *ModelImporter {
@@ -145,6 +150,7 @@ func NewModelImporter(
 		domainServices:          domainServices,
 		storageRegistryGetter:   storageRegistryGetter,
 		objectStoreGetter:       objectStoreGetter,
+		encryptionKeySource:     encryptionKeySource,
 		logger:                  logger,
 		clock:                   clock,
 	}
@@ -172,7 +178,7 @@ func (i *ModelImporter) ImportModel(ctx context.Context, bytes []byte) error {
 	}
 
 	coordinator := modelmigration.NewCoordinator(i.logger)
-	migrations.ImportOperations(coordinator, modelDefaultsProvider, i.storageRegistryGetter, i.objectStoreGetter, i.clock, i.logger)
+	migrations.ImportOperations(coordinator, modelDefaultsProvider, i.storageRegistryGetter, i.objectStoreGetter, i.encryptionKeySource, i.clock, i.logger)
 	if err := coordinator.Perform(ctx, i.scope(modelUUID), model); err != nil {
 		return errors.Trace(err)
 	}
@@ -180,6 +186,30 @@ func (i *ModelImporter) ImportModel(ctx context.Context, bytes []byte) error {
 	return nil
 }
 
+// AbortImport removes the model being imported from the controller, along
+// with its database. No cloud resources of the model are touched, as they are
+// still owned by the source controller. An error satisfying
+// [modelmigrationerrors.ModelNotImporting] is returned if the model is not
+// being imported.
+func (i *ModelImporter) AbortImport(ctx context.Context, modelUUID coremodel.UUID) error {
+	domainServices, err := i.domainServices.ServicesForModel(ctx, modelUUID)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	mode, err := domainServices.ModelMigration().ModelMigrationMode(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	} else if mode != migrations.MigrationModeImporting {
+		return internalerrors.Errorf("model %q", modelUUID).Add(modelmigrationerrors.ModelNotImporting)
+	}
+
+	if err := modelimport.DeleteImportedModel(ctx, i.scope(modelUUID), modelUUID, i.logger); err != nil {
+		return errors.Annotatef(err, "aborting import of model %q", modelUUID)
+	}
+	i.logger.Infof(ctx, "aborted import of model %q", modelUUID)
+	return nil
+}
+
 type modelDefaultsProvider struct {
 	modelUUID      coremodel.UUID
 	servicesGetter services.DomainServicesGetter
@@ -15,6 +15,7 @@ import (
 	corestorage "github.com/juju/juju/core/storage"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 	"github.com/juju/juju/internal/migration"
+	"github.com/juju/juju/internal/secrets/encryptionkey"
 	"github.com/juju/juju/internal/storage"
 	jujutesting "github.com/juju/juju/internal/testing"
 )
@@ -54,6 +55,7 @@ func (s *ExportImportSuite) exportImport(c *tc.C, leaders map[string]string) {
 			return &storage.StaticProviderRegistry{}
 		}),
 		s.objectStoreGetter,
+		encryptionkey.NewSource(c.MkDir()),
 		loggertesting.WrapCheckLog(c),
 		clock.WallClock,
 	)
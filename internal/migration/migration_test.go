@@ -129,6 +129,7 @@ func (s *ImportSuite) TestBadBytes(c *tc.C) {
 			return nil
 		}),
 		nil,
+		nil,
 		loggertesting.WrapCheckLog(c),
 		clock.WallClock,
 	)
@@ -0,0 +1,122 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package passphrase encrypts data with a key derived from a passphrase, for
+// the files the Juju client keeps secrets in.
+package passphrase
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+
+	"golang.org/x/crypto/scrypt"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// scrypt parameters recommended for interactive logins.
+	scryptN = 1 << 15
+	scryptR = 8
+	scryptP = 1
+
+	keySize  = 32
+	saltSize = 16
+
+	// ErrDecrypt is returned when sealed data cannot be decrypted.
+	ErrDecrypt = errors.ConstError("wrong passphrase or corrupt file")
+)
+
+// envelope is the encoding of sealed data. Data holds the AES-256-GCM nonce
+// and ciphertext, keyed with scrypt from the passphrase and salt.
+type envelope struct {
+	Version int    `json:"version"`
+	Salt    []byte `json:"salt"`
+	Data    []byte `json:"data"`
+}
+
+// NewSalt returns a new random salt to derive a key with.
+func NewSalt() ([]byte, error) {
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, errors.Capture(err)
+	}
+	return salt, nil
+}
+
+// DeriveKey returns the key derived from the passphrase and salt. Deriving
+// a key is deliberately slow, so callers decrypting repeatedly with the same
+// salt should keep the key.
+func DeriveKey(passphrase string, salt []byte) ([]byte, error) {
+	key, err := scrypt.Key([]byte(passphrase), salt, scryptN, scryptR, scryptP, keySize)
+	if err != nil {
+		return nil, errors.Errorf("deriving key: %w", err)
+	}
+	return key, nil
+}
+
+// Seal encrypts the plaintext with the key derived from the salt, and returns
+// it encoded along with the salt.
+func Seal(plaintext, key, salt []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, errors.Capture(err)
+	}
+	data, err := json.Marshal(envelope{
+		Version: 1,
+		Salt:    salt,
+		Data:    aead.Seal(nonce, nonce, plaintext, nil),
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return data, nil
+}
+
+// Open decrypts data returned by Seal, with the key returned by getKey for the
+// salt it was sealed with. The plaintext and the salt are returned.
+//
+// The following errors may be returned:
+//   - [ErrDecrypt] if the key is wrong or the data is corrupt.
+//   - [coreerrors.NotSupported] if the data was sealed by a newer version.
+func Open(data []byte, getKey func(salt []byte) ([]byte, error)) ([]byte, []byte, error) {
+	var sealed envelope
+	if err := json.Unmarshal(data, &sealed); err != nil {
+		return nil, nil, errors.Errorf("parsing: %w", err)
+	}
+	if sealed.Version != 1 {
+		return nil, nil, errors.Errorf("version %d", sealed.Version).Add(coreerrors.NotSupported)
+	}
+	key, err := getKey(sealed.Salt)
+	if err != nil {
+		return nil, nil, errors.Capture(err)
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, nil, errors.Capture(err)
+	}
+	if len(sealed.Data) < aead.NonceSize() {
+		return nil, nil, ErrDecrypt
+	}
+	nonce, ciphertext := sealed.Data[:aead.NonceSize()], sealed.Data[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, nil, ErrDecrypt
+	}
+	return plaintext, sealed.Salt, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return cipher.NewGCM(block)
+}
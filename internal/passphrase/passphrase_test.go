@@ -0,0 +1,65 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package passphrase
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type passphraseSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestPassphraseSuite(t *testing.T) {
+	tc.Run(t, &passphraseSuite{})
+}
+
+func (*passphraseSuite) keyFor(passphrase string) func([]byte) ([]byte, error) {
+	return func(salt []byte) ([]byte, error) {
+		return DeriveKey(passphrase, salt)
+	}
+}
+
+func (s *passphraseSuite) TestSealOpen(c *tc.C) {
+	salt, err := NewSalt()
+	c.Assert(err, tc.ErrorIsNil)
+	key, err := DeriveKey("secret", salt)
+	c.Assert(err, tc.ErrorIsNil)
+
+	data, err := Seal([]byte("hello"), key, salt)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(data), tc.Not(tc.Contains), "hello")
+
+	plaintext, openedSalt, err := Open(data, s.keyFor("secret"))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(plaintext), tc.Equals, "hello")
+	c.Check(openedSalt, tc.DeepEquals, salt)
+}
+
+func (s *passphraseSuite) TestOpenWrongPassphrase(c *tc.C) {
+	salt, err := NewSalt()
+	c.Assert(err, tc.ErrorIsNil)
+	key, err := DeriveKey("secret", salt)
+	c.Assert(err, tc.ErrorIsNil)
+	data, err := Seal([]byte("hello"), key, salt)
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, _, err = Open(data, s.keyFor("wrong"))
+	c.Check(err, tc.ErrorIs, ErrDecrypt)
+}
+
+func (s *passphraseSuite) TestOpenUnsupportedVersion(c *tc.C) {
+	_, _, err := Open([]byte(`{"version":2}`), s.keyFor("secret"))
+	c.Check(err, tc.ErrorIs, coreerrors.NotSupported)
+}
+
+func (s *passphraseSuite) TestOpenTruncated(c *tc.C) {
+	_, _, err := Open([]byte(`{"version":1,"salt":"","data":""}`), s.keyFor("secret"))
+	c.Check(err, tc.ErrorIs, ErrDecrypt)
+}
@@ -0,0 +1,11 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+//go:build !minimal || provider_proxmox
+
+package all
+
+import (
+	// Register the provider.
+	_ "github.com/juju/juju/internal/provider/proxmox"
+)
@@ -441,6 +441,8 @@ var unsupportedConstraints = []string{
 	constraints.Tags,
 	constraints.VirtType,
 	constraints.ImageID,
+	constraints.AffinityGroup,
+	constraints.AntiAffinity,
 }
 
 // ConstraintsValidator is defined on the Environs interface.
@@ -471,6 +473,7 @@ func (env *azureEnviron) ConstraintsValidator(ctx context.Context) (constraints.
 			constraints.Mem,
 			constraints.Cores,
 			constraints.Arch,
+			constraints.Accelerators,
 		},
 	)
 	validator.RegisterConflictResolver(constraints.InstanceType, constraints.Arch, func(attrValues map[string]interface{}) error {
@@ -440,6 +440,39 @@ var machineSizeCost = []string{
 	"B20ms",
 }
 
+// gpuMachineSize describes the GPUs attached to a VM size.
+type gpuMachineSize struct {
+	count uint64
+	kind  string
+}
+
+// gpuMachineSizes holds the GPUs attached to the N-series VM sizes. The
+// VirtualMachineSize returned by the API does not report GPUs, so this is
+// used to match the accelerators constraint. Sizes with a fractional GPU
+// are omitted.
+var gpuMachineSizes = map[string]gpuMachineSize{
+	"Standard_NC4as_T4_v3":      {1, "nvidia-tesla-t4"},
+	"Standard_NC8as_T4_v3":      {1, "nvidia-tesla-t4"},
+	"Standard_NC16as_T4_v3":     {1, "nvidia-tesla-t4"},
+	"Standard_NC64as_T4_v3":     {4, "nvidia-tesla-t4"},
+	"Standard_NC6s_v3":          {1, "nvidia-tesla-v100"},
+	"Standard_NC12s_v3":         {2, "nvidia-tesla-v100"},
+	"Standard_NC24s_v3":         {4, "nvidia-tesla-v100"},
+	"Standard_NC24rs_v3":        {4, "nvidia-tesla-v100"},
+	"Standard_ND40rs_v2":        {8, "nvidia-tesla-v100"},
+	"Standard_NC24ads_A100_v4":  {1, "nvidia-a100"},
+	"Standard_NC48ads_A100_v4":  {2, "nvidia-a100"},
+	"Standard_NC96ads_A100_v4":  {4, "nvidia-a100"},
+	"Standard_ND96asr_v4":       {8, "nvidia-a100"},
+	"Standard_ND96amsr_A100_v4": {8, "nvidia-a100"},
+	"Standard_ND96isr_H100_v5":  {8, "nvidia-h100"},
+	"Standard_NV36ads_A10_v5":   {1, "nvidia-a10"},
+	"Standard_NV72ads_A10_v5":   {2, "nvidia-a10"},
+	"Standard_NV12s_v3":         {1, "nvidia-tesla-m60"},
+	"Standard_NV24s_v3":         {2, "nvidia-tesla-m60"},
+	"Standard_NV48s_v3":         {4, "nvidia-tesla-m60"},
+}
+
 // newInstanceType creates an InstanceType based on a VirtualMachineSize.
 func newInstanceType(arch corearch.Arch, size armcompute.VirtualMachineSize) instances.InstanceType {
 	sizeName := toValue(size.Name)
@@ -483,6 +516,7 @@ func newInstanceType(arch corearch.Arch, size armcompute.VirtualMachineSize) ins
 	}
 
 	vtype := "Hyper-V"
+	gpus := gpuMachineSizes[sizeName]
 	return instances.InstanceType{
 		Id:       sizeName,
 		Name:     sizeName,
@@ -496,6 +530,8 @@ func newInstanceType(arch corearch.Arch, size armcompute.VirtualMachineSize) ins
 		Cost:     uint64(cost),
 		VirtType: &vtype,
 		// tags are not currently supported by azure
+		Accelerators:    gpus.count,
+		AcceleratorType: gpus.kind,
 	}
 }
 
@@ -154,6 +154,18 @@ func (s *InstanceTypeSuite) TestBasicARM64(c *tc.C) {
 	})
 }
 
+func (s *InstanceTypeSuite) TestGPU(c *tc.C) {
+	vm := armcompute.VirtualMachineSize{
+		Name:           to.Ptr("Standard_NC64as_T4_v3"),
+		MemoryInMB:     to.Ptr(int32(448 * 1024)),
+		NumberOfCores:  to.Ptr(int32(64)),
+		OSDiskSizeInMB: to.Ptr(int32(1024 * 1024)),
+	}
+	inst := newInstanceType(corearch.AMD64, vm)
+	c.Check(inst.Accelerators, tc.Equals, uint64(4))
+	c.Check(inst.AcceleratorType, tc.Equals, "nvidia-tesla-t4")
+}
+
 func (s *InstanceTypeSuite) TestDeleteInstanceFamily(c *tc.C) {
 	instanceTypes := map[string]instances.InstanceType{
 		"D6_v4":          {Name: "Standard_D6_v4"},
@@ -17,6 +17,8 @@ func CommonIAASStorageProviderTypes() []internalstorage.ProviderType {
 		internalprovider.TmpfsProviderType,
 		internalprovider.RootfsProviderType,
 		internalprovider.LoopProviderType,
+		internalprovider.LVMProviderType,
+		internalprovider.ZFSProviderType,
 	}
 }
 
@@ -35,6 +37,10 @@ func GetCommonIAASStorageProvider(t internalstorage.ProviderType) (
 		return internalprovider.NewRootfsProvider(internalprovider.LogAndExec), nil
 	case internalprovider.LoopProviderType:
 		return internalprovider.NewLoopProvider(internalprovider.LogAndExec), nil
+	case internalprovider.LVMProviderType:
+		return internalprovider.NewLVMProvider(internalprovider.LogAndExec), nil
+	case internalprovider.ZFSProviderType:
+		return internalprovider.NewZFSProvider(internalprovider.LogAndExec), nil
 	default:
 		return nil, errors.Errorf(
 			"no storage provider exists for type %q", t,
@@ -28,6 +28,8 @@ func (storageSuite) TestCommonIAASProviderTypes(c *tc.C) {
 		internalprovider.TmpfsProviderType,
 		internalprovider.RootfsProviderType,
 		internalprovider.LoopProviderType,
+		internalprovider.LVMProviderType,
+		internalprovider.ZFSProviderType,
 	}
 	c.Check(CommonIAASStorageProviderTypes(), tc.SameContents, expectedProviderTypes)
 }
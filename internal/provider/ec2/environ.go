@@ -279,7 +279,7 @@ func (e *environ) ConstraintsValidator(ctx context.Context) (constraints.Validat
 	)
 	validator.RegisterConflicts(
 		[]string{constraints.InstanceType},
-		[]string{constraints.Arch, constraints.Mem, constraints.Cores, constraints.CpuPower})
+		[]string{constraints.Arch, constraints.Mem, constraints.Cores, constraints.CpuPower, constraints.Accelerators})
 	validator.RegisterUnsupported(unsupportedConstraints)
 
 	instanceTypes, err := e.supportedInstanceTypes(ctx, allInstanceTypeFilter())
@@ -692,6 +692,12 @@ func (e *environ) StartInstance(
 	rootVolumeTags[tagName] = hostname + "-root"
 	volumeTags := CreateTagSpecification(types.ResourceTypeVolume, rootVolumeTags)
 
+	_ = callback(ctx, status.Allocating, "Setting up placement group", nil)
+	placementGroup, err := e.ensurePlacementGroup(ctx, args.ControllerUUID, args.Constraints)
+	if err != nil {
+		return nil, annotateWrapError(err, "cannot set up placement group")
+	}
+
 	imageID := aws.String(spec.Image.Id)
 	if args.Constraints.HasImageID() {
 		imageID = aws.String(*args.Constraints.ImageID)
@@ -723,6 +729,9 @@ func (e *environ) StartInstance(
 	runArgs.Placement = &types.Placement{
 		AvailabilityZone: aws.String(availabilityZone),
 	}
+	if placementGroup != "" {
+		runArgs.Placement.GroupName = aws.String(placementGroup)
+	}
 	runArgs.SubnetId = subnet.SubnetId
 
 	_ = callback(ctx, status.Allocating,
@@ -1751,6 +1760,9 @@ func (e *environ) Destroy(ctx context.Context) error {
 	if err := e.cleanModelSecurityGroups(ctx); err != nil {
 		return errors.Annotate(e.HandleCredentialError(ctx, err), "cannot delete model security groups")
 	}
+	if err := e.deletePlacementGroups(ctx, makeModelFilter(e.uuid())); err != nil {
+		return errors.Annotate(err, "cannot delete model placement groups")
+	}
 	return nil
 }
 
@@ -1793,6 +1805,11 @@ func (e *environ) destroyControllerManagedModels(ctx context.Context, controller
 		return errors.Annotatef(e.HandleCredentialError(ctx, err), "destroying volume %q", volIds[i])
 	}
 
+	// Delete placement groups managed by the controller.
+	if err := e.deletePlacementGroups(ctx, makeControllerFilter(controllerUUID)); err != nil {
+		return errors.Trace(err)
+	}
+
 	// Delete security groups managed by the controller.
 	groups, err := e.controllerSecurityGroups(ctx, controllerUUID)
 	if err != nil {
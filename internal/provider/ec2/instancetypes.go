@@ -456,10 +456,28 @@ func convertEC2InstanceType(
 			break
 		}
 	}
+	if info.GpuInfo != nil {
+		for _, gpu := range info.GpuInfo.Gpus {
+			// Instance types only ever have one kind of GPU attached.
+			instType.Accelerators = uint64(aws.ToInt32(gpu.Count))
+			instType.AcceleratorType = gpuTypeName(gpu)
+			break
+		}
+	}
 
 	return instType
 }
 
+// gpuTypeName returns the accelerator type name for the input GPU, made up of
+// the lower case manufacturer and model, e.g. nvidia-t4.
+func gpuTypeName(gpu types.GpuDeviceInfo) string {
+	name := strings.ToLower(aws.ToString(gpu.Name))
+	if manufacturer := strings.ToLower(aws.ToString(gpu.Manufacturer)); manufacturer != "" {
+		name = manufacturer + "-" + name
+	}
+	return strings.ReplaceAll(name, " ", "-")
+}
+
 // highestFamilyProcessorGeneration takes a slice of InstancceTypeInfo structs
 // and  calculates the highest generation supported by each family and processor
 // family. This is useful for Juju to align it's use of families on to the
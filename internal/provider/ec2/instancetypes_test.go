@@ -6,6 +6,7 @@ package ec2
 import (
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/juju/collections/set"
 	"github.com/juju/tc"
@@ -144,3 +145,26 @@ func (s *InstanceTypesSuite) TestParseInstanceType(c *tc.C) {
 		c.Assert(it, tc.DeepEquals, test.Expected)
 	}
 }
+
+func (s *InstanceTypesSuite) TestConvertEC2InstanceTypeGPU(c *tc.C) {
+	instType := convertEC2InstanceType(types.InstanceTypeInfo{
+		InstanceType: "g4dn.12xlarge",
+		GpuInfo: &types.GpuInfo{
+			Gpus: []types.GpuDeviceInfo{{
+				Count:        aws.Int32(4),
+				Manufacturer: aws.String("NVIDIA"),
+				Name:         aws.String("T4"),
+			}},
+		},
+	})
+	c.Check(instType.Accelerators, tc.Equals, uint64(4))
+	c.Check(instType.AcceleratorType, tc.Equals, "nvidia-t4")
+}
+
+func (s *InstanceTypesSuite) TestConvertEC2InstanceTypeNoGPU(c *tc.C) {
+	instType := convertEC2InstanceType(types.InstanceTypeInfo{
+		InstanceType: "m5.large",
+	})
+	c.Check(instType.Accelerators, tc.Equals, uint64(0))
+	c.Check(instType.AcceleratorType, tc.Equals, "")
+}
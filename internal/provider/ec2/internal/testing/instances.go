@@ -48,10 +48,19 @@ type Instance struct {
 	rootDeviceType      types.DeviceType
 	rootDeviceName      string
 	metadataOptions     *types.InstanceMetadataOptionsResponse
+	placementGroup      string
 
 	iamInstanceProfile *types.IamInstanceProfileSpecification
 }
 
+func (inst *Instance) placement() *types.Placement {
+	placement := &types.Placement{AvailabilityZone: aws.String(inst.availZone)}
+	if inst.placementGroup != "" {
+		placement.GroupName = aws.String(inst.placementGroup)
+	}
+	return placement
+}
+
 // TerminateInstances implements ec2.Client.
 func (srv *Server) TerminateInstances(ctx context.Context, in *ec2.TerminateInstancesInput, opts ...func(*ec2.Options)) (*ec2.TerminateInstancesOutput, error) {
 	srv.instanceMutatingCalls.next()
@@ -119,12 +128,17 @@ func (srv *Server) RunInstances(ctx context.Context, in *ec2.RunInstancesInput,
 	instType := in.InstanceType
 	imageId := aws.ToString(in.ImageId)
 	availZone := ""
+	placementGroup := ""
 	if in.Placement != nil {
 		availZone = aws.ToString(in.Placement.AvailabilityZone)
+		placementGroup = aws.ToString(in.Placement.GroupName)
 	}
 	if availZone == "" {
 		availZone = defaultAvailZone
 	}
+	if _, ok := srv.placementGroups[placementGroup]; placementGroup != "" && !ok {
+		return nil, apiError("InvalidPlacementGroup.Unknown", "placement group %q is unknown", placementGroup)
+	}
 
 	var groups []*securityGroup
 	if in != nil {
@@ -190,6 +204,7 @@ func (srv *Server) RunInstances(ctx context.Context, in *ec2.RunInstancesInput,
 			srv.createBlockDeviceMappingsOnRun(in.BlockDeviceMappings)...,
 		)
 		inst.metadataOptions = metadataResponse
+		inst.placementGroup = placementGroup
 		resp.Instances = append(resp.Instances, inst.ec2instance())
 	}
 	return resp, nil
@@ -407,7 +422,7 @@ func (inst *Instance) ec2instance() types.Instance {
 		PublicIpAddress:     aws.String(fmt.Sprintf("8.0.0.%d", inst.seq%256)),
 		PrivateIpAddress:    aws.String(fmt.Sprintf("127.0.0.%d", inst.seq%256)),
 		State:               &inst.state,
-		Placement:           &types.Placement{AvailabilityZone: aws.String(inst.availZone)},
+		Placement:           inst.placement(),
 		VpcId:               aws.String(inst.vpcId),
 		SubnetId:            aws.String(inst.subnetId),
 		BlockDeviceMappings: blockDeviceMappings,
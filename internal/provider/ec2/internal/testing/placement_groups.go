@@ -0,0 +1,124 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package testing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+type placementGroup struct {
+	id       string
+	name     string
+	strategy types.PlacementStrategy
+	tags     []types.Tag
+}
+
+func (g *placementGroup) matchAttr(attr, value string) (ok bool, err error) {
+	switch attr {
+	case "group-name":
+		return g.name == value, nil
+	case "strategy":
+		return string(g.strategy) == value, nil
+	case "state":
+		return value == string(types.PlacementGroupStateAvailable), nil
+	}
+	if strings.HasPrefix(attr, "tag:") {
+		key := attr[len("tag:"):]
+		return matchTag(g.tags, key, value), nil
+	}
+	return false, fmt.Errorf("unknown attribute %q", attr)
+}
+
+func (g *placementGroup) ec2PlacementGroup() types.PlacementGroup {
+	return types.PlacementGroup{
+		GroupId:   aws.String(g.id),
+		GroupName: aws.String(g.name),
+		Strategy:  g.strategy,
+		State:     types.PlacementGroupStateAvailable,
+		Tags:      g.tags,
+	}
+}
+
+// CreatePlacementGroup implements ec2.Client.
+func (srv *Server) CreatePlacementGroup(ctx context.Context, in *ec2.CreatePlacementGroupInput, opts ...func(*ec2.Options)) (*ec2.CreatePlacementGroupOutput, error) {
+	if err, ok := srv.apiCallErrors["CreatePlacementGroup"]; ok {
+		return nil, err
+	}
+	name := aws.ToString(in.GroupName)
+	if name == "" {
+		return nil, apiError("InvalidParameterValue", "empty placement group name")
+	}
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	if _, ok := srv.placementGroups[name]; ok {
+		return nil, apiError("InvalidPlacementGroup.Duplicate", "placement group %q already exists", name)
+	}
+	g := &placementGroup{
+		id:       fmt.Sprintf("pg-%d", srv.placementGroupId.next()),
+		name:     name,
+		strategy: in.Strategy,
+		tags:     tagSpecForType(types.ResourceTypePlacementGroup, in.TagSpecifications).Tags,
+	}
+	srv.placementGroups[name] = g
+	pg := g.ec2PlacementGroup()
+	return &ec2.CreatePlacementGroupOutput{PlacementGroup: &pg}, nil
+}
+
+// DescribePlacementGroups implements ec2.Client.
+func (srv *Server) DescribePlacementGroups(ctx context.Context, in *ec2.DescribePlacementGroupsInput, opts ...func(*ec2.Options)) (*ec2.DescribePlacementGroupsOutput, error) {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	var f ec2filter
+	if in != nil {
+		f = in.Filters
+	}
+
+	resp := &ec2.DescribePlacementGroupsOutput{}
+	for _, g := range srv.placementGroups {
+		ok, err := f.ok(g)
+		if ok {
+			resp.PlacementGroups = append(resp.PlacementGroups, g.ec2PlacementGroup())
+		} else if err != nil {
+			return nil, apiError("InvalidParameterValue", "describe placement groups: %v", err)
+		}
+	}
+	return resp, nil
+}
+
+// DeletePlacementGroup implements ec2.Client.
+func (srv *Server) DeletePlacementGroup(ctx context.Context, in *ec2.DeletePlacementGroupInput, opts ...func(*ec2.Options)) (*ec2.DeletePlacementGroupOutput, error) {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	name := aws.ToString(in.GroupName)
+	if _, ok := srv.placementGroups[name]; !ok {
+		return nil, apiError("InvalidPlacementGroup.Unknown", "placement group %q is unknown", name)
+	}
+	for _, inst := range srv.instances {
+		if inst.placementGroup == name && inst.state.Name != types.InstanceStateNameTerminated {
+			return nil, apiError("InvalidPlacementGroup.InUse", "placement group %q is in use", name)
+		}
+	}
+	delete(srv.placementGroups, name)
+	return &ec2.DeletePlacementGroupOutput{}, nil
+}
+
+// PlacementGroup returns the strategy of the placement group with the
+// given name, and whether it exists.
+func (srv *Server) PlacementGroup(name string) (types.PlacementStrategy, bool) {
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+	g, ok := srv.placementGroups[name]
+	if !ok {
+		return "", false
+	}
+	return g.strategy, true
+}
@@ -37,6 +37,8 @@ type Server struct {
 
 	tagsMutatingCalls counter
 
+	placementGroups map[string]*placementGroup // name -> group
+
 	maxId                       counter
 	reqId                       counter
 	reservationId               counter
@@ -50,6 +52,7 @@ type Server struct {
 	volumeId                    counter
 	ifaceId                     counter
 	attachId                    counter
+	placementGroupId            counter
 	initialInstanceState        types.InstanceState
 	instanceProfileAssociations map[string]types.IamInstanceProfileAssociation
 }
@@ -92,6 +95,7 @@ func (srv *Server) Reset(withoutZonesOrGroups bool) {
 	srv.volumeId.reset()
 	srv.ifaceId.reset()
 	srv.attachId.reset()
+	srv.placementGroupId.reset()
 
 	srv.instanceMutatingCalls.reset()
 	srv.groupMutatingCalls.reset()
@@ -112,6 +116,7 @@ func (srv *Server) Reset(withoutZonesOrGroups bool) {
 	srv.volumes = make(map[string]*volume)
 	srv.volumeAttachments = make(map[string]*volumeAttachment)
 	srv.reservations = make(map[string]*reservation)
+	srv.placementGroups = make(map[string]*placementGroup)
 
 	srv.instanceProfileAssociations = make(map[string]types.IamInstanceProfileAssociation)
 
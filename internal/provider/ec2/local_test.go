@@ -1617,6 +1617,73 @@ func (t *localServerSuite) TestStartInstanceNoPublicIP(c *tc.C) {
 	c.Assert(nics.NetworkInterfaces[0].Association.PublicIp, tc.IsNil)
 }
 
+func (t *localServerSuite) TestStartInstanceAntiAffinityPlacementGroup(c *tc.C) {
+	env := t.prepareAndBootstrap(c)
+
+	params := environs.StartInstanceParams{
+		ControllerUUID: t.ControllerUUID,
+		Constraints:    constraints.MustParse("affinity-group=db anti-affinity=true"),
+		StatusCallback: fakeCallback,
+	}
+	result, err := testing.StartInstanceWithParams(c, env, "1", params)
+	c.Assert(err, tc.ErrorIsNil)
+
+	groupName := "juju-" + env.Config().UUID() + "-db"
+	strategy, ok := t.srv.ec2srv.PlacementGroup(groupName)
+	c.Assert(ok, tc.IsTrue)
+	c.Check(strategy, tc.Equals, types.PlacementStrategySpread)
+
+	ec2Inst := ec2.InstanceSDKEC2(result.Instance)
+	c.Check(aws.ToString(ec2Inst.Placement.GroupName), tc.Equals, groupName)
+
+	// A second machine in the same group reuses the placement group.
+	_, err = testing.StartInstanceWithParams(c, env, "2", params)
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Destroying the model removes the placement group.
+	err = env.Destroy(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	_, ok = t.srv.ec2srv.PlacementGroup(groupName)
+	c.Check(ok, tc.IsFalse)
+}
+
+func (t *localServerSuite) TestStartInstanceAffinityPlacementGroup(c *tc.C) {
+	env := t.prepareAndBootstrap(c)
+
+	params := environs.StartInstanceParams{
+		ControllerUUID: t.ControllerUUID,
+		Constraints:    constraints.MustParse("affinity-group=db"),
+		StatusCallback: fakeCallback,
+	}
+	result, err := testing.StartInstanceWithParams(c, env, "1", params)
+	c.Assert(err, tc.ErrorIsNil)
+
+	groupName := "juju-" + env.Config().UUID() + "-db"
+	strategy, ok := t.srv.ec2srv.PlacementGroup(groupName)
+	c.Assert(ok, tc.IsTrue)
+	c.Check(strategy, tc.Equals, types.PlacementStrategyPartition)
+
+	ec2Inst := ec2.InstanceSDKEC2(result.Instance)
+	c.Check(aws.ToString(ec2Inst.Placement.GroupName), tc.Equals, groupName)
+}
+
+func (t *localServerSuite) TestStartInstanceAffinityGroupStrategyMismatch(c *tc.C) {
+	env := t.prepareAndBootstrap(c)
+
+	params := environs.StartInstanceParams{
+		ControllerUUID: t.ControllerUUID,
+		Constraints:    constraints.MustParse("affinity-group=db"),
+		StatusCallback: fakeCallback,
+	}
+	_, err := testing.StartInstanceWithParams(c, env, "1", params)
+	c.Assert(err, tc.ErrorIsNil)
+
+	params.Constraints = constraints.MustParse("affinity-group=db anti-affinity=true")
+	_, err = testing.StartInstanceWithParams(c, env, "2", params)
+	c.Assert(err, tc.ErrorMatches, `.*affinity group "db" uses placement strategy "partition", but "spread" is required by the constraints.*`)
+	c.Assert(err, tc.ErrorIs, environs.ErrAvailabilityZoneIndependent)
+}
+
 func (t *localServerSuite) TestStartInstanceAvailZoneOneConstrained(c *tc.C) {
 	t.testStartInstanceAvailZoneOneConstrained(c, azConstrainedErr)
 }
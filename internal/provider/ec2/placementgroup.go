@@ -0,0 +1,114 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/environs/tags"
+)
+
+// placementGroupName returns the name of the EC2 placement group used to
+// realise the input affinity group within this model.
+func (e *environ) placementGroupName(affinityGroup string) string {
+	return fmt.Sprintf("%s-%s", e.jujuGroupName(), affinityGroup)
+}
+
+// placementStrategy returns the EC2 placement strategy that satisfies the
+// affinity constraints. Anti-affinity is realised with the spread strategy,
+// which places each instance on distinct underlying hardware. Otherwise the
+// partition strategy groups the instances into logical partitions. The
+// cluster strategy is not used, as it confines a group to a single
+// availability zone and a limited set of instance types, which would fail
+// machines that Juju distributes across zones.
+func placementStrategy(cons constraints.Value) types.PlacementStrategy {
+	if cons.HasAntiAffinity() {
+		return types.PlacementStrategySpread
+	}
+	return types.PlacementStrategyPartition
+}
+
+// ensurePlacementGroup returns the name of the placement group that an
+// instance with the input constraints must be started in, creating the group
+// if it does not yet exist. An empty name is returned if the constraints do
+// not specify an affinity group.
+func (e *environ) ensurePlacementGroup(
+	ctx context.Context, controllerUUID string, cons constraints.Value,
+) (string, error) {
+	if !cons.HasAffinityGroup() {
+		return "", nil
+	}
+	name := e.placementGroupName(*cons.AffinityGroup)
+	strategy := placementStrategy(cons)
+
+	resp, err := e.ec2Client.DescribePlacementGroups(ctx, &ec2.DescribePlacementGroupsInput{
+		Filters: []types.Filter{makeFilter("group-name", name)},
+	})
+	if err != nil {
+		return "", errors.Annotatef(err, "describing placement group %q", name)
+	}
+	for _, group := range resp.PlacementGroups {
+		if aws.ToString(group.GroupName) != name {
+			continue
+		}
+		if group.Strategy != strategy {
+			return "", errors.NotValidf(
+				"affinity group %q uses placement strategy %q, but %q is required by the constraints",
+				*cons.AffinityGroup, group.Strategy, strategy,
+			)
+		}
+		return name, nil
+	}
+
+	cfg := e.Config()
+	groupTags := tags.ResourceTags(
+		names.NewModelTag(cfg.UUID()),
+		names.NewControllerTag(controllerUUID),
+		cfg,
+	)
+	groupTags[tagName] = name
+	_, err = e.ec2Client.CreatePlacementGroup(ctx, &ec2.CreatePlacementGroupInput{
+		GroupName: aws.String(name),
+		Strategy:  strategy,
+		TagSpecifications: []types.TagSpecification{
+			CreateTagSpecification(types.ResourceTypePlacementGroup, groupTags),
+		},
+	})
+	// Another provisioner may have raced us to create the group.
+	if err != nil && ec2ErrCode(err) != "InvalidPlacementGroup.Duplicate" {
+		return "", errors.Annotatef(err, "creating placement group %q", name)
+	}
+	return name, nil
+}
+
+// deletePlacementGroups deletes all placement groups matching the input
+// filter. It must only be called once the instances in the groups have been
+// terminated.
+func (e *environ) deletePlacementGroups(ctx context.Context, filter types.Filter) error {
+	resp, err := e.ec2Client.DescribePlacementGroups(ctx, &ec2.DescribePlacementGroupsInput{
+		Filters: []types.Filter{filter},
+	})
+	if err != nil {
+		return errors.Annotate(e.HandleCredentialError(ctx, err), "listing placement groups")
+	}
+	for _, group := range resp.PlacementGroups {
+		name := aws.ToString(group.GroupName)
+		logger.Debugf(ctx, "deleting placement group %q", name)
+		_, err := e.ec2Client.DeletePlacementGroup(ctx, &ec2.DeletePlacementGroupInput{
+			GroupName: group.GroupName,
+		})
+		if err != nil && ec2ErrCode(err) != "InvalidPlacementGroup.Unknown" {
+			return errors.Annotatef(e.HandleCredentialError(ctx, err), "deleting placement group %q", name)
+		}
+	}
+	return nil
+}
@@ -265,6 +265,40 @@ func (s *environInstSuite) TestListMachineTypes(c *tc.C) {
 
 }
 
+func (s *environInstSuite) TestListMachineTypesAccelerators(c *tc.C) {
+	ctrl := s.SetupMocks(c)
+	defer ctrl.Finish()
+
+	env := s.SetupEnv(c, s.MockService)
+
+	s.MockService.EXPECT().AvailabilityZones(gomock.Any(), "us-east1").Return([]*computepb.Zone{{
+		Name:   ptr("home-zone"),
+		Status: ptr("UP"),
+	}}, nil)
+	s.MockService.EXPECT().ListMachineTypes(gomock.Any(), "home-zone").Return([]*computepb.MachineType{{
+		Id:        ptr(uint64(0)),
+		Name:      ptr("n1-standard-8"),
+		GuestCpus: ptr(int32(8)),
+		MemoryMb:  ptr(int32(30720)),
+	}, {
+		Id:        ptr(uint64(1)),
+		Name:      ptr("g2-standard-8"),
+		GuestCpus: ptr(int32(8)),
+		MemoryMb:  ptr(int32(32768)),
+		Accelerators: []*computepb.Accelerators{{
+			GuestAcceleratorCount: ptr(int32(1)),
+			GuestAcceleratorType:  ptr("nvidia-l4"),
+		}},
+	}}, nil)
+
+	types, err := env.InstanceTypes(c.Context(), constraints.MustParse("accelerators=1:NVIDIA-L4"))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(types.InstanceTypes, tc.HasLen, 1)
+	c.Check(types.InstanceTypes[0].Name, tc.Equals, "g2-standard-8")
+	c.Check(types.InstanceTypes[0].Accelerators, tc.Equals, uint64(1))
+	c.Check(types.InstanceTypes[0].AcceleratorType, tc.Equals, "nvidia-l4")
+}
+
 func (s *environInstSuite) TestAdoptResources(c *tc.C) {
 	ctrl := s.SetupMocks(c)
 	defer ctrl.Finish()
@@ -49,6 +49,8 @@ var unsupportedConstraints = []string{
 	constraints.Tags,
 	constraints.VirtType,
 	constraints.ImageID,
+	constraints.AffinityGroup,
+	constraints.AntiAffinity,
 }
 
 // instanceTypeConstraints defines the fields defined on each of the
@@ -60,6 +62,7 @@ var instanceTypeConstraints = []string{
 	constraints.CpuPower,
 	constraints.Mem,
 	constraints.Container, // VirtType
+	constraints.Accelerators,
 }
 
 // ConstraintsValidator returns a Validator value which is used to
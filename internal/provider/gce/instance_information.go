@@ -87,6 +87,13 @@ func (env *environ) getAllInstanceTypes(ctx context.Context, clock clock.Clock)
 				Arch:     arch.AMD64,
 				VirtType: &virtType,
 			}
+			// Accelerator optimised machine types (e.g. a2, g2) come
+			// with a fixed set of GPUs attached.
+			for _, accel := range m.GetAccelerators() {
+				i.Accelerators = uint64(accel.GetGuestAcceleratorCount())
+				i.AcceleratorType = accel.GetGuestAcceleratorType()
+				break
+			}
 			resultUnique[m.GetName()] = i
 		}
 	}
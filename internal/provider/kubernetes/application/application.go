@@ -32,6 +32,7 @@ import (
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
 	"k8s.io/utils/pointer"
 
 	"github.com/juju/juju/caas"
@@ -270,11 +271,13 @@ func (a *app) Ensure(config caas.ApplicationConfig) (err error) {
 		return nil
 	}
 	var configureStorage = func(storageUniqueID string, handlePVC handlePVCFunc) error {
+		// Shared filesystems are always backed by a single standalone PVC
+		// which is mounted by every pod of the application.
 		err := a.configureStorage(
 			storageUniqueID,
 			config.Filesystems,
 			storageClasses,
-			handleVolume, handleVolumeMount, handlePVC, handleStorageClass,
+			handleVolume, handleVolumeMount, handlePVC, handlePVCForStatelessResource, handleStorageClass,
 		)
 		return errors.Trace(err)
 	}
@@ -343,6 +346,9 @@ func (a *app) Ensure(config caas.ApplicationConfig) (err error) {
 		); err != nil {
 			return errors.Trace(err)
 		}
+		// Volumes (e.g. shared filesystem claims) are pushed to the podspec
+		// after the statefulset template was copied from it.
+		statefulset.Spec.Template.Spec.Volumes = podSpec.Volumes
 
 		applier.Apply(statefulset)
 	case caas.DeploymentStateless:
@@ -2198,6 +2204,7 @@ func (a *app) configureStorage(
 	handleVolume handleVolumeFunc,
 	handleVolumeMount handleVolumeMountFunc,
 	handlePVC handlePVCFunc,
+	handleSharedPVC handlePVCFunc,
 	handleStorageClass handleStorageClassFunc,
 ) error {
 	storageClassMap := make(map[string]resources.StorageClass)
@@ -2249,7 +2256,13 @@ func (a *app) configureStorage(
 			}
 			storageClassMap[sc.Name] = resources.StorageClass{StorageClass: *sc}
 		}
-		if pvc != nil && handlePVC != nil {
+		if pvc != nil && fs.Shared && handleSharedPVC != nil {
+			logger.Debugf(context.TODO(), "using shared persistent volume claim for %s filesystem %s: %s", a.name, fs.StorageName, pretty.Sprint(*pvc))
+			volumeMount, err = handleSharedPVC(*pvc, mountPath, readOnly)
+			if err != nil {
+				return errors.Trace(err)
+			}
+		} else if pvc != nil && handlePVC != nil {
 			logger.Debugf(context.TODO(), "using persistent volume claim for %s filesystem %s: %s", a.name, fs.StorageName, pretty.Sprint(*pvc))
 			volumeMount, err = handlePVC(*pvc, mountPath, readOnly)
 			if err != nil {
@@ -2299,6 +2312,12 @@ func (a *app) filesystemToVolumeInfo(
 		return nil, nil, nil, errors.Annotatef(err, "getting volume params for %s", fs.StorageName)
 	}
 
+	if fs.Shared && params.AccessMode != corev1.ReadOnlyMany {
+		// A shared filesystem is mounted by all units of the application,
+		// so the claim must allow many nodes to mount it.
+		params.AccessMode = corev1.ReadWriteMany
+	}
+
 	var newStorageClass *storagev1.StorageClass
 	qualifiedStorageClassName := constants.QualifiedStorageClassName(a.namespace, params.StorageConfig.StorageClass)
 	if params.StorageConfig.StorageClass == "" {
@@ -2355,24 +2374,53 @@ func (a *app) handleVolumeAttachment(
 		pvcName = pvc.ObjectMeta.Name + "-" + pvcName
 
 		// Create the PVC if not found; otherwise, validate the existing volumeName.
+		// The template is copied as the constructor renames it.
+		claim := pvc
 		persistentVolumeClaim := resources.NewPersistentVolumeClaim(
 			a.client.CoreV1().PersistentVolumeClaims(a.namespace),
 			a.namespace,
 			pvcName,
-			&pvc,
+			&claim,
 		)
 
 		err := persistentVolumeClaim.Get(context.Background())
 		if errors.Is(err, errors.NotFound) {
-			logger.Debugf(context.TODO(), "pvc %s not found, create pvc with VolumeName %s", pvcName, attachment.VolumeId)
-			persistentVolumeClaim.Spec.VolumeName = attachment.VolumeId
+			volumeName, detachedPVC, err := a.resolveAttachmentVolume(attachment.VolumeId, pvc, storageName)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			if detachedPVC != nil && detachedPVC.Name != pvcName {
+				// The volume belongs to a claim of a removed unit, so hand it
+				// over to the new unit's claim.
+				if err := a.releaseVolume(volumeName, detachedPVC.Name, pvcName); err != nil {
+					return errors.Trace(err)
+				}
+				persistentVolumeClaim.Annotations = annotations.New(persistentVolumeClaim.Annotations).
+					Add(adoptedClaimAnnotationKey(), attachment.VolumeId).
+					ToMap()
+			}
+			logger.Debugf(context.TODO(), "pvc %s not found, create pvc with VolumeName %s", pvcName, volumeName)
+			persistentVolumeClaim.Spec.VolumeName = volumeName
 			applier.Apply(persistentVolumeClaim)
 		} else if err != nil {
 			return errors.Trace(err)
-		}
-
-		if persistentVolumeClaim.Spec.VolumeName != attachment.VolumeId {
-			return errors.AlreadyExistsf("PVC %q with volumeName %q", pvcName, pvc.Spec.VolumeName)
+		} else if persistentVolumeClaim.Annotations[adoptedClaimAnnotationKey()] == attachment.VolumeId {
+			// The volume was handed over from a removed unit's claim; put
+			// back its reclaim policy once this claim is bound to it.
+			if persistentVolumeClaim.Status.Phase == corev1.ClaimBound {
+				if err := a.restoreReclaimPolicy(persistentVolumeClaim.Spec.VolumeName); err != nil {
+					return errors.Trace(err)
+				}
+			}
+		} else if persistentVolumeClaim.Spec.VolumeName != attachment.VolumeId &&
+			persistentVolumeClaim.Annotations[adoptedClaimAnnotationKey()] != attachment.VolumeId {
+			volumeName, _, err := a.resolveAttachmentVolume(attachment.VolumeId, pvc, storageName)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			if persistentVolumeClaim.Spec.VolumeName != volumeName {
+				return errors.AlreadyExistsf("PVC %q with volumeName %q", pvcName, persistentVolumeClaim.Spec.VolumeName)
+			}
 		}
 		if _, err := utils.MatchStorageMetaLabelVersion(persistentVolumeClaim.ObjectMeta, storageName); err != nil {
 			return errors.Annotatef(
@@ -2382,6 +2430,159 @@ func (a *app) handleVolumeAttachment(
 	return nil
 }
 
+// adoptedClaimAnnotationKey returns the annotation key recording the UID of
+// the claim whose volume was handed over to a new unit's claim.
+func adoptedClaimAnnotationKey() string {
+	return utils.MakeK8sDomain("storage") + "/adopted-claim-uid"
+}
+
+// resolveAttachmentVolume returns the name of the persistent volume to bind
+// for the given volume id. The volume id is either the name of a persistent
+// volume, or the UID of a persistent volume claim left behind by a unit of
+// this application that has since been removed. In the latter case the
+// detached claim is validated against the claim template and returned so the
+// caller can release its volume.
+func (a *app) resolveAttachmentVolume(
+	volumeID string,
+	template corev1.PersistentVolumeClaim,
+	storageName string,
+) (string, *corev1.PersistentVolumeClaim, error) {
+	ctx := context.TODO()
+	_, err := a.client.CoreV1().PersistentVolumes().Get(ctx, volumeID, metav1.GetOptions{})
+	if err == nil {
+		return volumeID, nil, nil
+	} else if !k8serrors.IsNotFound(err) {
+		return "", nil, errors.Trace(err)
+	}
+
+	pvcs, err := resources.ListPersistentVolumeClaims(ctx, a.client, a.namespace, metav1.ListOptions{})
+	if err != nil {
+		return "", nil, errors.Annotate(err, "fetching persistent volume claims")
+	}
+	var detached *corev1.PersistentVolumeClaim
+	for _, claim := range pvcs {
+		if string(claim.UID) == volumeID {
+			detached = &claim.PersistentVolumeClaim
+			break
+		}
+	}
+	if detached == nil {
+		// The volume has not been provisioned yet, bind by name.
+		return volumeID, nil, nil
+	}
+
+	if !strings.HasPrefix(detached.Name, template.Name+"-"+a.name+"-") {
+		return "", nil, errors.NotValidf(
+			"PersistentVolumeClaim %q for storage %q of application %q", detached.Name, storageName, a.name)
+	}
+	if _, err := utils.MatchStorageMetaLabelVersion(detached.ObjectMeta, storageName); err != nil {
+		return "", nil, errors.Annotatef(
+			err, "ensuring PersistentVolumeClaim %q with labels %v", detached.Name, detached.Labels)
+	}
+	want, got := template.Spec.StorageClassName, detached.Spec.StorageClassName
+	if want != nil && got != nil && *want != *got {
+		return "", nil, errors.NotValidf(
+			"PersistentVolumeClaim %q with storage class %q, expected %q", detached.Name, *got, *want)
+	}
+	if detached.Spec.VolumeName == "" {
+		return "", nil, errors.NotValidf("unbound PersistentVolumeClaim %q", detached.Name)
+	}
+	return detached.Spec.VolumeName, detached, nil
+}
+
+// originalReclaimPolicyAnnotationKey returns the annotation key recording
+// the reclaim policy a persistent volume had before it was retained to hand
+// it over to a new claim.
+func originalReclaimPolicyAnnotationKey() string {
+	return utils.MakeK8sDomain("storage") + "/original-reclaim-policy"
+}
+
+// releaseVolume hands the named persistent volume over from the claim bound
+// to it to the new claim. The volume is first retained, so that deleting the
+// old claim does not delete the volume, then the old claim is deleted, and
+// finally the volume's claim reference is pointed at the new claim so that no
+// other claim can bind it in the meantime. The volume's original reclaim
+// policy is recorded on it, and put back by restoreReclaimPolicy once the new
+// claim is bound. Each step re-reads the volume, so it is safe to call again
+// for a volume which has already been released.
+func (a *app) releaseVolume(volumeName, claimName, newClaimName string) error {
+	ctx := context.TODO()
+	err := a.updatePersistentVolume(ctx, volumeName, func(pv *corev1.PersistentVolume) bool {
+		if pv.Spec.PersistentVolumeReclaimPolicy == corev1.PersistentVolumeReclaimRetain {
+			return false
+		}
+		pv.Annotations = annotations.New(pv.Annotations).
+			Add(originalReclaimPolicyAnnotationKey(), string(pv.Spec.PersistentVolumeReclaimPolicy)).
+			ToMap()
+		pv.Spec.PersistentVolumeReclaimPolicy = corev1.PersistentVolumeReclaimRetain
+		return true
+	})
+	if err != nil {
+		return errors.Annotatef(err, "retaining PersistentVolume %q", volumeName)
+	}
+
+	err = a.client.CoreV1().PersistentVolumeClaims(a.namespace).Delete(ctx, claimName, metav1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return errors.Annotatef(err, "deleting PersistentVolumeClaim %q", claimName)
+	}
+
+	err = a.updatePersistentVolume(ctx, volumeName, func(pv *corev1.PersistentVolume) bool {
+		if ref := pv.Spec.ClaimRef; ref != nil && ref.Namespace == a.namespace && ref.Name == newClaimName {
+			return false
+		}
+		pv.Spec.ClaimRef = &corev1.ObjectReference{
+			Kind:       "PersistentVolumeClaim",
+			APIVersion: "v1",
+			Namespace:  a.namespace,
+			Name:       newClaimName,
+		}
+		return true
+	})
+	if err != nil {
+		return errors.Annotatef(err, "releasing PersistentVolume %q", volumeName)
+	}
+	return nil
+}
+
+// restoreReclaimPolicy puts back the reclaim policy the named persistent
+// volume had before it was handed over to a new claim, once it is bound.
+func (a *app) restoreReclaimPolicy(volumeName string) error {
+	ctx := context.TODO()
+	err := a.updatePersistentVolume(ctx, volumeName, func(pv *corev1.PersistentVolume) bool {
+		policy, ok := pv.Annotations[originalReclaimPolicyAnnotationKey()]
+		if !ok || pv.Status.Phase != corev1.VolumeBound {
+			return false
+		}
+		pv.Spec.PersistentVolumeReclaimPolicy = corev1.PersistentVolumeReclaimPolicy(policy)
+		delete(pv.Annotations, originalReclaimPolicyAnnotationKey())
+		return true
+	})
+	if err != nil {
+		return errors.Annotatef(err, "restoring reclaim policy of PersistentVolume %q", volumeName)
+	}
+	return nil
+}
+
+// updatePersistentVolume applies the mutation to the latest revision of the
+// named persistent volume, retrying if the volume is changed concurrently.
+// The mutation returns false if the volume needs no update.
+func (a *app) updatePersistentVolume(
+	ctx context.Context, volumeName string, mutate func(*corev1.PersistentVolume) bool,
+) error {
+	api := a.client.CoreV1().PersistentVolumes()
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		pv, err := api.Get(ctx, volumeName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if !mutate(pv) {
+			return nil
+		}
+		_, err = api.Update(ctx, pv, metav1.UpdateOptions{FieldManager: resources.JujuFieldManager})
+		return err
+	})
+}
+
 func (a *app) pvcNameGetter(pvcNames map[string]string, storageUniqueID string) func(string) string {
 	return func(volName string) string {
 		if n, ok := pvcNames[volName]; ok {
@@ -605,6 +605,63 @@ func (s *applicationSuite) TestEnsureStateful(c *tc.C) {
 	s.assertDelete(c, app)
 }
 
+func (s *applicationSuite) TestEnsureStatefulSharedFilesystem(c *tc.C) {
+	app, _ := s.getApp(c, caas.DeploymentStateful, false)
+
+	appConfig := caas.ApplicationConfig{
+		AgentVersion:         semversion.MustParse(defaultAgentVersion),
+		AgentImagePath:       "operator/image-path:1.1.1",
+		CharmBaseImagePath:   "ubuntu@22.04",
+		CharmModifiedVersion: 9001,
+		Filesystems: []storage.KubernetesFilesystemParams{
+			{
+				StorageName: "database",
+				Size:        100,
+				Provider:    "kubernetes",
+				Attributes:  map[string]interface{}{"storage-class": "workload-storage"},
+				Shared:      true,
+				Attachment: &storage.KubernetesFilesystemAttachmentParams{
+					Path: "path/to/here",
+				},
+			},
+		},
+		Containers: map[string]caas.ContainerConfig{
+			"gitlab": {
+				Name: "gitlab",
+				Image: coreresources.DockerImageDetails{
+					RegistryPath: "docker.io/library/gitlab:latest",
+				},
+				Mounts: []caas.MountConfig{
+					{
+						StorageName: "database",
+						Path:        "path/to/here",
+					},
+				},
+			},
+		},
+		InitialScale: 3,
+	}
+	c.Assert(app.Ensure(appConfig), tc.ErrorIsNil)
+
+	// A single claim, mountable by all units, is created for the
+	// application rather than a claim template per unit.
+	pvc, err := s.client.CoreV1().PersistentVolumeClaims("test").Get(c.Context(), "gitlab-database-appuuid", metav1.GetOptions{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(pvc.Spec.AccessModes, tc.DeepEquals, []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany})
+
+	ss, err := s.client.AppsV1().StatefulSets("test").Get(c.Context(), "gitlab", metav1.GetOptions{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(ss.Spec.VolumeClaimTemplates, tc.HasLen, 0)
+
+	var found bool
+	for _, vol := range ss.Spec.Template.Spec.Volumes {
+		if vol.PersistentVolumeClaim != nil && vol.PersistentVolumeClaim.ClaimName == "gitlab-database-appuuid" {
+			found = true
+		}
+	}
+	c.Check(found, tc.IsTrue)
+}
+
 func (s *applicationSuite) TestEnsureStatefulRootless35(c *tc.C) {
 	app, _ := s.getApp(c, caas.DeploymentStateful, false)
 	s.assertEnsure(
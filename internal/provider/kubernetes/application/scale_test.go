@@ -4,12 +4,19 @@
 package application_test
 
 import (
+	"strings"
+
 	"github.com/juju/errors"
 	"github.com/juju/tc"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
 
 	"github.com/juju/juju/caas"
 	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/internal/provider/kubernetes/utils"
 	"github.com/juju/juju/internal/storage"
 )
 
@@ -98,3 +105,181 @@ func (s *applicationSuite) TestEnsurePVCs(c *tc.C) {
 	c.Assert(pvc.Spec.VolumeName, tc.Equals, "test-volume-id")
 	c.Assert(pvc.Name, tc.Matches, "gitlab-database-.*-gitlab-0")
 }
+
+func (s *applicationSuite) ensureTemplatePVC(c *tc.C, app caas.Application) corev1.PersistentVolumeClaim {
+	filesystems := []storage.KubernetesFilesystemParams{{
+		StorageName: "database",
+		Size:        1024,
+		Provider:    storage.ProviderType("kubernetes"),
+	}}
+	err := app.EnsurePVCs(filesystems, map[string][]storage.KubernetesFilesystemUnitAttachmentParams{
+		"database": {{UnitName: "gitlab/0", VolumeId: "test-volume-id"}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+
+	pvcList, err := s.client.CoreV1().PersistentVolumeClaims(s.namespace).List(c.Context(), metav1.ListOptions{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(pvcList.Items, tc.HasLen, 1)
+	return pvcList.Items[0]
+}
+
+func (s *applicationSuite) TestEnsurePVCsAdoptsDetachedClaim(c *tc.C) {
+	app, _ := s.getApp(c, caas.DeploymentStateful, false)
+	s.assertEnsure(c, app, false, constraints.Value{}, false, false, "", func() {})
+
+	existing := s.ensureTemplatePVC(c, app)
+	prefix := strings.TrimSuffix(existing.Name, "-gitlab-0")
+
+	_, err := s.client.CoreV1().PersistentVolumes().Create(c.Context(), &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: "pv-1"},
+		Spec: corev1.PersistentVolumeSpec{
+			PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimDelete,
+			ClaimRef: &corev1.ObjectReference{
+				Namespace: s.namespace,
+				Name:      prefix + "-gitlab-1",
+				UID:       "detached-uid",
+			},
+		},
+	}, metav1.CreateOptions{})
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = s.client.CoreV1().PersistentVolumeClaims(s.namespace).Create(c.Context(), &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   prefix + "-gitlab-1",
+			UID:    "detached-uid",
+			Labels: existing.Labels,
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{VolumeName: "pv-1"},
+	}, metav1.CreateOptions{})
+	c.Assert(err, tc.ErrorIsNil)
+
+	filesystems := []storage.KubernetesFilesystemParams{{
+		StorageName: "database",
+		Size:        1024,
+		Provider:    storage.ProviderType("kubernetes"),
+	}}
+	attachments := map[string][]storage.KubernetesFilesystemUnitAttachmentParams{
+		"database": {{UnitName: "gitlab/2", VolumeId: "detached-uid"}},
+	}
+	err = app.EnsurePVCs(filesystems, attachments)
+	c.Assert(err, tc.ErrorIsNil)
+
+	pvc, err := s.client.CoreV1().PersistentVolumeClaims(s.namespace).Get(c.Context(), prefix+"-gitlab-2", metav1.GetOptions{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(pvc.Spec.VolumeName, tc.Equals, "pv-1")
+
+	_, err = s.client.CoreV1().PersistentVolumeClaims(s.namespace).Get(c.Context(), prefix+"-gitlab-1", metav1.GetOptions{})
+	c.Check(k8serrors.IsNotFound(err), tc.IsTrue)
+
+	// The volume is reserved for the new claim, and retained until the
+	// new claim is bound to it.
+	pv, err := s.client.CoreV1().PersistentVolumes().Get(c.Context(), "pv-1", metav1.GetOptions{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(pv.Spec.ClaimRef, tc.DeepEquals, &corev1.ObjectReference{
+		Kind:       "PersistentVolumeClaim",
+		APIVersion: "v1",
+		Namespace:  s.namespace,
+		Name:       prefix + "-gitlab-2",
+	})
+	c.Check(pv.Spec.PersistentVolumeReclaimPolicy, tc.Equals, corev1.PersistentVolumeReclaimRetain)
+
+	// Ensuring again before the claim is bound keeps the volume retained.
+	err = app.EnsurePVCs(filesystems, attachments)
+	c.Assert(err, tc.ErrorIsNil)
+	pv, err = s.client.CoreV1().PersistentVolumes().Get(c.Context(), "pv-1", metav1.GetOptions{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(pv.Spec.PersistentVolumeReclaimPolicy, tc.Equals, corev1.PersistentVolumeReclaimRetain)
+
+	// Once the claim is bound, the original reclaim policy is put back.
+	pvc.Status.Phase = corev1.ClaimBound
+	_, err = s.client.CoreV1().PersistentVolumeClaims(s.namespace).UpdateStatus(c.Context(), pvc, metav1.UpdateOptions{})
+	c.Assert(err, tc.ErrorIsNil)
+	pv.Status.Phase = corev1.VolumeBound
+	_, err = s.client.CoreV1().PersistentVolumes().UpdateStatus(c.Context(), pv, metav1.UpdateOptions{})
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = app.EnsurePVCs(filesystems, attachments)
+	c.Assert(err, tc.ErrorIsNil)
+	pv, err = s.client.CoreV1().PersistentVolumes().Get(c.Context(), "pv-1", metav1.GetOptions{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(pv.Spec.PersistentVolumeReclaimPolicy, tc.Equals, corev1.PersistentVolumeReclaimDelete)
+	_, recorded := pv.Annotations[utils.MakeK8sDomain("storage")+"/original-reclaim-policy"]
+	c.Check(recorded, tc.IsFalse)
+}
+
+func (s *applicationSuite) TestEnsurePVCsAdoptsDetachedClaimConflict(c *tc.C) {
+	app, _ := s.getApp(c, caas.DeploymentStateful, false)
+	s.assertEnsure(c, app, false, constraints.Value{}, false, false, "", func() {})
+
+	existing := s.ensureTemplatePVC(c, app)
+	prefix := strings.TrimSuffix(existing.Name, "-gitlab-0")
+
+	// The old claim has already been removed and the volume released.
+	_, err := s.client.CoreV1().PersistentVolumes().Create(c.Context(), &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: "pv-1"},
+		Spec: corev1.PersistentVolumeSpec{
+			PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimRetain,
+		},
+	}, metav1.CreateOptions{})
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = s.client.CoreV1().PersistentVolumeClaims(s.namespace).Create(c.Context(), &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   prefix + "-gitlab-1",
+			UID:    "detached-uid",
+			Labels: existing.Labels,
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{VolumeName: "pv-1"},
+	}, metav1.CreateOptions{})
+	c.Assert(err, tc.ErrorIsNil)
+
+	// The volume is changed concurrently the first time it is updated.
+	conflicted := false
+	s.client.PrependReactor("update", "persistentvolumes", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if conflicted {
+			return false, nil, nil
+		}
+		conflicted = true
+		return true, nil, k8serrors.NewConflict(corev1.Resource("persistentvolumes"), "pv-1", errors.New("stale"))
+	})
+
+	err = app.EnsurePVCs([]storage.KubernetesFilesystemParams{{
+		StorageName: "database",
+		Size:        1024,
+		Provider:    storage.ProviderType("kubernetes"),
+	}}, map[string][]storage.KubernetesFilesystemUnitAttachmentParams{
+		"database": {{UnitName: "gitlab/2", VolumeId: "detached-uid"}},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(conflicted, tc.IsTrue)
+
+	pv, err := s.client.CoreV1().PersistentVolumes().Get(c.Context(), "pv-1", metav1.GetOptions{})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(pv.Spec.ClaimRef.Name, tc.Equals, prefix+"-gitlab-2")
+	_, recorded := pv.Annotations[utils.MakeK8sDomain("storage")+"/original-reclaim-policy"]
+	c.Check(recorded, tc.IsFalse)
+}
+
+func (s *applicationSuite) TestEnsurePVCsDetachedClaimOtherApplication(c *tc.C) {
+	app, _ := s.getApp(c, caas.DeploymentStateful, false)
+	s.assertEnsure(c, app, false, constraints.Value{}, false, false, "", func() {})
+
+	existing := s.ensureTemplatePVC(c, app)
+
+	_, err := s.client.CoreV1().PersistentVolumeClaims(s.namespace).Create(c.Context(), &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "mariadb-database-abcd-mariadb-0",
+			UID:    "detached-uid",
+			Labels: existing.Labels,
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{VolumeName: "pv-1"},
+	}, metav1.CreateOptions{})
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = app.EnsurePVCs([]storage.KubernetesFilesystemParams{{
+		StorageName: "database",
+		Size:        1024,
+		Provider:    storage.ProviderType("kubernetes"),
+	}}, map[string][]storage.KubernetesFilesystemUnitAttachmentParams{
+		"database": {{UnitName: "gitlab/1", VolumeId: "detached-uid"}},
+	})
+	c.Assert(err, tc.ErrorIs, errors.NotValid)
+}
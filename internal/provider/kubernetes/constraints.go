@@ -17,6 +17,9 @@ var unsupportedConstraints = []string{
 	constraints.Spaces,
 	constraints.AllocatePublicIP,
 	constraints.ImageID,
+	constraints.AffinityGroup,
+	constraints.AntiAffinity,
+	constraints.Accelerators,
 }
 
 // ConstraintsValidator returns a Validator value which is used to
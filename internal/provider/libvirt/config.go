@@ -0,0 +1,115 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package libvirt
+
+import (
+	"context"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/schema"
+
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/internal/configschema"
+)
+
+// The libvirt-specific config keys.
+const (
+	cfgStoragePool   = "libvirt-pool"
+	cfgNetwork       = "libvirt-network"
+	cfgCloudImageURL = "cloud-image-url"
+)
+
+// defaultCloudImageURL is the default template for the URL of the cloud
+// image that instances are created from.
+const defaultCloudImageURL = "https://cloud-images.ubuntu.com/releases/{version}/release/ubuntu-{version}-server-cloudimg-{arch}.img"
+
+var (
+	configSchema = configschema.Fields{
+		cfgStoragePool: {
+			Description: "The libvirt storage pool in which cloud images and instance root disks are created.",
+			Type:        configschema.Tstring,
+		},
+		cfgNetwork: {
+			Description: "The libvirt network that instances are connected to.",
+			Type:        configschema.Tstring,
+		},
+		cfgCloudImageURL: {
+			Description: "The URL template of the qcow2 cloud image that instances are created from. The {version} and {arch} placeholders are replaced with the base version and architecture of the instance.",
+			Type:        configschema.Tstring,
+		},
+	}
+
+	configDefaults = schema.Defaults{
+		cfgStoragePool:   "default",
+		cfgNetwork:       "default",
+		cfgCloudImageURL: defaultCloudImageURL,
+	}
+)
+
+var configFields = func() schema.Fields {
+	fs, _, err := configSchema.ValidationSchema()
+	if err != nil {
+		panic(err)
+	}
+	return fs
+}()
+
+type environConfig struct {
+	*config.Config
+	attrs map[string]interface{}
+}
+
+// newValidConfig builds a new environConfig from the provided Config
+// and returns it. The resulting config values are validated.
+func newValidConfig(ctx context.Context, cfg *config.Config) (*environConfig, error) {
+	// Ensure that the provided config is valid.
+	if err := config.Validate(ctx, cfg, nil); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	// Apply the defaults and coerce/validate the custom config attrs.
+	validated, err := cfg.ValidateUnknownAttrs(configFields, configDefaults)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	validCfg, err := cfg.Apply(validated)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	ecfg := &environConfig{
+		Config: validCfg,
+		attrs:  validCfg.UnknownAttrs(),
+	}
+	if err := ecfg.validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return ecfg, nil
+}
+
+// validate checks libvirt-specific config values.
+func (c *environConfig) validate() error {
+	for _, key := range []string{cfgStoragePool, cfgNetwork, cfgCloudImageURL} {
+		if value, _ := c.attrs[key].(string); value == "" {
+			return errors.NotValidf("empty %s", key)
+		}
+	}
+	if !strings.Contains(c.cloudImageURL(), "{version}") {
+		return errors.NotValidf("%s %q without {version} placeholder", cfgCloudImageURL, c.cloudImageURL())
+	}
+	return nil
+}
+
+func (c *environConfig) storagePool() string {
+	return c.attrs[cfgStoragePool].(string)
+}
+
+func (c *environConfig) network() string {
+	return c.attrs[cfgNetwork].(string)
+}
+
+func (c *environConfig) cloudImageURL() string {
+	return c.attrs[cfgCloudImageURL].(string)
+}
@@ -0,0 +1,163 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package libvirt
+
+import (
+	"context"
+	"io"
+)
+
+// DomainState describes the run state of a libvirt domain, as reported by
+// "virsh domstate".
+type DomainState string
+
+const (
+	DomainRunning     DomainState = "running"
+	DomainIdle        DomainState = "idle"
+	DomainPaused      DomainState = "paused"
+	DomainShutdown    DomainState = "in shutdown"
+	DomainShutOff     DomainState = "shut off"
+	DomainCrashed     DomainState = "crashed"
+	DomainPMSuspended DomainState = "pmsuspended"
+)
+
+// Domain describes a libvirt domain and its current run state.
+type Domain struct {
+	DomainXML
+
+	// State is the current run state of the domain.
+	State DomainState
+}
+
+// InterfaceAddress describes an IP address leased to one of the network
+// interfaces of a domain.
+type InterfaceAddress struct {
+	// MACAddress is the hardware address of the interface.
+	MACAddress string
+
+	// CIDRAddress is the address, in CIDR notation.
+	CIDRAddress string
+}
+
+// StoragePool describes a libvirt storage pool.
+type StoragePool struct {
+	// Name is the name of the pool.
+	Name string
+
+	// Type is the libvirt pool type, for example "dir" or "logical".
+	Type string
+
+	// Capacity is the capacity of the pool in bytes.
+	Capacity uint64
+
+	// Available is the free space in the pool in bytes.
+	Available uint64
+}
+
+// Volume describes a volume in a libvirt storage pool.
+type Volume struct {
+	// Pool is the name of the pool containing the volume.
+	Pool string
+
+	// Name is the name of the volume, unique within its pool.
+	Name string
+
+	// Path is the path of the volume on the libvirt host.
+	Path string
+
+	// Format is the format of the volume, for example "qcow2" or "raw".
+	Format string
+
+	// Capacity is the size of the volume in bytes.
+	Capacity uint64
+}
+
+// VolumeSpec describes a volume to create in a libvirt storage pool.
+type VolumeSpec struct {
+	// Name is the name of the new volume.
+	Name string
+
+	// Format is the format of the new volume, for example "qcow2".
+	Format string
+
+	// Capacity is the size of the new volume in bytes.
+	Capacity uint64
+
+	// BackingVolume, if set, is the name of a volume in the same pool
+	// that the new volume is a copy-on-write overlay of.
+	BackingVolume string
+
+	// BackingFormat is the format of the backing volume.
+	BackingFormat string
+}
+
+// Network describes a libvirt virtual network.
+type Network struct {
+	// Name is the name of the network.
+	Name string
+
+	// Bridge is the name of the host bridge backing the network.
+	Bridge string
+
+	// CIDRs are the subnets served by the network.
+	CIDRs []string
+
+	// Active reports whether the network is running.
+	Active bool
+}
+
+// Connection exposes the libvirt operations used by the provider.
+type Connection interface {
+	// HostName returns the host name of the libvirt host.
+	HostName(ctx context.Context) (string, error)
+
+	// HostArch returns the Juju architecture of the libvirt host.
+	HostArch(ctx context.Context) (string, error)
+
+	// Domains returns the domains whose names have the input prefix.
+	Domains(ctx context.Context, prefix string) ([]Domain, error)
+
+	// CreateDomain defines a persistent domain from the input description
+	// and starts it.
+	CreateDomain(ctx context.Context, domain DomainXML) error
+
+	// RemoveDomain stops and undefines the named domain. Volumes attached
+	// to the domain are not removed.
+	RemoveDomain(ctx context.Context, name string) error
+
+	// SetDomainMetadata replaces the Juju metadata recorded on the named
+	// domain.
+	SetDomainMetadata(ctx context.Context, name string, metadata DomainMetadata) error
+
+	// DomainAddresses returns the IP addresses leased to the network
+	// interfaces of the named domain.
+	DomainAddresses(ctx context.Context, name string) ([]InterfaceAddress, error)
+
+	// AttachDisk attaches the input disk to the named domain, both live
+	// and in its persistent definition.
+	AttachDisk(ctx context.Context, domain string, disk DomainDisk) error
+
+	// DetachDisk detaches the disk with the input target device from the
+	// named domain.
+	DetachDisk(ctx context.Context, domain string, target string) error
+
+	// StoragePools returns the storage pools defined on the host.
+	StoragePools(ctx context.Context) ([]StoragePool, error)
+
+	// Volumes returns the volumes in the named storage pool.
+	Volumes(ctx context.Context, pool string) ([]Volume, error)
+
+	// CreateVolume creates an empty volume in the named storage pool.
+	CreateVolume(ctx context.Context, pool string, spec VolumeSpec) (Volume, error)
+
+	// UploadVolume creates a volume in the named storage pool holding the
+	// content read from the input reader.
+	UploadVolume(ctx context.Context, pool string, spec VolumeSpec, content io.Reader) (Volume, error)
+
+	// DeleteVolume deletes the named volume from the storage pool.
+	DeleteVolume(ctx context.Context, pool, name string) error
+
+	// Networks returns the virtual networks defined on the host.
+	Networks(ctx context.Context) ([]Network, error)
+}
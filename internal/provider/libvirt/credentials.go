@@ -0,0 +1,30 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package libvirt
+
+import (
+	"github.com/juju/juju/cloud"
+	"github.com/juju/juju/environs"
+)
+
+// environProviderCredentials implements environs.ProviderCredentials. Access
+// to libvirt is controlled by the permissions on its socket, or by the
+// transport named in the connection URI (for example SSH keys for
+// qemu+ssh), so no credential attributes are needed.
+type environProviderCredentials struct{}
+
+// CredentialSchemas is part of the environs.ProviderCredentials interface.
+func (environProviderCredentials) CredentialSchemas() map[cloud.AuthType]cloud.CredentialSchema {
+	return map[cloud.AuthType]cloud.CredentialSchema{cloud.EmptyAuthType: {}}
+}
+
+// DetectCredentials is part of the environs.ProviderCredentials interface.
+func (environProviderCredentials) DetectCredentials(cloudName string) (*cloud.CloudCredential, error) {
+	return cloud.NewEmptyCloudCredential(), nil
+}
+
+// FinalizeCredential is part of the environs.ProviderCredentials interface.
+func (environProviderCredentials) FinalizeCredential(_ environs.FinalizeCredentialContext, args environs.FinalizeCredentialParams) (*cloud.Credential, error) {
+	return &args.Credential, nil
+}
@@ -0,0 +1,209 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package libvirt
+
+import (
+	"encoding/xml"
+)
+
+// metadataNamespace is the XML namespace of the Juju metadata element
+// recorded in the definition of each domain created by the provider.
+const metadataNamespace = "https://juju.is/libvirt/1"
+
+// DomainXML is the subset of the libvirt domain XML format used by the
+// provider. See https://libvirt.org/formatdomain.html.
+type DomainXML struct {
+	XMLName  xml.Name               `xml:"domain"`
+	Type     string                 `xml:"type,attr"`
+	Name     string                 `xml:"name"`
+	UUID     string                 `xml:"uuid,omitempty"`
+	Metadata *DomainMetadataSection `xml:"metadata,omitempty"`
+	Memory   DomainMemory           `xml:"memory"`
+	VCPU     uint64                 `xml:"vcpu"`
+	OS       DomainOS               `xml:"os"`
+	Features *DomainFeatures        `xml:"features,omitempty"`
+	CPU      *DomainCPU             `xml:"cpu,omitempty"`
+	Devices  DomainDevices          `xml:"devices"`
+}
+
+// DomainMetadataSection is the metadata element of a domain, which holds
+// application specific metadata keyed by XML namespace.
+type DomainMetadataSection struct {
+	Juju *DomainMetadata `xml:"https://juju.is/libvirt/1 instance,omitempty"`
+}
+
+// JujuMetadata returns the Juju metadata recorded against the domain, or
+// nil if there is none.
+func (d *DomainXML) JujuMetadata() *DomainMetadata {
+	if d.Metadata == nil {
+		return nil
+	}
+	return d.Metadata.Juju
+}
+
+// DomainMetadata holds the Juju metadata recorded against a domain.
+type DomainMetadata struct {
+	XMLName xml.Name    `xml:"https://juju.is/libvirt/1 instance"`
+	Tags    []DomainTag `xml:"https://juju.is/libvirt/1 tag"`
+}
+
+// DomainTag is a single key/value Juju tag.
+type DomainTag struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+// Tag returns the value of the tag with the input key, or the empty string
+// if there is no such tag.
+func (m *DomainMetadata) Tag(key string) string {
+	if m == nil {
+		return ""
+	}
+	for _, tag := range m.Tags {
+		if tag.Key == key {
+			return tag.Value
+		}
+	}
+	return ""
+}
+
+// SetTag sets the value of the tag with the input key.
+func (m *DomainMetadata) SetTag(key, value string) {
+	for i, tag := range m.Tags {
+		if tag.Key == key {
+			m.Tags[i].Value = value
+			return
+		}
+	}
+	m.Tags = append(m.Tags, DomainTag{Key: key, Value: value})
+}
+
+// DomainMemory is the memory allocation of a domain.
+type DomainMemory struct {
+	Unit  string `xml:"unit,attr,omitempty"`
+	Value uint64 `xml:",chardata"`
+}
+
+// MiB returns the memory allocation in MiB.
+func (m DomainMemory) MiB() uint64 {
+	switch m.Unit {
+	case "b", "bytes":
+		return m.Value / (1024 * 1024)
+	case "MiB", "M":
+		return m.Value
+	case "GiB", "G":
+		return m.Value * 1024
+	default:
+		// libvirt defaults to KiB.
+		return m.Value / 1024
+	}
+}
+
+// DomainOS describes how a domain boots.
+type DomainOS struct {
+	Type DomainOSType `xml:"type"`
+}
+
+// DomainOSType is the guest type of a domain.
+type DomainOSType struct {
+	Arch    string `xml:"arch,attr,omitempty"`
+	Machine string `xml:"machine,attr,omitempty"`
+	Value   string `xml:",chardata"`
+}
+
+// DomainFeatures lists the hypervisor features enabled for a domain.
+type DomainFeatures struct {
+	ACPI *struct{} `xml:"acpi,omitempty"`
+	APIC *struct{} `xml:"apic,omitempty"`
+}
+
+// DomainCPU describes the CPU model presented to a domain.
+type DomainCPU struct {
+	Mode string `xml:"mode,attr,omitempty"`
+}
+
+// DomainDevices lists the devices of a domain.
+type DomainDevices struct {
+	Disks      []DomainDisk      `xml:"disk"`
+	Interfaces []DomainInterface `xml:"interface"`
+	Serials    []DomainChardev   `xml:"serial"`
+	Consoles   []DomainChardev   `xml:"console"`
+}
+
+// DomainDisk describes a disk device backed by a storage pool volume.
+type DomainDisk struct {
+	XMLName  xml.Name         `xml:"disk"`
+	Type     string           `xml:"type,attr"`
+	Device   string           `xml:"device,attr"`
+	Driver   DomainDiskDriver `xml:"driver"`
+	Source   DomainDiskSource `xml:"source"`
+	Target   DomainDiskTarget `xml:"target"`
+	ReadOnly *struct{}        `xml:"readonly,omitempty"`
+	Serial   string           `xml:"serial,omitempty"`
+}
+
+// DomainDiskDriver describes the driver of a disk device.
+type DomainDiskDriver struct {
+	Name string `xml:"name,attr"`
+	Type string `xml:"type,attr"`
+}
+
+// DomainDiskSource identifies the storage pool volume backing a disk.
+type DomainDiskSource struct {
+	Pool   string `xml:"pool,attr,omitempty"`
+	Volume string `xml:"volume,attr,omitempty"`
+}
+
+// DomainDiskTarget describes how a disk is presented to the guest.
+type DomainDiskTarget struct {
+	Dev string `xml:"dev,attr"`
+	Bus string `xml:"bus,attr,omitempty"`
+}
+
+// DomainInterface describes a network interface connected to a libvirt
+// virtual network.
+type DomainInterface struct {
+	Type   string                 `xml:"type,attr"`
+	MAC    *DomainInterfaceMAC    `xml:"mac,omitempty"`
+	Source DomainInterfaceSource  `xml:"source"`
+	Model  *DomainInterfaceModel  `xml:"model,omitempty"`
+	Target *DomainInterfaceTarget `xml:"target,omitempty"`
+}
+
+// DomainInterfaceMAC is the hardware address of an interface.
+type DomainInterfaceMAC struct {
+	Address string `xml:"address,attr"`
+}
+
+// DomainInterfaceSource identifies the network an interface is connected to.
+type DomainInterfaceSource struct {
+	Network string `xml:"network,attr,omitempty"`
+	Bridge  string `xml:"bridge,attr,omitempty"`
+}
+
+// DomainInterfaceModel is the device model of an interface.
+type DomainInterfaceModel struct {
+	Type string `xml:"type,attr"`
+}
+
+// DomainInterfaceTarget is the host side device of an interface.
+type DomainInterfaceTarget struct {
+	Dev string `xml:"dev,attr"`
+}
+
+// DomainChardev describes a serial or console character device.
+type DomainChardev struct {
+	Type string `xml:"type,attr"`
+}
+
+// diskByVolume returns the disk of the domain backed by the input pool
+// volume, if any.
+func (d *DomainXML) diskByVolume(pool, volume string) (DomainDisk, bool) {
+	for _, disk := range d.Devices.Disks {
+		if disk.Source.Pool == pool && disk.Source.Volume == volume {
+			return disk, true
+		}
+	}
+	return DomainDisk{}, false
+}
@@ -0,0 +1,197 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package libvirt
+
+import (
+	"context"
+	"sync"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/core/semversion"
+	"github.com/juju/juju/environs"
+	environscloudspec "github.com/juju/juju/environs/cloudspec"
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/environs/tags"
+	"github.com/juju/juju/internal/provider/common"
+)
+
+type environ struct {
+	environs.NoSpaceDiscoveryEnviron
+	environs.NoContainerAddressesEnviron
+	common.CredentialInvalidator
+
+	name     string
+	uuid     string
+	cloud    environscloudspec.CloudSpec
+	provider *environProvider
+
+	// namespace is used to create the machine and device hostnames.
+	namespace instance.Namespace
+
+	// lock protects the *Unlocked fields below.
+	lock           sync.Mutex
+	ecfgUnlocked   *environConfig
+	connUnlocked   Connection
+	imageLocks     map[string]*sync.Mutex
+	imageLocksLock sync.Mutex
+}
+
+var _ environs.Environ = (*environ)(nil)
+
+func newEnviron(
+	ctx context.Context,
+	p *environProvider,
+	spec environscloudspec.CloudSpec,
+	cfg *config.Config,
+	invalidator environs.CredentialInvalidator,
+) (*environ, error) {
+	ecfg, err := newValidConfig(ctx, cfg)
+	if err != nil {
+		return nil, errors.Annotate(err, "invalid config")
+	}
+
+	namespace, err := instance.NewNamespace(cfg.UUID())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	env := &environ{
+		CredentialInvalidator: common.NewCredentialInvalidator(invalidator, IsAuthorisationFailure),
+		name:                  ecfg.Name(),
+		uuid:                  ecfg.UUID(),
+		provider:              p,
+		namespace:             namespace,
+		ecfgUnlocked:          ecfg,
+		imageLocks:            make(map[string]*sync.Mutex),
+	}
+	if err := env.SetCloudSpec(ctx, spec); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return env, nil
+}
+
+// Name returns the name of the environ.
+func (env *environ) Name() string {
+	return env.name
+}
+
+// Provider returns the provider that created this environ.
+func (env *environ) Provider() environs.EnvironProvider {
+	return env.provider
+}
+
+// SetConfig updates the environ's configuration.
+func (env *environ) SetConfig(ctx context.Context, cfg *config.Config) error {
+	ecfg, err := newValidConfig(ctx, cfg)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	env.lock.Lock()
+	defer env.lock.Unlock()
+	env.ecfgUnlocked = ecfg
+	return nil
+}
+
+// SetCloudSpec is specified in the environs.Environ interface.
+func (env *environ) SetCloudSpec(_ context.Context, spec environscloudspec.CloudSpec) error {
+	conn, err := env.provider.connect(spec.Endpoint)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	env.lock.Lock()
+	defer env.lock.Unlock()
+	env.cloud = spec
+	env.connUnlocked = conn
+	return nil
+}
+
+// Config returns the configuration data with which the env was created.
+func (env *environ) Config() *config.Config {
+	return env.ecfg().Config
+}
+
+func (env *environ) ecfg() *environConfig {
+	env.lock.Lock()
+	defer env.lock.Unlock()
+	return env.ecfgUnlocked
+}
+
+func (env *environ) conn() Connection {
+	env.lock.Lock()
+	defer env.lock.Unlock()
+	return env.connUnlocked
+}
+
+// PrepareForBootstrap implements environs.Environ.
+func (env *environ) PrepareForBootstrap(environs.BootstrapContext, string) error {
+	return nil
+}
+
+// Bootstrap is exported, because it has to be rewritten in external unit tests
+var Bootstrap = common.Bootstrap
+
+// Bootstrap implements environs.Environ.
+func (env *environ) Bootstrap(ctx environs.BootstrapContext, args environs.BootstrapParams) (*environs.BootstrapResult, error) {
+	return Bootstrap(ctx, env, args)
+}
+
+// Destroy shuts down all known machines and destroys the rest of the
+// known environment.
+func (env *environ) Destroy(ctx context.Context) error {
+	if err := common.Destroy(env, ctx); err != nil {
+		return errors.Trace(env.HandleCredentialError(ctx, err))
+	}
+	return nil
+}
+
+// DestroyController implements the Environ interface.
+func (env *environ) DestroyController(ctx context.Context, controllerUUID string) error {
+	if err := env.Destroy(ctx); err != nil {
+		return errors.Trace(err)
+	}
+
+	// Destroy all instances of hosted models of the controller.
+	domains, err := env.conn().Domains(ctx, "juju-")
+	if err != nil {
+		return errors.Trace(env.HandleCredentialError(ctx, err))
+	}
+	var ids []instance.Id
+	for _, domain := range domains {
+		md := domain.JujuMetadata()
+		if md.Tag(tags.JujuController) != controllerUUID || md.Tag(tags.JujuModel) == env.uuid {
+			continue
+		}
+		ids = append(ids, instance.Id(domain.Name))
+	}
+	logger.Debugf(ctx, "removing instances: %v", ids)
+	return errors.Trace(env.removeDomains(ctx, ids))
+}
+
+// AdoptResources updates the controller tags on all instances to have the
+// new controller id. It's part of the Environ interface.
+func (env *environ) AdoptResources(ctx context.Context, controllerUUID string, fromVersion semversion.Number) error {
+	domains, err := env.conn().Domains(ctx, env.namespace.Prefix())
+	if err != nil {
+		return errors.Annotate(env.HandleCredentialError(ctx, err), "all instances")
+	}
+
+	var failed []string
+	for _, domain := range domains {
+		md := DomainMetadata{}
+		if existing := domain.JujuMetadata(); existing != nil {
+			md = *existing
+		}
+		md.SetTag(tags.JujuController, controllerUUID)
+		if err := env.conn().SetDomainMetadata(ctx, domain.Name, md); err != nil {
+			logger.Errorf(ctx, "error setting controller uuid tag for %q: %v", domain.Name, err)
+			failed = append(failed, domain.Name)
+		}
+	}
+	if len(failed) != 0 {
+		return errors.Errorf("failed to update controller for some instances: %v", failed)
+	}
+	return nil
+}
@@ -0,0 +1,400 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/arch"
+	"github.com/juju/juju/core/base"
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/core/os/ostype"
+	"github.com/juju/juju/core/status"
+	"github.com/juju/juju/environs"
+	"github.com/juju/juju/environs/tags"
+	"github.com/juju/juju/internal/cloudconfig/cloudinit"
+	"github.com/juju/juju/internal/cloudconfig/instancecfg"
+	"github.com/juju/juju/internal/cloudconfig/nocloud"
+	"github.com/juju/juju/internal/cloudconfig/providerinit"
+	"github.com/juju/juju/internal/provider/common"
+	"github.com/juju/juju/internal/tools"
+)
+
+const (
+	// defaultMemMiB and defaultCPUCores are used for instances without
+	// mem or cores constraints.
+	defaultMemMiB   = 2048
+	defaultCPUCores = 1
+
+	// rootDiskTarget and seedDiskTarget are the guest devices of the root
+	// and cloud-init seed disks. Storage volumes are attached after them.
+	rootDiskTarget = "vda"
+	seedDiskTarget = "vdb"
+
+	mib = 1024 * 1024
+)
+
+// libvirtArches maps Juju architectures to libvirt guest architectures.
+var libvirtArches = map[string]string{
+	arch.AMD64:   "x86_64",
+	arch.ARM64:   "aarch64",
+	arch.PPC64EL: "ppc64le",
+	arch.S390X:   "s390x",
+	arch.RISCV64: "riscv64",
+}
+
+// StartInstance implements environs.InstanceBroker.
+func (env *environ) StartInstance(
+	ctx context.Context, args environs.StartInstanceParams,
+) (*environs.StartInstanceResult, error) {
+	logger.Debugf(ctx, "StartInstance: %q, %s", args.InstanceConfig.MachineId, args.InstanceConfig.Base)
+
+	hostArch, err := env.finishInstanceConfig(ctx, args)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	domain, hwc, err := env.newDomain(ctx, args, hostArch)
+	if err != nil {
+		err = env.HandleCredentialError(ctx, err)
+		if args.StatusCallback != nil {
+			_ = args.StatusCallback(ctx, status.ProvisioningError, err.Error(), nil)
+		}
+		return nil, errors.Trace(err)
+	}
+	logger.Infof(ctx, "started instance %q", domain.Name)
+
+	return &environs.StartInstanceResult{
+		Instance: newInstance(domain, env),
+		Hardware: hwc,
+	}, nil
+}
+
+func (env *environ) finishInstanceConfig(ctx context.Context, args environs.StartInstanceParams) (string, error) {
+	// Instances are virtual machines on the libvirt host, so use the host
+	// architecture to determine the tools to use.
+	hostArch, err := env.conn().HostArch(ctx)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	if args.Constraints.HasArch() && *args.Constraints.Arch != hostArch {
+		return "", environs.ZoneIndependentError(errors.NotValidf(
+			"arch %q on a %q libvirt host", *args.Constraints.Arch, hostArch))
+	}
+	matching, err := args.Tools.Match(tools.Filter{Arch: hostArch})
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	if err := args.InstanceConfig.SetTools(matching); err != nil {
+		return "", errors.Trace(err)
+	}
+	if err := instancecfg.FinishInstanceConfig(args.InstanceConfig, env.Config()); err != nil {
+		return "", errors.Trace(err)
+	}
+	return hostArch, nil
+}
+
+// newDomain creates the volumes of a new instance and then defines and
+// starts its domain. The volumes are removed again if the domain cannot
+// be started.
+func (env *environ) newDomain(
+	ctx context.Context, args environs.StartInstanceParams, hostArch string,
+) (_ Domain, _ *instance.HardwareCharacteristics, err error) {
+	statusCallback := func(msg string) {
+		if args.StatusCallback != nil {
+			_ = args.StatusCallback(ctx, status.Provisioning, msg, nil)
+		}
+	}
+
+	hostname, err := env.namespace.Hostname(args.InstanceConfig.MachineId)
+	if err != nil {
+		return Domain{}, nil, errors.Trace(err)
+	}
+
+	ecfg := env.ecfg()
+	cons := args.Constraints
+	pool := ecfg.storagePool()
+	if cons.HasRootDiskSource() {
+		pool = *cons.RootDiskSource
+	}
+	memMiB := uint64(defaultMemMiB)
+	if cons.HasMem() {
+		memMiB = *cons.Mem
+	}
+	cores := uint64(defaultCPUCores)
+	if cons.HasCpuCores() {
+		cores = *cons.CpuCores
+	}
+	rootDiskMiB := common.MinRootDiskSizeGiB(ostype.OSTypeForName(args.InstanceConfig.Base.OS)) * 1024
+	if cons.RootDisk != nil && *cons.RootDisk > rootDiskMiB {
+		rootDiskMiB = *cons.RootDisk
+	}
+
+	statusCallback("Preparing image")
+	image, err := env.ensureImage(ctx, pool, args.InstanceConfig.Base, hostArch)
+	if err != nil {
+		return Domain{}, nil, errors.Trace(err)
+	}
+
+	conn := env.conn()
+	rootVolume := hostname + "-root"
+	if _, err := conn.CreateVolume(ctx, pool, VolumeSpec{
+		Name:          rootVolume,
+		Format:        "qcow2",
+		Capacity:      rootDiskMiB * mib,
+		BackingVolume: image.Name,
+		BackingFormat: image.Format,
+	}); err != nil {
+		return Domain{}, nil, errors.Annotate(err, "creating root disk")
+	}
+	defer func() {
+		if err != nil {
+			env.deleteVolumes(ctx, pool, rootVolume)
+		}
+	}()
+
+	seed, err := env.seedImage(ctx, hostname, args)
+	if err != nil {
+		return Domain{}, nil, environs.ZoneIndependentError(err)
+	}
+	seedVolume := hostname + "-seed.iso"
+	if _, err := conn.UploadVolume(ctx, pool, VolumeSpec{
+		Name:   seedVolume,
+		Format: "raw",
+	}, strings.NewReader(string(seed))); err != nil {
+		return Domain{}, nil, errors.Annotate(err, "creating cloud-init seed disk")
+	}
+	defer func() {
+		if err != nil {
+			env.deleteVolumes(ctx, pool, seedVolume)
+		}
+	}()
+
+	domain := Domain{
+		DomainXML: env.domainXML(hostname, args, hostArch, memMiB, cores, pool, rootVolume, seedVolume),
+	}
+	statusCallback("Creating instance")
+	if err := conn.CreateDomain(ctx, domain.DomainXML); err != nil {
+		return Domain{}, nil, errors.Annotatef(err, "creating domain %q", hostname)
+	}
+	domain.State = DomainRunning
+
+	return domain, &instance.HardwareCharacteristics{
+		Arch:           &hostArch,
+		Mem:            &memMiB,
+		CpuCores:       &cores,
+		RootDisk:       &rootDiskMiB,
+		RootDiskSource: &pool,
+	}, nil
+}
+
+// domainXML returns the definition of the domain of a new instance.
+func (env *environ) domainXML(
+	hostname string, args environs.StartInstanceParams, hostArch string,
+	memMiB, cores uint64, pool, rootVolume, seedVolume string,
+) DomainXML {
+	md := &DomainMetadata{}
+	for k, v := range args.InstanceConfig.Tags {
+		if !strings.HasPrefix(k, tags.JujuTagPrefix) {
+			// Only Juju-defined tags are recorded against the domain.
+			continue
+		}
+		md.SetTag(k, v)
+	}
+
+	features := &DomainFeatures{ACPI: &struct{}{}}
+	if hostArch == arch.AMD64 {
+		features.APIC = &struct{}{}
+	}
+
+	return DomainXML{
+		Type:     "kvm",
+		Name:     hostname,
+		Metadata: &DomainMetadataSection{Juju: md},
+		Memory:   DomainMemory{Unit: "MiB", Value: memMiB},
+		VCPU:     cores,
+		OS: DomainOS{
+			Type: DomainOSType{Arch: libvirtArches[hostArch], Value: "hvm"},
+		},
+		Features: features,
+		CPU:      &DomainCPU{Mode: "host-passthrough"},
+		Devices: DomainDevices{
+			Disks: []DomainDisk{{
+				Type:   "volume",
+				Device: "disk",
+				Driver: DomainDiskDriver{Name: "qemu", Type: "qcow2"},
+				Source: DomainDiskSource{Pool: pool, Volume: rootVolume},
+				Target: DomainDiskTarget{Dev: rootDiskTarget, Bus: "virtio"},
+			}, {
+				Type:     "volume",
+				Device:   "disk",
+				Driver:   DomainDiskDriver{Name: "qemu", Type: "raw"},
+				Source:   DomainDiskSource{Pool: pool, Volume: seedVolume},
+				Target:   DomainDiskTarget{Dev: seedDiskTarget, Bus: "virtio"},
+				ReadOnly: &struct{}{},
+			}},
+			Interfaces: []DomainInterface{{
+				Type:   "network",
+				Source: DomainInterfaceSource{Network: env.ecfg().network()},
+				Model:  &DomainInterfaceModel{Type: "virtio"},
+			}},
+			Serials:  []DomainChardev{{Type: "pty"}},
+			Consoles: []DomainChardev{{Type: "pty"}},
+		},
+	}
+}
+
+// seedImage returns the cloud-init NoCloud seed image for a new instance.
+func (env *environ) seedImage(ctx context.Context, hostname string, args environs.StartInstanceParams) ([]byte, error) {
+	cloudCfg, err := cloudinit.New(args.InstanceConfig.Base.OS)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	userData, err := providerinit.ComposeUserData(args.InstanceConfig, cloudCfg, libvirtRenderer{})
+	if err != nil {
+		return nil, errors.Annotate(err, "composing user data")
+	}
+	logger.Debugf(ctx, "libvirt user data; %d bytes", len(userData))
+
+	metaData := fmt.Sprintf("instance-id: %s\nlocal-hostname: %s\n", hostname, hostname)
+	seed, err := nocloud.SeedImage(userData, []byte(metaData))
+	return seed, errors.Annotate(err, "creating cloud-init seed image")
+}
+
+// imageVolumeName returns the name of the pool volume holding the cloud
+// image for the input base and architecture. Image volumes are shared by
+// all models on the host.
+func imageVolumeName(b base.Base, arch string) string {
+	return fmt.Sprintf("juju-%s-%s-%s.img", b.OS, b.Channel.Track, arch)
+}
+
+// imageURL returns the URL of the cloud image for the input base and
+// architecture.
+func (env *environ) imageURL(b base.Base, arch string) string {
+	return strings.NewReplacer(
+		"{version}", b.Channel.Track,
+		"{arch}", arch,
+	).Replace(env.ecfg().cloudImageURL())
+}
+
+// imageLock returns the lock serialising downloads of the named image.
+func (env *environ) imageLock(name string) *sync.Mutex {
+	env.imageLocksLock.Lock()
+	defer env.imageLocksLock.Unlock()
+	lock, ok := env.imageLocks[name]
+	if !ok {
+		lock = &sync.Mutex{}
+		env.imageLocks[name] = lock
+	}
+	return lock
+}
+
+// ensureImage returns the pool volume holding the cloud image for the input
+// base and architecture, downloading the image into the pool if it is not
+// already there.
+func (env *environ) ensureImage(ctx context.Context, pool string, b base.Base, arch string) (Volume, error) {
+	if b.OS != base.UbuntuOS {
+		return Volume{}, environs.ZoneIndependentError(errors.NotSupportedf("base %q", b))
+	}
+	name := imageVolumeName(b, arch)
+	lock := env.imageLock(name)
+	lock.Lock()
+	defer lock.Unlock()
+
+	conn := env.conn()
+	volumes, err := conn.Volumes(ctx, pool)
+	if err != nil {
+		return Volume{}, errors.Annotatef(err, "listing volumes in pool %q", pool)
+	}
+	for _, vol := range volumes {
+		if vol.Name == name {
+			return vol, nil
+		}
+	}
+
+	url := env.imageURL(b, arch)
+	logger.Infof(ctx, "downloading cloud image %q into pool %q", url, pool)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return Volume{}, errors.Trace(err)
+	}
+	resp, err := env.provider.httpClient.Do(req)
+	if err != nil {
+		return Volume{}, errors.Annotatef(err, "downloading cloud image %q", url)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return Volume{}, environs.ZoneIndependentError(
+			errors.Errorf("downloading cloud image %q: %s", url, resp.Status))
+	}
+	vol, err := conn.UploadVolume(ctx, pool, VolumeSpec{
+		Name:   name,
+		Format: "qcow2",
+	}, resp.Body)
+	return vol, errors.Annotatef(err, "uploading cloud image %q", url)
+}
+
+// StopInstances implements environs.InstanceBroker.
+func (env *environ) StopInstances(ctx context.Context, ids ...instance.Id) error {
+	prefix := env.namespace.Prefix()
+	var owned []instance.Id
+	for _, id := range ids {
+		if strings.HasPrefix(string(id), prefix) {
+			owned = append(owned, id)
+		} else {
+			logger.Warningf(ctx, "ignoring request to stop instance %q - not in namespace %q", id, prefix)
+		}
+	}
+	return errors.Trace(env.HandleCredentialError(ctx, env.removeDomains(ctx, owned)))
+}
+
+// removeDomains removes the domains of the input instances, along with
+// their root and seed disks. Storage volumes attached to the domains are
+// left to the storage provisioner.
+func (env *environ) removeDomains(ctx context.Context, ids []instance.Id) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	conn := env.conn()
+	domains, err := conn.Domains(ctx, "juju-")
+	if err != nil {
+		return errors.Trace(err)
+	}
+	byName := make(map[string]Domain, len(domains))
+	for _, domain := range domains {
+		byName[domain.Name] = domain
+	}
+
+	for _, id := range ids {
+		domain, ok := byName[string(id)]
+		if !ok {
+			continue
+		}
+		if err := conn.RemoveDomain(ctx, domain.Name); err != nil {
+			return errors.Annotatef(err, "removing domain %q", domain.Name)
+		}
+		for _, disk := range domain.Devices.Disks {
+			if strings.HasPrefix(disk.Source.Volume, domain.Name+"-") {
+				env.deleteVolumes(ctx, disk.Source.Pool, disk.Source.Volume)
+			}
+		}
+	}
+	return nil
+}
+
+// deleteVolumes deletes the input volumes on a best effort basis, logging
+// any failures.
+func (env *environ) deleteVolumes(ctx context.Context, pool string, names ...string) {
+	for _, name := range names {
+		if err := env.conn().DeleteVolume(ctx, pool, name); err != nil {
+			logger.Warningf(ctx, "failed to delete volume %q in pool %q: %v", name, pool, err)
+		}
+	}
+}
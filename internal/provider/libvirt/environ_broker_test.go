@@ -0,0 +1,237 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package libvirt
+
+import (
+	"context"
+	stdtesting "testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/arch"
+	corebase "github.com/juju/juju/core/base"
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/core/semversion"
+	"github.com/juju/juju/core/status"
+	"github.com/juju/juju/environs"
+	"github.com/juju/juju/environs/tags"
+	"github.com/juju/juju/internal/cloudconfig/instancecfg"
+	"github.com/juju/juju/internal/testhelpers"
+	coretesting "github.com/juju/juju/internal/testing"
+	coretools "github.com/juju/juju/internal/tools"
+)
+
+type environBrokerSuite struct {
+	environFixture
+
+	statusCallbackStub testhelpers.Stub
+}
+
+func TestEnvironBrokerSuite(t *stdtesting.T) {
+	tc.Run(t, &environBrokerSuite{})
+}
+
+func (s *environBrokerSuite) SetUpTest(c *tc.C) {
+	s.environFixture.SetUpTest(c)
+	s.statusCallbackStub.ResetCalls()
+}
+
+func (s *environBrokerSuite) createStartInstanceArgs(c *tc.C) environs.StartInstanceParams {
+	var cons constraints.Value
+	instanceConfig, err := instancecfg.NewBootstrapInstanceConfig(
+		coretesting.FakeControllerConfig(), cons, cons, corebase.MakeDefaultBase("ubuntu", "24.04"), "", nil,
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	instanceConfig.AuthorizedKeys = fakeConfig(c).AuthorizedKeys()
+	instanceConfig.Tags = map[string]string{
+		tags.JujuController:   coretesting.ControllerTag.Id(),
+		tags.JujuModel:        fakeModelUUID,
+		tags.JujuIsController: "true",
+		"owner":               "not-recorded",
+	}
+
+	tools := coretools.List{{
+		Version: semversion.Binary{
+			Number:  semversion.MustParse("1.2.3"),
+			Arch:    arch.AMD64,
+			Release: "ubuntu",
+		},
+		URL: "https://example.org",
+	}}
+
+	return environs.StartInstanceParams{
+		ControllerUUID: instanceConfig.ControllerConfig.ControllerUUID(),
+		InstanceConfig: instanceConfig,
+		Tools:          tools,
+		Constraints:    cons,
+		StatusCallback: func(ctx context.Context, status status.Status, info string, data map[string]interface{}) error {
+			s.statusCallbackStub.AddCall("StatusCallback", status, info, data)
+			return s.statusCallbackStub.NextErr()
+		},
+	}
+}
+
+func (s *environBrokerSuite) TestStartInstance(c *tc.C) {
+	result, err := s.env.StartInstance(c.Context(), s.createStartInstanceArgs(c))
+	c.Assert(err, tc.ErrorIsNil)
+
+	hostname := "juju-f75cba-0"
+	c.Check(result.Instance.Id(), tc.Equals, instance.Id(hostname))
+	c.Check(result.Instance.Status(c.Context()).Status, tc.Equals, status.Running)
+
+	arch, mem, cores, rootDisk, pool := "amd64", uint64(2048), uint64(1), uint64(8192), "default"
+	c.Check(result.Hardware, tc.DeepEquals, &instance.HardwareCharacteristics{
+		Arch:           &arch,
+		Mem:            &mem,
+		CpuCores:       &cores,
+		RootDisk:       &rootDisk,
+		RootDiskSource: &pool,
+	})
+
+	// The cloud image is downloaded once into the pool, and instance
+	// disks are created on top of it.
+	c.Check(s.imageRequests, tc.DeepEquals, []string{"/24.04/amd64.img"})
+	c.Check(string(s.conn.uploaded["juju-ubuntu-24.04-amd64.img"]), tc.Equals, "image-content")
+	c.Check(s.conn.volumes["default"][hostname+"-root"].Capacity, tc.Equals, rootDisk*mib)
+	seed := s.conn.uploaded[hostname+"-seed.iso"]
+	c.Check(seed, tc.Not(tc.HasLen), 0)
+
+	domain := s.conn.domains[hostname]
+	c.Check(domain.Type, tc.Equals, "kvm")
+	c.Check(domain.Memory, tc.Equals, DomainMemory{Unit: "MiB", Value: mem})
+	c.Check(domain.VCPU, tc.Equals, cores)
+	c.Check(domain.OS.Type.Arch, tc.Equals, "x86_64")
+	c.Check(domain.Devices.Disks, tc.HasLen, 2)
+	c.Check(domain.Devices.Disks[0].Source, tc.Equals, DomainDiskSource{Pool: "default", Volume: hostname + "-root"})
+	c.Check(domain.Devices.Disks[1].Source, tc.Equals, DomainDiskSource{Pool: "default", Volume: hostname + "-seed.iso"})
+	c.Check(domain.Devices.Interfaces[0].Source.Network, tc.Equals, "default")
+
+	md := domain.JujuMetadata()
+	c.Check(md.Tag(tags.JujuController), tc.Equals, coretesting.ControllerTag.Id())
+	c.Check(md.Tag(tags.JujuModel), tc.Equals, fakeModelUUID)
+	c.Check(md.Tag(tags.JujuIsController), tc.Equals, "true")
+	c.Check(md.Tag("owner"), tc.Equals, "")
+
+	s.statusCallbackStub.CheckCallNames(c, "StatusCallback", "StatusCallback")
+}
+
+func (s *environBrokerSuite) TestStartInstanceReusesImage(c *tc.C) {
+	s.conn.addVolume("default", VolumeSpec{Name: "juju-ubuntu-24.04-amd64.img", Format: "qcow2"})
+
+	_, err := s.env.StartInstance(c.Context(), s.createStartInstanceArgs(c))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.imageRequests, tc.HasLen, 0)
+}
+
+func (s *environBrokerSuite) TestStartInstanceConstraints(c *tc.C) {
+	args := s.createStartInstanceArgs(c)
+	args.Constraints = constraints.MustParse("mem=4G cores=2 root-disk=20G root-disk-source=fast")
+	s.conn.pools = append(s.conn.pools, StoragePool{Name: "fast"})
+
+	result, err := s.env.StartInstance(c.Context(), args)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(*result.Hardware.Mem, tc.Equals, uint64(4096))
+	c.Check(*result.Hardware.CpuCores, tc.Equals, uint64(2))
+	c.Check(*result.Hardware.RootDisk, tc.Equals, uint64(20480))
+	c.Check(*result.Hardware.RootDiskSource, tc.Equals, "fast")
+	c.Check(s.conn.volumes["fast"]["juju-f75cba-0-root"].Capacity, tc.Equals, uint64(20480)*mib)
+}
+
+func (s *environBrokerSuite) TestStartInstanceArchMismatch(c *tc.C) {
+	args := s.createStartInstanceArgs(c)
+	args.Constraints = constraints.MustParse("arch=arm64")
+
+	_, err := s.env.StartInstance(c.Context(), args)
+	c.Assert(err, tc.ErrorMatches, `arch "arm64" on a "amd64" libvirt host not valid`)
+	c.Check(s.conn.domains, tc.HasLen, 0)
+}
+
+func (s *environBrokerSuite) TestStartInstanceCleansUpOnFailure(c *tc.C) {
+	s.conn.SetErrors(
+		nil, // HostArch
+		nil, // Volumes
+		nil, // UploadVolume (image)
+		nil, // CreateVolume (root)
+		nil, // UploadVolume (seed)
+		errors.New("boom"),
+	)
+
+	_, err := s.env.StartInstance(c.Context(), s.createStartInstanceArgs(c))
+	c.Assert(err, tc.ErrorMatches, `creating domain "juju-f75cba-0": boom`)
+
+	// The image volume is kept for other instances, the instance disks
+	// are removed.
+	_, ok := s.conn.volumes["default"]["juju-ubuntu-24.04-amd64.img"]
+	c.Check(ok, tc.IsTrue)
+	c.Check(s.conn.volumes["default"], tc.HasLen, 1)
+	s.statusCallbackStub.CheckCall(c, 2, "StatusCallback", status.ProvisioningError, `creating domain "juju-f75cba-0": boom`, map[string]interface{}(nil))
+}
+
+func (s *environBrokerSuite) TestStopInstances(c *tc.C) {
+	_, err := s.env.StartInstance(c.Context(), s.createStartInstanceArgs(c))
+	c.Assert(err, tc.ErrorIsNil)
+	s.conn.ResetCalls()
+
+	err = s.env.StopInstances(c.Context(), "juju-f75cba-0", "juju-other-0")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.conn.domains, tc.HasLen, 0)
+	c.Check(s.conn.volumes["default"], tc.HasLen, 1)
+	s.conn.CheckCallNames(c, "Domains", "RemoveDomain", "DeleteVolume", "DeleteVolume")
+}
+
+func (s *environBrokerSuite) TestInstances(c *tc.C) {
+	_, err := s.env.StartInstance(c.Context(), s.createStartInstanceArgs(c))
+	c.Assert(err, tc.ErrorIsNil)
+
+	insts, err := s.env.Instances(c.Context(), []instance.Id{"juju-f75cba-0", "juju-f75cba-1"})
+	c.Assert(err, tc.ErrorIs, environs.ErrPartialInstances)
+	c.Assert(insts, tc.HasLen, 2)
+	c.Check(insts[0].Id(), tc.Equals, instance.Id("juju-f75cba-0"))
+	c.Check(insts[1], tc.IsNil)
+
+	_, err = s.env.Instances(c.Context(), []instance.Id{"juju-f75cba-1"})
+	c.Assert(err, tc.ErrorIs, environs.ErrNoInstances)
+}
+
+func (s *environBrokerSuite) TestControllerInstances(c *tc.C) {
+	_, err := s.env.StartInstance(c.Context(), s.createStartInstanceArgs(c))
+	c.Assert(err, tc.ErrorIsNil)
+
+	ids, err := s.env.ControllerInstances(c.Context(), coretesting.ControllerTag.Id())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(ids, tc.DeepEquals, []instance.Id{"juju-f75cba-0"})
+
+	_, err = s.env.ControllerInstances(c.Context(), "other-controller")
+	c.Check(err, tc.ErrorIs, environs.ErrNotBootstrapped)
+}
+
+func (s *environBrokerSuite) TestAdoptResources(c *tc.C) {
+	_, err := s.env.StartInstance(c.Context(), s.createStartInstanceArgs(c))
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = s.env.AdoptResources(c.Context(), "new-controller", semversion.MustParse("1.2.3"))
+	c.Assert(err, tc.ErrorIsNil)
+	domain := s.conn.domains["juju-f75cba-0"]
+	md := domain.JujuMetadata()
+	c.Check(md.Tag(tags.JujuController), tc.Equals, "new-controller")
+	c.Check(md.Tag(tags.JujuModel), tc.Equals, fakeModelUUID)
+}
+
+func (s *environBrokerSuite) TestInstanceAddresses(c *tc.C) {
+	_, err := s.env.StartInstance(c.Context(), s.createStartInstanceArgs(c))
+	c.Assert(err, tc.ErrorIsNil)
+	s.conn.addresses["juju-f75cba-0"] = []InterfaceAddress{
+		{MACAddress: "52:54:00:aa:bb:cc", CIDRAddress: "192.168.122.10/24"},
+	}
+
+	insts, err := s.env.AllInstances(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(insts, tc.HasLen, 1)
+	addrs, err := insts[0].Addresses(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(addrs, tc.HasLen, 1)
+	c.Check(addrs[0].Value, tc.Equals, "192.168.122.10")
+}
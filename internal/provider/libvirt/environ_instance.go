@@ -0,0 +1,123 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package libvirt
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/environs"
+	"github.com/juju/juju/environs/instances"
+	"github.com/juju/juju/environs/tags"
+)
+
+// Instances returns the available instances in the environment that
+// match the provided instance IDs. For IDs that did not match any
+// instances, the result at the corresponding index will be nil. In that
+// case the error will be environs.ErrPartialInstances (or
+// ErrNoInstances if none of the IDs match an instance).
+func (env *environ) Instances(ctx context.Context, ids []instance.Id) ([]instances.Instance, error) {
+	if len(ids) == 0 {
+		return nil, environs.ErrNoInstances
+	}
+
+	all, err := env.allInstances(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	byID := make(map[instance.Id]*environInstance, len(all))
+	for _, inst := range all {
+		byID[inst.Id()] = inst
+	}
+
+	numFound := 0
+	results := make([]instances.Instance, len(ids))
+	for i, id := range ids {
+		if inst, ok := byID[id]; ok {
+			results[i] = inst
+			numFound++
+		}
+	}
+	if numFound == 0 {
+		return nil, environs.ErrNoInstances
+	} else if numFound != len(ids) {
+		return results, environs.ErrPartialInstances
+	}
+	return results, nil
+}
+
+// allInstances returns the instances of the model. Domains are matched on
+// the "juju-<model-UUID>-" name prefix, which isolates multiple models
+// sharing the same libvirt host.
+func (env *environ) allInstances(ctx context.Context) ([]*environInstance, error) {
+	domains, err := env.conn().Domains(ctx, env.namespace.Prefix())
+	if err != nil {
+		return nil, errors.Trace(env.HandleCredentialError(ctx, err))
+	}
+	results := make([]*environInstance, len(domains))
+	for i, domain := range domains {
+		results[i] = newInstance(domain, env)
+	}
+	return results, nil
+}
+
+// AllInstances implements environs.InstanceBroker.
+func (env *environ) AllInstances(ctx context.Context) ([]instances.Instance, error) {
+	all, err := env.allInstances(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	results := make([]instances.Instance, len(all))
+	for i, inst := range all {
+		results[i] = inst
+	}
+	return results, nil
+}
+
+// AllRunningInstances implements environs.InstanceBroker.
+func (env *environ) AllRunningInstances(ctx context.Context) ([]instances.Instance, error) {
+	all, err := env.allInstances(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var results []instances.Instance
+	for _, inst := range all {
+		if inst.domain.State != DomainShutOff {
+			results = append(results, inst)
+		}
+	}
+	return results, nil
+}
+
+// ControllerInstances returns the IDs of the instances corresponding
+// to juju controllers.
+func (env *environ) ControllerInstances(ctx context.Context, controllerUUID string) ([]instance.Id, error) {
+	domains, err := env.conn().Domains(ctx, "juju-")
+	if err != nil {
+		return nil, errors.Trace(env.HandleCredentialError(ctx, err))
+	}
+
+	var results []instance.Id
+	for _, domain := range domains {
+		md := domain.JujuMetadata()
+		if md.Tag(tags.JujuController) != controllerUUID {
+			continue
+		}
+		if md.Tag(tags.JujuIsController) == "true" {
+			results = append(results, instance.Id(domain.Name))
+		}
+	}
+	if len(results) == 0 {
+		return nil, environs.ErrNotBootstrapped
+	}
+	return results, nil
+}
+
+// InstanceTypes implements environs.InstanceTypesFetcher.
+func (env *environ) InstanceTypes(context.Context, constraints.Value) (instances.InstanceTypesWithCostMetadata, error) {
+	return instances.InstanceTypesWithCostMetadata{}, errors.NotSupportedf("InstanceTypes")
+}
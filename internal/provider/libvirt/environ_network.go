@@ -0,0 +1,124 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/environs"
+)
+
+var _ environs.Networking = (*environ)(nil)
+
+// Subnets returns basic information about the subnets of the active
+// libvirt networks.
+func (env *environ) Subnets(ctx context.Context, subnetIDs []network.Id) ([]network.SubnetInfo, error) {
+	networks, err := env.conn().Networks(ctx)
+	if err != nil {
+		return nil, errors.Trace(env.HandleCredentialError(ctx, err))
+	}
+
+	var keep set.Strings
+	if len(subnetIDs) != 0 {
+		keep = set.NewStrings()
+		for _, id := range subnetIDs {
+			keep.Add(string(id))
+		}
+	}
+
+	var subnets []network.SubnetInfo
+	for _, n := range networks {
+		if !n.Active {
+			continue
+		}
+		for _, cidr := range n.CIDRs {
+			id := makeSubnetID(n.Name, cidr)
+			if keep != nil && !keep.Contains(string(id)) {
+				continue
+			}
+			subnets = append(subnets, network.SubnetInfo{
+				ProviderId:        id,
+				ProviderNetworkId: makeNetworkID(n.Name),
+				CIDR:              cidr,
+			})
+		}
+	}
+	return subnets, nil
+}
+
+func makeNetworkID(networkName string) network.Id {
+	return network.Id(fmt.Sprintf("net-%s", networkName))
+}
+
+func makeSubnetID(networkName, cidr string) network.Id {
+	return network.Id(fmt.Sprintf("subnet-%s-%s", networkName, cidr))
+}
+
+// NetworkInterfaces returns a slice with the network interfaces that
+// correspond to the given instance IDs. If no instances where found, but there
+// was no other error, it will return ErrNoInstances. If some but not all of
+// the instances were found, the returned slice will have some nil slots, and
+// an ErrPartialInstances error will be returned.
+func (env *environ) NetworkInterfaces(ctx context.Context, ids []instance.Id) ([]network.InterfaceInfos, error) {
+	insts, err := env.Instances(ctx, ids)
+	if err != nil && !errors.Is(err, environs.ErrPartialInstances) {
+		return nil, err
+	}
+	partialErr := err
+
+	res := make([]network.InterfaceInfos, len(ids))
+	for i, inst := range insts {
+		if inst == nil {
+			continue
+		}
+		domain := inst.(*environInstance).domain
+		leased, err := env.conn().DomainAddresses(ctx, domain.Name)
+		if err != nil {
+			return nil, errors.Annotatef(env.HandleCredentialError(ctx, err),
+				"retrieving network interface info for instance %q", domain.Name)
+		}
+		for devIdx, iface := range domain.Devices.Interfaces {
+			if iface.MAC == nil {
+				continue
+			}
+			ni := network.InterfaceInfo{
+				DeviceIndex:         devIdx,
+				MACAddress:          iface.MAC.Address,
+				ParentInterfaceName: iface.Source.Network,
+				InterfaceType:       network.EthernetDevice,
+				Origin:              network.OriginProvider,
+				ProviderId:          network.Id(fmt.Sprintf("nic-%s", iface.MAC.Address)),
+			}
+			for _, addr := range leased {
+				if !strings.EqualFold(addr.MACAddress, iface.MAC.Address) {
+					continue
+				}
+				ip, ipNet, err := net.ParseCIDR(addr.CIDRAddress)
+				if err != nil {
+					continue
+				}
+				cidr := ipNet.String()
+				ni.Addresses = append(ni.Addresses, network.NewMachineAddress(ip.String(),
+					network.WithCIDR(cidr),
+					network.WithConfigType(network.ConfigDHCP),
+				).AsProviderAddress(network.WithProviderSubnetID(makeSubnetID(iface.Source.Network, cidr))))
+			}
+			res[i] = append(res[i], ni)
+		}
+	}
+	return res, partialErr
+}
+
+// SupportsSpaces is specified on environs.Networking.
+func (env *environ) SupportsSpaces() (bool, error) {
+	return false, nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package libvirt
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/arch"
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/environs"
+)
+
+// PrecheckInstance verifies that the provided series and constraints
+// are valid for use in creating an instance in this environment.
+func (env *environ) PrecheckInstance(ctx context.Context, args environs.PrecheckInstanceParams) error {
+	if args.Placement != "" {
+		return errors.NotValidf("placement directive %q", args.Placement)
+	}
+	if args.Constraints.HasRootDiskSource() {
+		if err := env.checkStoragePool(ctx, *args.Constraints.RootDiskSource); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+// checkStoragePool ensures that the named libvirt storage pool exists.
+func (env *environ) checkStoragePool(ctx context.Context, name string) error {
+	pools, err := env.conn().StoragePools(ctx)
+	if err != nil {
+		return errors.Trace(env.HandleCredentialError(ctx, err))
+	}
+	for _, pool := range pools {
+		if pool.Name == name {
+			return nil
+		}
+	}
+	return errors.NotFoundf("storage pool %q", name)
+}
+
+var unsupportedConstraints = []string{
+	constraints.CpuPower,
+	constraints.Tags,
+	constraints.InstanceType,
+	constraints.InstanceRole,
+	constraints.VirtType,
+	constraints.Zones,
+	constraints.Spaces,
+	constraints.AllocatePublicIP,
+	constraints.ImageID,
+	constraints.AffinityGroup,
+	constraints.AntiAffinity,
+	constraints.Accelerators,
+}
+
+// ConstraintsValidator returns a Validator value which is used to
+// validate and merge constraints.
+func (env *environ) ConstraintsValidator(ctx context.Context) (constraints.Validator, error) {
+	validator := constraints.NewValidator()
+	validator.RegisterUnsupported(unsupportedConstraints)
+
+	// Instances are virtual machines using the host architecture.
+	hostArch, err := env.conn().HostArch(ctx)
+	if err != nil {
+		return nil, errors.Trace(env.HandleCredentialError(ctx, err))
+	}
+	if arch.IsSupportedArch(hostArch) {
+		validator.RegisterVocabulary(constraints.Arch, []string{hostArch})
+	}
+	return validator, nil
+}
+
+// ShouldApplyControllerConstraints returns if bootstrapping logic should use
+// default constraints.
+func (env *environ) ShouldApplyControllerConstraints(constraints.Value) bool {
+	return true
+}
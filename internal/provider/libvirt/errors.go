@@ -0,0 +1,35 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package libvirt
+
+import (
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// IsAuthorisationFailure determines if the given error has an authorisation
+// failure.
+func IsAuthorisationFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := errors.Cause(err).Error()
+	return strings.Contains(msg, "authentication failed") ||
+		strings.Contains(msg, "access denied")
+}
+
+// isNotFound reports whether virsh failed because the domain or volume it
+// was operating on does not exist. virsh does not have typed errors, so
+// the message must be matched.
+func isNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := err.Error()
+	return strings.Contains(msg, "failed to get domain") ||
+		strings.Contains(msg, "Domain not found") ||
+		strings.Contains(msg, "Storage volume not found") ||
+		strings.Contains(msg, "failed to get vol")
+}
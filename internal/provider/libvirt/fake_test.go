@@ -0,0 +1,229 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package libvirt
+
+import (
+	"context"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+// fakeConnection is an in-memory Connection recording the calls made to it.
+type fakeConnection struct {
+	testhelpers.Stub
+
+	mu        sync.Mutex
+	hostArch  string
+	domains   map[string]Domain
+	addresses map[string][]InterfaceAddress
+	pools     []StoragePool
+	volumes   map[string]map[string]Volume
+	uploaded  map[string][]byte
+	networks  []Network
+}
+
+var _ Connection = (*fakeConnection)(nil)
+
+func newFakeConnection() *fakeConnection {
+	return &fakeConnection{
+		hostArch:  "amd64",
+		domains:   make(map[string]Domain),
+		addresses: make(map[string][]InterfaceAddress),
+		pools:     []StoragePool{{Name: "default", Type: "dir"}},
+		volumes:   map[string]map[string]Volume{"default": {}},
+		uploaded:  make(map[string][]byte),
+	}
+}
+
+func (c *fakeConnection) HostName(ctx context.Context) (string, error) {
+	c.AddCall("HostName")
+	return "libvirt-host", c.NextErr()
+}
+
+func (c *fakeConnection) HostArch(ctx context.Context) (string, error) {
+	c.AddCall("HostArch")
+	return c.hostArch, c.NextErr()
+}
+
+func (c *fakeConnection) Domains(ctx context.Context, prefix string) ([]Domain, error) {
+	c.AddCall("Domains", prefix)
+	if err := c.NextErr(); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var result []Domain
+	for name, domain := range c.domains {
+		if strings.HasPrefix(name, prefix) {
+			result = append(result, domain)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result, nil
+}
+
+func (c *fakeConnection) CreateDomain(ctx context.Context, domain DomainXML) error {
+	c.AddCall("CreateDomain", domain)
+	if err := c.NextErr(); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.domains[domain.Name] = Domain{DomainXML: domain, State: DomainRunning}
+	return nil
+}
+
+func (c *fakeConnection) RemoveDomain(ctx context.Context, name string) error {
+	c.AddCall("RemoveDomain", name)
+	if err := c.NextErr(); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.domains, name)
+	return nil
+}
+
+func (c *fakeConnection) SetDomainMetadata(ctx context.Context, name string, metadata DomainMetadata) error {
+	c.AddCall("SetDomainMetadata", name, metadata)
+	if err := c.NextErr(); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	domain, ok := c.domains[name]
+	if !ok {
+		return errors.NotFoundf("domain %q", name)
+	}
+	domain.Metadata = &DomainMetadataSection{Juju: &metadata}
+	c.domains[name] = domain
+	return nil
+}
+
+func (c *fakeConnection) DomainAddresses(ctx context.Context, name string) ([]InterfaceAddress, error) {
+	c.AddCall("DomainAddresses", name)
+	return c.addresses[name], c.NextErr()
+}
+
+func (c *fakeConnection) AttachDisk(ctx context.Context, name string, disk DomainDisk) error {
+	c.AddCall("AttachDisk", name, disk)
+	if err := c.NextErr(); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	domain, ok := c.domains[name]
+	if !ok {
+		return errors.NotFoundf("domain %q", name)
+	}
+	domain.Devices.Disks = append(domain.Devices.Disks, disk)
+	c.domains[name] = domain
+	return nil
+}
+
+func (c *fakeConnection) DetachDisk(ctx context.Context, name string, target string) error {
+	c.AddCall("DetachDisk", name, target)
+	if err := c.NextErr(); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	domain, ok := c.domains[name]
+	if !ok {
+		return errors.NotFoundf("domain %q", name)
+	}
+	var disks []DomainDisk
+	for _, disk := range domain.Devices.Disks {
+		if disk.Target.Dev != target {
+			disks = append(disks, disk)
+		}
+	}
+	domain.Devices.Disks = disks
+	c.domains[name] = domain
+	return nil
+}
+
+func (c *fakeConnection) StoragePools(ctx context.Context) ([]StoragePool, error) {
+	c.AddCall("StoragePools")
+	return c.pools, c.NextErr()
+}
+
+func (c *fakeConnection) Volumes(ctx context.Context, pool string) ([]Volume, error) {
+	c.AddCall("Volumes", pool)
+	if err := c.NextErr(); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var result []Volume
+	for _, vol := range c.volumes[pool] {
+		result = append(result, vol)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result, nil
+}
+
+func (c *fakeConnection) addVolume(pool string, spec VolumeSpec) Volume {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	vol := Volume{
+		Pool:     pool,
+		Name:     spec.Name,
+		Path:     "/var/lib/libvirt/images/" + spec.Name,
+		Format:   spec.Format,
+		Capacity: spec.Capacity,
+	}
+	if c.volumes[pool] == nil {
+		c.volumes[pool] = make(map[string]Volume)
+	}
+	c.volumes[pool][spec.Name] = vol
+	return vol
+}
+
+func (c *fakeConnection) CreateVolume(ctx context.Context, pool string, spec VolumeSpec) (Volume, error) {
+	c.AddCall("CreateVolume", pool, spec)
+	if err := c.NextErr(); err != nil {
+		return Volume{}, err
+	}
+	return c.addVolume(pool, spec), nil
+}
+
+func (c *fakeConnection) UploadVolume(ctx context.Context, pool string, spec VolumeSpec, content io.Reader) (Volume, error) {
+	c.AddCall("UploadVolume", pool, spec)
+	if err := c.NextErr(); err != nil {
+		return Volume{}, err
+	}
+	data, err := io.ReadAll(content)
+	if err != nil {
+		return Volume{}, err
+	}
+	spec.Capacity = uint64(len(data))
+	vol := c.addVolume(pool, spec)
+	c.mu.Lock()
+	c.uploaded[spec.Name] = data
+	c.mu.Unlock()
+	return vol, nil
+}
+
+func (c *fakeConnection) DeleteVolume(ctx context.Context, pool, name string) error {
+	c.AddCall("DeleteVolume", pool, name)
+	if err := c.NextErr(); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.volumes[pool], name)
+	return nil
+}
+
+func (c *fakeConnection) Networks(ctx context.Context) ([]Network, error) {
+	c.AddCall("Networks")
+	return c.networks, c.NextErr()
+}
@@ -0,0 +1,90 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package libvirt
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/cloud"
+	"github.com/juju/juju/environs"
+	environscloudspec "github.com/juju/juju/environs/cloudspec"
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/internal/testhelpers"
+	coretesting "github.com/juju/juju/internal/testing"
+)
+
+const fakeModelUUID = "2d02eeac-9dbb-11e4-89d3-123b93f75cba"
+
+func fakeConfig(c *tc.C, attrs ...coretesting.Attrs) *config.Config {
+	merged := coretesting.FakeConfig().Merge(coretesting.Attrs{
+		"type": "libvirt",
+		"uuid": fakeModelUUID,
+	})
+	for _, attrs := range attrs {
+		merged = merged.Merge(attrs)
+	}
+	cfg, err := coretesting.ModelConfig(c).Apply(merged)
+	c.Assert(err, tc.ErrorIsNil)
+	return cfg
+}
+
+func fakeCloudSpec() environscloudspec.CloudSpec {
+	cred := cloud.NewEmptyCredential()
+	return environscloudspec.CloudSpec{
+		Type:       "libvirt",
+		Name:       "libvirt",
+		Region:     defaultRegionName,
+		Endpoint:   "qemu+ssh://ubuntu@host1/system",
+		Credential: &cred,
+	}
+}
+
+// environFixture opens a libvirt environ backed by a fake connection, with
+// cloud images served by a local HTTP server.
+type environFixture struct {
+	testhelpers.IsolationSuite
+
+	conn          *fakeConnection
+	connectedURIs []string
+	provider      environs.CloudEnvironProvider
+	env           *environ
+
+	imageServer   *httptest.Server
+	imageRequests []string
+	imageMu       sync.Mutex
+}
+
+func (s *environFixture) SetUpTest(c *tc.C) {
+	s.IsolationSuite.SetUpTest(c)
+
+	s.imageRequests = nil
+	s.imageServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.imageMu.Lock()
+		s.imageRequests = append(s.imageRequests, r.URL.Path)
+		s.imageMu.Unlock()
+		_, _ = w.Write([]byte("image-content"))
+	}))
+	s.AddCleanup(func(*tc.C) { s.imageServer.Close() })
+
+	s.conn = newFakeConnection()
+	s.connectedURIs = nil
+	s.provider = NewProvider(func(uri string) (Connection, error) {
+		s.connectedURIs = append(s.connectedURIs, uri)
+		return s.conn, nil
+	})
+
+	env, err := s.provider.Open(c.Context(), environs.OpenParams{
+		Cloud: fakeCloudSpec(),
+		Config: fakeConfig(c, coretesting.Attrs{
+			"cloud-image-url": s.imageServer.URL + "/{version}/{arch}.img",
+		}),
+	}, environs.NoopCredentialInvalidator())
+	c.Assert(err, tc.ErrorIsNil)
+	s.env = env.(*environ)
+	s.conn.ResetCalls()
+}
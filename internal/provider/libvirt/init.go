@@ -0,0 +1,21 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package libvirt
+
+import (
+	"github.com/juju/juju/environs"
+	storageprovider "github.com/juju/juju/internal/storage/provider"
+)
+
+const (
+	// providerType is the unique identifier that the libvirt provider gets
+	// registered with.
+	providerType = "libvirt"
+)
+
+func init() {
+	environs.RegisterProvider(providerType, NewProvider(func(uri string) (Connection, error) {
+		return NewVirshConnection(uri, storageprovider.LogAndExec), nil
+	}))
+}
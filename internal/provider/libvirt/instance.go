@@ -0,0 +1,66 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package libvirt
+
+import (
+	"context"
+	"strings"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/core/status"
+	"github.com/juju/juju/environs/instances"
+)
+
+type environInstance struct {
+	domain Domain
+	env    *environ
+}
+
+var _ instances.Instance = (*environInstance)(nil)
+
+func newInstance(domain Domain, env *environ) *environInstance {
+	return &environInstance{
+		domain: domain,
+		env:    env,
+	}
+}
+
+// Id implements instances.Instance.
+func (i *environInstance) Id() instance.Id {
+	return instance.Id(i.domain.Name)
+}
+
+// Status implements instances.Instance.
+func (i *environInstance) Status(ctx context.Context) instance.Status {
+	var jujuStatus status.Status
+	switch i.domain.State {
+	case DomainRunning, DomainIdle:
+		jujuStatus = status.Running
+	case DomainCrashed:
+		jujuStatus = status.ProvisioningError
+	default:
+		jujuStatus = status.Empty
+	}
+	return instance.Status{
+		Status:  jujuStatus,
+		Message: string(i.domain.State),
+	}
+}
+
+// Addresses implements instances.Instance.
+func (i *environInstance) Addresses(ctx context.Context) (network.ProviderAddresses, error) {
+	leased, err := i.env.conn().DomainAddresses(ctx, i.domain.Name)
+	if err != nil {
+		return nil, errors.Trace(i.env.HandleCredentialError(ctx, err))
+	}
+	addrs := make(network.ProviderAddresses, 0, len(leased))
+	for _, addr := range leased {
+		value, _, _ := strings.Cut(addr.CIDRAddress, "/")
+		addrs = append(addrs, network.NewMachineAddress(value).AsProviderAddress())
+	}
+	return addrs, nil
+}
@@ -0,0 +1,234 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package libvirt
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"os"
+
+	"github.com/juju/errors"
+	"github.com/juju/jsonschema"
+	"github.com/juju/schema"
+
+	"github.com/juju/juju/cloud"
+	corelogger "github.com/juju/juju/core/logger"
+	"github.com/juju/juju/environs"
+	environscloudspec "github.com/juju/juju/environs/cloudspec"
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/internal/configschema"
+	jujuhttp "github.com/juju/juju/internal/http"
+	internallogger "github.com/juju/juju/internal/logger"
+)
+
+var logger = internallogger.GetLogger("juju.provider.libvirt")
+
+const (
+	currentProviderVersion = 0
+
+	// defaultCloudName is the name of the cloud detected when the local
+	// host runs a libvirt daemon.
+	defaultCloudName = "libvirt"
+
+	// defaultRegionName is the name of the single region of a libvirt
+	// cloud.
+	defaultRegionName = "default"
+)
+
+// localSocketPath is the path of the socket of the local system libvirt
+// daemon, used to detect whether a local libvirt cloud is available.
+var localSocketPath = "/var/run/libvirt/libvirt-sock"
+
+// ConnectionFunc returns a Connection to the libvirt daemon at the input URI.
+type ConnectionFunc func(uri string) (Connection, error)
+
+type environProvider struct {
+	environProviderCredentials
+	newConnection ConnectionFunc
+	httpClient    *http.Client
+}
+
+var (
+	_ config.ConfigSchemaSource     = (*environProvider)(nil)
+	_ environs.CloudDetector        = (*environProvider)(nil)
+	_ environs.CloudEnvironProvider = (*environProvider)(nil)
+)
+
+// NewProvider returns a new libvirt EnvironProvider that connects to libvirt
+// with the input function.
+func NewProvider(newConnection ConnectionFunc) environs.CloudEnvironProvider {
+	return &environProvider{
+		newConnection: newConnection,
+		httpClient: jujuhttp.NewClient(
+			jujuhttp.WithLogger(logger.Child("http", corelogger.HTTP)),
+		).Client(),
+	}
+}
+
+var cloudSchema = &jsonschema.Schema{
+	Type:     []jsonschema.Type{jsonschema.ObjectType},
+	Required: []string{cloud.AuthTypesKey},
+	Order:    []string{cloud.EndpointKey, cloud.AuthTypesKey, cloud.RegionsKey},
+	Properties: map[string]*jsonschema.Schema{
+		cloud.EndpointKey: {
+			Singular: "the libvirt connection URI (for example qemu+ssh://user@host/system)",
+			Type:     []jsonschema.Type{jsonschema.StringType},
+			Default:  DefaultURI,
+		},
+		cloud.AuthTypesKey: {
+			// don't need a prompt, since there's only one choice.
+			Type: []jsonschema.Type{jsonschema.ArrayType},
+			Enum: []interface{}{[]string{string(cloud.EmptyAuthType)}},
+		},
+		cloud.RegionsKey: {
+			Type:     []jsonschema.Type{jsonschema.ObjectType},
+			Singular: "region",
+			Plural:   "regions",
+			Default:  defaultRegionName,
+			AdditionalProperties: &jsonschema.Schema{
+				Type:          []jsonschema.Type{jsonschema.ObjectType},
+				MaxProperties: jsonschema.Int(0),
+			},
+		},
+	},
+}
+
+// Version is part of the EnvironProvider interface.
+func (*environProvider) Version() int {
+	return currentProviderVersion
+}
+
+// Open implements environs.EnvironProvider.
+func (p *environProvider) Open(ctx context.Context, args environs.OpenParams, invalidator environs.CredentialInvalidator) (environs.Environ, error) {
+	if err := validateCloudSpec(args.Cloud); err != nil {
+		return nil, errors.Annotate(err, "validating cloud spec")
+	}
+	env, err := newEnviron(ctx, p, args.Cloud, args.Config, invalidator)
+	return env, errors.Trace(err)
+}
+
+// CloudSchema returns the schema used to validate input for add-cloud.
+func (p *environProvider) CloudSchema() *jsonschema.Schema {
+	return cloudSchema
+}
+
+// Ping tests the connection to the cloud, to verify the endpoint is valid.
+func (p *environProvider) Ping(ctx context.Context, endpoint string) error {
+	conn, err := p.connect(endpoint)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if _, err := conn.HostName(ctx); err != nil {
+		return errors.Annotatef(err, "no libvirt daemon available at %s", uriOrDefault(endpoint))
+	}
+	return nil
+}
+
+func (p *environProvider) connect(endpoint string) (Connection, error) {
+	conn, err := p.newConnection(uriOrDefault(endpoint))
+	return conn, errors.Annotate(err, "connecting to libvirt")
+}
+
+// ValidateCloud is specified in the EnvironProvider interface.
+func (*environProvider) ValidateCloud(ctx context.Context, spec environscloudspec.CloudSpec) error {
+	return errors.Annotate(validateCloudSpec(spec), "validating cloud spec")
+}
+
+// Validate implements environs.EnvironProvider.
+func (*environProvider) Validate(ctx context.Context, cfg, old *config.Config) (valid *config.Config, err error) {
+	ecfg, err := newValidConfig(ctx, cfg)
+	if err != nil {
+		return nil, errors.Annotate(err, "invalid config")
+	}
+	return ecfg.Config, nil
+}
+
+// DetectClouds implements environs.CloudDetector. A cloud is reported if
+// the local host runs a system libvirt daemon.
+func (p *environProvider) DetectClouds() ([]cloud.Cloud, error) {
+	if _, err := os.Stat(localSocketPath); err != nil {
+		return nil, nil
+	}
+	return []cloud.Cloud{localCloud}, nil
+}
+
+// DetectCloud implements environs.CloudDetector.
+func (p *environProvider) DetectCloud(name string) (cloud.Cloud, error) {
+	clouds, err := p.DetectClouds()
+	if err != nil {
+		return cloud.Cloud{}, errors.Trace(err)
+	}
+	for _, c := range clouds {
+		if c.Name == name {
+			return c, nil
+		}
+	}
+	return cloud.Cloud{}, errors.NotFoundf("cloud %s", name)
+}
+
+// localCloud is the cloud backed by the system libvirt daemon on the local
+// host.
+var localCloud = cloud.Cloud{
+	Name:        defaultCloudName,
+	Type:        providerType,
+	AuthTypes:   []cloud.AuthType{cloud.EmptyAuthType},
+	Endpoint:    DefaultURI,
+	Regions:     []cloud.Region{{Name: defaultRegionName, Endpoint: DefaultURI}},
+	Description: "Local libvirt/QEMU host",
+}
+
+// Schema returns the configuration schema for an environment.
+func (*environProvider) Schema() configschema.Fields {
+	fields, err := config.Schema(configSchema)
+	if err != nil {
+		panic(err)
+	}
+	return fields
+}
+
+// ConfigSchema returns extra config attributes specific
+// to this provider only.
+func (*environProvider) ConfigSchema() schema.Fields {
+	return configFields
+}
+
+// ConfigDefaults returns the default values for the
+// provider specific config attributes.
+func (*environProvider) ConfigDefaults() schema.Defaults {
+	return configDefaults
+}
+
+// ModelConfigDefaults provides a set of default model config attributes that
+// should be set on a models config if they have not been specified by the user.
+func (*environProvider) ModelConfigDefaults(_ context.Context) (map[string]any, error) {
+	return map[string]any{
+		config.StorageDefaultBlockSourceKey: libvirtStorageProviderType,
+	}, nil
+}
+
+func validateCloudSpec(spec environscloudspec.CloudSpec) error {
+	if err := spec.Validate(); err != nil {
+		return errors.Trace(err)
+	}
+	if spec.Credential == nil {
+		return errors.NotValidf("missing credential")
+	}
+	if authType := spec.Credential.AuthType(); authType != cloud.EmptyAuthType {
+		return errors.NotSupportedf("%q auth-type", authType)
+	}
+	if spec.Endpoint != "" {
+		if _, err := url.Parse(spec.Endpoint); err != nil {
+			return errors.NotValidf("endpoint %q", spec.Endpoint)
+		}
+	}
+	return nil
+}
+
+func uriOrDefault(endpoint string) string {
+	if endpoint == "" {
+		return DefaultURI
+	}
+	return endpoint
+}
@@ -0,0 +1,140 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package libvirt
+
+import (
+	"os"
+	"path/filepath"
+	stdtesting "testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/cloud"
+	"github.com/juju/juju/environs"
+	"github.com/juju/juju/environs/config"
+	coretesting "github.com/juju/juju/internal/testing"
+)
+
+type providerSuite struct {
+	environFixture
+}
+
+func TestProviderSuite(t *stdtesting.T) {
+	tc.Run(t, &providerSuite{})
+}
+
+func (s *providerSuite) TestOpenConnectsToEndpoint(c *tc.C) {
+	c.Check(s.connectedURIs, tc.DeepEquals, []string{"qemu+ssh://ubuntu@host1/system"})
+	c.Check(s.env.Name(), tc.Equals, "testmodel")
+}
+
+func (s *providerSuite) TestOpenDefaultEndpoint(c *tc.C) {
+	spec := fakeCloudSpec()
+	spec.Endpoint = ""
+	s.connectedURIs = nil
+	_, err := s.provider.Open(c.Context(), environs.OpenParams{
+		Cloud:  spec,
+		Config: fakeConfig(c),
+	}, environs.NoopCredentialInvalidator())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.connectedURIs, tc.DeepEquals, []string{DefaultURI})
+}
+
+func (s *providerSuite) TestOpenUnsupportedAuthType(c *tc.C) {
+	spec := fakeCloudSpec()
+	cred := cloud.NewCredential(cloud.UserPassAuthType, map[string]string{
+		"username": "user", "password": "secret",
+	})
+	spec.Credential = &cred
+	_, err := s.provider.Open(c.Context(), environs.OpenParams{
+		Cloud:  spec,
+		Config: fakeConfig(c),
+	}, environs.NoopCredentialInvalidator())
+	c.Assert(err, tc.ErrorMatches, `validating cloud spec: "userpass" auth-type not supported`)
+}
+
+func (s *providerSuite) TestValidateDefaults(c *tc.C) {
+	cfg, err := s.provider.Validate(c.Context(), fakeConfig(c), nil)
+	c.Assert(err, tc.ErrorIsNil)
+	attrs := cfg.UnknownAttrs()
+	c.Check(attrs[cfgStoragePool], tc.Equals, "default")
+	c.Check(attrs[cfgNetwork], tc.Equals, "default")
+	c.Check(attrs[cfgCloudImageURL], tc.Equals, defaultCloudImageURL)
+}
+
+func (s *providerSuite) TestValidateInvalid(c *tc.C) {
+	for i, test := range []struct {
+		attrs coretesting.Attrs
+		err   string
+	}{{
+		attrs: coretesting.Attrs{cfgStoragePool: ""},
+		err:   `invalid config: empty libvirt-pool not valid`,
+	}, {
+		attrs: coretesting.Attrs{cfgNetwork: ""},
+		err:   `invalid config: empty libvirt-network not valid`,
+	}, {
+		attrs: coretesting.Attrs{cfgCloudImageURL: "https://example.com/image.img"},
+		err:   `invalid config: cloud-image-url .* not valid`,
+	}} {
+		c.Logf("test %d", i)
+		_, err := s.provider.Validate(c.Context(), fakeConfig(c, test.attrs), nil)
+		c.Check(err, tc.ErrorMatches, test.err)
+	}
+}
+
+func (s *providerSuite) TestPing(c *tc.C) {
+	err := s.provider.(environs.CloudEnvironProvider).Ping(c.Context(), "qemu:///session")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.connectedURIs[len(s.connectedURIs)-1], tc.Equals, "qemu:///session")
+	s.conn.CheckCallNames(c, "HostName")
+}
+
+func (s *providerSuite) TestPingFails(c *tc.C) {
+	s.conn.SetErrors(errors.New("failed to connect to the hypervisor"))
+	err := s.provider.Ping(c.Context(), "")
+	c.Assert(err, tc.ErrorMatches, "no libvirt daemon available at qemu:///system: failed to connect to the hypervisor")
+}
+
+func (s *providerSuite) TestDetectClouds(c *tc.C) {
+	socket := filepath.Join(c.MkDir(), "libvirt-sock")
+	s.PatchValue(&localSocketPath, socket)
+
+	detector := s.provider.(environs.CloudDetector)
+	clouds, err := detector.DetectClouds()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(clouds, tc.HasLen, 0)
+
+	err = os.WriteFile(socket, nil, 0600)
+	c.Assert(err, tc.ErrorIsNil)
+	clouds, err = detector.DetectClouds()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(clouds, tc.DeepEquals, []cloud.Cloud{localCloud})
+
+	detected, err := detector.DetectCloud("libvirt")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(detected, tc.DeepEquals, localCloud)
+
+	_, err = detector.DetectCloud("other")
+	c.Check(err, tc.ErrorIs, errors.NotFound)
+}
+
+func (s *providerSuite) TestCredentialSchemas(c *tc.C) {
+	schemas := s.provider.CredentialSchemas()
+	c.Check(schemas, tc.HasLen, 1)
+	_, ok := schemas[cloud.EmptyAuthType]
+	c.Check(ok, tc.IsTrue)
+
+	creds, err := s.provider.DetectCredentials("")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(creds.AuthCredentials["default"].AuthType(), tc.Equals, cloud.EmptyAuthType)
+}
+
+func (s *providerSuite) TestModelConfigDefaults(c *tc.C) {
+	defaults, err := s.provider.(environs.ModelConfigProvider).ModelConfigDefaults(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(defaults, tc.DeepEquals, map[string]any{
+		config.StorageDefaultBlockSourceKey: libvirtStorageProviderType,
+	})
+}
@@ -0,0 +1,22 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package libvirt
+
+import (
+	"testing"
+
+	"github.com/juju/tc"
+
+	domaincloud "github.com/juju/juju/domain/cloud"
+)
+
+// TestLibvirtProviderTypeEqualsDomainCloudValue checks that the unique provider
+// type value that the libvirt provider gets registered with is equal to that of
+// [domaincloud.CloudTypeLibvirt].
+//
+// This is important test to make sure that enum values are kept in sync across
+// Juju.
+func TestLibvirtProviderTypeEqualsDomainCloudValue(t *testing.T) {
+	tc.Assert(t, providerType, tc.Equals, domaincloud.CloudTypeLibvirt.String())
+}
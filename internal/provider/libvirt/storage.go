@@ -0,0 +1,386 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+	"github.com/juju/schema"
+
+	"github.com/juju/juju/internal/provider/common"
+	"github.com/juju/juju/internal/storage"
+)
+
+const (
+	libvirtStorageProviderType storage.ProviderType = "libvirt"
+
+	// attrLibvirtPool is the attribute name for the storage pool's
+	// corresponding libvirt storage pool. If this is not provided, the
+	// pool named by the model's libvirt-pool config is used.
+	attrLibvirtPool = "libvirt-pool"
+
+	// maxDiskSerialLen is the longest serial number a virtio disk can have.
+	maxDiskSerialLen = 20
+)
+
+// StorageProviderTypes implements storage.ProviderRegistry.
+func (env *environ) StorageProviderTypes() ([]storage.ProviderType, error) {
+	return append(common.CommonIAASStorageProviderTypes(), libvirtStorageProviderType), nil
+}
+
+// StorageProvider implements storage.ProviderRegistry.
+func (env *environ) StorageProvider(t storage.ProviderType) (storage.Provider, error) {
+	if t == libvirtStorageProviderType {
+		return &libvirtStorageProvider{env: env}, nil
+	}
+	return common.GetCommonIAASStorageProvider(t)
+}
+
+// libvirtStorageProvider is a storage provider for volumes in libvirt
+// storage pools, attached to instances as virtio disks.
+type libvirtStorageProvider struct {
+	env *environ
+}
+
+var _ storage.Provider = (*libvirtStorageProvider)(nil)
+
+var libvirtStorageConfigChecker = schema.FieldMap(
+	schema.Fields{
+		attrLibvirtPool: schema.String(),
+	},
+	schema.Defaults{
+		attrLibvirtPool: schema.Omit,
+	},
+)
+
+func (p *libvirtStorageProvider) poolName(attrs map[string]interface{}) (string, error) {
+	coerced, err := libvirtStorageConfigChecker.Coerce(attrs, nil)
+	if err != nil {
+		return "", errors.Annotate(err, "validating libvirt storage config")
+	}
+	if pool, _ := coerced.(map[string]interface{})[attrLibvirtPool].(string); pool != "" {
+		return pool, nil
+	}
+	return p.env.ecfg().storagePool(), nil
+}
+
+// ValidateConfig is part of the Provider interface.
+func (p *libvirtStorageProvider) ValidateConfig(cfg *storage.Config) error {
+	_, err := p.poolName(cfg.Attrs())
+	return errors.Trace(err)
+}
+
+// ValidateForK8s is part of the Provider interface.
+func (p *libvirtStorageProvider) ValidateForK8s(map[string]any) error {
+	return errors.NotValidf("storage provider type %q", libvirtStorageProviderType)
+}
+
+// Supports is part of the Provider interface.
+func (p *libvirtStorageProvider) Supports(k storage.StorageKind) bool {
+	return k == storage.StorageKindBlock
+}
+
+// Scope is part of the Provider interface.
+func (p *libvirtStorageProvider) Scope() storage.Scope {
+	return storage.ScopeEnviron
+}
+
+// Dynamic is part of the Provider interface.
+func (p *libvirtStorageProvider) Dynamic() bool {
+	return true
+}
+
+// Releasable is part of the Provider interface.
+func (p *libvirtStorageProvider) Releasable() bool {
+	return false
+}
+
+// DefaultPools is part of the Provider interface.
+func (p *libvirtStorageProvider) DefaultPools() []*storage.Config {
+	pool, _ := storage.NewConfig(
+		libvirtStorageProviderType.String(), libvirtStorageProviderType, storage.Attrs{},
+	)
+	return []*storage.Config{pool}
+}
+
+// VolumeSource is part of the Provider interface.
+func (p *libvirtStorageProvider) VolumeSource(cfg *storage.Config) (storage.VolumeSource, error) {
+	pool, err := p.poolName(cfg.Attrs())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &libvirtVolumeSource{env: p.env, pool: pool}, nil
+}
+
+// FilesystemSource is part of the Provider interface.
+func (p *libvirtStorageProvider) FilesystemSource(*storage.Config) (storage.FilesystemSource, error) {
+	return nil, errors.NotSupportedf("filesystems")
+}
+
+// libvirtVolumeSource creates volumes in a single libvirt storage pool.
+type libvirtVolumeSource struct {
+	env  *environ
+	pool string
+}
+
+var _ storage.VolumeSource = (*libvirtVolumeSource)(nil)
+
+// volumeID returns the provider ID of a volume. Volume IDs include the
+// pool, so that volumes remain addressable if the storage pool config
+// changes.
+func volumeID(pool, name string) string {
+	return pool + "/" + name
+}
+
+func parseVolumeID(id string) (pool, name string, err error) {
+	pool, name, ok := strings.Cut(id, "/")
+	if !ok || pool == "" || name == "" {
+		return "", "", errors.NotValidf("libvirt volume ID %q", id)
+	}
+	return pool, name, nil
+}
+
+// volumePrefix returns the name prefix of the model's storage volumes.
+func (s *libvirtVolumeSource) volumePrefix() string {
+	return s.env.namespace.Value("volume-")
+}
+
+// ValidateVolumeParams is part of the VolumeSource interface.
+func (s *libvirtVolumeSource) ValidateVolumeParams(params storage.VolumeParams) error {
+	return nil
+}
+
+// CreateVolumes is part of the VolumeSource interface.
+func (s *libvirtVolumeSource) CreateVolumes(ctx context.Context, params []storage.VolumeParams) ([]storage.CreateVolumesResult, error) {
+	results := make([]storage.CreateVolumesResult, len(params))
+	for i, p := range params {
+		name := s.env.namespace.Value(p.Tag.String())
+		vol, err := s.env.conn().CreateVolume(ctx, s.pool, VolumeSpec{
+			Name:     name,
+			Format:   "qcow2",
+			Capacity: p.Size * mib,
+		})
+		if err != nil {
+			results[i].Error = errors.Annotatef(s.env.HandleCredentialError(ctx, err), "creating volume %q", name)
+			continue
+		}
+		results[i].Volume = &storage.Volume{
+			Tag: p.Tag,
+			VolumeInfo: storage.VolumeInfo{
+				VolumeId:   volumeID(s.pool, vol.Name),
+				Size:       vol.Capacity / mib,
+				Persistent: true,
+			},
+		}
+	}
+	return results, nil
+}
+
+// ListVolumes is part of the VolumeSource interface.
+func (s *libvirtVolumeSource) ListVolumes(ctx context.Context) ([]string, error) {
+	volumes, err := s.env.conn().Volumes(ctx, s.pool)
+	if err != nil {
+		return nil, errors.Trace(s.env.HandleCredentialError(ctx, err))
+	}
+	var ids []string
+	for _, vol := range volumes {
+		if strings.HasPrefix(vol.Name, s.volumePrefix()) {
+			ids = append(ids, volumeID(s.pool, vol.Name))
+		}
+	}
+	return ids, nil
+}
+
+// DescribeVolumes is part of the VolumeSource interface.
+func (s *libvirtVolumeSource) DescribeVolumes(ctx context.Context, volIds []string) ([]storage.DescribeVolumesResult, error) {
+	volumes := make(map[string]map[string]Volume)
+	results := make([]storage.DescribeVolumesResult, len(volIds))
+	for i, id := range volIds {
+		pool, name, err := parseVolumeID(id)
+		if err != nil {
+			results[i].Error = err
+			continue
+		}
+		if _, ok := volumes[pool]; !ok {
+			poolVolumes, err := s.env.conn().Volumes(ctx, pool)
+			if err != nil {
+				return nil, errors.Trace(s.env.HandleCredentialError(ctx, err))
+			}
+			volumes[pool] = make(map[string]Volume)
+			for _, vol := range poolVolumes {
+				volumes[pool][vol.Name] = vol
+			}
+		}
+		vol, ok := volumes[pool][name]
+		if !ok {
+			results[i].Error = errors.NotFoundf("volume %q", id)
+			continue
+		}
+		results[i].VolumeInfo = &storage.VolumeInfo{
+			VolumeId:   id,
+			Size:       vol.Capacity / mib,
+			Persistent: true,
+		}
+	}
+	return results, nil
+}
+
+// DestroyVolumes is part of the VolumeSource interface.
+func (s *libvirtVolumeSource) DestroyVolumes(ctx context.Context, volIds []string) ([]error, error) {
+	results := make([]error, len(volIds))
+	for i, id := range volIds {
+		pool, name, err := parseVolumeID(id)
+		if err != nil {
+			results[i] = err
+			continue
+		}
+		if err := s.env.conn().DeleteVolume(ctx, pool, name); err != nil {
+			results[i] = errors.Annotatef(s.env.HandleCredentialError(ctx, err), "destroying volume %q", id)
+		}
+	}
+	return results, nil
+}
+
+// ReleaseVolumes is part of the VolumeSource interface.
+func (s *libvirtVolumeSource) ReleaseVolumes(ctx context.Context, volIds []string) ([]error, error) {
+	return nil, errors.NotSupportedf("releasing libvirt volumes")
+}
+
+// AttachVolumes is part of the VolumeSource interface.
+func (s *libvirtVolumeSource) AttachVolumes(ctx context.Context, params []storage.VolumeAttachmentParams) ([]storage.AttachVolumesResult, error) {
+	domains, err := s.domains(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	results := make([]storage.AttachVolumesResult, len(params))
+	for i, p := range params {
+		domain, ok := domains[string(p.InstanceId)]
+		if !ok {
+			results[i].Error = errors.NotFoundf("instance %q", p.InstanceId)
+			continue
+		}
+		info, err := s.attachVolume(ctx, &domain, p)
+		if err != nil {
+			results[i].Error = errors.Annotatef(s.env.HandleCredentialError(ctx, err),
+				"attaching volume %q to instance %q", p.VolumeId, p.InstanceId)
+			continue
+		}
+		domains[string(p.InstanceId)] = domain
+		results[i].VolumeAttachment = &storage.VolumeAttachment{
+			Volume:               p.Volume,
+			Machine:              p.Machine,
+			VolumeAttachmentInfo: info,
+		}
+	}
+	return results, nil
+}
+
+func (s *libvirtVolumeSource) attachVolume(
+	ctx context.Context, domain *Domain, p storage.VolumeAttachmentParams,
+) (storage.VolumeAttachmentInfo, error) {
+	pool, name, err := parseVolumeID(p.VolumeId)
+	if err != nil {
+		return storage.VolumeAttachmentInfo{}, errors.Trace(err)
+	}
+	// Attachment must be idempotent.
+	if disk, ok := domain.diskByVolume(pool, name); ok {
+		return diskAttachmentInfo(disk), nil
+	}
+
+	target, err := nextDiskTarget(domain.DomainXML)
+	if err != nil {
+		return storage.VolumeAttachmentInfo{}, errors.Trace(err)
+	}
+	serial := p.Volume.String()
+	if len(serial) > maxDiskSerialLen {
+		serial = serial[len(serial)-maxDiskSerialLen:]
+	}
+	disk := DomainDisk{
+		Type:   "volume",
+		Device: "disk",
+		Driver: DomainDiskDriver{Name: "qemu", Type: "qcow2"},
+		Source: DomainDiskSource{Pool: pool, Volume: name},
+		Target: DomainDiskTarget{Dev: target, Bus: "virtio"},
+		Serial: serial,
+	}
+	if p.ReadOnly {
+		disk.ReadOnly = &struct{}{}
+	}
+	if err := s.env.conn().AttachDisk(ctx, domain.Name, disk); err != nil {
+		return storage.VolumeAttachmentInfo{}, errors.Trace(err)
+	}
+	domain.Devices.Disks = append(domain.Devices.Disks, disk)
+	return diskAttachmentInfo(disk), nil
+}
+
+// diskAttachmentInfo returns the attachment info of a storage volume disk.
+// Virtio disk names depend on probe order, so the disk is identified by
+// the link udev creates from its serial number.
+func diskAttachmentInfo(disk DomainDisk) storage.VolumeAttachmentInfo {
+	return storage.VolumeAttachmentInfo{
+		DeviceLink: "/dev/disk/by-id/virtio-" + disk.Serial,
+		ReadOnly:   disk.ReadOnly != nil,
+	}
+}
+
+// nextDiskTarget returns the first unused virtio disk device of the domain.
+func nextDiskTarget(domain DomainXML) (string, error) {
+	used := set.NewStrings()
+	for _, disk := range domain.Devices.Disks {
+		used.Add(disk.Target.Dev)
+	}
+	for c := 'a'; c <= 'z'; c++ {
+		if target := fmt.Sprintf("vd%c", c); !used.Contains(target) {
+			return target, nil
+		}
+	}
+	return "", errors.Errorf("no free disk devices on domain %q", domain.Name)
+}
+
+// DetachVolumes is part of the VolumeSource interface.
+func (s *libvirtVolumeSource) DetachVolumes(ctx context.Context, params []storage.VolumeAttachmentParams) ([]error, error) {
+	domains, err := s.domains(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	results := make([]error, len(params))
+	for i, p := range params {
+		domain, ok := domains[string(p.InstanceId)]
+		if !ok {
+			// The instance is gone, so the volume is detached.
+			continue
+		}
+		pool, name, err := parseVolumeID(p.VolumeId)
+		if err != nil {
+			results[i] = err
+			continue
+		}
+		disk, ok := domain.diskByVolume(pool, name)
+		if !ok {
+			continue
+		}
+		if err := s.env.conn().DetachDisk(ctx, domain.Name, disk.Target.Dev); err != nil {
+			results[i] = errors.Annotatef(s.env.HandleCredentialError(ctx, err),
+				"detaching volume %q from instance %q", p.VolumeId, p.InstanceId)
+		}
+	}
+	return results, nil
+}
+
+// domains returns the domains of the model keyed by name.
+func (s *libvirtVolumeSource) domains(ctx context.Context) (map[string]Domain, error) {
+	domains, err := s.env.conn().Domains(ctx, s.env.namespace.Prefix())
+	if err != nil {
+		return nil, errors.Trace(s.env.HandleCredentialError(ctx, err))
+	}
+	byName := make(map[string]Domain, len(domains))
+	for _, domain := range domains {
+		byName[domain.Name] = domain
+	}
+	return byName, nil
+}
@@ -0,0 +1,168 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package libvirt
+
+import (
+	stdtesting "testing"
+
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/internal/storage"
+)
+
+type storageSuite struct {
+	environFixture
+
+	source storage.VolumeSource
+}
+
+func TestStorageSuite(t *stdtesting.T) {
+	tc.Run(t, &storageSuite{})
+}
+
+func (s *storageSuite) SetUpTest(c *tc.C) {
+	s.environFixture.SetUpTest(c)
+
+	p, err := s.env.StorageProvider(libvirtStorageProviderType)
+	c.Assert(err, tc.ErrorIsNil)
+	cfg, err := storage.NewConfig("libvirt", libvirtStorageProviderType, nil)
+	c.Assert(err, tc.ErrorIsNil)
+	s.source, err = p.VolumeSource(cfg)
+	c.Assert(err, tc.ErrorIsNil)
+
+	s.conn.domains["juju-f75cba-0"] = Domain{
+		DomainXML: DomainXML{
+			Name: "juju-f75cba-0",
+			Devices: DomainDevices{Disks: []DomainDisk{
+				{Target: DomainDiskTarget{Dev: rootDiskTarget}},
+				{Target: DomainDiskTarget{Dev: seedDiskTarget}},
+			}},
+		},
+		State: DomainRunning,
+	}
+}
+
+func (s *storageSuite) TestProvider(c *tc.C) {
+	types, err := s.env.StorageProviderTypes()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(types[len(types)-1], tc.Equals, libvirtStorageProviderType)
+
+	p, err := s.env.StorageProvider(libvirtStorageProviderType)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(p.Supports(storage.StorageKindBlock), tc.IsTrue)
+	c.Check(p.Supports(storage.StorageKindFilesystem), tc.IsFalse)
+	c.Check(p.Scope(), tc.Equals, storage.ScopeEnviron)
+	c.Check(p.Dynamic(), tc.IsTrue)
+
+	cfg, err := storage.NewConfig("bad", libvirtStorageProviderType, storage.Attrs{"libvirt-pool": 42})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(p.ValidateConfig(cfg), tc.ErrorMatches, "validating libvirt storage config: .*")
+}
+
+func (s *storageSuite) TestCreateListDescribeVolumes(c *tc.C) {
+	results, err := s.source.CreateVolumes(c.Context(), []storage.VolumeParams{{
+		Tag:  names.NewVolumeTag("0"),
+		Size: 1024,
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 1)
+	c.Assert(results[0].Error, tc.ErrorIsNil)
+	c.Check(results[0].Volume, tc.DeepEquals, &storage.Volume{
+		Tag: names.NewVolumeTag("0"),
+		VolumeInfo: storage.VolumeInfo{
+			VolumeId:   "default/juju-f75cba-volume-0",
+			Size:       1024,
+			Persistent: true,
+		},
+	})
+
+	// Volumes not created by the model are not listed.
+	s.conn.addVolume("default", VolumeSpec{Name: "juju-ubuntu-24.04-amd64.img"})
+	ids, err := s.source.ListVolumes(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(ids, tc.DeepEquals, []string{"default/juju-f75cba-volume-0"})
+
+	described, err := s.source.DescribeVolumes(c.Context(), []string{"default/juju-f75cba-volume-0", "default/missing"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(described, tc.HasLen, 2)
+	c.Check(described[0].VolumeInfo.Size, tc.Equals, uint64(1024))
+	c.Check(described[1].Error, tc.ErrorMatches, `volume "default/missing" not found`)
+}
+
+func (s *storageSuite) TestDestroyVolumes(c *tc.C) {
+	s.conn.addVolume("default", VolumeSpec{Name: "juju-f75cba-volume-0"})
+
+	results, err := s.source.DestroyVolumes(c.Context(), []string{"default/juju-f75cba-volume-0", "invalid"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(results[0], tc.ErrorIsNil)
+	c.Check(results[1], tc.ErrorMatches, `libvirt volume ID "invalid" not valid`)
+	c.Check(s.conn.volumes["default"], tc.HasLen, 0)
+}
+
+func (s *storageSuite) attachParams(volume, volumeId string) storage.VolumeAttachmentParams {
+	return storage.VolumeAttachmentParams{
+		AttachmentParams: storage.AttachmentParams{
+			Machine:    names.NewMachineTag("0"),
+			InstanceId: instance.Id("juju-f75cba-0"),
+		},
+		Volume:   names.NewVolumeTag(volume),
+		VolumeId: volumeId,
+	}
+}
+
+func (s *storageSuite) TestAttachVolumes(c *tc.C) {
+	params := []storage.VolumeAttachmentParams{
+		s.attachParams("0", "default/juju-f75cba-volume-0"),
+		s.attachParams("1", "default/juju-f75cba-volume-1"),
+	}
+	results, err := s.source.AttachVolumes(c.Context(), params)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 2)
+	c.Assert(results[0].Error, tc.ErrorIsNil)
+	c.Check(results[0].VolumeAttachment.DeviceLink, tc.Equals, "/dev/disk/by-id/virtio-volume-0")
+	c.Assert(results[1].Error, tc.ErrorIsNil)
+	c.Check(results[1].VolumeAttachment.DeviceLink, tc.Equals, "/dev/disk/by-id/virtio-volume-1")
+
+	disks := s.conn.domains["juju-f75cba-0"].Devices.Disks
+	c.Assert(disks, tc.HasLen, 4)
+	c.Check(disks[2].Target.Dev, tc.Equals, "vdc")
+	c.Check(disks[2].Source, tc.Equals, DomainDiskSource{Pool: "default", Volume: "juju-f75cba-volume-0"})
+	c.Check(disks[3].Target.Dev, tc.Equals, "vdd")
+
+	// Attaching again is a no-op.
+	s.conn.ResetCalls()
+	results, err = s.source.AttachVolumes(c.Context(), params[:1])
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(results[0].Error, tc.ErrorIsNil)
+	s.conn.CheckCallNames(c, "Domains")
+}
+
+func (s *storageSuite) TestAttachVolumesInstanceNotFound(c *tc.C) {
+	p := s.attachParams("0", "default/juju-f75cba-volume-0")
+	p.InstanceId = "juju-f75cba-9"
+	results, err := s.source.AttachVolumes(c.Context(), []storage.VolumeAttachmentParams{p})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(results[0].Error, tc.ErrorMatches, `instance "juju-f75cba-9" not found`)
+}
+
+func (s *storageSuite) TestDetachVolumes(c *tc.C) {
+	params := []storage.VolumeAttachmentParams{s.attachParams("0", "default/juju-f75cba-volume-0")}
+	_, err := s.source.AttachVolumes(c.Context(), params)
+	c.Assert(err, tc.ErrorIsNil)
+	s.conn.ResetCalls()
+
+	results, err := s.source.DetachVolumes(c.Context(), params)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(results, tc.DeepEquals, []error{nil})
+	s.conn.CheckCall(c, 1, "DetachDisk", "juju-f75cba-0", "vdc")
+
+	// Detaching an already detached volume is a no-op.
+	s.conn.ResetCalls()
+	results, err = s.source.DetachVolumes(c.Context(), params)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(results, tc.DeepEquals, []error{nil})
+	s.conn.CheckCallNames(c, "Domains")
+}
@@ -0,0 +1,23 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package libvirt
+
+import (
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/os/ostype"
+	"github.com/juju/juju/internal/cloudconfig/cloudinit"
+	"github.com/juju/juju/internal/cloudconfig/providerinit/renderers"
+)
+
+type libvirtRenderer struct{}
+
+// Render implements renderers.ProviderRenderer.
+func (libvirtRenderer) Render(cfg cloudinit.CloudConfig, os ostype.OSType) ([]byte, error) {
+	if os != ostype.Ubuntu {
+		return nil, errors.Errorf("cannot encode userdata for OS: %s", os)
+	}
+	bytes, err := renderers.RenderYAML(cfg)
+	return bytes, errors.Trace(err)
+}
@@ -0,0 +1,434 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package libvirt
+
+import (
+	"bufio"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/arch"
+	storageprovider "github.com/juju/juju/internal/storage/provider"
+)
+
+// DefaultURI is the URI of the local system libvirt daemon.
+const DefaultURI = "qemu:///system"
+
+// virshConnection is a Connection that drives libvirt with the virsh
+// command line client, which talks to the libvirt daemon over its socket.
+type virshConnection struct {
+	uri string
+	run storageprovider.RunCommandFunc
+}
+
+// NewVirshConnection returns a Connection to the libvirt daemon at the
+// input URI, running virsh with the input command runner.
+func NewVirshConnection(uri string, run storageprovider.RunCommandFunc) Connection {
+	if uri == "" {
+		uri = DefaultURI
+	}
+	return &virshConnection{uri: uri, run: run}
+}
+
+func (c *virshConnection) virsh(ctx context.Context, args ...string) (string, error) {
+	out, err := c.run(ctx, "virsh", append([]string{"-c", c.uri}, args...)...)
+	if err != nil {
+		return "", errors.Annotatef(err, "virsh %s", args[0])
+	}
+	return out, nil
+}
+
+// virshXML runs virsh with the input arguments and decodes its output
+// into v.
+func (c *virshConnection) virshXML(ctx context.Context, v any, args ...string) error {
+	out, err := c.virsh(ctx, args...)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Annotatef(xml.Unmarshal([]byte(out), v), "decoding virsh %s output", args[0])
+}
+
+// withTempFile writes content to a temporary file and calls f with its path.
+func withTempFile(content io.Reader, f func(path string, size int64) error) error {
+	dir, err := os.MkdirTemp("", "juju-libvirt")
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	path := filepath.Join(dir, "content")
+	file, err := os.Create(path)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	size, err := io.Copy(file, content)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return f(path, size)
+}
+
+func writeXMLFile(v any, f func(path string) error) error {
+	data, err := xml.Marshal(v)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return withTempFile(strings.NewReader(string(data)), func(path string, _ int64) error {
+		return f(path)
+	})
+}
+
+// HostName is part of the Connection interface.
+func (c *virshConnection) HostName(ctx context.Context) (string, error) {
+	out, err := c.virsh(ctx, "hostname")
+	return strings.TrimSpace(out), errors.Trace(err)
+}
+
+// HostArch is part of the Connection interface.
+func (c *virshConnection) HostArch(ctx context.Context) (string, error) {
+	var caps struct {
+		Arch string `xml:"host>cpu>arch"`
+	}
+	if err := c.virshXML(ctx, &caps, "capabilities"); err != nil {
+		return "", errors.Trace(err)
+	}
+	return arch.NormaliseArch(caps.Arch), nil
+}
+
+// names runs a virsh list command printing one name per line, and returns
+// the non-empty names.
+func (c *virshConnection) names(ctx context.Context, args ...string) ([]string, error) {
+	out, err := c.virsh(ctx, args...)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var names []string
+	for _, line := range strings.Split(out, "\n") {
+		if name := strings.TrimSpace(line); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// Domains is part of the Connection interface.
+func (c *virshConnection) Domains(ctx context.Context, prefix string) ([]Domain, error) {
+	names, err := c.names(ctx, "list", "--all", "--name")
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var domains []Domain
+	for _, name := range names {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		var domain Domain
+		if err := c.virshXML(ctx, &domain.DomainXML, "dumpxml", name, "--inactive"); err != nil {
+			return nil, errors.Trace(err)
+		}
+		state, err := c.virsh(ctx, "domstate", name)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		domain.State = DomainState(strings.TrimSpace(state))
+		domains = append(domains, domain)
+	}
+	return domains, nil
+}
+
+// CreateDomain is part of the Connection interface.
+func (c *virshConnection) CreateDomain(ctx context.Context, domain DomainXML) error {
+	err := writeXMLFile(domain, func(path string) error {
+		_, err := c.virsh(ctx, "define", path)
+		return err
+	})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if _, err := c.virsh(ctx, "start", domain.Name); err != nil {
+		return errors.Trace(err)
+	}
+	return nil
+}
+
+// RemoveDomain is part of the Connection interface.
+func (c *virshConnection) RemoveDomain(ctx context.Context, name string) error {
+	state, err := c.virsh(ctx, "domstate", name)
+	if isNotFound(err) {
+		return nil
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	if DomainState(strings.TrimSpace(state)) != DomainShutOff {
+		if _, err := c.virsh(ctx, "destroy", name); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	if _, err := c.virsh(ctx, "undefine", name, "--nvram"); err != nil && !isNotFound(err) {
+		return errors.Trace(err)
+	}
+	return nil
+}
+
+// SetDomainMetadata is part of the Connection interface.
+func (c *virshConnection) SetDomainMetadata(ctx context.Context, name string, metadata DomainMetadata) error {
+	data, err := xml.Marshal(metadata)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	_, err = c.virsh(ctx, "metadata", name, metadataNamespace,
+		"--key", "juju", "--set", string(data), "--config")
+	return errors.Trace(err)
+}
+
+// DomainAddresses is part of the Connection interface.
+func (c *virshConnection) DomainAddresses(ctx context.Context, name string) ([]InterfaceAddress, error) {
+	out, err := c.virsh(ctx, "domifaddr", name, "--source", "lease")
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return parseDomIfAddr(out), nil
+}
+
+// parseDomIfAddr parses the table printed by "virsh domifaddr":
+//
+//	Name       MAC address          Protocol     Address
+//	-------------------------------------------------------
+//	vnet0      52:54:00:2e:1f:7a    ipv4         192.168.122.10/24
+//	-          -                    ipv6         fd00::10/64
+func parseDomIfAddr(out string) []InterfaceAddress {
+	var (
+		addrs   []InterfaceAddress
+		mac     string
+		inTable bool
+	)
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "---") {
+			inTable = true
+			continue
+		}
+		fields := strings.Fields(line)
+		if !inTable || len(fields) != 4 {
+			continue
+		}
+		if fields[1] != "-" {
+			mac = fields[1]
+		}
+		addrs = append(addrs, InterfaceAddress{
+			MACAddress:  mac,
+			CIDRAddress: fields[3],
+		})
+	}
+	return addrs
+}
+
+// AttachDisk is part of the Connection interface.
+func (c *virshConnection) AttachDisk(ctx context.Context, domain string, disk DomainDisk) error {
+	return errors.Trace(writeXMLFile(disk, func(path string) error {
+		_, err := c.virsh(ctx, "attach-device", domain, path, "--persistent")
+		return err
+	}))
+}
+
+// DetachDisk is part of the Connection interface.
+func (c *virshConnection) DetachDisk(ctx context.Context, domain string, target string) error {
+	_, err := c.virsh(ctx, "detach-disk", domain, target, "--persistent")
+	return errors.Trace(err)
+}
+
+// StoragePools is part of the Connection interface.
+func (c *virshConnection) StoragePools(ctx context.Context) ([]StoragePool, error) {
+	names, err := c.names(ctx, "pool-list", "--all", "--name")
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	pools := make([]StoragePool, 0, len(names))
+	for _, name := range names {
+		var pool struct {
+			Type      string `xml:"type,attr"`
+			Name      string `xml:"name"`
+			Capacity  uint64 `xml:"capacity"`
+			Available uint64 `xml:"available"`
+		}
+		if err := c.virshXML(ctx, &pool, "pool-dumpxml", name); err != nil {
+			return nil, errors.Trace(err)
+		}
+		pools = append(pools, StoragePool{
+			Name:      pool.Name,
+			Type:      pool.Type,
+			Capacity:  pool.Capacity,
+			Available: pool.Available,
+		})
+	}
+	return pools, nil
+}
+
+// Volumes is part of the Connection interface.
+func (c *virshConnection) Volumes(ctx context.Context, pool string) ([]Volume, error) {
+	out, err := c.virsh(ctx, "vol-list", "--pool", pool)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var volumes []Volume
+	inTable := false
+	for _, line := range strings.Split(out, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "---") {
+			inTable = true
+			continue
+		}
+		fields := strings.Fields(line)
+		if !inTable || len(fields) == 0 {
+			continue
+		}
+		volume, err := c.volume(ctx, pool, fields[0])
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		volumes = append(volumes, volume)
+	}
+	return volumes, nil
+}
+
+func (c *virshConnection) volume(ctx context.Context, pool, name string) (Volume, error) {
+	var vol struct {
+		Name     string `xml:"name"`
+		Capacity uint64 `xml:"capacity"`
+		Path     string `xml:"target>path"`
+		Format   struct {
+			Type string `xml:"type,attr"`
+		} `xml:"target>format"`
+	}
+	if err := c.virshXML(ctx, &vol, "vol-dumpxml", "--pool", pool, name); err != nil {
+		return Volume{}, errors.Trace(err)
+	}
+	return Volume{
+		Pool:     pool,
+		Name:     vol.Name,
+		Path:     vol.Path,
+		Format:   vol.Format.Type,
+		Capacity: vol.Capacity,
+	}, nil
+}
+
+func (c *virshConnection) createVolume(ctx context.Context, pool string, spec VolumeSpec) error {
+	args := []string{
+		"vol-create-as", pool, spec.Name, strconv.FormatUint(spec.Capacity, 10) + "b",
+	}
+	if spec.Format != "" {
+		args = append(args, "--format", spec.Format)
+	}
+	if spec.BackingVolume != "" {
+		args = append(args, "--backing-vol", spec.BackingVolume)
+		if spec.BackingFormat != "" {
+			args = append(args, "--backing-vol-format", spec.BackingFormat)
+		}
+	}
+	_, err := c.virsh(ctx, args...)
+	return errors.Trace(err)
+}
+
+// CreateVolume is part of the Connection interface.
+func (c *virshConnection) CreateVolume(ctx context.Context, pool string, spec VolumeSpec) (Volume, error) {
+	if err := c.createVolume(ctx, pool, spec); err != nil {
+		return Volume{}, errors.Trace(err)
+	}
+	return c.volume(ctx, pool, spec.Name)
+}
+
+// UploadVolume is part of the Connection interface.
+func (c *virshConnection) UploadVolume(ctx context.Context, pool string, spec VolumeSpec, content io.Reader) (Volume, error) {
+	err := withTempFile(content, func(path string, size int64) error {
+		if spec.Capacity < uint64(size) {
+			spec.Capacity = uint64(size)
+		}
+		if err := c.createVolume(ctx, pool, spec); err != nil {
+			return errors.Trace(err)
+		}
+		if _, err := c.virsh(ctx, "vol-upload", "--pool", pool, spec.Name, path); err != nil {
+			_, _ = c.virsh(ctx, "vol-delete", "--pool", pool, spec.Name)
+			return errors.Trace(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return Volume{}, errors.Trace(err)
+	}
+	return c.volume(ctx, pool, spec.Name)
+}
+
+// DeleteVolume is part of the Connection interface.
+func (c *virshConnection) DeleteVolume(ctx context.Context, pool, name string) error {
+	_, err := c.virsh(ctx, "vol-delete", "--pool", pool, name)
+	if isNotFound(err) {
+		return nil
+	}
+	return errors.Trace(err)
+}
+
+// Networks is part of the Connection interface.
+func (c *virshConnection) Networks(ctx context.Context) ([]Network, error) {
+	names, err := c.names(ctx, "net-list", "--all", "--name")
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	active, err := c.names(ctx, "net-list", "--name")
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	activeNames := set.NewStrings(active...)
+
+	networks := make([]Network, 0, len(names))
+	for _, name := range names {
+		var netXML struct {
+			Name   string `xml:"name"`
+			Bridge struct {
+				Name string `xml:"name,attr"`
+			} `xml:"bridge"`
+			IPs []struct {
+				Family  string `xml:"family,attr"`
+				Address string `xml:"address,attr"`
+				Netmask string `xml:"netmask,attr"`
+				Prefix  string `xml:"prefix,attr"`
+			} `xml:"ip"`
+		}
+		if err := c.virshXML(ctx, &netXML, "net-dumpxml", name); err != nil {
+			return nil, errors.Trace(err)
+		}
+		network := Network{
+			Name:   netXML.Name,
+			Bridge: netXML.Bridge.Name,
+			Active: activeNames.Contains(name),
+		}
+		for _, ip := range netXML.IPs {
+			prefix := ip.Prefix
+			if prefix == "" && ip.Netmask != "" {
+				ones, _ := net.IPMask(net.ParseIP(ip.Netmask).To4()).Size()
+				prefix = strconv.Itoa(ones)
+			}
+			_, ipNet, err := net.ParseCIDR(fmt.Sprintf("%s/%s", ip.Address, prefix))
+			if err != nil {
+				return nil, errors.Annotatef(err, "parsing address of network %q", name)
+			}
+			network.CIDRs = append(network.CIDRs, ipNet.String())
+		}
+		networks = append(networks, network)
+	}
+	return networks, nil
+}
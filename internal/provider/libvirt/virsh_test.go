@@ -0,0 +1,202 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	stdtesting "testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type virshSuite struct {
+	testhelpers.IsolationSuite
+
+	commands []string
+	outputs  map[string]string
+	errs     map[string]error
+	conn     Connection
+}
+
+func TestVirshSuite(t *stdtesting.T) {
+	tc.Run(t, &virshSuite{})
+}
+
+func (s *virshSuite) SetUpTest(c *tc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.commands = nil
+	s.outputs = make(map[string]string)
+	s.errs = make(map[string]error)
+	s.conn = NewVirshConnection("qemu+ssh://host/system", func(ctx context.Context, cmd string, args ...string) (string, error) {
+		c.Assert(cmd, tc.Equals, "virsh")
+		c.Assert(args[:2], tc.DeepEquals, []string{"-c", "qemu+ssh://host/system"})
+		command := strings.Join(args[2:], " ")
+		s.commands = append(s.commands, command)
+		return s.outputs[command], s.errs[command]
+	})
+}
+
+func (s *virshSuite) TestHostArch(c *tc.C) {
+	s.outputs["capabilities"] = `<capabilities><host><cpu><arch>aarch64</arch></cpu></host></capabilities>`
+	hostArch, err := s.conn.HostArch(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(hostArch, tc.Equals, "arm64")
+}
+
+func (s *virshSuite) TestDomains(c *tc.C) {
+	s.outputs["list --all --name"] = "juju-f75cba-0\nother\n\n"
+	s.outputs["dumpxml juju-f75cba-0 --inactive"] = fmt.Sprintf(`
+<domain type="kvm">
+  <name>juju-f75cba-0</name>
+  <metadata>
+    <juju:instance xmlns:juju="%s">
+      <juju:tag key="juju-model-uuid">deadbeef</juju:tag>
+    </juju:instance>
+  </metadata>
+  <memory unit="KiB">2097152</memory>
+  <devices>
+    <disk type="volume" device="disk">
+      <source pool="default" volume="juju-f75cba-0-root"/>
+      <target dev="vda" bus="virtio"/>
+    </disk>
+  </devices>
+</domain>`, metadataNamespace)
+	s.outputs["domstate juju-f75cba-0"] = "shut off\n\n"
+
+	domains, err := s.conn.Domains(c.Context(), "juju-")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(domains, tc.HasLen, 1)
+	c.Check(domains[0].Name, tc.Equals, "juju-f75cba-0")
+	c.Check(domains[0].State, tc.Equals, DomainShutOff)
+	c.Check(domains[0].Memory.MiB(), tc.Equals, uint64(2048))
+	c.Check(domains[0].JujuMetadata().Tag("juju-model-uuid"), tc.Equals, "deadbeef")
+	c.Check(domains[0].Devices.Disks[0].Source.Volume, tc.Equals, "juju-f75cba-0-root")
+	c.Check(s.commands, tc.DeepEquals, []string{
+		"list --all --name",
+		"dumpxml juju-f75cba-0 --inactive",
+		"domstate juju-f75cba-0",
+	})
+}
+
+func (s *virshSuite) TestRemoveDomain(c *tc.C) {
+	s.outputs["domstate juju-f75cba-0"] = "running\n"
+	err := s.conn.RemoveDomain(c.Context(), "juju-f75cba-0")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.commands, tc.DeepEquals, []string{
+		"domstate juju-f75cba-0",
+		"destroy juju-f75cba-0",
+		"undefine juju-f75cba-0 --nvram",
+	})
+}
+
+func (s *virshSuite) TestRemoveDomainNotFound(c *tc.C) {
+	s.errs["domstate juju-f75cba-0"] = errors.New("error: failed to get domain 'juju-f75cba-0'")
+	err := s.conn.RemoveDomain(c.Context(), "juju-f75cba-0")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.commands, tc.HasLen, 1)
+}
+
+func (s *virshSuite) TestCreateVolume(c *tc.C) {
+	s.outputs["vol-dumpxml --pool default juju-f75cba-0-root"] = `
+<volume type="file">
+  <name>juju-f75cba-0-root</name>
+  <capacity unit="bytes">8589934592</capacity>
+  <target>
+    <path>/var/lib/libvirt/images/juju-f75cba-0-root</path>
+    <format type="qcow2"/>
+  </target>
+</volume>`
+
+	vol, err := s.conn.CreateVolume(c.Context(), "default", VolumeSpec{
+		Name:          "juju-f75cba-0-root",
+		Format:        "qcow2",
+		Capacity:      8589934592,
+		BackingVolume: "juju-ubuntu-24.04-amd64.img",
+		BackingFormat: "qcow2",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(vol, tc.Equals, Volume{
+		Pool:     "default",
+		Name:     "juju-f75cba-0-root",
+		Path:     "/var/lib/libvirt/images/juju-f75cba-0-root",
+		Format:   "qcow2",
+		Capacity: 8589934592,
+	})
+	c.Check(s.commands[0], tc.Equals, "vol-create-as default juju-f75cba-0-root 8589934592b --format qcow2 "+
+		"--backing-vol juju-ubuntu-24.04-amd64.img --backing-vol-format qcow2")
+}
+
+func (s *virshSuite) TestVolumes(c *tc.C) {
+	s.outputs["vol-list --pool default"] = ` Name     Path
+------------------------------------------
+ disk-a   /var/lib/libvirt/images/disk-a
+`
+	s.outputs["vol-dumpxml --pool default disk-a"] = `<volume><name>disk-a</name><capacity>1024</capacity></volume>`
+
+	volumes, err := s.conn.Volumes(c.Context(), "default")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(volumes, tc.DeepEquals, []Volume{{Pool: "default", Name: "disk-a", Capacity: 1024}})
+}
+
+func (s *virshSuite) TestDeleteVolumeNotFound(c *tc.C) {
+	s.errs["vol-delete --pool default gone"] = errors.New("error: Storage volume not found: no storage vol with matching path")
+	err := s.conn.DeleteVolume(c.Context(), "default", "gone")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *virshSuite) TestNetworks(c *tc.C) {
+	s.outputs["net-list --all --name"] = "default\nisolated\n"
+	s.outputs["net-list --name"] = "default\n"
+	s.outputs["net-dumpxml default"] = `
+<network>
+  <name>default</name>
+  <bridge name="virbr0"/>
+  <ip address="192.168.122.1" netmask="255.255.255.0"/>
+</network>`
+	s.outputs["net-dumpxml isolated"] = `
+<network>
+  <name>isolated</name>
+  <bridge name="virbr1"/>
+  <ip family="ipv6" address="fd00::1" prefix="64"/>
+</network>`
+
+	networks, err := s.conn.Networks(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(networks, tc.DeepEquals, []Network{{
+		Name:   "default",
+		Bridge: "virbr0",
+		CIDRs:  []string{"192.168.122.0/24"},
+		Active: true,
+	}, {
+		Name:   "isolated",
+		Bridge: "virbr1",
+		CIDRs:  []string{"fd00::/64"},
+	}})
+}
+
+func (s *virshSuite) TestParseDomIfAddr(c *tc.C) {
+	addrs := parseDomIfAddr(` Name       MAC address          Protocol     Address
+-------------------------------------------------------------------------------
+ vnet0      52:54:00:2e:1f:7a    ipv4         192.168.122.10/24
+ -          -                    ipv6         fd00::10/64
+ vnet1      52:54:00:2e:1f:7b    ipv4         10.0.0.5/8
+`)
+	c.Check(addrs, tc.DeepEquals, []InterfaceAddress{
+		{MACAddress: "52:54:00:2e:1f:7a", CIDRAddress: "192.168.122.10/24"},
+		{MACAddress: "52:54:00:2e:1f:7a", CIDRAddress: "fd00::10/64"},
+		{MACAddress: "52:54:00:2e:1f:7b", CIDRAddress: "10.0.0.5/8"},
+	})
+}
+
+func (s *virshSuite) TestIsAuthorisationFailure(c *tc.C) {
+	c.Check(IsAuthorisationFailure(errors.New("error: authentication failed: no agent")), tc.IsTrue)
+	c.Check(IsAuthorisationFailure(errors.New("error: access denied")), tc.IsTrue)
+	c.Check(IsAuthorisationFailure(errors.New("error: failed to get domain")), tc.IsFalse)
+	c.Check(IsAuthorisationFailure(nil), tc.IsFalse)
+}
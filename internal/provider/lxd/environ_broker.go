@@ -12,6 +12,7 @@ import (
 	"github.com/juju/errors"
 
 	"github.com/juju/juju/core/arch"
+	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/core/instance"
 	"github.com/juju/juju/core/status"
 	"github.com/juju/juju/environs"
@@ -241,6 +242,10 @@ func (env *environ) getContainerSpec(
 	//cfg[lxd.UserDataKey] = utils.Gzip(userData)
 	cSpec.Config[lxd.UserDataKey] = string(userData)
 
+	if args.Constraints.HasAffinityGroup() {
+		cSpec.Config[lxd.UserNamespacePrefix+affinityGroupKey] = *args.Constraints.AffinityGroup
+	}
+
 	for k, v := range args.InstanceConfig.Tags {
 		if !strings.HasPrefix(k, tags.JujuTagPrefix) {
 			// Since some metadata is interpreted by LXD, we cannot allow
@@ -355,9 +360,50 @@ func (env *environ) getTargetServer(
 		return env.server(), nil
 
 	}
+	if err := env.checkAffinityGroup(ctx, zone, args.Constraints); err != nil {
+		return nil, errors.Trace(err)
+	}
 	return env.server().UseTargetServer(ctx, zone)
 }
 
+// affinityGroupKey is the container metadata key under which the affinity
+// group of an instance is recorded.
+const affinityGroupKey = "juju-affinity-group"
+
+// checkAffinityGroup ensures that creating an instance on the input cluster
+// member honours the affinity group constraints of the new instance.
+// Violations are not zone independent, so the provisioner will go on to
+// try the instance on the next cluster member.
+func (env *environ) checkAffinityGroup(ctx context.Context, zone string, cons constraints.Value) error {
+	if !cons.HasAffinityGroup() {
+		return nil
+	}
+	group := *cons.AffinityGroup
+
+	insts, err := env.allInstances()
+	if err != nil {
+		return errors.Trace(env.HandleCredentialError(ctx, err))
+	}
+	members := set.NewStrings()
+	for _, inst := range insts {
+		if inst.container.Metadata(affinityGroupKey) == group {
+			members.Add(inst.container.Location)
+		}
+	}
+
+	if cons.HasAntiAffinity() {
+		if members.Contains(zone) {
+			return errors.Errorf("cluster member %q already hosts an instance in anti-affinity group %q", zone, group)
+		}
+		return nil
+	}
+	if !members.IsEmpty() && !members.Contains(zone) {
+		return errors.Errorf("affinity group %q is hosted on cluster member(s) %s, not %q",
+			group, strings.Join(members.SortedValues(), ", "), zone)
+	}
+	return nil
+}
+
 type lxdPlacement struct {
 	nodeName string
 }
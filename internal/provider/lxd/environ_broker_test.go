@@ -534,6 +534,89 @@ func (s *environBrokerSuite) TestStartInstanceWithZoneConstraintsNotAvailable(c
 	c.Assert(err, tc.ErrorMatches, `availability zone "node01" is "OFFLINE"`)
 }
 
+func (s *environBrokerSuite) TestStartInstanceWithAntiAffinityMemberInUse(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+	svr := lxd.NewMockServer(ctrl)
+	invalidator := lxd.NewMockCredentialInvalidator(ctrl)
+
+	members := []api.ClusterMember{{
+		ServerName: "node01",
+		Status:     "ONLINE",
+	}, {
+		ServerName: "node02",
+		Status:     "ONLINE",
+	}}
+
+	existing := containerlxd.Container{
+		Instance: api.Instance{
+			Name:     "juju-existing",
+			Location: "node01",
+			Config:   map[string]string{"user.juju-affinity-group": "db"},
+		},
+	}
+
+	sExp := svr.EXPECT()
+	gomock.InOrder(
+		sExp.HostArch().Return(arch.AMD64),
+		sExp.IsClustered().Return(true),
+		sExp.GetClusterMembers().Return(members, nil),
+		sExp.IsClustered().Return(true),
+		sExp.AliveContainers(gomock.Any()).Return([]containerlxd.Container{existing}, nil),
+	)
+
+	env := s.NewEnviron(c, svr, nil, environscloudspec.CloudSpec{}, invalidator)
+
+	args := s.GetStartInstanceArgs(c)
+	args.AvailabilityZone = "node01"
+	args.Constraints = constraints.MustParse("affinity-group=db anti-affinity=true")
+
+	_, err := env.StartInstance(c.Context(), args)
+	c.Assert(err, tc.ErrorMatches, `cluster member "node01" already hosts an instance in anti-affinity group "db"`)
+	c.Assert(err, tc.Not(tc.ErrorIs), environs.ErrAvailabilityZoneIndependent)
+}
+
+func (s *environBrokerSuite) TestStartInstanceWithAffinityOtherMember(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+	svr := lxd.NewMockServer(ctrl)
+	invalidator := lxd.NewMockCredentialInvalidator(ctrl)
+
+	members := []api.ClusterMember{{
+		ServerName: "node01",
+		Status:     "ONLINE",
+	}, {
+		ServerName: "node02",
+		Status:     "ONLINE",
+	}}
+
+	existing := containerlxd.Container{
+		Instance: api.Instance{
+			Name:     "juju-existing",
+			Location: "node02",
+			Config:   map[string]string{"user.juju-affinity-group": "db"},
+		},
+	}
+
+	sExp := svr.EXPECT()
+	gomock.InOrder(
+		sExp.HostArch().Return(arch.AMD64),
+		sExp.IsClustered().Return(true),
+		sExp.GetClusterMembers().Return(members, nil),
+		sExp.IsClustered().Return(true),
+		sExp.AliveContainers(gomock.Any()).Return([]containerlxd.Container{existing}, nil),
+	)
+
+	env := s.NewEnviron(c, svr, nil, environscloudspec.CloudSpec{}, invalidator)
+
+	args := s.GetStartInstanceArgs(c)
+	args.AvailabilityZone = "node01"
+	args.Constraints = constraints.MustParse("affinity-group=db")
+
+	_, err := env.StartInstance(c.Context(), args)
+	c.Assert(err, tc.ErrorMatches, `affinity group "db" is hosted on cluster member\(s\) node02, not "node01"`)
+}
+
 func (s *environBrokerSuite) TestStartInstanceWithConstraints(c *tc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
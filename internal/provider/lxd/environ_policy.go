@@ -38,7 +38,15 @@ var unsupportedConstraints = []string{
 func (env *environ) ConstraintsValidator(ctx context.Context) (constraints.Validator, error) {
 	validator := constraints.NewValidator()
 
-	validator.RegisterUnsupported(unsupportedConstraints)
+	// Affinity groups are realised by spreading instances across (or
+	// packing them onto) cluster members, which is meaningless for a
+	// single LXD server.
+	unsupported := unsupportedConstraints
+	if !env.server().IsClustered() {
+		unsupported = append(unsupported[:len(unsupported):len(unsupported)],
+			constraints.AffinityGroup, constraints.AntiAffinity)
+	}
+	validator.RegisterUnsupported(unsupported)
 	validator.RegisterVocabulary(constraints.VirtType, []string{"", "container", "virtual-machine"})
 
 	// Only consume supported juju architectures for this release. This will
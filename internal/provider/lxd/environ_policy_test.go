@@ -98,6 +98,8 @@ func (s *environPolicySuite) TestPrecheckInstanceAvailZone(c *tc.C) {
 func (s *environPolicySuite) TestConstraintsValidatorArch(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
+	s.svr.EXPECT().IsClustered().Return(false)
+
 	validator, err := s.env.ConstraintsValidator(c.Context())
 	c.Assert(err, tc.ErrorIsNil)
 
@@ -121,6 +123,8 @@ func (s *environPolicySuite) TestConstraintsValidatorArchWithUnsupportedArches(c
 
 	s.env = s.NewEnviron(c, s.svr, nil, environscloudspec.CloudSpec{}, invalidator)
 
+	s.svr.EXPECT().IsClustered().Return(false)
+
 	validator, err := s.env.ConstraintsValidator(c.Context())
 	c.Assert(err, tc.ErrorIsNil)
 
@@ -136,6 +140,8 @@ func (s *environPolicySuite) TestConstraintsValidatorArchWithUnsupportedArches(c
 func (s *environPolicySuite) TestConstraintsValidatorVirtType(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
+	s.svr.EXPECT().IsClustered().Return(false)
+
 	validator, err := s.env.ConstraintsValidator(c.Context())
 	c.Assert(err, tc.ErrorIsNil)
 
@@ -149,6 +155,8 @@ func (s *environPolicySuite) TestConstraintsValidatorVirtType(c *tc.C) {
 func (s *environPolicySuite) TestConstraintsValidatorEmptyVirtType(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
+	s.svr.EXPECT().IsClustered().Return(false)
+
 	validator, err := s.env.ConstraintsValidator(c.Context())
 	c.Assert(err, tc.ErrorIsNil)
 
@@ -162,6 +170,8 @@ func (s *environPolicySuite) TestConstraintsValidatorEmptyVirtType(c *tc.C) {
 func (s *environPolicySuite) TestConstraintsValidatorEmpty(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
+	s.svr.EXPECT().IsClustered().Return(false)
+
 	validator, err := s.env.ConstraintsValidator(c.Context())
 	c.Assert(err, tc.ErrorIsNil)
 
@@ -174,6 +184,8 @@ func (s *environPolicySuite) TestConstraintsValidatorEmpty(c *tc.C) {
 func (s *environPolicySuite) TestConstraintsValidatorUnsupported(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
+	s.svr.EXPECT().IsClustered().Return(false)
+
 	validator, err := s.env.ConstraintsValidator(c.Context())
 	c.Assert(err, tc.ErrorIsNil)
 
@@ -196,9 +208,41 @@ func (s *environPolicySuite) TestConstraintsValidatorUnsupported(c *tc.C) {
 	c.Check(unsupported, tc.SameContents, expected)
 }
 
+func (s *environPolicySuite) TestConstraintsValidatorAffinityUnsupportedNotClustered(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.svr.EXPECT().IsClustered().Return(false)
+
+	validator, err := s.env.ConstraintsValidator(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+
+	cons := constraints.MustParse("affinity-group=db anti-affinity=true")
+	unsupported, err := validator.Validate(cons)
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Check(unsupported, tc.SameContents, []string{"affinity-group", "anti-affinity"})
+}
+
+func (s *environPolicySuite) TestConstraintsValidatorAffinityClustered(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.svr.EXPECT().IsClustered().Return(true)
+
+	validator, err := s.env.ConstraintsValidator(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+
+	cons := constraints.MustParse("affinity-group=db anti-affinity=true")
+	unsupported, err := validator.Validate(cons)
+	c.Assert(err, tc.ErrorIsNil)
+
+	c.Check(unsupported, tc.HasLen, 0)
+}
+
 func (s *environPolicySuite) TestConstraintsValidatorVocabArchKnown(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
+	s.svr.EXPECT().IsClustered().Return(false)
+
 	validator, err := s.env.ConstraintsValidator(c.Context())
 	c.Assert(err, tc.ErrorIsNil)
 
@@ -211,6 +255,8 @@ func (s *environPolicySuite) TestConstraintsValidatorVocabArchKnown(c *tc.C) {
 func (s *environPolicySuite) TestConstraintsValidatorVocabArchUnknown(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
+	s.svr.EXPECT().IsClustered().Return(false)
+
 	validator, err := s.env.ConstraintsValidator(c.Context())
 	c.Assert(err, tc.ErrorIsNil)
 
@@ -225,6 +271,8 @@ func (s *environPolicySuite) TestConstraintsValidatorVocabContainerUnknown(c *tc
 
 	defer s.setupMocks(c).Finish()
 
+	s.svr.EXPECT().IsClustered().Return(false)
+
 	validator, err := s.env.ConstraintsValidator(c.Context())
 	c.Assert(err, tc.ErrorIsNil)
 
@@ -237,6 +285,8 @@ func (s *environPolicySuite) TestConstraintsValidatorVocabContainerUnknown(c *tc
 func (s *environPolicySuite) TestConstraintsValidatorConflicts(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
+	s.svr.EXPECT().IsClustered().Return(false)
+
 	validator, err := s.env.ConstraintsValidator(c.Context())
 	c.Assert(err, tc.ErrorIsNil)
 
@@ -34,13 +34,13 @@ func (s *storageSuite) TestStorageProviderTypes(c *tc.C) {
 	s.Client.StorageIsSupported = false
 	types, err := s.Env.StorageProviderTypes()
 	c.Assert(err, tc.ErrorIsNil)
-	c.Assert(types, tc.HasLen, 3)
+	c.Assert(types, tc.HasLen, 5)
 
 	s.Client.StorageIsSupported = true
 	types, err = s.Env.StorageProviderTypes()
 	c.Assert(err, tc.ErrorIsNil)
 	c.Assert(types, tc.SameContents, []storage.ProviderType{
-		"lxd", "loop", "rootfs", "tmpfs",
+		"lxd", "loop", "rootfs", "tmpfs", "lvm", "zfs",
 	})
 }
 
@@ -20,6 +20,9 @@ var unsupportedConstraints = []string{
 	constraints.InstanceType,
 	constraints.VirtType,
 	constraints.AllocatePublicIP,
+	constraints.AffinityGroup,
+	constraints.AntiAffinity,
+	constraints.Accelerators,
 }
 
 // ConstraintsValidator is defined on the Environs interface.
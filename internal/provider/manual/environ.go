@@ -332,6 +332,9 @@ var unsupportedConstraints = []string{
 	constraints.VirtType,
 	constraints.AllocatePublicIP,
 	constraints.ImageID,
+	constraints.AffinityGroup,
+	constraints.AntiAffinity,
+	constraints.Accelerators,
 }
 
 // ConstraintsValidator is defined on the Environs interface.
@@ -316,6 +316,9 @@ var unsupportedConstraints = []string{
 	constraints.VirtType,
 	constraints.Tags,
 	constraints.ImageID,
+	constraints.AffinityGroup,
+	constraints.AntiAffinity,
+	constraints.Accelerators,
 }
 
 // ConstraintsValidator implements environs.Environ.
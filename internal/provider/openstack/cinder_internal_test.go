@@ -45,6 +45,8 @@ func (s *cinderInternalSuite) TestStorageProviderTypes(c *tc.C) {
 		"loop",
 		"tmpfs",
 		"rootfs",
+		"lvm",
+		"zfs",
 	})
 }
 
@@ -59,6 +61,8 @@ func (s *cinderInternalSuite) TestStorageProviderTypesNotSupported(c *tc.C) {
 		"loop",
 		"tmpfs",
 		"rootfs",
+		"lvm",
+		"zfs",
 	})
 }
 
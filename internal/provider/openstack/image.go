@@ -4,6 +4,9 @@
 package openstack
 
 import (
+	"strconv"
+	"strings"
+
 	"github.com/go-goose/goose/v5/nova"
 	"github.com/juju/errors"
 
@@ -64,14 +67,17 @@ func findInstanceSpec(
 			continue
 		}
 		isSev := flavor.ExtraSpecs["hw:mem_encryption"] == "true"
+		accelerators, acceleratorType := flavorAccelerators(flavor.ExtraSpecs)
 		instanceType := instances.InstanceType{
-			Id:       flavor.Id,
-			Name:     flavor.Name,
-			Arch:     ic.Arch,
-			Mem:      uint64(flavor.RAM),
-			CpuCores: uint64(flavor.VCPUs),
-			RootDisk: uint64(flavor.Disk * 1024),
-			IsSev:    isSev,
+			Id:              flavor.Id,
+			Name:            flavor.Name,
+			Arch:            ic.Arch,
+			Mem:             uint64(flavor.RAM),
+			CpuCores:        uint64(flavor.VCPUs),
+			RootDisk:        uint64(flavor.Disk * 1024),
+			IsSev:           isSev,
+			Accelerators:    accelerators,
+			AcceleratorType: acceleratorType,
 			// tags not currently supported on openstack
 		}
 		if ic.Constraints.HasVirtType() {
@@ -96,3 +102,26 @@ func findInstanceSpec(
 	}
 	return spec, nil
 }
+
+// flavorAccelerators returns the number and type of accelerators requested by
+// the flavor extra specs. PCI passthrough devices are requested with
+// "pci_passthrough:alias" in the form <alias>:<count>, and the alias is used
+// as the accelerator type. Virtual GPUs are requested with "resources:VGPU".
+func flavorAccelerators(extraSpecs map[string]interface{}) (uint64, string) {
+	if alias, ok := extraSpecs["pci_passthrough:alias"].(string); ok {
+		// Only the first alias is considered if several are requested.
+		alias, _, _ = strings.Cut(alias, ",")
+		name, countStr, _ := strings.Cut(alias, ":")
+		count, err := strconv.ParseUint(countStr, 10, 64)
+		if err == nil && name != "" {
+			return count, name
+		}
+	}
+	if vgpus, ok := extraSpecs["resources:VGPU"].(string); ok {
+		count, err := strconv.ParseUint(vgpus, 10, 64)
+		if err == nil {
+			return count, "vgpu"
+		}
+	}
+	return 0, ""
+}
@@ -581,7 +581,7 @@ func (e *Environ) ConstraintsValidator(ctx context.Context) (constraints.Validat
 	validator.RegisterConflicts(
 		[]string{constraints.InstanceType},
 		// TODO: move to a dynamic conflict for arch when openstack supports defining arch in flavors
-		[]string{constraints.Mem, constraints.Cores})
+		[]string{constraints.Mem, constraints.Cores, constraints.Accelerators})
 	// NOTE: RootDiskSource and RootDisk constraints are validated in PrecheckInstance.
 	validator.RegisterUnsupported(unsupportedConstraints)
 	novaClient := e.nova()
@@ -1178,9 +1178,10 @@ func (e *Environ) startInstance(
 		attempts utils.AttemptStrategy,
 		client *nova.Client,
 		instanceOpts nova.RunServerOpts,
+		serverGroupID string,
 	) (server *nova.Entity, err error) {
 		for a := attempts.Start(); a.Next(); {
-			server, err = client.RunServer(instanceOpts)
+			server, err = e.runServer(client, instanceOpts, serverGroupID)
 			if err != nil {
 				break
 			}
@@ -1239,7 +1240,12 @@ func (e *Environ) startInstance(
 	}
 	e.configurator.ModifyRunServerOptions(&opts)
 
-	server, err := tryStartNovaInstance(shortAttempt, e.nova(), opts)
+	serverGroupID, err := e.ensureServerGroup(ctx, args.Constraints)
+	if err != nil {
+		return nil, environs.ZoneIndependentError(err)
+	}
+
+	server, err := tryStartNovaInstance(shortAttempt, e.nova(), opts, serverGroupID)
 	if err != nil || server == nil {
 		// Attempt to clean up any security groups we created.
 		if err := e.firewaller.DeleteMachineGroup(ctx, args.InstanceConfig.MachineId); err != nil {
@@ -1950,6 +1956,10 @@ func (e *Environ) Destroy(ctx context.Context) error {
 	if err := e.firewaller.DeleteAllModelGroups(ctx); err != nil {
 		return e.HandleCredentialError(ctx, err)
 	}
+	// Delete all server groups created for affinity constraints.
+	if err := e.deleteServerGroups(ctx); err != nil {
+		return errors.Trace(err)
+	}
 	return nil
 }
 
@@ -1184,6 +1184,36 @@ func (s *providerUnitTests) TestNetworksForInstanceNoSubnetAZsStillConsidered(c
 	})
 }
 
+func (s *providerUnitTests) TestFlavorAccelerators(c *tc.C) {
+	for _, t := range []struct {
+		extraSpecs map[string]interface{}
+		count      uint64
+		kind       string
+	}{
+		{nil, 0, ""},
+		{map[string]interface{}{"hw:mem_encryption": "true"}, 0, ""},
+		{map[string]interface{}{"pci_passthrough:alias": "nvidia-a100:2"}, 2, "nvidia-a100"},
+		{map[string]interface{}{"pci_passthrough:alias": "t4:1,nic:1"}, 1, "t4"},
+		{map[string]interface{}{"pci_passthrough:alias": "t4"}, 0, ""},
+		{map[string]interface{}{"resources:VGPU": "1"}, 1, "vgpu"},
+	} {
+		count, kind := flavorAccelerators(t.extraSpecs)
+		c.Check(count, tc.Equals, t.count, tc.Commentf("%v", t.extraSpecs))
+		c.Check(kind, tc.Equals, t.kind, tc.Commentf("%v", t.extraSpecs))
+	}
+}
+
+func (s *providerUnitTests) TestServerGroupName(c *tc.C) {
+	env := &Environ{modelUUID: "deadbeef-0bad-400d-8000-4b1d0d06f00d"}
+	c.Check(env.serverGroupName("db"), tc.Equals, "juju-deadbeef-0bad-400d-8000-4b1d0d06f00d-db")
+}
+
+func (s *providerUnitTests) TestServerGroupPolicy(c *tc.C) {
+	c.Check(serverGroupPolicy(constraints.MustParse("affinity-group=db")), tc.Equals, "affinity")
+	c.Check(serverGroupPolicy(constraints.MustParse("affinity-group=db anti-affinity=false")), tc.Equals, "affinity")
+	c.Check(serverGroupPolicy(constraints.MustParse("affinity-group=db anti-affinity=true")), tc.Equals, "anti-affinity")
+}
+
 func envWithNetworking(net Networking, netCfg string) *Environ {
 	return &Environ{
 		ecfgUnlocked: &environConfig{
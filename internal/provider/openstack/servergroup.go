@@ -0,0 +1,174 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package openstack
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-goose/goose/v5/client"
+	gooseerrors "github.com/go-goose/goose/v5/errors"
+	goosehttp "github.com/go-goose/goose/v5/http"
+	"github.com/go-goose/goose/v5/nova"
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/constraints"
+)
+
+const (
+	apiServerGroups = "os-server-groups"
+	apiServers      = "servers"
+
+	serverGroupPolicyAffinity     = "affinity"
+	serverGroupPolicyAntiAffinity = "anti-affinity"
+)
+
+// serverGroup describes a nova server group.
+type serverGroup struct {
+	Id       string   `json:"id"`
+	Name     string   `json:"name"`
+	Policies []string `json:"policies"`
+}
+
+// serverGroupName returns the name of the nova server group used to realise
+// the input affinity group within this model.
+func (e *Environ) serverGroupName(affinityGroup string) string {
+	return fmt.Sprintf("juju-%s-%s", e.modelUUID, affinityGroup)
+}
+
+// serverGroupPolicy returns the nova server group policy that satisfies the
+// affinity constraints.
+func serverGroupPolicy(cons constraints.Value) string {
+	if cons.HasAntiAffinity() {
+		return serverGroupPolicyAntiAffinity
+	}
+	return serverGroupPolicyAffinity
+}
+
+// ensureServerGroup returns the ID of the server group that an instance with
+// the input constraints must be started in, creating the group if it does not
+// yet exist. An empty ID is returned if the constraints do not specify an
+// affinity group.
+func (e *Environ) ensureServerGroup(ctx context.Context, cons constraints.Value) (string, error) {
+	if !cons.HasAffinityGroup() {
+		return "", nil
+	}
+	name := e.serverGroupName(*cons.AffinityGroup)
+	policy := serverGroupPolicy(cons)
+
+	var listResp struct {
+		ServerGroups []serverGroup `json:"server_groups"`
+	}
+	err := e.client().SendRequest(client.GET, "compute", "v2", apiServerGroups, &goosehttp.RequestData{
+		RespValue: &listResp,
+	})
+	if err != nil {
+		return "", errors.Annotate(e.HandleCredentialError(ctx, err), "listing server groups")
+	}
+	for _, group := range listResp.ServerGroups {
+		if group.Name != name {
+			continue
+		}
+		if len(group.Policies) > 0 && group.Policies[0] != policy {
+			return "", errors.NotValidf(
+				"affinity group %q uses server group policy %q, but %q is required by the constraints",
+				*cons.AffinityGroup, group.Policies[0], policy,
+			)
+		}
+		return group.Id, nil
+	}
+
+	var req struct {
+		ServerGroup struct {
+			Name     string   `json:"name"`
+			Policies []string `json:"policies"`
+		} `json:"server_group"`
+	}
+	req.ServerGroup.Name = name
+	req.ServerGroup.Policies = []string{policy}
+	var createResp struct {
+		ServerGroup serverGroup `json:"server_group"`
+	}
+	err = e.client().SendRequest(client.POST, "compute", "v2", apiServerGroups, &goosehttp.RequestData{
+		ReqValue:  req,
+		RespValue: &createResp,
+	})
+	if err != nil {
+		return "", errors.Annotatef(e.HandleCredentialError(ctx, err), "creating server group %q", name)
+	}
+	return createResp.ServerGroup.Id, nil
+}
+
+// deleteServerGroups deletes all server groups created for this model. It
+// must only be called once the instances in the groups have been terminated.
+func (e *Environ) deleteServerGroups(ctx context.Context) error {
+	var listResp struct {
+		ServerGroups []serverGroup `json:"server_groups"`
+	}
+	err := e.client().SendRequest(client.GET, "compute", "v2", apiServerGroups, &goosehttp.RequestData{
+		RespValue: &listResp,
+	})
+	if serverGroupsUnavailable(err) {
+		// The cloud does not support server groups, or does not allow
+		// us to see them, so there cannot be any of ours to delete.
+		logger.Debugf(ctx, "not deleting server groups: %v", err)
+		return nil
+	} else if err != nil {
+		return errors.Annotate(e.HandleCredentialError(ctx, err), "listing server groups")
+	}
+	prefix := e.serverGroupName("")
+	for _, group := range listResp.ServerGroups {
+		if !strings.HasPrefix(group.Name, prefix) {
+			continue
+		}
+		logger.Debugf(ctx, "deleting server group %q", group.Name)
+		err := e.client().SendRequest(client.DELETE, "compute", "v2", apiServerGroups+"/"+group.Id, &goosehttp.RequestData{
+			ExpectedStatus: []int{http.StatusNoContent, http.StatusNotFound},
+		})
+		if err != nil {
+			return errors.Annotatef(e.HandleCredentialError(ctx, err), "deleting server group %q", group.Name)
+		}
+	}
+	return nil
+}
+
+// serverGroupsUnavailable returns true if the error from listing server
+// groups indicates that the cloud does not support them, or that listing
+// them is forbidden by the cloud's policy.
+func serverGroupsUnavailable(err error) bool {
+	return gooseerrors.IsNotFound(err) ||
+		gooseerrors.IsForbidden(err) ||
+		gooseerrors.IsNotImplemented(err)
+}
+
+// runServer creates a new server, scheduling it into the input server group
+// if one is specified. nova.RunServerOpts has no support for scheduler hints,
+// so the request is made directly when a group is required.
+func (e *Environ) runServer(novaClient *nova.Client, opts nova.RunServerOpts, serverGroupID string) (*nova.Entity, error) {
+	if serverGroupID == "" {
+		return novaClient.RunServer(opts)
+	}
+	var req struct {
+		Server         nova.RunServerOpts `json:"server"`
+		SchedulerHints struct {
+			Group string `json:"group"`
+		} `json:"os:scheduler_hints"`
+	}
+	req.Server = opts
+	req.SchedulerHints.Group = serverGroupID
+	var resp struct {
+		Server nova.Entity `json:"server"`
+	}
+	err := e.client().SendRequest(client.POST, "compute", "v2", apiServers, &goosehttp.RequestData{
+		ReqValue:       req,
+		RespValue:      &resp,
+		ExpectedStatus: []int{http.StatusAccepted},
+	})
+	if err != nil {
+		return nil, errors.Annotatef(err, "failed to run a server in server group %q", serverGroupID)
+	}
+	return &resp.Server, nil
+}
@@ -0,0 +1,31 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package openstack
+
+import (
+	"errors"
+	"testing"
+
+	gooseerrors "github.com/go-goose/goose/v5/errors"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type serverGroupInternalSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestServerGroupInternalSuite(t *testing.T) {
+	tc.Run(t, &serverGroupInternalSuite{})
+}
+
+func (s *serverGroupInternalSuite) TestServerGroupsUnavailable(c *tc.C) {
+	cause := errors.New("boom")
+	c.Check(serverGroupsUnavailable(gooseerrors.NewNotFoundf(cause, nil, "not found")), tc.IsTrue)
+	c.Check(serverGroupsUnavailable(gooseerrors.NewForbiddenf(cause, nil, "forbidden")), tc.IsTrue)
+	c.Check(serverGroupsUnavailable(gooseerrors.NewNotImplementedf(cause, nil, "not implemented")), tc.IsTrue)
+	c.Check(serverGroupsUnavailable(gooseerrors.NewUnauthorisedf(cause, nil, "unauthorised")), tc.IsFalse)
+	c.Check(serverGroupsUnavailable(cause), tc.IsFalse)
+}
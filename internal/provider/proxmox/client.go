@@ -0,0 +1,499 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package proxmox
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/juju/errors"
+)
+
+// apiPath is the path of the Proxmox VE API, relative to the endpoint.
+const apiPath = "/api2/json"
+
+// taskPollInterval is how often the status of a running task is polled.
+var taskPollInterval = time.Second
+
+// Node describes a node of a Proxmox VE cluster.
+type Node struct {
+	Name   string `json:"node"`
+	Status string `json:"status"`
+}
+
+// Online reports whether the node is a running member of the cluster.
+func (n Node) Online() bool {
+	return n.Status == "online"
+}
+
+// VM describes a QEMU virtual machine, as reported by the cluster
+// resources endpoint.
+type VM struct {
+	VMID     int     `json:"vmid"`
+	Name     string  `json:"name"`
+	Node     string  `json:"node"`
+	Type     string  `json:"type"`
+	Status   string  `json:"status"`
+	Template int     `json:"template"`
+	MaxMem   uint64  `json:"maxmem"`
+	MaxCPU   float64 `json:"maxcpu"`
+	MaxDisk  uint64  `json:"maxdisk"`
+}
+
+// VMConfig is the configuration of a virtual machine. All values are
+// reported as strings.
+type VMConfig map[string]string
+
+// StorageVolume describes a volume in a Proxmox storage.
+type StorageVolume struct {
+	VolID  string `json:"volid"`
+	VMID   int    `json:"vmid"`
+	Size   uint64 `json:"size"`
+	Format string `json:"format"`
+}
+
+// Storage describes a storage configured on a node.
+type Storage struct {
+	Name    string `json:"storage"`
+	Type    string `json:"type"`
+	Content string `json:"content"`
+	Shared  int    `json:"shared"`
+	Active  int    `json:"active"`
+}
+
+// NodeNetwork describes a network device of a node.
+type NodeNetwork struct {
+	Iface  string `json:"iface"`
+	Type   string `json:"type"`
+	CIDR   string `json:"cidr"`
+	CIDR6  string `json:"cidr6"`
+	Active int    `json:"active"`
+}
+
+// AgentInterface describes a network interface of a guest, as reported by
+// the QEMU guest agent.
+type AgentInterface struct {
+	Name        string         `json:"name"`
+	MACAddress  string         `json:"hardware-address"`
+	IPAddresses []AgentAddress `json:"ip-addresses"`
+}
+
+// AgentAddress is an address of a guest network interface.
+type AgentAddress struct {
+	Address string `json:"ip-address"`
+	Type    string `json:"ip-address-type"`
+	Prefix  int    `json:"prefix"`
+}
+
+// APIError is returned when the Proxmox VE API responds with an error.
+type APIError struct {
+	StatusCode int
+	Status     string
+	Errors     map[string]string
+}
+
+// Error implements error.
+func (e *APIError) Error() string {
+	if len(e.Errors) == 0 {
+		return e.Status
+	}
+	keys := make([]string, 0, len(e.Errors))
+	for k := range e.Errors {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	details := make([]string, len(keys))
+	for i, k := range keys {
+		details[i] = fmt.Sprintf("%s: %s", k, strings.TrimSpace(e.Errors[k]))
+	}
+	return fmt.Sprintf("%s (%s)", e.Status, strings.Join(details, ", "))
+}
+
+// client is a client of the Proxmox VE REST API. It authenticates either
+// with an API token, or with a user name and password in exchange for a
+// ticket which is renewed when it expires.
+type client struct {
+	baseURL    string
+	httpClient *http.Client
+
+	tokenID     string
+	tokenSecret string
+	username    string
+	password    string
+
+	// mu protects the ticket fields below.
+	mu        sync.Mutex
+	ticket    string
+	csrfToken string
+}
+
+func (c *client) url(path string) string {
+	return c.baseURL + apiPath + path
+}
+
+// login exchanges the user name and password for a ticket.
+func (c *client) login(ctx context.Context) error {
+	form := url.Values{
+		"username": {c.username},
+		"password": {c.password},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url("/access/ticket"), strings.NewReader(form.Encode()))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	var ticket struct {
+		Ticket    string `json:"ticket"`
+		CSRFToken string `json:"CSRFPreventionToken"`
+	}
+	if err := c.send(req, &ticket); err != nil {
+		return errors.Annotate(err, "logging in to Proxmox VE")
+	}
+	c.mu.Lock()
+	c.ticket, c.csrfToken = ticket.Ticket, ticket.CSRFToken
+	c.mu.Unlock()
+	return nil
+}
+
+// authenticate adds the credentials to the request, logging in first if
+// necessary.
+func (c *client) authenticate(ctx context.Context, req *http.Request) error {
+	if c.tokenID != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("PVEAPIToken=%s=%s", c.tokenID, c.tokenSecret))
+		return nil
+	}
+	c.mu.Lock()
+	ticket := c.ticket
+	c.mu.Unlock()
+	if ticket == "" {
+		if err := c.login(ctx); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	req.AddCookie(&http.Cookie{Name: "PVEAuthCookie", Value: c.ticket})
+	if req.Method != http.MethodGet {
+		req.Header.Set("CSRFPreventionToken", c.csrfToken)
+	}
+	return nil
+}
+
+// do calls the API, decoding the data of the response into result if it
+// is not nil. A ticket that has expired is renewed once.
+func (c *client) do(ctx context.Context, method, path string, params url.Values, result any) error {
+	newRequest := func() (*http.Request, error) {
+		var body io.Reader
+		target := c.url(path)
+		if method == http.MethodGet || method == http.MethodDelete {
+			if len(params) > 0 {
+				target += "?" + params.Encode()
+			}
+		} else {
+			body = strings.NewReader(params.Encode())
+		}
+		req, err := http.NewRequestWithContext(ctx, method, target, body)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if body != nil {
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		}
+		return req, nil
+	}
+	return c.doRequest(ctx, newRequest, result)
+}
+
+func (c *client) doRequest(ctx context.Context, newRequest func() (*http.Request, error), result any) error {
+	for attempt := 0; ; attempt++ {
+		req, err := newRequest()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if err := c.authenticate(ctx, req); err != nil {
+			return errors.Trace(err)
+		}
+		err = c.send(req, result)
+		var apiErr *APIError
+		if attempt == 0 && c.tokenID == "" && errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusUnauthorized {
+			// The ticket has expired, so log in again.
+			c.mu.Lock()
+			c.ticket = ""
+			c.mu.Unlock()
+			continue
+		}
+		return errors.Trace(err)
+	}
+}
+
+// send sends the request and decodes the data of the response.
+func (c *client) send(req *http.Request, result any) error {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	var body struct {
+		Data    json.RawMessage   `json:"data"`
+		Errors  map[string]string `json:"errors"`
+		Message string            `json:"message"`
+	}
+	decodeErr := json.NewDecoder(resp.Body).Decode(&body)
+	if resp.StatusCode != http.StatusOK {
+		// The reason phrase of the status line carries the error message,
+		// which newer versions also include in the response body.
+		status := resp.Status
+		if msg := strings.TrimSpace(body.Message); msg != "" && !strings.Contains(status, msg) {
+			status = fmt.Sprintf("%d %s", resp.StatusCode, msg)
+		}
+		return &APIError{
+			StatusCode: resp.StatusCode,
+			Status:     status,
+			Errors:     body.Errors,
+		}
+	}
+	if decodeErr != nil {
+		return errors.Annotatef(decodeErr, "decoding response to %s %s", req.Method, req.URL.Path)
+	}
+	if result == nil || len(body.Data) == 0 || string(body.Data) == "null" {
+		return nil
+	}
+	return errors.Annotatef(json.Unmarshal(body.Data, result), "decoding response to %s %s", req.Method, req.URL.Path)
+}
+
+// Version returns the version of Proxmox VE.
+func (c *client) Version(ctx context.Context) (string, error) {
+	var version struct {
+		Version string `json:"version"`
+	}
+	err := c.do(ctx, http.MethodGet, "/version", nil, &version)
+	return version.Version, errors.Trace(err)
+}
+
+// Nodes returns the nodes of the cluster.
+func (c *client) Nodes(ctx context.Context) ([]Node, error) {
+	var nodes []Node
+	err := c.do(ctx, http.MethodGet, "/nodes", nil, &nodes)
+	return nodes, errors.Trace(err)
+}
+
+// VMs returns the QEMU virtual machines and templates in the cluster.
+func (c *client) VMs(ctx context.Context) ([]VM, error) {
+	var resources []VM
+	if err := c.do(ctx, http.MethodGet, "/cluster/resources", url.Values{"type": {"vm"}}, &resources); err != nil {
+		return nil, errors.Trace(err)
+	}
+	vms := resources[:0]
+	for _, vm := range resources {
+		if vm.Type == "qemu" {
+			vms = append(vms, vm)
+		}
+	}
+	return vms, nil
+}
+
+// NextID returns a free VM ID.
+func (c *client) NextID(ctx context.Context) (int, error) {
+	var id json.Number
+	if err := c.do(ctx, http.MethodGet, "/cluster/nextid", nil, &id); err != nil {
+		return 0, errors.Trace(err)
+	}
+	n, err := strconv.Atoi(id.String())
+	return n, errors.Annotatef(err, "parsing VM ID %q", id)
+}
+
+// WaitForTask waits for the task with the input ID, running on the input
+// node, to finish.
+func (c *client) WaitForTask(ctx context.Context, node, upid string) error {
+	if upid == "" {
+		return nil
+	}
+	path := fmt.Sprintf("/nodes/%s/tasks/%s/status", url.PathEscape(node), url.PathEscape(upid))
+	for {
+		var status struct {
+			Status     string `json:"status"`
+			ExitStatus string `json:"exitstatus"`
+		}
+		if err := c.do(ctx, http.MethodGet, path, nil, &status); err != nil {
+			return errors.Annotatef(err, "getting status of task %q", upid)
+		}
+		if status.Status == "stopped" {
+			if status.ExitStatus != "OK" {
+				return errors.Errorf("task %q failed: %s", upid, status.ExitStatus)
+			}
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return errors.Trace(ctx.Err())
+		case <-time.After(taskPollInterval):
+		}
+	}
+}
+
+// doTask calls an API that starts a task, and waits for the task to
+// finish.
+func (c *client) doTask(ctx context.Context, node, method, path string, params url.Values) error {
+	var upid string
+	if err := c.do(ctx, method, path, params, &upid); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(c.WaitForTask(ctx, node, upid))
+}
+
+func vmPath(node string, vmid int, rest string) string {
+	return fmt.Sprintf("/nodes/%s/qemu/%d%s", url.PathEscape(node), vmid, rest)
+}
+
+// CloneVM clones the template with the input ID on the input node.
+func (c *client) CloneVM(ctx context.Context, node string, vmid int, params url.Values) error {
+	return errors.Trace(c.doTask(ctx, node, http.MethodPost, vmPath(node, vmid, "/clone"), params))
+}
+
+// VMConfig returns the current configuration of a virtual machine.
+func (c *client) VMConfig(ctx context.Context, node string, vmid int) (VMConfig, error) {
+	var raw map[string]any
+	if err := c.do(ctx, http.MethodGet, vmPath(node, vmid, "/config"), nil, &raw); err != nil {
+		return nil, errors.Trace(err)
+	}
+	config := make(VMConfig, len(raw))
+	for k, v := range raw {
+		config[k] = fmt.Sprint(v)
+	}
+	return config, nil
+}
+
+// SetVMConfig updates the configuration of a virtual machine.
+func (c *client) SetVMConfig(ctx context.Context, node string, vmid int, params url.Values) error {
+	return errors.Trace(c.do(ctx, http.MethodPut, vmPath(node, vmid, "/config"), params, nil))
+}
+
+// ResizeDisk grows a disk of a virtual machine to the input size, in
+// MiB.
+func (c *client) ResizeDisk(ctx context.Context, node string, vmid int, disk string, sizeMiB uint64) error {
+	return errors.Trace(c.doTask(ctx, node, http.MethodPut, vmPath(node, vmid, "/resize"), url.Values{
+		"disk": {disk},
+		"size": {fmt.Sprintf("%dM", sizeMiB)},
+	}))
+}
+
+// StartVM starts a virtual machine.
+func (c *client) StartVM(ctx context.Context, node string, vmid int) error {
+	return errors.Trace(c.doTask(ctx, node, http.MethodPost, vmPath(node, vmid, "/status/start"), nil))
+}
+
+// StopVM stops a virtual machine immediately.
+func (c *client) StopVM(ctx context.Context, node string, vmid int) error {
+	return errors.Trace(c.doTask(ctx, node, http.MethodPost, vmPath(node, vmid, "/status/stop"), nil))
+}
+
+// DeleteVM destroys a stopped virtual machine and all of its disks.
+func (c *client) DeleteVM(ctx context.Context, node string, vmid int) error {
+	return errors.Trace(c.doTask(ctx, node, http.MethodDelete, vmPath(node, vmid, ""), url.Values{
+		"purge":                      {"1"},
+		"destroy-unreferenced-disks": {"1"},
+	}))
+}
+
+// AgentInterfaces returns the network interfaces of a virtual machine, as
+// reported by its guest agent.
+func (c *client) AgentInterfaces(ctx context.Context, node string, vmid int) ([]AgentInterface, error) {
+	var result struct {
+		Result []AgentInterface `json:"result"`
+	}
+	err := c.do(ctx, http.MethodGet, vmPath(node, vmid, "/agent/network-get-interfaces"), nil, &result)
+	return result.Result, errors.Trace(err)
+}
+
+func storagePath(node, storage, rest string) string {
+	return fmt.Sprintf("/nodes/%s/storage/%s%s", url.PathEscape(node), url.PathEscape(storage), rest)
+}
+
+// Storages returns the storages available on a node.
+func (c *client) Storages(ctx context.Context, node string) ([]Storage, error) {
+	var storages []Storage
+	err := c.do(ctx, http.MethodGet, fmt.Sprintf("/nodes/%s/storage", url.PathEscape(node)), nil, &storages)
+	return storages, errors.Trace(err)
+}
+
+// StorageVolumes returns the volumes with the input content type in a
+// storage of a node.
+func (c *client) StorageVolumes(ctx context.Context, node, storage, content string) ([]StorageVolume, error) {
+	var volumes []StorageVolume
+	err := c.do(ctx, http.MethodGet, storagePath(node, storage, "/content"), url.Values{"content": {content}}, &volumes)
+	return volumes, errors.Trace(err)
+}
+
+// AllocateDisk allocates a disk image owned by a virtual machine, and
+// returns its volume ID.
+func (c *client) AllocateDisk(ctx context.Context, node, storage string, vmid int, filename string, sizeMiB uint64) (string, error) {
+	var volid string
+	err := c.do(ctx, http.MethodPost, storagePath(node, storage, "/content"), url.Values{
+		"vmid":     {strconv.Itoa(vmid)},
+		"filename": {filename},
+		"size":     {fmt.Sprintf("%dM", sizeMiB)},
+		"format":   {"raw"},
+	}, &volid)
+	return volid, errors.Trace(err)
+}
+
+// DeleteVolume deletes a volume from a storage of a node.
+func (c *client) DeleteVolume(ctx context.Context, node, storage, volid string) error {
+	// Older versions delete the volume synchronously, in which case no
+	// task ID is returned.
+	return errors.Trace(c.doTask(ctx, node, http.MethodDelete,
+		storagePath(node, storage, "/content/"+url.PathEscape(volid)), nil))
+}
+
+// UploadISO uploads an ISO image to a storage of a node.
+func (c *client) UploadISO(ctx context.Context, node, storage, filename string, content []byte) error {
+	newRequest := func() (*http.Request, error) {
+		var body bytes.Buffer
+		w := multipart.NewWriter(&body)
+		if err := w.WriteField("content", "iso"); err != nil {
+			return nil, errors.Trace(err)
+		}
+		part, err := w.CreateFormFile("filename", filename)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if _, err := part.Write(content); err != nil {
+			return nil, errors.Trace(err)
+		}
+		if err := w.Close(); err != nil {
+			return nil, errors.Trace(err)
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url(storagePath(node, storage, "/upload")), &body)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		req.Header.Set("Content-Type", w.FormDataContentType())
+		return req, nil
+	}
+	var upid string
+	if err := c.doRequest(ctx, newRequest, &upid); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(c.WaitForTask(ctx, node, upid))
+}
+
+// NodeNetworks returns the bridges of a node.
+func (c *client) NodeNetworks(ctx context.Context, node string) ([]NodeNetwork, error) {
+	var networks []NodeNetwork
+	err := c.do(ctx, http.MethodGet, fmt.Sprintf("/nodes/%s/network", url.PathEscape(node)),
+		url.Values{"type": {"any_bridge"}}, &networks)
+	return networks, errors.Trace(err)
+}
@@ -0,0 +1,119 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package proxmox
+
+import (
+	"context"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/schema"
+
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/internal/configschema"
+)
+
+// The proxmox-specific config keys.
+const (
+	cfgTemplate   = "proxmox-template"
+	cfgStorage    = "proxmox-storage"
+	cfgISOStorage = "proxmox-iso-storage"
+	cfgBridge     = "proxmox-bridge"
+)
+
+var (
+	configSchema = configschema.Fields{
+		cfgTemplate: {
+			Description: "The name of the VM template that instances are cloned from. The {version} placeholder is replaced with the base version of the instance. A template of that name on the instance's node is preferred.",
+			Type:        configschema.Tstring,
+		},
+		cfgStorage: {
+			Description: "The storage in which instance disks and volumes are created.",
+			Type:        configschema.Tstring,
+		},
+		cfgISOStorage: {
+			Description: "The storage, with ISO image content enabled, to which cloud-init seed images are uploaded.",
+			Type:        configschema.Tstring,
+		},
+		cfgBridge: {
+			Description: "The bridge that instances are connected to.",
+			Type:        configschema.Tstring,
+		},
+	}
+
+	configDefaults = schema.Defaults{
+		cfgTemplate:   "ubuntu-{version}-cloudimg",
+		cfgStorage:    "local-lvm",
+		cfgISOStorage: "local",
+		cfgBridge:     "vmbr0",
+	}
+)
+
+var configFields = func() schema.Fields {
+	fs, _, err := configSchema.ValidationSchema()
+	if err != nil {
+		panic(err)
+	}
+	return fs
+}()
+
+type environConfig struct {
+	*config.Config
+	attrs map[string]interface{}
+}
+
+// newValidConfig builds a new environConfig from the provided Config
+// and returns it. The resulting config values are validated.
+func newValidConfig(ctx context.Context, cfg *config.Config) (*environConfig, error) {
+	// Ensure that the provided config is valid.
+	if err := config.Validate(ctx, cfg, nil); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	// Apply the defaults and coerce/validate the custom config attrs.
+	validated, err := cfg.ValidateUnknownAttrs(configFields, configDefaults)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	validCfg, err := cfg.Apply(validated)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	ecfg := &environConfig{
+		Config: validCfg,
+		attrs:  validCfg.UnknownAttrs(),
+	}
+	if err := ecfg.validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return ecfg, nil
+}
+
+// validate checks proxmox-specific config values.
+func (c *environConfig) validate() error {
+	for _, key := range []string{cfgTemplate, cfgStorage, cfgISOStorage, cfgBridge} {
+		if value, _ := c.attrs[key].(string); value == "" {
+			return errors.NotValidf("empty %s", key)
+		}
+	}
+	return nil
+}
+
+// template returns the name of the template for the input base version.
+func (c *environConfig) template(version string) string {
+	return strings.ReplaceAll(c.attrs[cfgTemplate].(string), "{version}", version)
+}
+
+func (c *environConfig) storage() string {
+	return c.attrs[cfgStorage].(string)
+}
+
+func (c *environConfig) isoStorage() string {
+	return c.attrs[cfgISOStorage].(string)
+}
+
+func (c *environConfig) bridge() string {
+	return c.attrs[cfgBridge].(string)
+}
@@ -0,0 +1,104 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package proxmox
+
+import (
+	"os"
+	"strings"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/cloud"
+	"github.com/juju/juju/environs"
+)
+
+const (
+	credAttrUsername    = "username"
+	credAttrPassword    = "password"
+	credAttrTokenID     = "token-id"
+	credAttrTokenSecret = "token-secret"
+)
+
+// The environment variables credentials are detected from. These are the
+// variables used by the Proxmox VE Terraform provider.
+const (
+	envAPIToken = "PROXMOX_VE_API_TOKEN"
+	envUsername = "PROXMOX_VE_USERNAME"
+	envPassword = "PROXMOX_VE_PASSWORD"
+)
+
+type environProviderCredentials struct{}
+
+// CredentialSchemas is part of the environs.ProviderCredentials interface.
+func (environProviderCredentials) CredentialSchemas() map[cloud.AuthType]cloud.CredentialSchema {
+	return map[cloud.AuthType]cloud.CredentialSchema{
+		cloud.AccessKeyAuthType: {
+			{
+				Name: credAttrTokenID,
+				CredentialAttr: cloud.CredentialAttr{
+					Description: "The ID of the API token, in the form user@realm!name.",
+				},
+			}, {
+				Name: credAttrTokenSecret,
+				CredentialAttr: cloud.CredentialAttr{
+					Description: "The secret of the API token.",
+					Hidden:      true,
+				},
+			},
+		},
+		cloud.UserPassAuthType: {
+			{
+				Name: credAttrUsername,
+				CredentialAttr: cloud.CredentialAttr{
+					Description: "The user to authenticate as, in the form user@realm.",
+				},
+			}, {
+				Name: credAttrPassword,
+				CredentialAttr: cloud.CredentialAttr{
+					Description: "The password to authenticate with.",
+					Hidden:      true,
+				},
+			},
+		},
+	}
+}
+
+// DetectCredentials is part of the environs.ProviderCredentials interface.
+// An API token is detected from PROXMOX_VE_API_TOKEN, in the form
+// user@realm!name=secret, and a user name and password from
+// PROXMOX_VE_USERNAME and PROXMOX_VE_PASSWORD.
+func (environProviderCredentials) DetectCredentials(cloudName string) (*cloud.CloudCredential, error) {
+	var credential cloud.Credential
+	if token := os.Getenv(envAPIToken); token != "" {
+		tokenID, secret, ok := strings.Cut(token, "=")
+		if !ok || tokenID == "" || secret == "" {
+			return nil, errors.NotValidf("%s value", envAPIToken)
+		}
+		credential = cloud.NewCredential(cloud.AccessKeyAuthType, map[string]string{
+			credAttrTokenID:     tokenID,
+			credAttrTokenSecret: secret,
+		})
+		credential.Label = "Proxmox VE API token " + tokenID
+	} else if username := os.Getenv(envUsername); username != "" {
+		password := os.Getenv(envPassword)
+		if password == "" {
+			return nil, errors.NotFoundf("%s environment variable", envPassword)
+		}
+		credential = cloud.NewCredential(cloud.UserPassAuthType, map[string]string{
+			credAttrUsername: username,
+			credAttrPassword: password,
+		})
+		credential.Label = "Proxmox VE user " + username
+	} else {
+		return nil, errors.NotFoundf("credentials")
+	}
+	return &cloud.CloudCredential{
+		AuthCredentials: map[string]cloud.Credential{"default": credential},
+	}, nil
+}
+
+// FinalizeCredential is part of the environs.ProviderCredentials interface.
+func (environProviderCredentials) FinalizeCredential(_ environs.FinalizeCredentialContext, args environs.FinalizeCredentialParams) (*cloud.Credential, error) {
+	return &args.Credential, nil
+}
@@ -0,0 +1,207 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package proxmox
+
+import (
+	"context"
+	"net/url"
+	"sync"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/core/semversion"
+	"github.com/juju/juju/environs"
+	environscloudspec "github.com/juju/juju/environs/cloudspec"
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/environs/tags"
+	"github.com/juju/juju/internal/provider/common"
+)
+
+type environ struct {
+	environs.NoSpaceDiscoveryEnviron
+	environs.NoContainerAddressesEnviron
+	common.CredentialInvalidator
+
+	name     string
+	uuid     string
+	provider *environProvider
+
+	// namespace is used to create the machine and device hostnames.
+	namespace instance.Namespace
+
+	// lock protects the *Unlocked fields below.
+	lock           sync.Mutex
+	ecfgUnlocked   *environConfig
+	cloudUnlocked  environscloudspec.CloudSpec
+	clientUnlocked *client
+
+	// cloneLock serialises allocating VM IDs and cloning templates, so
+	// that concurrent clones do not race for the same ID.
+	cloneLock sync.Mutex
+}
+
+var _ environs.Environ = (*environ)(nil)
+
+func newEnviron(
+	ctx context.Context,
+	p *environProvider,
+	spec environscloudspec.CloudSpec,
+	cfg *config.Config,
+	invalidator environs.CredentialInvalidator,
+) (*environ, error) {
+	ecfg, err := newValidConfig(ctx, cfg)
+	if err != nil {
+		return nil, errors.Annotate(err, "invalid config")
+	}
+
+	namespace, err := instance.NewNamespace(cfg.UUID())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	env := &environ{
+		CredentialInvalidator: common.NewCredentialInvalidator(invalidator, IsAuthorisationFailure),
+		name:                  ecfg.Name(),
+		uuid:                  ecfg.UUID(),
+		provider:              p,
+		namespace:             namespace,
+		ecfgUnlocked:          ecfg,
+	}
+	if err := env.SetCloudSpec(ctx, spec); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return env, nil
+}
+
+// Name returns the name of the environ.
+func (env *environ) Name() string {
+	return env.name
+}
+
+// Provider returns the provider that created this environ.
+func (env *environ) Provider() environs.EnvironProvider {
+	return env.provider
+}
+
+// SetConfig updates the environ's configuration.
+func (env *environ) SetConfig(ctx context.Context, cfg *config.Config) error {
+	ecfg, err := newValidConfig(ctx, cfg)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	env.lock.Lock()
+	defer env.lock.Unlock()
+	env.ecfgUnlocked = ecfg
+	return nil
+}
+
+// SetCloudSpec is specified in the environs.Environ interface.
+func (env *environ) SetCloudSpec(_ context.Context, spec environscloudspec.CloudSpec) error {
+	if err := validateCloudSpec(spec); err != nil {
+		return errors.Annotate(err, "validating cloud spec")
+	}
+	env.lock.Lock()
+	defer env.lock.Unlock()
+	env.cloudUnlocked = spec
+	env.clientUnlocked = newClient(spec)
+	return nil
+}
+
+// Config returns the configuration data with which the env was created.
+func (env *environ) Config() *config.Config {
+	return env.ecfg().Config
+}
+
+func (env *environ) ecfg() *environConfig {
+	env.lock.Lock()
+	defer env.lock.Unlock()
+	return env.ecfgUnlocked
+}
+
+func (env *environ) client() *client {
+	env.lock.Lock()
+	defer env.lock.Unlock()
+	return env.clientUnlocked
+}
+
+// PrepareForBootstrap implements environs.Environ.
+func (env *environ) PrepareForBootstrap(environs.BootstrapContext, string) error {
+	return nil
+}
+
+// Bootstrap is exported, because it has to be rewritten in external unit tests
+var Bootstrap = common.Bootstrap
+
+// Bootstrap implements environs.Environ.
+func (env *environ) Bootstrap(ctx environs.BootstrapContext, args environs.BootstrapParams) (*environs.BootstrapResult, error) {
+	return Bootstrap(ctx, env, args)
+}
+
+// Destroy shuts down all known machines and destroys the rest of the
+// known environment.
+func (env *environ) Destroy(ctx context.Context) error {
+	if err := common.Destroy(env, ctx); err != nil {
+		return errors.Trace(env.HandleCredentialError(ctx, err))
+	}
+	return nil
+}
+
+// DestroyController implements the Environ interface.
+func (env *environ) DestroyController(ctx context.Context, controllerUUID string) error {
+	if err := env.Destroy(ctx); err != nil {
+		return errors.Trace(err)
+	}
+
+	// Destroy all instances of hosted models of the controller.
+	vms, err := env.jujuVMs(ctx, "juju-")
+	if err != nil {
+		return errors.Trace(env.HandleCredentialError(ctx, err))
+	}
+	var hosted []VM
+	for _, vm := range vms {
+		md, err := env.vmMetadata(ctx, vm)
+		if err != nil {
+			return errors.Trace(env.HandleCredentialError(ctx, err))
+		}
+		if md[tags.JujuController] != controllerUUID || md[tags.JujuModel] == env.uuid {
+			continue
+		}
+		hosted = append(hosted, vm)
+	}
+	logger.Debugf(ctx, "removing %d hosted model instances", len(hosted))
+	return errors.Trace(env.HandleCredentialError(ctx, env.removeVMs(ctx, hosted)))
+}
+
+// AdoptResources updates the controller tags on all instances to have the
+// new controller id. It's part of the Environ interface.
+func (env *environ) AdoptResources(ctx context.Context, controllerUUID string, fromVersion semversion.Number) error {
+	vms, err := env.jujuVMs(ctx, env.namespace.Prefix())
+	if err != nil {
+		return errors.Annotate(env.HandleCredentialError(ctx, err), "all instances")
+	}
+
+	var failed []string
+	for _, vm := range vms {
+		if err := env.adoptVM(ctx, vm, controllerUUID); err != nil {
+			logger.Errorf(ctx, "error setting controller uuid tag for %q: %v", vm.Name, err)
+			failed = append(failed, vm.Name)
+		}
+	}
+	if len(failed) != 0 {
+		return errors.Errorf("failed to update controller for some instances: %v", failed)
+	}
+	return nil
+}
+
+func (env *environ) adoptVM(ctx context.Context, vm VM, controllerUUID string) error {
+	md, err := env.vmMetadata(ctx, vm)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	md[tags.JujuController] = controllerUUID
+	return errors.Trace(env.client().SetVMConfig(ctx, vm.Node, vm.VMID, url.Values{
+		"description": {formatDescription(md)},
+	}))
+}
@@ -0,0 +1,109 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package proxmox
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/environs"
+	"github.com/juju/juju/internal/provider/common"
+)
+
+var _ common.ZonedEnviron = (*environ)(nil)
+
+// proxmoxAvailZone is an availability zone backed by a node of the
+// cluster.
+type proxmoxAvailZone struct {
+	node Node
+}
+
+// Name returns the name of the node.
+func (z *proxmoxAvailZone) Name() string {
+	return z.node.Name
+}
+
+// Available implements common.AvailabilityZone. Only nodes that are
+// online can run new instances.
+func (z *proxmoxAvailZone) Available() bool {
+	return z.node.Online()
+}
+
+// AvailabilityZones is part of the common.ZonedEnviron interface.
+func (env *environ) AvailabilityZones(ctx context.Context) (network.AvailabilityZones, error) {
+	nodes, err := env.client().Nodes(ctx)
+	if err != nil {
+		return nil, errors.Trace(env.HandleCredentialError(ctx, err))
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].Name < nodes[j].Name
+	})
+	zones := make(network.AvailabilityZones, len(nodes))
+	for i, node := range nodes {
+		zones[i] = &proxmoxAvailZone{node: node}
+	}
+	return zones, nil
+}
+
+// InstanceAvailabilityZoneNames is part of the common.ZonedEnviron interface.
+func (env *environ) InstanceAvailabilityZoneNames(ctx context.Context, ids []instance.Id) (map[instance.Id]string, error) {
+	instances, err := env.Instances(ctx, ids)
+	if err != nil && !errors.Is(err, environs.ErrPartialInstances) {
+		return nil, err
+	}
+	results := make(map[instance.Id]string)
+	for _, inst := range instances {
+		if inst == nil {
+			continue
+		}
+		results[inst.Id()] = inst.(*environInstance).vm.Node
+	}
+	// Don't be tempted to change this err to nil, it actually bubbles up
+	// environs.ErrPartialInstances from above.
+	return results, err
+}
+
+// DeriveAvailabilityZones is part of the common.ZonedEnviron interface.
+func (env *environ) DeriveAvailabilityZones(ctx context.Context, args environs.StartInstanceParams) ([]string, error) {
+	zone, err := env.parsePlacement(ctx, args.Placement)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if zone != nil {
+		return []string{zone.Name()}, nil
+	}
+	return nil, nil
+}
+
+// parsePlacement returns the availability zone named by a "zone=<node>"
+// placement directive, or nil if there is no placement.
+func (env *environ) parsePlacement(ctx context.Context, placement string) (*proxmoxAvailZone, error) {
+	if placement == "" {
+		return nil, nil
+	}
+	key, value, ok := strings.Cut(placement, "=")
+	if !ok || key != "zone" {
+		return nil, errors.Errorf("unknown placement directive: %v", placement)
+	}
+	zone, err := env.availZone(ctx, value)
+	return zone, errors.Trace(err)
+}
+
+func (env *environ) availZone(ctx context.Context, name string) (*proxmoxAvailZone, error) {
+	zones, err := env.AvailabilityZones(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	for _, z := range zones {
+		if z.Name() == name {
+			return z.(*proxmoxAvailZone), nil
+		}
+	}
+	return nil, errors.NotFoundf("availability zone %q", name)
+}
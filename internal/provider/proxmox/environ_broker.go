@@ -0,0 +1,410 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package proxmox
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/arch"
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/core/os/ostype"
+	"github.com/juju/juju/core/status"
+	"github.com/juju/juju/environs"
+	"github.com/juju/juju/environs/tags"
+	"github.com/juju/juju/internal/cloudconfig/cloudinit"
+	"github.com/juju/juju/internal/cloudconfig/instancecfg"
+	"github.com/juju/juju/internal/cloudconfig/nocloud"
+	"github.com/juju/juju/internal/cloudconfig/providerinit"
+	"github.com/juju/juju/internal/provider/common"
+	"github.com/juju/juju/internal/tools"
+)
+
+const (
+	// defaultMemMiB and defaultCPUCores are used for instances without
+	// mem or cores constraints.
+	defaultMemMiB   = 2048
+	defaultCPUCores = 1
+
+	// seedDiskKey is the config key of the drive the cloud-init seed image
+	// is attached to, unless the template has a cloud-init drive of its
+	// own, which is replaced.
+	seedDiskKey = "ide2"
+)
+
+// rootDiskKeys are the config keys of the disks a template may boot from,
+// in order of preference.
+var rootDiskKeys = []string{"scsi0", "virtio0", "sata0", "ide0"}
+
+// StartInstance implements environs.InstanceBroker.
+func (env *environ) StartInstance(
+	ctx context.Context, args environs.StartInstanceParams,
+) (*environs.StartInstanceResult, error) {
+	logger.Debugf(ctx, "StartInstance: %q, %s", args.InstanceConfig.MachineId, args.InstanceConfig.Base)
+
+	if err := env.finishInstanceConfig(args); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	vm, hwc, err := env.newVM(ctx, args)
+	if err != nil {
+		err = env.HandleCredentialError(ctx, err)
+		if args.StatusCallback != nil {
+			_ = args.StatusCallback(ctx, status.ProvisioningError, err.Error(), nil)
+		}
+		return nil, errors.Trace(err)
+	}
+	logger.Infof(ctx, "started instance %q on node %q", vm.Name, vm.Node)
+
+	return &environs.StartInstanceResult{
+		Instance: newInstance(vm, env),
+		Hardware: hwc,
+	}, nil
+}
+
+func (env *environ) finishInstanceConfig(args environs.StartInstanceParams) error {
+	// Proxmox VE only runs on amd64 hosts.
+	matching, err := args.Tools.Match(tools.Filter{Arch: arch.AMD64})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := args.InstanceConfig.SetTools(matching); err != nil {
+		return errors.Trace(err)
+	}
+	if err := instancecfg.FinishInstanceConfig(args.InstanceConfig, env.Config()); err != nil {
+		return errors.Trace(err)
+	}
+	return nil
+}
+
+// instanceNode returns the node to create a new instance on.
+func (env *environ) instanceNode(ctx context.Context, zoneName string) (string, error) {
+	if zoneName != "" {
+		zone, err := env.availZone(ctx, zoneName)
+		if err != nil {
+			return "", errors.Trace(err)
+		}
+		if !zone.Available() {
+			return "", errors.Errorf("node %q is %s", zoneName, zone.node.Status)
+		}
+		return zone.Name(), nil
+	}
+	zones, err := env.AvailabilityZones(ctx)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	for _, zone := range zones {
+		if zone.Available() {
+			return zone.Name(), nil
+		}
+	}
+	return "", environs.ZoneIndependentError(errors.New("no online nodes"))
+}
+
+// findTemplate returns the named template, preferring a template on the
+// input node.
+func (env *environ) findTemplate(ctx context.Context, node, name string) (VM, error) {
+	vms, err := env.client().VMs(ctx)
+	if err != nil {
+		return VM{}, errors.Trace(err)
+	}
+	var (
+		found VM
+		ok    bool
+	)
+	for _, vm := range vms {
+		if vm.Template == 0 || vm.Name != name {
+			continue
+		}
+		if vm.Node == node {
+			return vm, nil
+		}
+		if !ok {
+			found, ok = vm, true
+		}
+	}
+	if !ok {
+		return VM{}, environs.ZoneIndependentError(errors.NotFoundf("template %q", name))
+	}
+	return found, nil
+}
+
+// newVM clones the template of a new instance, attaches its cloud-init
+// seed image and starts it. The virtual machine is removed again if it
+// cannot be started.
+func (env *environ) newVM(
+	ctx context.Context, args environs.StartInstanceParams,
+) (_ VM, _ *instance.HardwareCharacteristics, err error) {
+	statusCallback := func(msg string) {
+		if args.StatusCallback != nil {
+			_ = args.StatusCallback(ctx, status.Provisioning, msg, nil)
+		}
+	}
+
+	hostname, err := env.namespace.Hostname(args.InstanceConfig.MachineId)
+	if err != nil {
+		return VM{}, nil, errors.Trace(err)
+	}
+	node, err := env.instanceNode(ctx, args.AvailabilityZone)
+	if err != nil {
+		return VM{}, nil, errors.Trace(err)
+	}
+
+	ecfg := env.ecfg()
+	cons := args.Constraints
+	memMiB := uint64(defaultMemMiB)
+	if cons.HasMem() {
+		memMiB = *cons.Mem
+	}
+	cores := uint64(defaultCPUCores)
+	if cons.HasCpuCores() {
+		cores = *cons.CpuCores
+	}
+	rootDiskMiB := common.MinRootDiskSizeGiB(ostype.OSTypeForName(args.InstanceConfig.Base.OS)) * 1024
+	if cons.RootDisk != nil && *cons.RootDisk > rootDiskMiB {
+		rootDiskMiB = *cons.RootDisk
+	}
+
+	templateName := ecfg.template(args.InstanceConfig.Base.Channel.Track)
+	template, err := env.findTemplate(ctx, node, templateName)
+	if err != nil {
+		return VM{}, nil, errors.Trace(err)
+	}
+
+	seed, err := env.seedImage(ctx, hostname, args)
+	if err != nil {
+		return VM{}, nil, environs.ZoneIndependentError(err)
+	}
+
+	statusCallback("Cloning template")
+	vm, err := env.cloneTemplate(ctx, template, node, hostname)
+	if err != nil {
+		return VM{}, nil, errors.Annotatef(err, "cloning template %q", templateName)
+	}
+	defer func() {
+		if err != nil {
+			if err := env.removeVMs(ctx, []VM{vm}); err != nil {
+				logger.Warningf(ctx, "failed to remove instance %q: %v", vm.Name, err)
+			}
+		}
+	}()
+
+	c := env.client()
+	seedFile := seedFilename(hostname)
+	if err := c.UploadISO(ctx, node, ecfg.isoStorage(), seedFile, seed); err != nil {
+		return VM{}, nil, errors.Annotate(err, "uploading cloud-init seed image")
+	}
+
+	config, err := c.VMConfig(ctx, node, vm.VMID)
+	if err != nil {
+		return VM{}, nil, errors.Trace(err)
+	}
+	rootDisk, rootDiskSize, err := templateRootDisk(config)
+	if err != nil {
+		return VM{}, nil, environs.ZoneIndependentError(errors.Annotatef(err, "template %q", templateName))
+	}
+
+	md := make(map[string]string)
+	for k, v := range args.InstanceConfig.Tags {
+		if strings.HasPrefix(k, tags.JujuTagPrefix) {
+			md[k] = v
+		}
+	}
+	params := url.Values{
+		"memory":      {strconv.FormatUint(memMiB, 10)},
+		"cores":       {strconv.FormatUint(cores, 10)},
+		"agent":       {"1"},
+		"net0":        {"virtio,bridge=" + ecfg.bridge()},
+		"description": {formatDescription(md)},
+		"tags":        {jujuTag},
+	}
+	params.Set(cloudInitDiskKey(config), fmt.Sprintf("%s:iso/%s,media=cdrom", ecfg.isoStorage(), seedFile))
+	if err := c.SetVMConfig(ctx, node, vm.VMID, params); err != nil {
+		return VM{}, nil, errors.Annotate(err, "configuring instance")
+	}
+
+	if rootDiskSize < rootDiskMiB {
+		if err := c.ResizeDisk(ctx, node, vm.VMID, rootDisk, rootDiskMiB); err != nil {
+			return VM{}, nil, errors.Annotate(err, "resizing root disk")
+		}
+	} else {
+		rootDiskMiB = rootDiskSize
+	}
+
+	statusCallback("Starting instance")
+	if err := c.StartVM(ctx, node, vm.VMID); err != nil {
+		return VM{}, nil, errors.Annotatef(err, "starting instance %q", hostname)
+	}
+	vm.Status = "running"
+
+	instArch := arch.AMD64
+	return vm, &instance.HardwareCharacteristics{
+		Arch:             &instArch,
+		Mem:              &memMiB,
+		CpuCores:         &cores,
+		RootDisk:         &rootDiskMiB,
+		AvailabilityZone: &node,
+	}, nil
+}
+
+// cloneTemplate creates a full clone of the template on the input node.
+func (env *environ) cloneTemplate(ctx context.Context, template VM, node, hostname string) (VM, error) {
+	env.cloneLock.Lock()
+	defer env.cloneLock.Unlock()
+
+	c := env.client()
+	vmid, err := c.NextID(ctx)
+	if err != nil {
+		return VM{}, errors.Trace(err)
+	}
+	err = c.CloneVM(ctx, template.Node, template.VMID, url.Values{
+		"newid":   {strconv.Itoa(vmid)},
+		"name":    {hostname},
+		"target":  {node},
+		"full":    {"1"},
+		"storage": {env.ecfg().storage()},
+	})
+	if err != nil {
+		return VM{}, errors.Trace(err)
+	}
+	return VM{
+		VMID:   vmid,
+		Name:   hostname,
+		Node:   node,
+		Type:   "qemu",
+		Status: "stopped",
+	}, nil
+}
+
+// seedImage returns the cloud-init NoCloud seed image for a new instance.
+// The QEMU guest agent is installed, so that the addresses of the instance
+// can be reported.
+func (env *environ) seedImage(ctx context.Context, hostname string, args environs.StartInstanceParams) ([]byte, error) {
+	cloudCfg, err := cloudinit.New(args.InstanceConfig.Base.OS)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	cloudCfg.AddPackage("qemu-guest-agent")
+	cloudCfg.AddRunCmd("systemctl start qemu-guest-agent")
+	userData, err := providerinit.ComposeUserData(args.InstanceConfig, cloudCfg, proxmoxRenderer{})
+	if err != nil {
+		return nil, errors.Annotate(err, "composing user data")
+	}
+	logger.Debugf(ctx, "proxmox user data; %d bytes", len(userData))
+
+	metaData := fmt.Sprintf("instance-id: %s\nlocal-hostname: %s\n", hostname, hostname)
+	seed, err := nocloud.SeedImage(userData, []byte(metaData))
+	return seed, errors.Annotate(err, "creating cloud-init seed image")
+}
+
+// seedFilename returns the name of the cloud-init seed image of an
+// instance.
+func seedFilename(hostname string) string {
+	return hostname + "-seed.iso"
+}
+
+// templateRootDisk returns the config key and size in MiB of the disk that
+// a clone of a template boots from.
+func templateRootDisk(config VMConfig) (string, uint64, error) {
+	for _, key := range rootDiskKeys {
+		disk, ok := config[key]
+		if !ok || strings.Contains(disk, "media=cdrom") {
+			continue
+		}
+		size, err := diskSizeMiB(disk)
+		if err != nil {
+			return "", 0, errors.Annotatef(err, "disk %s", key)
+		}
+		return key, size, nil
+	}
+	return "", 0, errors.NotFoundf("root disk")
+}
+
+// cloudInitDiskKey returns the key of the cloud-init drive in the config
+// of a virtual machine, or the default key of the seed image drive.
+func cloudInitDiskKey(config VMConfig) string {
+	for key, value := range config {
+		volume, _, _ := strings.Cut(value, ",")
+		if strings.HasSuffix(volume, ":cloudinit") || strings.Contains(volume, "-cloudinit") {
+			return key
+		}
+	}
+	return seedDiskKey
+}
+
+// diskSizeMiB returns the size recorded in the config of a disk, for
+// example "local-lvm:vm-100-disk-0,size=3584M".
+func diskSizeMiB(disk string) (uint64, error) {
+	for _, opt := range strings.Split(disk, ",") {
+		value, ok := strings.CutPrefix(opt, "size=")
+		if !ok {
+			continue
+		}
+		multiplier := map[byte]float64{'K': 1.0 / 1024, 'M': 1, 'G': 1024, 'T': 1024 * 1024}
+		unit := byte('M')
+		if n := len(value); n > 0 && multiplier[value[n-1]] != 0 {
+			unit, value = value[n-1], value[:n-1]
+		}
+		size, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return 0, errors.NotValidf("size %q", opt)
+		}
+		return uint64(size * multiplier[unit]), nil
+	}
+	return 0, errors.NotFoundf("size")
+}
+
+// StopInstances implements environs.InstanceBroker.
+func (env *environ) StopInstances(ctx context.Context, ids ...instance.Id) error {
+	prefix := env.namespace.Prefix()
+	owned := make(map[instance.Id]bool)
+	for _, id := range ids {
+		if strings.HasPrefix(string(id), prefix) {
+			owned[id] = true
+		} else {
+			logger.Warningf(ctx, "ignoring request to stop instance %q - not in namespace %q", id, prefix)
+		}
+	}
+	if len(owned) == 0 {
+		return nil
+	}
+	vms, err := env.jujuVMs(ctx, prefix)
+	if err != nil {
+		return errors.Trace(env.HandleCredentialError(ctx, err))
+	}
+	var remove []VM
+	for _, vm := range vms {
+		if owned[instance.Id(vm.Name)] {
+			remove = append(remove, vm)
+		}
+	}
+	return errors.Trace(env.HandleCredentialError(ctx, env.removeVMs(ctx, remove)))
+}
+
+// removeVMs stops and destroys the input virtual machines, along with
+// their disks and cloud-init seed images.
+func (env *environ) removeVMs(ctx context.Context, vms []VM) error {
+	c := env.client()
+	for _, vm := range vms {
+		if vm.Status != "stopped" {
+			if err := c.StopVM(ctx, vm.Node, vm.VMID); err != nil && !isNotFound(err) {
+				return errors.Annotatef(err, "stopping instance %q", vm.Name)
+			}
+		}
+		if err := c.DeleteVM(ctx, vm.Node, vm.VMID); err != nil && !isNotFound(err) {
+			return errors.Annotatef(err, "destroying instance %q", vm.Name)
+		}
+		isoStorage := env.ecfg().isoStorage()
+		volid := fmt.Sprintf("%s:iso/%s", isoStorage, seedFilename(vm.Name))
+		if err := c.DeleteVolume(ctx, vm.Node, isoStorage, volid); err != nil && !isNotFound(err) {
+			logger.Warningf(ctx, "failed to delete seed image %q of instance %q: %v", volid, vm.Name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,300 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package proxmox
+
+import (
+	"context"
+	"net/http"
+	stdtesting "testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/arch"
+	corebase "github.com/juju/juju/core/base"
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/core/semversion"
+	"github.com/juju/juju/core/status"
+	"github.com/juju/juju/environs"
+	"github.com/juju/juju/environs/tags"
+	"github.com/juju/juju/internal/cloudconfig/instancecfg"
+	"github.com/juju/juju/internal/testhelpers"
+	coretesting "github.com/juju/juju/internal/testing"
+	coretools "github.com/juju/juju/internal/tools"
+)
+
+type environBrokerSuite struct {
+	environFixture
+
+	statusCallbackStub testhelpers.Stub
+}
+
+func TestEnvironBrokerSuite(t *stdtesting.T) {
+	tc.Run(t, &environBrokerSuite{})
+}
+
+func (s *environBrokerSuite) SetUpTest(c *tc.C) {
+	s.environFixture.SetUpTest(c)
+	s.statusCallbackStub.ResetCalls()
+}
+
+func (s *environBrokerSuite) createStartInstanceArgs(c *tc.C) environs.StartInstanceParams {
+	var cons constraints.Value
+	instanceConfig, err := instancecfg.NewBootstrapInstanceConfig(
+		coretesting.FakeControllerConfig(), cons, cons, corebase.MakeDefaultBase("ubuntu", "24.04"), "", nil,
+	)
+	c.Assert(err, tc.ErrorIsNil)
+	instanceConfig.AuthorizedKeys = fakeConfig(c).AuthorizedKeys()
+	instanceConfig.Tags = map[string]string{
+		tags.JujuController:   coretesting.ControllerTag.Id(),
+		tags.JujuModel:        fakeModelUUID,
+		tags.JujuIsController: "true",
+		"owner":               "not-recorded",
+	}
+
+	tools := coretools.List{{
+		Version: semversion.Binary{
+			Number:  semversion.MustParse("1.2.3"),
+			Arch:    arch.AMD64,
+			Release: "ubuntu",
+		},
+		URL: "https://example.org",
+	}}
+
+	return environs.StartInstanceParams{
+		ControllerUUID: instanceConfig.ControllerConfig.ControllerUUID(),
+		InstanceConfig: instanceConfig,
+		Tools:          tools,
+		Constraints:    cons,
+		StatusCallback: func(ctx context.Context, status status.Status, info string, data map[string]interface{}) error {
+			s.statusCallbackStub.AddCall("StatusCallback", status, info, data)
+			return s.statusCallbackStub.NextErr()
+		},
+	}
+}
+
+func (s *environBrokerSuite) TestStartInstance(c *tc.C) {
+	result, err := s.env.StartInstance(c.Context(), s.createStartInstanceArgs(c))
+	c.Assert(err, tc.ErrorIsNil)
+
+	hostname := "juju-f75cba-0"
+	c.Check(result.Instance.Id(), tc.Equals, instance.Id(hostname))
+	c.Check(result.Instance.Status(c.Context()).Status, tc.Equals, status.Running)
+
+	arch, mem, cores, rootDisk, zone := "amd64", uint64(2048), uint64(1), uint64(8192), "pve1"
+	c.Check(result.Hardware, tc.DeepEquals, &instance.HardwareCharacteristics{
+		Arch:             &arch,
+		Mem:              &mem,
+		CpuCores:         &cores,
+		RootDisk:         &rootDisk,
+		AvailabilityZone: &zone,
+	})
+
+	vm, ok := s.server.vm(hostname)
+	c.Assert(ok, tc.IsTrue)
+	c.Check(vm.VMID, tc.Equals, 100)
+	c.Check(vm.Node, tc.Equals, "pve1")
+	c.Check(vm.Status, tc.Equals, "running")
+	c.Check(vm.config["memory"], tc.Equals, "2048")
+	c.Check(vm.config["cores"], tc.Equals, "1")
+	c.Check(vm.config["agent"], tc.Equals, "1")
+	c.Check(vm.config["tags"], tc.Equals, "juju")
+	c.Check(vm.config["net0"], tc.Equals, "virtio,bridge=vmbr0")
+	c.Check(vm.config["scsi0"], tc.Equals, "local-lvm:vm-100-disk-0,size=8192M")
+	// The cloud-init drive of the template is replaced by the seed image.
+	c.Check(vm.config["ide2"], tc.Equals, "local:iso/"+hostname+"-seed.iso,media=cdrom")
+	c.Check(s.server.uploads["pve1/local:iso/"+hostname+"-seed.iso"], tc.Not(tc.HasLen), 0)
+
+	md := parseDescription(vm.config["description"])
+	c.Check(md, tc.DeepEquals, map[string]string{
+		tags.JujuController:   coretesting.ControllerTag.Id(),
+		tags.JujuModel:        fakeModelUUID,
+		tags.JujuIsController: "true",
+	})
+
+	s.statusCallbackStub.CheckCallNames(c, "StatusCallback", "StatusCallback")
+}
+
+func (s *environBrokerSuite) TestStartInstanceConstraints(c *tc.C) {
+	args := s.createStartInstanceArgs(c)
+	args.Constraints = constraints.MustParse("mem=4G cores=2 root-disk=20G")
+
+	result, err := s.env.StartInstance(c.Context(), args)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(*result.Hardware.Mem, tc.Equals, uint64(4096))
+	c.Check(*result.Hardware.CpuCores, tc.Equals, uint64(2))
+	c.Check(*result.Hardware.RootDisk, tc.Equals, uint64(20480))
+
+	vm, _ := s.server.vm("juju-f75cba-0")
+	c.Check(vm.config["memory"], tc.Equals, "4096")
+	c.Check(vm.config["cores"], tc.Equals, "2")
+	c.Check(vm.config["scsi0"], tc.Equals, "local-lvm:vm-100-disk-0,size=20480M")
+}
+
+func (s *environBrokerSuite) TestStartInstanceAvailabilityZone(c *tc.C) {
+	args := s.createStartInstanceArgs(c)
+	args.AvailabilityZone = "pve2"
+
+	result, err := s.env.StartInstance(c.Context(), args)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(*result.Hardware.AvailabilityZone, tc.Equals, "pve2")
+
+	// The template on pve1 is cloned to pve2.
+	vm, _ := s.server.vm("juju-f75cba-0")
+	c.Check(vm.Node, tc.Equals, "pve2")
+	c.Check(s.server.requestsMatching("POST /nodes/pve1/qemu/9000/clone"), tc.HasLen, 1)
+	c.Check(s.server.uploads["pve2/local:iso/juju-f75cba-0-seed.iso"], tc.Not(tc.HasLen), 0)
+}
+
+func (s *environBrokerSuite) TestStartInstanceOfflineZone(c *tc.C) {
+	args := s.createStartInstanceArgs(c)
+	args.AvailabilityZone = "pve3"
+
+	_, err := s.env.StartInstance(c.Context(), args)
+	c.Assert(err, tc.ErrorMatches, `node "pve3" is offline`)
+	c.Check(errors.Is(err, environs.ErrAvailabilityZoneIndependent), tc.IsFalse)
+}
+
+func (s *environBrokerSuite) TestStartInstanceNoTemplate(c *tc.C) {
+	args := s.createStartInstanceArgs(c)
+	args.InstanceConfig.Base = corebase.MakeDefaultBase("ubuntu", "22.04")
+
+	_, err := s.env.StartInstance(c.Context(), args)
+	c.Assert(err, tc.ErrorMatches, `template "ubuntu-22.04-cloudimg" not found`)
+	c.Check(err, tc.ErrorIs, environs.ErrAvailabilityZoneIndependent)
+}
+
+func (s *environBrokerSuite) TestStartInstanceCleansUpOnFailure(c *tc.C) {
+	s.server.fail(http.MethodPost, "/nodes/pve1/qemu/100/status/start", http.StatusInternalServerError)
+
+	_, err := s.env.StartInstance(c.Context(), s.createStartInstanceArgs(c))
+	c.Assert(err, tc.ErrorMatches, `starting instance "juju-f75cba-0": 500 injected failure`)
+
+	_, ok := s.server.vm("juju-f75cba-0")
+	c.Check(ok, tc.IsFalse)
+	c.Check(s.server.volumes["pve1/local"], tc.HasLen, 0)
+	s.statusCallbackStub.CheckCall(c, 2, "StatusCallback", status.ProvisioningError,
+		`starting instance "juju-f75cba-0": 500 injected failure`, map[string]interface{}(nil))
+}
+
+func (s *environBrokerSuite) TestStopInstances(c *tc.C) {
+	_, err := s.env.StartInstance(c.Context(), s.createStartInstanceArgs(c))
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = s.env.StopInstances(c.Context(), "juju-f75cba-0", "juju-other-0")
+	c.Assert(err, tc.ErrorIsNil)
+	_, ok := s.server.vm("juju-f75cba-0")
+	c.Check(ok, tc.IsFalse)
+	c.Check(s.server.volumes["pve1/local"], tc.HasLen, 0)
+	c.Check(s.server.requestsMatching("POST /nodes/pve1/qemu/100/status/stop"), tc.HasLen, 1)
+
+	// Stopping an instance that is gone is not an error.
+	err = s.env.StopInstances(c.Context(), "juju-f75cba-0")
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *environBrokerSuite) TestInstances(c *tc.C) {
+	_, err := s.env.StartInstance(c.Context(), s.createStartInstanceArgs(c))
+	c.Assert(err, tc.ErrorIsNil)
+
+	insts, err := s.env.Instances(c.Context(), []instance.Id{"juju-f75cba-0", "juju-f75cba-1"})
+	c.Assert(err, tc.ErrorIs, environs.ErrPartialInstances)
+	c.Assert(insts, tc.HasLen, 2)
+	c.Check(insts[0].Id(), tc.Equals, instance.Id("juju-f75cba-0"))
+	c.Check(insts[1], tc.IsNil)
+
+	_, err = s.env.Instances(c.Context(), []instance.Id{"juju-f75cba-1"})
+	c.Assert(err, tc.ErrorIs, environs.ErrNoInstances)
+}
+
+func (s *environBrokerSuite) TestAllInstancesExcludesTemplatesAndOtherModels(c *tc.C) {
+	_, err := s.env.StartInstance(c.Context(), s.createStartInstanceArgs(c))
+	c.Assert(err, tc.ErrorIsNil)
+	s.server.addVM(VM{VMID: 200, Name: "juju-123456-0", Node: "pve2"}, nil)
+	s.server.addVM(VM{VMID: 201, Name: "juju-f75cba-1", Node: "pve2", Template: 1}, nil)
+
+	insts, err := s.env.AllInstances(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(insts, tc.HasLen, 1)
+	c.Check(insts[0].Id(), tc.Equals, instance.Id("juju-f75cba-0"))
+
+	zones, err := s.env.InstanceAvailabilityZoneNames(c.Context(), []instance.Id{"juju-f75cba-0"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(zones, tc.DeepEquals, map[instance.Id]string{"juju-f75cba-0": "pve1"})
+}
+
+func (s *environBrokerSuite) TestControllerInstances(c *tc.C) {
+	_, err := s.env.StartInstance(c.Context(), s.createStartInstanceArgs(c))
+	c.Assert(err, tc.ErrorIsNil)
+
+	ids, err := s.env.ControllerInstances(c.Context(), coretesting.ControllerTag.Id())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(ids, tc.DeepEquals, []instance.Id{"juju-f75cba-0"})
+
+	_, err = s.env.ControllerInstances(c.Context(), "other-controller")
+	c.Check(err, tc.ErrorIs, environs.ErrNotBootstrapped)
+}
+
+func (s *environBrokerSuite) TestDestroyControllerRemovesHostedModels(c *tc.C) {
+	_, err := s.env.StartInstance(c.Context(), s.createStartInstanceArgs(c))
+	c.Assert(err, tc.ErrorIsNil)
+	s.server.addVM(VM{VMID: 200, Name: "juju-123456-0", Node: "pve2", Status: "running"}, VMConfig{
+		"description": formatDescription(map[string]string{
+			tags.JujuController: coretesting.ControllerTag.Id(),
+			tags.JujuModel:      "hosted-model",
+		}),
+	})
+	s.server.addVM(VM{VMID: 201, Name: "juju-abcdef-0", Node: "pve2"}, VMConfig{
+		"description": formatDescription(map[string]string{
+			tags.JujuController: "other-controller",
+		}),
+	})
+
+	err = s.env.DestroyController(c.Context(), coretesting.ControllerTag.Id())
+	c.Assert(err, tc.ErrorIsNil)
+	for name, exists := range map[string]bool{
+		"juju-f75cba-0": false,
+		"juju-123456-0": false,
+		"juju-abcdef-0": true,
+	} {
+		_, ok := s.server.vm(name)
+		c.Check(ok, tc.Equals, exists, tc.Commentf("%s", name))
+	}
+}
+
+func (s *environBrokerSuite) TestAdoptResources(c *tc.C) {
+	_, err := s.env.StartInstance(c.Context(), s.createStartInstanceArgs(c))
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = s.env.AdoptResources(c.Context(), "new-controller", semversion.MustParse("1.2.3"))
+	c.Assert(err, tc.ErrorIsNil)
+	vm, _ := s.server.vm("juju-f75cba-0")
+	md := parseDescription(vm.config["description"])
+	c.Check(md[tags.JujuController], tc.Equals, "new-controller")
+	c.Check(md[tags.JujuModel], tc.Equals, fakeModelUUID)
+}
+
+func (s *environBrokerSuite) TestInstanceAddresses(c *tc.C) {
+	_, err := s.env.StartInstance(c.Context(), s.createStartInstanceArgs(c))
+	c.Assert(err, tc.ErrorIsNil)
+
+	insts, err := s.env.AllInstances(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(insts, tc.HasLen, 1)
+
+	// There are no addresses until the guest agent is running.
+	addrs, err := insts[0].Addresses(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(addrs, tc.HasLen, 0)
+
+	s.server.agent[100] = []AgentInterface{
+		fakeAgentInterface("lo", "00:00:00:00:00:00", "127.0.0.1/8"),
+		fakeAgentInterface("ens18", "bc:24:11:aa:bb:cc", "10.0.0.10/24", "fe80::1/64"),
+	}
+	addrs, err = insts[0].Addresses(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(addrs, tc.HasLen, 1)
+	c.Check(addrs[0].Value, tc.Equals, "10.0.0.10")
+}
@@ -0,0 +1,150 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package proxmox
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/environs"
+	"github.com/juju/juju/environs/instances"
+	"github.com/juju/juju/environs/tags"
+)
+
+// Instances returns the available instances in the environment that
+// match the provided instance IDs. For IDs that did not match any
+// instances, the result at the corresponding index will be nil. In that
+// case the error will be environs.ErrPartialInstances (or
+// ErrNoInstances if none of the IDs match an instance).
+func (env *environ) Instances(ctx context.Context, ids []instance.Id) ([]instances.Instance, error) {
+	if len(ids) == 0 {
+		return nil, environs.ErrNoInstances
+	}
+
+	all, err := env.allInstances(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	byID := make(map[instance.Id]*environInstance, len(all))
+	for _, inst := range all {
+		byID[inst.Id()] = inst
+	}
+
+	numFound := 0
+	results := make([]instances.Instance, len(ids))
+	for i, id := range ids {
+		if inst, ok := byID[id]; ok {
+			results[i] = inst
+			numFound++
+		}
+	}
+	if numFound == 0 {
+		return nil, environs.ErrNoInstances
+	} else if numFound != len(ids) {
+		return results, environs.ErrPartialInstances
+	}
+	return results, nil
+}
+
+// jujuVMs returns the virtual machines, excluding templates, whose names
+// have the input prefix.
+func (env *environ) jujuVMs(ctx context.Context, prefix string) ([]VM, error) {
+	vms, err := env.client().VMs(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var results []VM
+	for _, vm := range vms {
+		if vm.Template == 0 && strings.HasPrefix(vm.Name, prefix) {
+			results = append(results, vm)
+		}
+	}
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Name < results[j].Name
+	})
+	return results, nil
+}
+
+// allInstances returns the instances of the model. Virtual machines are
+// matched on the "juju-<model-UUID>-" name prefix, which isolates multiple
+// models sharing the same cluster.
+func (env *environ) allInstances(ctx context.Context) ([]*environInstance, error) {
+	vms, err := env.jujuVMs(ctx, env.namespace.Prefix())
+	if err != nil {
+		return nil, errors.Trace(env.HandleCredentialError(ctx, err))
+	}
+	results := make([]*environInstance, len(vms))
+	for i, vm := range vms {
+		results[i] = newInstance(vm, env)
+	}
+	return results, nil
+}
+
+// AllInstances implements environs.InstanceBroker.
+func (env *environ) AllInstances(ctx context.Context) ([]instances.Instance, error) {
+	all, err := env.allInstances(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	results := make([]instances.Instance, len(all))
+	for i, inst := range all {
+		results[i] = inst
+	}
+	return results, nil
+}
+
+// AllRunningInstances implements environs.InstanceBroker.
+func (env *environ) AllRunningInstances(ctx context.Context) ([]instances.Instance, error) {
+	all, err := env.allInstances(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var results []instances.Instance
+	for _, inst := range all {
+		if inst.vm.Status != "stopped" {
+			results = append(results, inst)
+		}
+	}
+	return results, nil
+}
+
+// ControllerInstances returns the IDs of the instances corresponding
+// to juju controllers.
+func (env *environ) ControllerInstances(ctx context.Context, controllerUUID string) ([]instance.Id, error) {
+	vms, err := env.jujuVMs(ctx, "juju-")
+	if err != nil {
+		return nil, errors.Trace(env.HandleCredentialError(ctx, err))
+	}
+
+	var results []instance.Id
+	for _, vm := range vms {
+		md, err := env.vmMetadata(ctx, vm)
+		if isNotFound(err) {
+			// The virtual machine was removed since it was listed.
+			continue
+		} else if err != nil {
+			return nil, errors.Trace(env.HandleCredentialError(ctx, err))
+		}
+		if md[tags.JujuController] != controllerUUID {
+			continue
+		}
+		if md[tags.JujuIsController] == "true" {
+			results = append(results, instance.Id(vm.Name))
+		}
+	}
+	if len(results) == 0 {
+		return nil, environs.ErrNotBootstrapped
+	}
+	return results, nil
+}
+
+// InstanceTypes implements environs.InstanceTypesFetcher.
+func (env *environ) InstanceTypes(context.Context, constraints.Value) (instances.InstanceTypesWithCostMetadata, error) {
+	return instances.InstanceTypesWithCostMetadata{}, errors.NotSupportedf("InstanceTypes")
+}
@@ -0,0 +1,195 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package proxmox
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/environs"
+)
+
+var _ environs.Networking = (*environ)(nil)
+
+// Subnets returns basic information about the subnets of the bridges of
+// the online nodes. A bridge with the same CIDR on several nodes is
+// reported once, with the nodes as its availability zones.
+func (env *environ) Subnets(ctx context.Context, subnetIDs []network.Id) ([]network.SubnetInfo, error) {
+	zones, err := env.AvailabilityZones(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var keep set.Strings
+	if len(subnetIDs) != 0 {
+		keep = set.NewStrings()
+		for _, id := range subnetIDs {
+			keep.Add(string(id))
+		}
+	}
+
+	var subnets []network.SubnetInfo
+	byID := make(map[network.Id]int)
+	for _, zone := range zones {
+		if !zone.Available() {
+			continue
+		}
+		bridges, err := env.client().NodeNetworks(ctx, zone.Name())
+		if err != nil {
+			return nil, errors.Trace(env.HandleCredentialError(ctx, err))
+		}
+		for _, bridge := range bridges {
+			for _, addr := range []string{bridge.CIDR, bridge.CIDR6} {
+				if addr == "" {
+					continue
+				}
+				_, ipNet, err := net.ParseCIDR(addr)
+				if err != nil {
+					logger.Warningf(ctx, "ignoring bridge %q on node %q: %v", bridge.Iface, zone.Name(), err)
+					continue
+				}
+				cidr := ipNet.String()
+				id := makeSubnetID(bridge.Iface, cidr)
+				if keep != nil && !keep.Contains(string(id)) {
+					continue
+				}
+				if i, ok := byID[id]; ok {
+					subnets[i].AvailabilityZones = append(subnets[i].AvailabilityZones, zone.Name())
+					continue
+				}
+				byID[id] = len(subnets)
+				subnets = append(subnets, network.SubnetInfo{
+					ProviderId:        id,
+					ProviderNetworkId: makeNetworkID(bridge.Iface),
+					CIDR:              cidr,
+					AvailabilityZones: []string{zone.Name()},
+				})
+			}
+		}
+	}
+	return subnets, nil
+}
+
+func makeNetworkID(bridge string) network.Id {
+	return network.Id(fmt.Sprintf("net-%s", bridge))
+}
+
+func makeSubnetID(bridge, cidr string) network.Id {
+	return network.Id(fmt.Sprintf("subnet-%s-%s", bridge, cidr))
+}
+
+// vmNIC is a network device in the config of a virtual machine, for
+// example "net0: virtio=BC:24:11:2E:61:0C,bridge=vmbr0".
+type vmNIC struct {
+	index  int
+	mac    string
+	bridge string
+}
+
+// vmNICs returns the network devices of a virtual machine, ordered by
+// index.
+func vmNICs(config VMConfig) []vmNIC {
+	var nics []vmNIC
+	for key, value := range config {
+		index, err := strconv.Atoi(strings.TrimPrefix(key, "net"))
+		if !strings.HasPrefix(key, "net") || err != nil {
+			continue
+		}
+		nic := vmNIC{index: index}
+		for i, opt := range strings.Split(value, ",") {
+			k, v, _ := strings.Cut(opt, "=")
+			switch {
+			case i == 0:
+				// The first option is the model of the device, with
+				// its MAC address.
+				nic.mac = strings.ToLower(v)
+			case k == "bridge":
+				nic.bridge = v
+			}
+		}
+		nics = append(nics, nic)
+	}
+	sort.Slice(nics, func(i, j int) bool {
+		return nics[i].index < nics[j].index
+	})
+	return nics
+}
+
+// NetworkInterfaces returns a slice with the network interfaces that
+// correspond to the given instance IDs. If no instances where found, but there
+// was no other error, it will return ErrNoInstances. If some but not all of
+// the instances were found, the returned slice will have some nil slots, and
+// an ErrPartialInstances error will be returned.
+func (env *environ) NetworkInterfaces(ctx context.Context, ids []instance.Id) ([]network.InterfaceInfos, error) {
+	insts, err := env.Instances(ctx, ids)
+	if err != nil && !errors.Is(err, environs.ErrPartialInstances) {
+		return nil, err
+	}
+	partialErr := err
+
+	res := make([]network.InterfaceInfos, len(ids))
+	for i, inst := range insts {
+		if inst == nil {
+			continue
+		}
+		vm := inst.(*environInstance).vm
+		config, err := env.client().VMConfig(ctx, vm.Node, vm.VMID)
+		if err != nil {
+			return nil, errors.Annotatef(env.HandleCredentialError(ctx, err),
+				"retrieving network interface info for instance %q", vm.Name)
+		}
+		guestIfaces, err := env.agentInterfaces(ctx, vm)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		for _, nic := range vmNICs(config) {
+			ni := network.InterfaceInfo{
+				DeviceIndex:         nic.index,
+				MACAddress:          nic.mac,
+				ParentInterfaceName: nic.bridge,
+				InterfaceType:       network.EthernetDevice,
+				Origin:              network.OriginProvider,
+				ProviderId:          network.Id(fmt.Sprintf("nic-%s", nic.mac)),
+			}
+			for _, iface := range guestIfaces {
+				if !strings.EqualFold(iface.MACAddress, nic.mac) {
+					continue
+				}
+				ni.InterfaceName = iface.Name
+				for _, addr := range iface.IPAddresses {
+					ip := net.ParseIP(addr.Address)
+					if ip == nil || ip.IsLinkLocalUnicast() {
+						continue
+					}
+					bits := 128
+					if ip4 := ip.To4(); ip4 != nil {
+						ip, bits = ip4, 32
+					}
+					mask := net.CIDRMask(addr.Prefix, bits)
+					cidr := (&net.IPNet{IP: ip.Mask(mask), Mask: mask}).String()
+					ni.Addresses = append(ni.Addresses, network.NewMachineAddress(addr.Address,
+						network.WithCIDR(cidr),
+						network.WithConfigType(network.ConfigDHCP),
+					).AsProviderAddress(network.WithProviderSubnetID(makeSubnetID(nic.bridge, cidr))))
+				}
+			}
+			res[i] = append(res[i], ni)
+		}
+	}
+	return res, partialErr
+}
+
+// SupportsSpaces is specified on environs.Networking.
+func (env *environ) SupportsSpaces() (bool, error) {
+	return false, nil
+}
@@ -0,0 +1,125 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package proxmox
+
+import (
+	stdtesting "testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/environs"
+)
+
+type environNetworkSuite struct {
+	environFixture
+}
+
+func TestEnvironNetworkSuite(t *stdtesting.T) {
+	tc.Run(t, &environNetworkSuite{})
+}
+
+func (s *environNetworkSuite) TestAvailabilityZones(c *tc.C) {
+	zones, err := s.env.AvailabilityZones(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(zones, tc.HasLen, 3)
+	for i, expected := range []struct {
+		name      string
+		available bool
+	}{{"pve1", true}, {"pve2", true}, {"pve3", false}} {
+		c.Check(zones[i].Name(), tc.Equals, expected.name)
+		c.Check(zones[i].Available(), tc.Equals, expected.available)
+	}
+}
+
+func (s *environNetworkSuite) TestDeriveAvailabilityZones(c *tc.C) {
+	zones, err := s.env.DeriveAvailabilityZones(c.Context(), environs.StartInstanceParams{Placement: "zone=pve2"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(zones, tc.DeepEquals, []string{"pve2"})
+
+	_, err = s.env.DeriveAvailabilityZones(c.Context(), environs.StartInstanceParams{Placement: "zone=pve9"})
+	c.Check(err, tc.ErrorIs, errors.NotFound)
+
+	_, err = s.env.DeriveAvailabilityZones(c.Context(), environs.StartInstanceParams{Placement: "node=pve2"})
+	c.Check(err, tc.ErrorMatches, `unknown placement directive: node=pve2`)
+}
+
+func (s *environNetworkSuite) TestPrecheckInstance(c *tc.C) {
+	err := s.env.PrecheckInstance(c.Context(), environs.PrecheckInstanceParams{
+		Placement:   "zone=pve1",
+		Constraints: constraints.MustParse("zones=pve1,pve2"),
+	})
+	c.Check(err, tc.ErrorIsNil)
+
+	err = s.env.PrecheckInstance(c.Context(), environs.PrecheckInstanceParams{
+		Placement:   "zone=pve1",
+		Constraints: constraints.MustParse("zones=pve2"),
+	})
+	c.Check(err, tc.ErrorMatches, `placement zone "pve1" is not in the zones constraint .*`)
+
+	err = s.env.PrecheckInstance(c.Context(), environs.PrecheckInstanceParams{
+		Constraints: constraints.MustParse("zones=pve9"),
+	})
+	c.Check(err, tc.ErrorIs, errors.NotFound)
+}
+
+func (s *environNetworkSuite) TestConstraintsValidator(c *tc.C) {
+	validator, err := s.env.ConstraintsValidator(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+
+	unsupported, err := validator.Validate(constraints.MustParse("arch=amd64 mem=4G tags=foo"))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(unsupported, tc.DeepEquals, []string{"tags"})
+
+	_, err = validator.Validate(constraints.MustParse("arch=arm64"))
+	c.Check(err, tc.ErrorMatches, `invalid constraint value: arch=arm64\nvalid values are: amd64`)
+}
+
+func (s *environNetworkSuite) TestSubnets(c *tc.C) {
+	subnets, err := s.env.Subnets(c.Context(), nil)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(subnets, tc.DeepEquals, []network.SubnetInfo{{
+		ProviderId:        "subnet-vmbr0-10.0.0.0/24",
+		ProviderNetworkId: "net-vmbr0",
+		CIDR:              "10.0.0.0/24",
+		AvailabilityZones: []string{"pve1", "pve2"},
+	}})
+
+	subnets, err = s.env.Subnets(c.Context(), []network.Id{"subnet-vmbr1-10.1.0.0/24"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(subnets, tc.HasLen, 0)
+}
+
+func (s *environNetworkSuite) TestNetworkInterfaces(c *tc.C) {
+	s.server.addVM(VM{VMID: 100, Name: "juju-f75cba-0", Node: "pve1", Status: "running"}, VMConfig{
+		"net0": "virtio=BC:24:11:AA:BB:CC,bridge=vmbr0",
+		"net1": "virtio=BC:24:11:AA:BB:DD,bridge=vmbr1,firewall=1",
+	})
+	s.server.agent[100] = []AgentInterface{
+		fakeAgentInterface("ens18", "bc:24:11:aa:bb:cc", "10.0.0.10/24"),
+	}
+
+	infos, err := s.env.NetworkInterfaces(c.Context(), []instance.Id{"juju-f75cba-0", "juju-f75cba-1"})
+	c.Assert(err, tc.ErrorIs, environs.ErrPartialInstances)
+	c.Assert(infos, tc.HasLen, 2)
+	c.Check(infos[1], tc.IsNil)
+	c.Assert(infos[0], tc.HasLen, 2)
+
+	nic := infos[0][0]
+	c.Check(nic.DeviceIndex, tc.Equals, 0)
+	c.Check(nic.MACAddress, tc.Equals, "bc:24:11:aa:bb:cc")
+	c.Check(nic.InterfaceName, tc.Equals, "ens18")
+	c.Check(nic.ParentInterfaceName, tc.Equals, "vmbr0")
+	c.Assert(nic.Addresses, tc.HasLen, 1)
+	c.Check(nic.Addresses[0].Value, tc.Equals, "10.0.0.10")
+	c.Check(nic.Addresses[0].CIDR, tc.Equals, "10.0.0.0/24")
+	c.Check(nic.Addresses[0].ProviderSubnetID, tc.Equals, network.Id("subnet-vmbr0-10.0.0.0/24"))
+
+	c.Check(infos[0][1].DeviceIndex, tc.Equals, 1)
+	c.Check(infos[0][1].ParentInterfaceName, tc.Equals, "vmbr1")
+	c.Check(infos[0][1].Addresses, tc.HasLen, 0)
+}
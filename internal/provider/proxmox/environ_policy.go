@@ -0,0 +1,68 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package proxmox
+
+import (
+	"context"
+	"slices"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/arch"
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/environs"
+)
+
+// PrecheckInstance verifies that the provided series and constraints
+// are valid for use in creating an instance in this environment.
+func (env *environ) PrecheckInstance(ctx context.Context, args environs.PrecheckInstanceParams) error {
+	zone, err := env.parsePlacement(ctx, args.Placement)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if zone != nil && args.Constraints.HasZones() && !slices.Contains(*args.Constraints.Zones, zone.Name()) {
+		return errors.Errorf(
+			"placement zone %q is not in the zones constraint %q",
+			zone.Name(), *args.Constraints.Zones)
+	}
+	if args.Constraints.HasZones() {
+		for _, name := range *args.Constraints.Zones {
+			if _, err := env.availZone(ctx, name); err != nil {
+				return errors.Trace(err)
+			}
+		}
+	}
+	return nil
+}
+
+var unsupportedConstraints = []string{
+	constraints.CpuPower,
+	constraints.Tags,
+	constraints.InstanceType,
+	constraints.InstanceRole,
+	constraints.VirtType,
+	constraints.Spaces,
+	constraints.AllocatePublicIP,
+	constraints.ImageID,
+	constraints.RootDiskSource,
+	constraints.AffinityGroup,
+	constraints.AntiAffinity,
+	constraints.Accelerators,
+}
+
+// ConstraintsValidator returns a Validator value which is used to
+// validate and merge constraints.
+func (env *environ) ConstraintsValidator(ctx context.Context) (constraints.Validator, error) {
+	validator := constraints.NewValidator()
+	validator.RegisterUnsupported(unsupportedConstraints)
+	// Proxmox VE only runs on amd64 hosts.
+	validator.RegisterVocabulary(constraints.Arch, []string{arch.AMD64})
+	return validator, nil
+}
+
+// ShouldApplyControllerConstraints returns if bootstrapping logic should use
+// default constraints.
+func (env *environ) ShouldApplyControllerConstraints(constraints.Value) bool {
+	return true
+}
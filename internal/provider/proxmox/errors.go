@@ -0,0 +1,34 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package proxmox
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// IsAuthorisationFailure determines if the given error has an authorisation
+// failure.
+func IsAuthorisationFailure(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.StatusCode == http.StatusUnauthorized || apiErr.StatusCode == http.StatusForbidden
+}
+
+// isNotFound reports whether the API failed because the virtual machine or
+// volume it was operating on does not exist. Proxmox VE reports these as
+// internal server errors, so the message must be matched.
+func isNotFound(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.StatusCode == http.StatusNotFound ||
+		strings.Contains(apiErr.Status, "does not exist") ||
+		strings.Contains(apiErr.Status, "no such volume")
+}
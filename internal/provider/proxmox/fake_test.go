@@ -0,0 +1,464 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package proxmox
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	fakeTokenID     = "juju@pve!juju"
+	fakeTokenSecret = "0b5d8b3e-6e6c-4c47-9b0c-8b6f0f7f3c1e"
+	fakeUsername    = "juju@pve"
+	fakePassword    = "sekrit"
+	fakeTicket      = "PVE:juju@pve:TICKET"
+	fakeCSRFToken   = "CSRF"
+)
+
+// fakeVM is a virtual machine of the fake Proxmox VE cluster.
+type fakeVM struct {
+	VM
+	config VMConfig
+}
+
+// fakeServer is a local stand-in for the Proxmox VE API, holding the state
+// of a cluster in memory.
+type fakeServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	nodes    []Node
+	vms      map[int]*fakeVM
+	nextID   int
+	storages map[string][]Storage
+	volumes  map[string][]StorageVolume
+	networks map[string][]NodeNetwork
+	agent    map[int][]AgentInterface
+	uploads  map[string][]byte
+
+	// requests records the method and path of each request.
+	requests []string
+	// failures maps "METHOD path" to the status of a failed response.
+	failures map[string]int
+}
+
+func newFakeServer() *fakeServer {
+	s := &fakeServer{
+		nodes: []Node{
+			{Name: "pve1", Status: "online"},
+			{Name: "pve2", Status: "online"},
+			{Name: "pve3", Status: "offline"},
+		},
+		vms:      make(map[int]*fakeVM),
+		nextID:   100,
+		storages: make(map[string][]Storage),
+		volumes:  make(map[string][]StorageVolume),
+		networks: make(map[string][]NodeNetwork),
+		agent:    make(map[int][]AgentInterface),
+		uploads:  make(map[string][]byte),
+		failures: make(map[string]int),
+	}
+	for _, node := range []string{"pve1", "pve2"} {
+		s.storages[node] = []Storage{
+			{Name: "local", Type: "dir", Content: "iso,vztmpl,backup", Active: 1},
+			{Name: "local-lvm", Type: "lvmthin", Content: "images,rootdir", Active: 1},
+		}
+		s.networks[node] = []NodeNetwork{
+			{Iface: "vmbr0", Type: "bridge", CIDR: "10.0.0.2/24", Active: 1},
+			{Iface: "vmbr1", Type: "bridge", Active: 1},
+		}
+	}
+	s.addVM(VM{VMID: 9000, Name: "ubuntu-24.04-cloudimg", Node: "pve1", Template: 1}, VMConfig{
+		"scsi0": "local-lvm:base-9000-disk-0,size=3584M",
+		"ide2":  "local-lvm:vm-9000-cloudinit,media=cdrom",
+		"net0":  "virtio=BC:24:11:00:00:01,bridge=vmbr0",
+	})
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api2/json/version", s.handleVersion)
+	mux.HandleFunc("POST /api2/json/access/ticket", s.handleLogin)
+	mux.HandleFunc("GET /api2/json/nodes", s.handleNodes)
+	mux.HandleFunc("GET /api2/json/cluster/resources", s.handleResources)
+	mux.HandleFunc("GET /api2/json/cluster/nextid", s.handleNextID)
+	mux.HandleFunc("GET /api2/json/nodes/{node}/tasks/{upid}/status", s.handleTaskStatus)
+	mux.HandleFunc("POST /api2/json/nodes/{node}/qemu/{vmid}/clone", s.handleClone)
+	mux.HandleFunc("GET /api2/json/nodes/{node}/qemu/{vmid}/config", s.handleGetConfig)
+	mux.HandleFunc("PUT /api2/json/nodes/{node}/qemu/{vmid}/config", s.handleSetConfig)
+	mux.HandleFunc("PUT /api2/json/nodes/{node}/qemu/{vmid}/resize", s.handleResize)
+	mux.HandleFunc("POST /api2/json/nodes/{node}/qemu/{vmid}/status/{action}", s.handleVMStatus)
+	mux.HandleFunc("DELETE /api2/json/nodes/{node}/qemu/{vmid}", s.handleDeleteVM)
+	mux.HandleFunc("GET /api2/json/nodes/{node}/qemu/{vmid}/agent/network-get-interfaces", s.handleAgentInterfaces)
+	mux.HandleFunc("GET /api2/json/nodes/{node}/storage", s.handleStorages)
+	mux.HandleFunc("GET /api2/json/nodes/{node}/storage/{storage}/content", s.handleVolumes)
+	mux.HandleFunc("POST /api2/json/nodes/{node}/storage/{storage}/content", s.handleAllocate)
+	mux.HandleFunc("DELETE /api2/json/nodes/{node}/storage/{storage}/content/{volid}", s.handleDeleteVolume)
+	mux.HandleFunc("POST /api2/json/nodes/{node}/storage/{storage}/upload", s.handleUpload)
+	mux.HandleFunc("GET /api2/json/nodes/{node}/network", s.handleNetworks)
+
+	s.Server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, apiPath)
+		s.mu.Lock()
+		s.requests = append(s.requests, r.Method+" "+path)
+		failure := s.failures[r.Method+" "+path]
+		s.mu.Unlock()
+
+		if failure != 0 {
+			writeError(w, failure, "injected failure")
+			return
+		}
+		if path != "/version" && path != "/access/ticket" && !authorised(r) {
+			writeError(w, http.StatusUnauthorized, "authentication failure")
+			return
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		mux.ServeHTTP(w, r)
+	}))
+	return s
+}
+
+func authorised(r *http.Request) bool {
+	if r.Header.Get("Authorization") == fmt.Sprintf("PVEAPIToken=%s=%s", fakeTokenID, fakeTokenSecret) {
+		return true
+	}
+	cookie, err := r.Cookie("PVEAuthCookie")
+	if err != nil || cookie.Value != fakeTicket {
+		return false
+	}
+	return r.Method == http.MethodGet || r.Header.Get("CSRFPreventionToken") == fakeCSRFToken
+}
+
+// fail makes requests with the input method and path fail with the input
+// status.
+func (s *fakeServer) fail(method, path string, status int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures[method+" "+path] = status
+}
+
+// addVM adds a virtual machine to the cluster.
+func (s *fakeServer) addVM(vm VM, config VMConfig) {
+	vm.Type = "qemu"
+	if vm.Status == "" {
+		vm.Status = "stopped"
+	}
+	if config == nil {
+		config = make(VMConfig)
+	}
+	config["name"] = vm.Name
+	s.vms[vm.VMID] = &fakeVM{VM: vm, config: config}
+}
+
+// vm returns a copy of the virtual machine with the input name.
+func (s *fakeServer) vm(name string) (fakeVM, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, vm := range s.vms {
+		if vm.Name == name {
+			config := make(VMConfig, len(vm.config))
+			for k, v := range vm.config {
+				config[k] = v
+			}
+			return fakeVM{VM: vm.VM, config: config}, true
+		}
+	}
+	return fakeVM{}, false
+}
+
+func (s *fakeServer) requestsMatching(prefix string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var result []string
+	for _, req := range s.requests {
+		if strings.HasPrefix(req, prefix) {
+			result = append(result, req)
+		}
+	}
+	return result
+}
+
+func writeData(w http.ResponseWriter, data any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{"data": data})
+}
+
+func writeError(w http.ResponseWriter, status int, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]any{"data": nil, "message": msg})
+}
+
+// writeTask responds with the ID of a task, which has already finished.
+func writeTask(w http.ResponseWriter, r *http.Request, kind string) {
+	writeData(w, fmt.Sprintf("UPID:%s:%s:OK", r.PathValue("node"), kind))
+}
+
+func (s *fakeServer) lookupVM(w http.ResponseWriter, r *http.Request) *fakeVM {
+	vmid, _ := strconv.Atoi(r.PathValue("vmid"))
+	vm, ok := s.vms[vmid]
+	if !ok || vm.Node != r.PathValue("node") {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("Configuration file 'nodes/%s/qemu-server/%d.conf' does not exist", r.PathValue("node"), vmid))
+		return nil
+	}
+	return vm
+}
+
+func (s *fakeServer) handleVersion(w http.ResponseWriter, r *http.Request) {
+	if !authorised(r) {
+		writeError(w, http.StatusUnauthorized, "no ticket")
+		return
+	}
+	writeData(w, map[string]string{"version": "8.2.4"})
+}
+
+func (s *fakeServer) handleLogin(w http.ResponseWriter, r *http.Request) {
+	if r.FormValue("username") != fakeUsername || r.FormValue("password") != fakePassword {
+		writeError(w, http.StatusUnauthorized, "authentication failure")
+		return
+	}
+	writeData(w, map[string]string{"ticket": fakeTicket, "CSRFPreventionToken": fakeCSRFToken})
+}
+
+func (s *fakeServer) handleNodes(w http.ResponseWriter, r *http.Request) {
+	writeData(w, s.nodes)
+}
+
+func (s *fakeServer) handleResources(w http.ResponseWriter, r *http.Request) {
+	vms := make([]VM, 0, len(s.vms))
+	for _, vm := range s.vms {
+		vms = append(vms, vm.VM)
+	}
+	sort.Slice(vms, func(i, j int) bool { return vms[i].VMID < vms[j].VMID })
+	writeData(w, vms)
+}
+
+func (s *fakeServer) handleNextID(w http.ResponseWriter, r *http.Request) {
+	for s.vms[s.nextID] != nil {
+		s.nextID++
+	}
+	// Proxmox VE reports the ID as a string.
+	writeData(w, strconv.Itoa(s.nextID))
+}
+
+func (s *fakeServer) handleTaskStatus(w http.ResponseWriter, r *http.Request) {
+	exit := r.PathValue("upid")
+	exit = exit[strings.LastIndex(exit, ":")+1:]
+	writeData(w, map[string]string{"status": "stopped", "exitstatus": exit})
+}
+
+func (s *fakeServer) handleClone(w http.ResponseWriter, r *http.Request) {
+	template := s.lookupVM(w, r)
+	if template == nil {
+		return
+	}
+	newID, _ := strconv.Atoi(r.FormValue("newid"))
+	if s.vms[newID] != nil {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("VM %d already exists", newID))
+		return
+	}
+	config := make(VMConfig)
+	for k, v := range template.config {
+		config[k] = strings.ReplaceAll(v, fmt.Sprintf("base-%d-", template.VMID), fmt.Sprintf("vm-%d-", newID))
+	}
+	node := r.FormValue("target")
+	if node == "" {
+		node = template.Node
+	}
+	s.addVM(VM{VMID: newID, Name: r.FormValue("name"), Node: node}, config)
+	writeTask(w, r, "qmclone")
+}
+
+func (s *fakeServer) handleGetConfig(w http.ResponseWriter, r *http.Request) {
+	if vm := s.lookupVM(w, r); vm != nil {
+		writeData(w, vm.config)
+	}
+}
+
+func (s *fakeServer) handleSetConfig(w http.ResponseWriter, r *http.Request) {
+	vm := s.lookupVM(w, r)
+	if vm == nil {
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	for k, v := range r.PostForm {
+		if k == "delete" {
+			for _, key := range strings.Split(v[0], ",") {
+				if strings.HasPrefix(key, "scsi") {
+					vm.config["unused0"], _, _ = strings.Cut(vm.config[key], ",")
+				}
+				delete(vm.config, key)
+			}
+			continue
+		}
+		vm.config[k] = v[0]
+	}
+	writeData(w, nil)
+}
+
+func (s *fakeServer) handleResize(w http.ResponseWriter, r *http.Request) {
+	vm := s.lookupVM(w, r)
+	if vm == nil {
+		return
+	}
+	disk := r.FormValue("disk")
+	volume, _, _ := strings.Cut(vm.config[disk], ",")
+	vm.config[disk] = volume + ",size=" + r.FormValue("size")
+	writeTask(w, r, "resize")
+}
+
+func (s *fakeServer) handleVMStatus(w http.ResponseWriter, r *http.Request) {
+	vm := s.lookupVM(w, r)
+	if vm == nil {
+		return
+	}
+	switch r.PathValue("action") {
+	case "start":
+		vm.Status = "running"
+	case "stop":
+		vm.Status = "stopped"
+	}
+	writeTask(w, r, "qm"+r.PathValue("action"))
+}
+
+func (s *fakeServer) handleDeleteVM(w http.ResponseWriter, r *http.Request) {
+	vm := s.lookupVM(w, r)
+	if vm == nil {
+		return
+	}
+	if vm.Status != "stopped" {
+		writeData(w, fmt.Sprintf("UPID:%s:qmdestroy:VM %d is running", vm.Node, vm.VMID))
+		return
+	}
+	delete(s.vms, vm.VMID)
+	for key, volumes := range s.volumes {
+		var kept []StorageVolume
+		for _, vol := range volumes {
+			if vol.VMID != vm.VMID {
+				kept = append(kept, vol)
+			}
+		}
+		s.volumes[key] = kept
+	}
+	writeTask(w, r, "qmdestroy")
+}
+
+func (s *fakeServer) handleAgentInterfaces(w http.ResponseWriter, r *http.Request) {
+	vm := s.lookupVM(w, r)
+	if vm == nil {
+		return
+	}
+	ifaces, ok := s.agent[vm.VMID]
+	if !ok || vm.Status != "running" {
+		writeError(w, http.StatusInternalServerError, "QEMU guest agent is not running")
+		return
+	}
+	writeData(w, map[string]any{"result": ifaces})
+}
+
+func (s *fakeServer) handleStorages(w http.ResponseWriter, r *http.Request) {
+	writeData(w, s.storages[r.PathValue("node")])
+}
+
+func storageKey(r *http.Request) string {
+	return r.PathValue("node") + "/" + r.PathValue("storage")
+}
+
+func (s *fakeServer) handleVolumes(w http.ResponseWriter, r *http.Request) {
+	volumes := []StorageVolume{}
+	for _, vol := range s.volumes[storageKey(r)] {
+		if content := r.FormValue("content"); content == "images" && strings.Contains(vol.VolID, ":iso/") {
+			continue
+		}
+		volumes = append(volumes, vol)
+	}
+	writeData(w, volumes)
+}
+
+func (s *fakeServer) handleAllocate(w http.ResponseWriter, r *http.Request) {
+	vmid, _ := strconv.Atoi(r.FormValue("vmid"))
+	size, _ := strconv.ParseUint(strings.TrimSuffix(r.FormValue("size"), "M"), 10, 64)
+	filename := r.FormValue("filename")
+	volid := r.PathValue("storage") + ":" + filename
+	if strings.HasSuffix(filename, ".raw") {
+		volid = fmt.Sprintf("%s:%d/%s", r.PathValue("storage"), vmid, filename)
+	}
+	key := storageKey(r)
+	s.volumes[key] = append(s.volumes[key], StorageVolume{
+		VolID:  volid,
+		VMID:   vmid,
+		Size:   size * 1024 * 1024,
+		Format: r.FormValue("format"),
+	})
+	writeData(w, volid)
+}
+
+func (s *fakeServer) handleDeleteVolume(w http.ResponseWriter, r *http.Request) {
+	key := storageKey(r)
+	for i, vol := range s.volumes[key] {
+		if vol.VolID == r.PathValue("volid") {
+			s.volumes[key] = append(s.volumes[key][:i], s.volumes[key][i+1:]...)
+			writeTask(w, r, "imgdel")
+			return
+		}
+	}
+	writeError(w, http.StatusInternalServerError, fmt.Sprintf("no such volume '%s'", r.PathValue("volid")))
+}
+
+func (s *fakeServer) handleUpload(w http.ResponseWriter, r *http.Request) {
+	if r.FormValue("content") != "iso" {
+		writeError(w, http.StatusBadRequest, "content must be iso")
+		return
+	}
+	f, header, err := r.FormFile("filename")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	data, _ := io.ReadAll(f)
+	volid := fmt.Sprintf("%s:iso/%s", r.PathValue("storage"), header.Filename)
+	s.uploads[r.PathValue("node")+"/"+volid] = data
+	key := storageKey(r)
+	s.volumes[key] = append(s.volumes[key], StorageVolume{
+		VolID:  volid,
+		Size:   uint64(len(data)),
+		Format: "iso",
+	})
+	writeTask(w, r, "imgcopy")
+}
+
+func (s *fakeServer) handleNetworks(w http.ResponseWriter, r *http.Request) {
+	writeData(w, s.networks[r.PathValue("node")])
+}
+
+// fakeAgentInterface returns a guest interface with the input addresses,
+// in CIDR notation.
+func fakeAgentInterface(name, mac string, cidrs ...string) AgentInterface {
+	iface := AgentInterface{Name: name, MACAddress: mac}
+	for _, cidr := range cidrs {
+		ip, ipNet, _ := net.ParseCIDR(cidr)
+		prefix, _ := ipNet.Mask.Size()
+		addrType := "ipv4"
+		if ip.To4() == nil {
+			addrType = "ipv6"
+		}
+		iface.IPAddresses = append(iface.IPAddresses, AgentAddress{
+			Address: ip.String(),
+			Type:    addrType,
+			Prefix:  prefix,
+		})
+	}
+	return iface
+}
@@ -0,0 +1,76 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package proxmox
+
+import (
+	"encoding/pem"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/cloud"
+	"github.com/juju/juju/environs"
+	environscloudspec "github.com/juju/juju/environs/cloudspec"
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/internal/testhelpers"
+	coretesting "github.com/juju/juju/internal/testing"
+)
+
+const fakeModelUUID = "2d02eeac-9dbb-11e4-89d3-123b93f75cba"
+
+func fakeConfig(c *tc.C, attrs ...coretesting.Attrs) *config.Config {
+	merged := coretesting.FakeConfig().Merge(coretesting.Attrs{
+		"type": "proxmox",
+		"uuid": fakeModelUUID,
+	})
+	for _, attrs := range attrs {
+		merged = merged.Merge(attrs)
+	}
+	cfg, err := coretesting.ModelConfig(c).Apply(merged)
+	c.Assert(err, tc.ErrorIsNil)
+	return cfg
+}
+
+// fakeCloudSpec returns the spec of a cloud served by the input server,
+// trusting its certificate.
+func fakeCloudSpec(server *fakeServer) environscloudspec.CloudSpec {
+	cred := cloud.NewCredential(cloud.AccessKeyAuthType, map[string]string{
+		credAttrTokenID:     fakeTokenID,
+		credAttrTokenSecret: fakeTokenSecret,
+	})
+	return environscloudspec.CloudSpec{
+		Type:     "proxmox",
+		Name:     "proxmox",
+		Region:   defaultRegionName,
+		Endpoint: server.URL,
+		CACertificates: []string{string(pem.EncodeToMemory(&pem.Block{
+			Type:  "CERTIFICATE",
+			Bytes: server.Certificate().Raw,
+		}))},
+		Credential: &cred,
+	}
+}
+
+// environFixture opens a Proxmox VE environ backed by a fake API server.
+type environFixture struct {
+	testhelpers.IsolationSuite
+
+	server   *fakeServer
+	provider environs.CloudEnvironProvider
+	env      *environ
+}
+
+func (s *environFixture) SetUpTest(c *tc.C) {
+	s.IsolationSuite.SetUpTest(c)
+
+	s.server = newFakeServer()
+	s.AddCleanup(func(*tc.C) { s.server.Close() })
+
+	s.provider = NewProvider()
+	env, err := s.provider.Open(c.Context(), environs.OpenParams{
+		Cloud:  fakeCloudSpec(s.server),
+		Config: fakeConfig(c),
+	}, environs.NoopCredentialInvalidator())
+	c.Assert(err, tc.ErrorIsNil)
+	s.env = env.(*environ)
+}
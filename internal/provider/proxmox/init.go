@@ -0,0 +1,18 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package proxmox
+
+import (
+	"github.com/juju/juju/environs"
+)
+
+const (
+	// providerType is the unique identifier that the proxmox provider gets
+	// registered with.
+	providerType = "proxmox"
+)
+
+func init() {
+	environs.RegisterProvider(providerType, NewProvider())
+}
@@ -0,0 +1,86 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package proxmox
+
+import (
+	"context"
+	"net"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/core/status"
+	"github.com/juju/juju/environs/instances"
+)
+
+type environInstance struct {
+	vm  VM
+	env *environ
+}
+
+var _ instances.Instance = (*environInstance)(nil)
+
+func newInstance(vm VM, env *environ) *environInstance {
+	return &environInstance{
+		vm:  vm,
+		env: env,
+	}
+}
+
+// Id implements instances.Instance.
+func (i *environInstance) Id() instance.Id {
+	return instance.Id(i.vm.Name)
+}
+
+// Status implements instances.Instance.
+func (i *environInstance) Status(ctx context.Context) instance.Status {
+	var jujuStatus status.Status
+	switch i.vm.Status {
+	case "running":
+		jujuStatus = status.Running
+	default:
+		jujuStatus = status.Empty
+	}
+	return instance.Status{
+		Status:  jujuStatus,
+		Message: i.vm.Status,
+	}
+}
+
+// Addresses implements instances.Instance. Addresses are reported by the
+// QEMU guest agent, so an instance has none until the agent is running.
+func (i *environInstance) Addresses(ctx context.Context) (network.ProviderAddresses, error) {
+	ifaces, err := i.env.agentInterfaces(ctx, i.vm)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var addrs network.ProviderAddresses
+	for _, iface := range ifaces {
+		for _, addr := range iface.IPAddresses {
+			if ip := net.ParseIP(addr.Address); ip == nil || ip.IsLoopback() || ip.IsLinkLocalUnicast() {
+				continue
+			}
+			addrs = append(addrs, network.NewMachineAddress(addr.Address).AsProviderAddress())
+		}
+	}
+	return addrs, nil
+}
+
+// agentInterfaces returns the network interfaces reported by the guest
+// agent of a virtual machine. No interfaces are returned if the agent is
+// not running.
+func (env *environ) agentInterfaces(ctx context.Context, vm VM) ([]AgentInterface, error) {
+	if vm.Status != "running" {
+		return nil, nil
+	}
+	ifaces, err := env.client().AgentInterfaces(ctx, vm.Node, vm.VMID)
+	if IsAuthorisationFailure(err) {
+		return nil, errors.Trace(env.HandleCredentialError(ctx, err))
+	} else if err != nil {
+		logger.Debugf(ctx, "guest agent of %q not available: %v", vm.Name, err)
+		return nil, nil
+	}
+	return ifaces, nil
+}
@@ -0,0 +1,61 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package proxmox
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/environs/tags"
+)
+
+// Proxmox VE tags cannot hold key/value pairs, so the Juju tags of an
+// instance are recorded in the notes of the virtual machine instead, one
+// "key=value" line per tag below a fixed header.
+const descriptionHeader = "Managed by Juju."
+
+// jujuTag marks the virtual machines created by Juju in the Proxmox VE
+// user interface.
+const jujuTag = "juju"
+
+// formatDescription returns the notes recording the input Juju tags.
+func formatDescription(md map[string]string) string {
+	keys := make([]string, 0, len(md))
+	for k := range md {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	b.WriteString(descriptionHeader + "\n\n")
+	for _, k := range keys {
+		b.WriteString(k + "=" + md[k] + "\n")
+	}
+	return b.String()
+}
+
+// parseDescription returns the Juju tags recorded in the notes of a
+// virtual machine.
+func parseDescription(description string) map[string]string {
+	md := make(map[string]string)
+	for _, line := range strings.Split(description, "\n") {
+		k, v, ok := strings.Cut(strings.TrimSpace(line), "=")
+		if ok && strings.HasPrefix(k, tags.JujuTagPrefix) {
+			md[k] = v
+		}
+	}
+	return md
+}
+
+// vmMetadata returns the Juju tags recorded on a virtual machine.
+func (env *environ) vmMetadata(ctx context.Context, vm VM) (map[string]string, error) {
+	config, err := env.client().VMConfig(ctx, vm.Node, vm.VMID)
+	if err != nil {
+		return nil, errors.Annotatef(err, "getting config of %q", vm.Name)
+	}
+	return parseDescription(config["description"]), nil
+}
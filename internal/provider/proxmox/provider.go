@@ -0,0 +1,231 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package proxmox
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/jsonschema"
+	"github.com/juju/schema"
+
+	"github.com/juju/juju/cloud"
+	corelogger "github.com/juju/juju/core/logger"
+	"github.com/juju/juju/environs"
+	environscloudspec "github.com/juju/juju/environs/cloudspec"
+	"github.com/juju/juju/environs/config"
+	"github.com/juju/juju/internal/configschema"
+	jujuhttp "github.com/juju/juju/internal/http"
+	internallogger "github.com/juju/juju/internal/logger"
+)
+
+var logger = internallogger.GetLogger("juju.provider.proxmox")
+
+const (
+	currentProviderVersion = 0
+
+	// defaultRegionName is the name of the single region of a Proxmox VE
+	// cloud. The nodes of the cluster are its availability zones.
+	defaultRegionName = "default"
+)
+
+type environProvider struct {
+	environProviderCredentials
+}
+
+var (
+	_ config.ConfigSchemaSource     = (*environProvider)(nil)
+	_ environs.CloudEnvironProvider = (*environProvider)(nil)
+)
+
+// NewProvider returns a new Proxmox VE EnvironProvider.
+func NewProvider() environs.CloudEnvironProvider {
+	return &environProvider{}
+}
+
+var cloudSchema = &jsonschema.Schema{
+	Type:     []jsonschema.Type{jsonschema.ObjectType},
+	Required: []string{cloud.EndpointKey, cloud.AuthTypesKey},
+	Order:    []string{cloud.EndpointKey, cloud.AuthTypesKey, cloud.RegionsKey},
+	Properties: map[string]*jsonschema.Schema{
+		cloud.EndpointKey: {
+			Singular: "the API endpoint url for the cluster (for example https://pve.example.com:8006)",
+			Type:     []jsonschema.Type{jsonschema.StringType},
+			Format:   jsonschema.FormatURI,
+		},
+		cloud.AuthTypesKey: {
+			Singular:    "auth type",
+			Plural:      "auth types",
+			Type:        []jsonschema.Type{jsonschema.ArrayType},
+			UniqueItems: jsonschema.Bool(true),
+			Items: &jsonschema.ItemSpec{
+				Schemas: []*jsonschema.Schema{{
+					Type: []jsonschema.Type{jsonschema.StringType},
+					Enum: []interface{}{
+						string(cloud.AccessKeyAuthType),
+						string(cloud.UserPassAuthType),
+					},
+				}},
+			},
+		},
+		cloud.RegionsKey: {
+			Type:     []jsonschema.Type{jsonschema.ObjectType},
+			Singular: "region",
+			Plural:   "regions",
+			Default:  defaultRegionName,
+			AdditionalProperties: &jsonschema.Schema{
+				Type:          []jsonschema.Type{jsonschema.ObjectType},
+				MaxProperties: jsonschema.Int(0),
+			},
+		},
+	},
+}
+
+// Version is part of the EnvironProvider interface.
+func (*environProvider) Version() int {
+	return currentProviderVersion
+}
+
+// Open implements environs.EnvironProvider.
+func (p *environProvider) Open(ctx context.Context, args environs.OpenParams, invalidator environs.CredentialInvalidator) (environs.Environ, error) {
+	if err := validateCloudSpec(args.Cloud); err != nil {
+		return nil, errors.Annotate(err, "validating cloud spec")
+	}
+	env, err := newEnviron(ctx, p, args.Cloud, args.Config, invalidator)
+	return env, errors.Trace(err)
+}
+
+// CloudSchema returns the schema used to validate input for add-cloud.
+func (p *environProvider) CloudSchema() *jsonschema.Schema {
+	return cloudSchema
+}
+
+// Ping tests the connection to the cloud, to verify the endpoint is valid.
+// The API requires authentication, so an unauthenticated request that is
+// refused is taken as proof that the endpoint is a Proxmox VE API.
+func (p *environProvider) Ping(ctx context.Context, endpoint string) error {
+	if err := validateEndpoint(endpoint); err != nil {
+		return errors.Trace(err)
+	}
+	// The CA certificates of the cloud are not known yet, and no
+	// credentials are sent, so the server certificate is not verified.
+	c := &client{
+		baseURL:    strings.TrimRight(endpoint, "/"),
+		httpClient: newHTTPClient(environscloudspec.CloudSpec{SkipTLSVerify: true}),
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url("/version"), nil)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	err = c.send(req, nil)
+	if err == nil || IsAuthorisationFailure(err) {
+		return nil
+	}
+	return errors.Annotatef(err, "no Proxmox VE API available at %s", endpoint)
+}
+
+// ValidateCloud is specified in the EnvironProvider interface.
+func (*environProvider) ValidateCloud(ctx context.Context, spec environscloudspec.CloudSpec) error {
+	return errors.Annotate(validateCloudSpec(spec), "validating cloud spec")
+}
+
+// Validate implements environs.EnvironProvider.
+func (*environProvider) Validate(ctx context.Context, cfg, old *config.Config) (valid *config.Config, err error) {
+	ecfg, err := newValidConfig(ctx, cfg)
+	if err != nil {
+		return nil, errors.Annotate(err, "invalid config")
+	}
+	return ecfg.Config, nil
+}
+
+// Schema returns the configuration schema for an environment.
+func (*environProvider) Schema() configschema.Fields {
+	fields, err := config.Schema(configSchema)
+	if err != nil {
+		panic(err)
+	}
+	return fields
+}
+
+// ConfigSchema returns extra config attributes specific
+// to this provider only.
+func (*environProvider) ConfigSchema() schema.Fields {
+	return configFields
+}
+
+// ConfigDefaults returns the default values for the
+// provider specific config attributes.
+func (*environProvider) ConfigDefaults() schema.Defaults {
+	return configDefaults
+}
+
+// ModelConfigDefaults provides a set of default model config attributes that
+// should be set on a models config if they have not been specified by the user.
+func (*environProvider) ModelConfigDefaults(_ context.Context) (map[string]any, error) {
+	return map[string]any{
+		config.StorageDefaultBlockSourceKey: proxmoxStorageProviderType,
+	}, nil
+}
+
+func validateEndpoint(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" || (u.Scheme != "https" && u.Scheme != "http") {
+		return errors.NotValidf("endpoint %q", endpoint)
+	}
+	return nil
+}
+
+func validateCloudSpec(spec environscloudspec.CloudSpec) error {
+	if err := spec.Validate(); err != nil {
+		return errors.Trace(err)
+	}
+	if err := validateEndpoint(spec.Endpoint); err != nil {
+		return errors.Trace(err)
+	}
+	if spec.Credential == nil {
+		return errors.NotValidf("missing credential")
+	}
+	var required []string
+	switch authType := spec.Credential.AuthType(); authType {
+	case cloud.AccessKeyAuthType:
+		required = []string{credAttrTokenID, credAttrTokenSecret}
+	case cloud.UserPassAuthType:
+		required = []string{credAttrUsername, credAttrPassword}
+	default:
+		return errors.NotSupportedf("%q auth-type", authType)
+	}
+	attrs := spec.Credential.Attributes()
+	for _, key := range required {
+		if attrs[key] == "" {
+			return errors.NotValidf("credential with empty %s", key)
+		}
+	}
+	return nil
+}
+
+// newClient returns a client of the API of the input cloud.
+func newClient(spec environscloudspec.CloudSpec) *client {
+	attrs := spec.Credential.Attributes()
+	return &client{
+		baseURL:     strings.TrimRight(spec.Endpoint, "/"),
+		httpClient:  newHTTPClient(spec),
+		tokenID:     attrs[credAttrTokenID],
+		tokenSecret: attrs[credAttrTokenSecret],
+		username:    attrs[credAttrUsername],
+		password:    attrs[credAttrPassword],
+	}
+}
+
+// newHTTPClient returns an HTTP client trusting the CA certificates of the
+// input cloud. Proxmox VE uses self-signed certificates by default.
+func newHTTPClient(spec environscloudspec.CloudSpec) *http.Client {
+	return jujuhttp.NewClient(
+		jujuhttp.WithCACertificates(spec.CACertificates...),
+		jujuhttp.WithSkipHostnameVerification(spec.SkipTLSVerify),
+		jujuhttp.WithLogger(logger.Child("http", corelogger.HTTP)),
+	).Client()
+}
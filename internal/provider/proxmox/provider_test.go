@@ -0,0 +1,213 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package proxmox
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	stdtesting "testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/cloud"
+	"github.com/juju/juju/environs"
+	"github.com/juju/juju/environs/config"
+	coretesting "github.com/juju/juju/internal/testing"
+)
+
+type providerSuite struct {
+	environFixture
+}
+
+func TestProviderSuite(t *stdtesting.T) {
+	tc.Run(t, &providerSuite{})
+}
+
+func (s *providerSuite) TestOpen(c *tc.C) {
+	c.Check(s.env.Name(), tc.Equals, "testmodel")
+	version, err := s.env.client().Version(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(version, tc.Equals, "8.2.4")
+}
+
+func (s *providerSuite) TestOpenInvalidEndpoint(c *tc.C) {
+	spec := fakeCloudSpec(s.server)
+	spec.Endpoint = "pve.example.com:8006"
+	_, err := s.provider.Open(c.Context(), environs.OpenParams{
+		Cloud:  spec,
+		Config: fakeConfig(c),
+	}, environs.NoopCredentialInvalidator())
+	c.Assert(err, tc.ErrorMatches, `validating cloud spec: endpoint "pve.example.com:8006" not valid`)
+}
+
+func (s *providerSuite) TestOpenUnsupportedAuthType(c *tc.C) {
+	spec := fakeCloudSpec(s.server)
+	cred := cloud.NewEmptyCredential()
+	spec.Credential = &cred
+	_, err := s.provider.Open(c.Context(), environs.OpenParams{
+		Cloud:  spec,
+		Config: fakeConfig(c),
+	}, environs.NoopCredentialInvalidator())
+	c.Assert(err, tc.ErrorMatches, `validating cloud spec: "empty" auth-type not supported`)
+}
+
+func (s *providerSuite) TestOpenEmptyCredentialAttribute(c *tc.C) {
+	spec := fakeCloudSpec(s.server)
+	cred := cloud.NewCredential(cloud.UserPassAuthType, map[string]string{
+		credAttrUsername: fakeUsername,
+	})
+	spec.Credential = &cred
+	_, err := s.provider.Open(c.Context(), environs.OpenParams{
+		Cloud:  spec,
+		Config: fakeConfig(c),
+	}, environs.NoopCredentialInvalidator())
+	c.Assert(err, tc.ErrorMatches, `validating cloud spec: credential with empty password not valid`)
+}
+
+func (s *providerSuite) TestUserPassLogin(c *tc.C) {
+	spec := fakeCloudSpec(s.server)
+	cred := cloud.NewCredential(cloud.UserPassAuthType, map[string]string{
+		credAttrUsername: fakeUsername,
+		credAttrPassword: fakePassword,
+	})
+	spec.Credential = &cred
+	env, err := s.provider.Open(c.Context(), environs.OpenParams{
+		Cloud:  spec,
+		Config: fakeConfig(c),
+	}, environs.NoopCredentialInvalidator())
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Both a GET, and a request requiring the CSRF token, are
+	// authenticated with a single ticket.
+	_, err = env.AllInstances(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	err = env.StopInstances(c.Context(), "juju-f75cba-0")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(s.server.requestsMatching("POST /access/ticket"), tc.HasLen, 1)
+}
+
+func (s *providerSuite) TestInvalidCredential(c *tc.C) {
+	spec := fakeCloudSpec(s.server)
+	cred := cloud.NewCredential(cloud.AccessKeyAuthType, map[string]string{
+		credAttrTokenID:     fakeTokenID,
+		credAttrTokenSecret: "wrong",
+	})
+	spec.Credential = &cred
+	var invalidated string
+	env, err := s.provider.Open(c.Context(), environs.OpenParams{
+		Cloud:  spec,
+		Config: fakeConfig(c),
+	}, invalidatorFunc(func(_ context.Context, reason environs.CredentialInvalidReason) error {
+		invalidated = string(reason)
+		return nil
+	}))
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = env.AllInstances(c.Context())
+	c.Assert(err, tc.ErrorMatches, `.*401 authentication failure`)
+	c.Check(invalidated, tc.Not(tc.Equals), "")
+}
+
+type invalidatorFunc func(context.Context, environs.CredentialInvalidReason) error
+
+func (f invalidatorFunc) InvalidateCredentials(ctx context.Context, reason environs.CredentialInvalidReason) error {
+	return f(ctx, reason)
+}
+
+func (s *providerSuite) TestValidateDefaults(c *tc.C) {
+	cfg, err := s.provider.Validate(c.Context(), fakeConfig(c), nil)
+	c.Assert(err, tc.ErrorIsNil)
+	attrs := cfg.UnknownAttrs()
+	c.Check(attrs[cfgTemplate], tc.Equals, "ubuntu-{version}-cloudimg")
+	c.Check(attrs[cfgStorage], tc.Equals, "local-lvm")
+	c.Check(attrs[cfgISOStorage], tc.Equals, "local")
+	c.Check(attrs[cfgBridge], tc.Equals, "vmbr0")
+}
+
+func (s *providerSuite) TestValidateInvalid(c *tc.C) {
+	for i, key := range []string{cfgTemplate, cfgStorage, cfgISOStorage, cfgBridge} {
+		c.Logf("test %d", i)
+		_, err := s.provider.Validate(c.Context(), fakeConfig(c, coretesting.Attrs{key: ""}), nil)
+		c.Check(err, tc.ErrorMatches, `invalid config: empty `+key+` not valid`)
+	}
+}
+
+func (s *providerSuite) TestPing(c *tc.C) {
+	// The version endpoint refuses unauthenticated requests, which is
+	// enough to recognise the API.
+	err := s.provider.Ping(c.Context(), s.server.URL)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *providerSuite) TestPingFails(c *tc.C) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+	err := s.provider.Ping(c.Context(), server.URL)
+	c.Assert(err, tc.ErrorMatches, `no Proxmox VE API available at `+server.URL+`: 404 Not Found`)
+}
+
+func (s *providerSuite) TestPingInvalidEndpoint(c *tc.C) {
+	err := s.provider.Ping(c.Context(), "ftp://pve.example.com")
+	c.Assert(err, tc.ErrorMatches, `endpoint "ftp://pve.example.com" not valid`)
+}
+
+func (s *providerSuite) TestCredentialSchemas(c *tc.C) {
+	schemas := s.provider.CredentialSchemas()
+	c.Check(schemas, tc.HasLen, 2)
+	_, ok := schemas[cloud.AccessKeyAuthType]
+	c.Check(ok, tc.IsTrue)
+	_, ok = schemas[cloud.UserPassAuthType]
+	c.Check(ok, tc.IsTrue)
+}
+
+func (s *providerSuite) TestDetectCredentialsToken(c *tc.C) {
+	s.PatchEnvironment(envAPIToken, fakeTokenID+"="+fakeTokenSecret)
+	s.PatchEnvironment(envUsername, fakeUsername)
+
+	creds, err := s.provider.DetectCredentials("")
+	c.Assert(err, tc.ErrorIsNil)
+	cred := creds.AuthCredentials["default"]
+	c.Check(cred.AuthType(), tc.Equals, cloud.AccessKeyAuthType)
+	c.Check(cred.Attributes(), tc.DeepEquals, map[string]string{
+		credAttrTokenID:     fakeTokenID,
+		credAttrTokenSecret: fakeTokenSecret,
+	})
+}
+
+func (s *providerSuite) TestDetectCredentialsUserPass(c *tc.C) {
+	s.PatchEnvironment(envAPIToken, "")
+	s.PatchEnvironment(envUsername, fakeUsername)
+	s.PatchEnvironment(envPassword, fakePassword)
+
+	creds, err := s.provider.DetectCredentials("")
+	c.Assert(err, tc.ErrorIsNil)
+	cred := creds.AuthCredentials["default"]
+	c.Check(cred.AuthType(), tc.Equals, cloud.UserPassAuthType)
+	c.Check(cred.Attributes(), tc.DeepEquals, map[string]string{
+		credAttrUsername: fakeUsername,
+		credAttrPassword: fakePassword,
+	})
+}
+
+func (s *providerSuite) TestDetectCredentialsNotFound(c *tc.C) {
+	s.PatchEnvironment(envAPIToken, "")
+	s.PatchEnvironment(envUsername, "")
+
+	_, err := s.provider.DetectCredentials("")
+	c.Check(err, tc.ErrorIs, errors.NotFound)
+
+	s.PatchEnvironment(envAPIToken, "no-secret")
+	_, err = s.provider.DetectCredentials("")
+	c.Check(err, tc.ErrorIs, errors.NotValid)
+}
+
+func (s *providerSuite) TestModelConfigDefaults(c *tc.C) {
+	defaults, err := s.provider.(environs.ModelConfigProvider).ModelConfigDefaults(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(defaults, tc.DeepEquals, map[string]any{
+		config.StorageDefaultBlockSourceKey: proxmoxStorageProviderType,
+	})
+}
@@ -0,0 +1,457 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package proxmox
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+	"github.com/juju/schema"
+
+	"github.com/juju/juju/internal/provider/common"
+	"github.com/juju/juju/internal/storage"
+)
+
+const (
+	proxmoxStorageProviderType storage.ProviderType = "proxmox"
+
+	// attrProxmoxStorage is the attribute name for the storage pool's
+	// corresponding Proxmox VE storage. If this is not provided, the
+	// storage named by the model's proxmox-storage config is used.
+	attrProxmoxStorage = "proxmox-storage"
+
+	// maxDiskSerialLen is the longest serial number a disk can have.
+	maxDiskSerialLen = 20
+
+	// maxSCSIDisks is the number of SCSI disks a virtual machine can have.
+	maxSCSIDisks = 31
+
+	mib = 1024 * 1024
+)
+
+// fileStorageTypes are the types of Proxmox VE storage that keep disk
+// images as files, whose names require a format extension.
+var fileStorageTypes = set.NewStrings("dir", "nfs", "cifs", "glusterfs", "btrfs", "cephfs")
+
+// StorageProviderTypes implements storage.ProviderRegistry.
+func (env *environ) StorageProviderTypes() ([]storage.ProviderType, error) {
+	return append(common.CommonIAASStorageProviderTypes(), proxmoxStorageProviderType), nil
+}
+
+// StorageProvider implements storage.ProviderRegistry.
+func (env *environ) StorageProvider(t storage.ProviderType) (storage.Provider, error) {
+	if t == proxmoxStorageProviderType {
+		return &proxmoxStorageProvider{env: env}, nil
+	}
+	return common.GetCommonIAASStorageProvider(t)
+}
+
+// proxmoxStorageProvider is a storage provider for disk images in Proxmox
+// VE storages, attached to instances as SCSI disks.
+type proxmoxStorageProvider struct {
+	env *environ
+}
+
+var _ storage.Provider = (*proxmoxStorageProvider)(nil)
+
+var proxmoxStorageConfigChecker = schema.FieldMap(
+	schema.Fields{
+		attrProxmoxStorage: schema.String(),
+	},
+	schema.Defaults{
+		attrProxmoxStorage: schema.Omit,
+	},
+)
+
+func (p *proxmoxStorageProvider) storageName(attrs map[string]interface{}) (string, error) {
+	coerced, err := proxmoxStorageConfigChecker.Coerce(attrs, nil)
+	if err != nil {
+		return "", errors.Annotate(err, "validating proxmox storage config")
+	}
+	if name, _ := coerced.(map[string]interface{})[attrProxmoxStorage].(string); name != "" {
+		return name, nil
+	}
+	return p.env.ecfg().storage(), nil
+}
+
+// ValidateConfig is part of the Provider interface.
+func (p *proxmoxStorageProvider) ValidateConfig(cfg *storage.Config) error {
+	_, err := p.storageName(cfg.Attrs())
+	return errors.Trace(err)
+}
+
+// ValidateForK8s is part of the Provider interface.
+func (p *proxmoxStorageProvider) ValidateForK8s(map[string]any) error {
+	return errors.NotValidf("storage provider type %q", proxmoxStorageProviderType)
+}
+
+// Supports is part of the Provider interface.
+func (p *proxmoxStorageProvider) Supports(k storage.StorageKind) bool {
+	return k == storage.StorageKindBlock
+}
+
+// Scope is part of the Provider interface.
+func (p *proxmoxStorageProvider) Scope() storage.Scope {
+	return storage.ScopeEnviron
+}
+
+// Dynamic is part of the Provider interface.
+func (p *proxmoxStorageProvider) Dynamic() bool {
+	return true
+}
+
+// Releasable is part of the Provider interface.
+func (p *proxmoxStorageProvider) Releasable() bool {
+	return false
+}
+
+// DefaultPools is part of the Provider interface.
+func (p *proxmoxStorageProvider) DefaultPools() []*storage.Config {
+	pool, _ := storage.NewConfig(
+		proxmoxStorageProviderType.String(), proxmoxStorageProviderType, storage.Attrs{},
+	)
+	return []*storage.Config{pool}
+}
+
+// VolumeSource is part of the Provider interface.
+func (p *proxmoxStorageProvider) VolumeSource(cfg *storage.Config) (storage.VolumeSource, error) {
+	name, err := p.storageName(cfg.Attrs())
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &proxmoxVolumeSource{env: p.env, storage: name}, nil
+}
+
+// FilesystemSource is part of the Provider interface.
+func (p *proxmoxStorageProvider) FilesystemSource(*storage.Config) (storage.FilesystemSource, error) {
+	return nil, errors.NotSupportedf("filesystems")
+}
+
+// proxmoxVolumeSource creates volumes in a single Proxmox VE storage.
+//
+// Disk images in Proxmox VE are owned by a virtual machine, and are
+// destroyed along with it. Volumes are therefore created for the instance
+// they are initially attached to, on the node running that instance, and
+// are not persistent.
+type proxmoxVolumeSource struct {
+	env     *environ
+	storage string
+}
+
+var _ storage.VolumeSource = (*proxmoxVolumeSource)(nil)
+
+// volumeID returns the provider ID of a volume. Volume IDs of node-local
+// storages are only meaningful on one node, so the node is included.
+func volumeID(node, volid string) string {
+	return node + "/" + volid
+}
+
+func parseVolumeID(id string) (node, storageName, volid string, err error) {
+	node, volid, ok := strings.Cut(id, "/")
+	if ok {
+		storageName, _, ok = strings.Cut(volid, ":")
+	}
+	if !ok || node == "" || storageName == "" {
+		return "", "", "", errors.NotValidf("proxmox volume ID %q", id)
+	}
+	return node, storageName, volid, nil
+}
+
+// ValidateVolumeParams is part of the VolumeSource interface.
+func (s *proxmoxVolumeSource) ValidateVolumeParams(params storage.VolumeParams) error {
+	if params.Attachment == nil {
+		return errors.NotSupportedf("creating proxmox volumes without an attachment")
+	}
+	return nil
+}
+
+// CreateVolumes is part of the VolumeSource interface.
+func (s *proxmoxVolumeSource) CreateVolumes(ctx context.Context, params []storage.VolumeParams) ([]storage.CreateVolumesResult, error) {
+	vms, err := s.vms(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	results := make([]storage.CreateVolumesResult, len(params))
+	for i, p := range params {
+		if p.Attachment == nil {
+			results[i].Error = errors.NotSupportedf("creating proxmox volumes without an attachment")
+			continue
+		}
+		vm, ok := vms[string(p.Attachment.InstanceId)]
+		if !ok {
+			results[i].Error = errors.NotFoundf("instance %q", p.Attachment.InstanceId)
+			continue
+		}
+		volid, err := s.createVolume(ctx, vm, p)
+		if err != nil {
+			results[i].Error = errors.Annotatef(s.env.HandleCredentialError(ctx, err), "creating volume %q", p.Tag.Id())
+			continue
+		}
+		results[i].Volume = &storage.Volume{
+			Tag: p.Tag,
+			VolumeInfo: storage.VolumeInfo{
+				VolumeId: volumeID(vm.Node, volid),
+				Size:     p.Size,
+			},
+		}
+	}
+	return results, nil
+}
+
+func (s *proxmoxVolumeSource) createVolume(ctx context.Context, vm VM, p storage.VolumeParams) (string, error) {
+	storages, err := s.env.client().Storages(ctx, vm.Node)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	var storageType string
+	for _, st := range storages {
+		if st.Name == s.storage {
+			storageType = st.Type
+		}
+	}
+	if storageType == "" {
+		return "", errors.NotFoundf("storage %q on node %q", s.storage, vm.Node)
+	}
+	// Proxmox VE requires disk image names to start with the ID of the
+	// owning virtual machine.
+	filename := fmt.Sprintf("vm-%d-%s", vm.VMID, s.env.namespace.Value(p.Tag.String()))
+	if fileStorageTypes.Contains(storageType) {
+		filename += ".raw"
+	}
+	volid, err := s.env.client().AllocateDisk(ctx, vm.Node, s.storage, vm.VMID, filename, p.Size)
+	return volid, errors.Trace(err)
+}
+
+// ListVolumes is part of the VolumeSource interface.
+func (s *proxmoxVolumeSource) ListVolumes(ctx context.Context) ([]string, error) {
+	vms, err := s.vms(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	byNode := make(map[string]set.Ints)
+	for _, vm := range vms {
+		if byNode[vm.Node] == nil {
+			byNode[vm.Node] = set.NewInts()
+		}
+		byNode[vm.Node].Add(vm.VMID)
+	}
+	prefix := s.env.namespace.Value("volume-")
+	var ids []string
+	for _, node := range slices.Sorted(maps.Keys(byNode)) {
+		volumes, err := s.env.client().StorageVolumes(ctx, node, s.storage, "images")
+		if err != nil {
+			return nil, errors.Trace(s.env.HandleCredentialError(ctx, err))
+		}
+		for _, vol := range volumes {
+			if byNode[node].Contains(vol.VMID) && strings.Contains(vol.VolID, prefix) {
+				ids = append(ids, volumeID(node, vol.VolID))
+			}
+		}
+	}
+	return ids, nil
+}
+
+// DescribeVolumes is part of the VolumeSource interface.
+func (s *proxmoxVolumeSource) DescribeVolumes(ctx context.Context, volIds []string) ([]storage.DescribeVolumesResult, error) {
+	volumes := make(map[string]map[string]StorageVolume)
+	results := make([]storage.DescribeVolumesResult, len(volIds))
+	for i, id := range volIds {
+		node, storageName, volid, err := parseVolumeID(id)
+		if err != nil {
+			results[i].Error = err
+			continue
+		}
+		key := node + "/" + storageName
+		if _, ok := volumes[key]; !ok {
+			storageVolumes, err := s.env.client().StorageVolumes(ctx, node, storageName, "images")
+			if err != nil {
+				return nil, errors.Trace(s.env.HandleCredentialError(ctx, err))
+			}
+			volumes[key] = make(map[string]StorageVolume)
+			for _, vol := range storageVolumes {
+				volumes[key][vol.VolID] = vol
+			}
+		}
+		vol, ok := volumes[key][volid]
+		if !ok {
+			results[i].Error = errors.NotFoundf("volume %q", id)
+			continue
+		}
+		results[i].VolumeInfo = &storage.VolumeInfo{
+			VolumeId: id,
+			Size:     vol.Size / mib,
+		}
+	}
+	return results, nil
+}
+
+// DestroyVolumes is part of the VolumeSource interface.
+func (s *proxmoxVolumeSource) DestroyVolumes(ctx context.Context, volIds []string) ([]error, error) {
+	results := make([]error, len(volIds))
+	for i, id := range volIds {
+		node, storageName, volid, err := parseVolumeID(id)
+		if err != nil {
+			results[i] = err
+			continue
+		}
+		err = s.env.client().DeleteVolume(ctx, node, storageName, volid)
+		if err != nil && !isNotFound(err) {
+			results[i] = errors.Annotatef(s.env.HandleCredentialError(ctx, err), "destroying volume %q", id)
+		}
+	}
+	return results, nil
+}
+
+// ReleaseVolumes is part of the VolumeSource interface.
+func (s *proxmoxVolumeSource) ReleaseVolumes(ctx context.Context, volIds []string) ([]error, error) {
+	return nil, errors.NotSupportedf("releasing proxmox volumes")
+}
+
+// AttachVolumes is part of the VolumeSource interface.
+func (s *proxmoxVolumeSource) AttachVolumes(ctx context.Context, params []storage.VolumeAttachmentParams) ([]storage.AttachVolumesResult, error) {
+	vms, err := s.vms(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	results := make([]storage.AttachVolumesResult, len(params))
+	for i, p := range params {
+		vm, ok := vms[string(p.InstanceId)]
+		if !ok {
+			results[i].Error = errors.NotFoundf("instance %q", p.InstanceId)
+			continue
+		}
+		info, err := s.attachVolume(ctx, vm, p)
+		if err != nil {
+			results[i].Error = errors.Annotatef(s.env.HandleCredentialError(ctx, err),
+				"attaching volume %q to instance %q", p.VolumeId, p.InstanceId)
+			continue
+		}
+		results[i].VolumeAttachment = &storage.VolumeAttachment{
+			Volume:               p.Volume,
+			Machine:              p.Machine,
+			VolumeAttachmentInfo: info,
+		}
+	}
+	return results, nil
+}
+
+func (s *proxmoxVolumeSource) attachVolume(
+	ctx context.Context, vm VM, p storage.VolumeAttachmentParams,
+) (storage.VolumeAttachmentInfo, error) {
+	node, _, volid, err := parseVolumeID(p.VolumeId)
+	if err != nil {
+		return storage.VolumeAttachmentInfo{}, errors.Trace(err)
+	}
+	if node != vm.Node {
+		return storage.VolumeAttachmentInfo{}, errors.NotValidf("volume on node %q for instance on node %q", node, vm.Node)
+	}
+	config, err := s.env.client().VMConfig(ctx, vm.Node, vm.VMID)
+	if err != nil {
+		return storage.VolumeAttachmentInfo{}, errors.Trace(err)
+	}
+	serial := p.Volume.String()
+	if len(serial) > maxDiskSerialLen {
+		serial = serial[len(serial)-maxDiskSerialLen:]
+	}
+	info := storage.VolumeAttachmentInfo{
+		DeviceLink: "/dev/disk/by-id/scsi-0QEMU_QEMU_HARDDISK_" + serial,
+		ReadOnly:   p.ReadOnly,
+	}
+	// Attachment must be idempotent.
+	if _, ok := diskByVolume(config, volid); ok {
+		return info, nil
+	}
+
+	key, err := nextSCSIDisk(config)
+	if err != nil {
+		return storage.VolumeAttachmentInfo{}, errors.Annotatef(err, "instance %q", vm.Name)
+	}
+	disk := volid + ",serial=" + serial
+	if p.ReadOnly {
+		disk += ",ro=1"
+	}
+	if err := s.env.client().SetVMConfig(ctx, vm.Node, vm.VMID, url.Values{key: {disk}}); err != nil {
+		return storage.VolumeAttachmentInfo{}, errors.Trace(err)
+	}
+	return info, nil
+}
+
+// diskByVolume returns the config key of the disk of a virtual machine
+// backed by the input volume.
+func diskByVolume(config VMConfig, volid string) (string, bool) {
+	for key, value := range config {
+		if strings.HasPrefix(key, "unused") {
+			continue
+		}
+		if v, _, _ := strings.Cut(value, ","); v == volid {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+// nextSCSIDisk returns the first unused SCSI disk key of a virtual
+// machine. The first disk is left for the root disk.
+func nextSCSIDisk(config VMConfig) (string, error) {
+	for i := 1; i < maxSCSIDisks; i++ {
+		if key := fmt.Sprintf("scsi%d", i); config[key] == "" {
+			return key, nil
+		}
+	}
+	return "", errors.New("no free disk devices")
+}
+
+// DetachVolumes is part of the VolumeSource interface.
+func (s *proxmoxVolumeSource) DetachVolumes(ctx context.Context, params []storage.VolumeAttachmentParams) ([]error, error) {
+	vms, err := s.vms(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	results := make([]error, len(params))
+	for i, p := range params {
+		vm, ok := vms[string(p.InstanceId)]
+		if !ok {
+			// The instance is gone, so the volume is detached.
+			continue
+		}
+		_, _, volid, err := parseVolumeID(p.VolumeId)
+		if err != nil {
+			results[i] = err
+			continue
+		}
+		config, err := s.env.client().VMConfig(ctx, vm.Node, vm.VMID)
+		if err != nil {
+			results[i] = errors.Trace(s.env.HandleCredentialError(ctx, err))
+			continue
+		}
+		key, ok := diskByVolume(config, volid)
+		if !ok {
+			continue
+		}
+		if err := s.env.client().SetVMConfig(ctx, vm.Node, vm.VMID, url.Values{"delete": {key}}); err != nil {
+			results[i] = errors.Annotatef(s.env.HandleCredentialError(ctx, err),
+				"detaching volume %q from instance %q", p.VolumeId, p.InstanceId)
+		}
+	}
+	return results, nil
+}
+
+// vms returns the virtual machines of the model keyed by name.
+func (s *proxmoxVolumeSource) vms(ctx context.Context) (map[string]VM, error) {
+	vms, err := s.env.jujuVMs(ctx, s.env.namespace.Prefix())
+	if err != nil {
+		return nil, errors.Trace(s.env.HandleCredentialError(ctx, err))
+	}
+	byName := make(map[string]VM, len(vms))
+	for _, vm := range vms {
+		byName[vm.Name] = vm
+	}
+	return byName, nil
+}
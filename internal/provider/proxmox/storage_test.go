@@ -0,0 +1,205 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package proxmox
+
+import (
+	stdtesting "testing"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/internal/storage"
+)
+
+type storageSuite struct {
+	environFixture
+
+	source storage.VolumeSource
+}
+
+func TestStorageSuite(t *stdtesting.T) {
+	tc.Run(t, &storageSuite{})
+}
+
+func (s *storageSuite) SetUpTest(c *tc.C) {
+	s.environFixture.SetUpTest(c)
+	s.server.addVM(VM{VMID: 100, Name: "juju-f75cba-0", Node: "pve2", Status: "running"}, VMConfig{
+		"scsi0": "local-lvm:vm-100-disk-0,size=8G",
+	})
+	s.source = s.volumeSource(c, storage.Attrs{})
+}
+
+func (s *storageSuite) volumeSource(c *tc.C, attrs storage.Attrs) storage.VolumeSource {
+	provider, err := s.env.StorageProvider(proxmoxStorageProviderType)
+	c.Assert(err, tc.ErrorIsNil)
+	cfg, err := storage.NewConfig("proxmox", proxmoxStorageProviderType, attrs)
+	c.Assert(err, tc.ErrorIsNil)
+	source, err := provider.VolumeSource(cfg)
+	c.Assert(err, tc.ErrorIsNil)
+	return source
+}
+
+func (s *storageSuite) volumeParams(id string) storage.VolumeParams {
+	tag := names.NewVolumeTag(id)
+	return storage.VolumeParams{
+		Tag:      tag,
+		Size:     1024,
+		Provider: proxmoxStorageProviderType,
+		Attachment: &storage.VolumeAttachmentParams{
+			AttachmentParams: storage.AttachmentParams{
+				Machine:    names.NewMachineTag("0"),
+				InstanceId: "juju-f75cba-0",
+			},
+			Volume: tag,
+		},
+	}
+}
+
+func (s *storageSuite) attachmentParams(volumeID string) storage.VolumeAttachmentParams {
+	return storage.VolumeAttachmentParams{
+		AttachmentParams: storage.AttachmentParams{
+			Machine:    names.NewMachineTag("0"),
+			InstanceId: "juju-f75cba-0",
+		},
+		Volume:   names.NewVolumeTag("0"),
+		VolumeId: volumeID,
+	}
+}
+
+func (s *storageSuite) TestStorageProviderTypes(c *tc.C) {
+	types, err := s.env.StorageProviderTypes()
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(types[len(types)-1], tc.Equals, proxmoxStorageProviderType)
+}
+
+func (s *storageSuite) TestValidateConfig(c *tc.C) {
+	provider, err := s.env.StorageProvider(proxmoxStorageProviderType)
+	c.Assert(err, tc.ErrorIsNil)
+	cfg, err := storage.NewConfig("proxmox", proxmoxStorageProviderType, storage.Attrs{
+		attrProxmoxStorage: 42,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	err = provider.ValidateConfig(cfg)
+	c.Check(err, tc.ErrorMatches, `validating proxmox storage config: proxmox-storage: expected string, got int\(42\)`)
+	c.Check(provider.Supports(storage.StorageKindBlock), tc.IsTrue)
+	c.Check(provider.Supports(storage.StorageKindFilesystem), tc.IsFalse)
+}
+
+func (s *storageSuite) TestValidateVolumeParamsRequiresAttachment(c *tc.C) {
+	params := s.volumeParams("0")
+	c.Check(s.source.ValidateVolumeParams(params), tc.ErrorIsNil)
+	params.Attachment = nil
+	c.Check(s.source.ValidateVolumeParams(params), tc.ErrorIs, errors.NotSupported)
+}
+
+func (s *storageSuite) TestCreateVolumes(c *tc.C) {
+	results, err := s.source.CreateVolumes(c.Context(), []storage.VolumeParams{s.volumeParams("0")})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 1)
+	c.Assert(results[0].Error, tc.ErrorIsNil)
+	c.Check(results[0].Volume.VolumeId, tc.Equals, "pve2/local-lvm:vm-100-juju-f75cba-volume-0")
+	c.Check(results[0].Volume.Size, tc.Equals, uint64(1024))
+	c.Check(results[0].Volume.Persistent, tc.IsFalse)
+
+	vols := s.server.volumes["pve2/local-lvm"]
+	c.Assert(vols, tc.HasLen, 1)
+	c.Check(vols[0].VMID, tc.Equals, 100)
+	c.Check(vols[0].Size, tc.Equals, uint64(1024*mib))
+}
+
+func (s *storageSuite) TestCreateVolumesFileStorage(c *tc.C) {
+	source := s.volumeSource(c, storage.Attrs{attrProxmoxStorage: "local"})
+	results, err := source.CreateVolumes(c.Context(), []storage.VolumeParams{s.volumeParams("0")})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results[0].Error, tc.ErrorIsNil)
+	c.Check(results[0].Volume.VolumeId, tc.Equals, "pve2/local:100/vm-100-juju-f75cba-volume-0.raw")
+}
+
+func (s *storageSuite) TestCreateVolumesErrors(c *tc.C) {
+	unknownInstance := s.volumeParams("1")
+	unknownInstance.Attachment.InstanceId = "juju-f75cba-1"
+	source := s.volumeSource(c, storage.Attrs{attrProxmoxStorage: "ceph"})
+
+	results, err := source.CreateVolumes(c.Context(), []storage.VolumeParams{s.volumeParams("0"), unknownInstance})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(results[0].Error, tc.ErrorMatches, `creating volume "0": storage "ceph" on node "pve2" not found`)
+	c.Check(results[1].Error, tc.ErrorMatches, `instance "juju-f75cba-1" not found`)
+}
+
+func (s *storageSuite) TestListAndDescribeVolumes(c *tc.C) {
+	_, err := s.source.CreateVolumes(c.Context(), []storage.VolumeParams{s.volumeParams("0")})
+	c.Assert(err, tc.ErrorIsNil)
+	// Volumes of other models and root disks are not listed.
+	s.server.volumes["pve2/local-lvm"] = append(s.server.volumes["pve2/local-lvm"],
+		StorageVolume{VolID: "local-lvm:vm-100-disk-0", VMID: 100},
+		StorageVolume{VolID: "local-lvm:vm-200-juju-123456-volume-0", VMID: 200},
+	)
+
+	ids, err := s.source.ListVolumes(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(ids, tc.DeepEquals, []string{"pve2/local-lvm:vm-100-juju-f75cba-volume-0"})
+
+	results, err := s.source.DescribeVolumes(c.Context(), []string{ids[0], "pve2/local-lvm:missing", "invalid"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 3)
+	c.Check(results[0].VolumeInfo, tc.DeepEquals, &storage.VolumeInfo{
+		VolumeId: ids[0],
+		Size:     1024,
+	})
+	c.Check(results[1].Error, tc.ErrorIs, errors.NotFound)
+	c.Check(results[2].Error, tc.ErrorIs, errors.NotValid)
+}
+
+func (s *storageSuite) TestDestroyVolumes(c *tc.C) {
+	results, err := s.source.CreateVolumes(c.Context(), []storage.VolumeParams{s.volumeParams("0")})
+	c.Assert(err, tc.ErrorIsNil)
+	id := results[0].Volume.VolumeId
+
+	// Destroying a volume that is gone is not an error.
+	errs, err := s.source.DestroyVolumes(c.Context(), []string{id, id})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(errs, tc.DeepEquals, []error{nil, nil})
+	c.Check(s.server.volumes["pve2/local-lvm"], tc.HasLen, 0)
+}
+
+func (s *storageSuite) TestAttachDetachVolumes(c *tc.C) {
+	results, err := s.source.CreateVolumes(c.Context(), []storage.VolumeParams{s.volumeParams("0")})
+	c.Assert(err, tc.ErrorIsNil)
+	id := results[0].Volume.VolumeId
+
+	attached, err := s.source.AttachVolumes(c.Context(), []storage.VolumeAttachmentParams{s.attachmentParams(id)})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(attached[0].Error, tc.ErrorIsNil)
+	c.Check(attached[0].VolumeAttachment.DeviceLink, tc.Equals, "/dev/disk/by-id/scsi-0QEMU_QEMU_HARDDISK_volume-0")
+
+	vm, _ := s.server.vm("juju-f75cba-0")
+	c.Check(vm.config["scsi1"], tc.Equals, "local-lvm:vm-100-juju-f75cba-volume-0,serial=volume-0")
+
+	// Attachment is idempotent.
+	attached, err = s.source.AttachVolumes(c.Context(), []storage.VolumeAttachmentParams{s.attachmentParams(id)})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(attached[0].Error, tc.ErrorIsNil)
+	c.Check(s.server.requestsMatching("PUT /nodes/pve2/qemu/100/config"), tc.HasLen, 1)
+
+	errs, err := s.source.DetachVolumes(c.Context(), []storage.VolumeAttachmentParams{s.attachmentParams(id)})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(errs, tc.DeepEquals, []error{nil})
+	vm, _ = s.server.vm("juju-f75cba-0")
+	_, ok := vm.config["scsi1"]
+	c.Check(ok, tc.IsFalse)
+
+	// Detaching a volume that is not attached is not an error.
+	errs, err = s.source.DetachVolumes(c.Context(), []storage.VolumeAttachmentParams{s.attachmentParams(id)})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(errs, tc.DeepEquals, []error{nil})
+}
+
+func (s *storageSuite) TestAttachVolumeOtherNode(c *tc.C) {
+	attached, err := s.source.AttachVolumes(c.Context(), []storage.VolumeAttachmentParams{
+		s.attachmentParams("pve1/local-lvm:vm-100-juju-f75cba-volume-0"),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(attached[0].Error, tc.ErrorMatches, `attaching volume .* volume on node "pve1" for instance on node "pve2" not valid`)
+}
@@ -0,0 +1,173 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package encryptionkey manages the controller's root secret encryption key.
+//
+// The root key is kept in a key file in the controller agent's data
+// directory, outside of the database. The key-encryption keys which wrap
+// the model data keys are derived from it, so the database only records
+// the UUIDs of the key-encryption keys and never any key material.
+package encryptionkey
+
+import (
+	"context"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/juju/utils/v4"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// FileName is the name of the key file in the agent data directory.
+	FileName = "secret-encryption.key"
+
+	// keySize is the size of both the root key and the key-encryption
+	// keys derived from it.
+	keySize = 32
+
+	// derivationLabel binds the derived keys to their purpose.
+	derivationLabel = "juju secret key-encryption key"
+)
+
+// Path returns the path of the key file in the input data directory.
+func Path(dataDir string) string {
+	return filepath.Join(dataDir, FileName)
+}
+
+// NewRootKey returns a new random base64 encoded root key.
+func NewRootKey() (string, error) {
+	key := make([]byte, keySize)
+	if _, err := rand.Read(key); err != nil {
+		return "", errors.Errorf("generating secret encryption root key: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(key), nil
+}
+
+// ReadRootKey returns the base64 encoded root key from the key file in the
+// input data directory. An error satisfying [coreerrors.NotFound] is
+// returned if there is no key file.
+func ReadRootKey(dataDir string) (string, error) {
+	data, err := os.ReadFile(Path(dataDir))
+	if errors.Is(err, os.ErrNotExist) {
+		return "", errors.Errorf("secret encryption key file %q", Path(dataDir)).Add(coreerrors.NotFound)
+	} else if err != nil {
+		return "", errors.Errorf("reading secret encryption key file: %w", err)
+	}
+	rootKey := strings.TrimSpace(string(data))
+	if _, err := decodeRootKey(rootKey); err != nil {
+		return "", errors.Capture(err)
+	}
+	return rootKey, nil
+}
+
+// WriteRootKey writes the input base64 encoded root key to the key file in
+// the input data directory, unless the file already exists. An existing key
+// file is never replaced, as doing so would make all of the secret content
+// encrypted under it unreadable; an error satisfying [coreerrors.NotValid]
+// is returned if it holds a different key.
+func WriteRootKey(dataDir, rootKey string) error {
+	if _, err := decodeRootKey(rootKey); err != nil {
+		return errors.Capture(err)
+	}
+	existing, err := ReadRootKey(dataDir)
+	if err == nil {
+		if existing != rootKey {
+			return errors.Errorf(
+				"secret encryption key file %q holds a different key", Path(dataDir),
+			).Add(coreerrors.NotValid)
+		}
+		return nil
+	} else if !errors.Is(err, coreerrors.NotFound) {
+		return errors.Capture(err)
+	}
+	if err := utils.AtomicWriteFile(Path(dataDir), []byte(rootKey), 0600); err != nil {
+		return errors.Errorf("writing secret encryption key file: %w", err)
+	}
+	return nil
+}
+
+// EnsureRootKey writes a new random root key to the key file in the input
+// data directory, if there is no key file yet.
+func EnsureRootKey(dataDir string) error {
+	if _, err := ReadRootKey(dataDir); err == nil {
+		return nil
+	} else if !errors.Is(err, coreerrors.NotFound) {
+		return errors.Capture(err)
+	}
+	rootKey, err := NewRootKey()
+	if err != nil {
+		return errors.Capture(err)
+	}
+	return WriteRootKey(dataDir, rootKey)
+}
+
+func decodeRootKey(rootKey string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(rootKey)
+	if err != nil {
+		return nil, errors.Errorf("decoding secret encryption root key: %w", err).Add(coreerrors.NotValid)
+	}
+	if len(key) != keySize {
+		return nil, errors.Errorf(
+			"secret encryption root key has %d bytes, expected %d", len(key), keySize,
+		).Add(coreerrors.NotValid)
+	}
+	return key, nil
+}
+
+// Source derives key-encryption keys from the root key in the key file of
+// a data directory. The key file is read on first use.
+type Source struct {
+	dataDir string
+
+	mu      sync.Mutex
+	rootKey []byte
+}
+
+// NewSource returns a new Source reading the key file in the input data
+// directory.
+func NewSource(dataDir string) *Source {
+	return &Source{dataDir: dataDir}
+}
+
+// EncryptionKey returns the key-encryption key with the input UUID. The
+// same UUID always results in the same key, so rotating the key-encryption
+// key only requires recording a new UUID.
+func (s *Source) EncryptionKey(_ context.Context, keyUUID string) ([]byte, error) {
+	if keyUUID == "" {
+		return nil, errors.Errorf("empty secret encryption key uuid").Add(coreerrors.NotValid)
+	}
+	rootKey, err := s.getRootKey()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	key, err := hkdf.Key(sha256.New, rootKey, []byte(keyUUID), derivationLabel, keySize)
+	if err != nil {
+		return nil, errors.Errorf("deriving secret encryption key %q: %w", keyUUID, err)
+	}
+	return key, nil
+}
+
+func (s *Source) getRootKey() ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.rootKey != nil {
+		return s.rootKey, nil
+	}
+	encoded, err := ReadRootKey(s.dataDir)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	if s.rootKey, err = decodeRootKey(encoded); err != nil {
+		return nil, errors.Capture(err)
+	}
+	return s.rootKey, nil
+}
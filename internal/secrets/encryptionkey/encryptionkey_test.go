@@ -0,0 +1,95 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package encryptionkey
+
+import (
+	"os"
+	"testing"
+
+	"github.com/juju/tc"
+
+	coreerrors "github.com/juju/juju/core/errors"
+)
+
+type encryptionKeySuite struct{}
+
+func TestEncryptionKeySuite(t *testing.T) {
+	tc.Run(t, &encryptionKeySuite{})
+}
+
+func (s *encryptionKeySuite) TestReadRootKeyNotFound(c *tc.C) {
+	_, err := ReadRootKey(c.MkDir())
+	c.Assert(err, tc.ErrorIs, coreerrors.NotFound)
+}
+
+func (s *encryptionKeySuite) TestWriteRootKey(c *tc.C) {
+	dataDir := c.MkDir()
+	rootKey, err := NewRootKey()
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = WriteRootKey(dataDir, rootKey)
+	c.Assert(err, tc.ErrorIsNil)
+
+	info, err := os.Stat(Path(dataDir))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(info.Mode().Perm(), tc.Equals, os.FileMode(0600))
+
+	read, err := ReadRootKey(dataDir)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(read, tc.Equals, rootKey)
+
+	// Writing the same key again is a no-op.
+	err = WriteRootKey(dataDir, rootKey)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *encryptionKeySuite) TestWriteRootKeyDoesNotReplace(c *tc.C) {
+	dataDir := c.MkDir()
+	err := EnsureRootKey(dataDir)
+	c.Assert(err, tc.ErrorIsNil)
+	existing, err := ReadRootKey(dataDir)
+	c.Assert(err, tc.ErrorIsNil)
+
+	other, err := NewRootKey()
+	c.Assert(err, tc.ErrorIsNil)
+	err = WriteRootKey(dataDir, other)
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+
+	// Ensuring the key keeps the existing one.
+	err = EnsureRootKey(dataDir)
+	c.Assert(err, tc.ErrorIsNil)
+	read, err := ReadRootKey(dataDir)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(read, tc.Equals, existing)
+}
+
+func (s *encryptionKeySuite) TestWriteRootKeyNotValid(c *tc.C) {
+	err := WriteRootKey(c.MkDir(), "c2hvcnQ=")
+	c.Assert(err, tc.ErrorIs, coreerrors.NotValid)
+}
+
+func (s *encryptionKeySuite) TestSourceEncryptionKey(c *tc.C) {
+	dataDir := c.MkDir()
+	err := EnsureRootKey(dataDir)
+	c.Assert(err, tc.ErrorIsNil)
+
+	source := NewSource(dataDir)
+	first, err := source.EncryptionKey(c.Context(), "key-1")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(first, tc.HasLen, keySize)
+
+	// Keys are stable for a UUID, and differ between UUIDs.
+	again, err := NewSource(dataDir).EncryptionKey(c.Context(), "key-1")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(again, tc.DeepEquals, first)
+
+	second, err := source.EncryptionKey(c.Context(), "key-2")
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(second, tc.Not(tc.DeepEquals), first)
+}
+
+func (s *encryptionKeySuite) TestSourceEncryptionKeyNoKeyFile(c *tc.C) {
+	_, err := NewSource(c.MkDir()).EncryptionKey(c.Context(), "key-1")
+	c.Assert(err, tc.ErrorIs, coreerrors.NotFound)
+}
@@ -0,0 +1,138 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package awssecretsmanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/secrets"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+)
+
+type awsBackend struct {
+	pathPrefix string
+	client     *secretsmanager.Client
+}
+
+// secretName returns the name of the AWS secret holding the given
+// secret revision.
+func (k awsBackend) secretName(revisionId string) string {
+	return k.pathPrefix + "/" + revisionId
+}
+
+// GetContent implements SecretsBackend.
+func (k awsBackend) GetContent(ctx context.Context, revisionId string) (_ secrets.SecretValue, err error) {
+	defer func() {
+		err = maybePermissionDenied(err)
+	}()
+
+	s, err := k.client.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(k.secretName(revisionId)),
+	})
+	if isNotFound(err) {
+		return nil, fmt.Errorf("secret revision %q not found%w", revisionId, errors.Hide(secreterrors.SecretRevisionNotFound))
+	} else if err != nil {
+		return nil, errors.Annotatef(err, "getting secret %q", revisionId)
+	}
+	val := make(map[string]string)
+	if err := json.Unmarshal([]byte(aws.ToString(s.SecretString)), &val); err != nil {
+		return nil, errors.Annotatef(err, "parsing secret %q", revisionId)
+	}
+	return secrets.NewSecretValue(val), nil
+}
+
+// DeleteContent implements SecretsBackend.
+func (k awsBackend) DeleteContent(ctx context.Context, revisionId string) (err error) {
+	defer func() {
+		err = maybePermissionDenied(err)
+	}()
+
+	_, err = k.client.DeleteSecret(ctx, &secretsmanager.DeleteSecretInput{
+		SecretId:                   aws.String(k.secretName(revisionId)),
+		ForceDeleteWithoutRecovery: aws.Bool(true),
+	})
+	if isNotFound(err) {
+		return fmt.Errorf("secret revision %q not found%w", revisionId, errors.Hide(secreterrors.SecretRevisionNotFound))
+	}
+	return errors.Annotatef(err, "deleting secret %q", revisionId)
+}
+
+// SaveContent implements SecretsBackend.
+func (k awsBackend) SaveContent(ctx context.Context, uri *secrets.URI, revision int, value secrets.SecretValue) (_ string, err error) {
+	defer func() {
+		err = maybePermissionDenied(err)
+	}()
+
+	revisionId := uri.Name(revision)
+	data, err := json.Marshal(value.EncodedValues())
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	name := k.secretName(revisionId)
+	_, err = k.client.CreateSecret(ctx, &secretsmanager.CreateSecretInput{
+		Name:         aws.String(name),
+		SecretString: aws.String(string(data)),
+	})
+	if isAlreadyExists(err) {
+		// The content may have been saved by a drain worker which was
+		// restarted before it could record the revision as moved.
+		_, err = k.client.PutSecretValue(ctx, &secretsmanager.PutSecretValueInput{
+			SecretId:     aws.String(name),
+			SecretString: aws.String(string(data)),
+		})
+	}
+	if err != nil {
+		return "", errors.Annotatef(err, "saving secret content for %q", revisionId)
+	}
+	return revisionId, nil
+}
+
+// Ping implements SecretsBackend.
+func (k awsBackend) Ping() error {
+	_, err := k.client.ListSecrets(context.Background(), &secretsmanager.ListSecretsInput{
+		MaxResults: aws.Int32(1),
+	})
+	if err == nil {
+		return nil
+	}
+	if isPermissionDenied(err) {
+		return errors.New("aws credentials invalid: permission denied")
+	}
+	return errors.Annotate(err, "backend not reachable")
+}
+
+// listSecretNames returns the names of all secrets stored with the
+// backend's path prefix.
+func (k awsBackend) listSecretNames(ctx context.Context) ([]string, error) {
+	prefix := k.pathPrefix + "/"
+	paginator := secretsmanager.NewListSecretsPaginator(k.client, &secretsmanager.ListSecretsInput{
+		Filters: []types.Filter{{
+			Key:    types.FilterNameStringTypeName,
+			Values: []string{prefix},
+		}},
+	})
+	var names []string
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		for _, s := range page.SecretList {
+			// The name filter matches prefixes of any word in the name,
+			// so check the prefix proper.
+			if name := aws.ToString(s.Name); strings.HasPrefix(name, prefix) {
+				names = append(names, name)
+			}
+		}
+	}
+	return names, nil
+}
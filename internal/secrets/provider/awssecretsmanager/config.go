@@ -0,0 +1,158 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package awssecretsmanager
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/schema"
+
+	coreconfig "github.com/juju/juju/core/config"
+	"github.com/juju/juju/internal/configschema"
+	"github.com/juju/juju/internal/secrets/provider"
+)
+
+const (
+	RegionKey       = "region"
+	EndpointKey     = "endpoint"
+	STSEndpointKey  = "sts-endpoint"
+	AccessKeyKey    = "access-key"
+	SecretKeyKey    = "secret-key"
+	SessionTokenKey = "session-token"
+	RoleARNKey      = "role-arn"
+)
+
+var configSchema = configschema.Fields{
+	RegionKey: {
+		Description: "The AWS region in which to store secrets.",
+		Type:        configschema.Tstring,
+		Immutable:   true,
+		Mandatory:   true,
+	},
+	EndpointKey: {
+		Description: "The Secrets Manager endpoint, if not the default for the region.",
+		Type:        configschema.Tstring,
+		Immutable:   true,
+	},
+	STSEndpointKey: {
+		Description: "The STS endpoint, if not the default for the region.",
+		Type:        configschema.Tstring,
+	},
+	AccessKeyKey: {
+		Description: "The AWS access key ID.",
+		Type:        configschema.Tstring,
+		Mandatory:   true,
+	},
+	SecretKeyKey: {
+		Description: "The AWS secret access key.",
+		Type:        configschema.Tstring,
+		Mandatory:   true,
+		Secret:      true,
+	},
+	SessionTokenKey: {
+		Description: "The AWS session token, if the access key is temporary.",
+		Type:        configschema.Tstring,
+		Secret:      true,
+	},
+	RoleARNKey: {
+		Description: "The IAM role assumed to issue credentials restricted to the secrets an agent may access.",
+		Type:        configschema.Tstring,
+		Mandatory:   true,
+	},
+}
+
+var configDefaults = schema.Defaults{}
+
+type backendConfig struct {
+	validAttrs map[string]interface{}
+}
+
+func (c *backendConfig) region() string {
+	return c.validAttrs[RegionKey].(string)
+}
+
+func (c *backendConfig) endpoint() string {
+	v, _ := c.validAttrs[EndpointKey].(string)
+	return v
+}
+
+func (c *backendConfig) stsEndpoint() string {
+	v, _ := c.validAttrs[STSEndpointKey].(string)
+	return v
+}
+
+func (c *backendConfig) accessKey() string {
+	return c.validAttrs[AccessKeyKey].(string)
+}
+
+func (c *backendConfig) secretKey() string {
+	return c.validAttrs[SecretKeyKey].(string)
+}
+
+func (c *backendConfig) sessionToken() string {
+	v, _ := c.validAttrs[SessionTokenKey].(string)
+	return v
+}
+
+func (c *backendConfig) roleARN() string {
+	return c.validAttrs[RoleARNKey].(string)
+}
+
+// ConfigSchema implements SecretBackendProvider.
+func (p awsProvider) ConfigSchema() configschema.Fields {
+	return configSchema
+}
+
+// ConfigDefaults implements SecretBackendProvider.
+func (p awsProvider) ConfigDefaults() schema.Defaults {
+	return schema.Defaults{}
+}
+
+// ValidateConfig implements SecretBackendProvider.
+func (p awsProvider) ValidateConfig(oldCfg, newCfg provider.ConfigAttrs, tokenRotateInterval *time.Duration) error {
+	newValidCfg, err := newConfig(newCfg)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, ep := range []string{newValidCfg.endpoint(), newValidCfg.stsEndpoint()} {
+		if ep == "" {
+			continue
+		}
+		if _, err := url.Parse(ep); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	if tokenRotateInterval != nil && *tokenRotateInterval > maxSessionDuration {
+		return errors.NotValidf("token rotate interval %v greater than %v", *tokenRotateInterval, maxSessionDuration)
+	}
+
+	if oldCfg == nil {
+		return nil
+	}
+	oldValidCfg, err := newConfig(oldCfg)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for n, field := range configSchema {
+		if !field.Immutable {
+			continue
+		}
+		oldV := oldValidCfg.validAttrs[n]
+		newV := newValidCfg.validAttrs[n]
+		if oldV != newV {
+			return errors.Errorf("cannot change immutable field %q", n)
+		}
+	}
+	return nil
+}
+
+func newConfig(attrs map[string]interface{}) (*backendConfig, error) {
+	cfg, err := coreconfig.NewConfig(attrs, configSchema, configDefaults)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &backendConfig{cfg.Attributes()}, nil
+}
@@ -0,0 +1,72 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package awssecretsmanager_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/internal/secrets/provider"
+	_ "github.com/juju/juju/internal/secrets/provider/all"
+	"github.com/juju/juju/internal/secrets/provider/awssecretsmanager"
+	"github.com/juju/juju/internal/testhelpers"
+)
+
+type configSuite struct {
+	testhelpers.IsolationSuite
+}
+
+func TestConfigSuite(t *testing.T) {
+	tc.Run(t, &configSuite{})
+}
+
+func (s *configSuite) TestValidateConfig(c *tc.C) {
+	p, err := provider.Provider(awssecretsmanager.BackendType)
+	c.Assert(err, tc.ErrorIsNil)
+	configValidator, ok := p.(provider.ProviderConfig)
+	c.Assert(ok, tc.IsTrue)
+
+	validCfg := func(extra map[string]interface{}) map[string]interface{} {
+		cfg := map[string]interface{}{
+			"region":     "us-east-1",
+			"access-key": "key",
+			"secret-key": "secret",
+			"role-arn":   "arn:aws:iam::123456789012:role/juju",
+		}
+		for k, v := range extra {
+			cfg[k] = v
+		}
+		return cfg
+	}
+	twoHours := 2 * time.Hour
+	for _, t := range []struct {
+		cfg                 map[string]interface{}
+		oldCfg              map[string]interface{}
+		tokenRotateInterval *time.Duration
+		err                 string
+	}{{
+		cfg: map[string]interface{}{},
+		err: "access-key: expected string, got nothing",
+	}, {
+		cfg:    validCfg(map[string]interface{}{"region": "us-west-2"}),
+		oldCfg: validCfg(nil),
+		err:    `cannot change immutable field "region"`,
+	}, {
+		cfg:    validCfg(map[string]interface{}{"endpoint": "http://elsewhere"}),
+		oldCfg: validCfg(nil),
+		err:    `cannot change immutable field "endpoint"`,
+	}, {
+		cfg:                 validCfg(nil),
+		tokenRotateInterval: &twoHours,
+		err:                 `token rotate interval 2h0m0s greater than 1h0m0s not valid`,
+	}} {
+		err = configValidator.ValidateConfig(t.oldCfg, t.cfg, t.tokenRotateInterval)
+		c.Assert(err, tc.ErrorMatches, t.err)
+	}
+
+	err = configValidator.ValidateConfig(validCfg(nil), validCfg(map[string]interface{}{"access-key": "new-key"}), nil)
+	c.Assert(err, tc.ErrorIsNil)
+}
@@ -0,0 +1,337 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package awssecretsmanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/secrets"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+	internallogger "github.com/juju/juju/internal/logger"
+	"github.com/juju/juju/internal/secrets/provider"
+)
+
+var logger = internallogger.GetLogger("juju.secrets.awssecretsmanager")
+
+const (
+	// BackendType is the type of the AWS Secrets Manager secrets backend.
+	BackendType = "aws-secrets-manager"
+
+	// minSessionDuration and maxSessionDuration bound the lifetime of
+	// credentials issued by STS for an assumed role. The maximum is that
+	// allowed when chaining roles, as refreshed credentials are themselves
+	// temporary.
+	minSessionDuration = 15 * time.Minute
+	maxSessionDuration = time.Hour
+)
+
+// NewProvider returns an AWS Secrets Manager secrets provider.
+func NewProvider() provider.SecretBackendProvider {
+	return awsProvider{}
+}
+
+type awsProvider struct {
+}
+
+func (p awsProvider) Type() string {
+	return BackendType
+}
+
+// modelPathPrefix returns the prefix of the names of all secrets stored
+// for the model, so that models sharing a backend are kept apart.
+func modelPathPrefix(name, modelUUID string) string {
+	if name == "" || modelUUID == "" {
+		return ""
+	}
+	suffix := modelUUID[len(modelUUID)-6:]
+	return name + "-" + suffix
+}
+
+// Initialise is not needed for Secrets Manager, which has no equivalent of
+// a vault mount; secrets are namespaced by name prefix instead.
+func (p awsProvider) Initialise(cfg *provider.ModelBackendConfig) error {
+	return nil
+}
+
+// CleanupModel deletes all secrets associated with the model.
+func (p awsProvider) CleanupModel(ctx context.Context, cfg *provider.ModelBackendConfig) (err error) {
+	defer func() {
+		err = maybePermissionDenied(err)
+	}()
+
+	modelPath := modelPathPrefix(cfg.ModelName, cfg.ModelUUID)
+	if modelPath == "" {
+		return nil
+	}
+	backend, err := p.newBackend(modelPath, &cfg.BackendConfig)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	names, err := backend.listSecretNames(ctx)
+	if err != nil {
+		return errors.Annotatef(err, "listing secrets for model %q", cfg.ModelUUID)
+	}
+	for _, name := range names {
+		_, err := backend.client.DeleteSecret(ctx, &secretsmanager.DeleteSecretInput{
+			SecretId:                   aws.String(name),
+			ForceDeleteWithoutRecovery: aws.Bool(true),
+		})
+		if err != nil && !isNotFound(err) {
+			return errors.Annotatef(err, "deleting secret %q", name)
+		}
+	}
+	return nil
+}
+
+// CleanupSecrets removes any content for the removed secret revisions
+// which remains in the backend. Access to secrets is granted using session
+// policies attached to short lived credentials, so there are no policies
+// to remove.
+func (p awsProvider) CleanupSecrets(ctx context.Context, cfg *provider.ModelBackendConfig, _ secrets.Accessor, removed provider.SecretRevisions) error {
+	backend, err := p.NewBackend(cfg)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, revisionId := range removed.RevisionIDs() {
+		err := backend.DeleteContent(ctx, revisionId)
+		if err != nil && !errors.Is(err, secreterrors.SecretRevisionNotFound) {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+// policyDocument is an IAM policy document, used as the session policy
+// when issuing restricted credentials.
+type policyDocument struct {
+	Version   string            `json:"Version"`
+	Statement []policyStatement `json:"Statement"`
+}
+
+type policyStatement struct {
+	Effect   string   `json:"Effect"`
+	Action   []string `json:"Action"`
+	Resource []string `json:"Resource"`
+}
+
+var (
+	createActions = []string{"secretsmanager:CreateSecret"}
+	readActions   = []string{"secretsmanager:GetSecretValue", "secretsmanager:DescribeSecret"}
+	updateActions = []string{"secretsmanager:CreateSecret", "secretsmanager:PutSecretValue", "secretsmanager:DescribeSecret"}
+	ownerActions  = []string{
+		"secretsmanager:CreateSecret",
+		"secretsmanager:PutSecretValue",
+		"secretsmanager:GetSecretValue",
+		"secretsmanager:DescribeSecret",
+		"secretsmanager:DeleteSecret",
+	}
+)
+
+// secretARN returns an ARN pattern matching secrets whose name starts with
+// the given prefix. Secrets Manager appends a random suffix to the name in
+// the ARN, so the pattern always ends with a wildcard.
+func secretARN(namePrefix string) string {
+	return fmt.Sprintf("arn:aws:secretsmanager:*:*:secret:%s*", namePrefix)
+}
+
+// RestrictedConfig returns the config needed to create a
+// secrets backend client restricted to manage the specified
+// owned secrets and read shared secrets for the given accessor.
+// The credentials are issued by assuming the configured role, with a
+// session policy granting access to only those secrets.
+func (p awsProvider) RestrictedConfig(
+	ctx context.Context, adminCfg *provider.ModelBackendConfig, _, forDrain bool, accessor secrets.Accessor, owned provider.SecretRevisions, read provider.SecretRevisions,
+) (_ *provider.BackendConfig, err error) {
+	defer func() {
+		err = maybePermissionDenied(err)
+	}()
+
+	adminUser := accessor.Kind == secrets.ModelAccessor
+	modelPath := modelPathPrefix(adminCfg.ModelName, adminCfg.ModelUUID)
+
+	var statements []policyStatement
+	allow := func(actions []string, resource string) {
+		statements = append(statements, policyStatement{
+			Effect:   "Allow",
+			Action:   actions,
+			Resource: []string{resource},
+		})
+	}
+	if forDrain {
+		// For drain worker, we need to be able to update a secret.
+		// The worker may create a secret but get restarted before it
+		// can update the secret to the new backend, so it needs to be
+		// able to update the content after it comes up again.
+		allow(updateActions, secretARN(modelPath+"/"))
+	} else if adminUser {
+		// For admin users, all secrets for the model can be read.
+		allow(readActions, secretARN(modelPath+"/"))
+	}
+	// Agents, drain workers and admin users (creates user secrets) can create new secrets in the model.
+	allow(createActions, secretARN(modelPath+"/"))
+
+	// Any secrets owned by the agent can be updated/deleted etc.
+	logger.Debugf(ctx, "owned secrets: %#v", owned)
+	for _, id := range slices.Sorted(maps.Keys(owned)) {
+		allow(ownerActions, secretARN(fmt.Sprintf("%s/%s-", modelPath, id)))
+	}
+	// Any secrets consumed by the agent can be read etc.
+	logger.Debugf(ctx, "consumed secrets: %#v", read)
+	for _, id := range slices.Sorted(maps.Keys(read)) {
+		allow(readActions, secretARN(fmt.Sprintf("%s/%s-", modelPath, id)))
+	}
+
+	policy, err := json.Marshal(policyDocument{
+		Version:   "2012-10-17",
+		Statement: statements,
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	logger.Tracef(ctx, "session policy: %s", policy)
+
+	validCfg, err := newConfig(adminCfg.Config)
+	if err != nil {
+		return nil, errors.Annotatef(err, "invalid aws secrets manager config")
+	}
+	creds, err := assumeRole(ctx, validCfg, sessionName(modelPath, accessor), string(policy), minSessionDuration)
+	if err != nil {
+		return nil, errors.Annotate(err, "creating secret access credentials")
+	}
+
+	cfg := adminCfg.BackendConfig
+	cfg.Config = withCredentials(cfg.Config, creds)
+	return &cfg, nil
+}
+
+// sessionName returns the name of the role session for the given accessor,
+// which appears in CloudTrail logs. Role session names are limited to 64
+// characters from a restricted set.
+func sessionName(modelPath string, accessor secrets.Accessor) string {
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		case strings.ContainsRune("+=,.@-", r):
+			return r
+		}
+		return '-'
+	}, fmt.Sprintf("juju-%s-%s-%s", modelPath, accessor.Kind, accessor.ID))
+	if len(name) > 64 {
+		name = name[:64]
+	}
+	return name
+}
+
+// assumeRole returns temporary credentials for the configured role,
+// limited by the given session policy if not empty.
+func assumeRole(
+	ctx context.Context, cfg *backendConfig, sessionName, policy string, duration time.Duration,
+) (*sts.AssumeRoleOutput, error) {
+	client := sts.NewFromConfig(awsConfig(cfg), func(o *sts.Options) {
+		if ep := cfg.stsEndpoint(); ep != "" {
+			o.BaseEndpoint = aws.String(ep)
+		}
+	})
+	in := &sts.AssumeRoleInput{
+		RoleArn:         aws.String(cfg.roleARN()),
+		RoleSessionName: aws.String(sessionName),
+		DurationSeconds: aws.Int32(int32(duration.Seconds())),
+	}
+	if policy != "" {
+		in.Policy = aws.String(policy)
+	}
+	out, err := client.AssumeRole(ctx, in)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if out.Credentials == nil {
+		return nil, errors.Errorf("assuming role %q returned no credentials", cfg.roleARN())
+	}
+	return out, nil
+}
+
+// withCredentials returns a copy of the config using the temporary
+// credentials.
+func withCredentials(cfg provider.ConfigAttrs, creds *sts.AssumeRoleOutput) provider.ConfigAttrs {
+	result := make(provider.ConfigAttrs, len(cfg)+1)
+	for k, v := range cfg {
+		result[k] = v
+	}
+	result[AccessKeyKey] = aws.ToString(creds.Credentials.AccessKeyId)
+	result[SecretKeyKey] = aws.ToString(creds.Credentials.SecretAccessKey)
+	result[SessionTokenKey] = aws.ToString(creds.Credentials.SessionToken)
+	return result
+}
+
+// HTTPClient is used by AWS clients, and is patched for testing.
+var HTTPClient aws.HTTPClient = http.DefaultClient
+
+func awsConfig(cfg *backendConfig) aws.Config {
+	return aws.Config{
+		Region: cfg.region(),
+		Credentials: credentials.NewStaticCredentialsProvider(
+			cfg.accessKey(), cfg.secretKey(), cfg.sessionToken(),
+		),
+		HTTPClient: HTTPClient,
+	}
+}
+
+// NewBackend returns an AWS Secrets Manager backed secrets backend client.
+func (p awsProvider) NewBackend(cfg *provider.ModelBackendConfig) (provider.SecretsBackend, error) {
+	return p.newBackend(modelPathPrefix(cfg.ModelName, cfg.ModelUUID), &cfg.BackendConfig)
+}
+
+func (p awsProvider) newBackend(modelPathPrefix string, cfg *provider.BackendConfig) (*awsBackend, error) {
+	validCfg, err := newConfig(cfg.Config)
+	if err != nil {
+		return nil, errors.Annotatef(err, "invalid aws secrets manager config")
+	}
+	client := secretsmanager.NewFromConfig(awsConfig(validCfg), func(o *secretsmanager.Options) {
+		if ep := validCfg.endpoint(); ep != "" {
+			o.BaseEndpoint = aws.String(ep)
+		}
+	})
+	return &awsBackend{pathPrefix: modelPathPrefix, client: client}, nil
+}
+
+// RefreshAuth implements SupportAuthRefresh. The configured role is
+// assumed again to issue new temporary credentials, valid for the
+// requested duration within the limits allowed by STS.
+func (p awsProvider) RefreshAuth(ctx context.Context, backendConfig provider.BackendConfig, validFor time.Duration) (_ *provider.BackendConfig, err error) {
+	defer func() {
+		err = maybePermissionDenied(err)
+	}()
+
+	validCfg, err := newConfig(backendConfig.Config)
+	if err != nil {
+		return nil, errors.Annotatef(err, "invalid aws secrets manager config")
+	}
+	duration := validFor.Truncate(time.Second)
+	if duration < minSessionDuration {
+		duration = minSessionDuration
+	} else if duration > maxSessionDuration {
+		duration = maxSessionDuration
+	}
+	creds, err := assumeRole(ctx, validCfg, "juju-controller", "", duration)
+	if err != nil {
+		return nil, errors.Annotate(err, "creating new credentials")
+	}
+	backendConfig.Config = withCredentials(backendConfig.Config, creds)
+	return &backendConfig, nil
+}
@@ -0,0 +1,284 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package awssecretsmanager_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/secrets"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+	"github.com/juju/juju/internal/secrets/provider"
+	_ "github.com/juju/juju/internal/secrets/provider/all"
+	"github.com/juju/juju/internal/secrets/provider/awssecretsmanager"
+	"github.com/juju/juju/internal/testhelpers"
+	coretesting "github.com/juju/juju/internal/testing"
+)
+
+type providerSuite struct {
+	testhelpers.IsolationSuite
+	coretesting.JujuOSEnvSuite
+
+	aws *fakeAWS
+}
+
+func TestProviderSuite(t *testing.T) {
+	tc.Run(t, &providerSuite{})
+}
+
+func (s *providerSuite) SetUpTest(c *tc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.JujuOSEnvSuite.SetUpTest(c)
+
+	s.aws = newFakeAWS()
+	s.AddCleanup(func(*tc.C) { s.aws.Close() })
+}
+
+func (s *providerSuite) backendConfig() *provider.ModelBackendConfig {
+	return &provider.ModelBackendConfig{
+		ControllerUUID: coretesting.ControllerTag.Id(),
+		ModelUUID:      coretesting.ModelTag.Id(),
+		ModelName:      "fred",
+		BackendConfig: provider.BackendConfig{
+			BackendType: awssecretsmanager.BackendType,
+			Config: map[string]interface{}{
+				"region":       "us-east-1",
+				"endpoint":     s.aws.URL,
+				"sts-endpoint": s.aws.URL,
+				"access-key":   "admin-key",
+				"secret-key":   "admin-secret",
+				"role-arn":     "arn:aws:iam::123456789012:role/juju",
+			},
+		},
+	}
+}
+
+func (s *providerSuite) newBackend(c *tc.C) provider.SecretsBackend {
+	p, err := provider.Provider(awssecretsmanager.BackendType)
+	c.Assert(err, tc.ErrorIsNil)
+	b, err := p.NewBackend(s.backendConfig())
+	c.Assert(err, tc.ErrorIsNil)
+	return b
+}
+
+func (s *providerSuite) TestSaveContent(c *tc.C) {
+	b := s.newBackend(c)
+
+	uri := secrets.NewURI()
+	revisionId, err := b.SaveContent(c.Context(), uri, 1, secrets.NewSecretValue(map[string]string{"foo": "YmFy"}))
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(revisionId, tc.Equals, uri.ID+"-1")
+	c.Assert(s.aws.SecretNames(), tc.DeepEquals, []string{"fred-" + coretesting.ModelTag.Id()[30:] + "/" + revisionId})
+
+	// Saving again, as when a drain is restarted, updates the content.
+	_, err = b.SaveContent(c.Context(), uri, 1, secrets.NewSecretValue(map[string]string{"foo": "YmF6"}))
+	c.Assert(err, tc.ErrorIsNil)
+	val, err := b.GetContent(c.Context(), revisionId)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(val.EncodedValues(), tc.DeepEquals, map[string]string{"foo": "YmF6"})
+}
+
+func (s *providerSuite) TestGetContent(c *tc.C) {
+	b := s.newBackend(c)
+
+	uri := secrets.NewURI()
+	revisionId, err := b.SaveContent(c.Context(), uri, 1, secrets.NewSecretValue(map[string]string{"foo": "YmFy"}))
+	c.Assert(err, tc.ErrorIsNil)
+
+	val, err := b.GetContent(c.Context(), revisionId)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(val.EncodedValues(), tc.DeepEquals, map[string]string{"foo": "YmFy"})
+}
+
+func (s *providerSuite) TestGetContentNotFound(c *tc.C) {
+	_, err := s.newBackend(c).GetContent(c.Context(), "missing-1")
+	c.Assert(err, tc.ErrorIs, secreterrors.SecretRevisionNotFound)
+}
+
+func (s *providerSuite) TestDeleteContent(c *tc.C) {
+	b := s.newBackend(c)
+
+	uri := secrets.NewURI()
+	revisionId, err := b.SaveContent(c.Context(), uri, 1, secrets.NewSecretValue(map[string]string{"foo": "YmFy"}))
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = b.DeleteContent(c.Context(), revisionId)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(s.aws.SecretNames(), tc.HasLen, 0)
+
+	err = b.DeleteContent(c.Context(), revisionId)
+	c.Assert(err, tc.ErrorIs, secreterrors.SecretRevisionNotFound)
+}
+
+func (s *providerSuite) TestPermissionDenied(c *tc.C) {
+	s.aws.SetDenied(true)
+	_, err := s.newBackend(c).GetContent(c.Context(), "some-1")
+	c.Assert(err, tc.ErrorMatches, `getting secret "some-1": .*access denied`)
+}
+
+func (s *providerSuite) TestPing(c *tc.C) {
+	b := s.newBackend(c)
+	c.Assert(b.Ping(), tc.ErrorIsNil)
+
+	s.aws.SetDenied(true)
+	c.Assert(b.Ping(), tc.ErrorMatches, "aws credentials invalid: permission denied")
+}
+
+func (s *providerSuite) TestCleanupModel(c *tc.C) {
+	b := s.newBackend(c)
+	_, err := b.SaveContent(c.Context(), secrets.NewURI(), 1, secrets.NewSecretValue(map[string]string{"foo": "YmFy"}))
+	c.Assert(err, tc.ErrorIsNil)
+
+	// Secrets for another model are untouched.
+	otherCfg := s.backendConfig()
+	otherCfg.ModelName = "wilma"
+	p, err := provider.Provider(awssecretsmanager.BackendType)
+	c.Assert(err, tc.ErrorIsNil)
+	other, err := p.NewBackend(otherCfg)
+	c.Assert(err, tc.ErrorIsNil)
+	otherRevisionId, err := other.SaveContent(c.Context(), secrets.NewURI(), 1, secrets.NewSecretValue(map[string]string{"foo": "YmFy"}))
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = p.CleanupModel(c.Context(), s.backendConfig())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(s.aws.SecretNames(), tc.DeepEquals, []string{"wilma-" + coretesting.ModelTag.Id()[30:] + "/" + otherRevisionId})
+}
+
+func (s *providerSuite) TestCleanupSecrets(c *tc.C) {
+	b := s.newBackend(c)
+	uri := secrets.NewURI()
+	_, err := b.SaveContent(c.Context(), uri, 1, secrets.NewSecretValue(map[string]string{"foo": "YmFy"}))
+	c.Assert(err, tc.ErrorIsNil)
+
+	p, err := provider.Provider(awssecretsmanager.BackendType)
+	c.Assert(err, tc.ErrorIsNil)
+	err = p.CleanupSecrets(c.Context(), s.backendConfig(), secrets.Accessor{}, provider.SecretRevisions{
+		uri.ID: set.NewStrings(uri.ID+"-1", uri.ID+"-2"),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(s.aws.SecretNames(), tc.HasLen, 0)
+}
+
+func (s *providerSuite) TestRestrictedConfig(c *tc.C) {
+	p, err := provider.Provider(awssecretsmanager.BackendType)
+	c.Assert(err, tc.ErrorIsNil)
+
+	accessor := secrets.Accessor{Kind: secrets.UnitAccessor, ID: "ubuntu/0"}
+	adminCfg := s.backendConfig()
+	cfg, err := p.RestrictedConfig(c.Context(), adminCfg, true, false, accessor,
+		provider.SecretRevisions{"owned-id": set.NewStrings("owned-id-1")},
+		provider.SecretRevisions{"read-id": set.NewStrings("read-id-1")},
+	)
+	c.Assert(err, tc.ErrorIsNil)
+
+	modelPath := "fred-" + coretesting.ModelTag.Id()[30:]
+	session := "juju-" + modelPath + "-unit-ubuntu-0"
+	c.Check(cfg.Config["access-key"], tc.Equals, session+"-key")
+	c.Check(cfg.Config["secret-key"], tc.Equals, session+"-secret")
+	c.Check(cfg.Config["session-token"], tc.Equals, session+"-token")
+	c.Check(adminCfg.Config["access-key"], tc.Equals, "admin-key")
+
+	var policy map[string]interface{}
+	err = json.Unmarshal([]byte(s.aws.SessionPolicy(session)), &policy)
+	c.Assert(err, tc.ErrorIsNil)
+	arn := "arn:aws:secretsmanager:*:*:secret:" + modelPath
+	c.Assert(policy, tc.DeepEquals, map[string]interface{}{
+		"Version": "2012-10-17",
+		"Statement": []interface{}{
+			map[string]interface{}{
+				"Effect":   "Allow",
+				"Action":   []interface{}{"secretsmanager:CreateSecret"},
+				"Resource": []interface{}{arn + "/*"},
+			},
+			map[string]interface{}{
+				"Effect": "Allow",
+				"Action": []interface{}{
+					"secretsmanager:CreateSecret",
+					"secretsmanager:PutSecretValue",
+					"secretsmanager:GetSecretValue",
+					"secretsmanager:DescribeSecret",
+					"secretsmanager:DeleteSecret",
+				},
+				"Resource": []interface{}{arn + "/owned-id-*"},
+			},
+			map[string]interface{}{
+				"Effect":   "Allow",
+				"Action":   []interface{}{"secretsmanager:GetSecretValue", "secretsmanager:DescribeSecret"},
+				"Resource": []interface{}{arn + "/read-id-*"},
+			},
+		},
+	})
+
+	// The restricted config is used to access the backend.
+	b, err := p.NewBackend(&provider.ModelBackendConfig{
+		ModelUUID:     adminCfg.ModelUUID,
+		ModelName:     adminCfg.ModelName,
+		BackendConfig: *cfg,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = b.GetContent(c.Context(), "read-id-1")
+	c.Assert(err, tc.ErrorIs, secreterrors.SecretRevisionNotFound)
+	keys := s.aws.AccessKeys()
+	c.Assert(keys[len(keys)-1], tc.Equals, session+"-key")
+}
+
+func (s *providerSuite) TestRestrictedConfigAdmin(c *tc.C) {
+	p, err := provider.Provider(awssecretsmanager.BackendType)
+	c.Assert(err, tc.ErrorIsNil)
+
+	accessor := secrets.Accessor{Kind: secrets.ModelAccessor, ID: coretesting.ModelTag.Id()}
+	_, err = p.RestrictedConfig(c.Context(), s.backendConfig(), true, false, accessor, nil, nil)
+	c.Assert(err, tc.ErrorIsNil)
+
+	modelPath := "fred-" + coretesting.ModelTag.Id()[30:]
+	var policy struct {
+		Statement []struct {
+			Action   []string
+			Resource []string
+		}
+	}
+	err = json.Unmarshal([]byte(s.aws.SessionPolicy("juju-"+modelPath+"-model-"+coretesting.ModelTag.Id())), &policy)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(policy.Statement, tc.HasLen, 2)
+	c.Check(policy.Statement[0].Action, tc.DeepEquals, []string{"secretsmanager:GetSecretValue", "secretsmanager:DescribeSecret"})
+	c.Check(policy.Statement[0].Resource, tc.DeepEquals, []string{"arn:aws:secretsmanager:*:*:secret:" + modelPath + "/*"})
+}
+
+func (s *providerSuite) TestRestrictedConfigPermissionDenied(c *tc.C) {
+	s.aws.SetDenied(true)
+	p, err := provider.Provider(awssecretsmanager.BackendType)
+	c.Assert(err, tc.ErrorIsNil)
+
+	accessor := secrets.Accessor{Kind: secrets.UnitAccessor, ID: "ubuntu/0"}
+	_, err = p.RestrictedConfig(c.Context(), s.backendConfig(), true, false, accessor, nil, nil)
+	c.Assert(err, tc.ErrorMatches, "creating secret access credentials: .*denied")
+}
+
+func (s *providerSuite) TestRefreshAuth(c *tc.C) {
+	p, err := provider.Provider(awssecretsmanager.BackendType)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(provider.HasAuthRefresh(p), tc.IsTrue)
+
+	cfg, err := p.(provider.SupportAuthRefresh).RefreshAuth(c.Context(), s.backendConfig().BackendConfig, time.Hour)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(cfg.Config["access-key"], tc.Equals, "juju-controller-key")
+	c.Check(cfg.Config["secret-key"], tc.Equals, "juju-controller-secret")
+	c.Check(cfg.Config["session-token"], tc.Equals, "juju-controller-token")
+	// Session policies are only used for restricted credentials.
+	c.Check(s.aws.SessionPolicy("juju-controller"), tc.Equals, "")
+}
+
+func (s *providerSuite) TestRefreshAuthPermissionDenied(c *tc.C) {
+	s.aws.SetDenied(true)
+	p, err := provider.Provider(awssecretsmanager.BackendType)
+	c.Assert(err, tc.ErrorIsNil)
+
+	_, err = p.(provider.SupportAuthRefresh).RefreshAuth(c.Context(), s.backendConfig().BackendConfig, time.Hour)
+	c.Assert(errors.Cause(err), tc.ErrorMatches, ".*denied")
+}
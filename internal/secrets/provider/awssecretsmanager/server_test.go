@@ -0,0 +1,185 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package awssecretsmanager_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// fakeAWS is a local stand-in for the Secrets Manager and STS APIs,
+// implementing just enough of each to exercise the provider.
+type fakeAWS struct {
+	*httptest.Server
+
+	mu sync.Mutex
+	// secrets holds secret strings by name.
+	secrets map[string]string
+	// sessionPolicies records the policy of each role session assumed.
+	sessionPolicies map[string]string
+	// accessKeys records the access key used to sign each request.
+	accessKeys []string
+	// denied causes all requests to be rejected as unauthorised.
+	denied bool
+}
+
+func newFakeAWS() *fakeAWS {
+	f := &fakeAWS{
+		secrets:         make(map[string]string),
+		sessionPolicies: make(map[string]string),
+	}
+	f.Server = httptest.NewServer(http.HandlerFunc(f.serveHTTP))
+	return f
+}
+
+func (f *fakeAWS) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	// The credential scope is "<access-key>/<date>/<region>/<service>/aws4_request".
+	auth := r.Header.Get("Authorization")
+	if _, cred, ok := strings.Cut(auth, "Credential="); ok {
+		accessKey, _, _ := strings.Cut(cred, "/")
+		f.accessKeys = append(f.accessKeys, accessKey)
+	}
+
+	if target := r.Header.Get("X-Amz-Target"); target != "" {
+		f.serveSecretsManager(w, r, strings.TrimPrefix(target, "secretsmanager."))
+		return
+	}
+	f.serveSTS(w, r)
+}
+
+func (f *fakeAWS) serveSecretsManager(w http.ResponseWriter, r *http.Request, action string) {
+	writeError := func(code, message string) {
+		w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(map[string]string{"__type": code, "message": message})
+	}
+	if f.denied {
+		writeError("AccessDeniedException", "access denied")
+		return
+	}
+
+	var in struct {
+		Name         string
+		SecretId     string
+		SecretString string
+		Filters      []struct {
+			Key    string
+			Values []string
+		}
+	}
+	if err := json.NewDecoder(r.Body).Decode(&in); err != nil {
+		writeError("InvalidRequestException", err.Error())
+		return
+	}
+
+	var out any = struct{}{}
+	switch action {
+	case "CreateSecret":
+		if _, ok := f.secrets[in.Name]; ok {
+			writeError("ResourceExistsException", "secret exists")
+			return
+		}
+		f.secrets[in.Name] = in.SecretString
+		out = map[string]string{"Name": in.Name}
+	case "PutSecretValue", "GetSecretValue", "DeleteSecret":
+		value, ok := f.secrets[in.SecretId]
+		if !ok {
+			writeError("ResourceNotFoundException", "secret not found")
+			return
+		}
+		switch action {
+		case "PutSecretValue":
+			f.secrets[in.SecretId] = in.SecretString
+		case "GetSecretValue":
+			out = map[string]string{"Name": in.SecretId, "SecretString": value}
+		case "DeleteSecret":
+			delete(f.secrets, in.SecretId)
+		}
+	case "ListSecrets":
+		var list []map[string]string
+		for _, name := range f.secretNames() {
+			match := true
+			for _, filter := range in.Filters {
+				if filter.Key == "name" && !strings.HasPrefix(name, filter.Values[0]) {
+					match = false
+				}
+			}
+			if match {
+				list = append(list, map[string]string{"Name": name})
+			}
+		}
+		out = map[string]any{"SecretList": list}
+	default:
+		writeError("InvalidAction", action)
+		return
+	}
+	w.Header().Set("Content-Type", "application/x-amz-json-1.1")
+	_ = json.NewEncoder(w).Encode(out)
+}
+
+func (f *fakeAWS) serveSTS(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", "text/xml")
+	if f.denied || r.Form.Get("Action") != "AssumeRole" {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `<ErrorResponse><Error><Type>Sender</Type><Code>AccessDenied</Code><Message>denied</Message></Error></ErrorResponse>`)
+		return
+	}
+	session := r.Form.Get("RoleSessionName")
+	f.sessionPolicies[session] = r.Form.Get("Policy")
+	fmt.Fprintf(w, `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleResult>
+    <Credentials>
+      <AccessKeyId>%s-key</AccessKeyId>
+      <SecretAccessKey>%s-secret</SecretAccessKey>
+      <SessionToken>%s-token</SessionToken>
+      <Expiration>2100-01-01T00:00:00Z</Expiration>
+    </Credentials>
+  </AssumeRoleResult>
+</AssumeRoleResponse>`, session, session, session)
+}
+
+func (f *fakeAWS) secretNames() []string {
+	var names []string
+	for name := range f.secrets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (f *fakeAWS) SecretNames() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.secretNames()
+}
+
+func (f *fakeAWS) SessionPolicy(session string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.sessionPolicies[session]
+}
+
+func (f *fakeAWS) AccessKeys() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.accessKeys...)
+}
+
+func (f *fakeAWS) SetDenied(denied bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.denied = denied
+}
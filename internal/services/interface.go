@@ -118,7 +118,7 @@ type ModelDomainServices interface {
 	// Application returns the application service.
 	Application() *applicationservice.WatchableService
 	// Status returns the application status service.
-	Status() *statusservice.LeadershipService
+	Status() *statusservice.WatchableService
 	// Resolve returns the resolve service.
 	Resolve() *resolveservice.WatchableService
 	// KeyManager returns the key manager service.
@@ -223,6 +223,25 @@ type VolumeImporter interface {
 	) (VolumeInfo, error)
 }
 
+// VolumeResizer provides an interface for growing volumes in place.
+type VolumeResizer interface {
+	// ResizeVolumes grows each of the volumes with the specified
+	// provider IDs to at least the corresponding size in MiB, and
+	// returns the resulting volume information. Volumes are never
+	// shrunk; a volume already at least as large as requested is
+	// left untouched.
+	ResizeVolumes(ctx context.Context, args []ResizeVolumeParams) ([]DescribeVolumesResult, error)
+}
+
+// FilesystemResizer provides an interface for growing filesystems in
+// place.
+type FilesystemResizer interface {
+	// ResizeFilesystems grows each of the filesystems with the
+	// specified provider IDs to at least the corresponding size in
+	// MiB. Filesystems are never shrunk.
+	ResizeFilesystems(ctx context.Context, args []ResizeFilesystemParams) ([]error, error)
+}
+
 // VolumeParams is a fully specified set of parameters for volume creation,
 // derived from one or more of user-specified storage directives, a
 // storage pool definition, and charm storage metadata.
@@ -346,6 +365,30 @@ type FilesystemAttachmentParams struct {
 	Path string
 }
 
+// ResizeVolumeParams is a set of parameters for growing a volume.
+type ResizeVolumeParams struct {
+	// Volume is the tag of the volume to resize.
+	Volume names.VolumeTag
+
+	// VolumeId is the unique provider-supplied ID for the volume.
+	VolumeId string
+
+	// Size is the requested minimum size of the volume in MiB.
+	Size uint64
+}
+
+// ResizeFilesystemParams is a set of parameters for growing a filesystem.
+type ResizeFilesystemParams struct {
+	// Filesystem is the tag of the filesystem to resize.
+	Filesystem names.FilesystemTag
+
+	// ProviderId is the unique provider-supplied ID for the filesystem.
+	ProviderId string
+
+	// Size is the requested minimum size of the filesystem in MiB.
+	Size uint64
+}
+
 // CreateVolumesResult contains the result of a VolumeSource.CreateVolumes call
 // for one volume. Volume and VolumeAttachment should only be used if Error is
 // nil.
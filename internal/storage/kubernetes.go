@@ -24,6 +24,10 @@ type KubernetesFilesystemParams struct {
 	// storage provider supports tags.
 	ResourceTags map[string]string
 
+	// Shared is true when a single filesystem is mounted by all units of
+	// the application.
+	Shared bool
+
 	// Attachment identifies the mount point the filesystem should be
 	// mounted at.
 	Attachment *KubernetesFilesystemAttachmentParams
@@ -131,3 +131,13 @@ func TmpfsFilesystemSource(etcDir, storageDir string, run func(context.Context,
 		storageDir,
 	}
 }
+
+func ZFSFilesystemSource(etcDir, pool string, run func(context.Context, string, ...string) (string, error), fakeMountInfo ...string) (storage.FilesystemSource, *MockDirFuncs) {
+	rdr := strings.NewReader(strings.Join(fakeMountInfo, "\n"))
+	d := &MockDirFuncs{
+		osDirFuncs{run: run, mountInfoRdr: rdr},
+		etcDir,
+		set.NewStrings(),
+	}
+	return &zfsFilesystemSource{d, run, pool}, d
+}
@@ -0,0 +1,452 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/names/v6"
+	"github.com/juju/schema"
+
+	"github.com/juju/juju/internal/storage"
+)
+
+const (
+	// LVMProviderType is the storage provider type for LVM logical
+	// volumes, carved out of a volume group on the machine.
+	LVMProviderType = storage.ProviderType("lvm")
+
+	// LVMVolumeGroup is the pool attribute naming the volume group in
+	// which logical volumes are created.
+	LVMVolumeGroup = "volume-group"
+)
+
+// validVolumeGroupName matches the names LVM accepts for volume groups.
+var validVolumeGroupName = regexp.MustCompile(`^[a-zA-Z0-9+_.][a-zA-Z0-9+_.-]*$`)
+
+var lvmConfigChecker = schema.FieldMap(
+	schema.Fields{
+		LVMVolumeGroup: schema.String(),
+	},
+	schema.Defaults{},
+)
+
+// LVMProvider provides a storage volume source to Juju that creates
+// logical volumes in an existing volume group on a machine.
+type LVMProvider struct {
+	// run is a function used for running commands on the local machine.
+	run RunCommandFunc
+}
+
+var _ storage.Provider = (*LVMProvider)(nil)
+
+// NewLVMProvider returns a new lvm storage provider.
+func NewLVMProvider(run RunCommandFunc) *LVMProvider {
+	return &LVMProvider{
+		run: run,
+	}
+}
+
+// ValidateForK8s is defined on the Provider interface.
+func (*LVMProvider) ValidateForK8s(map[string]any) error {
+	return errors.NotValidf("storage provider type %q", LVMProviderType)
+}
+
+// ValidateConfig is defined on the Provider interface.
+func (*LVMProvider) ValidateConfig(cfg *storage.Config) error {
+	_, err := lvmVolumeGroup(cfg)
+	return errors.Trace(err)
+}
+
+// lvmVolumeGroup returns the validated volume group of the specified
+// pool config.
+func lvmVolumeGroup(cfg *storage.Config) (string, error) {
+	coerced, err := lvmConfigChecker.Coerce(cfg.Attrs(), nil)
+	if err != nil {
+		return "", errors.Annotate(err, "validating lvm storage config")
+	}
+	vg := coerced.(map[string]any)[LVMVolumeGroup].(string)
+	if !validVolumeGroupName.MatchString(vg) {
+		return "", errors.NotValidf("volume group name %q", vg)
+	}
+	return vg, nil
+}
+
+// VolumeSource is defined on the Provider interface.
+func (p *LVMProvider) VolumeSource(sourceConfig *storage.Config) (storage.VolumeSource, error) {
+	vg, err := lvmVolumeGroup(sourceConfig)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &lvmVolumeSource{
+		run:         p.run,
+		volumeGroup: vg,
+	}, nil
+}
+
+// FilesystemSource is defined on the Provider interface.
+func (*LVMProvider) FilesystemSource(*storage.Config) (storage.FilesystemSource, error) {
+	return nil, errors.NotSupportedf("filesystems")
+}
+
+// Supports is defined on the Provider interface.
+func (*LVMProvider) Supports(k storage.StorageKind) bool {
+	return k == storage.StorageKindBlock
+}
+
+// Scope is defined on the Provider interface.
+func (*LVMProvider) Scope() storage.Scope {
+	return storage.ScopeMachine
+}
+
+// Dynamic is defined on the Provider interface.
+func (*LVMProvider) Dynamic() bool {
+	return true
+}
+
+// Releasable is defined on the Provider interface.
+func (*LVMProvider) Releasable() bool {
+	return false
+}
+
+// DefaultPools provides the default storage pools available through this
+// provider.
+//
+// There is no volume group common to all machines, so lvm pools must be
+// created by the user.
+//
+// Implements [storage.Provider] interface.
+func (*LVMProvider) DefaultPools() []*storage.Config {
+	return nil
+}
+
+// lvmVolumeSource creates Juju volumes as logical volumes, named after
+// the volume tag, in a single volume group.
+type lvmVolumeSource struct {
+	run         RunCommandFunc
+	volumeGroup string
+}
+
+var (
+	_ storage.VolumeSource  = (*lvmVolumeSource)(nil)
+	_ storage.VolumeResizer = (*lvmVolumeSource)(nil)
+)
+
+// logicalVolume describes a logical volume, as reported by lvs.
+type logicalVolume struct {
+	name string
+	// size is the size of the logical volume in MiB.
+	size uint64
+	// attr is the lv_attr field, whose characters describe the
+	// volume's permissions, state etc.
+	attr string
+}
+
+func (lv logicalVolume) writable() bool {
+	return len(lv.attr) > 1 && lv.attr[1] == 'w'
+}
+
+func (lv logicalVolume) active() bool {
+	return len(lv.attr) > 4 && lv.attr[4] == 'a'
+}
+
+// ValidateVolumeParams is defined on the VolumeSource interface.
+func (s *lvmVolumeSource) ValidateVolumeParams(params storage.VolumeParams) error {
+	// ValidateVolumeParams may be called on a machine other than the
+	// machine where the logical volume will be created, so we cannot
+	// check the volume group until we get to CreateVolumes.
+	return nil
+}
+
+// CreateVolumes is defined on the VolumeSource interface.
+func (s *lvmVolumeSource) CreateVolumes(ctx context.Context, args []storage.VolumeParams) ([]storage.CreateVolumesResult, error) {
+	results := make([]storage.CreateVolumesResult, len(args))
+	lvs, err := s.logicalVolumes(ctx)
+	if err != nil {
+		for i := range results {
+			results[i].Error = errors.Annotate(err, "creating volume")
+		}
+		return results, nil
+	}
+	for i, arg := range args {
+		volume, err := s.createVolume(ctx, arg, lvs)
+		if err != nil {
+			results[i].Error = errors.Annotate(err, "creating volume")
+			continue
+		}
+		results[i].Volume = volume
+	}
+	return results, nil
+}
+
+// createVolume creates the logical volume for the specified volume. A
+// logical volume left behind by an earlier attempt is reused, growing
+// it if necessary.
+func (s *lvmVolumeSource) createVolume(
+	ctx context.Context, params storage.VolumeParams, lvs map[string]logicalVolume,
+) (*storage.Volume, error) {
+	name := params.Tag.String()
+	size := params.Size
+	if lv, ok := lvs[name]; ok {
+		var err error
+		if size, err = s.extend(ctx, lv, params.Size); err != nil {
+			return nil, errors.Trace(err)
+		}
+	} else if _, err := s.run(
+		ctx, "lvcreate", "--yes", "--name", name, "--size", fmt.Sprintf("%dm", params.Size), s.volumeGroup,
+	); err != nil {
+		return nil, errors.Annotatef(err, "creating logical volume %q", name)
+	}
+	return &storage.Volume{
+		Tag: params.Tag,
+		VolumeInfo: storage.VolumeInfo{
+			VolumeId: s.volumeId(name),
+			Size:     size,
+		},
+	}, nil
+}
+
+// extend grows the logical volume to at least the specified size in MiB,
+// returning its resulting size.
+func (s *lvmVolumeSource) extend(ctx context.Context, lv logicalVolume, size uint64) (uint64, error) {
+	if lv.size >= size {
+		return lv.size, nil
+	}
+	if _, err := s.run(
+		ctx, "lvextend", "--size", fmt.Sprintf("%dm", size), s.volumeId(lv.name),
+	); err != nil {
+		return 0, errors.Annotatef(err, "extending logical volume %q", lv.name)
+	}
+	return size, nil
+}
+
+// ListVolumes is defined on the VolumeSource interface.
+func (s *lvmVolumeSource) ListVolumes(ctx context.Context) ([]string, error) {
+	lvs, err := s.logicalVolumes(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var volumeIds []string
+	for name := range lvs {
+		// Only logical volumes named after volume tags are Juju's.
+		if _, err := names.ParseVolumeTag(name); err == nil {
+			volumeIds = append(volumeIds, s.volumeId(name))
+		}
+	}
+	slices.Sort(volumeIds)
+	return volumeIds, nil
+}
+
+// DescribeVolumes is defined on the VolumeSource interface.
+func (s *lvmVolumeSource) DescribeVolumes(ctx context.Context, volumeIds []string) ([]storage.DescribeVolumesResult, error) {
+	lvs, err := s.logicalVolumes(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	results := make([]storage.DescribeVolumesResult, len(volumeIds))
+	for i, volumeId := range volumeIds {
+		lv, err := s.lookup(volumeId, lvs)
+		if err != nil {
+			results[i].Error = err
+			continue
+		}
+		results[i].VolumeInfo = &storage.VolumeInfo{
+			VolumeId: volumeId,
+			Size:     lv.size,
+		}
+	}
+	return results, nil
+}
+
+// DestroyVolumes is defined on the VolumeSource interface.
+func (s *lvmVolumeSource) DestroyVolumes(ctx context.Context, volumeIds []string) ([]error, error) {
+	lvs, err := s.logicalVolumes(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	results := make([]error, len(volumeIds))
+	for i, volumeId := range volumeIds {
+		_, err := s.lookup(volumeId, lvs)
+		if errors.Is(err, errors.NotFound) {
+			continue
+		} else if err != nil {
+			results[i] = errors.Annotatef(err, "destroying %q", volumeId)
+			continue
+		}
+		if _, err := s.run(ctx, "lvremove", "--yes", volumeId); err != nil {
+			results[i] = errors.Annotatef(err, "destroying %q", volumeId)
+		}
+	}
+	return results, nil
+}
+
+// ReleaseVolumes is defined on the VolumeSource interface.
+func (s *lvmVolumeSource) ReleaseVolumes(ctx context.Context, volumeIds []string) ([]error, error) {
+	return make([]error, len(volumeIds)), nil
+}
+
+// AttachVolumes is defined on the VolumeSource interface.
+func (s *lvmVolumeSource) AttachVolumes(ctx context.Context, args []storage.VolumeAttachmentParams) ([]storage.AttachVolumesResult, error) {
+	lvs, err := s.logicalVolumes(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	results := make([]storage.AttachVolumesResult, len(args))
+	for i, arg := range args {
+		attachment, err := s.attachVolume(ctx, arg, lvs)
+		if err != nil {
+			results[i].Error = errors.Annotatef(err, "attaching volume %v", arg.Volume.Id())
+			continue
+		}
+		results[i].VolumeAttachment = attachment
+	}
+	return results, nil
+}
+
+// attachVolume activates the logical volume with the requested
+// permission. The device mapper device is identified by a link that
+// the diskmanager worker reports for the machine's block devices.
+func (s *lvmVolumeSource) attachVolume(
+	ctx context.Context, arg storage.VolumeAttachmentParams, lvs map[string]logicalVolume,
+) (*storage.VolumeAttachment, error) {
+	lv, err := s.lookup(arg.VolumeId, lvs)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if arg.ReadOnly == lv.writable() {
+		permission := "rw"
+		if arg.ReadOnly {
+			permission = "r"
+		}
+		if _, err := s.run(ctx, "lvchange", "--permission", permission, arg.VolumeId); err != nil {
+			return nil, errors.Annotate(err, "changing logical volume permission")
+		}
+	}
+	if !lv.active() {
+		if _, err := s.run(ctx, "lvchange", "--activate", "y", arg.VolumeId); err != nil {
+			return nil, errors.Annotate(err, "activating logical volume")
+		}
+	}
+	return &storage.VolumeAttachment{
+		Volume:  arg.Volume,
+		Machine: arg.Machine,
+		VolumeAttachmentInfo: storage.VolumeAttachmentInfo{
+			DeviceLink: "/dev/disk/by-id/dm-name-" + deviceMapperName(s.volumeGroup, lv.name),
+			ReadOnly:   arg.ReadOnly,
+		},
+	}, nil
+}
+
+// DetachVolumes is defined on the VolumeSource interface.
+func (s *lvmVolumeSource) DetachVolumes(ctx context.Context, args []storage.VolumeAttachmentParams) ([]error, error) {
+	lvs, err := s.logicalVolumes(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	results := make([]error, len(args))
+	for i, arg := range args {
+		lv, err := s.lookup(arg.VolumeId, lvs)
+		if errors.Is(err, errors.NotFound) || (err == nil && !lv.active()) {
+			continue
+		} else if err != nil {
+			results[i] = errors.Annotatef(err, "detaching volume %s", arg.Volume.Id())
+			continue
+		}
+		if _, err := s.run(ctx, "lvchange", "--activate", "n", arg.VolumeId); err != nil {
+			results[i] = errors.Annotatef(err, "detaching volume %s", arg.Volume.Id())
+		}
+	}
+	return results, nil
+}
+
+// ResizeVolumes is defined on the VolumeResizer interface.
+func (s *lvmVolumeSource) ResizeVolumes(ctx context.Context, args []storage.ResizeVolumeParams) ([]storage.DescribeVolumesResult, error) {
+	lvs, err := s.logicalVolumes(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	results := make([]storage.DescribeVolumesResult, len(args))
+	for i, arg := range args {
+		lv, err := s.lookup(arg.VolumeId, lvs)
+		if err != nil {
+			results[i].Error = errors.Annotatef(err, "resizing volume %s", arg.Volume.Id())
+			continue
+		}
+		size, err := s.extend(ctx, lv, arg.Size)
+		if err != nil {
+			results[i].Error = errors.Annotatef(err, "resizing volume %s", arg.Volume.Id())
+			continue
+		}
+		results[i].VolumeInfo = &storage.VolumeInfo{
+			VolumeId: arg.VolumeId,
+			Size:     size,
+		}
+	}
+	return results, nil
+}
+
+// volumeId returns the provider ID of the named logical volume, which is
+// also the path lvm commands accept for it.
+func (s *lvmVolumeSource) volumeId(name string) string {
+	return s.volumeGroup + "/" + name
+}
+
+// lookup returns the logical volume with the specified provider ID.
+func (s *lvmVolumeSource) lookup(volumeId string, lvs map[string]logicalVolume) (logicalVolume, error) {
+	vg, name, ok := strings.Cut(volumeId, "/")
+	if !ok || vg != s.volumeGroup {
+		return logicalVolume{}, errors.NotValidf("lvm volume ID %q", volumeId)
+	}
+	lv, ok := lvs[name]
+	if !ok {
+		return logicalVolume{}, errors.NotFoundf("logical volume %q", volumeId)
+	}
+	return lv, nil
+}
+
+// logicalVolumes returns the logical volumes in the source's volume
+// group, keyed by name.
+func (s *lvmVolumeSource) logicalVolumes(ctx context.Context) (map[string]logicalVolume, error) {
+	stdout, err := s.run(
+		ctx, "lvs", "--noheadings", "--nosuffix", "--units", "m", "--separator", ":",
+		"--options", "lv_name,lv_size,lv_attr", s.volumeGroup,
+	)
+	if err != nil {
+		return nil, errors.Annotatef(err, "listing logical volumes in %q", s.volumeGroup)
+	}
+	lvs := make(map[string]logicalVolume)
+	for _, line := range strings.Split(strings.TrimSpace(stdout), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		fields := strings.Split(line, ":")
+		if len(fields) != 3 {
+			return nil, errors.Errorf("unexpected lvs output %q", line)
+		}
+		size, err := strconv.ParseFloat(fields[1], 64)
+		if err != nil {
+			return nil, errors.Annotatef(err, "parsing size of logical volume %q", fields[0])
+		}
+		lvs[fields[0]] = logicalVolume{
+			name: fields[0],
+			size: uint64(math.Ceil(size)),
+			attr: fields[2],
+		}
+	}
+	return lvs, nil
+}
+
+// deviceMapperName returns the device mapper name of a logical volume,
+// which escapes hyphens in the volume group and volume names.
+func deviceMapperName(vg, lv string) string {
+	return strings.ReplaceAll(vg, "-", "--") + "-" + strings.ReplaceAll(lv, "-", "--")
+}
@@ -0,0 +1,237 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package provider_test
+
+import (
+	"errors"
+	stdtesting "testing"
+
+	jujuerrors "github.com/juju/errors"
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/internal/storage"
+	"github.com/juju/juju/internal/storage/provider"
+	"github.com/juju/juju/internal/testing"
+)
+
+func TestLVMSuite(t *stdtesting.T) {
+	tc.Run(t, &lvmSuite{})
+}
+
+type lvmSuite struct {
+	testing.BaseSuite
+	commands *mockRunCommand
+}
+
+func (s *lvmSuite) TearDownTest(c *tc.C) {
+	if s.commands != nil {
+		s.commands.assertDrained()
+	}
+	s.BaseSuite.TearDownTest(c)
+}
+
+func (s *lvmSuite) lvmProvider(c *tc.C) storage.Provider {
+	s.commands = &mockRunCommand{c: c}
+	return provider.NewLVMProvider(s.commands.run)
+}
+
+func (s *lvmSuite) lvmVolumeSource(c *tc.C) storage.VolumeSource {
+	p := s.lvmProvider(c)
+	cfg, err := storage.NewConfig("fast", provider.LVMProviderType, map[string]any{
+		provider.LVMVolumeGroup: "juju-vg",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	source, err := p.VolumeSource(cfg)
+	c.Assert(err, tc.ErrorIsNil)
+	return source
+}
+
+func (s *lvmSuite) expectLVs(output string) {
+	s.commands.expect("lvs", "--noheadings", "--nosuffix", "--units", "m", "--separator", ":",
+		"--options", "lv_name,lv_size,lv_attr", "juju-vg").respond(output, nil)
+}
+
+func (s *lvmSuite) TestValidateConfig(c *tc.C) {
+	p := s.lvmProvider(c)
+	for i, test := range []struct {
+		attrs map[string]any
+		err   string
+	}{{
+		attrs: map[string]any{},
+		err:   `validating lvm storage config: volume-group: expected string, got nothing`,
+	}, {
+		attrs: map[string]any{"volume-group": "-vg"},
+		err:   `volume group name "-vg" not valid`,
+	}, {
+		attrs: map[string]any{"volume-group": "ubuntu-vg"},
+	}} {
+		c.Logf("test %d", i)
+		cfg, err := storage.NewConfig("fast", provider.LVMProviderType, test.attrs)
+		c.Assert(err, tc.ErrorIsNil)
+		err = p.ValidateConfig(cfg)
+		if test.err == "" {
+			c.Check(err, tc.ErrorIsNil)
+		} else {
+			c.Check(err, tc.ErrorMatches, test.err)
+		}
+	}
+}
+
+func (s *lvmSuite) TestSupports(c *tc.C) {
+	p := s.lvmProvider(c)
+	c.Assert(p.Supports(storage.StorageKindBlock), tc.IsTrue)
+	c.Assert(p.Supports(storage.StorageKindFilesystem), tc.IsFalse)
+	c.Assert(p.Scope(), tc.Equals, storage.ScopeMachine)
+	c.Assert(p.DefaultPools(), tc.HasLen, 0)
+}
+
+func (s *lvmSuite) TestCreateVolumes(c *tc.C) {
+	source := s.lvmVolumeSource(c)
+	// volume-1 was left behind by an earlier attempt, but is too small.
+	s.expectLVs("  root:20480.00:-wi-ao----\n  volume-1:100.00:-wi-a-----\n")
+	s.commands.expect("lvcreate", "--yes", "--name", "volume-0", "--size", "1024m", "juju-vg")
+	s.commands.expect("lvextend", "--size", "512m", "juju-vg/volume-1")
+
+	results, err := source.CreateVolumes(c.Context(), []storage.VolumeParams{{
+		Tag:  names.NewVolumeTag("0"),
+		Size: 1024,
+	}, {
+		Tag:  names.NewVolumeTag("1"),
+		Size: 512,
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.DeepEquals, []storage.CreateVolumesResult{{
+		Volume: &storage.Volume{
+			Tag:        names.NewVolumeTag("0"),
+			VolumeInfo: storage.VolumeInfo{VolumeId: "juju-vg/volume-0", Size: 1024},
+		},
+	}, {
+		Volume: &storage.Volume{
+			Tag:        names.NewVolumeTag("1"),
+			VolumeInfo: storage.VolumeInfo{VolumeId: "juju-vg/volume-1", Size: 512},
+		},
+	}})
+}
+
+func (s *lvmSuite) TestCreateVolumesVolumeGroupMissing(c *tc.C) {
+	source := s.lvmVolumeSource(c)
+	s.commands.expect("lvs", "--noheadings", "--nosuffix", "--units", "m", "--separator", ":",
+		"--options", "lv_name,lv_size,lv_attr", "juju-vg").respond("", errors.New(`Volume group "juju-vg" not found`))
+
+	results, err := source.CreateVolumes(c.Context(), []storage.VolumeParams{{
+		Tag:  names.NewVolumeTag("0"),
+		Size: 1024,
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 1)
+	c.Check(results[0].Error, tc.ErrorMatches, `creating volume: listing logical volumes in "juju-vg": Volume group "juju-vg" not found`)
+}
+
+func (s *lvmSuite) TestListAndDescribeVolumes(c *tc.C) {
+	source := s.lvmVolumeSource(c)
+	s.expectLVs("  volume-1:2048.00:-wi-a-----\n  root:20480.00:-wi-ao----\n  volume-0:1023.50:-wi-------\n")
+	ids, err := source.ListVolumes(c.Context())
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(ids, tc.DeepEquals, []string{"juju-vg/volume-0", "juju-vg/volume-1"})
+
+	s.expectLVs("  volume-0:1023.50:-wi-------\n")
+	results, err := source.DescribeVolumes(c.Context(), []string{"juju-vg/volume-0", "juju-vg/volume-1", "other-vg/volume-0"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 3)
+	c.Check(results[0].VolumeInfo, tc.DeepEquals, &storage.VolumeInfo{VolumeId: "juju-vg/volume-0", Size: 1024})
+	c.Check(results[1].Error, tc.ErrorIs, jujuerrors.NotFound)
+	c.Check(results[2].Error, tc.ErrorIs, jujuerrors.NotValid)
+}
+
+func (s *lvmSuite) TestDestroyVolumes(c *tc.C) {
+	source := s.lvmVolumeSource(c)
+	s.expectLVs("  volume-0:1024.00:-wi-------\n")
+	s.commands.expect("lvremove", "--yes", "juju-vg/volume-0")
+
+	// Destroying a logical volume that is gone is not an error.
+	errs, err := source.DestroyVolumes(c.Context(), []string{"juju-vg/volume-0", "juju-vg/volume-1"})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(errs, tc.DeepEquals, []error{nil, nil})
+}
+
+func (s *lvmSuite) attachmentParams(id string, readOnly bool) storage.VolumeAttachmentParams {
+	return storage.VolumeAttachmentParams{
+		AttachmentParams: storage.AttachmentParams{
+			Machine:  names.NewMachineTag("0"),
+			ReadOnly: readOnly,
+		},
+		Volume:   names.NewVolumeTag(id),
+		VolumeId: "juju-vg/volume-" + id,
+	}
+}
+
+func (s *lvmSuite) TestAttachVolumes(c *tc.C) {
+	source := s.lvmVolumeSource(c)
+	// volume-0 is inactive, volume-1 is already active but must become
+	// read-only, and volume-2 is already attached as requested.
+	s.expectLVs("  volume-0:1024.00:-wi-------\n  volume-1:1024.00:-wi-a-----\n  volume-2:1024.00:-wi-a-----\n")
+	s.commands.expect("lvchange", "--activate", "y", "juju-vg/volume-0")
+	s.commands.expect("lvchange", "--permission", "r", "juju-vg/volume-1")
+
+	results, err := source.AttachVolumes(c.Context(), []storage.VolumeAttachmentParams{
+		s.attachmentParams("0", false),
+		s.attachmentParams("1", true),
+		s.attachmentParams("2", false),
+		s.attachmentParams("3", false),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 4)
+	c.Check(results[0].VolumeAttachment, tc.DeepEquals, &storage.VolumeAttachment{
+		Volume:  names.NewVolumeTag("0"),
+		Machine: names.NewMachineTag("0"),
+		VolumeAttachmentInfo: storage.VolumeAttachmentInfo{
+			DeviceLink: "/dev/disk/by-id/dm-name-juju--vg-volume--0",
+		},
+	})
+	c.Check(results[1].VolumeAttachment.ReadOnly, tc.IsTrue)
+	c.Check(results[2].Error, tc.ErrorIsNil)
+	c.Check(results[3].Error, tc.ErrorMatches, `attaching volume 3: logical volume "juju-vg/volume-3" not found`)
+}
+
+func (s *lvmSuite) TestDetachVolumes(c *tc.C) {
+	source := s.lvmVolumeSource(c)
+	s.expectLVs("  volume-0:1024.00:-wi-a-----\n  volume-1:1024.00:-wi-------\n")
+	s.commands.expect("lvchange", "--activate", "n", "juju-vg/volume-0")
+
+	// Detaching inactive or missing logical volumes is not an error.
+	errs, err := source.DetachVolumes(c.Context(), []storage.VolumeAttachmentParams{
+		s.attachmentParams("0", false),
+		s.attachmentParams("1", false),
+		s.attachmentParams("2", false),
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(errs, tc.DeepEquals, []error{nil, nil, nil})
+}
+
+func (s *lvmSuite) TestResizeVolumes(c *tc.C) {
+	source := s.lvmVolumeSource(c)
+	resizer, ok := source.(storage.VolumeResizer)
+	c.Assert(ok, tc.IsTrue)
+
+	s.expectLVs("  volume-0:1024.00:-wi-a-----\n  volume-1:4096.00:-wi-a-----\n")
+	s.commands.expect("lvextend", "--size", "2048m", "juju-vg/volume-0")
+
+	// Volumes are never shrunk.
+	results, err := resizer.ResizeVolumes(c.Context(), []storage.ResizeVolumeParams{{
+		Volume:   names.NewVolumeTag("0"),
+		VolumeId: "juju-vg/volume-0",
+		Size:     2048,
+	}, {
+		Volume:   names.NewVolumeTag("1"),
+		VolumeId: "juju-vg/volume-1",
+		Size:     2048,
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(results, tc.DeepEquals, []storage.DescribeVolumesResult{{
+		VolumeInfo: &storage.VolumeInfo{VolumeId: "juju-vg/volume-0", Size: 2048},
+	}, {
+		VolumeInfo: &storage.VolumeInfo{VolumeId: "juju-vg/volume-1", Size: 4096},
+	}})
+}
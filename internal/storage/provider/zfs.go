@@ -0,0 +1,373 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/juju/errors"
+	"github.com/juju/schema"
+
+	"github.com/juju/juju/internal/storage"
+)
+
+const (
+	// ZFSProviderType is the storage provider type for ZFS datasets,
+	// created in a pool on the machine.
+	ZFSProviderType = storage.ProviderType("zfs")
+
+	// ZFSPool is the pool attribute naming the ZFS pool, or dataset,
+	// under which datasets are created.
+	ZFSPool = "zfs-pool"
+)
+
+var zfsConfigChecker = schema.FieldMap(
+	schema.Fields{
+		ZFSPool: schema.String(),
+	},
+	schema.Defaults{},
+)
+
+// ZFSProvider provides a storage filesystem source to Juju that creates
+// datasets in an existing ZFS pool on a machine.
+type ZFSProvider struct {
+	// run is a function used for running commands on the local machine.
+	run RunCommandFunc
+}
+
+var _ storage.Provider = (*ZFSProvider)(nil)
+
+// NewZFSProvider returns a new zfs storage provider.
+func NewZFSProvider(run RunCommandFunc) *ZFSProvider {
+	return &ZFSProvider{
+		run: run,
+	}
+}
+
+// ValidateForK8s is defined on the Provider interface.
+func (*ZFSProvider) ValidateForK8s(map[string]any) error {
+	return errors.NotValidf("storage provider type %q", ZFSProviderType)
+}
+
+// ValidateConfig is defined on the Provider interface.
+func (*ZFSProvider) ValidateConfig(cfg *storage.Config) error {
+	_, err := zfsPool(cfg)
+	return errors.Trace(err)
+}
+
+// zfsPool returns the validated ZFS pool of the specified pool config.
+func zfsPool(cfg *storage.Config) (string, error) {
+	coerced, err := zfsConfigChecker.Coerce(cfg.Attrs(), nil)
+	if err != nil {
+		return "", errors.Annotate(err, "validating zfs storage config")
+	}
+	pool := coerced.(map[string]any)[ZFSPool].(string)
+	if pool == "" || strings.HasPrefix(pool, "/") || strings.HasSuffix(pool, "/") ||
+		strings.ContainsAny(pool, "@# \t\n") {
+		return "", errors.NotValidf("zfs pool name %q", pool)
+	}
+	return pool, nil
+}
+
+// VolumeSource is defined on the Provider interface.
+func (*ZFSProvider) VolumeSource(*storage.Config) (storage.VolumeSource, error) {
+	return nil, errors.NotSupportedf("volumes")
+}
+
+// FilesystemSource is defined on the Provider interface.
+func (p *ZFSProvider) FilesystemSource(sourceConfig *storage.Config) (storage.FilesystemSource, error) {
+	pool, err := zfsPool(sourceConfig)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &zfsFilesystemSource{
+		dirFuncs: &osDirFuncs{run: p.run},
+		run:      p.run,
+		pool:     pool,
+	}, nil
+}
+
+// Supports is defined on the Provider interface.
+func (*ZFSProvider) Supports(k storage.StorageKind) bool {
+	return k == storage.StorageKindFilesystem
+}
+
+// Scope is defined on the Provider interface.
+func (*ZFSProvider) Scope() storage.Scope {
+	return storage.ScopeMachine
+}
+
+// Dynamic is defined on the Provider interface.
+func (*ZFSProvider) Dynamic() bool {
+	return true
+}
+
+// Releasable is defined on the Provider interface.
+func (*ZFSProvider) Releasable() bool {
+	return false
+}
+
+// DefaultPools provides the default storage pools available through this
+// provider.
+//
+// There is no ZFS pool common to all machines, so zfs pools must be
+// created by the user.
+//
+// Implements [storage.Provider] interface.
+func (*ZFSProvider) DefaultPools() []*storage.Config {
+	return nil
+}
+
+// zfsFilesystemSource creates Juju filesystems as datasets, named after
+// the filesystem tag and limited by a quota, directly under a pool.
+type zfsFilesystemSource struct {
+	dirFuncs dirFuncs
+	run      RunCommandFunc
+	pool     string
+}
+
+var (
+	_ storage.FilesystemSource  = (*zfsFilesystemSource)(nil)
+	_ storage.FilesystemResizer = (*zfsFilesystemSource)(nil)
+)
+
+// ValidateFilesystemParams is defined on the FilesystemSource interface.
+func (s *zfsFilesystemSource) ValidateFilesystemParams(params storage.FilesystemParams) error {
+	// ValidateFilesystemParams may be called on a machine other than the
+	// machine where the dataset will be created, so we cannot check the
+	// pool until we get to CreateFilesystems.
+	return nil
+}
+
+// CreateFilesystems is defined on the FilesystemSource interface.
+func (s *zfsFilesystemSource) CreateFilesystems(ctx context.Context, args []storage.FilesystemParams) ([]storage.CreateFilesystemsResult, error) {
+	results := make([]storage.CreateFilesystemsResult, len(args))
+	quotas, err := s.datasetQuotas(ctx)
+	if err != nil {
+		for i := range results {
+			results[i].Error = errors.Annotate(err, "creating filesystem")
+		}
+		return results, nil
+	}
+	for i, arg := range args {
+		filesystem, err := s.createFilesystem(ctx, arg, quotas)
+		if err != nil {
+			results[i].Error = errors.Annotate(err, "creating filesystem")
+			continue
+		}
+		results[i].Filesystem = filesystem
+	}
+	return results, nil
+}
+
+// createFilesystem creates the dataset for the specified filesystem. A
+// dataset left behind by an earlier attempt is reused, raising its
+// quota if necessary.
+func (s *zfsFilesystemSource) createFilesystem(
+	ctx context.Context, params storage.FilesystemParams, quotas map[string]uint64,
+) (*storage.Filesystem, error) {
+	dataset := s.pool + "/" + params.Tag.String()
+	size := params.Size
+	if quota, ok := quotas[dataset]; ok {
+		var err error
+		if size, err = s.grow(ctx, dataset, quota, params.Size); err != nil {
+			return nil, errors.Trace(err)
+		}
+	} else if _, err := s.run(
+		ctx, "zfs", "create",
+		// Juju mounts the dataset where the charm needs it when the
+		// filesystem is attached, rather than ZFS mounting it at boot.
+		"-o", "mountpoint=legacy",
+		"-o", fmt.Sprintf("quota=%dM", params.Size),
+		dataset,
+	); err != nil {
+		return nil, errors.Annotatef(err, "creating dataset %q", dataset)
+	}
+	return &storage.Filesystem{
+		Tag:    params.Tag,
+		Volume: params.Volume,
+		FilesystemInfo: storage.FilesystemInfo{
+			ProviderId: dataset,
+			Size:       size,
+		},
+	}, nil
+}
+
+// grow raises the quota of the dataset to at least the specified size in
+// MiB, returning the resulting quota.
+func (s *zfsFilesystemSource) grow(ctx context.Context, dataset string, quota, size uint64) (uint64, error) {
+	// A quota of zero means the dataset is unlimited.
+	if quota == 0 || quota >= size {
+		return max(quota, size), nil
+	}
+	if _, err := s.run(ctx, "zfs", "set", fmt.Sprintf("quota=%dM", size), dataset); err != nil {
+		return 0, errors.Annotatef(err, "setting quota of dataset %q", dataset)
+	}
+	return size, nil
+}
+
+// DestroyFilesystems is defined on the FilesystemSource interface.
+func (s *zfsFilesystemSource) DestroyFilesystems(ctx context.Context, filesystemIds []string) ([]error, error) {
+	quotas, err := s.datasetQuotas(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	results := make([]error, len(filesystemIds))
+	for i, filesystemId := range filesystemIds {
+		if err := s.validateDataset(filesystemId); err != nil {
+			results[i] = errors.Annotatef(err, "destroying %q", filesystemId)
+			continue
+		}
+		if _, ok := quotas[filesystemId]; !ok {
+			continue
+		}
+		if _, err := s.run(ctx, "zfs", "destroy", filesystemId); err != nil {
+			results[i] = errors.Annotatef(err, "destroying %q", filesystemId)
+		}
+	}
+	return results, nil
+}
+
+// ReleaseFilesystems is defined on the FilesystemSource interface.
+func (s *zfsFilesystemSource) ReleaseFilesystems(ctx context.Context, filesystemIds []string) ([]error, error) {
+	return make([]error, len(filesystemIds)), nil
+}
+
+// AttachFilesystems is defined on the FilesystemSource interface.
+func (s *zfsFilesystemSource) AttachFilesystems(ctx context.Context, args []storage.FilesystemAttachmentParams) ([]storage.AttachFilesystemsResult, error) {
+	results := make([]storage.AttachFilesystemsResult, len(args))
+	for i, arg := range args {
+		attachment, err := s.attachFilesystem(ctx, arg)
+		if err != nil {
+			results[i].Error = errors.Annotatef(err, "attaching filesystem %v", arg.Filesystem.Id())
+			continue
+		}
+		results[i].FilesystemAttachment = attachment
+	}
+	return results, nil
+}
+
+func (s *zfsFilesystemSource) attachFilesystem(
+	ctx context.Context,
+	arg storage.FilesystemAttachmentParams,
+) (*storage.FilesystemAttachment, error) {
+	path := arg.Path
+	if path == "" {
+		return nil, errNoMountPoint
+	}
+	if err := s.validateDataset(arg.ProviderId); err != nil {
+		return nil, errors.Trace(err)
+	}
+	if err := ensureDir(s.dirFuncs, path); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	// Check if the dataset is already mounted.
+	source, err := s.dirFuncs.mountPoint(path)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if source != arg.ProviderId {
+		if err := ensureEmptyDir(s.dirFuncs, path); err != nil {
+			return nil, err
+		}
+		options := "rw"
+		if arg.ReadOnly {
+			options = "ro"
+		}
+		if _, err := s.run(
+			ctx, "mount", "-t", "zfs", "-o", options, arg.ProviderId, path,
+		); err != nil {
+			os.Remove(path)
+			return nil, errors.Annotate(err, "cannot mount zfs dataset")
+		}
+	}
+
+	return &storage.FilesystemAttachment{
+		Filesystem: arg.Filesystem,
+		Machine:    arg.Machine,
+		FilesystemAttachmentInfo: storage.FilesystemAttachmentInfo{
+			Path:     path,
+			ReadOnly: arg.ReadOnly,
+		},
+	}, nil
+}
+
+// DetachFilesystems is defined on the FilesystemSource interface.
+func (s *zfsFilesystemSource) DetachFilesystems(ctx context.Context, args []storage.FilesystemAttachmentParams) ([]error, error) {
+	results := make([]error, len(args))
+	for i, arg := range args {
+		if err := maybeUnmount(ctx, s.run, s.dirFuncs, arg.Path); err != nil {
+			results[i] = errors.Annotatef(err, "detaching filesystem %v", arg.Filesystem.Id())
+		}
+	}
+	return results, nil
+}
+
+// ResizeFilesystems is defined on the FilesystemResizer interface.
+func (s *zfsFilesystemSource) ResizeFilesystems(ctx context.Context, args []storage.ResizeFilesystemParams) ([]error, error) {
+	quotas, err := s.datasetQuotas(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	results := make([]error, len(args))
+	for i, arg := range args {
+		quota, ok := quotas[arg.ProviderId]
+		if !ok {
+			results[i] = errors.NotFoundf("dataset %q", arg.ProviderId)
+			continue
+		}
+		if _, err := s.grow(ctx, arg.ProviderId, quota, arg.Size); err != nil {
+			results[i] = errors.Annotatef(err, "resizing filesystem %v", arg.Filesystem.Id())
+		}
+	}
+	return results, nil
+}
+
+// validateDataset checks that the dataset is one that this source
+// creates.
+func (s *zfsFilesystemSource) validateDataset(dataset string) error {
+	name, ok := strings.CutPrefix(dataset, s.pool+"/")
+	if !ok || name == "" || strings.Contains(name, "/") {
+		return errors.NotValidf("zfs dataset %q", dataset)
+	}
+	return nil
+}
+
+// datasetQuotas returns the quotas, in MiB, of the datasets directly
+// under the source's pool, keyed by dataset name.
+func (s *zfsFilesystemSource) datasetQuotas(ctx context.Context) (map[string]uint64, error) {
+	// -H omits headers and separates fields by tabs, and -p reports
+	// exact byte values.
+	stdout, err := s.run(
+		ctx, "zfs", "list", "-H", "-p", "-o", "name,quota", "-t", "filesystem", "-d", "1", s.pool,
+	)
+	if err != nil {
+		return nil, errors.Annotatef(err, "listing datasets in %q", s.pool)
+	}
+	quotas := make(map[string]uint64)
+	for _, line := range strings.Split(strings.TrimSpace(stdout), "\n") {
+		if line == "" {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) != 2 {
+			return nil, errors.Errorf("unexpected zfs output %q", line)
+		}
+		var quota uint64
+		if fields[1] != "-" && fields[1] != "none" {
+			if quota, err = strconv.ParseUint(fields[1], 10, 64); err != nil {
+				return nil, errors.Annotatef(err, "parsing quota of dataset %q", fields[0])
+			}
+		}
+		quotas[fields[0]] = (quota + mib - 1) / mib
+	}
+	return quotas, nil
+}
+
+const mib = 1024 * 1024
@@ -0,0 +1,208 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package provider_test
+
+import (
+	"fmt"
+	stdtesting "testing"
+
+	"github.com/juju/names/v6"
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/internal/storage"
+	"github.com/juju/juju/internal/storage/provider"
+	"github.com/juju/juju/internal/testing"
+)
+
+func TestZFSSuite(t *stdtesting.T) {
+	tc.Run(t, &zfsSuite{})
+}
+
+type zfsSuite struct {
+	testing.BaseSuite
+	commands   *mockRunCommand
+	fakeEtcDir string
+}
+
+func mountInfoZFSLine(id int, mountPoint, source string) string {
+	return fmt.Sprintf("%d 6666 0:55 / %s rw,relatime shared:1 - zfs %s rw,xattr,noacl", id, mountPoint, source)
+}
+
+func (s *zfsSuite) SetUpTest(c *tc.C) {
+	s.BaseSuite.SetUpTest(c)
+	s.fakeEtcDir = c.MkDir()
+}
+
+func (s *zfsSuite) TearDownTest(c *tc.C) {
+	if s.commands != nil {
+		s.commands.assertDrained()
+	}
+	s.BaseSuite.TearDownTest(c)
+}
+
+func (s *zfsSuite) zfsFilesystemSource(c *tc.C, fakeMountInfo ...string) storage.FilesystemSource {
+	s.commands = &mockRunCommand{c: c}
+	source, _ := provider.ZFSFilesystemSource(s.fakeEtcDir, "tank/juju", s.commands.run, fakeMountInfo...)
+	return source
+}
+
+func (s *zfsSuite) expectDatasets(output string) {
+	s.commands.expect("zfs", "list", "-H", "-p", "-o", "name,quota", "-t", "filesystem", "-d", "1", "tank/juju").respond(output, nil)
+}
+
+func (s *zfsSuite) TestFilesystemSource(c *tc.C) {
+	p := provider.NewZFSProvider(nil)
+	cfg, err := storage.NewConfig("name", provider.ZFSProviderType, map[string]any{})
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = p.FilesystemSource(cfg)
+	c.Assert(err, tc.ErrorMatches, "validating zfs storage config: zfs-pool: expected string, got nothing")
+
+	cfg, err = storage.NewConfig("name", provider.ZFSProviderType, map[string]any{
+		provider.ZFSPool: "tank/",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(p.ValidateConfig(cfg), tc.ErrorMatches, `zfs pool name "tank/" not valid`)
+
+	cfg, err = storage.NewConfig("name", provider.ZFSProviderType, map[string]any{
+		provider.ZFSPool: "tank/juju",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = p.FilesystemSource(cfg)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *zfsSuite) TestSupports(c *tc.C) {
+	p := provider.NewZFSProvider(nil)
+	c.Assert(p.Supports(storage.StorageKindBlock), tc.IsFalse)
+	c.Assert(p.Supports(storage.StorageKindFilesystem), tc.IsTrue)
+	c.Assert(p.Scope(), tc.Equals, storage.ScopeMachine)
+	c.Assert(p.DefaultPools(), tc.HasLen, 0)
+}
+
+func (s *zfsSuite) TestCreateFilesystems(c *tc.C) {
+	source := s.zfsFilesystemSource(c)
+	// filesystem-1 was left behind by an earlier attempt, with a smaller
+	// quota.
+	s.expectDatasets("tank/juju\t0\ntank/juju/filesystem-1\t104857600\n")
+	s.commands.expect("zfs", "create", "-o", "mountpoint=legacy", "-o", "quota=1024M", "tank/juju/filesystem-0")
+	s.commands.expect("zfs", "set", "quota=512M", "tank/juju/filesystem-1")
+
+	results, err := source.CreateFilesystems(c.Context(), []storage.FilesystemParams{{
+		Tag:  names.NewFilesystemTag("0"),
+		Size: 1024,
+	}, {
+		Tag:  names.NewFilesystemTag("1"),
+		Size: 512,
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.DeepEquals, []storage.CreateFilesystemsResult{{
+		Filesystem: &storage.Filesystem{
+			Tag: names.NewFilesystemTag("0"),
+			FilesystemInfo: storage.FilesystemInfo{
+				ProviderId: "tank/juju/filesystem-0",
+				Size:       1024,
+			},
+		},
+	}, {
+		Filesystem: &storage.Filesystem{
+			Tag: names.NewFilesystemTag("1"),
+			FilesystemInfo: storage.FilesystemInfo{
+				ProviderId: "tank/juju/filesystem-1",
+				Size:       512,
+			},
+		},
+	}})
+}
+
+func (s *zfsSuite) TestAttachFilesystems(c *tc.C) {
+	source := s.zfsFilesystemSource(c)
+	s.commands.expect("mount", "-t", "zfs", "-o", "ro", "tank/juju/filesystem-0", testMountPoint)
+
+	results, err := source.AttachFilesystems(c.Context(), []storage.FilesystemAttachmentParams{{
+		Filesystem: names.NewFilesystemTag("0"),
+		ProviderId: "tank/juju/filesystem-0",
+		Path:       testMountPoint,
+		AttachmentParams: storage.AttachmentParams{
+			Machine:  names.NewMachineTag("0"),
+			ReadOnly: true,
+		},
+	}, {
+		Filesystem: names.NewFilesystemTag("1"),
+		ProviderId: "tank/other/filesystem-1",
+		Path:       "/srv/data",
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 2)
+	c.Check(results[0].FilesystemAttachment, tc.DeepEquals, &storage.FilesystemAttachment{
+		Filesystem: names.NewFilesystemTag("0"),
+		Machine:    names.NewMachineTag("0"),
+		FilesystemAttachmentInfo: storage.FilesystemAttachmentInfo{
+			Path:     testMountPoint,
+			ReadOnly: true,
+		},
+	})
+	c.Check(results[1].Error, tc.ErrorMatches, `attaching filesystem 1: zfs dataset "tank/other/filesystem-1" not valid`)
+}
+
+func (s *zfsSuite) TestAttachFilesystemsAlreadyMounted(c *tc.C) {
+	source := s.zfsFilesystemSource(c, mountInfoZFSLine(666, testMountPoint, "tank/juju/filesystem-0"))
+
+	results, err := source.AttachFilesystems(c.Context(), []storage.FilesystemAttachmentParams{{
+		Filesystem: names.NewFilesystemTag("0"),
+		ProviderId: "tank/juju/filesystem-0",
+		Path:       testMountPoint,
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(results, tc.HasLen, 1)
+	c.Check(results[0].Error, tc.ErrorIsNil)
+}
+
+func (s *zfsSuite) TestDetachFilesystems(c *tc.C) {
+	source := s.zfsFilesystemSource(c, mountInfoZFSLine(666, testMountPoint, "tank/juju/filesystem-0-0"))
+	testDetachFilesystems(c, s.commands, source, true, s.fakeEtcDir, "")
+}
+
+func (s *zfsSuite) TestDetachFilesystemsUnattached(c *tc.C) {
+	source := s.zfsFilesystemSource(c)
+	testDetachFilesystems(c, s.commands, source, false, s.fakeEtcDir, "")
+}
+
+func (s *zfsSuite) TestDestroyFilesystems(c *tc.C) {
+	source := s.zfsFilesystemSource(c)
+	s.expectDatasets("tank/juju\t0\ntank/juju/filesystem-0\t1073741824\n")
+	s.commands.expect("zfs", "destroy", "tank/juju/filesystem-0")
+
+	// Destroying a dataset that is gone is not an error, but datasets
+	// that the source does not manage are never destroyed.
+	errs, err := source.DestroyFilesystems(c.Context(), []string{
+		"tank/juju/filesystem-0", "tank/juju/filesystem-1", "tank/juju",
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Assert(errs, tc.HasLen, 3)
+	c.Check(errs[0], tc.ErrorIsNil)
+	c.Check(errs[1], tc.ErrorIsNil)
+	c.Check(errs[2], tc.ErrorMatches, `destroying "tank/juju": zfs dataset "tank/juju" not valid`)
+}
+
+func (s *zfsSuite) TestResizeFilesystems(c *tc.C) {
+	source := s.zfsFilesystemSource(c)
+	resizer, ok := source.(storage.FilesystemResizer)
+	c.Assert(ok, tc.IsTrue)
+
+	s.expectDatasets("tank/juju\t0\ntank/juju/filesystem-0\t1073741824\ntank/juju/filesystem-1\t4294967296\n")
+	s.commands.expect("zfs", "set", "quota=2048M", "tank/juju/filesystem-0")
+
+	// Filesystems are never shrunk.
+	errs, err := resizer.ResizeFilesystems(c.Context(), []storage.ResizeFilesystemParams{{
+		Filesystem: names.NewFilesystemTag("0"),
+		ProviderId: "tank/juju/filesystem-0",
+		Size:       2048,
+	}, {
+		Filesystem: names.NewFilesystemTag("1"),
+		ProviderId: "tank/juju/filesystem-1",
+		Size:       2048,
+	}})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(errs, tc.DeepEquals, []error{nil, nil})
+}
@@ -52,6 +52,8 @@ func (s *JujuOSEnvSuite) SetUpTest(c *tc.C) {
 		osenv.JujuLoggingConfigEnvKey,
 		osenv.JujuFeatureFlagEnvKey,
 		osenv.JujuFeatures,
+		osenv.JujuCredentialHelperEnvKey,
+		osenv.JujuCredentialPassphraseEnvKey,
 		osenv.XDGDataHome,
 	} {
 		s.oldEnvironment[name] = os.Getenv(name)
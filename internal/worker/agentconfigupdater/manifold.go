@@ -21,6 +21,7 @@ import (
 	"github.com/juju/juju/core/model"
 	coretrace "github.com/juju/juju/core/trace"
 	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/secrets/encryptionkey"
 	"github.com/juju/juju/internal/services"
 	jworker "github.com/juju/juju/internal/worker"
 	"github.com/juju/juju/internal/worker/trace"
@@ -159,6 +160,15 @@ func Manifold(config ManifoldConfig) dependency.Manifold {
 			if err != nil {
 				return nil, errors.Errorf("getting state serving info: %w", err)
 			}
+			// The secret encryption root key is kept in its own key file
+			// rather than in the agent configuration.
+			if info.SecretEncryptionKey != "" {
+				err := encryptionkey.WriteRootKey(currentConfig.DataDir(), info.SecretEncryptionKey)
+				if err != nil {
+					return nil, errors.Errorf("writing secret encryption key: %w", err)
+				}
+				info.SecretEncryptionKey = ""
+			}
 			err = agent.ChangeConfig(func(config jujuagent.ConfigSetter) error {
 				existing, hasInfo := config.ControllerAgentInfo()
 				if hasInfo {
@@ -35,6 +35,7 @@ type appWorker struct {
 	statusService              StatusService
 	storageProvisioningService StorageProvisioningService
 	resourceOpenerGetter       ResourceOpenerGetter
+	imageMirror                ImageMirror
 
 	facade CAASProvisionerFacade
 	broker CAASBroker
@@ -60,6 +61,7 @@ type AppWorkerConfig struct {
 	StatusService              StatusService
 	StorageProvisioningService StorageProvisioningService
 	ResourceOpenerGetter       ResourceOpenerGetter
+	ImageMirror                ImageMirror
 
 	Ops    ApplicationOps
 	Broker CAASBroker
@@ -88,6 +90,7 @@ func NewAppWorker(config AppWorkerConfig) func(ctx context.Context) (worker.Work
 			statusService:              config.StatusService,
 			storageProvisioningService: config.StorageProvisioningService,
 			resourceOpenerGetter:       config.ResourceOpenerGetter,
+			imageMirror:                config.ImageMirror,
 			appID:                      config.AppID,
 			facade:                     config.Facade,
 			broker:                     config.Broker,
@@ -273,7 +276,7 @@ func (a *appWorker) loop() error {
 				a.provisioningInfo, err = a.ops.ProvisioningInfo(ctx, name,
 					a.appID, a.facade, a.storageProvisioningService,
 					a.applicationService, a.resourceOpenerGetter,
-					a.provisioningInfo, a.logger)
+					a.imageMirror, a.provisioningInfo, a.logger)
 				if errors.Is(err, errors.NotProvisioned) {
 					a.logger.Debugf(ctx, "application %q is not provisioned", name)
 					// State not ready for this application to be provisioned yet.
@@ -201,7 +201,7 @@ func (s *ApplicationWorkerSuite) TestWorker(c *tc.C) {
 		applicationService.EXPECT().GetApplicationLife(x, s.appID).Return(life.Alive, nil),
 		applicationService.EXPECT().GetApplicationScalingState(x, "test").Return(applicationservice.ScalingState{}, nil),
 		facade.EXPECT().WatchProvisioningInfo(x, "test").Return(watchertest.NewMockNotifyWatcher(provisioningInfoChan), nil),
-		ops.EXPECT().ProvisioningInfo(x, "test", s.appID, x, x, x, x, x, x).Return(&ProvisioningInfo{}, nil),
+		ops.EXPECT().ProvisioningInfo(x, "test", s.appID, x, x, x, x, x, x, x).Return(&ProvisioningInfo{}, nil),
 		ops.EXPECT().AppAlive(x, "test", app, x, x, x, x, x, x).Return(nil),
 		app.EXPECT().Watch(x).Return(watchertest.NewMockNotifyWatcher(appChan), nil),
 		app.EXPECT().WatchReplicas().DoAndReturn(func() (watcher.NotifyWatcher, error) {
@@ -245,7 +245,7 @@ func (s *ApplicationWorkerSuite) TestWorker(c *tc.C) {
 
 		// provisioningInfoChan fired
 		applicationService.EXPECT().GetApplicationLife(x, s.appID).Return(life.Alive, nil),
-		ops.EXPECT().ProvisioningInfo(x, "test", s.appID, x, x, x, x, x, x).Return(&ProvisioningInfo{}, nil),
+		ops.EXPECT().ProvisioningInfo(x, "test", s.appID, x, x, x, x, x, x, x).Return(&ProvisioningInfo{}, nil),
 		ops.EXPECT().AppAlive(x, "test", app, x, x, x, x, x, x).DoAndReturn(func(ctx context.Context, s1 string, a caas.Application, s2 string, ac *caas.ApplicationConfig, pi *ProvisioningInfo, ss StatusService, c clock.Clock, l logger.Logger) error {
 			provisioningInfoChan <- struct{}{}
 			return nil
@@ -394,11 +394,11 @@ func (s *ApplicationWorkerSuite) TestNotProvisionedRetry(c *tc.C) {
 		applicationService.EXPECT().GetApplicationScalingState(x, "test").Return(applicationservice.ScalingState{}, nil),
 		facade.EXPECT().WatchProvisioningInfo(x, "test").Return(watchertest.NewMockNotifyWatcher(provisioningInfoChan), nil),
 		// error with not provisioned
-		ops.EXPECT().ProvisioningInfo(x, "test", s.appID, x, x, x, x, x, x).Return(nil, errors.NotProvisioned),
+		ops.EXPECT().ProvisioningInfo(x, "test", s.appID, x, x, x, x, x, x, x).Return(nil, errors.NotProvisioned),
 
 		// retry handleChange
 		applicationService.EXPECT().GetApplicationLife(x, s.appID).Return(life.Alive, nil),
-		ops.EXPECT().ProvisioningInfo(x, "test", s.appID, x, x, x, x, x, x).Return(&ProvisioningInfo{}, nil),
+		ops.EXPECT().ProvisioningInfo(x, "test", s.appID, x, x, x, x, x, x, x).Return(&ProvisioningInfo{}, nil),
 		ops.EXPECT().AppAlive(x, "test", app, x, x, x, x, x, x).Return(nil),
 		app.EXPECT().Watch(x).Return(watchertest.NewMockNotifyWatcher(appChan), nil),
 		app.EXPECT().WatchReplicas().DoAndReturn(func() (watcher.NotifyWatcher, error) {
@@ -442,7 +442,7 @@ func (s *ApplicationWorkerSuite) TestNotProvisionedRetry(c *tc.C) {
 
 		// provisioningInfoChan fired
 		applicationService.EXPECT().GetApplicationLife(x, s.appID).Return(life.Alive, nil),
-		ops.EXPECT().ProvisioningInfo(x, "test", s.appID, x, x, x, x, x, x).Return(&ProvisioningInfo{}, nil),
+		ops.EXPECT().ProvisioningInfo(x, "test", s.appID, x, x, x, x, x, x, x).Return(&ProvisioningInfo{}, nil),
 		ops.EXPECT().AppAlive(x, "test", app, x, x, x, x, x, x).DoAndReturn(func(ctx context.Context, s1 string, a caas.Application, s2 string, ac *caas.ApplicationConfig, pi *ProvisioningInfo, ss StatusService, c clock.Clock, l logger.Logger) error {
 			provisioningInfoChan <- struct{}{}
 			return nil
@@ -0,0 +1,133 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package caasapplicationprovisioner
+
+import (
+	"context"
+	"sync"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/controller"
+	coreresource "github.com/juju/juju/core/resource"
+	charmresource "github.com/juju/juju/internal/charm/resource"
+	"github.com/juju/juju/internal/docker"
+	"github.com/juju/juju/internal/docker/registry/mirror"
+)
+
+// ImageMirror copies OCI image resources into a registry that the cluster
+// can pull them from.
+type ImageMirror interface {
+	// MirrorImage returns the details to pull the image with, which
+	// reference the mirrored copy if images are mirrored, or are unchanged
+	// if they aren't. The fingerprint identifies the revision of the
+	// resource the image details were read from.
+	MirrorImage(
+		ctx context.Context,
+		fingerprint charmresource.Fingerprint,
+		image coreresource.DockerImageDetails,
+	) (coreresource.DockerImageDetails, error)
+}
+
+// ControllerConfigService provides access to the controller configuration.
+type ControllerConfigService interface {
+	// ControllerConfig returns the current controller configuration.
+	ControllerConfig(context.Context) (controller.Config, error)
+}
+
+// dockerImageMirror copies images into a mirror repository.
+type dockerImageMirror interface {
+	MirrorImage(ctx context.Context, image docker.DockerImageDetails) (docker.DockerImageDetails, error)
+}
+
+// controllerImageMirror mirrors images into the repository configured by
+// the caas-image-mirror controller config. The config is read for each
+// image, so changes apply the next time an application's images are
+// resolved. Each resource revision is copied once per mirror config; later
+// requests for it are answered with the recorded digest reference.
+type controllerImageMirror struct {
+	controllerConfigService ControllerConfigService
+	newMirror               func(target docker.ImageRepoDetails) dockerImageMirror
+
+	mu       sync.Mutex
+	mirrored map[mirroredImageKey]coreresource.DockerImageDetails
+}
+
+// mirroredImageKey identifies a resource revision copied into a mirror.
+type mirroredImageKey struct {
+	mirrorConfig string
+	fingerprint  string
+}
+
+// NewControllerImageMirror returns an ImageMirror which copies images into
+// the caas-image-mirror repository, if one is configured.
+func NewControllerImageMirror(controllerConfigService ControllerConfigService) ImageMirror {
+	return newControllerImageMirror(controllerConfigService, func(target docker.ImageRepoDetails) dockerImageMirror {
+		return mirror.New(target)
+	})
+}
+
+func newControllerImageMirror(
+	controllerConfigService ControllerConfigService,
+	newMirror func(target docker.ImageRepoDetails) dockerImageMirror,
+) *controllerImageMirror {
+	return &controllerImageMirror{
+		controllerConfigService: controllerConfigService,
+		newMirror:               newMirror,
+		mirrored:                make(map[mirroredImageKey]coreresource.DockerImageDetails),
+	}
+}
+
+// MirrorImage is part of the ImageMirror interface.
+func (m *controllerImageMirror) MirrorImage(
+	ctx context.Context,
+	fingerprint charmresource.Fingerprint,
+	image coreresource.DockerImageDetails,
+) (coreresource.DockerImageDetails, error) {
+	cfg, err := m.controllerConfigService.ControllerConfig(ctx)
+	if err != nil {
+		return coreresource.DockerImageDetails{}, errors.Annotate(err, "getting controller config")
+	}
+	if cfg.CAASImageMirror() == "" {
+		return image, nil
+	}
+	target, err := docker.NewImageRepoDetails(cfg.CAASImageMirror())
+	if err != nil {
+		return coreresource.DockerImageDetails{}, errors.Annotatef(err, "parsing %s", controller.CAASImageMirror)
+	}
+
+	// Without a fingerprint there is no revision to record the copy
+	// against, so the image is mirrored every time.
+	var key *mirroredImageKey
+	if !fingerprint.IsZero() {
+		key = &mirroredImageKey{
+			mirrorConfig: cfg.CAASImageMirror(),
+			fingerprint:  fingerprint.String(),
+		}
+		m.mu.Lock()
+		result, ok := m.mirrored[*key]
+		m.mu.Unlock()
+		if ok {
+			return result, nil
+		}
+	}
+
+	mirrored, err := m.newMirror(target).MirrorImage(ctx, docker.DockerImageDetails{
+		RegistryPath:     image.RegistryPath,
+		ImageRepoDetails: docker.ConvertFromResourceImageDetails(image.ImageRepoDetails),
+	})
+	if err != nil {
+		return coreresource.DockerImageDetails{}, errors.Trace(err)
+	}
+	result := coreresource.DockerImageDetails{
+		RegistryPath:     mirrored.RegistryPath,
+		ImageRepoDetails: docker.ConvertToResourceImageDetails(mirrored.ImageRepoDetails),
+	}
+	if key != nil {
+		m.mu.Lock()
+		m.mirrored[*key] = result
+		m.mu.Unlock()
+	}
+	return result, nil
+}
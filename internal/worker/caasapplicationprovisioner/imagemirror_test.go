@@ -0,0 +1,138 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package caasapplicationprovisioner_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/juju/tc"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/controller"
+	coreresource "github.com/juju/juju/core/resource"
+	charmresource "github.com/juju/juju/internal/charm/resource"
+	"github.com/juju/juju/internal/docker"
+	"github.com/juju/juju/internal/worker/caasapplicationprovisioner"
+	"github.com/juju/juju/internal/worker/caasapplicationprovisioner/mocks"
+)
+
+func TestImageMirrorSuite(t *testing.T) {
+	tc.Run(t, &ImageMirrorSuite{})
+}
+
+type ImageMirrorSuite struct{}
+
+type fakeDockerImageMirror struct {
+	target docker.ImageRepoDetails
+	images []docker.DockerImageDetails
+}
+
+func (m *fakeDockerImageMirror) MirrorImage(_ context.Context, image docker.DockerImageDetails) (docker.DockerImageDetails, error) {
+	m.images = append(m.images, image)
+	return docker.DockerImageDetails{
+		RegistryPath:     m.target.Repository + "/docker.io/library/mysql@sha256:deadbeef",
+		ImageRepoDetails: m.target,
+	}, nil
+}
+
+func (s *ImageMirrorSuite) TestMirrorImageNotConfigured(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	controllerConfigService := mocks.NewMockControllerConfigService(ctrl)
+	controllerConfigService.EXPECT().ControllerConfig(gomock.Any()).Return(controller.Config{}, nil)
+
+	imageMirror := caasapplicationprovisioner.NewControllerImageMirrorForTest(
+		controllerConfigService,
+		func(docker.ImageRepoDetails) caasapplicationprovisioner.DockerImageMirror {
+			c.Fatalf("unexpected mirror")
+			return nil
+		},
+	)
+	image := coreresource.DockerImageDetails{RegistryPath: "mysql:8.0"}
+	result, err := imageMirror.MirrorImage(c.Context(), charmresource.Fingerprint{}, image)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(result, tc.DeepEquals, image)
+}
+
+func (s *ImageMirrorSuite) TestMirrorImage(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	controllerConfigService := mocks.NewMockControllerConfigService(ctrl)
+	controllerConfigService.EXPECT().ControllerConfig(gomock.Any()).Return(controller.Config{
+		controller.CAASImageMirror: `{"repository": "registry.internal/juju", "username": "juju", "password": "secret"}`,
+	}, nil)
+
+	fake := &fakeDockerImageMirror{}
+	imageMirror := caasapplicationprovisioner.NewControllerImageMirrorForTest(
+		controllerConfigService,
+		func(target docker.ImageRepoDetails) caasapplicationprovisioner.DockerImageMirror {
+			fake.target = target
+			return fake
+		},
+	)
+	result, err := imageMirror.MirrorImage(c.Context(), charmresource.Fingerprint{}, coreresource.DockerImageDetails{
+		RegistryPath: "mysql:8.0",
+		ImageRepoDetails: coreresource.ImageRepoDetails{
+			BasicAuthConfig: coreresource.BasicAuthConfig{
+				Username: "user",
+				Password: "pass",
+			},
+		},
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(fake.images, tc.DeepEquals, []docker.DockerImageDetails{{
+		RegistryPath: "mysql:8.0",
+		ImageRepoDetails: docker.ImageRepoDetails{
+			BasicAuthConfig: docker.BasicAuthConfig{
+				Username: "user",
+				Password: "pass",
+			},
+		},
+	}})
+	c.Check(result.RegistryPath, tc.Equals, "registry.internal/juju/docker.io/library/mysql@sha256:deadbeef")
+	c.Check(result.Repository, tc.Equals, "registry.internal/juju")
+	c.Check(result.Username, tc.Equals, "juju")
+	c.Check(result.Password, tc.Equals, "secret")
+}
+
+func (s *ImageMirrorSuite) TestMirrorImageOncePerRevision(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	controllerConfigService := mocks.NewMockControllerConfigService(ctrl)
+	controllerConfigService.EXPECT().ControllerConfig(gomock.Any()).Return(controller.Config{
+		controller.CAASImageMirror: `{"repository": "registry.internal/juju"}`,
+	}, nil).Times(3)
+
+	fake := &fakeDockerImageMirror{}
+	imageMirror := caasapplicationprovisioner.NewControllerImageMirrorForTest(
+		controllerConfigService,
+		func(target docker.ImageRepoDetails) caasapplicationprovisioner.DockerImageMirror {
+			fake.target = target
+			return fake
+		},
+	)
+
+	image := coreresource.DockerImageDetails{RegistryPath: "mysql:8.0"}
+	fingerprint, err := charmresource.GenerateFingerprint(strings.NewReader("registrypath: mysql:8.0"))
+	c.Assert(err, tc.ErrorIsNil)
+	first, err := imageMirror.MirrorImage(c.Context(), fingerprint, image)
+	c.Assert(err, tc.ErrorIsNil)
+	second, err := imageMirror.MirrorImage(c.Context(), fingerprint, image)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(second, tc.DeepEquals, first)
+	c.Check(fake.images, tc.HasLen, 1)
+
+	// A new revision of the resource is mirrored again.
+	image = coreresource.DockerImageDetails{RegistryPath: "mysql:8.4"}
+	fingerprint, err = charmresource.GenerateFingerprint(strings.NewReader("registrypath: mysql:8.4"))
+	c.Assert(err, tc.ErrorIsNil)
+	_, err = imageMirror.MirrorImage(c.Context(), fingerprint, image)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(fake.images, tc.HasLen, 2)
+}
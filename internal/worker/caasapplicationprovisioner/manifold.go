@@ -65,7 +65,7 @@ func (config ManifoldConfig) start(ctx context.Context, getter dependency.Getter
 		return nil, errors.Trace(err)
 	}
 
-	var domainServices services.ModelDomainServices
+	var domainServices services.DomainServices
 	if err := getter.Get(config.DomainServicesName, &domainServices); err != nil {
 		return nil, errors.Trace(err)
 	}
@@ -97,6 +97,7 @@ func (config ManifoldConfig) start(ctx context.Context, getter dependency.Getter
 		AgentPasswordService:       domainServices.AgentPassword(),
 		StorageProvisioningService: domainServices.StorageProvisioning(),
 		ResourceOpenerGetter:       rog,
+		ImageMirror:                NewControllerImageMirror(domainServices.ControllerConfig()),
 		Facade:                     apicaasapplicationprovisioner.NewClient(apiCaller),
 		Broker:                     broker,
 		Clock:                      clock,
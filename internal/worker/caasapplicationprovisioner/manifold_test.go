@@ -18,11 +18,13 @@ import (
 	"github.com/juju/juju/caas"
 	agentpasswordservice "github.com/juju/juju/domain/agentpassword/service"
 	applicationservice "github.com/juju/juju/domain/application/service"
+	controllerconfigservice "github.com/juju/juju/domain/controllerconfig/service"
 	modelconfigservice "github.com/juju/juju/domain/modelconfig/service"
 	resourceservice "github.com/juju/juju/domain/resource/service"
 	statusservice "github.com/juju/juju/domain/status/service"
 	storageprovisioningservice "github.com/juju/juju/domain/storageprovisioning/service"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/services"
 	"github.com/juju/juju/internal/testhelpers"
 	"github.com/juju/juju/internal/worker/caasapplicationprovisioner"
 	"github.com/juju/juju/internal/worker/caasapplicationprovisioner/mocks"
@@ -102,7 +104,7 @@ func (s *ManifoldSuite) TestStart(c *tc.C) {
 	mockDomainServices := mocks.NewMockModelDomainServices(ctrl)
 	mockDomainServices.EXPECT().Config().Return(&modelconfigservice.WatchableService{}).AnyTimes()
 	mockDomainServices.EXPECT().Application().Return(&applicationservice.WatchableService{}).AnyTimes()
-	mockDomainServices.EXPECT().Status().Return(&statusservice.LeadershipService{}).AnyTimes()
+	mockDomainServices.EXPECT().Status().Return(&statusservice.WatchableService{}).AnyTimes()
 	mockDomainServices.EXPECT().AgentPassword().Return(&agentpasswordservice.Service{}).AnyTimes()
 	mockDomainServices.EXPECT().Resource().Return(&resourceservice.Service{}).AnyTimes()
 	mockDomainServices.EXPECT().StorageProvisioning().Return(&storageprovisioningservice.Service{}).AnyTimes()
@@ -117,13 +119,24 @@ func (s *ManifoldSuite) TestStart(c *tc.C) {
 		"api-caller":      struct{ base.APICaller }{&mockAPICaller{}},
 		"broker":          struct{ caas.Broker }{},
 		"clock":           struct{ clock.Clock }{},
-		"domain-services": mockDomainServices,
+		"domain-services": domainServices{ModelDomainServices: mockDomainServices},
 	}))
 	c.Assert(w, tc.IsNil)
 	c.Assert(err, tc.ErrorIsNil)
 	c.Assert(called, tc.IsTrue)
 }
 
+// domainServices adds the controller config service to the mocked model
+// domain services.
+type domainServices struct {
+	services.ModelDomainServices
+	services.ControllerDomainServices
+}
+
+func (domainServices) ControllerConfig() *controllerconfigservice.WatchableService {
+	return &controllerconfigservice.WatchableService{}
+}
+
 type mockAPICaller struct {
 	base.APICaller
 }
@@ -236,18 +236,18 @@ func (c *MockApplicationOpsEnsureTrustCall) DoAndReturn(f func(context.Context,
 }
 
 // ProvisioningInfo mocks base method.
-func (m *MockApplicationOps) ProvisioningInfo(arg0 context.Context, arg1 string, arg2 application.ID, arg3 caasapplicationprovisioner.CAASProvisionerFacade, arg4 caasapplicationprovisioner.StorageProvisioningService, arg5 caasapplicationprovisioner.ApplicationService, arg6 caasapplicationprovisioner.ResourceOpenerGetter, arg7 *caasapplicationprovisioner.ProvisioningInfo, arg8 logger.Logger) (*caasapplicationprovisioner.ProvisioningInfo, error) {
+func (m *MockApplicationOps) ProvisioningInfo(arg0 context.Context, arg1 string, arg2 application.ID, arg3 caasapplicationprovisioner.CAASProvisionerFacade, arg4 caasapplicationprovisioner.StorageProvisioningService, arg5 caasapplicationprovisioner.ApplicationService, arg6 caasapplicationprovisioner.ResourceOpenerGetter, arg7 caasapplicationprovisioner.ImageMirror, arg8 *caasapplicationprovisioner.ProvisioningInfo, arg9 logger.Logger) (*caasapplicationprovisioner.ProvisioningInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ProvisioningInfo", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8)
+	ret := m.ctrl.Call(m, "ProvisioningInfo", arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9)
 	ret0, _ := ret[0].(*caasapplicationprovisioner.ProvisioningInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ProvisioningInfo indicates an expected call of ProvisioningInfo.
-func (mr *MockApplicationOpsMockRecorder) ProvisioningInfo(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8 any) *MockApplicationOpsProvisioningInfoCall {
+func (mr *MockApplicationOpsMockRecorder) ProvisioningInfo(arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9 any) *MockApplicationOpsProvisioningInfoCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProvisioningInfo", reflect.TypeOf((*MockApplicationOps)(nil).ProvisioningInfo), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProvisioningInfo", reflect.TypeOf((*MockApplicationOps)(nil).ProvisioningInfo), arg0, arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9)
 	return &MockApplicationOpsProvisioningInfoCall{Call: call}
 }
 
@@ -263,13 +263,13 @@ func (c *MockApplicationOpsProvisioningInfoCall) Return(arg0 *caasapplicationpro
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockApplicationOpsProvisioningInfoCall) Do(f func(context.Context, string, application.ID, caasapplicationprovisioner.CAASProvisionerFacade, caasapplicationprovisioner.StorageProvisioningService, caasapplicationprovisioner.ApplicationService, caasapplicationprovisioner.ResourceOpenerGetter, *caasapplicationprovisioner.ProvisioningInfo, logger.Logger) (*caasapplicationprovisioner.ProvisioningInfo, error)) *MockApplicationOpsProvisioningInfoCall {
+func (c *MockApplicationOpsProvisioningInfoCall) Do(f func(context.Context, string, application.ID, caasapplicationprovisioner.CAASProvisionerFacade, caasapplicationprovisioner.StorageProvisioningService, caasapplicationprovisioner.ApplicationService, caasapplicationprovisioner.ResourceOpenerGetter, caasapplicationprovisioner.ImageMirror, *caasapplicationprovisioner.ProvisioningInfo, logger.Logger) (*caasapplicationprovisioner.ProvisioningInfo, error)) *MockApplicationOpsProvisioningInfoCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockApplicationOpsProvisioningInfoCall) DoAndReturn(f func(context.Context, string, application.ID, caasapplicationprovisioner.CAASProvisionerFacade, caasapplicationprovisioner.StorageProvisioningService, caasapplicationprovisioner.ApplicationService, caasapplicationprovisioner.ResourceOpenerGetter, *caasapplicationprovisioner.ProvisioningInfo, logger.Logger) (*caasapplicationprovisioner.ProvisioningInfo, error)) *MockApplicationOpsProvisioningInfoCall {
+func (c *MockApplicationOpsProvisioningInfoCall) DoAndReturn(f func(context.Context, string, application.ID, caasapplicationprovisioner.CAASProvisionerFacade, caasapplicationprovisioner.StorageProvisioningService, caasapplicationprovisioner.ApplicationService, caasapplicationprovisioner.ResourceOpenerGetter, caasapplicationprovisioner.ImageMirror, *caasapplicationprovisioner.ProvisioningInfo, logger.Logger) (*caasapplicationprovisioner.ProvisioningInfo, error)) *MockApplicationOpsProvisioningInfoCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
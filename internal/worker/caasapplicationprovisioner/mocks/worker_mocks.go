@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/internal/worker/caasapplicationprovisioner (interfaces: Runner,ResourceOpenerGetter)
+// Source: github.com/juju/juju/internal/worker/caasapplicationprovisioner (interfaces: Runner,ResourceOpenerGetter,ImageMirror)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package mocks -destination mocks/worker_mocks.go github.com/juju/juju/internal/worker/caasapplicationprovisioner Runner,ResourceOpenerGetter
+//	mockgen -typed -package mocks -destination mocks/worker_mocks.go github.com/juju/juju/internal/worker/caasapplicationprovisioner Runner,ResourceOpenerGetter,ImageMirror
 //
 
 // Package mocks is a generated GoMock package.
@@ -15,6 +15,7 @@ import (
 
 	application "github.com/juju/juju/core/application"
 	resource "github.com/juju/juju/core/resource"
+	resource0 "github.com/juju/juju/internal/charm/resource"
 	worker "github.com/juju/worker/v4"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -330,3 +331,65 @@ func (c *MockResourceOpenerGetterResourceOpenerForApplicationCall) DoAndReturn(f
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockImageMirror is a mock of ImageMirror interface.
+type MockImageMirror struct {
+	ctrl     *gomock.Controller
+	recorder *MockImageMirrorMockRecorder
+}
+
+// MockImageMirrorMockRecorder is the mock recorder for MockImageMirror.
+type MockImageMirrorMockRecorder struct {
+	mock *MockImageMirror
+}
+
+// NewMockImageMirror creates a new mock instance.
+func NewMockImageMirror(ctrl *gomock.Controller) *MockImageMirror {
+	mock := &MockImageMirror{ctrl: ctrl}
+	mock.recorder = &MockImageMirrorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImageMirror) EXPECT() *MockImageMirrorMockRecorder {
+	return m.recorder
+}
+
+// MirrorImage mocks base method.
+func (m *MockImageMirror) MirrorImage(arg0 context.Context, arg1 resource0.Fingerprint, arg2 resource.DockerImageDetails) (resource.DockerImageDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MirrorImage", arg0, arg1, arg2)
+	ret0, _ := ret[0].(resource.DockerImageDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MirrorImage indicates an expected call of MirrorImage.
+func (mr *MockImageMirrorMockRecorder) MirrorImage(arg0, arg1, arg2 any) *MockImageMirrorMirrorImageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MirrorImage", reflect.TypeOf((*MockImageMirror)(nil).MirrorImage), arg0, arg1, arg2)
+	return &MockImageMirrorMirrorImageCall{Call: call}
+}
+
+// MockImageMirrorMirrorImageCall wrap *gomock.Call
+type MockImageMirrorMirrorImageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockImageMirrorMirrorImageCall) Return(arg0 resource.DockerImageDetails, arg1 error) *MockImageMirrorMirrorImageCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockImageMirrorMirrorImageCall) Do(f func(context.Context, resource0.Fingerprint, resource.DockerImageDetails) (resource.DockerImageDetails, error)) *MockImageMirrorMirrorImageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockImageMirrorMirrorImageCall) DoAndReturn(f func(context.Context, resource0.Fingerprint, resource.DockerImageDetails) (resource.DockerImageDetails, error)) *MockImageMirrorMirrorImageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
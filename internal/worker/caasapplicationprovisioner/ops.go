@@ -74,6 +74,7 @@ type ApplicationOps interface {
 		storageProvisioningService StorageProvisioningService,
 		applicationService ApplicationService,
 		resourceOpenerGetter ResourceOpenerGetter,
+		imageMirror ImageMirror,
 		lastProvisioningInfo *ProvisioningInfo,
 		logger logger.Logger) (*ProvisioningInfo, error)
 
@@ -127,9 +128,10 @@ func (applicationOps) ProvisioningInfo(
 	storageProvisioningService StorageProvisioningService,
 	applicationService ApplicationService,
 	resourceOpenerGetter ResourceOpenerGetter,
+	imageMirror ImageMirror,
 	lastProvisioningInfo *ProvisioningInfo,
 	logger logger.Logger) (*ProvisioningInfo, error) {
-	return provisioningInfo(ctx, appName, appID, facade, storageProvisioningService, applicationService, resourceOpenerGetter, lastProvisioningInfo, logger)
+	return provisioningInfo(ctx, appName, appID, facade, storageProvisioningService, applicationService, resourceOpenerGetter, imageMirror, lastProvisioningInfo, logger)
 }
 
 func (applicationOps) AppAlive(
@@ -297,6 +299,7 @@ func appAlive(ctx context.Context, appName string, app caas.Application,
 					Path:     mountPoint,
 				},
 				ResourceTags: pi.StorageResourceTags,
+				Shared:       fst.Shared,
 			}
 			filesystems = append(filesystems, fsp)
 		}
@@ -822,6 +825,7 @@ func provisioningInfo(
 	storageProvisioningService StorageProvisioningService,
 	applicationService ApplicationService,
 	resourceOpenerGetter ResourceOpenerGetter,
+	imageMirror ImageMirror,
 	lastProvisioningInfo *ProvisioningInfo,
 	logger logger.Logger,
 ) (*ProvisioningInfo, error) {
@@ -890,6 +894,10 @@ func provisioningInfo(
 		if err != nil {
 			return nil, errors.Trace(err)
 		}
+		rsc, err = imageMirror.MirrorImage(ctx, opened.Fingerprint, rsc)
+		if err != nil {
+			return nil, errors.Annotatef(err, "mirroring image resource %q", v.Name)
+		}
 		pi.Images[v.Name] = rsc
 		err = ro.SetResourceUsed(ctx, opened.UUID)
 		if err != nil {
@@ -747,6 +747,7 @@ func (s *OpsSuite) TestProvisioningInfo(c *tc.C) {
 	resourceOpenerGetter := mocks.NewMockResourceOpenerGetter(ctrl)
 	ro := mocks.NewMockOpener(ctrl)
 	resourceOpenerGetter.EXPECT().ResourceOpenerForApplication(gomock.Any(), appId, "test").Return(ro, nil)
+	imageMirror := mocks.NewMockImageMirror(ctrl)
 
 	facadePi := api.ProvisioningInfo{
 		ImageDetails: coreresource.DockerImageDetails{
@@ -825,7 +826,12 @@ func (s *OpsSuite) TestProvisioningInfo(c *tc.C) {
 	ch := charm.NewCharmBase(chMeta, nil, nil, nil, nil)
 	applicationService.EXPECT().GetCharmByApplicationID(gomock.Any(), appId).Return(ch, applicationcharm.CharmLocator{}, nil)
 
+	mysqlImageFingerprint, err := charmresource.GenerateFingerprint(bytes.NewBufferString("registrypath: mysql/ubuntu:latest-22.04"))
+	c.Assert(err, tc.ErrorIsNil)
 	mysqlImageResource := coreresource.Opened{
+		Resource: coreresource.Resource{
+			Resource: charmresource.Resource{Fingerprint: mysqlImageFingerprint},
+		},
 		ReadCloser: io.NopCloser(bytes.NewBufferString("registrypath: mysql/ubuntu:latest-22.04")),
 	}
 	ro.EXPECT().OpenResource(gomock.Any(), "mysql-image").Return(mysqlImageResource, nil)
@@ -836,7 +842,21 @@ func (s *OpsSuite) TestProvisioningInfo(c *tc.C) {
 	ro.EXPECT().OpenResource(gomock.Any(), "rootless-image").Return(rootlessImageResource, nil)
 	ro.EXPECT().SetResourceUsed(gomock.Any(), gomock.Any()).Return(nil)
 
-	pi, err := caasapplicationprovisioner.AppOps.ProvisioningInfo(c.Context(), "test", appId, facade, storageProvisioningService, applicationService, resourceOpenerGetter, nil, s.logger)
+	mirroredImage := coreresource.DockerImageDetails{
+		RegistryPath: "registry.internal/juju/docker.io/mysql/ubuntu@sha256:deadbeef",
+		ImageRepoDetails: coreresource.ImageRepoDetails{
+			Repository: "registry.internal/juju",
+		},
+	}
+	imageMirror.EXPECT().MirrorImage(gomock.Any(), mysqlImageFingerprint, coreresource.DockerImageDetails{
+		RegistryPath: "mysql/ubuntu:latest-22.04",
+	}).Return(mirroredImage, nil)
+	rootlessImage := coreresource.DockerImageDetails{
+		RegistryPath: "rootless:foo-bar",
+	}
+	imageMirror.EXPECT().MirrorImage(gomock.Any(), charmresource.Fingerprint{}, rootlessImage).Return(rootlessImage, nil)
+
+	pi, err := caasapplicationprovisioner.AppOps.ProvisioningInfo(c.Context(), "test", appId, facade, storageProvisioningService, applicationService, resourceOpenerGetter, imageMirror, nil, s.logger)
 	c.Assert(err, tc.ErrorIsNil)
 	c.Check(pi, tc.DeepEquals, &caasapplicationprovisioner.ProvisioningInfo{
 		ImageDetails: coreresource.DockerImageDetails{
@@ -866,12 +886,8 @@ func (s *OpsSuite) TestProvisioningInfo(c *tc.C) {
 		Devices:     []devices.KubernetesDeviceParams{},
 		CharmMeta:   chMeta,
 		Images: map[string]coreresource.DockerImageDetails{
-			"mysql-image": {
-				RegistryPath: "mysql/ubuntu:latest-22.04",
-			},
-			"rootless-image": {
-				RegistryPath: "rootless:foo-bar",
-			},
+			"mysql-image":    mirroredImage,
+			"rootless-image": rootlessImage,
 		},
 		FilesystemTemplates: fsTemplates,
 		StorageResourceTags: storageResourceTags,
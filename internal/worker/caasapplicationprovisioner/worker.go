@@ -166,6 +166,7 @@ type Config struct {
 	AgentPasswordService       AgentPasswordService
 	StorageProvisioningService StorageProvisioningService
 	ResourceOpenerGetter       ResourceOpenerGetter
+	ImageMirror                ImageMirror
 	Facade                     CAASProvisionerFacade
 	Broker                     CAASBroker
 	Clock                      clock.Clock
@@ -173,6 +174,44 @@ type Config struct {
 	NewAppWorker               NewAppWorkerFunc
 }
 
+// Validate checks the config for missing values.
+func (config Config) Validate() error {
+	if config.ApplicationService == nil {
+		return errors.NotValidf("nil ApplicationService")
+	}
+	if config.StatusService == nil {
+		return errors.NotValidf("nil StatusService")
+	}
+	if config.AgentPasswordService == nil {
+		return errors.NotValidf("nil AgentPasswordService")
+	}
+	if config.StorageProvisioningService == nil {
+		return errors.NotValidf("nil StorageProvisioningService")
+	}
+	if config.ResourceOpenerGetter == nil {
+		return errors.NotValidf("nil ResourceOpenerGetter")
+	}
+	if config.ImageMirror == nil {
+		return errors.NotValidf("nil ImageMirror")
+	}
+	if config.Facade == nil {
+		return errors.NotValidf("nil Facade")
+	}
+	if config.Broker == nil {
+		return errors.NotValidf("nil Broker")
+	}
+	if config.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	if config.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	if config.NewAppWorker == nil {
+		return errors.NotValidf("nil NewAppWorker")
+	}
+	return nil
+}
+
 type provisioner struct {
 	catacomb                   catacomb.Catacomb
 	runner                     Runner
@@ -181,6 +220,7 @@ type provisioner struct {
 	agentPasswordService       AgentPasswordService
 	storageProvisioningService StorageProvisioningService
 	resourceOpenerGetter       ResourceOpenerGetter
+	imageMirror                ImageMirror
 	Facade                     CAASProvisionerFacade
 	facade                     CAASProvisionerFacade
 	broker                     CAASBroker
@@ -191,6 +231,9 @@ type provisioner struct {
 
 // NewProvisionerWorker starts and returns a new CAAS provisioner worker.
 func NewProvisionerWorker(config Config) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
 	runner, err := worker.NewRunner(worker.RunnerParams{
 		Name:         "provisioner",
 		Clock:        config.Clock,
@@ -207,12 +250,16 @@ func NewProvisionerWorker(config Config) (worker.Worker, error) {
 func newProvisionerWorker(
 	config Config, runner Runner,
 ) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
 	p := &provisioner{
 		applicationService:         config.ApplicationService,
 		statusService:              config.StatusService,
 		agentPasswordService:       config.AgentPasswordService,
 		storageProvisioningService: config.StorageProvisioningService,
 		resourceOpenerGetter:       config.ResourceOpenerGetter,
+		imageMirror:                config.ImageMirror,
 		facade:                     config.Facade,
 		broker:                     config.Broker,
 		clock:                      config.Clock,
@@ -288,6 +335,7 @@ func (p *provisioner) loop() error {
 					AgentPasswordService:       p.agentPasswordService,
 					StorageProvisioningService: p.storageProvisioningService,
 					ResourceOpenerGetter:       p.resourceOpenerGetter,
+					ImageMirror:                p.imageMirror,
 					Facade:                     p.facade,
 					Broker:                     p.broker,
 					Clock:                      p.clock,
@@ -45,6 +45,34 @@ func (s *CAASApplicationSuite) SetUpTest(c *tc.C) {
 	s.logger = loggertesting.WrapCheckLog(c)
 }
 
+func (s *CAASApplicationSuite) TestValidateConfig(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	config := caasapplicationprovisioner.Config{
+		ApplicationService:         mocks.NewMockApplicationService(ctrl),
+		StatusService:              mocks.NewMockStatusService(ctrl),
+		AgentPasswordService:       mocks.NewMockAgentPasswordService(ctrl),
+		StorageProvisioningService: mocks.NewMockStorageProvisioningService(ctrl),
+		ResourceOpenerGetter:       mocks.NewMockResourceOpenerGetter(ctrl),
+		ImageMirror:                mocks.NewMockImageMirror(ctrl),
+		Facade:                     mocks.NewMockCAASProvisionerFacade(ctrl),
+		Broker:                     struct{ caas.Broker }{},
+		Clock:                      s.clock,
+		Logger:                     s.logger,
+		NewAppWorker:               caasapplicationprovisioner.NewAppWorker,
+	}
+	c.Assert(config.Validate(), tc.ErrorIsNil)
+
+	config.ImageMirror = nil
+	err := config.Validate()
+	c.Check(err, tc.ErrorIs, errors.NotValid)
+	c.Check(err, tc.ErrorMatches, "nil ImageMirror not valid")
+
+	_, err = caasapplicationprovisioner.NewProvisionerWorker(config)
+	c.Check(err, tc.ErrorIs, errors.NotValid)
+}
+
 func (s *CAASApplicationSuite) TestWorkerStart(c *tc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
@@ -85,12 +113,17 @@ func (s *CAASApplicationSuite) TestWorkerStart(c *tc.C) {
 		}
 	}
 	config := caasapplicationprovisioner.Config{
-		ApplicationService: applicationService,
-		Facade:             facade,
-		Broker:             struct{ caas.Broker }{},
-		Clock:              s.clock,
-		Logger:             s.logger,
-		NewAppWorker:       newWorker,
+		ApplicationService:         applicationService,
+		StatusService:              mocks.NewMockStatusService(ctrl),
+		AgentPasswordService:       mocks.NewMockAgentPasswordService(ctrl),
+		StorageProvisioningService: mocks.NewMockStorageProvisioningService(ctrl),
+		ResourceOpenerGetter:       mocks.NewMockResourceOpenerGetter(ctrl),
+		ImageMirror:                mocks.NewMockImageMirror(ctrl),
+		Facade:                     facade,
+		Broker:                     struct{ caas.Broker }{},
+		Clock:                      s.clock,
+		Logger:                     s.logger,
+		NewAppWorker:               newWorker,
 	}
 	provisioner, err := caasapplicationprovisioner.NewProvisionerWorkerForTest(config, runner)
 	c.Assert(err, tc.ErrorIsNil)
@@ -161,12 +194,17 @@ func (s *CAASApplicationSuite) TestWorkerStartOnceNotify(c *tc.C) {
 		}
 	}
 	config := caasapplicationprovisioner.Config{
-		ApplicationService: applicationService,
-		Facade:             facade,
-		Broker:             struct{ caas.Broker }{},
-		Clock:              s.clock,
-		Logger:             s.logger,
-		NewAppWorker:       newWorker,
+		ApplicationService:         applicationService,
+		StatusService:              mocks.NewMockStatusService(ctrl),
+		AgentPasswordService:       mocks.NewMockAgentPasswordService(ctrl),
+		StorageProvisioningService: mocks.NewMockStorageProvisioningService(ctrl),
+		ResourceOpenerGetter:       mocks.NewMockResourceOpenerGetter(ctrl),
+		ImageMirror:                mocks.NewMockImageMirror(ctrl),
+		Facade:                     facade,
+		Broker:                     struct{ caas.Broker }{},
+		Clock:                      s.clock,
+		Logger:                     s.logger,
+		NewAppWorker:               newWorker,
 	}
 	provisioner, err := caasapplicationprovisioner.NewProvisionerWorkerForTest(config, runner)
 	c.Assert(err, tc.ErrorIsNil)
@@ -0,0 +1,33 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package charmrefresher provides a worker that acts on the charm revisions
+// found by the charm revision updater, according to the refresh policy of
+// each application.
+//
+// # Overview
+//
+// The charm revision updater polls Charmhub and records the latest revision
+// available in the channel each application is tracking. This worker
+// periodically asks the application service for the applications which have
+// a newer revision available and a refresh policy other than none:
+//   - notify: the available revision is recorded once in the status history
+//     of the application.
+//   - auto: if the current time is within the application's maintenance
+//     window (or it has none), the available revision is downloaded from
+//     Charmhub and the application is refreshed to it once it is available,
+//     provided it is compatible with the current charm. The outcome is
+//     recorded in the status history of the application. An automatic refresh has no
+//     signature to verify, so while the controller's charm-signature-policy
+//     is "required" these applications are only notified, as above.
+//
+// A revision which failed to download is retried on the next run, but one
+// which failed to refresh is not retried, so that a broken revision doesn't
+// fill the status history. The revisions notified of, and those which failed
+// to refresh, are recorded by the application service, so this holds across
+// restarts of the worker.
+//
+// # Integration
+//
+// The worker is intended to be run by the Juju controller, for each model.
+package charmrefresher
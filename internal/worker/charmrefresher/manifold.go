@@ -0,0 +1,129 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package charmrefresher
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/errors"
+	"github.com/juju/worker/v4"
+	"github.com/juju/worker/v4/dependency"
+
+	corehttp "github.com/juju/juju/core/http"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/charm/charmdownloader"
+	"github.com/juju/juju/internal/charmhub"
+	"github.com/juju/juju/internal/services"
+	internalworker "github.com/juju/juju/internal/worker"
+)
+
+// Downloader is responsible for downloading charm archives from Charmhub.
+type Downloader interface {
+	// Download downloads the charm archive at the given URL to a temporary
+	// file, verifying that it has the expected sha256 hash.
+	Download(ctx context.Context, curl *url.URL, hash string) (*charmdownloader.DownloadResult, error)
+}
+
+// NewDownloaderFunc is a function that creates a new Downloader.
+type NewDownloaderFunc func(charmhub.HTTPClient, logger.Logger) Downloader
+
+// NewHTTPClientFunc is a function that creates a new HTTP client.
+type NewHTTPClientFunc func(context.Context, corehttp.HTTPClientGetter) (corehttp.HTTPClient, error)
+
+// ManifoldConfig describes the resources used by the charm refresher worker.
+type ManifoldConfig struct {
+	DomainServicesName string
+	HTTPClientName     string
+	NewHTTPClient      NewHTTPClientFunc
+	NewDownloader      NewDownloaderFunc
+	Clock              clock.Clock
+	Logger             logger.Logger
+	// Interval specifies how often the refresher should check for
+	// applications to refresh.
+	Interval time.Duration
+}
+
+// Validate validates the manifold configuration.
+func (config ManifoldConfig) Validate() error {
+	if config.DomainServicesName == "" {
+		return errors.NotValidf("empty DomainServicesName")
+	}
+	if config.HTTPClientName == "" {
+		return errors.NotValidf("empty HTTPClientName")
+	}
+	if config.NewHTTPClient == nil {
+		return errors.NotValidf("nil NewHTTPClient")
+	}
+	if config.NewDownloader == nil {
+		return errors.NotValidf("nil NewDownloader")
+	}
+	if config.Clock == nil {
+		return errors.NotValidf("nil Clock")
+	}
+	if config.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	if config.Interval <= 0 {
+		return errors.NotValidf("non-positive Interval")
+	}
+	return nil
+}
+
+// start starts the charm refresher worker.
+func (config ManifoldConfig) start(ctx context.Context, getter dependency.Getter) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var domainServices services.DomainServices
+	if err := getter.Get(config.DomainServicesName, &domainServices); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var httpClientGetter corehttp.HTTPClientGetter
+	if err := getter.Get(config.HTTPClientName, &httpClientGetter); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	w, err := NewWorker(Config{
+		ApplicationService:      domainServices.Application(),
+		ControllerConfigService: domainServices.ControllerConfig(),
+		HTTPClientGetter:        httpClientGetter,
+		NewHTTPClient:           config.NewHTTPClient,
+		NewDownloader:           config.NewDownloader,
+		Clock:                   config.Clock,
+		Logger:                  config.Logger,
+		Interval:                config.Interval,
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return w, nil
+}
+
+// Manifold returns a Manifold that encapsulates the charm refresher worker.
+func Manifold(config ManifoldConfig) dependency.Manifold {
+	return dependency.Manifold{
+		Inputs: []string{
+			config.DomainServicesName,
+			config.HTTPClientName,
+		},
+		Start:  config.start,
+		Filter: internalworker.ShouldWorkerUninstall,
+	}
+}
+
+// NewHTTPClient creates a new HTTP client for talking to Charmhub.
+func NewHTTPClient(ctx context.Context, getter corehttp.HTTPClientGetter) (corehttp.HTTPClient, error) {
+	return getter.GetHTTPClient(ctx, corehttp.CharmhubPurpose)
+}
+
+// NewDownloader creates a new Downloader instance.
+func NewDownloader(httpClient charmhub.HTTPClient, logger logger.Logger) Downloader {
+	downloadClient := charmhub.NewDownloadClient(httpClient, charmhub.DefaultFileSystem(), logger)
+	return charmdownloader.NewCharmDownloader(downloadClient, logger)
+}
@@ -0,0 +1,6 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package charmrefresher
+
+//go:generate go run go.uber.org/mock/mockgen -typed -package charmrefresher -destination services_mock_test.go github.com/juju/juju/internal/worker/charmrefresher ApplicationService,ControllerConfigService,Downloader
@@ -0,0 +1,361 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/internal/worker/charmrefresher (interfaces: ApplicationService,ControllerConfigService,Downloader)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package charmrefresher -destination services_mock_test.go github.com/juju/juju/internal/worker/charmrefresher ApplicationService,ControllerConfigService,Downloader
+//
+
+// Package charmrefresher is a generated GoMock package.
+package charmrefresher
+
+import (
+	context "context"
+	url "net/url"
+	reflect "reflect"
+
+	controller "github.com/juju/juju/controller"
+	application "github.com/juju/juju/domain/application"
+	charm "github.com/juju/juju/domain/application/charm"
+	charmdownloader "github.com/juju/juju/internal/charm/charmdownloader"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockApplicationService is a mock of ApplicationService interface.
+type MockApplicationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockApplicationServiceMockRecorder
+}
+
+// MockApplicationServiceMockRecorder is the mock recorder for MockApplicationService.
+type MockApplicationServiceMockRecorder struct {
+	mock *MockApplicationService
+}
+
+// NewMockApplicationService creates a new mock instance.
+func NewMockApplicationService(ctrl *gomock.Controller) *MockApplicationService {
+	mock := &MockApplicationService{ctrl: ctrl}
+	mock.recorder = &MockApplicationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockApplicationService) EXPECT() *MockApplicationServiceMockRecorder {
+	return m.recorder
+}
+
+// GetApplicationRefreshCandidates mocks base method.
+func (m *MockApplicationService) GetApplicationRefreshCandidates(arg0 context.Context) ([]application.RefreshCandidate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationRefreshCandidates", arg0)
+	ret0, _ := ret[0].([]application.RefreshCandidate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationRefreshCandidates indicates an expected call of GetApplicationRefreshCandidates.
+func (mr *MockApplicationServiceMockRecorder) GetApplicationRefreshCandidates(arg0 any) *MockApplicationServiceGetApplicationRefreshCandidatesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationRefreshCandidates", reflect.TypeOf((*MockApplicationService)(nil).GetApplicationRefreshCandidates), arg0)
+	return &MockApplicationServiceGetApplicationRefreshCandidatesCall{Call: call}
+}
+
+// MockApplicationServiceGetApplicationRefreshCandidatesCall wrap *gomock.Call
+type MockApplicationServiceGetApplicationRefreshCandidatesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceGetApplicationRefreshCandidatesCall) Return(arg0 []application.RefreshCandidate, arg1 error) *MockApplicationServiceGetApplicationRefreshCandidatesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceGetApplicationRefreshCandidatesCall) Do(f func(context.Context) ([]application.RefreshCandidate, error)) *MockApplicationServiceGetApplicationRefreshCandidatesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceGetApplicationRefreshCandidatesCall) DoAndReturn(f func(context.Context) ([]application.RefreshCandidate, error)) *MockApplicationServiceGetApplicationRefreshCandidatesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetCharmRevisionDownloadInfo mocks base method.
+func (m *MockApplicationService) GetCharmRevisionDownloadInfo(arg0 context.Context, arg1 charm.CharmLocator) (application.CharmDownloadInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCharmRevisionDownloadInfo", arg0, arg1)
+	ret0, _ := ret[0].(application.CharmDownloadInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCharmRevisionDownloadInfo indicates an expected call of GetCharmRevisionDownloadInfo.
+func (mr *MockApplicationServiceMockRecorder) GetCharmRevisionDownloadInfo(arg0, arg1 any) *MockApplicationServiceGetCharmRevisionDownloadInfoCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCharmRevisionDownloadInfo", reflect.TypeOf((*MockApplicationService)(nil).GetCharmRevisionDownloadInfo), arg0, arg1)
+	return &MockApplicationServiceGetCharmRevisionDownloadInfoCall{Call: call}
+}
+
+// MockApplicationServiceGetCharmRevisionDownloadInfoCall wrap *gomock.Call
+type MockApplicationServiceGetCharmRevisionDownloadInfoCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceGetCharmRevisionDownloadInfoCall) Return(arg0 application.CharmDownloadInfo, arg1 error) *MockApplicationServiceGetCharmRevisionDownloadInfoCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceGetCharmRevisionDownloadInfoCall) Do(f func(context.Context, charm.CharmLocator) (application.CharmDownloadInfo, error)) *MockApplicationServiceGetCharmRevisionDownloadInfoCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceGetCharmRevisionDownloadInfoCall) DoAndReturn(f func(context.Context, charm.CharmLocator) (application.CharmDownloadInfo, error)) *MockApplicationServiceGetCharmRevisionDownloadInfoCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// NotifyCharmRevisionAvailable mocks base method.
+func (m *MockApplicationService) NotifyCharmRevisionAvailable(arg0 context.Context, arg1 application.RefreshCandidate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyCharmRevisionAvailable", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifyCharmRevisionAvailable indicates an expected call of NotifyCharmRevisionAvailable.
+func (mr *MockApplicationServiceMockRecorder) NotifyCharmRevisionAvailable(arg0, arg1 any) *MockApplicationServiceNotifyCharmRevisionAvailableCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyCharmRevisionAvailable", reflect.TypeOf((*MockApplicationService)(nil).NotifyCharmRevisionAvailable), arg0, arg1)
+	return &MockApplicationServiceNotifyCharmRevisionAvailableCall{Call: call}
+}
+
+// MockApplicationServiceNotifyCharmRevisionAvailableCall wrap *gomock.Call
+type MockApplicationServiceNotifyCharmRevisionAvailableCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceNotifyCharmRevisionAvailableCall) Return(arg0 error) *MockApplicationServiceNotifyCharmRevisionAvailableCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceNotifyCharmRevisionAvailableCall) Do(f func(context.Context, application.RefreshCandidate) error) *MockApplicationServiceNotifyCharmRevisionAvailableCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceNotifyCharmRevisionAvailableCall) DoAndReturn(f func(context.Context, application.RefreshCandidate) error) *MockApplicationServiceNotifyCharmRevisionAvailableCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RefreshApplicationCharm mocks base method.
+func (m *MockApplicationService) RefreshApplicationCharm(arg0 context.Context, arg1 application.RefreshCandidate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshApplicationCharm", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshApplicationCharm indicates an expected call of RefreshApplicationCharm.
+func (mr *MockApplicationServiceMockRecorder) RefreshApplicationCharm(arg0, arg1 any) *MockApplicationServiceRefreshApplicationCharmCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshApplicationCharm", reflect.TypeOf((*MockApplicationService)(nil).RefreshApplicationCharm), arg0, arg1)
+	return &MockApplicationServiceRefreshApplicationCharmCall{Call: call}
+}
+
+// MockApplicationServiceRefreshApplicationCharmCall wrap *gomock.Call
+type MockApplicationServiceRefreshApplicationCharmCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceRefreshApplicationCharmCall) Return(arg0 error) *MockApplicationServiceRefreshApplicationCharmCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceRefreshApplicationCharmCall) Do(f func(context.Context, application.RefreshCandidate) error) *MockApplicationServiceRefreshApplicationCharmCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceRefreshApplicationCharmCall) DoAndReturn(f func(context.Context, application.RefreshCandidate) error) *MockApplicationServiceRefreshApplicationCharmCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ResolveCharmRevisionDownload mocks base method.
+func (m *MockApplicationService) ResolveCharmRevisionDownload(arg0 context.Context, arg1 charm.CharmLocator, arg2 application.ResolveCharmDownload) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveCharmRevisionDownload", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResolveCharmRevisionDownload indicates an expected call of ResolveCharmRevisionDownload.
+func (mr *MockApplicationServiceMockRecorder) ResolveCharmRevisionDownload(arg0, arg1, arg2 any) *MockApplicationServiceResolveCharmRevisionDownloadCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveCharmRevisionDownload", reflect.TypeOf((*MockApplicationService)(nil).ResolveCharmRevisionDownload), arg0, arg1, arg2)
+	return &MockApplicationServiceResolveCharmRevisionDownloadCall{Call: call}
+}
+
+// MockApplicationServiceResolveCharmRevisionDownloadCall wrap *gomock.Call
+type MockApplicationServiceResolveCharmRevisionDownloadCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceResolveCharmRevisionDownloadCall) Return(arg0 error) *MockApplicationServiceResolveCharmRevisionDownloadCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceResolveCharmRevisionDownloadCall) Do(f func(context.Context, charm.CharmLocator, application.ResolveCharmDownload) error) *MockApplicationServiceResolveCharmRevisionDownloadCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceResolveCharmRevisionDownloadCall) DoAndReturn(f func(context.Context, charm.CharmLocator, application.ResolveCharmDownload) error) *MockApplicationServiceResolveCharmRevisionDownloadCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockControllerConfigService is a mock of ControllerConfigService interface.
+type MockControllerConfigService struct {
+	ctrl     *gomock.Controller
+	recorder *MockControllerConfigServiceMockRecorder
+}
+
+// MockControllerConfigServiceMockRecorder is the mock recorder for MockControllerConfigService.
+type MockControllerConfigServiceMockRecorder struct {
+	mock *MockControllerConfigService
+}
+
+// NewMockControllerConfigService creates a new mock instance.
+func NewMockControllerConfigService(ctrl *gomock.Controller) *MockControllerConfigService {
+	mock := &MockControllerConfigService{ctrl: ctrl}
+	mock.recorder = &MockControllerConfigServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockControllerConfigService) EXPECT() *MockControllerConfigServiceMockRecorder {
+	return m.recorder
+}
+
+// ControllerConfig mocks base method.
+func (m *MockControllerConfigService) ControllerConfig(arg0 context.Context) (controller.Config, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ControllerConfig", arg0)
+	ret0, _ := ret[0].(controller.Config)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ControllerConfig indicates an expected call of ControllerConfig.
+func (mr *MockControllerConfigServiceMockRecorder) ControllerConfig(arg0 any) *MockControllerConfigServiceControllerConfigCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ControllerConfig", reflect.TypeOf((*MockControllerConfigService)(nil).ControllerConfig), arg0)
+	return &MockControllerConfigServiceControllerConfigCall{Call: call}
+}
+
+// MockControllerConfigServiceControllerConfigCall wrap *gomock.Call
+type MockControllerConfigServiceControllerConfigCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockControllerConfigServiceControllerConfigCall) Return(arg0 controller.Config, arg1 error) *MockControllerConfigServiceControllerConfigCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockControllerConfigServiceControllerConfigCall) Do(f func(context.Context) (controller.Config, error)) *MockControllerConfigServiceControllerConfigCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockControllerConfigServiceControllerConfigCall) DoAndReturn(f func(context.Context) (controller.Config, error)) *MockControllerConfigServiceControllerConfigCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockDownloader is a mock of Downloader interface.
+type MockDownloader struct {
+	ctrl     *gomock.Controller
+	recorder *MockDownloaderMockRecorder
+}
+
+// MockDownloaderMockRecorder is the mock recorder for MockDownloader.
+type MockDownloaderMockRecorder struct {
+	mock *MockDownloader
+}
+
+// NewMockDownloader creates a new mock instance.
+func NewMockDownloader(ctrl *gomock.Controller) *MockDownloader {
+	mock := &MockDownloader{ctrl: ctrl}
+	mock.recorder = &MockDownloaderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDownloader) EXPECT() *MockDownloaderMockRecorder {
+	return m.recorder
+}
+
+// Download mocks base method.
+func (m *MockDownloader) Download(arg0 context.Context, arg1 *url.URL, arg2 string) (*charmdownloader.DownloadResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Download", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*charmdownloader.DownloadResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Download indicates an expected call of Download.
+func (mr *MockDownloaderMockRecorder) Download(arg0, arg1, arg2 any) *MockDownloaderDownloadCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Download", reflect.TypeOf((*MockDownloader)(nil).Download), arg0, arg1, arg2)
+	return &MockDownloaderDownloadCall{Call: call}
+}
+
+// MockDownloaderDownloadCall wrap *gomock.Call
+type MockDownloaderDownloadCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDownloaderDownloadCall) Return(arg0 *charmdownloader.DownloadResult, arg1 error) *MockDownloaderDownloadCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDownloaderDownloadCall) Do(f func(context.Context, *url.URL, string) (*charmdownloader.DownloadResult, error)) *MockDownloaderDownloadCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDownloaderDownloadCall) DoAndReturn(f func(context.Context, *url.URL, string) (*charmdownloader.DownloadResult, error)) *MockDownloaderDownloadCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,310 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package charmrefresher
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/retry"
+	"github.com/juju/worker/v4"
+	"github.com/juju/worker/v4/catacomb"
+
+	"github.com/juju/juju/controller"
+	coreerrors "github.com/juju/juju/core/errors"
+	corehttp "github.com/juju/juju/core/http"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/domain/application"
+	"github.com/juju/juju/domain/application/charm"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/internal/errors"
+)
+
+// ApplicationService provides access to the applications which have a newer
+// charm revision available, and the means to act on them.
+type ApplicationService interface {
+	// GetApplicationRefreshCandidates returns the alive applications with a
+	// refresh policy other than none, for which a newer revision of their
+	// charm is available.
+	GetApplicationRefreshCandidates(ctx context.Context) ([]application.RefreshCandidate, error)
+
+	// NotifyCharmRevisionAvailable records in the status history of the
+	// candidate's application that a newer revision of its charm is
+	// available.
+	NotifyCharmRevisionAvailable(ctx context.Context, candidate application.RefreshCandidate) error
+
+	// GetCharmRevisionDownloadInfo returns the information needed to
+	// download the archive of the charm revision. If the archive has already
+	// been downloaded, [applicationerrors.CharmAlreadyAvailable] is returned.
+	GetCharmRevisionDownloadInfo(ctx context.Context, locator charm.CharmLocator) (application.CharmDownloadInfo, error)
+
+	// ResolveCharmRevisionDownload verifies and stores the downloaded archive
+	// of the charm revision, after which the charm is available.
+	ResolveCharmRevisionDownload(ctx context.Context, locator charm.CharmLocator, resolve application.ResolveCharmDownload) error
+
+	// RefreshApplicationCharm refreshes the candidate's application to the
+	// newest revision of its charm, and records the outcome in the
+	// application's status history. The revision must have been downloaded.
+	RefreshApplicationCharm(ctx context.Context, candidate application.RefreshCandidate) error
+}
+
+// ControllerConfigService provides access to the controller configuration.
+type ControllerConfigService interface {
+	// ControllerConfig returns the current controller configuration.
+	ControllerConfig(context.Context) (controller.Config, error)
+}
+
+// Config is the configuration for the charm refresher.
+type Config struct {
+	ApplicationService      ApplicationService
+	ControllerConfigService ControllerConfigService
+	HTTPClientGetter        corehttp.HTTPClientGetter
+	NewHTTPClient           NewHTTPClientFunc
+	NewDownloader           NewDownloaderFunc
+	Clock                   clock.Clock
+	Logger                  logger.Logger
+
+	// Interval is the interval at which the refresher will run.
+	Interval time.Duration
+}
+
+// Validate checks whether the worker configuration settings are valid.
+func (config Config) Validate() error {
+	if config.ApplicationService == nil {
+		return errors.Errorf("nil ApplicationService").Add(coreerrors.NotValid)
+	}
+	if config.ControllerConfigService == nil {
+		return errors.Errorf("nil ControllerConfigService").Add(coreerrors.NotValid)
+	}
+	if config.HTTPClientGetter == nil {
+		return errors.Errorf("nil HTTPClientGetter").Add(coreerrors.NotValid)
+	}
+	if config.NewHTTPClient == nil {
+		return errors.Errorf("nil NewHTTPClient").Add(coreerrors.NotValid)
+	}
+	if config.NewDownloader == nil {
+		return errors.Errorf("nil NewDownloader").Add(coreerrors.NotValid)
+	}
+	if config.Clock == nil {
+		return errors.Errorf("nil clock.Clock").Add(coreerrors.NotValid)
+	}
+	if config.Logger == nil {
+		return errors.Errorf("nil Logger").Add(coreerrors.NotValid)
+	}
+	if config.Interval <= 0 {
+		return errors.Errorf("interval must be positive").Add(coreerrors.NotValid)
+	}
+	return nil
+}
+
+// refresherWorker is a worker that refreshes applications, or notifies of
+// available charm revisions, according to their refresh policy.
+type refresherWorker struct {
+	config   Config
+	catacomb catacomb.Catacomb
+
+	// mu guards the fields below it.
+	mu sync.Mutex
+
+	lastRun     time.Time
+	refreshed   int
+	refreshFail int
+}
+
+// NewWorker returns a new charm refresher worker.
+func NewWorker(config Config) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+	w := &refresherWorker{
+		config: config,
+	}
+	err := catacomb.Invoke(catacomb.Plan{
+		Name: "charm-refresher",
+		Site: &w.catacomb,
+		Work: w.loop,
+	})
+	return w, errors.Capture(err)
+}
+
+// Kill is part of the worker.Worker interface.
+func (w *refresherWorker) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (w *refresherWorker) Wait() error {
+	return w.catacomb.Wait()
+}
+
+// Report shows up in the dependency engine report.
+func (w *refresherWorker) Report() map[string]interface{} {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return map[string]interface{}{
+		"last-run":         w.lastRun,
+		"refreshed":        w.refreshed,
+		"refresh-failures": w.refreshFail,
+	}
+}
+
+// jitter returns a random duration around the given period, between 0.5 and 1.5
+// times the period.
+func jitter(period time.Duration) time.Duration {
+	half := period / 2
+	return retry.ExpBackoff(half, period+half, 2, true)(0, 1)
+}
+
+// loop is the worker's main loop. On each tick of the interval it acts on
+// the applications which have a newer charm revision available.
+func (w *refresherWorker) loop() error {
+	ctx := w.catacomb.Context(context.Background())
+
+	timer := w.config.Clock.NewTimer(jitter(w.config.Interval))
+	defer timer.Stop()
+	for {
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+		case <-timer.Chan():
+			if err := w.process(ctx); err != nil {
+				return errors.Capture(err)
+			}
+			timer.Reset(jitter(w.config.Interval))
+		}
+	}
+}
+
+// process acts on each refresh candidate according to its policy. Failing to
+// get the candidates is fatal to the worker, but failing to act on a single
+// application isn't.
+//
+// An automatic refresh has no signature to verify, so while the controller
+// requires charm signatures, applications with the auto policy are only
+// notified of the available revision.
+func (w *refresherWorker) process(ctx context.Context) error {
+	candidates, err := w.config.ApplicationService.GetApplicationRefreshCandidates(ctx)
+	if err != nil {
+		return errors.Errorf("getting refresh candidates: %w", err)
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	cfg, err := w.config.ControllerConfigService.ControllerConfig(ctx)
+	if err != nil {
+		return errors.Errorf("getting controller config: %w", err)
+	}
+	signatureRequired := cfg.CharmSignaturePolicy() == controller.CharmSignaturePolicyRequired
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	now := w.config.Clock.Now()
+	w.lastRun = now
+	for _, candidate := range candidates {
+		switch candidate.RefreshPolicy.Policy {
+		case application.RefreshPolicyNotify:
+			w.notify(ctx, candidate)
+		case application.RefreshPolicyAuto:
+			if signatureRequired {
+				w.config.Logger.Debugf(ctx, "not refreshing %q while charm signatures are required",
+					candidate.ApplicationName)
+				w.notify(ctx, candidate)
+				continue
+			}
+			window := candidate.RefreshPolicy.Window
+			if window != nil && !window.Contains(now) {
+				w.config.Logger.Debugf(ctx, "not refreshing %q outside of its maintenance window %s",
+					candidate.ApplicationName, window)
+				continue
+			}
+			w.refresh(ctx, candidate)
+		}
+	}
+	return nil
+}
+
+// notify records that a newer revision is available for the candidate's
+// application, unless it has already been notified of that revision.
+func (w *refresherWorker) notify(ctx context.Context, candidate application.RefreshCandidate) {
+	if candidate.Notified {
+		return
+	}
+	if err := w.config.ApplicationService.NotifyCharmRevisionAvailable(ctx, candidate); err != nil {
+		w.config.Logger.Warningf(ctx, "notifying of charm revision %d for %q: %v",
+			candidate.Latest.Revision, candidate.ApplicationName, err)
+	}
+}
+
+// refresh refreshes the candidate's application, unless refreshing it to
+// that revision has already failed.
+func (w *refresherWorker) refresh(ctx context.Context, candidate application.RefreshCandidate) {
+	appName := candidate.ApplicationName
+	if candidate.RefreshFailed {
+		return
+	}
+	if err := w.download(ctx, candidate.Latest); err != nil {
+		// Downloading may fail for reasons unrelated to the revision, so it
+		// is retried on the next run.
+		w.config.Logger.Warningf(ctx, "downloading charm revision %d for %q: %v",
+			candidate.Latest.Revision, appName, err)
+		return
+	}
+	if err := w.config.ApplicationService.RefreshApplicationCharm(ctx, candidate); err != nil {
+		w.config.Logger.Errorf(ctx, "refreshing %q to charm revision %d: %v",
+			appName, candidate.Latest.Revision, err)
+		w.refreshFail++
+		return
+	}
+	w.refreshed++
+}
+
+// download downloads the archive of the charm revision, unless it is already
+// available, so that the application is only refreshed to a charm its units
+// can fetch.
+func (w *refresherWorker) download(ctx context.Context, locator charm.CharmLocator) error {
+	service := w.config.ApplicationService
+	info, err := service.GetCharmRevisionDownloadInfo(ctx, locator)
+	if errors.Is(err, applicationerrors.CharmAlreadyAvailable) {
+		return nil
+	} else if err != nil {
+		return errors.Capture(err)
+	}
+
+	url, err := url.Parse(info.DownloadInfo.DownloadURL)
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	httpClient, err := w.config.NewHTTPClient(ctx, w.config.HTTPClientGetter)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	result, err := w.config.NewDownloader(httpClient, w.config.Logger).Download(ctx, url, info.SHA256)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	defer func() {
+		if err := os.Remove(result.Path); err != nil && !os.IsNotExist(err) {
+			w.config.Logger.Warningf(ctx, "failed to remove temporary file %q: %v", result.Path, err)
+		}
+	}()
+
+	err = service.ResolveCharmRevisionDownload(ctx, locator, application.ResolveCharmDownload{
+		CharmUUID: info.CharmUUID,
+		SHA256:    result.SHA256,
+		SHA384:    result.SHA384,
+		Path:      result.Path,
+		Size:      result.Size,
+	})
+	if err != nil && !errors.Is(err, applicationerrors.CharmAlreadyResolved) {
+		return errors.Capture(err)
+	}
+	return nil
+}
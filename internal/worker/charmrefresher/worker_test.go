@@ -0,0 +1,377 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package charmrefresher
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+	"github.com/juju/worker/v4/workertest"
+	"go.uber.org/mock/gomock"
+
+	"github.com/juju/juju/controller"
+	charmtesting "github.com/juju/juju/core/charm/testing"
+	corehttp "github.com/juju/juju/core/http"
+	"github.com/juju/juju/core/logger"
+	coretesting "github.com/juju/juju/core/testing"
+	"github.com/juju/juju/domain/application"
+	"github.com/juju/juju/domain/application/charm"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/internal/charm/charmdownloader"
+	"github.com/juju/juju/internal/charmhub"
+	"github.com/juju/juju/internal/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+func TestConfigSuite(t *testing.T) { tc.Run(t, &configSuite{}) }
+func TestWorkerSuite(t *testing.T) { tc.Run(t, &workerSuite{}) }
+
+type configSuite struct{}
+
+func (s *configSuite) TestConfigValidation(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	origCfg := Config{
+		ApplicationService:      NewMockApplicationService(ctrl),
+		ControllerConfigService: NewMockControllerConfigService(ctrl),
+		HTTPClientGetter:        httpClientGetter{},
+		NewHTTPClient:           NewHTTPClient,
+		NewDownloader:           NewDownloader,
+		Clock:                   testclock.NewClock(time.Now()),
+		Logger:                  loggertesting.WrapCheckLog(c),
+		Interval:                time.Second,
+	}
+	c.Check(origCfg.Validate(), tc.ErrorIsNil)
+
+	testCfg := origCfg
+	testCfg.ApplicationService = nil
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "nil ApplicationService.*")
+
+	testCfg = origCfg
+	testCfg.ControllerConfigService = nil
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "nil ControllerConfigService.*")
+
+	testCfg = origCfg
+	testCfg.HTTPClientGetter = nil
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "nil HTTPClientGetter.*")
+
+	testCfg = origCfg
+	testCfg.NewHTTPClient = nil
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "nil NewHTTPClient.*")
+
+	testCfg = origCfg
+	testCfg.NewDownloader = nil
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "nil NewDownloader.*")
+
+	testCfg = origCfg
+	testCfg.Clock = nil
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "nil clock.Clock.*")
+
+	testCfg = origCfg
+	testCfg.Logger = nil
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "nil Logger.*")
+
+	testCfg = origCfg
+	testCfg.Interval = 0
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "interval must be positive.*")
+}
+
+type workerSuite struct {
+	clock                   *testclock.Clock
+	applicationService      *MockApplicationService
+	controllerConfigService *MockControllerConfigService
+	downloader              *MockDownloader
+
+	lastCandidatesCall *gomock.Call
+
+	signaturePolicy string
+}
+
+// TestNotifyOnce verifies that an available revision is notified only once.
+func (s *workerSuite) TestNotifyOnce(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	candidate := s.candidate(application.RefreshPolicyNotify, nil)
+	s.expectCandidates(1, candidate)
+	s.applicationService.EXPECT().NotifyCharmRevisionAvailable(gomock.Any(), candidate).Return(nil)
+	notified := candidate
+	notified.Notified = true
+	s.expectCandidates(1, notified)
+
+	w := s.startWorker(c)
+	defer workertest.CleanKill(c, w)
+
+	s.advance(c)
+	s.advance(c)
+}
+
+// TestNotifyAlreadyNotified verifies that a revision the application has
+// already been notified of, such as by a previous run of the worker, is not
+// notified again.
+func (s *workerSuite) TestNotifyAlreadyNotified(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	candidate := s.candidate(application.RefreshPolicyNotify, nil)
+	candidate.Notified = true
+	s.expectCandidates(1, candidate)
+
+	w := s.startWorker(c)
+	defer workertest.CleanKill(c, w)
+
+	s.advance(c)
+}
+
+// TestAutoRefresh verifies that applications with the auto policy and no
+// maintenance window are refreshed.
+func (s *workerSuite) TestAutoRefresh(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	candidate := s.candidate(application.RefreshPolicyAuto, nil)
+	s.expectCandidates(1, candidate)
+	s.expectAvailable(candidate)
+	s.applicationService.EXPECT().RefreshApplicationCharm(gomock.Any(), candidate).Return(nil)
+
+	w := s.startWorker(c)
+	defer workertest.CleanKill(c, w)
+
+	s.advance(c)
+}
+
+// TestAutoRefreshDownloads verifies that a revision which hasn't been
+// downloaded yet is downloaded before the application is refreshed.
+func (s *workerSuite) TestAutoRefreshDownloads(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	candidate := s.candidate(application.RefreshPolicyAuto, nil)
+	s.expectCandidates(1, candidate)
+
+	charmUUID := charmtesting.GenCharmID(c)
+	s.applicationService.EXPECT().GetCharmRevisionDownloadInfo(gomock.Any(), candidate.Latest).Return(application.CharmDownloadInfo{
+		CharmUUID: charmUUID,
+		Name:      "foo",
+		SHA256:    "sha256",
+		DownloadInfo: charm.DownloadInfo{
+			DownloadURL: "https://example.com/foo",
+		},
+	}, nil)
+	path := filepath.Join(c.MkDir(), "foo.charm")
+	err := os.WriteFile(path, []byte("charm"), 0644)
+	c.Assert(err, tc.ErrorIsNil)
+	s.downloader.EXPECT().Download(gomock.Any(), gomock.Any(), "sha256").DoAndReturn(
+		func(_ context.Context, curl *url.URL, _ string) (*charmdownloader.DownloadResult, error) {
+			c.Check(curl.String(), tc.Equals, "https://example.com/foo")
+			return &charmdownloader.DownloadResult{
+				SHA256: "sha256",
+				SHA384: "sha384",
+				Path:   path,
+				Size:   5,
+			}, nil
+		})
+	s.applicationService.EXPECT().ResolveCharmRevisionDownload(gomock.Any(), candidate.Latest, application.ResolveCharmDownload{
+		CharmUUID: charmUUID,
+		SHA256:    "sha256",
+		SHA384:    "sha384",
+		Path:      path,
+		Size:      5,
+	}).Return(nil)
+	s.applicationService.EXPECT().RefreshApplicationCharm(gomock.Any(), candidate).Return(nil)
+
+	w := s.startWorker(c)
+	defer workertest.CleanKill(c, w)
+
+	s.advance(c)
+
+	_, err = os.Stat(path)
+	c.Check(os.IsNotExist(err), tc.IsTrue)
+}
+
+// TestAutoRefreshDownloadFailureRetried verifies that the application isn't
+// refreshed to a revision which failed to download, and that the download is
+// retried on the next run.
+func (s *workerSuite) TestAutoRefreshDownloadFailureRetried(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	candidate := s.candidate(application.RefreshPolicyAuto, nil)
+	s.expectCandidates(2, candidate)
+	s.applicationService.EXPECT().GetCharmRevisionDownloadInfo(gomock.Any(), candidate.Latest).Return(application.CharmDownloadInfo{
+		SHA256: "sha256",
+		DownloadInfo: charm.DownloadInfo{
+			DownloadURL: "https://example.com/foo",
+		},
+	}, nil).Times(2)
+	s.downloader.EXPECT().Download(gomock.Any(), gomock.Any(), "sha256").Return(nil, errors.New("boom")).Times(2)
+
+	w := s.startWorker(c)
+	defer workertest.CleanKill(c, w)
+
+	s.advance(c)
+	s.advance(c)
+}
+
+// TestAutoRefreshOutsideWindow verifies that applications are not refreshed
+// outside of their maintenance window.
+func (s *workerSuite) TestAutoRefreshOutsideWindow(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// The clock starts at 12:00 UTC.
+	candidate := s.candidate(application.RefreshPolicyAuto, &application.MaintenanceWindow{
+		Start: 2 * time.Hour,
+		End:   4 * time.Hour,
+	})
+	s.expectCandidates(1, candidate)
+
+	w := s.startWorker(c)
+	defer workertest.CleanKill(c, w)
+
+	s.advance(c)
+}
+
+// TestAutoRefreshFailureNotRetried verifies that a failed refresh is not
+// retried for the same revision, and doesn't kill the worker.
+func (s *workerSuite) TestAutoRefreshFailureNotRetried(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	candidate := s.candidate(application.RefreshPolicyAuto, nil)
+	s.expectCandidates(1, candidate)
+	s.expectAvailable(candidate)
+	s.applicationService.EXPECT().RefreshApplicationCharm(gomock.Any(), candidate).Return(errors.New("boom"))
+	failed := candidate
+	failed.RefreshFailed = true
+	s.expectCandidates(1, failed)
+
+	w := s.startWorker(c)
+	defer workertest.CleanKill(c, w)
+
+	s.advance(c)
+	s.advance(c)
+}
+
+// TestAutoRefreshSignatureRequired verifies that applications with the auto
+// policy are only notified while the controller requires charm signatures.
+func (s *workerSuite) TestAutoRefreshSignatureRequired(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.signaturePolicy = controller.CharmSignaturePolicyRequired
+	candidate := s.candidate(application.RefreshPolicyAuto, nil)
+	s.expectCandidates(1, candidate)
+	s.applicationService.EXPECT().NotifyCharmRevisionAvailable(gomock.Any(), candidate).Return(nil)
+	notified := candidate
+	notified.Notified = true
+	s.expectCandidates(1, notified)
+
+	w := s.startWorker(c)
+	defer workertest.CleanKill(c, w)
+
+	s.advance(c)
+	s.advance(c)
+}
+
+// TestGetCandidatesError verifies that failing to get the candidates kills
+// the worker.
+func (s *workerSuite) TestGetCandidatesError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.applicationService.EXPECT().GetApplicationRefreshCandidates(gomock.Any()).Return(nil, errors.New("boom"))
+
+	w := s.startWorker(c)
+	err := s.clock.WaitAdvance(2*time.Second, coretesting.ShortWait, 1)
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = workertest.CheckKilled(c, w)
+	c.Assert(err, tc.ErrorMatches, "getting refresh candidates: boom")
+}
+
+func (s *workerSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.clock = testclock.NewClock(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	s.applicationService = NewMockApplicationService(ctrl)
+	s.controllerConfigService = NewMockControllerConfigService(ctrl)
+	s.downloader = NewMockDownloader(ctrl)
+	s.lastCandidatesCall = nil
+	s.signaturePolicy = controller.CharmSignaturePolicyNone
+	s.controllerConfigService.EXPECT().ControllerConfig(gomock.Any()).DoAndReturn(
+		func(context.Context) (controller.Config, error) {
+			return controller.Config{
+				controller.CharmSignaturePolicy: s.signaturePolicy,
+			}, nil
+		},
+	).AnyTimes()
+	return ctrl
+}
+
+func (s *workerSuite) startWorker(c *tc.C) *refresherWorker {
+	w, err := NewWorker(Config{
+		ApplicationService:      s.applicationService,
+		ControllerConfigService: s.controllerConfigService,
+		HTTPClientGetter:        httpClientGetter{},
+		NewHTTPClient: func(context.Context, corehttp.HTTPClientGetter) (corehttp.HTTPClient, error) {
+			return nil, nil
+		},
+		NewDownloader: func(charmhub.HTTPClient, logger.Logger) Downloader {
+			return s.downloader
+		},
+		Clock:    s.clock,
+		Logger:   loggertesting.WrapCheckLog(c),
+		Interval: time.Second,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	return w.(*refresherWorker)
+}
+
+// expectCandidates expects the given number of calls to get the refresh
+// candidates, after any calls already expected.
+func (s *workerSuite) expectCandidates(times int, candidates ...application.RefreshCandidate) {
+	call := s.applicationService.EXPECT().GetApplicationRefreshCandidates(gomock.Any()).Return(candidates, nil).Times(times)
+	if s.lastCandidatesCall != nil {
+		call.After(s.lastCandidatesCall)
+	}
+	s.lastCandidatesCall = call
+}
+
+// expectAvailable expects the candidate's latest revision to have already
+// been downloaded.
+func (s *workerSuite) expectAvailable(candidate application.RefreshCandidate) {
+	s.applicationService.EXPECT().GetCharmRevisionDownloadInfo(gomock.Any(), candidate.Latest).
+		Return(application.CharmDownloadInfo{}, applicationerrors.CharmAlreadyAvailable)
+}
+
+// advance fires the worker's timer and waits for the timer to be reset,
+// which happens once the run has completed.
+func (s *workerSuite) advance(c *tc.C) {
+	err := s.clock.WaitAdvance(2*time.Second, coretesting.ShortWait, 1)
+	c.Assert(err, tc.ErrorIsNil)
+	err = s.clock.WaitAdvance(0, coretesting.ShortWait, 1)
+	c.Assert(err, tc.ErrorIsNil)
+}
+
+func (s *workerSuite) candidate(policy application.RefreshPolicy, window *application.MaintenanceWindow) application.RefreshCandidate {
+	return application.RefreshCandidate{
+		ApplicationName: "foo",
+		RefreshPolicy: application.ApplicationRefreshPolicy{
+			Policy: policy,
+			Window: window,
+		},
+		Current: charm.CharmLocator{
+			Name:     "foo",
+			Revision: 42,
+			Source:   charm.CharmHubSource,
+		},
+		Latest: charm.CharmLocator{
+			Name:     "foo",
+			Revision: 43,
+			Source:   charm.CharmHubSource,
+		},
+	}
+}
+
+type httpClientGetter struct {
+	corehttp.HTTPClientGetter
+}
@@ -442,6 +442,11 @@ func (w *revisionUpdateWorker) fetchInfo(ctx context.Context, client CharmhubCli
 			revision:          result.revision,
 			resources:         result.resources,
 			appName:           apps[i].name,
+			channel: applicationcharm.Channel{
+				Track:  origin.Channel.Track,
+				Risk:   applicationcharm.ChannelRisk(origin.Channel.Risk),
+				Branch: origin.Channel.Branch,
+			},
 		})
 	}
 
@@ -534,6 +539,9 @@ func (w *revisionUpdateWorker) storeNewCharmRevision(ctx context.Context, info l
 		},
 		Hash:         origin.Hash,
 		Architecture: origin.Platform.Architecture,
+		// This is the channel of the application the revision was found
+		// for.
+		Channel: &info.channel,
 	})
 	if err != nil {
 		return internalerrors.Capture(err)
@@ -808,6 +816,7 @@ type latestCharmInfo struct {
 	revision          int
 	resources         []resource.Resource
 	appName           string
+	channel           applicationcharm.Channel
 }
 
 // charmhubResult is the type charmhubLatestCharmInfo returns: information
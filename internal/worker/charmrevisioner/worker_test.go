@@ -288,6 +288,9 @@ func (s *WorkerSuite) TestFetch(c *tc.C) {
 		timestamp:    s.now,
 		revision:     666,
 		appName:      "foo",
+		channel: applicationcharm.Channel{
+			Risk: applicationcharm.RiskStable,
+		},
 	}})
 }
 
@@ -763,6 +766,10 @@ func (s *WorkerSuite) TestStoreNewCharmRevisionsNoResource(c *tc.C) {
 		timestamp: s.now,
 		revision:  43,
 		appName:   "foo",
+		channel: applicationcharm.Channel{
+			Track: "latest",
+			Risk:  applicationcharm.RiskStable,
+		},
 	}}
 	essentialMetadata := latestCharmInfos[0].essentialMetadata
 
@@ -785,6 +792,10 @@ func (s *WorkerSuite) TestStoreNewCharmRevisionsNoResource(c *tc.C) {
 			DownloadURL:        "https://example.com/foo",
 			DownloadSize:       123,
 		},
+		Channel: &applicationcharm.Channel{
+			Track: "latest",
+			Risk:  applicationcharm.RiskStable,
+		},
 	}).Return(charm.ID("foo"), nil, nil)
 
 	w := s.newWorker(c)
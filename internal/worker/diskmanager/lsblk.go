@@ -29,6 +29,7 @@ const (
 
 	typeDisk = "disk"
 	typeLoop = "loop"
+	typeLVM  = "lvm"
 	typePart = "part"
 )
 
@@ -101,11 +102,13 @@ func listBlockDevices(ctx context.Context) ([]blockdevice.BlockDevice, error) {
 		// TODO(storage): store the type of the block device and the parent
 		// device if once exists to allow for reliable matching.
 
-		// We may later want to expand this, e.g. to handle lvm,
-		// dmraid, crypt, etc., but this is enough to cover bases
-		// for now.
+		// We may later want to expand this, e.g. to handle dmraid,
+		// crypt, etc., but this is enough to cover bases for now.
+		// Logical volumes are reported so that volumes created by
+		// the lvm storage provider can be matched to attachments.
 		switch deviceType {
 		case typeLoop:
+		case typeLVM:
 		case typePart:
 		case typeDisk:
 			// Floppy disks, which have major device number 2,
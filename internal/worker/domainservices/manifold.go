@@ -21,6 +21,7 @@ import (
 	"github.com/juju/juju/core/providertracker"
 	"github.com/juju/juju/core/storage"
 	domainservices "github.com/juju/juju/domain/services"
+	"github.com/juju/juju/internal/secrets/encryptionkey"
 	"github.com/juju/juju/internal/services"
 	sshimporter "github.com/juju/juju/internal/ssh/importer"
 	"github.com/juju/juju/internal/worker/common"
@@ -37,6 +38,7 @@ type ManifoldConfig struct {
 	LeaseManagerName            string
 	LogSinkName                 string
 	LogDir                      string
+	DataDir                     string
 	Logger                      logger.Logger
 	Clock                       clock.Clock
 	NewWorker                   func(Config) (worker.Worker, error)
@@ -54,6 +56,7 @@ type DomainServicesGetterFn func(
 	objectstore.ObjectStoreGetter,
 	storage.StorageRegistryGetter,
 	domainservices.PublicKeyImporter,
+	domainservices.EncryptionKeySource,
 	lease.Manager,
 	string,
 	clock.Clock,
@@ -78,6 +81,7 @@ type ModelDomainServicesFn func(
 	objectstore.ModelObjectStoreGetter,
 	storage.ModelStorageRegistryGetter,
 	domainservices.PublicKeyImporter,
+	domainservices.EncryptionKeySource,
 	lease.ModelLeaseManagerGetter,
 	string,
 	clock.Clock,
@@ -122,6 +126,9 @@ func (config ManifoldConfig) Validate() error {
 	if config.LogDir == "" {
 		return errors.NotValidf("empty LogDir")
 	}
+	if config.DataDir == "" {
+		return errors.NotValidf("empty DataDir")
+	}
 	if config.Logger == nil {
 		return errors.NotValidf("nil Logger")
 	}
@@ -201,6 +208,7 @@ func (config ManifoldConfig) start(ctx context.Context, getter dependency.Getter
 		ObjectStoreGetter:           objectStoreGetter,
 		StorageRegistryGetter:       storageRegistryGetter,
 		PublicKeyImporter:           sshimporter.NewImporter(sshImporterClient),
+		EncryptionKeySource:         encryptionkey.NewSource(config.DataDir),
 		LeaseManager:                leaseManager,
 		LoggerContextGetter:         loggerContextGetter,
 		LogDir:                      config.LogDir,
@@ -260,6 +268,7 @@ func NewProviderTrackerModelDomainServices(
 	modelObjectStoreGetter objectstore.ModelObjectStoreGetter,
 	storageRegistry storage.ModelStorageRegistryGetter,
 	publicKeyImporter domainservices.PublicKeyImporter,
+	encryptionKeySource domainservices.EncryptionKeySource,
 	leaseManager lease.ModelLeaseManagerGetter,
 	logDir string,
 	clock clock.Clock,
@@ -273,6 +282,7 @@ func NewProviderTrackerModelDomainServices(
 		modelObjectStoreGetter,
 		storageRegistry,
 		publicKeyImporter,
+		encryptionKeySource,
 		leaseManager,
 		logDir,
 		clock,
@@ -289,6 +299,7 @@ func NewDomainServicesGetter(
 	objectStoreGetter objectstore.ObjectStoreGetter,
 	storageRegistryGetter storage.StorageRegistryGetter,
 	publicKeyImporter domainservices.PublicKeyImporter,
+	encryptionKeySource domainservices.EncryptionKeySource,
 	leaseManager lease.Manager,
 	logDir string,
 	clock clock.Clock,
@@ -302,6 +313,7 @@ func NewDomainServicesGetter(
 		objectStoreGetter:      objectStoreGetter,
 		storageRegistryGetter:  storageRegistryGetter,
 		publicKeyImporter:      publicKeyImporter,
+		encryptionKeySource:    encryptionKeySource,
 		leaseManager:           leaseManager,
 		logDir:                 logDir,
 		clock:                  clock,
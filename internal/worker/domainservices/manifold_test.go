@@ -92,6 +92,10 @@ func (s *manifoldSuite) TestValidateConfig(c *tc.C) {
 	cfg.LogDir = ""
 	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
 
+	cfg = s.getConfig(c)
+	cfg.DataDir = ""
+	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
+
 	cfg = s.getConfig(c)
 	cfg.Clock = nil
 	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
@@ -126,6 +130,7 @@ func (s *manifoldSuite) TestStart(c *tc.C) {
 		NewControllerDomainServices: NewControllerDomainServices,
 		NewModelDomainServices:      NewProviderTrackerModelDomainServices,
 		LogDir:                      c.MkDir(),
+		DataDir:                     c.MkDir(),
 		Clock:                       s.clock,
 	})
 	w, err := manifold.Start(c.Context(), dt.StubGetter(getter))
@@ -146,6 +151,7 @@ func (s *manifoldSuite) TestOutputControllerDomainServices(c *tc.C) {
 		ObjectStoreGetter:           s.objectStoreGetter,
 		StorageRegistryGetter:       s.storageRegistryGetter,
 		PublicKeyImporter:           s.publicKeyImporter,
+		EncryptionKeySource:         s.encryptionKeySource,
 		LeaseManager:                s.leaseManager,
 		NewDomainServicesGetter:     NewDomainServicesGetter,
 		NewControllerDomainServices: NewControllerDomainServices,
@@ -174,6 +180,7 @@ func (s *manifoldSuite) TestOutputDomainServicesGetter(c *tc.C) {
 		ObjectStoreGetter:           s.objectStoreGetter,
 		StorageRegistryGetter:       s.storageRegistryGetter,
 		PublicKeyImporter:           s.publicKeyImporter,
+		EncryptionKeySource:         s.encryptionKeySource,
 		LeaseManager:                s.leaseManager,
 		NewDomainServicesGetter:     NewDomainServicesGetter,
 		NewControllerDomainServices: NewControllerDomainServices,
@@ -202,6 +209,7 @@ func (s *manifoldSuite) TestOutputInvalid(c *tc.C) {
 		ObjectStoreGetter:           s.objectStoreGetter,
 		StorageRegistryGetter:       s.storageRegistryGetter,
 		PublicKeyImporter:           s.publicKeyImporter,
+		EncryptionKeySource:         s.encryptionKeySource,
 		LeaseManager:                s.leaseManager,
 		NewDomainServicesGetter:     NewDomainServicesGetter,
 		NewControllerDomainServices: NewControllerDomainServices,
@@ -231,6 +239,7 @@ func (s *manifoldSuite) TestNewModelDomainServices(c *tc.C) {
 		s.modelObjectStoreGetter,
 		s.modelStorageRegistryGetter,
 		s.publicKeyImporter,
+		s.encryptionKeySource,
 		s.modelLeaseManagerGetter,
 		c.MkDir(),
 		s.clock,
@@ -254,6 +263,7 @@ func (s *manifoldSuite) TestNewDomainServicesGetter(c *tc.C) {
 		s.objectStoreGetter,
 		s.storageRegistryGetter,
 		s.publicKeyImporter,
+		s.encryptionKeySource,
 		s.leaseManager,
 		c.MkDir(),
 		s.clock,
@@ -276,6 +286,7 @@ func (s *manifoldSuite) getConfig(c *tc.C) ManifoldConfig {
 		LeaseManagerName:    "leasemanager",
 		LogSinkName:         "logsink",
 		LogDir:              c.MkDir(),
+		DataDir:             c.MkDir(),
 		Clock:               s.clock,
 		Logger:              s.logger,
 		NewWorker: func(Config) (worker.Worker, error) {
@@ -295,6 +306,7 @@ func noopDomainServicesGetter(
 	objectstore.ObjectStoreGetter,
 	storage.StorageRegistryGetter,
 	domainservices.PublicKeyImporter,
+	domainservices.EncryptionKeySource,
 	lease.Manager,
 	string,
 	clock.Clock,
@@ -320,6 +332,7 @@ func noopModelDomainServices(
 	objectstore.ModelObjectStoreGetter,
 	storage.ModelStorageRegistryGetter,
 	domainservices.PublicKeyImporter,
+	domainservices.EncryptionKeySource,
 	lease.ModelLeaseManagerGetter,
 	string,
 	clock.Clock,
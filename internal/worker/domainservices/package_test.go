@@ -20,6 +20,7 @@ import (
 	domaintesting "github.com/juju/juju/domain/schema/testing"
 	domainservices "github.com/juju/juju/domain/services"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/secrets/encryptionkey"
 	"github.com/juju/juju/internal/services"
 	sshimporter "github.com/juju/juju/internal/ssh/importer"
 )
@@ -65,7 +66,8 @@ type baseSuite struct {
 	leaseManagerGetter      *MockLeaseManagerGetter
 	modelLeaseManagerGetter *MockModelLeaseManagerGetter
 
-	publicKeyImporter *sshimporter.Importer
+	publicKeyImporter   *sshimporter.Importer
+	encryptionKeySource *encryptionkey.Source
 }
 
 func (s *baseSuite) setupMocks(c *tc.C) *gomock.Controller {
@@ -101,6 +103,7 @@ func (s *baseSuite) setupMocks(c *tc.C) *gomock.Controller {
 	s.modelLeaseManagerGetter = NewMockModelLeaseManagerGetter(ctrl)
 
 	s.publicKeyImporter = sshimporter.NewImporter(&http.Client{})
+	s.encryptionKeySource = encryptionkey.NewSource(c.MkDir())
 
 	c.Cleanup(func() {
 		s.logger = nil
@@ -133,6 +136,7 @@ func (s *baseSuite) setupMocks(c *tc.C) *gomock.Controller {
 		s.modelLeaseManagerGetter = nil
 
 		s.publicKeyImporter = nil
+		s.encryptionKeySource = nil
 	})
 
 	return ctrl
@@ -147,6 +151,7 @@ func NewModelDomainServices(
 	modelObjectStoreGetter objectstore.ModelObjectStoreGetter,
 	storageRegistry storage.ModelStorageRegistryGetter,
 	publicKeyImporter domainservices.PublicKeyImporter,
+	encryptionKeySource domainservices.EncryptionKeySource,
 	leaseManager lease.ModelLeaseManagerGetter,
 	logDir string,
 	clock clock.Clock,
@@ -160,6 +165,7 @@ func NewModelDomainServices(
 		modelObjectStoreGetter,
 		storageRegistry,
 		publicKeyImporter,
+		encryptionKeySource,
 		leaseManager,
 		logDir,
 		clock,
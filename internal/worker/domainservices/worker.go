@@ -42,6 +42,9 @@ type Config struct {
 	// PublicKeyImporter is used to import public keys.
 	PublicKeyImporter domainservices.PublicKeyImporter
 
+	// EncryptionKeySource is used to get the secret key-encryption keys.
+	EncryptionKeySource domainservices.EncryptionKeySource
+
 	// LeaseManager is used to manage leases.
 	LeaseManager lease.Manager
 
@@ -84,6 +87,9 @@ func (config Config) Validate() error {
 	if config.PublicKeyImporter == nil {
 		return errors.NotValidf("nil PublicKeyImporter")
 	}
+	if config.EncryptionKeySource == nil {
+		return errors.NotValidf("nil EncryptionKeySource")
+	}
 	if config.LeaseManager == nil {
 		return errors.NotValidf("nil LeaseManager")
 	}
@@ -137,6 +143,7 @@ func NewWorker(config Config) (worker.Worker, error) {
 			config.ObjectStoreGetter,
 			config.StorageRegistryGetter,
 			config.PublicKeyImporter,
+			config.EncryptionKeySource,
 			config.LeaseManager,
 			config.LogDir,
 			config.Clock,
@@ -202,6 +209,7 @@ type domainServicesGetter struct {
 	objectStoreGetter      objectstore.ObjectStoreGetter
 	storageRegistryGetter  storage.StorageRegistryGetter
 	publicKeyImporter      domainservices.PublicKeyImporter
+	encryptionKeySource    domainservices.EncryptionKeySource
 	leaseManager           lease.Manager
 	logDir                 string
 	clock                  clock.Clock
@@ -230,6 +238,7 @@ func (s *domainServicesGetter) ServicesForModel(ctx context.Context, modelUUID c
 				storageRegistryGetter: s.storageRegistryGetter,
 			},
 			s.publicKeyImporter,
+			s.encryptionKeySource,
 			modelApplicationLeaseManager{
 				modelUUID: modelUUID,
 				manager:   s.leaseManager,
@@ -85,6 +85,10 @@ func (s *workerSuite) TestValidateConfig(c *tc.C) {
 	cfg.PublicKeyImporter = nil
 	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
 
+	cfg = s.getConfig(c)
+	cfg.EncryptionKeySource = nil
+	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
+
 	cfg = s.getConfig(c)
 	cfg.LoggerContextGetter = nil
 	c.Check(cfg.Validate(), tc.ErrorIs, errors.NotValid)
@@ -97,6 +101,7 @@ func (s *workerSuite) getConfig(c *tc.C) Config {
 		ObjectStoreGetter:     s.objectStoreGetter,
 		StorageRegistryGetter: s.storageRegistryGetter,
 		PublicKeyImporter:     s.publicKeyImporter,
+		EncryptionKeySource:   s.encryptionKeySource,
 		LeaseManager:          s.leaseManager,
 		LogDir:                c.MkDir(),
 		Clock:                 s.clock,
@@ -110,6 +115,7 @@ func (s *workerSuite) getConfig(c *tc.C) Config {
 			objectstore.ObjectStoreGetter,
 			storage.StorageRegistryGetter,
 			domainservices.PublicKeyImporter,
+			domainservices.EncryptionKeySource,
 			lease.Manager,
 			string,
 			clock.Clock,
@@ -133,6 +139,7 @@ func (s *workerSuite) getConfig(c *tc.C) Config {
 			objectstore.ModelObjectStoreGetter,
 			storage.ModelStorageRegistryGetter,
 			domainservices.PublicKeyImporter,
+			domainservices.EncryptionKeySource,
 			lease.ModelLeaseManagerGetter,
 			string,
 			clock.Clock,
@@ -17,6 +17,7 @@ import (
 	"github.com/juju/juju/core/logger"
 	"github.com/juju/juju/core/watcher"
 	internalerrors "github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/secrets/encryptionkey"
 )
 
 // MachineClient represents necessary methods for this worker from the
@@ -127,6 +128,16 @@ func (c *converter) Handle(ctx context.Context) error {
 		return internalerrors.Errorf("getting state serving info: %w", err)
 	}
 
+	// The secret encryption root key is kept in its own key file rather
+	// than in the agent configuration.
+	if info.SecretEncryptionKey != "" {
+		dataDir := c.agent.CurrentConfig().DataDir()
+		if err := encryptionkey.WriteRootKey(dataDir, info.SecretEncryptionKey); err != nil {
+			return internalerrors.Errorf("writing secret encryption key: %w", err)
+		}
+		info.SecretEncryptionKey = ""
+	}
+
 	err = c.agent.ChangeConfig(func(config jujuagent.ConfigSetter) error {
 		_, hasInfo := config.ControllerAgentInfo()
 		if hasInfo {
@@ -16,6 +16,7 @@ import (
 	controller "github.com/juju/juju/controller"
 	"github.com/juju/juju/core/watcher"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/secrets/encryptionkey"
 )
 
 func TestConverterSuite(t *testing.T) {
@@ -96,6 +97,43 @@ func (s *converterSuite) TestHandle(c *tc.C) {
 	c.Assert(err, tc.ErrorIs, agenterrors.FatalError)
 }
 
+func (s *converterSuite) TestHandleWritesSecretEncryptionKey(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	dataDir := c.MkDir()
+	rootKey, err := encryptionkey.NewRootKey()
+	c.Assert(err, tc.ErrorIsNil)
+
+	s.machineClient.EXPECT().Machine(gomock.Any(), gomock.Any()).Return(s.machine, nil)
+	s.machine.EXPECT().Watch(gomock.Any()).Return(nil, nil)
+	s.machine.EXPECT().IsController(gomock.Any(), gomock.Any()).Return(true, nil)
+	s.agentConfig.EXPECT().DataDir().Return(dataDir)
+
+	s.agentClient.EXPECT().StateServingInfo(gomock.Any()).Return(controller.ControllerAgentInfo{
+		APIPort:             1234,
+		SecretEncryptionKey: rootKey,
+	}, nil)
+	s.agentConfigSetter.EXPECT().ControllerAgentInfo().Return(controller.ControllerAgentInfo{}, false)
+	// The key is not written to the agent config.
+	s.agentConfigSetter.EXPECT().SetControllerAgentInfo(controller.ControllerAgentInfo{
+		APIPort: 1234,
+	})
+
+	s.agent.EXPECT().ChangeConfig(gomock.Any()).DoAndReturn(func(f agent.ConfigMutator) error {
+		return f(s.agentConfigSetter)
+	})
+
+	conv := s.newConverter(c)
+	_, err = conv.SetUp(c.Context())
+	c.Assert(err, tc.IsNil)
+	err = conv.Handle(c.Context())
+	c.Assert(err, tc.ErrorIs, agenterrors.FatalError)
+
+	written, err := encryptionkey.ReadRootKey(dataDir)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(written, tc.Equals, rootKey)
+}
+
 func (s *converterSuite) TestHandleAlreadyHasInfo(c *tc.C) {
 	defer s.setupMocks(c).Finish()
 
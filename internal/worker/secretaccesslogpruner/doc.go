@@ -0,0 +1,19 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package secretaccesslogpruner provides a worker that periodically removes
+// old entries from the secret access log.
+//
+// # Overview
+//
+// Each read of secret content is recorded in the model's secret access log.
+// On a fixed interval, configured via the worker Config.Interval and
+// randomized between 0.5 and 1.5 times its value, the worker asks the secret
+// service to remove the entries older than the access log retention period.
+// Pruning is kept off the read path, so that reading secret content doesn't
+// pay for, or fail because of, it.
+//
+// # Integration
+//
+// The worker is intended to be run by the Juju controller, for each model.
+package secretaccesslogpruner
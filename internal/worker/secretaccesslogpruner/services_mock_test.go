@@ -0,0 +1,78 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/internal/worker/secretaccesslogpruner (interfaces: SecretService)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package secretaccesslogpruner -destination services_mock_test.go github.com/juju/juju/internal/worker/secretaccesslogpruner SecretService
+//
+
+// Package secretaccesslogpruner is a generated GoMock package.
+package secretaccesslogpruner
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSecretService is a mock of SecretService interface.
+type MockSecretService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecretServiceMockRecorder
+}
+
+// MockSecretServiceMockRecorder is the mock recorder for MockSecretService.
+type MockSecretServiceMockRecorder struct {
+	mock *MockSecretService
+}
+
+// NewMockSecretService creates a new mock instance.
+func NewMockSecretService(ctrl *gomock.Controller) *MockSecretService {
+	mock := &MockSecretService{ctrl: ctrl}
+	mock.recorder = &MockSecretServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSecretService) EXPECT() *MockSecretServiceMockRecorder {
+	return m.recorder
+}
+
+// PruneSecretAccessLog mocks base method.
+func (m *MockSecretService) PruneSecretAccessLog(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PruneSecretAccessLog", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PruneSecretAccessLog indicates an expected call of PruneSecretAccessLog.
+func (mr *MockSecretServiceMockRecorder) PruneSecretAccessLog(arg0 any) *MockSecretServicePruneSecretAccessLogCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneSecretAccessLog", reflect.TypeOf((*MockSecretService)(nil).PruneSecretAccessLog), arg0)
+	return &MockSecretServicePruneSecretAccessLogCall{Call: call}
+}
+
+// MockSecretServicePruneSecretAccessLogCall wrap *gomock.Call
+type MockSecretServicePruneSecretAccessLogCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretServicePruneSecretAccessLogCall) Return(arg0 error) *MockSecretServicePruneSecretAccessLogCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretServicePruneSecretAccessLogCall) Do(f func(context.Context) error) *MockSecretServicePruneSecretAccessLogCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretServicePruneSecretAccessLogCall) DoAndReturn(f func(context.Context) error) *MockSecretServicePruneSecretAccessLogCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
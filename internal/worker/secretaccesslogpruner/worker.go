@@ -0,0 +1,129 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secretaccesslogpruner
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/juju/clock"
+	"github.com/juju/retry"
+	"github.com/juju/worker/v4"
+	"github.com/juju/worker/v4/catacomb"
+
+	coreerrors "github.com/juju/juju/core/errors"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/internal/errors"
+)
+
+// SecretService provides access to the secret access log.
+type SecretService interface {
+	// PruneSecretAccessLog removes the entries of the secret access log
+	// recorded longer ago than the retention period.
+	PruneSecretAccessLog(ctx context.Context) error
+}
+
+// Config is the configuration for the secret access log pruner.
+type Config struct {
+	SecretService SecretService
+	Clock         clock.Clock
+	Logger        logger.Logger
+
+	// Interval is the interval at which the pruner will run.
+	Interval time.Duration
+}
+
+// Validate checks whether the worker configuration settings are valid.
+func (config Config) Validate() error {
+	if config.SecretService == nil {
+		return errors.Errorf("nil SecretService").Add(coreerrors.NotValid)
+	}
+	if config.Clock == nil {
+		return errors.Errorf("nil clock.Clock").Add(coreerrors.NotValid)
+	}
+	if config.Logger == nil {
+		return errors.Errorf("nil Logger").Add(coreerrors.NotValid)
+	}
+	if config.Interval <= 0 {
+		return errors.Errorf("interval must be positive").Add(coreerrors.NotValid)
+	}
+	return nil
+}
+
+// prunerWorker is a worker that prunes the secret access log.
+type prunerWorker struct {
+	config   Config
+	catacomb catacomb.Catacomb
+
+	// mu guards the fields below it.
+	mu sync.Mutex
+
+	lastPrune time.Time
+}
+
+// NewWorker returns a new secret access log pruner worker.
+func NewWorker(config Config) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Capture(err)
+	}
+	w := &prunerWorker{
+		config: config,
+	}
+	err := catacomb.Invoke(catacomb.Plan{
+		Name: "secret-access-log-pruner",
+		Site: &w.catacomb,
+		Work: w.loop,
+	})
+	return w, errors.Capture(err)
+}
+
+// Kill is part of the worker.Worker interface.
+func (w *prunerWorker) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (w *prunerWorker) Wait() error {
+	return w.catacomb.Wait()
+}
+
+// Report shows up in the dependency engine report.
+func (w *prunerWorker) Report() map[string]interface{} {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return map[string]interface{}{
+		"last-prune": w.lastPrune,
+	}
+}
+
+// jitter returns a random duration around the given period, between 0.5 and 1.5
+// times the period.
+func jitter(period time.Duration) time.Duration {
+	half := period / 2
+	return retry.ExpBackoff(half, period+half, 2, true)(0, 1)
+}
+
+// loop is the worker's main loop. On each tick of the interval it prunes the
+// secret access log.
+func (w *prunerWorker) loop() error {
+	ctx := w.catacomb.Context(context.Background())
+
+	timer := w.config.Clock.NewTimer(jitter(w.config.Interval))
+	defer timer.Stop()
+	for {
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+		case <-timer.Chan():
+			if err := w.config.SecretService.PruneSecretAccessLog(ctx); err != nil {
+				return errors.Errorf("pruning secret access log: %w", err)
+			}
+			w.mu.Lock()
+			w.lastPrune = w.config.Clock.Now()
+			w.mu.Unlock()
+			timer.Reset(jitter(w.config.Interval))
+		}
+	}
+}
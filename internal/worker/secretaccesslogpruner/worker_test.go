@@ -0,0 +1,111 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secretaccesslogpruner
+
+import (
+	"testing"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/tc"
+	"github.com/juju/worker/v4/workertest"
+	"go.uber.org/mock/gomock"
+
+	coretesting "github.com/juju/juju/core/testing"
+	"github.com/juju/juju/internal/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+func TestConfigSuite(t *testing.T) { tc.Run(t, &configSuite{}) }
+func TestWorkerSuite(t *testing.T) { tc.Run(t, &workerSuite{}) }
+
+type configSuite struct{}
+
+func (s *configSuite) TestConfigValidation(c *tc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	origCfg := Config{
+		SecretService: NewMockSecretService(ctrl),
+		Clock:         testclock.NewClock(time.Now()),
+		Logger:        loggertesting.WrapCheckLog(c),
+		Interval:      time.Second,
+	}
+	c.Check(origCfg.Validate(), tc.ErrorIsNil)
+
+	testCfg := origCfg
+	testCfg.SecretService = nil
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "nil SecretService.*")
+
+	testCfg = origCfg
+	testCfg.Clock = nil
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "nil clock.Clock.*")
+
+	testCfg = origCfg
+	testCfg.Logger = nil
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "nil Logger.*")
+
+	testCfg = origCfg
+	testCfg.Interval = 0
+	c.Check(testCfg.Validate(), tc.ErrorMatches, "interval must be positive.*")
+}
+
+type workerSuite struct {
+	clock         *testclock.Clock
+	secretService *MockSecretService
+}
+
+// TestPrune verifies that the access log is pruned on each interval.
+func (s *workerSuite) TestPrune(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.secretService.EXPECT().PruneSecretAccessLog(gomock.Any()).Return(nil).Times(2)
+
+	w := s.startWorker(c)
+	defer workertest.CleanKill(c, w)
+
+	s.advance(c)
+	s.advance(c)
+}
+
+// TestPruneError verifies that failing to prune kills the worker.
+func (s *workerSuite) TestPruneError(c *tc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.secretService.EXPECT().PruneSecretAccessLog(gomock.Any()).Return(errors.New("boom"))
+
+	w := s.startWorker(c)
+	err := s.clock.WaitAdvance(2*time.Second, coretesting.ShortWait, 1)
+	c.Assert(err, tc.ErrorIsNil)
+
+	err = workertest.CheckKilled(c, w)
+	c.Assert(err, tc.ErrorMatches, "pruning secret access log: boom")
+}
+
+func (s *workerSuite) setupMocks(c *tc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.clock = testclock.NewClock(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	s.secretService = NewMockSecretService(ctrl)
+	return ctrl
+}
+
+func (s *workerSuite) startWorker(c *tc.C) *prunerWorker {
+	w, err := NewWorker(Config{
+		SecretService: s.secretService,
+		Clock:         s.clock,
+		Logger:        loggertesting.WrapCheckLog(c),
+		Interval:      time.Second,
+	})
+	c.Assert(err, tc.ErrorIsNil)
+	return w.(*prunerWorker)
+}
+
+// advance fires the worker's timer and waits for the timer to be reset,
+// which happens once the prune has completed.
+func (s *workerSuite) advance(c *tc.C) {
+	err := s.clock.WaitAdvance(2*time.Second, coretesting.ShortWait, 1)
+	c.Assert(err, tc.ErrorIsNil)
+	err = s.clock.WaitAdvance(0, coretesting.ShortWait, 1)
+	c.Assert(err, tc.ErrorIsNil)
+}
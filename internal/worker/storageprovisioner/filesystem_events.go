@@ -313,6 +313,7 @@ func processAliveFilesystems(ctx context.Context, deps *dependencies, tags []nam
 	deps.config.Logger.Tracef(ctx, "processAliveFilesystems: %#v %#v", tags, filesystemResults)
 	// Filter out the already-provisioned filesystems.
 	pending := make([]names.FilesystemTag, 0, len(tags))
+	var provisioned []storage.Filesystem
 	for i, result := range filesystemResults {
 		tag := tags[i]
 		if result.Error == nil {
@@ -330,6 +331,7 @@ func processAliveFilesystems(ctx context.Context, deps *dependencies, tags []nam
 					// filesystem, so that attachments can be made.
 					maybeAddPendingVolumeBlockDevice(ctx, deps, filesystem.Volume)
 				}
+				provisioned = append(provisioned, filesystem)
 			}
 			continue
 		}
@@ -342,6 +344,10 @@ func processAliveFilesystems(ctx context.Context, deps *dependencies, tags []nam
 		// to enquire about parameters below.
 		pending = append(pending, tag)
 	}
+	// Grow any provisioned filesystems whose requested size has increased.
+	if err := resizeFilesystems(ctx, deps, provisioned); err != nil {
+		return errors.Annotate(err, "resizing filesystems")
+	}
 	if len(pending) == 0 {
 		return nil
 	}
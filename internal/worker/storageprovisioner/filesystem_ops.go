@@ -117,6 +117,100 @@ func createFilesystems(ctx context.Context, deps *dependencies, ops map[names.Fi
 	return nil
 }
 
+// resizeFilesystems grows the specified provisioned filesystems to their
+// requested size, for those whose filesystem source supports resizing.
+// Volume-backed filesystems are left alone.
+func resizeFilesystems(ctx context.Context, deps *dependencies, filesystems []storage.Filesystem) error {
+	deps.config.Logger.Tracef(ctx, "resizeFilesystems: %#v", filesystems)
+	tags := make([]names.FilesystemTag, 0, len(filesystems))
+	filesystemsByTag := make(map[names.FilesystemTag]storage.Filesystem)
+	for _, f := range filesystems {
+		if f.Volume != (names.VolumeTag{}) {
+			continue
+		}
+		tags = append(tags, f.Tag)
+		filesystemsByTag[f.Tag] = f
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+	filesystemParams, err := filesystemParams(ctx, deps, tags)
+	if err != nil {
+		return errors.Annotate(err, "getting filesystem params")
+	}
+	filesystemResizers := make(map[string]storage.FilesystemResizer)
+	paramsBySource := make(map[string][]storage.ResizeFilesystemParams)
+	for _, params := range filesystemParams {
+		filesystem := filesystemsByTag[params.Tag]
+		if params.Size <= filesystem.Size {
+			continue
+		}
+		sourceName := string(params.Provider)
+		resizer, ok := filesystemResizers[sourceName]
+		if !ok {
+			filesystemSource, err := filesystemSource(
+				deps.config.StorageDir, sourceName, params.Provider, deps.config.Registry,
+			)
+			if err != nil && !errors.Is(err, errors.NotFound) {
+				return errors.Annotate(err, "getting filesystem source")
+			}
+			// Sources that cannot resize filesystems are recorded as
+			// nil, leaving their filesystems at the provisioned size.
+			resizer, _ = filesystemSource.(storage.FilesystemResizer)
+			filesystemResizers[sourceName] = resizer
+		}
+		if resizer == nil {
+			continue
+		}
+		paramsBySource[sourceName] = append(paramsBySource[sourceName], storage.ResizeFilesystemParams{
+			Filesystem: filesystem.Tag,
+			ProviderId: filesystem.ProviderId,
+			Size:       params.Size,
+		})
+	}
+	var resized []storage.Filesystem
+	for sourceName, resizeParams := range paramsBySource {
+		deps.config.Logger.Debugf(ctx, "resizing filesystems: %v", resizeParams)
+		results, err := filesystemResizers[sourceName].ResizeFilesystems(ctx, resizeParams)
+		if err != nil {
+			return errors.Annotatef(err, "resizing filesystems from source %q", sourceName)
+		}
+		for i, err := range results {
+			if err != nil {
+				deps.config.Logger.Warningf(ctx,
+					"failed to resize %s: %v",
+					names.ReadableString(resizeParams[i].Filesystem),
+					err,
+				)
+				continue
+			}
+			filesystem := filesystemsByTag[resizeParams[i].Filesystem]
+			filesystem.Size = resizeParams[i].Size
+			resized = append(resized, filesystem)
+		}
+	}
+	if len(resized) == 0 {
+		return nil
+	}
+	errorResults, err := deps.config.Filesystems.SetFilesystemInfo(ctx, filesystemsFromStorage(resized))
+	if err != nil {
+		return errors.Annotate(err, "publishing filesystems to state")
+	}
+	for i, result := range errorResults {
+		if result.Error != nil {
+			deps.config.Logger.Errorf(ctx,
+				"publishing filesystem %s to state: %v",
+				resized[i].Tag.Id(),
+				result.Error,
+			)
+		}
+	}
+	for _, f := range resized {
+		updateFilesystem(ctx, deps, f)
+	}
+	return nil
+}
+
 // attachFilesystems creates filesystem attachments with the specified parameters.
 func attachFilesystems(ctx context.Context, deps *dependencies, ops map[params.MachineStorageId]*attachFilesystemOp) error {
 	deps.config.Logger.Tracef(ctx, "createFilesystems: %#v", ops)
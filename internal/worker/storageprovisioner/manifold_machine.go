@@ -58,8 +58,10 @@ func (config MachineManifoldConfig) newWorker(_ context.Context, a agent.Agent,
 		Registry: storage.StaticProviderRegistry{
 			Providers: map[storage.ProviderType]storage.Provider{
 				provider.LoopProviderType:   provider.NewLoopProvider(provider.LogAndExec),
+				provider.LVMProviderType:    provider.NewLVMProvider(provider.LogAndExec),
 				provider.RootfsProviderType: provider.NewRootfsProvider(provider.LogAndExec),
 				provider.TmpfsProviderType:  provider.NewTmpfsProvider(provider.LogAndExec),
+				provider.ZFSProviderType:    provider.NewZFSProvider(provider.LogAndExec),
 			},
 		},
 		Machines: api,
@@ -546,6 +546,8 @@ type dummyProvider struct {
 	releaseFilesystemsFunc       func([]string) ([]error, error)
 	validateVolumeParamsFunc     func(storage.VolumeParams) error
 	validateFilesystemParamsFunc func(storage.FilesystemParams) error
+	resizeVolumesFunc            func([]storage.ResizeVolumeParams) ([]storage.DescribeVolumesResult, error)
+	resizeFilesystemsFunc        func([]storage.ResizeFilesystemParams) ([]error, error)
 }
 
 type dummyVolumeSource struct {
@@ -560,6 +562,18 @@ type dummyFilesystemSource struct {
 	createFilesystemsArgs [][]storage.FilesystemParams
 }
 
+// resizableVolumeSource is a dummyVolumeSource that also implements
+// storage.VolumeResizer.
+type resizableVolumeSource struct {
+	dummyVolumeSource
+}
+
+// resizableFilesystemSource is a dummyFilesystemSource that also
+// implements storage.FilesystemResizer.
+type resizableFilesystemSource struct {
+	dummyFilesystemSource
+}
+
 func (p *dummyProvider) VolumeSource(providerConfig *storage.Config) (storage.VolumeSource, error) {
 	if p.volumeSourceFunc != nil {
 		return p.volumeSourceFunc(providerConfig)
@@ -661,6 +675,21 @@ func (s *dummyVolumeSource) DetachVolumes(ctx context.Context, params []storage.
 	return make([]error, len(params)), nil
 }
 
+// ResizeVolumes grows volumes.
+func (s *resizableVolumeSource) ResizeVolumes(ctx context.Context, params []storage.ResizeVolumeParams) ([]storage.DescribeVolumesResult, error) {
+	if s.provider.resizeVolumesFunc != nil {
+		return s.provider.resizeVolumesFunc(params)
+	}
+	results := make([]storage.DescribeVolumesResult, len(params))
+	for i, p := range params {
+		results[i].VolumeInfo = &storage.VolumeInfo{
+			VolumeId: p.VolumeId,
+			Size:     p.Size,
+		}
+	}
+	return results, nil
+}
+
 func (s *dummyFilesystemSource) ValidateFilesystemParams(params storage.FilesystemParams) error {
 	if s.provider != nil && s.provider.validateFilesystemParamsFunc != nil {
 		return s.provider.validateFilesystemParamsFunc(params)
@@ -740,6 +769,14 @@ func (s *dummyFilesystemSource) DetachFilesystems(ctx context.Context, params []
 	return make([]error, len(params)), nil
 }
 
+// ResizeFilesystems grows filesystems.
+func (s *resizableFilesystemSource) ResizeFilesystems(ctx context.Context, params []storage.ResizeFilesystemParams) ([]error, error) {
+	if s.provider.resizeFilesystemsFunc != nil {
+		return s.provider.resizeFilesystemsFunc(params)
+	}
+	return make([]error, len(params)), nil
+}
+
 type mockManagedFilesystemSource struct {
 	blockDevices        map[names.VolumeTag]blockdevice.BlockDevice
 	filesystems         map[names.FilesystemTag]storage.Filesystem
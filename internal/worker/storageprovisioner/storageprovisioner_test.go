@@ -1328,6 +1328,133 @@ func (s *storageProvisionerSuite) TestSetVolumeInfoErrorResultDoesNotStopWorker(
 	assertNoEvent(c, done, "worker exited")
 }
 
+func (s *storageProvisionerSuite) TestResizeVolumes(c *tc.C) {
+	s.provider.volumeSourceFunc = func(*storage.Config) (storage.VolumeSource, error) {
+		return &resizableVolumeSource{dummyVolumeSource{provider: s.provider}}, nil
+	}
+	s.provider.resizeVolumesFunc = func(args []storage.ResizeVolumeParams) ([]storage.DescribeVolumesResult, error) {
+		c.Assert(args, tc.DeepEquals, []storage.ResizeVolumeParams{{
+			Volume:   names.NewVolumeTag("1"),
+			VolumeId: "vol-1",
+			Size:     1024,
+		}})
+		return []storage.DescribeVolumesResult{{
+			VolumeInfo: &storage.VolumeInfo{VolumeId: "vol-1", Size: 1028},
+		}}, nil
+	}
+
+	volumeInfoSet := make(chan interface{})
+	volumeAccessor := newMockVolumeAccessor()
+	volumeAccessor.provisionedMachines["machine-1"] = "already-provisioned-1"
+	volumeAccessor.provisionVolume(names.NewVolumeTag("1"))
+	volumeAccessor.setVolumeInfo = func(volumes []params.Volume) ([]params.ErrorResult, error) {
+		defer close(volumeInfoSet)
+		c.Assert(volumes, tc.DeepEquals, []params.Volume{{
+			VolumeTag: "volume-1",
+			Info: params.VolumeInfo{
+				ProviderId: "vol-1",
+				SizeMiB:    1028,
+			},
+		}})
+		return make([]params.ErrorResult, len(volumes)), nil
+	}
+
+	args := &workerArgs{volumes: volumeAccessor, registry: s.registry}
+	worker := newStorageProvisioner(c, args)
+	defer func() { c.Assert(worker.Wait(), tc.IsNil) }()
+	defer worker.Kill()
+
+	volumeAccessor.volumesWatcher.changes <- []string{"1"}
+	waitChannel(c, volumeInfoSet, "waiting for volume info to be set")
+}
+
+func (s *storageProvisionerSuite) TestResizeVolumesNotSupported(c *tc.C) {
+	volumeInfoSet := make(chan interface{})
+	volumeAccessor := newMockVolumeAccessor()
+	volumeAccessor.provisionedMachines["machine-1"] = "already-provisioned-1"
+	volumeAccessor.provisionVolume(names.NewVolumeTag("1"))
+	volumeAccessor.setVolumeInfo = func(volumes []params.Volume) ([]params.ErrorResult, error) {
+		defer close(volumeInfoSet)
+		return make([]params.ErrorResult, len(volumes)), nil
+	}
+
+	args := &workerArgs{volumes: volumeAccessor, registry: s.registry}
+	worker := newStorageProvisioner(c, args)
+	defer func() { c.Assert(worker.Wait(), tc.IsNil) }()
+	defer worker.Kill()
+
+	// The dummy volume source cannot resize volumes, so the provisioned
+	// volume is left at its recorded size.
+	volumeAccessor.volumesWatcher.changes <- []string{"1"}
+	assertNoEvent(c, volumeInfoSet, "volume info set")
+}
+
+func (s *storageProvisionerSuite) TestResizeVolumesErrorResultDoesNotStopWorker(c *tc.C) {
+	s.provider.volumeSourceFunc = func(*storage.Config) (storage.VolumeSource, error) {
+		return &resizableVolumeSource{dummyVolumeSource{provider: s.provider}}, nil
+	}
+	resized := make(chan interface{})
+	s.provider.resizeVolumesFunc = func(args []storage.ResizeVolumeParams) ([]storage.DescribeVolumesResult, error) {
+		defer close(resized)
+		return []storage.DescribeVolumesResult{{Error: errors.New("no free extents")}}, nil
+	}
+
+	volumeInfoSet := make(chan interface{})
+	volumeAccessor := newMockVolumeAccessor()
+	volumeAccessor.provisionedMachines["machine-1"] = "already-provisioned-1"
+	volumeAccessor.provisionVolume(names.NewVolumeTag("1"))
+	volumeAccessor.setVolumeInfo = func(volumes []params.Volume) ([]params.ErrorResult, error) {
+		defer close(volumeInfoSet)
+		return make([]params.ErrorResult, len(volumes)), nil
+	}
+
+	args := &workerArgs{volumes: volumeAccessor, registry: s.registry}
+	worker := newStorageProvisioner(c, args)
+	defer func() { c.Assert(worker.Wait(), tc.IsNil) }()
+	defer worker.Kill()
+
+	volumeAccessor.volumesWatcher.changes <- []string{"1"}
+	waitChannel(c, resized, "waiting for volume to be resized")
+	assertNoEvent(c, volumeInfoSet, "volume info set")
+}
+
+func (s *storageProvisionerSuite) TestResizeFilesystems(c *tc.C) {
+	s.provider.filesystemSourceFunc = func(*storage.Config) (storage.FilesystemSource, error) {
+		return &resizableFilesystemSource{dummyFilesystemSource{provider: s.provider}}, nil
+	}
+	s.provider.resizeFilesystemsFunc = func(args []storage.ResizeFilesystemParams) ([]error, error) {
+		c.Assert(args, tc.DeepEquals, []storage.ResizeFilesystemParams{{
+			Filesystem: names.NewFilesystemTag("1"),
+			ProviderId: "fs-1",
+			Size:       1024,
+		}})
+		return make([]error, len(args)), nil
+	}
+
+	filesystemInfoSet := make(chan interface{})
+	filesystemAccessor := newMockFilesystemAccessor()
+	filesystemAccessor.provisionFilesystem(names.NewFilesystemTag("1"))
+	filesystemAccessor.setFilesystemInfo = func(filesystems []params.Filesystem) ([]params.ErrorResult, error) {
+		defer close(filesystemInfoSet)
+		c.Assert(filesystems, tc.DeepEquals, []params.Filesystem{{
+			FilesystemTag: "filesystem-1",
+			Info: params.FilesystemInfo{
+				ProviderId: "fs-1",
+				SizeMiB:    1024,
+			},
+		}})
+		return make([]params.ErrorResult, len(filesystems)), nil
+	}
+
+	args := &workerArgs{filesystems: filesystemAccessor, registry: s.registry}
+	worker := newStorageProvisioner(c, args)
+	defer func() { c.Assert(worker.Wait(), tc.IsNil) }()
+	defer worker.Kill()
+
+	filesystemAccessor.filesystemsWatcher.changes <- []string{"1"}
+	waitChannel(c, filesystemInfoSet, "waiting for filesystem info to be set")
+}
+
 func (s *storageProvisionerSuite) TestDetachVolumesUnattached(c *tc.C) {
 	removed := make(chan interface{})
 	removeAttachments := func(ids []params.MachineStorageId) ([]params.ErrorResult, error) {
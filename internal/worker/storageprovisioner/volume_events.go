@@ -451,6 +451,7 @@ func processAliveVolumes(
 
 	// Filter out the already-provisioned volumes.
 	pending := make([]names.VolumeTag, 0, len(tags))
+	var provisioned []storage.Volume
 	for i, result := range volumeResults {
 		volumeTag := tags[i].(names.VolumeTag)
 		if result.Error == nil {
@@ -462,6 +463,7 @@ func processAliveVolumes(
 			}
 			updateVolume(ctx, deps, volume)
 			removePendingVolume(ctx, deps, volumeTag)
+			provisioned = append(provisioned, volume)
 			continue
 		}
 		if !params.IsCodeNotProvisioned(result.Error) {
@@ -473,6 +475,10 @@ func processAliveVolumes(
 		// to enquire about parameters below.
 		pending = append(pending, volumeTag)
 	}
+	// Grow any provisioned volumes whose requested size has increased.
+	if err := resizeVolumes(ctx, deps, provisioned); err != nil {
+		return errors.Annotate(err, "resizing volumes")
+	}
 	if len(pending) == 0 {
 		return nil
 	}
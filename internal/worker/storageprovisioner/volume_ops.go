@@ -126,6 +126,96 @@ func createVolumes(ctx context.Context, deps *dependencies, ops map[names.Volume
 	return nil
 }
 
+// resizeVolumes grows the specified provisioned volumes to their requested
+// size, for those whose volume source supports resizing.
+func resizeVolumes(ctx context.Context, deps *dependencies, volumes []storage.Volume) error {
+	deps.config.Logger.Tracef(ctx, "resizeVolumes: %#v", volumes)
+	if len(volumes) == 0 {
+		return nil
+	}
+	tags := make([]names.VolumeTag, len(volumes))
+	for i, v := range volumes {
+		tags[i] = v.Tag
+	}
+	volumeParams, err := volumeParams(ctx, deps, tags)
+	if err != nil {
+		return errors.Annotate(err, "getting volume params")
+	}
+	volumeResizers := make(map[string]storage.VolumeResizer)
+	paramsBySource := make(map[string][]storage.ResizeVolumeParams)
+	volumesByTag := make(map[names.VolumeTag]storage.Volume)
+	for i, params := range volumeParams {
+		volume := volumes[i]
+		if params.Size <= volume.Size {
+			continue
+		}
+		sourceName := string(params.Provider)
+		resizer, ok := volumeResizers[sourceName]
+		if !ok {
+			volumeSource, err := volumeSource(
+				deps.config.StorageDir, sourceName, params.Provider, deps.config.Registry,
+			)
+			if err != nil && errors.Cause(err) != errNonDynamic {
+				return errors.Annotate(err, "getting volume source")
+			}
+			// Sources that cannot resize volumes are recorded as nil,
+			// leaving their volumes at the provisioned size.
+			resizer, _ = volumeSource.(storage.VolumeResizer)
+			volumeResizers[sourceName] = resizer
+		}
+		if resizer == nil {
+			continue
+		}
+		paramsBySource[sourceName] = append(paramsBySource[sourceName], storage.ResizeVolumeParams{
+			Volume:   volume.Tag,
+			VolumeId: volume.VolumeId,
+			Size:     params.Size,
+		})
+		volumesByTag[volume.Tag] = volume
+	}
+	var resized []storage.Volume
+	for sourceName, resizeParams := range paramsBySource {
+		deps.config.Logger.Debugf(ctx, "resizing volumes: %v", resizeParams)
+		results, err := volumeResizers[sourceName].ResizeVolumes(ctx, resizeParams)
+		if err != nil {
+			return errors.Annotatef(err, "resizing volumes from source %q", sourceName)
+		}
+		for i, result := range results {
+			if result.Error != nil {
+				deps.config.Logger.Warningf(ctx,
+					"failed to resize %s: %v",
+					names.ReadableString(resizeParams[i].Volume),
+					result.Error,
+				)
+				continue
+			}
+			volume := volumesByTag[resizeParams[i].Volume]
+			volume.Size = result.VolumeInfo.Size
+			resized = append(resized, volume)
+		}
+	}
+	if len(resized) == 0 {
+		return nil
+	}
+	errorResults, err := deps.config.Volumes.SetVolumeInfo(ctx, volumesFromStorage(resized))
+	if err != nil {
+		return errors.Annotate(err, "publishing volumes to state")
+	}
+	for i, result := range errorResults {
+		if result.Error != nil {
+			deps.config.Logger.Errorf(ctx,
+				"publishing volume %s to state: %v",
+				resized[i].Tag.Id(),
+				result.Error,
+			)
+		}
+	}
+	for _, v := range resized {
+		updateVolume(ctx, deps, v)
+	}
+	return nil
+}
+
 // attachVolumes creates volume attachments with the specified parameters.
 func attachVolumes(ctx context.Context, deps *dependencies, ops map[params.MachineStorageId]*attachVolumeOp) error {
 	deps.config.Logger.Tracef(ctx, "attachVolumes: %#v", ops)
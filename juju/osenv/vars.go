@@ -54,6 +54,15 @@ const (
 	// timestamps to be written in RFC3339 format.
 	JujuStatusIsoTimeEnvKey = "JUJU_STATUS_ISO_TIME"
 
+	// JujuCredentialHelperEnvKey selects the credential helper used by the
+	// client store to hold passwords and cloud credential secrets, instead
+	// of writing them to the YAML files in the clear.
+	JujuCredentialHelperEnvKey = "JUJU_CREDENTIAL_HELPER"
+
+	// JujuCredentialPassphraseEnvKey holds the passphrase used by the
+	// built-in encrypted file credential helper.
+	JujuCredentialPassphraseEnvKey = "JUJU_CREDENTIAL_PASSPHRASE"
+
 	// XDGDataHome is a path where data for the running user
 	// should be stored according to the xdg standard.
 	XDGDataHome = "XDG_DATA_HOME"
@@ -65,6 +65,14 @@ type ApplicationDeploy struct {
 	EndpointBindings map[string]string              `json:"endpoint-bindings,omitempty"`
 	Resources        map[string]string              `json:"resources,omitempty"`
 	Force            bool
+
+	// CharmSignature is a detached signature over the charm archive's
+	// SHA256 hash, required by the charm-signature-policy controller config.
+	CharmSignature string `json:"charm-signature,omitempty"`
+
+	// ResourceSignatures maps OCI image resource names to detached
+	// signatures over the resource's SHA384 fingerprint.
+	ResourceSignatures map[string]string `json:"resource-signatures,omitempty"`
 }
 
 // ApplicationSetCharmV2 sets the charm for a given application.
@@ -118,6 +126,14 @@ type ApplicationSetCharmV2 struct {
 	// space names to be merged with any existing endpoint bindings. This
 	// field is only understood by Application facade version 10 and greater.
 	EndpointBindings map[string]string `json:"endpoint-bindings,omitempty"`
+
+	// CharmSignature is a detached signature over the charm archive's
+	// SHA256 hash, required by the charm-signature-policy controller config.
+	CharmSignature string `json:"charm-signature,omitempty"`
+
+	// ResourceSignatures maps OCI image resource names to detached
+	// signatures over the resource's SHA384 fingerprint.
+	ResourceSignatures map[string]string `json:"resource-signatures,omitempty"`
 }
 
 // ApplicationSetCharmV1 sets the charm for a given application.
@@ -640,6 +656,14 @@ type DeployFromRepositoryArg struct {
 
 	//  Trust allows charm to run hooks that require access credentials
 	Trust bool
+
+	// CharmSignature is a detached signature over the charm archive's
+	// SHA256 hash, required by the charm-signature-policy controller config.
+	CharmSignature string `json:"charm-signature,omitempty"`
+
+	// ResourceSignatures maps OCI image resource names to detached
+	// signatures over the resource's SHA384 fingerprint.
+	ResourceSignatures map[string]string `json:"resource-signatures,omitempty"`
 }
 
 type DeployFromRepositoryResults struct {
@@ -727,3 +751,35 @@ type ApplicationStorageUpdate struct {
 	// Holds the application storage constraints where the key is the storage name.
 	StorageConstraints map[string]StorageDirectives `json:"storage-constraints"`
 }
+
+// ApplicationRefreshPolicy holds the charm refresh policy of an
+// application.
+type ApplicationRefreshPolicy struct {
+	ApplicationTag string `json:"application-tag"`
+
+	// Policy is one of "none", "notify" or "auto".
+	Policy string `json:"policy"`
+
+	// Window is the maintenance window, as "HH:MM-HH:MM" in UTC, within
+	// which an application with the "auto" policy may be refreshed.
+	Window string `json:"window,omitempty"`
+}
+
+// ApplicationRefreshPolicies holds the charm refresh policies to set on one
+// or more applications.
+type ApplicationRefreshPolicies struct {
+	Policies []ApplicationRefreshPolicy `json:"policies"`
+}
+
+// ApplicationRefreshPolicyResult holds the charm refresh policy of an
+// application, or an error.
+type ApplicationRefreshPolicyResult struct {
+	Result *ApplicationRefreshPolicy `json:"result,omitempty"`
+	Error  *Error                    `json:"error,omitempty"`
+}
+
+// ApplicationRefreshPolicyResults holds the results of a bulk refresh policy
+// get request.
+type ApplicationRefreshPolicyResults struct {
+	Results []ApplicationRefreshPolicyResult `json:"results"`
+}
@@ -14,6 +14,7 @@ type KubernetesFilesystemParams struct {
 	Provider    string                                `json:"provider"`
 	Attributes  map[string]interface{}                `json:"attributes,omitempty"`
 	Tags        map[string]string                     `json:"tags,omitempty"`
+	Shared      bool                                  `json:"shared,omitempty"`
 	Attachment  *KubernetesFilesystemAttachmentParams `json:"attachment,omitempty"`
 }
 
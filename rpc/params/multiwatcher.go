@@ -0,0 +1,271 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package params
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/core/life"
+	"github.com/juju/juju/core/status"
+)
+
+// Entity kinds reported by the all-watchers.
+const (
+	ApplicationEntityKind       = "application"
+	UnitEntityKind              = "unit"
+	MachineEntityKind           = "machine"
+	RelationEntityKind          = "relation"
+	AnnotationEntityKind        = "annotation"
+	RemoteApplicationEntityKind = "remoteApplication"
+)
+
+// AllWatcherNextResults holds deltas returned from calling AllWatcher.Next().
+type AllWatcherNextResults struct {
+	Deltas []Delta `json:"deltas"`
+}
+
+// EntityId uniquely identifies an entity being tracked by the
+// multiwatcher.
+type EntityId struct {
+	Kind      string `json:"kind"`
+	ModelUUID string `json:"model-uuid"`
+	Id        string `json:"id"`
+}
+
+// EntityInfo is implemented by all entity Info types.
+type EntityInfo interface {
+	// EntityId returns an identifier that will uniquely identify the
+	// entity within its kind.
+	EntityId() EntityId
+}
+
+// Delta holds details of a change to the model.
+type Delta struct {
+	// If Removed is true, the entity has been removed; otherwise it has
+	// been created or changed.
+	Removed bool `json:"removed"`
+	// Entity holds data about the entity that has changed.
+	Entity EntityInfo `json:"entity"`
+}
+
+// MarshalJSON implements json.Marshaler. A delta is encoded as a three
+// element array of the entity kind, the operation ("change" or "remove")
+// and the entity itself.
+func (d *Delta) MarshalJSON() ([]byte, error) {
+	b, err := json.Marshal(d.Entity)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	c := "change"
+	if d.Removed {
+		c = "remove"
+	}
+	fmt.Fprintf(&buf, "%q,%q,", d.Entity.EntityId().Kind, c)
+	buf.Write(b)
+	buf.WriteByte(']')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *Delta) UnmarshalJSON(data []byte) error {
+	var elements []json.RawMessage
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+	if len(elements) != 3 {
+		return fmt.Errorf(
+			"expected 3 elements in top-level of JSON but got %d",
+			len(elements))
+	}
+	var entityKind, operation string
+	if err := json.Unmarshal(elements[0], &entityKind); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(elements[1], &operation); err != nil {
+		return err
+	}
+	switch operation {
+	case "change":
+		d.Removed = false
+	case "remove":
+		d.Removed = true
+	default:
+		return fmt.Errorf("unexpected operation %q", operation)
+	}
+	switch entityKind {
+	case ApplicationEntityKind:
+		d.Entity = new(ApplicationInfo)
+	case UnitEntityKind:
+		d.Entity = new(UnitInfo)
+	case MachineEntityKind:
+		d.Entity = new(MachineInfo)
+	case RelationEntityKind:
+		d.Entity = new(RelationInfo)
+	case AnnotationEntityKind:
+		d.Entity = new(AnnotationInfo)
+	case RemoteApplicationEntityKind:
+		d.Entity = new(RemoteApplicationUpdate)
+	default:
+		return fmt.Errorf("unexpected entity name %q", entityKind)
+	}
+	return json.Unmarshal(elements[2], &d.Entity)
+}
+
+// StatusInfo holds the unit and machine status information. It is
+// used by ApplicationInfo, UnitInfo and MachineInfo.
+type StatusInfo struct {
+	Current status.Status          `json:"current"`
+	Message string                 `json:"message"`
+	Since   *time.Time             `json:"since"`
+	Version string                 `json:"version"`
+	Data    map[string]interface{} `json:"data,omitempty"`
+}
+
+// ApplicationInfo holds the information about an application that is
+// tracked by the all-watchers.
+type ApplicationInfo struct {
+	ModelUUID       string     `json:"model-uuid"`
+	Name            string     `json:"name"`
+	Exposed         bool       `json:"exposed"`
+	CharmURL        string     `json:"charm-url"`
+	Life            life.Value `json:"life"`
+	Subordinate     bool       `json:"subordinate"`
+	Status          StatusInfo `json:"status"`
+	WorkloadVersion string     `json:"workload-version"`
+}
+
+// EntityId returns a unique identifier for an application across
+// models.
+func (i *ApplicationInfo) EntityId() EntityId {
+	return EntityId{
+		Kind:      ApplicationEntityKind,
+		ModelUUID: i.ModelUUID,
+		Id:        i.Name,
+	}
+}
+
+// UnitInfo holds the information about a unit that is tracked by the
+// all-watchers.
+type UnitInfo struct {
+	ModelUUID      string      `json:"model-uuid"`
+	Name           string      `json:"name"`
+	Application    string      `json:"application"`
+	Base           string      `json:"base"`
+	CharmURL       string      `json:"charm-url"`
+	Life           life.Value  `json:"life"`
+	MachineId      string      `json:"machine-id"`
+	PortRanges     []PortRange `json:"port-ranges"`
+	Principal      string      `json:"principal"`
+	Subordinate    bool        `json:"subordinate"`
+	WorkloadStatus StatusInfo  `json:"workload-status"`
+	AgentStatus    StatusInfo  `json:"agent-status"`
+}
+
+// EntityId returns a unique identifier for a unit across
+// models.
+func (i *UnitInfo) EntityId() EntityId {
+	return EntityId{
+		Kind:      UnitEntityKind,
+		ModelUUID: i.ModelUUID,
+		Id:        i.Name,
+	}
+}
+
+// MachineInfo holds the information about a machine that is tracked by
+// the all-watchers.
+type MachineInfo struct {
+	ModelUUID               string                            `json:"model-uuid"`
+	Id                      string                            `json:"id"`
+	InstanceId              string                            `json:"instance-id"`
+	AgentStatus             StatusInfo                        `json:"agent-status"`
+	InstanceStatus          StatusInfo                        `json:"instance-status"`
+	Life                    life.Value                        `json:"life"`
+	Base                    string                            `json:"base"`
+	ContainerType           string                            `json:"container-type"`
+	HardwareCharacteristics *instance.HardwareCharacteristics `json:"hardware-characteristics,omitempty"`
+	Addresses               []Address                         `json:"addresses"`
+	Hostname                string                            `json:"hostname,omitempty"`
+}
+
+// EntityId returns a unique identifier for a machine across
+// models.
+func (i *MachineInfo) EntityId() EntityId {
+	return EntityId{
+		Kind:      MachineEntityKind,
+		ModelUUID: i.ModelUUID,
+		Id:        i.Id,
+	}
+}
+
+// RelationInfo holds the information about a relation that is tracked
+// by the all-watchers.
+type RelationInfo struct {
+	ModelUUID string             `json:"model-uuid"`
+	Key       string             `json:"key"`
+	Id        int                `json:"id"`
+	Life      life.Value         `json:"life"`
+	Status    StatusInfo         `json:"status"`
+	Endpoints []RelationEndpoint `json:"endpoints"`
+}
+
+// RelationEndpoint holds an application-relation pair of a relation
+// reported by the all-watchers.
+type RelationEndpoint struct {
+	ApplicationName string        `json:"application-name"`
+	Relation        CharmRelation `json:"relation"`
+}
+
+// EntityId returns a unique identifier for a relation across
+// models.
+func (i *RelationInfo) EntityId() EntityId {
+	return EntityId{
+		Kind:      RelationEntityKind,
+		ModelUUID: i.ModelUUID,
+		Id:        i.Key,
+	}
+}
+
+// AnnotationInfo holds the information about an annotation that is
+// tracked by the all-watchers.
+type AnnotationInfo struct {
+	ModelUUID   string            `json:"model-uuid"`
+	Tag         string            `json:"tag"`
+	Annotations map[string]string `json:"annotations"`
+}
+
+// EntityId returns a unique identifier for an annotation across
+// models.
+func (i *AnnotationInfo) EntityId() EntityId {
+	return EntityId{
+		Kind:      AnnotationEntityKind,
+		ModelUUID: i.ModelUUID,
+		Id:        i.Tag,
+	}
+}
+
+// RemoteApplicationUpdate holds the information about a remote
+// application, consuming an offer from another model, that is tracked
+// by the all-watchers.
+type RemoteApplicationUpdate struct {
+	ModelUUID string     `json:"model-uuid"`
+	Name      string     `json:"name"`
+	OfferUUID string     `json:"offer-uuid"`
+	Life      life.Value `json:"life"`
+}
+
+// EntityId returns a unique identifier for a remote application across
+// models.
+func (i *RemoteApplicationUpdate) EntityId() EntityId {
+	return EntityId{
+		Kind:      RemoteApplicationEntityKind,
+		ModelUUID: i.ModelUUID,
+		Id:        i.Name,
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2025 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package params_test
+
+import (
+	"encoding/json"
+	stdtesting "testing"
+
+	"github.com/juju/tc"
+
+	"github.com/juju/juju/core/life"
+	"github.com/juju/juju/core/status"
+	"github.com/juju/juju/rpc/params"
+)
+
+type DeltaSuite struct{}
+
+func TestDeltaSuite(t *stdtesting.T) {
+	tc.Run(t, &DeltaSuite{})
+}
+
+func (s *DeltaSuite) TestMarshalRoundTrip(c *tc.C) {
+	deltas := []params.Delta{{
+		Entity: &params.ApplicationInfo{
+			ModelUUID: "uuid",
+			Name:      "mysql",
+			Life:      life.Alive,
+			Status:    params.StatusInfo{Current: status.Active},
+		},
+	}, {
+		Removed: true,
+		Entity: &params.UnitInfo{
+			ModelUUID:   "uuid",
+			Name:        "mysql/0",
+			Application: "mysql",
+			MachineId:   "0",
+		},
+	}, {
+		Entity: &params.MachineInfo{ModelUUID: "uuid", Id: "0"},
+	}, {
+		Entity: &params.RelationInfo{ModelUUID: "uuid", Key: "mysql:db wordpress:db", Id: 1},
+	}, {
+		Entity: &params.AnnotationInfo{
+			ModelUUID:   "uuid",
+			Tag:         "application-mysql",
+			Annotations: map[string]string{"foo": "bar"},
+		},
+	}, {
+		Entity: &params.RemoteApplicationUpdate{ModelUUID: "uuid", Name: "db2", Life: life.Dying},
+	}}
+
+	for _, delta := range deltas {
+		data, err := json.Marshal(&delta)
+		c.Assert(err, tc.ErrorIsNil)
+
+		var got params.Delta
+		err = json.Unmarshal(data, &got)
+		c.Assert(err, tc.ErrorIsNil)
+		c.Check(got, tc.DeepEquals, delta)
+	}
+}
+
+func (s *DeltaSuite) TestMarshalFormat(c *tc.C) {
+	delta := params.Delta{
+		Removed: true,
+		Entity:  &params.RemoteApplicationUpdate{ModelUUID: "uuid", Name: "db2", Life: life.Dead},
+	}
+	data, err := json.Marshal(&delta)
+	c.Assert(err, tc.ErrorIsNil)
+	c.Check(string(data), tc.Equals,
+		`["remoteApplication","remove",{"model-uuid":"uuid","name":"db2","offer-uuid":"","life":"dead"}]`)
+}
+
+func (s *DeltaSuite) TestUnmarshalErrors(c *tc.C) {
+	var delta params.Delta
+	err := json.Unmarshal([]byte(`["unit","change"]`), &delta)
+	c.Check(err, tc.ErrorMatches, `expected 3 elements in top-level of JSON but got 2`)
+
+	err = json.Unmarshal([]byte(`["unit","frob",{}]`), &delta)
+	c.Check(err, tc.ErrorMatches, `unexpected operation "frob"`)
+
+	err = json.Unmarshal([]byte(`["charm","change",{}]`), &delta)
+	c.Check(err, tc.ErrorMatches, `unexpected entity name "charm"`)
+}
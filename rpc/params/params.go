@@ -727,6 +727,8 @@ type StateServingInfo struct {
 	CAPrivateKey string `json:"ca-private-key"`
 	// this will be passed as the KeyFile argument to MongoDB
 	SystemIdentity string `json:"system-identity"`
+	// The root key from which the secret key-encryption keys are derived.
+	SecretEncryptionKey string `json:"secret-encryption-key,omitempty"`
 }
 
 // IsMasterResult holds the result of an IsMaster API call.
@@ -144,3 +144,61 @@ type CharmResourceResult struct {
 	ErrorResult
 	CharmResource
 }
+
+// ResourceStorageReport holds the blobs held in a model's object store on
+// behalf of charms and resources.
+type ResourceStorageReport struct {
+	Blobs []ResourceStorageBlob `json:"blobs"`
+}
+
+// ResourceStorageBlob describes a blob held in a model's object store.
+type ResourceStorageBlob struct {
+	// Paths are the object store paths of the blob.
+	Paths []string `json:"paths"`
+
+	// SHA384 is the hash of the blob.
+	SHA384 string `json:"sha384"`
+
+	// Size is the size of the blob, in bytes.
+	Size int64 `json:"size"`
+
+	// Created is when the blob was stored.
+	Created time.Time `json:"created"`
+
+	// Usage describes how the blob is used, one of "in-use", "old-revision"
+	// or "unreferenced".
+	Usage string `json:"usage"`
+
+	// References are the charm and resource revisions held in the blob.
+	References []ResourceStorageBlobReference `json:"references,omitempty"`
+}
+
+// ResourceStorageBlobReference describes a charm or resource revision held in
+// a blob.
+type ResourceStorageBlobReference struct {
+	// Kind is either "charm" or "resource".
+	Kind string `json:"kind"`
+
+	// Name is the charm name, or the resource name.
+	Name string `json:"name"`
+
+	// ApplicationName is the application a resource belongs to.
+	ApplicationName string `json:"application-name,omitempty"`
+
+	// Revision is the charm or resource revision.
+	Revision int `json:"revision"`
+
+	// InUse is true if the revision is in use by an application or unit.
+	InUse bool `json:"in-use"`
+}
+
+// PruneResourceStorageArgs holds the arguments for pruning unreferenced blobs
+// from a model's object store.
+type PruneResourceStorageArgs struct {
+	// GracePeriod is how long an unreferenced blob is kept after it has been
+	// stored.
+	GracePeriod time.Duration `json:"grace-period"`
+
+	// DryRun is true if nothing should be removed.
+	DryRun bool `json:"dry-run"`
+}
@@ -296,6 +296,76 @@ type ListSecretResult struct {
 	Access                 []AccessInfo       `json:"access,omitempty"`
 }
 
+// SecretAccessLogArgs holds the args for getting secret access logs.
+type SecretAccessLogArgs struct {
+	Args []SecretAccessLogArg `json:"args"`
+}
+
+// SecretAccessLogArg holds the args for getting the access log of a secret.
+type SecretAccessLogArg struct {
+	URI      string `json:"uri"`
+	Revision *int   `json:"revision,omitempty"`
+}
+
+// SecretAccessLogResults holds secret access log results.
+type SecretAccessLogResults struct {
+	Results []SecretAccessLogResult `json:"results"`
+}
+
+// SecretAccessLogResult holds the access log of a secret.
+type SecretAccessLogResult struct {
+	Entries []SecretAccessLogEntry `json:"entries,omitempty"`
+	Error   *Error                 `json:"error,omitempty"`
+}
+
+// SecretAccessLogEntry records a read of secret content.
+type SecretAccessLogEntry struct {
+	Revision    int       `json:"revision"`
+	AccessorTag string    `json:"accessor-tag"`
+	AccessTime  time.Time `json:"access-time"`
+}
+
+// ExportSecretsArgs holds the args for exporting secrets.
+type ExportSecretsArgs struct {
+	Labels    []string `json:"labels,omitempty"`
+	OwnerTags []string `json:"owner-tags,omitempty"`
+}
+
+// ExportSecretsResult holds exported secrets.
+type ExportSecretsResult struct {
+	Secrets []ExportedSecret `json:"secrets"`
+	Error   *Error           `json:"error,omitempty"`
+}
+
+// ImportSecretsArgs holds the args for importing secrets.
+type ImportSecretsArgs struct {
+	Secrets []ExportedSecret `json:"secrets"`
+}
+
+// ExportedSecret holds the metadata of a secret and the
+// content of each of its revisions.
+type ExportedSecret struct {
+	URI                    string                   `json:"uri"`
+	Version                int                      `json:"version"`
+	OwnerTag               string                   `json:"owner-tag"`
+	Description            string                   `json:"description,omitempty"`
+	Label                  string                   `json:"label,omitempty"`
+	RotatePolicy           string                   `json:"rotate-policy,omitempty"`
+	NextRotateTime         *time.Time               `json:"next-rotate-time,omitempty"`
+	AutoPrune              bool                     `json:"auto-prune,omitempty"`
+	LatestRevisionChecksum string                   `json:"latest-revision-checksum"`
+	LatestExpireTime       *time.Time               `json:"latest-expire-time,omitempty"`
+	Revisions              []ExportedSecretRevision `json:"revisions"`
+}
+
+// ExportedSecretRevision holds the content of a secret revision.
+type ExportedSecretRevision struct {
+	Revision   int               `json:"revision"`
+	CreateTime time.Time         `json:"create-time,omitempty"`
+	ExpireTime *time.Time        `json:"expire-time,omitempty"`
+	Data       map[string]string `json:"data"`
+}
+
 // SecretRevisionsToDrainResults holds secret revisions to drain results.
 type SecretRevisionsToDrainResults struct {
 	Results []SecretRevisionsToDrainResult `json:"results"`